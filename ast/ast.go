@@ -8,6 +8,8 @@ package ast
 import (
 	"bytes"
 	"fmt"
+	"regexp"
+	"regexp/syntax"
 	"strconv"
 	"strings"
 )
@@ -33,6 +35,8 @@ type Grammar struct {
 	p     Pos
 	Init  *CodeBlock
 	Rules []*Rule
+	// FailureLabels declares the message templates of the failure labels.
+	FailureLabels []*FailureLabelDecl
 }
 
 var _ Expression = (*Grammar)(nil)
@@ -73,12 +77,40 @@ func (g *Grammar) InitialNames() map[string]struct{} {
 	panic("InitialNames should not be called on the Grammar")
 }
 
-// Rule represents a rule in the PEG grammar. It has a name, an optional
-// display name to be used in error messages, and an expression.
+// Annotations of the rules, written as @name between the name (or display
+// name) of the rule and the rule definition operator.
+const (
+	// MemoAnnotation marks a rule to be memoized by the generated parser,
+	// regardless of the Memoize option.
+	MemoAnnotation = "memo"
+	// SkipAnnotation marks the skip rule of the grammar, which is matched
+	// between the expressions of the sequences of the syntactic rules, see
+	// ApplySkip.
+	SkipAnnotation = "skip"
+	// LexicalAnnotation marks a rule as lexical: the skip rule is not
+	// matched in the rule, nor in the rules it references.
+	LexicalAnnotation = "lexical"
+	// LongestAnnotation marks a rule that matches the longest of the
+	// literals of its choice, see ApplyLitSets.
+	LongestAnnotation = "longest"
+	// KeywordsAnnotation marks a rule that matches the longest of the
+	// literals of its choice that is not followed by a letter, a digit or
+	// an underscore, see ApplyLitSets.
+	KeywordsAnnotation = "keywords"
+)
+
+// Rule represents a rule in the PEG grammar. It has a name, optional
+// parameters, an optional display name to be used in error messages,
+// optional annotations and an expression.
 type Rule struct {
-	p           Pos
-	Name        *Identifier
+	p    Pos
+	Name *Identifier
+	// Params are the parameters of a parameterized rule, e.g. Item and Sep
+	// in List<Item, Sep>. They are replaced by the arguments of the rule
+	// references, see Instantiate.
+	Params      []*Identifier
 	DisplayName *StringLit
+	Annotations []*Identifier
 	Expr        Expression
 
 	// Fields below to work with left recursion.
@@ -101,10 +133,28 @@ func (r *Rule) Pos() Pos { return r.p }
 
 // String returns the textual representation of a node.
 func (r *Rule) String() string {
+	if len(r.Params) > 0 {
+		return fmt.Sprintf("%s: %T{Name: %v, Params: %v, DisplayName: %v, Annotations: %v, Expr: %v}",
+			r.p, r, r.Name, r.Params, r.DisplayName, r.Annotations, r.Expr)
+	}
+	if len(r.Annotations) > 0 {
+		return fmt.Sprintf("%s: %T{Name: %v, DisplayName: %v, Annotations: %v, Expr: %v}",
+			r.p, r, r.Name, r.DisplayName, r.Annotations, r.Expr)
+	}
 	return fmt.Sprintf("%s: %T{Name: %v, DisplayName: %v, Expr: %v}",
 		r.p, r, r.Name, r.DisplayName, r.Expr)
 }
 
+// HasAnnotation returns true if the rule has the annotation name.
+func (r *Rule) HasAnnotation(name string) bool {
+	for _, a := range r.Annotations {
+		if a.Val == name {
+			return true
+		}
+	}
+	return false
+}
+
 // NullableVisit recursively determines whether an object is nullable.
 func (r *Rule) NullableVisit(rules map[string]*Rule) bool {
 	if r.Visited {
@@ -200,6 +250,30 @@ func (c *ChoiceExpr) InitialNames() map[string]struct{} {
 // FailureLabel is an identifier, which can by thrown and recovered in a grammar.
 type FailureLabel string
 
+// FailureLabelDecl declares the message template of a failure label, e.g.
+// %{errComma} = "expecting ','". The placeholders {label}, {expected} and
+// {found} of the template are replaced by the label, the values expected
+// at the position of the failure and the input at that position.
+type FailureLabelDecl struct {
+	p       Pos
+	Label   *Identifier
+	Message string
+}
+
+// NewFailureLabelDecl creates a new failure label declaration at the
+// specified position.
+func NewFailureLabelDecl(p Pos) *FailureLabelDecl {
+	return &FailureLabelDecl{p: p}
+}
+
+// Pos returns the starting position of the node.
+func (f *FailureLabelDecl) Pos() Pos { return f.p }
+
+// String returns the textual representation of a node.
+func (f *FailureLabelDecl) String() string {
+	return fmt.Sprintf("%s: %T{Label: %v, Message: %q}", f.p, f, f.Label, f.Message)
+}
+
 // RecoveryExpr is an ordered sequence of expressions. The parser tries to
 // match any of the alternatives in sequence and stops at the first one
 // that matches.
@@ -341,11 +415,94 @@ func (t *ThrowExpr) InitialNames() map[string]struct{} {
 	return make(map[string]struct{})
 }
 
+// CutExpr is a zero-length matcher that is always a match. It commits the
+// sequence it is part of: if an expression that follows the cut in the
+// sequence does not match, the parsing stops with an error instead of
+// backtracking.
+type CutExpr struct {
+	p Pos
+}
+
+var _ Expression = (*CutExpr)(nil)
+
+// NewCutExpr creates a new cut expression at the specified position.
+func NewCutExpr(p Pos) *CutExpr {
+	return &CutExpr{p: p}
+}
+
+// Pos returns the starting position of the node.
+func (c *CutExpr) Pos() Pos { return c.p }
+
+// String returns the textual representation of a node.
+func (c *CutExpr) String() string {
+	return fmt.Sprintf("%s: %T{}", c.p, c)
+}
+
+// NullableVisit recursively determines whether an object is nullable.
+func (c *CutExpr) NullableVisit(rules map[string]*Rule) bool {
+	return true
+}
+
+// IsNullable returns the nullable attribute of the node.
+func (c *CutExpr) IsNullable() bool {
+	return true
+}
+
+// InitialNames returns names of nodes with which an expression can begin.
+func (c *CutExpr) InitialNames() map[string]struct{} {
+	return make(map[string]struct{})
+}
+
+// BackRefExpr is a matcher that matches the text captured earlier by a
+// label of its rule, or the text of a value of the state store.
+type BackRefExpr struct {
+	p    Pos
+	Name *Identifier
+	// State is true if Name is the key of the value in the state store,
+	// instead of a label.
+	State bool
+}
+
+var _ Expression = (*BackRefExpr)(nil)
+
+// NewBackRefExpr creates a new back-reference expression at the
+// specified position.
+func NewBackRefExpr(p Pos) *BackRefExpr {
+	return &BackRefExpr{p: p}
+}
+
+// Pos returns the starting position of the node.
+func (b *BackRefExpr) Pos() Pos { return b.p }
+
+// String returns the textual representation of a node.
+func (b *BackRefExpr) String() string {
+	return fmt.Sprintf("%s: %T{Name: %v, State: %t}", b.p, b, b.Name, b.State)
+}
+
+// NullableVisit recursively determines whether an object is nullable.
+func (b *BackRefExpr) NullableVisit(rules map[string]*Rule) bool {
+	return true
+}
+
+// IsNullable returns the nullable attribute of the node. The captured text
+// may be empty.
+func (b *BackRefExpr) IsNullable() bool {
+	return true
+}
+
+// InitialNames returns names of nodes with which an expression can begin.
+func (b *BackRefExpr) InitialNames() map[string]struct{} {
+	return make(map[string]struct{})
+}
+
 // SeqExpr is an ordered sequence of expressions, all of which must match
 // if the SeqExpr is to be a match itself.
 type SeqExpr struct {
 	p     Pos
 	Exprs []Expression
+	// Skip is true if the skip rule of the grammar is matched between the
+	// expressions, see ApplySkip.
+	Skip bool
 
 	Nullable bool
 }
@@ -384,6 +541,28 @@ func (s *SeqExpr) NullableVisit(rules map[string]*Rule) bool {
 	return true
 }
 
+// CutIndex returns the index of the first cut expression of the sequence,
+// or -1 if it has none.
+func (s *SeqExpr) CutIndex() int {
+	for i, e := range s.Exprs {
+		if _, ok := e.(*CutExpr); ok {
+			return i
+		}
+	}
+	return -1
+}
+
+// Plucked returns the indexes of the pluck expressions of the sequence.
+func (s *SeqExpr) Plucked() []int {
+	var ix []int
+	for i, e := range s.Exprs {
+		if _, ok := e.(*PluckExpr); ok {
+			ix = append(ix, i)
+		}
+	}
+	return ix
+}
+
 // IsNullable returns the nullable attribute of the node.
 func (s *SeqExpr) IsNullable() bool {
 	return s.Nullable
@@ -442,6 +621,83 @@ func (l *LabeledExpr) InitialNames() map[string]struct{} {
 	return l.Expr.InitialNames()
 }
 
+// PluckExpr marks an expression of a sequence whose value is the value of
+// the sequence, instead of the slice of the values of its expressions. If
+// more than one expression of the sequence is marked, the value is the
+// slice of the values of the marked expressions.
+type PluckExpr struct {
+	p    Pos
+	Expr Expression
+}
+
+var _ Expression = (*PluckExpr)(nil)
+
+// NewPluckExpr creates a new pluck (@) expression at the specified
+// position.
+func NewPluckExpr(p Pos) *PluckExpr {
+	return &PluckExpr{p: p}
+}
+
+// Pos returns the starting position of the node.
+func (p *PluckExpr) Pos() Pos { return p.p }
+
+// String returns the textual representation of a node.
+func (p *PluckExpr) String() string {
+	return fmt.Sprintf("%s: %T{Expr: %v}", p.p, p, p.Expr)
+}
+
+// NullableVisit recursively determines whether an object is nullable.
+func (p *PluckExpr) NullableVisit(rules map[string]*Rule) bool {
+	return p.Expr.NullableVisit(rules)
+}
+
+// IsNullable returns the nullable attribute of the node.
+func (p *PluckExpr) IsNullable() bool {
+	return p.Expr.IsNullable()
+}
+
+// InitialNames returns names of nodes with which an expression can begin.
+func (p *PluckExpr) InitialNames() map[string]struct{} {
+	return p.Expr.InitialNames()
+}
+
+// TextExpr is an expression whose value is the text matched by the
+// expression it contains, as a string.
+type TextExpr struct {
+	p    Pos
+	Expr Expression
+}
+
+var _ Expression = (*TextExpr)(nil)
+
+// NewTextExpr creates a new text ($) expression at the specified position.
+func NewTextExpr(p Pos) *TextExpr {
+	return &TextExpr{p: p}
+}
+
+// Pos returns the starting position of the node.
+func (t *TextExpr) Pos() Pos { return t.p }
+
+// String returns the textual representation of a node.
+func (t *TextExpr) String() string {
+	return fmt.Sprintf("%s: %T{Expr: %v}", t.p, t, t.Expr)
+}
+
+// NullableVisit recursively determines whether an object is nullable.
+func (t *TextExpr) NullableVisit(rules map[string]*Rule) bool {
+	return t.Expr.NullableVisit(rules)
+}
+
+// IsNullable returns the nullable attribute of the node.
+func (t *TextExpr) IsNullable() bool {
+	return t.Expr.IsNullable()
+}
+
+// InitialNames returns names of nodes with which an expression can begin.
+func (t *TextExpr) InitialNames() map[string]struct{} {
+	return t.Expr.InitialNames()
+}
+
 // AndExpr is a zero-length matcher that is considered a match if the
 // expression it contains is a match.
 type AndExpr struct {
@@ -557,6 +813,9 @@ func (z *ZeroOrOneExpr) InitialNames() map[string]struct{} {
 type ZeroOrMoreExpr struct {
 	p    Pos
 	Expr Expression
+	// Skip is true if the skip rule of the grammar is matched between the
+	// repetitions of the expression, see ApplySkip.
+	Skip bool
 }
 
 var _ Expression = (*ZeroOrMoreExpr)(nil)
@@ -594,6 +853,9 @@ func (z *ZeroOrMoreExpr) InitialNames() map[string]struct{} {
 type OneOrMoreExpr struct {
 	p    Pos
 	Expr Expression
+	// Skip is true if the skip rule of the grammar is matched between the
+	// repetitions of the expression, see ApplySkip.
+	Skip bool
 }
 
 var _ Expression = (*OneOrMoreExpr)(nil)
@@ -627,10 +889,190 @@ func (o *OneOrMoreExpr) InitialNames() map[string]struct{} {
 	return o.Expr.InitialNames()
 }
 
+// RepeatExpr is an expression that can be matched between Min and Max
+// times, e.g. expr{2,4}. Max is -1 if the number of repetitions is not
+// bounded, e.g. expr{2,}.
+type RepeatExpr struct {
+	p    Pos
+	Expr Expression
+	Min  int
+	Max  int
+	// Skip is true if the skip rule of the grammar is matched between the
+	// repetitions of the expression, see ApplySkip.
+	Skip bool
+
+	Nullable bool
+}
+
+var _ Expression = (*RepeatExpr)(nil)
+
+// NewRepeatExpr creates a new repeat expression at the specified position.
+func NewRepeatExpr(p Pos) *RepeatExpr {
+	return &RepeatExpr{p: p}
+}
+
+// Pos returns the starting position of the node.
+func (r *RepeatExpr) Pos() Pos { return r.p }
+
+// String returns the textual representation of a node.
+func (r *RepeatExpr) String() string {
+	return fmt.Sprintf("%s: %T{Expr: %v, Min: %d, Max: %d}", r.p, r, r.Expr, r.Min, r.Max)
+}
+
+// NullableVisit recursively determines whether an object is nullable.
+func (r *RepeatExpr) NullableVisit(rules map[string]*Rule) bool {
+	r.Nullable = r.Expr.NullableVisit(rules) || r.Min == 0
+	return r.Nullable
+}
+
+// IsNullable returns the nullable attribute of the node.
+func (r *RepeatExpr) IsNullable() bool {
+	return r.Nullable
+}
+
+// InitialNames returns names of nodes with which an expression can begin.
+func (r *RepeatExpr) InitialNames() map[string]struct{} {
+	return r.Expr.InitialNames()
+}
+
+// PrecedenceKind is the kind of the operators of a level of a precedence
+// expression.
+type PrecedenceKind int
+
+// List of the kinds of operators of a precedence expression.
+const (
+	PrecLeft    PrecedenceKind = iota // left-associative binary operators
+	PrecRight                         // right-associative binary operators
+	PrecNone                          // non-associative binary operators
+	PrecPrefix                        // prefix unary operators
+	PrecPostfix                       // postfix unary operators
+)
+
+var precedenceKinds = [...]string{
+	PrecLeft:    "left",
+	PrecRight:   "right",
+	PrecNone:    "nonassoc",
+	PrecPrefix:  "prefix",
+	PrecPostfix: "postfix",
+}
+
+// String returns the keyword of the kind of operators, without the
+// leading "%".
+func (k PrecedenceKind) String() string {
+	if k >= 0 && int(k) < len(precedenceKinds) {
+		return precedenceKinds[k]
+	}
+	return "PrecedenceKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// PrecedenceLevel is a level of operators of a precedence expression. Its
+// code block, if any, is called for each operator that is matched, with
+// the operator and its operands as arguments: l, op and r for a binary
+// operator, op and x for a unary one.
+type PrecedenceLevel struct {
+	p      Pos
+	Kind   PrecedenceKind
+	Op     Expression
+	Code   *CodeBlock
+	FuncIx int
+}
+
+// NewPrecedenceLevel creates a new level of operators of the specified
+// kind at the specified position.
+func NewPrecedenceLevel(p Pos, kind PrecedenceKind) *PrecedenceLevel {
+	return &PrecedenceLevel{p: p, Kind: kind}
+}
+
+// Pos returns the starting position of the level.
+func (l *PrecedenceLevel) Pos() Pos { return l.p }
+
+// String returns the textual representation of a level.
+func (l *PrecedenceLevel) String() string {
+	return fmt.Sprintf("%s: %T{Kind: %s, Op: %v, Code: %v}", l.p, l, l.Kind, l.Op, l.Code)
+}
+
+// PrecedenceExpr is an expression of operands and operators, parsed by
+// precedence climbing. The levels of operators are listed from the lowest
+// precedence to the highest, e.g.:
+//
+//	%precedence( Number
+//	    %left ( "+" / "-" ) { return eval(l, op, r) }
+//	    %left ( "*" / "/" ) { return eval(l, op, r) }
+//	    %prefix "-" { return -x.(int), nil }
+//	)
+type PrecedenceExpr struct {
+	p       Pos
+	Operand Expression
+	Levels  []*PrecedenceLevel
+	// Skip is true if the skip rule of the grammar is matched between the
+	// operators and the operands, see ApplySkip.
+	Skip bool
+
+	Nullable bool
+}
+
+var _ Expression = (*PrecedenceExpr)(nil)
+
+// NewPrecedenceExpr creates a new precedence expression at the specified
+// position.
+func NewPrecedenceExpr(p Pos) *PrecedenceExpr {
+	return &PrecedenceExpr{p: p}
+}
+
+// Pos returns the starting position of the node.
+func (e *PrecedenceExpr) Pos() Pos { return e.p }
+
+// String returns the textual representation of a node.
+func (e *PrecedenceExpr) String() string {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "%s: %T{Operand: %v, Levels: [\n", e.p, e, e.Operand)
+	for _, l := range e.Levels {
+		fmt.Fprintf(&buf, "%s,\n", l)
+	}
+	buf.WriteString("]}")
+	return buf.String()
+}
+
+// NullableVisit recursively determines whether an object is nullable.
+func (e *PrecedenceExpr) NullableVisit(rules map[string]*Rule) bool {
+	for _, l := range e.Levels {
+		l.Op.NullableVisit(rules)
+	}
+	e.Nullable = e.Operand.NullableVisit(rules)
+	return e.Nullable
+}
+
+// IsNullable returns the nullable attribute of the node.
+func (e *PrecedenceExpr) IsNullable() bool {
+	return e.Nullable
+}
+
+// InitialNames returns names of nodes with which an expression can begin.
+func (e *PrecedenceExpr) InitialNames() map[string]struct{} {
+	names := make(map[string]struct{})
+	for name := range e.Operand.InitialNames() {
+		names[name] = struct{}{}
+	}
+	for _, l := range e.Levels {
+		if l.Kind != PrecPrefix && !e.Operand.IsNullable() {
+			continue
+		}
+		for name := range l.Op.InitialNames() {
+			names[name] = struct{}{}
+		}
+	}
+	return names
+}
+
 // RuleRefExpr is an expression that references a rule by name.
 type RuleRefExpr struct {
 	p    Pos
 	Name *Identifier
+	// Args are the arguments of the reference, e.g. the arguments of a
+	// parameterized rule or the label of the built-in bytes<label> rule of
+	// the binary parsers.
+	Args []Expression
 
 	Nullable bool
 }
@@ -648,7 +1090,18 @@ func (r *RuleRefExpr) Pos() Pos { return r.p }
 
 // String returns the textual representation of a node.
 func (r *RuleRefExpr) String() string {
-	return fmt.Sprintf("%s: %T{Name: %v}", r.p, r, r.Name)
+	if len(r.Args) == 0 {
+		return fmt.Sprintf("%s: %T{Name: %v}", r.p, r, r.Name)
+	}
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "%s: %T{Name: %v, Args: [\n", r.p, r, r.Name)
+	for _, e := range r.Args {
+		fmt.Fprintf(&buf, "%s,\n", e)
+	}
+	buf.WriteString("]}")
+	return buf.String()
 }
 
 // NullableVisit recursively determines whether an object is nullable.
@@ -829,13 +1282,75 @@ func (l *LitMatcher) InitialNames() map[string]struct{} {
 	return make(map[string]struct{})
 }
 
+// LitSetMatcher is a matcher of a set of string literals, which are matched
+// in a single pass over the input using a trie of the literals. It matches
+// the first literal of the set that matches, like a choice of the literals,
+// unless Longest is true, in which case it matches the longest one. If
+// Boundary is true, the literal must not be followed by a letter, a digit
+// or an underscore.
+type LitSetMatcher struct {
+	p        Pos
+	Lits     []*LitMatcher
+	Longest  bool
+	Boundary bool
+}
+
+var _ Expression = (*LitSetMatcher)(nil)
+
+// NewLitSetMatcher creates a new literal set matcher at the specified
+// position and with the specified literals.
+func NewLitSetMatcher(p Pos, lits ...*LitMatcher) *LitSetMatcher {
+	return &LitSetMatcher{p: p, Lits: lits}
+}
+
+// Pos returns the starting position of the node.
+func (l *LitSetMatcher) Pos() Pos { return l.p }
+
+// String returns the textual representation of a node.
+func (l *LitSetMatcher) String() string {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "%s: %T{Longest: %t, Boundary: %t, Lits: [\n", l.p, l, l.Longest, l.Boundary)
+	for _, lit := range l.Lits {
+		fmt.Fprintf(&buf, "%s,\n", lit)
+	}
+	buf.WriteString("]}")
+	return buf.String()
+}
+
+// NullableVisit recursively determines whether an object is nullable.
+func (l *LitSetMatcher) NullableVisit(rules map[string]*Rule) bool {
+	return l.IsNullable()
+}
+
+// IsNullable returns the nullable attribute of the node.
+func (l *LitSetMatcher) IsNullable() bool {
+	for _, lit := range l.Lits {
+		if lit.IsNullable() {
+			return true
+		}
+	}
+	return false
+}
+
+// InitialNames returns names of nodes with which an expression can begin.
+func (l *LitSetMatcher) InitialNames() map[string]struct{} {
+	return make(map[string]struct{})
+}
+
 // CharClassMatcher is a character class matcher. The value to match must
 // be one of the specified characters, in a range of characters, or in the
 // Unicode classes of characters.
+//
+// The classes that use set operations, negated Unicode classes or derived
+// properties are computed when they are parsed: Derived is true and
+// Ranges holds the sorted ranges of the matched runes, case-insensitive
+// classes included.
 type CharClassMatcher struct {
 	posValue
 	IgnoreCase     bool
 	Inverted       bool
+	Derived        bool
 	Chars          []rune
 	Ranges         []rune // pairs of low/high range
 	UnicodeClasses []string
@@ -872,6 +1387,9 @@ func (c *CharClassMatcher) parse() {
 			return
 		}
 	}
+	if c.parseDerived(raw) {
+		return
+	}
 
 	// content of char class is necessarily valid, so escapes are correct
 	r := strings.NewReader(raw)
@@ -904,7 +1422,7 @@ outer:
 						}
 						buf.WriteRune(rn)
 					}
-					c.UnicodeClasses = append(c.UnicodeClasses, buf.String())
+					c.UnicodeClasses = append(c.UnicodeClasses, UnicodeClassName(buf.String()))
 				} else {
 					c.UnicodeClasses = append(c.UnicodeClasses, string(rn))
 				}
@@ -973,7 +1491,7 @@ func (c *CharClassMatcher) NullableVisit(rules map[string]*Rule) bool {
 
 // IsNullable returns the nullable attribute of the node.
 func (c *CharClassMatcher) IsNullable() bool {
-	return len(c.Chars) == 0 && len(c.Ranges) == 0 && len(c.UnicodeClasses) == 0
+	return !c.Derived && len(c.Chars) == 0 && len(c.Ranges) == 0 && len(c.UnicodeClasses) == 0
 }
 
 // InitialNames returns names of nodes with which an expression can begin.
@@ -1017,6 +1535,115 @@ func (a *AnyMatcher) InitialNames() map[string]struct{} {
 	return make(map[string]struct{})
 }
 
+// RegexpMatcher is a matcher of a Go regular expression, anchored at the
+// current position of the input. Val is the raw matcher, e.g.
+// re"[0-9]+", and Expr is its regular expression.
+type RegexpMatcher struct {
+	posValue
+	Expr string
+}
+
+var _ Expression = (*RegexpMatcher)(nil)
+
+// NewRegexpMatcher creates a new regular expression matcher at the
+// specified position and with the specified raw value. The regular
+// expression is the text between the quotes, where \" is a quote and the
+// other backslashes are kept as-is.
+func NewRegexpMatcher(p Pos, raw string) *RegexpMatcher {
+	r := &RegexpMatcher{posValue: posValue{p: p, Val: raw}}
+	expr := strings.TrimSuffix(strings.TrimPrefix(raw, `re"`), `"`)
+	r.Expr = strings.ReplaceAll(expr, `\"`, `"`)
+	return r
+}
+
+// Pos returns the starting position of the node.
+func (r *RegexpMatcher) Pos() Pos { return r.p }
+
+// String returns the textual representation of a node.
+func (r *RegexpMatcher) String() string {
+	return fmt.Sprintf("%s: %T{Val: %q}", r.p, r, r.Val)
+}
+
+// NullableVisit recursively determines whether an object is nullable.
+func (r *RegexpMatcher) NullableVisit(rules map[string]*Rule) bool {
+	return r.IsNullable()
+}
+
+// IsNullable returns the nullable attribute of the node, true if the
+// regular expression matches the empty string.
+func (r *RegexpMatcher) IsNullable() bool {
+	re, err := regexp.Compile(r.Expr)
+	return err == nil && re.MatchString("")
+}
+
+// LooksBehind returns true if the regular expression has an assertion on
+// the input before the position where it is matched: "^", "\b" or "\B".
+func (r *RegexpMatcher) LooksBehind() bool {
+	re, err := syntax.Parse(r.Expr, syntax.Perl)
+	if err != nil {
+		return false
+	}
+	var looksBehind func(re *syntax.Regexp) bool
+	looksBehind = func(re *syntax.Regexp) bool {
+		switch re.Op {
+		case syntax.OpBeginLine, syntax.OpBeginText, syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+			return true
+		}
+		for _, sub := range re.Sub {
+			if looksBehind(sub) {
+				return true
+			}
+		}
+		return false
+	}
+	return looksBehind(re)
+}
+
+// InitialNames returns names of nodes with which an expression can begin.
+func (r *RegexpMatcher) InitialNames() map[string]struct{} {
+	return make(map[string]struct{})
+}
+
+// MatcherFuncExpr is a matcher that calls a Go function of the package of
+// the parser, e.g. @scanString(), which returns the number of bytes that
+// it matched at the current position and the value of the match.
+type MatcherFuncExpr struct {
+	p    Pos
+	Name *Identifier
+}
+
+var _ Expression = (*MatcherFuncExpr)(nil)
+
+// NewMatcherFuncExpr creates a new matcher function expression at the
+// specified position.
+func NewMatcherFuncExpr(p Pos) *MatcherFuncExpr {
+	return &MatcherFuncExpr{p: p}
+}
+
+// Pos returns the starting position of the node.
+func (m *MatcherFuncExpr) Pos() Pos { return m.p }
+
+// String returns the textual representation of a node.
+func (m *MatcherFuncExpr) String() string {
+	return fmt.Sprintf("%s: %T{Name: %v}", m.p, m, m.Name)
+}
+
+// NullableVisit recursively determines whether an object is nullable.
+func (m *MatcherFuncExpr) NullableVisit(rules map[string]*Rule) bool {
+	return true
+}
+
+// IsNullable returns the nullable attribute of the node. The function may
+// match the empty string.
+func (m *MatcherFuncExpr) IsNullable() bool {
+	return true
+}
+
+// InitialNames returns names of nodes with which an expression can begin.
+func (m *MatcherFuncExpr) InitialNames() map[string]struct{} {
+	return make(map[string]struct{})
+}
+
 // CodeBlock represents a code block.
 type CodeBlock struct {
 	posValue
@@ -0,0 +1,125 @@
+package ast
+
+import "fmt"
+
+// ResolveBackRefs replaces the text expressions of a reference to a rule,
+// e.g. $open, by a back-reference to the label with that name when their
+// rule has such a label, and $open* by the repetition of the
+// back-reference. The $ operator otherwise matches the expression that
+// follows it and returns its text, so $open is the text of the rule open
+// in the rules that have no label open.
+//
+// It returns an error if $name could be both: if the rule has a label name
+// and the grammar has a rule name, so that adding a label cannot silently
+// change the meaning of $name.
+//
+// ResolveBackRefs can be called again on the resulting grammar, which is
+// left unchanged.
+func ResolveBackRefs(g *Grammar) error {
+	rules := make(map[string]bool, len(g.Rules))
+	for _, r := range g.Rules {
+		if r.Name != nil {
+			rules[r.Name.Val] = true
+		}
+	}
+
+	var err error
+	for _, r := range g.Rules {
+		labels := make(map[string]bool)
+		Inspect(r.Expr, func(expr Expression) bool {
+			if lab, ok := expr.(*LabeledExpr); ok && lab.Label != nil {
+				labels[lab.Label.Val] = true
+			}
+			return true
+		})
+		if len(labels) == 0 {
+			continue
+		}
+
+		backRef := func(text *TextExpr, expr Expression) Expression {
+			ref, ok := expr.(*RuleRefExpr)
+			if !ok || len(ref.Args) > 0 || !labels[ref.Name.Val] {
+				return nil
+			}
+			if rules[ref.Name.Val] && err == nil {
+				err = fmt.Errorf("%s: $%s is ambiguous in rule %s: %[2]s is both a label and a rule", text.p, ref.Name.Val, r.Name.Val)
+			}
+			return &BackRefExpr{p: text.p, Name: ref.Name}
+		}
+		resolve := func(expr Expression) Expression {
+			text, ok := expr.(*TextExpr)
+			if !ok {
+				return expr
+			}
+			if ref := backRef(text, text.Expr); ref != nil {
+				return ref
+			}
+			switch rep := text.Expr.(type) {
+			case *ZeroOrOneExpr, *ZeroOrMoreExpr, *OneOrMoreExpr, *RepeatExpr:
+				// $open* repeats the back-reference
+				sub := subExprs(rep)[0]
+				if ref := backRef(text, *sub); ref != nil {
+					*sub = ref
+					return rep
+				}
+			}
+			return expr
+		}
+		r.Expr = resolve(r.Expr)
+		Inspect(r.Expr, func(expr Expression) bool {
+			for _, sub := range subExprs(expr) {
+				*sub = resolve(*sub)
+			}
+			return true
+		})
+	}
+	return err
+}
+
+// subExprs returns pointers to the sub-expressions of expr, so that they
+// can be replaced.
+func subExprs(expr Expression) []*Expression {
+	switch expr := expr.(type) {
+	case *ActionExpr:
+		return []*Expression{&expr.Expr}
+	case *AndExpr:
+		return []*Expression{&expr.Expr}
+	case *ChoiceExpr:
+		subs := make([]*Expression, len(expr.Alternatives))
+		for i := range expr.Alternatives {
+			subs[i] = &expr.Alternatives[i]
+		}
+		return subs
+	case *LabeledExpr:
+		return []*Expression{&expr.Expr}
+	case *NotExpr:
+		return []*Expression{&expr.Expr}
+	case *OneOrMoreExpr:
+		return []*Expression{&expr.Expr}
+	case *PluckExpr:
+		return []*Expression{&expr.Expr}
+	case *PrecedenceExpr:
+		subs := []*Expression{&expr.Operand}
+		for _, l := range expr.Levels {
+			subs = append(subs, &l.Op)
+		}
+		return subs
+	case *RecoveryExpr:
+		return []*Expression{&expr.Expr, &expr.RecoverExpr}
+	case *RepeatExpr:
+		return []*Expression{&expr.Expr}
+	case *SeqExpr:
+		subs := make([]*Expression, len(expr.Exprs))
+		for i := range expr.Exprs {
+			subs[i] = &expr.Exprs[i]
+		}
+		return subs
+	case *TextExpr:
+		return []*Expression{&expr.Expr}
+	case *ZeroOrMoreExpr:
+		return []*Expression{&expr.Expr}
+	case *ZeroOrOneExpr:
+		return []*Expression{&expr.Expr}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package ast
+
+import (
+	"strings"
+	"testing"
+)
+
+func testText(expr Expression) *TextExpr {
+	text := NewTextExpr(Pos{})
+	text.Expr = expr
+	return text
+}
+
+func testLabeled(label string, expr Expression) *LabeledExpr {
+	lab := NewLabeledExpr(Pos{})
+	lab.Label = NewIdentifier(Pos{}, label)
+	lab.Expr = expr
+	return lab
+}
+
+func TestResolveBackRefs(t *testing.T) {
+	g := testGrammar(
+		testRule("A", testSeq(testLabeled("o", testRef("B")), testText(testRef("o")), testText(testRef("B")))),
+		testRule("B", testChoice(1, testText(testRef("o")), testLabeled("b", testLit("b", false)))),
+		testRule("C", testText(testRef("o"))),
+		testRule("D", testSeq(testLabeled("o", testRef("B")), testText(&ZeroOrMoreExpr{Expr: testRef("o")}))),
+	)
+	if err := ResolveBackRefs(g); err != nil {
+		t.Fatal(err)
+	}
+	// calling it again leaves the grammar unchanged
+	if err := ResolveBackRefs(g); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`(o:(B) $o $(B))`,
+		`($(o) / b:("b"))`,
+		`$(o)`,
+		`(o:(B) ($o)*)`,
+	}
+	for i, r := range g.Rules {
+		if got := exprText(r.Expr); got != want[i] {
+			t.Errorf("rule %s: want %s, got %s", r.Name.Val, want[i], got)
+		}
+	}
+}
+
+func TestResolveBackRefsAmbiguous(t *testing.T) {
+	// $B is the text of the rule B in C, but C has a label B
+	g := testGrammar(
+		testRule("C", testSeq(testLabeled("B", testLit("b", false)), testText(testRef("B")))),
+		testRule("B", testLit("b", false)),
+	)
+	want := "$B is ambiguous in rule C: B is both a label and a rule"
+	if err := ResolveBackRefs(g); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("want error %q, got %v", want, err)
+	}
+}
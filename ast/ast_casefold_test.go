@@ -0,0 +1,72 @@
+package ast
+
+import "testing"
+
+func TestCaseFold(t *testing.T) {
+	cases := []struct {
+		in   string
+		want rune
+	}{
+		{in: "aA", want: 'a'},
+		{in: "kKK", want: 'k'},
+		{in: "sSſ", want: 's'},
+		{in: "σςΣ", want: 'ς'},
+		{in: "ßẞ", want: 'ß'},
+		{in: "İ", want: 'İ'},
+		{in: "ı", want: 'ı'},
+		{in: "1", want: '1'},
+	}
+	for _, tc := range cases {
+		for _, rn := range tc.in {
+			if got := CaseFold(rn); got != tc.want {
+				t.Errorf("%q: want %q, got %q", rn, tc.want, got)
+			}
+		}
+	}
+}
+
+func TestFoldString(t *testing.T) {
+	cases := []struct {
+		in, want string
+	}{
+		{in: "Abc", want: "abc"},
+		{in: "Straße", want: "strasse"},
+		{in: "STRAẞE", want: "strasse"},
+		{in: "ﬃ", want: "ffi"},
+		{in: "İ", want: "i̇"},
+		{in: "ΣΑΣ", want: "ςας"},
+	}
+	for _, tc := range cases {
+		if got := FoldString(tc.in); got != tc.want {
+			t.Errorf("%q: want %q, got %q", tc.in, tc.want, got)
+		}
+	}
+}
+
+func TestFirstCaseFold(t *testing.T) {
+	fs := NewFirstSets(testGrammar())
+	cases := []struct {
+		expr     Expression
+		match    string
+		notMatch string
+	}{
+		{expr: testLit("ss", true), match: "sSſßẞ", notMatch: "t"},
+		{expr: testLit("st", true), match: "sSſ", notMatch: "ß"},
+		{expr: testLit("k", true), match: "kKK"},
+		{expr: NewCharClassMatcher(Pos{}, "[a-c]i"), match: "abcABC", notMatch: "d"},
+		{expr: NewCharClassMatcher(Pos{}, "[^s]i"), match: "t", notMatch: "sSſ"},
+	}
+	for _, tc := range cases {
+		f := fs.Of(tc.expr)
+		for _, rn := range tc.match {
+			if !f.Runes.Contains(rn) {
+				t.Errorf("%s: want %q in FIRST set", exprText(tc.expr), rn)
+			}
+		}
+		for _, rn := range tc.notMatch {
+			if f.Runes.Contains(rn) {
+				t.Errorf("%s: want %q not in FIRST set", exprText(tc.expr), rn)
+			}
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package ast
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// UnicodeClassName returns the name of the Unicode class of a \p{name}
+// escape of a character class. The names of the form Script=Latin or
+// sc=Latin return the script, and General_Category=Lu or gc=Lu return the
+// category, or an empty string if the value is not a known script or
+// category. The other names are returned unchanged.
+func UnicodeClassName(name string) string {
+	key, val, ok := strings.Cut(name, "=")
+	if !ok {
+		return name
+	}
+	switch key {
+	case "Script", "sc":
+		if _, ok := unicode.Scripts[val]; ok {
+			return val
+		}
+	case "General_Category", "gc":
+		if _, ok := unicode.Categories[val]; ok {
+			return val
+		}
+	}
+	return ""
+}
+
+// IsDerivedClass returns true if the Unicode class name is a derived
+// property, which is not in the tables of the unicode package and is
+// computed from them, e.g. XID_Start and XID_Continue.
+func IsDerivedClass(name string) bool {
+	_, ok := derivedClasses[name]
+	return ok
+}
+
+// derivedClasses are the derived properties of the identifiers, as defined
+// by the Unicode Standard Annex #31.
+var derivedClasses = map[string]func() RuneSet{
+	"ID_Start":     idStart,
+	"ID_Continue":  idContinue,
+	"XID_Start":    func() RuneSet { return idStart().Subtract(runes(xidExcluded...)) },
+	"XID_Continue": func() RuneSet { return idContinue().Subtract(runes(xidExcluded[4:]...)) },
+}
+
+// xidExcluded are the runes of ID_Start that are not in XID_Start, the
+// first four are in XID_Continue.
+var xidExcluded = []rune{
+	0x0E33, 0x0EB3, 0xFF9E, 0xFF9F,
+	0x037A, 0x309B, 0x309C, 0xFC5E, 0xFC5F, 0xFC60, 0xFC61, 0xFC62, 0xFC63,
+	0xFDFA, 0xFDFB, 0xFE70, 0xFE72, 0xFE74, 0xFE76, 0xFE78, 0xFE7A, 0xFE7C,
+	0xFE7E,
+}
+
+func idStart() RuneSet {
+	s := tables(unicode.L, unicode.Nl, unicode.Other_ID_Start)
+	return s.Subtract(tables(unicode.Pattern_Syntax, unicode.Pattern_White_Space))
+}
+
+func idContinue() RuneSet {
+	s := tables(unicode.L, unicode.Nl, unicode.Other_ID_Start, unicode.Mn,
+		unicode.Mc, unicode.Nd, unicode.Pc, unicode.Other_ID_Continue)
+	return s.Subtract(tables(unicode.Pattern_Syntax, unicode.Pattern_White_Space))
+}
+
+func tables(rts ...*unicode.RangeTable) RuneSet {
+	var s RuneSet
+	for _, rt := range rts {
+		s.AddTable(rt)
+	}
+	return s
+}
+
+func runes(rns ...rune) RuneSet {
+	var s RuneSet
+	for _, rn := range rns {
+		s.Add(rn)
+	}
+	return s
+}
+
+// parseDerived parses the content of the character class raw, without
+// its brackets and leading ^, and sets its ranges to the runes of the
+// class if it uses the set operations -- and &&, a \P{...} escape or a
+// derived property. It returns false and leaves the matcher unchanged
+// otherwise.
+func (c *CharClassMatcher) parseDerived(raw string) bool {
+	p := &classParser{s: raw}
+	set := p.class()
+	if !p.derived {
+		return false
+	}
+
+	if c.IgnoreCase {
+		// the case folding of the input is matched against the case folding
+		// of the set.
+		folded := set.Subtract(foldedRuneSet())
+		for _, r := range foldedRunes() {
+			if set.Contains(r) {
+				folded.Add(CaseFold(r))
+			}
+		}
+		folded.AddSet(folded.foldIn())
+		set = folded
+		c.IgnoreCase = false
+	}
+	c.Derived = true
+	c.Ranges = set.Ranges()
+	return true
+}
+
+// classParser computes the set of runes of the content of a character
+// class, which is necessarily valid.
+type classParser struct {
+	s       string
+	i       int
+	derived bool
+}
+
+// class parses the items of the class, followed by set operations.
+func (p *classParser) class() RuneSet {
+	s := p.union()
+	for p.isSetOp(p.i) {
+		op := p.s[p.i : p.i+2]
+		p.i += 2
+		p.derived = true
+		if o := p.operand(); op == "--" {
+			s = s.Subtract(o)
+		} else {
+			s = s.Intersect(o)
+		}
+	}
+	return s
+}
+
+// operand parses the right operand of a set operation, a nested class or
+// a list of items.
+func (p *classParser) operand() RuneSet {
+	if p.i >= len(p.s) || p.s[p.i] != '[' {
+		return p.union()
+	}
+	p.i++
+	inverted := p.i < len(p.s) && p.s[p.i] == '^'
+	if inverted {
+		p.i++
+	}
+	s := p.union()
+	p.i++ // closing ]
+	if inverted {
+		return s.Complement()
+	}
+	return s
+}
+
+// union parses a list of items, up to a set operation or the end of the
+// (nested) class.
+func (p *classParser) union() RuneSet {
+	var s RuneSet
+	for p.i < len(p.s) && p.s[p.i] != ']' && !p.isSetOp(p.i) {
+		lo, set, isClass := p.item()
+		if isClass {
+			s.AddSet(set)
+			continue
+		}
+		if p.i < len(p.s)-1 && p.s[p.i] == '-' && !p.isSetOp(p.i) && p.isChar(p.i+1) {
+			p.i++
+			hi, _, _ := p.item()
+			s.AddRange(lo, hi)
+			continue
+		}
+		s.Add(lo)
+	}
+	return s
+}
+
+// item parses a character or a Unicode class escape.
+func (p *classParser) item() (rune, RuneSet, bool) {
+	if p.s[p.i] != '\\' {
+		rn, n := utf8.DecodeRuneInString(p.s[p.i:])
+		p.i += n
+		return rn, RuneSet{}, false
+	}
+
+	switch esc := p.s[p.i+1]; esc {
+	case ']':
+		p.i += 2
+		return ']', RuneSet{}, false
+
+	case 'p', 'P':
+		p.i += 2
+		var name string
+		if p.s[p.i] == '{' {
+			end := strings.IndexByte(p.s[p.i:], '}')
+			name = p.s[p.i+1 : p.i+end]
+			p.i += end + 1
+		} else {
+			rn, n := utf8.DecodeRuneInString(p.s[p.i:])
+			name = string(rn)
+			p.i += n
+		}
+
+		var set RuneSet
+		name = UnicodeClassName(name)
+		if derived, ok := derivedClasses[name]; ok {
+			p.derived = true
+			set = derived()
+		} else if rt := unicodeClassTable(name); rt != nil {
+			set.AddTable(rt)
+		}
+		if esc == 'P' {
+			p.derived = true
+			set = set.Complement()
+		}
+		return 0, set, true
+	}
+
+	rn, _, tail, _ := strconv.UnquoteChar(p.s[p.i:], 0)
+	p.i = len(p.s) - len(tail)
+	return rn, RuneSet{}, false
+}
+
+// isSetOp returns true if a set operation starts at i, its right operand
+// is a nested class or a Unicode class escape.
+func (p *classParser) isSetOp(i int) bool {
+	if i+3 >= len(p.s) {
+		return false
+	}
+	if op := p.s[i : i+2]; op != "--" && op != "&&" {
+		return false
+	}
+	next := p.s[i+2:]
+	return next[0] == '[' || strings.HasPrefix(next, `\p`) || strings.HasPrefix(next, `\P`)
+}
+
+// isChar returns true if a character, that can end a range, starts at i.
+func (p *classParser) isChar(i int) bool {
+	switch {
+	case i >= len(p.s), p.s[i] == ']', p.isSetOp(i):
+		return false
+	case p.s[i] == '\\':
+		return p.s[i+1] != 'p' && p.s[i+1] != 'P'
+	}
+	return true
+}
@@ -0,0 +1,45 @@
+package ast
+
+import "testing"
+
+func TestCharClassDerived(t *testing.T) {
+	cases := []struct {
+		class    string
+		derived  bool
+		match    string
+		notMatch string
+	}{
+		{class: `[a-z]`, match: "az", notMatch: "A"},
+		{class: `[---]`, match: "-"},
+		{class: `[----]`, match: "-"},
+		{class: `[\p{Script=Greek}]`, match: "αΩ", notMatch: "a"},
+		{class: `[a-z--[aeiou]]`, derived: true, match: "bz", notMatch: "aeB"},
+		{class: `[a-z--[aeiou]]i`, derived: true, match: "bzBZ", notMatch: "aeAE"},
+		{class: `[\pL--[a-z]&&\p{sc=Latin}]`, derived: true, match: "AéÉ", notMatch: "aαΩ1"},
+		{class: `[\p{Greek}&&\pL]`, derived: true, match: "αΩ", notMatch: "a͵"},
+		{class: `[a-z--[^aeiou]]`, derived: true, match: "ae", notMatch: "bA"},
+		{class: `[\P{L}]`, derived: true, match: "1 -", notMatch: "aα"},
+		{class: `[\p{gc=Nd}\P{Latin}]`, derived: true, match: "1α", notMatch: "a"},
+		{class: `[\p{XID_Start}]`, derived: true, match: "aα日", notMatch: "1_゛"},
+		{class: `[\p{ID_Start}]`, derived: true, match: "a゛", notMatch: "1"},
+		{class: `[\p{XID_Continue}]`, derived: true, match: "a1_ำ", notMatch: "-゛"},
+	}
+	for _, tc := range cases {
+		m := NewCharClassMatcher(Pos{}, tc.class)
+		if m.Derived != tc.derived {
+			t.Errorf("%s: want derived %t, got %t", tc.class, tc.derived, m.Derived)
+			continue
+		}
+		rs := charClassRunes(m)
+		for _, r := range tc.match {
+			if !rs.Contains(r) {
+				t.Errorf("%s: want %q to match", tc.class, r)
+			}
+		}
+		for _, r := range tc.notMatch {
+			if rs.Contains(r) {
+				t.Errorf("%s: want %q not to match", tc.class, r)
+			}
+		}
+	}
+}
@@ -0,0 +1,441 @@
+package ast
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// RuneSet is a set of runes, stored as sorted, non-overlapping and
+// non-adjacent ranges. The zero value is an empty set.
+type RuneSet struct {
+	ranges []runeRange
+}
+
+type runeRange struct {
+	lo, hi rune
+}
+
+// AddRange adds the runes from lo to hi inclusively to the set.
+func (s *RuneSet) AddRange(lo, hi rune) {
+	if lo > hi {
+		return
+	}
+
+	// find the first range that ends at or after lo-1, it is the first range
+	// that can be merged with [lo, hi].
+	i := sort.Search(len(s.ranges), func(i int) bool { return s.ranges[i].hi >= lo-1 })
+	j := i
+	for j < len(s.ranges) && s.ranges[j].lo <= hi+1 {
+		lo = min(lo, s.ranges[j].lo)
+		hi = max(hi, s.ranges[j].hi)
+		j++
+	}
+	s.ranges = append(s.ranges[:i], append([]runeRange{{lo, hi}}, s.ranges[j:]...)...)
+}
+
+// Add adds the rune r to the set.
+func (s *RuneSet) Add(r rune) {
+	s.AddRange(r, r)
+}
+
+// AddSet adds the runes of o to the set.
+func (s *RuneSet) AddSet(o RuneSet) {
+	for _, rr := range o.ranges {
+		s.AddRange(rr.lo, rr.hi)
+	}
+}
+
+// AddTable adds the runes of the Unicode range table to the set.
+func (s *RuneSet) AddTable(rt *unicode.RangeTable) {
+	for _, r16 := range rt.R16 {
+		addStride(s, rune(r16.Lo), rune(r16.Hi), rune(r16.Stride))
+	}
+	for _, r32 := range rt.R32 {
+		addStride(s, rune(r32.Lo), rune(r32.Hi), rune(r32.Stride))
+	}
+}
+
+func addStride(s *RuneSet, lo, hi, stride rune) {
+	if stride == 1 {
+		s.AddRange(lo, hi)
+		return
+	}
+	for r := lo; r <= hi; r += stride {
+		s.Add(r)
+	}
+}
+
+// Contains returns true if the rune r is in the set.
+func (s RuneSet) Contains(r rune) bool {
+	i := sort.Search(len(s.ranges), func(i int) bool { return s.ranges[i].hi >= r })
+	return i < len(s.ranges) && s.ranges[i].lo <= r
+}
+
+// Intersects returns true if the sets have at least one rune in common.
+func (s RuneSet) Intersects(o RuneSet) bool {
+	i, j := 0, 0
+	for i < len(s.ranges) && j < len(o.ranges) {
+		a, b := s.ranges[i], o.ranges[j]
+		if a.hi < b.lo {
+			i++
+			continue
+		}
+		if b.hi < a.lo {
+			j++
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// Complement returns the set of the runes from 0 to unicode.MaxRune that are
+// not in the set.
+func (s RuneSet) Complement() RuneSet {
+	var c RuneSet
+	next := rune(0)
+	for _, rr := range s.ranges {
+		if rr.lo > next {
+			c.ranges = append(c.ranges, runeRange{next, rr.lo - 1})
+		}
+		next = rr.hi + 1
+	}
+	if next <= unicode.MaxRune {
+		c.ranges = append(c.ranges, runeRange{next, unicode.MaxRune})
+	}
+	return c
+}
+
+// Intersect returns the set of the runes that are in both sets.
+func (s RuneSet) Intersect(o RuneSet) RuneSet {
+	var r RuneSet
+	i, j := 0, 0
+	for i < len(s.ranges) && j < len(o.ranges) {
+		a, b := s.ranges[i], o.ranges[j]
+		if lo, hi := max(a.lo, b.lo), min(a.hi, b.hi); lo <= hi {
+			r.ranges = append(r.ranges, runeRange{lo, hi})
+		}
+		if a.hi < b.hi {
+			i++
+		} else {
+			j++
+		}
+	}
+	return r
+}
+
+// Subtract returns the set of the runes that are in s but not in o.
+func (s RuneSet) Subtract(o RuneSet) RuneSet {
+	return s.Intersect(o.Complement())
+}
+
+// IsEmpty returns true if the set has no rune.
+func (s RuneSet) IsEmpty() bool {
+	return len(s.ranges) == 0
+}
+
+// Ranges returns the pairs of low/high runes of the ranges of the set, in
+// increasing order.
+func (s RuneSet) Ranges() []rune {
+	rs := make([]rune, 0, 2*len(s.ranges))
+	for _, rr := range s.ranges {
+		rs = append(rs, rr.lo, rr.hi)
+	}
+	return rs
+}
+
+// String returns the textual representation of the set, in the character
+// class syntax of the grammar.
+func (s RuneSet) String() string {
+	var buf strings.Builder
+	buf.WriteByte('[')
+	for _, rr := range s.ranges {
+		buf.WriteString(escapeRune(rr.lo))
+		if rr.hi > rr.lo {
+			buf.WriteByte('-')
+			buf.WriteString(escapeRune(rr.hi))
+		}
+	}
+	buf.WriteByte(']')
+	return buf.String()
+}
+
+// foldIn returns the set of runes that CaseFold maps to another rune
+// in the set. The parser matches case-insensitive literals and character
+// classes by case folding the input.
+func (s RuneSet) foldIn() RuneSet {
+	var f RuneSet
+	for _, r := range foldedRunes() {
+		if s.Contains(CaseFold(r)) {
+			f.Add(r)
+		}
+	}
+	return f
+}
+
+// First is the FIRST set of an expression, the runes of the input that the
+// expression can start with when it consumes input.
+type First struct {
+	Runes RuneSet
+
+	// Nullable is true if the expression can match without consuming input.
+	Nullable bool
+
+	// Opaque is true if the FIRST set cannot be computed statically, e.g.
+	// for left-recursive rules, references to undefined rules or recovery
+	// expressions. Runes and Nullable are meaningless if Opaque is true.
+	Opaque bool
+}
+
+// Disjoint returns true if at most one of the expressions of f and o can
+// match any given input, because both consume input and they cannot
+// start with the same rune.
+func (f First) Disjoint(o First) bool {
+	if f.Opaque || o.Opaque || f.Nullable || o.Nullable {
+		return false
+	}
+	return !f.Runes.Intersects(o.Runes)
+}
+
+// FirstSets computes the FIRST sets of the expressions of a grammar.
+type FirstSets struct {
+	rules    map[string]*Rule
+	cache    map[string]First
+	visiting map[string]bool
+}
+
+// NewFirstSets returns a FirstSets for the rules of the grammar g.
+func NewFirstSets(g *Grammar) *FirstSets {
+	fs := &FirstSets{
+		rules:    make(map[string]*Rule, len(g.Rules)),
+		cache:    make(map[string]First),
+		visiting: make(map[string]bool),
+	}
+	for _, r := range g.Rules {
+		fs.rules[r.Name.Val] = r
+	}
+	return fs
+}
+
+// Of returns the FIRST set of the expression expr.
+func (fs *FirstSets) Of(expr Expression) First {
+	switch expr := expr.(type) {
+	case *Rule:
+		return fs.rule(expr.Name.Val)
+
+	case *RuleRefExpr:
+		return fs.rule(expr.Name.Val)
+
+	case *ActionExpr:
+		return fs.Of(expr.Expr)
+
+	case *LabeledExpr:
+		return fs.Of(expr.Expr)
+
+	case *PluckExpr:
+		return fs.Of(expr.Expr)
+
+	case *TextExpr:
+		return fs.Of(expr.Expr)
+
+	case *ChoiceExpr:
+		var f First
+		for _, alt := range expr.Alternatives {
+			af := fs.Of(alt)
+			if af.Opaque {
+				return af
+			}
+			f.Runes.AddSet(af.Runes)
+			f.Nullable = f.Nullable || af.Nullable
+		}
+		return f
+
+	case *SeqExpr:
+		f := First{Nullable: true}
+		for i, e := range expr.Exprs {
+			ef := fs.Of(e)
+			if ef.Opaque {
+				return ef
+			}
+			if expr.Skip && ef.Nullable && i < len(expr.Exprs)-1 {
+				// the skip rule is matched before the next expression
+				return First{Opaque: true}
+			}
+			f.Runes.AddSet(ef.Runes)
+			if !ef.Nullable {
+				f.Nullable = false
+				break
+			}
+		}
+		return f
+
+	case *ZeroOrOneExpr:
+		return fs.optional(fs.Of(expr.Expr))
+
+	case *ZeroOrMoreExpr:
+		return fs.optional(fs.Of(expr.Expr))
+
+	case *OneOrMoreExpr:
+		return fs.Of(expr.Expr)
+
+	case *RepeatExpr:
+		if expr.Max == 0 {
+			return First{Nullable: true}
+		}
+		if expr.Min == 0 {
+			return fs.optional(fs.Of(expr.Expr))
+		}
+		return fs.Of(expr.Expr)
+
+	case *AndExpr, *NotExpr, *AndCodeExpr, *NotCodeExpr, *StateCodeExpr, *CutExpr:
+		// lookaheads, code blocks and cuts never consume input, they can
+		// only restrict the input accepted by the expressions that follow.
+		return First{Nullable: true}
+
+	case *LitMatcher:
+		val := expr.Val
+		if expr.IgnoreCase {
+			val = FoldString(val)
+		}
+		if val == "" {
+			return First{Nullable: true}
+		}
+		var f First
+		rn, _ := utf8.DecodeRuneInString(val)
+		f.Runes.Add(rn)
+		// the binary parsers match the first byte of the literal
+		f.Runes.Add(rune(val[0]))
+		if expr.IgnoreCase {
+			f.Runes.AddSet(f.Runes.foldIn())
+			// the runes whose full case folding starts the literal
+			for r, fold := range FullCaseFolds() {
+				if strings.HasPrefix(val, fold) {
+					f.Runes.Add(r)
+				}
+			}
+		}
+		return f
+
+	case *PrecedenceExpr:
+		// the expression starts with the operand, or with a prefix
+		// operator that must be followed by the operand.
+		f := fs.Of(expr.Operand)
+		if f.Opaque {
+			return f
+		}
+		for _, l := range expr.Levels {
+			if l.Kind != PrecPrefix {
+				continue
+			}
+			lf := fs.Of(l.Op)
+			if lf.Opaque || lf.Nullable {
+				return First{Opaque: true}
+			}
+			f.Runes.AddSet(lf.Runes)
+		}
+		if f.Nullable {
+			// an empty operand may be followed by any operator
+			return First{Opaque: true}
+		}
+		return f
+
+	case *LitSetMatcher:
+		var f First
+		for _, lit := range expr.Lits {
+			lf := fs.Of(lit)
+			f.Runes.AddSet(lf.Runes)
+			f.Nullable = f.Nullable || lf.Nullable
+		}
+		return f
+
+	case *CharClassMatcher:
+		return First{Runes: charClassRunes(expr)}
+
+	case *AnyMatcher:
+		var f First
+		f.Runes.AddRange(0, unicode.MaxRune)
+		return f
+	}
+
+	// recovery and throw expressions, back-references, whose text is only
+	// known while parsing, regular expressions, matcher functions and any
+	// unknown expression.
+	return First{Opaque: true}
+}
+
+func (fs *FirstSets) optional(f First) First {
+	if !f.Opaque {
+		f.Nullable = true
+	}
+	return f
+}
+
+func (fs *FirstSets) rule(name string) First {
+	if f, ok := fs.cache[name]; ok {
+		return f
+	}
+	r := fs.rules[name]
+	if r == nil || fs.visiting[name] {
+		// undefined or left-recursive rule
+		return First{Opaque: true}
+	}
+
+	fs.visiting[name] = true
+	f := fs.Of(r.Expr)
+	delete(fs.visiting, name)
+	fs.cache[name] = f
+	return f
+}
+
+// Runes returns the set of runes that the character class matches, after
+// the case folding of the input if it ignores case.
+func (c *CharClassMatcher) Runes() RuneSet {
+	return charClassRunes(c)
+}
+
+// charClassRunes returns the runes matched by the character class.
+func charClassRunes(ch *CharClassMatcher) RuneSet {
+	fold := func(r rune) rune {
+		if ch.IgnoreCase {
+			return CaseFold(r)
+		}
+		return r
+	}
+
+	var s RuneSet
+	for _, rn := range ch.Chars {
+		s.Add(fold(rn))
+	}
+	for i := 0; i+1 < len(ch.Ranges); i += 2 {
+		s.AddRange(fold(ch.Ranges[i]), fold(ch.Ranges[i+1]))
+	}
+	for _, cl := range ch.UnicodeClasses {
+		if rt := unicodeClassTable(cl); rt != nil {
+			s.AddTable(rt)
+		}
+	}
+	if ch.IgnoreCase {
+		// the case folding of the input is matched
+		folded := s.Subtract(foldedRuneSet())
+		folded.AddSet(s.foldIn())
+		s = folded
+	}
+	if ch.Inverted {
+		return s.Complement()
+	}
+	return s
+}
+
+// unicodeClassTable returns the range table of the Unicode class name, it
+// resolves the names like the generated parsers do.
+func unicodeClassTable(class string) *unicode.RangeTable {
+	if rt, ok := unicode.Categories[class]; ok {
+		return rt
+	}
+	if rt, ok := unicode.Properties[class]; ok {
+		return rt
+	}
+	return unicode.Scripts[class]
+}
@@ -0,0 +1,388 @@
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Limits of the rules created by Instantiate, so that a parameterized rule
+// that references itself with ever-growing arguments is reported as an
+// error instead of being expanded forever. The depth of an instance is 1
+// plus the depth of the rule that references it, the depth of the rules
+// of the grammar is 0.
+const (
+	maxInstances     = 1000
+	maxInstanceDepth = 16
+)
+
+// Instantiate replaces the references to the parameterized rules of the
+// grammar, e.g. List<Expr, ",">, by references to an instance of the rule
+// where the references to the parameters are replaced by the arguments.
+// An instance is created for each distinct list of arguments. It is named
+// after the parameterized rule with a numeric suffix, e.g. List_1, and it
+// is added after the rules of the grammar. The parameterized rules are
+// then removed from the grammar.
+//
+// The arguments are copied in the instances, so the code blocks of the
+// parameterized rule see the labels of the rule as usual, and the code
+// blocks of the arguments are generated in the instances. Instantiate
+// does nothing if the grammar has no parameterized rule.
+func Instantiate(g *Grammar) error {
+	in := &instantiator{
+		templates: make(map[string]*Rule),
+		names:     make(map[string]bool, len(g.Rules)),
+		instances: make(map[string]*Rule),
+		counts:    make(map[string]int),
+		depths:    make(map[*Rule]int),
+	}
+	for _, r := range g.Rules {
+		in.names[r.Name.Val] = true
+		if len(r.Params) == 0 {
+			in.rules = append(in.rules, r)
+			continue
+		}
+
+		params := make(map[string]bool, len(r.Params))
+		for _, p := range r.Params {
+			if params[p.Val] {
+				return fmt.Errorf("%s: duplicate parameter %s of rule %s", p.Pos(), p.Val, r.Name.Val)
+			}
+			params[p.Val] = true
+		}
+		in.templates[r.Name.Val] = r
+	}
+	if len(in.templates) == 0 {
+		return nil
+	}
+	if start := g.Rules[0]; len(start.Params) > 0 {
+		return fmt.Errorf("%s: the start rule %s cannot have parameters", start.Pos(), start.Name.Val)
+	}
+
+	// the instances are appended to the rules while they are processed, so
+	// that their own references are instantiated too.
+	for i := 0; i < len(in.rules); i++ {
+		if err := in.instantiateRefs(in.rules[i]); err != nil {
+			return err
+		}
+	}
+	g.Rules = in.rules
+	return nil
+}
+
+type instantiator struct {
+	templates map[string]*Rule
+	// names of the rules, including the instances
+	names map[string]bool
+	// instances by the text of the reference to the parameterized rule
+	instances map[string]*Rule
+	// number of instances by parameterized rule, for their names
+	counts map[string]int
+	depths map[*Rule]int
+	rules  []*Rule
+}
+
+// instantiateRefs replaces the references to parameterized rules in the
+// expression of r by references to their instances.
+func (in *instantiator) instantiateRefs(r *Rule) error {
+	var err error
+	Inspect(r.Expr, func(expr Expression) bool {
+		ref, ok := expr.(*RuleRefExpr)
+		if !ok || err != nil {
+			return err == nil
+		}
+		t := in.templates[ref.Name.Val]
+		if t == nil {
+			return true
+		}
+		if len(ref.Args) != len(t.Params) {
+			noun := "arguments"
+			if len(t.Params) == 1 {
+				noun = "argument"
+			}
+			err = fmt.Errorf("%s: rule %s takes %d %s, got %d", ref.Pos(), t.Name.Val, len(t.Params), noun, len(ref.Args))
+			return false
+		}
+
+		var inst *Rule
+		inst, err = in.instance(t, ref, in.depths[r]+1)
+		if err == nil {
+			ref.Name = NewIdentifier(ref.Name.Pos(), inst.Name.Val)
+			ref.Args = nil
+		}
+		return false
+	})
+	return err
+}
+
+// instance returns the instance of the parameterized rule t for the
+// arguments of ref, creating it at depth if needed.
+func (in *instantiator) instance(t *Rule, ref *RuleRefExpr, depth int) (*Rule, error) {
+	key := exprText(ref)
+	if inst := in.instances[key]; inst != nil {
+		return inst, nil
+	}
+	if len(in.instances) >= maxInstances || depth > maxInstanceDepth {
+		return nil, fmt.Errorf("%s: too many instances of rule %s", ref.Pos(), t.Name.Val)
+	}
+
+	name := t.Name.Val
+	for in.names[name] {
+		in.counts[t.Name.Val]++
+		name = t.Name.Val + "_" + strconv.Itoa(in.counts[t.Name.Val])
+	}
+	in.names[name] = true
+
+	args := make(map[string]Expression, len(t.Params))
+	for i, p := range t.Params {
+		args[p.Val] = ref.Args[i]
+	}
+	expr, err := substituteArgs(t.Expr, args)
+	if err != nil {
+		return nil, err
+	}
+
+	inst := NewRule(t.Pos(), NewIdentifier(t.Name.Pos(), name))
+	inst.DisplayName = t.DisplayName
+	inst.Annotations = t.Annotations
+	inst.Expr = expr
+	in.instances[key] = inst
+	in.depths[inst] = depth
+	in.rules = append(in.rules, inst)
+	return inst, nil
+}
+
+// substituteArgs returns a copy of expr where the references to the
+// parameters in args are replaced by a copy of their argument.
+func substituteArgs(expr Expression, args map[string]Expression) (Expression, error) {
+	var err error
+	var subst func(Expression) Expression
+	subst = func(expr Expression) Expression {
+		switch expr := expr.(type) {
+		case *ActionExpr:
+			return &ActionExpr{p: expr.p, Expr: subst(expr.Expr), Code: expr.Code}
+		case *AndCodeExpr:
+			return &AndCodeExpr{p: expr.p, Code: expr.Code}
+		case *AndExpr:
+			return &AndExpr{p: expr.p, Expr: subst(expr.Expr)}
+		case *ChoiceExpr:
+			ch := &ChoiceExpr{p: expr.p}
+			for _, alt := range expr.Alternatives {
+				ch.Alternatives = append(ch.Alternatives, subst(alt))
+			}
+			return ch
+		case *LabeledExpr:
+			return &LabeledExpr{p: expr.p, Label: expr.Label, Expr: subst(expr.Expr)}
+		case *NotCodeExpr:
+			return &NotCodeExpr{p: expr.p, Code: expr.Code}
+		case *NotExpr:
+			return &NotExpr{p: expr.p, Expr: subst(expr.Expr)}
+		case *OneOrMoreExpr:
+			return &OneOrMoreExpr{p: expr.p, Expr: subst(expr.Expr), Skip: expr.Skip}
+		case *PluckExpr:
+			return &PluckExpr{p: expr.p, Expr: subst(expr.Expr)}
+		case *RepeatExpr:
+			return &RepeatExpr{p: expr.p, Expr: subst(expr.Expr), Min: expr.Min, Max: expr.Max, Skip: expr.Skip}
+		case *RecoveryExpr:
+			return &RecoveryExpr{p: expr.p, Expr: subst(expr.Expr), RecoverExpr: subst(expr.RecoverExpr), Labels: expr.Labels}
+		case *PrecedenceExpr:
+			prec := &PrecedenceExpr{p: expr.p, Operand: subst(expr.Operand), Skip: expr.Skip}
+			for _, l := range expr.Levels {
+				prec.Levels = append(prec.Levels, &PrecedenceLevel{p: l.p, Kind: l.Kind, Op: subst(l.Op), Code: l.Code})
+			}
+			return prec
+		case *RuleRefExpr:
+			if arg, ok := args[expr.Name.Val]; ok {
+				if len(expr.Args) > 0 && err == nil {
+					err = fmt.Errorf("%s: parameter %s does not take arguments", expr.Pos(), expr.Name.Val)
+				}
+				// the argument is copied as is, the parameters of the rule
+				// are not in its scope.
+				a, _ := substituteArgs(arg, nil)
+				return a
+			}
+			ref := &RuleRefExpr{p: expr.p, Name: expr.Name}
+			for _, a := range expr.Args {
+				ref.Args = append(ref.Args, subst(a))
+			}
+			return ref
+		case *SeqExpr:
+			seq := &SeqExpr{p: expr.p, Skip: expr.Skip}
+			for _, e := range expr.Exprs {
+				seq.Exprs = append(seq.Exprs, subst(e))
+			}
+			return seq
+		case *StateCodeExpr:
+			return &StateCodeExpr{p: expr.p, Code: expr.Code}
+		case *TextExpr:
+			return &TextExpr{p: expr.p, Expr: subst(expr.Expr)}
+		case *ZeroOrMoreExpr:
+			return &ZeroOrMoreExpr{p: expr.p, Expr: subst(expr.Expr), Skip: expr.Skip}
+		case *ZeroOrOneExpr:
+			return &ZeroOrOneExpr{p: expr.p, Expr: subst(expr.Expr)}
+		case *CharClassMatcher:
+			cc := *expr
+			return &cc
+		case *LitMatcher:
+			lit := *expr
+			return &lit
+		case *LitSetMatcher:
+			set := *expr
+			set.Lits = make([]*LitMatcher, 0, len(expr.Lits))
+			for _, lit := range expr.Lits {
+				set.Lits = append(set.Lits, subst(lit).(*LitMatcher))
+			}
+			return &set
+		case *AnyMatcher:
+			m := *expr
+			return &m
+		case *RegexpMatcher:
+			m := *expr
+			return &m
+		case *MatcherFuncExpr:
+			m := *expr
+			return &m
+		case *ThrowExpr:
+			throw := *expr
+			return &throw
+		case *CutExpr:
+			return &CutExpr{p: expr.p}
+		case *BackRefExpr:
+			ref := *expr
+			return &ref
+		}
+		return expr
+	}
+	return subst(expr), err
+}
+
+// exprText returns the text of expr in the PEG syntax, without the
+// positions, so that two expressions with the same text are equivalent.
+func exprText(expr Expression) string {
+	var buf strings.Builder
+	writeExprText(&buf, expr)
+	return buf.String()
+}
+
+func writeExprText(buf *strings.Builder, expr Expression) {
+	writeList := func(open, sep, close string, exprs []Expression) {
+		buf.WriteString(open)
+		for i, e := range exprs {
+			if i > 0 {
+				buf.WriteString(sep)
+			}
+			writeExprText(buf, e)
+		}
+		buf.WriteString(close)
+	}
+
+	switch expr := expr.(type) {
+	case *ActionExpr:
+		writeList("(", "", ") ", []Expression{expr.Expr})
+		buf.WriteString(expr.Code.Val)
+	case *AndCodeExpr:
+		buf.WriteString("&" + expr.Code.Val)
+	case *AndExpr:
+		writeList("&(", "", ")", []Expression{expr.Expr})
+	case *AnyMatcher:
+		buf.WriteString(".")
+	case *CharClassMatcher:
+		buf.WriteString(expr.Val)
+	case *RegexpMatcher:
+		buf.WriteString(expr.Val)
+	case *ChoiceExpr:
+		writeList("(", " / ", ")", expr.Alternatives)
+	case *CutExpr:
+		buf.WriteString("~")
+	case *BackRefExpr:
+		buf.WriteString("$")
+		if expr.State {
+			buf.WriteString("#")
+		}
+		buf.WriteString(expr.Name.Val)
+	case *LabeledExpr:
+		writeList(expr.Label.Val+":(", "", ")", []Expression{expr.Expr})
+	case *MatcherFuncExpr:
+		buf.WriteString("@" + expr.Name.Val + "()")
+	case *LitMatcher:
+		buf.WriteString(strconv.Quote(expr.Val))
+		if expr.IgnoreCase {
+			buf.WriteString("i")
+		}
+	case *LitSetMatcher:
+		exprs := make([]Expression, 0, len(expr.Lits))
+		for _, lit := range expr.Lits {
+			exprs = append(exprs, lit)
+		}
+		writeList("(", " / ", ")", exprs)
+		if expr.Longest {
+			buf.WriteString("@longest")
+		}
+		if expr.Boundary {
+			buf.WriteString("@boundary")
+		}
+	case *NotCodeExpr:
+		buf.WriteString("!" + expr.Code.Val)
+	case *NotExpr:
+		writeList("!(", "", ")", []Expression{expr.Expr})
+	case *OneOrMoreExpr:
+		writeList("(", "", ")+", []Expression{expr.Expr})
+	case *PluckExpr:
+		writeList("@(", "", ")", []Expression{expr.Expr})
+	case *PrecedenceExpr:
+		writeList("%precedence(", "", "", []Expression{expr.Operand})
+		for _, l := range expr.Levels {
+			writeList(" %"+l.Kind.String()+" (", "", ")", []Expression{l.Op})
+			if l.Code != nil {
+				buf.WriteString(" " + l.Code.Val)
+			}
+		}
+		buf.WriteString(")")
+	case *RepeatExpr:
+		writeList("(", "", ")", []Expression{expr.Expr})
+		buf.WriteString(repeatBounds(expr.Min, expr.Max))
+	case *RecoveryExpr:
+		writeList("(", "", "", []Expression{expr.Expr})
+		fmt.Fprintf(buf, " //{%s} ", joinLabels(expr.Labels))
+		writeList("", "", ")", []Expression{expr.RecoverExpr})
+	case *RuleRefExpr:
+		buf.WriteString(expr.Name.Val)
+		if len(expr.Args) > 0 {
+			writeList("<", ", ", ">", expr.Args)
+		}
+	case *SeqExpr:
+		writeList("(", " ", ")", expr.Exprs)
+	case *StateCodeExpr:
+		buf.WriteString("#" + expr.Code.Val)
+	case *TextExpr:
+		writeList("$(", "", ")", []Expression{expr.Expr})
+	case *ThrowExpr:
+		buf.WriteString("%{" + expr.Label + "}")
+	case *ZeroOrMoreExpr:
+		writeList("(", "", ")*", []Expression{expr.Expr})
+	case *ZeroOrOneExpr:
+		writeList("(", "", ")?", []Expression{expr.Expr})
+	default:
+		fmt.Fprintf(buf, "%T", expr)
+	}
+}
+
+// repeatBounds returns the bounds of a repeat expression in the PEG syntax,
+// e.g. {2,4}.
+func repeatBounds(min, max int) string {
+	switch {
+	case min == max:
+		return fmt.Sprintf("{%d}", min)
+	case max < 0:
+		return fmt.Sprintf("{%d,}", min)
+	}
+	return fmt.Sprintf("{%d,%d}", min, max)
+}
+
+func joinLabels(labels []FailureLabel) string {
+	s := make([]string, len(labels))
+	for i, l := range labels {
+		s[i] = string(l)
+	}
+	return strings.Join(s, ", ")
+}
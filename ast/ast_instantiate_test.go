@@ -0,0 +1,124 @@
+package ast
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testTemplate(name string, params []string, expr Expression) *Rule {
+	r := testRule(name, expr)
+	for _, p := range params {
+		r.Params = append(r.Params, NewIdentifier(Pos{}, p))
+	}
+	return r
+}
+
+func testArgsRef(name string, args ...Expression) *RuleRefExpr {
+	ref := testRef(name)
+	ref.Args = args
+	return ref
+}
+
+func TestInstantiate(t *testing.T) {
+	g := testGrammar(
+		testRule("A", testSeq(
+			testArgsRef("List", testRef("B"), testLit(",", false)),
+			testArgsRef("List", testRef("B"), testLit(",", false)),
+			testArgsRef("List", testRef("B"), testLit(";", false)),
+		)),
+		testTemplate("List", []string{"Item", "Sep"}, testSeq(
+			testRef("Item"),
+			NewZeroOrMoreExpr(Pos{}),
+		)),
+		testRule("B", testArgsRef("Parens", testRef("B"))),
+		testTemplate("Parens", []string{"X"}, testSeq(
+			testLit("(", false),
+			testArgsRef("List", testRef("X"), testRef("Sep")),
+			testLit(")", false),
+		)),
+		testRule("List_1", testLit("l", false)),
+	)
+	g.Rules[1].Expr.(*SeqExpr).Exprs[1].(*ZeroOrMoreExpr).Expr = testSeq(testRef("Sep"), testRef("Item"))
+
+	if err := Instantiate(g); err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, r := range g.Rules {
+		names = append(names, r.Name.Val)
+	}
+	if want := []string{"A", "B", "List_1", "List_2", "List_3", "Parens_1", "List_4"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("want rules %v, got %v", want, names)
+	}
+
+	texts := make(map[string]string)
+	for _, r := range g.Rules {
+		texts[r.Name.Val] = exprText(r.Expr)
+	}
+	want := map[string]string{
+		"A":        "(List_2 List_2 List_3)",
+		"B":        "Parens_1",
+		"List_1":   `"l"`,
+		"List_2":   `(B (("," B))*)`,
+		"List_3":   `(B ((";" B))*)`,
+		"Parens_1": `("(" List_4 ")")`,
+		"List_4":   `(B ((Sep B))*)`,
+	}
+	if !reflect.DeepEqual(texts, want) {
+		t.Errorf("want expressions %v, got %v", want, texts)
+	}
+}
+
+func TestInstantiateErrors(t *testing.T) {
+	cases := []struct {
+		g   *Grammar
+		err string
+	}{
+		{
+			g:   testGrammar(testTemplate("A", []string{"X"}, testRef("X"))),
+			err: "the start rule A cannot have parameters",
+		},
+		{
+			g: testGrammar(
+				testRule("A", testArgsRef("B", testRef("C"))),
+				testTemplate("B", []string{"X", "X"}, testRef("X")),
+			),
+			err: "duplicate parameter X of rule B",
+		},
+		{
+			g: testGrammar(
+				testRule("A", testRef("B")),
+				testTemplate("B", []string{"X"}, testRef("X")),
+			),
+			err: "rule B takes 1 argument, got 0",
+		},
+		{
+			g: testGrammar(
+				testRule("A", testArgsRef("B", testRef("C"))),
+				testTemplate("B", []string{"X", "Y"}, testRef("X")),
+			),
+			err: "rule B takes 2 arguments, got 1",
+		},
+		{
+			g: testGrammar(
+				testRule("A", testArgsRef("B", testRef("C"))),
+				testTemplate("B", []string{"X"}, testArgsRef("X", testRef("C"))),
+			),
+			err: "parameter X does not take arguments",
+		},
+		{
+			g: testGrammar(
+				testRule("A", testArgsRef("B", testRef("C"))),
+				testTemplate("B", []string{"X"}, testArgsRef("B", testSeq(testRef("X"), testRef("X")))),
+			),
+			err: "too many instances of rule B",
+		},
+	}
+	for _, tc := range cases {
+		err := Instantiate(tc.g)
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("want error %q, got %v", tc.err, err)
+		}
+	}
+}
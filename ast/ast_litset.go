@@ -0,0 +1,98 @@
+package ast
+
+import (
+	"errors"
+	"fmt"
+)
+
+// minLitSetLen is the minimum number of consecutive literals of a choice
+// that Optimize combines in a literal set matcher.
+const minLitSetLen = 4
+
+// ApplyLitSets replaces the expression of the rules annotated with
+// @longest or @keywords by a literal set matcher that matches the longest
+// of its literals, instead of the first one that matches like a choice.
+// The expression of those rules, or the expression of their action, must
+// be a choice of literals or a single literal. The literals of the rules
+// annotated with @keywords must also not be followed by a letter, a digit
+// or an underscore, so that "in" does not match the start of "inner".
+//
+// ApplyLitSets can be called again on the resulting grammar, which is left
+// unchanged.
+func ApplyLitSets(g *Grammar) error {
+	for _, r := range g.Rules {
+		keywords := r.HasAnnotation(KeywordsAnnotation)
+		if !keywords && !r.HasAnnotation(LongestAnnotation) {
+			continue
+		}
+
+		expr := &r.Expr
+		if act, ok := r.Expr.(*ActionExpr); ok {
+			expr = &act.Expr
+		}
+		set, err := litSet(*expr)
+		if err != nil {
+			return fmt.Errorf("%s: rule %s: %w", r.Pos(), r.Name.Val, err)
+		}
+		set.Longest = true
+		set.Boundary = set.Boundary || keywords
+		*expr = set
+	}
+	return nil
+}
+
+// litSet returns the literal set matcher of the literals of expr.
+func litSet(expr Expression) (*LitSetMatcher, error) {
+	switch expr := expr.(type) {
+	case *LitSetMatcher:
+		return expr, nil
+	case *LitMatcher:
+		return NewLitSetMatcher(expr.Pos(), expr), nil
+	case *ChoiceExpr:
+		set := NewLitSetMatcher(expr.Pos())
+		for _, alt := range expr.Alternatives {
+			lit, ok := alt.(*LitMatcher)
+			if !ok {
+				return nil, fmt.Errorf("%s: expected a literal in the choice, got %T", alt.Pos(), alt)
+			}
+			set.Lits = append(set.Lits, lit)
+		}
+		return set, nil
+	}
+	return nil, errors.New("the expression must be a choice of literals")
+}
+
+// combineLitSets combines the runs of at least minLitSetLen consecutive
+// literals of the alternatives of a choice in literal set matchers, which
+// match the first literal that matches like the choice. It returns the
+// alternatives and true if any run was combined.
+func combineLitSets(alts []Expression) ([]Expression, bool) {
+	var combined bool
+	for i := 0; i < len(alts); i++ {
+		var lits []*LitMatcher
+		j := i
+	run:
+		for ; j < len(alts); j++ {
+			switch alt := alts[j].(type) {
+			case *LitMatcher:
+				lits = append(lits, alt)
+			case *LitSetMatcher:
+				if alt.Longest || alt.Boundary {
+					break run
+				}
+				lits = append(lits, alt.Lits...)
+			default:
+				break run
+			}
+		}
+		if len(lits) < minLitSetLen || j-i == 1 {
+			continue
+		}
+
+		combined = true
+		set := NewLitSetMatcher(alts[i].Pos(), lits...)
+		alts = append(alts[:i+1], alts[j:]...)
+		alts[i] = set
+	}
+	return alts, combined
+}
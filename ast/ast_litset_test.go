@@ -0,0 +1,81 @@
+package ast
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestApplyLitSets(t *testing.T) {
+	g := testGrammar(
+		testRule("A", testChoice(1, testRef("B"), testRef("C"))),
+		testAnnotated(testRule("B", testChoice(2, testLit("in", true), testLit("insert", true))), KeywordsAnnotation),
+		testAnnotated(testRule("C", testLit("<", false)), LongestAnnotation),
+	)
+	if err := ApplyLitSets(g); err != nil {
+		t.Fatal(err)
+	}
+	// calling it again leaves the grammar unchanged
+	if err := ApplyLitSets(g); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`(B / C)`,
+		`("in"i / "insert"i)@longest@boundary`,
+		`("<")@longest`,
+	}
+	for i, r := range g.Rules {
+		if got := exprText(r.Expr); got != want[i] {
+			t.Errorf("rule %s: want %s, got %s", r.Name.Val, want[i], got)
+		}
+	}
+}
+
+func TestApplyLitSetsError(t *testing.T) {
+	g := testGrammar(
+		testAnnotated(testRule("A", testChoice(1, testLit("a", false), testRef("B"))), KeywordsAnnotation),
+	)
+	err := ApplyLitSets(g)
+	if want := "rule A: 0:0 (0): expected a literal in the choice"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("want error %q, got %v", want, err)
+	}
+}
+
+func TestCombineLitSets(t *testing.T) {
+	cases := []struct {
+		alts []Expression
+		want string
+	}{
+		{
+			alts: []Expression{testLit("a", false), testLit("bb", false), testLit("c", true)},
+			want: `("a" / "bb" / "c"i)`,
+		},
+		{
+			alts: []Expression{
+				testRef("A"), testLit("in", false), testLit("insert", false), testLit("is", false),
+				testLit("if", true), testRef("B"), testLit("a", false),
+			},
+			want: `(A / ("in" / "insert" / "is" / "if"i) / B / "a")`,
+		},
+		{
+			alts: []Expression{
+				testLit("a", false), &LitSetMatcher{Lits: []*LitMatcher{testLit("b", false), testLit("c", false)}},
+				testLit("d", false),
+			},
+			want: `(("a" / "b" / "c" / "d"))`,
+		},
+		{
+			alts: []Expression{
+				testLit("a", false), testLit("b", false), testLit("c", false),
+				&LitSetMatcher{Lits: []*LitMatcher{testLit("d", false)}, Longest: true},
+			},
+			want: `("a" / "b" / "c" / ("d")@longest)`,
+		},
+	}
+	for _, tc := range cases {
+		alts, _ := combineLitSets(tc.alts)
+		if got := exprText(&ChoiceExpr{Alternatives: alts}); got != tc.want {
+			t.Errorf("want %s, got %s", tc.want, got)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package ast
 
 import (
 	"bytes"
+	"fmt"
 	"strconv"
 	"strings"
 )
@@ -14,6 +15,7 @@ type grammarOptimizer struct {
 	ruleUsedByRules map[string]map[string]struct{}
 	visitor         func(expr Expression) Visitor
 	optimized       bool
+	binary          bool
 }
 
 func newGrammarOptimizer(protectedRules []string) *grammarOptimizer {
@@ -94,6 +96,9 @@ func (r *grammarOptimizer) optimize(expr0 Expression) Visitor {
 				l1, lok1 := expr.Alternatives[i].(*LitMatcher)
 				c0, cok0 := expr.Alternatives[i-1].(*CharClassMatcher)
 				c1, cok1 := expr.Alternatives[i].(*CharClassMatcher)
+				// the derived classes are only matched by their ranges
+				cok0 = cok0 && !c0.Derived
+				cok1 = cok1 && !c1.Derived
 
 				combined := false
 
@@ -143,6 +148,12 @@ func (r *grammarOptimizer) optimize(expr0 Expression) Visitor {
 			}
 		}
 
+		// Combine runs of LitMatcher to LitSetMatcher
+		// "select" / "insert" / "in" / "delete" => a trie of the literals
+		var combined bool
+		expr.Alternatives, combined = combineLitSets(expr.Alternatives)
+		r.optimized = r.optimized || combined
+
 	case *Grammar:
 		// Reset optimized at the start of each Walk.
 		r.optimized = false
@@ -177,6 +188,15 @@ func (r *grammarOptimizer) optimize(expr0 Expression) Visitor {
 		expr.Expr = r.optimizeRule(expr.Expr)
 	case *OneOrMoreExpr:
 		expr.Expr = r.optimizeRule(expr.Expr)
+	case *PluckExpr:
+		expr.Expr = r.optimizeRule(expr.Expr)
+	case *PrecedenceExpr:
+		expr.Operand = r.optimizeRule(expr.Operand)
+		for _, l := range expr.Levels {
+			l.Op = r.optimizeRule(l.Op)
+		}
+	case *RepeatExpr:
+		expr.Expr = r.optimizeRule(expr.Expr)
 	case *Rule:
 		r.rule = expr.Name.Val
 		expr.Expr = r.optimizeRule(expr.Expr)
@@ -184,8 +204,11 @@ func (r *grammarOptimizer) optimize(expr0 Expression) Visitor {
 		expr.Exprs = r.optimizeRules(expr.Exprs)
 
 		for i := 0; i < len(expr.Exprs); i++ {
-			// Optimize nested sequences
-			if seq, ok := expr.Exprs[i].(*SeqExpr); ok {
+			// Optimize nested sequences, unless only one of them matches the
+			// skip rule between its expressions or the nested one has a cut,
+			// which only commits the nested sequence, or pluck expressions,
+			// which only select the value of the nested sequence
+			if seq, ok := expr.Exprs[i].(*SeqExpr); ok && seq.Skip == expr.Skip && seq.CutIndex() < 0 && len(seq.Plucked()) == 0 {
 				r.optimized = true
 				if i+1 < len(expr.Exprs) {
 					expr.Exprs = append(expr.Exprs[:i], append(seq.Exprs, expr.Exprs[i+1:]...)...)
@@ -198,7 +221,7 @@ func (r *grammarOptimizer) optimize(expr0 Expression) Visitor {
 			if i > 0 {
 				l0, ok0 := expr.Exprs[i-1].(*LitMatcher)
 				l1, ok1 := expr.Exprs[i].(*LitMatcher)
-				if ok0 && ok1 && l0.IgnoreCase == l1.IgnoreCase {
+				if ok0 && ok1 && l0.IgnoreCase == l1.IgnoreCase && !expr.Skip {
 					r.optimized = true
 					l0.Val += l1.Val
 					expr.Exprs[i-1] = l0
@@ -211,6 +234,8 @@ func (r *grammarOptimizer) optimize(expr0 Expression) Visitor {
 			}
 		}
 
+	case *TextExpr:
+		expr.Expr = r.optimizeRule(expr.Expr)
 	case *ZeroOrMoreExpr:
 		expr.Expr = r.optimizeRule(expr.Expr)
 	case *ZeroOrOneExpr:
@@ -229,7 +254,14 @@ func (r *grammarOptimizer) optimizeRules(exprs []Expression) []Expression {
 func (r *grammarOptimizer) optimizeRule(expr Expression) Expression {
 	// Optimize RuleRefExpr
 	if ruleRef, ok := expr.(*RuleRefExpr); ok {
-		if _, ok := r.ruleUsesRules[ruleRef.Name.Val]; !ok {
+		// Memoized rules are not inlined, the memoization is done per rule.
+		// Lexical rules are only inlined in lexical rules, so that the
+		// expressions of the rule keep matching the skip rule or not. The
+		// rules with a cut are not inlined, so that the cut stays out of
+		// the predicates and repetitions of the referencing rule.
+		_, ok := r.ruleUsesRules[ruleRef.Name.Val]
+		if rule := r.rules[ruleRef.Name.Val]; !ok && rule != nil && !rule.HasAnnotation(MemoAnnotation) &&
+			(!isLexical(rule) || isLexical(r.rules[r.rule])) && !hasCut(rule.Expr) {
 			r.optimized = true
 			delete(r.ruleUsedByRules[ruleRef.Name.Val], r.rule)
 			if len(r.ruleUsedByRules[ruleRef.Name.Val]) == 0 {
@@ -256,6 +288,9 @@ func (r *grammarOptimizer) optimizeRule(expr Expression) Expression {
 	if seq, ok := expr.(*SeqExpr); ok {
 		if len(seq.Exprs) == 1 {
 			r.optimized = true
+			if pluck, ok := seq.Exprs[0].(*PluckExpr); ok {
+				return pluck.Expr
+			}
 			return seq.Exprs[0]
 		}
 	}
@@ -263,6 +298,12 @@ func (r *grammarOptimizer) optimizeRule(expr Expression) Expression {
 	return expr
 }
 
+// isLexical returns true if the skip rule of the grammar is not matched in
+// the rule, see ApplySkip.
+func isLexical(rule *Rule) bool {
+	return rule.HasAnnotation(LexicalAnnotation) || rule.HasAnnotation(SkipAnnotation)
+}
+
 // cloneExpr takes an Expression and deep clones it (including all children)
 // This is necessary because referenced Rules are denormalized and therefore
 // have to become independent from their original Expression.
@@ -291,10 +332,18 @@ func cloneExpr(expr Expression) Expression {
 			Chars:          append([]rune{}, expr.Chars...),
 			IgnoreCase:     expr.IgnoreCase,
 			Inverted:       expr.Inverted,
+			Derived:        expr.Derived,
 			posValue:       expr.posValue,
 			Ranges:         append([]rune{}, expr.Ranges...),
 			UnicodeClasses: append([]string{}, expr.UnicodeClasses...),
 		}
+	case *LitSetMatcher:
+		return &LitSetMatcher{
+			Boundary: expr.Boundary,
+			Lits:     append([]*LitMatcher{}, expr.Lits...),
+			Longest:  expr.Longest,
+			p:        expr.p,
+		}
 	case *ChoiceExpr:
 		alts := make([]Expression, 0, len(expr.Alternatives))
 		for i := 0; i < len(expr.Alternatives); i++ {
@@ -323,9 +372,23 @@ func cloneExpr(expr Expression) Expression {
 		}
 	case *OneOrMoreExpr:
 		return &OneOrMoreExpr{
+			Expr: cloneExpr(expr.Expr),
+			Skip: expr.Skip,
+			p:    expr.p,
+		}
+	case *PluckExpr:
+		return &PluckExpr{
 			Expr: cloneExpr(expr.Expr),
 			p:    expr.p,
 		}
+	case *RepeatExpr:
+		return &RepeatExpr{
+			Expr: cloneExpr(expr.Expr),
+			Min:  expr.Min,
+			Max:  expr.Max,
+			Skip: expr.Skip,
+			p:    expr.p,
+		}
 	case *SeqExpr:
 		exprs := make([]Expression, 0, len(expr.Exprs))
 		for i := 0; i < len(expr.Exprs); i++ {
@@ -333,17 +396,41 @@ func cloneExpr(expr Expression) Expression {
 		}
 		return &SeqExpr{
 			Exprs: exprs,
+			Skip:  expr.Skip,
 			p:     expr.p,
 		}
+	case *PrecedenceExpr:
+		levels := make([]*PrecedenceLevel, 0, len(expr.Levels))
+		for _, l := range expr.Levels {
+			levels = append(levels, &PrecedenceLevel{
+				Code:   l.Code,
+				FuncIx: l.FuncIx,
+				Kind:   l.Kind,
+				Op:     cloneExpr(l.Op),
+				p:      l.p,
+			})
+		}
+		return &PrecedenceExpr{
+			Levels:  levels,
+			Operand: cloneExpr(expr.Operand),
+			Skip:    expr.Skip,
+			p:       expr.p,
+		}
 	case *StateCodeExpr:
 		return &StateCodeExpr{
 			p:      expr.p,
 			Code:   expr.Code,
 			FuncIx: expr.FuncIx,
 		}
+	case *TextExpr:
+		return &TextExpr{
+			Expr: cloneExpr(expr.Expr),
+			p:    expr.p,
+		}
 	case *ZeroOrMoreExpr:
 		return &ZeroOrMoreExpr{
 			Expr: cloneExpr(expr.Expr),
+			Skip: expr.Skip,
 			p:    expr.p,
 		}
 	case *ZeroOrOneExpr:
@@ -361,8 +448,9 @@ func cloneExpr(expr Expression) Expression {
 // and UnicodeClasses of the given CharClassMatcher as well as regenerating the
 // correct content for the Val field (string representation of the CharClassMatcher).
 func (r *grammarOptimizer) cleanupCharClassMatcher(expr0 Expression) Visitor {
-	// We are only interested in nodes of type *CharClassMatcher
-	if chr, ok := expr0.(*CharClassMatcher); ok {
+	// We are only interested in nodes of type *CharClassMatcher, the
+	// derived ones keep their ranges and Val
+	if chr, ok := expr0.(*CharClassMatcher); ok && !chr.Derived {
 		// Remove redundancies in Chars
 		chars := make([]rune, 0, len(chr.Chars))
 		charsMap := make(map[rune]struct{})
@@ -415,13 +503,17 @@ func (r *grammarOptimizer) cleanupCharClassMatcher(expr0 Expression) Visitor {
 		if chr.Inverted {
 			val.WriteString("^")
 		}
+		escape := escapeRune
+		if r.binary {
+			escape = escapeByte
+		}
 		for _, c := range chr.Chars {
-			val.WriteString(escapeRune(c))
+			val.WriteString(escape(c))
 		}
 		for i := 0; i < len(chr.Ranges); i += 2 {
-			val.WriteString(escapeRune(chr.Ranges[i]))
+			val.WriteString(escape(chr.Ranges[i]))
 			val.WriteString("-")
-			val.WriteString(escapeRune(chr.Ranges[i+1]))
+			val.WriteString(escape(chr.Ranges[i+1]))
 		}
 		for _, u := range chr.UnicodeClasses {
 			val.WriteString("\\p" + u)
@@ -435,10 +527,30 @@ func (r *grammarOptimizer) cleanupCharClassMatcher(expr0 Expression) Visitor {
 	return r
 }
 
+// hasCut returns true if expr contains a cut expression.
+func hasCut(expr Expression) bool {
+	found := false
+	Inspect(expr, func(expr Expression) bool {
+		_, ok := expr.(*CutExpr)
+		found = found || ok
+		return !found
+	})
+	return found
+}
+
 func escapeRune(r rune) string {
 	return strings.Trim(strconv.QuoteRune(r), `'`)
 }
 
+// escapeByte is escapeRune for the character classes of the binary parsers,
+// which match bytes: the bytes above 0x7f are escaped as \xNN.
+func escapeByte(r rune) string {
+	if r >= 0x80 && r <= 0xff {
+		return fmt.Sprintf(`\x%02x`, r)
+	}
+	return escapeRune(r)
+}
+
 // Optimize walks a given grammar and optimizes the grammar in regards
 // of parsing performance. This is done with several optimizations:
 //   - removal of unreferenced rules
@@ -449,13 +561,35 @@ func escapeRune(r rune) string {
 //   - resolve nested sequences expression
 //   - resolve sequence expressions with only one element
 //   - combine character class matcher and literal matcher, where possible
+//   - combine the runs of literals of choice expressions in literal set
+//     matchers
+//
+// The skip rule of the grammar is kept, as the generated parser references
+// it by name, see ApplySkip.
 func Optimize(g *Grammar, alternateEntrypoints ...string) {
+	optimize(g, false, alternateEntrypoints)
+}
+
+// OptimizeBinary is Optimize for the grammars of the binary parsers: the
+// bytes above 0x7f are escaped as \xNN in the regenerated text of the
+// character classes, e.g. [\x80-\xff] instead of [\u0080-ÿ].
+func OptimizeBinary(g *Grammar, alternateEntrypoints ...string) {
+	optimize(g, true, alternateEntrypoints)
+}
+
+func optimize(g *Grammar, binary bool, alternateEntrypoints []string) {
 	entrypoints := alternateEntrypoints
 	if len(g.Rules) > 0 {
 		entrypoints = append(entrypoints, g.Rules[0].Name.Val)
 	}
+	for _, rule := range g.Rules {
+		if rule.HasAnnotation(SkipAnnotation) {
+			entrypoints = append(entrypoints, rule.Name.Val)
+		}
+	}
 
 	r := newGrammarOptimizer(entrypoints)
+	r.binary = binary
 	Walk(r, g)
 
 	r.visitor = r.optimize
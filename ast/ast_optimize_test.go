@@ -169,24 +169,24 @@ var cases = []struct {
 									},
 								},
 							},
-							&ChoiceExpr{
-								Alternatives: []Expression{
-									&LitMatcher{
+							&LitSetMatcher{
+								Lits: []*LitMatcher{
+									{
 										posValue: posValue{
 											Val: "c1",
 										},
 									},
-									&LitMatcher{
+									{
 										posValue: posValue{
 											Val: "c2",
 										},
 									},
-									&LitMatcher{
+									{
 										posValue: posValue{
 											Val: "c3",
 										},
 									},
-									&LitMatcher{
+									{
 										posValue: posValue{
 											Val: "c4",
 										},
@@ -360,3 +360,75 @@ func TestOptimize(t *testing.T) {
 		}
 	}
 }
+
+func TestOptimizeMemoRule(t *testing.T) {
+	memo := testRule("Memo", testLit("m", false))
+	memo.Annotations = []*Identifier{NewIdentifier(Pos{}, MemoAnnotation)}
+	g := testGrammar(
+		testRule("Start", testSeq(testRef("Memo"), testRef("Plain"))),
+		memo,
+		testRule("Plain", testLit("p", false)),
+	)
+	Optimize(g)
+
+	if len(g.Rules) != 2 || g.Rules[1] != memo {
+		t.Fatalf("want Start and Memo rules, got %v", g.Rules)
+	}
+	seq := g.Rules[0].Expr.(*SeqExpr)
+	if ref, ok := seq.Exprs[0].(*RuleRefExpr); !ok || ref.Name.Val != "Memo" {
+		t.Errorf("want reference to Memo, got %v", seq.Exprs[0])
+	}
+	if lit, ok := seq.Exprs[1].(*LitMatcher); !ok || lit.Val != "p" {
+		t.Errorf("want inlined Plain, got %v", seq.Exprs[1])
+	}
+}
+
+func TestOptimizeCutRule(t *testing.T) {
+	cut := testRule("Cut", testSeq(testLit("c", false), NewCutExpr(Pos{}), testLit("d", false)))
+	star := NewZeroOrMoreExpr(Pos{})
+	star.Expr = testRef("Cut")
+	g := testGrammar(testRule("Start", star), cut)
+	Optimize(g)
+
+	// the cut stays in its rule, out of the repetition
+	if len(g.Rules) != 2 || g.Rules[1] != cut {
+		t.Fatalf("want Start and Cut rules, got %v", g.Rules)
+	}
+	if ref, ok := star.Expr.(*RuleRefExpr); !ok || ref.Name.Val != "Cut" {
+		t.Errorf("want reference to Cut, got %v", star.Expr)
+	}
+}
+
+func TestOptimizePluck(t *testing.T) {
+	pluck := NewPluckExpr(Pos{})
+	pluck.Expr = testRef("Inner")
+	g := testGrammar(
+		testRule("Start", testSeq(testLit("a", false), testRef("Paren"), testLit("b", false))),
+		testRule("Paren", testSeq(testLit("(", false), pluck, testLit(")", false))),
+		testRule("Inner", testLit("i", false)),
+	)
+	Optimize(g)
+
+	// the sequence of Paren is not merged in the sequence of Start, its
+	// pluck expression only selects the value of Paren.
+	if got, want := exprText(g.Rules[0].Expr), `("a" ("(" @("i") ")") "b")`; got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
+
+func TestOptimizeBinary(t *testing.T) {
+	cases := []struct {
+		optimize func(*Grammar, ...string)
+		want     string
+	}{
+		{Optimize, `[a\u0080-ÿ]`},
+		{OptimizeBinary, `[a\x80-\xff]`},
+	}
+	for _, tc := range cases {
+		g := testGrammar(testRule("Start", NewCharClassMatcher(Pos{}, `[a\x80-\xff]`)))
+		tc.optimize(g)
+		if got := g.Rules[0].Expr.(*CharClassMatcher).Val; got != tc.want {
+			t.Errorf("want %s, got %s", tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package ast
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// ChoiceReordering describes the reordering of the alternatives of a
+// choice expression performed by ReorderChoices.
+type ChoiceReordering struct {
+	// Rule is the name of the rule that contains the choice expression.
+	Rule   string
+	Choice *ChoiceExpr
+
+	// Order holds the original (zero-based) index of each alternative, in
+	// the new order of the alternatives.
+	Order []int
+
+	// Counts holds the number of matches of each alternative, in the
+	// original order of the alternatives.
+	Counts []int
+}
+
+// ReorderChoices reorders the alternatives of the choice expressions of the
+// grammar so that the alternatives that matched most often are tried first.
+// The number of matches of the alternatives are provided by counts, in the
+// format of the ChoiceAltCnt field of the statistics of the generated
+// parsers: the outer key is the rule name followed by a space and the
+// line:column position of the choice, the inner key is the one-based index
+// of the alternative. Other inner keys, such as the key of the choices
+// that did not match, are ignored.
+//
+// Two alternatives are only swapped if they are disjoint, that is if both
+// always consume input and they cannot start with the same rune, so that
+// at most one of them can match any given input and the language of the
+// grammar is unchanged. Alternatives that cannot be swapped keep their
+// relative order.
+//
+// The counts must have been collected with a parser generated from the
+// same grammar, without reordering. ReorderChoices returns the performed
+// reorderings, in the order of the choice expressions in the grammar.
+func ReorderChoices(g *Grammar, counts map[string]map[string]int) []*ChoiceReordering {
+	var reorderings []*ChoiceReordering
+	fs := NewFirstSets(g)
+	for _, r := range g.Rules {
+		rule := r.Name.Val
+		Inspect(r.Expr, func(expr Expression) bool {
+			ch, ok := expr.(*ChoiceExpr)
+			if !ok {
+				return true
+			}
+			pos := ch.Pos()
+			altCounts := choiceCounts(counts[fmt.Sprintf("%s %d:%d", rule, pos.Line, pos.Col)], len(ch.Alternatives))
+			if altCounts == nil {
+				return true
+			}
+			if order := reorderChoice(fs, ch, altCounts); order != nil {
+				reorderings = append(reorderings, &ChoiceReordering{
+					Rule:   rule,
+					Choice: ch,
+					Order:  order,
+					Counts: altCounts,
+				})
+			}
+			return true
+		})
+	}
+	return reorderings
+}
+
+// choiceCounts returns the number of matches of each of the n alternatives
+// of a choice, or nil if none of them matched.
+func choiceCounts(m map[string]int, n int) []int {
+	var counts []int
+	for k, cnt := range m {
+		i, err := strconv.Atoi(k)
+		if err != nil || i < 1 || i > n || cnt <= 0 {
+			continue
+		}
+		if counts == nil {
+			counts = make([]int, n)
+		}
+		counts[i-1] = cnt
+	}
+	return counts
+}
+
+// reorderChoice reorders the alternatives of ch by decreasing counts, as far
+// as the alternatives that are not disjoint keep their relative order. It
+// returns the original index of each alternative in the new order, or nil
+// if the order is unchanged.
+func reorderChoice(fs *FirstSets, ch *ChoiceExpr, counts []int) []int {
+	n := len(ch.Alternatives)
+	firsts := make([]First, n)
+	for i, alt := range ch.Alternatives {
+		firsts[i] = fs.Of(alt)
+	}
+
+	// preds[j] is the number of alternatives that must stay before j and
+	// are not placed yet.
+	preds := make([]int, n)
+	for j := range n {
+		for i := range j {
+			if !firsts[i].Disjoint(firsts[j]) {
+				preds[j]++
+			}
+		}
+	}
+
+	// repeatedly place the alternative with the most matches among those
+	// that have all their predecessors placed, the stable sort keeps the
+	// original order on ties.
+	order := make([]int, 0, n)
+	placed := make([]bool, n)
+	for len(order) < n {
+		var ready []int
+		for i := range n {
+			if !placed[i] && preds[i] == 0 {
+				ready = append(ready, i)
+			}
+		}
+		sort.SliceStable(ready, func(a, b int) bool { return counts[ready[a]] > counts[ready[b]] })
+		next := ready[0]
+		placed[next] = true
+		order = append(order, next)
+		for j := next + 1; j < n; j++ {
+			if !placed[j] && !firsts[next].Disjoint(firsts[j]) {
+				preds[j]--
+			}
+		}
+	}
+
+	changed := false
+	alts := make([]Expression, n)
+	for i, ix := range order {
+		alts[i] = ch.Alternatives[ix]
+		changed = changed || i != ix
+	}
+	if !changed {
+		return nil
+	}
+	ch.Alternatives = alts
+	return order
+}
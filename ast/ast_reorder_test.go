@@ -0,0 +1,168 @@
+package ast
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testLit(v string, ignoreCase bool) *LitMatcher {
+	lit := NewLitMatcher(Pos{}, v)
+	lit.IgnoreCase = ignoreCase
+	return lit
+}
+
+func testRef(name string) *RuleRefExpr {
+	ref := NewRuleRefExpr(Pos{})
+	ref.Name = NewIdentifier(Pos{}, name)
+	return ref
+}
+
+func testSeq(exprs ...Expression) *SeqExpr {
+	seq := NewSeqExpr(Pos{})
+	seq.Exprs = exprs
+	return seq
+}
+
+func testChoice(line int, alts ...Expression) *ChoiceExpr {
+	ch := NewChoiceExpr(Pos{Line: line, Col: 5})
+	ch.Alternatives = alts
+	return ch
+}
+
+func testRule(name string, expr Expression) *Rule {
+	r := NewRule(Pos{}, NewIdentifier(Pos{}, name))
+	r.Expr = expr
+	return r
+}
+
+func testGrammar(rules ...*Rule) *Grammar {
+	g := NewGrammar(Pos{})
+	g.Rules = rules
+	return g
+}
+
+func TestRuneSet(t *testing.T) {
+	var s RuneSet
+	s.AddRange('d', 'f')
+	s.Add('a')
+	s.AddRange('b', 'c')
+	s.Add('x')
+	if want := []rune{'a', 'f', 'x', 'x'}; !reflect.DeepEqual(s.Ranges(), want) {
+		t.Errorf("want ranges %q, got %q", want, s.Ranges())
+	}
+	if !s.Contains('e') || s.Contains('g') {
+		t.Errorf("want e and not g in %s", s)
+	}
+
+	var o RuneSet
+	o.AddRange('g', 'w')
+	if s.Intersects(o) {
+		t.Errorf("want %s and %s disjoint", s, o)
+	}
+	o.Add('y')
+	o.Add('x')
+	if !s.Intersects(o) {
+		t.Errorf("want %s and %s intersecting", s, o)
+	}
+
+	c := s.Complement()
+	if c.Contains('a') || !c.Contains('g') || !c.Contains(0) || !c.Contains('\U0010FFFF') {
+		t.Errorf("invalid complement %s", c)
+	}
+}
+
+func TestFirstSets(t *testing.T) {
+	g := testGrammar(
+		testRule("Num", NewCharClassMatcher(Pos{}, "[0-9]")),
+		testRule("Ident", testSeq(NewNotExpr(Pos{}), NewCharClassMatcher(Pos{}, "[a-z_]i"))),
+		testRule("Opt", testSeq(testLit("", false), testRef("Num"))),
+		testRule("Left", testSeq(testRef("Left"), testLit("+", false))),
+		testRule("Not", NewCharClassMatcher(Pos{}, "[^\\pL]i")),
+	)
+	fs := NewFirstSets(g)
+	zom := NewZeroOrMoreExpr(Pos{})
+	zom.Expr = testLit("x", false)
+
+	cases := []struct {
+		expr     Expression
+		in, out  string
+		nullable bool
+		opaque   bool
+	}{
+		{expr: testRef("Num"), in: "09", out: "a"},
+		{expr: testRef("Ident"), in: "aZ_", out: "0"},
+		{expr: testRef("Opt"), in: "5", out: "x"},
+		{expr: testLit("ab", true), in: "aA", out: "b"},
+		{expr: testLit("\u212a", true), in: "kK\u212a", out: "a"},
+		{expr: testRef("Not"), in: "0", out: "aA"},
+		{expr: NewAnyMatcher(Pos{}, "."), in: "a\x00"},
+		{expr: testSeq(NewAndCodeExpr(Pos{}), testLit("", false)), nullable: true},
+		{expr: testChoice(1, testRef("Num"), zom), nullable: true},
+		{expr: testRef("Left"), opaque: true},
+		{expr: testRef("Undefined"), opaque: true},
+	}
+	for i, tc := range cases {
+		f := fs.Of(tc.expr)
+		if f.Opaque != tc.opaque || f.Nullable != tc.nullable {
+			t.Errorf("%d: want opaque %t and nullable %t, got %+v", i, tc.opaque, tc.nullable, f)
+			continue
+		}
+		for _, r := range tc.in {
+			if !f.Runes.Contains(r) {
+				t.Errorf("%d: want %q in %s", i, r, f.Runes)
+			}
+		}
+		for _, r := range tc.out {
+			if f.Runes.Contains(r) {
+				t.Errorf("%d: want %q not in %s", i, r, f.Runes)
+			}
+		}
+	}
+}
+
+func TestReorderChoices(t *testing.T) {
+	num := testRef("Num")
+	str := testLit("\"", false)
+	kw := testLit("null", false)
+	ident := NewCharClassMatcher(Pos{}, "[a-z]")
+	opt := NewZeroOrOneExpr(Pos{})
+	opt.Expr = testLit("-", false)
+
+	disjoint := testChoice(1, num, str, testLit("[", false))
+	overlap := testChoice(2, kw, str, ident, num)
+	nullable := testChoice(3, num, opt)
+	g := testGrammar(
+		testRule("Value", testSeq(disjoint, overlap, nullable)),
+		testRule("Num", NewCharClassMatcher(Pos{}, "[0-9]")),
+	)
+
+	counts := map[string]map[string]int{
+		"Value 1:5": {"1": 2, "2": 7, "3": 5, "no match": 100},
+		"Value 2:5": {"1": 1, "2": 2, "3": 9, "4": 8},
+		"Value 3:5": {"1": 1, "2": 9},
+		"Num 1:1":   {"1": 3},
+	}
+	got := ReorderChoices(g, counts)
+	if len(got) != 2 {
+		t.Fatalf("want 2 reorderings, got %d", len(got))
+	}
+
+	if got[0].Choice != disjoint || !reflect.DeepEqual(got[0].Order, []int{1, 2, 0}) {
+		t.Errorf("want disjoint choice reordered as [1 2 0], got %+v", got[0])
+	}
+	if !reflect.DeepEqual(disjoint.Alternatives, []Expression{str, disjoint.Alternatives[1], num}) {
+		t.Errorf("unexpected alternatives %v", disjoint.Alternatives)
+	}
+	if !reflect.DeepEqual(got[0].Counts, []int{2, 7, 5}) {
+		t.Errorf("want counts [2 7 5], got %v", got[0].Counts)
+	}
+
+	// the keyword and the identifier overlap, the identifier cannot move
+	// before the keyword.
+	if got[1].Choice != overlap || !reflect.DeepEqual(got[1].Order, []int{3, 1, 0, 2}) {
+		t.Errorf("want overlapping choice reordered as [3 1 0 2], got %+v", got[1])
+	}
+	if !reflect.DeepEqual(nullable.Alternatives, []Expression{num, opt}) {
+		t.Errorf("want nullable choice unchanged, got %v", nullable.Alternatives)
+	}
+}
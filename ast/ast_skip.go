@@ -0,0 +1,205 @@
+package ast
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ApplySkip marks the expressions of the syntactic rules between which the
+// generated parser matches the skip rule of the grammar, the rule with the
+// @skip annotation. The skip rule is matched as many times as possible
+// between the expressions of the sequences, and between the repetitions
+// of the zero or more, one or more and repeat expressions, and between the
+// operands and operators of the precedence expressions, but not before the
+// first or after the last one.
+//
+// The rules with the @lexical annotation and the skip rule itself are
+// lexical, the skip rule is not matched in their expression. The rules
+// that are only referenced by lexical rules are lexical too. A rule that
+// is referenced by both lexical and syntactic rules is copied for the
+// lexical rules, the copy is named after the rule with the "_lexical"
+// suffix and is added after the rules of the grammar.
+//
+// ApplySkip does nothing if the grammar has no skip rule. It can be called
+// again on the resulting grammar, which is left unchanged.
+func ApplySkip(g *Grammar) error {
+	var skip *Rule
+	rules := make(map[string]*Rule, len(g.Rules))
+	for _, r := range g.Rules {
+		rules[r.Name.Val] = r
+		if !r.HasAnnotation(SkipAnnotation) {
+			continue
+		}
+		if skip != nil {
+			return fmt.Errorf("%s: rule %s: the grammar already has the skip rule %s", r.Pos(), r.Name.Val, skip.Name.Val)
+		}
+		skip = r
+	}
+	if skip == nil {
+		return nil
+	}
+	if start := g.Rules[0]; start == skip {
+		return fmt.Errorf("%s: the start rule %s cannot be the skip rule", start.Pos(), start.Name.Val)
+	}
+
+	refs := make(map[*Rule][]*Rule, len(g.Rules))
+	referenced := make(map[*Rule]bool, len(g.Rules))
+	for _, r := range g.Rules {
+		for _, t := range ruleRefs(r, rules) {
+			refs[r] = append(refs[r], t)
+			if t != r {
+				referenced[t] = true
+			}
+		}
+	}
+
+	// the rules referenced by the syntactic rules, starting with the start
+	// rule and the rules that are not referenced, which may be entrypoints,
+	// and the rules referenced by the lexical rules.
+	var synRoots, lexRoots []*Rule
+	for i, r := range g.Rules {
+		switch {
+		case isLexical(r):
+			lexRoots = append(lexRoots, r)
+		case i == 0 || !referenced[r]:
+			synRoots = append(synRoots, r)
+		}
+	}
+	syntactic := reachableRules(synRoots, refs, isLexical)
+	lexical := reachableRules(lexRoots, refs, func(r *Rule) bool { return false })
+
+	// a rule is sensitive to the skip rule if its expression has an
+	// expression that matches it, or if it references a sensitive rule
+	// that is not lexical.
+	sensitive := make(map[*Rule]bool, len(g.Rules))
+	for _, r := range g.Rules {
+		sensitive[r] = !isLexical(r) && hasSkipExpr(r.Expr)
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, r := range g.Rules {
+			if sensitive[r] || isLexical(r) {
+				continue
+			}
+			for _, t := range refs[r] {
+				if sensitive[t] {
+					sensitive[r] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	copies := make(map[string]string)
+	var lexRules, copyRules []*Rule
+	for _, r := range g.Rules {
+		switch {
+		case isLexical(r):
+			lexRules = append(lexRules, r)
+		case lexical[r] && !syntactic[r]:
+			r.Annotations = append(r.Annotations[:len(r.Annotations):len(r.Annotations)],
+				NewIdentifier(r.Pos(), LexicalAnnotation))
+			lexRules = append(lexRules, r)
+		case lexical[r] && sensitive[r]:
+			name := r.Name.Val + "_lexical"
+			for i := 1; rules[name] != nil; i++ {
+				name = r.Name.Val + "_lexical_" + strconv.Itoa(i)
+			}
+			cp := NewRule(r.Pos(), NewIdentifier(r.Name.Pos(), name))
+			cp.DisplayName = r.DisplayName
+			cp.Annotations = append(append([]*Identifier{}, r.Annotations...), NewIdentifier(r.Pos(), LexicalAnnotation))
+			cp.Expr, _ = substituteArgs(r.Expr, nil)
+			rules[name] = cp
+			copies[r.Name.Val] = name
+			lexRules = append(lexRules, cp)
+			copyRules = append(copyRules, cp)
+		}
+	}
+	g.Rules = append(g.Rules, copyRules...)
+
+	// the lexical rules reference the copies, the syntactic rules match
+	// the skip rule.
+	for _, r := range lexRules {
+		Inspect(r.Expr, func(expr Expression) bool {
+			if ref, ok := expr.(*RuleRefExpr); ok {
+				if name, ok := copies[ref.Name.Val]; ok {
+					ref.Name = NewIdentifier(ref.Name.Pos(), name)
+				}
+			}
+			return true
+		})
+	}
+	for _, r := range g.Rules {
+		if isLexical(r) {
+			continue
+		}
+		Inspect(r.Expr, func(expr Expression) bool {
+			switch expr := expr.(type) {
+			case *SeqExpr:
+				expr.Skip = len(expr.Exprs) > 1
+			case *ZeroOrMoreExpr:
+				expr.Skip = true
+			case *OneOrMoreExpr:
+				expr.Skip = true
+			case *RepeatExpr:
+				expr.Skip = true
+			case *PrecedenceExpr:
+				expr.Skip = true
+			}
+			return true
+		})
+	}
+	return nil
+}
+
+// ruleRefs returns the rules of the grammar referenced by r.
+func ruleRefs(r *Rule, rules map[string]*Rule) []*Rule {
+	var refs []*Rule
+	Inspect(r.Expr, func(expr Expression) bool {
+		if ref, ok := expr.(*RuleRefExpr); ok {
+			if t := rules[ref.Name.Val]; t != nil {
+				refs = append(refs, t)
+			}
+		}
+		return true
+	})
+	return refs
+}
+
+// reachableRules returns the roots and the rules they reference, directly
+// or indirectly, without following the references of the rules for which
+// stop returns true.
+func reachableRules(roots []*Rule, refs map[*Rule][]*Rule, stop func(*Rule) bool) map[*Rule]bool {
+	seen := make(map[*Rule]bool)
+	for len(roots) > 0 {
+		r := roots[len(roots)-1]
+		roots = roots[:len(roots)-1]
+		if seen[r] {
+			continue
+		}
+		seen[r] = true
+		for _, t := range refs[r] {
+			if !stop(t) {
+				roots = append(roots, t)
+			}
+		}
+	}
+	return seen
+}
+
+// hasSkipExpr returns true if expr has a sequence of more than one
+// expression, a repetition or a precedence expression.
+func hasSkipExpr(expr Expression) bool {
+	var found bool
+	Inspect(expr, func(expr Expression) bool {
+		switch expr := expr.(type) {
+		case *SeqExpr:
+			found = found || len(expr.Exprs) > 1
+		case *ZeroOrMoreExpr, *OneOrMoreExpr, *RepeatExpr, *PrecedenceExpr:
+			found = true
+		}
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,83 @@
+package ast
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testAnnotated(r *Rule, annotations ...string) *Rule {
+	for _, a := range annotations {
+		r.Annotations = append(r.Annotations, NewIdentifier(Pos{}, a))
+	}
+	return r
+}
+
+func TestApplySkip(t *testing.T) {
+	g := testGrammar(
+		testRule("A", testSeq(testRef("B"), testRef("C"), testRef("D"))),
+		testRule("B", testSeq(testLit("b", false), testRef("D"))),
+		testAnnotated(testRule("C", testSeq(testLit("c", false), testRef("B"), testRef("E"))), LexicalAnnotation),
+		testRule("D", testLit("d", false)),
+		testRule("E", testSeq(testLit("e", false), testRef("D"))),
+		testAnnotated(testRule("S", testSeq(testLit("#", false), testRef("E"))), SkipAnnotation),
+	)
+	if err := ApplySkip(g); err != nil {
+		t.Fatal(err)
+	}
+	// calling it again leaves the grammar unchanged
+	if err := ApplySkip(g); err != nil {
+		t.Fatal(err)
+	}
+
+	var names, lexical []string
+	skips := make(map[string]bool)
+	for _, r := range g.Rules {
+		names = append(names, r.Name.Val)
+		if r.HasAnnotation(LexicalAnnotation) {
+			lexical = append(lexical, r.Name.Val)
+		}
+		if seq, ok := r.Expr.(*SeqExpr); ok {
+			skips[r.Name.Val] = seq.Skip
+		}
+	}
+	if want := []string{"A", "B", "C", "D", "E", "S", "B_lexical"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("want rules %v, got %v", want, names)
+	}
+	if want := []string{"C", "E", "B_lexical"}; !reflect.DeepEqual(lexical, want) {
+		t.Errorf("want lexical rules %v, got %v", want, lexical)
+	}
+	want := map[string]bool{"A": true, "B": true, "C": false, "E": false, "S": false, "B_lexical": false}
+	if !reflect.DeepEqual(skips, want) {
+		t.Errorf("want skips %v, got %v", want, skips)
+	}
+	if got := exprText(g.Rules[2].Expr); got != `("c" B_lexical E)` {
+		t.Errorf("want C to reference B_lexical, got %s", got)
+	}
+}
+
+func TestApplySkipErrors(t *testing.T) {
+	cases := []struct {
+		g   *Grammar
+		err string
+	}{
+		{
+			g:   testGrammar(testAnnotated(testRule("A", testLit("a", false)), SkipAnnotation)),
+			err: "the start rule A cannot be the skip rule",
+		},
+		{
+			g: testGrammar(
+				testRule("A", testLit("a", false)),
+				testAnnotated(testRule("B", testLit("b", false)), SkipAnnotation),
+				testAnnotated(testRule("C", testLit("c", false)), SkipAnnotation),
+			),
+			err: "rule C: the grammar already has the skip rule B",
+		},
+	}
+	for _, tc := range cases {
+		err := ApplySkip(tc.g)
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("want error %q, got %v", tc.err, err)
+		}
+	}
+}
@@ -35,6 +35,10 @@ func Walk(v Visitor, expr Expression) {
 		for _, e := range expr.Alternatives {
 			Walk(v, e)
 		}
+	case *BackRefExpr:
+		// Nothing to do
+	case *CutExpr:
+		// Nothing to do
 	case *Grammar:
 		for _, e := range expr.Rules {
 			Walk(v, e)
@@ -43,12 +47,32 @@ func Walk(v Visitor, expr Expression) {
 		Walk(v, expr.Expr)
 	case *LitMatcher:
 		// Nothing to do
+	case *LitSetMatcher:
+		for _, e := range expr.Lits {
+			Walk(v, e)
+		}
+	case *MatcherFuncExpr:
+		// Nothing to do
 	case *NotCodeExpr:
 		// Nothing to do
 	case *NotExpr:
 		Walk(v, expr.Expr)
 	case *OneOrMoreExpr:
 		Walk(v, expr.Expr)
+	case *PluckExpr:
+		Walk(v, expr.Expr)
+	case *PrecedenceExpr:
+		Walk(v, expr.Operand)
+		for _, l := range expr.Levels {
+			Walk(v, l.Op)
+		}
+	case *RecoveryExpr:
+		Walk(v, expr.Expr)
+		Walk(v, expr.RecoverExpr)
+	case *RegexpMatcher:
+		// Nothing to do
+	case *RepeatExpr:
+		Walk(v, expr.Expr)
 	case *Rule:
 		Walk(v, expr.Expr)
 	case *RuleRefExpr:
@@ -59,6 +83,10 @@ func Walk(v Visitor, expr Expression) {
 		}
 	case *StateCodeExpr:
 		// Nothing to do
+	case *TextExpr:
+		Walk(v, expr.Expr)
+	case *ThrowExpr:
+		// Nothing to do
 	case *ZeroOrMoreExpr:
 		Walk(v, expr.Expr)
 	case *ZeroOrOneExpr:
@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 	"unicode"
 	"unicode/utf8"
 
@@ -24,30 +25,39 @@ var g = &grammar{
 		{
 			name: "Grammar",
 			pos:  position{line: 5, col: 1, offset: 18},
+			id:   0,
 			expr: &actionExpr{
 				pos: position{line: 5, col: 11, offset: 30},
+				id:  58,
 				run: (*parser).callonGrammar1,
 				expr: &seqExpr{
 					pos: position{line: 5, col: 11, offset: 30},
+					id:  59,
 					exprs: []any{
 						&ruleRefExpr{
 							pos:  position{line: 5, col: 11, offset: 30},
+							id:   60,
 							name: "__",
 						},
 						&labeledExpr{
 							pos:   position{line: 5, col: 14, offset: 33},
+							id:    61,
 							label: "initializer",
 							expr: &zeroOrOneExpr{
 								pos: position{line: 5, col: 28, offset: 47},
+								id:  62,
 								expr: &seqExpr{
 									pos: position{line: 5, col: 28, offset: 47},
+									id:  63,
 									exprs: []any{
 										&ruleRefExpr{
 											pos:  position{line: 5, col: 28, offset: 47},
+											id:   64,
 											name: "Initializer",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 5, col: 40, offset: 59},
+											id:   65,
 											name: "__",
 										},
 									},
@@ -56,18 +66,23 @@ var g = &grammar{
 						},
 						&labeledExpr{
 							pos:   position{line: 5, col: 46, offset: 65},
+							id:    66,
 							label: "rules",
 							expr: &oneOrMoreExpr{
 								pos: position{line: 5, col: 54, offset: 73},
+								id:  67,
 								expr: &seqExpr{
 									pos: position{line: 5, col: 54, offset: 73},
+									id:  68,
 									exprs: []any{
 										&ruleRefExpr{
 											pos:  position{line: 5, col: 54, offset: 73},
+											id:   69,
 											name: "Rule",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 5, col: 59, offset: 78},
+											id:   70,
 											name: "__",
 										},
 									},
@@ -81,22 +96,28 @@ var g = &grammar{
 		{
 			name: "Initializer",
 			pos:  position{line: 24, col: 1, offset: 509},
+			id:   1,
 			expr: &actionExpr{
 				pos: position{line: 24, col: 15, offset: 525},
+				id:  71,
 				run: (*parser).callonInitializer1,
 				expr: &seqExpr{
 					pos: position{line: 24, col: 15, offset: 525},
+					id:  72,
 					exprs: []any{
 						&labeledExpr{
 							pos:   position{line: 24, col: 15, offset: 525},
+							id:    73,
 							label: "code",
 							expr: &ruleRefExpr{
 								pos:  position{line: 24, col: 20, offset: 530},
+								id:   74,
 								name: "CodeBlock",
 							},
 						},
 						&ruleRefExpr{
 							pos:  position{line: 24, col: 30, offset: 540},
+							id:   75,
 							name: "EOS",
 						},
 					},
@@ -106,38 +127,49 @@ var g = &grammar{
 		{
 			name: "Rule",
 			pos:  position{line: 28, col: 1, offset: 570},
+			id:   2,
 			expr: &actionExpr{
 				pos: position{line: 28, col: 8, offset: 579},
+				id:  76,
 				run: (*parser).callonRule1,
 				expr: &seqExpr{
 					pos: position{line: 28, col: 8, offset: 579},
+					id:  77,
 					exprs: []any{
 						&labeledExpr{
 							pos:   position{line: 28, col: 8, offset: 579},
+							id:    78,
 							label: "name",
 							expr: &ruleRefExpr{
 								pos:  position{line: 28, col: 13, offset: 584},
+								id:   79,
 								name: "IdentifierName",
 							},
 						},
 						&ruleRefExpr{
 							pos:  position{line: 28, col: 28, offset: 599},
+							id:   80,
 							name: "__",
 						},
 						&labeledExpr{
 							pos:   position{line: 28, col: 31, offset: 602},
+							id:    81,
 							label: "display",
 							expr: &zeroOrOneExpr{
 								pos: position{line: 28, col: 41, offset: 612},
+								id:  82,
 								expr: &seqExpr{
 									pos: position{line: 28, col: 41, offset: 612},
+									id:  83,
 									exprs: []any{
 										&ruleRefExpr{
 											pos:  position{line: 28, col: 41, offset: 612},
+											id:   84,
 											name: "StringLiteral",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 28, col: 55, offset: 626},
+											id:   85,
 											name: "__",
 										},
 									},
@@ -146,22 +178,27 @@ var g = &grammar{
 						},
 						&ruleRefExpr{
 							pos:  position{line: 28, col: 61, offset: 632},
+							id:   86,
 							name: "RuleDefOp",
 						},
 						&ruleRefExpr{
 							pos:  position{line: 28, col: 71, offset: 642},
+							id:   87,
 							name: "__",
 						},
 						&labeledExpr{
 							pos:   position{line: 28, col: 74, offset: 645},
+							id:    88,
 							label: "expr",
 							expr: &ruleRefExpr{
 								pos:  position{line: 28, col: 79, offset: 650},
+								id:   89,
 								name: "Expression",
 							},
 						},
 						&ruleRefExpr{
 							pos:  position{line: 28, col: 90, offset: 661},
+							id:   90,
 							name: "EOS",
 						},
 					},
@@ -171,52 +208,66 @@ var g = &grammar{
 		{
 			name: "Expression",
 			pos:  position{line: 41, col: 1, offset: 943},
+			id:   3,
 			expr: &ruleRefExpr{
 				pos:  position{line: 41, col: 14, offset: 958},
+				id:   91,
 				name: "ChoiceExpr",
 			},
 		},
 		{
 			name: "ChoiceExpr",
 			pos:  position{line: 43, col: 1, offset: 970},
+			id:   4,
 			expr: &actionExpr{
 				pos: position{line: 43, col: 14, offset: 985},
+				id:  92,
 				run: (*parser).callonChoiceExpr1,
 				expr: &seqExpr{
 					pos: position{line: 43, col: 14, offset: 985},
+					id:  93,
 					exprs: []any{
 						&labeledExpr{
 							pos:   position{line: 43, col: 14, offset: 985},
+							id:    94,
 							label: "first",
 							expr: &ruleRefExpr{
 								pos:  position{line: 43, col: 20, offset: 991},
+								id:   95,
 								name: "ActionExpr",
 							},
 						},
 						&labeledExpr{
 							pos:   position{line: 43, col: 31, offset: 1002},
+							id:    96,
 							label: "rest",
 							expr: &zeroOrMoreExpr{
 								pos: position{line: 43, col: 38, offset: 1009},
+								id:  97,
 								expr: &seqExpr{
 									pos: position{line: 43, col: 38, offset: 1009},
+									id:  98,
 									exprs: []any{
 										&ruleRefExpr{
 											pos:  position{line: 43, col: 38, offset: 1009},
+											id:   99,
 											name: "__",
 										},
 										&litMatcher{
 											pos:        position{line: 43, col: 41, offset: 1012},
+											id:         100,
 											val:        "/",
 											ignoreCase: false,
 											want:       "\"/\"",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 43, col: 45, offset: 1016},
+											id:   101,
 											name: "__",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 43, col: 48, offset: 1019},
+											id:   102,
 											name: "ActionExpr",
 										},
 									},
@@ -230,34 +281,44 @@ var g = &grammar{
 		{
 			name: "ActionExpr",
 			pos:  position{line: 58, col: 1, offset: 1414},
+			id:   5,
 			expr: &actionExpr{
 				pos: position{line: 58, col: 14, offset: 1429},
+				id:  103,
 				run: (*parser).callonActionExpr1,
 				expr: &seqExpr{
 					pos: position{line: 58, col: 14, offset: 1429},
+					id:  104,
 					exprs: []any{
 						&labeledExpr{
 							pos:   position{line: 58, col: 14, offset: 1429},
+							id:    105,
 							label: "expr",
 							expr: &ruleRefExpr{
 								pos:  position{line: 58, col: 19, offset: 1434},
+								id:   106,
 								name: "SeqExpr",
 							},
 						},
 						&labeledExpr{
 							pos:   position{line: 58, col: 27, offset: 1442},
+							id:    107,
 							label: "code",
 							expr: &zeroOrOneExpr{
 								pos: position{line: 58, col: 34, offset: 1449},
+								id:  108,
 								expr: &seqExpr{
 									pos: position{line: 58, col: 34, offset: 1449},
+									id:  109,
 									exprs: []any{
 										&ruleRefExpr{
 											pos:  position{line: 58, col: 34, offset: 1449},
+											id:   110,
 											name: "__",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 58, col: 37, offset: 1452},
+											id:   111,
 											name: "CodeBlock",
 										},
 									},
@@ -271,34 +332,44 @@ var g = &grammar{
 		{
 			name: "SeqExpr",
 			pos:  position{line: 72, col: 1, offset: 1716},
+			id:   6,
 			expr: &actionExpr{
 				pos: position{line: 72, col: 11, offset: 1728},
+				id:  112,
 				run: (*parser).callonSeqExpr1,
 				expr: &seqExpr{
 					pos: position{line: 72, col: 11, offset: 1728},
+					id:  113,
 					exprs: []any{
 						&labeledExpr{
 							pos:   position{line: 72, col: 11, offset: 1728},
+							id:    114,
 							label: "first",
 							expr: &ruleRefExpr{
 								pos:  position{line: 72, col: 17, offset: 1734},
+								id:   115,
 								name: "LabeledExpr",
 							},
 						},
 						&labeledExpr{
 							pos:   position{line: 72, col: 29, offset: 1746},
+							id:    116,
 							label: "rest",
 							expr: &zeroOrMoreExpr{
 								pos: position{line: 72, col: 36, offset: 1753},
+								id:  117,
 								expr: &seqExpr{
 									pos: position{line: 72, col: 36, offset: 1753},
+									id:  118,
 									exprs: []any{
 										&ruleRefExpr{
 											pos:  position{line: 72, col: 36, offset: 1753},
+											id:   119,
 											name: "__",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 72, col: 39, offset: 1756},
+											id:   120,
 											name: "LabeledExpr",
 										},
 									},
@@ -312,42 +383,53 @@ var g = &grammar{
 		{
 			name: "LabeledExpr",
 			pos:  position{line: 85, col: 1, offset: 2097},
+			id:   7,
 			expr: &choiceExpr{
 				pos: position{line: 85, col: 15, offset: 2113},
+				id:  121,
 				alternatives: []any{
 					&actionExpr{
 						pos: position{line: 85, col: 15, offset: 2113},
+						id:  122,
 						run: (*parser).callonLabeledExpr2,
 						expr: &seqExpr{
 							pos: position{line: 85, col: 15, offset: 2113},
+							id:  123,
 							exprs: []any{
 								&labeledExpr{
 									pos:   position{line: 85, col: 15, offset: 2113},
+									id:    124,
 									label: "label",
 									expr: &ruleRefExpr{
 										pos:  position{line: 85, col: 21, offset: 2119},
+										id:   125,
 										name: "Identifier",
 									},
 								},
 								&ruleRefExpr{
 									pos:  position{line: 85, col: 32, offset: 2130},
+									id:   126,
 									name: "__",
 								},
 								&litMatcher{
 									pos:        position{line: 85, col: 35, offset: 2133},
+									id:         127,
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
 									pos:  position{line: 85, col: 39, offset: 2137},
+									id:   128,
 									name: "__",
 								},
 								&labeledExpr{
 									pos:   position{line: 85, col: 42, offset: 2140},
+									id:    129,
 									label: "expr",
 									expr: &ruleRefExpr{
 										pos:  position{line: 85, col: 47, offset: 2145},
+										id:   130,
 										name: "PrefixedExpr",
 									},
 								},
@@ -356,40 +438,57 @@ var g = &grammar{
 					},
 					&ruleRefExpr{
 						pos:  position{line: 91, col: 5, offset: 2318},
+						id:   131,
 						name: "PrefixedExpr",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x00\x00\x00\x01\x01\x01\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x01\x00\x00\x00\x02\x01\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080', 'ſ', 'ƀ', 'K', 'Å'},
+					rangeSets: "\x00\x02\x00\x02\x00",
+					alts:      [][]int{{}, {1}, {0, 1}},
+					expected:  [][]string{{"[a-z_]i", "\"&\"", "\"!\"", "\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "\"(\""}, {"[a-z_]i"}, {}},
+				},
 			},
 		},
 		{
 			name: "PrefixedExpr",
 			pos:  position{line: 93, col: 1, offset: 2332},
+			id:   8,
 			expr: &choiceExpr{
 				pos: position{line: 93, col: 16, offset: 2349},
+				id:  132,
 				alternatives: []any{
 					&actionExpr{
 						pos: position{line: 93, col: 16, offset: 2349},
+						id:  133,
 						run: (*parser).callonPrefixedExpr2,
 						expr: &seqExpr{
 							pos: position{line: 93, col: 16, offset: 2349},
+							id:  134,
 							exprs: []any{
 								&labeledExpr{
 									pos:   position{line: 93, col: 16, offset: 2349},
+									id:    135,
 									label: "op",
 									expr: &ruleRefExpr{
 										pos:  position{line: 93, col: 19, offset: 2352},
+										id:   136,
 										name: "PrefixedOp",
 									},
 								},
 								&ruleRefExpr{
 									pos:  position{line: 93, col: 30, offset: 2363},
+									id:   137,
 									name: "__",
 								},
 								&labeledExpr{
 									pos:   position{line: 93, col: 33, offset: 2366},
+									id:    138,
 									label: "expr",
 									expr: &ruleRefExpr{
 										pos:  position{line: 93, col: 38, offset: 2371},
+										id:   139,
 										name: "SuffixedExpr",
 									},
 								},
@@ -398,65 +497,94 @@ var g = &grammar{
 					},
 					&ruleRefExpr{
 						pos:  position{line: 104, col: 5, offset: 2653},
+						id:   140,
 						name: "SuffixedExpr",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x01\x02\x02\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080', 'ſ', 'ƀ', 'K', 'Å'},
+					rangeSets: "\x00\x02\x00\x02\x00",
+					alts:      [][]int{{}, {0, 1}, {1}},
+					expected:  [][]string{{"\"&\"", "\"!\"", "\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "[a-z_]i", "\"(\""}, {}, {"\"&\"", "\"!\""}},
+				},
 			},
 		},
 		{
 			name: "PrefixedOp",
 			pos:  position{line: 106, col: 1, offset: 2667},
+			id:   9,
 			expr: &actionExpr{
 				pos: position{line: 106, col: 14, offset: 2682},
+				id:  141,
 				run: (*parser).callonPrefixedOp1,
 				expr: &choiceExpr{
 					pos: position{line: 106, col: 16, offset: 2684},
+					id:  142,
 					alternatives: []any{
 						&litMatcher{
 							pos:        position{line: 106, col: 16, offset: 2684},
+							id:         143,
 							val:        "&",
 							ignoreCase: false,
 							want:       "\"&\"",
 						},
 						&litMatcher{
 							pos:        position{line: 106, col: 22, offset: 2690},
+							id:         144,
 							val:        "!",
 							ignoreCase: false,
 							want:       "\"!\"",
 						},
 					},
+					dispatch: &choiceDispatch{
+						ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+						ranges:    []rune{'\u0080'},
+						rangeSets: "\x00",
+						alts:      [][]int{{}, {1}, {0}},
+						expected:  [][]string{{"\"&\"", "\"!\""}, {"\"&\""}, {"\"!\""}},
+					},
 				},
 			},
 		},
 		{
 			name: "SuffixedExpr",
 			pos:  position{line: 110, col: 1, offset: 2732},
+			id:   10,
 			expr: &choiceExpr{
 				pos: position{line: 110, col: 16, offset: 2749},
+				id:  145,
 				alternatives: []any{
 					&actionExpr{
 						pos: position{line: 110, col: 16, offset: 2749},
+						id:  146,
 						run: (*parser).callonSuffixedExpr2,
 						expr: &seqExpr{
 							pos: position{line: 110, col: 16, offset: 2749},
+							id:  147,
 							exprs: []any{
 								&labeledExpr{
 									pos:   position{line: 110, col: 16, offset: 2749},
+									id:    148,
 									label: "expr",
 									expr: &ruleRefExpr{
 										pos:  position{line: 110, col: 21, offset: 2754},
+										id:   149,
 										name: "PrimaryExpr",
 									},
 								},
 								&ruleRefExpr{
 									pos:  position{line: 110, col: 33, offset: 2766},
+									id:   150,
 									name: "__",
 								},
 								&labeledExpr{
 									pos:   position{line: 110, col: 36, offset: 2769},
+									id:    151,
 									label: "op",
 									expr: &ruleRefExpr{
 										pos:  position{line: 110, col: 39, offset: 2772},
+										id:   152,
 										name: "SuffixedOp",
 									},
 								},
@@ -465,98 +593,134 @@ var g = &grammar{
 					},
 					&ruleRefExpr{
 						pos:  position{line: 129, col: 5, offset: 3302},
+						id:   153,
 						name: "PrimaryExpr",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x00\x00\x00\x01\x01\x01\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080', 'ſ', 'ƀ', 'K', 'Å'},
+					rangeSets: "\x00\x01\x00\x01\x00",
+					alts:      [][]int{{}, {0, 1}},
+					expected:  [][]string{{"\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "[a-z_]i", "\"&\"", "\"!\"", "\"(\""}, {}},
+				},
 			},
 		},
 		{
 			name: "SuffixedOp",
 			pos:  position{line: 131, col: 1, offset: 3316},
+			id:   11,
 			expr: &actionExpr{
 				pos: position{line: 131, col: 14, offset: 3331},
+				id:  154,
 				run: (*parser).callonSuffixedOp1,
 				expr: &choiceExpr{
 					pos: position{line: 131, col: 16, offset: 3333},
+					id:  155,
 					alternatives: []any{
 						&litMatcher{
 							pos:        position{line: 131, col: 16, offset: 3333},
+							id:         156,
 							val:        "?",
 							ignoreCase: false,
 							want:       "\"?\"",
 						},
 						&litMatcher{
 							pos:        position{line: 131, col: 22, offset: 3339},
+							id:         157,
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 						&litMatcher{
 							pos:        position{line: 131, col: 28, offset: 3345},
+							id:         158,
 							val:        "+",
 							ignoreCase: false,
 							want:       "\"+\"",
 						},
 					},
+					dispatch: &choiceDispatch{
+						ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+						ranges:    []rune{'\u0080'},
+						rangeSets: "\x00",
+						alts:      [][]int{{}, {1}, {2}, {0}},
+						expected:  [][]string{{"\"?\"", "\"*\"", "\"+\""}, {"\"?\"", "\"+\""}, {"\"?\"", "\"*\""}, {"\"*\"", "\"+\""}},
+					},
 				},
 			},
 		},
 		{
 			name: "PrimaryExpr",
 			pos:  position{line: 135, col: 1, offset: 3387},
+			id:   12,
 			expr: &choiceExpr{
 				pos: position{line: 135, col: 15, offset: 3403},
+				id:  159,
 				alternatives: []any{
 					&ruleRefExpr{
 						pos:  position{line: 135, col: 15, offset: 3403},
+						id:   160,
 						name: "LitMatcher",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 135, col: 28, offset: 3416},
+						id:   161,
 						name: "CharClassMatcher",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 135, col: 47, offset: 3435},
+						id:   162,
 						name: "AnyMatcher",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 135, col: 60, offset: 3448},
+						id:   163,
 						name: "RuleRefExpr",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 135, col: 74, offset: 3462},
+						id:   164,
 						name: "SemanticPredExpr",
 					},
 					&actionExpr{
 						pos: position{line: 135, col: 93, offset: 3481},
+						id:  165,
 						run: (*parser).callonPrimaryExpr7,
 						expr: &seqExpr{
 							pos: position{line: 135, col: 93, offset: 3481},
+							id:  166,
 							exprs: []any{
 								&litMatcher{
 									pos:        position{line: 135, col: 93, offset: 3481},
+									id:         167,
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
 									pos:  position{line: 135, col: 97, offset: 3485},
+									id:   168,
 									name: "__",
 								},
 								&labeledExpr{
 									pos:   position{line: 135, col: 100, offset: 3488},
+									id:    169,
 									label: "expr",
 									expr: &ruleRefExpr{
 										pos:  position{line: 135, col: 105, offset: 3493},
+										id:   170,
 										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
 									pos:  position{line: 135, col: 116, offset: 3504},
+									id:   171,
 									name: "__",
 								},
 								&litMatcher{
 									pos:        position{line: 135, col: 119, offset: 3507},
+									id:         172,
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -565,45 +729,64 @@ var g = &grammar{
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x01\x02\x03\x00\x00\x00\x00\x00\x04\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x06\x00\x00\x00\x05\x02\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x05\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080', 'ſ', 'ƀ', 'K', 'Å'},
+					rangeSets: "\x00\x05\x00\x05\x00",
+					alts:      [][]int{{}, {4}, {0}, {5}, {2}, {3}, {1}},
+					expected:  [][]string{{"\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "[a-z_]i", "\"&\"", "\"!\"", "\"(\""}, {"\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "[a-z_]i", "\"(\""}, {"\"[\"", "\".\"", "[a-z_]i", "\"&\"", "\"!\"", "\"(\""}, {"\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "[a-z_]i", "\"&\"", "\"!\""}, {"\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "[a-z_]i", "\"&\"", "\"!\"", "\"(\""}, {"\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "\"&\"", "\"!\"", "\"(\""}, {"\"\\\"\"", "\"'\"", "\"`\"", "\".\"", "[a-z_]i", "\"&\"", "\"!\"", "\"(\""}},
+				},
 			},
 		},
 		{
 			name: "RuleRefExpr",
 			pos:  position{line: 138, col: 1, offset: 3536},
+			id:   13,
 			expr: &actionExpr{
 				pos: position{line: 138, col: 15, offset: 3552},
+				id:  173,
 				run: (*parser).callonRuleRefExpr1,
 				expr: &seqExpr{
 					pos: position{line: 138, col: 15, offset: 3552},
+					id:  174,
 					exprs: []any{
 						&labeledExpr{
 							pos:   position{line: 138, col: 15, offset: 3552},
+							id:    175,
 							label: "name",
 							expr: &ruleRefExpr{
 								pos:  position{line: 138, col: 20, offset: 3557},
+								id:   176,
 								name: "IdentifierName",
 							},
 						},
 						&notExpr{
 							pos: position{line: 138, col: 35, offset: 3572},
+							id:  177,
 							expr: &seqExpr{
 								pos: position{line: 138, col: 38, offset: 3575},
+								id:  178,
 								exprs: []any{
 									&ruleRefExpr{
 										pos:  position{line: 138, col: 38, offset: 3575},
+										id:   179,
 										name: "__",
 									},
 									&zeroOrOneExpr{
 										pos: position{line: 138, col: 43, offset: 3580},
+										id:  180,
 										expr: &seqExpr{
 											pos: position{line: 138, col: 43, offset: 3580},
+											id:  181,
 											exprs: []any{
 												&ruleRefExpr{
 													pos:  position{line: 138, col: 43, offset: 3580},
+													id:   182,
 													name: "StringLiteral",
 												},
 												&ruleRefExpr{
 													pos:  position{line: 138, col: 57, offset: 3594},
+													id:   183,
 													name: "__",
 												},
 											},
@@ -611,6 +794,7 @@ var g = &grammar{
 									},
 									&ruleRefExpr{
 										pos:  position{line: 138, col: 63, offset: 3600},
+										id:   184,
 										name: "RuleDefOp",
 									},
 								},
@@ -623,29 +807,37 @@ var g = &grammar{
 		{
 			name: "SemanticPredExpr",
 			pos:  position{line: 143, col: 1, offset: 3716},
+			id:   14,
 			expr: &actionExpr{
 				pos: position{line: 143, col: 20, offset: 3737},
+				id:  185,
 				run: (*parser).callonSemanticPredExpr1,
 				expr: &seqExpr{
 					pos: position{line: 143, col: 20, offset: 3737},
+					id:  186,
 					exprs: []any{
 						&labeledExpr{
 							pos:   position{line: 143, col: 20, offset: 3737},
+							id:    187,
 							label: "op",
 							expr: &ruleRefExpr{
 								pos:  position{line: 143, col: 23, offset: 3740},
+								id:   188,
 								name: "SemanticPredOp",
 							},
 						},
 						&ruleRefExpr{
 							pos:  position{line: 143, col: 38, offset: 3755},
+							id:   189,
 							name: "__",
 						},
 						&labeledExpr{
 							pos:   position{line: 143, col: 41, offset: 3758},
+							id:    190,
 							label: "code",
 							expr: &ruleRefExpr{
 								pos:  position{line: 143, col: 46, offset: 3763},
+								id:   191,
 								name: "CodeBlock",
 							},
 						},
@@ -656,106 +848,151 @@ var g = &grammar{
 		{
 			name: "SemanticPredOp",
 			pos:  position{line: 154, col: 1, offset: 4040},
+			id:   15,
 			expr: &actionExpr{
 				pos: position{line: 154, col: 18, offset: 4059},
+				id:  192,
 				run: (*parser).callonSemanticPredOp1,
 				expr: &choiceExpr{
 					pos: position{line: 154, col: 20, offset: 4061},
+					id:  193,
 					alternatives: []any{
 						&litMatcher{
 							pos:        position{line: 154, col: 20, offset: 4061},
+							id:         194,
 							val:        "&",
 							ignoreCase: false,
 							want:       "\"&\"",
 						},
 						&litMatcher{
 							pos:        position{line: 154, col: 26, offset: 4067},
+							id:         195,
 							val:        "!",
 							ignoreCase: false,
 							want:       "\"!\"",
 						},
 					},
+					dispatch: &choiceDispatch{
+						ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+						ranges:    []rune{'\u0080'},
+						rangeSets: "\x00",
+						alts:      [][]int{{}, {1}, {0}},
+						expected:  [][]string{{"\"&\"", "\"!\""}, {"\"&\""}, {"\"!\""}},
+					},
 				},
 			},
 		},
 		{
 			name: "RuleDefOp",
 			pos:  position{line: 158, col: 1, offset: 4109},
+			id:   16,
 			expr: &choiceExpr{
 				pos: position{line: 158, col: 13, offset: 4123},
+				id:  196,
 				alternatives: []any{
 					&litMatcher{
 						pos:        position{line: 158, col: 13, offset: 4123},
+						id:         197,
 						val:        "=",
 						ignoreCase: false,
 						want:       "\"=\"",
 					},
 					&litMatcher{
 						pos:        position{line: 158, col: 19, offset: 4129},
+						id:         198,
 						val:        "<-",
 						ignoreCase: false,
 						want:       "\"<-\"",
 					},
 					&litMatcher{
 						pos:        position{line: 158, col: 26, offset: 4136},
+						id:         199,
 						val:        "←",
 						ignoreCase: false,
 						want:       "\"←\"",
 					},
 					&litMatcher{
 						pos:        position{line: 158, col: 37, offset: 4147},
+						id:         200,
 						val:        "⟵",
 						ignoreCase: false,
 						want:       "\"⟵\"",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080', 'â', 'ã', '←', '↑', '⟵', '⟶'},
+					rangeSets: "\x00\x03\x00\x04\x00\x05\x00",
+					alts:      [][]int{{}, {1}, {0}, {2, 3}, {2}, {3}},
+					expected:  [][]string{{"\"=\"", "\"<-\"", "\"←\"", "\"⟵\""}, {"\"=\"", "\"←\"", "\"⟵\""}, {"\"<-\"", "\"←\"", "\"⟵\""}, {"\"=\"", "\"<-\""}, {"\"=\"", "\"<-\"", "\"⟵\""}, {"\"=\"", "\"<-\"", "\"←\""}},
+				},
 			},
 		},
 		{
 			name: "SourceChar",
 			pos:  position{line: 160, col: 1, offset: 4157},
+			id:   17,
 			expr: &anyMatcher{
-				line: 160, col: 14, offset: 4172,
+				pos: position{line: 160, col: 14, offset: 4172},
+				id:  201,
 			},
 		},
 		{
 			name: "Comment",
 			pos:  position{line: 161, col: 1, offset: 4174},
+			id:   18,
 			expr: &choiceExpr{
 				pos: position{line: 161, col: 11, offset: 4186},
+				id:  202,
 				alternatives: []any{
 					&ruleRefExpr{
 						pos:  position{line: 161, col: 11, offset: 4186},
+						id:   203,
 						name: "MultiLineComment",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 161, col: 30, offset: 4205},
+						id:   204,
 						name: "SingleLineComment",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {0, 1}},
+					expected:  [][]string{{"\"/*\"", "\"//\""}, {}},
+				},
 			},
 		},
 		{
 			name: "MultiLineComment",
 			pos:  position{line: 162, col: 1, offset: 4223},
+			id:   19,
 			expr: &seqExpr{
 				pos: position{line: 162, col: 20, offset: 4244},
+				id:  205,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 162, col: 20, offset: 4244},
+						id:         206,
 						val:        "/*",
 						ignoreCase: false,
 						want:       "\"/*\"",
 					},
 					&zeroOrMoreExpr{
 						pos: position{line: 162, col: 27, offset: 4251},
+						id:  207,
 						expr: &seqExpr{
 							pos: position{line: 162, col: 27, offset: 4251},
+							id:  208,
 							exprs: []any{
 								&notExpr{
 									pos: position{line: 162, col: 27, offset: 4251},
+									id:  209,
 									expr: &litMatcher{
 										pos:        position{line: 162, col: 28, offset: 4252},
+										id:         210,
 										val:        "*/",
 										ignoreCase: false,
 										want:       "\"*/\"",
@@ -763,6 +1000,7 @@ var g = &grammar{
 								},
 								&ruleRefExpr{
 									pos:  position{line: 162, col: 33, offset: 4257},
+									id:   211,
 									name: "SourceChar",
 								},
 							},
@@ -770,6 +1008,7 @@ var g = &grammar{
 					},
 					&litMatcher{
 						pos:        position{line: 162, col: 47, offset: 4271},
+						id:         212,
 						val:        "*/",
 						ignoreCase: false,
 						want:       "\"*/\"",
@@ -780,40 +1019,57 @@ var g = &grammar{
 		{
 			name: "MultiLineCommentNoLineTerminator",
 			pos:  position{line: 163, col: 1, offset: 4276},
+			id:   20,
 			expr: &seqExpr{
 				pos: position{line: 163, col: 36, offset: 4313},
+				id:  213,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 163, col: 36, offset: 4313},
+						id:         214,
 						val:        "/*",
 						ignoreCase: false,
 						want:       "\"/*\"",
 					},
 					&zeroOrMoreExpr{
 						pos: position{line: 163, col: 43, offset: 4320},
+						id:  215,
 						expr: &seqExpr{
 							pos: position{line: 163, col: 43, offset: 4320},
+							id:  216,
 							exprs: []any{
 								&notExpr{
 									pos: position{line: 163, col: 43, offset: 4320},
+									id:  217,
 									expr: &choiceExpr{
 										pos: position{line: 163, col: 46, offset: 4323},
+										id:  218,
 										alternatives: []any{
 											&litMatcher{
 												pos:        position{line: 163, col: 46, offset: 4323},
+												id:         219,
 												val:        "*/",
 												ignoreCase: false,
 												want:       "\"*/\"",
 											},
 											&ruleRefExpr{
 												pos:  position{line: 163, col: 53, offset: 4330},
+												id:   220,
 												name: "EOL",
 											},
 										},
+										dispatch: &choiceDispatch{
+											ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+											ranges:    []rune{'\u0080'},
+											rangeSets: "\x00",
+											alts:      [][]int{{}, {1}, {0}},
+											expected:  [][]string{{"\"*/\"", "\"\\n\""}, {"\"*/\""}, {"\"\\n\""}},
+										},
 									},
 								},
 								&ruleRefExpr{
 									pos:  position{line: 163, col: 59, offset: 4336},
+									id:   221,
 									name: "SourceChar",
 								},
 							},
@@ -821,6 +1077,7 @@ var g = &grammar{
 					},
 					&litMatcher{
 						pos:        position{line: 163, col: 73, offset: 4350},
+						id:         222,
 						val:        "*/",
 						ignoreCase: false,
 						want:       "\"*/\"",
@@ -831,29 +1088,37 @@ var g = &grammar{
 		{
 			name: "SingleLineComment",
 			pos:  position{line: 164, col: 1, offset: 4355},
+			id:   21,
 			expr: &seqExpr{
 				pos: position{line: 164, col: 21, offset: 4377},
+				id:  223,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 164, col: 21, offset: 4377},
+						id:         224,
 						val:        "//",
 						ignoreCase: false,
 						want:       "\"//\"",
 					},
 					&zeroOrMoreExpr{
 						pos: position{line: 164, col: 28, offset: 4384},
+						id:  225,
 						expr: &seqExpr{
 							pos: position{line: 164, col: 28, offset: 4384},
+							id:  226,
 							exprs: []any{
 								&notExpr{
 									pos: position{line: 164, col: 28, offset: 4384},
+									id:  227,
 									expr: &ruleRefExpr{
 										pos:  position{line: 164, col: 29, offset: 4385},
+										id:   228,
 										name: "EOL",
 									},
 								},
 								&ruleRefExpr{
 									pos:  position{line: 164, col: 33, offset: 4389},
+									id:   229,
 									name: "SourceChar",
 								},
 							},
@@ -865,28 +1130,36 @@ var g = &grammar{
 		{
 			name: "Identifier",
 			pos:  position{line: 166, col: 1, offset: 4404},
+			id:   22,
 			expr: &ruleRefExpr{
 				pos:  position{line: 166, col: 14, offset: 4419},
+				id:   230,
 				name: "IdentifierName",
 			},
 		},
 		{
 			name: "IdentifierName",
 			pos:  position{line: 167, col: 1, offset: 4434},
+			id:   23,
 			expr: &actionExpr{
 				pos: position{line: 167, col: 18, offset: 4453},
+				id:  231,
 				run: (*parser).callonIdentifierName1,
 				expr: &seqExpr{
 					pos: position{line: 167, col: 18, offset: 4453},
+					id:  232,
 					exprs: []any{
 						&ruleRefExpr{
 							pos:  position{line: 167, col: 18, offset: 4453},
+							id:   233,
 							name: "IdentifierStart",
 						},
 						&zeroOrMoreExpr{
 							pos: position{line: 167, col: 34, offset: 4469},
+							id:  234,
 							expr: &ruleRefExpr{
 								pos:  position{line: 167, col: 34, offset: 4469},
+								id:   235,
 								name: "IdentifierPart",
 							},
 						},
@@ -897,8 +1170,10 @@ var g = &grammar{
 		{
 			name: "IdentifierStart",
 			pos:  position{line: 170, col: 1, offset: 4551},
+			id:   24,
 			expr: &charClassMatcher{
 				pos:        position{line: 170, col: 19, offset: 4571},
+				id:         236,
 				val:        "[a-z_]i",
 				chars:      []rune{'_'},
 				ranges:     []rune{'a', 'z'},
@@ -909,47 +1184,66 @@ var g = &grammar{
 		{
 			name: "IdentifierPart",
 			pos:  position{line: 171, col: 1, offset: 4579},
+			id:   25,
 			expr: &choiceExpr{
 				pos: position{line: 171, col: 18, offset: 4598},
+				id:  237,
 				alternatives: []any{
 					&ruleRefExpr{
 						pos:  position{line: 171, col: 18, offset: 4598},
+						id:   238,
 						name: "IdentifierStart",
 					},
 					&charClassMatcher{
 						pos:        position{line: 171, col: 36, offset: 4616},
+						id:         239,
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x02\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080', 'ſ', 'ƀ', 'K', 'Å'},
+					rangeSets: "\x00\x02\x00\x02\x00",
+					alts:      [][]int{{}, {1}, {0}},
+					expected:  [][]string{{"[a-z_]i", "[0-9]"}, {"[a-z_]i"}, {"[0-9]"}},
+				},
 			},
 		},
 		{
 			name: "LitMatcher",
 			pos:  position{line: 173, col: 1, offset: 4623},
+			id:   26,
 			expr: &actionExpr{
 				pos: position{line: 173, col: 14, offset: 4638},
+				id:  240,
 				run: (*parser).callonLitMatcher1,
 				expr: &seqExpr{
 					pos: position{line: 173, col: 14, offset: 4638},
+					id:  241,
 					exprs: []any{
 						&labeledExpr{
 							pos:   position{line: 173, col: 14, offset: 4638},
+							id:    242,
 							label: "lit",
 							expr: &ruleRefExpr{
 								pos:  position{line: 173, col: 18, offset: 4642},
+								id:   243,
 								name: "StringLiteral",
 							},
 						},
 						&labeledExpr{
 							pos:   position{line: 173, col: 32, offset: 4656},
+							id:    244,
 							label: "ignore",
 							expr: &zeroOrOneExpr{
 								pos: position{line: 173, col: 39, offset: 4663},
+								id:  245,
 								expr: &litMatcher{
 									pos:        position{line: 173, col: 39, offset: 4663},
+									id:         246,
 									val:        "i",
 									ignoreCase: false,
 									want:       "\"i\"",
@@ -963,30 +1257,38 @@ var g = &grammar{
 		{
 			name: "StringLiteral",
 			pos:  position{line: 183, col: 1, offset: 4889},
+			id:   27,
 			expr: &actionExpr{
 				pos: position{line: 183, col: 17, offset: 4907},
+				id:  247,
 				run: (*parser).callonStringLiteral1,
 				expr: &choiceExpr{
 					pos: position{line: 183, col: 19, offset: 4909},
+					id:  248,
 					alternatives: []any{
 						&seqExpr{
 							pos: position{line: 183, col: 19, offset: 4909},
+							id:  249,
 							exprs: []any{
 								&litMatcher{
 									pos:        position{line: 183, col: 19, offset: 4909},
+									id:         250,
 									val:        "\"",
 									ignoreCase: false,
 									want:       "\"\\\"\"",
 								},
 								&zeroOrMoreExpr{
 									pos: position{line: 183, col: 23, offset: 4913},
+									id:  251,
 									expr: &ruleRefExpr{
 										pos:  position{line: 183, col: 23, offset: 4913},
+										id:   252,
 										name: "DoubleStringChar",
 									},
 								},
 								&litMatcher{
 									pos:        position{line: 183, col: 41, offset: 4931},
+									id:         253,
 									val:        "\"",
 									ignoreCase: false,
 									want:       "\"\\\"\"",
@@ -995,19 +1297,23 @@ var g = &grammar{
 						},
 						&seqExpr{
 							pos: position{line: 183, col: 47, offset: 4937},
+							id:  254,
 							exprs: []any{
 								&litMatcher{
 									pos:        position{line: 183, col: 47, offset: 4937},
+									id:         255,
 									val:        "'",
 									ignoreCase: false,
 									want:       "\"'\"",
 								},
 								&ruleRefExpr{
 									pos:  position{line: 183, col: 51, offset: 4941},
+									id:   256,
 									name: "SingleStringChar",
 								},
 								&litMatcher{
 									pos:        position{line: 183, col: 68, offset: 4958},
+									id:         257,
 									val:        "'",
 									ignoreCase: false,
 									want:       "\"'\"",
@@ -1016,22 +1322,27 @@ var g = &grammar{
 						},
 						&seqExpr{
 							pos: position{line: 183, col: 74, offset: 4964},
+							id:  258,
 							exprs: []any{
 								&litMatcher{
 									pos:        position{line: 183, col: 74, offset: 4964},
+									id:         259,
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
 								},
 								&zeroOrMoreExpr{
 									pos: position{line: 183, col: 78, offset: 4968},
+									id:  260,
 									expr: &ruleRefExpr{
 										pos:  position{line: 183, col: 78, offset: 4968},
+										id:   261,
 										name: "RawStringChar",
 									},
 								},
 								&litMatcher{
 									pos:        position{line: 183, col: 93, offset: 4983},
+									id:         262,
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -1039,133 +1350,196 @@ var g = &grammar{
 							},
 						},
 					},
+					dispatch: &choiceDispatch{
+						ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+						ranges:    []rune{'\u0080'},
+						rangeSets: "\x00",
+						alts:      [][]int{{}, {0}, {1}, {2}},
+						expected:  [][]string{{"\"\\\"\"", "\"'\"", "\"`\""}, {"\"'\"", "\"`\""}, {"\"\\\"\"", "\"`\""}, {"\"\\\"\"", "\"'\""}},
+					},
 				},
 			},
 		},
 		{
 			name: "DoubleStringChar",
 			pos:  position{line: 186, col: 1, offset: 5054},
+			id:   28,
 			expr: &choiceExpr{
 				pos: position{line: 186, col: 20, offset: 5075},
+				id:  263,
 				alternatives: []any{
 					&seqExpr{
 						pos: position{line: 186, col: 20, offset: 5075},
+						id:  264,
 						exprs: []any{
 							&notExpr{
 								pos: position{line: 186, col: 20, offset: 5075},
+								id:  265,
 								expr: &choiceExpr{
 									pos: position{line: 186, col: 23, offset: 5078},
+									id:  266,
 									alternatives: []any{
 										&litMatcher{
 											pos:        position{line: 186, col: 23, offset: 5078},
+											id:         267,
 											val:        "\"",
 											ignoreCase: false,
 											want:       "\"\\\"\"",
 										},
 										&litMatcher{
 											pos:        position{line: 186, col: 29, offset: 5084},
+											id:         268,
 											val:        "\\",
 											ignoreCase: false,
 											want:       "\"\\\\\"",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 186, col: 36, offset: 5091},
+											id:   269,
 											name: "EOL",
 										},
 									},
+									dispatch: &choiceDispatch{
+										ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x00",
+										alts:      [][]int{{}, {2}, {0}, {1}},
+										expected:  [][]string{{"\"\\\"\"", "\"\\\\\"", "\"\\n\""}, {"\"\\\"\"", "\"\\\\\""}, {"\"\\\\\"", "\"\\n\""}, {"\"\\\"\"", "\"\\n\""}},
+									},
 								},
 							},
 							&ruleRefExpr{
 								pos:  position{line: 186, col: 42, offset: 5097},
+								id:   270,
 								name: "SourceChar",
 							},
 						},
 					},
 					&seqExpr{
 						pos: position{line: 186, col: 55, offset: 5110},
+						id:  271,
 						exprs: []any{
 							&litMatcher{
 								pos:        position{line: 186, col: 55, offset: 5110},
+								id:         272,
 								val:        "\\",
 								ignoreCase: false,
 								want:       "\"\\\\\"",
 							},
 							&ruleRefExpr{
 								pos:  position{line: 186, col: 60, offset: 5115},
+								id:   273,
 								name: "DoubleStringEscape",
 							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{0}, {0, 1}},
+					expected:  [][]string{{"\"\\\\\""}, {}},
+				},
 			},
 		},
 		{
 			name: "SingleStringChar",
 			pos:  position{line: 187, col: 1, offset: 5134},
+			id:   29,
 			expr: &choiceExpr{
 				pos: position{line: 187, col: 20, offset: 5155},
+				id:  274,
 				alternatives: []any{
 					&seqExpr{
 						pos: position{line: 187, col: 20, offset: 5155},
+						id:  275,
 						exprs: []any{
 							&notExpr{
 								pos: position{line: 187, col: 20, offset: 5155},
+								id:  276,
 								expr: &choiceExpr{
 									pos: position{line: 187, col: 23, offset: 5158},
+									id:  277,
 									alternatives: []any{
 										&litMatcher{
 											pos:        position{line: 187, col: 23, offset: 5158},
+											id:         278,
 											val:        "'",
 											ignoreCase: false,
 											want:       "\"'\"",
 										},
 										&litMatcher{
 											pos:        position{line: 187, col: 29, offset: 5164},
+											id:         279,
 											val:        "\\",
 											ignoreCase: false,
 											want:       "\"\\\\\"",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 187, col: 36, offset: 5171},
+											id:   280,
 											name: "EOL",
 										},
 									},
+									dispatch: &choiceDispatch{
+										ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x00",
+										alts:      [][]int{{}, {2}, {0}, {1}},
+										expected:  [][]string{{"\"'\"", "\"\\\\\"", "\"\\n\""}, {"\"'\"", "\"\\\\\""}, {"\"\\\\\"", "\"\\n\""}, {"\"'\"", "\"\\n\""}},
+									},
 								},
 							},
 							&ruleRefExpr{
 								pos:  position{line: 187, col: 42, offset: 5177},
+								id:   281,
 								name: "SourceChar",
 							},
 						},
 					},
 					&seqExpr{
 						pos: position{line: 187, col: 55, offset: 5190},
+						id:  282,
 						exprs: []any{
 							&litMatcher{
 								pos:        position{line: 187, col: 55, offset: 5190},
+								id:         283,
 								val:        "\\",
 								ignoreCase: false,
 								want:       "\"\\\\\"",
 							},
 							&ruleRefExpr{
 								pos:  position{line: 187, col: 60, offset: 5195},
+								id:   284,
 								name: "SingleStringEscape",
 							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{0}, {0, 1}},
+					expected:  [][]string{{"\"\\\\\""}, {}},
+				},
 			},
 		},
 		{
 			name: "RawStringChar",
 			pos:  position{line: 188, col: 1, offset: 5214},
+			id:   30,
 			expr: &seqExpr{
 				pos: position{line: 188, col: 17, offset: 5232},
+				id:  285,
 				exprs: []any{
 					&notExpr{
 						pos: position{line: 188, col: 17, offset: 5232},
+						id:  286,
 						expr: &litMatcher{
 							pos:        position{line: 188, col: 18, offset: 5233},
+							id:         287,
 							val:        "`",
 							ignoreCase: false,
 							want:       "\"`\"",
@@ -1173,6 +1547,7 @@ var g = &grammar{
 					},
 					&ruleRefExpr{
 						pos:  position{line: 188, col: 22, offset: 5237},
+						id:   288,
 						name: "SourceChar",
 					},
 				},
@@ -1181,143 +1556,201 @@ var g = &grammar{
 		{
 			name: "DoubleStringEscape",
 			pos:  position{line: 190, col: 1, offset: 5249},
+			id:   31,
 			expr: &choiceExpr{
 				pos: position{line: 190, col: 22, offset: 5272},
+				id:  289,
 				alternatives: []any{
 					&litMatcher{
 						pos:        position{line: 190, col: 22, offset: 5272},
+						id:         290,
 						val:        "\"",
 						ignoreCase: false,
 						want:       "\"\\\"\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 190, col: 28, offset: 5278},
+						id:   291,
 						name: "CommonEscapeSequence",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x02\x02\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x02\x00\x02\x02\x02\x00\x02\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {0}, {1}},
+					expected:  [][]string{{"\"\\\"\"", "\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}, {"\"\\\"\""}},
+				},
 			},
 		},
 		{
 			name: "SingleStringEscape",
 			pos:  position{line: 191, col: 1, offset: 5299},
+			id:   32,
 			expr: &choiceExpr{
 				pos: position{line: 191, col: 22, offset: 5322},
+				id:  292,
 				alternatives: []any{
 					&litMatcher{
 						pos:        position{line: 191, col: 22, offset: 5322},
+						id:         293,
 						val:        "'",
 						ignoreCase: false,
 						want:       "\"'\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 191, col: 28, offset: 5328},
+						id:   294,
 						name: "CommonEscapeSequence",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x02\x02\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x02\x00\x02\x02\x02\x00\x02\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {0}, {1}},
+					expected:  [][]string{{"\"'\"", "\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}, {"\"'\""}},
+				},
 			},
 		},
 		{
 			name: "CommonEscapeSequence",
 			pos:  position{line: 193, col: 1, offset: 5350},
+			id:   33,
 			expr: &choiceExpr{
 				pos: position{line: 193, col: 24, offset: 5375},
+				id:  295,
 				alternatives: []any{
 					&ruleRefExpr{
 						pos:  position{line: 193, col: 24, offset: 5375},
+						id:   296,
 						name: "SingleCharEscape",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 193, col: 43, offset: 5394},
+						id:   297,
 						name: "OctalEscape",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 193, col: 57, offset: 5408},
+						id:   298,
 						name: "HexEscape",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 193, col: 69, offset: 5420},
+						id:   299,
 						name: "LongUnicodeEscape",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 193, col: 89, offset: 5440},
+						id:   300,
 						name: "ShortUnicodeEscape",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x03\x03\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x03\x00\x03\x04\x03\x00\x05\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {1}, {3}, {0}, {4}, {2}},
+					expected:  [][]string{{"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "\"x\"", "\"U\"", "\"u\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"u\""}, {"[0-7]", "\"x\"", "\"U\"", "\"u\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"U\"", "\"u\""}},
+				},
 			},
 		},
 		{
 			name: "SingleCharEscape",
 			pos:  position{line: 194, col: 1, offset: 5459},
+			id:   34,
 			expr: &choiceExpr{
 				pos: position{line: 194, col: 20, offset: 5480},
+				id:  301,
 				alternatives: []any{
 					&litMatcher{
 						pos:        position{line: 194, col: 20, offset: 5480},
+						id:         302,
 						val:        "a",
 						ignoreCase: false,
 						want:       "\"a\"",
 					},
 					&litMatcher{
 						pos:        position{line: 194, col: 26, offset: 5486},
+						id:         303,
 						val:        "b",
 						ignoreCase: false,
 						want:       "\"b\"",
 					},
 					&litMatcher{
 						pos:        position{line: 194, col: 32, offset: 5492},
+						id:         304,
 						val:        "n",
 						ignoreCase: false,
 						want:       "\"n\"",
 					},
 					&litMatcher{
 						pos:        position{line: 194, col: 38, offset: 5498},
+						id:         305,
 						val:        "f",
 						ignoreCase: false,
 						want:       "\"f\"",
 					},
 					&litMatcher{
 						pos:        position{line: 194, col: 44, offset: 5504},
+						id:         306,
 						val:        "r",
 						ignoreCase: false,
 						want:       "\"r\"",
 					},
 					&litMatcher{
 						pos:        position{line: 194, col: 50, offset: 5510},
+						id:         307,
 						val:        "t",
 						ignoreCase: false,
 						want:       "\"t\"",
 					},
 					&litMatcher{
 						pos:        position{line: 194, col: 56, offset: 5516},
+						id:         308,
 						val:        "v",
 						ignoreCase: false,
 						want:       "\"v\"",
 					},
 					&litMatcher{
 						pos:        position{line: 194, col: 62, offset: 5522},
+						id:         309,
 						val:        "\\",
 						ignoreCase: false,
 						want:       "\"\\\\\"",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x02\x03\x00\x00\x00\x04\x00\x00\x00\x00\x00\x00\x00\x05\x00\x00\x00\x06\x00\a\x00\b\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {7}, {0}, {1}, {3}, {2}, {4}, {5}, {6}},
+					expected:  [][]string{{"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\""}, {"\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\""}, {"\"a\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\""}, {"\"a\"", "\"b\"", "\"n\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\""}, {"\"a\"", "\"b\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"t\"", "\"v\"", "\"\\\\\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"v\"", "\"\\\\\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"\\\\\""}},
+				},
 			},
 		},
 		{
 			name: "OctalEscape",
 			pos:  position{line: 195, col: 1, offset: 5527},
+			id:   35,
 			expr: &seqExpr{
 				pos: position{line: 195, col: 15, offset: 5543},
+				id:  310,
 				exprs: []any{
 					&ruleRefExpr{
 						pos:  position{line: 195, col: 15, offset: 5543},
+						id:   311,
 						name: "OctalDigit",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 195, col: 26, offset: 5554},
+						id:   312,
 						name: "OctalDigit",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 195, col: 37, offset: 5565},
+						id:   313,
 						name: "OctalDigit",
 					},
 				},
@@ -1326,21 +1759,26 @@ var g = &grammar{
 		{
 			name: "HexEscape",
 			pos:  position{line: 196, col: 1, offset: 5576},
+			id:   36,
 			expr: &seqExpr{
 				pos: position{line: 196, col: 13, offset: 5590},
+				id:  314,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 196, col: 13, offset: 5590},
+						id:         315,
 						val:        "x",
 						ignoreCase: false,
 						want:       "\"x\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 196, col: 17, offset: 5594},
+						id:   316,
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 196, col: 26, offset: 5603},
+						id:   317,
 						name: "HexDigit",
 					},
 				},
@@ -1349,45 +1787,56 @@ var g = &grammar{
 		{
 			name: "LongUnicodeEscape",
 			pos:  position{line: 197, col: 1, offset: 5612},
+			id:   37,
 			expr: &seqExpr{
 				pos: position{line: 197, col: 21, offset: 5634},
+				id:  318,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 197, col: 21, offset: 5634},
+						id:         319,
 						val:        "U",
 						ignoreCase: false,
 						want:       "\"U\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 197, col: 25, offset: 5638},
+						id:   320,
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 197, col: 34, offset: 5647},
+						id:   321,
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 197, col: 43, offset: 5656},
+						id:   322,
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 197, col: 52, offset: 5665},
+						id:   323,
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 197, col: 61, offset: 5674},
+						id:   324,
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 197, col: 70, offset: 5683},
+						id:   325,
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 197, col: 79, offset: 5692},
+						id:   326,
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 197, col: 88, offset: 5701},
+						id:   327,
 						name: "HexDigit",
 					},
 				},
@@ -1396,29 +1845,36 @@ var g = &grammar{
 		{
 			name: "ShortUnicodeEscape",
 			pos:  position{line: 198, col: 1, offset: 5710},
+			id:   38,
 			expr: &seqExpr{
 				pos: position{line: 198, col: 22, offset: 5733},
+				id:  328,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 198, col: 22, offset: 5733},
+						id:         329,
 						val:        "u",
 						ignoreCase: false,
 						want:       "\"u\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 198, col: 26, offset: 5737},
+						id:   330,
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 198, col: 35, offset: 5746},
+						id:   331,
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 198, col: 44, offset: 5755},
+						id:   332,
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 198, col: 53, offset: 5764},
+						id:   333,
 						name: "HexDigit",
 					},
 				},
@@ -1427,8 +1883,10 @@ var g = &grammar{
 		{
 			name: "OctalDigit",
 			pos:  position{line: 200, col: 1, offset: 5774},
+			id:   39,
 			expr: &charClassMatcher{
 				pos:        position{line: 200, col: 14, offset: 5789},
+				id:         334,
 				val:        "[0-7]",
 				ranges:     []rune{'0', '7'},
 				ignoreCase: false,
@@ -1438,8 +1896,10 @@ var g = &grammar{
 		{
 			name: "DecimalDigit",
 			pos:  position{line: 201, col: 1, offset: 5795},
+			id:   40,
 			expr: &charClassMatcher{
 				pos:        position{line: 201, col: 16, offset: 5812},
+				id:         335,
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -1449,8 +1909,10 @@ var g = &grammar{
 		{
 			name: "HexDigit",
 			pos:  position{line: 202, col: 1, offset: 5818},
+			id:   41,
 			expr: &charClassMatcher{
 				pos:        position{line: 202, col: 12, offset: 5831},
+				id:         336,
 				val:        "[0-9a-f]i",
 				ranges:     []rune{'0', '9', 'a', 'f'},
 				ignoreCase: true,
@@ -1460,59 +1922,80 @@ var g = &grammar{
 		{
 			name: "CharClassMatcher",
 			pos:  position{line: 204, col: 1, offset: 5842},
+			id:   42,
 			expr: &actionExpr{
 				pos: position{line: 204, col: 20, offset: 5863},
+				id:  337,
 				run: (*parser).callonCharClassMatcher1,
 				expr: &seqExpr{
 					pos: position{line: 204, col: 20, offset: 5863},
+					id:  338,
 					exprs: []any{
 						&litMatcher{
 							pos:        position{line: 204, col: 20, offset: 5863},
+							id:         339,
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&zeroOrMoreExpr{
 							pos: position{line: 204, col: 26, offset: 5869},
+							id:  340,
 							expr: &choiceExpr{
 								pos: position{line: 204, col: 26, offset: 5869},
+								id:  341,
 								alternatives: []any{
 									&ruleRefExpr{
 										pos:  position{line: 204, col: 26, offset: 5869},
+										id:   342,
 										name: "ClassCharRange",
 									},
 									&ruleRefExpr{
 										pos:  position{line: 204, col: 43, offset: 5886},
+										id:   343,
 										name: "ClassChar",
 									},
 									&seqExpr{
 										pos: position{line: 204, col: 55, offset: 5898},
+										id:  344,
 										exprs: []any{
 											&litMatcher{
 												pos:        position{line: 204, col: 55, offset: 5898},
+												id:         345,
 												val:        "\\",
 												ignoreCase: false,
 												want:       "\"\\\\\"",
 											},
 											&ruleRefExpr{
 												pos:  position{line: 204, col: 60, offset: 5903},
+												id:   346,
 												name: "UnicodeClassEscape",
 											},
 										},
 									},
 								},
+								dispatch: &choiceDispatch{
+									ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+									ranges:    []rune{'\u0080'},
+									rangeSets: "\x00",
+									alts:      [][]int{{0, 1}, {0, 1, 2}},
+									expected:  [][]string{{"\"\\\\\""}, {}},
+								},
 							},
 						},
 						&litMatcher{
 							pos:        position{line: 204, col: 82, offset: 5925},
+							id:         347,
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrOneExpr{
 							pos: position{line: 204, col: 86, offset: 5929},
+							id:  348,
 							expr: &litMatcher{
 								pos:        position{line: 204, col: 86, offset: 5929},
+								id:         349,
 								val:        "i",
 								ignoreCase: false,
 								want:       "\"i\"",
@@ -1525,21 +2008,26 @@ var g = &grammar{
 		{
 			name: "ClassCharRange",
 			pos:  position{line: 209, col: 1, offset: 6034},
+			id:   43,
 			expr: &seqExpr{
 				pos: position{line: 209, col: 18, offset: 6053},
+				id:  350,
 				exprs: []any{
 					&ruleRefExpr{
 						pos:  position{line: 209, col: 18, offset: 6053},
+						id:   351,
 						name: "ClassChar",
 					},
 					&litMatcher{
 						pos:        position{line: 209, col: 28, offset: 6063},
+						id:         352,
 						val:        "-",
 						ignoreCase: false,
 						want:       "\"-\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 209, col: 32, offset: 6067},
+						id:   353,
 						name: "ClassChar",
 					},
 				},
@@ -1548,113 +2036,159 @@ var g = &grammar{
 		{
 			name: "ClassChar",
 			pos:  position{line: 210, col: 1, offset: 6077},
+			id:   44,
 			expr: &choiceExpr{
 				pos: position{line: 210, col: 13, offset: 6091},
+				id:  354,
 				alternatives: []any{
 					&seqExpr{
 						pos: position{line: 210, col: 13, offset: 6091},
+						id:  355,
 						exprs: []any{
 							&notExpr{
 								pos: position{line: 210, col: 13, offset: 6091},
+								id:  356,
 								expr: &choiceExpr{
 									pos: position{line: 210, col: 16, offset: 6094},
+									id:  357,
 									alternatives: []any{
 										&litMatcher{
 											pos:        position{line: 210, col: 16, offset: 6094},
+											id:         358,
 											val:        "]",
 											ignoreCase: false,
 											want:       "\"]\"",
 										},
 										&litMatcher{
 											pos:        position{line: 210, col: 22, offset: 6100},
+											id:         359,
 											val:        "\\",
 											ignoreCase: false,
 											want:       "\"\\\\\"",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 210, col: 29, offset: 6107},
+											id:   360,
 											name: "EOL",
 										},
 									},
+									dispatch: &choiceDispatch{
+										ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x00",
+										alts:      [][]int{{}, {2}, {1}, {0}},
+										expected:  [][]string{{"\"]\"", "\"\\\\\"", "\"\\n\""}, {"\"]\"", "\"\\\\\""}, {"\"]\"", "\"\\n\""}, {"\"\\\\\"", "\"\\n\""}},
+									},
 								},
 							},
 							&ruleRefExpr{
 								pos:  position{line: 210, col: 35, offset: 6113},
+								id:   361,
 								name: "SourceChar",
 							},
 						},
 					},
 					&seqExpr{
 						pos: position{line: 210, col: 48, offset: 6126},
+						id:  362,
 						exprs: []any{
 							&litMatcher{
 								pos:        position{line: 210, col: 48, offset: 6126},
+								id:         363,
 								val:        "\\",
 								ignoreCase: false,
 								want:       "\"\\\\\"",
 							},
 							&ruleRefExpr{
 								pos:  position{line: 210, col: 53, offset: 6131},
+								id:   364,
 								name: "CharClassEscape",
 							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{0}, {0, 1}},
+					expected:  [][]string{{"\"\\\\\""}, {}},
+				},
 			},
 		},
 		{
 			name: "CharClassEscape",
 			pos:  position{line: 211, col: 1, offset: 6147},
+			id:   45,
 			expr: &choiceExpr{
 				pos: position{line: 211, col: 19, offset: 6167},
+				id:  365,
 				alternatives: []any{
 					&litMatcher{
 						pos:        position{line: 211, col: 19, offset: 6167},
+						id:         366,
 						val:        "]",
 						ignoreCase: false,
 						want:       "\"]\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 211, col: 25, offset: 6173},
+						id:   367,
 						name: "CommonEscapeSequence",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x01\x01\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01\x00\x01\x01\x01\x00\x01\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {1}, {0}},
+					expected:  [][]string{{"\"]\"", "\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}, {"\"]\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}},
+				},
 			},
 		},
 		{
 			name: "UnicodeClassEscape",
 			pos:  position{line: 213, col: 1, offset: 6195},
+			id:   46,
 			expr: &seqExpr{
 				pos: position{line: 213, col: 22, offset: 6218},
+				id:  368,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 213, col: 22, offset: 6218},
+						id:         369,
 						val:        "p",
 						ignoreCase: false,
 						want:       "\"p\"",
 					},
 					&choiceExpr{
 						pos: position{line: 213, col: 28, offset: 6224},
+						id:  370,
 						alternatives: []any{
 							&ruleRefExpr{
 								pos:  position{line: 213, col: 28, offset: 6224},
+								id:   371,
 								name: "SingleCharUnicodeClass",
 							},
 							&seqExpr{
 								pos: position{line: 213, col: 53, offset: 6249},
+								id:  372,
 								exprs: []any{
 									&litMatcher{
 										pos:        position{line: 213, col: 53, offset: 6249},
+										id:         373,
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
 									},
 									&ruleRefExpr{
 										pos:  position{line: 213, col: 57, offset: 6253},
+										id:   374,
 										name: "UnicodeClass",
 									},
 									&litMatcher{
 										pos:        position{line: 213, col: 70, offset: 6266},
+										id:         375,
 										val:        "}",
 										ignoreCase: false,
 										want:       "\"}\"",
@@ -1662,6 +2196,13 @@ var g = &grammar{
 								},
 							},
 						},
+						dispatch: &choiceDispatch{
+							ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x00\x01\x00\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00",
+							ranges:    []rune{'\u0080'},
+							rangeSets: "\x00",
+							alts:      [][]int{{}, {0}, {1}},
+							expected:  [][]string{{"[LMNCPZS]", "\"{\""}, {"\"{\""}, {"[LMNCPZS]"}},
+						},
 					},
 				},
 			},
@@ -1669,8 +2210,10 @@ var g = &grammar{
 		{
 			name: "SingleCharUnicodeClass",
 			pos:  position{line: 214, col: 1, offset: 6272},
+			id:   47,
 			expr: &charClassMatcher{
 				pos:        position{line: 214, col: 26, offset: 6299},
+				id:         376,
 				val:        "[LMNCPZS]",
 				chars:      []rune{'L', 'M', 'N', 'C', 'P', 'Z', 'S'},
 				ignoreCase: false,
@@ -1680,10 +2223,13 @@ var g = &grammar{
 		{
 			name: "UnicodeClass",
 			pos:  position{line: 215, col: 1, offset: 6309},
+			id:   48,
 			expr: &oneOrMoreExpr{
 				pos: position{line: 215, col: 16, offset: 6326},
+				id:  377,
 				expr: &charClassMatcher{
 					pos:        position{line: 215, col: 16, offset: 6326},
+					id:         378,
 					val:        "[a-z_]i",
 					chars:      []rune{'_'},
 					ranges:     []rune{'a', 'z'},
@@ -1695,11 +2241,14 @@ var g = &grammar{
 		{
 			name: "AnyMatcher",
 			pos:  position{line: 217, col: 1, offset: 6336},
+			id:   49,
 			expr: &actionExpr{
 				pos: position{line: 217, col: 14, offset: 6351},
+				id:  379,
 				run: (*parser).callonAnyMatcher1,
 				expr: &litMatcher{
 					pos:        position{line: 217, col: 14, offset: 6351},
+					id:         380,
 					val:        ".",
 					ignoreCase: false,
 					want:       "\".\"",
@@ -1709,24 +2258,30 @@ var g = &grammar{
 		{
 			name: "CodeBlock",
 			pos:  position{line: 222, col: 1, offset: 6426},
+			id:   50,
 			expr: &actionExpr{
 				pos: position{line: 222, col: 13, offset: 6440},
+				id:  381,
 				run: (*parser).callonCodeBlock1,
 				expr: &seqExpr{
 					pos: position{line: 222, col: 13, offset: 6440},
+					id:  382,
 					exprs: []any{
 						&litMatcher{
 							pos:        position{line: 222, col: 13, offset: 6440},
+							id:         383,
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&ruleRefExpr{
 							pos:  position{line: 222, col: 17, offset: 6444},
+							id:   384,
 							name: "Code",
 						},
 						&litMatcher{
 							pos:        position{line: 222, col: 22, offset: 6449},
+							id:         385,
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -1738,20 +2293,27 @@ var g = &grammar{
 		{
 			name: "Code",
 			pos:  position{line: 228, col: 1, offset: 6547},
+			id:   51,
 			expr: &zeroOrMoreExpr{
 				pos: position{line: 228, col: 10, offset: 6558},
+				id:  386,
 				expr: &choiceExpr{
 					pos: position{line: 228, col: 10, offset: 6558},
+					id:  387,
 					alternatives: []any{
 						&oneOrMoreExpr{
 							pos: position{line: 228, col: 12, offset: 6560},
+							id:  388,
 							expr: &seqExpr{
 								pos: position{line: 228, col: 12, offset: 6560},
+								id:  389,
 								exprs: []any{
 									&notExpr{
 										pos: position{line: 228, col: 12, offset: 6560},
+										id:  390,
 										expr: &charClassMatcher{
 											pos:        position{line: 228, col: 13, offset: 6561},
+											id:         391,
 											val:        "[{}]",
 											chars:      []rune{'{', '}'},
 											ignoreCase: false,
@@ -1760,6 +2322,7 @@ var g = &grammar{
 									},
 									&ruleRefExpr{
 										pos:  position{line: 228, col: 18, offset: 6566},
+										id:   392,
 										name: "SourceChar",
 									},
 								},
@@ -1767,19 +2330,23 @@ var g = &grammar{
 						},
 						&seqExpr{
 							pos: position{line: 228, col: 34, offset: 6582},
+							id:  393,
 							exprs: []any{
 								&litMatcher{
 									pos:        position{line: 228, col: 34, offset: 6582},
+									id:         394,
 									val:        "{",
 									ignoreCase: false,
 									want:       "\"{\"",
 								},
 								&ruleRefExpr{
 									pos:  position{line: 228, col: 38, offset: 6586},
+									id:   395,
 									name: "Code",
 								},
 								&litMatcher{
 									pos:        position{line: 228, col: 43, offset: 6591},
+									id:         396,
 									val:        "}",
 									ignoreCase: false,
 									want:       "\"}\"",
@@ -1787,58 +2354,92 @@ var g = &grammar{
 							},
 						},
 					},
+					dispatch: &choiceDispatch{
+						ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00",
+						ranges:    []rune{'\u0080'},
+						rangeSets: "\x00",
+						alts:      [][]int{{0}, {0, 1}},
+						expected:  [][]string{{"\"{\""}, {}},
+					},
 				},
 			},
 		},
 		{
 			name: "__",
 			pos:  position{line: 230, col: 1, offset: 6599},
+			id:   52,
 			expr: &zeroOrMoreExpr{
 				pos: position{line: 230, col: 8, offset: 6608},
+				id:  397,
 				expr: &choiceExpr{
 					pos: position{line: 230, col: 8, offset: 6608},
+					id:  398,
 					alternatives: []any{
 						&ruleRefExpr{
 							pos:  position{line: 230, col: 8, offset: 6608},
+							id:   399,
 							name: "Whitespace",
 						},
 						&ruleRefExpr{
 							pos:  position{line: 230, col: 21, offset: 6621},
+							id:   400,
 							name: "EOL",
 						},
 						&ruleRefExpr{
 							pos:  position{line: 230, col: 27, offset: 6627},
+							id:   401,
 							name: "Comment",
 						},
 					},
+					dispatch: &choiceDispatch{
+						ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+						ranges:    []rune{'\u0080'},
+						rangeSets: "\x00",
+						alts:      [][]int{{}, {0}, {1}, {2}},
+						expected:  [][]string{{"[ \\t\\r]", "\"\\n\"", "\"/*\"", "\"//\""}, {"\"\\n\"", "\"/*\"", "\"//\""}, {"[ \\t\\r]", "\"/*\"", "\"//\""}, {"[ \\t\\r]", "\"\\n\""}},
+					},
 				},
 			},
 		},
 		{
 			name: "_",
 			pos:  position{line: 231, col: 1, offset: 6638},
+			id:   53,
 			expr: &zeroOrMoreExpr{
 				pos: position{line: 231, col: 7, offset: 6646},
+				id:  402,
 				expr: &choiceExpr{
 					pos: position{line: 231, col: 7, offset: 6646},
+					id:  403,
 					alternatives: []any{
 						&ruleRefExpr{
 							pos:  position{line: 231, col: 7, offset: 6646},
+							id:   404,
 							name: "Whitespace",
 						},
 						&ruleRefExpr{
 							pos:  position{line: 231, col: 20, offset: 6659},
+							id:   405,
 							name: "MultiLineCommentNoLineTerminator",
 						},
 					},
+					dispatch: &choiceDispatch{
+						ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+						ranges:    []rune{'\u0080'},
+						rangeSets: "\x00",
+						alts:      [][]int{{}, {0}, {1}},
+						expected:  [][]string{{"[ \\t\\r]", "\"/*\""}, {"\"/*\""}, {"[ \\t\\r]"}},
+					},
 				},
 			},
 		},
 		{
 			name: "Whitespace",
 			pos:  position{line: 233, col: 1, offset: 6696},
+			id:   54,
 			expr: &charClassMatcher{
 				pos:        position{line: 233, col: 14, offset: 6711},
+				id:         406,
 				val:        "[ \\t\\r]",
 				chars:      []rune{' ', '\t', '\r'},
 				ignoreCase: false,
@@ -1848,8 +2449,10 @@ var g = &grammar{
 		{
 			name: "EOL",
 			pos:  position{line: 234, col: 1, offset: 6719},
+			id:   55,
 			expr: &litMatcher{
 				pos:        position{line: 234, col: 7, offset: 6727},
+				id:         407,
 				val:        "\n",
 				ignoreCase: false,
 				want:       "\"\\n\"",
@@ -1858,18 +2461,23 @@ var g = &grammar{
 		{
 			name: "EOS",
 			pos:  position{line: 235, col: 1, offset: 6732},
+			id:   56,
 			expr: &choiceExpr{
 				pos: position{line: 235, col: 7, offset: 6740},
+				id:  408,
 				alternatives: []any{
 					&seqExpr{
 						pos: position{line: 235, col: 7, offset: 6740},
+						id:  409,
 						exprs: []any{
 							&ruleRefExpr{
 								pos:  position{line: 235, col: 7, offset: 6740},
+								id:   410,
 								name: "__",
 							},
 							&litMatcher{
 								pos:        position{line: 235, col: 10, offset: 6743},
+								id:         411,
 								val:        ";",
 								ignoreCase: false,
 								want:       "\";\"",
@@ -1878,47 +2486,65 @@ var g = &grammar{
 					},
 					&seqExpr{
 						pos: position{line: 235, col: 16, offset: 6749},
+						id:  412,
 						exprs: []any{
 							&ruleRefExpr{
 								pos:  position{line: 235, col: 16, offset: 6749},
+								id:   413,
 								name: "_",
 							},
 							&zeroOrOneExpr{
 								pos: position{line: 235, col: 18, offset: 6751},
+								id:  414,
 								expr: &ruleRefExpr{
 									pos:  position{line: 235, col: 18, offset: 6751},
+									id:   415,
 									name: "SingleLineComment",
 								},
 							},
 							&ruleRefExpr{
 								pos:  position{line: 235, col: 37, offset: 6770},
+								id:   416,
 								name: "EOL",
 							},
 						},
 					},
 					&seqExpr{
 						pos: position{line: 235, col: 43, offset: 6776},
+						id:  417,
 						exprs: []any{
 							&ruleRefExpr{
 								pos:  position{line: 235, col: 43, offset: 6776},
+								id:   418,
 								name: "__",
 							},
 							&ruleRefExpr{
 								pos:  position{line: 235, col: 46, offset: 6779},
+								id:   419,
 								name: "EOF",
 							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{2}, {0, 1, 2}, {0, 2}},
+					expected:  [][]string{{"[ \\t\\r]", "\"\\n\"", "\"/*\"", "\"//\"", "\";\""}, {}, {"[ \\t\\r]", "\"/*\"", "\"//\"", "\"\\n\""}},
+				},
 			},
 		},
 		{
 			name: "EOF",
 			pos:  position{line: 237, col: 1, offset: 6784},
+			id:   57,
 			expr: &notExpr{
 				pos: position{line: 237, col: 7, offset: 6792},
+				id:  420,
 				expr: &anyMatcher{
-					line: 237, col: 8, offset: 6793,
+					pos: position{line: 237, col: 8, offset: 6793},
+					id:  421,
 				},
 			},
 		},
@@ -2295,7 +2921,8 @@ func Entrypoint(ruleName string) Option {
 
 // Statistics adds a user provided Stats struct to the parser to allow
 // the user to process the results after the parsing has finished.
-// Also the key for the "no match" counter is set.
+// Also the key for the "no match" counter is set. Per-rule statistics
+// (see RuleStats) are only collected when this option is set.
 //
 // Example usage:
 //
@@ -2319,6 +2946,9 @@ func Statistics(stats *Stats, choiceNoMatch string) Option {
 		if p.Stats.ChoiceAltCnt == nil {
 			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
 		}
+		if p.Stats.Rules == nil {
+			p.Stats.Rules = make(map[string]*RuleStats)
+		}
 		return Statistics(oldStats, oldChoiceNoMatch)
 	}
 }
@@ -2339,6 +2969,9 @@ func Debug(b bool) Option {
 // the parser will cache all results so each expression is evaluated only
 // once. This guarantees linear parsing time even for pathological cases,
 // at the expense of more memory and slower times for typical cases.
+// The rules annotated with @memo in the grammar are always memoized,
+// regardless of this option. The memoization table is pooled, so its
+// storage is reused by the following parses.
 //
 // The default is false.
 func Memoize(b bool) Option {
@@ -2363,6 +2996,57 @@ func AllowInvalidUTF8(b bool) Option {
 	}
 }
 
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
 // Recover creates an Option to set the recover flag to b. When set to
 // true, this causes the parser to recover from panics and convert it
 // to an error. Setting it to false can be useful while debugging to
@@ -2476,21 +3160,71 @@ type rule struct {
 	name        string
 	displayName string
 	expr        any
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
 }
 
 type choiceExpr struct {
 	pos          position
+	id           int
 	alternatives []any
+	dispatch     *choiceDispatch
 }
 
 type actionExpr struct {
 	pos  position
+	id   int
 	expr any
 	run  func(*parser) (any, error)
 }
 
 type recoveryExpr struct {
 	pos          position
+	id           int
 	expr         any
 	recoverExpr  any
 	failureLabel []string
@@ -2498,25 +3232,32 @@ type recoveryExpr struct {
 
 type seqExpr struct {
 	pos   position
+	id    int
 	exprs []any
 }
 
-type throwExpr struct {
-	pos   position
-	label string
-}
-
 type labeledExpr struct {
 	pos   position
+	id    int
 	label string
 	expr  any
 }
 
 type expr struct {
 	pos  position
+	id   int
 	expr any
 }
 
+type repeatExpr struct {
+	pos  position
+	id   int
+	expr any
+	// max is -1 if the number of repetitions is not bounded.
+	min int
+	max int
+}
+
 type (
 	andExpr        expr
 	notExpr        expr
@@ -2527,26 +3268,31 @@ type (
 
 type ruleRefExpr struct {
 	pos  position
+	id   int
 	name string
 }
 
 type stateCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) error
 }
 
 type andCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) (bool, error)
 }
 
 type notCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) (bool, error)
 }
 
 type litMatcher struct {
 	pos        position
+	id         int
 	val        string
 	ignoreCase bool
 	want       string
@@ -2554,6 +3300,7 @@ type litMatcher struct {
 
 type charClassMatcher struct {
 	pos             position
+	id              int
 	val             string
 	basicLatinChars [128]bool
 	chars           []rune
@@ -2563,7 +3310,60 @@ type charClassMatcher struct {
 	inverted        bool
 }
 
-type anyMatcher position
+type anyMatcher struct {
+	pos position
+	id  int
+}
+
+// matchCaseFold matches the full case folding of the input against val,
+// the case folding of a case-insensitive literal. The input is consumed
+// if it matches, it must be restored otherwise.
+func (p *parser) matchCaseFold(val string) bool {
+	for len(val) > 0 {
+		if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+			// EOF - see utf8.DecodeRune
+			return false
+		}
+		if f, ok := fullCaseFolds[p.pt.rn]; ok {
+			if !strings.HasPrefix(val, f) {
+				return false
+			}
+			val = val[len(f):]
+		} else {
+			want, n := utf8.DecodeRuneInString(val)
+			if caseFold(p.pt.rn) != want {
+				return false
+			}
+			val = val[n:]
+		}
+		p.read()
+	}
+	return true
+}
+
+// caseFold returns the simple case folding of rn, the smallest lowercase
+// rune of its orbit for unicode.SimpleFold, or its smallest rune if it has
+// no lowercase rune.
+func caseFold(rn rune) rune {
+	if rn < utf8.RuneSelf {
+		if 'A' <= rn && rn <= 'Z' {
+			rn += 'a' - 'A'
+		}
+		return rn
+	}
+
+	folded := rn
+	for r := unicode.SimpleFold(rn); r != rn; r = unicode.SimpleFold(r) {
+		if lower := unicode.IsLower(r); lower != unicode.IsLower(folded) {
+			if lower {
+				folded = r
+			}
+		} else if r < folded {
+			folded = r
+		}
+	}
+	return folded
+}
 
 // errList cumulates the errors found by the parser.
 type errList []error
@@ -2648,6 +3448,7 @@ func newParser(filename string, b []byte, opts ...Option) *parser {
 		entrypoint: g.rules[0].name,
 	}
 	p.setOptions(opts)
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
 
 	if p.maxExprCnt == 0 {
 		p.maxExprCnt = math.MaxUint64
@@ -2681,7 +3482,8 @@ type Stats struct {
 	// ChoiceAltCnt is used to count for each ordered choice expression,
 	// which alternative is used how may times.
 	// These numbers allow to optimize the order of the ordered choice expression
-	// to increase the performance of the parser
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
 	//
 	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
 	// as the line and the column of the ordered choice.
@@ -2691,6 +3493,46 @@ type Stats struct {
 	// the parser option Statistics.
 	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
 	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
 }
 
 type parser struct {
@@ -2706,9 +3548,9 @@ type parser struct {
 	debug   bool
 
 	memoize bool
-	// memoization table for the packrat algorithm:
-	// map[offset in source] map[expression or rule] {value, match}
-	memo map[int]map[any]resultTuple
+	// memoization table for the packrat algorithm, taken from memoTables
+	// on the first memoized result
+	memo *memoTable
 
 	// rules table, maps the rule identifier to the rule node
 	rules map[string]*rule
@@ -2729,11 +3571,33 @@ type parser struct {
 
 	allowInvalidUTF8 bool
 
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+
 	*Stats
+	// profiling frames, tracks the time spent in invoked rules to compute
+	// the self time of each rule.
+	profStack []profFrame
+	// number of active invocations of each rule, the time of a recursive
+	// rule is only added at its outermost invocation.
+	profActive map[*rule]int
+	// rules evaluated at each offset, to count the repeated evaluations.
+	profEvals map[profEval]bool
 
 	choiceNoMatch string
 	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
-	recoveryStack []map[string]any
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
 }
 
 // push a variable set on the vstack.
@@ -2772,7 +3636,7 @@ func (p *parser) popV() {
 func (p *parser) pushRecovery(labels []string, expr any) {
 	if cap(p.recoveryStack) == len(p.recoveryStack) {
 		// create new empty slot in the stack
-		p.recoveryStack = append(p.recoveryStack, nil)
+		p.recoveryStack = append(p.recoveryStack, recovery{})
 	} else {
 		// slice to 1 more
 		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
@@ -2782,13 +3646,13 @@ func (p *parser) pushRecovery(labels []string, expr any) {
 	for _, fl := range labels {
 		m[fl] = expr
 	}
-	p.recoveryStack[len(p.recoveryStack)-1] = m
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
 }
 
 // pop a recovery expression from the recoveryStack
 func (p *parser) popRecovery() {
 	// GC that map
-	p.recoveryStack[len(p.recoveryStack)-1] = nil
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
 
 	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
 }
@@ -2798,8 +3662,9 @@ func (p *parser) print(prefix, s string) string {
 		return s
 	}
 
+	pos := p.pt.position
 	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
-		prefix, p.pt.line, p.pt.col, p.pt.offset, s, p.pt.rn)
+		prefix, pos.line, pos.col, pos.offset, s, p.pt.rn)
 	return s
 }
 
@@ -2867,14 +3732,19 @@ func (p *parser) failAt(fail bool, pos position, want string) {
 
 // read advances the parser to the next rune.
 func (p *parser) read() {
-	p.pt.offset += p.pt.w
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
 	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
 	p.pt.rn = rn
 	p.pt.w = n
-	p.pt.col++
-	if rn == '\n' {
-		p.pt.line++
-		p.pt.col = 0
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
 	}
 
 	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
@@ -2884,6 +3754,36 @@ func (p *parser) read() {
 	}
 }
 
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
 // restore parser position to the savepoint pt.
 func (p *parser) restore(pt savepoint) {
 	if p.debug {
@@ -2892,6 +3792,9 @@ func (p *parser) restore(pt savepoint) {
 	if pt.offset == p.pt.offset {
 		return
 	}
+	if p.Rules != nil && pt.offset < p.pt.offset && len(p.rstack) > 0 {
+		p.ruleStats(p.rstack[len(p.rstack)-1]).Backtracked += uint64(p.pt.offset - pt.offset)
+	}
 	p.pt = pt
 }
 
@@ -2949,28 +3852,109 @@ func (p *parser) sliceFrom(start savepoint) []byte {
 	return p.data[start.position.offset:p.pt.position.offset]
 }
 
-func (p *parser) getMemoized(node any) (resultTuple, bool) {
-	if len(p.memo) == 0 {
-		return resultTuple{}, false
+// memoSlot is an entry of the memoization table, the key of an empty slot
+// is 0.
+type memoSlot struct {
+	key uint64
+	res resultTuple
+}
+
+// memoTable is the memoization table for the packrat algorithm, an
+// open-addressed hash table with linear probing that maps the offset in
+// the source and the id of a rule or expression to its result.
+type memoTable struct {
+	slots []memoSlot
+	shift uint
+	n     int
+}
+
+// memoTables pools the memoization tables so that their storage is
+// reused across parses.
+var memoTables = &sync.Pool{
+	New: func() any {
+		return &memoTable{slots: make([]memoSlot, 1<<10), shift: 64 - 10}
+	},
+}
+
+// memoKey returns the key of the result of the rule or expression id at
+// offset, it supports up to 1<<24 ids.
+func memoKey(offset, id int) uint64 {
+	return uint64(offset+1)<<24 | uint64(id)
+}
+
+// index returns the index of the slot of key, or of the empty slot where
+// it should be stored.
+func (t *memoTable) index(key uint64) int {
+	mask := len(t.slots) - 1
+	i := int((key*0x9E3779B97F4A7C15)>>t.shift) & mask
+	for t.slots[i].key != 0 && t.slots[i].key != key {
+		i = (i + 1) & mask
+	}
+	return i
+}
+
+func (t *memoTable) get(key uint64) (resultTuple, bool) {
+	s := &t.slots[t.index(key)]
+	return s.res, s.key == key
+}
+
+func (t *memoTable) set(key uint64, res resultTuple) {
+	if 4*(t.n+1) > 3*len(t.slots) {
+		t.grow()
 	}
-	m := p.memo[p.pt.offset]
-	if len(m) == 0 {
+	s := &t.slots[t.index(key)]
+	if s.key == 0 {
+		s.key = key
+		t.n++
+	}
+	s.res = res
+}
+
+// grow doubles the capacity of the table.
+func (t *memoTable) grow() {
+	old := t.slots
+	t.slots = make([]memoSlot, 2*len(old))
+	t.shift--
+	for _, s := range old {
+		if s.key != 0 {
+			t.slots[t.index(s.key)] = s
+		}
+	}
+}
+
+// reset empties the table, releasing the references to the results.
+func (t *memoTable) reset() {
+	if t.n == 0 {
+		return
+	}
+	for i := range t.slots {
+		t.slots[i] = memoSlot{}
+	}
+	t.n = 0
+}
+
+func (p *parser) getMemoized(id int) (resultTuple, bool) {
+	if p.memo == nil {
 		return resultTuple{}, false
 	}
-	res, ok := m[node]
-	return res, ok
+	return p.memo.get(memoKey(p.pt.offset, id))
 }
 
-func (p *parser) setMemoized(pt savepoint, node any, tuple resultTuple) {
+func (p *parser) setMemoized(pt savepoint, id int, tuple resultTuple) {
 	if p.memo == nil {
-		p.memo = make(map[int]map[any]resultTuple)
+		p.memo = memoTables.Get().(*memoTable)
 	}
-	m := p.memo[pt.offset]
-	if m == nil {
-		m = make(map[any]resultTuple)
-		p.memo[pt.offset] = m
+	p.memo.set(memoKey(pt.offset, id), tuple)
+}
+
+// releaseMemo returns the memoization table to the pool.
+func (p *parser) releaseMemo() {
+	if p.memo == nil {
+		return
 	}
-	m[node] = tuple
+	p.memo.reset()
+	memoTables.Put(p.memo)
+	p.memo = nil
 }
 
 func (p *parser) buildRulesTable(g *grammar) {
@@ -2988,6 +3972,7 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 
 	// TODO : not super critical but this could be generated
 	p.buildRulesTable(g)
+	defer p.releaseMemo()
 
 	if p.recover {
 		// panic can be used in action code to stop parsing immediately
@@ -3021,24 +4006,7 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 		if len(*p.errs) == 0 {
 			// If parsing fails, but no errors have been recorded, the expected values
 			// for the farthest parser position are returned as error.
-			maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
-			for _, v := range p.maxFailExpected {
-				maxFailExpectedMap[v] = struct{}{}
-			}
-			expected := make([]string, 0, len(maxFailExpectedMap))
-			eof := false
-			if _, ok := maxFailExpectedMap["!."]; ok {
-				delete(maxFailExpectedMap, "!.")
-				eof = true
-			}
-			for k := range maxFailExpectedMap {
-				expected = append(expected, k)
-			}
-			sort.Strings(expected)
-			if eof {
-				expected = append(expected, "EOF")
-			}
-			p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+			p.addNoMatchErr()
 		}
 
 		return nil, p.errs.err()
@@ -3046,6 +4014,36 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 	return val, p.errs.err()
 }
 
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
 func listJoin(list []string, sep string, lastSep string) string {
 	switch len(list) {
 	case 0:
@@ -3057,8 +4055,90 @@ func listJoin(list []string, sep string, lastSep string) string {
 	}
 }
 
+// profFrame is a rule invocation being profiled.
+type profFrame struct {
+	start time.Time
+	child time.Duration
+}
+
+// profEval is the evaluation of a rule at an offset.
+type profEval struct {
+	rule   *rule
+	offset int
+}
+
+// ruleStats returns the statistics of rule, creating them if needed.
+func (p *parser) ruleStats(rule *rule) *RuleStats {
+	rs := p.Rules[rule.name]
+	if rs == nil {
+		rs = &RuleStats{}
+		p.Rules[rule.name] = rs
+	}
+	return rs
+}
+
+func (p *parser) countMemo(rs *RuleStats, hit bool) {
+	if hit {
+		rs.MemoHits++
+	} else {
+		rs.MemoMisses++
+	}
+}
+
+func (p *parser) profEnter(r *rule) {
+	if p.profActive == nil {
+		p.profActive = make(map[*rule]int)
+	}
+	p.profActive[r]++
+	p.profStack = append(p.profStack, profFrame{start: time.Now()})
+}
+
+// countRepeat counts the evaluation of rule at the current offset if
+// it was already evaluated at that offset.
+func (p *parser) countRepeat(rule *rule) {
+	if p.profEvals == nil {
+		p.profEvals = make(map[profEval]bool)
+	}
+	key := profEval{rule: rule, offset: p.pt.offset}
+	if p.profEvals[key] {
+		p.ruleStats(rule).Repeats++
+		return
+	}
+	p.profEvals[key] = true
+}
+
+func (p *parser) profExit(rule *rule, start savepoint, ok bool) {
+	frame := p.profStack[len(p.profStack)-1]
+	p.profStack = p.profStack[:len(p.profStack)-1]
+	elapsed := time.Since(frame.start)
+	if n := len(p.profStack); n > 0 {
+		p.profStack[n-1].child += elapsed
+	}
+
+	rs := p.ruleStats(rule)
+	rs.Invocations++
+	if p.profActive[rule]--; p.profActive[rule] == 0 {
+		// the time of the inner invocations is included
+		rs.Time += elapsed
+	}
+	rs.SelfTime += elapsed - frame.child
+	if ok {
+		rs.Matches++
+		rs.Bytes += uint64(p.pt.offset - start.offset)
+	} else {
+		rs.Failures++
+	}
+}
+
 func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
-	res, ok := p.getMemoized(rule)
+	if rule.id < 0 {
+		// its results depend on the captured texts or the state store
+		return p.parseRule(rule)
+	}
+	res, ok := p.getMemoized(rule.id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(rule), ok)
+	}
 	if ok {
 		p.restore(res.end)
 		return res.v, res.b
@@ -3066,7 +4146,7 @@ func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
 
 	startMark := p.pt
 	val, ok := p.parseRule(rule)
-	p.setMemoized(startMark, rule, resultTuple{val, ok, p.pt})
+	p.setMemoized(startMark, rule.id, resultTuple{v: val, b: ok, end: p.pt})
 
 	return val, ok
 }
@@ -3081,6 +4161,11 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 		startMark = p.pt
 	)
 
+	if p.Rules != nil {
+		p.profEnter(rule)
+		defer func() { p.profExit(rule, startMark, ok) }()
+	}
+
 	if p.memoize {
 		val, ok = p.parseRuleMemoize(rule)
 	} else {
@@ -3094,6 +4179,9 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 }
 
 func (p *parser) parseRule(rule *rule) (any, bool) {
+	if p.Rules != nil {
+		p.countRepeat(rule)
+	}
 	p.rstack = append(p.rstack, rule)
 	p.pushV()
 	val, ok := p.parseExprWrap(rule.expr)
@@ -3102,11 +4190,32 @@ func (p *parser) parseRule(rule *rule) (any, bool) {
 	return val, ok
 }
 
+// incChoiceAltCnt counts the match of the alternative altI of the choice
+// at pos, or its failure if altI is choiceNoMatch.
+func (p *parser) incChoiceAltCnt(pos position, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, pos.line, pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
 func (p *parser) parseExprWrap(expr any) (any, bool) {
 	var pt savepoint
+	id := exprID(expr)
 
-	if p.memoize {
-		res, ok := p.getMemoized(expr)
+	if p.memoize && id >= 0 {
+		res, ok := p.getMemoized(id)
+		if p.Rules != nil {
+			p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+		}
 		if ok {
 			p.restore(res.end)
 			return res.v, res.b
@@ -3116,12 +4225,58 @@ func (p *parser) parseExprWrap(expr any) (any, bool) {
 
 	val, ok := p.parseExpr(expr)
 
-	if p.memoize {
-		p.setMemoized(pt, expr, resultTuple{val, ok, p.pt})
+	if p.memoize && id >= 0 {
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
 	}
 	return val, ok
 }
 
+// exprID returns the id of the expression in the memoization table, -1 if
+// its results depend on the texts captured by the labels or on the state
+// store and are not memoized.
+func exprID(expr any) int {
+	switch expr := expr.(type) {
+	case *actionExpr:
+		return expr.id
+	case *andCodeExpr:
+		return expr.id
+	case *andExpr:
+		return expr.id
+	case *anyMatcher:
+		return expr.id
+	case *charClassMatcher:
+		return expr.id
+	case *choiceExpr:
+		return expr.id
+	case *labeledExpr:
+		return expr.id
+	case *litMatcher:
+		return expr.id
+	case *notCodeExpr:
+		return expr.id
+	case *notExpr:
+		return expr.id
+	case *oneOrMoreExpr:
+		return expr.id
+	case *recoveryExpr:
+		return expr.id
+	case *repeatExpr:
+		return expr.id
+	case *ruleRefExpr:
+		return expr.id
+	case *seqExpr:
+		return expr.id
+	case *stateCodeExpr:
+		return expr.id
+	case *zeroOrMoreExpr:
+		return expr.id
+	case *zeroOrOneExpr:
+		return expr.id
+	default:
+		panic(fmt.Sprintf("unknown expression type %T", expr))
+	}
+}
+
 func (p *parser) parseExpr(expr any) (any, bool) {
 	p.ExprCnt++
 	if p.ExprCnt > p.maxExprCnt {
@@ -3155,14 +4310,14 @@ func (p *parser) parseExpr(expr any) (any, bool) {
 		val, ok = p.parseOneOrMoreExpr(expr)
 	case *recoveryExpr:
 		val, ok = p.parseRecoveryExpr(expr)
+	case *repeatExpr:
+		val, ok = p.parseRepeatExpr(expr)
 	case *ruleRefExpr:
 		val, ok = p.parseRuleRefExpr(expr)
 	case *seqExpr:
 		val, ok = p.parseSeqExpr(expr)
 	case *stateCodeExpr:
 		val, ok = p.parseStateCodeExpr(expr)
-	case *throwExpr:
-		val, ok = p.parseThrowExpr(expr)
 	case *zeroOrMoreExpr:
 		val, ok = p.parseZeroOrMoreExpr(expr)
 	case *zeroOrOneExpr:
@@ -3261,7 +4416,7 @@ func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
 	}
 
 	if chr.ignoreCase {
-		cur = unicode.ToLower(cur)
+		cur = caseFold(cur)
 	}
 
 	// try to match in the list of available chars
@@ -3312,29 +4467,29 @@ func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
 	return nil, false
 }
 
-func (p *parser) incChoiceAltCnt(ch *choiceExpr, altI int) {
-	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, ch.pos.line, ch.pos.col)
-	m := p.ChoiceAltCnt[choiceIdent]
-	if m == nil {
-		m = make(map[string]int)
-		p.ChoiceAltCnt[choiceIdent] = m
-	}
-	// We increment altI by 1, so the keys do not start at 0
-	alt := strconv.Itoa(altI + 1)
-	if altI == choiceNoMatch {
-		alt = p.choiceNoMatch
-	}
-	m[alt]++
-}
-
 func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseChoiceExpr"))
 	}
 
-	for altI, alt := range ch.alternatives {
-		// dummy assignment to prevent compile error if optimized
-		_ = altI
+	n := len(ch.alternatives)
+	var alts []int
+	if d := ch.dispatch; d != nil {
+		// the alternatives that cannot match the current rune are skipped,
+		// with the values they expect.
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		alts = d.alts[set]
+		n = len(alts)
+	}
+	for i := 0; i < n; i++ {
+		altI := i
+		if alts != nil {
+			altI = alts[i]
+		}
+		alt := ch.alternatives[altI]
 
 		state := p.cloneState()
 
@@ -3342,12 +4497,12 @@ func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 		val, ok := p.parseExprWrap(alt)
 		p.popV()
 		if ok {
-			p.incChoiceAltCnt(ch, altI)
+			p.incChoiceAltCnt(ch.pos, altI)
 			return val, ok
 		}
 		p.restoreState(state)
 	}
-	p.incChoiceAltCnt(ch, choiceNoMatch)
+	p.incChoiceAltCnt(ch.pos, choiceNoMatch)
 	return nil, false
 }
 
@@ -3372,6 +4527,15 @@ func (p *parser) parseLitMatcher(lit *litMatcher) (any, bool) {
 	}
 
 	start := p.pt
+	if lit.ignoreCase {
+		if !p.matchCaseFold(lit.val) {
+			p.failAt(false, start.position, lit.want)
+			p.restore(start)
+			return nil, false
+		}
+		p.failAt(true, start.position, lit.want)
+		return p.sliceFrom(start), true
+	}
 	for _, want := range lit.val {
 		cur := p.pt.rn
 		if lit.ignoreCase {
@@ -3456,6 +4620,33 @@ func (p *parser) parseRecoveryExpr(recover *recoveryExpr) (any, bool) {
 	return val, ok
 }
 
+func (p *parser) parseRepeatExpr(expr *repeatExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRepeatExpr"))
+	}
+
+	var vals []any
+
+	pt := p.pt
+	state := p.cloneState()
+	for expr.max < 0 || len(vals) < expr.max {
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			break
+		}
+		vals = append(vals, val)
+	}
+	if len(vals) < expr.min {
+		// did not match enough times, no match
+		p.restoreState(state)
+		p.restore(pt)
+		return nil, false
+	}
+	return vals, true
+}
+
 func (p *parser) parseRuleRefExpr(ref *ruleRefExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseRuleRefExpr " + ref.name))
@@ -3506,22 +4697,6 @@ func (p *parser) parseStateCodeExpr(state *stateCodeExpr) (any, bool) {
 	return nil, true
 }
 
-func (p *parser) parseThrowExpr(expr *throwExpr) (any, bool) {
-	if p.debug {
-		defer p.out(p.in("parseThrowExpr"))
-	}
-
-	for i := len(p.recoveryStack) - 1; i >= 0; i-- {
-		if recoverExpr, ok := p.recoveryStack[i][expr.label]; ok {
-			if val, ok := p.parseExprWrap(recoverExpr); ok {
-				return val, ok
-			}
-		}
-	}
-
-	return nil, false
-}
-
 func (p *parser) parseZeroOrMoreExpr(expr *zeroOrMoreExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseZeroOrMoreExpr"))
@@ -3551,3 +4726,112 @@ func (p *parser) parseZeroOrOneExpr(expr *zeroOrOneExpr) (any, bool) {
 	// whether it matched or not, consider it a match
 	return val, true
 }
+
+// fullCaseFolds are the full case foldings of more than one rune, with
+// the simple case folding of their runes.
+var fullCaseFolds = map[rune]string{
+	0x00df: "ss",
+	0x0130: "i\u0307",
+	0x0149: "\u02bcn",
+	0x01f0: "j\u030c",
+	0x0390: "\u03b9\u0308\u0301",
+	0x03b0: "\u03c5\u0308\u0301",
+	0x0587: "\u0565\u0582",
+	0x1e96: "h\u0331",
+	0x1e97: "t\u0308",
+	0x1e98: "w\u030a",
+	0x1e99: "y\u030a",
+	0x1e9a: "a\u02be",
+	0x1e9e: "ss",
+	0x1f50: "\u03c5\u0313",
+	0x1f52: "\u03c5\u0313\u0300",
+	0x1f54: "\u03c5\u0313\u0301",
+	0x1f56: "\u03c5\u0313\u0342",
+	0x1f80: "\u1f00\u03b9",
+	0x1f81: "\u1f01\u03b9",
+	0x1f82: "\u1f02\u03b9",
+	0x1f83: "\u1f03\u03b9",
+	0x1f84: "\u1f04\u03b9",
+	0x1f85: "\u1f05\u03b9",
+	0x1f86: "\u1f06\u03b9",
+	0x1f87: "\u1f07\u03b9",
+	0x1f88: "\u1f00\u03b9",
+	0x1f89: "\u1f01\u03b9",
+	0x1f8a: "\u1f02\u03b9",
+	0x1f8b: "\u1f03\u03b9",
+	0x1f8c: "\u1f04\u03b9",
+	0x1f8d: "\u1f05\u03b9",
+	0x1f8e: "\u1f06\u03b9",
+	0x1f8f: "\u1f07\u03b9",
+	0x1f90: "\u1f20\u03b9",
+	0x1f91: "\u1f21\u03b9",
+	0x1f92: "\u1f22\u03b9",
+	0x1f93: "\u1f23\u03b9",
+	0x1f94: "\u1f24\u03b9",
+	0x1f95: "\u1f25\u03b9",
+	0x1f96: "\u1f26\u03b9",
+	0x1f97: "\u1f27\u03b9",
+	0x1f98: "\u1f20\u03b9",
+	0x1f99: "\u1f21\u03b9",
+	0x1f9a: "\u1f22\u03b9",
+	0x1f9b: "\u1f23\u03b9",
+	0x1f9c: "\u1f24\u03b9",
+	0x1f9d: "\u1f25\u03b9",
+	0x1f9e: "\u1f26\u03b9",
+	0x1f9f: "\u1f27\u03b9",
+	0x1fa0: "\u1f60\u03b9",
+	0x1fa1: "\u1f61\u03b9",
+	0x1fa2: "\u1f62\u03b9",
+	0x1fa3: "\u1f63\u03b9",
+	0x1fa4: "\u1f64\u03b9",
+	0x1fa5: "\u1f65\u03b9",
+	0x1fa6: "\u1f66\u03b9",
+	0x1fa7: "\u1f67\u03b9",
+	0x1fa8: "\u1f60\u03b9",
+	0x1fa9: "\u1f61\u03b9",
+	0x1faa: "\u1f62\u03b9",
+	0x1fab: "\u1f63\u03b9",
+	0x1fac: "\u1f64\u03b9",
+	0x1fad: "\u1f65\u03b9",
+	0x1fae: "\u1f66\u03b9",
+	0x1faf: "\u1f67\u03b9",
+	0x1fb2: "\u1f70\u03b9",
+	0x1fb3: "\u03b1\u03b9",
+	0x1fb4: "\u03ac\u03b9",
+	0x1fb6: "\u03b1\u0342",
+	0x1fb7: "\u03b1\u0342\u03b9",
+	0x1fbc: "\u03b1\u03b9",
+	0x1fc2: "\u1f74\u03b9",
+	0x1fc3: "\u03b7\u03b9",
+	0x1fc4: "\u03ae\u03b9",
+	0x1fc6: "\u03b7\u0342",
+	0x1fc7: "\u03b7\u0342\u03b9",
+	0x1fcc: "\u03b7\u03b9",
+	0x1fd2: "\u03b9\u0308\u0300",
+	0x1fd3: "\u03b9\u0308\u0301",
+	0x1fd6: "\u03b9\u0342",
+	0x1fd7: "\u03b9\u0308\u0342",
+	0x1fe2: "\u03c5\u0308\u0300",
+	0x1fe3: "\u03c5\u0308\u0301",
+	0x1fe4: "\u03c1\u0313",
+	0x1fe6: "\u03c5\u0342",
+	0x1fe7: "\u03c5\u0308\u0342",
+	0x1ff2: "\u1f7c\u03b9",
+	0x1ff3: "\u03c9\u03b9",
+	0x1ff4: "\u03ce\u03b9",
+	0x1ff6: "\u03c9\u0342",
+	0x1ff7: "\u03c9\u0342\u03b9",
+	0x1ffc: "\u03c9\u03b9",
+	0xfb00: "ff",
+	0xfb01: "fi",
+	0xfb02: "fl",
+	0xfb03: "ffi",
+	0xfb04: "ffl",
+	0xfb05: "st",
+	0xfb06: "st",
+	0xfb13: "\u0574\u0576",
+	0xfb14: "\u0574\u0565",
+	0xfb15: "\u0574\u056b",
+	0xfb16: "\u057e\u0576",
+	0xfb17: "\u0574\u056d",
+}
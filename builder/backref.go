@@ -0,0 +1,109 @@
+package builder
+
+import (
+	"github.com/mna/pigeon/ast"
+)
+
+// captureTexts records the labeled expressions whose text is captured for
+// the back-references of their rule, the labels with the name of a
+// back-reference.
+func (b *builder) captureTexts(g *ast.Grammar) {
+	for _, rule := range g.Rules {
+		names := make(map[string]bool)
+		ast.Inspect(rule.Expr, func(expr ast.Expression) bool {
+			if ref, ok := expr.(*ast.BackRefExpr); ok && !ref.State {
+				names[ref.Name.Val] = true
+			}
+			return true
+		})
+		if len(names) == 0 {
+			continue
+		}
+		ast.Inspect(rule.Expr, func(expr ast.Expression) bool {
+			if lab, ok := expr.(*ast.LabeledExpr); ok && lab.Label != nil && names[lab.Label.Val] {
+				b.textLabels[lab] = true
+			}
+			return true
+		})
+	}
+}
+
+// collectNoMemo records the rules and expressions whose results depend on
+// the texts captured by the labels, on the values of the labels or on the
+// state store, so that they are not memoized: the back-references, the
+// bytes<label> built-in rule of the binary parsers and the rules and
+// expressions that contain one, directly or through the rules that they
+// reference.
+func (b *builder) collectNoMemo(g *ast.Grammar) {
+	b.noMemo = make(map[ast.Expression]bool)
+	b.noMemoRules = make(map[string]bool)
+
+	// the rules that contain one, until no rule is added
+	for changed := true; changed; {
+		changed = false
+		for _, rule := range g.Rules {
+			if !b.noMemoRules[rule.Name.Val] && b.hasContextual(rule.Expr) {
+				b.noMemoRules[rule.Name.Val] = true
+				changed = true
+			}
+		}
+	}
+	for _, rule := range g.Rules {
+		ast.Inspect(rule.Expr, func(expr ast.Expression) bool {
+			if b.hasContextual(expr) {
+				b.noMemo[expr] = true
+				return true
+			}
+			return false
+		})
+	}
+}
+
+// hasContextual returns true if expr contains an expression whose result
+// depends on the captured texts, on the values of the labels or on the
+// state store, see collectNoMemo.
+func (b *builder) hasContextual(expr ast.Expression) bool {
+	found := false
+	ast.Inspect(expr, func(expr ast.Expression) bool {
+		switch expr := expr.(type) {
+		case *ast.BackRefExpr:
+			found = true
+		case *ast.RuleRefExpr:
+			found = found || b.noMemoRules[expr.Name.Val] || b.isBuiltinRule(expr) && expr.Name.Val == bytesRule
+		}
+		return !found
+	})
+	return found
+}
+
+// textKey returns the key of the text captured by the label in the labels
+// of the generated parser, which cannot be the name of a label.
+func textKey(label string) string {
+	return "$" + label
+}
+
+// backRefKey returns the key of the text matched by the back-reference in
+// the labels or in the state store.
+func backRefKey(ref *ast.BackRefExpr) string {
+	if ref.State {
+		return ref.Name.Val
+	}
+	return textKey(ref.Name.Val)
+}
+
+func (b *builder) writeBackRefExpr(ref *ast.BackRefExpr) {
+	if ref == nil {
+		b.writelnf("nil,")
+		return
+	}
+	if ref.State {
+		b.globalState = true
+	}
+	b.writelnf("&backRefExpr{")
+	b.writeExprPos(ref.Pos())
+	b.writelnf("\tkey: %q,", backRefKey(ref))
+	if ref.State {
+		b.writelnf("\tstate: true,")
+	}
+	b.writelnf("},")
+}
@@ -0,0 +1,31 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/mna/pigeon/ast"
+)
+
+func TestBackRefErrors(t *testing.T) {
+	lit := testLit("a")
+	cases := []struct {
+		expr ast.Expression
+		err  string
+	}{
+		{expr: testSeq(testLabel("a", lit), testBackRef("a", false))},
+		{expr: testSeq(testLabel("a", lit), testOpt(testBackRef("a", false)))},
+		{expr: testBackRef("a", true)},
+		{expr: testSeq(testBackRef("a", false), testLabel("a", lit)), err: "undefined label a"},
+		{expr: testSeq(testOpt(testLabel("a", lit)), testBackRef("a", false)), err: "undefined label a"},
+	}
+	for _, tc := range cases {
+		testBuildError(t, tc.expr, tc.err)
+	}
+}
+
+func testBackRef(name string, state bool) *ast.BackRefExpr {
+	ref := ast.NewBackRefExpr(ast.Pos{})
+	ref.Name = ast.NewIdentifier(ast.Pos{}, name)
+	ref.State = state
+	return ref
+}
@@ -0,0 +1,263 @@
+package builder
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/mna/pigeon/ast"
+)
+
+// Binary returns an option that specifies the binary option. If binary is
+// true, the generated parser matches the input a byte at a time instead of
+// decoding UTF-8 runes: the literals and character classes match bytes,
+// the positions count bytes and the built-in rules that decode binary
+// fields are available, see binaryUints and bytesRule.
+func Binary(binary bool) Option {
+	return func(b *builder) Option {
+		prev := b.binary
+		b.binary = binary
+		return Binary(prev)
+	}
+}
+
+// binaryUint is a built-in rule of the binary parsers that matches an
+// unsigned integer of size bytes.
+type binaryUint struct {
+	size         int
+	littleEndian bool
+}
+
+// binaryUints maps the names of the built-in rules that match a
+// fixed-width unsigned integer to their size and byte order.
+var binaryUints = map[string]binaryUint{
+	"u8":    {size: 1},
+	"u16be": {size: 2},
+	"u16le": {size: 2, littleEndian: true},
+	"u32be": {size: 4},
+	"u32le": {size: 4, littleEndian: true},
+	"u64be": {size: 8},
+	"u64le": {size: 8, littleEndian: true},
+}
+
+// bytesRule is the name of the built-in rule bytes<label>, which matches
+// the number of bytes given by the value of the label.
+const bytesRule = "bytes"
+
+// isBuiltinRule returns true if ref references a built-in rule, of the
+// binary parsers or, for the other parsers, an indentation rule. The rules
+// of the grammar take precedence over the built-in rules.
+func (b *builder) isBuiltinRule(ref *ast.RuleRefExpr) bool {
+	if b.rules[ref.Name.Val] != nil {
+		return false
+	}
+	if !b.binary {
+		_, ok := indentRules[ref.Name.Val]
+		return ok
+	}
+	_, ok := binaryUints[ref.Name.Val]
+	return ok || ref.Name.Val == bytesRule
+}
+
+// checkRuleRefs validates the arguments of the rule references, the labels
+// of the back-references and, for the binary parsers, the matchers of the
+// grammar.
+func (b *builder) checkRuleRefs(g *ast.Grammar) error {
+	for _, rule := range g.Rules {
+		b.pushArgsSet()
+		err := b.checkExpr(rule.Expr)
+		b.popArgsSet()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkExpr validates expr and its sub-expressions, the labels in scope
+// are tracked in the argsStack like for the code blocks.
+func (b *builder) checkExpr(expr ast.Expression) error {
+	switch expr := expr.(type) {
+	case *ast.ActionExpr:
+		return b.checkExpr(expr.Expr)
+
+	case *ast.LabeledExpr:
+		b.addArg(expr.Label)
+		return b.checkNestedExprs(expr.Expr)
+
+	case *ast.AndExpr:
+		return b.checkNestedExprs(expr.Expr)
+
+	case *ast.TextExpr:
+		return b.checkNestedExprs(expr.Expr)
+
+	case *ast.PluckExpr:
+		return b.checkExpr(expr.Expr)
+
+	case *ast.NotExpr:
+		return b.checkNestedExprs(expr.Expr)
+
+	case *ast.OneOrMoreExpr:
+		return b.checkNestedExprs(expr.Expr)
+
+	case *ast.RepeatExpr:
+		return b.checkNestedExprs(expr.Expr)
+
+	case *ast.PrecedenceExpr:
+		if err := b.checkNestedExprs(expr.Operand); err != nil {
+			return err
+		}
+		for _, lvl := range expr.Levels {
+			if err := b.checkNestedExprs(lvl.Op); err != nil {
+				return err
+			}
+		}
+
+	case *ast.ZeroOrMoreExpr:
+		return b.checkNestedExprs(expr.Expr)
+
+	case *ast.ZeroOrOneExpr:
+		return b.checkNestedExprs(expr.Expr)
+
+	case *ast.RecoveryExpr:
+		return b.checkNestedExprs(expr.Expr, expr.RecoverExpr)
+
+	case *ast.ChoiceExpr:
+		for _, alt := range expr.Alternatives {
+			if err := b.checkNestedExprs(alt); err != nil {
+				return err
+			}
+		}
+
+	case *ast.SeqExpr:
+		for _, e := range expr.Exprs {
+			if err := b.checkExpr(e); err != nil {
+				return err
+			}
+		}
+
+	case *ast.RuleRefExpr:
+		return b.checkRuleRef(expr)
+
+	case *ast.BackRefExpr:
+		if !expr.State && !b.inScope(expr.Name.Val) {
+			return fmt.Errorf("%s: undefined label %s", expr.Pos(), expr.Name.Val)
+		}
+
+	case *ast.LitMatcher:
+		if b.binary && expr.IgnoreCase && !isASCII([]rune(expr.Val)) {
+			return fmt.Errorf("%s: case-insensitive literal %q is not ASCII in binary mode", expr.Pos(), expr.Val)
+		}
+
+	case *ast.RegexpMatcher:
+		if b.binary {
+			return fmt.Errorf("%s: regular expression %s is not supported in binary mode", expr.Pos(), expr.Val)
+		}
+
+	case *ast.LitSetMatcher:
+		for _, lit := range expr.Lits {
+			if err := b.checkExpr(lit); err != nil {
+				return err
+			}
+		}
+
+	case *ast.CharClassMatcher:
+		if !b.binary {
+			break
+		}
+		if len(expr.UnicodeClasses) > 0 {
+			return fmt.Errorf("%s: character class %s has Unicode classes in binary mode", expr.Pos(), expr.Val)
+		}
+		runes := append(slices.Clone(expr.Chars), expr.Ranges...)
+		if slices.ContainsFunc(runes, func(rn rune) bool { return rn > 0xff }) {
+			return fmt.Errorf("%s: character class %s has runes above \\xff in binary mode", expr.Pos(), expr.Val)
+		}
+		if expr.IgnoreCase && !isASCII(runes) {
+			return fmt.Errorf("%s: case-insensitive character class %s is not ASCII in binary mode", expr.Pos(), expr.Val)
+		}
+	}
+	return nil
+}
+
+// checkNestedExprs validates the expressions in a new scope of labels.
+func (b *builder) checkNestedExprs(exprs ...ast.Expression) error {
+	b.pushArgsSet()
+	defer b.popArgsSet()
+	for _, expr := range exprs {
+		if err := b.checkExpr(expr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkRuleRef validates the arguments of the rule reference, only the
+// built-in bytes<label> rule takes an argument.
+func (b *builder) checkRuleRef(ref *ast.RuleRefExpr) error {
+	if len(ref.Args) == 0 {
+		return nil
+	}
+	if !b.isBuiltinRule(ref) || ref.Name.Val != bytesRule {
+		return fmt.Errorf("%s: rule %s does not take arguments", ref.Pos(), ref.Name.Val)
+	}
+	label, ok := ref.Args[0].(*ast.RuleRefExpr)
+	if len(ref.Args) != 1 || !ok || len(label.Args) > 0 {
+		return fmt.Errorf("%s: rule %s takes a single label argument", ref.Pos(), bytesRule)
+	}
+	if !b.inScope(label.Name.Val) {
+		return fmt.Errorf("%s: undefined label %s", ref.Pos(), label.Name.Val)
+	}
+	return nil
+}
+
+// inScope returns true if the label is in the scope of the expression
+// being validated.
+func (b *builder) inScope(label string) bool {
+	for _, args := range b.argsStack {
+		if slices.Contains(args, label) {
+			return true
+		}
+	}
+	return false
+}
+
+// isASCII returns true if all runes are in the ASCII range.
+func isASCII(runes []rune) bool {
+	return !slices.ContainsFunc(runes, func(rn rune) bool { return rn >= 0x80 })
+}
+
+// writeBuiltinRuleRef writes the matcher of the built-in rule referenced by
+// ref.
+func (b *builder) writeBuiltinRuleRef(ref *ast.RuleRefExpr) {
+	if !b.binary {
+		b.writeIndentRuleRef(ref)
+		return
+	}
+	if ref.Name.Val == bytesRule {
+		b.writelnf("&bytesExpr{")
+		b.writeExprPos(ref.Pos())
+		b.writelnf("\tlabel: %q,", ref.Args[0].(*ast.RuleRefExpr).Name.Val)
+		b.writelnf("},")
+		return
+	}
+
+	u := binaryUints[ref.Name.Val]
+	b.writelnf("&uintMatcher{")
+	b.writeExprPos(ref.Pos())
+	b.writelnf("\tname: %q,", ref.Name.Val)
+	b.writelnf("\tsize: %d,", u.size)
+	b.writelnf("\tlittleEndian: %t,", u.littleEndian)
+	b.writelnf("},")
+}
+
+// builtinRuleCall returns the Go expression that evaluates the built-in
+// rule referenced by ref, for the compiled parsers.
+func (b *builder) builtinRuleCall(ref *ast.RuleRefExpr) string {
+	if !b.binary {
+		return indentRuleCall(ref)
+	}
+	if ref.Name.Val == bytesRule {
+		return fmt.Sprintf("p.matchBytes(%q)", ref.Args[0].(*ast.RuleRefExpr).Name.Val)
+	}
+	u := binaryUints[ref.Name.Val]
+	return fmt.Sprintf("p.matchUint(%q, %d, %t)", ref.Name.Val, u.size, u.littleEndian)
+}
@@ -0,0 +1,61 @@
+package builder
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/mna/pigeon/ast"
+	"github.com/mna/pigeon/bootstrap"
+)
+
+func TestBinaryErrors(t *testing.T) {
+	// the bootstrap parser does not support the rule arguments, they are
+	// set on the rule references named in args.
+	cases := []struct {
+		src    string
+		args   map[string]string
+		binary bool
+		err    string
+	}{
+		{src: "A = n:u8 bytes", args: map[string]string{"bytes": "n"}, err: "rule bytes does not take arguments"},
+		{src: "A = n:u8 B\nB = .", args: map[string]string{"B": "n"}, binary: true, err: "rule B does not take arguments"},
+		{src: "A = n:u8 bytes\nbytes = .", args: map[string]string{"bytes": "n"}, binary: true, err: "rule bytes does not take arguments"},
+		{src: "A = n:u8 bytes", args: map[string]string{"bytes": "m"}, binary: true, err: "undefined label m"},
+		{src: "A = (n:u8)? bytes", args: map[string]string{"bytes": "n"}, binary: true, err: "undefined label n"},
+		{src: `A = [\pL]`, binary: true, err: `character class [\pL] has Unicode classes in binary mode`},
+		{src: `A = [a-ā]`, binary: true, err: `character class [a-ā] has runes above \xff in binary mode`},
+		{src: `A = [é]i`, binary: true, err: `case-insensitive character class [é]i is not ASCII in binary mode`},
+		{src: `A = "é"i`, binary: true, err: `case-insensitive literal "é" is not ASCII in binary mode`},
+		{src: "A = n:u8 bytes", args: map[string]string{"bytes": "n"}, binary: true},
+		{src: `A = [\x00-\xff] "\xff"`, binary: true},
+	}
+	for _, tc := range cases {
+		p := bootstrap.NewParser()
+		g, err := p.Parse("", strings.NewReader(tc.src))
+		if err != nil {
+			t.Fatalf("%q: %v", tc.src, err)
+		}
+		ast.Inspect(g, func(expr ast.Expression) bool {
+			if ref, ok := expr.(*ast.RuleRefExpr); ok {
+				if label, ok := tc.args[ref.Name.Val]; ok {
+					arg := ast.NewRuleRefExpr(ref.Pos())
+					arg.Name = ast.NewIdentifier(ref.Pos(), label)
+					ref.Args = []ast.Expression{arg}
+				}
+			}
+			return true
+		})
+
+		err = BuildParser(io.Discard, g, Binary(tc.binary))
+		if tc.err == "" {
+			if err != nil {
+				t.Errorf("%q: want no error, got %v", tc.src, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%q: want error %q, got %v", tc.src, tc.err, err)
+		}
+	}
+}
@@ -110,6 +110,32 @@ func BasicLatinLookupTable(basicLatinLookupTable bool) Option {
 	}
 }
 
+// LazyPositions returns an option that specifies the lazyPositions option.
+// If lazyPositions is true, the generated parser only tracks the byte
+// offset while parsing, the line and column are computed from an index of
+// the line starts when an error is reported or when the position of an
+// action is read. It has no effect on the binary parsers.
+func LazyPositions(lazyPositions bool) Option {
+	return func(b *builder) Option {
+		prev := b.lazyPositions
+		b.lazyPositions = lazyPositions
+		return LazyPositions(prev)
+	}
+}
+
+// OptimizeDFA returns an option that specifies the optimizeDFA option. If
+// optimizeDFA is true, the regular expressions of the grammar whose value
+// is not used, e.g. the expression of an action or of a text expression,
+// are matched by deterministic finite automata, see collectDFAs. It has no
+// effect on the binary parsers and with coverage.
+func OptimizeDFA(optimizeDFA bool) Option {
+	return func(b *builder) Option {
+		prev := b.optimizeDFA
+		b.optimizeDFA = optimizeDFA
+		return OptimizeDFA(prev)
+	}
+}
+
 // BuildParser builds the PEG parser using the provider grammar. The code is
 // written to the specified w.
 func BuildParser(w io.Writer, g *ast.Grammar, opts ...Option) error {
@@ -130,12 +156,89 @@ type builder struct {
 	nolint                bool
 	supportLeftRecursion  bool
 	haveLeftRecursion     bool
+	memoRules             bool
+	coverFile             string
+	compile               bool
+	binary                bool
+	lazyPositions         bool
+	optimizeDFA           bool
+
+	// name of the skip rule of the grammar, if any
+	skipRule string
+	// true if the grammar has cut expressions
+	haveCut bool
+	// true if the grammar has literal set matchers
+	haveLitSet bool
+	// true if the grammar has precedence expressions
+	havePrecedence bool
+	// true if the grammar references the built-in indentation rules
+	haveIndent bool
+	// true if the grammar has back-references, and the labeled expressions
+	// whose text they match
+	haveBackRef bool
+	textLabels  map[*ast.LabeledExpr]bool
+	// the rules and expressions that are not memoized, and whether the
+	// expression being written is one of them, see collectNoMemo
+	noMemo      map[ast.Expression]bool
+	noMemoRules map[string]bool
+	writeNoMemo bool
+	// true if the grammar has text expressions
+	haveText bool
+	// true if the grammar has sequences with pluck expressions
+	havePluck bool
+	// true if the parser matches case-insensitive literals or character
+	// classes by case folding the input
+	haveCaseFold bool
+	// true if the grammar has regular expression matchers
+	haveRegexp bool
+	// true if the grammar calls matcher functions
+	haveMatcherFunc bool
+	// true if the grammar has throw expressions, and the message templates
+	// of the failure labels
+	haveThrow       bool
+	failureMessages map[string]string
 
 	ruleName  string
 	exprIndex int
+	// ids of the next rule and expression in the memoization table
+	ruleID    int
+	exprID    int
 	argsStack [][]string
 
 	rangeTable bool
+
+	// coverage points, indexed by instrumented expression
+	coverPoints []coverPoint
+	coverExprs  map[ast.Expression]int
+
+	// rules of the grammar by name
+	rules map[string]*ast.Rule
+
+	// FIRST sets and expected values of the rules, for the dispatch tables
+	// of the choices
+	firstSets    *ast.FirstSets
+	ruleExpected map[string]expectedResult
+
+	// expressions generated as methods of the parser, the ids of all the
+	// expressions and the tables they use
+	compiled           []*compiledExpr
+	compiledMethods    map[int]bool
+	compiledVar        int
+	compiledIDs        map[ast.Expression]int
+	coverIDs           map[ast.Expression]int
+	compiledDispatches []compiledDispatch
+	compiledLitSets    []compiledLitSet
+	compiledPrecs      []compiledPrec
+	compiledClasses    []string
+	compiledRegexps    []string
+	compiledRules      []string
+	ruleIndices        map[string]int
+
+	// automata of the regular expressions of the grammar, the expressions
+	// they match and the range tables of their transitions
+	dfas      []*dfa
+	dfaExprs  map[ast.Expression]int
+	dfaTables []string
 }
 
 func (b *builder) setOptions(opts []Option) {
@@ -145,6 +248,18 @@ func (b *builder) setOptions(opts []Option) {
 }
 
 func (b *builder) buildParser(grammar *ast.Grammar) error {
+	if err := ast.ResolveBackRefs(grammar); err != nil {
+		return fmt.Errorf("incorrect grammar: %w", err)
+	}
+	if err := ast.Instantiate(grammar); err != nil {
+		return fmt.Errorf("incorrect grammar: %w", err)
+	}
+	if err := ast.ApplySkip(grammar); err != nil {
+		return fmt.Errorf("incorrect grammar: %w", err)
+	}
+	if err := ast.ApplyLitSets(grammar); err != nil {
+		return fmt.Errorf("incorrect grammar: %w", err)
+	}
 	haveLeftRecursion, err := PrepareGrammar(grammar)
 	if err != nil {
 		return fmt.Errorf("incorrect grammar: %w", err)
@@ -153,12 +268,104 @@ func (b *builder) buildParser(grammar *ast.Grammar) error {
 		return fmt.Errorf("incorrect grammar: %w", ErrHaveLeftRecursion)
 	}
 	b.haveLeftRecursion = haveLeftRecursion
+	b.rules = make(map[string]*ast.Rule, len(grammar.Rules))
+	for _, rule := range grammar.Rules {
+		b.memoRules = b.memoRules || rule.HasAnnotation(ast.MemoAnnotation)
+		if rule.HasAnnotation(ast.SkipAnnotation) {
+			b.skipRule = rule.Name.Val
+		}
+		if rule.Name != nil {
+			b.rules[rule.Name.Val] = rule
+		}
+	}
+	if err := b.checkRuleRefs(grammar); err != nil {
+		return fmt.Errorf("incorrect grammar: %w", err)
+	}
+	if err := checkCuts(grammar); err != nil {
+		return fmt.Errorf("incorrect grammar: %w", err)
+	}
+	b.failureMessages = make(map[string]string, len(grammar.FailureLabels))
+	for _, decl := range grammar.FailureLabels {
+		if _, ok := b.failureMessages[decl.Label.Val]; ok {
+			return fmt.Errorf("incorrect grammar: %s: failure label %s already declared", decl.Pos(), decl.Label.Val)
+		}
+		if err := checkFailureMessage(decl); err != nil {
+			return fmt.Errorf("incorrect grammar: %w", err)
+		}
+		b.failureMessages[decl.Label.Val] = decl.Message
+	}
+	ast.Inspect(grammar, func(expr ast.Expression) bool {
+		switch expr := expr.(type) {
+		case *ast.RuleRefExpr:
+			b.haveIndent = b.haveIndent || b.isIndentRule(expr)
+		case *ast.BackRefExpr:
+			b.haveBackRef = true
+		case *ast.CutExpr:
+			b.haveCut = true
+		case *ast.LitMatcher:
+			b.haveCaseFold = b.haveCaseFold || expr.IgnoreCase && !b.binary
+		case *ast.CharClassMatcher:
+			b.haveCaseFold = b.haveCaseFold || expr.IgnoreCase && !b.binary
+		case *ast.LitSetMatcher:
+			b.haveLitSet = true
+			for _, lit := range expr.Lits {
+				b.haveCaseFold = b.haveCaseFold || lit.IgnoreCase && !b.binary
+			}
+		case *ast.RegexpMatcher:
+			b.haveRegexp = true
+		case *ast.MatcherFuncExpr:
+			b.haveMatcherFunc = true
+		case *ast.ThrowExpr:
+			b.haveThrow = true
+		case *ast.PrecedenceExpr:
+			b.havePrecedence = true
+		case *ast.TextExpr:
+			b.haveText = true
+		case *ast.SeqExpr:
+			b.havePluck = b.havePluck || len(expr.Plucked()) > 0
+		}
+		return true
+	})
+
+	if b.haveBackRef {
+		b.textLabels = make(map[*ast.LabeledExpr]bool)
+		b.captureTexts(grammar)
+	}
+	b.collectNoMemo(grammar)
+
+	if b.coverFile != "" {
+		b.collectCoverPoints(grammar)
+	} else {
+		// the coverage counts the empty matches in the alternatives that
+		// fail, so the choices only dispatch on the current rune without it.
+		b.firstSets = ast.NewFirstSets(grammar)
+		b.ruleExpected = make(map[string]expectedResult)
+		if b.optimizeDFA && !b.binary {
+			b.collectDFAs(grammar)
+		}
+	}
+
+	if b.compile {
+		b.compiledIDs = make(map[ast.Expression]int)
+		b.compiledMethods = make(map[int]bool)
+		b.coverIDs = make(map[ast.Expression]int)
+		b.ruleIndices = make(map[string]int, len(grammar.Rules))
+		for _, rule := range grammar.Rules {
+			if rule.Name != nil {
+				b.ruleIndices[rule.Name.Val] = len(b.ruleIndices)
+			}
+		}
+	}
 
 	b.writeInit(grammar.Init)
 	b.writeGrammar(grammar)
+	if b.coverFile != "" {
+		b.writeCoverPoints()
+	}
 	for _, rule := range grammar.Rules {
 		b.writeRuleCode(rule)
 	}
+	b.writeDFAs()
 	b.writeStaticCode()
 
 	return b.err
@@ -177,13 +384,20 @@ func (b *builder) writeInit(init *ast.CodeBlock) {
 func (b *builder) writeGrammar(g *ast.Grammar) {
 	// transform the ast grammar to the self-contained, no dependency version
 	// of the parser-generator grammar.
+	b.ruleID, b.exprID = 0, len(g.Rules)
 	b.writelnf("var g = &grammar {")
 	b.writelnf("\trules: []*rule{")
 	for _, r := range g.Rules {
 		b.writeRule(r)
 	}
 	b.writelnf("\t},")
+	if b.skipRule != "" {
+		b.writelnf("\tskip: %q,", b.skipRule)
+	}
 	b.writelnf("}")
+	if b.compile {
+		b.writeCompiledExprs()
+	}
 }
 
 func (b *builder) writeRule(r *ast.Rule) {
@@ -201,16 +415,47 @@ func (b *builder) writeRule(r *ast.Rule) {
 	}
 	pos := r.Pos()
 	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
-	b.writef("\texpr: ")
-	b.writeExpr(r.Expr)
+	if b.memoizable() {
+		if b.noMemoRules[r.Name.Val] && !r.LeftRecursive {
+			b.writelnf("\tid: -1,")
+		} else {
+			b.writelnf("\tid: %d,", b.ruleID)
+		}
+		b.ruleID++
+	}
+	if b.compile {
+		b.numberExpr(r.Expr)
+		b.compiledRules = append(b.compiledRules, b.compiledFunc(r.Expr))
+	} else {
+		b.writef("\texpr: ")
+		b.writeExpr(r.Expr)
+	}
 	if b.haveLeftRecursion {
 		b.writelnf("\tleader: %t,", r.Leader)
 		b.writelnf("\tleftRecursive: %t,", r.LeftRecursive)
 	}
+	if r.HasAnnotation(ast.MemoAnnotation) {
+		b.writelnf("\tmemoize: true,")
+	}
 	b.writelnf("},")
 }
 
 func (b *builder) writeExpr(expr ast.Expression) {
+	b.writeNoMemo = b.noMemo[expr]
+	if id, ok := b.coverExprs[expr]; ok {
+		// remove it so that the wrapped expression is written as usual
+		delete(b.coverExprs, expr)
+		b.writeCoverExpr(id, expr)
+		return
+	}
+	if i, ok := b.dfaExprs[expr]; ok {
+		// remove it so that the expression of the automaton is written as
+		// usual
+		delete(b.dfaExprs, expr)
+		b.writeDFAExpr(i, expr)
+		return
+	}
+
 	b.exprIndex++
 	switch expr := expr.(type) {
 	case *ast.ActionExpr:
@@ -221,28 +466,47 @@ func (b *builder) writeExpr(expr ast.Expression) {
 		b.writeAndExpr(expr)
 	case *ast.AnyMatcher:
 		b.writeAnyMatcher(expr)
+	case *ast.BackRefExpr:
+		b.writeBackRefExpr(expr)
 	case *ast.CharClassMatcher:
 		b.writeCharClassMatcher(expr)
 	case *ast.ChoiceExpr:
 		b.writeChoiceExpr(expr)
+	case *ast.CutExpr:
+		b.writeCutExpr(expr)
 	case *ast.LabeledExpr:
 		b.writeLabeledExpr(expr)
 	case *ast.LitMatcher:
 		b.writeLitMatcher(expr)
+	case *ast.LitSetMatcher:
+		b.writeLitSetMatcher(expr)
+	case *ast.MatcherFuncExpr:
+		b.writeMatcherFuncExpr(expr)
 	case *ast.NotCodeExpr:
 		b.writeNotCodeExpr(expr)
 	case *ast.NotExpr:
 		b.writeNotExpr(expr)
 	case *ast.OneOrMoreExpr:
 		b.writeOneOrMoreExpr(expr)
+	case *ast.PluckExpr:
+		// the sequence returns the value of the expression, see writeSeqExpr
+		b.writeExpr(expr.Expr)
+	case *ast.PrecedenceExpr:
+		b.writePrecedenceExpr(expr)
 	case *ast.RecoveryExpr:
 		b.writeRecoveryExpr(expr)
+	case *ast.RegexpMatcher:
+		b.writeRegexpMatcher(expr)
+	case *ast.RepeatExpr:
+		b.writeRepeatExpr(expr)
 	case *ast.RuleRefExpr:
 		b.writeRuleRefExpr(expr)
 	case *ast.SeqExpr:
 		b.writeSeqExpr(expr)
 	case *ast.StateCodeExpr:
 		b.writeStateCodeExpr(expr)
+	case *ast.TextExpr:
+		b.writeTextExpr(expr)
 	case *ast.ThrowExpr:
 		b.writeThrowExpr(expr)
 	case *ast.ZeroOrMoreExpr:
@@ -264,7 +528,7 @@ func (b *builder) writeActionExpr(act *ast.ActionExpr) {
 	}
 	b.writelnf("&actionExpr{")
 	pos := act.Pos()
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
 	b.writelnf("\trun: (*parser).call%s,", b.funcName(act.FuncIx))
 	b.writef("\texpr: ")
 	b.writeExpr(act.Expr)
@@ -281,7 +545,7 @@ func (b *builder) writeAndCodeExpr(and *ast.AndCodeExpr) {
 	if and.FuncIx == 0 {
 		and.FuncIx = b.exprIndex
 	}
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
 	b.writelnf("\trun: (*parser).call%s,", b.funcName(and.FuncIx))
 	b.writelnf("},")
 }
@@ -293,7 +557,7 @@ func (b *builder) writeAndExpr(and *ast.AndExpr) {
 	}
 	b.writelnf("&andExpr{")
 	pos := and.Pos()
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
 	b.writef("\texpr: ")
 	b.writeExpr(and.Expr)
 	b.writelnf("},")
@@ -306,10 +570,59 @@ func (b *builder) writeAnyMatcher(any *ast.AnyMatcher) {
 	}
 	b.writelnf("&anyMatcher{")
 	pos := any.Pos()
-	b.writelnf("\tline: %d, col: %d, offset: %d,", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
+	b.writelnf("},")
+}
+
+func (b *builder) writeRegexpMatcher(re *ast.RegexpMatcher) {
+	if re == nil {
+		b.writelnf("nil,")
+		return
+	}
+	b.writelnf("&regexpMatcher{")
+	pos := re.Pos()
+	b.writeExprPos(pos)
+	b.writelnf("\tre: %s,", regexpLit(re))
+	if re.LooksBehind() {
+		b.writelnf("\tafter: %s,", regexpAfterLit(re))
+	}
+	b.writelnf("\twant: %q,", re.Val)
 	b.writelnf("},")
 }
 
+// regexpLit returns the Go expression that compiles the regular expression
+// of re, anchored at the start of the input.
+func regexpLit(re *ast.RegexpMatcher) string {
+	return fmt.Sprintf("regexp.MustCompile(%q)", `\A(?:`+re.Expr+`)`)
+}
+
+// regexpAfterLit returns the Go expression that compiles the regular
+// expression of re, anchored at the start of the input and preceded by a
+// rune, so that its assertions see the rune before the current position,
+// see matchRegexp.
+func regexpAfterLit(re *ast.RegexpMatcher) string {
+	return fmt.Sprintf("regexp.MustCompile(%q)", `\A(?s:.)(?:`+re.Expr+`)`)
+}
+
+func (b *builder) writeMatcherFuncExpr(m *ast.MatcherFuncExpr) {
+	if m == nil {
+		b.writelnf("nil,")
+		return
+	}
+	b.writelnf("&matcherFuncExpr{")
+	pos := m.Pos()
+	b.writeExprPos(pos)
+	b.writelnf("\tfn: %s,", m.Name.Val)
+	b.writelnf("\twant: %q,", matcherFuncWant(m))
+	b.writelnf("},")
+}
+
+// matcherFuncWant returns the name of the matcher function m in the
+// expected list of the errors.
+func matcherFuncWant(m *ast.MatcherFuncExpr) string {
+	return "@" + m.Name.Val + "()"
+}
+
 func (b *builder) writeCharClassMatcher(ch *ast.CharClassMatcher) {
 	if ch == nil {
 		b.writelnf("nil,")
@@ -317,24 +630,27 @@ func (b *builder) writeCharClassMatcher(ch *ast.CharClassMatcher) {
 	}
 	b.writelnf("&charClassMatcher{")
 	pos := ch.Pos()
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
 	b.writelnf("\tval: %q,", ch.Val)
 	if len(ch.Chars) > 0 {
 		b.writef("\tchars: []rune{")
 		for _, rn := range ch.Chars {
 			if ch.IgnoreCase {
-				b.writef("%q,", unicode.ToLower(rn))
+				b.writef("%q,", ast.CaseFold(rn))
 			} else {
 				b.writef("%q,", rn)
 			}
 		}
 		b.writelnf("},")
 	}
-	if len(ch.Ranges) > 0 {
+	if ch.Derived {
+		// the ranges of the derived classes are matched as a range table
+		b.writelnf("\tclasses: []*unicode.RangeTable{%s},", rangeTableLit(ch.Ranges))
+	} else if len(ch.Ranges) > 0 {
 		b.writef("\tranges: []rune{")
 		for _, rn := range ch.Ranges {
 			if ch.IgnoreCase {
-				b.writef("%q,", unicode.ToLower(rn))
+				b.writef("%q,", ast.CaseFold(rn))
 			} else {
 				b.writef("%q,", rn)
 			}
@@ -359,39 +675,29 @@ func (b *builder) writeCharClassMatcher(ch *ast.CharClassMatcher) {
 
 // BasicLatinLookup calculates the decision results for the first 256 characters of the UTF-8 character
 // set for a given set of chars, ranges and unicodeClasses to speedup the CharClassMatcher.
+// The characters of a case-insensitive class are case folded, like the input.
 func BasicLatinLookup(chars, ranges []rune, unicodeClasses []string, ignoreCase bool) (basicLatinChars [128]bool) {
-	for _, rn := range chars {
-		if rn < 128 {
-			basicLatinChars[rn] = true
-			if ignoreCase {
-				if unicode.IsLower(rn) {
-					basicLatinChars[unicode.ToUpper(rn)] = true
-				} else {
-					basicLatinChars[unicode.ToLower(rn)] = true
-				}
-			}
+	fold := func(rn rune) rune {
+		if ignoreCase {
+			return ast.CaseFold(rn)
 		}
+		return rn
 	}
-	for i := 0; i < len(ranges); i += 2 {
-		if ranges[i] < 128 {
-			for j := ranges[i]; j < 128 && j <= ranges[i+1]; j++ {
-				basicLatinChars[j] = true
-				if ignoreCase {
-					if unicode.IsLower(j) {
-						basicLatinChars[unicode.ToUpper(j)] = true
-					} else {
-						basicLatinChars[unicode.ToLower(j)] = true
-					}
-				}
-			}
-		}
+	tables := make([]*unicode.RangeTable, len(unicodeClasses))
+	for i, cl := range unicodeClasses {
+		tables[i] = rangeTable(cl)
 	}
-	for _, cl := range unicodeClasses {
-		rt := rangeTable(cl)
-		for r := rune(0); r < 128; r++ {
-			if unicode.Is(rt, r) {
-				basicLatinChars[r] = true
-			}
+
+	for r := rune(0); r < 128; r++ {
+		cur := fold(r)
+		for _, rn := range chars {
+			basicLatinChars[r] = basicLatinChars[r] || fold(rn) == cur
+		}
+		for i := 0; i < len(ranges); i += 2 {
+			basicLatinChars[r] = basicLatinChars[r] || cur >= fold(ranges[i]) && cur <= fold(ranges[i+1])
+		}
+		for _, rt := range tables {
+			basicLatinChars[r] = basicLatinChars[r] || unicode.Is(rt, cur)
 		}
 	}
 	return
@@ -404,7 +710,7 @@ func (b *builder) writeChoiceExpr(ch *ast.ChoiceExpr) {
 	}
 	b.writelnf("&choiceExpr{")
 	pos := ch.Pos()
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
 	if len(ch.Alternatives) > 0 {
 		b.writelnf("\talternatives: []any{")
 		for _, alt := range ch.Alternatives {
@@ -412,6 +718,11 @@ func (b *builder) writeChoiceExpr(ch *ast.ChoiceExpr) {
 		}
 		b.writelnf("\t},")
 	}
+	if d := b.choiceDispatch(ch); d != nil {
+		b.writef("\tdispatch: ")
+		b.writeChoiceDispatch(d)
+		b.writelnf(",")
+	}
 	b.writelnf("},")
 }
 
@@ -422,10 +733,13 @@ func (b *builder) writeLabeledExpr(lab *ast.LabeledExpr) {
 	}
 	b.writelnf("&labeledExpr{")
 	pos := lab.Pos()
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
 	if lab.Label != nil && lab.Label.Val != "" {
 		b.writelnf("\tlabel: %q,", lab.Label.Val)
 	}
+	if b.textLabels[lab] {
+		b.writelnf("\ttextKey: %q,", textKey(lab.Label.Val))
+	}
 	b.writef("\texpr: ")
 	b.writeExpr(lab.Expr)
 	b.writelnf("},")
@@ -438,21 +752,49 @@ func (b *builder) writeLitMatcher(lit *ast.LitMatcher) {
 	}
 	b.writelnf("&litMatcher{")
 	pos := lit.Pos()
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
 	if lit.IgnoreCase {
-		b.writelnf("\tval: %q,", strings.ToLower(lit.Val))
+		b.writelnf("\tval: %q,", ast.FoldString(lit.Val))
 	} else {
 		b.writelnf("\tval: %q,", lit.Val)
 	}
 	b.writelnf("\tignoreCase: %t,", lit.IgnoreCase)
-	ignoreCaseFlag := ""
-	if lit.IgnoreCase {
-		ignoreCaseFlag = "i"
+	b.writelnf("\twant: %q,", litWant(lit))
+	b.writelnf("},")
+}
+
+func (b *builder) writeLitSetMatcher(set *ast.LitSetMatcher) {
+	if set == nil {
+		b.writelnf("nil,")
+		return
 	}
-	b.writelnf("\twant: %q,", strconv.Quote(lit.Val)+ignoreCaseFlag)
+	b.writelnf("&litSetMatcher{")
+	pos := set.Pos()
+	b.writeExprPos(pos)
+	b.writeLitSetFields(set)
 	b.writelnf("},")
 }
 
+// writeLitSetFields writes the fields of the literal set matcher, other
+// than its position and id.
+func (b *builder) writeLitSetFields(set *ast.LitSetMatcher) {
+	b.writelnf("	lits: []litSetLit{")
+	for _, lit := range set.Lits {
+		val := lit.Val
+		if lit.IgnoreCase {
+			val = ast.FoldString(val)
+		}
+		b.writelnf("		{val: %q, ignoreCase: %t, want: %q},", val, lit.IgnoreCase, litWant(lit))
+	}
+	b.writelnf("	},")
+	if set.Longest {
+		b.writelnf("	longest: true,")
+	}
+	if set.Boundary {
+		b.writelnf("	boundary: true,")
+	}
+}
+
 func (b *builder) writeNotCodeExpr(not *ast.NotCodeExpr) {
 	if not == nil {
 		b.writelnf("nil,")
@@ -463,7 +805,7 @@ func (b *builder) writeNotCodeExpr(not *ast.NotCodeExpr) {
 	if not.FuncIx == 0 {
 		not.FuncIx = b.exprIndex
 	}
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
 	b.writelnf("\trun: (*parser).call%s,", b.funcName(not.FuncIx))
 	b.writelnf("},")
 }
@@ -475,7 +817,7 @@ func (b *builder) writeNotExpr(not *ast.NotExpr) {
 	}
 	b.writelnf("&notExpr{")
 	pos := not.Pos()
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
 	b.writef("\texpr: ")
 	b.writeExpr(not.Expr)
 	b.writelnf("},")
@@ -488,7 +830,10 @@ func (b *builder) writeOneOrMoreExpr(one *ast.OneOrMoreExpr) {
 	}
 	b.writelnf("&oneOrMoreExpr{")
 	pos := one.Pos()
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
+	if one.Skip {
+		b.writelnf("\tskip: true,")
+	}
 	b.writef("\texpr: ")
 	b.writeExpr(one.Expr)
 	b.writelnf("},")
@@ -501,7 +846,7 @@ func (b *builder) writeRecoveryExpr(recover *ast.RecoveryExpr) {
 	}
 	b.writelnf("&recoveryExpr{")
 	pos := recover.Pos()
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
 
 	b.writef("\texpr: ")
 	b.writeExpr(recover.Expr)
@@ -515,14 +860,78 @@ func (b *builder) writeRecoveryExpr(recover *ast.RecoveryExpr) {
 	b.writelnf("},")
 }
 
+func (b *builder) writePrecedenceExpr(prec *ast.PrecedenceExpr) {
+	if prec == nil {
+		b.writelnf("nil,")
+		return
+	}
+	b.writelnf("&precExpr{")
+	pos := prec.Pos()
+	b.writeExprPos(pos)
+	if prec.Skip {
+		b.writelnf("\tskip: true,")
+	}
+	b.writef("\toperand: ")
+	b.writeExpr(prec.Operand)
+	b.writelnf("\tlevels: []precLevel{")
+	for _, lvl := range prec.Levels {
+		b.writelnf("\t{")
+		b.writelnf("\tkind: %s,", precKinds[lvl.Kind])
+		b.writef("\top: ")
+		b.writeExpr(lvl.Op)
+		if lvl.Code != nil {
+			b.exprIndex++
+			if lvl.FuncIx == 0 {
+				lvl.FuncIx = b.exprIndex
+			}
+			b.writelnf("\trun: (*parser).call%s,", b.funcName(lvl.FuncIx))
+		}
+		b.writelnf("\t},")
+	}
+	b.writelnf("\t},")
+	b.writelnf("},")
+}
+
+// precKinds are the names of the kinds of the levels of the precedence
+// expressions in the generated parser.
+var precKinds = map[ast.PrecedenceKind]string{
+	ast.PrecLeft:    "precLeft",
+	ast.PrecRight:   "precRight",
+	ast.PrecNone:    "precNone",
+	ast.PrecPrefix:  "precPrefix",
+	ast.PrecPostfix: "precPostfix",
+}
+
+func (b *builder) writeRepeatExpr(rep *ast.RepeatExpr) {
+	if rep == nil {
+		b.writelnf("nil,")
+		return
+	}
+	b.writelnf("&repeatExpr{")
+	pos := rep.Pos()
+	b.writeExprPos(pos)
+	if rep.Skip {
+		b.writelnf("\tskip: true,")
+	}
+	b.writelnf("\tmin: %d,", rep.Min)
+	b.writelnf("\tmax: %d,", rep.Max)
+	b.writef("\texpr: ")
+	b.writeExpr(rep.Expr)
+	b.writelnf("},")
+}
+
 func (b *builder) writeRuleRefExpr(ref *ast.RuleRefExpr) {
 	if ref == nil {
 		b.writelnf("nil,")
 		return
 	}
+	if b.isBuiltinRule(ref) {
+		b.writeBuiltinRuleRef(ref)
+		return
+	}
 	b.writelnf("&ruleRefExpr{")
 	pos := ref.Pos()
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
 	if ref.Name != nil && ref.Name.Val != "" {
 		b.writelnf("\tname: %q,", ref.Name.Val)
 	}
@@ -536,7 +945,24 @@ func (b *builder) writeSeqExpr(seq *ast.SeqExpr) {
 	}
 	b.writelnf("&seqExpr{")
 	pos := seq.Pos()
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
+	if seq.Skip {
+		b.writelnf("\tskip: true,")
+	}
+	if i := seq.CutIndex(); i >= 0 {
+		b.writelnf("\tcut: %d,", i+1)
+	}
+	if ix := seq.Plucked(); len(ix) > 0 {
+		// the indexes are those of the values, without the cuts
+		for i, j := range ix {
+			for _, e := range seq.Exprs[:j] {
+				if _, ok := e.(*ast.CutExpr); ok {
+					ix[i]--
+				}
+			}
+		}
+		b.writelnf("\tpluck: %#v,", ix)
+	}
 	if len(seq.Exprs) > 0 {
 		b.writelnf("\texprs: []any{")
 		for _, e := range seq.Exprs {
@@ -558,11 +984,76 @@ func (b *builder) writeStateCodeExpr(state *ast.StateCodeExpr) {
 	if state.FuncIx == 0 {
 		state.FuncIx = b.exprIndex
 	}
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
 	b.writelnf("\trun: (*parser).call%s,", b.funcName(state.FuncIx))
 	b.writelnf("},")
 }
 
+func (b *builder) writeTextExpr(text *ast.TextExpr) {
+	if text == nil {
+		b.writelnf("nil,")
+		return
+	}
+	b.writelnf("&textExpr{")
+	pos := text.Pos()
+	b.writeExprPos(pos)
+	b.writef("\texpr: ")
+	b.writeExpr(text.Expr)
+	b.writelnf("},")
+}
+
+// checkCuts returns an error if a cut is in a predicate, an optional
+// expression or a repetition: a failure after the cut stops the parsing,
+// instead of failing the predicate or ending the repetition.
+func checkCuts(g *ast.Grammar) error {
+	var err error
+	ast.Inspect(g, func(expr ast.Expression) bool {
+		var in, what string
+		var sub ast.Expression
+		switch expr := expr.(type) {
+		case *ast.AndExpr:
+			in, what = "predicate", "&"
+			sub = expr.Expr
+		case *ast.NotExpr:
+			in, what = "predicate", "!"
+			sub = expr.Expr
+		case *ast.ZeroOrOneExpr:
+			in, what = "optional expression", "?"
+			sub = expr.Expr
+		case *ast.ZeroOrMoreExpr:
+			in, what = "repetition", "*"
+			sub = expr.Expr
+		case *ast.OneOrMoreExpr:
+			in, what = "repetition", "+"
+			sub = expr.Expr
+		case *ast.RepeatExpr:
+			in, what = "repetition", "{n,m}"
+			sub = expr.Expr
+		default:
+			return err == nil
+		}
+		ast.Inspect(sub, func(expr ast.Expression) bool {
+			if cut, ok := expr.(*ast.CutExpr); ok && err == nil {
+				err = fmt.Errorf("%s: cut in %q %s", cut.Pos(), what, in)
+			}
+			return err == nil
+		})
+		return false
+	})
+	return err
+}
+
+func (b *builder) writeCutExpr(cut *ast.CutExpr) {
+	if cut == nil {
+		b.writelnf("nil,")
+		return
+	}
+	b.writelnf("&cutExpr{")
+	pos := cut.Pos()
+	b.writeExprPos(pos)
+	b.writelnf("},")
+}
+
 func (b *builder) writeThrowExpr(throw *ast.ThrowExpr) {
 	if throw == nil {
 		b.writelnf("nil,")
@@ -570,11 +1061,37 @@ func (b *builder) writeThrowExpr(throw *ast.ThrowExpr) {
 	}
 	b.writelnf("&throwExpr{")
 	pos := throw.Pos()
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
 	b.writelnf("\tlabel: %q,", throw.Label)
+	b.writelnf("\tmessage: %q,", b.failureMessage(throw))
 	b.writelnf("},")
 }
 
+// failurePlaceholder matches the placeholders of a message template.
+var failurePlaceholder = regexp.MustCompile(`\{\w+\}`)
+
+// checkFailureMessage returns an error if the message template of decl
+// has a placeholder other than {label}, {expected} and {found}.
+func checkFailureMessage(decl *ast.FailureLabelDecl) error {
+	for _, ph := range failurePlaceholder.FindAllString(decl.Message, -1) {
+		switch ph {
+		case "{label}", "{expected}", "{found}":
+		default:
+			return fmt.Errorf("%s: unknown placeholder %s in the message of failure label %s", decl.Pos(), ph, decl.Label.Val)
+		}
+	}
+	return nil
+}
+
+// failureMessage returns the message template of the label of throw, the
+// label itself if it is not declared.
+func (b *builder) failureMessage(throw *ast.ThrowExpr) string {
+	if msg, ok := b.failureMessages[throw.Label]; ok {
+		return msg
+	}
+	return throw.Label
+}
+
 func (b *builder) writeZeroOrMoreExpr(zero *ast.ZeroOrMoreExpr) {
 	if zero == nil {
 		b.writelnf("nil,")
@@ -582,7 +1099,10 @@ func (b *builder) writeZeroOrMoreExpr(zero *ast.ZeroOrMoreExpr) {
 	}
 	b.writelnf("&zeroOrMoreExpr{")
 	pos := zero.Pos()
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
+	if zero.Skip {
+		b.writelnf("\tskip: true,")
+	}
 	b.writef("\texpr: ")
 	b.writeExpr(zero.Expr)
 	b.writelnf("},")
@@ -595,12 +1115,33 @@ func (b *builder) writeZeroOrOneExpr(zero *ast.ZeroOrOneExpr) {
 	}
 	b.writelnf("&zeroOrOneExpr{")
 	pos := zero.Pos()
-	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	b.writeExprPos(pos)
 	b.writef("\texpr: ")
 	b.writeExpr(zero.Expr)
 	b.writelnf("},")
 }
 
+// memoizable returns true if the generated parser may memoize the results
+// of rules, which are then identified by an id in the memoization table.
+func (b *builder) memoizable() bool {
+	return b.haveLeftRecursion || b.memoRules || !b.optimize
+}
+
+// writeExprPos writes the position of an expression and, if the generated
+// parser may memoize its results, its id in the memoization table, -1 if
+// its results are not memoized.
+func (b *builder) writeExprPos(pos ast.Pos) {
+	b.writelnf("\tpos: position{line: %d, col: %d, offset: %d},", pos.Line, pos.Col, pos.Off)
+	if !b.optimize {
+		if b.writeNoMemo {
+			b.writelnf("\tid: -1,")
+		} else {
+			b.writelnf("\tid: %d,", b.exprID)
+		}
+		b.exprID++
+	}
+}
+
 func (b *builder) writeRuleCode(rule *ast.Rule) {
 	if rule == nil || rule.Name == nil {
 		return
@@ -670,6 +1211,17 @@ func (b *builder) writeExprCode(expr ast.Expression) {
 		b.writeExprCode(expr.Expr)
 		b.popArgsSet()
 
+	case *ast.PrecedenceExpr:
+		b.writePrecedenceExprCode(expr)
+
+	case *ast.RepeatExpr:
+		b.pushArgsSet()
+		b.writeExprCode(expr.Expr)
+		b.popArgsSet()
+
+	case *ast.PluckExpr:
+		b.writeExprCode(expr.Expr)
+
 	case *ast.RecoveryExpr:
 		b.pushArgsSet()
 		b.writeExprCode(expr.Expr)
@@ -684,6 +1236,11 @@ func (b *builder) writeExprCode(expr ast.Expression) {
 	case *ast.StateCodeExpr:
 		b.writeStateCodeExprCode(expr)
 
+	case *ast.TextExpr:
+		b.pushArgsSet()
+		b.writeExprCode(expr.Expr)
+		b.popArgsSet()
+
 	case *ast.ZeroOrMoreExpr:
 		b.pushArgsSet()
 		b.writeExprCode(expr.Expr)
@@ -736,6 +1293,35 @@ func (b *builder) writeStateCodeExprCode(state *ast.StateCodeExpr) {
 	}
 }
 
+func (b *builder) writePrecedenceExprCode(prec *ast.PrecedenceExpr) {
+	if prec == nil {
+		return
+	}
+	b.pushArgsSet()
+	b.writeExprCode(prec.Operand)
+	b.popArgsSet()
+	for _, lvl := range prec.Levels {
+		b.pushArgsSet()
+		b.writeExprCode(lvl.Op)
+		b.popArgsSet()
+	}
+
+	// the actions of the levels get the operands and the operator
+	for _, lvl := range prec.Levels {
+		if lvl.FuncIx == 0 {
+			continue
+		}
+		args := []string{"l", "op", "r"}
+		if lvl.Kind == ast.PrecPrefix || lvl.Kind == ast.PrecPostfix {
+			args = []string{"op", "x"}
+		}
+		b.argsStack = append(b.argsStack, args)
+		b.writeFunc(lvl.FuncIx, lvl.Code, callFuncTemplate, onFuncTemplate)
+		b.popArgsSet()
+		lvl.FuncIx = 0 // already rendered, prevent duplicates
+	}
+}
+
 func (b *builder) writeFunc(funcIx int, code *ast.CodeBlock, callTpl, funcTpl string) {
 	if code == nil {
 		return
@@ -784,12 +1370,48 @@ func (b *builder) writeStaticCode() {
 		GlobalState           bool
 		LeftRecursion         bool
 		Nolint                bool
+		MemoRules             bool
+		Coverage              bool
+		Compile               bool
+		Binary                bool
+		LazyPositions         bool
+		Skip                  bool
+		Cut                   bool
+		LitSet                bool
+		Precedence            bool
+		Indent                bool
+		BackRef               bool
+		Text                  bool
+		Pluck                 bool
+		CaseFold              bool
+		Regexp                bool
+		DFA                   bool
+		MatcherFunc           bool
+		Throw                 bool
 	}{
 		Optimize:              b.optimize,
 		BasicLatinLookupTable: b.basicLatinLookupTable,
 		GlobalState:           b.globalState,
 		LeftRecursion:         b.haveLeftRecursion,
 		Nolint:                b.nolint,
+		MemoRules:             b.memoRules,
+		Coverage:              b.coverFile != "",
+		Compile:               b.compile,
+		Binary:                b.binary,
+		LazyPositions:         b.lazyPositions && !b.binary,
+		Skip:                  b.skipRule != "",
+		Cut:                   b.haveCut,
+		LitSet:                b.haveLitSet,
+		Precedence:            b.havePrecedence,
+		Indent:                b.haveIndent,
+		BackRef:               b.haveBackRef,
+		Text:                  b.haveText,
+		Pluck:                 b.havePluck,
+		CaseFold:              b.haveCaseFold,
+		Regexp:                b.haveRegexp,
+		DFA:                   len(b.dfas) > 0,
+		MatcherFunc:           b.haveMatcherFunc,
+		Throw:                 b.haveThrow,
 	}
 	t := template.Must(template.New("static_code").Parse(staticCode))
 
@@ -819,6 +1441,9 @@ func (b *builder) writeStaticCode() {
 	if b.rangeTable {
 		b.writeln(rangeTable0)
 	}
+	if b.haveCaseFold {
+		b.writeFullCaseFolds()
+	}
 }
 
 func (b *builder) funcName(ix int) string {
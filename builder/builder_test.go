@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/mna/pigeon/ast"
 	"github.com/mna/pigeon/bootstrap"
 )
 
@@ -38,3 +39,120 @@ func TestBuildParser(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestCutErrors(t *testing.T) {
+	lit := testLit("a")
+	cut := ast.NewCutExpr(ast.Pos{Line: 1, Col: 5, Off: 4})
+	ref := ast.NewRuleRefExpr(ast.Pos{})
+	ref.Name = ast.NewIdentifier(ast.Pos{}, "B")
+	repeat := ast.NewRepeatExpr(ast.Pos{})
+	repeat.Expr = testNot(testSeq(lit, cut, lit))
+	repeat.Min, repeat.Max = 1, 2
+
+	cases := []struct {
+		expr ast.Expression
+		err  string
+	}{
+		{expr: testSeq(lit, cut, lit)},
+		// the rules with a cut may be repeated
+		{expr: testStar(ref)},
+		{expr: testNot(testSeq(lit, cut, lit)), err: `1:5 (4): cut in "!" predicate`},
+		{expr: testSeq(lit, testStar(testSeq(lit, cut, lit))), err: `1:5 (4): cut in "*" repetition`},
+		{expr: repeat, err: `1:5 (4): cut in "{n,m}" repetition`},
+	}
+	for _, tc := range cases {
+		testBuildError(t, tc.expr, tc.err, testRule("B", testSeq(lit, cut, lit)))
+	}
+}
+
+func TestFailureMessageErrors(t *testing.T) {
+	cases := []struct {
+		msg string
+		err string
+	}{
+		{msg: "expecting {expected} after {label}, found {found}"},
+		// only the identifiers in braces are placeholders
+		{msg: "expecting '{' or '{ }'"},
+		{msg: "expecting {expected}, found {fonud}", err: "1:1 (0): unknown placeholder {fonud} in the message of failure label errId"},
+	}
+	for _, tc := range cases {
+		g := ast.NewGrammar(ast.Pos{})
+		g.Rules = []*ast.Rule{testRule("A", testLit("a"))}
+		decl := ast.NewFailureLabelDecl(ast.Pos{Line: 1, Col: 1})
+		decl.Label = ast.NewIdentifier(ast.Pos{}, "errId")
+		decl.Message = tc.msg
+		g.FailureLabels = []*ast.FailureLabelDecl{decl}
+
+		err := BuildParser(io.Discard, g)
+		if tc.err == "" {
+			if err != nil {
+				t.Errorf("%q: want no error, got %v", tc.msg, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%q: want error %q, got %v", tc.msg, tc.err, err)
+		}
+	}
+}
+
+func testRule(name string, expr ast.Expression) *ast.Rule {
+	r := ast.NewRule(ast.Pos{}, ast.NewIdentifier(ast.Pos{}, name))
+	r.Expr = expr
+	return r
+}
+
+func testSeq(exprs ...ast.Expression) *ast.SeqExpr {
+	seq := ast.NewSeqExpr(ast.Pos{})
+	seq.Exprs = exprs
+	return seq
+}
+
+func testNot(expr ast.Expression) *ast.NotExpr {
+	not := ast.NewNotExpr(ast.Pos{})
+	not.Expr = expr
+	return not
+}
+
+func testStar(expr ast.Expression) *ast.ZeroOrMoreExpr {
+	star := ast.NewZeroOrMoreExpr(ast.Pos{})
+	star.Expr = expr
+	return star
+}
+
+func testOpt(expr ast.Expression) *ast.ZeroOrOneExpr {
+	opt := ast.NewZeroOrOneExpr(ast.Pos{})
+	opt.Expr = expr
+	return opt
+}
+
+func testLabel(name string, expr ast.Expression) *ast.LabeledExpr {
+	lab := ast.NewLabeledExpr(ast.Pos{})
+	lab.Label = ast.NewIdentifier(ast.Pos{}, name)
+	lab.Expr = expr
+	return lab
+}
+
+func testLit(val string) *ast.LitMatcher {
+	return ast.NewLitMatcher(ast.Pos{}, val)
+}
+
+// testBuildError builds the parser of the grammar of the rule A = expr and
+// of rules, and checks that it fails with an error that contains want, or
+// that it succeeds if want is empty.
+func testBuildError(t *testing.T, expr ast.Expression, want string, rules ...*ast.Rule) {
+	t.Helper()
+
+	g := ast.NewGrammar(ast.Pos{})
+	g.Rules = append([]*ast.Rule{testRule("A", expr)}, rules...)
+	err := BuildParser(io.Discard, g)
+	if want == "" {
+		if err != nil {
+			t.Errorf("%s: want no error, got %v", expr, err)
+		}
+		return
+	}
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("%s: want error %q, got %v", expr, want, err)
+	}
+}
@@ -0,0 +1,26 @@
+package builder
+
+import (
+	"sort"
+
+	"github.com/mna/pigeon/ast"
+)
+
+// writeFullCaseFolds writes the table of the full case foldings of more
+// than one rune, used by the parsers that match case-insensitive literals.
+func (b *builder) writeFullCaseFolds() {
+	folds := ast.FullCaseFolds()
+	rns := make([]rune, 0, len(folds))
+	for rn := range folds {
+		rns = append(rns, rn)
+	}
+	sort.Slice(rns, func(i, j int) bool { return rns[i] < rns[j] })
+
+	b.writelnf("// fullCaseFolds are the full case foldings of more than one rune, with")
+	b.writelnf("// the simple case folding of their runes.")
+	b.writelnf("var fullCaseFolds = map[rune]string{")
+	for _, rn := range rns {
+		b.writelnf("\t%#04x: %+q,", rn, folds[rn])
+	}
+	b.writelnf("}")
+}
@@ -0,0 +1,47 @@
+package builder
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// rangeTableLit returns the Go expression of the range table of the pairs
+// of low/high runes of a derived character class, which are sorted and
+// disjoint.
+func rangeTableLit(ranges []rune) string {
+	var r16, r32 []string
+	latinOffset := 0
+	for i := 0; i+1 < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		if lo <= 0xffff {
+			// the ranges of R16 and R32 cannot overlap
+			h := hi
+			if h > 0xffff {
+				h = 0xffff
+			}
+			r16 = append(r16, fmt.Sprintf("{Lo: %#04x, Hi: %#04x, Stride: 1},", lo, h))
+			if h <= unicode.MaxLatin1 {
+				latinOffset++
+			}
+			lo = h + 1
+		}
+		if lo <= hi {
+			r32 = append(r32, fmt.Sprintf("{Lo: %#x, Hi: %#x, Stride: 1},", lo, hi))
+		}
+	}
+
+	var buf strings.Builder
+	buf.WriteString("&unicode.RangeTable{\n")
+	if len(r16) > 0 {
+		buf.WriteString("R16: []unicode.Range16{\n" + strings.Join(r16, "\n") + "\n},\n")
+	}
+	if len(r32) > 0 {
+		buf.WriteString("R32: []unicode.Range32{\n" + strings.Join(r32, "\n") + "\n},\n")
+	}
+	if latinOffset > 0 {
+		fmt.Fprintf(&buf, "LatinOffset: %d,\n", latinOffset)
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
@@ -0,0 +1,1066 @@
+package builder
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mna/pigeon/ast"
+)
+
+// Compile returns an option that specifies the compile option. If compile
+// is true, each rule of the grammar is generated as a specialised Go
+// function of the parser, with the code of its expressions inlined,
+// instead of a node of the grammar table that the parser interprets. The
+// compiled parser produces the same results, but it is faster and its code
+// is larger.
+func Compile(compile bool) Option {
+	return func(b *builder) Option {
+		prev := b.compile
+		b.compile = compile
+		return Compile(prev)
+	}
+}
+
+// compiledExpr is an expression generated as a parser method named
+// expr<id>: the expression of a rule, or an expression that the parser
+// invokes through a function value, e.g. the operand of a precedence
+// expression.
+type compiledExpr struct {
+	expr ast.Expression
+	rule string
+	id   int
+}
+
+// compiledDispatch is the dispatch table of the compiled choice
+// expression id.
+type compiledDispatch struct {
+	id       int
+	dispatch *choiceDispatch
+}
+
+// compiledLitSet is the literal set matcher of the compiled expression id.
+type compiledLitSet struct {
+	id  int
+	set *ast.LitSetMatcher
+}
+
+// compiledPrec is the precedence expression of the compiled expression
+// id, in the rule that names the functions of its code blocks.
+type compiledPrec struct {
+	id   int
+	rule string
+	prec *ast.PrecedenceExpr
+}
+
+// numberExpr assigns an id to expr and its sub-expressions in the order
+// the grammar table is written, so that the code blocks get the same
+// names as with the interpreted parser. The id identifies the results of
+// the expression in the memoization table.
+func (b *builder) numberExpr(expr ast.Expression) {
+	if _, ok := b.coverExprs[expr]; ok {
+		b.coverIDs[expr] = b.exprID
+		b.exprID++
+	}
+
+	b.exprIndex++
+	b.compiledIDs[expr] = b.exprID
+	b.exprID++
+
+	switch expr := expr.(type) {
+	case *ast.ActionExpr:
+		if expr.FuncIx == 0 {
+			expr.FuncIx = b.exprIndex
+		}
+		b.numberExpr(expr.Expr)
+	case *ast.AndCodeExpr:
+		if expr.FuncIx == 0 {
+			expr.FuncIx = b.exprIndex
+		}
+	case *ast.NotCodeExpr:
+		if expr.FuncIx == 0 {
+			expr.FuncIx = b.exprIndex
+		}
+	case *ast.StateCodeExpr:
+		b.globalState = true
+		if expr.FuncIx == 0 {
+			expr.FuncIx = b.exprIndex
+		}
+	case *ast.AndExpr:
+		b.numberExpr(expr.Expr)
+	case *ast.NotExpr:
+		b.numberExpr(expr.Expr)
+	case *ast.BackRefExpr:
+		b.globalState = b.globalState || expr.State
+	case *ast.LabeledExpr:
+		b.numberExpr(expr.Expr)
+	case *ast.PluckExpr:
+		b.numberExpr(expr.Expr)
+	case *ast.TextExpr:
+		b.numberExpr(expr.Expr)
+	case *ast.OneOrMoreExpr:
+		b.numberExpr(expr.Expr)
+	case *ast.ZeroOrMoreExpr:
+		b.numberExpr(expr.Expr)
+	case *ast.ZeroOrOneExpr:
+		b.numberExpr(expr.Expr)
+	case *ast.RepeatExpr:
+		b.numberExpr(expr.Expr)
+	case *ast.PrecedenceExpr:
+		b.numberExpr(expr.Operand)
+		for _, lvl := range expr.Levels {
+			b.numberExpr(lvl.Op)
+			if lvl.Code != nil {
+				b.exprIndex++
+				if lvl.FuncIx == 0 {
+					lvl.FuncIx = b.exprIndex
+				}
+			}
+		}
+	case *ast.RecoveryExpr:
+		b.numberExpr(expr.Expr)
+		b.numberExpr(expr.RecoverExpr)
+	case *ast.ChoiceExpr:
+		for _, alt := range expr.Alternatives {
+			b.numberExpr(alt)
+		}
+	case *ast.SeqExpr:
+		for _, e := range expr.Exprs {
+			b.numberExpr(e)
+		}
+	}
+}
+
+// compiledID returns the id of expr in the memoization table, which is the
+// id of its coverage wrapper if it is instrumented.
+func (b *builder) compiledID(expr ast.Expression) int {
+	if id, ok := b.coverIDs[expr]; ok {
+		return id
+	}
+	return b.compiledIDs[expr]
+}
+
+// compiledFunc returns a Go function value that evaluates expr, the method
+// expr<id> of the parser, which is written by writeCompiledExprs.
+func (b *builder) compiledFunc(expr ast.Expression) string {
+	id := b.compiledIDs[expr]
+	if !b.compiledMethods[id] {
+		b.compiledMethods[id] = true
+		b.compiled = append(b.compiled, &compiledExpr{expr: expr, rule: b.ruleName, id: id})
+	}
+	return fmt.Sprintf("(*parser).expr%d", id)
+}
+
+// writeCompiledExprs writes the methods of the compiled expressions and
+// the tables they use.
+func (b *builder) writeCompiledExprs() {
+	// the methods refer to g to invoke the rules, so they are attached to
+	// the rules once g is initialized.
+	b.writelnf("func init() {")
+	for i, run := range b.compiledRules {
+		b.writelnf("\tg.rules[%d].run = %s", i, run)
+	}
+	b.writelnf("}\n")
+
+	// the methods of the precedence tables are added to b.compiled while
+	// it is written.
+	for i := 0; i < len(b.compiled); i++ {
+		ce := b.compiled[i]
+		b.ruleName = ce.rule
+		b.writeCompiledExpr(ce)
+		for _, cp := range b.compiledPrecs {
+			b.writeCompiledPrec(cp)
+		}
+		b.compiledPrecs = b.compiledPrecs[:0]
+	}
+	for _, cd := range b.compiledDispatches {
+		b.writef("var expr%dDispatch = ", cd.id)
+		b.writeChoiceDispatch(cd.dispatch)
+		b.writelnf("\n")
+	}
+	for _, cs := range b.compiledLitSets {
+		b.writelnf("var expr%dLitSet = &litSetMatcher{", cs.id)
+		b.writeLitSetFields(cs.set)
+		b.writelnf("}\n")
+	}
+	if len(b.compiledClasses) > 0 {
+		b.writelnf("var compiledClasses = []*unicode.RangeTable{")
+		for _, cl := range b.compiledClasses {
+			b.writelnf("\t%s,", cl)
+		}
+		b.writelnf("}")
+	}
+	if len(b.compiledRegexps) > 0 {
+		b.writelnf("var compiledRegexps = []*regexp.Regexp{")
+		for _, re := range b.compiledRegexps {
+			b.writelnf("\t%s,", re)
+		}
+		b.writelnf("}")
+	}
+}
+
+// writeCompiledExpr writes the method of the compiled expression, which
+// evaluates the expression and its sub-expressions without calling other
+// methods, except to invoke the rules.
+func (b *builder) writeCompiledExpr(ce *compiledExpr) {
+	b.compiledVar = 0
+	b.writelnf("func (p *parser) expr%d() (any, bool) {", ce.id)
+	b.writelnf("\tvar val any")
+	b.writelnf("\tvar ok bool")
+	b.writeInline(ce.expr, "val", "ok")
+	b.writelnf("\treturn val, ok")
+	b.writelnf("}\n")
+}
+
+// compiledVarName returns a new variable name with the prefix, unique in
+// the method being written.
+func (b *builder) compiledVarName(prefix string) string {
+	b.compiledVar++
+	return prefix + strconv.Itoa(b.compiledVar)
+}
+
+// writeInline writes the code that evaluates expr, which assigns its value
+// to val and its result to ok. The variables are nil and false before the
+// code, which only assigns them if the expression matches. val may be "_"
+// if the value is not used, so that it is not built if it can be avoided,
+// e.g. the values of the sequence of an action.
+func (b *builder) writeInline(expr ast.Expression, val, ok string) {
+	b.writelnf("{")
+	defer b.writelnf("}")
+
+	if b.optimize || b.noMemo[expr] {
+		b.writeInlineCounted(expr, val, ok)
+		return
+	}
+
+	// the results are memoized if memoization is enabled, see
+	// collectNoMemo for the expressions that are not.
+	id := b.compiledID(expr)
+	res, pt := b.compiledVarName("res"), b.compiledVarName("pt")
+	b.writelnf("if %s, hit := p.compiledMemoized(%d); hit {", res, id)
+	b.writelnf("%s, %s = %s.v, %s.b", val, ok, res, res)
+	b.writelnf("} else {")
+	b.writelnf("%s := p.pt", pt)
+	b.writeInlineCounted(expr, val, ok)
+	if val == "_" {
+		// the value is not used, see writeInline
+		val = "nil"
+	}
+	b.writelnf("p.compiledMemoize(%s, %d, %s, %s)", pt, id, val, ok)
+	b.writelnf("}")
+}
+
+// writeInlineCounted writes the code of writeInline that counts the
+// evaluation of expr, in ExprCnt and in its coverage point, and that
+// evaluates it with its automaton if it has one.
+func (b *builder) writeInlineCounted(expr ast.Expression, val, ok string) {
+	b.writelnf("p.countExpr()")
+	if !b.optimize {
+		b.writelnf("if p.debug {")
+		b.writelnf("p.in(%q)", b.compiledDebugName(expr))
+		b.writelnf("}")
+	}
+	if i, isDFA := b.dfaExprs[expr]; isDFA {
+		// the automaton does not record the matches that an inverted
+		// predicate expects, see failAt, nor the statistics of the rules
+		// and the alternatives that it matches.
+		cond := "!p.maxFailInvertExpected"
+		if !b.optimize {
+			cond += " && p.Stats == nil"
+		}
+		b.writelnf("if %s {", cond)
+		b.writelnf("%s, %s = p.dfa%d()", val, ok, i)
+		b.writelnf("} else {")
+		b.writeInlineExpr(expr, val, ok)
+		b.writelnf("}")
+	} else {
+		b.writeInlineExpr(expr, val, ok)
+	}
+	if point, isCovered := b.coverExprs[expr]; isCovered {
+		b.writelnf("if %s {", ok)
+		b.writelnf("atomic.AddUint64(&coverCounts[%d], 1)", point)
+		b.writelnf("}")
+	}
+	if !b.optimize {
+		b.writelnf("if p.debug {")
+		b.writelnf("p.out(%q)", b.compiledDebugName(expr))
+		b.writelnf("}")
+	}
+}
+
+// writeInlineExpr writes the code of writeInline specific to the type of
+// expr.
+func (b *builder) writeInlineExpr(expr ast.Expression, val, ok string) {
+	switch expr := expr.(type) {
+	case *ast.ActionExpr:
+		b.writeCompiledActionExpr(expr, val, ok)
+	case *ast.AndCodeExpr:
+		b.writeCompiledCodeExpr(expr.FuncIx, false, ok)
+	case *ast.NotCodeExpr:
+		b.writeCompiledCodeExpr(expr.FuncIx, true, ok)
+	case *ast.StateCodeExpr:
+		b.writelnf("if err := p.call%s(); err != nil {", b.funcName(expr.FuncIx))
+		b.writelnf("p.addErr(err)")
+		b.writelnf("}")
+		b.writelnf("%s = true", ok)
+	case *ast.AndExpr:
+		b.writeCompiledLookahead(expr.Expr, false, ok)
+	case *ast.NotExpr:
+		b.writeCompiledLookahead(expr.Expr, true, ok)
+	case *ast.AnyMatcher:
+		b.writeCompiledAnyMatcher(val, ok)
+	case *ast.CharClassMatcher:
+		b.writeCompiledCharClassMatcher(expr, val, ok)
+	case *ast.LitMatcher:
+		b.writeCompiledLitMatcher(expr, val, ok)
+	case *ast.RegexpMatcher:
+		after := "nil"
+		if expr.LooksBehind() {
+			after = fmt.Sprintf("compiledRegexps[%d]", b.compiledRegexp(regexpAfterLit(expr)))
+		}
+		b.writelnf("%s, %s = p.matchRegexp(compiledRegexps[%d], %s, %q)", val, ok, b.compiledRegexp(regexpLit(expr)), after, expr.Val)
+	case *ast.MatcherFuncExpr:
+		b.writelnf("%s, %s = p.matchFunc(%s, %q)", val, ok, expr.Name.Val, matcherFuncWant(expr))
+	case *ast.LitSetMatcher:
+		id := b.compiledIDs[expr]
+		b.compiledLitSets = append(b.compiledLitSets, compiledLitSet{id: id, set: expr})
+		b.writelnf("%s, %s = p.matchLitSet(expr%dLitSet)", val, ok, id)
+	case *ast.ChoiceExpr:
+		b.writeCompiledChoiceExpr(expr, val, ok)
+	case *ast.BackRefExpr:
+		b.writelnf("%s, %s = p.matchBackRef(%q, %t)", val, ok, backRefKey(expr), expr.State)
+	case *ast.LabeledExpr:
+		b.writeCompiledLabeledExpr(expr, val, ok)
+	case *ast.PluckExpr:
+		b.writeInline(expr.Expr, val, ok)
+	case *ast.TextExpr:
+		b.writeCompiledTextExpr(expr, val, ok)
+	case *ast.OneOrMoreExpr:
+		b.writeCompiledRepeat(expr.Expr, true, expr.Skip, val, ok)
+	case *ast.ZeroOrMoreExpr:
+		b.writeCompiledRepeat(expr.Expr, false, expr.Skip, val, ok)
+	case *ast.RepeatExpr:
+		b.writeCompiledRepeatExpr(expr, val, ok)
+	case *ast.PrecedenceExpr:
+		id := b.compiledIDs[expr]
+		b.compiledPrecs = append(b.compiledPrecs, compiledPrec{id: id, rule: b.ruleName, prec: expr})
+		b.writelnf("%s, %s = p.parsePrec(expr%dPrec, 0)", val, ok, id)
+	case *ast.ZeroOrOneExpr:
+		b.writePushV(expr.Expr)
+		b.writeInline(expr.Expr, val, ok)
+		b.writePopV(expr.Expr)
+		b.writelnf("%s = true", ok)
+	case *ast.RecoveryExpr:
+		b.writef("p.pushRecovery([]string{")
+		for _, label := range expr.Labels {
+			b.writef("%q,", label)
+		}
+		b.writelnf("}, %s)", b.compiledFunc(expr.RecoverExpr))
+		b.writeInline(expr.Expr, val, ok)
+		b.writelnf("p.popRecovery()")
+	case *ast.CutExpr:
+		b.writelnf("%s = true", ok)
+	case *ast.ThrowExpr:
+		b.writelnf("%s, %s = p.throw(%q, %q)", val, ok, expr.Label, b.failureMessage(expr))
+	case *ast.RuleRefExpr:
+		b.writeCompiledRuleRefExpr(expr, val, ok)
+	case *ast.SeqExpr:
+		b.writeCompiledSeqExpr(expr, val, ok)
+	default:
+		b.err = fmt.Errorf("%s: unsupported expression type %T", expr.Pos(), expr)
+	}
+}
+
+// compiledDebugName returns the name of the expression in the debug
+// output, the name of the method of the interpreted parser.
+func (b *builder) compiledDebugName(expr ast.Expression) string {
+	switch expr := expr.(type) {
+	case *ast.RuleRefExpr:
+		switch {
+		case !b.isBuiltinRule(expr):
+			return "parseRuleRefExpr " + expr.Name.Val
+		case !b.binary:
+			return "parseIndentExpr " + expr.Name.Val
+		case expr.Name.Val == bytesRule:
+			return "parseBytesExpr " + expr.Args[0].(*ast.RuleRefExpr).Name.Val
+		}
+		return "parseUintMatcher " + expr.Name.Val
+	case *ast.RecoveryExpr:
+		labels := make([]string, len(expr.Labels))
+		for i, label := range expr.Labels {
+			labels[i] = string(label)
+		}
+		return "parseRecoveryExpr (" + strings.Join(labels, ",") + ")"
+	}
+	name := fmt.Sprintf("%T", expr)
+	return "parse" + name[strings.LastIndex(name, ".")+1:]
+}
+
+// stateful returns true if the compiled expressions must save and restore
+// the global state.
+func (b *builder) stateful() bool {
+	return b.globalState || !b.optimize
+}
+
+// usesVars returns true if expr stores labeled values in the variable sets
+// of the parser or runs code that reads them, so that it must be evaluated
+// in its own set if the interpreted parser pushes one for it.
+func usesVars(expr ast.Expression) bool {
+	var uses bool
+	ast.Inspect(expr, func(expr ast.Expression) bool {
+		switch expr := expr.(type) {
+		case *ast.LabeledExpr:
+			uses = uses || expr.Label != nil && expr.Label.Val != ""
+		case *ast.ActionExpr, *ast.AndCodeExpr, *ast.NotCodeExpr, *ast.StateCodeExpr,
+			*ast.PrecedenceExpr, *ast.ThrowExpr:
+			uses = true
+		}
+		return !uses
+	})
+	return uses
+}
+
+// writePushV writes the push of a new variable set for expr, if it uses
+// them, see usesVars.
+func (b *builder) writePushV(expr ast.Expression) {
+	if usesVars(expr) {
+		b.writelnf("p.pushV()")
+	}
+}
+
+// writePopV writes the pop of the variable set pushed by writePushV.
+func (b *builder) writePopV(expr ast.Expression) {
+	if usesVars(expr) {
+		b.writelnf("p.popV()")
+	}
+}
+
+// writeCompiledActionExpr writes the action, whose expression is
+// evaluated without building its value, which is replaced by the value of
+// the code block.
+func (b *builder) writeCompiledActionExpr(act *ast.ActionExpr, val, ok string) {
+	start := b.compiledVarName("start")
+	b.writelnf("%s := p.pt", start)
+	b.writeInline(act.Expr, "_", ok)
+	b.writelnf("if %s {", ok)
+	if b.lazyPositions && !b.binary {
+		b.writelnf("p.cur.pos = p.resolvePosition(%s.position)", start)
+	} else {
+		b.writelnf("p.cur.pos = %s.position", start)
+	}
+	b.writelnf("p.cur.text = p.sliceFrom(%s)", start)
+	if b.stateful() {
+		b.writelnf("state := p.cloneState()")
+	}
+	b.writelnf("actVal, err := p.call%s()", b.funcName(act.FuncIx))
+	b.writelnf("if err != nil {")
+	b.writelnf("p.addErrAt(err, %s.position, []string{})", start)
+	b.writelnf("}")
+	if b.stateful() {
+		b.writelnf("p.restoreState(state)")
+	}
+	b.writelnf("%s = actVal", val)
+	if !b.optimize {
+		b.writelnf("if p.debug {")
+		b.writelnf("p.printIndent(\"MATCH\", string(p.sliceFrom(%s)))", start)
+		b.writelnf("}")
+	}
+	b.writelnf("}")
+}
+
+func (b *builder) writeCompiledCodeExpr(funcIx int, not bool, ok string) {
+	if b.stateful() {
+		b.writelnf("state := p.cloneState()")
+	}
+	b.writelnf("match, err := p.call%s()", b.funcName(funcIx))
+	b.writelnf("if err != nil {")
+	b.writelnf("p.addErr(err)")
+	b.writelnf("}")
+	if b.stateful() {
+		b.writelnf("p.restoreState(state)")
+	}
+	if not {
+		b.writelnf("%s = !match", ok)
+	} else {
+		b.writelnf("%s = match", ok)
+	}
+}
+
+func (b *builder) writeCompiledLookahead(expr ast.Expression, not bool, ok string) {
+	pt := b.compiledVarName("pt")
+	b.writelnf("%s := p.pt", pt)
+	var state string
+	if b.stateful() {
+		state = b.compiledVarName("state")
+		b.writelnf("%s := p.cloneState()", state)
+	}
+	b.writePushV(expr)
+	if not {
+		b.writelnf("p.maxFailInvertExpected = !p.maxFailInvertExpected")
+	}
+	match := b.compiledVarName("match")
+	b.writelnf("var %s bool", match)
+	b.writeInline(expr, "_", match)
+	if not {
+		b.writelnf("p.maxFailInvertExpected = !p.maxFailInvertExpected")
+	}
+	b.writePopV(expr)
+	if b.stateful() {
+		b.writelnf("p.restoreState(%s)", state)
+	}
+	b.writelnf("p.restore(%s)", pt)
+	if not {
+		b.writelnf("%s = !%s", ok, match)
+	} else {
+		b.writelnf("%s = %s", ok, match)
+	}
+}
+
+func (b *builder) writeCompiledAnyMatcher(val, ok string) {
+	b.writelnf("if p.pt.rn == utf8.RuneError && p.pt.w == 0 {")
+	b.writelnf("// EOF - see utf8.DecodeRune")
+	b.writelnf("p.failAt(false, p.pt.position, \".\")")
+	b.writelnf("} else {")
+	b.writelnf("start := p.pt")
+	b.writelnf("p.read()")
+	b.writelnf("p.failAt(true, start.position, \".\")")
+	b.writeCompiledMatch(val, ok)
+	b.writelnf("}")
+}
+
+// writeCompiledMatch writes the assignment of the text matched from start
+// to val and of true to ok.
+func (b *builder) writeCompiledMatch(val, ok string) {
+	if val != "_" {
+		b.writelnf("%s = p.sliceFrom(start)", val)
+	}
+	b.writelnf("%s = true", ok)
+}
+
+// writeCompiledCharClassMatcher writes the char class as a switch on the
+// current rune.
+func (b *builder) writeCompiledCharClassMatcher(ch *ast.CharClassMatcher, val, ok string) {
+	fold := func(r rune) rune {
+		if ch.IgnoreCase {
+			return ast.CaseFold(r)
+		}
+		return r
+	}
+
+	b.writelnf("cur := p.pt.rn")
+	b.writelnf("start := p.pt")
+	b.writelnf("var matched bool")
+	b.writelnf("// can't match EOF")
+	b.writelnf("eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune")
+	if b.basicLatinLookupTable {
+		// the lookup table of the interpreted parser is compiled as well
+		table := BasicLatinLookup(ch.Chars, ch.Ranges, ch.UnicodeClasses, ch.IgnoreCase)
+		var conds []string
+		for lo := 0; lo < len(table); lo++ {
+			if !table[lo] {
+				continue
+			}
+			hi := lo
+			for hi+1 < len(table) && table[hi+1] {
+				hi++
+			}
+			conds = append(conds, runeRangeCond(rune(lo), rune(hi)))
+			lo = hi
+		}
+		b.writelnf("if cur < 128 {")
+		if len(conds) > 0 {
+			b.writelnf("switch {")
+			b.writelnf("case %s:", strings.Join(conds, ", "))
+			b.writelnf("matched = true")
+			b.writelnf("}")
+		}
+		b.writef("} else ")
+	}
+
+	b.writelnf("if !eof {")
+	if len(ch.Chars) > 0 || len(ch.Ranges) > 0 || len(ch.UnicodeClasses) > 0 {
+		if ch.IgnoreCase && b.haveCaseFold {
+			b.writelnf("cur = caseFold(cur)")
+		} else if ch.IgnoreCase {
+			b.writelnf("cur = unicode.ToLower(cur)")
+		}
+
+		var conds []string
+		if len(ch.Chars) > 0 {
+			chars := make([]string, len(ch.Chars))
+			for i, rn := range ch.Chars {
+				chars[i] = strconv.QuoteRune(fold(rn))
+			}
+			b.writelnf("switch cur {")
+			b.writelnf("case %s:", strings.Join(chars, ", "))
+			b.writelnf("matched = true")
+			b.writelnf("}")
+		}
+		if ch.Derived {
+			conds = append(conds, fmt.Sprintf("unicode.Is(compiledClasses[%d], cur)", b.compiledClass(rangeTableLit(ch.Ranges))))
+		} else {
+			for i := 0; i+1 < len(ch.Ranges); i += 2 {
+				conds = append(conds, runeRangeCond(fold(ch.Ranges[i]), fold(ch.Ranges[i+1])))
+			}
+		}
+		for _, cl := range ch.UnicodeClasses {
+			b.rangeTable = true
+			conds = append(conds, fmt.Sprintf("unicode.Is(compiledClasses[%d], cur)", b.compiledClass(fmt.Sprintf("rangeTable(%q)", cl))))
+		}
+		if len(conds) > 0 {
+			b.writelnf("switch {")
+			b.writelnf("case %s:", strings.Join(conds, ", "))
+			b.writelnf("matched = true")
+			b.writelnf("}")
+		}
+	}
+	b.writelnf("}")
+
+	cond := "matched"
+	if ch.Inverted {
+		cond = "!matched"
+	}
+	b.writelnf("if %s && !eof {", cond)
+	b.writelnf("p.read()")
+	b.writelnf("p.failAt(true, start.position, %q)", ch.Val)
+	b.writeCompiledMatch(val, ok)
+	b.writelnf("} else {")
+	b.writelnf("p.failAt(false, start.position, %q)", ch.Val)
+	b.writelnf("}")
+}
+
+// runeRangeCond returns the condition that tests if cur is in the range
+// lo-hi.
+func runeRangeCond(lo, hi rune) string {
+	if lo == hi {
+		return "cur == " + strconv.QuoteRune(lo)
+	}
+	return fmt.Sprintf("cur >= %s && cur <= %s", strconv.QuoteRune(lo), strconv.QuoteRune(hi))
+}
+
+// compiledClass returns the index of the range table in the
+// compiledClasses table, class is the Go expression of the table.
+func (b *builder) compiledClass(class string) int {
+	for i, cl := range b.compiledClasses {
+		if cl == class {
+			return i
+		}
+	}
+	b.compiledClasses = append(b.compiledClasses, class)
+	return len(b.compiledClasses) - 1
+}
+
+// compiledRegexp returns the index of the regular expression in the
+// compiledRegexps table, lit is the Go expression that compiles it.
+func (b *builder) compiledRegexp(lit string) int {
+	for i, r := range b.compiledRegexps {
+		if r == lit {
+			return i
+		}
+	}
+	b.compiledRegexps = append(b.compiledRegexps, lit)
+	return len(b.compiledRegexps) - 1
+}
+
+// writeCompiledLitMatcher writes the literal as a prefix test of the
+// remaining input. The case-insensitive literals are matched against the
+// case folding of the input and, unless the parser is binary, the
+// literals that contain utf8.RuneError or invalid UTF-8, which match any
+// invalid UTF-8 input, are matched rune by rune.
+func (b *builder) writeCompiledLitMatcher(lit *ast.LitMatcher, val, ok string) {
+	want := litWant(lit)
+	b.writelnf("start := p.pt")
+	switch {
+	case lit.IgnoreCase && b.haveCaseFold:
+		b.writelnf("if p.matchCaseFold(%q) {", ast.FoldString(lit.Val))
+	case lit.IgnoreCase || !b.binary && (!utf8.ValidString(lit.Val) || strings.ContainsRune(lit.Val, utf8.RuneError)):
+		val := lit.Val
+		if lit.IgnoreCase {
+			val = ast.FoldString(val)
+		}
+		b.writelnf("matched := true")
+		b.writelnf("for _, want := range %q {", val)
+		if lit.IgnoreCase {
+			b.writelnf("if unicode.ToLower(p.pt.rn) != want {")
+		} else {
+			b.writelnf("if p.pt.rn != want {")
+		}
+		b.writelnf("matched = false")
+		b.writelnf("break")
+		b.writelnf("}")
+		b.writelnf("p.read()")
+		b.writelnf("}")
+		b.writelnf("if matched {")
+	case lit.Val != "":
+		b.writelnf("if end := p.pt.offset + %d; end <= len(p.data) && string(p.data[p.pt.offset:end]) == %q {", len(lit.Val), lit.Val)
+		if b.binary {
+			b.writelnf("p.skip(%d)", len(lit.Val))
+		} else if n := utf8.RuneCountInString(lit.Val); n == 1 {
+			b.writelnf("p.read()")
+		} else {
+			b.writelnf("for i := 0; i < %d; i++ {", n)
+			b.writelnf("p.read()")
+			b.writelnf("}")
+		}
+	default:
+		b.writelnf("p.failAt(true, start.position, %q)", want)
+		b.writeCompiledMatch(val, ok)
+		return
+	}
+	b.writelnf("p.failAt(true, start.position, %q)", want)
+	b.writeCompiledMatch(val, ok)
+	b.writelnf("} else {")
+	b.writelnf("p.failAt(false, start.position, %q)", want)
+	if lit.IgnoreCase || !b.binary && (!utf8.ValidString(lit.Val) || strings.ContainsRune(lit.Val, utf8.RuneError)) {
+		b.writelnf("p.restore(start)")
+	}
+	b.writelnf("}")
+}
+
+// writeCompiledChoiceExpr writes the alternatives in order, each one is
+// evaluated only if the previous ones did not match.
+func (b *builder) writeCompiledChoiceExpr(ch *ast.ChoiceExpr, val, ok string) {
+	pos := ch.Pos()
+	writeAlt := func(alt ast.Expression, altI string) {
+		var state string
+		if b.stateful() {
+			state = b.compiledVarName("state")
+			b.writelnf("%s := p.cloneState()", state)
+		}
+		b.writePushV(alt)
+		b.writeInline(alt, val, ok)
+		b.writePopV(alt)
+		if !b.optimize {
+			b.writelnf("if %s {", ok)
+			b.writelnf("p.incChoiceAltCnt(position{line: %d, col: %d, offset: %d}, %s)", pos.Line, pos.Col, pos.Off, altI)
+			b.writelnf("}")
+		}
+		if b.stateful() {
+			b.writelnf("if !%s {", ok)
+			b.writelnf("p.restoreState(%s)", state)
+			b.writelnf("}")
+		}
+	}
+
+	if d := b.choiceDispatch(ch); d != nil {
+		// the alternatives are selected by the dispatch table, then
+		// evaluated in a switch on their index.
+		id := b.compiledIDs[ch]
+		b.compiledDispatches = append(b.compiledDispatches, compiledDispatch{id: id, dispatch: d})
+		b.writelnf("d := expr%dDispatch", id)
+		b.writelnf("set := d.set(p.pt)")
+		b.writelnf("for _, want := range d.expected[set] {")
+		b.writelnf("p.failAt(false, p.pt.position, want)")
+		b.writelnf("}")
+		b.writelnf("for _, altI := range d.alts[set] {")
+		b.writelnf("switch altI {")
+		for i, alt := range ch.Alternatives {
+			b.writelnf("case %d:", i)
+			writeAlt(alt, "altI")
+		}
+		b.writelnf("}")
+		b.writelnf("if %s {", ok)
+		b.writelnf("break")
+		b.writelnf("}")
+		b.writelnf("}")
+	} else {
+		for i, alt := range ch.Alternatives {
+			if i > 0 {
+				b.writelnf("if !%s {", ok)
+			}
+			writeAlt(alt, strconv.Itoa(i))
+			if i > 0 {
+				b.writelnf("}")
+			}
+		}
+	}
+	if !b.optimize {
+		b.writelnf("if !%s {", ok)
+		b.writelnf("p.incChoiceAltCnt(position{line: %d, col: %d, offset: %d}, choiceNoMatch)", pos.Line, pos.Col, pos.Off)
+		b.writelnf("}")
+	}
+}
+
+func (b *builder) writeCompiledLabeledExpr(lab *ast.LabeledExpr, val, ok string) {
+	if lab.Label == nil || lab.Label.Val == "" {
+		b.writePushV(lab.Expr)
+		b.writeInline(lab.Expr, val, ok)
+		b.writePopV(lab.Expr)
+		return
+	}
+
+	var start string
+	if b.textLabels[lab] {
+		start = b.compiledVarName("start")
+		b.writelnf("%s := p.pt", start)
+	}
+	v := b.compiledVarName("v")
+	b.writelnf("var %s any", v)
+	b.writelnf("p.pushV()")
+	b.writeInline(lab.Expr, v, ok)
+	b.writelnf("p.popV()")
+	b.writelnf("if %s {", ok)
+	b.writelnf("p.vstack[len(p.vstack)-1][%q] = %s", lab.Label.Val, v)
+	if b.textLabels[lab] {
+		b.writelnf("p.vstack[len(p.vstack)-1][%q] = p.sliceFrom(%s)", textKey(lab.Label.Val), start)
+	}
+	b.writelnf("%s = %s", val, v)
+	b.writelnf("}")
+}
+
+func (b *builder) writeCompiledTextExpr(text *ast.TextExpr, val, ok string) {
+	var start string
+	if val != "_" {
+		start = b.compiledVarName("start")
+		b.writelnf("%s := p.pt", start)
+	}
+	b.writePushV(text.Expr)
+	b.writeInline(text.Expr, "_", ok)
+	b.writePopV(text.Expr)
+	if val != "_" {
+		b.writelnf("if %s {", ok)
+		b.writelnf("%s = string(p.sliceFrom(%s))", val, start)
+		b.writelnf("}")
+	}
+}
+
+// writeCompiledRepeat writes the repetition loop. If skip is true, the
+// skip rule is matched before each repetition but the first, and the
+// input it matched is restored if the repetition fails. The values are
+// only collected if the value of the repetition is used.
+func (b *builder) writeCompiledRepeat(expr ast.Expression, oneOrMore, skip bool, val, ok string) {
+	vals, count := b.writeCompiledRepeatVars(val, oneOrMore || skip)
+	b.writelnf("for {")
+	b.writeCompiledRepeatItem(expr, skip, vals, count)
+	b.writelnf("}")
+	switch {
+	case !oneOrMore:
+		b.writeCompiledRepeatMatch(vals, val, ok)
+	case vals != "":
+		b.writelnf("if len(%s) > 0 {", vals)
+		b.writeCompiledRepeatMatch(vals, val, ok)
+		b.writelnf("}")
+	default:
+		b.writelnf("if %s > 0 {", count)
+		b.writeCompiledRepeatMatch(vals, val, ok)
+		b.writelnf("}")
+	}
+}
+
+// writeCompiledRepeatVars writes the declaration of the variables of a
+// repetition: the slice of its values if val is used, or the number of
+// repetitions if counted is true. It returns their names, the number of
+// repetitions is len(vals) if there is a slice.
+func (b *builder) writeCompiledRepeatVars(val string, counted bool) (vals, count string) {
+	if val != "_" {
+		vals = b.compiledVarName("vals")
+		b.writelnf("var %s []any", vals)
+		return vals, "len(" + vals + ")"
+	}
+	if counted {
+		count = b.compiledVarName("n")
+		b.writelnf("%s := 0", count)
+	}
+	return "", count
+}
+
+// writeCompiledRepeatItem writes the body of the loop of a repetition,
+// which matches expr and breaks out of the loop if it does not match.
+func (b *builder) writeCompiledRepeatItem(expr ast.Expression, skip bool, vals, count string) {
+	var pt string
+	if skip {
+		pt = b.compiledVarName("pt")
+		b.writelnf("%s := p.pt", pt)
+		b.writelnf("if %s > 0 {", count)
+		b.writelnf("p.parseSkip()")
+		b.writelnf("}")
+	}
+	v, match := "_", b.compiledVarName("match")
+	if vals != "" {
+		v = b.compiledVarName("v")
+		b.writelnf("var %s any", v)
+	}
+	b.writelnf("var %s bool", match)
+	b.writePushV(expr)
+	b.writeInline(expr, v, match)
+	b.writePopV(expr)
+	b.writelnf("if !%s {", match)
+	if skip {
+		b.writelnf("p.restore(%s)", pt)
+	}
+	b.writelnf("break")
+	b.writelnf("}")
+	switch {
+	case vals != "":
+		b.writelnf("%s = append(%s, %s)", vals, vals, v)
+	case count != "":
+		b.writelnf("%s++", count)
+	}
+}
+
+// writeCompiledRepeatMatch writes the assignment of the values of a
+// repetition to val and of true to ok.
+func (b *builder) writeCompiledRepeatMatch(vals, val, ok string) {
+	if vals != "" {
+		b.writelnf("%s = %s", val, vals)
+	}
+	b.writelnf("%s = true", ok)
+}
+
+// writeCompiledPrec writes the precedence expression of a compiled
+// expression, with the functions that parse its operand and operators.
+func (b *builder) writeCompiledPrec(cp compiledPrec) {
+	b.ruleName = cp.rule
+	b.writelnf("var expr%dPrec = &precExpr{", cp.id)
+	if cp.prec.Skip {
+		b.writelnf("\tskip: true,")
+	}
+	b.writelnf("\toperand: %s,", b.compiledFunc(cp.prec.Operand))
+	b.writelnf("\tlevels: []precLevel{")
+	for _, lvl := range cp.prec.Levels {
+		b.writef("\t\t{kind: %s, op: %s", precKinds[lvl.Kind], b.compiledFunc(lvl.Op))
+		if lvl.FuncIx > 0 {
+			b.writef(", run: (*parser).call%s", b.funcName(lvl.FuncIx))
+		}
+		b.writelnf("},")
+	}
+	b.writelnf("\t},")
+	b.writelnf("}\n")
+}
+
+// writeCompiledRepeatExpr writes the repetition loop of the bounded
+// repetition, which restores the input if it does not match enough times.
+func (b *builder) writeCompiledRepeatExpr(rep *ast.RepeatExpr, val, ok string) {
+	vals, count := b.writeCompiledRepeatVars(val, rep.Min > 0 || rep.Max >= 0 || rep.Skip)
+	if rep.Min == 0 {
+		b.writeCompiledRepeatLoop(rep, vals, count)
+		b.writeCompiledRepeatMatch(vals, val, ok)
+		return
+	}
+
+	pt := b.compiledVarName("pt")
+	b.writelnf("%s := p.pt", pt)
+	var state string
+	if b.stateful() {
+		state = b.compiledVarName("state")
+		b.writelnf("%s := p.cloneState()", state)
+	}
+	b.writeCompiledRepeatLoop(rep, vals, count)
+	b.writelnf("if %s < %d {", count, rep.Min)
+	if b.stateful() {
+		b.writelnf("p.restoreState(%s)", state)
+	}
+	b.writelnf("p.restore(%s)", pt)
+	b.writelnf("} else {")
+	b.writeCompiledRepeatMatch(vals, val, ok)
+	b.writelnf("}")
+}
+
+// writeCompiledRepeatLoop writes the loop of a bounded repetition.
+func (b *builder) writeCompiledRepeatLoop(rep *ast.RepeatExpr, vals, count string) {
+	if rep.Max < 0 {
+		b.writelnf("for {")
+	} else {
+		b.writelnf("for %s < %d {", count, rep.Max)
+	}
+	b.writeCompiledRepeatItem(rep.Expr, rep.Skip, vals, count)
+	b.writelnf("}")
+}
+
+func (b *builder) writeCompiledRuleRefExpr(ref *ast.RuleRefExpr, val, ok string) {
+	if b.isBuiltinRule(ref) {
+		b.writelnf("%s, %s = %s", val, ok, b.builtinRuleCall(ref))
+		return
+	}
+	ix, found := b.ruleIndices[ref.Name.Val]
+	if !found {
+		b.writelnf("p.addErr(errors.New(%q))", "undefined rule: "+ref.Name.Val)
+		return
+	}
+	b.writelnf("%s, %s = p.parseRuleWrap(g.rules[%d])", val, ok, ix)
+}
+
+// writeCompiledSeqExpr writes the sequence as straight-line code, each
+// expression is evaluated if the previous ones matched. The values of the
+// expressions are only kept if the value of the sequence is used.
+func (b *builder) writeCompiledSeqExpr(seq *ast.SeqExpr, val, ok string) {
+	pt := b.compiledVarName("pt")
+	b.writelnf("%s := p.pt", pt)
+	var state string
+	if b.stateful() {
+		state = b.compiledVarName("state")
+		b.writelnf("%s := p.cloneState()", state)
+	}
+
+	plucked := seq.Plucked()
+	keep := func(i int) bool {
+		if val == "_" {
+			return false
+		}
+		if len(plucked) == 0 {
+			return true
+		}
+		for _, j := range plucked {
+			if i == j {
+				return true
+			}
+		}
+		return false
+	}
+
+	cut := seq.CutIndex()
+	vals := make([]string, len(seq.Exprs))
+	for i, e := range seq.Exprs {
+		if i > 0 && seq.Skip {
+			b.writelnf("p.parseSkip()")
+		}
+		vals[i] = "_"
+		if _, isCut := e.(*ast.CutExpr); !isCut && keep(i) {
+			vals[i] = b.compiledVarName("v")
+			b.writelnf("var %s any", vals[i])
+		}
+		match := b.compiledVarName("match")
+		b.writelnf("var %s bool", match)
+		b.writeInline(e, vals[i], match)
+		b.writelnf("if %s {", match)
+	}
+
+	if val != "_" {
+		switch len(plucked) {
+		case 0:
+			// the cuts have no value
+			var svals []string
+			for _, v := range vals {
+				if v != "_" {
+					svals = append(svals, v)
+				}
+			}
+			b.writelnf("%s = []any{%s}", val, strings.Join(svals, ", "))
+		case 1:
+			b.writelnf("%s = %s", val, vals[plucked[0]])
+		default:
+			pvals := make([]string, len(plucked))
+			for i, j := range plucked {
+				pvals[i] = vals[j]
+			}
+			b.writelnf("%s = []any{%s}", val, strings.Join(pvals, ", "))
+		}
+	}
+	b.writelnf("%s = true", ok)
+
+	// the expressions after the cut must match
+	for i := len(seq.Exprs) - 1; i >= 0; i-- {
+		if cut >= 0 && i > cut {
+			b.writelnf("} else {")
+			b.writelnf("panic(errCutFailure)")
+		}
+		b.writelnf("}")
+	}
+	b.writelnf("if !%s {", ok)
+	if b.stateful() {
+		b.writelnf("p.restoreState(%s)", state)
+	}
+	b.writelnf("p.restore(%s)", pt)
+	b.writelnf("}")
+}
@@ -0,0 +1,50 @@
+package builder
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/mna/pigeon/bootstrap"
+)
+
+func TestCompile(t *testing.T) {
+	src := `
+Start = a:Word _ b:Word { return nil, nil }
+Word = [a-z]+ / "ABC"i / Missing
+_ = " "*
+`
+	p := bootstrap.NewParser()
+	g, err := p.Parse("", strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := BuildParser(&buf, g, Compile(true), Optimize(true)); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	want := []string{
+		"g.rules[0].run = (*parser).expr3",
+		"func (p *parser) expr3() (any, bool) {",
+		"actVal, err := p.callonStart1()",
+		`p.vstack[len(p.vstack)-1]["a"] = v4`,
+		"v4, match3 = p.parseRuleWrap(g.rules[1])",
+		"func (p *parser) expr10() (any, bool) {",
+		`case cur >= 'a' && cur <= 'z':`,
+		`p.failAt(true, start.position, "\"ABC\"i")`,
+		`p.addErr(errors.New("undefined rule: Missing"))`,
+	}
+	for _, w := range want {
+		if !strings.Contains(out, w) {
+			t.Errorf("want %q in the generated code", w)
+		}
+	}
+	// the sub-expressions of the rules are inlined
+	for _, nw := range []string{"&seqExpr{", "&charClassMatcher{", "func (p *parser) parseExprWrap(", "func (p *parser) expr4()"} {
+		if strings.Contains(out, nw) {
+			t.Errorf("want no %q in the generated code", nw)
+		}
+	}
+}
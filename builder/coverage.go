@@ -0,0 +1,106 @@
+package builder
+
+import (
+	"github.com/mna/pigeon/ast"
+)
+
+// Coverage kinds of the instrumented expressions, as written in the
+// coverage profile.
+const (
+	coverRule        = "rule"
+	coverAlternative = "alternative"
+	coverOptional    = "optional"
+	coverRepetition  = "repetition"
+	coverAnd         = "and"
+	coverNot         = "not"
+	coverAndCode     = "andcode"
+	coverNotCode     = "notcode"
+)
+
+// Coverage returns an option that specifies the coverage option. If
+// grammarFile is not empty, every rule, choice alternative, optional and
+// repeated expression and predicate of the grammar is instrumented to
+// count how many times it matched, and the generated parser exports a
+// WriteCoverProfile function to write those counts. The profile refers to
+// the grammar as grammarFile.
+func Coverage(grammarFile string) Option {
+	return func(b *builder) Option {
+		prev := b.coverFile
+		b.coverFile = grammarFile
+		return Coverage(prev)
+	}
+}
+
+// coverPoint is an instrumented expression.
+type coverPoint struct {
+	expr ast.Expression
+	rule string
+	kind string
+}
+
+// collectCoverPoints collects the expressions of the grammar to instrument,
+// in depth-first order, and indexes them by expression in b.coverExprs.
+func (b *builder) collectCoverPoints(g *ast.Grammar) {
+	b.coverExprs = make(map[ast.Expression]int)
+	add := func(rule string, kind string, expr ast.Expression) {
+		if _, ok := b.coverExprs[expr]; ok {
+			return
+		}
+		b.coverExprs[expr] = len(b.coverPoints)
+		b.coverPoints = append(b.coverPoints, coverPoint{expr: expr, rule: rule, kind: kind})
+	}
+
+	for _, r := range g.Rules {
+		rule := r.Name.Val
+		add(rule, coverRule, r.Expr)
+		ast.Inspect(r.Expr, func(expr ast.Expression) bool {
+			switch expr := expr.(type) {
+			case *ast.ChoiceExpr:
+				for _, alt := range expr.Alternatives {
+					add(rule, coverAlternative, alt)
+				}
+			case *ast.ZeroOrOneExpr:
+				add(rule, coverOptional, expr.Expr)
+			case *ast.ZeroOrMoreExpr:
+				add(rule, coverRepetition, expr.Expr)
+			case *ast.OneOrMoreExpr:
+				add(rule, coverRepetition, expr.Expr)
+			case *ast.RepeatExpr:
+				add(rule, coverRepetition, expr.Expr)
+			case *ast.AndExpr:
+				add(rule, coverAnd, expr)
+			case *ast.NotExpr:
+				add(rule, coverNot, expr)
+			case *ast.AndCodeExpr:
+				add(rule, coverAndCode, expr)
+			case *ast.NotCodeExpr:
+				add(rule, coverNotCode, expr)
+			}
+			return true
+		})
+	}
+}
+
+// writeCoverExpr writes the coverExpr wrapping the expression instrumented
+// by the coverage point id.
+func (b *builder) writeCoverExpr(id int, expr ast.Expression) {
+	b.writelnf("&coverExpr{")
+	pos := expr.Pos()
+	b.writeExprPos(pos)
+	b.writelnf("\tpoint: %d,", id)
+	b.writef("\texpr: ")
+	b.writeExpr(expr)
+	b.writelnf("},")
+}
+
+// writeCoverPoints writes the table of coverage points and their counters.
+func (b *builder) writeCoverPoints() {
+	b.writelnf("const coverFile = %q", b.coverFile)
+	b.writelnf("var coverPoints = []coverPoint{")
+	for _, pt := range b.coverPoints {
+		pos := pt.expr.Pos()
+		b.writelnf("\t{pos: position{line: %d, col: %d, offset: %d}, rule: %q, kind: %q},", pos.Line, pos.Col, pos.Off, pt.rule, pt.kind)
+	}
+	b.writelnf("}")
+	b.writelnf("var coverCounts = make([]uint64, len(coverPoints))")
+}
@@ -0,0 +1,1038 @@
+package builder
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mna/pigeon/ast"
+)
+
+// maximum number of states of an automaton, of threads of a state and of
+// items of a thread, the expressions that need more are generated as
+// usual.
+const (
+	maxDFAStates  = 256
+	maxDFAThreads = 64
+	maxDFAItems   = 64
+)
+
+// dfa is the deterministic finite automaton of a regular expression of the
+// grammar. Its states are the paths of the backtracking parser that are
+// still possible after the runes read so far, in the order the parser
+// tries them, so that it matches the same text and records the same
+// failures as the parser.
+type dfa struct {
+	states []*dfaState
+	// true if a transition ends the match at the saved accepted position
+	accept bool
+	// number of read positions that the failures of the literals need
+	hist int
+}
+
+// dfaState is a state of an automaton: its threads in the order of the
+// parser, and done, the path that matched at the accepted position, if any,
+// which is the match unless a thread matches.
+type dfaState struct {
+	threads []dfaThread
+	done    *dfaThread
+	pending []dfaPending
+	frames  int
+
+	edges []*dfaEdge
+	deflt *dfaEdge
+}
+
+// the targets of the transitions that are not states
+const (
+	dfaMatched  = -1 - iota // the runes read so far are the match
+	dfaAccepted             // the match ends at the accepted position
+	dfaFailed               // the expression does not match
+)
+
+// dfaEdge is a transition of a state on its runes, or on the other runes
+// and the end of the input if it is the default transition of the state.
+// The failures are recorded before the rune is read, mark is true if the
+// position after the rune is the accepted position.
+type dfaEdge struct {
+	runes ast.RuneSet
+	fails []dfaFail
+	mark  bool
+	next  int
+}
+
+// dfaFail is a failure of an automaton, the literal that failed started
+// back runes before the current position.
+type dfaFail struct {
+	want string
+	back int
+}
+
+// dfaPending is the failure of a thread that took the alternatives alts,
+// which is recorded only if the previous alternatives fail.
+type dfaPending struct {
+	fail dfaFail
+	alts map[int]int
+}
+
+type dfaItemKind int
+
+const (
+	dfaMatch  dfaItemKind = iota // match expr, n is the offset in a literal
+	dfaCommit                    // alternative n of frame matched
+	dfaStar                      // match expr zero or more times
+	dfaRepeat                    // match the repeat expr, n times so far
+)
+
+type dfaItem struct {
+	kind  dfaItemKind
+	expr  ast.Expression
+	n     int
+	frame int
+}
+
+// dfaThread is a path of the parser: the items it has left to match and
+// the alternatives it took in the frames of the choices and repetitions
+// that a commit of a previous alternative may still discard.
+type dfaThread struct {
+	items []dfaItem
+	alts  map[int]int
+}
+
+// collectDFAs finds the regular expressions of the grammar whose value is
+// not used and builds their automata. The expressions that are matched by
+// an automaton are stored in dfaExprs with the index of the automaton.
+func (b *builder) collectDFAs(g *ast.Grammar) {
+	b.dfaExprs = make(map[ast.Expression]int)
+	indices := make(map[ast.Expression]int)
+
+	// the expressions of the not predicates are parsed as usual, see
+	// parseDFAExpr, so the automata are only built if inverted is false.
+	var walk func(expr ast.Expression, used, inverted bool)
+	walk = func(expr ast.Expression, used, inverted bool) {
+		if !used && !inverted && !isMatcher(expr) && b.isRegular(expr, make(map[string]bool)) {
+			target := b.dfaTarget(expr)
+			i, ok := indices[target]
+			if !ok {
+				i = -1
+				if d := b.buildDFA(target); d != nil {
+					i = len(b.dfas)
+					b.dfas = append(b.dfas, d)
+				}
+				indices[target] = i
+			}
+			if i >= 0 {
+				b.dfaExprs[expr] = i
+				return
+			}
+		}
+
+		switch expr := expr.(type) {
+		case *ast.ActionExpr:
+			walk(expr.Expr, false, inverted)
+		case *ast.AndExpr:
+			walk(expr.Expr, false, inverted)
+		case *ast.NotExpr:
+			walk(expr.Expr, false, !inverted)
+		case *ast.TextExpr:
+			walk(expr.Expr, false, inverted)
+		case *ast.LabeledExpr:
+			walk(expr.Expr, true, inverted)
+		case *ast.PluckExpr:
+			walk(expr.Expr, used, inverted)
+		case *ast.SeqExpr:
+			// the value of a sequence with pluck expressions is the value of
+			// the plucked expressions.
+			plucked := len(expr.Plucked()) > 0
+			for _, e := range expr.Exprs {
+				_, isPluck := e.(*ast.PluckExpr)
+				walk(e, used && (!plucked || isPluck), inverted)
+			}
+		case *ast.ChoiceExpr:
+			for _, alt := range expr.Alternatives {
+				walk(alt, used, inverted)
+			}
+		case *ast.ZeroOrOneExpr:
+			walk(expr.Expr, used, inverted)
+		case *ast.ZeroOrMoreExpr:
+			walk(expr.Expr, used, inverted)
+		case *ast.OneOrMoreExpr:
+			walk(expr.Expr, used, inverted)
+		case *ast.RepeatExpr:
+			walk(expr.Expr, used, inverted)
+		case *ast.RecoveryExpr:
+			walk(expr.Expr, used, inverted)
+			walk(expr.RecoverExpr, used, inverted)
+		case *ast.PrecedenceExpr:
+			walk(expr.Operand, true, inverted)
+			for _, lvl := range expr.Levels {
+				walk(lvl.Op, true, inverted)
+			}
+		}
+	}
+	// any rule can be the entrypoint, so the value of its expression is used
+	for _, rule := range g.Rules {
+		walk(rule.Expr, true, false)
+	}
+}
+
+// isMatcher returns true if expr is a single matcher, which the parser
+// matches as fast as its automaton.
+func isMatcher(expr ast.Expression) bool {
+	switch expr.(type) {
+	case *ast.LitMatcher, *ast.CharClassMatcher, *ast.AnyMatcher:
+		return true
+	}
+	return false
+}
+
+// dfaTarget returns the expression that expr matches, without the text
+// expressions and the references to rules, so that the same automaton
+// matches all the expressions that have the same target.
+func (b *builder) dfaTarget(expr ast.Expression) ast.Expression {
+	for {
+		switch e := expr.(type) {
+		case *ast.TextExpr:
+			expr = e.Expr
+		case *ast.RuleRefExpr:
+			expr = b.rules[e.Name.Val].Expr
+		default:
+			return expr
+		}
+	}
+}
+
+// isRegular returns true if expr only matches literals, character classes
+// and any rune in sequences, choices and repetitions, without recursion,
+// so that an automaton can match it. The repeated expressions cannot
+// match the empty string, and the literals must be case-sensitive and
+// valid UTF-8.
+func (b *builder) isRegular(expr ast.Expression, visiting map[string]bool) bool {
+	switch expr := expr.(type) {
+	case *ast.AnyMatcher, *ast.CharClassMatcher:
+		return true
+	case *ast.LitMatcher:
+		return !expr.IgnoreCase && utf8.ValidString(expr.Val) && !strings.ContainsRune(expr.Val, utf8.RuneError)
+	case *ast.SeqExpr:
+		if expr.Skip || len(expr.Plucked()) > 0 {
+			return false
+		}
+		for _, e := range expr.Exprs {
+			if !b.isRegular(e, visiting) {
+				return false
+			}
+		}
+		return true
+	case *ast.ChoiceExpr:
+		for _, alt := range expr.Alternatives {
+			if !b.isRegular(alt, visiting) {
+				return false
+			}
+		}
+		return true
+	case *ast.ZeroOrOneExpr:
+		return b.isRegular(expr.Expr, visiting)
+	case *ast.TextExpr:
+		return b.isRegular(expr.Expr, visiting)
+	case *ast.ZeroOrMoreExpr:
+		return !expr.Skip && b.isRegular(expr.Expr, visiting) && !b.dfaNullable(expr.Expr)
+	case *ast.OneOrMoreExpr:
+		return !expr.Skip && b.isRegular(expr.Expr, visiting) && !b.dfaNullable(expr.Expr)
+	case *ast.RepeatExpr:
+		if expr.Skip || expr.Max >= 0 && expr.Min > expr.Max {
+			return false
+		}
+		return b.isRegular(expr.Expr, visiting) && !b.dfaNullable(expr.Expr)
+	case *ast.RuleRefExpr:
+		name := expr.Name.Val
+		rule := b.rules[name]
+		if rule == nil || len(expr.Args) > 0 || b.isIndentRule(expr) || visiting[name] {
+			return false
+		}
+		visiting[name] = true
+		ok := b.isRegular(rule.Expr, visiting)
+		delete(visiting, name)
+		return ok
+	}
+	return false
+}
+
+// dfaNullable returns true if the regular expression expr can match the
+// empty string.
+func (b *builder) dfaNullable(expr ast.Expression) bool {
+	switch expr := expr.(type) {
+	case *ast.LitMatcher:
+		return expr.Val == ""
+	case *ast.SeqExpr:
+		for _, e := range expr.Exprs {
+			if !b.dfaNullable(e) {
+				return false
+			}
+		}
+		return true
+	case *ast.ChoiceExpr:
+		for _, alt := range expr.Alternatives {
+			if b.dfaNullable(alt) {
+				return true
+			}
+		}
+		return false
+	case *ast.ZeroOrOneExpr, *ast.ZeroOrMoreExpr:
+		return true
+	case *ast.OneOrMoreExpr:
+		return b.dfaNullable(expr.Expr)
+	case *ast.RepeatExpr:
+		return expr.Min == 0 || b.dfaNullable(expr.Expr)
+	case *ast.TextExpr:
+		return b.dfaNullable(expr.Expr)
+	case *ast.RuleRefExpr:
+		return b.dfaNullable(b.rules[expr.Name.Val].Expr)
+	}
+	return false
+}
+
+// dfaBuilder builds the states of an automaton.
+type dfaBuilder struct {
+	b       *builder
+	d       *dfa
+	states  map[string]int
+	classes map[*ast.CharClassMatcher]ast.RuneSet
+	failed  bool
+}
+
+// buildDFA returns the automaton of the regular expression expr, or nil if
+// it is too large.
+func (b *builder) buildDFA(expr ast.Expression) *dfa {
+	db := &dfaBuilder{
+		b:       b,
+		d:       &dfa{},
+		states:  make(map[string]int),
+		classes: make(map[*ast.CharClassMatcher]ast.RuneSet),
+	}
+	s := &dfaStep{db: db, committed: make(map[int]int)}
+	s.close(dfaThread{items: []dfaItem{{kind: dfaMatch, expr: expr}}})
+	if db.failed || s.accept || len(s.out) == 0 {
+		// an automaton that always matches the empty string is useless
+		return nil
+	}
+	db.add(s)
+	for i := 0; i < len(db.d.states) && !db.failed; i++ {
+		db.transitions(db.d.states[i])
+	}
+	if db.failed {
+		return nil
+	}
+	return db.d
+}
+
+// add adds the state of the threads of the step s, unless an identical
+// state exists, and returns its index.
+func (db *dfaBuilder) add(s *dfaStep) int {
+	st := s.state()
+	key := st.key()
+	if i, ok := db.states[key]; ok {
+		return i
+	}
+	if len(db.d.states) >= maxDFAStates {
+		db.failed = true
+		return dfaFailed
+	}
+	db.states[key] = len(db.d.states)
+	db.d.states = append(db.d.states, st)
+	return len(db.d.states) - 1
+}
+
+// transitions computes the transitions of the state st: the runes are
+// partitioned by the threads whose next rune they match, the runes that
+// lead to the same transition share an edge.
+func (db *dfaBuilder) transitions(st *dfaState) {
+	sets := make([]ast.RuneSet, len(st.threads))
+	var points []rune
+	for i, t := range st.threads {
+		sets[i] = db.headRunes(t.items[0])
+		rs := sets[i].Ranges()
+		for j := 0; j+1 < len(rs); j += 2 {
+			points = append(points, rs[j], rs[j+1]+1)
+		}
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i] < points[j] })
+
+	edges := make(map[string]*dfaEdge)
+	for i, lo := range points {
+		if lo > unicode.MaxRune || i > 0 && lo == points[i-1] {
+			continue
+		}
+		hi := rune(unicode.MaxRune)
+		for _, p := range points[i+1:] {
+			if p > lo {
+				hi = p - 1
+				break
+			}
+		}
+
+		match := make([]bool, len(sets))
+		matched := false
+		for j, set := range sets {
+			match[j] = set.Contains(lo)
+			matched = matched || match[j]
+		}
+		if !matched {
+			continue
+		}
+		sig := fmt.Sprint(match)
+		e, ok := edges[sig]
+		if !ok {
+			e = db.step(st, match)
+			edges[sig] = e
+			st.edges = append(st.edges, e)
+		}
+		e.runes.AddRange(lo, hi)
+	}
+	st.deflt = db.step(st, make([]bool, len(sets)))
+}
+
+// headRunes returns the runes that the matcher of the item matches.
+func (db *dfaBuilder) headRunes(it dfaItem) ast.RuneSet {
+	var s ast.RuneSet
+	switch e := it.expr.(type) {
+	case *ast.LitMatcher:
+		rn, _ := utf8.DecodeRuneInString(e.Val[it.n:])
+		s.Add(rn)
+	case *ast.CharClassMatcher:
+		rs, ok := db.classes[e]
+		if !ok {
+			rs = e.Runes()
+			db.classes[e] = rs
+		}
+		return rs
+	case *ast.AnyMatcher:
+		s.AddRange(0, unicode.MaxRune)
+	}
+	return s
+}
+
+// step returns the transition of the state st on a rune that the threads
+// in match match, the other threads fail.
+func (db *dfaBuilder) step(st *dfaState, match []bool) *dfaEdge {
+	s := &dfaStep{db: db, frame: st.frames, committed: make(map[int]int)}
+
+	// the failures of the threads that fail, after the failures that
+	// were pending
+	fails := slices.Clone(st.pending)
+	var next []dfaThread
+	for i, t := range st.threads {
+		if !match[i] {
+			fails = append(fails, dfaPending{fail: headFail(t.items[0]), alts: t.alts})
+			continue
+		}
+		head := t.items[0]
+		items := t.items[1:]
+		if lit, ok := head.expr.(*ast.LitMatcher); ok {
+			_, w := utf8.DecodeRuneInString(lit.Val[head.n:])
+			head.n += w
+			items = prepend(items, head)
+		}
+		next = append(next, dfaThread{items: items, alts: t.alts})
+	}
+	for _, t := range next {
+		s.close(t)
+	}
+	if !s.stop && st.done != nil && !s.killed(*st.done) {
+		s.done = st.done
+	}
+	out := s.out[:0]
+	for _, t := range s.out {
+		if !s.killed(t) {
+			out = append(out, t)
+		}
+	}
+	s.out = out
+
+	e := &dfaEdge{}
+	read := slices.Contains(match, true)
+	for _, pf := range fails {
+		switch s.resolve(pf.alts) {
+		case dfaDiscarded:
+			continue
+		case dfaPendingFail:
+			// the position of the failure is one more rune back after the
+			// current rune is read.
+			pf.fail.back++
+			if read && pf.fail.back <= maxDFAItems {
+				s.pending = append(s.pending, pf)
+			} else {
+				db.failed = true
+			}
+			continue
+		}
+		if !slices.Contains(e.fails, pf.fail) {
+			e.fails = append(e.fails, pf.fail)
+		}
+		db.d.hist = max(db.d.hist, pf.fail.back)
+	}
+
+	switch {
+	case s.accept:
+		e.next = dfaMatched
+	case len(s.out) == 0 && s.done == nil:
+		e.next = dfaFailed
+	case len(s.out) == 0:
+		e.next = dfaAccepted
+		if s.mark {
+			e.next = dfaMatched
+		}
+	default:
+		e.next = db.add(s)
+		e.mark = s.mark
+	}
+	if e.next == dfaAccepted {
+		db.d.accept = true
+	}
+	return e
+}
+
+// the resolutions of a failure, see resolve
+const (
+	dfaRecorded = iota
+	dfaDiscarded
+	dfaPendingFail
+)
+
+// resolve returns the resolution of the failure of a thread that took the
+// alternatives alts: the failure is discarded if a previous alternative of
+// a frame matched, it is pending if a previous alternative may still
+// match, and it is recorded otherwise.
+func (s *dfaStep) resolve(alts map[int]int) int {
+	for f, i := range alts {
+		if k, ok := s.committed[f]; ok && k < i {
+			return dfaDiscarded
+		}
+	}
+	for f, i := range alts {
+		if s.holds(f, i) {
+			return dfaPendingFail
+		}
+	}
+	return dfaRecorded
+}
+
+// headFail returns the failure of the matcher of the item.
+func headFail(it dfaItem) dfaFail {
+	switch e := it.expr.(type) {
+	case *ast.LitMatcher:
+		return dfaFail{want: litWant(e), back: utf8.RuneCountInString(e.Val[:it.n])}
+	case *ast.CharClassMatcher:
+		return dfaFail{want: e.Val}
+	}
+	return dfaFail{want: "."}
+}
+
+// dfaStep computes the threads that follow the threads of a state, by
+// advancing them to their next matcher in the order of the parser.
+type dfaStep struct {
+	db    *dfaBuilder
+	frame int
+	out   []dfaThread
+	// the failures that are recorded only if the previous alternatives of
+	// their threads fail
+	pending []dfaPending
+	// the thread that matched after the threads of out, and true if it
+	// matched in this step
+	done *dfaThread
+	mark bool
+	// true if a thread matched before any other thread
+	accept bool
+	// true if the threads that follow are not tried, as a previous thread
+	// matched
+	stop bool
+	// the first alternative that matched, by frame
+	committed map[int]int
+}
+
+// close advances the thread t to its next matcher, which adds it to out,
+// or to the end of its expression.
+func (s *dfaStep) close(t dfaThread) {
+	for !s.stop && !s.killed(t) {
+		if len(t.items) == 0 {
+			if len(s.out) == 0 {
+				s.accept = true
+			} else {
+				s.done, s.mark = &t, true
+			}
+			s.stop = true
+			return
+		}
+		if len(t.items) > maxDFAItems {
+			s.fail()
+			return
+		}
+
+		it, rest := t.items[0], t.items[1:]
+		switch it.kind {
+		case dfaCommit:
+			if k, ok := s.committed[it.frame]; !ok || it.n < k {
+				s.committed[it.frame] = it.n
+			}
+			t.items = rest
+			continue
+
+		case dfaStar:
+			f := s.newFrame()
+			s.fork(t, f, [][]dfaItem{{dfaMatchItem(it.expr), {kind: dfaCommit, frame: f}, it}, nil}, rest)
+			return
+
+		case dfaRepeat:
+			rep := it.expr.(*ast.RepeatExpr)
+			next := it
+			next.n++
+			if rep.Max < 0 && next.n > rep.Min {
+				// the count is only needed up to the minimum
+				next.n = rep.Min
+			}
+			switch {
+			case it.n < rep.Min:
+				t.items = prepend(rest, dfaMatchItem(rep.Expr), next)
+			case rep.Max >= 0 && it.n >= rep.Max:
+				t.items = rest
+			default:
+				f := s.newFrame()
+				s.fork(t, f, [][]dfaItem{{dfaMatchItem(rep.Expr), {kind: dfaCommit, frame: f}, next}, nil}, rest)
+				return
+			}
+			continue
+		}
+
+		switch e := it.expr.(type) {
+		case *ast.LitMatcher:
+			if it.n < len(e.Val) {
+				s.head(t)
+				return
+			}
+			t.items = rest
+		case *ast.CharClassMatcher, *ast.AnyMatcher:
+			s.head(t)
+			return
+		case *ast.SeqExpr:
+			items := make([]dfaItem, len(e.Exprs))
+			for i, ex := range e.Exprs {
+				items[i] = dfaMatchItem(ex)
+			}
+			t.items = prepend(rest, items...)
+		case *ast.ChoiceExpr:
+			f := s.newFrame()
+			alts := make([][]dfaItem, len(e.Alternatives))
+			for i, alt := range e.Alternatives {
+				alts[i] = []dfaItem{dfaMatchItem(alt)}
+				if i < len(alts)-1 {
+					alts[i] = append(alts[i], dfaItem{kind: dfaCommit, n: i, frame: f})
+				}
+			}
+			s.fork(t, f, alts, rest)
+			return
+		case *ast.ZeroOrOneExpr:
+			f := s.newFrame()
+			s.fork(t, f, [][]dfaItem{{dfaMatchItem(e.Expr), {kind: dfaCommit, frame: f}}, nil}, rest)
+			return
+		case *ast.ZeroOrMoreExpr:
+			t.items = prepend(rest, dfaItem{kind: dfaStar, expr: e.Expr})
+		case *ast.OneOrMoreExpr:
+			t.items = prepend(rest, dfaMatchItem(e.Expr), dfaItem{kind: dfaStar, expr: e.Expr})
+		case *ast.RepeatExpr:
+			t.items = prepend(rest, dfaItem{kind: dfaRepeat, expr: e})
+		case *ast.TextExpr:
+			t.items = prepend(rest, dfaMatchItem(e.Expr))
+		case *ast.RuleRefExpr:
+			t.items = prepend(rest, dfaMatchItem(s.db.b.rules[e.Name.Val].Expr))
+		}
+	}
+}
+
+// fork closes a thread for each alternative of the frame f, in order. The
+// alternatives are followed by the items rest.
+func (s *dfaStep) fork(t dfaThread, f int, alts [][]dfaItem, rest []dfaItem) {
+	for k, alt := range alts {
+		nt := dfaThread{items: prepend(rest, alt...), alts: t.alts}
+		if k > 0 {
+			nt.alts = make(map[int]int, len(t.alts)+1)
+			for fr, i := range t.alts {
+				nt.alts[fr] = i
+			}
+			nt.alts[f] = k
+		}
+		s.close(nt)
+	}
+}
+
+func (s *dfaStep) head(t dfaThread) {
+	s.out = append(s.out, t)
+	if len(s.out) > maxDFAThreads {
+		s.fail()
+	}
+}
+
+func (s *dfaStep) fail() {
+	s.db.failed = true
+	s.stop = true
+}
+
+func (s *dfaStep) newFrame() int {
+	s.frame++
+	return s.frame - 1
+}
+
+// killed returns true if a previous alternative of a frame of t matched.
+func (s *dfaStep) killed(t dfaThread) bool {
+	for f, i := range t.alts {
+		if k, ok := s.committed[f]; ok && k < i {
+			return true
+		}
+	}
+	return false
+}
+
+// holds returns true if a thread can still commit an alternative of the
+// frame f that is before alt.
+func (s *dfaStep) holds(f, alt int) bool {
+	for _, t := range s.out {
+		for _, it := range t.items {
+			if it.kind == dfaCommit && it.frame == f && it.n < alt {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// state returns the state of the threads of the step, without the commits
+// that cannot discard a thread or a failure, the alternatives that cannot
+// be discarded and the threads and failures that are identical to a
+// previous one. Its frames are numbered in the order they appear, so that
+// identical states have the same key.
+func (s *dfaStep) state() *dfaState {
+	threads := s.out
+	if s.done != nil {
+		threads = append(threads[:len(threads):len(threads)], *s.done)
+	}
+	pending := s.pending
+	for changed := true; changed; {
+		threads, pending, changed = simplifyThreads(threads, pending)
+	}
+
+	frames := make(map[int]int)
+	num := func(f int) int {
+		n, ok := frames[f]
+		if !ok {
+			n = len(frames)
+			frames[f] = n
+		}
+		return n
+	}
+	number := func(alts map[int]int) map[int]int {
+		if len(alts) == 0 {
+			return nil
+		}
+		res := make(map[int]int, len(alts))
+		for _, f := range sortedKeys(alts) {
+			res[num(f)] = alts[f]
+		}
+		return res
+	}
+	st := &dfaState{}
+	for _, t := range threads {
+		nt := dfaThread{items: make([]dfaItem, len(t.items))}
+		for i, it := range t.items {
+			if it.kind == dfaCommit {
+				it.frame = num(it.frame)
+			}
+			nt.items[i] = it
+		}
+		nt.alts = number(t.alts)
+		st.threads = append(st.threads, nt)
+	}
+	for _, pf := range pending {
+		st.pending = append(st.pending, dfaPending{fail: pf.fail, alts: number(pf.alts)})
+	}
+	if s.done != nil {
+		st.done = &st.threads[len(st.threads)-1]
+		st.threads = st.threads[:len(st.threads)-1]
+	}
+	st.frames = len(frames)
+	return st
+}
+
+// simplifyThreads returns the threads and the pending failures without the
+// commits that cannot discard them, the alternatives that cannot be
+// discarded and the duplicates, and true if it changed them. The last
+// thread is kept last.
+func simplifyThreads(threads []dfaThread, pending []dfaPending) ([]dfaThread, []dfaPending, bool) {
+	changed := false
+	victims := make(map[[2]int]bool) // discarded by a commit of the frame before alt
+	for _, t := range threads {
+		for f, i := range t.alts {
+			victims[[2]int{f, i}] = true
+		}
+	}
+	for _, pf := range pending {
+		for f, i := range pf.alts {
+			victims[[2]int{f, i}] = true
+		}
+	}
+	canDiscard := func(f, k int) bool {
+		for v := range victims {
+			if v[0] == f && v[1] > k {
+				return true
+			}
+		}
+		return false
+	}
+
+	holders := make(map[[2]int]bool)
+	res := make([]dfaThread, 0, len(threads))
+	for _, t := range threads {
+		nt := dfaThread{alts: t.alts}
+		for _, it := range t.items {
+			if it.kind == dfaCommit {
+				if !canDiscard(it.frame, it.n) {
+					changed = true
+					continue
+				}
+				holders[[2]int{it.frame, it.n}] = true
+			}
+			nt.items = append(nt.items, it)
+		}
+		res = append(res, nt)
+	}
+	held := func(alts map[int]int) map[int]int {
+		var res map[int]int
+		for f, i := range alts {
+			isHeld := false
+			for h := range holders {
+				isHeld = isHeld || h[0] == f && h[1] < i
+			}
+			if !isHeld {
+				changed = true
+				continue
+			}
+			if res == nil {
+				res = make(map[int]int)
+			}
+			res[f] = i
+		}
+		return res
+	}
+
+	seen := make(map[string]bool)
+	out := res[:0]
+	for _, t := range res {
+		t.alts = held(t.alts)
+		key := t.key()
+		if seen[key] {
+			changed = true
+			continue
+		}
+		seen[key] = true
+		out = append(out, t)
+	}
+	var outPending []dfaPending
+	for _, pf := range pending {
+		pf.alts = held(pf.alts)
+		key := pf.key()
+		if seen[key] {
+			changed = true
+			continue
+		}
+		seen[key] = true
+		outPending = append(outPending, pf)
+	}
+	return out, outPending, changed
+}
+
+// key returns the textual representation of the thread.
+func (t dfaThread) key() string {
+	var buf strings.Builder
+	for _, it := range t.items {
+		fmt.Fprintf(&buf, "%d:%p:%d:%d;", it.kind, it.expr, it.n, it.frame)
+	}
+	buf.WriteString("|")
+	buf.WriteString(altsKey(t.alts))
+	return buf.String()
+}
+
+// key returns the textual representation of the pending failure.
+func (pf dfaPending) key() string {
+	return fmt.Sprintf("fail:%q:%d|%s", pf.fail.want, pf.fail.back, altsKey(pf.alts))
+}
+
+func altsKey(alts map[int]int) string {
+	var buf strings.Builder
+	for _, f := range sortedKeys(alts) {
+		fmt.Fprintf(&buf, "%d=%d,", f, alts[f])
+	}
+	return buf.String()
+}
+
+// key returns the textual representation of the state.
+func (st *dfaState) key() string {
+	var buf strings.Builder
+	for _, t := range st.threads {
+		buf.WriteString(t.key())
+		buf.WriteString("\n")
+	}
+	if st.done != nil {
+		buf.WriteString("done:")
+		buf.WriteString(st.done.key())
+		buf.WriteString("\n")
+	}
+	for _, pf := range st.pending {
+		buf.WriteString(pf.key())
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
+
+func sortedKeys(m map[int]int) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+func dfaMatchItem(expr ast.Expression) dfaItem {
+	return dfaItem{kind: dfaMatch, expr: expr}
+}
+
+// prepend returns a new slice of the items followed by rest.
+func prepend(rest []dfaItem, items ...dfaItem) []dfaItem {
+	res := make([]dfaItem, 0, len(items)+len(rest))
+	res = append(res, items...)
+	return append(res, rest...)
+}
+
+// writeDFAExpr writes the expression expr that is matched by the automaton
+// at index i of the grammar.
+func (b *builder) writeDFAExpr(i int, expr ast.Expression) {
+	b.writelnf("&dfaExpr{")
+	pos := expr.Pos()
+	b.writeExprPos(pos)
+	b.writelnf("\trun: (*parser).dfa%d,", i)
+	b.writef("\texpr: ")
+	b.writeExpr(expr)
+	b.writelnf("},")
+}
+
+// writeDFAs writes the automata of the grammar as methods of the parser,
+// named dfa<index>, and the range tables of their transitions.
+func (b *builder) writeDFAs() {
+	for i, d := range b.dfas {
+		b.writeDFA(i, d)
+	}
+	if len(b.dfaTables) > 0 {
+		b.writelnf("var dfaTables = []*unicode.RangeTable{")
+		for _, rt := range b.dfaTables {
+			b.writelnf("\t%s,", rt)
+		}
+		b.writelnf("}")
+	}
+}
+
+// writeDFA writes the automaton d, each state is a switch on the current
+// rune. The positions of the last runes read are kept in a ring buffer
+// for the failures of the literals.
+func (b *builder) writeDFA(i int, d *dfa) {
+	targets := make(map[int]bool)
+	for _, st := range d.states {
+		for _, e := range append(st.edges, st.deflt) {
+			targets[e.next] = true
+		}
+	}
+
+	b.writelnf("func (p *parser) dfa%d() (any, bool) {", i)
+	b.writelnf("\tstart := p.pt")
+	if d.accept {
+		b.writelnf("\taccept := start")
+	}
+	if d.hist > 0 {
+		b.writelnf("\tvar back [%d]position", d.hist)
+		b.writelnf("\tvar n int")
+	}
+	for si, st := range d.states {
+		if targets[si] {
+			b.writelnf("s%d:", si)
+		}
+		if len(st.edges) > 0 {
+			b.writelnf("\tswitch cur := p.dfaRune(); {")
+			for _, e := range st.edges {
+				b.writelnf("\tcase %s:", b.dfaCond(e.runes))
+				b.writeDFAEdge(d, e, true, "\t\t")
+			}
+			b.writelnf("\t}")
+		}
+		b.writeDFAEdge(d, st.deflt, false, "\t")
+	}
+	b.writelnf("}\n")
+}
+
+// writeDFAEdge writes the transition e, which reads the current rune if
+// read is true.
+func (b *builder) writeDFAEdge(d *dfa, e *dfaEdge, read bool, indent string) {
+	for _, f := range e.fails {
+		pos := "p.pt.position"
+		if f.back > 0 {
+			pos = fmt.Sprintf("back[(n-%d)%%%d]", f.back, d.hist)
+		}
+		b.writelnf("%sp.failAt(false, %s, %q)", indent, pos, f.want)
+	}
+	if read {
+		if d.hist > 0 {
+			b.writelnf("%sback[n%%%d] = p.pt.position", indent, d.hist)
+			b.writelnf("%sn++", indent)
+		}
+		b.writelnf("%sp.read()", indent)
+		if e.mark && d.accept {
+			b.writelnf("%saccept = p.pt", indent)
+		}
+	}
+	switch e.next {
+	case dfaMatched:
+		b.writelnf("%sreturn p.sliceFrom(start), true", indent)
+	case dfaAccepted:
+		b.writelnf("%sp.restore(accept)", indent)
+		b.writelnf("%sreturn p.sliceFrom(start), true", indent)
+	case dfaFailed:
+		b.writelnf("%sp.restore(start)", indent)
+		b.writelnf("%sreturn nil, false", indent)
+	default:
+		b.writelnf("%sgoto s%d", indent, e.next)
+	}
+}
+
+// dfaCond returns the condition of the switch case of the runes of a
+// transition, a range table is used if there are more than four ranges.
+func (b *builder) dfaCond(runes ast.RuneSet) string {
+	rs := runes.Ranges()
+	if len(rs) > 8 {
+		return fmt.Sprintf("unicode.Is(dfaTables[%d], cur)", b.dfaTable(rangeTableLit(rs)))
+	}
+	conds := make([]string, 0, len(rs)/2)
+	for i := 0; i+1 < len(rs); i += 2 {
+		conds = append(conds, runeRangeCond(rs[i], rs[i+1]))
+	}
+	return strings.Join(conds, " || ")
+}
+
+// dfaTable returns the index of the range table in the dfaTables table, rt
+// is the Go expression of the table.
+func (b *builder) dfaTable(rt string) int {
+	for i, t := range b.dfaTables {
+		if t == rt {
+			return i
+		}
+	}
+	b.dfaTables = append(b.dfaTables, rt)
+	return len(b.dfaTables) - 1
+}
@@ -0,0 +1,104 @@
+package builder
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/mna/pigeon/bootstrap"
+)
+
+func TestOptimizeDFA(t *testing.T) {
+	cases := []struct {
+		src    string
+		opts   []Option
+		want   []string
+		noWant []string
+	}{
+		{
+			src: `
+Start = w:Word _ { return nil, nil }
+Word = [a-z]+ ( "." [a-z]+ )* { return string(c.text), nil }
+_ = " "*
+`,
+			opts: []Option{OptimizeDFA(true)},
+			want: []string{
+				"func (p *parser) dfa0() (any, bool) {",
+				"func (p *parser) dfa1() (any, bool) {",
+				"run: (*parser).dfa0,",
+				"func (p *parser) parseDFAExpr(dfa *dfaExpr) (any, bool) {",
+				`p.failAt(false, p.pt.position, "[a-z]")`,
+			},
+		},
+		{
+			src: `
+Start = Word { return nil, nil }
+Word = [a-z]+ ( "." [a-z]+ )*
+`,
+			opts: []Option{OptimizeDFA(true), Compile(true)},
+			want: []string{
+				"func (p *parser) dfa0() (any, bool) {",
+				"if !p.maxFailInvertExpected && p.Stats == nil {\n_, ok = p.dfa0()\n} else {",
+			},
+			noWant: []string{"&dfaExpr{", "func (p *parser) parseDFAExpr("},
+		},
+		{
+			// the value of the expression is used
+			src: `
+Start = w:Word
+Word = [a-z]+ ( "." [a-z]+ )*
+`,
+			opts:   []Option{OptimizeDFA(true)},
+			noWant: []string{"func (p *parser) dfa0("},
+		},
+		{
+			// recursive rule, case-insensitive literal and nullable repetition,
+			// only the expression of the repetition is regular
+			src: `
+Start = ( Parens / "abc"i "d" / ( "a" / "" )* ) { return nil, nil }
+Parens = "(" Parens? ")"
+`,
+			opts:   []Option{OptimizeDFA(true)},
+			want:   []string{"func (p *parser) dfa0("},
+			noWant: []string{"func (p *parser) dfa1("},
+		},
+		{
+			src: `
+Start = Word { return nil, nil }
+Word = [a-z]+ ( "." [a-z]+ )*
+`,
+			opts:   []Option{OptimizeDFA(true), Coverage("cover.peg")},
+			noWant: []string{"func (p *parser) dfa0("},
+		},
+		{
+			src: `
+Start = Word { return nil, nil }
+Word = [a-z]+ ( "." [a-z]+ )*
+`,
+			noWant: []string{"func (p *parser) dfa0(", "dfaExpr"},
+		},
+	}
+	for _, tc := range cases {
+		p := bootstrap.NewParser()
+		g, err := p.Parse("", strings.NewReader(tc.src))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var buf bytes.Buffer
+		if err := BuildParser(&buf, g, tc.opts...); err != nil {
+			t.Fatal(err)
+		}
+		out := buf.String()
+		for _, w := range tc.want {
+			if !strings.Contains(out, w) {
+				t.Errorf("%s: want %q in the generated code", tc.src, w)
+			}
+		}
+		for _, nw := range tc.noWant {
+			if strings.Contains(out, nw) {
+				t.Errorf("%s: want no %q in the generated code", tc.src, nw)
+			}
+		}
+	}
+}
@@ -0,0 +1,311 @@
+package builder
+
+import (
+	"sort"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mna/pigeon/ast"
+)
+
+// maxDispatchSets is the maximum number of sets of alternatives in the
+// dispatch table of a choice, so that the index of a set fits in a byte.
+const maxDispatchSets = 256
+
+// choiceDispatch is the dispatch table of a choice expression, it maps
+// the current rune to the set of alternatives that can match it.
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf
+	ascii []int
+	// start of the ranges of runes from utf8.RuneSelf and the index in
+	// alts of their set
+	ranges    []rune
+	rangeSets []int
+
+	// alts lists the alternatives of each set, the set at index 0 is the
+	// one used at EOF. expected lists the values expected by the other
+	// alternatives, which fail on the rune without consuming input.
+	alts     [][]int
+	expected [][]string
+}
+
+// choiceDispatch computes the dispatch table of the choice from the FIRST
+// sets of its alternatives. It returns nil if no alternative can be
+// skipped based on the current rune.
+//
+// An alternative can be skipped only if it cannot match the empty string
+// and if its failure on a rune that is not in its FIRST set does not run
+// any code, so that skipping it only requires to record the values it
+// expects for the error messages.
+func (b *builder) choiceDispatch(ch *ast.ChoiceExpr) *choiceDispatch {
+	n := len(ch.Alternatives)
+	if b.firstSets == nil || n < 2 || n > 64 {
+		return nil
+	}
+
+	firsts := make([]*ast.RuneSet, n)
+	wants := make([][]string, n)
+	skippable := false
+	for i, alt := range ch.Alternatives {
+		f := b.firstSets.Of(alt)
+		if f.Opaque || f.Nullable {
+			continue
+		}
+		w, ok := b.failExpected(alt)
+		if !ok {
+			continue
+		}
+		firsts[i], wants[i] = &f.Runes, w
+		skippable = true
+	}
+	if !skippable {
+		return nil
+	}
+
+	// the runes are split in segments where the same alternatives can match
+	bounds := map[rune]bool{utf8.RuneSelf: true}
+	for _, rs := range firsts {
+		if rs == nil {
+			continue
+		}
+		r := rs.Ranges()
+		for i := 0; i < len(r); i += 2 {
+			bounds[r[i]] = true
+			if r[i+1] < unicode.MaxRune {
+				bounds[r[i+1]+1] = true
+			}
+		}
+	}
+	starts := make([]rune, 0, len(bounds))
+	for r := range bounds {
+		if r >= utf8.RuneSelf {
+			starts = append(starts, r)
+		}
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+
+	// the sets are identified by the bit mask of the skippable alternatives
+	// that can match, the empty set is the one used at EOF.
+	sets := map[uint64]int{0: 0}
+	masks := []uint64{0}
+	set := func(r rune) int {
+		var m uint64
+		for i, rs := range firsts {
+			if rs != nil && rs.Contains(r) {
+				m |= 1 << uint(i)
+			}
+		}
+		ix, ok := sets[m]
+		if !ok {
+			ix = len(masks)
+			sets[m] = ix
+			masks = append(masks, m)
+		}
+		return ix
+	}
+
+	d := &choiceDispatch{}
+	for r := rune(0); r < utf8.RuneSelf; r++ {
+		d.ascii = append(d.ascii, set(r))
+	}
+	for _, r := range starts {
+		ix := set(r)
+		if k := len(d.rangeSets); k > 0 && d.rangeSets[k-1] == ix {
+			continue
+		}
+		d.ranges = append(d.ranges, r)
+		d.rangeSets = append(d.rangeSets, ix)
+	}
+	if len(masks) > maxDispatchSets {
+		return nil
+	}
+
+	for _, m := range masks {
+		alts := []int{}
+		var expected []string
+		seen := make(map[string]bool)
+		for i := range ch.Alternatives {
+			if firsts[i] == nil || m&(1<<uint(i)) != 0 {
+				alts = append(alts, i)
+				continue
+			}
+			for _, w := range wants[i] {
+				if !seen[w] {
+					seen[w] = true
+					expected = append(expected, w)
+				}
+			}
+		}
+		d.alts = append(d.alts, alts)
+		d.expected = append(d.expected, expected)
+	}
+	return d
+}
+
+// failExpected returns the values expected by expr when it fails on a rune
+// that is not in its FIRST set. It returns false if they cannot be
+// determined statically or if evaluating expr runs some code, e.g. an
+// action on the empty string, a predicate or a state code block.
+func (b *builder) failExpected(expr ast.Expression) ([]string, bool) {
+	if b.firstSets.Of(expr).Opaque {
+		return nil, false
+	}
+
+	switch expr := expr.(type) {
+	case *ast.RuleRefExpr:
+		return b.ruleFailExpected(expr.Name.Val)
+
+	case *ast.ActionExpr:
+		if b.firstSets.Of(expr.Expr).Nullable {
+			return nil, false
+		}
+		return b.failExpected(expr.Expr)
+
+	case *ast.LabeledExpr:
+		return b.failExpected(expr.Expr)
+
+	case *ast.PluckExpr:
+		return b.failExpected(expr.Expr)
+
+	case *ast.TextExpr:
+		return b.failExpected(expr.Expr)
+
+	case *ast.ChoiceExpr:
+		return b.failExpectedList(expr.Alternatives, true)
+
+	case *ast.SeqExpr:
+		return b.failExpectedList(expr.Exprs, false)
+
+	case *ast.ZeroOrOneExpr:
+		return b.failExpected(expr.Expr)
+
+	case *ast.ZeroOrMoreExpr:
+		return b.failExpected(expr.Expr)
+
+	case *ast.OneOrMoreExpr:
+		return b.failExpected(expr.Expr)
+
+	case *ast.RepeatExpr:
+		if expr.Max == 0 {
+			return nil, false
+		}
+		return b.failExpected(expr.Expr)
+
+	case *ast.LitMatcher:
+		// the empty literal matches, which is recorded in a not expression
+		if expr.Val == "" {
+			return nil, false
+		}
+		return []string{litWant(expr)}, true
+
+	case *ast.LitSetMatcher:
+		var wants []string
+		for _, lit := range expr.Lits {
+			if lit.Val == "" {
+				return nil, false
+			}
+			wants = append(wants, litWant(lit))
+		}
+		return wants, true
+
+	case *ast.CharClassMatcher:
+		return []string{expr.Val}, true
+
+	case *ast.AnyMatcher:
+		return []string{"."}, true
+	}
+	return nil, false
+}
+
+// failExpectedList returns the values expected by the expressions of a
+// choice or sequence, which are evaluated in order until one matches the
+// empty string for a choice, or cannot match it for a sequence.
+func (b *builder) failExpectedList(exprs []ast.Expression, choice bool) ([]string, bool) {
+	var wants []string
+	for _, e := range exprs {
+		w, ok := b.failExpected(e)
+		if !ok {
+			return nil, false
+		}
+		wants = append(wants, w...)
+		if b.firstSets.Of(e).Nullable == choice {
+			break
+		}
+	}
+	return wants, true
+}
+
+// ruleFailExpected returns the values expected by the rule name when it
+// fails on a rune that is not in its FIRST set, see failExpected.
+func (b *builder) ruleFailExpected(name string) ([]string, bool) {
+	if res, ok := b.ruleExpected[name]; ok {
+		return res.wants, res.ok
+	}
+	rule := b.rules[name]
+	if rule == nil {
+		return nil, false
+	}
+
+	// a rule that refers to itself is not expanded again
+	b.ruleExpected[name] = expectedResult{}
+	wants, ok := b.failExpected(rule.Expr)
+	b.ruleExpected[name] = expectedResult{wants: wants, ok: ok}
+	return wants, ok
+}
+
+// expectedResult is the cached result of ruleFailExpected.
+type expectedResult struct {
+	wants []string
+	ok    bool
+}
+
+// writeChoiceDispatch writes the dispatch table of a choice as a
+// composite literal.
+func (b *builder) writeChoiceDispatch(d *choiceDispatch) {
+	b.writelnf("&choiceDispatch{")
+	b.writelnf("\tascii: %q,", setIndices(d.ascii))
+	b.writef("\tranges: []rune{")
+	for _, r := range d.ranges {
+		b.writef("%q,", r)
+	}
+	b.writelnf("},")
+	b.writelnf("\trangeSets: %q,", setIndices(d.rangeSets))
+	b.writef("\talts: [][]int{")
+	for _, alts := range d.alts {
+		b.writef("{")
+		for _, ix := range alts {
+			b.writef("%d,", ix)
+		}
+		b.writef("},")
+	}
+	b.writelnf("},")
+	b.writef("\texpected: [][]string{")
+	for _, exp := range d.expected {
+		b.writef("{")
+		for _, w := range exp {
+			b.writef("%q,", w)
+		}
+		b.writef("},")
+	}
+	b.writelnf("},")
+	b.writef("}")
+}
+
+// setIndices returns the indices of the sets of a dispatch table as a
+// string, with one byte per index.
+func setIndices(ixs []int) string {
+	buf := make([]byte, len(ixs))
+	for i, ix := range ixs {
+		buf[i] = byte(ix)
+	}
+	return string(buf)
+}
+
+// litWant returns the value expected by the literal in error messages.
+func litWant(lit *ast.LitMatcher) string {
+	if lit.IgnoreCase {
+		return strconv.Quote(lit.Val) + "i"
+	}
+	return strconv.Quote(lit.Val)
+}
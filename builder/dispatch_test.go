@@ -0,0 +1,75 @@
+package builder
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mna/pigeon/ast"
+	"github.com/mna/pigeon/bootstrap"
+)
+
+func TestChoiceDispatch(t *testing.T) {
+	src := `
+Value = Num / Str / Kw / Opt / Pred / "é"
+Num = _ [0-9]+
+Str = '"' [^"]* '"'
+Kw = "null"i
+Opt = "-"?
+Pred = !"-" "+"
+_ = " "*
+`
+	p := bootstrap.NewParser()
+	g, err := p.Parse("", strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &builder{
+		firstSets:    ast.NewFirstSets(g),
+		rules:        make(map[string]*ast.Rule),
+		ruleExpected: make(map[string]expectedResult),
+	}
+	for _, r := range g.Rules {
+		b.rules[r.Name.Val] = r
+	}
+
+	d := b.choiceDispatch(g.Rules[0].Expr.(*ast.ChoiceExpr))
+	if d == nil {
+		t.Fatal("want a dispatch table")
+	}
+	// Opt is nullable and Pred starts with a lookahead, they are never
+	// skipped.
+	cases := []struct {
+		rn       rune
+		alts     []int
+		expected []string
+	}{
+		{rn: '5', alts: []int{0, 3, 4}, expected: []string{`"\""`, `"null"i`, `"é"`}},
+		{rn: ' ', alts: []int{0, 3, 4}, expected: []string{`"\""`, `"null"i`, `"é"`}},
+		{rn: 'N', alts: []int{2, 3, 4}, expected: []string{`" "`, "[0-9]", `"\""`, `"é"`}},
+		{rn: 'x', alts: []int{3, 4}, expected: []string{`" "`, "[0-9]", `"\""`, `"null"i`, `"é"`}},
+		{rn: 'é', alts: []int{3, 4, 5}, expected: []string{`" "`, "[0-9]", `"\""`, `"null"i`}},
+		{rn: 'K', alts: []int{3, 4}, expected: []string{`" "`, "[0-9]", `"\""`, `"null"i`, `"é"`}},
+	}
+	for _, tc := range cases {
+		var set int
+		if tc.rn < 128 {
+			set = d.ascii[tc.rn]
+		} else {
+			for i, r := range d.ranges {
+				if r <= tc.rn {
+					set = d.rangeSets[i]
+				}
+			}
+		}
+		if !reflect.DeepEqual(d.alts[set], tc.alts) {
+			t.Errorf("%q: want alternatives %v, got %v", tc.rn, tc.alts, d.alts[set])
+		}
+		if !reflect.DeepEqual(d.expected[set], tc.expected) {
+			t.Errorf("%q: want expected %q, got %q", tc.rn, tc.expected, d.expected[set])
+		}
+	}
+	if want := []int{3, 4}; !reflect.DeepEqual(d.alts[0], want) {
+		t.Errorf("EOF: want alternatives %v, got %v", want, d.alts[0])
+	}
+}
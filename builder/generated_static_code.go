@@ -18,6 +18,19 @@ var (
 	// errMaxExprCnt is used to signal that the maximum number of
 	// expressions have been parsed.
 	errMaxExprCnt = errors.New("max number of expressions parsed")
+	// ==template== {{ if .Cut }}
+
+	// errCutFailure is used to signal that an expression that follows a
+	// cut did not match, which stops the parsing.
+	errCutFailure = errors.New("no match after cut")
+	// {{ end }} ==template==
+	// ==template== {{ if .Indent }}
+
+	// errIndentTabs is added when the indentation matched by the built-in
+	// INDENT, DEDENT and SAMEDENT rules has tabs rejected by the Indentation
+	// option.
+	errIndentTabs = errors.New("invalid tabs in the indentation")
+	// {{ end }} ==template==
 )
 
 // Option is a function that can set an option on the parser. It returns
@@ -58,7 +71,8 @@ func Entrypoint(ruleName string) Option {
 // ==template== {{ if not .Optimize }}
 // Statistics adds a user provided Stats struct to the parser to allow
 // the user to process the results after the parsing has finished.
-// Also the key for the "no match" counter is set.
+// Also the key for the "no match" counter is set. Per-rule statistics
+// (see RuleStats) are only collected when this option is set.
 //
 // Example usage:
 //
@@ -82,6 +96,9 @@ func Statistics(stats *Stats, choiceNoMatch string) Option {
 		if p.Stats.ChoiceAltCnt == nil {
 			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
 		}
+		if p.Stats.Rules == nil {
+			p.Stats.Rules = make(map[string]*RuleStats)
+		}
 		return Statistics(oldStats, oldChoiceNoMatch)
 	}
 }
@@ -102,6 +119,9 @@ func Debug(b bool) Option {
 // the parser will cache all results so each expression is evaluated only
 // once. This guarantees linear parsing time even for pathological cases,
 // at the expense of more memory and slower times for typical cases.
+// The rules annotated with @memo in the grammar are always memoized,
+// regardless of this option. The memoization table is pooled, so its
+// storage is reused by the following parses.
 //
 // The default is false.
 func Memoize(b bool) Option {
@@ -128,6 +148,92 @@ func AllowInvalidUTF8(b bool) Option {
 	}
 }
 
+// ==template== {{ if not .Binary }}
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
+// {{ end }} ==template==
+
+// ==template== {{ if .Indent }}
+// IndentTabs is the policy for the tabs in the indentation matched by the
+// built-in INDENT, DEDENT and SAMEDENT rules, see the Indentation option.
+type IndentTabs int
+
+const (
+	// IndentTabsExpand advances the width of the indentation to the next
+	// tab stop for each tab.
+	IndentTabsExpand IndentTabs = iota
+	// IndentTabsNoMix rejects the indentation that has both tabs and
+	// spaces, the tabs are expanded otherwise.
+	IndentTabsNoMix
+	// IndentTabsReject rejects the indentation that has tabs.
+	IndentTabsReject
+)
+
+// Indentation creates an Option to set the width of a tab and the policy
+// for the tabs in the indentation matched by the built-in INDENT, DEDENT
+// and SAMEDENT rules. A rejected indentation does not match and adds an
+// error.
+//
+// The default is a tab width of 8 and IndentTabsExpand.
+func Indentation(tabWidth int, tabs IndentTabs) Option {
+	return func(p *parser) Option {
+		oldTabWidth, oldTabs := p.indentTabWidth, p.indentTabs
+		p.indentTabWidth, p.indentTabs = tabWidth, tabs
+		return Indentation(oldTabWidth, oldTabs)
+	}
+}
+
+// {{ end }} ==template==
+
 // Recover creates an Option to set the recover flag to b. When set to
 // true, this causes the parser to recover from panics and convert it
 // to an error. Setting it to false can be useful while debugging to
@@ -212,6 +318,10 @@ type savepoint struct {
 	position
 	rn rune
 	w  int
+	// ==template== {{ if .Indent }}
+	// indent is the indentation stack, see indentLevel.
+	indent *indentLevel
+	// {{ end }} ==template==
 }
 
 type current struct {
@@ -227,6 +337,13 @@ type current struct {
 
 	// {{ end }} ==template==
 
+	// ==template== {{ if .Throw }}
+	// failure is the labeled failure that the recovery expression being
+	// parsed recovers from, nil outside of the recovery expressions.
+	failure *failure
+
+	// {{ end }} ==template==
+
 	// globalStore is a general store for the user to store arbitrary key-value
 	// pairs that they need to manage and that they do not want tied to the
 	// backtracking of the parser. This is only modified by the user and never
@@ -237,12 +354,41 @@ type current struct {
 
 type storeDict map[string]any
 
+// ==template== {{ if .Throw }}
+// failure is a labeled failure thrown by a throw expression. It is an error
+// whose message is the message template of its label, which a recovery
+// code block may return to report it at its position.
+type failure struct {
+	label   string
+	message string
+	// pos is the position of the farthest failure at or after the throw
+	// expression, where the input did not match the expected values,
+	// otherwise the position of the throw expression.
+	pos      position
+	expected []string
+	// text is the text matched by the expression that the recovery
+	// expression protects, up to the throw expression.
+	text []byte
+}
+
+// Error returns the message of the failure.
+func (f *failure) Error() string {
+	return f.message
+}
+
+// {{ end }} ==template==
+
 // the AST types...
 
 // {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
 type grammar struct {
 	pos   position
 	rules []*rule
+	// ==template== {{ if .Skip }}
+	// name of the skip rule, matched between the expressions of the
+	// sequences and repetitions that have skip set.
+	skip string
+	// {{ end }} ==template==
 }
 
 // {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
@@ -250,23 +396,82 @@ type rule struct {
 	pos         position
 	name        string
 	displayName string
-	expr        any
+	// ==template== {{ if .Compile }}
+	run func(*parser) (any, bool)
+	// {{ else }}
+	expr any
+	// {{ end }} ==template==
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
 
 	// ==template== {{ if .LeftRecursion }}
 	leader        bool
 	leftRecursive bool
 	// {{ end }} ==template==
+	// ==template== {{ if .MemoRules }}
+	memoize bool
+	// {{ end }} ==template==
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+// {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
 }
 
+// ==template== {{ if not .Compile }}
+
 // {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
 type choiceExpr struct {
 	pos          position
+	id           int
 	alternatives []any
+	dispatch     *choiceDispatch
 }
 
 // {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
 type actionExpr struct {
 	pos  position
+	id   int
 	expr any
 	run  func(*parser) (any, error)
 }
@@ -274,6 +479,7 @@ type actionExpr struct {
 // {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
 type recoveryExpr struct {
 	pos          position
+	id           int
 	expr         any
 	recoverExpr  any
 	failureLabel []string
@@ -282,26 +488,77 @@ type recoveryExpr struct {
 // {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
 type seqExpr struct {
 	pos   position
+	id    int
 	exprs []any
+	// ==template== {{ if .Cut }}
+	// cut is the number of expressions up to and including the cut, 0 if
+	// the sequence has no cut.
+	cut int
+	// {{ end }} ==template==
+	// ==template== {{ if .Skip }}
+	skip bool
+	// {{ end }} ==template==
+	// ==template== {{ if .Pluck }}
+	// pluck is the indexes of the expressions whose values are the value of
+	// the sequence, if any.
+	pluck []int
+	// {{ end }} ==template==
+}
+
+// ==template== {{ if .Cut }}
+// {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
+type cutExpr struct {
+	pos position
+	id  int
 }
 
+// {{ end }} ==template==
+// ==template== {{ if .Throw }}
 // {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
 type throwExpr struct {
-	pos   position
-	label string
+	pos     position
+	id      int
+	label   string
+	message string
 }
 
+// {{ end }} ==template==
+
 // {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
 type labeledExpr struct {
 	pos   position
+	id    int
 	label string
 	expr  any
+	// ==template== {{ if .BackRef }}
+	// textKey is the key of the matched text in the labels, if it is
+	// captured for the back-references to the label.
+	textKey string
+	// {{ end }} ==template==
 }
 
 // {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
 type expr struct {
 	pos  position
+	id   int
+	expr any
+	// ==template== {{ if .Skip }}
+	// skip is only set on the repetitions.
+	skip bool
+	// {{ end }} ==template==
+}
+
+// {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
+type repeatExpr struct {
+	pos  position
+	id   int
 	expr any
+	// max is -1 if the number of repetitions is not bounded.
+	min int
+	max int
+	// ==template== {{ if .Skip }}
+	skip bool
+	// {{ end }} ==template==
 }
 
 type (
@@ -315,6 +572,7 @@ type (
 // {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
 type ruleRefExpr struct {
 	pos  position
+	id   int
 	name string
 }
 
@@ -323,6 +581,7 @@ type ruleRefExpr struct {
 // {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
 type stateCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) error
 }
 
@@ -331,18 +590,21 @@ type stateCodeExpr struct {
 // {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
 type andCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) (bool, error)
 }
 
 // {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
 type notCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) (bool, error)
 }
 
 // {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
 type litMatcher struct {
 	pos        position
+	id         int
 	val        string
 	ignoreCase bool
 	want       string
@@ -351,6 +613,7 @@ type litMatcher struct {
 // {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
 type charClassMatcher struct {
 	pos             position
+	id              int
 	val             string
 	basicLatinChars [128]bool
 	chars           []rune
@@ -360,172 +623,788 @@ type charClassMatcher struct {
 	inverted        bool
 }
 
-type anyMatcher position //{{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
-
-// errList cumulates the errors found by the parser.
-type errList []error
+// {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
+type anyMatcher struct {
+	pos position
+	id  int
+}
 
-func (e *errList) add(err error) {
-	*e = append(*e, err)
+// ==template== {{ if .Binary }}
+// {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
+type uintMatcher struct {
+	pos          position
+	id           int
+	name         string
+	size         int
+	littleEndian bool
 }
 
-func (e errList) err() error {
-	if len(e) == 0 {
-		return nil
-	}
-	e.dedupe()
-	return e
+// {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
+type bytesExpr struct {
+	pos   position
+	id    int
+	label string
 }
 
-func (e *errList) dedupe() {
-	var cleaned []error
-	set := make(map[string]bool)
-	for _, err := range *e {
-		if msg := err.Error(); !set[msg] {
-			set[msg] = true
-			cleaned = append(cleaned, err)
-		}
-	}
-	*e = cleaned
+// {{ end }} ==template==
+// ==template== {{ if .BackRef }}
+// backRefExpr matches the text captured by a label or the text of a value
+// of the state store, see matchBackRef.
+// {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
+type backRefExpr struct {
+	pos   position
+	id    int
+	key   string
+	state bool
 }
 
-func (e errList) Error() string {
-	switch len(e) {
-	case 0:
-		return ""
-	case 1:
-		return e[0].Error()
-	default:
-		var buf bytes.Buffer
+// {{ end }} ==template==
+// ==template== {{ if .Regexp }}
+// regexpMatcher matches a regular expression, which is anchored at the
+// start of the input by its \A prefix, see matchRegexp.
+// {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
+type regexpMatcher struct {
+	pos   position
+	id    int
+	re    *regexp.Regexp
+	after *regexp.Regexp
+	want  string
+}
 
-		for i, err := range e {
-			if i > 0 {
-				buf.WriteRune('\n')
-			}
-			buf.WriteString(err.Error())
-		}
-		return buf.String()
-	}
+// {{ end }} ==template==
+// ==template== {{ if .MatcherFunc }}
+// matcherFuncExpr calls the matcher function fn of the grammar, want is its
+// name in the expected list, see matchFunc.
+// {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
+type matcherFuncExpr struct {
+	pos  position
+	id   int
+	fn   func(*current, []byte) (int, any, bool)
+	want string
 }
 
-// parserError wraps an error with a prefix indicating the rule in which
-// the error occurred. The original error is stored in the Inner field.
-type parserError struct {
-	Inner    error
-	pos      position
-	prefix   string
-	expected []string
+// {{ end }} ==template==
+// ==template== {{ if .DFA }}
+// dfaExpr is an expression that is matched by the deterministic finite
+// automaton run, expr is the expression itself, see parseDFAExpr.
+// {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
+type dfaExpr struct {
+	pos  position
+	id   int
+	run  func(*parser) (any, bool)
+	expr any
 }
 
-// Error returns the error message.
-func (p *parserError) Error() string {
-	return p.prefix + ": " + p.Inner.Error()
+// {{ end }} ==template==
+// ==template== {{ if .Text }}
+// {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
+type textExpr struct {
+	pos  position
+	id   int
+	expr any
 }
 
-// newParser creates a parser with the specified input source and options.
-func newParser(filename string, b []byte, opts ...Option) *parser {
-	stats := Stats{
-		ChoiceAltCnt: make(map[string]map[string]int),
-	}
+// {{ end }} ==template==
+// ==template== {{ if .Indent }}
+// indentExpr is a reference to a built-in indentation rule.
+// {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
+type indentExpr struct {
+	pos  position
+	id   int
+	kind indentKind
+}
 
-	p := &parser{
-		filename: filename,
-		errs:     new(errList),
-		data:     b,
-		pt:       savepoint{position: position{line: 1}},
-		recover:  true,
-		cur: current{
-			// ==template== {{ if or .GlobalState (not .Optimize) }}
-			state: make(storeDict),
-			// {{ end }} ==template==
-			globalStore: make(storeDict),
-		},
-		maxFailPos:      position{col: 1, line: 1},
-		maxFailExpected: make([]string, 0, 20),
-		Stats:           &stats,
-		// start rule is rule [0] unless an alternate entrypoint is specified
-		entrypoint: g.rules[0].name,
-	}
-	p.setOptions(opts)
+// {{ end }} ==template==
+// {{ end }} ==template==
 
-	if p.maxExprCnt == 0 {
-		p.maxExprCnt = math.MaxUint64
-	}
+// ==template== {{ if .Indent }}
+// indentKind is the kind of the built-in indentation rules.
+type indentKind int
 
-	return p
-}
+const (
+	indentIndent indentKind = iota
+	indentDedent
+	indentSame
+)
 
-// setOptions applies the options to the parser.
-func (p *parser) setOptions(opts []Option) {
-	for _, opt := range opts {
-		opt(p)
-	}
+// indentNames are the names of the built-in indentation rules.
+var indentNames = [...]string{
+	indentIndent: "INDENT",
+	indentDedent: "DEDENT",
+	indentSame:   "SAMEDENT",
 }
 
-// {{ if .Nolint }} nolint: structcheck,deadcode {{else}} ==template== {{ end }}
-type resultTuple struct {
-	v   any
-	b   bool
-	end savepoint
+// indentLevel is the top of the indentation stack of the parser, the
+// width of the current indentation. The stack is never modified, a level
+// is pushed with a new indentLevel so that the savepoints record the stack
+// and restore it on backtracking. The nil stack is the indentation of
+// width 0.
+type indentLevel struct {
+	width int
+	prev  *indentLevel
 }
 
-// {{ if .Nolint }} nolint: varcheck {{else}} ==template== {{ end }}
-const choiceNoMatch = -1
+// {{ end }} ==template==
 
-// Stats stores some statistics, gathered during parsing
-type Stats struct {
-	// ExprCnt counts the number of expressions processed during parsing
-	// This value is compared to the maximum number of expressions allowed
-	// (set by the MaxExpressions option).
-	ExprCnt uint64
+// ==template== {{ if .LitSet }}
+// litSetMatcher matches one of its literals using a trie of the literals,
+// see matchLitSet.
+// {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
+type litSetMatcher struct {
+	pos      position
+	id       int
+	lits     []litSetLit
+	longest  bool
+	boundary bool
 
-	// ChoiceAltCnt is used to count for each ordered choice expression,
-	// which alternative is used how may times.
-	// These numbers allow to optimize the order of the ordered choice expression
-	// to increase the performance of the parser
-	//
-	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
-	// as the line and the column of the ordered choice.
-	// The inner key of ChoiceAltCnt is the number (one-based) of the matching alternative.
-	// For each alternative the number of matches are counted. If an ordered choice does not
-	// match, a special counter is incremented. The name of this counter is set with
-	// the parser option Statistics.
-	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
-	ChoiceAltCnt map[string]map[string]int
+	// the tries of the case-sensitive and of the case-insensitive
+	// literals, built on first use.
+	once  sync.Once
+	tries [2]*litTrie
 }
 
-// ==template== {{ if .LeftRecursion }}
-type ruleWithExpsStack struct {
-	rule   *rule
-	estack []any
+// litSetLit is a literal of a litSetMatcher, its value is lowercase if
+// it is case-insensitive.
+type litSetLit struct {
+	val        string
+	ignoreCase bool
+	want       string
 }
 
-// {{ end }} ==template==
+// litTrie is a node of the trie of the literals of a litSetMatcher.
+type litTrie struct {
+	next map[rune]*litTrie
+	// lits are the indices of the literals that end at this node.
+	lits []int
+}
 
-// {{ if .Nolint }} nolint: structcheck,maligned {{else}} ==template== {{ end }}
-type parser struct {
-	filename string
-	pt       savepoint
-	cur      current
+func (set *litSetMatcher) buildTries() {
+	for i, lit := range set.lits {
+		ix := 0
+		if lit.ignoreCase {
+			ix = 1
+		}
+		if set.tries[ix] == nil {
+			set.tries[ix] = &litTrie{}
+		}
+		t := set.tries[ix]
+		// ==template== {{ if .Binary }}
+		for j := 0; j < len(lit.val); j++ {
+			rn := rune(lit.val[j])
+			// {{ else }}
+		for _, rn := range lit.val {
+			// {{ end }} ==template==
+			next := t.next[rn]
+			if next == nil {
+				if t.next == nil {
+					t.next = make(map[rune]*litTrie)
+				}
+				next = &litTrie{}
+				t.next[rn] = next
+			}
+			t = next
+		}
+		t.lits = append(t.lits, i)
+	}
+}
 
-	data []byte
-	errs *errList
+// better returns true if the literal ix that ends at offset is a better
+// match than the literal match that ends at end, which is -1 if there is
+// none yet.
+func (set *litSetMatcher) better(ix, offset, match, end int) bool {
+	switch {
+	case match < 0:
+		return true
+	case set.longest && offset != end:
+		return offset > end
+	default:
+		return ix < match
+	}
+}
 
-	depth   int
-	recover bool
-	// ==template== {{ if not .Optimize }}
-	debug bool
+// matchLitSet matches the literals of set in a single pass over the input
+// for each trie. It matches the first literal that matches, or the longest
+// one if set.longest is true.
+func (p *parser) matchLitSet(set *litSetMatcher) (any, bool) {
+	set.once.Do(set.buildTries)
+	start := p.pt
+	match, end := -1, start
+	for i, t := range set.tries {
+		for t != nil {
+			for _, ix := range t.lits {
+				if set.better(ix, p.pt.offset, match, end.offset) && (!set.boundary || !isWordRune(p.pt.rn)) {
+					match, end = ix, p.pt
+				}
+			}
+			if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+				// EOF - see utf8.DecodeRune
+				break
+			}
+			cur := p.pt.rn
+			// ==template== {{ if .CaseFold }}
+			if i == 1 {
+				// the trie of the case-insensitive literals has their case
+				// folding
+				t = t.nextFold(cur)
+			} else {
+				t = t.next[cur]
+			}
+			// {{ else }}
+			if i == 1 {
+				cur = unicode.ToLower(cur)
+			}
+			t = t.next[cur]
+			// {{ end }} ==template==
+			if t != nil {
+				p.read()
+			}
+		}
+		p.restore(start)
+	}
+
+	for i, lit := range set.lits {
+		// a choice of the literals would not try the ones after the match
+		if i != match && (match < 0 || i < match || set.longest) {
+			p.failAt(false, start.position, lit.want)
+		}
+	}
+	if match < 0 {
+		return nil, false
+	}
+	p.failAt(true, start.position, set.lits[match].want)
+	p.restore(end)
+	return p.sliceFrom(start), true
+}
+
+// ==template== {{ if .CaseFold }}
+// nextFold returns the node of the trie that follows the case folding of
+// rn, which may be more than one rune, or nil if there is none.
+func (t *litTrie) nextFold(rn rune) *litTrie {
+	f, ok := fullCaseFolds[rn]
+	if !ok {
+		return t.next[caseFold(rn)]
+	}
+	for _, rn := range f {
+		if t = t.next[rn]; t == nil {
+			return nil
+		}
+	}
+	return t
+}
+
+// {{ end }} ==template==
+// isWordRune returns true if rn is a letter, a digit or an underscore.
+func isWordRune(rn rune) bool {
+	return rn == '_' || unicode.IsLetter(rn) || unicode.IsDigit(rn)
+}
+
+// {{ end }} ==template==
+// ==template== {{ if .CaseFold }}
+// matchCaseFold matches the full case folding of the input against val,
+// the case folding of a case-insensitive literal. The input is consumed
+// if it matches, it must be restored otherwise.
+func (p *parser) matchCaseFold(val string) bool {
+	for len(val) > 0 {
+		if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+			// EOF - see utf8.DecodeRune
+			return false
+		}
+		if f, ok := fullCaseFolds[p.pt.rn]; ok {
+			if !strings.HasPrefix(val, f) {
+				return false
+			}
+			val = val[len(f):]
+		} else {
+			want, n := utf8.DecodeRuneInString(val)
+			if caseFold(p.pt.rn) != want {
+				return false
+			}
+			val = val[n:]
+		}
+		p.read()
+	}
+	return true
+}
+
+// caseFold returns the simple case folding of rn, the smallest lowercase
+// rune of its orbit for unicode.SimpleFold, or its smallest rune if it has
+// no lowercase rune.
+func caseFold(rn rune) rune {
+	if rn < utf8.RuneSelf {
+		if 'A' <= rn && rn <= 'Z' {
+			rn += 'a' - 'A'
+		}
+		return rn
+	}
+
+	folded := rn
+	for r := unicode.SimpleFold(rn); r != rn; r = unicode.SimpleFold(r) {
+		if lower := unicode.IsLower(r); lower != unicode.IsLower(folded) {
+			if lower {
+				folded = r
+			}
+		} else if r < folded {
+			folded = r
+		}
+	}
+	return folded
+}
+
+// {{ end }} ==template==
+// ==template== {{ if .Precedence }}
+// precKind is the kind of the operators of a level of a precExpr.
+type precKind int
+
+const (
+	precLeft precKind = iota
+	precRight
+	precNone
+	precPrefix
+	precPostfix
+)
+
+// precExpr is an expression of operands and operators parsed by
+// precedence climbing, see parsePrec.
+// {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
+type precExpr struct {
+	pos     position
+	id      int
+	operand any
+	// levels are the levels of operators, from the lowest precedence to
+	// the highest.
+	levels []precLevel
+	// ==template== {{ if .Skip }}
+	skip bool
+	// {{ end }} ==template==
+}
+
+// precLevel is a level of operators of a precExpr. Its action, if any, is
+// called for each operator with the operands, see runPrec.
+type precLevel struct {
+	kind precKind
+	op   any
+	run  func(*parser) (any, error)
+}
+
+// the labels of the values of the binary and unary operators in their
+// action.
+var (
+	precBinaryLabels = []string{"l", "op", "r"}
+	precUnaryLabels  = []string{"op", "x"}
+)
+
+// parsePrec parses an operand and the operators that follow it, with the
+// operands of the levels from min. A left-associative or non-associative
+// operator parses its right operand with the levels above its own, a
+// right-associative one with its own level too, so that it binds to the
+// right. The operators are tried in the order of the levels, the first
+// one that matches with its operand is used.
+func (p *parser) parsePrec(prec *precExpr, min int) (any, bool) {
+	start := p.pt
+	val, ok := p.parsePrecOperand(prec)
+	if !ok {
+		return nil, false
+	}
+
+	// the level of the last non-associative operator, which cannot be
+	// followed by an operator of the same level.
+	none := -1
+	for matched := true; matched; {
+		matched = false
+		pt := p.pt
+		for i := min; i < len(prec.levels) && !matched; i++ {
+			lvl := &prec.levels[i]
+			if lvl.kind == precPrefix || i == none {
+				continue
+			}
+			p.parsePrecSkip(prec)
+			op, ok := p.parsePrecPart(lvl.op)
+			if !ok {
+				p.restore(pt)
+				continue
+			}
+			if lvl.kind == precPostfix {
+				val = p.runPrec(lvl, start, op, val)
+				matched = true
+				continue
+			}
+
+			rmin := i + 1
+			if lvl.kind == precRight {
+				rmin = i
+			}
+			p.parsePrecSkip(prec)
+			r, ok := p.parsePrec(prec, rmin)
+			if !ok {
+				p.restore(pt)
+				continue
+			}
+			val = p.runPrec(lvl, start, val, op, r)
+			matched = true
+			none = -1
+			if lvl.kind == precNone {
+				none = i
+			}
+		}
+	}
+	return val, true
+}
+
+// parsePrecOperand parses an operand of prec, preceded by a prefix operator
+// of any level, which parses its operand with the levels from its own.
+func (p *parser) parsePrecOperand(prec *precExpr) (any, bool) {
+	start := p.pt
+	for i := range prec.levels {
+		lvl := &prec.levels[i]
+		if lvl.kind != precPrefix {
+			continue
+		}
+		op, ok := p.parsePrecPart(lvl.op)
+		if !ok {
+			continue
+		}
+		p.parsePrecSkip(prec)
+		if x, ok := p.parsePrec(prec, i); ok {
+			return p.runPrec(lvl, start, op, x), true
+		}
+		p.restore(start)
+	}
+	return p.parsePrecPart(prec.operand)
+}
+
+// parsePrecPart parses an operand or an operator of a precExpr.
+func (p *parser) parsePrecPart(expr any) (any, bool) {
+	// ==template== {{ if .Compile }}
+	return expr.(func(*parser) (any, bool))(p)
+	// {{ else }}
+	return p.parseExprWrap(expr)
+	// {{ end }} ==template==
+}
+
+// parsePrecSkip matches the skip rule between the operands and the
+// operators of prec, if it is syntactic.
+func (p *parser) parsePrecSkip(prec *precExpr) {
+	// ==template== {{ if .Skip }}
+	if prec.skip {
+		p.parseSkip()
+	}
+	// {{ end }} ==template==
+}
+
+// runPrec returns the value of the operator of lvl with the values vals,
+// the result of the action of the level with the values labeled l, op and
+// r for a binary operator, or op and x for a unary one. The text of the
+// action starts at start. Without action, the value is the slice of the
+// values.
+func (p *parser) runPrec(lvl *precLevel, start savepoint, vals ...any) any {
+	if lvl.run == nil {
+		return vals
+	}
+	labels := precBinaryLabels
+	if len(vals) == 2 {
+		labels = precUnaryLabels
+	}
+	p.pushV()
+	m := p.vstack[len(p.vstack)-1]
+	for i, v := range vals {
+		m[labels[i]] = v
+	}
+	// ==template== {{ if .LazyPositions }}
+	p.cur.pos = p.resolvePosition(start.position)
+	// {{ else }}
+	p.cur.pos = start.position
+	// {{ end }} ==template==
+	p.cur.text = p.sliceFrom(start)
+	// ==template== {{ if or .GlobalState (not .Optimize) }}
+	state := p.cloneState()
+	// {{ end }} ==template==
+	val, err := lvl.run(p)
+	if err != nil {
+		p.addErrAt(err, start.position, []string{})
+	}
+	// ==template== {{ if or .GlobalState (not .Optimize) }}
+	p.restoreState(state)
+	// {{ end }} ==template==
+	p.popV()
+	return val
+}
+
+// {{ end }} ==template==
+// ==template== {{ if .Coverage }}
+// ==template== {{ if not .Compile }}
+
+// coverExpr counts the matches of the expression it wraps in coverCounts,
+// at index point.
+// {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
+type coverExpr struct {
+	pos   position
+	id    int
+	point int
+	expr  any
+}
+
+// {{ end }} ==template==
+
+// coverPoint describes an expression instrumented for coverage.
+// {{ if .Nolint }} nolint: structcheck {{else}} ==template== {{ end }}
+type coverPoint struct {
+	pos  position
+	rule string
+	kind string
+}
+
+// WriteCoverProfile writes the grammar coverage profile to w. The profile
+// records how many times each instrumented expression of the grammar
+// matched since the program started, in all parsers. Profiles can be
+// merged and reported with the "pigeon cover" command.
+//
+// Example usage, to write a profile after running the tests:
+//
+//	func TestMain(m *testing.M) {
+//	    code := m.Run()
+//	    f, err := os.Create("grammar.cover")
+//	    if err == nil {
+//	        err = WriteCoverProfile(f)
+//	        f.Close()
+//	    }
+//	    if err != nil {
+//	        log.Println(err)
+//	    }
+//	    os.Exit(code)
+//	}
+func WriteCoverProfile(w io.Writer) error {
+	var buf bytes.Buffer
+	buf.WriteString("mode: count\n")
+	for i, pt := range coverPoints {
+		fmt.Fprintf(&buf, "%s:%d:%d %d %s %s %d\n", coverFile, pt.pos.line, pt.pos.col,
+			pt.pos.offset, pt.rule, pt.kind, atomic.LoadUint64(&coverCounts[i]))
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// {{ end }} ==template==
+
+// errList cumulates the errors found by the parser.
+type errList []error
+
+func (e *errList) add(err error) {
+	*e = append(*e, err)
+}
+
+func (e errList) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	e.dedupe()
+	return e
+}
+
+func (e *errList) dedupe() {
+	var cleaned []error
+	set := make(map[string]bool)
+	for _, err := range *e {
+		if msg := err.Error(); !set[msg] {
+			set[msg] = true
+			cleaned = append(cleaned, err)
+		}
+	}
+	*e = cleaned
+}
+
+func (e errList) Error() string {
+	switch len(e) {
+	case 0:
+		return ""
+	case 1:
+		return e[0].Error()
+	default:
+		var buf bytes.Buffer
+
+		for i, err := range e {
+			if i > 0 {
+				buf.WriteRune('\n')
+			}
+			buf.WriteString(err.Error())
+		}
+		return buf.String()
+	}
+}
+
+// parserError wraps an error with a prefix indicating the rule in which
+// the error occurred. The original error is stored in the Inner field.
+type parserError struct {
+	Inner    error
+	pos      position
+	prefix   string
+	expected []string
+}
+
+// Error returns the error message.
+func (p *parserError) Error() string {
+	return p.prefix + ": " + p.Inner.Error()
+}
+
+// newParser creates a parser with the specified input source and options.
+func newParser(filename string, b []byte, opts ...Option) *parser {
+	stats := Stats{
+		ChoiceAltCnt: make(map[string]map[string]int),
+	}
+
+	p := &parser{
+		filename: filename,
+		errs:     new(errList),
+		data:     b,
+		pt:       savepoint{position: position{line: 1}},
+		recover:  true,
+		cur: current{
+			// ==template== {{ if or .GlobalState (not .Optimize) }}
+			state: make(storeDict),
+			// {{ end }} ==template==
+			globalStore: make(storeDict),
+		},
+		maxFailPos:      position{col: 1, line: 1},
+		maxFailExpected: make([]string, 0, 20),
+		Stats:           &stats,
+		// start rule is rule [0] unless an alternate entrypoint is specified
+		entrypoint: g.rules[0].name,
+		// ==template== {{ if .Indent }}
+		indentTabWidth: 8,
+		// {{ end }} ==template==
+	}
+	p.setOptions(opts)
+	// ==template== {{ if not .Binary }}
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
+	// {{ end }} ==template==
+
+	if p.maxExprCnt == 0 {
+		p.maxExprCnt = math.MaxUint64
+	}
+
+	return p
+}
+
+// setOptions applies the options to the parser.
+func (p *parser) setOptions(opts []Option) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// {{ if .Nolint }} nolint: structcheck,deadcode {{else}} ==template== {{ end }}
+type resultTuple struct {
+	v   any
+	b   bool
+	end savepoint
+	// ==template== {{ if .Indent }}
+	// the indentation stack at the start of the result, which depends on
+	// it.
+	indent *indentLevel
+	// {{ end }} ==template==
+}
+
+// {{ if .Nolint }} nolint: varcheck {{else}} ==template== {{ end }}
+const choiceNoMatch = -1
+
+// Stats stores some statistics, gathered during parsing
+type Stats struct {
+	// ExprCnt counts the number of expressions processed during parsing
+	// This value is compared to the maximum number of expressions allowed
+	// (set by the MaxExpressions option).
+	ExprCnt uint64
+
+	// ChoiceAltCnt is used to count for each ordered choice expression,
+	// which alternative is used how may times.
+	// These numbers allow to optimize the order of the ordered choice expression
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
+	//
+	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
+	// as the line and the column of the ordered choice.
+	// The inner key of ChoiceAltCnt is the number (one-based) of the matching alternative.
+	// For each alternative the number of matches are counted. If an ordered choice does not
+	// match, a special counter is incremented. The name of this counter is set with
+	// the parser option Statistics.
+	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
+	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
+}
+
+// ==template== {{ if .LeftRecursion }}
+type ruleWithExpsStack struct {
+	rule   *rule
+	estack []any
+}
+
+// {{ end }} ==template==
+
+// {{ if .Nolint }} nolint: structcheck,maligned {{else}} ==template== {{ end }}
+type parser struct {
+	filename string
+	pt       savepoint
+	cur      current
+
+	data []byte
+	errs *errList
+
+	depth   int
+	recover bool
+	// ==template== {{ if not .Optimize }}
+	debug bool
 
 	memoize bool
 	// {{ end }} ==template==
-	// ==template== {{ if or .LeftRecursion (not .Optimize) }}
-	// memoization table for the packrat algorithm:
-	// map[offset in source] map[expression or rule] {value, match}
-	memo map[int]map[any]resultTuple
+	// ==template== {{ if or .LeftRecursion .MemoRules (not .Optimize) }}
+	// memoization table for the packrat algorithm, taken from memoTables
+	// on the first memoized result
+	memo *memoTable
 	// {{ end }} ==template==
 
 	// rules table, maps the rule identifier to the rule node
 	rules map[string]*rule
+	// ==template== {{ if .Skip }}
+	// skip rule of the grammar
+	skipRule *rule
+	// {{ end }} ==template==
 	// variables stack, map of label to value
 	vstack []map[string]any
 	// rule stack, allows identification of the current rule in errors
@@ -543,11 +1422,51 @@ type parser struct {
 
 	allowInvalidUTF8 bool
 
+	// ==template== {{ if .Indent }}
+	// tabs options of the indentation, and offsets of the indentations
+	// whose tabs were rejected, to add the error once.
+	indentTabWidth int
+	indentTabs     IndentTabs
+	indentErrs     map[int]bool
+	// {{ end }} ==template==
+
+	// ==template== {{ if not .Binary }}
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+	// ==template== {{ if .LazyPositions }}
+	// offsets of the line breaks of the input and last position returned
+	// by resolvePosition.
+	lineBreaks   []int
+	lastResolved position
+	// {{ end }} ==template==
+	// {{ end }} ==template==
+
 	*Stats
+	// ==template== {{ if not .Optimize }}
+	// profiling frames, tracks the time spent in invoked rules to compute
+	// the self time of each rule.
+	profStack []profFrame
+	// number of active invocations of each rule, the time of a recursive
+	// rule is only added at its outermost invocation.
+	profActive map[*rule]int
+	// rules evaluated at each offset, to count the repeated evaluations.
+	profEvals map[profEval]bool
+	// {{ end }} ==template==
 
 	choiceNoMatch string
 	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
-	recoveryStack []map[string]any
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
 }
 
 // push a variable set on the vstack.
@@ -586,7 +1505,7 @@ func (p *parser) popV() {
 func (p *parser) pushRecovery(labels []string, expr any) {
 	if cap(p.recoveryStack) == len(p.recoveryStack) {
 		// create new empty slot in the stack
-		p.recoveryStack = append(p.recoveryStack, nil)
+		p.recoveryStack = append(p.recoveryStack, recovery{})
 	} else {
 		// slice to 1 more
 		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
@@ -596,111 +1515,630 @@ func (p *parser) pushRecovery(labels []string, expr any) {
 	for _, fl := range labels {
 		m[fl] = expr
 	}
-	p.recoveryStack[len(p.recoveryStack)-1] = m
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
 }
 
 // pop a recovery expression from the recoveryStack
 func (p *parser) popRecovery() {
 	// GC that map
-	p.recoveryStack[len(p.recoveryStack)-1] = nil
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
 
 	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
 }
 
+// ==template== {{ if .Throw }}
+// throw throws the failure of label, whose message template is message,
+// to the recovery expressions of the label from the innermost one until
+// one matches. Their code blocks get the failure as c.failure.
+func (p *parser) throw(label, message string) (any, bool) {
+	f := p.newFailure(label, message)
+	prev := p.cur.failure
+	for i := len(p.recoveryStack) - 1; i >= 0; i-- {
+		rec := p.recoveryStack[i]
+		recoverExpr, ok := rec.exprs[label]
+		if !ok {
+			continue
+		}
+		failure := *f
+		failure.text = p.data[rec.start:p.pt.offset]
+		p.cur.failure = &failure
+		// ==template== {{ if .Compile }}
+		val, ok := recoverExpr.(func(*parser) (any, bool))(p)
+		// {{ else }}
+		val, ok := p.parseExprWrap(recoverExpr)
+		// {{ end }} ==template==
+		p.cur.failure = prev
+		if ok {
+			return val, ok
+		}
+	}
+	return nil, false
+}
+
+// newFailure returns the failure of label thrown at the current position,
+// see failure.pos. The placeholders {label}, {expected} and {found} of the
+// message template are replaced by the label, the expected values and the
+// input at the position of the failure.
+func (p *parser) newFailure(label, message string) *failure {
+	f := &failure{label: label, pos: p.pt.position}
+	if p.maxFailPos.offset >= p.pt.offset && len(p.maxFailExpected) > 0 {
+		f.pos = p.maxFailPos
+		f.expected = p.expectedList()
+	}
+	// ==template== {{ if .LazyPositions }}
+	f.pos = p.resolvePosition(f.pos)
+	// {{ end }} ==template==
+
+	found := "EOF"
+	if f.pos.offset < len(p.data) {
+		// ==template== {{ if .Binary }}
+		found = fmt.Sprintf("%#02x", p.data[f.pos.offset])
+		// {{ else }}
+		rn, _ := utf8.DecodeRune(p.data[f.pos.offset:])
+		found = strconv.QuoteRune(rn)
+		// {{ end }} ==template==
+	}
+	f.message = strings.NewReplacer(
+		"{label}", label,
+		"{expected}", listJoin(f.expected, ", ", "or"),
+		"{found}", found,
+	).Replace(message)
+	return f
+}
+
+// {{ end }} ==template==
+
 // ==template== {{ if not .Optimize }}
 func (p *parser) print(prefix, s string) string {
 	if !p.debug {
 		return s
 	}
-
-	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
-		prefix, p.pt.line, p.pt.col, p.pt.offset, s, p.pt.rn)
-	return s
-}
-
-func (p *parser) printIndent(mark string, s string) string {
-	return p.print(strings.Repeat(" ", p.depth)+mark, s)
+
+	pos := p.pt.position
+	// ==template== {{ if .LazyPositions }}
+	pos = p.resolvePosition(pos)
+	// {{ end }} ==template==
+	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
+		prefix, pos.line, pos.col, pos.offset, s, p.pt.rn)
+	return s
+}
+
+func (p *parser) printIndent(mark string, s string) string {
+	return p.print(strings.Repeat(" ", p.depth)+mark, s)
+}
+
+func (p *parser) in(s string) string {
+	res := p.printIndent(">", s)
+	p.depth++
+	return res
+}
+
+func (p *parser) out(s string) string {
+	p.depth--
+	return p.printIndent("<", s)
+}
+
+// {{ end }} ==template==
+
+func (p *parser) addErr(err error) {
+	p.addErrAt(err, p.pt.position, []string{})
+}
+
+func (p *parser) addErrAt(err error, pos position, expected []string) {
+	// ==template== {{ if .Throw }}
+	var f *failure
+	if errors.As(err, &f) {
+		pos, expected = f.pos, f.expected
+	}
+	// {{ end }} ==template==
+	// ==template== {{ if .LazyPositions }}
+	pos = p.resolvePosition(pos)
+	// {{ end }} ==template==
+	var buf bytes.Buffer
+	if p.filename != "" {
+		buf.WriteString(p.filename)
+	}
+	if buf.Len() > 0 {
+		buf.WriteString(":")
+	}
+	buf.WriteString(fmt.Sprintf("%d:%d (%d)", pos.line, pos.col, pos.offset))
+	if len(p.rstack) > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString(": ")
+		}
+		rule := p.rstack[len(p.rstack)-1]
+		if rule.displayName != "" {
+			buf.WriteString("rule " + rule.displayName)
+		} else {
+			buf.WriteString("rule " + rule.name)
+		}
+	}
+	pe := &parserError{Inner: err, pos: pos, prefix: buf.String(), expected: expected}
+	p.errs.add(pe)
+}
+
+func (p *parser) failAt(fail bool, pos position, want string) {
+	// process fail if parsing fails and not inverted or parsing succeeds and invert is set
+	if fail == p.maxFailInvertExpected {
+		if pos.offset < p.maxFailPos.offset {
+			return
+		}
+
+		if pos.offset > p.maxFailPos.offset {
+			p.maxFailPos = pos
+			p.maxFailExpected = p.maxFailExpected[:0]
+		}
+
+		if p.maxFailInvertExpected {
+			want = "!" + want
+		}
+		p.maxFailExpected = append(p.maxFailExpected, want)
+	}
+}
+
+// read advances the parser to the next rune.
+func (p *parser) read() {
+	// ==template== {{ if .Binary }}
+	// the binary parser reads a byte at a time, the column is the byte
+	// count and there are no lines.
+	p.pt.offset += p.pt.w
+	if p.pt.offset < len(p.data) {
+		p.pt.rn = rune(p.data[p.pt.offset])
+		p.pt.w = 1
+	} else {
+		p.pt.rn = utf8.RuneError
+		p.pt.w = 0
+	}
+	p.pt.col++
+	// {{ else }}
+	// ==template== {{ if .LazyPositions }}
+	// the line and column are computed from the offset when they are
+	// needed, see resolvePosition.
+	p.pt.offset += p.pt.w
+	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
+	p.pt.rn = rn
+	p.pt.w = n
+	// {{ else }}
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
+	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
+	p.pt.rn = rn
+	p.pt.w = n
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
+	}
+	// {{ end }} ==template==
+
+	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
+		if !p.allowInvalidUTF8 {
+			p.addErr(errInvalidEncoding)
+		}
+	}
+	// {{ end }} ==template==
+}
+
+// ==template== {{ if not .Binary }}
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
+// ==template== {{ if .LazyPositions }}
+// resolvePosition returns pos with the line and column computed from its
+// offset. The line is found in the index of the line breaks, built on the
+// first call, and the column by reading the line up to the offset, from
+// the last resolved position if it is on the same line.
+func (p *parser) resolvePosition(pos position) position {
+	if p.lineBreaks == nil {
+		p.indexLineBreaks()
+	}
+	i := sort.SearchInts(p.lineBreaks, pos.offset+1) - 1
+	line := i + 2
+
+	// the first line has no line break, it is read from the start of the
+	// input like the parser does.
+	start := position{line: 1}
+	cur, next, prev, prevW := start, 0, rune(0), 0
+	switch {
+	case p.lastResolved.line == line && p.lastResolved.offset <= pos.offset:
+		cur = p.lastResolved
+	case i >= 0:
+		cur = position{line: line, offset: p.lineBreaks[i]}
+	}
+	if cur != start {
+		if cur.offset == pos.offset {
+			return cur
+		}
+		prev, prevW = utf8.DecodeRune(p.data[cur.offset:])
+		next = cur.offset + prevW
+	}
+
+	for {
+		rn, n := utf8.DecodeRune(p.data[next:])
+		p.advancePosition(&cur, prev, prevW, rn)
+		cur.offset = next
+		if next >= pos.offset {
+			break
+		}
+		prev, prevW = rn, n
+		next += n
+	}
+	p.lastResolved = cur
+	return cur
+}
+
+// indexLineBreaks records the offsets of the line breaks of the input, a
+// '\r' followed by '\n' is a single line break at the '\r' if the CRLF
+// option is set.
+func (p *parser) indexLineBreaks() {
+	p.lineBreaks = make([]int, 0, bytes.Count(p.data, []byte{'\n'}))
+	for i, c := range p.data {
+		switch {
+		case c == '\r' && p.crlf:
+			p.lineBreaks = append(p.lineBreaks, i)
+		case c == '\n' && !(p.crlf && i > 0 && p.data[i-1] == '\r'):
+			p.lineBreaks = append(p.lineBreaks, i)
+		}
+	}
+}
+
+// {{ end }} ==template==
+
+// {{ end }} ==template==
+
+// ==template== {{ if .Binary }}
+// skip advances the binary parser by n bytes, there must be at least n
+// bytes left in the input.
+func (p *parser) skip(n int) {
+	if n == 0 {
+		return
+	}
+	p.pt.offset += n - 1
+	p.pt.col += n - 1
+	p.read()
+}
+
+// matchUint matches the built-in rule name, an unsigned integer of size
+// bytes. Its value is an uint8, uint16, uint32 or uint64.
+func (p *parser) matchUint(name string, size int, littleEndian bool) (any, bool) {
+	start := p.pt
+	if len(p.data)-start.offset < size {
+		p.failAt(false, start.position, name)
+		return nil, false
+	}
+	var v uint64
+	for i := 0; i < size; i++ {
+		ix := i
+		if littleEndian {
+			ix = size - 1 - i
+		}
+		v = v<<8 | uint64(p.data[start.offset+ix])
+	}
+	p.skip(size)
+	p.failAt(true, start.position, name)
+
+	switch size {
+	case 1:
+		return uint8(v), true
+	case 2:
+		return uint16(v), true
+	case 4:
+		return uint32(v), true
+	}
+	return v, true
+}
+
+// matchBytes matches the built-in rule bytes<label>, the number of bytes
+// given by the value of the label. Its value is the matched bytes.
+func (p *parser) matchBytes(label string) (any, bool) {
+	start := p.pt
+	want := "bytes<" + label + ">"
+	var v any
+	for i := len(p.vstack) - 1; i >= 0; i-- {
+		var ok bool
+		if v, ok = p.vstack[i][label]; ok {
+			break
+		}
+	}
+	n, ok := byteCount(v)
+	if !ok {
+		p.addErr(fmt.Errorf("%s: invalid number of bytes %v", want, v))
+		return nil, false
+	}
+	if len(p.data)-start.offset < n {
+		p.failAt(false, start.position, want)
+		return nil, false
+	}
+	p.skip(n)
+	p.failAt(true, start.position, want)
+	return p.sliceFrom(start), true
+}
+
+// byteCount returns the number of bytes represented by the value v of a
+// label, which must be a non-negative integer.
+func byteCount(v any) (int, bool) {
+	var n uint64
+	switch v := v.(type) {
+	case uint8:
+		n = uint64(v)
+	case uint16:
+		n = uint64(v)
+	case uint32:
+		n = uint64(v)
+	case uint64:
+		n = v
+	case int:
+		if v < 0 {
+			return 0, false
+		}
+		n = uint64(v)
+	default:
+		return 0, false
+	}
+	if n > math.MaxInt32 {
+		return 0, false
+	}
+	return int(n), true
+}
+
+// {{ end }} ==template==
+
+// ==template== {{ if .BackRef }}
+// matchBackRef matches the text captured by the label whose text key is
+// key, or if state is true, the text of the value of key in the state
+// store, which must be a string or a []byte. Its value is the matched
+// text.
+func (p *parser) matchBackRef(key string, state bool) (any, bool) {
+	var text []byte
+	if state {
+		// ==template== {{ if or .GlobalState (not .Optimize) }}
+		switch v := p.cur.state[key].(type) {
+		case string:
+			text = []byte(v)
+		case []byte:
+			text = v
+		default:
+			p.addErr(fmt.Errorf("$#%s: invalid text %v", key, v))
+			return nil, false
+		}
+		// {{ end }} ==template==
+	} else {
+		for i := len(p.vstack) - 1; i >= 0; i-- {
+			if v, ok := p.vstack[i][key]; ok {
+				text = v.([]byte)
+				break
+			}
+		}
+	}
+
+	start := p.pt
+	want := strconv.Quote(string(text))
+	if !bytes.HasPrefix(p.data[start.offset:], text) {
+		p.failAt(false, start.position, want)
+		return nil, false
+	}
+	for p.pt.offset < start.offset+len(text) {
+		p.read()
+	}
+	p.failAt(true, start.position, want)
+	return p.sliceFrom(start), true
+}
+
+// {{ end }} ==template==
+
+// ==template== {{ if .Regexp }}
+// matchRegexp matches the regular expression re, anchored at the start of
+// the remaining input, want is its name in the expected list. Its value is
+// the matched text. If the regular expression has assertions on the input
+// before the current position, after is the regular expression preceded
+// by a rune, which is matched from the rune before the current position.
+func (p *parser) matchRegexp(re, after *regexp.Regexp, want string) (any, bool) {
+	start := p.pt
+	from := start.offset
+	if after != nil && from > 0 {
+		_, n := utf8.DecodeLastRune(p.data[:from])
+		from -= n
+		re = after
+	}
+	loc := re.FindIndex(p.data[from:])
+	if loc == nil {
+		p.failAt(false, start.position, want)
+		return nil, false
+	}
+	for p.pt.offset < from+loc[1] {
+		p.read()
+	}
+	p.failAt(true, start.position, want)
+	return p.sliceFrom(start), true
+}
+
+// {{ end }} ==template==
+
+// ==template== {{ if .MatcherFunc }}
+// matchFunc calls the matcher function fn with the remaining input, and the
+// current position as the position of c. If it matches, fn returns the
+// number of bytes that it matched, which must end on a rune, and the value
+// of the match. want is its name in the expected list. The changes of fn
+// to the state store are rolled back if it does not match.
+func (p *parser) matchFunc(fn func(*current, []byte) (int, any, bool), want string) (any, bool) {
+	start := p.pt
+	// ==template== {{ if .LazyPositions }}
+	p.cur.pos = p.resolvePosition(start.position)
+	// {{ else }}
+	p.cur.pos = start.position
+	// {{ end }} ==template==
+	p.cur.text = nil
+	// ==template== {{ if or .GlobalState (not .Optimize) }}
+	state := p.cloneState()
+	// {{ end }} ==template==
+
+	input := p.data[start.offset:]
+	n, val, ok := fn(&p.cur, input)
+	if ok && (n < 0 || n > len(input)) {
+		p.addErr(fmt.Errorf("%s: invalid length %d", want, n))
+		ok = false
+	}
+	if ok {
+		for p.pt.offset < start.offset+n {
+			p.read()
+		}
+		if p.pt.offset != start.offset+n {
+			// n ends in the middle of a rune
+			p.restore(start)
+			p.addErr(fmt.Errorf("%s: invalid length %d", want, n))
+			ok = false
+		}
+	}
+	if !ok {
+		// ==template== {{ if or .GlobalState (not .Optimize) }}
+		p.restoreState(state)
+		// {{ end }} ==template==
+		p.failAt(false, start.position, want)
+		return nil, false
+	}
+	p.failAt(true, start.position, want)
+	return val, true
 }
 
-func (p *parser) in(s string) string {
-	res := p.printIndent(">", s)
-	p.depth++
-	return res
-}
+// {{ end }} ==template==
 
-func (p *parser) out(s string) string {
-	p.depth--
-	return p.printIndent("<", s)
+// ==template== {{ if .DFA }}
+// dfaRune returns the current rune for the automata of the grammar, or -1
+// at the end of the input.
+func (p *parser) dfaRune() rune {
+	if p.pt.w == 0 {
+		return -1
+	}
+	return p.pt.rn
 }
 
 // {{ end }} ==template==
 
-func (p *parser) addErr(err error) {
-	p.addErrAt(err, p.pt.position, []string{})
-}
-
-func (p *parser) addErrAt(err error, pos position, expected []string) {
-	var buf bytes.Buffer
-	if p.filename != "" {
-		buf.WriteString(p.filename)
-	}
-	if buf.Len() > 0 {
-		buf.WriteString(":")
-	}
-	buf.WriteString(fmt.Sprintf("%d:%d (%d)", pos.line, pos.col, pos.offset))
-	if len(p.rstack) > 0 {
-		if buf.Len() > 0 {
-			buf.WriteString(": ")
+// ==template== {{ if .Indent }}
+// matchIndent matches the built-in indentation rule of kind. INDENT
+// matches if the indentation at the current position is wider than the
+// current one and pushes it on the indentation stack, DEDENT matches if it
+// is narrower and is one of the enclosing indentations and pops the
+// current one. Neither consumes any input. SAMEDENT matches if the
+// indentation is the current one and consumes it. Their value is the
+// matched text.
+func (p *parser) matchIndent(kind indentKind) (any, bool) {
+	start := p.pt
+	width, end, ok := p.indentation()
+	cur := p.pt.indent.indentWidth()
+	switch kind {
+	case indentIndent:
+		ok = ok && width > cur
+		if ok {
+			p.pt.indent = &indentLevel{width: width, prev: p.pt.indent}
 		}
-		rule := p.rstack[len(p.rstack)-1]
-		if rule.displayName != "" {
-			buf.WriteString("rule " + rule.displayName)
-		} else {
-			buf.WriteString("rule " + rule.name)
+	case indentDedent:
+		ok = ok && width < cur && p.pt.indent.prev.encloses(width)
+		if ok {
+			p.pt.indent = p.pt.indent.prev
+		}
+	case indentSame:
+		ok = ok && width == cur
+		for ok && p.pt.offset < end {
+			p.read()
 		}
 	}
-	pe := &parserError{Inner: err, pos: pos, prefix: buf.String(), expected: expected}
-	p.errs.add(pe)
+	p.failAt(ok, start.position, indentNames[kind])
+	if !ok {
+		return nil, false
+	}
+	return p.sliceFrom(start), true
 }
 
-func (p *parser) failAt(fail bool, pos position, want string) {
-	// process fail if parsing fails and not inverted or parsing succeeds and invert is set
-	if fail == p.maxFailInvertExpected {
-		if pos.offset < p.maxFailPos.offset {
-			return
-		}
-
-		if pos.offset > p.maxFailPos.offset {
-			p.maxFailPos = pos
-			p.maxFailExpected = p.maxFailExpected[:0]
+// indentation returns the width of the spaces and tabs at the current
+// position and the offset that follows them. It returns false if the tabs
+// are rejected by the Indentation option.
+func (p *parser) indentation() (width, end int, ok bool) {
+	tabWidth := p.indentTabWidth
+	if tabWidth < 1 {
+		tabWidth = 1
+	}
+	var spaces, tabs bool
+	end = p.pt.offset
+scan:
+	for ; end < len(p.data); end++ {
+		switch p.data[end] {
+		case ' ':
+			width++
+			spaces = true
+		case '\t':
+			width += tabWidth - width%tabWidth
+			tabs = true
+		default:
+			break scan
 		}
+	}
 
-		if p.maxFailInvertExpected {
-			want = "!" + want
+	if tabs && (p.indentTabs == IndentTabsReject || spaces && p.indentTabs == IndentTabsNoMix) {
+		if !p.indentErrs[p.pt.offset] {
+			if p.indentErrs == nil {
+				p.indentErrs = make(map[int]bool)
+			}
+			p.indentErrs[p.pt.offset] = true
+			p.addErr(errIndentTabs)
 		}
-		p.maxFailExpected = append(p.maxFailExpected, want)
+		return 0, end, false
 	}
+	return width, end, true
 }
 
-// read advances the parser to the next rune.
-func (p *parser) read() {
-	p.pt.offset += p.pt.w
-	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
-	p.pt.rn = rn
-	p.pt.w = n
-	p.pt.col++
-	if rn == '\n' {
-		p.pt.line++
-		p.pt.col = 0
+// indentWidth returns the width of the indentation l.
+func (l *indentLevel) indentWidth() int {
+	if l == nil {
+		return 0
 	}
+	return l.width
+}
 
-	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
-		if !p.allowInvalidUTF8 {
-			p.addErr(errInvalidEncoding)
+// encloses returns true if width is the width of l or of one of the
+// levels below it.
+func (l *indentLevel) encloses(width int) bool {
+	for ; l != nil && l.width >= width; l = l.prev {
+		if l.width == width {
+			return true
 		}
 	}
+	return l == nil && width == 0
 }
 
+// {{ end }} ==template==
+
 // restore parser position to the savepoint pt.
 func (p *parser) restore(pt savepoint) {
 	// ==template== {{ if not .Optimize }}
@@ -709,8 +2147,17 @@ func (p *parser) restore(pt savepoint) {
 	}
 	// {{ end }} ==template==
 	if pt.offset == p.pt.offset {
+		// ==template== {{ if .Indent }}
+		// the indentation rules change the stack without consuming input
+		p.pt.indent = pt.indent
+		// {{ end }} ==template==
 		return
 	}
+	// ==template== {{ if not .Optimize }}
+	if p.Rules != nil && pt.offset < p.pt.offset && len(p.rstack) > 0 {
+		p.ruleStats(p.rstack[len(p.rstack)-1]).Backtracked += uint64(p.pt.offset - pt.offset)
+	}
+	// {{ end }} ==template==
 	p.pt = pt
 }
 
@@ -776,29 +2223,118 @@ func (p *parser) sliceFrom(start savepoint) []byte {
 	return p.data[start.position.offset:p.pt.position.offset]
 }
 
-// ==template== {{ if or .LeftRecursion (not .Optimize) }}
-func (p *parser) getMemoized(node any) (resultTuple, bool) {
-	if len(p.memo) == 0 {
-		return resultTuple{}, false
+// ==template== {{ if or .LeftRecursion .MemoRules (not .Optimize) }}
+// memoSlot is an entry of the memoization table, the key of an empty slot
+// is 0.
+type memoSlot struct {
+	key uint64
+	res resultTuple
+}
+
+// memoTable is the memoization table for the packrat algorithm, an
+// open-addressed hash table with linear probing that maps the offset in
+// the source and the id of a rule or expression to its result.
+type memoTable struct {
+	slots []memoSlot
+	shift uint
+	n     int
+}
+
+// memoTables pools the memoization tables so that their storage is
+// reused across parses.
+var memoTables = &sync.Pool{
+	New: func() any {
+		return &memoTable{slots: make([]memoSlot, 1<<10), shift: 64 - 10}
+	},
+}
+
+// memoKey returns the key of the result of the rule or expression id at
+// offset, it supports up to 1<<24 ids.
+func memoKey(offset, id int) uint64 {
+	return uint64(offset+1)<<24 | uint64(id)
+}
+
+// index returns the index of the slot of key, or of the empty slot where
+// it should be stored.
+func (t *memoTable) index(key uint64) int {
+	mask := len(t.slots) - 1
+	i := int((key*0x9E3779B97F4A7C15)>>t.shift) & mask
+	for t.slots[i].key != 0 && t.slots[i].key != key {
+		i = (i + 1) & mask
+	}
+	return i
+}
+
+func (t *memoTable) get(key uint64) (resultTuple, bool) {
+	s := &t.slots[t.index(key)]
+	return s.res, s.key == key
+}
+
+func (t *memoTable) set(key uint64, res resultTuple) {
+	if 4*(t.n+1) > 3*len(t.slots) {
+		t.grow()
 	}
-	m := p.memo[p.pt.offset]
-	if len(m) == 0 {
+	s := &t.slots[t.index(key)]
+	if s.key == 0 {
+		s.key = key
+		t.n++
+	}
+	s.res = res
+}
+
+// grow doubles the capacity of the table.
+func (t *memoTable) grow() {
+	old := t.slots
+	t.slots = make([]memoSlot, 2*len(old))
+	t.shift--
+	for _, s := range old {
+		if s.key != 0 {
+			t.slots[t.index(s.key)] = s
+		}
+	}
+}
+
+// reset empties the table, releasing the references to the results.
+func (t *memoTable) reset() {
+	if t.n == 0 {
+		return
+	}
+	for i := range t.slots {
+		t.slots[i] = memoSlot{}
+	}
+	t.n = 0
+}
+
+func (p *parser) getMemoized(id int) (resultTuple, bool) {
+	if p.memo == nil {
 		return resultTuple{}, false
 	}
-	res, ok := m[node]
-	return res, ok
+	// ==template== {{ if .Indent }}
+	res, ok := p.memo.get(memoKey(p.pt.offset, id))
+	return res, ok && res.indent == p.pt.indent
+	// {{ else }}
+	return p.memo.get(memoKey(p.pt.offset, id))
+	// {{ end }} ==template==
 }
 
-func (p *parser) setMemoized(pt savepoint, node any, tuple resultTuple) {
+func (p *parser) setMemoized(pt savepoint, id int, tuple resultTuple) {
 	if p.memo == nil {
-		p.memo = make(map[int]map[any]resultTuple)
+		p.memo = memoTables.Get().(*memoTable)
 	}
-	m := p.memo[pt.offset]
-	if m == nil {
-		m = make(map[any]resultTuple)
-		p.memo[pt.offset] = m
+	// ==template== {{ if .Indent }}
+	tuple.indent = pt.indent
+	// {{ end }} ==template==
+	p.memo.set(memoKey(pt.offset, id), tuple)
+}
+
+// releaseMemo returns the memoization table to the pool.
+func (p *parser) releaseMemo() {
+	if p.memo == nil {
+		return
 	}
-	m[node] = tuple
+	p.memo.reset()
+	memoTables.Put(p.memo)
+	p.memo = nil
 }
 
 // {{ end }} ==template==
@@ -808,8 +2344,26 @@ func (p *parser) buildRulesTable(g *grammar) {
 	for _, r := range g.rules {
 		p.rules[r.name] = r
 	}
+	// ==template== {{ if .Skip }}
+	p.skipRule = p.rules[g.skip]
+	// {{ end }} ==template==
+}
+
+// ==template== {{ if .Skip }}
+// parseSkip matches the skip rule as many times as possible. It stops when
+// the rule fails or does not consume any input.
+func (p *parser) parseSkip() {
+	for {
+		pt := p.pt
+		if _, ok := p.parseRuleWrap(p.skipRule); !ok || p.pt.offset == pt.offset {
+			p.restore(pt)
+			return
+		}
+	}
 }
 
+// {{ end }} ==template==
+
 // {{ if .Nolint }} nolint: gocyclo {{else}} ==template== {{ end }}
 func (p *parser) parse(g *grammar) (val any, err error) {
 	if len(g.rules) == 0 {
@@ -819,6 +2373,9 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 
 	// TODO : not super critical but this could be generated
 	p.buildRulesTable(g)
+	// ==template== {{ if or .LeftRecursion .MemoRules (not .Optimize) }}
+	defer p.releaseMemo()
+	// {{ end }} ==template==
 
 	if p.recover {
 		// panic can be used in action code to stop parsing immediately
@@ -841,6 +2398,21 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 			}
 		}()
 	}
+	// ==template== {{ if .Cut }}
+
+	// a failure after a cut stops the parsing, the error reports the
+	// expected values where it failed, in the rule of the cut.
+	defer func() {
+		if e := recover(); e != nil {
+			if e != errCutFailure {
+				panic(e)
+			}
+			val = nil
+			p.addNoMatchErr()
+			err = p.errs.err()
+		}
+	}()
+	// {{ end }} ==template==
 
 	startRule, ok := p.rules[p.entrypoint]
 	if !ok {
@@ -854,24 +2426,7 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 		if len(*p.errs) == 0 {
 			// If parsing fails, but no errors have been recorded, the expected values
 			// for the farthest parser position are returned as error.
-			maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
-			for _, v := range p.maxFailExpected {
-				maxFailExpectedMap[v] = struct{}{}
-			}
-			expected := make([]string, 0, len(maxFailExpectedMap))
-			eof := false
-			if _, ok := maxFailExpectedMap["!."]; ok {
-				delete(maxFailExpectedMap, "!.")
-				eof = true
-			}
-			for k := range maxFailExpectedMap {
-				expected = append(expected, k)
-			}
-			sort.Strings(expected)
-			if eof {
-				expected = append(expected, "EOF")
-			}
-			p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+			p.addNoMatchErr()
 		}
 
 		return nil, p.errs.err()
@@ -879,6 +2434,36 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 	return val, p.errs.err()
 }
 
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
 func listJoin(list []string, sep string, lastSep string) string {
 	switch len(list) {
 	case 0:
@@ -893,7 +2478,7 @@ func listJoin(list []string, sep string, lastSep string) string {
 // ==template== {{ if .LeftRecursion }}
 
 func (p *parser) parseRuleRecursiveLeader(rule *rule) (any, bool) {
-	result, ok := p.getMemoized(rule)
+	result, ok := p.getMemoized(rule.id)
 	if ok {
 		p.restore(result.end)
 		return result.v, result.b
@@ -908,7 +2493,7 @@ func (p *parser) parseRuleRecursiveLeader(rule *rule) (any, bool) {
 	var (
 		depth      = 0
 		startMark  = p.pt
-		lastResult = resultTuple{nil, false, startMark}
+		lastResult = resultTuple{end: startMark}
 		lastErrors = *p.errs
 	)
 
@@ -916,7 +2501,7 @@ func (p *parser) parseRuleRecursiveLeader(rule *rule) (any, bool) {
 		// ==template== {{ if or .GlobalState (not .Optimize) }}
 		lastState := p.cloneState()
 		// {{ end }} ==template==
-		p.setMemoized(startMark, rule, lastResult)
+		p.setMemoized(startMark, rule.id, lastResult)
 		val, ok := p.parseRule(rule)
 		endMark := p.pt
 		// ==template== {{ if not .Optimize }}
@@ -933,14 +2518,14 @@ func (p *parser) parseRuleRecursiveLeader(rule *rule) (any, bool) {
 			*p.errs = lastErrors
 			break
 		}
-		lastResult = resultTuple{val, ok, endMark}
+		lastResult = resultTuple{v: val, b: ok, end: endMark}
 		lastErrors = *p.errs
 		p.restore(startMark)
 		depth++
 	}
 
 	p.restore(lastResult.end)
-	p.setMemoized(startMark, rule, lastResult)
+	p.setMemoized(startMark, rule.id, lastResult)
 	return lastResult.v, lastResult.b
 }
 
@@ -951,8 +2536,96 @@ func (p *parser) parseRuleRecursiveNoLeader(rule *rule) (any, bool) {
 // {{ end }} ==template==
 
 // ==template== {{ if not .Optimize }}
+
+// profFrame is a rule invocation being profiled.
+type profFrame struct {
+	start time.Time
+	child time.Duration
+}
+
+// profEval is the evaluation of a rule at an offset.
+type profEval struct {
+	rule   *rule
+	offset int
+}
+
+// ruleStats returns the statistics of rule, creating them if needed.
+func (p *parser) ruleStats(rule *rule) *RuleStats {
+	rs := p.Rules[rule.name]
+	if rs == nil {
+		rs = &RuleStats{}
+		p.Rules[rule.name] = rs
+	}
+	return rs
+}
+
+func (p *parser) countMemo(rs *RuleStats, hit bool) {
+	if hit {
+		rs.MemoHits++
+	} else {
+		rs.MemoMisses++
+	}
+}
+
+func (p *parser) profEnter(r *rule) {
+	if p.profActive == nil {
+		p.profActive = make(map[*rule]int)
+	}
+	p.profActive[r]++
+	p.profStack = append(p.profStack, profFrame{start: time.Now()})
+}
+
+// countRepeat counts the evaluation of rule at the current offset if
+// it was already evaluated at that offset.
+func (p *parser) countRepeat(rule *rule) {
+	if p.profEvals == nil {
+		p.profEvals = make(map[profEval]bool)
+	}
+	key := profEval{rule: rule, offset: p.pt.offset}
+	if p.profEvals[key] {
+		p.ruleStats(rule).Repeats++
+		return
+	}
+	p.profEvals[key] = true
+}
+
+func (p *parser) profExit(rule *rule, start savepoint, ok bool) {
+	frame := p.profStack[len(p.profStack)-1]
+	p.profStack = p.profStack[:len(p.profStack)-1]
+	elapsed := time.Since(frame.start)
+	if n := len(p.profStack); n > 0 {
+		p.profStack[n-1].child += elapsed
+	}
+
+	rs := p.ruleStats(rule)
+	rs.Invocations++
+	if p.profActive[rule]--; p.profActive[rule] == 0 {
+		// the time of the inner invocations is included
+		rs.Time += elapsed
+	}
+	rs.SelfTime += elapsed - frame.child
+	if ok {
+		rs.Matches++
+		rs.Bytes += uint64(p.pt.offset - start.offset)
+	} else {
+		rs.Failures++
+	}
+}
+
+// {{ end }} ==template==
+
+// ==template== {{ if or .MemoRules (not .Optimize) }}
 func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
-	res, ok := p.getMemoized(rule)
+	if rule.id < 0 {
+		// its results depend on the captured texts or the state store
+		return p.parseRule(rule)
+	}
+	res, ok := p.getMemoized(rule.id)
+	// ==template== {{ if not .Optimize }}
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(rule), ok)
+	}
+	// {{ end }} ==template==
 	if ok {
 		p.restore(res.end)
 		return res.v, res.b
@@ -960,7 +2633,7 @@ func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
 
 	startMark := p.pt
 	val, ok := p.parseRule(rule)
-	p.setMemoized(startMark, rule, resultTuple{val, ok, p.pt})
+	p.setMemoized(startMark, rule.id, resultTuple{v: val, b: ok, end: p.pt})
 
 	return val, ok
 }
@@ -981,11 +2654,23 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 		// {{ end }} ==template==
 	)
 
+	// ==template== {{ if not .Optimize }}
+	if p.Rules != nil {
+		p.profEnter(rule)
+		defer func() { p.profExit(rule, startMark, ok) }()
+	}
+	// {{ end }} ==template==
+
 	// ==template== {{ if and .LeftRecursion (not .Optimize) }}
-	if p.memoize || rule.leftRecursive {
+	// ==template== {{ if .MemoRules }}
+	memoize := p.memoize || rule.memoize
+	// {{ else }}
+	memoize := p.memoize
+	// {{ end }} ==template==
+	if memoize || rule.leftRecursive {
 		if rule.leader {
 			val, ok = p.parseRuleRecursiveLeader(rule)
-		} else if p.memoize && !rule.leftRecursive {
+		} else if memoize && !rule.leftRecursive {
 			val, ok = p.parseRuleMemoize(rule)
 		} else {
 			val, ok = p.parseRuleRecursiveNoLeader(rule)
@@ -994,7 +2679,11 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 		val, ok = p.parseRule(rule)
 	}
 	// {{ else if not .Optimize }}
+	// ==template== {{ if .MemoRules }}
+	if p.memoize || rule.memoize {
+	// {{ else }}
 	if p.memoize {
+	// {{ end }} ==template==
 		val, ok = p.parseRuleMemoize(rule)
 	} else {
 		val, ok = p.parseRule(rule)
@@ -1006,6 +2695,16 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 		} else {
 			val, ok = p.parseRuleRecursiveNoLeader(rule)
 		}
+	// ==template== {{ if .MemoRules }}
+	} else if rule.memoize {
+		val, ok = p.parseRuleMemoize(rule)
+	// {{ end }} ==template==
+	} else {
+		val, ok = p.parseRule(rule)
+	}
+	// {{ else if .MemoRules }}
+	if rule.memoize {
+		val, ok = p.parseRuleMemoize(rule)
 	} else {
 		val, ok = p.parseRule(rule)
 	}
@@ -1022,25 +2721,61 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 }
 
 func (p *parser) parseRule(rule *rule) (any, bool) {
+	// ==template== {{ if not .Optimize }}
+	if p.Rules != nil {
+		p.countRepeat(rule)
+	}
+	// {{ end }} ==template==
 	p.rstack = append(p.rstack, rule)
 	p.pushV()
+	// ==template== {{ if .Compile }}
+	val, ok := rule.run(p)
+	// {{ else }}
 	val, ok := p.parseExprWrap(rule.expr)
+	// {{ end }} ==template==
 	p.popV()
 	p.rstack = p.rstack[:len(p.rstack)-1]
 	return val, ok
 }
 
+// ==template== {{ if not .Optimize }}
+
+// incChoiceAltCnt counts the match of the alternative altI of the choice
+// at pos, or its failure if altI is choiceNoMatch.
+func (p *parser) incChoiceAltCnt(pos position, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, pos.line, pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
+// {{ end }} ==template==
+
+// ==template== {{ if not .Compile }}
+
 func (p *parser) parseExprWrap(expr any) (any, bool) {
 	// ==template== {{ if not .Optimize }}
 	var pt savepoint
+	id := exprID(expr)
 
 	// ==template== {{ if .LeftRecursion }}
 	isLeftRecursion := p.rstack[len(p.rstack)-1].leftRecursive
-	if p.memoize && !isLeftRecursion {
+	if p.memoize && id >= 0 && !isLeftRecursion {
 	// {{ else }}
-	if p.memoize {
+	if p.memoize && id >= 0 {
 	// {{ end }} ==template==
-		res, ok := p.getMemoized(expr)
+		res, ok := p.getMemoized(id)
+		if p.Rules != nil {
+			p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+		}
 		if ok {
 			p.restore(res.end)
 			return res.v, res.b
@@ -1053,18 +2788,123 @@ func (p *parser) parseExprWrap(expr any) (any, bool) {
 
 	// ==template== {{ if not .Optimize }}
 	// ==template== {{ if .LeftRecursion }}
-	if p.memoize && !isLeftRecursion {
+	if p.memoize && id >= 0 && !isLeftRecursion {
 	// {{ else }}
-	if p.memoize {
+	if p.memoize && id >= 0 {
+	// {{ end }} ==template==
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
+	}
+	// {{ end }} ==template==
+	return val, ok
+}
+
+// ==template== {{ if not .Optimize }}
+// exprID returns the id of the expression in the memoization table, -1 if
+// its results depend on the texts captured by the labels or on the state
+// store and are not memoized.
+// {{ if .Nolint }} nolint: gocyclo {{else}} ==template== {{ end }}
+func exprID(expr any) int {
+	switch expr := expr.(type) {
+	case *actionExpr:
+		return expr.id
+	case *andCodeExpr:
+		return expr.id
+	case *andExpr:
+		return expr.id
+	case *anyMatcher:
+		return expr.id
+	case *charClassMatcher:
+		return expr.id
+	case *choiceExpr:
+		return expr.id
+	// ==template== {{ if .Cut }}
+	case *cutExpr:
+		return expr.id
+	// {{ end }} ==template==
+	case *labeledExpr:
+		return expr.id
+	case *litMatcher:
+		return expr.id
+	// ==template== {{ if .LitSet }}
+	case *litSetMatcher:
+		return expr.id
+	// {{ end }} ==template==
+	// ==template== {{ if .Precedence }}
+	case *precExpr:
+		return expr.id
+	// {{ end }} ==template==
+	case *notCodeExpr:
+		return expr.id
+	case *notExpr:
+		return expr.id
+	case *oneOrMoreExpr:
+		return expr.id
+	case *recoveryExpr:
+		return expr.id
+	case *repeatExpr:
+		return expr.id
+	case *ruleRefExpr:
+		return expr.id
+	case *seqExpr:
+		return expr.id
+	case *stateCodeExpr:
+		return expr.id
+	// ==template== {{ if .Throw }}
+	case *throwExpr:
+		return expr.id
+	// {{ end }} ==template==
+	case *zeroOrMoreExpr:
+		return expr.id
+	case *zeroOrOneExpr:
+		return expr.id
+	// ==template== {{ if .Binary }}
+	case *uintMatcher:
+		return expr.id
+	case *bytesExpr:
+		return expr.id
+	// {{ end }} ==template==
+	// ==template== {{ if .Indent }}
+	case *indentExpr:
+		return expr.id
+	// {{ end }} ==template==
+	// ==template== {{ if .BackRef }}
+	case *backRefExpr:
+		return expr.id
+	// {{ end }} ==template==
+	// ==template== {{ if .Regexp }}
+	case *regexpMatcher:
+		return expr.id
 	// {{ end }} ==template==
-		p.setMemoized(pt, expr, resultTuple{val, ok, p.pt})
-	}
+	// ==template== {{ if .MatcherFunc }}
+	case *matcherFuncExpr:
+		return expr.id
 	// {{ end }} ==template==
-	return val, ok
+	// ==template== {{ if .DFA }}
+	case *dfaExpr:
+		return expr.id
+	// {{ end }} ==template==
+	// ==template== {{ if .Text }}
+	case *textExpr:
+		return expr.id
+	// {{ end }} ==template==
+	// ==template== {{ if .Coverage }}
+	case *coverExpr:
+		return expr.id
+	// {{ end }} ==template==
+	default:
+		panic(fmt.Sprintf("unknown expression type %T", expr))
+	}
 }
 
+// {{ end }} ==template==
+
 // {{ if .Nolint }} nolint: gocyclo {{else}} ==template== {{ end }}
 func (p *parser) parseExpr(expr any) (any, bool) {
+	// ==template== {{ if .Coverage }}
+	if cov, ok := expr.(*coverExpr); ok {
+		return p.parseCoverExpr(cov)
+	}
+	// {{ end }} ==template==
 	p.ExprCnt++
 	if p.ExprCnt > p.maxExprCnt {
 		panic(errMaxExprCnt)
@@ -1089,6 +2929,14 @@ func (p *parser) parseExpr(expr any) (any, bool) {
 		val, ok = p.parseLabeledExpr(expr)
 	case *litMatcher:
 		val, ok = p.parseLitMatcher(expr)
+	// ==template== {{ if .LitSet }}
+	case *litSetMatcher:
+		val, ok = p.parseLitSetMatcher(expr)
+	// {{ end }} ==template==
+	// ==template== {{ if .Precedence }}
+	case *precExpr:
+		val, ok = p.parsePrecExpr(expr)
+	// {{ end }} ==template==
 	case *notCodeExpr:
 		val, ok = p.parseNotCodeExpr(expr)
 	case *notExpr:
@@ -1097,16 +2945,54 @@ func (p *parser) parseExpr(expr any) (any, bool) {
 		val, ok = p.parseOneOrMoreExpr(expr)
 	case *recoveryExpr:
 		val, ok = p.parseRecoveryExpr(expr)
+	case *repeatExpr:
+		val, ok = p.parseRepeatExpr(expr)
 	case *ruleRefExpr:
 		val, ok = p.parseRuleRefExpr(expr)
+	// ==template== {{ if .Binary }}
+	case *uintMatcher:
+		val, ok = p.parseUintMatcher(expr)
+	case *bytesExpr:
+		val, ok = p.parseBytesExpr(expr)
+	// {{ end }} ==template==
+	// ==template== {{ if .Indent }}
+	case *indentExpr:
+		val, ok = p.parseIndentExpr(expr)
+	// {{ end }} ==template==
+	// ==template== {{ if .BackRef }}
+	case *backRefExpr:
+		val, ok = p.parseBackRefExpr(expr)
+	// {{ end }} ==template==
+	// ==template== {{ if .Regexp }}
+	case *regexpMatcher:
+		val, ok = p.parseRegexpMatcher(expr)
+	// {{ end }} ==template==
+	// ==template== {{ if .MatcherFunc }}
+	case *matcherFuncExpr:
+		val, ok = p.parseMatcherFuncExpr(expr)
+	// {{ end }} ==template==
+	// ==template== {{ if .DFA }}
+	case *dfaExpr:
+		val, ok = p.parseDFAExpr(expr)
+	// {{ end }} ==template==
+	// ==template== {{ if .Text }}
+	case *textExpr:
+		val, ok = p.parseTextExpr(expr)
+	// {{ end }} ==template==
 	case *seqExpr:
 		val, ok = p.parseSeqExpr(expr)
 	// ==template== {{ if or .GlobalState (not .Optimize) }}
 	case *stateCodeExpr:
 		val, ok = p.parseStateCodeExpr(expr)
 	// {{ end }} ==template==
+	// ==template== {{ if .Throw }}
 	case *throwExpr:
 		val, ok = p.parseThrowExpr(expr)
+	// {{ end }} ==template==
+	// ==template== {{ if .Cut }}
+	case *cutExpr:
+		val, ok = p.parseCutExpr(expr)
+	// {{ end }} ==template==
 	case *zeroOrMoreExpr:
 		val, ok = p.parseZeroOrMoreExpr(expr)
 	case *zeroOrOneExpr:
@@ -1127,7 +3013,11 @@ func (p *parser) parseActionExpr(act *actionExpr) (any, bool) {
 	start := p.pt
 	val, ok := p.parseExprWrap(act.expr)
 	if ok {
+		// ==template== {{ if .LazyPositions }}
+		p.cur.pos = p.resolvePosition(start.position)
+		// {{ else }}
 		p.cur.pos = start.position
+		// {{ end }} ==template==
 		p.cur.text = p.sliceFrom(start)
 		// ==template== {{ if or .GlobalState (not .Optimize) }}
 		state := p.cloneState()
@@ -1212,6 +3102,102 @@ func (p *parser) parseAnyMatcher(any *anyMatcher) (any, bool) {
 	return p.sliceFrom(start), true
 }
 
+// ==template== {{ if .Binary }}
+func (p *parser) parseUintMatcher(m *uintMatcher) (any, bool) {
+	// ==template== {{ if not .Optimize }}
+	if p.debug {
+		defer p.out(p.in("parseUintMatcher " + m.name))
+	}
+
+	// {{ end }} ==template==
+	return p.matchUint(m.name, m.size, m.littleEndian)
+}
+
+func (p *parser) parseBytesExpr(by *bytesExpr) (any, bool) {
+	// ==template== {{ if not .Optimize }}
+	if p.debug {
+		defer p.out(p.in("parseBytesExpr " + by.label))
+	}
+
+	// {{ end }} ==template==
+	return p.matchBytes(by.label)
+}
+
+// {{ end }} ==template==
+
+// ==template== {{ if .Indent }}
+func (p *parser) parseIndentExpr(ind *indentExpr) (any, bool) {
+	// ==template== {{ if not .Optimize }}
+	if p.debug {
+		defer p.out(p.in("parseIndentExpr " + indentNames[ind.kind]))
+	}
+
+	// {{ end }} ==template==
+	return p.matchIndent(ind.kind)
+}
+
+// {{ end }} ==template==
+
+// ==template== {{ if .BackRef }}
+func (p *parser) parseBackRefExpr(ref *backRefExpr) (any, bool) {
+	// ==template== {{ if not .Optimize }}
+	if p.debug {
+		defer p.out(p.in("parseBackRefExpr " + ref.key))
+	}
+
+	// {{ end }} ==template==
+	return p.matchBackRef(ref.key, ref.state)
+}
+
+// {{ end }} ==template==
+// ==template== {{ if .Regexp }}
+func (p *parser) parseRegexpMatcher(re *regexpMatcher) (any, bool) {
+	// ==template== {{ if not .Optimize }}
+	if p.debug {
+		defer p.out(p.in("parseRegexpMatcher " + re.want))
+	}
+
+	// {{ end }} ==template==
+	return p.matchRegexp(re.re, re.after, re.want)
+}
+
+// {{ end }} ==template==
+// ==template== {{ if .MatcherFunc }}
+func (p *parser) parseMatcherFuncExpr(m *matcherFuncExpr) (any, bool) {
+	// ==template== {{ if not .Optimize }}
+	if p.debug {
+		defer p.out(p.in("parseMatcherFuncExpr " + m.want))
+	}
+
+	// {{ end }} ==template==
+	return p.matchFunc(m.fn, m.want)
+}
+
+// {{ end }} ==template==
+// ==template== {{ if .DFA }}
+func (p *parser) parseDFAExpr(dfa *dfaExpr) (any, bool) {
+	// ==template== {{ if not .Optimize }}
+	if p.debug {
+		defer p.out(p.in("parseDFAExpr"))
+	}
+
+	// {{ end }} ==template==
+	if p.maxFailInvertExpected {
+		// the automaton does not record the matches that an inverted
+		// predicate expects, see failAt.
+		return p.parseExpr(dfa.expr)
+	}
+	// ==template== {{ if not .Optimize }}
+	if p.Stats != nil {
+		// nor the statistics of the rules and the alternatives that it
+		// matches.
+		return p.parseExpr(dfa.expr)
+	}
+	// {{ end }} ==template==
+	return dfa.run(p)
+}
+
+// {{ end }} ==template==
 // {{ if .Nolint }} nolint: gocyclo {{else}} ==template== {{ end }}
 func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
 	// ==template== {{ if not .Optimize }}
@@ -1242,7 +3228,11 @@ func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
 	}
 
 	if chr.ignoreCase {
+		// ==template== {{ if .CaseFold }}
+		cur = caseFold(cur)
+		// {{ else }}
 		cur = unicode.ToLower(cur)
+		// {{ end }} ==template==
 	}
 
 	// try to match in the list of available chars
@@ -1293,24 +3283,6 @@ func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
 	return nil, false
 }
 
-// ==template== {{ if not .Optimize }}
-
-func (p *parser) incChoiceAltCnt(ch *choiceExpr, altI int) {
-	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, ch.pos.line, ch.pos.col)
-	m := p.ChoiceAltCnt[choiceIdent]
-	if m == nil {
-		m = make(map[string]int)
-		p.ChoiceAltCnt[choiceIdent] = m
-	}
-	// We increment altI by 1, so the keys do not start at 0
-	alt := strconv.Itoa(altI + 1)
-	if altI == choiceNoMatch {
-		alt = p.choiceNoMatch
-	}
-	m[alt]++
-}
-
-// {{ end }} ==template==
 
 func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 	// ==template== {{ if not .Optimize }}
@@ -1319,9 +3291,24 @@ func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 	}
 	// {{ end }} ==template==
 
-	for altI, alt := range ch.alternatives {
-		// dummy assignment to prevent compile error if optimized
-		_ = altI
+	n := len(ch.alternatives)
+	var alts []int
+	if d := ch.dispatch; d != nil {
+		// the alternatives that cannot match the current rune are skipped,
+		// with the values they expect.
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		alts = d.alts[set]
+		n = len(alts)
+	}
+	for i := 0; i < n; i++ {
+		altI := i
+		if alts != nil {
+			altI = alts[i]
+		}
+		alt := ch.alternatives[altI]
 
 		// ==template== {{ if or .GlobalState (not .Optimize) }}
 		state := p.cloneState()
@@ -1332,7 +3319,7 @@ func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 		p.popV()
 		if ok {
 			// ==template== {{ if not .Optimize }}
-			p.incChoiceAltCnt(ch, altI)
+			p.incChoiceAltCnt(ch.pos, altI)
 			// {{ end }} ==template==
 			return val, ok
 		}
@@ -1341,17 +3328,32 @@ func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 		// {{ end }} ==template==
 	}
 	// ==template== {{ if not .Optimize }}
-	p.incChoiceAltCnt(ch, choiceNoMatch)
+	p.incChoiceAltCnt(ch.pos, choiceNoMatch)
 	// {{ end }} ==template==
 	return nil, false
 }
 
+// ==template== {{ if .Coverage }}
+
+func (p *parser) parseCoverExpr(cov *coverExpr) (any, bool) {
+	val, ok := p.parseExprWrap(cov.expr)
+	if ok {
+		atomic.AddUint64(&coverCounts[cov.point], 1)
+	}
+	return val, ok
+}
+
+// {{ end }} ==template==
+
 func (p *parser) parseLabeledExpr(lab *labeledExpr) (any, bool) {
 	// ==template== {{ if not .Optimize }}
 	if p.debug {
 		defer p.out(p.in("parseLabeledExpr"))
 	}
 
+	// {{ end }} ==template==
+	// ==template== {{ if .BackRef }}
+	start := p.pt
 	// {{ end }} ==template==
 	p.pushV()
 	val, ok := p.parseExprWrap(lab.expr)
@@ -1359,6 +3361,11 @@ func (p *parser) parseLabeledExpr(lab *labeledExpr) (any, bool) {
 	if ok && lab.label != "" {
 		m := p.vstack[len(p.vstack)-1]
 		m[lab.label] = val
+		// ==template== {{ if .BackRef }}
+		if lab.textKey != "" {
+			m[lab.textKey] = p.sliceFrom(start)
+		}
+		// {{ end }} ==template==
 	}
 	return val, ok
 }
@@ -1371,7 +3378,23 @@ func (p *parser) parseLitMatcher(lit *litMatcher) (any, bool) {
 
 	// {{ end }} ==template==
 	start := p.pt
+	// ==template== {{ if .CaseFold }}
+	if lit.ignoreCase {
+		if !p.matchCaseFold(lit.val) {
+			p.failAt(false, start.position, lit.want)
+			p.restore(start)
+			return nil, false
+		}
+		p.failAt(true, start.position, lit.want)
+		return p.sliceFrom(start), true
+	}
+	// {{ end }} ==template==
+	// ==template== {{ if .Binary }}
+	for i := 0; i < len(lit.val); i++ {
+		want := rune(lit.val[i])
+		// {{ else }}
 	for _, want := range lit.val {
+		// {{ end }} ==template==
 		cur := p.pt.rn
 		if lit.ignoreCase {
 			cur = unicode.ToLower(cur)
@@ -1387,6 +3410,30 @@ func (p *parser) parseLitMatcher(lit *litMatcher) (any, bool) {
 	return p.sliceFrom(start), true
 }
 
+// ==template== {{ if .LitSet }}
+func (p *parser) parseLitSetMatcher(set *litSetMatcher) (any, bool) {
+	// ==template== {{ if not .Optimize }}
+	if p.debug {
+		defer p.out(p.in("parseLitSetMatcher"))
+	}
+
+	// {{ end }} ==template==
+	return p.matchLitSet(set)
+}
+
+// {{ end }} ==template==
+// ==template== {{ if .Precedence }}
+func (p *parser) parsePrecExpr(prec *precExpr) (any, bool) {
+	// ==template== {{ if not .Optimize }}
+	if p.debug {
+		defer p.out(p.in("parsePrecExpr"))
+	}
+
+	// {{ end }} ==template==
+	return p.parsePrec(prec, 0)
+}
+
+// {{ end }} ==template==
 func (p *parser) parseNotCodeExpr(not *notCodeExpr) (any, bool) {
 	// ==template== {{ if not .Optimize }}
 	if p.debug {
@@ -1443,10 +3490,19 @@ func (p *parser) parseOneOrMoreExpr(expr *oneOrMoreExpr) (any, bool) {
 	var vals []any
 
 	for {
+		// ==template== {{ if .Skip }}
+		pt := p.pt
+		if expr.skip && len(vals) > 0 {
+			p.parseSkip()
+		}
+		// {{ end }} ==template==
 		p.pushV()
 		val, ok := p.parseExprWrap(expr.expr)
 		p.popV()
 		if !ok {
+			// ==template== {{ if .Skip }}
+			p.restore(pt)
+			// {{ end }} ==template==
 			if len(vals) == 0 {
 				// did not match once, no match
 				return nil, false
@@ -1472,6 +3528,48 @@ func (p *parser) parseRecoveryExpr(recover *recoveryExpr) (any, bool) {
 	return val, ok
 }
 
+func (p *parser) parseRepeatExpr(expr *repeatExpr) (any, bool) {
+	// ==template== {{ if not .Optimize }}
+	if p.debug {
+		defer p.out(p.in("parseRepeatExpr"))
+	}
+
+	// {{ end }} ==template==
+	var vals []any
+
+	pt := p.pt
+	// ==template== {{ if or .GlobalState (not .Optimize) }}
+	state := p.cloneState()
+	// {{ end }} ==template==
+	for expr.max < 0 || len(vals) < expr.max {
+		// ==template== {{ if .Skip }}
+		itemPt := p.pt
+		if expr.skip && len(vals) > 0 {
+			p.parseSkip()
+		}
+		// {{ end }} ==template==
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			// ==template== {{ if .Skip }}
+			p.restore(itemPt)
+			// {{ end }} ==template==
+			break
+		}
+		vals = append(vals, val)
+	}
+	if len(vals) < expr.min {
+		// did not match enough times, no match
+		// ==template== {{ if or .GlobalState (not .Optimize) }}
+		p.restoreState(state)
+		// {{ end }} ==template==
+		p.restore(pt)
+		return nil, false
+	}
+	return vals, true
+}
+
 func (p *parser) parseRuleRefExpr(ref *ruleRefExpr) (any, bool) {
 	// ==template== {{ if not .Optimize }}
 	if p.debug {
@@ -1504,20 +3602,63 @@ func (p *parser) parseSeqExpr(seq *seqExpr) (any, bool) {
 	// ==template== {{ if or .GlobalState (not .Optimize) }}
 	state := p.cloneState()
 	// {{ end }} ==template==
+	// ==template== {{ if or .Skip .Cut }}
+	for i, expr := range seq.exprs {
+	// {{ else }}
 	for _, expr := range seq.exprs {
+	// {{ end }} ==template==
+		// ==template== {{ if .Skip }}
+		if seq.skip && i > 0 {
+			p.parseSkip()
+		}
+		// {{ end }} ==template==
 		val, ok := p.parseExprWrap(expr)
 		if !ok {
+			// ==template== {{ if .Cut }}
+			if seq.cut > 0 && i >= seq.cut {
+				// the sequence is committed, no backtracking
+				panic(errCutFailure)
+			}
+			// {{ end }} ==template==
 			// ==template== {{ if or .GlobalState (not .Optimize) }}
 			p.restoreState(state)
 			// {{ end }} ==template==
 			p.restore(pt)
 			return nil, false
 		}
+		// ==template== {{ if .Cut }}
+		if _, isCut := expr.(*cutExpr); isCut {
+			// the cut has no value
+			continue
+		}
+		// {{ end }} ==template==
 		vals = append(vals, val)
 	}
+	// ==template== {{ if .Pluck }}
+	if len(seq.pluck) > 0 {
+		return p.pluck(vals, seq.pluck), true
+	}
+	// {{ end }} ==template==
 	return vals, true
 }
 
+// ==template== {{ if .Pluck }}
+// pluck returns the value of a sequence with pluck expressions at the
+// indexes ix: the value at the index if there is only one, the slice of
+// the values at the indexes otherwise.
+func (p *parser) pluck(vals []any, ix []int) any {
+	if len(ix) == 1 {
+		return vals[ix[0]]
+	}
+	plucked := make([]any, len(ix))
+	for i, j := range ix {
+		plucked[i] = vals[j]
+	}
+	return plucked
+}
+
+// {{ end }} ==template==
+
 // ==template== {{ if or .GlobalState (not .Optimize) }}
 
 func (p *parser) parseStateCodeExpr(state *stateCodeExpr) (any, bool) {
@@ -1536,25 +3677,51 @@ func (p *parser) parseStateCodeExpr(state *stateCodeExpr) (any, bool) {
 
 // {{ end }} ==template==
 
-func (p *parser) parseThrowExpr(expr *throwExpr) (any, bool) {
+// ==template== {{ if .Text }}
+func (p *parser) parseTextExpr(text *textExpr) (any, bool) {
 	// ==template== {{ if not .Optimize }}
 	if p.debug {
-		defer p.out(p.in("parseThrowExpr"))
+		defer p.out(p.in("parseTextExpr"))
 	}
 
 	// {{ end }} ==template==
+	start := p.pt
+	p.pushV()
+	_, ok := p.parseExprWrap(text.expr)
+	p.popV()
+	if !ok {
+		return nil, false
+	}
+	return string(p.sliceFrom(start)), true
+}
 
-	for i := len(p.recoveryStack) - 1; i >= 0; i-- {
-		if recoverExpr, ok := p.recoveryStack[i][expr.label]; ok {
-			if val, ok := p.parseExprWrap(recoverExpr); ok {
-				return val, ok
-			}
-		}
+// {{ end }} ==template==
+
+// ==template== {{ if .Cut }}
+func (p *parser) parseCutExpr(expr *cutExpr) (any, bool) {
+	// ==template== {{ if not .Optimize }}
+	if p.debug {
+		defer p.out(p.in("parseCutExpr"))
 	}
 
-	return nil, false
+	// {{ end }} ==template==
+	return nil, true
+}
+
+// {{ end }} ==template==
+// ==template== {{ if .Throw }}
+func (p *parser) parseThrowExpr(expr *throwExpr) (any, bool) {
+	// ==template== {{ if not .Optimize }}
+	if p.debug {
+		defer p.out(p.in("parseThrowExpr"))
+	}
+
+	// {{ end }} ==template==
+	return p.throw(expr.label, expr.message)
 }
 
+// {{ end }} ==template==
+
 func (p *parser) parseZeroOrMoreExpr(expr *zeroOrMoreExpr) (any, bool) {
 	// ==template== {{ if not .Optimize }}
 	if p.debug {
@@ -1565,10 +3732,19 @@ func (p *parser) parseZeroOrMoreExpr(expr *zeroOrMoreExpr) (any, bool) {
 	var vals []any
 
 	for {
+		// ==template== {{ if .Skip }}
+		pt := p.pt
+		if expr.skip && len(vals) > 0 {
+			p.parseSkip()
+		}
+		// {{ end }} ==template==
 		p.pushV()
 		val, ok := p.parseExprWrap(expr.expr)
 		p.popV()
 		if !ok {
+			// ==template== {{ if .Skip }}
+			p.restore(pt)
+			// {{ end }} ==template==
 			return vals, true
 		}
 		vals = append(vals, val)
@@ -1589,4 +3765,51 @@ func (p *parser) parseZeroOrOneExpr(expr *zeroOrOneExpr) (any, bool) {
 	return val, true
 }
 
+// ==template== {{ else }}
+
+// countExpr counts the evaluation of an expression.
+func (p *parser) countExpr() {
+	p.ExprCnt++
+	if p.ExprCnt > p.maxExprCnt {
+		panic(errMaxExprCnt)
+	}
+}
+
+// ==template== {{ if not .Optimize }}
+// compiledMemoized returns the memoized results of the compiled expression
+// id at the current position, and restores the position at their end. It
+// returns false if they are not memoized or if memoization is disabled.
+func (p *parser) compiledMemoized(id int) (resultTuple, bool) {
+	// ==template== {{ if .LeftRecursion }}
+	if !p.memoize || p.rstack[len(p.rstack)-1].leftRecursive {
+	// {{ else }}
+	if !p.memoize {
+	// {{ end }} ==template==
+		return resultTuple{}, false
+	}
+	res, ok := p.getMemoized(id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+	}
+	if ok {
+		p.restore(res.end)
+	}
+	return res, ok
+}
+
+// compiledMemoize memoizes the results of the compiled expression id,
+// which started at pt, if memoization is enabled.
+func (p *parser) compiledMemoize(pt savepoint, id int, val any, ok bool) {
+	// ==template== {{ if .LeftRecursion }}
+	if p.memoize && !p.rstack[len(p.rstack)-1].leftRecursive {
+	// {{ else }}
+	if p.memoize {
+	// {{ end }} ==template==
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
+	}
+}
+
+// {{ end }} ==template==
+// {{ end }} ==template==
+
 `
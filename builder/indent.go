@@ -0,0 +1,38 @@
+package builder
+
+import (
+	"fmt"
+
+	"github.com/mna/pigeon/ast"
+)
+
+// indentRules maps the names of the built-in indentation rules to the
+// kind of the matcher in the generated parser. They are available in the
+// parsers that are not binary.
+var indentRules = map[string]string{
+	"INDENT":   "indentIndent",
+	"DEDENT":   "indentDedent",
+	"SAMEDENT": "indentSame",
+}
+
+// isIndentRule returns true if ref references a built-in indentation
+// rule.
+func (b *builder) isIndentRule(ref *ast.RuleRefExpr) bool {
+	_, ok := indentRules[ref.Name.Val]
+	return ok && b.isBuiltinRule(ref)
+}
+
+// writeIndentRuleRef writes the matcher of the built-in indentation rule
+// referenced by ref.
+func (b *builder) writeIndentRuleRef(ref *ast.RuleRefExpr) {
+	b.writelnf("&indentExpr{")
+	b.writeExprPos(ref.Pos())
+	b.writelnf("\tkind: %s,", indentRules[ref.Name.Val])
+	b.writelnf("},")
+}
+
+// indentRuleCall returns the Go expression that evaluates the built-in
+// indentation rule referenced by ref, for the compiled parsers.
+func indentRuleCall(ref *ast.RuleRefExpr) string {
+	return fmt.Sprintf("p.matchIndent(%s)", indentRules[ref.Name.Val])
+}
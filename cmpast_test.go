@@ -31,6 +31,18 @@ func compareGrammars(t *testing.T, src string, exp, got *ast.Grammar) bool {
 		}
 	}
 
+	if len(exp.FailureLabels) != len(got.FailureLabels) {
+		t.Errorf("%q: want %d failure labels, got %d", src, len(exp.FailureLabels), len(got.FailureLabels))
+		return false
+	}
+	for i, f := range got.FailureLabels {
+		e := exp.FailureLabels[i]
+		if e.Label.Val != f.Label.Val || e.Message != f.Message {
+			t.Errorf("%q: want failure label %s %q, got %s %q", src, e.Label.Val, e.Message, f.Label.Val, f.Message)
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -39,6 +51,16 @@ func compareRule(t *testing.T, prefix string, exp, got *ast.Rule) bool {
 		t.Errorf("%q: want rule name %q, got %q", prefix, exp.Name.Val, got.Name.Val)
 		return false
 	}
+	if len(exp.Params) != len(got.Params) {
+		t.Errorf("%q: want %d params, got %d", prefix, len(exp.Params), len(got.Params))
+		return false
+	}
+	for i, p := range exp.Params {
+		if p.Val != got.Params[i].Val {
+			t.Errorf("%q: want param %d %q, got %q", prefix, i, p.Val, got.Params[i].Val)
+			return false
+		}
+	}
 	if (exp.DisplayName != nil) != (got.DisplayName != nil) {
 		t.Errorf("%q: want DisplayName? %t, got %t", prefix, exp.DisplayName != nil, got.DisplayName != nil)
 		return false
@@ -49,6 +71,16 @@ func compareRule(t *testing.T, prefix string, exp, got *ast.Rule) bool {
 			return false
 		}
 	}
+	if len(exp.Annotations) != len(got.Annotations) {
+		t.Errorf("%q: want %d annotations, got %d", prefix, len(exp.Annotations), len(got.Annotations))
+		return false
+	}
+	for i, a := range exp.Annotations {
+		if a.Val != got.Annotations[i].Val {
+			t.Errorf("%q: want annotation %d %q, got %q", prefix, i, a.Val, got.Annotations[i].Val)
+			return false
+		}
+	}
 	return compareExpr(t, prefix, 0, exp.Expr, got.Expr)
 }
 
@@ -124,6 +156,10 @@ func compareExpr(t *testing.T, prefix string, ix int, exp, got ast.Expression) b
 			t.Errorf("%q: want Inverted %t, got %t", ixPrefix, exp.Inverted, got.Inverted)
 			return false
 		}
+		if exp.Derived != got.Derived {
+			t.Errorf("%q: want Derived %t, got %t", ixPrefix, exp.Derived, got.Derived)
+			return false
+		}
 
 		ne, ng := len(exp.Chars), len(got.Chars)
 		if ne != ng {
@@ -161,6 +197,88 @@ func compareExpr(t *testing.T, prefix string, ix int, exp, got ast.Expression) b
 			}
 		}
 
+	case *ast.PrecedenceExpr:
+		got, ok := got.(*ast.PrecedenceExpr)
+		if !ok {
+			t.Errorf("%q: want expression type %T, got %T", ixPrefix, exp, got)
+			return false
+		}
+		if !compareExpr(t, prefix, ix+1, exp.Operand, got.Operand) {
+			return false
+		}
+		ne, ng := len(exp.Levels), len(got.Levels)
+		if ne != ng {
+			t.Errorf("%q: want %d Levels, got %d", ixPrefix, ne, ng)
+			return false
+		}
+		for i, lvl := range exp.Levels {
+			if lvl.Kind != got.Levels[i].Kind {
+				t.Errorf("%q: want Levels[%d] kind %s, got %s", ixPrefix, i, lvl.Kind, got.Levels[i].Kind)
+				return false
+			}
+			if (lvl.Code != nil) != (got.Levels[i].Code != nil) {
+				t.Errorf("%q: want Levels[%d] Code?: %t, got %t", ixPrefix, i, lvl.Code != nil, got.Levels[i].Code != nil)
+				return false
+			}
+			if lvl.Code != nil && lvl.Code.Val != got.Levels[i].Code.Val {
+				t.Errorf("%q: want Levels[%d] code %q, got %q", ixPrefix, i, lvl.Code.Val, got.Levels[i].Code.Val)
+				return false
+			}
+			if !compareExpr(t, prefix, ix+1, lvl.Op, got.Levels[i].Op) {
+				return false
+			}
+		}
+
+	case *ast.BackRefExpr:
+		got, ok := got.(*ast.BackRefExpr)
+		if !ok {
+			t.Errorf("%q: want expression type %T, got %T", ixPrefix, exp, got)
+			return false
+		}
+		if exp.Name.Val != got.Name.Val || exp.State != got.State {
+			t.Errorf("%q: want back-reference %s (state %t), got %s (state %t)", ixPrefix, exp.Name.Val, exp.State, got.Name.Val, got.State)
+			return false
+		}
+
+	case *ast.RegexpMatcher:
+		got, ok := got.(*ast.RegexpMatcher)
+		if !ok {
+			t.Errorf("%q: want expression type %T, got %T", ixPrefix, exp, got)
+			return false
+		}
+		if exp.Expr != got.Expr {
+			t.Errorf("%q: want regular expression %q, got %q", ixPrefix, exp.Expr, got.Expr)
+			return false
+		}
+
+	case *ast.ThrowExpr:
+		got, ok := got.(*ast.ThrowExpr)
+		if !ok {
+			t.Errorf("%q: want expression type %T, got %T", ixPrefix, exp, got)
+			return false
+		}
+		if exp.Label != got.Label {
+			t.Errorf("%q: want failure label %s, got %s", ixPrefix, exp.Label, got.Label)
+			return false
+		}
+
+	case *ast.MatcherFuncExpr:
+		got, ok := got.(*ast.MatcherFuncExpr)
+		if !ok {
+			t.Errorf("%q: want expression type %T, got %T", ixPrefix, exp, got)
+			return false
+		}
+		if exp.Name.Val != got.Name.Val {
+			t.Errorf("%q: want matcher function %s, got %s", ixPrefix, exp.Name.Val, got.Name.Val)
+			return false
+		}
+
+	case *ast.CutExpr:
+		if _, ok := got.(*ast.CutExpr); !ok {
+			t.Errorf("%q: want expression type %T, got %T", ixPrefix, exp, got)
+			return false
+		}
+
 	case *ast.ChoiceExpr:
 		got, ok := got.(*ast.ChoiceExpr)
 		if !ok {
@@ -238,6 +356,22 @@ func compareExpr(t *testing.T, prefix string, ix int, exp, got ast.Expression) b
 		}
 		return compareExpr(t, prefix, ix+1, exp.Expr, got.Expr)
 
+	case *ast.PluckExpr:
+		got, ok := got.(*ast.PluckExpr)
+		if !ok {
+			t.Errorf("%q: want expression type %T, got %T", ixPrefix, exp, got)
+			return false
+		}
+		return compareExpr(t, prefix, ix+1, exp.Expr, got.Expr)
+
+	case *ast.TextExpr:
+		got, ok := got.(*ast.TextExpr)
+		if !ok {
+			t.Errorf("%q: want expression type %T, got %T", ixPrefix, exp, got)
+			return false
+		}
+		return compareExpr(t, prefix, ix+1, exp.Expr, got.Expr)
+
 	case *ast.OneOrMoreExpr:
 		got, ok := got.(*ast.OneOrMoreExpr)
 		if !ok {
@@ -246,6 +380,18 @@ func compareExpr(t *testing.T, prefix string, ix int, exp, got ast.Expression) b
 		}
 		return compareExpr(t, prefix, ix+1, exp.Expr, got.Expr)
 
+	case *ast.RepeatExpr:
+		got, ok := got.(*ast.RepeatExpr)
+		if !ok {
+			t.Errorf("%q: want expression type %T, got %T", ixPrefix, exp, got)
+			return false
+		}
+		if exp.Min != got.Min || exp.Max != got.Max {
+			t.Errorf("%q: want bounds {%d,%d}, got {%d,%d}", ixPrefix, exp.Min, exp.Max, got.Min, got.Max)
+			return false
+		}
+		return compareExpr(t, prefix, ix+1, exp.Expr, got.Expr)
+
 	case *ast.RuleRefExpr:
 		got, ok := got.(*ast.RuleRefExpr)
 		if !ok {
@@ -262,6 +408,17 @@ func compareExpr(t *testing.T, prefix string, ix int, exp, got ast.Expression) b
 				return false
 			}
 		}
+		ne, ng := len(exp.Args), len(got.Args)
+		if ne != ng {
+			t.Errorf("%q: want %d Args, got %d", ixPrefix, ne, ng)
+			return false
+		}
+
+		for i, expr := range exp.Args {
+			if !compareExpr(t, prefix, ix+1, expr, got.Args[i]) {
+				return false
+			}
+		}
 
 	case *ast.SeqExpr:
 		got, ok := got.(*ast.SeqExpr)
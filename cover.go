@@ -0,0 +1,337 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var coverUsagePage = `usage: %s cover [options] PROFILE...
+
+Cover merges the grammar coverage profiles written by parsers generated
+with the -coverage flag (see the WriteCoverProfile function of the
+generated parser) and reports the expressions of the grammar that never
+matched. The grammar files referenced in the profiles must be readable
+to generate the HTML report.
+
+	-html OUTPUT_FILE
+		write an HTML report of the grammar files annotated with the
+		coverage counts to OUTPUT_FILE, instead of the text report.
+	-o OUTPUT_FILE
+		write the merged profile to OUTPUT_FILE, instead of the text
+		report.
+`
+
+// coverBlock is a coverage point of a profile.
+type coverBlock struct {
+	file      string
+	line, col int
+	offset    int
+	rule      string
+	kind      string
+	count     uint64
+}
+
+func (b *coverBlock) key() string {
+	return b.file + "\x00" + strconv.Itoa(b.offset) + "\x00" + b.rule + "\x00" + b.kind
+}
+
+// cover implements the cover command, args are the command-line arguments
+// following the command name.
+func cover(args []string) {
+	fs := flag.NewFlagSet(os.Args[0]+" cover", flag.ExitOnError)
+	var (
+		htmlFlag   = fs.String("html", "", "HTML output file")
+		outputFlag = fs.String("o", "", "merged profile output file")
+	)
+	fs.Usage = func() {
+		fmt.Printf(coverUsagePage, os.Args[0])
+	}
+	if err := fs.Parse(args); err != nil {
+		fmt.Fprintln(os.Stderr, "args parse error:\n", err)
+		exit(6)
+	}
+	if fs.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "expected at least one profile")
+		fs.Usage()
+		exit(1)
+	}
+
+	var profiles [][]*coverBlock
+	for _, file := range fs.Args() {
+		f, err := os.Open(file)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			exit(2)
+		}
+		blocks, err := parseCoverProfile(f)
+		f.Close()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "profile parse error:\n %s: %v\n", file, err)
+			exit(3)
+		}
+		profiles = append(profiles, blocks)
+	}
+	blocks := mergeCoverProfiles(profiles...)
+
+	var buf bytes.Buffer
+	var err error
+	switch {
+	case *outputFlag != "":
+		writeCoverProfile(&buf, blocks)
+	case *htmlFlag != "":
+		err = writeCoverHTML(&buf, blocks, os.ReadFile)
+	default:
+		writeCoverReport(&buf, blocks)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "report error: ", err)
+		exit(5)
+	}
+
+	nm := *outputFlag
+	if nm == "" {
+		nm = *htmlFlag
+	}
+	out := output(nm)
+	if _, err := out.Write(buf.Bytes()); err != nil {
+		fmt.Fprintln(os.Stderr, "write error: ", err)
+		exit(7)
+	}
+	if err := out.Close(); err != nil {
+		fmt.Fprintln(os.Stderr, "close file error:\n", err)
+		exit(8)
+	}
+}
+
+// parseCoverProfile parses a coverage profile. Each line following the
+// "mode: count" header has the format:
+//
+//	file:line:col offset rule kind count
+func parseCoverProfile(r io.Reader) ([]*coverBlock, error) {
+	var blocks []*coverBlock
+	sc := bufio.NewScanner(r)
+	if !sc.Scan() || strings.TrimSpace(sc.Text()) != "mode: count" {
+		if err := sc.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("missing mode: count header")
+	}
+	for n := 2; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+
+		b, err := parseCoverLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		blocks = append(blocks, b)
+	}
+	return blocks, sc.Err()
+}
+
+func parseCoverLine(line string) (*coverBlock, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid coverage line %q", line)
+	}
+
+	var b coverBlock
+	var err error
+	loc := fields[0]
+	ix := strings.LastIndexByte(loc, ':')
+	jx := -1
+	if ix > 0 {
+		jx = strings.LastIndexByte(loc[:ix], ':')
+	}
+	if jx <= 0 {
+		return nil, fmt.Errorf("invalid location %q", loc)
+	}
+	b.file = loc[:jx]
+	if b.line, err = strconv.Atoi(loc[jx+1 : ix]); err != nil {
+		return nil, fmt.Errorf("invalid line in %q", loc)
+	}
+	if b.col, err = strconv.Atoi(loc[ix+1:]); err != nil {
+		return nil, fmt.Errorf("invalid column in %q", loc)
+	}
+	if b.offset, err = strconv.Atoi(fields[1]); err != nil {
+		return nil, fmt.Errorf("invalid offset %q", fields[1])
+	}
+	b.rule, b.kind = fields[2], fields[3]
+	if b.count, err = strconv.ParseUint(fields[4], 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid count %q", fields[4])
+	}
+	return &b, nil
+}
+
+// mergeCoverProfiles sums the counts of the same coverage points of the
+// profiles, and returns the points sorted by file and offset.
+func mergeCoverProfiles(profiles ...[]*coverBlock) []*coverBlock {
+	var merged []*coverBlock
+	byKey := make(map[string]*coverBlock)
+	for _, blocks := range profiles {
+		for _, b := range blocks {
+			if mb := byKey[b.key()]; mb != nil {
+				mb.count += b.count
+				continue
+			}
+			mb := *b
+			byKey[b.key()] = &mb
+			merged = append(merged, &mb)
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		if merged[i].file != merged[j].file {
+			return merged[i].file < merged[j].file
+		}
+		return merged[i].offset < merged[j].offset
+	})
+	return merged
+}
+
+func writeCoverProfile(w io.Writer, blocks []*coverBlock) {
+	fmt.Fprintln(w, "mode: count")
+	for _, b := range blocks {
+		fmt.Fprintf(w, "%s:%d:%d %d %s %s %d\n", b.file, b.line, b.col, b.offset, b.rule, b.kind, b.count)
+	}
+}
+
+// writeCoverReport writes the coverage points that never matched, followed
+// by the percentage of points that matched for each grammar file.
+func writeCoverReport(w io.Writer, blocks []*coverBlock) {
+	var files []string
+	total := make(map[string]int)
+	covered := make(map[string]int)
+	for _, b := range blocks {
+		if total[b.file] == 0 {
+			files = append(files, b.file)
+		}
+		total[b.file]++
+		if b.count > 0 {
+			covered[b.file]++
+			continue
+		}
+		what := b.kind + " in rule " + b.rule
+		if b.kind == "rule" {
+			what = "rule " + b.rule
+		}
+		fmt.Fprintf(w, "%s:%d:%d: %s never matched\n", b.file, b.line, b.col, what)
+	}
+	for _, file := range files {
+		fmt.Fprintf(w, "%s: %.1f%% of %d expressions matched\n", file,
+			100*float64(covered[file])/float64(total[file]), total[file])
+	}
+}
+
+const coverHTMLHead = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>pigeon grammar coverage</title>
+<style>
+body { font-family: monospace; background: #fff; color: #222; }
+pre { line-height: 1.3; }
+.cov0 { background: #f8c4c4; }
+.cov1 { background: #c8ecc8; }
+.summary { font-weight: bold; }
+</style>
+</head>
+<body>
+`
+
+// writeCoverHTML writes the grammar files of the coverage points with the
+// text of each point highlighted, the text of points that never matched in
+// red and the others in green. The text of a point extends to the start of
+// the next point or the end of the line. The grammar files are read with
+// readFile.
+func writeCoverHTML(w io.Writer, blocks []*coverBlock, readFile func(string) ([]byte, error)) error {
+	var files []string
+	byFile := make(map[string][]*coverBlock)
+	for _, b := range blocks {
+		if byFile[b.file] == nil {
+			files = append(files, b.file)
+		}
+		byFile[b.file] = append(byFile[b.file], b)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(coverHTMLHead)
+	for _, file := range files {
+		src, err := readFile(file)
+		if err != nil {
+			return err
+		}
+		if err := writeCoverFileHTML(&buf, file, src, byFile[file]); err != nil {
+			return err
+		}
+	}
+	buf.WriteString("</body>\n</html>\n")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func writeCoverFileHTML(buf *bytes.Buffer, file string, src []byte, blocks []*coverBlock) error {
+	// group the points by offset, an offset is considered matched only if
+	// all its points matched.
+	type span struct {
+		start, end int
+		count      uint64
+		titles     []string
+	}
+	var spans []*span
+	covered := 0
+	for _, b := range blocks {
+		if b.offset < 0 || b.offset >= len(src) {
+			return fmt.Errorf("%s: offset %d out of range, the profile does not match the grammar", file, b.offset)
+		}
+		if b.count > 0 {
+			covered++
+		}
+		title := fmt.Sprintf("%s in rule %s: %d", b.kind, b.rule, b.count)
+		if n := len(spans); n > 0 && spans[n-1].start == b.offset {
+			s := spans[n-1]
+			s.count = min(s.count, b.count)
+			s.titles = append(s.titles, title)
+			continue
+		}
+		spans = append(spans, &span{start: b.offset, count: b.count, titles: []string{title}})
+	}
+	for i, s := range spans {
+		s.end = len(src)
+		if eol := bytes.IndexByte(src[s.start:], '\n'); eol >= 0 {
+			s.end = s.start + eol
+		}
+		if i+1 < len(spans) && spans[i+1].start < s.end {
+			s.end = spans[i+1].start
+		}
+	}
+
+	fmt.Fprintf(buf, "<h2>%s</h2>\n", html.EscapeString(file))
+	fmt.Fprintf(buf, "<p class=\"summary\">%.1f%% of %d expressions matched</p>\n",
+		100*float64(covered)/float64(len(blocks)), len(blocks))
+	buf.WriteString("<pre>")
+	off := 0
+	for _, s := range spans {
+		buf.WriteString(html.EscapeString(string(src[off:s.start])))
+		class := "cov1"
+		if s.count == 0 {
+			class = "cov0"
+		}
+		fmt.Fprintf(buf, "<span class=\"%s\" title=\"%s\">%s</span>", class,
+			html.EscapeString(strings.Join(s.titles, "\n")), html.EscapeString(string(src[s.start:s.end])))
+		off = s.end
+	}
+	buf.WriteString(html.EscapeString(string(src[off:])))
+	buf.WriteString("</pre>\n")
+	return nil
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+var coverTestGrammar = `A = B / C
+B = 'b'+
+C = 'c'? 'd'
+`
+
+var coverTestProfiles = []string{
+	`mode: count
+g.peg:1:5 4 A rule 1
+g.peg:1:5 4 A alternative 1
+g.peg:1:9 8 A alternative 0
+g.peg:2:5 14 B rule 1
+g.peg:2:5 14 B repetition 3
+g.peg:3:5 23 C rule 0
+g.peg:3:5 23 C optional 0
+`,
+	`mode: count
+g.peg:1:5 4 A rule 2
+g.peg:1:5 4 A alternative 1
+g.peg:1:9 8 A alternative 1
+g.peg:2:5 14 B rule 1
+g.peg:2:5 14 B repetition 1
+g.peg:3:5 23 C rule 1
+g.peg:3:5 23 C optional 0
+`,
+}
+
+func parseTestProfiles(t *testing.T) [][]*coverBlock {
+	var profiles [][]*coverBlock
+	for _, p := range coverTestProfiles {
+		blocks, err := parseCoverProfile(strings.NewReader(p))
+		if err != nil {
+			t.Fatal(err)
+		}
+		profiles = append(profiles, blocks)
+	}
+	return profiles
+}
+
+func TestParseCoverProfile(t *testing.T) {
+	invalid := map[string]string{
+		"":                                      "missing mode: count header",
+		"mode: set\n":                           "missing mode: count header",
+		"mode: count\ng.peg:1:5 4 A rule\n":     "line 2: invalid coverage line",
+		"mode: count\ng.peg:1 4 A rule 1\n":     "line 2: invalid location",
+		"mode: count\ng.peg:x:5 4 A rule 1\n":   "line 2: invalid line",
+		"mode: count\ng.peg:1:5 4 A rule -1\n":  "line 2: invalid count",
+		"mode: count\ng.peg:1:5 off A rule 1\n": "line 2: invalid offset",
+	}
+	for in, want := range invalid {
+		_, err := parseCoverProfile(strings.NewReader(in))
+		if err == nil || !strings.HasPrefix(err.Error(), want) {
+			t.Errorf("%q: want error %q, got %v", in, want, err)
+		}
+	}
+
+	blocks, err := parseCoverProfile(strings.NewReader("mode: count\nc:\\g.peg:3:5 23 C optional 7\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := coverBlock{file: `c:\g.peg`, line: 3, col: 5, offset: 23, rule: "C", kind: "optional", count: 7}
+	if len(blocks) != 1 || *blocks[0] != want {
+		t.Errorf("want %+v, got %+v", want, blocks)
+	}
+}
+
+func TestMergeCoverProfiles(t *testing.T) {
+	var buf bytes.Buffer
+	writeCoverProfile(&buf, mergeCoverProfiles(parseTestProfiles(t)...))
+
+	want := `mode: count
+g.peg:1:5 4 A rule 3
+g.peg:1:5 4 A alternative 2
+g.peg:1:9 8 A alternative 1
+g.peg:2:5 14 B rule 2
+g.peg:2:5 14 B repetition 4
+g.peg:3:5 23 C rule 1
+g.peg:3:5 23 C optional 0
+`
+	if got := buf.String(); got != want {
+		t.Errorf("want\n%s\ngot\n%s", want, got)
+	}
+}
+
+func TestWriteCoverReport(t *testing.T) {
+	var buf bytes.Buffer
+	writeCoverReport(&buf, mergeCoverProfiles(parseTestProfiles(t)[0]))
+
+	want := `g.peg:1:9: alternative in rule A never matched
+g.peg:3:5: rule C never matched
+g.peg:3:5: optional in rule C never matched
+g.peg: 57.1% of 7 expressions matched
+`
+	if got := buf.String(); got != want {
+		t.Errorf("want\n%s\ngot\n%s", want, got)
+	}
+}
+
+func TestWriteCoverHTML(t *testing.T) {
+	readFile := func(string) ([]byte, error) {
+		return []byte(coverTestGrammar), nil
+	}
+
+	var buf bytes.Buffer
+	if err := writeCoverHTML(&buf, mergeCoverProfiles(parseTestProfiles(t)[0]), readFile); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.String()
+	wants := []string{
+		`<pre>A = <span class="cov1" title="rule in rule A: 1` + "\n" + `alternative in rule A: 1">B / </span>`,
+		`<span class="cov0" title="alternative in rule A: 0">C</span>`,
+		`B = <span class="cov1" title="rule in rule B: 1` + "\n" + `repetition in rule B: 3">&#39;b&#39;+</span>`,
+		`C = <span class="cov0" title="rule in rule C: 0` + "\n" + `optional in rule C: 0">&#39;c&#39;? &#39;d&#39;</span>`,
+		`57.1% of 7 expressions matched`,
+	}
+	for _, want := range wants {
+		if !strings.Contains(got, want) {
+			t.Errorf("want %q in:\n%s", want, got)
+		}
+	}
+
+	short := func(string) ([]byte, error) {
+		return []byte("A = B"), nil
+	}
+	if err := writeCoverHTML(&buf, mergeCoverProfiles(parseTestProfiles(t)[0]), short); err == nil {
+		t.Errorf("want error for out of range offsets")
+	}
+}
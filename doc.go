@@ -29,12 +29,36 @@ by default.
 
 The following options can be specified:
 
+	-binary : boolean, if set, the generated parser matches the input a byte
+	at a time instead of decoding UTF-8 runes, see the "Binary parsers"
+	section below (default: false).
+
 	-cache : cache parser results to avoid exponential parsing time in
 	pathological cases. Can make the parsing slower for typical
 	cases and uses more memory (default: false).
 
+	-compile : boolean, if set, each rule of the grammar is generated as a
+	Go method of the parser with the code of its expressions inlined, e.g.
+	sequences become straight-line code and character classes become switch
+	statements, instead of a node of the grammar table that the parser
+	interprets. The generated parser is
+	larger but faster, and it supports the same options (default: false).
+
+	-coverage : boolean, if set, the generated parser counts how many times
+	each rule, choice alternative, optional and repeated expression and
+	predicate of the grammar matched, and exports a WriteCoverProfile function
+	to write those counts, see the "Grammar coverage" section below
+	(default: false).
+
 	-debug : boolean, print debugging info to stdout (default: false).
 
+	-lazy-positions : boolean, if set, the generated parser only tracks the
+	byte offset of the input while parsing. The line and column of a position
+	are computed from an index of the line starts, built once, when an error
+	is reported or when an action is invoked, for its c.pos field. This speeds up
+	the parsing of grammars with few actions. It has no effect with -binary
+	(default: false).
+
 	-nolint: add '// nolint: ...' comments for generated parser to suppress
 	warnings by gometalinter (https://github.com/alecthomas/gometalinter) or
 	golangci-lint (https://golangci-lint.run/).
@@ -51,6 +75,12 @@ The following options can be specified:
 	class matcher. This speeds up the parsing, if parsed data mainly consists
 	of characters from this range (default: false).
 
+	-optimize-dfa : boolean, if set, the regular sub-expressions of the grammar
+	whose value is not used, e.g. the expression of an action or of a text
+	expression, are matched by deterministic finite automata generated as Go
+	code, see the "Deterministic finite automata" section below. It has no
+	effect with -binary and -coverage (default: false).
+
 	-optimize-grammar : boolean, (EXPERIMENTAL FEATURE) if set, several performance
 	optimizations on the grammar are performed, with focus to the reduction of the
 	grammar depth.
@@ -63,6 +93,8 @@ The following options can be specified:
 		* resolve nested sequences expression
 		* resolve sequence expressions with only one element
 		* combine character class matcher and literal matcher, where possible
+		* combine four or more consecutive literals of a choice in a single
+		  matcher that uses a trie of the literals, with the same result
 	The resulting grammar is usually more memory consuming, but faster for parsing.
 	The optimization of the grammar is done in multiple rounds (optimize until no
 	more optimizations have applied). This process takes some time, depending on the
@@ -82,6 +114,15 @@ The following options can be specified:
 	code blocks. Non-initializer code blocks in the grammar end up as methods on the
 	*current type, and this option sets the name of the receiver (default: c).
 
+	-reorder-choices=STATS_FILE : string, if set, the alternatives of the choice
+	expressions are reordered so that the alternatives that matched most often are
+	tried first. STATS_FILE is the JSON encoding of the Stats struct (or of its
+	ChoiceAltCnt field) collected by a parser generated from the same grammar
+	without this option, see the Statistics option of the generated parser.
+	Two alternatives are only swapped if they both always consume input and
+	cannot start with the same character, so that the language of the grammar
+	is unchanged. Every reordering is reported on stderr (default: none).
+
 	-alternate-entrypoints=RULE[,RULE...] : string, comma-separated list of rule names
 	that may be used as alternate entrypoints for the parser, in addition to the
 	default entrypoint (the first rule in the grammar) (default: none).
@@ -96,6 +137,17 @@ The following options can be specified:
 	E.g.:
 		expr = expr '*' term / expr '+' term
 
+The prof command prints a report of the statistics collected by a generated
+parser, see the "Profiling" section below:
+
+	pigeon prof [options] STATS_FILE
+
+The cover command merges the grammar coverage profiles written by a
+generated parser and reports the expressions that never matched, see the
+"Grammar coverage" section below:
+
+	pigeon cover [options] PROFILE...
+
 If the code blocks in the grammar (see below, section "Code block") are golint-
 and go vet-compliant, then the resulting generated code will also be golint-
 and go vet-compliant.
@@ -136,6 +188,74 @@ be specified after the rule identifier. E.g.:
 The rule definition operator can be any one of those:
 	=, <-, ← (U+2190), ⟵ (U+27F5)
 
+Annotations - an "@" followed by the name of the annotation - can be
+specified after the rule identifier and display name, before the rule
+definition operator. The following annotations are supported:
+	@memo : the results of the rule are memoized, so that the rule is
+	evaluated only once at a given position of the input, even if the
+	generated parser is not created with the Memoize option or is
+	generated with -optimize-parser. E.g.:
+		Expr "expression" @memo = Term '+' Expr / Term
+	@skip : the rule is the skip rule of the grammar, see below. A grammar
+	has at most one skip rule.
+	@lexical : the skip rule is not matched in the rule, see below.
+	@longest : the rule, a choice of literals, matches the longest of its
+	literals instead of the first one that matches, see below.
+	@keywords : like @longest, but the literal must not be followed by a
+	letter, a digit or an underscore.
+
+Memoizing only the rules that are evaluated repeatedly at the same position
+avoids the exponential parsing time of some grammars without the memory and
+time cost of memoizing every expression. The prof command reports the
+candidate rules, see the "Profiling" section below.
+
+The skip rule - typically matching whitespace and comments - is matched
+implicitly, as many times as possible, between the expressions of the
+sequences and between the repetitions of the "*" and "+" expressions of the
+syntactic rules. It is not matched before the first or after the last
+expression, so the input that precedes the first token must be matched
+explicitly. The skip rule itself, the rules annotated with @lexical and the
+rules that are only referenced by lexical rules are lexical: the skip rule
+is not matched in their expressions, so that they can define the tokens of
+the grammar. A rule that is referenced by both lexical and syntactic rules
+is copied for the lexical rules, with the "_lexical" suffix. E.g.:
+	File = Skip? stmts:Stmt* !.
+	Stmt = name:Ident '=' val:Number ';' // matches "a = 1 ;"
+	Ident @lexical = [a-z] [a-z0-9]*
+	Number @lexical = [0-9]+ ( '.' [0-9]+ )? // does not match "1 .5"
+	Skip @skip = [ \t\r\n]+ / '#' [^\n]*
+
+The expression of a rule annotated with @longest or @keywords - or the
+expression of its action - must be a choice of literals. The literals are
+matched in a single pass over the input using a trie, which is faster than
+trying them one after the other, and the longest literal that matches is
+the match of the rule, so that the order of the literals does not matter:
+with the choice "in" / "insert", "insert" never matches, but it does with
+@longest. The literals of a @keywords rule must also end at a word
+boundary, so that "in" does not match the start of "inner". E.g.:
+	Keyword @keywords = ( "in"i / "insert"i / "into"i / "select"i ) {
+		return strings.ToLower(string(c.text)), nil
+	}
+	Op @longest = "<" / "<=" / "<<" / "<<="
+
+A rule may have parameters, a comma-separated list of identifiers between
+"<" and ">" immediately after the rule identifier. A reference to the rule
+must then provide an expression for each parameter, between "<" and ">"
+immediately after the rule identifier. E.g.:
+	List<Item, Sep> = first:Item rest:( Sep Item )* {
+		// ...
+	}
+	Args = List<Expr, ","> / "(" List<Ident, ( ";" / "," )> ")"
+
+Pigeon creates an instance of the rule for each distinct list of arguments,
+where the references to the parameters are replaced by the arguments. The
+instances are named after the rule with a numeric suffix (e.g. List_1 and
+List_2), which appears in the debugging output, the statistics and the error
+messages. The code blocks of the rule see its labels as usual, and the code
+blocks of the arguments see the labels of the arguments. A parameter cannot
+take arguments itself, and the first rule of the grammar cannot have
+parameters.
+
 Expressions
 
 A rule is defined by an expression. The following sections describe the
@@ -154,6 +274,17 @@ of expressions. For example, in this rule, "<=" would never be used because
 the "<" expression comes first:
 	BadChoiceExpr = "<" / "<="
 
+The generated parser only tests the alternatives that can start with the
+current character, as computed from the grammar, unless the parser is
+generated with the -coverage flag. The alternatives that cannot match the
+empty input and that do not start with a code block or an and/not expression
+are skipped when they cannot match that character, which gives the same
+result and error messages as testing them, but the statistics only count
+the expressions that were actually tested: the choices inside a skipped
+alternative are not counted in the ChoiceAltCnt statistics, not even as
+"no match", and the skipped expressions are not counted in ExprCnt nor
+against the limit of the MaxExpressions option.
+
 Sequence expression
 
 The sequence expression is a list of expressions that must all match in
@@ -207,6 +338,25 @@ is used. E.g.:
 	}
 	RuleB = label:RuleA { // label is int }
 
+Text and pluck expressions
+
+An expression prefixed with the dollar sign "$" is the text expression: it
+matches like the expression, but its value is the matched text as a string.
+The dollar sign applies to the repetitions, so "$[0-9]+" is the text of the
+digits. A text expression "$name" is a back-reference if its rule has a
+label with that name, see below, and it is an error if the grammar also has
+a rule with that name. E.g.:
+	Number = $( '-'? [0-9]+ ) // the value is a string, e.g. "-12"
+
+An expression of a sequence prefixed with the at sign "@" is plucked: the
+value of the sequence is the value of that expression, instead of the slice
+of the values of all its expressions. If more than one expression of the
+sequence is plucked, the value is the slice of the values of the plucked
+expressions. A plucked expression may be labeled, and an action replaces
+the value of the sequence as usual. E.g.:
+	Group = '(' @Expr ')'          // the value is the value of Expr
+	Pair = @Key '=' @Value         // the value is []any{key, value}
+
 And and not expressions
 
 An expression prefixed with the ampersand "&" is the "and" predicate
@@ -237,6 +387,89 @@ occurs zero or more times ("*"), zero or one time "?" or one or more times
 possible. E.g.
 	ZeroOrMoreAs = "A"*
 
+An expression immediately followed by "{n}", "{n,}" or "{n,m}" - without
+space before the opening brace, which would start the code block of an
+action - is a match if the expression occurs exactly n times, at least n
+times or between n and m times respectively. The match is greedy, it will
+match as many times as possible, up to m times. Like the "*" and "+"
+repetitions, its value is the slice of the values of the matches. E.g.
+	Color = '#' HexDigit{6}
+	Octet = Digit{1,3}
+
+Cut
+
+The cut "~" commits a sequence to the alternative it is in. It always
+matches without consuming any input, but once it is reached, the
+expressions that follow it in its sequence must match: if one of them
+fails, the other alternatives are not tried and the parsing stops with an
+error reporting the expected values at the farthest position reached,
+prefixed with the rule of the cut. The cut reports errors where the input
+is invalid instead of where the alternatives started, and avoids trying
+alternatives that cannot match. The failure after a cut is not caught by
+the recover expressions, and a cut cannot be in a predicate, an optional
+expression or a repetition, where it would stop the parsing instead of
+failing the predicate or ending the repetition. A rule with a cut can be
+referenced there, with the same effect, e.g. Stmt* stops the parsing at
+the first statement that fails after its cut. The cut has no value, it is
+not in the values of its sequence. The cut does not discard the memoized
+results of the expressions before it, so it does not reduce the memory
+used by the memoization. E.g.:
+	Stmt = If / Assign
+	If = "if" !Letter ~ Cond ':' Body // "if = a" is not an assignment
+
+Precedence expression
+
+The precedence expression "%precedence( Operand levels... )" parses the
+operands and operators of an expression by precedence climbing, instead of
+a rule for each level of precedence. The levels of operators follow the
+operand expression, from the lowest precedence to the highest. Each level
+starts with its kind - "%left", "%right" or "%nonassoc" for binary
+operators, "%prefix" or "%postfix" for unary operators - followed by the
+expression that matches its operators and an optional action code block.
+E.g.:
+	Expr = %precedence( Number
+		%nonassoc ( "==" / '<' ) { return cmp(op, l, r) }
+		%left ( '+' / '-' ) { return arith(op, l, r) }
+		%left ( '*' / '/' ) { return arith(op, l, r) }
+		%prefix '-' { return neg(x) }
+		%right '^' { return pow(l, r) }
+		%postfix '!' { return fact(x) }
+	)
+
+The action of a binary level receives the left operand, the operator and
+the right operand as the "l", "op" and "r" arguments, the action of a unary
+level receives the operator and the operand as the "op" and "x" arguments.
+Without action, the value is the slice of those values. A non-associative
+operator cannot be followed by an operator of the same level, so "1 < 2 < 3"
+does not match the whole input. At each position the operators are tried
+in the order of their levels, an operator whose operand fails to match is
+backtracked. The operand of a prefix operator binds the operators of its
+level and above, so "-2^2" is "-(2^2)" and "-2*3" is "(-2)*3" above. In
+syntactic rules, the skip rule is matched between the operands and the
+operators.
+
+Back-reference
+
+A back-reference "$label" matches the exact text matched earlier by the
+label, which must be in scope like for the arguments of a code block. It is
+a back-reference if the rule has a label with that name, it is the text of
+the rule with that name otherwise, and "$label*" repeats it. If the rule
+has a label with that name and the grammar has a rule with that name, it
+is an error, so that adding a label does not silently change the meaning
+of "$name". A
+back-reference "$#key" matches the text of the value of key in the state
+store (see the code blocks below), which must be a string or a []byte. The
+value of a back-reference is the matched text, and it reports the text it
+expects when it fails. E.g.:
+	RawString = 'r' hashes:'#'* '"' ( !( '"' $hashes ) . )* '"' $hashes
+	Element = '<' name:Name '>' Element* "</" $name '>'
+
+The results of a back-reference depend on the captured texts or on the
+state store, so they are never memoized, and neither are the results of the
+sequences, choices and other expressions that contain one, nor of the rules
+that contain one or refer to such a rule, even with the Memoize option or the
+@memo annotation.
+
 Literal matcher
 
 A literal matcher tries to match the input against a single character or a
@@ -248,6 +481,13 @@ The literal may be followed by a lowercase "i" (outside the ending quote)
 to indicate that the match is case-insensitive. E.g.:
 	LiteralMatch = "Awesome\n"i // matches "awesome" followed by a newline
 
+The case-insensitive literals are matched using the Unicode case folding,
+and not by converting the input to lowercase: the runes that fold to the
+same rune match each other, e.g. "k"i matches k, K and the Kelvin sign,
+and the runes whose full case folding has more than one rune match it,
+e.g. "strasse"i matches "Straße". The binary parsers only match the ASCII
+letters case-insensitively.
+
 Character class matcher
 
 A character class matcher tries to match the input against a class of characters
@@ -259,10 +499,29 @@ square bracket "]" must be escaped to be used.
 Character ranges can be specified using the "[a-z]" notation. Unicode
 classes can be specified using the "[\pL]" notation, where L is a
 single-letter Unicode class of characters, or using the "[\p{Class}]"
-notation where Class is a valid Unicode class (e.g. "Latin").
+notation where Class is a valid Unicode class (e.g. "Latin"). The script
+and the general category may also be named explicitly, e.g.
+"[\p{Script=Latin}]" or "[\p{sc=Latin}]", and "[\p{General_Category=Lu}]"
+or "[\p{gc=Lu}]". The "[\P{Class}]" notation matches the characters that
+are not in the Unicode class. The derived properties of identifiers
+ID_Start, ID_Continue, XID_Start and XID_Continue, which are not Unicode
+classes of the Go unicode package, are also available, e.g. "[\p{XID_Start}]".
+
+The characters of a class may be combined with a set operation and a
+nested class or a Unicode class, using "--" for the difference and "&&"
+for the intersection, which are applied from left to right. The nested
+class may be inverted by a leading "^". E.g.:
+	Consonant = [a-z--[aeiou]]
+	GreekLetter = [\p{Greek}&&\pL]
+
+The classes that use set operations, negated Unicode classes or derived
+properties are computed when the grammar is parsed, and the generated
+parser matches them using a single Unicode range table.
 
 As for string literals, a lowercase "i" may follow the matcher (outside
-the ending square bracket) to indicate that the match is case-insensitive.
+the ending square bracket) to indicate that the match is case-insensitive,
+using the simple case folding of the input and of the characters of the
+class.
 A "^" as first character inside the square brackets indicates that the match
 is inverted (it is a match if the input does not match the character class
 matcher). E.g.:
@@ -276,6 +535,48 @@ the end of file". E.g.:
 	AnyChar = . // match a single character
 	EOF = !.
 
+Regular expression matcher
+
+A regular expression matcher is a Go regular expression (see the regexp
+package) between double quotes and prefixed by "re", e.g. re"[0-9]+". A
+double quote is escaped as \", the other backslashes are part of the
+regular expression. It is anchored at the current position of the input and
+matches the leftmost-first match of the regular expression, so the
+alternatives of a "|" are tested in order like those of a choice
+expression. Its value is the matched text and, on failure, the matcher
+itself is added to the list of expected values. E.g.:
+	Number = re"[0-9]+(\.[0-9]+)?([eE][+-]?[0-9]+)?"
+
+The regular expressions are compiled when the package of the generated
+parser is initialized. The "^", "\b" and "\B" assertions see the input
+before the current position, e.g. re"\bfoo" does not match the "foo" of
+"xfoo" and re"^#" only matches at the start of the input. Regular
+expression matchers are not supported by binary parsers.
+
+The "re" prefix and the opening double quote form a single token, which
+changes the meaning of the grammars written before the regular expression
+matchers: a reference to a rule named "re" immediately followed by a
+double-quoted string, e.g. re"a", is now a regular expression matcher. A
+space keeps the rule reference followed by the literal, e.g. re "a".
+
+Matcher function
+
+A matcher function is a Go function of the package of the generated parser
+called by the grammar, e.g. @scanString(), to match what a grammar cannot
+express, such as nested comments or length-prefixed strings. The
+parentheses distinguish it from a pluck expression, so a matcher function
+is plucked as "@ @scanString()". The function must have the signature:
+	func(c *current, input []byte) (n int, val any, ok bool)
+
+It receives the remaining input, and c with the current position as "c.pos".
+If ok is true, it matched the first n bytes of the input, which the parser
+consumes, and val is the value of the matcher. If n is negative, past the
+end of the input or in the middle of a rune, an error is added to the list
+of errors and the matcher fails. On failure, the matcher itself is added to
+the list of expected values and the changes to the "state" store are rolled
+back. E.g.:
+	String = '#' @ @scanString()
+
 Code block
 
 Code blocks can be added to generate custom Go code. There are three kinds
@@ -406,6 +707,59 @@ References:
 	[10]: https://arxiv.org/pdf/1207.0443.pdf
 	[11]: http://web.cs.ucla.edu/~todd/research/pepm08.pdf
 
+Indentation
+
+The generated parser has built-in INDENT, DEDENT and SAMEDENT rules to
+parse the blocks delimited by their indentation, like in Python or YAML.
+They match the spaces and tabs at the start of a line against a stack of
+indentations managed by the parser, which starts with the indentation of
+width 0:
+	- INDENT matches if the indentation is wider than the current one, and
+	  pushes it on the stack;
+	- DEDENT matches if the indentation is narrower than the current one
+	  and is one of the enclosing indentations, and pops the current one;
+	- SAMEDENT matches if the indentation is the current one, and consumes
+	  it.
+
+INDENT and DEDENT do not consume any input, so a block is typically parsed
+with:
+	Block = INDENT Stmt+ DEDENT
+	Stmt = SAMEDENT ( If / Print )
+	If = "if" _ Cond ':' EOL Block
+
+The stack is part of the position of the parser, so it is restored when
+the parser backtracks. The lines that are empty or only have spaces must be
+consumed by the grammar, e.g. by the rule that matches the end of the
+lines. The Indentation option of the generated parser sets the width of a
+tab, which advances the indentation to the next tab stop, and whether the
+tabs or the indentations that mix tabs and spaces are rejected. The rules
+of the grammar take precedence over the built-in rules of the same name.
+The built-in rules are not available in the binary parsers.
+
+Binary parsers
+
+With the -binary flag, the generated parser matches the input a byte at a
+time, to describe binary formats such as file headers and network protocol
+framing. The literals match their bytes, e.g. "\x89PNG", and the character
+classes and the any matcher match a single byte, e.g. [\x80-\xff]. The
+character classes cannot contain Unicode classes nor runes above \xff, and
+the case-insensitive matchers must be ASCII, and regular expression matchers
+are not supported. The columns of the positions
+count the bytes and the line is always 1.
+
+The grammar of a binary parser can reference built-in rules, unless it
+defines rules with the same names. The rules u8, u16be, u16le, u32be, u32le,
+u64be and u64le match an unsigned integer of 1, 2, 4 or 8 bytes, in
+big-endian or little-endian order, and their value is an uint8, uint16,
+uint32 or uint64. The rule bytes<label> matches the number of bytes given by
+the value of the label, which must be an unsigned integer or a non-negative
+int, and its value is the matched bytes. The label must be in scope like for
+a code block. Like the back-references, its results depend on the value of
+the label, so they are never memoized, and neither are the results of the
+expressions and rules that contain it. E.g.:
+
+	Chunk = length:u32be type:ChunkType data:bytes<length> crc:u32be
+
 Failure labels, throw and recover
 
 pigeon supports an extension of the classical PEG syntax called failure labels,
@@ -432,7 +786,7 @@ To signal a failure condition, the throw expression is used. E.g.:
 	ThrowExpr = %{FailureLabel1}
 
 For concrete examples, how to use throw and recover, have a look at the examples
-"labeled_failures" and "thrownrecover" in the "test" folder.
+"labeled_failures", "failure_messages" and "thrownrecover" in the "test" folder.
 
 The implementation of the throw and recover operators work as follows:
 The failure recover expression adds the recover expression for every failure label
@@ -443,6 +797,30 @@ this expression is successful, the parser continues the processing of the input.
 the recovery expression is not successful, the parsing fails and the parser starts
 to backtrack.
 
+A failure label may be declared with a message template, at the top level of
+the grammar among the rules. E.g.:
+	%{errComma} = "expecting ',', found {found}"
+
+When a throw expression throws a label, it records the failure at the
+farthest position where the input did not match, if it is at or after the
+throw expression, otherwise at the throw expression, with the values that
+were expected at that position. In the template, {label} is replaced by the
+label, {expected} by the list of expected values and {found} by the input
+character at the position of the failure, or EOF. Any other identifier in
+braces is an error when the parser is generated. The message of a label
+that is not declared is the label itself.
+
+The code blocks of the recovery expression get the failure as "c.failure",
+of type "*failure", with the fields "label", "message", "pos" (the position
+of the failure), "expected" and "text" (the text matched by the expression
+protected by the recovery expression, up to the throw expression). It is nil
+outside of recovery expressions. A "*failure" is an error whose message is the message of the
+failure, and when a code block returns it, wrapped or not, the error is
+reported at the position of the failure. E.g.:
+	ErrComma = #{
+		return c.failure
+	} ( ![a-z] . )*
+
 If throw and recover expressions are used together with global state, it is the
 responsibility of the author of the grammar to reset the global state to a valid
 state during the recovery operation.
@@ -458,6 +836,8 @@ as a package with public functions to parse input text. The exported API is:
 	- ParseFile(string, ...Option) (any, error)
 	- ParseReader(string, io.Reader, ...Option) (any, error)
 	- AllowInvalidUTF8(bool) Option
+	- Columns(ColumnUnit) Option
+	- CRLF(bool) Option
 	- Debug(bool) Option
 	- Entrypoint(string) Option
 	- GlobalStore(string, any) Option
@@ -465,6 +845,11 @@ as a package with public functions to parse input text. The exported API is:
 	- Memoize(bool) Option
 	- Recover(bool) Option
 	- Statistics(*Stats) Option
+	- TabWidth(int) Option
+
+If the parser is generated with the -coverage flag, it also exports:
+
+	- WriteCoverProfile(io.Writer) error
 
 See the godoc page of the generated parser for the test/predicates grammar
 for an example documentation page of the exported API:
@@ -473,6 +858,19 @@ http://godoc.org/github.com/mna/pigeon/test/predicates.
 Like the grammar used to generate the parser, the input text must be
 UTF-8-encoded Unicode.
 
+The positions in c.pos and in the errors have a 1-based line and column and
+a 0-based byte offset. By default, the columns count runes, a tab is one
+column and only "\n" starts a new line. The Columns option counts the
+columns in bytes (ColumnBytes) or in UTF-16 code units (ColumnUTF16, as
+required by the Language Server Protocol) instead, the TabWidth option
+advances the column to the next tab stop after a tab, and the CRLF option
+also treats "\r\n" and a lone "\r" as line breaks. E.g.:
+
+	Parse("file", b, Columns(ColumnUTF16), CRLF(true))
+
+With the -lazy-positions flag, the same positions are computed on demand
+from the byte offsets, see the flag above.
+
 The start rule of the parser is the first rule in the PEG grammar used
 to generate the parser. A call to any of the Parse* functions returns
 the value generated by executing the grammar on the provided input text,
@@ -483,6 +881,118 @@ but for simple grammars it may evaluate the result immediately, such as in
 the examples/calculator example. There are no constraints imposed on the
 author of the grammar, it can return whatever is needed.
 
+Deterministic finite automata
+
+With the -optimize-dfa flag, the expressions that only match literals,
+character classes and the any matcher in sequences, choices and
+repetitions, directly or through references to non-recursive rules, are
+compiled to deterministic finite automata, which match them a rune at a
+time without backtracking. Only the expressions whose value is not used are
+compiled, e.g. the expression of an action, of a text expression or of a
+predicate, because the value of an automaton is the matched text. The
+literals must be case-sensitive and the repeated expressions must not match
+the empty string. E.g. the expression of the action is a single automaton:
+	Number = '-'? ( '0' / [1-9] [0-9]* ) ( '.' [0-9]+ )? {
+		return strconv.ParseFloat(string(c.text), 64)
+	}
+
+The automata follow the PEG semantics: a choice matches its first
+alternative that matches and the repetitions are greedy, so they match the
+same text and record the same expected values in the errors as the
+expressions. An expression is parsed as usual if its automaton would be
+too large, when it is evaluated in a not predicate, and when the parser is
+created with the Statistics option, so that its choices and rules are
+counted in the ChoiceAltCnt and Rules fields of the Stats of the parser.
+
+Profiling
+
+When a parser is created with the Statistics option, the Rules field of the
+Stats struct records, for each rule, the number of invocations, matches and
+failures, the cumulative time spent in the rule (Time, including the rules
+it invoked, and SelfTime, excluding them), the number of bytes consumed, the
+memoization hits and misses, the number of bytes the parser backtracked
+while the rule was the innermost rule being parsed and the number of times
+the rule was evaluated again at a position where it had already been
+evaluated (Repeats). Collecting those statistics has a cost, so they are
+only recorded with the Statistics option and are not available with
+-optimize-parser.
+
+The Rules and ChoiceAltCnt fields count the rules and the alternatives of
+the grammar that the parser evaluates, and the counts are the same with
+-compile, -optimize-basic-latin and -optimize-dfa: with the Statistics
+option, the expressions of the automata are evaluated as usual. The
+exceptions are:
+	- a choice skips the alternatives that cannot match the current rune
+	  without evaluating them, e.g. a rule reference is skipped if the
+	  rule cannot start with the rune, so a rule is only in Rules if it was
+	  evaluated at least once, and the choices inside the skipped
+	  alternatives are not counted in ChoiceAltCnt, e.g. the "no match" of
+	  a choice of numbers is missing if the input is a string;
+	- with -optimize-grammar, the rules inlined in the rules that reference
+	  them are not in Rules and their choices are counted in ChoiceAltCnt
+	  under the name of the rule they are inlined in, and the alternatives
+	  are those of the optimized grammar, e.g. the literals combined in a
+	  single alternative are counted once;
+	- the ExprCnt field depends on the generated parser, e.g. the compiled
+	  parsers do not count the same expressions as the others, and it does
+	  not count the expressions of the alternatives skipped by a choice, so
+	  the limit of MaxExpressions is reached later than without skipping.
+
+The prof command reads the JSON encoding of the Stats struct and prints the
+hottest rules:
+
+	stats := Stats{}
+	_, err := ParseFile("input", Statistics(&stats, "no match"))
+	// ...
+	b, err := json.Marshal(stats)
+	// ... write b to stats.json
+
+	pigeon prof -top 10 stats.json
+	pigeon prof -format pprof -o rules.pb.gz stats.json
+	go tool pprof -top rules.pb.gz
+
+The text report is sorted by self time by default, the -sort flag sorts it by
+"time", "calls", "fails", "bytes", "backtracked" or "repeats" instead. The
+pprof format has one sample per rule, with the calls, failures, bytes,
+backtracked bytes, repeats and self time as values (use the -sample_index
+flag of pprof to select one).
+
+The -memo flag prints the rules that were evaluated repeatedly at the same
+position instead, sorted by the estimated time spent in those repeated
+evaluations. They are the candidates for the @memo annotation (see the
+"Rules" section above):
+
+	pigeon prof -memo stats.json
+
+Grammar coverage
+
+When the parser is generated with the -coverage flag, every rule, choice
+alternative, optional and repeated expression and predicate of the grammar
+records how many times it matched, for all parses of the process. The
+WriteCoverProfile function writes those counts as a coverage profile, for
+example at the end of the tests of the parser:
+
+	func TestMain(m *testing.M) {
+		code := m.Run()
+		f, _ := os.Create("grammar.cov")
+		_ = WriteCoverProfile(f)
+		_ = f.Close()
+		os.Exit(code)
+	}
+
+The cover command merges one or more profiles and lists the expressions of
+the grammar that never matched, with the percentage of expressions that
+matched. The -html flag writes the grammar instead, with the expressions
+highlighted in green if they matched and in red if they never did, and the -o
+flag writes the merged profile:
+
+	pigeon cover grammar.cov
+	pigeon cover -html coverage.html grammar.cov other.cov
+
+The counters are updated atomically so that the parser can be used from
+concurrent tests, but they add a cost to the parsing and should not be
+enabled in production parsers.
+
 Error reporting
 
 When the parser returns a non-nil error, the error is always of type errList,
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 	"unicode"
 	"unicode/utf8"
 )
@@ -78,22 +79,28 @@ var g = &grammar{
 		{
 			name: "Input",
 			pos:  position{line: 61, col: 1, offset: 1209},
+			id:   0,
 			expr: &actionExpr{
 				pos: position{line: 61, col: 10, offset: 1218},
+				id:  9,
 				run: (*parser).callonInput1,
 				expr: &seqExpr{
 					pos: position{line: 61, col: 10, offset: 1218},
+					id:  10,
 					exprs: []any{
 						&labeledExpr{
 							pos:   position{line: 61, col: 10, offset: 1218},
+							id:    11,
 							label: "expr",
 							expr: &ruleRefExpr{
 								pos:  position{line: 61, col: 15, offset: 1223},
+								id:   12,
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
 							pos:  position{line: 61, col: 20, offset: 1228},
+							id:   13,
 							name: "EOF",
 						},
 					},
@@ -103,46 +110,59 @@ var g = &grammar{
 		{
 			name: "Expr",
 			pos:  position{line: 66, col: 1, offset: 1278},
+			id:   1,
 			expr: &actionExpr{
 				pos: position{line: 66, col: 9, offset: 1286},
+				id:  14,
 				run: (*parser).callonExpr1,
 				expr: &seqExpr{
 					pos: position{line: 66, col: 9, offset: 1286},
+					id:  15,
 					exprs: []any{
 						&ruleRefExpr{
 							pos:  position{line: 66, col: 9, offset: 1286},
+							id:   16,
 							name: "_",
 						},
 						&labeledExpr{
 							pos:   position{line: 66, col: 11, offset: 1288},
+							id:    17,
 							label: "first",
 							expr: &ruleRefExpr{
 								pos:  position{line: 66, col: 17, offset: 1294},
+								id:   18,
 								name: "Term",
 							},
 						},
 						&labeledExpr{
 							pos:   position{line: 66, col: 22, offset: 1299},
+							id:    19,
 							label: "rest",
 							expr: &zeroOrMoreExpr{
 								pos: position{line: 66, col: 27, offset: 1304},
+								id:  20,
 								expr: &seqExpr{
 									pos: position{line: 66, col: 29, offset: 1306},
+									id:  21,
 									exprs: []any{
 										&ruleRefExpr{
 											pos:  position{line: 66, col: 29, offset: 1306},
+											id:   22,
 											name: "_",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 66, col: 31, offset: 1308},
+											id:   23,
 											name: "AddOp",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 66, col: 37, offset: 1314},
+											id:   24,
 											name: "_",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 66, col: 39, offset: 1316},
+											id:   25,
 											name: "Term",
 										},
 									},
@@ -151,6 +171,7 @@ var g = &grammar{
 						},
 						&ruleRefExpr{
 							pos:  position{line: 66, col: 47, offset: 1324},
+							id:   26,
 							name: "_",
 						},
 					},
@@ -160,42 +181,54 @@ var g = &grammar{
 		{
 			name: "Term",
 			pos:  position{line: 71, col: 1, offset: 1385},
+			id:   2,
 			expr: &actionExpr{
 				pos: position{line: 71, col: 9, offset: 1393},
+				id:  27,
 				run: (*parser).callonTerm1,
 				expr: &seqExpr{
 					pos: position{line: 71, col: 9, offset: 1393},
+					id:  28,
 					exprs: []any{
 						&labeledExpr{
 							pos:   position{line: 71, col: 9, offset: 1393},
+							id:    29,
 							label: "first",
 							expr: &ruleRefExpr{
 								pos:  position{line: 71, col: 15, offset: 1399},
+								id:   30,
 								name: "Factor",
 							},
 						},
 						&labeledExpr{
 							pos:   position{line: 71, col: 22, offset: 1406},
+							id:    31,
 							label: "rest",
 							expr: &zeroOrMoreExpr{
 								pos: position{line: 71, col: 27, offset: 1411},
+								id:  32,
 								expr: &seqExpr{
 									pos: position{line: 71, col: 29, offset: 1413},
+									id:  33,
 									exprs: []any{
 										&ruleRefExpr{
 											pos:  position{line: 71, col: 29, offset: 1413},
+											id:   34,
 											name: "_",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 71, col: 31, offset: 1415},
+											id:   35,
 											name: "MulOp",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 71, col: 37, offset: 1421},
+											id:   36,
 											name: "_",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 71, col: 39, offset: 1423},
+											id:   37,
 											name: "Factor",
 										},
 									},
@@ -209,31 +242,39 @@ var g = &grammar{
 		{
 			name: "Factor",
 			pos:  position{line: 76, col: 1, offset: 1492},
+			id:   3,
 			expr: &choiceExpr{
 				pos: position{line: 76, col: 11, offset: 1502},
+				id:  38,
 				alternatives: []any{
 					&actionExpr{
 						pos: position{line: 76, col: 11, offset: 1502},
+						id:  39,
 						run: (*parser).callonFactor2,
 						expr: &seqExpr{
 							pos: position{line: 76, col: 11, offset: 1502},
+							id:  40,
 							exprs: []any{
 								&litMatcher{
 									pos:        position{line: 76, col: 11, offset: 1502},
+									id:         41,
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&labeledExpr{
 									pos:   position{line: 76, col: 15, offset: 1506},
+									id:    42,
 									label: "expr",
 									expr: &ruleRefExpr{
 										pos:  position{line: 76, col: 20, offset: 1511},
+										id:   43,
 										name: "Expr",
 									},
 								},
 								&litMatcher{
 									pos:        position{line: 76, col: 25, offset: 1516},
+									id:         44,
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -243,82 +284,121 @@ var g = &grammar{
 					},
 					&actionExpr{
 						pos: position{line: 79, col: 5, offset: 1567},
+						id:  45,
 						run: (*parser).callonFactor8,
 						expr: &labeledExpr{
 							pos:   position{line: 79, col: 5, offset: 1567},
+							id:    46,
 							label: "integer",
 							expr: &ruleRefExpr{
 								pos:  position{line: 79, col: 13, offset: 1575},
+								id:   47,
 								name: "Integer",
 							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x02\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {0}, {1}},
+					expected:  [][]string{{"\"(\"", "\"-\"", "[0-9]"}, {"\"-\"", "[0-9]"}, {"\"(\""}},
+				},
 			},
 		},
 		{
 			name: "AddOp",
 			pos:  position{line: 84, col: 1, offset: 1632},
+			id:   4,
 			expr: &actionExpr{
 				pos: position{line: 84, col: 10, offset: 1641},
+				id:  48,
 				run: (*parser).callonAddOp1,
 				expr: &choiceExpr{
 					pos: position{line: 84, col: 12, offset: 1643},
+					id:  49,
 					alternatives: []any{
 						&litMatcher{
 							pos:        position{line: 84, col: 12, offset: 1643},
+							id:         50,
 							val:        "+",
 							ignoreCase: false,
 							want:       "\"+\"",
 						},
 						&litMatcher{
 							pos:        position{line: 84, col: 18, offset: 1649},
+							id:         51,
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
 						},
 					},
+					dispatch: &choiceDispatch{
+						ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+						ranges:    []rune{'\u0080'},
+						rangeSets: "\x00",
+						alts:      [][]int{{}, {0}, {1}},
+						expected:  [][]string{{"\"+\"", "\"-\""}, {"\"-\""}, {"\"+\""}},
+					},
 				},
 			},
 		},
 		{
 			name: "MulOp",
 			pos:  position{line: 89, col: 1, offset: 1711},
+			id:   5,
 			expr: &actionExpr{
 				pos: position{line: 89, col: 10, offset: 1720},
+				id:  52,
 				run: (*parser).callonMulOp1,
 				expr: &choiceExpr{
 					pos: position{line: 89, col: 12, offset: 1722},
+					id:  53,
 					alternatives: []any{
 						&litMatcher{
 							pos:        position{line: 89, col: 12, offset: 1722},
+							id:         54,
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 						&litMatcher{
 							pos:        position{line: 89, col: 18, offset: 1728},
+							id:         55,
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 					},
+					dispatch: &choiceDispatch{
+						ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+						ranges:    []rune{'\u0080'},
+						rangeSets: "\x00",
+						alts:      [][]int{{}, {0}, {1}},
+						expected:  [][]string{{"\"*\"", "\"/\""}, {"\"/\""}, {"\"*\""}},
+					},
 				},
 			},
 		},
 		{
 			name: "Integer",
 			pos:  position{line: 94, col: 1, offset: 1790},
+			id:   6,
 			expr: &actionExpr{
 				pos: position{line: 94, col: 12, offset: 1801},
+				id:  56,
 				run: (*parser).callonInteger1,
 				expr: &seqExpr{
 					pos: position{line: 94, col: 12, offset: 1801},
+					id:  57,
 					exprs: []any{
 						&zeroOrOneExpr{
 							pos: position{line: 94, col: 12, offset: 1801},
+							id:  58,
 							expr: &litMatcher{
 								pos:        position{line: 94, col: 12, offset: 1801},
+								id:         59,
 								val:        "-",
 								ignoreCase: false,
 								want:       "\"-\"",
@@ -326,8 +406,10 @@ var g = &grammar{
 						},
 						&oneOrMoreExpr{
 							pos: position{line: 94, col: 17, offset: 1806},
+							id:  60,
 							expr: &charClassMatcher{
 								pos:        position{line: 94, col: 17, offset: 1806},
+								id:         61,
 								val:        "[0-9]",
 								ranges:     []rune{'0', '9'},
 								ignoreCase: false,
@@ -342,10 +424,13 @@ var g = &grammar{
 			name:        "_",
 			displayName: "\"whitespace\"",
 			pos:         position{line: 99, col: 1, offset: 1878},
+			id:          7,
 			expr: &zeroOrMoreExpr{
 				pos: position{line: 99, col: 19, offset: 1896},
+				id:  62,
 				expr: &charClassMatcher{
 					pos:        position{line: 99, col: 19, offset: 1896},
+					id:         63,
 					val:        "[ \\n\\t\\r]",
 					chars:      []rune{' ', '\n', '\t', '\r'},
 					ignoreCase: false,
@@ -356,10 +441,13 @@ var g = &grammar{
 		{
 			name: "EOF",
 			pos:  position{line: 101, col: 1, offset: 1908},
+			id:   8,
 			expr: &notExpr{
 				pos: position{line: 101, col: 8, offset: 1915},
+				id:  64,
 				expr: &anyMatcher{
-					line: 101, col: 9, offset: 1916,
+					pos: position{line: 101, col: 9, offset: 1916},
+					id:  65,
 				},
 			},
 		},
@@ -508,7 +596,8 @@ func Entrypoint(ruleName string) Option {
 
 // Statistics adds a user provided Stats struct to the parser to allow
 // the user to process the results after the parsing has finished.
-// Also the key for the "no match" counter is set.
+// Also the key for the "no match" counter is set. Per-rule statistics
+// (see RuleStats) are only collected when this option is set.
 //
 // Example usage:
 //
@@ -532,6 +621,9 @@ func Statistics(stats *Stats, choiceNoMatch string) Option {
 		if p.Stats.ChoiceAltCnt == nil {
 			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
 		}
+		if p.Stats.Rules == nil {
+			p.Stats.Rules = make(map[string]*RuleStats)
+		}
 		return Statistics(oldStats, oldChoiceNoMatch)
 	}
 }
@@ -552,6 +644,9 @@ func Debug(b bool) Option {
 // the parser will cache all results so each expression is evaluated only
 // once. This guarantees linear parsing time even for pathological cases,
 // at the expense of more memory and slower times for typical cases.
+// The rules annotated with @memo in the grammar are always memoized,
+// regardless of this option. The memoization table is pooled, so its
+// storage is reused by the following parses.
 //
 // The default is false.
 func Memoize(b bool) Option {
@@ -576,6 +671,57 @@ func AllowInvalidUTF8(b bool) Option {
 	}
 }
 
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
 // Recover creates an Option to set the recover flag to b. When set to
 // true, this causes the parser to recover from panics and convert it
 // to an error. Setting it to false can be useful while debugging to
@@ -691,17 +837,68 @@ type rule struct {
 	name        string
 	displayName string
 	expr        any
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+//
+//	nolint: structcheck
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
 }
 
 // nolint: structcheck
 type choiceExpr struct {
 	pos          position
+	id           int
 	alternatives []any
+	dispatch     *choiceDispatch
 }
 
 // nolint: structcheck
 type actionExpr struct {
 	pos  position
+	id   int
 	expr any
 	run  func(*parser) (any, error)
 }
@@ -709,6 +906,7 @@ type actionExpr struct {
 // nolint: structcheck
 type recoveryExpr struct {
 	pos          position
+	id           int
 	expr         any
 	recoverExpr  any
 	failureLabel []string
@@ -717,18 +915,14 @@ type recoveryExpr struct {
 // nolint: structcheck
 type seqExpr struct {
 	pos   position
+	id    int
 	exprs []any
 }
 
-// nolint: structcheck
-type throwExpr struct {
-	pos   position
-	label string
-}
-
 // nolint: structcheck
 type labeledExpr struct {
 	pos   position
+	id    int
 	label string
 	expr  any
 }
@@ -736,9 +930,20 @@ type labeledExpr struct {
 // nolint: structcheck
 type expr struct {
 	pos  position
+	id   int
 	expr any
 }
 
+// nolint: structcheck
+type repeatExpr struct {
+	pos  position
+	id   int
+	expr any
+	// max is -1 if the number of repetitions is not bounded.
+	min int
+	max int
+}
+
 type (
 	andExpr        expr // nolint: structcheck
 	notExpr        expr // nolint: structcheck
@@ -750,30 +955,35 @@ type (
 // nolint: structcheck
 type ruleRefExpr struct {
 	pos  position
+	id   int
 	name string
 }
 
 // nolint: structcheck
 type stateCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) error
 }
 
 // nolint: structcheck
 type andCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) (bool, error)
 }
 
 // nolint: structcheck
 type notCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) (bool, error)
 }
 
 // nolint: structcheck
 type litMatcher struct {
 	pos        position
+	id         int
 	val        string
 	ignoreCase bool
 	want       string
@@ -782,6 +992,7 @@ type litMatcher struct {
 // nolint: structcheck
 type charClassMatcher struct {
 	pos             position
+	id              int
 	val             string
 	basicLatinChars [128]bool
 	chars           []rune
@@ -791,7 +1002,11 @@ type charClassMatcher struct {
 	inverted        bool
 }
 
-type anyMatcher position // nolint: structcheck
+// nolint: structcheck
+type anyMatcher struct {
+	pos position
+	id  int
+}
 
 // errList cumulates the errors found by the parser.
 type errList []error
@@ -876,6 +1091,7 @@ func newParser(filename string, b []byte, opts ...Option) *parser {
 		entrypoint: g.rules[0].name,
 	}
 	p.setOptions(opts)
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
 
 	if p.maxExprCnt == 0 {
 		p.maxExprCnt = math.MaxUint64
@@ -911,7 +1127,8 @@ type Stats struct {
 	// ChoiceAltCnt is used to count for each ordered choice expression,
 	// which alternative is used how may times.
 	// These numbers allow to optimize the order of the ordered choice expression
-	// to increase the performance of the parser
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
 	//
 	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
 	// as the line and the column of the ordered choice.
@@ -921,6 +1138,46 @@ type Stats struct {
 	// the parser option Statistics.
 	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
 	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
 }
 
 // nolint: structcheck,maligned
@@ -937,9 +1194,9 @@ type parser struct {
 	debug   bool
 
 	memoize bool
-	// memoization table for the packrat algorithm:
-	// map[offset in source] map[expression or rule] {value, match}
-	memo map[int]map[any]resultTuple
+	// memoization table for the packrat algorithm, taken from memoTables
+	// on the first memoized result
+	memo *memoTable
 
 	// rules table, maps the rule identifier to the rule node
 	rules map[string]*rule
@@ -960,11 +1217,33 @@ type parser struct {
 
 	allowInvalidUTF8 bool
 
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+
 	*Stats
+	// profiling frames, tracks the time spent in invoked rules to compute
+	// the self time of each rule.
+	profStack []profFrame
+	// number of active invocations of each rule, the time of a recursive
+	// rule is only added at its outermost invocation.
+	profActive map[*rule]int
+	// rules evaluated at each offset, to count the repeated evaluations.
+	profEvals map[profEval]bool
 
 	choiceNoMatch string
 	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
-	recoveryStack []map[string]any
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
 }
 
 // push a variable set on the vstack.
@@ -1003,7 +1282,7 @@ func (p *parser) popV() {
 func (p *parser) pushRecovery(labels []string, expr any) {
 	if cap(p.recoveryStack) == len(p.recoveryStack) {
 		// create new empty slot in the stack
-		p.recoveryStack = append(p.recoveryStack, nil)
+		p.recoveryStack = append(p.recoveryStack, recovery{})
 	} else {
 		// slice to 1 more
 		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
@@ -1013,13 +1292,13 @@ func (p *parser) pushRecovery(labels []string, expr any) {
 	for _, fl := range labels {
 		m[fl] = expr
 	}
-	p.recoveryStack[len(p.recoveryStack)-1] = m
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
 }
 
 // pop a recovery expression from the recoveryStack
 func (p *parser) popRecovery() {
 	// GC that map
-	p.recoveryStack[len(p.recoveryStack)-1] = nil
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
 
 	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
 }
@@ -1029,8 +1308,9 @@ func (p *parser) print(prefix, s string) string {
 		return s
 	}
 
+	pos := p.pt.position
 	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
-		prefix, p.pt.line, p.pt.col, p.pt.offset, s, p.pt.rn)
+		prefix, pos.line, pos.col, pos.offset, s, p.pt.rn)
 	return s
 }
 
@@ -1098,14 +1378,19 @@ func (p *parser) failAt(fail bool, pos position, want string) {
 
 // read advances the parser to the next rune.
 func (p *parser) read() {
-	p.pt.offset += p.pt.w
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
 	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
 	p.pt.rn = rn
 	p.pt.w = n
-	p.pt.col++
-	if rn == '\n' {
-		p.pt.line++
-		p.pt.col = 0
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
 	}
 
 	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
@@ -1115,6 +1400,36 @@ func (p *parser) read() {
 	}
 }
 
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
 // restore parser position to the savepoint pt.
 func (p *parser) restore(pt savepoint) {
 	if p.debug {
@@ -1123,6 +1438,9 @@ func (p *parser) restore(pt savepoint) {
 	if pt.offset == p.pt.offset {
 		return
 	}
+	if p.Rules != nil && pt.offset < p.pt.offset && len(p.rstack) > 0 {
+		p.ruleStats(p.rstack[len(p.rstack)-1]).Backtracked += uint64(p.pt.offset - pt.offset)
+	}
 	p.pt = pt
 }
 
@@ -1180,28 +1498,109 @@ func (p *parser) sliceFrom(start savepoint) []byte {
 	return p.data[start.position.offset:p.pt.position.offset]
 }
 
-func (p *parser) getMemoized(node any) (resultTuple, bool) {
-	if len(p.memo) == 0 {
-		return resultTuple{}, false
+// memoSlot is an entry of the memoization table, the key of an empty slot
+// is 0.
+type memoSlot struct {
+	key uint64
+	res resultTuple
+}
+
+// memoTable is the memoization table for the packrat algorithm, an
+// open-addressed hash table with linear probing that maps the offset in
+// the source and the id of a rule or expression to its result.
+type memoTable struct {
+	slots []memoSlot
+	shift uint
+	n     int
+}
+
+// memoTables pools the memoization tables so that their storage is
+// reused across parses.
+var memoTables = &sync.Pool{
+	New: func() any {
+		return &memoTable{slots: make([]memoSlot, 1<<10), shift: 64 - 10}
+	},
+}
+
+// memoKey returns the key of the result of the rule or expression id at
+// offset, it supports up to 1<<24 ids.
+func memoKey(offset, id int) uint64 {
+	return uint64(offset+1)<<24 | uint64(id)
+}
+
+// index returns the index of the slot of key, or of the empty slot where
+// it should be stored.
+func (t *memoTable) index(key uint64) int {
+	mask := len(t.slots) - 1
+	i := int((key*0x9E3779B97F4A7C15)>>t.shift) & mask
+	for t.slots[i].key != 0 && t.slots[i].key != key {
+		i = (i + 1) & mask
+	}
+	return i
+}
+
+func (t *memoTable) get(key uint64) (resultTuple, bool) {
+	s := &t.slots[t.index(key)]
+	return s.res, s.key == key
+}
+
+func (t *memoTable) set(key uint64, res resultTuple) {
+	if 4*(t.n+1) > 3*len(t.slots) {
+		t.grow()
+	}
+	s := &t.slots[t.index(key)]
+	if s.key == 0 {
+		s.key = key
+		t.n++
+	}
+	s.res = res
+}
+
+// grow doubles the capacity of the table.
+func (t *memoTable) grow() {
+	old := t.slots
+	t.slots = make([]memoSlot, 2*len(old))
+	t.shift--
+	for _, s := range old {
+		if s.key != 0 {
+			t.slots[t.index(s.key)] = s
+		}
 	}
-	m := p.memo[p.pt.offset]
-	if len(m) == 0 {
+}
+
+// reset empties the table, releasing the references to the results.
+func (t *memoTable) reset() {
+	if t.n == 0 {
+		return
+	}
+	for i := range t.slots {
+		t.slots[i] = memoSlot{}
+	}
+	t.n = 0
+}
+
+func (p *parser) getMemoized(id int) (resultTuple, bool) {
+	if p.memo == nil {
 		return resultTuple{}, false
 	}
-	res, ok := m[node]
-	return res, ok
+	return p.memo.get(memoKey(p.pt.offset, id))
 }
 
-func (p *parser) setMemoized(pt savepoint, node any, tuple resultTuple) {
+func (p *parser) setMemoized(pt savepoint, id int, tuple resultTuple) {
 	if p.memo == nil {
-		p.memo = make(map[int]map[any]resultTuple)
+		p.memo = memoTables.Get().(*memoTable)
 	}
-	m := p.memo[pt.offset]
-	if m == nil {
-		m = make(map[any]resultTuple)
-		p.memo[pt.offset] = m
+	p.memo.set(memoKey(pt.offset, id), tuple)
+}
+
+// releaseMemo returns the memoization table to the pool.
+func (p *parser) releaseMemo() {
+	if p.memo == nil {
+		return
 	}
-	m[node] = tuple
+	p.memo.reset()
+	memoTables.Put(p.memo)
+	p.memo = nil
 }
 
 func (p *parser) buildRulesTable(g *grammar) {
@@ -1220,6 +1619,7 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 
 	// TODO : not super critical but this could be generated
 	p.buildRulesTable(g)
+	defer p.releaseMemo()
 
 	if p.recover {
 		// panic can be used in action code to stop parsing immediately
@@ -1253,24 +1653,7 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 		if len(*p.errs) == 0 {
 			// If parsing fails, but no errors have been recorded, the expected values
 			// for the farthest parser position are returned as error.
-			maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
-			for _, v := range p.maxFailExpected {
-				maxFailExpectedMap[v] = struct{}{}
-			}
-			expected := make([]string, 0, len(maxFailExpectedMap))
-			eof := false
-			if _, ok := maxFailExpectedMap["!."]; ok {
-				delete(maxFailExpectedMap, "!.")
-				eof = true
-			}
-			for k := range maxFailExpectedMap {
-				expected = append(expected, k)
-			}
-			sort.Strings(expected)
-			if eof {
-				expected = append(expected, "EOF")
-			}
-			p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+			p.addNoMatchErr()
 		}
 
 		return nil, p.errs.err()
@@ -1278,6 +1661,36 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 	return val, p.errs.err()
 }
 
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
 func listJoin(list []string, sep string, lastSep string) string {
 	switch len(list) {
 	case 0:
@@ -1289,8 +1702,90 @@ func listJoin(list []string, sep string, lastSep string) string {
 	}
 }
 
+// profFrame is a rule invocation being profiled.
+type profFrame struct {
+	start time.Time
+	child time.Duration
+}
+
+// profEval is the evaluation of a rule at an offset.
+type profEval struct {
+	rule   *rule
+	offset int
+}
+
+// ruleStats returns the statistics of rule, creating them if needed.
+func (p *parser) ruleStats(rule *rule) *RuleStats {
+	rs := p.Rules[rule.name]
+	if rs == nil {
+		rs = &RuleStats{}
+		p.Rules[rule.name] = rs
+	}
+	return rs
+}
+
+func (p *parser) countMemo(rs *RuleStats, hit bool) {
+	if hit {
+		rs.MemoHits++
+	} else {
+		rs.MemoMisses++
+	}
+}
+
+func (p *parser) profEnter(r *rule) {
+	if p.profActive == nil {
+		p.profActive = make(map[*rule]int)
+	}
+	p.profActive[r]++
+	p.profStack = append(p.profStack, profFrame{start: time.Now()})
+}
+
+// countRepeat counts the evaluation of rule at the current offset if
+// it was already evaluated at that offset.
+func (p *parser) countRepeat(rule *rule) {
+	if p.profEvals == nil {
+		p.profEvals = make(map[profEval]bool)
+	}
+	key := profEval{rule: rule, offset: p.pt.offset}
+	if p.profEvals[key] {
+		p.ruleStats(rule).Repeats++
+		return
+	}
+	p.profEvals[key] = true
+}
+
+func (p *parser) profExit(rule *rule, start savepoint, ok bool) {
+	frame := p.profStack[len(p.profStack)-1]
+	p.profStack = p.profStack[:len(p.profStack)-1]
+	elapsed := time.Since(frame.start)
+	if n := len(p.profStack); n > 0 {
+		p.profStack[n-1].child += elapsed
+	}
+
+	rs := p.ruleStats(rule)
+	rs.Invocations++
+	if p.profActive[rule]--; p.profActive[rule] == 0 {
+		// the time of the inner invocations is included
+		rs.Time += elapsed
+	}
+	rs.SelfTime += elapsed - frame.child
+	if ok {
+		rs.Matches++
+		rs.Bytes += uint64(p.pt.offset - start.offset)
+	} else {
+		rs.Failures++
+	}
+}
+
 func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
-	res, ok := p.getMemoized(rule)
+	if rule.id < 0 {
+		// its results depend on the captured texts or the state store
+		return p.parseRule(rule)
+	}
+	res, ok := p.getMemoized(rule.id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(rule), ok)
+	}
 	if ok {
 		p.restore(res.end)
 		return res.v, res.b
@@ -1298,7 +1793,7 @@ func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
 
 	startMark := p.pt
 	val, ok := p.parseRule(rule)
-	p.setMemoized(startMark, rule, resultTuple{val, ok, p.pt})
+	p.setMemoized(startMark, rule.id, resultTuple{v: val, b: ok, end: p.pt})
 
 	return val, ok
 }
@@ -1313,6 +1808,11 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 		startMark = p.pt
 	)
 
+	if p.Rules != nil {
+		p.profEnter(rule)
+		defer func() { p.profExit(rule, startMark, ok) }()
+	}
+
 	if p.memoize {
 		val, ok = p.parseRuleMemoize(rule)
 	} else {
@@ -1326,6 +1826,9 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 }
 
 func (p *parser) parseRule(rule *rule) (any, bool) {
+	if p.Rules != nil {
+		p.countRepeat(rule)
+	}
 	p.rstack = append(p.rstack, rule)
 	p.pushV()
 	val, ok := p.parseExprWrap(rule.expr)
@@ -1334,11 +1837,32 @@ func (p *parser) parseRule(rule *rule) (any, bool) {
 	return val, ok
 }
 
+// incChoiceAltCnt counts the match of the alternative altI of the choice
+// at pos, or its failure if altI is choiceNoMatch.
+func (p *parser) incChoiceAltCnt(pos position, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, pos.line, pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
 func (p *parser) parseExprWrap(expr any) (any, bool) {
 	var pt savepoint
+	id := exprID(expr)
 
-	if p.memoize {
-		res, ok := p.getMemoized(expr)
+	if p.memoize && id >= 0 {
+		res, ok := p.getMemoized(id)
+		if p.Rules != nil {
+			p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+		}
 		if ok {
 			p.restore(res.end)
 			return res.v, res.b
@@ -1348,12 +1872,60 @@ func (p *parser) parseExprWrap(expr any) (any, bool) {
 
 	val, ok := p.parseExpr(expr)
 
-	if p.memoize {
-		p.setMemoized(pt, expr, resultTuple{val, ok, p.pt})
+	if p.memoize && id >= 0 {
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
 	}
 	return val, ok
 }
 
+// exprID returns the id of the expression in the memoization table, -1 if
+// its results depend on the texts captured by the labels or on the state
+// store and are not memoized.
+//
+//	nolint: gocyclo
+func exprID(expr any) int {
+	switch expr := expr.(type) {
+	case *actionExpr:
+		return expr.id
+	case *andCodeExpr:
+		return expr.id
+	case *andExpr:
+		return expr.id
+	case *anyMatcher:
+		return expr.id
+	case *charClassMatcher:
+		return expr.id
+	case *choiceExpr:
+		return expr.id
+	case *labeledExpr:
+		return expr.id
+	case *litMatcher:
+		return expr.id
+	case *notCodeExpr:
+		return expr.id
+	case *notExpr:
+		return expr.id
+	case *oneOrMoreExpr:
+		return expr.id
+	case *recoveryExpr:
+		return expr.id
+	case *repeatExpr:
+		return expr.id
+	case *ruleRefExpr:
+		return expr.id
+	case *seqExpr:
+		return expr.id
+	case *stateCodeExpr:
+		return expr.id
+	case *zeroOrMoreExpr:
+		return expr.id
+	case *zeroOrOneExpr:
+		return expr.id
+	default:
+		panic(fmt.Sprintf("unknown expression type %T", expr))
+	}
+}
+
 // nolint: gocyclo
 func (p *parser) parseExpr(expr any) (any, bool) {
 	p.ExprCnt++
@@ -1388,14 +1960,14 @@ func (p *parser) parseExpr(expr any) (any, bool) {
 		val, ok = p.parseOneOrMoreExpr(expr)
 	case *recoveryExpr:
 		val, ok = p.parseRecoveryExpr(expr)
+	case *repeatExpr:
+		val, ok = p.parseRepeatExpr(expr)
 	case *ruleRefExpr:
 		val, ok = p.parseRuleRefExpr(expr)
 	case *seqExpr:
 		val, ok = p.parseSeqExpr(expr)
 	case *stateCodeExpr:
 		val, ok = p.parseStateCodeExpr(expr)
-	case *throwExpr:
-		val, ok = p.parseThrowExpr(expr)
 	case *zeroOrMoreExpr:
 		val, ok = p.parseZeroOrMoreExpr(expr)
 	case *zeroOrOneExpr:
@@ -1546,29 +2118,29 @@ func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
 	return nil, false
 }
 
-func (p *parser) incChoiceAltCnt(ch *choiceExpr, altI int) {
-	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, ch.pos.line, ch.pos.col)
-	m := p.ChoiceAltCnt[choiceIdent]
-	if m == nil {
-		m = make(map[string]int)
-		p.ChoiceAltCnt[choiceIdent] = m
-	}
-	// We increment altI by 1, so the keys do not start at 0
-	alt := strconv.Itoa(altI + 1)
-	if altI == choiceNoMatch {
-		alt = p.choiceNoMatch
-	}
-	m[alt]++
-}
-
 func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseChoiceExpr"))
 	}
 
-	for altI, alt := range ch.alternatives {
-		// dummy assignment to prevent compile error if optimized
-		_ = altI
+	n := len(ch.alternatives)
+	var alts []int
+	if d := ch.dispatch; d != nil {
+		// the alternatives that cannot match the current rune are skipped,
+		// with the values they expect.
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		alts = d.alts[set]
+		n = len(alts)
+	}
+	for i := 0; i < n; i++ {
+		altI := i
+		if alts != nil {
+			altI = alts[i]
+		}
+		alt := ch.alternatives[altI]
 
 		state := p.cloneState()
 
@@ -1576,12 +2148,12 @@ func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 		val, ok := p.parseExprWrap(alt)
 		p.popV()
 		if ok {
-			p.incChoiceAltCnt(ch, altI)
+			p.incChoiceAltCnt(ch.pos, altI)
 			return val, ok
 		}
 		p.restoreState(state)
 	}
-	p.incChoiceAltCnt(ch, choiceNoMatch)
+	p.incChoiceAltCnt(ch.pos, choiceNoMatch)
 	return nil, false
 }
 
@@ -1690,6 +2262,33 @@ func (p *parser) parseRecoveryExpr(recover *recoveryExpr) (any, bool) {
 	return val, ok
 }
 
+func (p *parser) parseRepeatExpr(expr *repeatExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRepeatExpr"))
+	}
+
+	var vals []any
+
+	pt := p.pt
+	state := p.cloneState()
+	for expr.max < 0 || len(vals) < expr.max {
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			break
+		}
+		vals = append(vals, val)
+	}
+	if len(vals) < expr.min {
+		// did not match enough times, no match
+		p.restoreState(state)
+		p.restore(pt)
+		return nil, false
+	}
+	return vals, true
+}
+
 func (p *parser) parseRuleRefExpr(ref *ruleRefExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseRuleRefExpr " + ref.name))
@@ -1740,22 +2339,6 @@ func (p *parser) parseStateCodeExpr(state *stateCodeExpr) (any, bool) {
 	return nil, true
 }
 
-func (p *parser) parseThrowExpr(expr *throwExpr) (any, bool) {
-	if p.debug {
-		defer p.out(p.in("parseThrowExpr"))
-	}
-
-	for i := len(p.recoveryStack) - 1; i >= 0; i-- {
-		if recoverExpr, ok := p.recoveryStack[i][expr.label]; ok {
-			if val, ok := p.parseExprWrap(recoverExpr); ok {
-				return val, ok
-			}
-		}
-	}
-
-	return nil, false
-}
-
 func (p *parser) parseZeroOrMoreExpr(expr *zeroOrMoreExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseZeroOrMoreExpr"))
@@ -135,8 +135,8 @@ func TestMemoization(t *testing.T) {
 	if goti != want {
 		t.Errorf("want %d, got %d", want, goti)
 	}
-	if p.ExprCnt != 415 {
-		t.Errorf("with Memoize=false, want %d expressions evaluated, got %d", 415, p.ExprCnt)
+	if p.ExprCnt != 369 {
+		t.Errorf("with Memoize=false, want %d expressions evaluated, got %d", 369, p.ExprCnt)
 	}
 
 	p = newParser("", []byte(in), Memoize(true))
@@ -148,8 +148,8 @@ func TestMemoization(t *testing.T) {
 	if goti != want {
 		t.Errorf("want %d, got %d", want, goti)
 	}
-	if p.ExprCnt != 389 {
-		t.Errorf("with Memoize=true, want %d expressions evaluated, got %d", 389, p.ExprCnt)
+	if p.ExprCnt != 343 {
+		t.Errorf("with Memoize=true, want %d expressions evaluated, got %d", 343, p.ExprCnt)
 	}
 }
 
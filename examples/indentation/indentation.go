@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 	"unicode"
 	"unicode/utf8"
 )
@@ -29,34 +30,38 @@ var g = &grammar{
 		{
 			name: "Input",
 			pos:  position{line: 13, col: 1, offset: 117},
+			id:   0,
 			expr: &actionExpr{
 				pos: position{line: 13, col: 15, offset: 133},
+				id:  16,
 				run: (*parser).callonInput1,
 				expr: &seqExpr{
 					pos: position{line: 13, col: 15, offset: 133},
+					id:  17,
 					exprs: []any{
-						&stateCodeExpr{
-							pos: position{line: 13, col: 15, offset: 133},
-							run: (*parser).callonInput3,
-						},
 						&labeledExpr{
-							pos:   position{line: 13, col: 59, offset: 177},
+							pos:   position{line: 13, col: 15, offset: 133},
+							id:    18,
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 13, col: 61, offset: 179},
+								pos:  position{line: 13, col: 17, offset: 135},
+								id:   19,
 								name: "Statements",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 13, col: 73, offset: 191},
+							pos:   position{line: 13, col: 28, offset: 146},
+							id:    20,
 							label: "r",
 							expr: &ruleRefExpr{
-								pos:  position{line: 13, col: 75, offset: 193},
+								pos:  position{line: 13, col: 30, offset: 148},
+								id:   21,
 								name: "ReturnOp",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 13, col: 84, offset: 202},
+							pos:  position{line: 13, col: 39, offset: 157},
+							id:   22,
 							name: "EOF",
 						},
 					},
@@ -65,17 +70,22 @@ var g = &grammar{
 		},
 		{
 			name: "Statements",
-			pos:  position{line: 15, col: 1, offset: 312},
+			pos:  position{line: 15, col: 1, offset: 266},
+			id:   1,
 			expr: &actionExpr{
-				pos: position{line: 15, col: 15, offset: 328},
+				pos: position{line: 15, col: 15, offset: 282},
+				id:  23,
 				run: (*parser).callonStatements1,
 				expr: &labeledExpr{
-					pos:   position{line: 15, col: 15, offset: 328},
+					pos:   position{line: 15, col: 15, offset: 282},
+					id:    24,
 					label: "s",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 15, col: 17, offset: 330},
+						pos: position{line: 15, col: 17, offset: 284},
+						id:  25,
 						expr: &ruleRefExpr{
-							pos:  position{line: 15, col: 17, offset: 330},
+							pos:  position{line: 15, col: 17, offset: 284},
+							id:   26,
 							name: "Line",
 						},
 					},
@@ -84,22 +94,28 @@ var g = &grammar{
 		},
 		{
 			name: "Line",
-			pos:  position{line: 16, col: 1, offset: 389},
+			pos:  position{line: 16, col: 1, offset: 343},
+			id:   2,
 			expr: &actionExpr{
-				pos: position{line: 16, col: 15, offset: 405},
+				pos: position{line: 16, col: 15, offset: 359},
+				id:  27,
 				run: (*parser).callonLine1,
 				expr: &seqExpr{
-					pos: position{line: 16, col: 15, offset: 405},
+					pos: position{line: 16, col: 15, offset: 359},
+					id:  28,
 					exprs: []any{
-						&ruleRefExpr{
-							pos:  position{line: 16, col: 15, offset: 405},
-							name: "INDENTATION",
+						&indentExpr{
+							pos:  position{line: 16, col: 15, offset: 359},
+							id:   29,
+							kind: indentSame,
 						},
 						&labeledExpr{
-							pos:   position{line: 16, col: 27, offset: 417},
+							pos:   position{line: 16, col: 24, offset: 368},
+							id:    30,
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 16, col: 29, offset: 419},
+								pos:  position{line: 16, col: 26, offset: 370},
+								id:   31,
 								name: "Statement",
 							},
 						},
@@ -109,33 +125,41 @@ var g = &grammar{
 		},
 		{
 			name: "ReturnOp",
-			pos:  position{line: 17, col: 1, offset: 452},
+			pos:  position{line: 17, col: 1, offset: 406},
+			id:   3,
 			expr: &actionExpr{
-				pos: position{line: 17, col: 15, offset: 468},
+				pos: position{line: 17, col: 15, offset: 422},
+				id:  32,
 				run: (*parser).callonReturnOp1,
 				expr: &seqExpr{
-					pos: position{line: 17, col: 15, offset: 468},
+					pos: position{line: 17, col: 15, offset: 422},
+					id:  33,
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 17, col: 15, offset: 468},
+							pos:        position{line: 17, col: 15, offset: 422},
+							id:         34,
 							val:        "return",
 							ignoreCase: false,
 							want:       "\"return\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 17, col: 24, offset: 477},
+							pos:  position{line: 17, col: 24, offset: 431},
+							id:   35,
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 17, col: 26, offset: 479},
+							pos:   position{line: 17, col: 26, offset: 433},
+							id:    36,
 							label: "arg",
 							expr: &ruleRefExpr{
-								pos:  position{line: 17, col: 30, offset: 483},
+								pos:  position{line: 17, col: 30, offset: 437},
+								id:   37,
 								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 17, col: 41, offset: 494},
+							pos:  position{line: 17, col: 41, offset: 448},
+							id:   38,
 							name: "EOL",
 						},
 					},
@@ -144,136 +168,176 @@ var g = &grammar{
 		},
 		{
 			name: "Statement",
-			pos:  position{line: 19, col: 1, offset: 545},
+			pos:  position{line: 19, col: 1, offset: 499},
+			id:   4,
 			expr: &choiceExpr{
-				pos: position{line: 19, col: 15, offset: 561},
+				pos: position{line: 19, col: 15, offset: 515},
+				id:  39,
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 19, col: 15, offset: 561},
+						pos: position{line: 19, col: 15, offset: 515},
+						id:  40,
 						run: (*parser).callonStatement2,
 						expr: &seqExpr{
-							pos: position{line: 19, col: 15, offset: 561},
+							pos: position{line: 19, col: 15, offset: 515},
+							id:  41,
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 19, col: 15, offset: 561},
+									pos:   position{line: 19, col: 15, offset: 515},
+									id:    42,
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 19, col: 17, offset: 563},
+										pos:  position{line: 19, col: 17, offset: 517},
+										id:   43,
 										name: "Assignment",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 19, col: 28, offset: 574},
+									pos:  position{line: 19, col: 28, offset: 528},
+									id:   44,
 									name: "EOL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 20, col: 7, offset: 631},
+						pos: position{line: 20, col: 7, offset: 585},
+						id:  45,
 						run: (*parser).callonStatement7,
 						expr: &seqExpr{
-							pos: position{line: 20, col: 7, offset: 631},
+							pos: position{line: 20, col: 7, offset: 585},
+							id:  46,
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 20, col: 7, offset: 631},
+									pos:        position{line: 20, col: 7, offset: 585},
+									id:         47,
 									val:        "if",
 									ignoreCase: false,
 									want:       "\"if\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 20, col: 12, offset: 636},
+									pos:  position{line: 20, col: 12, offset: 590},
+									id:   48,
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 20, col: 14, offset: 638},
+									pos:   position{line: 20, col: 14, offset: 592},
+									id:    49,
 									label: "arg",
 									expr: &ruleRefExpr{
-										pos:  position{line: 20, col: 18, offset: 642},
+										pos:  position{line: 20, col: 18, offset: 596},
+										id:   50,
 										name: "LogicalExpression",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 20, col: 36, offset: 660},
+									pos: position{line: 20, col: 36, offset: 614},
+									id:  51,
 									expr: &ruleRefExpr{
-										pos:  position{line: 20, col: 36, offset: 660},
+										pos:  position{line: 20, col: 36, offset: 614},
+										id:   52,
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 20, col: 39, offset: 663},
+									pos:        position{line: 20, col: 39, offset: 617},
+									id:         53,
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 20, col: 43, offset: 667},
+									pos:  position{line: 20, col: 43, offset: 621},
+									id:   54,
 									name: "EOL",
 								},
-								&ruleRefExpr{
-									pos:  position{line: 20, col: 47, offset: 671},
-									name: "INDENT",
+								&indentExpr{
+									pos:  position{line: 20, col: 47, offset: 625},
+									id:   55,
+									kind: indentIndent,
 								},
 								&labeledExpr{
-									pos:   position{line: 20, col: 54, offset: 678},
+									pos:   position{line: 20, col: 54, offset: 632},
+									id:    56,
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 20, col: 56, offset: 680},
+										pos:  position{line: 20, col: 56, offset: 634},
+										id:   57,
 										name: "Statements",
 									},
 								},
-								&ruleRefExpr{
-									pos:  position{line: 20, col: 67, offset: 691},
-									name: "DEDENT",
+								&indentExpr{
+									pos:  position{line: 20, col: 67, offset: 645},
+									id:   58,
+									kind: indentDedent,
 								},
 							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x02\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {0}, {0, 1}},
+					expected:  [][]string{{"[a-zA-Z]", "\"if\""}, {"\"if\""}, {}},
+				},
 			},
 		},
 		{
 			name: "Assignment",
-			pos:  position{line: 24, col: 1, offset: 818},
+			pos:  position{line: 24, col: 1, offset: 772},
+			id:   5,
 			expr: &actionExpr{
-				pos: position{line: 24, col: 14, offset: 833},
+				pos: position{line: 24, col: 14, offset: 787},
+				id:  59,
 				run: (*parser).callonAssignment1,
 				expr: &seqExpr{
-					pos: position{line: 24, col: 14, offset: 833},
+					pos: position{line: 24, col: 14, offset: 787},
+					id:  60,
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 24, col: 14, offset: 833},
+							pos:   position{line: 24, col: 14, offset: 787},
+							id:    61,
 							label: "lvalue",
 							expr: &ruleRefExpr{
-								pos:  position{line: 24, col: 21, offset: 840},
+								pos:  position{line: 24, col: 21, offset: 794},
+								id:   62,
 								name: "Identifier",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 24, col: 32, offset: 851},
+							pos: position{line: 24, col: 32, offset: 805},
+							id:  63,
 							expr: &ruleRefExpr{
-								pos:  position{line: 24, col: 32, offset: 851},
+								pos:  position{line: 24, col: 32, offset: 805},
+								id:   64,
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 24, col: 35, offset: 854},
+							pos:        position{line: 24, col: 35, offset: 808},
+							id:         65,
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 24, col: 39, offset: 858},
+							pos: position{line: 24, col: 39, offset: 812},
+							id:  66,
 							expr: &ruleRefExpr{
-								pos:  position{line: 24, col: 39, offset: 858},
+								pos:  position{line: 24, col: 39, offset: 812},
+								id:   67,
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 24, col: 42, offset: 861},
+							pos:   position{line: 24, col: 42, offset: 815},
+							id:    68,
 							label: "rvalue",
 							expr: &ruleRefExpr{
-								pos:  position{line: 24, col: 49, offset: 868},
+								pos:  position{line: 24, col: 49, offset: 822},
+								id:   69,
 								name: "AdditiveExpression",
 							},
 						},
@@ -283,15 +347,19 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalExpression",
-			pos:  position{line: 27, col: 1, offset: 1018},
+			pos:  position{line: 27, col: 1, offset: 972},
+			id:   6,
 			expr: &actionExpr{
-				pos: position{line: 27, col: 23, offset: 1042},
+				pos: position{line: 27, col: 23, offset: 996},
+				id:  70,
 				run: (*parser).callonLogicalExpression1,
 				expr: &labeledExpr{
-					pos:   position{line: 27, col: 23, offset: 1042},
+					pos:   position{line: 27, col: 23, offset: 996},
+					id:    71,
 					label: "arg",
 					expr: &ruleRefExpr{
-						pos:  position{line: 27, col: 27, offset: 1046},
+						pos:  position{line: 27, col: 27, offset: 1000},
+						id:   72,
 						name: "PrimaryExpression",
 					},
 				},
@@ -299,43 +367,55 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveExpression",
-			pos:  position{line: 28, col: 1, offset: 1129},
+			pos:  position{line: 28, col: 1, offset: 1083},
+			id:   7,
 			expr: &actionExpr{
-				pos: position{line: 28, col: 23, offset: 1153},
+				pos: position{line: 28, col: 23, offset: 1107},
+				id:  73,
 				run: (*parser).callonAdditiveExpression1,
 				expr: &seqExpr{
-					pos: position{line: 28, col: 23, offset: 1153},
+					pos: position{line: 28, col: 23, offset: 1107},
+					id:  74,
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 28, col: 23, offset: 1153},
+							pos:   position{line: 28, col: 23, offset: 1107},
+							id:    75,
 							label: "arg",
 							expr: &ruleRefExpr{
-								pos:  position{line: 28, col: 27, offset: 1157},
+								pos:  position{line: 28, col: 27, offset: 1111},
+								id:   76,
 								name: "PrimaryExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 28, col: 45, offset: 1175},
+							pos:   position{line: 28, col: 45, offset: 1129},
+							id:    77,
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 28, col: 50, offset: 1180},
+								pos: position{line: 28, col: 50, offset: 1134},
+								id:  78,
 								expr: &seqExpr{
-									pos: position{line: 28, col: 52, offset: 1182},
+									pos: position{line: 28, col: 52, offset: 1136},
+									id:  79,
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 28, col: 52, offset: 1182},
+											pos:  position{line: 28, col: 52, offset: 1136},
+											id:   80,
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 28, col: 54, offset: 1184},
+											pos:  position{line: 28, col: 54, offset: 1138},
+											id:   81,
 											name: "AddOp",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 28, col: 60, offset: 1190},
+											pos:  position{line: 28, col: 60, offset: 1144},
+											id:   82,
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 28, col: 62, offset: 1192},
+											pos:  position{line: 28, col: 62, offset: 1146},
+											id:   83,
 											name: "PrimaryExpression",
 										},
 									},
@@ -348,39 +428,56 @@ var g = &grammar{
 		},
 		{
 			name: "PrimaryExpression",
-			pos:  position{line: 30, col: 1, offset: 1328},
+			pos:  position{line: 30, col: 1, offset: 1282},
+			id:   8,
 			expr: &actionExpr{
-				pos: position{line: 30, col: 23, offset: 1352},
+				pos: position{line: 30, col: 23, offset: 1306},
+				id:  84,
 				run: (*parser).callonPrimaryExpression1,
 				expr: &labeledExpr{
-					pos:   position{line: 30, col: 23, offset: 1352},
+					pos:   position{line: 30, col: 23, offset: 1306},
+					id:    85,
 					label: "arg",
 					expr: &choiceExpr{
-						pos: position{line: 30, col: 28, offset: 1357},
+						pos: position{line: 30, col: 28, offset: 1311},
+						id:  86,
 						alternatives: []any{
 							&ruleRefExpr{
-								pos:  position{line: 30, col: 28, offset: 1357},
+								pos:  position{line: 30, col: 28, offset: 1311},
+								id:   87,
 								name: "Integer",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 30, col: 38, offset: 1367},
+								pos:  position{line: 30, col: 38, offset: 1321},
+								id:   88,
 								name: "Identifier",
 							},
 						},
+						dispatch: &choiceDispatch{
+							ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00",
+							ranges:    []rune{'\u0080'},
+							rangeSets: "\x00",
+							alts:      [][]int{{}, {0}, {1}},
+							expected:  [][]string{{"[0-9]", "[a-zA-Z]"}, {"[a-zA-Z]"}, {"[0-9]"}},
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "Integer",
-			pos:  position{line: 33, col: 1, offset: 1466},
+			pos:  position{line: 33, col: 1, offset: 1420},
+			id:   9,
 			expr: &actionExpr{
-				pos: position{line: 33, col: 11, offset: 1478},
+				pos: position{line: 33, col: 11, offset: 1432},
+				id:  89,
 				run: (*parser).callonInteger1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 33, col: 11, offset: 1478},
+					pos: position{line: 33, col: 11, offset: 1432},
+					id:  90,
 					expr: &charClassMatcher{
-						pos:        position{line: 33, col: 11, offset: 1478},
+						pos:        position{line: 33, col: 11, offset: 1432},
+						id:         91,
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -391,24 +488,30 @@ var g = &grammar{
 		},
 		{
 			name: "Identifier",
-			pos:  position{line: 34, col: 1, offset: 1554},
+			pos:  position{line: 34, col: 1, offset: 1508},
+			id:   10,
 			expr: &actionExpr{
-				pos: position{line: 34, col: 14, offset: 1569},
+				pos: position{line: 34, col: 14, offset: 1523},
+				id:  92,
 				run: (*parser).callonIdentifier1,
 				expr: &seqExpr{
-					pos: position{line: 34, col: 14, offset: 1569},
+					pos: position{line: 34, col: 14, offset: 1523},
+					id:  93,
 					exprs: []any{
 						&charClassMatcher{
-							pos:        position{line: 34, col: 14, offset: 1569},
+							pos:        position{line: 34, col: 14, offset: 1523},
+							id:         94,
 							val:        "[a-zA-Z]",
 							ranges:     []rune{'a', 'z', 'A', 'Z'},
 							ignoreCase: false,
 							inverted:   false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 34, col: 23, offset: 1578},
+							pos: position{line: 34, col: 23, offset: 1532},
+							id:  95,
 							expr: &charClassMatcher{
-								pos:        position{line: 34, col: 23, offset: 1578},
+								pos:        position{line: 34, col: 23, offset: 1532},
+								id:         96,
 								val:        "[a-zA-Z0-9]",
 								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
 								ignoreCase: false,
@@ -421,36 +524,51 @@ var g = &grammar{
 		},
 		{
 			name: "AddOp",
-			pos:  position{line: 36, col: 1, offset: 1646},
+			pos:  position{line: 36, col: 1, offset: 1600},
+			id:   11,
 			expr: &actionExpr{
-				pos: position{line: 36, col: 9, offset: 1656},
+				pos: position{line: 36, col: 9, offset: 1610},
+				id:  97,
 				run: (*parser).callonAddOp1,
 				expr: &choiceExpr{
-					pos: position{line: 36, col: 11, offset: 1658},
+					pos: position{line: 36, col: 11, offset: 1612},
+					id:  98,
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 36, col: 11, offset: 1658},
+							pos:        position{line: 36, col: 11, offset: 1612},
+							id:         99,
 							val:        "+",
 							ignoreCase: false,
 							want:       "\"+\"",
 						},
 						&litMatcher{
-							pos:        position{line: 36, col: 17, offset: 1664},
+							pos:        position{line: 36, col: 17, offset: 1618},
+							id:         100,
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
 						},
 					},
+					dispatch: &choiceDispatch{
+						ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+						ranges:    []rune{'\u0080'},
+						rangeSets: "\x00",
+						alts:      [][]int{{}, {0}, {1}},
+						expected:  [][]string{{"\"+\"", "\"-\""}, {"\"-\""}, {"\"+\""}},
+					},
 				},
 			},
 		},
 		{
 			name: "_",
-			pos:  position{line: 38, col: 1, offset: 1723},
+			pos:  position{line: 38, col: 1, offset: 1677},
+			id:   12,
 			expr: &oneOrMoreExpr{
-				pos: position{line: 38, col: 5, offset: 1729},
+				pos: position{line: 38, col: 5, offset: 1683},
+				id:  101,
 				expr: &charClassMatcher{
-					pos:        position{line: 38, col: 5, offset: 1729},
+					pos:        position{line: 38, col: 5, offset: 1683},
+					id:         102,
 					val:        "[ \\t]",
 					chars:      []rune{' ', '\t'},
 					ignoreCase: false,
@@ -460,76 +578,100 @@ var g = &grammar{
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 40, col: 1, offset: 1737},
+			pos:  position{line: 40, col: 1, offset: 1691},
+			id:   13,
 			expr: &seqExpr{
-				pos: position{line: 40, col: 7, offset: 1745},
+				pos: position{line: 40, col: 7, offset: 1699},
+				id:  103,
 				exprs: []any{
 					&zeroOrOneExpr{
-						pos: position{line: 40, col: 7, offset: 1745},
+						pos: position{line: 40, col: 7, offset: 1699},
+						id:  104,
 						expr: &ruleRefExpr{
-							pos:  position{line: 40, col: 7, offset: 1745},
+							pos:  position{line: 40, col: 7, offset: 1699},
+							id:   105,
 							name: "_",
 						},
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 40, col: 10, offset: 1748},
+						pos: position{line: 40, col: 10, offset: 1702},
+						id:  106,
 						expr: &ruleRefExpr{
-							pos:  position{line: 40, col: 10, offset: 1748},
+							pos:  position{line: 40, col: 10, offset: 1702},
+							id:   107,
 							name: "Comment",
 						},
 					},
 					&choiceExpr{
-						pos: position{line: 40, col: 20, offset: 1758},
+						pos: position{line: 40, col: 20, offset: 1712},
+						id:  108,
 						alternatives: []any{
 							&litMatcher{
-								pos:        position{line: 40, col: 20, offset: 1758},
+								pos:        position{line: 40, col: 20, offset: 1712},
+								id:         109,
 								val:        "\r\n",
 								ignoreCase: false,
 								want:       "\"\\r\\n\"",
 							},
 							&litMatcher{
-								pos:        position{line: 40, col: 29, offset: 1767},
+								pos:        position{line: 40, col: 29, offset: 1721},
+								id:         110,
 								val:        "\n\r",
 								ignoreCase: false,
 								want:       "\"\\n\\r\"",
 							},
 							&litMatcher{
-								pos:        position{line: 40, col: 38, offset: 1776},
+								pos:        position{line: 40, col: 38, offset: 1730},
+								id:         111,
 								val:        "\r",
 								ignoreCase: false,
 								want:       "\"\\r\"",
 							},
 							&litMatcher{
-								pos:        position{line: 40, col: 45, offset: 1783},
+								pos:        position{line: 40, col: 45, offset: 1737},
+								id:         112,
 								val:        "\n",
 								ignoreCase: false,
 								want:       "\"\\n\"",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 40, col: 52, offset: 1790},
+								pos:  position{line: 40, col: 52, offset: 1744},
+								id:   113,
 								name: "EOF",
 							},
 						},
+						dispatch: &choiceDispatch{
+							ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+							ranges:    []rune{'\u0080'},
+							rangeSets: "\x00",
+							alts:      [][]int{{4}, {1, 3, 4}, {0, 2, 4}},
+							expected:  [][]string{{"\"\\r\\n\"", "\"\\n\\r\"", "\"\\r\"", "\"\\n\""}, {"\"\\r\\n\"", "\"\\r\""}, {"\"\\n\\r\"", "\"\\n\""}},
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "Comment",
-			pos:  position{line: 42, col: 1, offset: 1796},
+			pos:  position{line: 42, col: 1, offset: 1750},
+			id:   14,
 			expr: &seqExpr{
-				pos: position{line: 42, col: 11, offset: 1808},
+				pos: position{line: 42, col: 11, offset: 1762},
+				id:  114,
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 42, col: 11, offset: 1808},
+						pos:        position{line: 42, col: 11, offset: 1762},
+						id:         115,
 						val:        "//",
 						ignoreCase: false,
 						want:       "\"//\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 42, col: 16, offset: 1813},
+						pos: position{line: 42, col: 16, offset: 1767},
+						id:  116,
 						expr: &charClassMatcher{
-							pos:        position{line: 42, col: 16, offset: 1813},
+							pos:        position{line: 42, col: 16, offset: 1767},
+							id:         117,
 							val:        "[^\\r\\n]",
 							chars:      []rune{'\r', '\n'},
 							ignoreCase: false,
@@ -541,70 +683,20 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 44, col: 1, offset: 1823},
+			pos:  position{line: 44, col: 1, offset: 1777},
+			id:   15,
 			expr: &notExpr{
-				pos: position{line: 44, col: 7, offset: 1831},
+				pos: position{line: 44, col: 7, offset: 1785},
+				id:  118,
 				expr: &anyMatcher{
-					line: 44, col: 8, offset: 1832,
+					pos: position{line: 44, col: 8, offset: 1786},
+					id:  119,
 				},
 			},
 		},
-		{
-			name: "INDENTATION",
-			pos:  position{line: 46, col: 1, offset: 1835},
-			expr: &seqExpr{
-				pos: position{line: 46, col: 15, offset: 1851},
-				exprs: []any{
-					&labeledExpr{
-						pos:   position{line: 46, col: 15, offset: 1851},
-						label: "spaces",
-						expr: &zeroOrMoreExpr{
-							pos: position{line: 46, col: 22, offset: 1858},
-							expr: &litMatcher{
-								pos:        position{line: 46, col: 22, offset: 1858},
-								val:        " ",
-								ignoreCase: false,
-								want:       "\" \"",
-							},
-						},
-					},
-					&andCodeExpr{
-						pos: position{line: 46, col: 27, offset: 1863},
-						run: (*parser).callonINDENTATION5,
-					},
-				},
-			},
-		},
-		{
-			name: "INDENT",
-			pos:  position{line: 48, col: 1, offset: 1937},
-			expr: &stateCodeExpr{
-				pos: position{line: 48, col: 10, offset: 1948},
-				run: (*parser).callonINDENT1,
-			},
-		},
-		{
-			name: "DEDENT",
-			pos:  position{line: 50, col: 1, offset: 2024},
-			expr: &stateCodeExpr{
-				pos: position{line: 50, col: 10, offset: 2035},
-				run: (*parser).callonDEDENT1,
-			},
-		},
 	},
 }
 
-func (c *current) onInput3() error {
-	c.state["Indentation"] = 0
-	return nil
-}
-
-func (p *parser) callonInput3() error {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onInput3()
-}
-
 func (c *current) onInput1(s, r any) (any, error) {
 	return newProgramNode(s.(StatementsNode), r.(ReturnNode))
 }
@@ -735,38 +827,6 @@ func (p *parser) callonAddOp1() (any, error) {
 	return p.cur.onAddOp1()
 }
 
-func (c *current) onINDENTATION5(spaces any) (bool, error) {
-	return len(toAnySlice(spaces)) == c.state["Indentation"].(int), nil
-}
-
-func (p *parser) callonINDENTATION5() (bool, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onINDENTATION5(stack["spaces"])
-}
-
-func (c *current) onINDENT1() error {
-	c.state["Indentation"] = c.state["Indentation"].(int) + 4
-	return nil
-}
-
-func (p *parser) callonINDENT1() error {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onINDENT1()
-}
-
-func (c *current) onDEDENT1() error {
-	c.state["Indentation"] = c.state["Indentation"].(int) - 4
-	return nil
-}
-
-func (p *parser) callonDEDENT1() error {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onDEDENT1()
-}
-
 var (
 	// errNoRule is returned when the grammar to parse has no rule.
 	errNoRule = errors.New("grammar has no rule")
@@ -782,6 +842,11 @@ var (
 	// errMaxExprCnt is used to signal that the maximum number of
 	// expressions have been parsed.
 	errMaxExprCnt = errors.New("max number of expressions parsed")
+
+	// errIndentTabs is added when the indentation matched by the built-in
+	// INDENT, DEDENT and SAMEDENT rules has tabs rejected by the Indentation
+	// option.
+	errIndentTabs = errors.New("invalid tabs in the indentation")
 )
 
 // Option is a function that can set an option on the parser. It returns
@@ -821,7 +886,8 @@ func Entrypoint(ruleName string) Option {
 
 // Statistics adds a user provided Stats struct to the parser to allow
 // the user to process the results after the parsing has finished.
-// Also the key for the "no match" counter is set.
+// Also the key for the "no match" counter is set. Per-rule statistics
+// (see RuleStats) are only collected when this option is set.
 //
 // Example usage:
 //
@@ -845,6 +911,9 @@ func Statistics(stats *Stats, choiceNoMatch string) Option {
 		if p.Stats.ChoiceAltCnt == nil {
 			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
 		}
+		if p.Stats.Rules == nil {
+			p.Stats.Rules = make(map[string]*RuleStats)
+		}
 		return Statistics(oldStats, oldChoiceNoMatch)
 	}
 }
@@ -865,6 +934,9 @@ func Debug(b bool) Option {
 // the parser will cache all results so each expression is evaluated only
 // once. This guarantees linear parsing time even for pathological cases,
 // at the expense of more memory and slower times for typical cases.
+// The rules annotated with @memo in the grammar are always memoized,
+// regardless of this option. The memoization table is pooled, so its
+// storage is reused by the following parses.
 //
 // The default is false.
 func Memoize(b bool) Option {
@@ -889,6 +961,86 @@ func AllowInvalidUTF8(b bool) Option {
 	}
 }
 
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
+// IndentTabs is the policy for the tabs in the indentation matched by the
+// built-in INDENT, DEDENT and SAMEDENT rules, see the Indentation option.
+type IndentTabs int
+
+const (
+	// IndentTabsExpand advances the width of the indentation to the next
+	// tab stop for each tab.
+	IndentTabsExpand IndentTabs = iota
+	// IndentTabsNoMix rejects the indentation that has both tabs and
+	// spaces, the tabs are expanded otherwise.
+	IndentTabsNoMix
+	// IndentTabsReject rejects the indentation that has tabs.
+	IndentTabsReject
+)
+
+// Indentation creates an Option to set the width of a tab and the policy
+// for the tabs in the indentation matched by the built-in INDENT, DEDENT
+// and SAMEDENT rules. A rejected indentation does not match and adds an
+// error.
+//
+// The default is a tab width of 8 and IndentTabsExpand.
+func Indentation(tabWidth int, tabs IndentTabs) Option {
+	return func(p *parser) Option {
+		oldTabWidth, oldTabs := p.indentTabWidth, p.indentTabs
+		p.indentTabWidth, p.indentTabs = tabWidth, tabs
+		return Indentation(oldTabWidth, oldTabs)
+	}
+}
+
 // Recover creates an Option to set the recover flag to b. When set to
 // true, this causes the parser to recover from panics and convert it
 // to an error. Setting it to false can be useful while debugging to
@@ -969,6 +1121,8 @@ type savepoint struct {
 	position
 	rn rune
 	w  int
+	// indent is the indentation stack, see indentLevel.
+	indent *indentLevel
 }
 
 type current struct {
@@ -1004,17 +1158,68 @@ type rule struct {
 	name        string
 	displayName string
 	expr        any
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+//
+//	nolint: structcheck
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
 }
 
 // nolint: structcheck
 type choiceExpr struct {
 	pos          position
+	id           int
 	alternatives []any
+	dispatch     *choiceDispatch
 }
 
 // nolint: structcheck
 type actionExpr struct {
 	pos  position
+	id   int
 	expr any
 	run  func(*parser) (any, error)
 }
@@ -1022,6 +1227,7 @@ type actionExpr struct {
 // nolint: structcheck
 type recoveryExpr struct {
 	pos          position
+	id           int
 	expr         any
 	recoverExpr  any
 	failureLabel []string
@@ -1030,18 +1236,14 @@ type recoveryExpr struct {
 // nolint: structcheck
 type seqExpr struct {
 	pos   position
+	id    int
 	exprs []any
 }
 
-// nolint: structcheck
-type throwExpr struct {
-	pos   position
-	label string
-}
-
 // nolint: structcheck
 type labeledExpr struct {
 	pos   position
+	id    int
 	label string
 	expr  any
 }
@@ -1049,9 +1251,20 @@ type labeledExpr struct {
 // nolint: structcheck
 type expr struct {
 	pos  position
+	id   int
 	expr any
 }
 
+// nolint: structcheck
+type repeatExpr struct {
+	pos  position
+	id   int
+	expr any
+	// max is -1 if the number of repetitions is not bounded.
+	min int
+	max int
+}
+
 type (
 	andExpr        expr // nolint: structcheck
 	notExpr        expr // nolint: structcheck
@@ -1063,30 +1276,35 @@ type (
 // nolint: structcheck
 type ruleRefExpr struct {
 	pos  position
+	id   int
 	name string
 }
 
 // nolint: structcheck
 type stateCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) error
 }
 
 // nolint: structcheck
 type andCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) (bool, error)
 }
 
 // nolint: structcheck
 type notCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) (bool, error)
 }
 
 // nolint: structcheck
 type litMatcher struct {
 	pos        position
+	id         int
 	val        string
 	ignoreCase bool
 	want       string
@@ -1095,6 +1313,7 @@ type litMatcher struct {
 // nolint: structcheck
 type charClassMatcher struct {
 	pos             position
+	id              int
 	val             string
 	basicLatinChars [128]bool
 	chars           []rune
@@ -1104,7 +1323,46 @@ type charClassMatcher struct {
 	inverted        bool
 }
 
-type anyMatcher position // nolint: structcheck
+// nolint: structcheck
+type anyMatcher struct {
+	pos position
+	id  int
+}
+
+// indentExpr is a reference to a built-in indentation rule.
+//
+//	nolint: structcheck
+type indentExpr struct {
+	pos  position
+	id   int
+	kind indentKind
+}
+
+// indentKind is the kind of the built-in indentation rules.
+type indentKind int
+
+const (
+	indentIndent indentKind = iota
+	indentDedent
+	indentSame
+)
+
+// indentNames are the names of the built-in indentation rules.
+var indentNames = [...]string{
+	indentIndent: "INDENT",
+	indentDedent: "DEDENT",
+	indentSame:   "SAMEDENT",
+}
+
+// indentLevel is the top of the indentation stack of the parser, the
+// width of the current indentation. The stack is never modified, a level
+// is pushed with a new indentLevel so that the savepoints record the stack
+// and restore it on backtracking. The nil stack is the indentation of
+// width 0.
+type indentLevel struct {
+	width int
+	prev  *indentLevel
+}
 
 // errList cumulates the errors found by the parser.
 type errList []error
@@ -1186,9 +1444,11 @@ func newParser(filename string, b []byte, opts ...Option) *parser {
 		maxFailExpected: make([]string, 0, 20),
 		Stats:           &stats,
 		// start rule is rule [0] unless an alternate entrypoint is specified
-		entrypoint: g.rules[0].name,
+		entrypoint:     g.rules[0].name,
+		indentTabWidth: 8,
 	}
 	p.setOptions(opts)
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
 
 	if p.maxExprCnt == 0 {
 		p.maxExprCnt = math.MaxUint64
@@ -1209,6 +1469,9 @@ type resultTuple struct {
 	v   any
 	b   bool
 	end savepoint
+	// the indentation stack at the start of the result, which depends on
+	// it.
+	indent *indentLevel
 }
 
 // nolint: varcheck
@@ -1224,7 +1487,8 @@ type Stats struct {
 	// ChoiceAltCnt is used to count for each ordered choice expression,
 	// which alternative is used how may times.
 	// These numbers allow to optimize the order of the ordered choice expression
-	// to increase the performance of the parser
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
 	//
 	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
 	// as the line and the column of the ordered choice.
@@ -1234,6 +1498,46 @@ type Stats struct {
 	// the parser option Statistics.
 	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
 	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
 }
 
 // nolint: structcheck,maligned
@@ -1250,9 +1554,9 @@ type parser struct {
 	debug   bool
 
 	memoize bool
-	// memoization table for the packrat algorithm:
-	// map[offset in source] map[expression or rule] {value, match}
-	memo map[int]map[any]resultTuple
+	// memoization table for the packrat algorithm, taken from memoTables
+	// on the first memoized result
+	memo *memoTable
 
 	// rules table, maps the rule identifier to the rule node
 	rules map[string]*rule
@@ -1273,11 +1577,39 @@ type parser struct {
 
 	allowInvalidUTF8 bool
 
+	// tabs options of the indentation, and offsets of the indentations
+	// whose tabs were rejected, to add the error once.
+	indentTabWidth int
+	indentTabs     IndentTabs
+	indentErrs     map[int]bool
+
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+
 	*Stats
+	// profiling frames, tracks the time spent in invoked rules to compute
+	// the self time of each rule.
+	profStack []profFrame
+	// number of active invocations of each rule, the time of a recursive
+	// rule is only added at its outermost invocation.
+	profActive map[*rule]int
+	// rules evaluated at each offset, to count the repeated evaluations.
+	profEvals map[profEval]bool
 
 	choiceNoMatch string
 	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
-	recoveryStack []map[string]any
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
 }
 
 // push a variable set on the vstack.
@@ -1316,7 +1648,7 @@ func (p *parser) popV() {
 func (p *parser) pushRecovery(labels []string, expr any) {
 	if cap(p.recoveryStack) == len(p.recoveryStack) {
 		// create new empty slot in the stack
-		p.recoveryStack = append(p.recoveryStack, nil)
+		p.recoveryStack = append(p.recoveryStack, recovery{})
 	} else {
 		// slice to 1 more
 		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
@@ -1326,13 +1658,13 @@ func (p *parser) pushRecovery(labels []string, expr any) {
 	for _, fl := range labels {
 		m[fl] = expr
 	}
-	p.recoveryStack[len(p.recoveryStack)-1] = m
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
 }
 
 // pop a recovery expression from the recoveryStack
 func (p *parser) popRecovery() {
 	// GC that map
-	p.recoveryStack[len(p.recoveryStack)-1] = nil
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
 
 	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
 }
@@ -1342,8 +1674,9 @@ func (p *parser) print(prefix, s string) string {
 		return s
 	}
 
+	pos := p.pt.position
 	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
-		prefix, p.pt.line, p.pt.col, p.pt.offset, s, p.pt.rn)
+		prefix, pos.line, pos.col, pos.offset, s, p.pt.rn)
 	return s
 }
 
@@ -1411,14 +1744,19 @@ func (p *parser) failAt(fail bool, pos position, want string) {
 
 // read advances the parser to the next rune.
 func (p *parser) read() {
-	p.pt.offset += p.pt.w
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
 	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
 	p.pt.rn = rn
 	p.pt.w = n
-	p.pt.col++
-	if rn == '\n' {
-		p.pt.line++
-		p.pt.col = 0
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
 	}
 
 	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
@@ -1428,14 +1766,140 @@ func (p *parser) read() {
 	}
 }
 
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
+// matchIndent matches the built-in indentation rule of kind. INDENT
+// matches if the indentation at the current position is wider than the
+// current one and pushes it on the indentation stack, DEDENT matches if it
+// is narrower and is one of the enclosing indentations and pops the
+// current one. Neither consumes any input. SAMEDENT matches if the
+// indentation is the current one and consumes it. Their value is the
+// matched text.
+func (p *parser) matchIndent(kind indentKind) (any, bool) {
+	start := p.pt
+	width, end, ok := p.indentation()
+	cur := p.pt.indent.indentWidth()
+	switch kind {
+	case indentIndent:
+		ok = ok && width > cur
+		if ok {
+			p.pt.indent = &indentLevel{width: width, prev: p.pt.indent}
+		}
+	case indentDedent:
+		ok = ok && width < cur && p.pt.indent.prev.encloses(width)
+		if ok {
+			p.pt.indent = p.pt.indent.prev
+		}
+	case indentSame:
+		ok = ok && width == cur
+		for ok && p.pt.offset < end {
+			p.read()
+		}
+	}
+	p.failAt(ok, start.position, indentNames[kind])
+	if !ok {
+		return nil, false
+	}
+	return p.sliceFrom(start), true
+}
+
+// indentation returns the width of the spaces and tabs at the current
+// position and the offset that follows them. It returns false if the tabs
+// are rejected by the Indentation option.
+func (p *parser) indentation() (width, end int, ok bool) {
+	tabWidth := p.indentTabWidth
+	if tabWidth < 1 {
+		tabWidth = 1
+	}
+	var spaces, tabs bool
+	end = p.pt.offset
+scan:
+	for ; end < len(p.data); end++ {
+		switch p.data[end] {
+		case ' ':
+			width++
+			spaces = true
+		case '\t':
+			width += tabWidth - width%tabWidth
+			tabs = true
+		default:
+			break scan
+		}
+	}
+
+	if tabs && (p.indentTabs == IndentTabsReject || spaces && p.indentTabs == IndentTabsNoMix) {
+		if !p.indentErrs[p.pt.offset] {
+			if p.indentErrs == nil {
+				p.indentErrs = make(map[int]bool)
+			}
+			p.indentErrs[p.pt.offset] = true
+			p.addErr(errIndentTabs)
+		}
+		return 0, end, false
+	}
+	return width, end, true
+}
+
+// indentWidth returns the width of the indentation l.
+func (l *indentLevel) indentWidth() int {
+	if l == nil {
+		return 0
+	}
+	return l.width
+}
+
+// encloses returns true if width is the width of l or of one of the
+// levels below it.
+func (l *indentLevel) encloses(width int) bool {
+	for ; l != nil && l.width >= width; l = l.prev {
+		if l.width == width {
+			return true
+		}
+	}
+	return l == nil && width == 0
+}
+
 // restore parser position to the savepoint pt.
 func (p *parser) restore(pt savepoint) {
 	if p.debug {
 		defer p.out(p.in("restore"))
 	}
 	if pt.offset == p.pt.offset {
+		// the indentation rules change the stack without consuming input
+		p.pt.indent = pt.indent
 		return
 	}
+	if p.Rules != nil && pt.offset < p.pt.offset && len(p.rstack) > 0 {
+		p.ruleStats(p.rstack[len(p.rstack)-1]).Backtracked += uint64(p.pt.offset - pt.offset)
+	}
 	p.pt = pt
 }
 
@@ -1493,28 +1957,111 @@ func (p *parser) sliceFrom(start savepoint) []byte {
 	return p.data[start.position.offset:p.pt.position.offset]
 }
 
-func (p *parser) getMemoized(node any) (resultTuple, bool) {
-	if len(p.memo) == 0 {
-		return resultTuple{}, false
+// memoSlot is an entry of the memoization table, the key of an empty slot
+// is 0.
+type memoSlot struct {
+	key uint64
+	res resultTuple
+}
+
+// memoTable is the memoization table for the packrat algorithm, an
+// open-addressed hash table with linear probing that maps the offset in
+// the source and the id of a rule or expression to its result.
+type memoTable struct {
+	slots []memoSlot
+	shift uint
+	n     int
+}
+
+// memoTables pools the memoization tables so that their storage is
+// reused across parses.
+var memoTables = &sync.Pool{
+	New: func() any {
+		return &memoTable{slots: make([]memoSlot, 1<<10), shift: 64 - 10}
+	},
+}
+
+// memoKey returns the key of the result of the rule or expression id at
+// offset, it supports up to 1<<24 ids.
+func memoKey(offset, id int) uint64 {
+	return uint64(offset+1)<<24 | uint64(id)
+}
+
+// index returns the index of the slot of key, or of the empty slot where
+// it should be stored.
+func (t *memoTable) index(key uint64) int {
+	mask := len(t.slots) - 1
+	i := int((key*0x9E3779B97F4A7C15)>>t.shift) & mask
+	for t.slots[i].key != 0 && t.slots[i].key != key {
+		i = (i + 1) & mask
+	}
+	return i
+}
+
+func (t *memoTable) get(key uint64) (resultTuple, bool) {
+	s := &t.slots[t.index(key)]
+	return s.res, s.key == key
+}
+
+func (t *memoTable) set(key uint64, res resultTuple) {
+	if 4*(t.n+1) > 3*len(t.slots) {
+		t.grow()
+	}
+	s := &t.slots[t.index(key)]
+	if s.key == 0 {
+		s.key = key
+		t.n++
+	}
+	s.res = res
+}
+
+// grow doubles the capacity of the table.
+func (t *memoTable) grow() {
+	old := t.slots
+	t.slots = make([]memoSlot, 2*len(old))
+	t.shift--
+	for _, s := range old {
+		if s.key != 0 {
+			t.slots[t.index(s.key)] = s
+		}
+	}
+}
+
+// reset empties the table, releasing the references to the results.
+func (t *memoTable) reset() {
+	if t.n == 0 {
+		return
 	}
-	m := p.memo[p.pt.offset]
-	if len(m) == 0 {
+	for i := range t.slots {
+		t.slots[i] = memoSlot{}
+	}
+	t.n = 0
+}
+
+func (p *parser) getMemoized(id int) (resultTuple, bool) {
+	if p.memo == nil {
 		return resultTuple{}, false
 	}
-	res, ok := m[node]
-	return res, ok
+	res, ok := p.memo.get(memoKey(p.pt.offset, id))
+	return res, ok && res.indent == p.pt.indent
 }
 
-func (p *parser) setMemoized(pt savepoint, node any, tuple resultTuple) {
+func (p *parser) setMemoized(pt savepoint, id int, tuple resultTuple) {
 	if p.memo == nil {
-		p.memo = make(map[int]map[any]resultTuple)
+		p.memo = memoTables.Get().(*memoTable)
 	}
-	m := p.memo[pt.offset]
-	if m == nil {
-		m = make(map[any]resultTuple)
-		p.memo[pt.offset] = m
+	tuple.indent = pt.indent
+	p.memo.set(memoKey(pt.offset, id), tuple)
+}
+
+// releaseMemo returns the memoization table to the pool.
+func (p *parser) releaseMemo() {
+	if p.memo == nil {
+		return
 	}
-	m[node] = tuple
+	p.memo.reset()
+	memoTables.Put(p.memo)
+	p.memo = nil
 }
 
 func (p *parser) buildRulesTable(g *grammar) {
@@ -1533,6 +2080,7 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 
 	// TODO : not super critical but this could be generated
 	p.buildRulesTable(g)
+	defer p.releaseMemo()
 
 	if p.recover {
 		// panic can be used in action code to stop parsing immediately
@@ -1566,24 +2114,7 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 		if len(*p.errs) == 0 {
 			// If parsing fails, but no errors have been recorded, the expected values
 			// for the farthest parser position are returned as error.
-			maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
-			for _, v := range p.maxFailExpected {
-				maxFailExpectedMap[v] = struct{}{}
-			}
-			expected := make([]string, 0, len(maxFailExpectedMap))
-			eof := false
-			if _, ok := maxFailExpectedMap["!."]; ok {
-				delete(maxFailExpectedMap, "!.")
-				eof = true
-			}
-			for k := range maxFailExpectedMap {
-				expected = append(expected, k)
-			}
-			sort.Strings(expected)
-			if eof {
-				expected = append(expected, "EOF")
-			}
-			p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+			p.addNoMatchErr()
 		}
 
 		return nil, p.errs.err()
@@ -1591,6 +2122,36 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 	return val, p.errs.err()
 }
 
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
 func listJoin(list []string, sep string, lastSep string) string {
 	switch len(list) {
 	case 0:
@@ -1602,8 +2163,90 @@ func listJoin(list []string, sep string, lastSep string) string {
 	}
 }
 
+// profFrame is a rule invocation being profiled.
+type profFrame struct {
+	start time.Time
+	child time.Duration
+}
+
+// profEval is the evaluation of a rule at an offset.
+type profEval struct {
+	rule   *rule
+	offset int
+}
+
+// ruleStats returns the statistics of rule, creating them if needed.
+func (p *parser) ruleStats(rule *rule) *RuleStats {
+	rs := p.Rules[rule.name]
+	if rs == nil {
+		rs = &RuleStats{}
+		p.Rules[rule.name] = rs
+	}
+	return rs
+}
+
+func (p *parser) countMemo(rs *RuleStats, hit bool) {
+	if hit {
+		rs.MemoHits++
+	} else {
+		rs.MemoMisses++
+	}
+}
+
+func (p *parser) profEnter(r *rule) {
+	if p.profActive == nil {
+		p.profActive = make(map[*rule]int)
+	}
+	p.profActive[r]++
+	p.profStack = append(p.profStack, profFrame{start: time.Now()})
+}
+
+// countRepeat counts the evaluation of rule at the current offset if
+// it was already evaluated at that offset.
+func (p *parser) countRepeat(rule *rule) {
+	if p.profEvals == nil {
+		p.profEvals = make(map[profEval]bool)
+	}
+	key := profEval{rule: rule, offset: p.pt.offset}
+	if p.profEvals[key] {
+		p.ruleStats(rule).Repeats++
+		return
+	}
+	p.profEvals[key] = true
+}
+
+func (p *parser) profExit(rule *rule, start savepoint, ok bool) {
+	frame := p.profStack[len(p.profStack)-1]
+	p.profStack = p.profStack[:len(p.profStack)-1]
+	elapsed := time.Since(frame.start)
+	if n := len(p.profStack); n > 0 {
+		p.profStack[n-1].child += elapsed
+	}
+
+	rs := p.ruleStats(rule)
+	rs.Invocations++
+	if p.profActive[rule]--; p.profActive[rule] == 0 {
+		// the time of the inner invocations is included
+		rs.Time += elapsed
+	}
+	rs.SelfTime += elapsed - frame.child
+	if ok {
+		rs.Matches++
+		rs.Bytes += uint64(p.pt.offset - start.offset)
+	} else {
+		rs.Failures++
+	}
+}
+
 func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
-	res, ok := p.getMemoized(rule)
+	if rule.id < 0 {
+		// its results depend on the captured texts or the state store
+		return p.parseRule(rule)
+	}
+	res, ok := p.getMemoized(rule.id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(rule), ok)
+	}
 	if ok {
 		p.restore(res.end)
 		return res.v, res.b
@@ -1611,7 +2254,7 @@ func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
 
 	startMark := p.pt
 	val, ok := p.parseRule(rule)
-	p.setMemoized(startMark, rule, resultTuple{val, ok, p.pt})
+	p.setMemoized(startMark, rule.id, resultTuple{v: val, b: ok, end: p.pt})
 
 	return val, ok
 }
@@ -1626,6 +2269,11 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 		startMark = p.pt
 	)
 
+	if p.Rules != nil {
+		p.profEnter(rule)
+		defer func() { p.profExit(rule, startMark, ok) }()
+	}
+
 	if p.memoize {
 		val, ok = p.parseRuleMemoize(rule)
 	} else {
@@ -1639,6 +2287,9 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 }
 
 func (p *parser) parseRule(rule *rule) (any, bool) {
+	if p.Rules != nil {
+		p.countRepeat(rule)
+	}
 	p.rstack = append(p.rstack, rule)
 	p.pushV()
 	val, ok := p.parseExprWrap(rule.expr)
@@ -1647,11 +2298,32 @@ func (p *parser) parseRule(rule *rule) (any, bool) {
 	return val, ok
 }
 
+// incChoiceAltCnt counts the match of the alternative altI of the choice
+// at pos, or its failure if altI is choiceNoMatch.
+func (p *parser) incChoiceAltCnt(pos position, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, pos.line, pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
 func (p *parser) parseExprWrap(expr any) (any, bool) {
 	var pt savepoint
+	id := exprID(expr)
 
-	if p.memoize {
-		res, ok := p.getMemoized(expr)
+	if p.memoize && id >= 0 {
+		res, ok := p.getMemoized(id)
+		if p.Rules != nil {
+			p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+		}
 		if ok {
 			p.restore(res.end)
 			return res.v, res.b
@@ -1661,12 +2333,62 @@ func (p *parser) parseExprWrap(expr any) (any, bool) {
 
 	val, ok := p.parseExpr(expr)
 
-	if p.memoize {
-		p.setMemoized(pt, expr, resultTuple{val, ok, p.pt})
+	if p.memoize && id >= 0 {
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
 	}
 	return val, ok
 }
 
+// exprID returns the id of the expression in the memoization table, -1 if
+// its results depend on the texts captured by the labels or on the state
+// store and are not memoized.
+//
+//	nolint: gocyclo
+func exprID(expr any) int {
+	switch expr := expr.(type) {
+	case *actionExpr:
+		return expr.id
+	case *andCodeExpr:
+		return expr.id
+	case *andExpr:
+		return expr.id
+	case *anyMatcher:
+		return expr.id
+	case *charClassMatcher:
+		return expr.id
+	case *choiceExpr:
+		return expr.id
+	case *labeledExpr:
+		return expr.id
+	case *litMatcher:
+		return expr.id
+	case *notCodeExpr:
+		return expr.id
+	case *notExpr:
+		return expr.id
+	case *oneOrMoreExpr:
+		return expr.id
+	case *recoveryExpr:
+		return expr.id
+	case *repeatExpr:
+		return expr.id
+	case *ruleRefExpr:
+		return expr.id
+	case *seqExpr:
+		return expr.id
+	case *stateCodeExpr:
+		return expr.id
+	case *zeroOrMoreExpr:
+		return expr.id
+	case *zeroOrOneExpr:
+		return expr.id
+	case *indentExpr:
+		return expr.id
+	default:
+		panic(fmt.Sprintf("unknown expression type %T", expr))
+	}
+}
+
 // nolint: gocyclo
 func (p *parser) parseExpr(expr any) (any, bool) {
 	p.ExprCnt++
@@ -1701,14 +2423,16 @@ func (p *parser) parseExpr(expr any) (any, bool) {
 		val, ok = p.parseOneOrMoreExpr(expr)
 	case *recoveryExpr:
 		val, ok = p.parseRecoveryExpr(expr)
+	case *repeatExpr:
+		val, ok = p.parseRepeatExpr(expr)
 	case *ruleRefExpr:
 		val, ok = p.parseRuleRefExpr(expr)
+	case *indentExpr:
+		val, ok = p.parseIndentExpr(expr)
 	case *seqExpr:
 		val, ok = p.parseSeqExpr(expr)
 	case *stateCodeExpr:
 		val, ok = p.parseStateCodeExpr(expr)
-	case *throwExpr:
-		val, ok = p.parseThrowExpr(expr)
 	case *zeroOrMoreExpr:
 		val, ok = p.parseZeroOrMoreExpr(expr)
 	case *zeroOrOneExpr:
@@ -1792,6 +2516,14 @@ func (p *parser) parseAnyMatcher(any *anyMatcher) (any, bool) {
 	return p.sliceFrom(start), true
 }
 
+func (p *parser) parseIndentExpr(ind *indentExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseIndentExpr " + indentNames[ind.kind]))
+	}
+
+	return p.matchIndent(ind.kind)
+}
+
 // nolint: gocyclo
 func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
 	if p.debug {
@@ -1859,29 +2591,29 @@ func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
 	return nil, false
 }
 
-func (p *parser) incChoiceAltCnt(ch *choiceExpr, altI int) {
-	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, ch.pos.line, ch.pos.col)
-	m := p.ChoiceAltCnt[choiceIdent]
-	if m == nil {
-		m = make(map[string]int)
-		p.ChoiceAltCnt[choiceIdent] = m
-	}
-	// We increment altI by 1, so the keys do not start at 0
-	alt := strconv.Itoa(altI + 1)
-	if altI == choiceNoMatch {
-		alt = p.choiceNoMatch
-	}
-	m[alt]++
-}
-
 func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseChoiceExpr"))
 	}
 
-	for altI, alt := range ch.alternatives {
-		// dummy assignment to prevent compile error if optimized
-		_ = altI
+	n := len(ch.alternatives)
+	var alts []int
+	if d := ch.dispatch; d != nil {
+		// the alternatives that cannot match the current rune are skipped,
+		// with the values they expect.
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		alts = d.alts[set]
+		n = len(alts)
+	}
+	for i := 0; i < n; i++ {
+		altI := i
+		if alts != nil {
+			altI = alts[i]
+		}
+		alt := ch.alternatives[altI]
 
 		state := p.cloneState()
 
@@ -1889,12 +2621,12 @@ func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 		val, ok := p.parseExprWrap(alt)
 		p.popV()
 		if ok {
-			p.incChoiceAltCnt(ch, altI)
+			p.incChoiceAltCnt(ch.pos, altI)
 			return val, ok
 		}
 		p.restoreState(state)
 	}
-	p.incChoiceAltCnt(ch, choiceNoMatch)
+	p.incChoiceAltCnt(ch.pos, choiceNoMatch)
 	return nil, false
 }
 
@@ -2003,6 +2735,33 @@ func (p *parser) parseRecoveryExpr(recover *recoveryExpr) (any, bool) {
 	return val, ok
 }
 
+func (p *parser) parseRepeatExpr(expr *repeatExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRepeatExpr"))
+	}
+
+	var vals []any
+
+	pt := p.pt
+	state := p.cloneState()
+	for expr.max < 0 || len(vals) < expr.max {
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			break
+		}
+		vals = append(vals, val)
+	}
+	if len(vals) < expr.min {
+		// did not match enough times, no match
+		p.restoreState(state)
+		p.restore(pt)
+		return nil, false
+	}
+	return vals, true
+}
+
 func (p *parser) parseRuleRefExpr(ref *ruleRefExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseRuleRefExpr " + ref.name))
@@ -2053,22 +2812,6 @@ func (p *parser) parseStateCodeExpr(state *stateCodeExpr) (any, bool) {
 	return nil, true
 }
 
-func (p *parser) parseThrowExpr(expr *throwExpr) (any, bool) {
-	if p.debug {
-		defer p.out(p.in("parseThrowExpr"))
-	}
-
-	for i := len(p.recoveryStack) - 1; i >= 0; i-- {
-		if recoverExpr, ok := p.recoveryStack[i][expr.label]; ok {
-			if val, ok := p.parseExprWrap(recoverExpr); ok {
-				return val, ok
-			}
-		}
-	}
-
-	return nil, false
-}
-
 func (p *parser) parseZeroOrMoreExpr(expr *zeroOrMoreExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseZeroOrMoreExpr"))
@@ -0,0 +1,2650 @@
+// Code generated by pigeon; DO NOT EDIT.
+
+// Package json parses JSON as defined by [1].
+//
+// BUGS: the escaped forward solidus (`\/`) is not currently handled.
+//
+// [1]: http://www.ecma-international.org/publications/files/ECMA-ST/ECMA-404.pdf
+package json
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+func toAnySlice(v any) []any {
+	if v == nil {
+		return nil
+	}
+	return v.([]any)
+}
+
+var g = &grammar{
+	rules: []*rule{
+		{
+			name: "JSON",
+			pos:  position{line: 17, col: 1, offset: 321},
+		},
+		{
+			name: "Value",
+			pos:  position{line: 21, col: 1, offset: 371},
+		},
+		{
+			name: "Object",
+			pos:  position{line: 25, col: 1, offset: 463},
+		},
+		{
+			name: "Array",
+			pos:  position{line: 40, col: 1, offset: 871},
+		},
+		{
+			name: "Number",
+			pos:  position{line: 54, col: 1, offset: 1204},
+		},
+		{
+			name: "Integer",
+			pos:  position{line: 60, col: 1, offset: 1406},
+		},
+		{
+			name: "Exponent",
+			pos:  position{line: 62, col: 1, offset: 1459},
+		},
+		{
+			name: "String",
+			pos:  position{line: 64, col: 1, offset: 1498},
+		},
+		{
+			name: "EscapedChar",
+			pos:  position{line: 69, col: 1, offset: 1673},
+		},
+		{
+			name: "EscapeSequence",
+			pos:  position{line: 71, col: 1, offset: 1705},
+		},
+		{
+			name: "SingleCharEscape",
+			pos:  position{line: 73, col: 1, offset: 1758},
+		},
+		{
+			name: "UnicodeEscape",
+			pos:  position{line: 75, col: 1, offset: 1792},
+		},
+		{
+			name: "DecimalDigit",
+			pos:  position{line: 77, col: 1, offset: 1851},
+		},
+		{
+			name: "NonZeroDecimalDigit",
+			pos:  position{line: 79, col: 1, offset: 1875},
+		},
+		{
+			name: "HexDigit",
+			pos:  position{line: 81, col: 1, offset: 1906},
+		},
+		{
+			name: "Bool",
+			pos:  position{line: 83, col: 1, offset: 1930},
+		},
+		{
+			name: "Null",
+			pos:  position{line: 85, col: 1, offset: 2000},
+		},
+		{
+			name:        "_",
+			displayName: "\"whitespace\"",
+			pos:         position{line: 87, col: 1, offset: 2037},
+		},
+		{
+			name: "EOF",
+			pos:  position{line: 89, col: 1, offset: 2068},
+		},
+	},
+}
+
+func init() {
+	g.rules[0].run = (*parser).expr19
+	g.rules[1].run = (*parser).expr25
+	g.rules[2].run = (*parser).expr36
+	g.rules[3].run = (*parser).expr58
+	g.rules[4].run = (*parser).expr72
+	g.rules[5].run = (*parser).expr84
+	g.rules[6].run = (*parser).expr90
+	g.rules[7].run = (*parser).expr96
+	g.rules[8].run = (*parser).expr109
+	g.rules[9].run = (*parser).expr110
+	g.rules[10].run = (*parser).expr113
+	g.rules[11].run = (*parser).expr114
+	g.rules[12].run = (*parser).expr120
+	g.rules[13].run = (*parser).expr121
+	g.rules[14].run = (*parser).expr122
+	g.rules[15].run = (*parser).expr123
+	g.rules[16].run = (*parser).expr128
+	g.rules[17].run = (*parser).expr130
+	g.rules[18].run = (*parser).expr132
+}
+
+func (p *parser) expr19() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		start1 := p.pt
+		{
+			p.countExpr()
+			pt2 := p.pt
+			var match3 bool
+			{
+				p.countExpr()
+				_, match3 = p.parseRuleWrap(g.rules[17])
+			}
+			if match3 {
+				var match4 bool
+				{
+					p.countExpr()
+					var v5 any
+					p.pushV()
+					{
+						p.countExpr()
+						v5, match4 = p.parseRuleWrap(g.rules[1])
+					}
+					p.popV()
+					if match4 {
+						p.vstack[len(p.vstack)-1]["val"] = v5
+						_ = v5
+					}
+				}
+				if match4 {
+					var match6 bool
+					{
+						p.countExpr()
+						_, match6 = p.parseRuleWrap(g.rules[18])
+					}
+					if match6 {
+						ok = true
+					}
+				}
+			}
+			if !ok {
+				p.restore(pt2)
+			}
+		}
+		if ok {
+			p.cur.pos = start1.position
+			p.cur.text = p.sliceFrom(start1)
+			actVal, err := p.callonJSON1()
+			if err != nil {
+				p.addErrAt(err, start1.position, []string{})
+			}
+			val = actVal
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr25() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		start1 := p.pt
+		{
+			p.countExpr()
+			pt2 := p.pt
+			var match3 bool
+			{
+				p.countExpr()
+				var v4 any
+				p.pushV()
+				{
+					p.countExpr()
+					d := expr28Dispatch
+					set := d.set(p.pt)
+					for _, want := range d.expected[set] {
+						p.failAt(false, p.pt.position, want)
+					}
+					for _, altI := range d.alts[set] {
+						switch altI {
+						case 0:
+							{
+								p.countExpr()
+								v4, match3 = p.parseRuleWrap(g.rules[2])
+							}
+						case 1:
+							{
+								p.countExpr()
+								v4, match3 = p.parseRuleWrap(g.rules[3])
+							}
+						case 2:
+							{
+								p.countExpr()
+								v4, match3 = p.parseRuleWrap(g.rules[4])
+							}
+						case 3:
+							{
+								p.countExpr()
+								v4, match3 = p.parseRuleWrap(g.rules[7])
+							}
+						case 4:
+							{
+								p.countExpr()
+								v4, match3 = p.parseRuleWrap(g.rules[15])
+							}
+						case 5:
+							{
+								p.countExpr()
+								v4, match3 = p.parseRuleWrap(g.rules[16])
+							}
+						}
+						if match3 {
+							break
+						}
+					}
+				}
+				p.popV()
+				if match3 {
+					p.vstack[len(p.vstack)-1]["val"] = v4
+					_ = v4
+				}
+			}
+			if match3 {
+				var match5 bool
+				{
+					p.countExpr()
+					_, match5 = p.parseRuleWrap(g.rules[17])
+				}
+				if match5 {
+					ok = true
+				}
+			}
+			if !ok {
+				p.restore(pt2)
+			}
+		}
+		if ok {
+			p.cur.pos = start1.position
+			p.cur.text = p.sliceFrom(start1)
+			actVal, err := p.callonValue1()
+			if err != nil {
+				p.addErrAt(err, start1.position, []string{})
+			}
+			val = actVal
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr36() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		start1 := p.pt
+		{
+			p.countExpr()
+			pt2 := p.pt
+			var match3 bool
+			{
+				p.countExpr()
+				start := p.pt
+				if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "{" {
+					p.read()
+					p.failAt(true, start.position, "\"{\"")
+					match3 = true
+				} else {
+					p.failAt(false, start.position, "\"{\"")
+				}
+			}
+			if match3 {
+				var match4 bool
+				{
+					p.countExpr()
+					_, match4 = p.parseRuleWrap(g.rules[17])
+				}
+				if match4 {
+					var match5 bool
+					{
+						p.countExpr()
+						var v6 any
+						p.pushV()
+						{
+							p.countExpr()
+							{
+								p.countExpr()
+								pt7 := p.pt
+								var v8 any
+								var match9 bool
+								{
+									p.countExpr()
+									v8, match9 = p.parseRuleWrap(g.rules[7])
+								}
+								if match9 {
+									var v10 any
+									var match11 bool
+									{
+										p.countExpr()
+										v10, match11 = p.parseRuleWrap(g.rules[17])
+									}
+									if match11 {
+										var v12 any
+										var match13 bool
+										{
+											p.countExpr()
+											start := p.pt
+											if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == ":" {
+												p.read()
+												p.failAt(true, start.position, "\":\"")
+												v12 = p.sliceFrom(start)
+												match13 = true
+											} else {
+												p.failAt(false, start.position, "\":\"")
+											}
+										}
+										if match13 {
+											var v14 any
+											var match15 bool
+											{
+												p.countExpr()
+												v14, match15 = p.parseRuleWrap(g.rules[17])
+											}
+											if match15 {
+												var v16 any
+												var match17 bool
+												{
+													p.countExpr()
+													v16, match17 = p.parseRuleWrap(g.rules[1])
+												}
+												if match17 {
+													var v18 any
+													var match19 bool
+													{
+														p.countExpr()
+														var vals20 []any
+														for {
+															var v22 any
+															var match21 bool
+															{
+																p.countExpr()
+																pt23 := p.pt
+																var v24 any
+																var match25 bool
+																{
+																	p.countExpr()
+																	start := p.pt
+																	if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "," {
+																		p.read()
+																		p.failAt(true, start.position, "\",\"")
+																		v24 = p.sliceFrom(start)
+																		match25 = true
+																	} else {
+																		p.failAt(false, start.position, "\",\"")
+																	}
+																}
+																if match25 {
+																	var v26 any
+																	var match27 bool
+																	{
+																		p.countExpr()
+																		v26, match27 = p.parseRuleWrap(g.rules[17])
+																	}
+																	if match27 {
+																		var v28 any
+																		var match29 bool
+																		{
+																			p.countExpr()
+																			v28, match29 = p.parseRuleWrap(g.rules[7])
+																		}
+																		if match29 {
+																			var v30 any
+																			var match31 bool
+																			{
+																				p.countExpr()
+																				v30, match31 = p.parseRuleWrap(g.rules[17])
+																			}
+																			if match31 {
+																				var v32 any
+																				var match33 bool
+																				{
+																					p.countExpr()
+																					start := p.pt
+																					if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == ":" {
+																						p.read()
+																						p.failAt(true, start.position, "\":\"")
+																						v32 = p.sliceFrom(start)
+																						match33 = true
+																					} else {
+																						p.failAt(false, start.position, "\":\"")
+																					}
+																				}
+																				if match33 {
+																					var v34 any
+																					var match35 bool
+																					{
+																						p.countExpr()
+																						v34, match35 = p.parseRuleWrap(g.rules[17])
+																					}
+																					if match35 {
+																						var v36 any
+																						var match37 bool
+																						{
+																							p.countExpr()
+																							v36, match37 = p.parseRuleWrap(g.rules[1])
+																						}
+																						if match37 {
+																							v22 = []any{v24, v26, v28, v30, v32, v34, v36}
+																							match21 = true
+																						}
+																					}
+																				}
+																			}
+																		}
+																	}
+																}
+																if !match21 {
+																	p.restore(pt23)
+																}
+															}
+															if !match21 {
+																break
+															}
+															vals20 = append(vals20, v22)
+														}
+														v18 = vals20
+														match19 = true
+													}
+													if match19 {
+														v6 = []any{v8, v10, v12, v14, v16, v18}
+														match5 = true
+													}
+												}
+											}
+										}
+									}
+								}
+								if !match5 {
+									p.restore(pt7)
+								}
+							}
+							match5 = true
+						}
+						p.popV()
+						if match5 {
+							p.vstack[len(p.vstack)-1]["vals"] = v6
+							_ = v6
+						}
+					}
+					if match5 {
+						var match38 bool
+						{
+							p.countExpr()
+							start := p.pt
+							if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "}" {
+								p.read()
+								p.failAt(true, start.position, "\"}\"")
+								match38 = true
+							} else {
+								p.failAt(false, start.position, "\"}\"")
+							}
+						}
+						if match38 {
+							ok = true
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restore(pt2)
+			}
+		}
+		if ok {
+			p.cur.pos = start1.position
+			p.cur.text = p.sliceFrom(start1)
+			actVal, err := p.callonObject1()
+			if err != nil {
+				p.addErrAt(err, start1.position, []string{})
+			}
+			val = actVal
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr58() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		start1 := p.pt
+		{
+			p.countExpr()
+			pt2 := p.pt
+			var match3 bool
+			{
+				p.countExpr()
+				start := p.pt
+				if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "[" {
+					p.read()
+					p.failAt(true, start.position, "\"[\"")
+					match3 = true
+				} else {
+					p.failAt(false, start.position, "\"[\"")
+				}
+			}
+			if match3 {
+				var match4 bool
+				{
+					p.countExpr()
+					_, match4 = p.parseRuleWrap(g.rules[17])
+				}
+				if match4 {
+					var match5 bool
+					{
+						p.countExpr()
+						var v6 any
+						p.pushV()
+						{
+							p.countExpr()
+							{
+								p.countExpr()
+								pt7 := p.pt
+								var v8 any
+								var match9 bool
+								{
+									p.countExpr()
+									v8, match9 = p.parseRuleWrap(g.rules[1])
+								}
+								if match9 {
+									var v10 any
+									var match11 bool
+									{
+										p.countExpr()
+										var vals12 []any
+										for {
+											var v14 any
+											var match13 bool
+											{
+												p.countExpr()
+												pt15 := p.pt
+												var v16 any
+												var match17 bool
+												{
+													p.countExpr()
+													start := p.pt
+													if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "," {
+														p.read()
+														p.failAt(true, start.position, "\",\"")
+														v16 = p.sliceFrom(start)
+														match17 = true
+													} else {
+														p.failAt(false, start.position, "\",\"")
+													}
+												}
+												if match17 {
+													var v18 any
+													var match19 bool
+													{
+														p.countExpr()
+														v18, match19 = p.parseRuleWrap(g.rules[17])
+													}
+													if match19 {
+														var v20 any
+														var match21 bool
+														{
+															p.countExpr()
+															v20, match21 = p.parseRuleWrap(g.rules[1])
+														}
+														if match21 {
+															v14 = []any{v16, v18, v20}
+															match13 = true
+														}
+													}
+												}
+												if !match13 {
+													p.restore(pt15)
+												}
+											}
+											if !match13 {
+												break
+											}
+											vals12 = append(vals12, v14)
+										}
+										v10 = vals12
+										match11 = true
+									}
+									if match11 {
+										v6 = []any{v8, v10}
+										match5 = true
+									}
+								}
+								if !match5 {
+									p.restore(pt7)
+								}
+							}
+							match5 = true
+						}
+						p.popV()
+						if match5 {
+							p.vstack[len(p.vstack)-1]["vals"] = v6
+							_ = v6
+						}
+					}
+					if match5 {
+						var match22 bool
+						{
+							p.countExpr()
+							start := p.pt
+							if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "]" {
+								p.read()
+								p.failAt(true, start.position, "\"]\"")
+								match22 = true
+							} else {
+								p.failAt(false, start.position, "\"]\"")
+							}
+						}
+						if match22 {
+							ok = true
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restore(pt2)
+			}
+		}
+		if ok {
+			p.cur.pos = start1.position
+			p.cur.text = p.sliceFrom(start1)
+			actVal, err := p.callonArray1()
+			if err != nil {
+				p.addErrAt(err, start1.position, []string{})
+			}
+			val = actVal
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr72() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		start1 := p.pt
+		{
+			p.countExpr()
+			pt2 := p.pt
+			var match3 bool
+			{
+				p.countExpr()
+				{
+					p.countExpr()
+					start := p.pt
+					if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "-" {
+						p.read()
+						p.failAt(true, start.position, "\"-\"")
+						match3 = true
+					} else {
+						p.failAt(false, start.position, "\"-\"")
+					}
+				}
+				match3 = true
+			}
+			if match3 {
+				var match4 bool
+				{
+					p.countExpr()
+					_, match4 = p.parseRuleWrap(g.rules[5])
+				}
+				if match4 {
+					var match5 bool
+					{
+						p.countExpr()
+						{
+							p.countExpr()
+							pt6 := p.pt
+							var match7 bool
+							{
+								p.countExpr()
+								start := p.pt
+								if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "." {
+									p.read()
+									p.failAt(true, start.position, "\".\"")
+									match7 = true
+								} else {
+									p.failAt(false, start.position, "\".\"")
+								}
+							}
+							if match7 {
+								var match8 bool
+								{
+									p.countExpr()
+									n9 := 0
+									for {
+										var match10 bool
+										{
+											p.countExpr()
+											_, match10 = p.parseRuleWrap(g.rules[12])
+										}
+										if !match10 {
+											break
+										}
+										n9++
+									}
+									if n9 > 0 {
+										match8 = true
+									}
+								}
+								if match8 {
+									match5 = true
+								}
+							}
+							if !match5 {
+								p.restore(pt6)
+							}
+						}
+						match5 = true
+					}
+					if match5 {
+						var match11 bool
+						{
+							p.countExpr()
+							{
+								p.countExpr()
+								_, match11 = p.parseRuleWrap(g.rules[6])
+							}
+							match11 = true
+						}
+						if match11 {
+							ok = true
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restore(pt2)
+			}
+		}
+		if ok {
+			p.cur.pos = start1.position
+			p.cur.text = p.sliceFrom(start1)
+			actVal, err := p.callonNumber1()
+			if err != nil {
+				p.addErrAt(err, start1.position, []string{})
+			}
+			val = actVal
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr84() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		d := expr84Dispatch
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		for _, altI := range d.alts[set] {
+			switch altI {
+			case 0:
+				{
+					p.countExpr()
+					start := p.pt
+					if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "0" {
+						p.read()
+						p.failAt(true, start.position, "\"0\"")
+						val = p.sliceFrom(start)
+						ok = true
+					} else {
+						p.failAt(false, start.position, "\"0\"")
+					}
+				}
+			case 1:
+				{
+					p.countExpr()
+					pt1 := p.pt
+					var v2 any
+					var match3 bool
+					{
+						p.countExpr()
+						v2, match3 = p.parseRuleWrap(g.rules[13])
+					}
+					if match3 {
+						var v4 any
+						var match5 bool
+						{
+							p.countExpr()
+							var vals6 []any
+							for {
+								var v8 any
+								var match7 bool
+								{
+									p.countExpr()
+									v8, match7 = p.parseRuleWrap(g.rules[12])
+								}
+								if !match7 {
+									break
+								}
+								vals6 = append(vals6, v8)
+							}
+							v4 = vals6
+							match5 = true
+						}
+						if match5 {
+							val = []any{v2, v4}
+							ok = true
+						}
+					}
+					if !ok {
+						p.restore(pt1)
+					}
+				}
+			}
+			if ok {
+				break
+			}
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr90() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		pt1 := p.pt
+		var v2 any
+		var match3 bool
+		{
+			p.countExpr()
+			start := p.pt
+			if p.matchCaseFold("e") {
+				p.failAt(true, start.position, "\"e\"i")
+				v2 = p.sliceFrom(start)
+				match3 = true
+			} else {
+				p.failAt(false, start.position, "\"e\"i")
+				p.restore(start)
+			}
+		}
+		if match3 {
+			var v4 any
+			var match5 bool
+			{
+				p.countExpr()
+				{
+					p.countExpr()
+					cur := p.pt.rn
+					start := p.pt
+					var matched bool
+					// can't match EOF
+					eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+					if cur < 128 {
+						switch {
+						case cur == '+', cur == '-':
+							matched = true
+						}
+					} else if !eof {
+						switch cur {
+						case '+', '-':
+							matched = true
+						}
+					}
+					if matched && !eof {
+						p.read()
+						p.failAt(true, start.position, "[+-]")
+						v4 = p.sliceFrom(start)
+						match5 = true
+					} else {
+						p.failAt(false, start.position, "[+-]")
+					}
+				}
+				match5 = true
+			}
+			if match5 {
+				var v6 any
+				var match7 bool
+				{
+					p.countExpr()
+					var vals8 []any
+					for {
+						var v10 any
+						var match9 bool
+						{
+							p.countExpr()
+							v10, match9 = p.parseRuleWrap(g.rules[12])
+						}
+						if !match9 {
+							break
+						}
+						vals8 = append(vals8, v10)
+					}
+					if len(vals8) > 0 {
+						v6 = vals8
+						match7 = true
+					}
+				}
+				if match7 {
+					val = []any{v2, v4, v6}
+					ok = true
+				}
+			}
+		}
+		if !ok {
+			p.restore(pt1)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr96() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		start1 := p.pt
+		{
+			p.countExpr()
+			pt2 := p.pt
+			var match3 bool
+			{
+				p.countExpr()
+				start := p.pt
+				if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "\"" {
+					p.read()
+					p.failAt(true, start.position, "\"\\\"\"")
+					match3 = true
+				} else {
+					p.failAt(false, start.position, "\"\\\"\"")
+				}
+			}
+			if match3 {
+				var match4 bool
+				{
+					p.countExpr()
+					for {
+						var match5 bool
+						{
+							p.countExpr()
+							d := expr100Dispatch
+							set := d.set(p.pt)
+							for _, want := range d.expected[set] {
+								p.failAt(false, p.pt.position, want)
+							}
+							for _, altI := range d.alts[set] {
+								switch altI {
+								case 0:
+									{
+										p.countExpr()
+										pt6 := p.pt
+										var match7 bool
+										{
+											p.countExpr()
+											pt8 := p.pt
+											p.maxFailInvertExpected = !p.maxFailInvertExpected
+											var match9 bool
+											{
+												p.countExpr()
+												_, match9 = p.parseRuleWrap(g.rules[8])
+											}
+											p.maxFailInvertExpected = !p.maxFailInvertExpected
+											p.restore(pt8)
+											match7 = !match9
+										}
+										if match7 {
+											var match10 bool
+											{
+												p.countExpr()
+												if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+													// EOF - see utf8.DecodeRune
+													p.failAt(false, p.pt.position, ".")
+												} else {
+													start := p.pt
+													p.read()
+													p.failAt(true, start.position, ".")
+													match10 = true
+												}
+											}
+											if match10 {
+												match5 = true
+											}
+										}
+										if !match5 {
+											p.restore(pt6)
+										}
+									}
+								case 1:
+									{
+										p.countExpr()
+										pt11 := p.pt
+										var match12 bool
+										{
+											p.countExpr()
+											start := p.pt
+											if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "\\" {
+												p.read()
+												p.failAt(true, start.position, "\"\\\\\"")
+												match12 = true
+											} else {
+												p.failAt(false, start.position, "\"\\\\\"")
+											}
+										}
+										if match12 {
+											var match13 bool
+											{
+												p.countExpr()
+												_, match13 = p.parseRuleWrap(g.rules[9])
+											}
+											if match13 {
+												match5 = true
+											}
+										}
+										if !match5 {
+											p.restore(pt11)
+										}
+									}
+								}
+								if match5 {
+									break
+								}
+							}
+						}
+						if !match5 {
+							break
+						}
+					}
+					match4 = true
+				}
+				if match4 {
+					var match14 bool
+					{
+						p.countExpr()
+						start := p.pt
+						if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "\"" {
+							p.read()
+							p.failAt(true, start.position, "\"\\\"\"")
+							match14 = true
+						} else {
+							p.failAt(false, start.position, "\"\\\"\"")
+						}
+					}
+					if match14 {
+						ok = true
+					}
+				}
+			}
+			if !ok {
+				p.restore(pt2)
+			}
+		}
+		if ok {
+			p.cur.pos = start1.position
+			p.cur.text = p.sliceFrom(start1)
+			actVal, err := p.callonString1()
+			if err != nil {
+				p.addErrAt(err, start1.position, []string{})
+			}
+			val = actVal
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr109() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		cur := p.pt.rn
+		start := p.pt
+		var matched bool
+		// can't match EOF
+		eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+		if cur < 128 {
+			switch {
+			case cur >= '\x00' && cur <= '\x1f', cur == '"', cur == '\\':
+				matched = true
+			}
+		} else if !eof {
+			switch cur {
+			case '"', '\\':
+				matched = true
+			}
+			switch {
+			case cur >= '\x00' && cur <= '\x1f':
+				matched = true
+			}
+		}
+		if matched && !eof {
+			p.read()
+			p.failAt(true, start.position, "[\\x00-\\x1f\"\\\\]")
+			val = p.sliceFrom(start)
+			ok = true
+		} else {
+			p.failAt(false, start.position, "[\\x00-\\x1f\"\\\\]")
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr110() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		d := expr110Dispatch
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		for _, altI := range d.alts[set] {
+			switch altI {
+			case 0:
+				{
+					p.countExpr()
+					val, ok = p.parseRuleWrap(g.rules[10])
+				}
+			case 1:
+				{
+					p.countExpr()
+					val, ok = p.parseRuleWrap(g.rules[11])
+				}
+			}
+			if ok {
+				break
+			}
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr113() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		cur := p.pt.rn
+		start := p.pt
+		var matched bool
+		// can't match EOF
+		eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+		if cur < 128 {
+			switch {
+			case cur == '"', cur == '/', cur == '\\', cur == 'b', cur == 'f', cur == 'n', cur == 'r', cur == 't':
+				matched = true
+			}
+		} else if !eof {
+			switch cur {
+			case '"', '\\', '/', 'b', 'f', 'n', 'r', 't':
+				matched = true
+			}
+		}
+		if matched && !eof {
+			p.read()
+			p.failAt(true, start.position, "[\"\\\\/bfnrt]")
+			val = p.sliceFrom(start)
+			ok = true
+		} else {
+			p.failAt(false, start.position, "[\"\\\\/bfnrt]")
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr114() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		pt1 := p.pt
+		var v2 any
+		var match3 bool
+		{
+			p.countExpr()
+			start := p.pt
+			if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "u" {
+				p.read()
+				p.failAt(true, start.position, "\"u\"")
+				v2 = p.sliceFrom(start)
+				match3 = true
+			} else {
+				p.failAt(false, start.position, "\"u\"")
+			}
+		}
+		if match3 {
+			var v4 any
+			var match5 bool
+			{
+				p.countExpr()
+				v4, match5 = p.parseRuleWrap(g.rules[14])
+			}
+			if match5 {
+				var v6 any
+				var match7 bool
+				{
+					p.countExpr()
+					v6, match7 = p.parseRuleWrap(g.rules[14])
+				}
+				if match7 {
+					var v8 any
+					var match9 bool
+					{
+						p.countExpr()
+						v8, match9 = p.parseRuleWrap(g.rules[14])
+					}
+					if match9 {
+						var v10 any
+						var match11 bool
+						{
+							p.countExpr()
+							v10, match11 = p.parseRuleWrap(g.rules[14])
+						}
+						if match11 {
+							val = []any{v2, v4, v6, v8, v10}
+							ok = true
+						}
+					}
+				}
+			}
+		}
+		if !ok {
+			p.restore(pt1)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr120() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		cur := p.pt.rn
+		start := p.pt
+		var matched bool
+		// can't match EOF
+		eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+		if cur < 128 {
+			switch {
+			case cur >= '0' && cur <= '9':
+				matched = true
+			}
+		} else if !eof {
+			switch {
+			case cur >= '0' && cur <= '9':
+				matched = true
+			}
+		}
+		if matched && !eof {
+			p.read()
+			p.failAt(true, start.position, "[0-9]")
+			val = p.sliceFrom(start)
+			ok = true
+		} else {
+			p.failAt(false, start.position, "[0-9]")
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr121() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		cur := p.pt.rn
+		start := p.pt
+		var matched bool
+		// can't match EOF
+		eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+		if cur < 128 {
+			switch {
+			case cur >= '1' && cur <= '9':
+				matched = true
+			}
+		} else if !eof {
+			switch {
+			case cur >= '1' && cur <= '9':
+				matched = true
+			}
+		}
+		if matched && !eof {
+			p.read()
+			p.failAt(true, start.position, "[1-9]")
+			val = p.sliceFrom(start)
+			ok = true
+		} else {
+			p.failAt(false, start.position, "[1-9]")
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr122() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		cur := p.pt.rn
+		start := p.pt
+		var matched bool
+		// can't match EOF
+		eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+		if cur < 128 {
+			switch {
+			case cur >= '0' && cur <= '9', cur >= 'A' && cur <= 'F', cur >= 'a' && cur <= 'f':
+				matched = true
+			}
+		} else if !eof {
+			cur = caseFold(cur)
+			switch {
+			case cur >= '0' && cur <= '9', cur >= 'a' && cur <= 'f':
+				matched = true
+			}
+		}
+		if matched && !eof {
+			p.read()
+			p.failAt(true, start.position, "[0-9a-f]i")
+			val = p.sliceFrom(start)
+			ok = true
+		} else {
+			p.failAt(false, start.position, "[0-9a-f]i")
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr123() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		d := expr123Dispatch
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		for _, altI := range d.alts[set] {
+			switch altI {
+			case 0:
+				p.pushV()
+				{
+					p.countExpr()
+					start1 := p.pt
+					{
+						p.countExpr()
+						start := p.pt
+						if end := p.pt.offset + 4; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "true" {
+							for i := 0; i < 4; i++ {
+								p.read()
+							}
+							p.failAt(true, start.position, "\"true\"")
+							ok = true
+						} else {
+							p.failAt(false, start.position, "\"true\"")
+						}
+					}
+					if ok {
+						p.cur.pos = start1.position
+						p.cur.text = p.sliceFrom(start1)
+						actVal, err := p.callonBool2()
+						if err != nil {
+							p.addErrAt(err, start1.position, []string{})
+						}
+						val = actVal
+					}
+				}
+				p.popV()
+			case 1:
+				p.pushV()
+				{
+					p.countExpr()
+					start2 := p.pt
+					{
+						p.countExpr()
+						start := p.pt
+						if end := p.pt.offset + 5; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "false" {
+							for i := 0; i < 5; i++ {
+								p.read()
+							}
+							p.failAt(true, start.position, "\"false\"")
+							ok = true
+						} else {
+							p.failAt(false, start.position, "\"false\"")
+						}
+					}
+					if ok {
+						p.cur.pos = start2.position
+						p.cur.text = p.sliceFrom(start2)
+						actVal, err := p.callonBool4()
+						if err != nil {
+							p.addErrAt(err, start2.position, []string{})
+						}
+						val = actVal
+					}
+				}
+				p.popV()
+			}
+			if ok {
+				break
+			}
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr128() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		start1 := p.pt
+		{
+			p.countExpr()
+			start := p.pt
+			if end := p.pt.offset + 4; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "null" {
+				for i := 0; i < 4; i++ {
+					p.read()
+				}
+				p.failAt(true, start.position, "\"null\"")
+				ok = true
+			} else {
+				p.failAt(false, start.position, "\"null\"")
+			}
+		}
+		if ok {
+			p.cur.pos = start1.position
+			p.cur.text = p.sliceFrom(start1)
+			actVal, err := p.callonNull1()
+			if err != nil {
+				p.addErrAt(err, start1.position, []string{})
+			}
+			val = actVal
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr130() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		var vals1 []any
+		for {
+			var v3 any
+			var match2 bool
+			{
+				p.countExpr()
+				cur := p.pt.rn
+				start := p.pt
+				var matched bool
+				// can't match EOF
+				eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+				if cur < 128 {
+					switch {
+					case cur >= '\t' && cur <= '\n', cur == '\r', cur == ' ':
+						matched = true
+					}
+				} else if !eof {
+					switch cur {
+					case ' ', '\t', '\r', '\n':
+						matched = true
+					}
+				}
+				if matched && !eof {
+					p.read()
+					p.failAt(true, start.position, "[ \\t\\r\\n]")
+					v3 = p.sliceFrom(start)
+					match2 = true
+				} else {
+					p.failAt(false, start.position, "[ \\t\\r\\n]")
+				}
+			}
+			if !match2 {
+				break
+			}
+			vals1 = append(vals1, v3)
+		}
+		val = vals1
+		ok = true
+	}
+	return val, ok
+}
+
+func (p *parser) expr132() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		pt1 := p.pt
+		p.maxFailInvertExpected = !p.maxFailInvertExpected
+		var match2 bool
+		{
+			p.countExpr()
+			if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+				// EOF - see utf8.DecodeRune
+				p.failAt(false, p.pt.position, ".")
+			} else {
+				start := p.pt
+				p.read()
+				p.failAt(true, start.position, ".")
+				match2 = true
+			}
+		}
+		p.maxFailInvertExpected = !p.maxFailInvertExpected
+		p.restore(pt1)
+		ok = !match2
+	}
+	return val, ok
+}
+
+var expr28Dispatch = &choiceDispatch{
+	ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x04\x00\x00\x00\x00\x00\x00\x00\x05\x00\x00\x00\x00\x00\x04\x00\x00\x00\x00\x00\x00\x06\x00\x00\x00\x00",
+	ranges:    []rune{'\u0080'},
+	rangeSets: "\x00",
+	alts:      [][]int{{}, {3}, {2}, {1}, {4}, {5}, {0}},
+	expected:  [][]string{{"\"{\"", "\"[\"", "\"-\"", "\"0\"", "[1-9]", "\"\\\"\"", "\"true\"", "\"false\"", "\"null\""}, {"\"{\"", "\"[\"", "\"-\"", "\"0\"", "[1-9]", "\"true\"", "\"false\"", "\"null\""}, {"\"{\"", "\"[\"", "\"\\\"\"", "\"true\"", "\"false\"", "\"null\""}, {"\"{\"", "\"-\"", "\"0\"", "[1-9]", "\"\\\"\"", "\"true\"", "\"false\"", "\"null\""}, {"\"{\"", "\"[\"", "\"-\"", "\"0\"", "[1-9]", "\"\\\"\"", "\"null\""}, {"\"{\"", "\"[\"", "\"-\"", "\"0\"", "[1-9]", "\"\\\"\"", "\"true\"", "\"false\""}, {"\"[\"", "\"-\"", "\"0\"", "[1-9]", "\"\\\"\"", "\"true\"", "\"false\"", "\"null\""}},
+}
+
+var expr84Dispatch = &choiceDispatch{
+	ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+	ranges:    []rune{'\u0080'},
+	rangeSets: "\x00",
+	alts:      [][]int{{}, {0}, {1}},
+	expected:  [][]string{{"\"0\"", "[1-9]"}, {"[1-9]"}, {"\"0\""}},
+}
+
+var expr100Dispatch = &choiceDispatch{
+	ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+	ranges:    []rune{'\u0080'},
+	rangeSets: "\x00",
+	alts:      [][]int{{0}, {0, 1}},
+	expected:  [][]string{{"\"\\\\\""}, {}},
+}
+
+var expr110Dispatch = &choiceDispatch{
+	ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01\x00\x01\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+	ranges:    []rune{'\u0080'},
+	rangeSets: "\x00",
+	alts:      [][]int{{}, {0}, {1}},
+	expected:  [][]string{{"[\"\\\\/bfnrt]", "\"u\""}, {"\"u\""}, {"[\"\\\\/bfnrt]"}},
+}
+
+var expr123Dispatch = &choiceDispatch{
+	ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+	ranges:    []rune{'\u0080'},
+	rangeSets: "\x00",
+	alts:      [][]int{{}, {1}, {0}},
+	expected:  [][]string{{"\"true\"", "\"false\""}, {"\"true\""}, {"\"false\""}},
+}
+
+func (c *current) onJSON1(val any) (any, error) {
+	return val, nil
+}
+
+func (p *parser) callonJSON1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJSON1(stack["val"])
+}
+
+func (c *current) onValue1(val any) (any, error) {
+	return val, nil
+}
+
+func (p *parser) callonValue1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onValue1(stack["val"])
+}
+
+func (c *current) onObject1(vals any) (any, error) {
+	res := make(map[string]any)
+	valsSl := toAnySlice(vals)
+	if len(valsSl) == 0 {
+		return res, nil
+	}
+	res[valsSl[0].(string)] = valsSl[4]
+	restSl := toAnySlice(valsSl[5])
+	for _, v := range restSl {
+		vSl := toAnySlice(v)
+		res[vSl[2].(string)] = vSl[6]
+	}
+	return res, nil
+}
+
+func (p *parser) callonObject1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onObject1(stack["vals"])
+}
+
+func (c *current) onArray1(vals any) (any, error) {
+	valsSl := toAnySlice(vals)
+	if len(valsSl) == 0 {
+		return []any{}, nil
+	}
+	res := []any{valsSl[0]}
+	restSl := toAnySlice(valsSl[1])
+	for _, v := range restSl {
+		vSl := toAnySlice(v)
+		res = append(res, vSl[2])
+	}
+	return res, nil
+}
+
+func (p *parser) callonArray1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArray1(stack["vals"])
+}
+
+func (c *current) onNumber1() (any, error) {
+	// JSON numbers have the same syntax as Go's, and are parseable using
+	// strconv.
+	return strconv.ParseFloat(string(c.text), 64)
+}
+
+func (p *parser) callonNumber1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNumber1()
+}
+
+func (c *current) onString1() (any, error) {
+	c.text = bytes.Replace(c.text, []byte(`\/`), []byte(`/`), -1)
+	return strconv.Unquote(string(c.text))
+}
+
+func (p *parser) callonString1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onString1()
+}
+
+func (c *current) onBool2() (any, error) {
+	return true, nil
+}
+
+func (p *parser) callonBool2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBool2()
+}
+
+func (c *current) onBool4() (any, error) {
+	return false, nil
+}
+
+func (p *parser) callonBool4() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBool4()
+}
+
+func (c *current) onNull1() (any, error) {
+	return nil, nil
+}
+
+func (p *parser) callonNull1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNull1()
+}
+
+var (
+	// errNoRule is returned when the grammar to parse has no rule.
+	errNoRule = errors.New("grammar has no rule")
+
+	// errInvalidEntrypoint is returned when the specified entrypoint rule
+	// does not exit.
+	errInvalidEntrypoint = errors.New("invalid entrypoint")
+
+	// errInvalidEncoding is returned when the source is not properly
+	// utf8-encoded.
+	errInvalidEncoding = errors.New("invalid encoding")
+
+	// errMaxExprCnt is used to signal that the maximum number of
+	// expressions have been parsed.
+	errMaxExprCnt = errors.New("max number of expressions parsed")
+)
+
+// Option is a function that can set an option on the parser. It returns
+// the previous setting as an Option.
+type Option func(*parser) Option
+
+// MaxExpressions creates an Option to stop parsing after the provided
+// number of expressions have been parsed, if the value is 0 then the parser will
+// parse for as many steps as needed (possibly an infinite number).
+//
+// The default for maxExprCnt is 0.
+func MaxExpressions(maxExprCnt uint64) Option {
+	return func(p *parser) Option {
+		oldMaxExprCnt := p.maxExprCnt
+		p.maxExprCnt = maxExprCnt
+		return MaxExpressions(oldMaxExprCnt)
+	}
+}
+
+// Entrypoint creates an Option to set the rule name to use as entrypoint.
+// The rule name must have been specified in the -alternate-entrypoints
+// if generating the parser with the -optimize-grammar flag, otherwise
+// it may have been optimized out. Passing an empty string sets the
+// entrypoint to the first rule in the grammar.
+//
+// The default is to start parsing at the first rule in the grammar.
+func Entrypoint(ruleName string) Option {
+	return func(p *parser) Option {
+		oldEntrypoint := p.entrypoint
+		p.entrypoint = ruleName
+		if ruleName == "" {
+			p.entrypoint = g.rules[0].name
+		}
+		return Entrypoint(oldEntrypoint)
+	}
+}
+
+// AllowInvalidUTF8 creates an Option to allow invalid UTF-8 bytes.
+// Every invalid UTF-8 byte is treated as a utf8.RuneError (U+FFFD)
+// by character class matchers and is matched by the any matcher.
+// The returned matched value, c.text and c.offset are NOT affected.
+//
+// The default is false.
+func AllowInvalidUTF8(b bool) Option {
+	return func(p *parser) Option {
+		old := p.allowInvalidUTF8
+		p.allowInvalidUTF8 = b
+		return AllowInvalidUTF8(old)
+	}
+}
+
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
+// Recover creates an Option to set the recover flag to b. When set to
+// true, this causes the parser to recover from panics and convert it
+// to an error. Setting it to false can be useful while debugging to
+// access the full stack trace.
+//
+// The default is true.
+func Recover(b bool) Option {
+	return func(p *parser) Option {
+		old := p.recover
+		p.recover = b
+		return Recover(old)
+	}
+}
+
+// GlobalStore creates an Option to set a key to a certain value in
+// the globalStore.
+func GlobalStore(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.globalStore[key]
+		p.cur.globalStore[key] = value
+		return GlobalStore(key, old)
+	}
+}
+
+// ParseFile parses the file identified by filename.
+func ParseFile(filename string, opts ...Option) (i any, err error) { // nolint: deadcode
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+	return ParseReader(filename, f, opts...)
+}
+
+// ParseReader parses the data from r using filename as information in the
+// error messages.
+func ParseReader(filename string, r io.Reader, opts ...Option) (any, error) { // nolint: deadcode
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(filename, b, opts...)
+}
+
+// Parse parses the data from b using filename as information in the
+// error messages.
+func Parse(filename string, b []byte, opts ...Option) (any, error) {
+	return newParser(filename, b, opts...).parse(g)
+}
+
+// position records a position in the text.
+type position struct {
+	line, col, offset int
+}
+
+func (p position) String() string {
+	return strconv.Itoa(p.line) + ":" + strconv.Itoa(p.col) + " [" + strconv.Itoa(p.offset) + "]"
+}
+
+// savepoint stores all state required to go back to this point in the
+// parser.
+type savepoint struct {
+	position
+	rn rune
+	w  int
+}
+
+type current struct {
+	pos  position // start position of the match
+	text []byte   // raw text of the match
+
+	// globalStore is a general store for the user to store arbitrary key-value
+	// pairs that they need to manage and that they do not want tied to the
+	// backtracking of the parser. This is only modified by the user and never
+	// rolled back by the parser. It is always up to the user to keep this in a
+	// consistent state.
+	globalStore storeDict
+}
+
+type storeDict map[string]any
+
+// the AST types...
+
+// nolint: structcheck
+type grammar struct {
+	pos   position
+	rules []*rule
+}
+
+// nolint: structcheck
+type rule struct {
+	pos         position
+	name        string
+	displayName string
+	run         func(*parser) (any, bool)
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+//
+//	nolint: structcheck
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
+}
+
+// matchCaseFold matches the full case folding of the input against val,
+// the case folding of a case-insensitive literal. The input is consumed
+// if it matches, it must be restored otherwise.
+func (p *parser) matchCaseFold(val string) bool {
+	for len(val) > 0 {
+		if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+			// EOF - see utf8.DecodeRune
+			return false
+		}
+		if f, ok := fullCaseFolds[p.pt.rn]; ok {
+			if !strings.HasPrefix(val, f) {
+				return false
+			}
+			val = val[len(f):]
+		} else {
+			want, n := utf8.DecodeRuneInString(val)
+			if caseFold(p.pt.rn) != want {
+				return false
+			}
+			val = val[n:]
+		}
+		p.read()
+	}
+	return true
+}
+
+// caseFold returns the simple case folding of rn, the smallest lowercase
+// rune of its orbit for unicode.SimpleFold, or its smallest rune if it has
+// no lowercase rune.
+func caseFold(rn rune) rune {
+	if rn < utf8.RuneSelf {
+		if 'A' <= rn && rn <= 'Z' {
+			rn += 'a' - 'A'
+		}
+		return rn
+	}
+
+	folded := rn
+	for r := unicode.SimpleFold(rn); r != rn; r = unicode.SimpleFold(r) {
+		if lower := unicode.IsLower(r); lower != unicode.IsLower(folded) {
+			if lower {
+				folded = r
+			}
+		} else if r < folded {
+			folded = r
+		}
+	}
+	return folded
+}
+
+// errList cumulates the errors found by the parser.
+type errList []error
+
+func (e *errList) add(err error) {
+	*e = append(*e, err)
+}
+
+func (e errList) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	e.dedupe()
+	return e
+}
+
+func (e *errList) dedupe() {
+	var cleaned []error
+	set := make(map[string]bool)
+	for _, err := range *e {
+		if msg := err.Error(); !set[msg] {
+			set[msg] = true
+			cleaned = append(cleaned, err)
+		}
+	}
+	*e = cleaned
+}
+
+func (e errList) Error() string {
+	switch len(e) {
+	case 0:
+		return ""
+	case 1:
+		return e[0].Error()
+	default:
+		var buf bytes.Buffer
+
+		for i, err := range e {
+			if i > 0 {
+				buf.WriteRune('\n')
+			}
+			buf.WriteString(err.Error())
+		}
+		return buf.String()
+	}
+}
+
+// parserError wraps an error with a prefix indicating the rule in which
+// the error occurred. The original error is stored in the Inner field.
+type parserError struct {
+	Inner    error
+	pos      position
+	prefix   string
+	expected []string
+}
+
+// Error returns the error message.
+func (p *parserError) Error() string {
+	return p.prefix + ": " + p.Inner.Error()
+}
+
+// newParser creates a parser with the specified input source and options.
+func newParser(filename string, b []byte, opts ...Option) *parser {
+	stats := Stats{
+		ChoiceAltCnt: make(map[string]map[string]int),
+	}
+
+	p := &parser{
+		filename: filename,
+		errs:     new(errList),
+		data:     b,
+		pt:       savepoint{position: position{line: 1}},
+		recover:  true,
+		cur: current{
+			globalStore: make(storeDict),
+		},
+		maxFailPos:      position{col: 1, line: 1},
+		maxFailExpected: make([]string, 0, 20),
+		Stats:           &stats,
+		// start rule is rule [0] unless an alternate entrypoint is specified
+		entrypoint: g.rules[0].name,
+	}
+	p.setOptions(opts)
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
+
+	if p.maxExprCnt == 0 {
+		p.maxExprCnt = math.MaxUint64
+	}
+
+	return p
+}
+
+// setOptions applies the options to the parser.
+func (p *parser) setOptions(opts []Option) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// nolint: structcheck,deadcode
+type resultTuple struct {
+	v   any
+	b   bool
+	end savepoint
+}
+
+// nolint: varcheck
+const choiceNoMatch = -1
+
+// Stats stores some statistics, gathered during parsing
+type Stats struct {
+	// ExprCnt counts the number of expressions processed during parsing
+	// This value is compared to the maximum number of expressions allowed
+	// (set by the MaxExpressions option).
+	ExprCnt uint64
+
+	// ChoiceAltCnt is used to count for each ordered choice expression,
+	// which alternative is used how may times.
+	// These numbers allow to optimize the order of the ordered choice expression
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
+	//
+	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
+	// as the line and the column of the ordered choice.
+	// The inner key of ChoiceAltCnt is the number (one-based) of the matching alternative.
+	// For each alternative the number of matches are counted. If an ordered choice does not
+	// match, a special counter is incremented. The name of this counter is set with
+	// the parser option Statistics.
+	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
+	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
+}
+
+// nolint: structcheck,maligned
+type parser struct {
+	filename string
+	pt       savepoint
+	cur      current
+
+	data []byte
+	errs *errList
+
+	depth   int
+	recover bool
+
+	// rules table, maps the rule identifier to the rule node
+	rules map[string]*rule
+	// variables stack, map of label to value
+	vstack []map[string]any
+	// rule stack, allows identification of the current rule in errors
+	rstack []*rule
+
+	// parse fail
+	maxFailPos            position
+	maxFailExpected       []string
+	maxFailInvertExpected bool
+
+	// max number of expressions to be parsed
+	maxExprCnt uint64
+	// entrypoint for the parser
+	entrypoint string
+
+	allowInvalidUTF8 bool
+
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+
+	*Stats
+
+	choiceNoMatch string
+	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
+}
+
+// push a variable set on the vstack.
+func (p *parser) pushV() {
+	if cap(p.vstack) == len(p.vstack) {
+		// create new empty slot in the stack
+		p.vstack = append(p.vstack, nil)
+	} else {
+		// slice to 1 more
+		p.vstack = p.vstack[:len(p.vstack)+1]
+	}
+
+	// get the last args set
+	m := p.vstack[len(p.vstack)-1]
+	if m != nil && len(m) == 0 {
+		// empty map, all good
+		return
+	}
+
+	m = make(map[string]any)
+	p.vstack[len(p.vstack)-1] = m
+}
+
+// pop a variable set from the vstack.
+func (p *parser) popV() {
+	// if the map is not empty, clear it
+	m := p.vstack[len(p.vstack)-1]
+	if len(m) > 0 {
+		// GC that map
+		p.vstack[len(p.vstack)-1] = nil
+	}
+	p.vstack = p.vstack[:len(p.vstack)-1]
+}
+
+// push a recovery expression with its labels to the recoveryStack
+func (p *parser) pushRecovery(labels []string, expr any) {
+	if cap(p.recoveryStack) == len(p.recoveryStack) {
+		// create new empty slot in the stack
+		p.recoveryStack = append(p.recoveryStack, recovery{})
+	} else {
+		// slice to 1 more
+		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
+	}
+
+	m := make(map[string]any, len(labels))
+	for _, fl := range labels {
+		m[fl] = expr
+	}
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
+}
+
+// pop a recovery expression from the recoveryStack
+func (p *parser) popRecovery() {
+	// GC that map
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
+
+	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
+}
+
+func (p *parser) addErr(err error) {
+	p.addErrAt(err, p.pt.position, []string{})
+}
+
+func (p *parser) addErrAt(err error, pos position, expected []string) {
+	var buf bytes.Buffer
+	if p.filename != "" {
+		buf.WriteString(p.filename)
+	}
+	if buf.Len() > 0 {
+		buf.WriteString(":")
+	}
+	buf.WriteString(fmt.Sprintf("%d:%d (%d)", pos.line, pos.col, pos.offset))
+	if len(p.rstack) > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString(": ")
+		}
+		rule := p.rstack[len(p.rstack)-1]
+		if rule.displayName != "" {
+			buf.WriteString("rule " + rule.displayName)
+		} else {
+			buf.WriteString("rule " + rule.name)
+		}
+	}
+	pe := &parserError{Inner: err, pos: pos, prefix: buf.String(), expected: expected}
+	p.errs.add(pe)
+}
+
+func (p *parser) failAt(fail bool, pos position, want string) {
+	// process fail if parsing fails and not inverted or parsing succeeds and invert is set
+	if fail == p.maxFailInvertExpected {
+		if pos.offset < p.maxFailPos.offset {
+			return
+		}
+
+		if pos.offset > p.maxFailPos.offset {
+			p.maxFailPos = pos
+			p.maxFailExpected = p.maxFailExpected[:0]
+		}
+
+		if p.maxFailInvertExpected {
+			want = "!" + want
+		}
+		p.maxFailExpected = append(p.maxFailExpected, want)
+	}
+}
+
+// read advances the parser to the next rune.
+func (p *parser) read() {
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
+	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
+	p.pt.rn = rn
+	p.pt.w = n
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
+	}
+
+	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
+		if !p.allowInvalidUTF8 {
+			p.addErr(errInvalidEncoding)
+		}
+	}
+}
+
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
+// restore parser position to the savepoint pt.
+func (p *parser) restore(pt savepoint) {
+	if pt.offset == p.pt.offset {
+		return
+	}
+	p.pt = pt
+}
+
+// get the slice of bytes from the savepoint start to the current position.
+func (p *parser) sliceFrom(start savepoint) []byte {
+	return p.data[start.position.offset:p.pt.position.offset]
+}
+
+func (p *parser) buildRulesTable(g *grammar) {
+	p.rules = make(map[string]*rule, len(g.rules))
+	for _, r := range g.rules {
+		p.rules[r.name] = r
+	}
+}
+
+// nolint: gocyclo
+func (p *parser) parse(g *grammar) (val any, err error) {
+	if len(g.rules) == 0 {
+		p.addErr(errNoRule)
+		return nil, p.errs.err()
+	}
+
+	// TODO : not super critical but this could be generated
+	p.buildRulesTable(g)
+
+	if p.recover {
+		// panic can be used in action code to stop parsing immediately
+		// and return the panic as an error.
+		defer func() {
+			if e := recover(); e != nil {
+				val = nil
+				switch e := e.(type) {
+				case error:
+					p.addErr(e)
+				default:
+					p.addErr(fmt.Errorf("%v", e))
+				}
+				err = p.errs.err()
+			}
+		}()
+	}
+
+	startRule, ok := p.rules[p.entrypoint]
+	if !ok {
+		p.addErr(errInvalidEntrypoint)
+		return nil, p.errs.err()
+	}
+
+	p.read() // advance to first rune
+	val, ok = p.parseRuleWrap(startRule)
+	if !ok {
+		if len(*p.errs) == 0 {
+			// If parsing fails, but no errors have been recorded, the expected values
+			// for the farthest parser position are returned as error.
+			p.addNoMatchErr()
+		}
+
+		return nil, p.errs.err()
+	}
+	return val, p.errs.err()
+}
+
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
+func listJoin(list []string, sep string, lastSep string) string {
+	switch len(list) {
+	case 0:
+		return ""
+	case 1:
+		return list[0]
+	default:
+		return strings.Join(list[:len(list)-1], sep) + " " + lastSep + " " + list[len(list)-1]
+	}
+}
+
+func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
+	var (
+		val any
+		ok  bool
+	)
+
+	val, ok = p.parseRule(rule)
+
+	return val, ok
+}
+
+func (p *parser) parseRule(rule *rule) (any, bool) {
+	p.rstack = append(p.rstack, rule)
+	p.pushV()
+	val, ok := rule.run(p)
+	p.popV()
+	p.rstack = p.rstack[:len(p.rstack)-1]
+	return val, ok
+}
+
+// countExpr counts the evaluation of an expression.
+func (p *parser) countExpr() {
+	p.ExprCnt++
+	if p.ExprCnt > p.maxExprCnt {
+		panic(errMaxExprCnt)
+	}
+}
+
+// fullCaseFolds are the full case foldings of more than one rune, with
+// the simple case folding of their runes.
+var fullCaseFolds = map[rune]string{
+	0x00df: "ss",
+	0x0130: "i\u0307",
+	0x0149: "\u02bcn",
+	0x01f0: "j\u030c",
+	0x0390: "\u03b9\u0308\u0301",
+	0x03b0: "\u03c5\u0308\u0301",
+	0x0587: "\u0565\u0582",
+	0x1e96: "h\u0331",
+	0x1e97: "t\u0308",
+	0x1e98: "w\u030a",
+	0x1e99: "y\u030a",
+	0x1e9a: "a\u02be",
+	0x1e9e: "ss",
+	0x1f50: "\u03c5\u0313",
+	0x1f52: "\u03c5\u0313\u0300",
+	0x1f54: "\u03c5\u0313\u0301",
+	0x1f56: "\u03c5\u0313\u0342",
+	0x1f80: "\u1f00\u03b9",
+	0x1f81: "\u1f01\u03b9",
+	0x1f82: "\u1f02\u03b9",
+	0x1f83: "\u1f03\u03b9",
+	0x1f84: "\u1f04\u03b9",
+	0x1f85: "\u1f05\u03b9",
+	0x1f86: "\u1f06\u03b9",
+	0x1f87: "\u1f07\u03b9",
+	0x1f88: "\u1f00\u03b9",
+	0x1f89: "\u1f01\u03b9",
+	0x1f8a: "\u1f02\u03b9",
+	0x1f8b: "\u1f03\u03b9",
+	0x1f8c: "\u1f04\u03b9",
+	0x1f8d: "\u1f05\u03b9",
+	0x1f8e: "\u1f06\u03b9",
+	0x1f8f: "\u1f07\u03b9",
+	0x1f90: "\u1f20\u03b9",
+	0x1f91: "\u1f21\u03b9",
+	0x1f92: "\u1f22\u03b9",
+	0x1f93: "\u1f23\u03b9",
+	0x1f94: "\u1f24\u03b9",
+	0x1f95: "\u1f25\u03b9",
+	0x1f96: "\u1f26\u03b9",
+	0x1f97: "\u1f27\u03b9",
+	0x1f98: "\u1f20\u03b9",
+	0x1f99: "\u1f21\u03b9",
+	0x1f9a: "\u1f22\u03b9",
+	0x1f9b: "\u1f23\u03b9",
+	0x1f9c: "\u1f24\u03b9",
+	0x1f9d: "\u1f25\u03b9",
+	0x1f9e: "\u1f26\u03b9",
+	0x1f9f: "\u1f27\u03b9",
+	0x1fa0: "\u1f60\u03b9",
+	0x1fa1: "\u1f61\u03b9",
+	0x1fa2: "\u1f62\u03b9",
+	0x1fa3: "\u1f63\u03b9",
+	0x1fa4: "\u1f64\u03b9",
+	0x1fa5: "\u1f65\u03b9",
+	0x1fa6: "\u1f66\u03b9",
+	0x1fa7: "\u1f67\u03b9",
+	0x1fa8: "\u1f60\u03b9",
+	0x1fa9: "\u1f61\u03b9",
+	0x1faa: "\u1f62\u03b9",
+	0x1fab: "\u1f63\u03b9",
+	0x1fac: "\u1f64\u03b9",
+	0x1fad: "\u1f65\u03b9",
+	0x1fae: "\u1f66\u03b9",
+	0x1faf: "\u1f67\u03b9",
+	0x1fb2: "\u1f70\u03b9",
+	0x1fb3: "\u03b1\u03b9",
+	0x1fb4: "\u03ac\u03b9",
+	0x1fb6: "\u03b1\u0342",
+	0x1fb7: "\u03b1\u0342\u03b9",
+	0x1fbc: "\u03b1\u03b9",
+	0x1fc2: "\u1f74\u03b9",
+	0x1fc3: "\u03b7\u03b9",
+	0x1fc4: "\u03ae\u03b9",
+	0x1fc6: "\u03b7\u0342",
+	0x1fc7: "\u03b7\u0342\u03b9",
+	0x1fcc: "\u03b7\u03b9",
+	0x1fd2: "\u03b9\u0308\u0300",
+	0x1fd3: "\u03b9\u0308\u0301",
+	0x1fd6: "\u03b9\u0342",
+	0x1fd7: "\u03b9\u0308\u0342",
+	0x1fe2: "\u03c5\u0308\u0300",
+	0x1fe3: "\u03c5\u0308\u0301",
+	0x1fe4: "\u03c1\u0313",
+	0x1fe6: "\u03c5\u0342",
+	0x1fe7: "\u03c5\u0308\u0342",
+	0x1ff2: "\u1f7c\u03b9",
+	0x1ff3: "\u03c9\u03b9",
+	0x1ff4: "\u03ce\u03b9",
+	0x1ff6: "\u03c9\u0342",
+	0x1ff7: "\u03c9\u0342\u03b9",
+	0x1ffc: "\u03c9\u03b9",
+	0xfb00: "ff",
+	0xfb01: "fi",
+	0xfb02: "fl",
+	0xfb03: "ffi",
+	0xfb04: "ffl",
+	0xfb05: "st",
+	0xfb06: "st",
+	0xfb13: "\u0574\u0576",
+	0xfb14: "\u0574\u0565",
+	0xfb15: "\u0574\u056b",
+	0xfb16: "\u057e\u0576",
+	0xfb17: "\u0574\u056d",
+}
@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"testing"
 
+	compiled "github.com/mna/pigeon/examples/json/compiled"
 	optimized "github.com/mna/pigeon/examples/json/optimized"
 	optimizedgrammar "github.com/mna/pigeon/examples/json/optimized-grammar"
 )
@@ -32,6 +33,12 @@ func TestCmpStdlib(t *testing.T) {
 			continue
 		}
 
+		pcgot, err := compiled.ParseFile(file)
+		if err != nil {
+			t.Errorf("%s: compiled.ParseFile: %v", file, err)
+			continue
+		}
+
 		b, err := os.ReadFile(file)
 		if err != nil {
 			t.Errorf("%s: os.ReadFile: %v", file, err)
@@ -57,6 +64,11 @@ func TestCmpStdlib(t *testing.T) {
 			t.Errorf("%s: optimized grammar not equal", file)
 			continue
 		}
+
+		if !reflect.DeepEqual(pcgot, jgot) {
+			t.Errorf("%s: compiled not equal", file)
+			continue
+		}
 	}
 }
 
@@ -91,10 +103,11 @@ func TestChoiceAltStatistics(t *testing.T) {
 		},
 		{
 			json: `{ "string": "string", "number": 123 }`,
+			// the choice of Value skips Number on the strings, so the choice of
+			// Integer has no "no match".
 			expectedStats: map[string]map[string]int{
 				"Integer 60:11": {
-					"2":        1,
-					"no match": 1,
+					"2": 1,
 				},
 				"String 64:16": {
 					"1":        18,
@@ -121,6 +134,63 @@ func TestChoiceAltStatistics(t *testing.T) {
 	}
 }
 
+func TestRuleStatistics(t *testing.T) {
+	input := `{ "a": [1, 2, 3], "b": [[[[[[true]]]]]] }`
+	for _, memo := range []bool{false, true} {
+		stats := Stats{}
+		_, err := Parse("TestRuleStatistics", []byte(input), Statistics(&stats, "no match"), Memoize(memo))
+		if err != nil {
+			t.Fatalf("memo %t: Expected to parse %s without error, got: %v", memo, input, err)
+		}
+
+		root := stats.Rules["JSON"]
+		if root == nil {
+			t.Fatalf("memo %t: Expected stats for rule JSON, got %#v", memo, stats.Rules)
+		}
+		if root.Invocations != 1 || root.Matches != 1 || root.Bytes != uint64(len(input)) {
+			t.Errorf("memo %t: Expected JSON to match once all input, got %+v", memo, *root)
+		}
+		if root.Time <= 0 || root.SelfTime > root.Time {
+			t.Errorf("memo %t: Expected 0 < self time <= time, got %+v", memo, *root)
+		}
+		// the time of the recursive invocations of Value is counted once
+		if value := stats.Rules["Value"]; value.Time > root.Time {
+			t.Errorf("memo %t: Expected Value time <= JSON time, got %v > %v", memo, value.Time, root.Time)
+		}
+		if got := stats.Rules["Integer"].Matches; got != 3 {
+			t.Errorf("memo %t: Expected 3 Integer matches, got %d", memo, got)
+		}
+
+		var hits, misses, backtracked uint64
+		for nm, rs := range stats.Rules {
+			if rs.Invocations != rs.Matches+rs.Failures {
+				t.Errorf("memo %t: %s: Expected invocations to be matches + failures, got %+v", memo, nm, *rs)
+			}
+			hits += rs.MemoHits
+			misses += rs.MemoMisses
+			backtracked += rs.Backtracked
+		}
+		if memo != (misses > 0) {
+			t.Errorf("memo %t: Expected memo misses to be recorded only if memoizing, got %d", memo, misses)
+		}
+		if !memo && hits > 0 {
+			t.Errorf("memo %t: Expected no memo hits, got %d", memo, hits)
+		}
+		if backtracked == 0 {
+			t.Errorf("memo %t: Expected backtracked bytes", memo)
+		}
+	}
+
+	// no per-rule statistics without the Statistics option
+	p := newParser("", []byte(input))
+	if _, err := p.parse(g); err != nil {
+		t.Fatal(err)
+	}
+	if p.Rules != nil {
+		t.Errorf("Expected no rule statistics without the Statistics option, got %#v", p.Rules)
+	}
+}
+
 func TestZeroZero(t *testing.T) {
 	_, err := Parse(`fuzz`, []byte(`00`))
 	if err == nil {
@@ -193,6 +263,20 @@ func BenchmarkPigeonJSONOptimizedGrammar(b *testing.B) {
 	}
 }
 
+func BenchmarkPigeonJSONCompiled(b *testing.B) {
+	d, err := os.ReadFile("testdata/github-octokit-repos.json")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := compiled.Parse("", d); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func BenchmarkStdlibJSON(b *testing.B) {
 	d, err := os.ReadFile("testdata/github-octokit-repos.json")
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 	"unicode"
 	"unicode/utf8"
 )
@@ -34,16 +35,21 @@ var g = &grammar{
 		{
 			name: "JSON",
 			pos:  position{line: 17, col: 1, offset: 321},
+			id:   0,
 			expr: &actionExpr{
 				pos: position{line: 17, col: 8, offset: 330},
+				id:  4,
 				run: (*parser).callonJSON1,
 				expr: &seqExpr{
 					pos: position{line: 17, col: 8, offset: 330},
+					id:  5,
 					exprs: []any{
 						&zeroOrMoreExpr{
 							pos: position{line: 87, col: 18, offset: 2056},
+							id:  6,
 							expr: &charClassMatcher{
 								pos:        position{line: 87, col: 18, offset: 2056},
+								id:         7,
 								val:        "[ \\t\\r\\n]",
 								chars:      []rune{' ', '\t', '\r', '\n'},
 								ignoreCase: false,
@@ -52,16 +58,20 @@ var g = &grammar{
 						},
 						&labeledExpr{
 							pos:   position{line: 17, col: 10, offset: 332},
+							id:    8,
 							label: "val",
 							expr: &ruleRefExpr{
 								pos:  position{line: 17, col: 14, offset: 336},
+								id:   9,
 								name: "Value",
 							},
 						},
 						&notExpr{
 							pos: position{line: 89, col: 7, offset: 2076},
+							id:  10,
 							expr: &anyMatcher{
-								line: 89, col: 8, offset: 2077,
+								pos: position{line: 89, col: 8, offset: 2077},
+								id:  11,
 							},
 						},
 					},
@@ -71,36 +81,47 @@ var g = &grammar{
 		{
 			name: "Value",
 			pos:  position{line: 21, col: 1, offset: 371},
+			id:   1,
 			expr: &actionExpr{
 				pos: position{line: 21, col: 9, offset: 381},
+				id:  12,
 				run: (*parser).callonValue1,
 				expr: &seqExpr{
 					pos: position{line: 21, col: 9, offset: 381},
+					id:  13,
 					exprs: []any{
 						&labeledExpr{
 							pos:   position{line: 21, col: 9, offset: 381},
+							id:    14,
 							label: "val",
 							expr: &choiceExpr{
 								pos: position{line: 21, col: 15, offset: 387},
+								id:  15,
 								alternatives: []any{
 									&ruleRefExpr{
 										pos:  position{line: 21, col: 15, offset: 387},
+										id:   16,
 										name: "Object",
 									},
 									&ruleRefExpr{
 										pos:  position{line: 21, col: 24, offset: 396},
+										id:   17,
 										name: "Array",
 									},
 									&actionExpr{
 										pos: position{line: 54, col: 10, offset: 1215},
+										id:  18,
 										run: (*parser).callonValue7,
 										expr: &seqExpr{
 											pos: position{line: 54, col: 10, offset: 1215},
+											id:  19,
 											exprs: []any{
 												&zeroOrOneExpr{
 													pos: position{line: 54, col: 10, offset: 1215},
+													id:  20,
 													expr: &litMatcher{
 														pos:        position{line: 54, col: 10, offset: 1215},
+														id:         21,
 														val:        "-",
 														ignoreCase: false,
 														want:       "\"-\"",
@@ -108,18 +129,22 @@ var g = &grammar{
 												},
 												&choiceExpr{
 													pos: position{line: 60, col: 11, offset: 1418},
+													id:  22,
 													alternatives: []any{
 														&litMatcher{
 															pos:        position{line: 60, col: 11, offset: 1418},
+															id:         23,
 															val:        "0",
 															ignoreCase: false,
 															want:       "\"0\"",
 														},
 														&seqExpr{
 															pos: position{line: 60, col: 17, offset: 1424},
+															id:  24,
 															exprs: []any{
 																&charClassMatcher{
 																	pos:        position{line: 79, col: 23, offset: 1899},
+																	id:         25,
 																	val:        "[1-9]",
 																	ranges:     []rune{'1', '9'},
 																	ignoreCase: false,
@@ -127,8 +152,10 @@ var g = &grammar{
 																},
 																&zeroOrMoreExpr{
 																	pos: position{line: 60, col: 37, offset: 1444},
+																	id:  26,
 																	expr: &charClassMatcher{
 																		pos:        position{line: 77, col: 16, offset: 1868},
+																		id:         27,
 																		val:        "[0-9]",
 																		ranges:     []rune{'0', '9'},
 																		ignoreCase: false,
@@ -138,22 +165,34 @@ var g = &grammar{
 															},
 														},
 													},
+													dispatch: &choiceDispatch{
+														ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+														ranges:    []rune{'\u0080'},
+														rangeSets: "\x00",
+														alts:      [][]int{{}, {0}, {1}},
+														expected:  [][]string{{"\"0\"", "[1-9]"}, {"[1-9]"}, {"\"0\""}},
+													},
 												},
 												&zeroOrOneExpr{
 													pos: position{line: 54, col: 23, offset: 1228},
+													id:  28,
 													expr: &seqExpr{
 														pos: position{line: 54, col: 25, offset: 1230},
+														id:  29,
 														exprs: []any{
 															&litMatcher{
 																pos:        position{line: 54, col: 25, offset: 1230},
+																id:         30,
 																val:        ".",
 																ignoreCase: false,
 																want:       "\".\"",
 															},
 															&oneOrMoreExpr{
 																pos: position{line: 54, col: 29, offset: 1234},
+																id:  31,
 																expr: &charClassMatcher{
 																	pos:        position{line: 77, col: 16, offset: 1868},
+																	id:         32,
 																	val:        "[0-9]",
 																	ranges:     []rune{'0', '9'},
 																	ignoreCase: false,
@@ -165,19 +204,24 @@ var g = &grammar{
 												},
 												&zeroOrOneExpr{
 													pos: position{line: 54, col: 46, offset: 1251},
+													id:  33,
 													expr: &seqExpr{
 														pos: position{line: 62, col: 12, offset: 1472},
+														id:  34,
 														exprs: []any{
 															&litMatcher{
 																pos:        position{line: 62, col: 12, offset: 1472},
+																id:         35,
 																val:        "e",
 																ignoreCase: true,
 																want:       "\"e\"i",
 															},
 															&zeroOrOneExpr{
 																pos: position{line: 62, col: 17, offset: 1477},
+																id:  36,
 																expr: &charClassMatcher{
 																	pos:        position{line: 62, col: 17, offset: 1477},
+																	id:         37,
 																	val:        "[+-]",
 																	chars:      []rune{'+', '-'},
 																	ignoreCase: false,
@@ -186,8 +230,10 @@ var g = &grammar{
 															},
 															&oneOrMoreExpr{
 																pos: position{line: 62, col: 23, offset: 1483},
+																id:  38,
 																expr: &charClassMatcher{
 																	pos:        position{line: 77, col: 16, offset: 1868},
+																	id:         39,
 																	val:        "[0-9]",
 																	ranges:     []rune{'0', '9'},
 																	ignoreCase: false,
@@ -202,28 +248,36 @@ var g = &grammar{
 									},
 									&actionExpr{
 										pos: position{line: 64, col: 10, offset: 1509},
+										id:  40,
 										run: (*parser).callonValue29,
 										expr: &seqExpr{
 											pos: position{line: 64, col: 10, offset: 1509},
+											id:  41,
 											exprs: []any{
 												&litMatcher{
 													pos:        position{line: 64, col: 10, offset: 1509},
+													id:         42,
 													val:        "\"",
 													ignoreCase: false,
 													want:       "\"\\\"\"",
 												},
 												&zeroOrMoreExpr{
 													pos: position{line: 64, col: 14, offset: 1513},
+													id:  43,
 													expr: &choiceExpr{
 														pos: position{line: 64, col: 16, offset: 1515},
+														id:  44,
 														alternatives: []any{
 															&seqExpr{
 																pos: position{line: 64, col: 16, offset: 1515},
+																id:  45,
 																exprs: []any{
 																	&notExpr{
 																		pos: position{line: 64, col: 16, offset: 1515},
+																		id:  46,
 																		expr: &charClassMatcher{
 																			pos:        position{line: 69, col: 15, offset: 1689},
+																			id:         47,
 																			val:        "[\"\\\\\\x00-\\x1f]",
 																			chars:      []rune{'"', '\\'},
 																			ranges:     []rune{'\x00', '\x1f'},
@@ -232,24 +286,29 @@ var g = &grammar{
 																		},
 																	},
 																	&anyMatcher{
-																		line: 64, col: 29, offset: 1528,
+																		pos: position{line: 64, col: 29, offset: 1528},
+																		id:  48,
 																	},
 																},
 															},
 															&seqExpr{
 																pos: position{line: 64, col: 33, offset: 1532},
+																id:  49,
 																exprs: []any{
 																	&litMatcher{
 																		pos:        position{line: 64, col: 33, offset: 1532},
+																		id:         50,
 																		val:        "\\",
 																		ignoreCase: false,
 																		want:       "\"\\\\\"",
 																	},
 																	&choiceExpr{
 																		pos: position{line: 71, col: 18, offset: 1724},
+																		id:  51,
 																		alternatives: []any{
 																			&charClassMatcher{
 																				pos:        position{line: 73, col: 20, offset: 1779},
+																				id:         52,
 																				val:        "[\"\\\\/bfnrt]",
 																				chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
 																				ignoreCase: false,
@@ -257,15 +316,18 @@ var g = &grammar{
 																			},
 																			&seqExpr{
 																				pos: position{line: 75, col: 17, offset: 1810},
+																				id:  53,
 																				exprs: []any{
 																					&litMatcher{
 																						pos:        position{line: 75, col: 17, offset: 1810},
+																						id:         54,
 																						val:        "u",
 																						ignoreCase: false,
 																						want:       "\"u\"",
 																					},
 																					&charClassMatcher{
 																						pos:        position{line: 81, col: 12, offset: 1919},
+																						id:         55,
 																						val:        "[0-9a-f]i",
 																						ranges:     []rune{'0', '9', 'a', 'f'},
 																						ignoreCase: true,
@@ -273,6 +335,7 @@ var g = &grammar{
 																					},
 																					&charClassMatcher{
 																						pos:        position{line: 81, col: 12, offset: 1919},
+																						id:         56,
 																						val:        "[0-9a-f]i",
 																						ranges:     []rune{'0', '9', 'a', 'f'},
 																						ignoreCase: true,
@@ -280,6 +343,7 @@ var g = &grammar{
 																					},
 																					&charClassMatcher{
 																						pos:        position{line: 81, col: 12, offset: 1919},
+																						id:         57,
 																						val:        "[0-9a-f]i",
 																						ranges:     []rune{'0', '9', 'a', 'f'},
 																						ignoreCase: true,
@@ -287,6 +351,7 @@ var g = &grammar{
 																					},
 																					&charClassMatcher{
 																						pos:        position{line: 81, col: 12, offset: 1919},
+																						id:         58,
 																						val:        "[0-9a-f]i",
 																						ranges:     []rune{'0', '9', 'a', 'f'},
 																						ignoreCase: true,
@@ -295,14 +360,29 @@ var g = &grammar{
 																				},
 																			},
 																		},
+																		dispatch: &choiceDispatch{
+																			ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01\x00\x01\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+																			ranges:    []rune{'\u0080'},
+																			rangeSets: "\x00",
+																			alts:      [][]int{{}, {0}, {1}},
+																			expected:  [][]string{{"[\"\\\\/bfnrt]", "\"u\""}, {"\"u\""}, {"[\"\\\\/bfnrt]"}},
+																		},
 																	},
 																},
 															},
 														},
+														dispatch: &choiceDispatch{
+															ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+															ranges:    []rune{'\u0080'},
+															rangeSets: "\x00",
+															alts:      [][]int{{0}, {0, 1}},
+															expected:  [][]string{{"\"\\\\\""}, {}},
+														},
 													},
 												},
 												&litMatcher{
 													pos:        position{line: 64, col: 56, offset: 1555},
+													id:         59,
 													val:        "\"",
 													ignoreCase: false,
 													want:       "\"\\\"\"",
@@ -312,9 +392,11 @@ var g = &grammar{
 									},
 									&actionExpr{
 										pos: position{line: 83, col: 8, offset: 1939},
+										id:  60,
 										run: (*parser).callonValue49,
 										expr: &litMatcher{
 											pos:        position{line: 83, col: 8, offset: 1939},
+											id:         61,
 											val:        "true",
 											ignoreCase: false,
 											want:       "\"true\"",
@@ -322,9 +404,11 @@ var g = &grammar{
 									},
 									&actionExpr{
 										pos: position{line: 83, col: 38, offset: 1969},
+										id:  62,
 										run: (*parser).callonValue51,
 										expr: &litMatcher{
 											pos:        position{line: 83, col: 38, offset: 1969},
+											id:         63,
 											val:        "false",
 											ignoreCase: false,
 											want:       "\"false\"",
@@ -332,21 +416,32 @@ var g = &grammar{
 									},
 									&actionExpr{
 										pos: position{line: 85, col: 8, offset: 2009},
+										id:  64,
 										run: (*parser).callonValue53,
 										expr: &litMatcher{
 											pos:        position{line: 85, col: 8, offset: 2009},
+											id:         65,
 											val:        "null",
 											ignoreCase: false,
 											want:       "\"null\"",
 										},
 									},
 								},
+								dispatch: &choiceDispatch{
+									ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x04\x00\x00\x00\x00\x00\x00\x00\x05\x00\x00\x00\x00\x00\x06\x00\x00\x00\x00\x00\x00\a\x00\x00\x00\x00",
+									ranges:    []rune{'\u0080'},
+									rangeSets: "\x00",
+									alts:      [][]int{{}, {3}, {2}, {1}, {5}, {6}, {4}, {0}},
+									expected:  [][]string{{"\"{\"", "\"[\"", "\"-\"", "\"0\"", "[1-9]", "\"\\\"\"", "\"true\"", "\"false\"", "\"null\""}, {"\"{\"", "\"[\"", "\"-\"", "\"0\"", "[1-9]", "\"true\"", "\"false\"", "\"null\""}, {"\"{\"", "\"[\"", "\"\\\"\"", "\"true\"", "\"false\"", "\"null\""}, {"\"{\"", "\"-\"", "\"0\"", "[1-9]", "\"\\\"\"", "\"true\"", "\"false\"", "\"null\""}, {"\"{\"", "\"[\"", "\"-\"", "\"0\"", "[1-9]", "\"\\\"\"", "\"true\"", "\"null\""}, {"\"{\"", "\"[\"", "\"-\"", "\"0\"", "[1-9]", "\"\\\"\"", "\"true\"", "\"false\""}, {"\"{\"", "\"[\"", "\"-\"", "\"0\"", "[1-9]", "\"\\\"\"", "\"false\"", "\"null\""}, {"\"[\"", "\"-\"", "\"0\"", "[1-9]", "\"\\\"\"", "\"true\"", "\"false\"", "\"null\""}},
+								},
 							},
 						},
 						&zeroOrMoreExpr{
 							pos: position{line: 87, col: 18, offset: 2056},
+							id:  66,
 							expr: &charClassMatcher{
 								pos:        position{line: 87, col: 18, offset: 2056},
+								id:         67,
 								val:        "[ \\t\\r\\n]",
 								chars:      []rune{' ', '\t', '\r', '\n'},
 								ignoreCase: false,
@@ -360,22 +455,28 @@ var g = &grammar{
 		{
 			name: "Object",
 			pos:  position{line: 25, col: 1, offset: 463},
+			id:   2,
 			expr: &actionExpr{
 				pos: position{line: 25, col: 10, offset: 474},
+				id:  68,
 				run: (*parser).callonObject1,
 				expr: &seqExpr{
 					pos: position{line: 25, col: 10, offset: 474},
+					id:  69,
 					exprs: []any{
 						&litMatcher{
 							pos:        position{line: 25, col: 10, offset: 474},
+							id:         70,
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&zeroOrMoreExpr{
 							pos: position{line: 87, col: 18, offset: 2056},
+							id:  71,
 							expr: &charClassMatcher{
 								pos:        position{line: 87, col: 18, offset: 2056},
+								id:         72,
 								val:        "[ \\t\\r\\n]",
 								chars:      []rune{' ', '\t', '\r', '\n'},
 								ignoreCase: false,
@@ -384,36 +485,47 @@ var g = &grammar{
 						},
 						&labeledExpr{
 							pos:   position{line: 25, col: 16, offset: 480},
+							id:    73,
 							label: "vals",
 							expr: &zeroOrOneExpr{
 								pos: position{line: 25, col: 21, offset: 485},
+								id:  74,
 								expr: &seqExpr{
 									pos: position{line: 25, col: 23, offset: 487},
+									id:  75,
 									exprs: []any{
 										&actionExpr{
 											pos: position{line: 64, col: 10, offset: 1509},
+											id:  76,
 											run: (*parser).callonObject9,
 											expr: &seqExpr{
 												pos: position{line: 64, col: 10, offset: 1509},
+												id:  77,
 												exprs: []any{
 													&litMatcher{
 														pos:        position{line: 64, col: 10, offset: 1509},
+														id:         78,
 														val:        "\"",
 														ignoreCase: false,
 														want:       "\"\\\"\"",
 													},
 													&zeroOrMoreExpr{
 														pos: position{line: 64, col: 14, offset: 1513},
+														id:  79,
 														expr: &choiceExpr{
 															pos: position{line: 64, col: 16, offset: 1515},
+															id:  80,
 															alternatives: []any{
 																&seqExpr{
 																	pos: position{line: 64, col: 16, offset: 1515},
+																	id:  81,
 																	exprs: []any{
 																		&notExpr{
 																			pos: position{line: 64, col: 16, offset: 1515},
+																			id:  82,
 																			expr: &charClassMatcher{
 																				pos:        position{line: 69, col: 15, offset: 1689},
+																				id:         83,
 																				val:        "[\"\\\\\\x00-\\x1f]",
 																				chars:      []rune{'"', '\\'},
 																				ranges:     []rune{'\x00', '\x1f'},
@@ -422,24 +534,29 @@ var g = &grammar{
 																			},
 																		},
 																		&anyMatcher{
-																			line: 64, col: 29, offset: 1528,
+																			pos: position{line: 64, col: 29, offset: 1528},
+																			id:  84,
 																		},
 																	},
 																},
 																&seqExpr{
 																	pos: position{line: 64, col: 33, offset: 1532},
+																	id:  85,
 																	exprs: []any{
 																		&litMatcher{
 																			pos:        position{line: 64, col: 33, offset: 1532},
+																			id:         86,
 																			val:        "\\",
 																			ignoreCase: false,
 																			want:       "\"\\\\\"",
 																		},
 																		&choiceExpr{
 																			pos: position{line: 71, col: 18, offset: 1724},
+																			id:  87,
 																			alternatives: []any{
 																				&charClassMatcher{
 																					pos:        position{line: 73, col: 20, offset: 1779},
+																					id:         88,
 																					val:        "[\"\\\\/bfnrt]",
 																					chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
 																					ignoreCase: false,
@@ -447,15 +564,18 @@ var g = &grammar{
 																				},
 																				&seqExpr{
 																					pos: position{line: 75, col: 17, offset: 1810},
+																					id:  89,
 																					exprs: []any{
 																						&litMatcher{
 																							pos:        position{line: 75, col: 17, offset: 1810},
+																							id:         90,
 																							val:        "u",
 																							ignoreCase: false,
 																							want:       "\"u\"",
 																						},
 																						&charClassMatcher{
 																							pos:        position{line: 81, col: 12, offset: 1919},
+																							id:         91,
 																							val:        "[0-9a-f]i",
 																							ranges:     []rune{'0', '9', 'a', 'f'},
 																							ignoreCase: true,
@@ -463,6 +583,7 @@ var g = &grammar{
 																						},
 																						&charClassMatcher{
 																							pos:        position{line: 81, col: 12, offset: 1919},
+																							id:         92,
 																							val:        "[0-9a-f]i",
 																							ranges:     []rune{'0', '9', 'a', 'f'},
 																							ignoreCase: true,
@@ -470,6 +591,7 @@ var g = &grammar{
 																						},
 																						&charClassMatcher{
 																							pos:        position{line: 81, col: 12, offset: 1919},
+																							id:         93,
 																							val:        "[0-9a-f]i",
 																							ranges:     []rune{'0', '9', 'a', 'f'},
 																							ignoreCase: true,
@@ -477,6 +599,7 @@ var g = &grammar{
 																						},
 																						&charClassMatcher{
 																							pos:        position{line: 81, col: 12, offset: 1919},
+																							id:         94,
 																							val:        "[0-9a-f]i",
 																							ranges:     []rune{'0', '9', 'a', 'f'},
 																							ignoreCase: true,
@@ -485,14 +608,29 @@ var g = &grammar{
 																					},
 																				},
 																			},
+																			dispatch: &choiceDispatch{
+																				ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01\x00\x01\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+																				ranges:    []rune{'\u0080'},
+																				rangeSets: "\x00",
+																				alts:      [][]int{{}, {0}, {1}},
+																				expected:  [][]string{{"[\"\\\\/bfnrt]", "\"u\""}, {"\"u\""}, {"[\"\\\\/bfnrt]"}},
+																			},
 																		},
 																	},
 																},
 															},
+															dispatch: &choiceDispatch{
+																ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+																ranges:    []rune{'\u0080'},
+																rangeSets: "\x00",
+																alts:      [][]int{{0}, {0, 1}},
+																expected:  [][]string{{"\"\\\\\""}, {}},
+															},
 														},
 													},
 													&litMatcher{
 														pos:        position{line: 64, col: 56, offset: 1555},
+														id:         95,
 														val:        "\"",
 														ignoreCase: false,
 														want:       "\"\\\"\"",
@@ -502,8 +640,10 @@ var g = &grammar{
 										},
 										&zeroOrMoreExpr{
 											pos: position{line: 87, col: 18, offset: 2056},
+											id:  96,
 											expr: &charClassMatcher{
 												pos:        position{line: 87, col: 18, offset: 2056},
+												id:         97,
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
@@ -512,14 +652,17 @@ var g = &grammar{
 										},
 										&litMatcher{
 											pos:        position{line: 25, col: 32, offset: 496},
+											id:         98,
 											val:        ":",
 											ignoreCase: false,
 											want:       "\":\"",
 										},
 										&zeroOrMoreExpr{
 											pos: position{line: 87, col: 18, offset: 2056},
+											id:  99,
 											expr: &charClassMatcher{
 												pos:        position{line: 87, col: 18, offset: 2056},
+												id:         100,
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
@@ -528,23 +671,29 @@ var g = &grammar{
 										},
 										&ruleRefExpr{
 											pos:  position{line: 25, col: 38, offset: 502},
+											id:   101,
 											name: "Value",
 										},
 										&zeroOrMoreExpr{
 											pos: position{line: 25, col: 44, offset: 508},
+											id:  102,
 											expr: &seqExpr{
 												pos: position{line: 25, col: 46, offset: 510},
+												id:  103,
 												exprs: []any{
 													&litMatcher{
 														pos:        position{line: 25, col: 46, offset: 510},
+														id:         104,
 														val:        ",",
 														ignoreCase: false,
 														want:       "\",\"",
 													},
 													&zeroOrMoreExpr{
 														pos: position{line: 87, col: 18, offset: 2056},
+														id:  105,
 														expr: &charClassMatcher{
 															pos:        position{line: 87, col: 18, offset: 2056},
+															id:         106,
 															val:        "[ \\t\\r\\n]",
 															chars:      []rune{' ', '\t', '\r', '\n'},
 															ignoreCase: false,
@@ -553,28 +702,36 @@ var g = &grammar{
 													},
 													&actionExpr{
 														pos: position{line: 64, col: 10, offset: 1509},
+														id:  107,
 														run: (*parser).callonObject40,
 														expr: &seqExpr{
 															pos: position{line: 64, col: 10, offset: 1509},
+															id:  108,
 															exprs: []any{
 																&litMatcher{
 																	pos:        position{line: 64, col: 10, offset: 1509},
+																	id:         109,
 																	val:        "\"",
 																	ignoreCase: false,
 																	want:       "\"\\\"\"",
 																},
 																&zeroOrMoreExpr{
 																	pos: position{line: 64, col: 14, offset: 1513},
+																	id:  110,
 																	expr: &choiceExpr{
 																		pos: position{line: 64, col: 16, offset: 1515},
+																		id:  111,
 																		alternatives: []any{
 																			&seqExpr{
 																				pos: position{line: 64, col: 16, offset: 1515},
+																				id:  112,
 																				exprs: []any{
 																					&notExpr{
 																						pos: position{line: 64, col: 16, offset: 1515},
+																						id:  113,
 																						expr: &charClassMatcher{
 																							pos:        position{line: 69, col: 15, offset: 1689},
+																							id:         114,
 																							val:        "[\"\\\\\\x00-\\x1f]",
 																							chars:      []rune{'"', '\\'},
 																							ranges:     []rune{'\x00', '\x1f'},
@@ -583,24 +740,29 @@ var g = &grammar{
 																						},
 																					},
 																					&anyMatcher{
-																						line: 64, col: 29, offset: 1528,
+																						pos: position{line: 64, col: 29, offset: 1528},
+																						id:  115,
 																					},
 																				},
 																			},
 																			&seqExpr{
 																				pos: position{line: 64, col: 33, offset: 1532},
+																				id:  116,
 																				exprs: []any{
 																					&litMatcher{
 																						pos:        position{line: 64, col: 33, offset: 1532},
+																						id:         117,
 																						val:        "\\",
 																						ignoreCase: false,
 																						want:       "\"\\\\\"",
 																					},
 																					&choiceExpr{
 																						pos: position{line: 71, col: 18, offset: 1724},
+																						id:  118,
 																						alternatives: []any{
 																							&charClassMatcher{
 																								pos:        position{line: 73, col: 20, offset: 1779},
+																								id:         119,
 																								val:        "[\"\\\\/bfnrt]",
 																								chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
 																								ignoreCase: false,
@@ -608,15 +770,18 @@ var g = &grammar{
 																							},
 																							&seqExpr{
 																								pos: position{line: 75, col: 17, offset: 1810},
+																								id:  120,
 																								exprs: []any{
 																									&litMatcher{
 																										pos:        position{line: 75, col: 17, offset: 1810},
+																										id:         121,
 																										val:        "u",
 																										ignoreCase: false,
 																										want:       "\"u\"",
 																									},
 																									&charClassMatcher{
 																										pos:        position{line: 81, col: 12, offset: 1919},
+																										id:         122,
 																										val:        "[0-9a-f]i",
 																										ranges:     []rune{'0', '9', 'a', 'f'},
 																										ignoreCase: true,
@@ -624,6 +789,7 @@ var g = &grammar{
 																									},
 																									&charClassMatcher{
 																										pos:        position{line: 81, col: 12, offset: 1919},
+																										id:         123,
 																										val:        "[0-9a-f]i",
 																										ranges:     []rune{'0', '9', 'a', 'f'},
 																										ignoreCase: true,
@@ -631,6 +797,7 @@ var g = &grammar{
 																									},
 																									&charClassMatcher{
 																										pos:        position{line: 81, col: 12, offset: 1919},
+																										id:         124,
 																										val:        "[0-9a-f]i",
 																										ranges:     []rune{'0', '9', 'a', 'f'},
 																										ignoreCase: true,
@@ -638,6 +805,7 @@ var g = &grammar{
 																									},
 																									&charClassMatcher{
 																										pos:        position{line: 81, col: 12, offset: 1919},
+																										id:         125,
 																										val:        "[0-9a-f]i",
 																										ranges:     []rune{'0', '9', 'a', 'f'},
 																										ignoreCase: true,
@@ -646,14 +814,29 @@ var g = &grammar{
 																								},
 																							},
 																						},
+																						dispatch: &choiceDispatch{
+																							ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01\x00\x01\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+																							ranges:    []rune{'\u0080'},
+																							rangeSets: "\x00",
+																							alts:      [][]int{{}, {0}, {1}},
+																							expected:  [][]string{{"[\"\\\\/bfnrt]", "\"u\""}, {"\"u\""}, {"[\"\\\\/bfnrt]"}},
+																						},
 																					},
 																				},
 																			},
 																		},
+																		dispatch: &choiceDispatch{
+																			ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+																			ranges:    []rune{'\u0080'},
+																			rangeSets: "\x00",
+																			alts:      [][]int{{0}, {0, 1}},
+																			expected:  [][]string{{"\"\\\\\""}, {}},
+																		},
 																	},
 																},
 																&litMatcher{
 																	pos:        position{line: 64, col: 56, offset: 1555},
+																	id:         126,
 																	val:        "\"",
 																	ignoreCase: false,
 																	want:       "\"\\\"\"",
@@ -663,8 +846,10 @@ var g = &grammar{
 													},
 													&zeroOrMoreExpr{
 														pos: position{line: 87, col: 18, offset: 2056},
+														id:  127,
 														expr: &charClassMatcher{
 															pos:        position{line: 87, col: 18, offset: 2056},
+															id:         128,
 															val:        "[ \\t\\r\\n]",
 															chars:      []rune{' ', '\t', '\r', '\n'},
 															ignoreCase: false,
@@ -673,14 +858,17 @@ var g = &grammar{
 													},
 													&litMatcher{
 														pos:        position{line: 25, col: 61, offset: 525},
+														id:         129,
 														val:        ":",
 														ignoreCase: false,
 														want:       "\":\"",
 													},
 													&zeroOrMoreExpr{
 														pos: position{line: 87, col: 18, offset: 2056},
+														id:  130,
 														expr: &charClassMatcher{
 															pos:        position{line: 87, col: 18, offset: 2056},
+															id:         131,
 															val:        "[ \\t\\r\\n]",
 															chars:      []rune{' ', '\t', '\r', '\n'},
 															ignoreCase: false,
@@ -689,6 +877,7 @@ var g = &grammar{
 													},
 													&ruleRefExpr{
 														pos:  position{line: 25, col: 67, offset: 531},
+														id:   132,
 														name: "Value",
 													},
 												},
@@ -700,6 +889,7 @@ var g = &grammar{
 						},
 						&litMatcher{
 							pos:        position{line: 25, col: 79, offset: 543},
+							id:         133,
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -711,22 +901,28 @@ var g = &grammar{
 		{
 			name: "Array",
 			pos:  position{line: 40, col: 1, offset: 871},
+			id:   3,
 			expr: &actionExpr{
 				pos: position{line: 40, col: 9, offset: 881},
+				id:  134,
 				run: (*parser).callonArray1,
 				expr: &seqExpr{
 					pos: position{line: 40, col: 9, offset: 881},
+					id:  135,
 					exprs: []any{
 						&litMatcher{
 							pos:        position{line: 40, col: 9, offset: 881},
+							id:         136,
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&zeroOrMoreExpr{
 							pos: position{line: 87, col: 18, offset: 2056},
+							id:  137,
 							expr: &charClassMatcher{
 								pos:        position{line: 87, col: 18, offset: 2056},
+								id:         138,
 								val:        "[ \\t\\r\\n]",
 								chars:      []rune{' ', '\t', '\r', '\n'},
 								ignoreCase: false,
@@ -735,31 +931,40 @@ var g = &grammar{
 						},
 						&labeledExpr{
 							pos:   position{line: 40, col: 15, offset: 887},
+							id:    139,
 							label: "vals",
 							expr: &zeroOrOneExpr{
 								pos: position{line: 40, col: 20, offset: 892},
+								id:  140,
 								expr: &seqExpr{
 									pos: position{line: 40, col: 22, offset: 894},
+									id:  141,
 									exprs: []any{
 										&ruleRefExpr{
 											pos:  position{line: 40, col: 22, offset: 894},
+											id:   142,
 											name: "Value",
 										},
 										&zeroOrMoreExpr{
 											pos: position{line: 40, col: 28, offset: 900},
+											id:  143,
 											expr: &seqExpr{
 												pos: position{line: 40, col: 30, offset: 902},
+												id:  144,
 												exprs: []any{
 													&litMatcher{
 														pos:        position{line: 40, col: 30, offset: 902},
+														id:         145,
 														val:        ",",
 														ignoreCase: false,
 														want:       "\",\"",
 													},
 													&zeroOrMoreExpr{
 														pos: position{line: 87, col: 18, offset: 2056},
+														id:  146,
 														expr: &charClassMatcher{
 															pos:        position{line: 87, col: 18, offset: 2056},
+															id:         147,
 															val:        "[ \\t\\r\\n]",
 															chars:      []rune{' ', '\t', '\r', '\n'},
 															ignoreCase: false,
@@ -768,6 +973,7 @@ var g = &grammar{
 													},
 													&ruleRefExpr{
 														pos:  position{line: 40, col: 36, offset: 908},
+														id:   148,
 														name: "Value",
 													},
 												},
@@ -779,6 +985,7 @@ var g = &grammar{
 						},
 						&litMatcher{
 							pos:        position{line: 40, col: 48, offset: 920},
+							id:         149,
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -980,7 +1187,8 @@ func Entrypoint(ruleName string) Option {
 
 // Statistics adds a user provided Stats struct to the parser to allow
 // the user to process the results after the parsing has finished.
-// Also the key for the "no match" counter is set.
+// Also the key for the "no match" counter is set. Per-rule statistics
+// (see RuleStats) are only collected when this option is set.
 //
 // Example usage:
 //
@@ -1004,6 +1212,9 @@ func Statistics(stats *Stats, choiceNoMatch string) Option {
 		if p.Stats.ChoiceAltCnt == nil {
 			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
 		}
+		if p.Stats.Rules == nil {
+			p.Stats.Rules = make(map[string]*RuleStats)
+		}
 		return Statistics(oldStats, oldChoiceNoMatch)
 	}
 }
@@ -1024,6 +1235,9 @@ func Debug(b bool) Option {
 // the parser will cache all results so each expression is evaluated only
 // once. This guarantees linear parsing time even for pathological cases,
 // at the expense of more memory and slower times for typical cases.
+// The rules annotated with @memo in the grammar are always memoized,
+// regardless of this option. The memoization table is pooled, so its
+// storage is reused by the following parses.
 //
 // The default is false.
 func Memoize(b bool) Option {
@@ -1048,6 +1262,57 @@ func AllowInvalidUTF8(b bool) Option {
 	}
 }
 
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
 // Recover creates an Option to set the recover flag to b. When set to
 // true, this causes the parser to recover from panics and convert it
 // to an error. Setting it to false can be useful while debugging to
@@ -1163,17 +1428,68 @@ type rule struct {
 	name        string
 	displayName string
 	expr        any
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+//
+//	nolint: structcheck
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
 }
 
 // nolint: structcheck
 type choiceExpr struct {
 	pos          position
+	id           int
 	alternatives []any
+	dispatch     *choiceDispatch
 }
 
 // nolint: structcheck
 type actionExpr struct {
 	pos  position
+	id   int
 	expr any
 	run  func(*parser) (any, error)
 }
@@ -1181,6 +1497,7 @@ type actionExpr struct {
 // nolint: structcheck
 type recoveryExpr struct {
 	pos          position
+	id           int
 	expr         any
 	recoverExpr  any
 	failureLabel []string
@@ -1189,18 +1506,14 @@ type recoveryExpr struct {
 // nolint: structcheck
 type seqExpr struct {
 	pos   position
+	id    int
 	exprs []any
 }
 
-// nolint: structcheck
-type throwExpr struct {
-	pos   position
-	label string
-}
-
 // nolint: structcheck
 type labeledExpr struct {
 	pos   position
+	id    int
 	label string
 	expr  any
 }
@@ -1208,9 +1521,20 @@ type labeledExpr struct {
 // nolint: structcheck
 type expr struct {
 	pos  position
+	id   int
 	expr any
 }
 
+// nolint: structcheck
+type repeatExpr struct {
+	pos  position
+	id   int
+	expr any
+	// max is -1 if the number of repetitions is not bounded.
+	min int
+	max int
+}
+
 type (
 	andExpr        expr // nolint: structcheck
 	notExpr        expr // nolint: structcheck
@@ -1222,30 +1546,35 @@ type (
 // nolint: structcheck
 type ruleRefExpr struct {
 	pos  position
+	id   int
 	name string
 }
 
 // nolint: structcheck
 type stateCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) error
 }
 
 // nolint: structcheck
 type andCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) (bool, error)
 }
 
 // nolint: structcheck
 type notCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) (bool, error)
 }
 
 // nolint: structcheck
 type litMatcher struct {
 	pos        position
+	id         int
 	val        string
 	ignoreCase bool
 	want       string
@@ -1254,6 +1583,7 @@ type litMatcher struct {
 // nolint: structcheck
 type charClassMatcher struct {
 	pos             position
+	id              int
 	val             string
 	basicLatinChars [128]bool
 	chars           []rune
@@ -1263,7 +1593,61 @@ type charClassMatcher struct {
 	inverted        bool
 }
 
-type anyMatcher position // nolint: structcheck
+// nolint: structcheck
+type anyMatcher struct {
+	pos position
+	id  int
+}
+
+// matchCaseFold matches the full case folding of the input against val,
+// the case folding of a case-insensitive literal. The input is consumed
+// if it matches, it must be restored otherwise.
+func (p *parser) matchCaseFold(val string) bool {
+	for len(val) > 0 {
+		if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+			// EOF - see utf8.DecodeRune
+			return false
+		}
+		if f, ok := fullCaseFolds[p.pt.rn]; ok {
+			if !strings.HasPrefix(val, f) {
+				return false
+			}
+			val = val[len(f):]
+		} else {
+			want, n := utf8.DecodeRuneInString(val)
+			if caseFold(p.pt.rn) != want {
+				return false
+			}
+			val = val[n:]
+		}
+		p.read()
+	}
+	return true
+}
+
+// caseFold returns the simple case folding of rn, the smallest lowercase
+// rune of its orbit for unicode.SimpleFold, or its smallest rune if it has
+// no lowercase rune.
+func caseFold(rn rune) rune {
+	if rn < utf8.RuneSelf {
+		if 'A' <= rn && rn <= 'Z' {
+			rn += 'a' - 'A'
+		}
+		return rn
+	}
+
+	folded := rn
+	for r := unicode.SimpleFold(rn); r != rn; r = unicode.SimpleFold(r) {
+		if lower := unicode.IsLower(r); lower != unicode.IsLower(folded) {
+			if lower {
+				folded = r
+			}
+		} else if r < folded {
+			folded = r
+		}
+	}
+	return folded
+}
 
 // errList cumulates the errors found by the parser.
 type errList []error
@@ -1348,6 +1732,7 @@ func newParser(filename string, b []byte, opts ...Option) *parser {
 		entrypoint: g.rules[0].name,
 	}
 	p.setOptions(opts)
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
 
 	if p.maxExprCnt == 0 {
 		p.maxExprCnt = math.MaxUint64
@@ -1383,7 +1768,8 @@ type Stats struct {
 	// ChoiceAltCnt is used to count for each ordered choice expression,
 	// which alternative is used how may times.
 	// These numbers allow to optimize the order of the ordered choice expression
-	// to increase the performance of the parser
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
 	//
 	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
 	// as the line and the column of the ordered choice.
@@ -1393,6 +1779,46 @@ type Stats struct {
 	// the parser option Statistics.
 	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
 	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
 }
 
 // nolint: structcheck,maligned
@@ -1409,9 +1835,9 @@ type parser struct {
 	debug   bool
 
 	memoize bool
-	// memoization table for the packrat algorithm:
-	// map[offset in source] map[expression or rule] {value, match}
-	memo map[int]map[any]resultTuple
+	// memoization table for the packrat algorithm, taken from memoTables
+	// on the first memoized result
+	memo *memoTable
 
 	// rules table, maps the rule identifier to the rule node
 	rules map[string]*rule
@@ -1432,11 +1858,33 @@ type parser struct {
 
 	allowInvalidUTF8 bool
 
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+
 	*Stats
+	// profiling frames, tracks the time spent in invoked rules to compute
+	// the self time of each rule.
+	profStack []profFrame
+	// number of active invocations of each rule, the time of a recursive
+	// rule is only added at its outermost invocation.
+	profActive map[*rule]int
+	// rules evaluated at each offset, to count the repeated evaluations.
+	profEvals map[profEval]bool
 
 	choiceNoMatch string
 	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
-	recoveryStack []map[string]any
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
 }
 
 // push a variable set on the vstack.
@@ -1475,7 +1923,7 @@ func (p *parser) popV() {
 func (p *parser) pushRecovery(labels []string, expr any) {
 	if cap(p.recoveryStack) == len(p.recoveryStack) {
 		// create new empty slot in the stack
-		p.recoveryStack = append(p.recoveryStack, nil)
+		p.recoveryStack = append(p.recoveryStack, recovery{})
 	} else {
 		// slice to 1 more
 		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
@@ -1485,13 +1933,13 @@ func (p *parser) pushRecovery(labels []string, expr any) {
 	for _, fl := range labels {
 		m[fl] = expr
 	}
-	p.recoveryStack[len(p.recoveryStack)-1] = m
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
 }
 
 // pop a recovery expression from the recoveryStack
 func (p *parser) popRecovery() {
 	// GC that map
-	p.recoveryStack[len(p.recoveryStack)-1] = nil
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
 
 	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
 }
@@ -1501,8 +1949,9 @@ func (p *parser) print(prefix, s string) string {
 		return s
 	}
 
+	pos := p.pt.position
 	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
-		prefix, p.pt.line, p.pt.col, p.pt.offset, s, p.pt.rn)
+		prefix, pos.line, pos.col, pos.offset, s, p.pt.rn)
 	return s
 }
 
@@ -1570,14 +2019,19 @@ func (p *parser) failAt(fail bool, pos position, want string) {
 
 // read advances the parser to the next rune.
 func (p *parser) read() {
-	p.pt.offset += p.pt.w
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
 	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
 	p.pt.rn = rn
 	p.pt.w = n
-	p.pt.col++
-	if rn == '\n' {
-		p.pt.line++
-		p.pt.col = 0
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
 	}
 
 	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
@@ -1587,6 +2041,36 @@ func (p *parser) read() {
 	}
 }
 
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
 // restore parser position to the savepoint pt.
 func (p *parser) restore(pt savepoint) {
 	if p.debug {
@@ -1595,6 +2079,9 @@ func (p *parser) restore(pt savepoint) {
 	if pt.offset == p.pt.offset {
 		return
 	}
+	if p.Rules != nil && pt.offset < p.pt.offset && len(p.rstack) > 0 {
+		p.ruleStats(p.rstack[len(p.rstack)-1]).Backtracked += uint64(p.pt.offset - pt.offset)
+	}
 	p.pt = pt
 }
 
@@ -1652,28 +2139,109 @@ func (p *parser) sliceFrom(start savepoint) []byte {
 	return p.data[start.position.offset:p.pt.position.offset]
 }
 
-func (p *parser) getMemoized(node any) (resultTuple, bool) {
-	if len(p.memo) == 0 {
-		return resultTuple{}, false
+// memoSlot is an entry of the memoization table, the key of an empty slot
+// is 0.
+type memoSlot struct {
+	key uint64
+	res resultTuple
+}
+
+// memoTable is the memoization table for the packrat algorithm, an
+// open-addressed hash table with linear probing that maps the offset in
+// the source and the id of a rule or expression to its result.
+type memoTable struct {
+	slots []memoSlot
+	shift uint
+	n     int
+}
+
+// memoTables pools the memoization tables so that their storage is
+// reused across parses.
+var memoTables = &sync.Pool{
+	New: func() any {
+		return &memoTable{slots: make([]memoSlot, 1<<10), shift: 64 - 10}
+	},
+}
+
+// memoKey returns the key of the result of the rule or expression id at
+// offset, it supports up to 1<<24 ids.
+func memoKey(offset, id int) uint64 {
+	return uint64(offset+1)<<24 | uint64(id)
+}
+
+// index returns the index of the slot of key, or of the empty slot where
+// it should be stored.
+func (t *memoTable) index(key uint64) int {
+	mask := len(t.slots) - 1
+	i := int((key*0x9E3779B97F4A7C15)>>t.shift) & mask
+	for t.slots[i].key != 0 && t.slots[i].key != key {
+		i = (i + 1) & mask
+	}
+	return i
+}
+
+func (t *memoTable) get(key uint64) (resultTuple, bool) {
+	s := &t.slots[t.index(key)]
+	return s.res, s.key == key
+}
+
+func (t *memoTable) set(key uint64, res resultTuple) {
+	if 4*(t.n+1) > 3*len(t.slots) {
+		t.grow()
+	}
+	s := &t.slots[t.index(key)]
+	if s.key == 0 {
+		s.key = key
+		t.n++
+	}
+	s.res = res
+}
+
+// grow doubles the capacity of the table.
+func (t *memoTable) grow() {
+	old := t.slots
+	t.slots = make([]memoSlot, 2*len(old))
+	t.shift--
+	for _, s := range old {
+		if s.key != 0 {
+			t.slots[t.index(s.key)] = s
+		}
+	}
+}
+
+// reset empties the table, releasing the references to the results.
+func (t *memoTable) reset() {
+	if t.n == 0 {
+		return
+	}
+	for i := range t.slots {
+		t.slots[i] = memoSlot{}
 	}
-	m := p.memo[p.pt.offset]
-	if len(m) == 0 {
+	t.n = 0
+}
+
+func (p *parser) getMemoized(id int) (resultTuple, bool) {
+	if p.memo == nil {
 		return resultTuple{}, false
 	}
-	res, ok := m[node]
-	return res, ok
+	return p.memo.get(memoKey(p.pt.offset, id))
 }
 
-func (p *parser) setMemoized(pt savepoint, node any, tuple resultTuple) {
+func (p *parser) setMemoized(pt savepoint, id int, tuple resultTuple) {
 	if p.memo == nil {
-		p.memo = make(map[int]map[any]resultTuple)
+		p.memo = memoTables.Get().(*memoTable)
 	}
-	m := p.memo[pt.offset]
-	if m == nil {
-		m = make(map[any]resultTuple)
-		p.memo[pt.offset] = m
+	p.memo.set(memoKey(pt.offset, id), tuple)
+}
+
+// releaseMemo returns the memoization table to the pool.
+func (p *parser) releaseMemo() {
+	if p.memo == nil {
+		return
 	}
-	m[node] = tuple
+	p.memo.reset()
+	memoTables.Put(p.memo)
+	p.memo = nil
 }
 
 func (p *parser) buildRulesTable(g *grammar) {
@@ -1692,6 +2260,7 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 
 	// TODO : not super critical but this could be generated
 	p.buildRulesTable(g)
+	defer p.releaseMemo()
 
 	if p.recover {
 		// panic can be used in action code to stop parsing immediately
@@ -1725,24 +2294,7 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 		if len(*p.errs) == 0 {
 			// If parsing fails, but no errors have been recorded, the expected values
 			// for the farthest parser position are returned as error.
-			maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
-			for _, v := range p.maxFailExpected {
-				maxFailExpectedMap[v] = struct{}{}
-			}
-			expected := make([]string, 0, len(maxFailExpectedMap))
-			eof := false
-			if _, ok := maxFailExpectedMap["!."]; ok {
-				delete(maxFailExpectedMap, "!.")
-				eof = true
-			}
-			for k := range maxFailExpectedMap {
-				expected = append(expected, k)
-			}
-			sort.Strings(expected)
-			if eof {
-				expected = append(expected, "EOF")
-			}
-			p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+			p.addNoMatchErr()
 		}
 
 		return nil, p.errs.err()
@@ -1750,6 +2302,36 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 	return val, p.errs.err()
 }
 
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
 func listJoin(list []string, sep string, lastSep string) string {
 	switch len(list) {
 	case 0:
@@ -1761,8 +2343,90 @@ func listJoin(list []string, sep string, lastSep string) string {
 	}
 }
 
+// profFrame is a rule invocation being profiled.
+type profFrame struct {
+	start time.Time
+	child time.Duration
+}
+
+// profEval is the evaluation of a rule at an offset.
+type profEval struct {
+	rule   *rule
+	offset int
+}
+
+// ruleStats returns the statistics of rule, creating them if needed.
+func (p *parser) ruleStats(rule *rule) *RuleStats {
+	rs := p.Rules[rule.name]
+	if rs == nil {
+		rs = &RuleStats{}
+		p.Rules[rule.name] = rs
+	}
+	return rs
+}
+
+func (p *parser) countMemo(rs *RuleStats, hit bool) {
+	if hit {
+		rs.MemoHits++
+	} else {
+		rs.MemoMisses++
+	}
+}
+
+func (p *parser) profEnter(r *rule) {
+	if p.profActive == nil {
+		p.profActive = make(map[*rule]int)
+	}
+	p.profActive[r]++
+	p.profStack = append(p.profStack, profFrame{start: time.Now()})
+}
+
+// countRepeat counts the evaluation of rule at the current offset if
+// it was already evaluated at that offset.
+func (p *parser) countRepeat(rule *rule) {
+	if p.profEvals == nil {
+		p.profEvals = make(map[profEval]bool)
+	}
+	key := profEval{rule: rule, offset: p.pt.offset}
+	if p.profEvals[key] {
+		p.ruleStats(rule).Repeats++
+		return
+	}
+	p.profEvals[key] = true
+}
+
+func (p *parser) profExit(rule *rule, start savepoint, ok bool) {
+	frame := p.profStack[len(p.profStack)-1]
+	p.profStack = p.profStack[:len(p.profStack)-1]
+	elapsed := time.Since(frame.start)
+	if n := len(p.profStack); n > 0 {
+		p.profStack[n-1].child += elapsed
+	}
+
+	rs := p.ruleStats(rule)
+	rs.Invocations++
+	if p.profActive[rule]--; p.profActive[rule] == 0 {
+		// the time of the inner invocations is included
+		rs.Time += elapsed
+	}
+	rs.SelfTime += elapsed - frame.child
+	if ok {
+		rs.Matches++
+		rs.Bytes += uint64(p.pt.offset - start.offset)
+	} else {
+		rs.Failures++
+	}
+}
+
 func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
-	res, ok := p.getMemoized(rule)
+	if rule.id < 0 {
+		// its results depend on the captured texts or the state store
+		return p.parseRule(rule)
+	}
+	res, ok := p.getMemoized(rule.id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(rule), ok)
+	}
 	if ok {
 		p.restore(res.end)
 		return res.v, res.b
@@ -1770,7 +2434,7 @@ func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
 
 	startMark := p.pt
 	val, ok := p.parseRule(rule)
-	p.setMemoized(startMark, rule, resultTuple{val, ok, p.pt})
+	p.setMemoized(startMark, rule.id, resultTuple{v: val, b: ok, end: p.pt})
 
 	return val, ok
 }
@@ -1785,6 +2449,11 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 		startMark = p.pt
 	)
 
+	if p.Rules != nil {
+		p.profEnter(rule)
+		defer func() { p.profExit(rule, startMark, ok) }()
+	}
+
 	if p.memoize {
 		val, ok = p.parseRuleMemoize(rule)
 	} else {
@@ -1798,6 +2467,9 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 }
 
 func (p *parser) parseRule(rule *rule) (any, bool) {
+	if p.Rules != nil {
+		p.countRepeat(rule)
+	}
 	p.rstack = append(p.rstack, rule)
 	p.pushV()
 	val, ok := p.parseExprWrap(rule.expr)
@@ -1806,11 +2478,32 @@ func (p *parser) parseRule(rule *rule) (any, bool) {
 	return val, ok
 }
 
+// incChoiceAltCnt counts the match of the alternative altI of the choice
+// at pos, or its failure if altI is choiceNoMatch.
+func (p *parser) incChoiceAltCnt(pos position, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, pos.line, pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
 func (p *parser) parseExprWrap(expr any) (any, bool) {
 	var pt savepoint
+	id := exprID(expr)
 
-	if p.memoize {
-		res, ok := p.getMemoized(expr)
+	if p.memoize && id >= 0 {
+		res, ok := p.getMemoized(id)
+		if p.Rules != nil {
+			p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+		}
 		if ok {
 			p.restore(res.end)
 			return res.v, res.b
@@ -1820,12 +2513,60 @@ func (p *parser) parseExprWrap(expr any) (any, bool) {
 
 	val, ok := p.parseExpr(expr)
 
-	if p.memoize {
-		p.setMemoized(pt, expr, resultTuple{val, ok, p.pt})
+	if p.memoize && id >= 0 {
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
 	}
 	return val, ok
 }
 
+// exprID returns the id of the expression in the memoization table, -1 if
+// its results depend on the texts captured by the labels or on the state
+// store and are not memoized.
+//
+//	nolint: gocyclo
+func exprID(expr any) int {
+	switch expr := expr.(type) {
+	case *actionExpr:
+		return expr.id
+	case *andCodeExpr:
+		return expr.id
+	case *andExpr:
+		return expr.id
+	case *anyMatcher:
+		return expr.id
+	case *charClassMatcher:
+		return expr.id
+	case *choiceExpr:
+		return expr.id
+	case *labeledExpr:
+		return expr.id
+	case *litMatcher:
+		return expr.id
+	case *notCodeExpr:
+		return expr.id
+	case *notExpr:
+		return expr.id
+	case *oneOrMoreExpr:
+		return expr.id
+	case *recoveryExpr:
+		return expr.id
+	case *repeatExpr:
+		return expr.id
+	case *ruleRefExpr:
+		return expr.id
+	case *seqExpr:
+		return expr.id
+	case *stateCodeExpr:
+		return expr.id
+	case *zeroOrMoreExpr:
+		return expr.id
+	case *zeroOrOneExpr:
+		return expr.id
+	default:
+		panic(fmt.Sprintf("unknown expression type %T", expr))
+	}
+}
+
 // nolint: gocyclo
 func (p *parser) parseExpr(expr any) (any, bool) {
 	p.ExprCnt++
@@ -1860,14 +2601,14 @@ func (p *parser) parseExpr(expr any) (any, bool) {
 		val, ok = p.parseOneOrMoreExpr(expr)
 	case *recoveryExpr:
 		val, ok = p.parseRecoveryExpr(expr)
+	case *repeatExpr:
+		val, ok = p.parseRepeatExpr(expr)
 	case *ruleRefExpr:
 		val, ok = p.parseRuleRefExpr(expr)
 	case *seqExpr:
 		val, ok = p.parseSeqExpr(expr)
 	case *stateCodeExpr:
 		val, ok = p.parseStateCodeExpr(expr)
-	case *throwExpr:
-		val, ok = p.parseThrowExpr(expr)
 	case *zeroOrMoreExpr:
 		val, ok = p.parseZeroOrMoreExpr(expr)
 	case *zeroOrOneExpr:
@@ -1967,7 +2708,7 @@ func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
 	}
 
 	if chr.ignoreCase {
-		cur = unicode.ToLower(cur)
+		cur = caseFold(cur)
 	}
 
 	// try to match in the list of available chars
@@ -2018,29 +2759,29 @@ func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
 	return nil, false
 }
 
-func (p *parser) incChoiceAltCnt(ch *choiceExpr, altI int) {
-	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, ch.pos.line, ch.pos.col)
-	m := p.ChoiceAltCnt[choiceIdent]
-	if m == nil {
-		m = make(map[string]int)
-		p.ChoiceAltCnt[choiceIdent] = m
-	}
-	// We increment altI by 1, so the keys do not start at 0
-	alt := strconv.Itoa(altI + 1)
-	if altI == choiceNoMatch {
-		alt = p.choiceNoMatch
-	}
-	m[alt]++
-}
-
 func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseChoiceExpr"))
 	}
 
-	for altI, alt := range ch.alternatives {
-		// dummy assignment to prevent compile error if optimized
-		_ = altI
+	n := len(ch.alternatives)
+	var alts []int
+	if d := ch.dispatch; d != nil {
+		// the alternatives that cannot match the current rune are skipped,
+		// with the values they expect.
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		alts = d.alts[set]
+		n = len(alts)
+	}
+	for i := 0; i < n; i++ {
+		altI := i
+		if alts != nil {
+			altI = alts[i]
+		}
+		alt := ch.alternatives[altI]
 
 		state := p.cloneState()
 
@@ -2048,12 +2789,12 @@ func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 		val, ok := p.parseExprWrap(alt)
 		p.popV()
 		if ok {
-			p.incChoiceAltCnt(ch, altI)
+			p.incChoiceAltCnt(ch.pos, altI)
 			return val, ok
 		}
 		p.restoreState(state)
 	}
-	p.incChoiceAltCnt(ch, choiceNoMatch)
+	p.incChoiceAltCnt(ch.pos, choiceNoMatch)
 	return nil, false
 }
 
@@ -2078,6 +2819,15 @@ func (p *parser) parseLitMatcher(lit *litMatcher) (any, bool) {
 	}
 
 	start := p.pt
+	if lit.ignoreCase {
+		if !p.matchCaseFold(lit.val) {
+			p.failAt(false, start.position, lit.want)
+			p.restore(start)
+			return nil, false
+		}
+		p.failAt(true, start.position, lit.want)
+		return p.sliceFrom(start), true
+	}
 	for _, want := range lit.val {
 		cur := p.pt.rn
 		if lit.ignoreCase {
@@ -2162,6 +2912,33 @@ func (p *parser) parseRecoveryExpr(recover *recoveryExpr) (any, bool) {
 	return val, ok
 }
 
+func (p *parser) parseRepeatExpr(expr *repeatExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRepeatExpr"))
+	}
+
+	var vals []any
+
+	pt := p.pt
+	state := p.cloneState()
+	for expr.max < 0 || len(vals) < expr.max {
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			break
+		}
+		vals = append(vals, val)
+	}
+	if len(vals) < expr.min {
+		// did not match enough times, no match
+		p.restoreState(state)
+		p.restore(pt)
+		return nil, false
+	}
+	return vals, true
+}
+
 func (p *parser) parseRuleRefExpr(ref *ruleRefExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseRuleRefExpr " + ref.name))
@@ -2212,22 +2989,6 @@ func (p *parser) parseStateCodeExpr(state *stateCodeExpr) (any, bool) {
 	return nil, true
 }
 
-func (p *parser) parseThrowExpr(expr *throwExpr) (any, bool) {
-	if p.debug {
-		defer p.out(p.in("parseThrowExpr"))
-	}
-
-	for i := len(p.recoveryStack) - 1; i >= 0; i-- {
-		if recoverExpr, ok := p.recoveryStack[i][expr.label]; ok {
-			if val, ok := p.parseExprWrap(recoverExpr); ok {
-				return val, ok
-			}
-		}
-	}
-
-	return nil, false
-}
-
 func (p *parser) parseZeroOrMoreExpr(expr *zeroOrMoreExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseZeroOrMoreExpr"))
@@ -2257,3 +3018,112 @@ func (p *parser) parseZeroOrOneExpr(expr *zeroOrOneExpr) (any, bool) {
 	// whether it matched or not, consider it a match
 	return val, true
 }
+
+// fullCaseFolds are the full case foldings of more than one rune, with
+// the simple case folding of their runes.
+var fullCaseFolds = map[rune]string{
+	0x00df: "ss",
+	0x0130: "i\u0307",
+	0x0149: "\u02bcn",
+	0x01f0: "j\u030c",
+	0x0390: "\u03b9\u0308\u0301",
+	0x03b0: "\u03c5\u0308\u0301",
+	0x0587: "\u0565\u0582",
+	0x1e96: "h\u0331",
+	0x1e97: "t\u0308",
+	0x1e98: "w\u030a",
+	0x1e99: "y\u030a",
+	0x1e9a: "a\u02be",
+	0x1e9e: "ss",
+	0x1f50: "\u03c5\u0313",
+	0x1f52: "\u03c5\u0313\u0300",
+	0x1f54: "\u03c5\u0313\u0301",
+	0x1f56: "\u03c5\u0313\u0342",
+	0x1f80: "\u1f00\u03b9",
+	0x1f81: "\u1f01\u03b9",
+	0x1f82: "\u1f02\u03b9",
+	0x1f83: "\u1f03\u03b9",
+	0x1f84: "\u1f04\u03b9",
+	0x1f85: "\u1f05\u03b9",
+	0x1f86: "\u1f06\u03b9",
+	0x1f87: "\u1f07\u03b9",
+	0x1f88: "\u1f00\u03b9",
+	0x1f89: "\u1f01\u03b9",
+	0x1f8a: "\u1f02\u03b9",
+	0x1f8b: "\u1f03\u03b9",
+	0x1f8c: "\u1f04\u03b9",
+	0x1f8d: "\u1f05\u03b9",
+	0x1f8e: "\u1f06\u03b9",
+	0x1f8f: "\u1f07\u03b9",
+	0x1f90: "\u1f20\u03b9",
+	0x1f91: "\u1f21\u03b9",
+	0x1f92: "\u1f22\u03b9",
+	0x1f93: "\u1f23\u03b9",
+	0x1f94: "\u1f24\u03b9",
+	0x1f95: "\u1f25\u03b9",
+	0x1f96: "\u1f26\u03b9",
+	0x1f97: "\u1f27\u03b9",
+	0x1f98: "\u1f20\u03b9",
+	0x1f99: "\u1f21\u03b9",
+	0x1f9a: "\u1f22\u03b9",
+	0x1f9b: "\u1f23\u03b9",
+	0x1f9c: "\u1f24\u03b9",
+	0x1f9d: "\u1f25\u03b9",
+	0x1f9e: "\u1f26\u03b9",
+	0x1f9f: "\u1f27\u03b9",
+	0x1fa0: "\u1f60\u03b9",
+	0x1fa1: "\u1f61\u03b9",
+	0x1fa2: "\u1f62\u03b9",
+	0x1fa3: "\u1f63\u03b9",
+	0x1fa4: "\u1f64\u03b9",
+	0x1fa5: "\u1f65\u03b9",
+	0x1fa6: "\u1f66\u03b9",
+	0x1fa7: "\u1f67\u03b9",
+	0x1fa8: "\u1f60\u03b9",
+	0x1fa9: "\u1f61\u03b9",
+	0x1faa: "\u1f62\u03b9",
+	0x1fab: "\u1f63\u03b9",
+	0x1fac: "\u1f64\u03b9",
+	0x1fad: "\u1f65\u03b9",
+	0x1fae: "\u1f66\u03b9",
+	0x1faf: "\u1f67\u03b9",
+	0x1fb2: "\u1f70\u03b9",
+	0x1fb3: "\u03b1\u03b9",
+	0x1fb4: "\u03ac\u03b9",
+	0x1fb6: "\u03b1\u0342",
+	0x1fb7: "\u03b1\u0342\u03b9",
+	0x1fbc: "\u03b1\u03b9",
+	0x1fc2: "\u1f74\u03b9",
+	0x1fc3: "\u03b7\u03b9",
+	0x1fc4: "\u03ae\u03b9",
+	0x1fc6: "\u03b7\u0342",
+	0x1fc7: "\u03b7\u0342\u03b9",
+	0x1fcc: "\u03b7\u03b9",
+	0x1fd2: "\u03b9\u0308\u0300",
+	0x1fd3: "\u03b9\u0308\u0301",
+	0x1fd6: "\u03b9\u0342",
+	0x1fd7: "\u03b9\u0308\u0342",
+	0x1fe2: "\u03c5\u0308\u0300",
+	0x1fe3: "\u03c5\u0308\u0301",
+	0x1fe4: "\u03c1\u0313",
+	0x1fe6: "\u03c5\u0342",
+	0x1fe7: "\u03c5\u0308\u0342",
+	0x1ff2: "\u1f7c\u03b9",
+	0x1ff3: "\u03c9\u03b9",
+	0x1ff4: "\u03ce\u03b9",
+	0x1ff6: "\u03c9\u0342",
+	0x1ff7: "\u03c9\u0342\u03b9",
+	0x1ffc: "\u03c9\u03b9",
+	0xfb00: "ff",
+	0xfb01: "fi",
+	0xfb02: "fl",
+	0xfb03: "ffi",
+	0xfb04: "ffl",
+	0xfb05: "st",
+	0xfb06: "st",
+	0xfb13: "\u0574\u0576",
+	0xfb14: "\u0574\u0565",
+	0xfb15: "\u0574\u056b",
+	0xfb16: "\u057e\u0576",
+	0xfb17: "\u0574\u056d",
+}
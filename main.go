@@ -35,21 +35,38 @@ func (r *ruleNamesFlag) Set(value string) error {
 }
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "cover":
+			cover(os.Args[2:])
+			return
+		case "prof":
+			prof(os.Args[2:])
+			return
+		}
+	}
+
 	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
 
 	// define command-line flags
 	var (
+		binaryFlag             = fs.Bool("binary", false, "generate a parser that matches bytes instead of UTF-8 runes")
 		cacheFlag              = fs.Bool("cache", false, "cache parsing results")
+		compileFlag            = fs.Bool("compile", false, "generate the rules as Go functions instead of a grammar table")
+		coverageFlag           = fs.Bool("coverage", false, "instrument the generated parser for grammar coverage")
 		dbgFlag                = fs.Bool("debug", false, "set debug mode")
 		shortHelpFlag          = fs.Bool("h", false, "show help page")
 		longHelpFlag           = fs.Bool("help", false, "show help page")
+		lazyPositionsFlag      = fs.Bool("lazy-positions", false, "compute the line and column of the positions only when needed")
 		nolint                 = fs.Bool("nolint", false, "add '// nolint: ...' comments to suppress warnings by gometalinter or golangci-lint")
 		noRecoverFlag          = fs.Bool("no-recover", false, "do not recover from panic")
 		outputFlag             = fs.String("o", "", "output file, defaults to stdout")
 		optimizeBasicLatinFlag = fs.Bool("optimize-basic-latin", false, "generate optimized parser for Unicode Basic Latin character sets")
+		optimizeDFAFlag        = fs.Bool("optimize-dfa", false, "match the regular expressions of the grammar with deterministic finite automata")
 		optimizeGrammar        = fs.Bool("optimize-grammar", false, "optimize the given grammar (EXPERIMENTAL FEATURE)")
 		optimizeParserFlag     = fs.Bool("optimize-parser", false, "generate optimized parser without Debug and Memoize options")
 		recvrNmFlag            = fs.String("receiver-name", "c", "receiver name for the generated methods")
+		reorderChoicesFlag     = fs.String("reorder-choices", "", "reorder the choice alternatives based on the statistics in this file")
 		noBuildFlag            = fs.Bool("x", false, "do not build, only parse")
 		supportLeftRecursion   = fs.Bool("support-left-recursion", false, "add support left recursion (EXPERIMENTAL FEATURE)")
 
@@ -99,8 +116,29 @@ func main() {
 		exit(3)
 	}
 
-	// validate alternate entrypoints
+	// resolve the back-references, instantiate the parameterized rules,
+	// mark the expressions that match the skip rule and replace the choices
+	// of the @longest and @keywords rules before the rules are validated
+	// and optimized
 	grammar := g.(*ast.Grammar)
+	if err := ast.ResolveBackRefs(grammar); err != nil {
+		fmt.Fprintln(os.Stderr, "build error: ", err)
+		exit(5)
+	}
+	if err := ast.Instantiate(grammar); err != nil {
+		fmt.Fprintln(os.Stderr, "build error: ", err)
+		exit(5)
+	}
+	if err := ast.ApplySkip(grammar); err != nil {
+		fmt.Fprintln(os.Stderr, "build error: ", err)
+		exit(5)
+	}
+	if err := ast.ApplyLitSets(grammar); err != nil {
+		fmt.Fprintln(os.Stderr, "build error: ", err)
+		exit(5)
+	}
+
+	// validate alternate entrypoints
 	rules := make(map[string]struct{}, len(grammar.Rules))
 	for _, rule := range grammar.Rules {
 		rules[rule.Name.Val] = struct{}{}
@@ -117,8 +155,20 @@ func main() {
 	}
 
 	if !*noBuildFlag {
+		if *reorderChoicesFlag != "" {
+			counts, err := loadChoiceCounts(*reorderChoicesFlag)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "statistics error:\n", err)
+				exit(10)
+			}
+			writeReorderings(os.Stderr, nm, ast.ReorderChoices(grammar, counts))
+		}
 		if *optimizeGrammar {
-			ast.Optimize(grammar, altEntrypointsFlag...)
+			if *binaryFlag {
+				ast.OptimizeBinary(grammar, altEntrypointsFlag...)
+			} else {
+				ast.Optimize(grammar, altEntrypointsFlag...)
+			}
 		}
 
 		// generate parser
@@ -138,9 +188,18 @@ func main() {
 		basicLatinOptimize := builder.BasicLatinLookupTable(*optimizeBasicLatinFlag)
 		nolintOpt := builder.Nolint(*nolint)
 		leftRecursionSupporter := builder.SupportLeftRecursion(*supportLeftRecursion)
+		compileOpt := builder.Compile(*compileFlag)
+		binaryOpt := builder.Binary(*binaryFlag)
+		lazyPositionsOpt := builder.LazyPositions(*lazyPositionsFlag)
+		optimizeDFAOpt := builder.OptimizeDFA(*optimizeDFAFlag)
+		coverageOpt := builder.Coverage("")
+		if *coverageFlag {
+			coverageOpt = builder.Coverage(nm)
+		}
 		if err := builder.BuildParser(
 			outBuf, grammar, curNmOpt, optimizeParser, basicLatinOptimize,
-			nolintOpt, leftRecursionSupporter); err != nil {
+			nolintOpt, leftRecursionSupporter, coverageOpt, compileOpt, binaryOpt,
+			lazyPositionsOpt, optimizeDFAOpt); err != nil {
 			fmt.Fprintln(os.Stderr, "build error: ", err)
 			exit(5)
 		}
@@ -171,22 +230,45 @@ func main() {
 }
 
 var usagePage = `usage: %s [options] [GRAMMAR_FILE]
+       %[1]s cover [options] PROFILE...
+       %[1]s prof [options] STATS_FILE
 
-Pigeon generates a parser based on a PEG grammar.
+Pigeon generates a parser based on a PEG grammar. The cover command
+reports the grammar coverage profiles written by parsers generated
+with -coverage, see "%[1]s cover -h". The prof command prints a
+report of the rules' statistics collected by a generated parser, see
+"%[1]s prof -h".
 
 By default, pigeon reads the grammar from stdin and writes the
 generated parser to stdout. If GRAMMAR_FILE is specified, the
 grammar is read from this file instead. If the -o flag is set,
 the generated code is written to this file instead.
 
+	-binary
+		generate a parser that matches the input a byte at a time instead
+		of decoding UTF-8 runes, with built-in rules to decode binary
+		integers and length-prefixed fields.
 	-cache
 		cache parser results to avoid exponential parsing time in
 		pathological cases. Can make the parsing slower for typical
 		cases and uses more memory.
+	-compile
+		generate each rule of the grammar as a Go function of the parser,
+		with the code of its expressions inlined, instead of a node of the
+		grammar table interpreted by the parser. The compiled parser is faster but its code is larger.
+	-coverage
+		instrument the generated parser to count the matches of each
+		rule, choice alternative, optional and repeated expression and
+		predicate of the grammar. The counts are written with the
+		WriteCoverProfile function of the generated parser.
 	-debug
 		output debugging information while parsing the grammar.
 	-h -help
 		display this help message.
+	-lazy-positions
+		track only the byte offset while parsing and compute the line
+		and column of a position when an error is reported or when an
+		action is invoked. Has no effect with -binary.
 	-nolint
 		add '// nolint: ...' comments for generated parser to suppress
 		warnings by gometalinter (https://github.com/alecthomas/gometalinter) or
@@ -198,11 +280,21 @@ the generated code is written to this file instead.
 		write the generated parser to OUTPUT_FILE. Defaults to stdout.
 	-optimize-basic-latin
 		generate optimized parser for Unicode Basic Latin character set
+	-optimize-dfa
+		match the regular sub-expressions of the grammar whose value is
+		not used, e.g. the expression of an action, with deterministic
+		finite automata generated as Go code. Has no effect with -binary
+		and -coverage.
 	-optimize-grammar
 		perform several performance optimizations on the grammar (EXPERIMENTAL FEATURE)
 	-optimize-parser
 		generate optimized parser without Debug and Memoize options and
 		with some other optimizations applied.
+	-reorder-choices STATS_FILE
+		reorder the alternatives of the choice expressions so that the
+		alternatives that matched most often in the statistics of
+		STATS_FILE are tried first, when this does not change the
+		language of the grammar. Every reordering is reported on stderr.
 	-receiver-name NAME
 		use NAME as for the receiver name of the generated methods
 		for the grammar's code blocks. Defaults to "c".
@@ -7,18 +7,23 @@ import (
 )
 
 var invalidParseCases = map[string]string{
-	"":           `file:1:1 (0): no match found, expected: "/*", "//", "\n", "{", [ \t\r] or [\pL_]`,
-	"a":          `file:1:2 (1): no match found, expected: "'", "/*", "//", "<-", "=", "\"", "\n", "` + "`" + `", "←", "⟵", [ \t\r], [\pL_] or [\p{Nd}]`,
-	"abc":        `file:1:4 (3): no match found, expected: "'", "/*", "//", "<-", "=", "\"", "\n", "` + "`" + `", "←", "⟵", [ \t\r], [\pL_] or [\p{Nd}]`,
-	" ":          `file:1:2 (1): no match found, expected: "/*", "//", "\n", "{", [ \t\r] or [\pL_]`,
-	`a = +`:      `file:1:5 (4): no match found, expected: "!", "#", "%", "&", "'", "(", ".", "/*", "//", "[", "\"", "\n", "` + "`" + `", [ \t\r] or [\pL_]`,
-	`a = *`:      `file:1:5 (4): no match found, expected: "!", "#", "%", "&", "'", "(", ".", "/*", "//", "[", "\"", "\n", "` + "`" + `", [ \t\r] or [\pL_]`,
-	`a = ?`:      `file:1:5 (4): no match found, expected: "!", "#", "%", "&", "'", "(", ".", "/*", "//", "[", "\"", "\n", "` + "`" + `", [ \t\r] or [\pL_]`,
-	"a ←":        `file:1:4 (5): no match found, expected: "!", "#", "%", "&", "'", "(", ".", "/*", "//", "[", "\"", "\n", "` + "`" + `", [ \t\r] or [\pL_]`,
-	"a ← b\nb ←": `file:2:4 (13): no match found, expected: "!", "#", "%", "&", "'", "(", ".", "/*", "//", "[", "\"", "\n", "` + "`" + `", [ \t\r] or [\pL_]`,
+	"":           `file:1:1 (0): no match found, expected: "%", "/*", "//", "\n", "{", [ \t\r] or [\pL_]`,
+	"a":          `file:1:2 (1): no match found, expected: "'", "/*", "//", "<", "<-", "=", "@", "\"", "\n", "` + "`" + `", "←", "⟵", [ \t\r], [\pL_] or [\p{Nd}]`,
+	"abc":        `file:1:4 (3): no match found, expected: "'", "/*", "//", "<", "<-", "=", "@", "\"", "\n", "` + "`" + `", "←", "⟵", [ \t\r], [\pL_] or [\p{Nd}]`,
+	" ":          `file:1:2 (1): no match found, expected: "%", "/*", "//", "\n", "{", [ \t\r] or [\pL_]`,
+	`a = +`:      `file:1:5 (4): no match found, expected: "!", "#", "$", "%", "%precedence", "&", "'", "(", ".", "/*", "//", "@", "[", "\"", "\n", "` + "`" + `", "re\"", "~", [ \t\r] or [\pL_]`,
+	`a = *`:      `file:1:5 (4): no match found, expected: "!", "#", "$", "%", "%precedence", "&", "'", "(", ".", "/*", "//", "@", "[", "\"", "\n", "` + "`" + `", "re\"", "~", [ \t\r] or [\pL_]`,
+	`a = ?`:      `file:1:5 (4): no match found, expected: "!", "#", "$", "%", "%precedence", "&", "'", "(", ".", "/*", "//", "@", "[", "\"", "\n", "` + "`" + `", "re\"", "~", [ \t\r] or [\pL_]`,
+	"a ←":        `file:1:4 (5): no match found, expected: "!", "#", "$", "%", "%precedence", "&", "'", "(", ".", "/*", "//", "@", "[", "\"", "\n", "` + "`" + `", "re\"", "~", [ \t\r] or [\pL_]`,
+	"a ← b\nb ←": `file:2:4 (13): no match found, expected: "!", "#", "$", "%", "%precedence", "&", "'", "(", ".", "/*", "//", "@", "[", "\"", "\n", "` + "`" + `", "re\"", "~", [ \t\r] or [\pL_]`,
 	"a ← nil:b":  "file:1:5 (6): rule Identifier: identifier is a reserved word",
+	"a @foo = b": "file:1:3 (2): rule RuleAnnotation: invalid rule annotation",
+	"a<> = b":    `file:1:3 (2): no match found, expected: "/*", "//", "\n", [ \t\r] or [\pL_]`,
+	"a = b<>":    `file:1:7 (6): no match found, expected: "!", "#", "$", "%", "%precedence", "&", "'", "(", ".", "/*", "//", "@", "[", "\"", "\n", "` + "`" + `", "re\"", "~", [ \t\r] or [\pL_]`,
+	"a = b{3,2}": "file:1:6 (5): rule RepeatOp: invalid repetition bounds",
 	"\xfe":       "file:1:1 (0): invalid encoding",
 	"{}{}":       `file:1:3 (2): no match found, expected: "/*", "//", ";", "\n", [ \t\r] or EOF`,
+	`%{e} = "f"`: "file:1:1 (0): rule Grammar: grammar has no rule",
 
 	// non-terminated, empty, EOF "quoted" tokens
 	"{":         "file:1:1 (0): rule CodeBlock: code block not terminated",
@@ -39,9 +44,10 @@ var invalidParseCases = map[string]string{
 	"a = [\\p{\n]": `file:1:5 (4): rule CharClassMatcher: character class not terminated`,
 
 	// non-terminated quoted tokens with escaped closing char
-	`a = "\"`: "file:1:5 (4): rule StringLiteral: string literal not terminated",
-	`a = '\'`: "file:1:5 (4): rule StringLiteral: string literal not terminated",
-	`a = [\]`: "file:1:5 (4): rule CharClassMatcher: character class not terminated",
+	`a = "\"`:   "file:1:5 (4): rule StringLiteral: string literal not terminated",
+	`a = '\'`:   "file:1:5 (4): rule StringLiteral: string literal not terminated",
+	`a = [\]`:   "file:1:5 (4): rule CharClassMatcher: character class not terminated",
+	`a = re"\"`: "file:1:5 (4): rule RegexpMatcher: regular expression not terminated",
 
 	// non-terminated, non-empty, EOF "quoted" tokens
 	"{a":     "file:1:1 (0): rule CodeBlock: code block not terminated",
@@ -52,17 +58,24 @@ var invalidParseCases = map[string]string{
 	`a = [b`: "file:1:5 (4): rule CharClassMatcher: character class not terminated",
 	`a = [\p{W]`: `file:1:8 (7): rule UnicodeClassEscape: Unicode class not terminated
 file:1:5 (4): rule CharClassMatcher: character class not terminated`,
+	`a = re"b`:    "file:1:5 (4): rule RegexpMatcher: regular expression not terminated",
+	"a = re\"b\n": "file:1:5 (4): rule RegexpMatcher: regular expression not terminated",
+
+	// invalid regular expressions
+	`a = re"(b"`:  "file:1:5 (4): rule RegexpMatcher: error parsing regexp: missing closing ): `(b`",
+	`a = re"b**"`: "file:1:5 (4): rule RegexpMatcher: error parsing regexp: invalid nested repetition operator: `**`",
 
 	// invalid escapes
-	`a ← [\pA]`:    "file:1:8 (9): rule UnicodeClassEscape: invalid Unicode class escape",
-	`a ← [\p{WW}]`: "file:1:8 (9): rule UnicodeClassEscape: invalid Unicode class escape",
-	`a = '\"'`:     "file:1:7 (6): rule SingleStringEscape: invalid escape character",
-	`a = "\'"`:     "file:1:7 (6): rule DoubleStringEscape: invalid escape character",
-	`a = [\']`:     "file:1:7 (6): rule CharClassEscape: invalid escape character",
-	`a = '\xz'`:    "file:1:7 (6): rule HexEscape: invalid hexadecimal escape",
-	`a = '\0z'`:    "file:1:7 (6): rule OctalEscape: invalid octal escape",
-	`a = '\uz'`:    "file:1:7 (6): rule ShortUnicodeEscape: invalid Unicode escape",
-	`a = '\Uz'`:    "file:1:7 (6): rule LongUnicodeEscape: invalid Unicode escape",
+	`a ← [\pA]`:       "file:1:8 (9): rule UnicodeClassEscape: invalid Unicode class escape",
+	`a ← [\p{WW}]`:    "file:1:8 (9): rule UnicodeClassEscape: invalid Unicode class escape",
+	`a ← [\P{sc=Lu}]`: "file:1:8 (9): rule UnicodeClassEscape: invalid Unicode class escape",
+	`a = '\"'`:        "file:1:7 (6): rule SingleStringEscape: invalid escape character",
+	`a = "\'"`:        "file:1:7 (6): rule DoubleStringEscape: invalid escape character",
+	`a = [\']`:        "file:1:7 (6): rule CharClassEscape: invalid escape character",
+	`a = '\xz'`:       "file:1:7 (6): rule HexEscape: invalid hexadecimal escape",
+	`a = '\0z'`:       "file:1:7 (6): rule OctalEscape: invalid octal escape",
+	`a = '\uz'`:       "file:1:7 (6): rule ShortUnicodeEscape: invalid Unicode escape",
+	`a = '\Uz'`:       "file:1:7 (6): rule LongUnicodeEscape: invalid Unicode escape",
 
 	// escapes followed by newline
 	"a = '\\\n": `file:2:0 (6): rule SingleStringEscape: invalid escape character
@@ -230,6 +243,223 @@ var validParseCases = map[string]*ast.Grammar{
 			},
 		},
 	},
+	`a "A" @memo = b`: {
+		Rules: []*ast.Rule{
+			{
+				Name:        ast.NewIdentifier(ast.Pos{}, "a"),
+				DisplayName: ast.NewStringLit(ast.Pos{}, `"A"`),
+				Annotations: []*ast.Identifier{ast.NewIdentifier(ast.Pos{}, "memo")},
+				Expr:        &ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "b")},
+			},
+		},
+	},
+	"a<b, c> 'A' @memo = b\nd = a<e, 'f'>": {
+		Rules: []*ast.Rule{
+			{
+				Name:        ast.NewIdentifier(ast.Pos{}, "a"),
+				Params:      []*ast.Identifier{ast.NewIdentifier(ast.Pos{}, "b"), ast.NewIdentifier(ast.Pos{}, "c")},
+				DisplayName: ast.NewStringLit(ast.Pos{}, `'A'`),
+				Annotations: []*ast.Identifier{ast.NewIdentifier(ast.Pos{}, "memo")},
+				Expr:        &ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "b")},
+			},
+			{
+				Name: ast.NewIdentifier(ast.Pos{}, "d"),
+				Expr: &ast.RuleRefExpr{
+					Name: ast.NewIdentifier(ast.Pos{}, "a"),
+					Args: []ast.Expression{
+						&ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "e")},
+						ast.NewLitMatcher(ast.Pos{}, "f"),
+					},
+				},
+			},
+		},
+	},
+	"a = b<c, 'd'>\ne<-f": {
+		Rules: []*ast.Rule{
+			{
+				Name: ast.NewIdentifier(ast.Pos{}, "a"),
+				Expr: &ast.RuleRefExpr{
+					Name: ast.NewIdentifier(ast.Pos{}, "b"),
+					Args: []ast.Expression{
+						&ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "c")},
+						ast.NewLitMatcher(ast.Pos{}, "d"),
+					},
+				},
+			},
+			{
+				Name: ast.NewIdentifier(ast.Pos{}, "e"),
+				Expr: &ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "f")},
+			},
+		},
+	},
+	"a = b{2} c{1,} d{0,3}\ne = f {2}": {
+		Rules: []*ast.Rule{
+			{
+				Name: ast.NewIdentifier(ast.Pos{}, "a"),
+				Expr: &ast.SeqExpr{
+					Exprs: []ast.Expression{
+						&ast.RepeatExpr{Expr: &ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "b")}, Min: 2, Max: 2},
+						&ast.RepeatExpr{Expr: &ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "c")}, Min: 1, Max: -1},
+						&ast.RepeatExpr{Expr: &ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "d")}, Min: 0, Max: 3},
+					},
+				},
+			},
+			{
+				Name: ast.NewIdentifier(ast.Pos{}, "e"),
+				Expr: &ast.ActionExpr{
+					Expr: &ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "f")},
+					Code: ast.NewCodeBlock(ast.Pos{}, "{2}"),
+				},
+			},
+		},
+	},
+	"a = \"if\" ~ b": {
+		Rules: []*ast.Rule{
+			{
+				Name: ast.NewIdentifier(ast.Pos{}, "a"),
+				Expr: &ast.SeqExpr{
+					Exprs: []ast.Expression{
+						ast.NewLitMatcher(ast.Pos{}, "if"),
+						&ast.CutExpr{},
+						&ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "b")},
+					},
+				},
+			},
+		},
+	},
+	"a = o:b $o $#c": {
+		Rules: []*ast.Rule{
+			{
+				Name: ast.NewIdentifier(ast.Pos{}, "a"),
+				Expr: &ast.SeqExpr{
+					Exprs: []ast.Expression{
+						&ast.LabeledExpr{
+							Label: ast.NewIdentifier(ast.Pos{}, "o"),
+							Expr:  &ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "b")},
+						},
+						// resolved to a back-reference by ast.ResolveBackRefs
+						&ast.TextExpr{Expr: &ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "o")}},
+						&ast.BackRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "c"), State: true},
+					},
+				},
+			},
+		},
+	},
+	"a = '(' @e:b ')' / @$c+ @d": {
+		Rules: []*ast.Rule{
+			{
+				Name: ast.NewIdentifier(ast.Pos{}, "a"),
+				Expr: &ast.ChoiceExpr{
+					Alternatives: []ast.Expression{
+						&ast.SeqExpr{
+							Exprs: []ast.Expression{
+								ast.NewLitMatcher(ast.Pos{}, "("),
+								&ast.PluckExpr{Expr: &ast.LabeledExpr{
+									Label: ast.NewIdentifier(ast.Pos{}, "e"),
+									Expr:  &ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "b")},
+								}},
+								ast.NewLitMatcher(ast.Pos{}, ")"),
+							},
+						},
+						&ast.SeqExpr{
+							Exprs: []ast.Expression{
+								&ast.PluckExpr{Expr: &ast.TextExpr{Expr: &ast.OneOrMoreExpr{
+									Expr: &ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "c")},
+								}}},
+								&ast.PluckExpr{Expr: &ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "d")}},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	"a = [a-e--[bd]] [\\p{sc=Greek}\\P{Lu}&&\\p{XID_Start}]": {
+		Rules: []*ast.Rule{
+			{
+				Name: ast.NewIdentifier(ast.Pos{}, "a"),
+				Expr: &ast.SeqExpr{
+					Exprs: []ast.Expression{
+						&ast.CharClassMatcher{Derived: true, Ranges: []rune{'a', 'a', 'c', 'c', 'e', 'e'}},
+						ast.NewCharClassMatcher(ast.Pos{}, `[\p{Greek}\P{Lu}&&\p{XID_Start}]`),
+					},
+				},
+			},
+		},
+	},
+	`a = re"[0-9]+" re"\"\\d" re "b"`: {
+		Rules: []*ast.Rule{
+			{
+				Name: ast.NewIdentifier(ast.Pos{}, "a"),
+				Expr: &ast.SeqExpr{
+					Exprs: []ast.Expression{
+						&ast.RegexpMatcher{Expr: `[0-9]+`},
+						&ast.RegexpMatcher{Expr: `"\\d`},
+						&ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "re")},
+						ast.NewLitMatcher(ast.Pos{}, "b"),
+					},
+				},
+			},
+		},
+	},
+	`a = @b() @ @c() @d`: {
+		Rules: []*ast.Rule{
+			{
+				Name: ast.NewIdentifier(ast.Pos{}, "a"),
+				Expr: &ast.SeqExpr{
+					Exprs: []ast.Expression{
+						&ast.MatcherFuncExpr{Name: ast.NewIdentifier(ast.Pos{}, "b")},
+						&ast.PluckExpr{Expr: &ast.MatcherFuncExpr{Name: ast.NewIdentifier(ast.Pos{}, "c")}},
+						&ast.PluckExpr{Expr: &ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "d")}},
+					},
+				},
+			},
+		},
+	},
+	"%{e} = \"expecting {expected}\"\na = 'b' / %{e}\n%{f} ← `found {found}`": {
+		Rules: []*ast.Rule{
+			{
+				Name: ast.NewIdentifier(ast.Pos{}, "a"),
+				Expr: &ast.ChoiceExpr{
+					Alternatives: []ast.Expression{
+						ast.NewLitMatcher(ast.Pos{}, "b"),
+						&ast.ThrowExpr{Label: "e"},
+					},
+				},
+			},
+		},
+		FailureLabels: []*ast.FailureLabelDecl{
+			{Label: ast.NewIdentifier(ast.Pos{}, "e"), Message: "expecting {expected}"},
+			{Label: ast.NewIdentifier(ast.Pos{}, "f"), Message: "found {found}"},
+		},
+	},
+	"a = %precedence( b %left '+' { 1 } %prefix '-' )": {
+		Rules: []*ast.Rule{
+			{
+				Name: ast.NewIdentifier(ast.Pos{}, "a"),
+				Expr: &ast.PrecedenceExpr{
+					Operand: &ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "b")},
+					Levels: []*ast.PrecedenceLevel{
+						{Kind: ast.PrecLeft, Op: ast.NewLitMatcher(ast.Pos{}, "+"), Code: ast.NewCodeBlock(ast.Pos{}, "{ 1 }")},
+						{Kind: ast.PrecPrefix, Op: ast.NewLitMatcher(ast.Pos{}, "-")},
+					},
+				},
+			},
+		},
+	},
+	"a @memo\n@memo ← b\nc = d": {
+		Rules: []*ast.Rule{
+			{
+				Name:        ast.NewIdentifier(ast.Pos{}, "a"),
+				Annotations: []*ast.Identifier{ast.NewIdentifier(ast.Pos{}, "memo"), ast.NewIdentifier(ast.Pos{}, "memo")},
+				Expr:        &ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "b")},
+			},
+			{
+				Name: ast.NewIdentifier(ast.Pos{}, "c"),
+				Expr: &ast.RuleRefExpr{Name: ast.NewIdentifier(ast.Pos{}, "d")},
+			},
+		},
+	},
 	"{ init \n}\na 'A'← b": {
 		Init: ast.NewCodeBlock(ast.Pos{}, "{ init \n}"),
 		Rules: []*ast.Rule{
@@ -443,3 +673,52 @@ again:
 		goto again
 	}
 }
+
+// TestTextOrBackRef pins the meaning of $name: a back-reference if the
+// rule has a label name, the text of the rule name otherwise, and an
+// error if both exist.
+func TestTextOrBackRef(t *testing.T) {
+	cases := []struct {
+		in      string
+		backRef bool
+		err     string
+	}{
+		{in: "a = n:'x' $n", backRef: true},
+		{in: "a = $b\nb = 'b'"},
+		{in: "a = n:'x' $b\nb = 'b'"},
+		{in: "a = b:'x' $b\nb = 'b'", err: "1:11 (10): $b is ambiguous in rule a: b is both a label and a rule"},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err != nil {
+			t.Errorf("%q: got error %v", tc.in, err)
+			continue
+		}
+		g := got.(*ast.Grammar)
+		err = ast.ResolveBackRefs(g)
+		if tc.err != "" {
+			if err == nil || err.Error() != tc.err {
+				t.Errorf("%q: want error %q, got %v", tc.in, tc.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: got error %v", tc.in, err)
+			continue
+		}
+
+		var backRef, text bool
+		ast.Inspect(g.Rules[0].Expr, func(expr ast.Expression) bool {
+			switch expr.(type) {
+			case *ast.BackRefExpr:
+				backRef = true
+			case *ast.TextExpr:
+				text = true
+			}
+			return true
+		})
+		if backRef != tc.backRef || text == tc.backRef {
+			t.Errorf("%q: want back-reference %t, got back-reference %t and text %t", tc.in, tc.backRef, backRef, text)
+		}
+	}
+}
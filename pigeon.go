@@ -9,10 +9,12 @@ import (
 	"io"
 	"math"
 	"os"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 	"unicode"
 	"unicode/utf8"
 
@@ -24,30 +26,39 @@ var g = &grammar{
 		{
 			name: "Grammar",
 			pos:  position{line: 5, col: 1, offset: 18},
+			id:   0,
 			expr: &actionExpr{
 				pos: position{line: 5, col: 11, offset: 30},
+				id:  80,
 				run: (*parser).callonGrammar1,
 				expr: &seqExpr{
 					pos: position{line: 5, col: 11, offset: 30},
+					id:  81,
 					exprs: []any{
 						&ruleRefExpr{
 							pos:  position{line: 5, col: 11, offset: 30},
+							id:   82,
 							name: "__",
 						},
 						&labeledExpr{
 							pos:   position{line: 5, col: 14, offset: 33},
+							id:    83,
 							label: "initializer",
 							expr: &zeroOrOneExpr{
 								pos: position{line: 5, col: 26, offset: 45},
+								id:  84,
 								expr: &seqExpr{
 									pos: position{line: 5, col: 28, offset: 47},
+									id:  85,
 									exprs: []any{
 										&ruleRefExpr{
 											pos:  position{line: 5, col: 28, offset: 47},
+											id:   86,
 											name: "Initializer",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 5, col: 40, offset: 59},
+											id:   87,
 											name: "__",
 										},
 									},
@@ -56,18 +67,41 @@ var g = &grammar{
 						},
 						&labeledExpr{
 							pos:   position{line: 5, col: 46, offset: 65},
+							id:    88,
 							label: "rules",
 							expr: &oneOrMoreExpr{
 								pos: position{line: 5, col: 52, offset: 71},
+								id:  89,
 								expr: &seqExpr{
 									pos: position{line: 5, col: 54, offset: 73},
+									id:  90,
 									exprs: []any{
-										&ruleRefExpr{
-											pos:  position{line: 5, col: 54, offset: 73},
-											name: "Rule",
+										&choiceExpr{
+											pos: position{line: 5, col: 56, offset: 75},
+											id:  91,
+											alternatives: []any{
+												&ruleRefExpr{
+													pos:  position{line: 5, col: 56, offset: 75},
+													id:   92,
+													name: "FailureLabelDecl",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 5, col: 75, offset: 94},
+													id:   93,
+													name: "Rule",
+												},
+											},
+											dispatch: &choiceDispatch{
+												ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x02\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00",
+												ranges:    []rune{'\u0080', 'ª', '«', 'µ', '¶', 'º', '»', 'À', '×', 'Ø', '÷', 'ø', '˂', 'ˆ', '˒', 'ˠ', '˥', 'ˬ', '˭', 'ˮ', '˯', 'Ͱ', '͵', 'Ͷ', '\u0378', 'ͺ', ';', 'Ϳ', '\u0380', 'Ά', '·', 'Έ', '\u038b', 'Ό', '\u038d', 'Ύ', '\u03a2', 'Σ', '϶', 'Ϸ', '҂', 'Ҋ', '\u0530', 'Ա', '\u0557', 'ՙ', '՚', 'ՠ', '։', 'א', '\u05eb', 'ׯ', '׳', 'ؠ', 'ً', 'ٮ', 'ٰ', 'ٱ', '۔', 'ە', 'ۖ', 'ۥ', 'ۧ', 'ۮ', '۰', 'ۺ', '۽', 'ۿ', '܀', 'ܐ', 'ܑ', 'ܒ', 'ܰ', 'ݍ', 'ަ', 'ޱ', '\u07b2', 'ߊ', '߫', 'ߴ', '߶', 'ߺ', '\u07fb', 'ࠀ', 'ࠖ', 'ࠚ', 'ࠛ', 'ࠤ', 'ࠥ', 'ࠨ', 'ࠩ', 'ࡀ', '࡙', 'ࡠ', '\u086b', 'ࡰ', '࢈', 'ࢉ', '\u0890', 'ࢠ', '࣊', 'ऄ', 'ऺ', 'ऽ', 'ा', 'ॐ', '॑', 'क़', 'ॢ', 'ॱ', 'ঁ', 'অ', '\u098d', 'এ', '\u0991', 'ও', '\u09a9', 'প', '\u09b1', 'ল', '\u09b3', 'শ', '\u09ba', 'ঽ', 'া', 'ৎ', '\u09cf', 'ড়', '\u09de', 'য়', 'ৢ', 'ৰ', '৲', 'ৼ', '৽', 'ਅ', '\u0a0b', 'ਏ', '\u0a11', 'ਓ', '\u0a29', 'ਪ', '\u0a31', 'ਲ', '\u0a34', 'ਵ', '\u0a37', 'ਸ', '\u0a3a', 'ਖ਼', '\u0a5d', 'ਫ਼', '\u0a5f', 'ੲ', 'ੵ', 'અ', '\u0a8e', 'એ', '\u0a92', 'ઓ', '\u0aa9', 'પ', '\u0ab1', 'લ', '\u0ab4', 'વ', '\u0aba', 'ઽ', 'ા', 'ૐ', '\u0ad1', 'ૠ', 'ૢ', 'ૹ', 'ૺ', 'ଅ', '\u0b0d', 'ଏ', '\u0b11', 'ଓ', '\u0b29', 'ପ', '\u0b31', 'ଲ', '\u0b34', 'ଵ', '\u0b3a', 'ଽ', 'ା', 'ଡ଼', '\u0b5e', 'ୟ', 'ୢ', 'ୱ', '୲', 'ஃ', '\u0b84', 'அ', '\u0b8b', 'எ', '\u0b91', 'ஒ', '\u0b96', 'ங', '\u0b9b', 'ஜ', '\u0b9d', 'ஞ', '\u0ba0', 'ண', '\u0ba5', 'ந', '\u0bab', 'ம', '\u0bba', 'ௐ', '\u0bd1', 'అ', '\u0c0d', 'ఎ', '\u0c11', 'ఒ', '\u0c29', 'ప', '\u0c3a', 'ఽ', 'ా', 'ౘ', '\u0c5b', '౜', '\u0c5e', 'ౠ', 'ౢ', 'ಀ', 'ಁ', 'ಅ', '\u0c8d', 'ಎ', '\u0c91', 'ಒ', '\u0ca9', 'ಪ', '\u0cb4', 'ವ', '\u0cba', 'ಽ', 'ಾ', '೜', '\u0cdf', 'ೠ', 'ೢ', 'ೱ', 'ೳ', 'ഄ', '\u0d0d', 'എ', '\u0d11', 'ഒ', '഻', 'ഽ', 'ാ', 'ൎ', '൏', 'ൔ', 'ൗ', 'ൟ', 'ൢ', 'ൺ', '\u0d80', 'අ', '\u0d97', 'ක', '\u0db2', 'ඳ', '\u0dbc', 'ල', '\u0dbe', 'ව', '\u0dc7', 'ก', 'ั', 'า', 'ิ', 'เ', '็', 'ກ', '\u0e83', 'ຄ', '\u0e85', 'ຆ', '\u0e8b', 'ຌ', '\u0ea4', 'ລ', '\u0ea6', 'ວ', 'ັ', 'າ', 'ິ', 'ຽ', '\u0ebe', 'ເ', '\u0ec5', 'ໆ', '\u0ec7', 'ໜ', '\u0ee0', 'ༀ', '༁', 'ཀ', '\u0f48', 'ཉ', '\u0f6d', 'ྈ', 'ྍ', 'က', 'ါ', 'ဿ', '၀', 'ၐ', 'ၖ', 'ၚ', 'ၞ', 'ၡ', 'ၢ', 'ၥ', 'ၧ', 'ၮ', 'ၱ', 'ၵ', 'ႂ', 'ႎ', 'ႏ', 'Ⴀ', '\u10c6', 'Ⴧ', '\u10c8', 'Ⴭ', '\u10ce', 'ა', '჻', 'ჼ', '\u1249', 'ቊ', '\u124e', 'ቐ', '\u1257', 'ቘ', '\u1259', 'ቚ', '\u125e', 'በ', '\u1289', 'ኊ', '\u128e', 'ነ', '\u12b1', 'ኲ', '\u12b6', 'ኸ', '\u12bf', 'ዀ', '\u12c1', 'ዂ', '\u12c6', 'ወ', '\u12d7', 'ዘ', '\u1311', 'ጒ', '\u1316', 'ጘ', '\u135b', 'ᎀ', '᎐', 'Ꭰ', '\u13f6', 'ᏸ', '\u13fe', 'ᐁ', '᙭', 'ᙯ', '\u1680', 'ᚁ', '᚛', 'ᚠ', '᛫', 'ᛱ', '\u16f9', 'ᜀ', 'ᜒ', 'ᜟ', 'ᜲ', 'ᝀ', 'ᝒ', 'ᝠ', '\u176d', 'ᝮ', '\u1771', 'ក', '឴', 'ៗ', '៘', 'ៜ', '៝', 'ᠠ', '\u1879', 'ᢀ', 'ᢅ', 'ᢇ', 'ᢩ', 'ᢪ', '\u18ab', 'ᢰ', '\u18f6', 'ᤀ', '\u191f', 'ᥐ', '\u196e', 'ᥰ', '\u1975', 'ᦀ', '\u19ac', 'ᦰ', '\u19ca', 'ᨀ', 'ᨗ', 'ᨠ', 'ᩕ', 'ᪧ', '᪨', 'ᬅ', '᬴', 'ᭅ', '\u1b4d', 'ᮃ', 'ᮡ', 'ᮮ', '᮰', 'ᮺ', '᯦', 'ᰀ', 'ᰤ', 'ᱍ', '᱐', 'ᱚ', '᱾', 'ᲀ', '\u1c8b', 'Ა', '\u1cbb', 'Ჽ', '᳀', 'ᳩ', '᳭', 'ᳮ', '᳴', 'ᳵ', '᳷', 'ᳺ', '\u1cfb', 'ᴀ', '᷀', 'Ḁ', '\u1f16', 'Ἐ', '\u1f1e', 'ἠ', '\u1f46', 'Ὀ', '\u1f4e', 'ὐ', '\u1f58', 'Ὑ', '\u1f5a', 'Ὓ', '\u1f5c', 'Ὕ', '\u1f5e', 'Ὗ', '\u1f7e', 'ᾀ', '\u1fb5', 'ᾶ', '᾽', 'ι', '᾿', 'ῂ', '\u1fc5', 'ῆ', '῍', 'ῐ', '\u1fd4', 'ῖ', '\u1fdc', 'ῠ', '῭', 'ῲ', '\u1ff5', 'ῶ', '´', 'ⁱ', '\u2072', 'ⁿ', '₀', 'ₐ', '\u209d', 'ℂ', '℃', 'ℇ', '℈', 'ℊ', '℔', 'ℕ', '№', 'ℙ', '℞', 'ℤ', '℥', 'Ω', '℧', 'ℨ', '℩', 'K', '℮', 'ℯ', '℺', 'ℼ', '⅀', 'ⅅ', '⅊', 'ⅎ', '⅏', 'Ↄ', 'ↅ', 'Ⰰ', '⳥', 'Ⳬ', '⳯', 'Ⳳ', '\u2cf4', 'ⴀ', '\u2d26', 'ⴧ', '\u2d28', 'ⴭ', '\u2d2e', 'ⴰ', '\u2d68', 'ⵯ', '⵰', 'ⶀ', '\u2d97', 'ⶠ', '\u2da7', 'ⶨ', '\u2daf', 'ⶰ', '\u2db7', 'ⶸ', '\u2dbf', 'ⷀ', '\u2dc7', 'ⷈ', '\u2dcf', 'ⷐ', '\u2dd7', 'ⷘ', '\u2ddf', 'ⸯ', '⸰', '々', '〇', '〱', '〶', '〻', '〽', 'ぁ', '\u3097', 'ゝ', '゠', 'ァ', '・', 'ー', '\u3100', 'ㄅ', '\u3130', 'ㄱ', '\u318f', 'ㆠ', '㇀', 'ㇰ', '㈀', '㐀', '䷀', '一', '\ua48d', 'ꓐ', '꓾', 'ꔀ', '꘍', 'ꘐ', '꘠', 'ꘪ', '\ua62c', 'Ꙁ', '꙯', 'ꙿ', 'ꚞ', 'ꚠ', 'ꛦ', 'ꜗ', '꜠', 'Ꜣ', '꞉', 'Ꞌ', '\ua7dd', '꟱', 'ꠂ', 'ꠃ', '꠆', 'ꠇ', 'ꠋ', 'ꠌ', 'ꠣ', 'ꡀ', '꡴', 'ꢂ', 'ꢴ', 'ꣲ', '꣸', 'ꣻ', '꣼', 'ꣽ', 'ꣿ', 'ꤊ', 'ꤦ', 'ꤰ', 'ꥇ', 'ꥠ', '\ua97d', 'ꦄ', '꦳', 'ꧏ', '꧐', 'ꧠ', 'ꧥ', 'ꧦ', '꧰', 'ꧺ', '\ua9ff', 'ꨀ', 'ꨩ', 'ꩀ', 'ꩃ', 'ꩄ', 'ꩌ', 'ꩠ', '꩷', 'ꩺ', 'ꩻ', 'ꩾ', 'ꪰ', 'ꪱ', 'ꪲ', 'ꪵ', 'ꪷ', 'ꪹ', 'ꪾ', 'ꫀ', '꫁', 'ꫂ', '\uaac3', 'ꫛ', '꫞', 'ꫠ', 'ꫫ', 'ꫲ', 'ꫵ', 'ꬁ', '\uab07', 'ꬉ', '\uab0f', 'ꬑ', '\uab17', 'ꬠ', '\uab27', 'ꬨ', '\uab2f', 'ꬰ', '꭛', 'ꭜ', '꭪', 'ꭰ', 'ꯣ', '가', '\ud7a4', 'ힰ', '\ud7c7', 'ퟋ', '\ud7fc', '豈', '\ufa6e', '並', '\ufada', 'ﬀ', '\ufb07', 'ﬓ', '\ufb18', 'יִ', 'ﬞ', 'ײַ', '﬩', 'שׁ', '\ufb37', 'טּ', '\ufb3d', 'מּ', '\ufb3f', 'נּ', '\ufb42', 'ףּ', '\ufb45', 'צּ', '﮲', 'ﯓ', '﴾', 'ﵐ', '﶐', 'ﶒ', '﷈', 'ﷰ', '﷼', 'ﹰ', '\ufe75', 'ﹶ', '\ufefd', 'Ａ', '［', 'ａ', '｛', 'ｦ', '\uffbf', 'ￂ', '\uffc8', 'ￊ', '\uffd0', 'ￒ', '\uffd8', 'ￚ', '\uffdd', '𐀀', '\U0001000c', '𐀍', '\U00010027', '𐀨', '\U0001003b', '𐀼', '\U0001003e', '𐀿', '\U0001004e', '𐁐', '\U0001005e', '𐂀', '\U000100fb', '𐊀', '\U0001029d', '𐊠', '\U000102d1', '𐌀', '𐌠', '𐌭', '𐍁', '𐍂', '𐍊', '𐍐', '𐍶', '𐎀', '\U0001039e', '𐎠', '\U000103c4', '𐏈', '𐏐', '𐐀', '\U0001049e', '𐒰', '\U000104d4', '𐓘', '\U000104fc', '𐔀', '\U00010528', '𐔰', '\U00010564', '𐕰', '\U0001057b', '𐕼', '\U0001058b', '𐖌', '\U00010593', '𐖔', '\U00010596', '𐖗', '\U000105a2', '𐖣', '\U000105b2', '𐖳', '\U000105ba', '𐖻', '\U000105bd', '𐗀', '\U000105f4', '𐘀', '\U00010737', '𐝀', '\U00010756', '𐝠', '\U00010768', '𐞀', '\U00010786', '𐞇', '\U000107b1', '𐞲', '\U000107bb', '𐠀', '\U00010806', '𐠈', '\U00010809', '𐠊', '\U00010836', '𐠷', '\U00010839', '𐠼', '\U0001083d', '𐠿', '\U00010856', '𐡠', '𐡷', '𐢀', '\U0001089f', '𐣠', '\U000108f3', '𐣴', '\U000108f6', '𐤀', '𐤖', '𐤠', '\U0001093a', '𐥀', '\U0001095a', '𐦀', '\U000109b8', '𐦾', '𐧀', '𐨀', '𐨁', '𐨐', '\U00010a14', '𐨕', '\U00010a18', '𐨙', '\U00010a36', '𐩠', '𐩽', '𐪀', '𐪝', '𐫀', '𐫈', '𐫉', '𐫥', '𐬀', '\U00010b36', '𐭀', '\U00010b56', '𐭠', '\U00010b73', '𐮀', '\U00010b92', '𐰀', '\U00010c49', '𐲀', '\U00010cb3', '𐳀', '\U00010cf3', '𐴀', '𐴤', '𐵊', '\U00010d66', '𐵯', '\U00010d86', '𐺀', '\U00010eaa', '𐺰', '\U00010eb2', '𐻂', '\U00010ec8', '𐼀', '𐼝', '𐼧', '\U00010f28', '𐼰', '𐽆', '𐽰', '𐾂', '𐾰', '𐿅', '𐿠', '\U00010ff7', '𑀃', '𑀸', '𑁱', '𑁳', '𑁵', '\U00011076', '𑂃', '𑂰', '𑃐', '\U000110e9', '𑄃', '𑄧', '𑅄', '𑅅', '𑅇', '\U00011148', '𑅐', '𑅳', '𑅶', '\U00011177', '𑆃', '𑆳', '𑇁', '𑇅', '𑇚', '𑇛', '𑇜', '𑇝', '𑈀', '\U00011212', '𑈓', '𑈬', '𑈿', '𑉁', '𑊀', '\U00011287', '𑊈', '\U00011289', '𑊊', '\U0001128e', '𑊏', '\U0001129e', '𑊟', '𑊩', '𑊰', '𑋟', '𑌅', '\U0001130d', '𑌏', '\U00011311', '𑌓', '\U00011329', '𑌪', '\U00011331', '𑌲', '\U00011334', '𑌵', '\U0001133a', '𑌽', '𑌾', '𑍐', '\U00011351', '𑍝', '𑍢', '𑎀', '\U0001138a', '𑎋', '\U0001138c', '𑎎', '\U0001138f', '𑎐', '\U000113b6', '𑎷', '𑎸', '𑏑', '𑏒', '𑏓', '𑏔', '𑐀', '𑐵', '𑑇', '𑑋', '𑑟', '\U00011462', '𑒀', '𑒰', '𑓄', '𑓆', '𑓇', '\U000114c8', '𑖀', '𑖯', '𑗘', '𑗜', '𑘀', '𑘰', '𑙄', '\U00011645', '𑚀', '𑚫', '𑚸', '𑚹', '𑜀', '\U0001171b', '𑝀', '\U00011747', '𑠀', '𑠬', '𑢠', '𑣠', '𑣿', '\U00011907', '𑤉', '\U0001190a', '𑤌', '\U00011914', '𑤕', '\U00011917', '𑤘', '𑤰', '𑤿', '𑥀', '𑥁', '𑥂', '𑦠', '\U000119a8', '𑦪', '𑧑', '𑧡', '𑧢', '𑧣', '𑧤', '𑨀', '𑨁', '𑨋', '𑨳', '𑨺', '𑨻', '𑩐', '𑩑', '𑩜', '𑪊', '𑪝', '𑪞', '𑪰', '\U00011af9', '𑯀', '𑯡', '𑰀', '\U00011c09', '𑰊', '𑰯', '𑱀', '𑱁', '𑱲', '\U00011c90', '𑴀', '\U00011d07', '𑴈', '\U00011d0a', '𑴋', '𑴱', '𑵆', '𑵇', '𑵠', '\U00011d66', '𑵧', '\U00011d69', '𑵪', '𑶊', '𑶘', '\U00011d99', '𑶰', '\U00011ddc', '𑻠', '𑻳', '𑼂', '𑼃', '𑼄', '\U00011f11', '𑼒', '𑼴', '𑾰', '\U00011fb1', '𒀀', '\U0001239a', '𒒀', '\U00012544', '𒾐', '𒿱', '𓀀', '\U00013430', '𓑁', '𓑇', '𓑠', '\U000143fb', '𔐀', '\U00014647', '𖄀', '𖄞', '𖠀', '\U00016a39', '𖩀', '\U00016a5f', '𖩰', '\U00016abf', '𖫐', '\U00016aee', '𖬀', '𖬰', '𖭀', '𖭄', '𖭣', '\U00016b78', '𖭽', '\U00016b90', '𖵀', '𖵭', '𖹀', '𖺀', '𖺠', '\U00016eb9', '𖺻', '\U00016ed4', '𖼀', '\U00016f4b', '𖽐', '𖽑', '𖾓', '\U00016fa0', '𖿠', '𖿢', '𖿣', '𖿤', '𖿲', '𖿴', '𗀀', '\U00018cd6', '𘳿', '\U00018d1f', '𘶀', '\U00018df3', '𚿰', '\U0001aff4', '𚿵', '\U0001affc', '𚿽', '\U0001afff', '𛀀', '\U0001b123', '𛄲', '\U0001b133', '𛅐', '\U0001b153', '𛅕', '\U0001b156', '𛅤', '\U0001b168', '𛅰', '\U0001b2fc', '𛰀', '\U0001bc6b', '𛱰', '\U0001bc7d', '𛲀', '\U0001bc89', '𛲐', '\U0001bc9a', '𝐀', '\U0001d455', '𝑖', '\U0001d49d', '𝒞', '\U0001d4a0', '𝒢', '\U0001d4a3', '𝒥', '\U0001d4a7', '𝒩', '\U0001d4ad', '𝒮', '\U0001d4ba', '𝒻', '\U0001d4bc', '𝒽', '\U0001d4c4', '𝓅', '\U0001d506', '𝔇', '\U0001d50b', '𝔍', '\U0001d515', '𝔖', '\U0001d51d', '𝔞', '\U0001d53a', '𝔻', '\U0001d53f', '𝕀', '\U0001d545', '𝕆', '\U0001d547', '𝕊', '\U0001d551', '𝕒', '\U0001d6a6', '𝚨', '𝛁', '𝛂', '𝛛', '𝛜', '𝛻', '𝛼', '𝜕', '𝜖', '𝜵', '𝜶', '𝝏', '𝝐', '𝝯', '𝝰', '𝞉', '𝞊', '𝞩', '𝞪', '𝟃', '𝟄', '\U0001d7cc', '𝼀', '\U0001df1f', '𝼥', '\U0001df2b', '𞀰', '\U0001e06e', '𞄀', '\U0001e12d', '𞄷', '\U0001e13e', '𞅎', '𞅏', '𞊐', '𞊮', '𞋀', '𞋬', '𞓐', '𞓬', '𞗐', '𞗮', '𞗰', '𞗱', '𞛀', '\U0001e6df', '𞛠', '𞛣', '𞛤', '𞛦', '𞛧', '𞛮', '𞛰', '𞛵', '𞛾', '\U0001e700', '𞟠', '\U0001e7e7', '𞟨', '\U0001e7ec', '𞟭', '\U0001e7ef', '𞟰', '\U0001e7ff', '𞠀', '\U0001e8c5', '𞤀', '𞥄', '𞥋', '\U0001e94c', '𞸀', '\U0001ee04', '𞸅', '\U0001ee20', '𞸡', '\U0001ee23', '𞸤', '\U0001ee25', '𞸧', '\U0001ee28', '𞸩', '\U0001ee33', '𞸴', '\U0001ee38', '𞸹', '\U0001ee3a', '𞸻', '\U0001ee3c', '𞹂', '\U0001ee43', '𞹇', '\U0001ee48', '𞹉', '\U0001ee4a', '𞹋', '\U0001ee4c', '𞹍', '\U0001ee50', '𞹑', '\U0001ee53', '𞹔', '\U0001ee55', '𞹗', '\U0001ee58', '𞹙', '\U0001ee5a', '𞹛', '\U0001ee5c', '𞹝', '\U0001ee5e', '𞹟', '\U0001ee60', '𞹡', '\U0001ee63', '𞹤', '\U0001ee65', '𞹧', '\U0001ee6b', '𞹬', '\U0001ee73', '𞹴', '\U0001ee78', '𞹹', '\U0001ee7d', '𞹾', '\U0001ee7f', '𞺀', '\U0001ee8a', '𞺋', '\U0001ee9c', '𞺡', '\U0001eea4', '𞺥', '\U0001eeaa', '𞺫', '\U0001eebc', '𠀀', '\U0002a6e0', '𪜀', '\U0002b81e', '𫠠', '\U0002ceae', '𬺰', '\U0002ebe1', '𮯰', '\U0002ee5e', '丽', '\U0002fa1e', '𰀀', '\U0003134b', '𱍐', '\U0003347a'},
+												rangeSets: "\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00",
+												alts:      [][]int{{}, {0}, {1}},
+												expected:  [][]string{{"\"%\"", "[\\pL_]"}, {"[\\pL_]"}, {"\"%\""}},
+											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 5, col: 59, offset: 78},
+											pos:  position{line: 5, col: 82, offset: 101},
+											id:   94,
 											name: "__",
 										},
 									},
@@ -75,7 +109,8 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 5, col: 65, offset: 84},
+							pos:  position{line: 5, col: 88, offset: 107},
+							id:   95,
 							name: "EOF",
 						},
 					},
@@ -84,23 +119,29 @@ var g = &grammar{
 		},
 		{
 			name: "Initializer",
-			pos:  position{line: 24, col: 1, offset: 513},
+			pos:  position{line: 31, col: 1, offset: 770},
+			id:   1,
 			expr: &actionExpr{
-				pos: position{line: 24, col: 15, offset: 529},
+				pos: position{line: 31, col: 15, offset: 786},
+				id:  96,
 				run: (*parser).callonInitializer1,
 				expr: &seqExpr{
-					pos: position{line: 24, col: 15, offset: 529},
+					pos: position{line: 31, col: 15, offset: 786},
+					id:  97,
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 24, col: 15, offset: 529},
+							pos:   position{line: 31, col: 15, offset: 786},
+							id:    98,
 							label: "code",
 							expr: &ruleRefExpr{
-								pos:  position{line: 24, col: 20, offset: 534},
+								pos:  position{line: 31, col: 20, offset: 791},
+								id:   99,
 								name: "CodeBlock",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 24, col: 30, offset: 544},
+							pos:  position{line: 31, col: 30, offset: 801},
+							id:   100,
 							name: "EOS",
 						},
 					},
@@ -109,39 +150,89 @@ var g = &grammar{
 		},
 		{
 			name: "Rule",
-			pos:  position{line: 28, col: 1, offset: 574},
+			pos:  position{line: 35, col: 1, offset: 831},
+			id:   2,
 			expr: &actionExpr{
-				pos: position{line: 28, col: 8, offset: 583},
+				pos: position{line: 35, col: 8, offset: 840},
+				id:  101,
 				run: (*parser).callonRule1,
 				expr: &seqExpr{
-					pos: position{line: 28, col: 8, offset: 583},
+					pos: position{line: 35, col: 8, offset: 840},
+					id:  102,
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 28, col: 8, offset: 583},
+							pos:   position{line: 35, col: 8, offset: 840},
+							id:    103,
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 28, col: 13, offset: 588},
+								pos:  position{line: 35, col: 13, offset: 845},
+								id:   104,
 								name: "IdentifierName",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 35, col: 28, offset: 860},
+							id:    105,
+							label: "params",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 35, col: 35, offset: 867},
+								id:  106,
+								expr: &ruleRefExpr{
+									pos:  position{line: 35, col: 35, offset: 867},
+									id:   107,
+									name: "RuleParams",
+								},
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 28, col: 28, offset: 603},
+							pos:  position{line: 35, col: 47, offset: 879},
+							id:   108,
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 28, col: 31, offset: 606},
+							pos:   position{line: 35, col: 50, offset: 882},
+							id:    109,
 							label: "display",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 28, col: 39, offset: 614},
+								pos: position{line: 35, col: 58, offset: 890},
+								id:  110,
 								expr: &seqExpr{
-									pos: position{line: 28, col: 41, offset: 616},
+									pos: position{line: 35, col: 60, offset: 892},
+									id:  111,
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 28, col: 41, offset: 616},
+											pos:  position{line: 35, col: 60, offset: 892},
+											id:   112,
 											name: "StringLiteral",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 28, col: 55, offset: 630},
+											pos:  position{line: 35, col: 74, offset: 906},
+											id:   113,
+											name: "__",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 35, col: 80, offset: 912},
+							id:    114,
+							label: "annotations",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 35, col: 92, offset: 924},
+								id:  115,
+								expr: &seqExpr{
+									pos: position{line: 35, col: 94, offset: 926},
+									id:  116,
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 35, col: 94, offset: 926},
+											id:   117,
+											name: "RuleAnnotation",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 35, col: 109, offset: 941},
+											id:   118,
 											name: "__",
 										},
 									},
@@ -149,96 +240,316 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 28, col: 61, offset: 636},
+							pos:  position{line: 35, col: 115, offset: 947},
+							id:   119,
 							name: "RuleDefOp",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 28, col: 71, offset: 646},
+							pos:  position{line: 35, col: 125, offset: 957},
+							id:   120,
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 28, col: 74, offset: 649},
+							pos:   position{line: 35, col: 128, offset: 960},
+							id:    121,
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 28, col: 79, offset: 654},
+								pos:  position{line: 35, col: 133, offset: 965},
+								id:   122,
 								name: "Expression",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 28, col: 90, offset: 665},
+							pos:  position{line: 35, col: 144, offset: 976},
+							id:   123,
+							name: "EOS",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FailureLabelDecl",
+			pos:  position{line: 56, col: 1, offset: 1600},
+			id:   3,
+			expr: &actionExpr{
+				pos: position{line: 56, col: 20, offset: 1621},
+				id:  124,
+				run: (*parser).callonFailureLabelDecl1,
+				expr: &seqExpr{
+					pos: position{line: 56, col: 20, offset: 1621},
+					id:  125,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 56, col: 20, offset: 1621},
+							id:         126,
+							val:        "%",
+							ignoreCase: false,
+							want:       "\"%\"",
+						},
+						&litMatcher{
+							pos:        position{line: 56, col: 24, offset: 1625},
+							id:         127,
+							val:        "{",
+							ignoreCase: false,
+							want:       "\"{\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 56, col: 28, offset: 1629},
+							id:    128,
+							label: "label",
+							expr: &ruleRefExpr{
+								pos:  position{line: 56, col: 34, offset: 1635},
+								id:   129,
+								name: "IdentifierName",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 56, col: 49, offset: 1650},
+							id:         130,
+							val:        "}",
+							ignoreCase: false,
+							want:       "\"}\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 56, col: 53, offset: 1654},
+							id:   131,
+							name: "__",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 56, col: 56, offset: 1657},
+							id:   132,
+							name: "RuleDefOp",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 56, col: 66, offset: 1667},
+							id:   133,
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 56, col: 69, offset: 1670},
+							id:    134,
+							label: "message",
+							expr: &ruleRefExpr{
+								pos:  position{line: 56, col: 77, offset: 1678},
+								id:   135,
+								name: "StringLiteral",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 56, col: 91, offset: 1692},
+							id:   136,
 							name: "EOS",
 						},
 					},
 				},
 			},
 		},
+		{
+			name: "RuleParams",
+			pos:  position{line: 67, col: 1, offset: 1982},
+			id:   4,
+			expr: &actionExpr{
+				pos: position{line: 67, col: 14, offset: 1997},
+				id:  137,
+				run: (*parser).callonRuleParams1,
+				expr: &seqExpr{
+					pos: position{line: 67, col: 14, offset: 1997},
+					id:  138,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 67, col: 14, offset: 1997},
+							id:         139,
+							val:        "<",
+							ignoreCase: false,
+							want:       "\"<\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 67, col: 18, offset: 2001},
+							id:   140,
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 67, col: 21, offset: 2004},
+							id:    141,
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 67, col: 27, offset: 2010},
+								id:   142,
+								name: "IdentifierName",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 67, col: 42, offset: 2025},
+							id:    143,
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 67, col: 47, offset: 2030},
+								id:  144,
+								expr: &seqExpr{
+									pos: position{line: 67, col: 49, offset: 2032},
+									id:  145,
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 67, col: 49, offset: 2032},
+											id:   146,
+											name: "__",
+										},
+										&litMatcher{
+											pos:        position{line: 67, col: 52, offset: 2035},
+											id:         147,
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 67, col: 56, offset: 2039},
+											id:   148,
+											name: "__",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 67, col: 59, offset: 2042},
+											id:   149,
+											name: "IdentifierName",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 67, col: 77, offset: 2060},
+							id:   150,
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 67, col: 80, offset: 2063},
+							id:         151,
+							val:        ">",
+							ignoreCase: false,
+							want:       "\">\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "RuleAnnotation",
+			pos:  position{line: 75, col: 1, offset: 2263},
+			id:   5,
+			expr: &actionExpr{
+				pos: position{line: 75, col: 18, offset: 2282},
+				id:  152,
+				run: (*parser).callonRuleAnnotation1,
+				expr: &seqExpr{
+					pos: position{line: 75, col: 18, offset: 2282},
+					id:  153,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 75, col: 18, offset: 2282},
+							id:         154,
+							val:        "@",
+							ignoreCase: false,
+							want:       "\"@\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 75, col: 22, offset: 2286},
+							id:    155,
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 75, col: 27, offset: 2291},
+								id:   156,
+								name: "IdentifierName",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "Expression",
-			pos:  position{line: 41, col: 1, offset: 947},
+			pos:  position{line: 83, col: 1, offset: 2472},
+			id:   6,
 			expr: &ruleRefExpr{
-				pos:  position{line: 41, col: 14, offset: 962},
+				pos:  position{line: 83, col: 14, offset: 2487},
+				id:   157,
 				name: "RecoveryExpr",
 			},
 		},
 		{
 			name: "RecoveryExpr",
-			pos:  position{line: 43, col: 1, offset: 976},
+			pos:  position{line: 85, col: 1, offset: 2501},
+			id:   7,
 			expr: &actionExpr{
-				pos: position{line: 43, col: 16, offset: 993},
+				pos: position{line: 85, col: 16, offset: 2518},
+				id:  158,
 				run: (*parser).callonRecoveryExpr1,
 				expr: &seqExpr{
-					pos: position{line: 43, col: 16, offset: 993},
+					pos: position{line: 85, col: 16, offset: 2518},
+					id:  159,
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 43, col: 16, offset: 993},
+							pos:   position{line: 85, col: 16, offset: 2518},
+							id:    160,
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 43, col: 21, offset: 998},
+								pos:  position{line: 85, col: 21, offset: 2523},
+								id:   161,
 								name: "ChoiceExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 43, col: 32, offset: 1009},
+							pos:   position{line: 85, col: 32, offset: 2534},
+							id:    162,
 							label: "recoverExprs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 43, col: 45, offset: 1022},
+								pos: position{line: 85, col: 45, offset: 2547},
+								id:  163,
 								expr: &seqExpr{
-									pos: position{line: 43, col: 47, offset: 1024},
+									pos: position{line: 85, col: 47, offset: 2549},
+									id:  164,
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 43, col: 47, offset: 1024},
+											pos:  position{line: 85, col: 47, offset: 2549},
+											id:   165,
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 43, col: 50, offset: 1027},
+											pos:        position{line: 85, col: 50, offset: 2552},
+											id:         166,
 											val:        "//{",
 											ignoreCase: false,
 											want:       "\"//{\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 43, col: 56, offset: 1033},
+											pos:  position{line: 85, col: 56, offset: 2558},
+											id:   167,
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 43, col: 59, offset: 1036},
+											pos:  position{line: 85, col: 59, offset: 2561},
+											id:   168,
 											name: "Labels",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 43, col: 66, offset: 1043},
+											pos:  position{line: 85, col: 66, offset: 2568},
+											id:   169,
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 43, col: 69, offset: 1046},
+											pos:        position{line: 85, col: 69, offset: 2571},
+											id:         170,
 											val:        "}",
 											ignoreCase: false,
 											want:       "\"}\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 43, col: 73, offset: 1050},
+											pos:  position{line: 85, col: 73, offset: 2575},
+											id:   171,
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 43, col: 76, offset: 1053},
+											pos:  position{line: 85, col: 76, offset: 2578},
+											id:   172,
 											name: "ChoiceExpr",
 										},
 									},
@@ -251,45 +562,57 @@ var g = &grammar{
 		},
 		{
 			name: "Labels",
-			pos:  position{line: 58, col: 1, offset: 1449},
+			pos:  position{line: 100, col: 1, offset: 2974},
+			id:   8,
 			expr: &actionExpr{
-				pos: position{line: 58, col: 10, offset: 1460},
+				pos: position{line: 100, col: 10, offset: 2985},
+				id:  173,
 				run: (*parser).callonLabels1,
 				expr: &seqExpr{
-					pos: position{line: 58, col: 10, offset: 1460},
+					pos: position{line: 100, col: 10, offset: 2985},
+					id:  174,
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 58, col: 10, offset: 1460},
+							pos:   position{line: 100, col: 10, offset: 2985},
+							id:    175,
 							label: "label",
 							expr: &ruleRefExpr{
-								pos:  position{line: 58, col: 16, offset: 1466},
+								pos:  position{line: 100, col: 16, offset: 2991},
+								id:   176,
 								name: "IdentifierName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 58, col: 31, offset: 1481},
+							pos:   position{line: 100, col: 31, offset: 3006},
+							id:    177,
 							label: "labels",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 58, col: 38, offset: 1488},
+								pos: position{line: 100, col: 38, offset: 3013},
+								id:  178,
 								expr: &seqExpr{
-									pos: position{line: 58, col: 40, offset: 1490},
+									pos: position{line: 100, col: 40, offset: 3015},
+									id:  179,
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 58, col: 40, offset: 1490},
+											pos:  position{line: 100, col: 40, offset: 3015},
+											id:   180,
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 58, col: 43, offset: 1493},
+											pos:        position{line: 100, col: 43, offset: 3018},
+											id:         181,
 											val:        ",",
 											ignoreCase: false,
 											want:       "\",\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 58, col: 47, offset: 1497},
+											pos:  position{line: 100, col: 47, offset: 3022},
+											id:   182,
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 58, col: 50, offset: 1500},
+											pos:  position{line: 100, col: 50, offset: 3025},
+											id:   183,
 											name: "IdentifierName",
 										},
 									},
@@ -302,45 +625,57 @@ var g = &grammar{
 		},
 		{
 			name: "ChoiceExpr",
-			pos:  position{line: 67, col: 1, offset: 1819},
+			pos:  position{line: 109, col: 1, offset: 3344},
+			id:   9,
 			expr: &actionExpr{
-				pos: position{line: 67, col: 14, offset: 1834},
+				pos: position{line: 109, col: 14, offset: 3359},
+				id:  184,
 				run: (*parser).callonChoiceExpr1,
 				expr: &seqExpr{
-					pos: position{line: 67, col: 14, offset: 1834},
+					pos: position{line: 109, col: 14, offset: 3359},
+					id:  185,
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 67, col: 14, offset: 1834},
+							pos:   position{line: 109, col: 14, offset: 3359},
+							id:    186,
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 67, col: 20, offset: 1840},
+								pos:  position{line: 109, col: 20, offset: 3365},
+								id:   187,
 								name: "ActionExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 67, col: 31, offset: 1851},
+							pos:   position{line: 109, col: 31, offset: 3376},
+							id:    188,
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 67, col: 36, offset: 1856},
+								pos: position{line: 109, col: 36, offset: 3381},
+								id:  189,
 								expr: &seqExpr{
-									pos: position{line: 67, col: 38, offset: 1858},
+									pos: position{line: 109, col: 38, offset: 3383},
+									id:  190,
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 67, col: 38, offset: 1858},
+											pos:  position{line: 109, col: 38, offset: 3383},
+											id:   191,
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 67, col: 41, offset: 1861},
+											pos:        position{line: 109, col: 41, offset: 3386},
+											id:         192,
 											val:        "/",
 											ignoreCase: false,
 											want:       "\"/\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 67, col: 45, offset: 1865},
+											pos:  position{line: 109, col: 45, offset: 3390},
+											id:   193,
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 67, col: 48, offset: 1868},
+											pos:  position{line: 109, col: 48, offset: 3393},
+											id:   194,
 											name: "ActionExpr",
 										},
 									},
@@ -353,35 +688,45 @@ var g = &grammar{
 		},
 		{
 			name: "ActionExpr",
-			pos:  position{line: 82, col: 1, offset: 2263},
+			pos:  position{line: 124, col: 1, offset: 3788},
+			id:   10,
 			expr: &actionExpr{
-				pos: position{line: 82, col: 14, offset: 2278},
+				pos: position{line: 124, col: 14, offset: 3803},
+				id:  195,
 				run: (*parser).callonActionExpr1,
 				expr: &seqExpr{
-					pos: position{line: 82, col: 14, offset: 2278},
+					pos: position{line: 124, col: 14, offset: 3803},
+					id:  196,
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 82, col: 14, offset: 2278},
+							pos:   position{line: 124, col: 14, offset: 3803},
+							id:    197,
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 82, col: 19, offset: 2283},
+								pos:  position{line: 124, col: 19, offset: 3808},
+								id:   198,
 								name: "SeqExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 82, col: 27, offset: 2291},
+							pos:   position{line: 124, col: 27, offset: 3816},
+							id:    199,
 							label: "code",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 82, col: 32, offset: 2296},
+								pos: position{line: 124, col: 32, offset: 3821},
+								id:  200,
 								expr: &seqExpr{
-									pos: position{line: 82, col: 34, offset: 2298},
+									pos: position{line: 124, col: 34, offset: 3823},
+									id:  201,
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 82, col: 34, offset: 2298},
+											pos:  position{line: 124, col: 34, offset: 3823},
+											id:   202,
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 82, col: 37, offset: 2301},
+											pos:  position{line: 124, col: 37, offset: 3826},
+											id:   203,
 											name: "CodeBlock",
 										},
 									},
@@ -394,36 +739,46 @@ var g = &grammar{
 		},
 		{
 			name: "SeqExpr",
-			pos:  position{line: 96, col: 1, offset: 2565},
+			pos:  position{line: 138, col: 1, offset: 4090},
+			id:   11,
 			expr: &actionExpr{
-				pos: position{line: 96, col: 11, offset: 2577},
+				pos: position{line: 138, col: 11, offset: 4102},
+				id:  204,
 				run: (*parser).callonSeqExpr1,
 				expr: &seqExpr{
-					pos: position{line: 96, col: 11, offset: 2577},
+					pos: position{line: 138, col: 11, offset: 4102},
+					id:  205,
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 96, col: 11, offset: 2577},
+							pos:   position{line: 138, col: 11, offset: 4102},
+							id:    206,
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 96, col: 17, offset: 2583},
-								name: "LabeledExpr",
+								pos:  position{line: 138, col: 17, offset: 4108},
+								id:   207,
+								name: "PluckExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 96, col: 29, offset: 2595},
+							pos:   position{line: 138, col: 27, offset: 4118},
+							id:    208,
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 96, col: 34, offset: 2600},
+								pos: position{line: 138, col: 32, offset: 4123},
+								id:  209,
 								expr: &seqExpr{
-									pos: position{line: 96, col: 36, offset: 2602},
+									pos: position{line: 138, col: 34, offset: 4125},
+									id:  210,
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 96, col: 36, offset: 2602},
+											pos:  position{line: 138, col: 34, offset: 4125},
+											id:   211,
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 96, col: 39, offset: 2605},
-											name: "LabeledExpr",
+											pos:  position{line: 138, col: 37, offset: 4128},
+											id:   212,
+											name: "PluckExpr",
 										},
 									},
 								},
@@ -433,45 +788,121 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "PluckExpr",
+			pos:  position{line: 158, col: 1, offset: 4810},
+			id:   12,
+			expr: &choiceExpr{
+				pos: position{line: 158, col: 13, offset: 4824},
+				id:  213,
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 158, col: 13, offset: 4824},
+						id:  214,
+						run: (*parser).callonPluckExpr2,
+						expr: &seqExpr{
+							pos: position{line: 158, col: 13, offset: 4824},
+							id:  215,
+							exprs: []any{
+								&notExpr{
+									pos: position{line: 158, col: 13, offset: 4824},
+									id:  216,
+									expr: &ruleRefExpr{
+										pos:  position{line: 158, col: 14, offset: 4825},
+										id:   217,
+										name: "MatcherFuncExpr",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 158, col: 30, offset: 4841},
+									id:         218,
+									val:        "@",
+									ignoreCase: false,
+									want:       "\"@\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 158, col: 34, offset: 4845},
+									id:   219,
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 158, col: 37, offset: 4848},
+									id:    220,
+									label: "expr",
+									expr: &ruleRefExpr{
+										pos:  position{line: 158, col: 42, offset: 4853},
+										id:   221,
+										name: "LabeledExpr",
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 162, col: 5, offset: 4974},
+						id:   222,
+						name: "LabeledExpr",
+					},
+				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x01\x00",
+					ranges:    []rune{'\u0080', 'ª', '«', 'µ', '¶', 'º', '»', 'À', '×', 'Ø', '÷', 'ø', '˂', 'ˆ', '˒', 'ˠ', '˥', 'ˬ', '˭', 'ˮ', '˯', 'Ͱ', '͵', 'Ͷ', '\u0378', 'ͺ', ';', 'Ϳ', '\u0380', 'Ά', '·', 'Έ', '\u038b', 'Ό', '\u038d', 'Ύ', '\u03a2', 'Σ', '϶', 'Ϸ', '҂', 'Ҋ', '\u0530', 'Ա', '\u0557', 'ՙ', '՚', 'ՠ', '։', 'א', '\u05eb', 'ׯ', '׳', 'ؠ', 'ً', 'ٮ', 'ٰ', 'ٱ', '۔', 'ە', 'ۖ', 'ۥ', 'ۧ', 'ۮ', '۰', 'ۺ', '۽', 'ۿ', '܀', 'ܐ', 'ܑ', 'ܒ', 'ܰ', 'ݍ', 'ަ', 'ޱ', '\u07b2', 'ߊ', '߫', 'ߴ', '߶', 'ߺ', '\u07fb', 'ࠀ', 'ࠖ', 'ࠚ', 'ࠛ', 'ࠤ', 'ࠥ', 'ࠨ', 'ࠩ', 'ࡀ', '࡙', 'ࡠ', '\u086b', 'ࡰ', '࢈', 'ࢉ', '\u0890', 'ࢠ', '࣊', 'ऄ', 'ऺ', 'ऽ', 'ा', 'ॐ', '॑', 'क़', 'ॢ', 'ॱ', 'ঁ', 'অ', '\u098d', 'এ', '\u0991', 'ও', '\u09a9', 'প', '\u09b1', 'ল', '\u09b3', 'শ', '\u09ba', 'ঽ', 'া', 'ৎ', '\u09cf', 'ড়', '\u09de', 'য়', 'ৢ', 'ৰ', '৲', 'ৼ', '৽', 'ਅ', '\u0a0b', 'ਏ', '\u0a11', 'ਓ', '\u0a29', 'ਪ', '\u0a31', 'ਲ', '\u0a34', 'ਵ', '\u0a37', 'ਸ', '\u0a3a', 'ਖ਼', '\u0a5d', 'ਫ਼', '\u0a5f', 'ੲ', 'ੵ', 'અ', '\u0a8e', 'એ', '\u0a92', 'ઓ', '\u0aa9', 'પ', '\u0ab1', 'લ', '\u0ab4', 'વ', '\u0aba', 'ઽ', 'ા', 'ૐ', '\u0ad1', 'ૠ', 'ૢ', 'ૹ', 'ૺ', 'ଅ', '\u0b0d', 'ଏ', '\u0b11', 'ଓ', '\u0b29', 'ପ', '\u0b31', 'ଲ', '\u0b34', 'ଵ', '\u0b3a', 'ଽ', 'ା', 'ଡ଼', '\u0b5e', 'ୟ', 'ୢ', 'ୱ', '୲', 'ஃ', '\u0b84', 'அ', '\u0b8b', 'எ', '\u0b91', 'ஒ', '\u0b96', 'ங', '\u0b9b', 'ஜ', '\u0b9d', 'ஞ', '\u0ba0', 'ண', '\u0ba5', 'ந', '\u0bab', 'ம', '\u0bba', 'ௐ', '\u0bd1', 'అ', '\u0c0d', 'ఎ', '\u0c11', 'ఒ', '\u0c29', 'ప', '\u0c3a', 'ఽ', 'ా', 'ౘ', '\u0c5b', '౜', '\u0c5e', 'ౠ', 'ౢ', 'ಀ', 'ಁ', 'ಅ', '\u0c8d', 'ಎ', '\u0c91', 'ಒ', '\u0ca9', 'ಪ', '\u0cb4', 'ವ', '\u0cba', 'ಽ', 'ಾ', '೜', '\u0cdf', 'ೠ', 'ೢ', 'ೱ', 'ೳ', 'ഄ', '\u0d0d', 'എ', '\u0d11', 'ഒ', '഻', 'ഽ', 'ാ', 'ൎ', '൏', 'ൔ', 'ൗ', 'ൟ', 'ൢ', 'ൺ', '\u0d80', 'අ', '\u0d97', 'ක', '\u0db2', 'ඳ', '\u0dbc', 'ල', '\u0dbe', 'ව', '\u0dc7', 'ก', 'ั', 'า', 'ิ', 'เ', '็', 'ກ', '\u0e83', 'ຄ', '\u0e85', 'ຆ', '\u0e8b', 'ຌ', '\u0ea4', 'ລ', '\u0ea6', 'ວ', 'ັ', 'າ', 'ິ', 'ຽ', '\u0ebe', 'ເ', '\u0ec5', 'ໆ', '\u0ec7', 'ໜ', '\u0ee0', 'ༀ', '༁', 'ཀ', '\u0f48', 'ཉ', '\u0f6d', 'ྈ', 'ྍ', 'က', 'ါ', 'ဿ', '၀', 'ၐ', 'ၖ', 'ၚ', 'ၞ', 'ၡ', 'ၢ', 'ၥ', 'ၧ', 'ၮ', 'ၱ', 'ၵ', 'ႂ', 'ႎ', 'ႏ', 'Ⴀ', '\u10c6', 'Ⴧ', '\u10c8', 'Ⴭ', '\u10ce', 'ა', '჻', 'ჼ', '\u1249', 'ቊ', '\u124e', 'ቐ', '\u1257', 'ቘ', '\u1259', 'ቚ', '\u125e', 'በ', '\u1289', 'ኊ', '\u128e', 'ነ', '\u12b1', 'ኲ', '\u12b6', 'ኸ', '\u12bf', 'ዀ', '\u12c1', 'ዂ', '\u12c6', 'ወ', '\u12d7', 'ዘ', '\u1311', 'ጒ', '\u1316', 'ጘ', '\u135b', 'ᎀ', '᎐', 'Ꭰ', '\u13f6', 'ᏸ', '\u13fe', 'ᐁ', '᙭', 'ᙯ', '\u1680', 'ᚁ', '᚛', 'ᚠ', '᛫', 'ᛱ', '\u16f9', 'ᜀ', 'ᜒ', 'ᜟ', 'ᜲ', 'ᝀ', 'ᝒ', 'ᝠ', '\u176d', 'ᝮ', '\u1771', 'ក', '឴', 'ៗ', '៘', 'ៜ', '៝', 'ᠠ', '\u1879', 'ᢀ', 'ᢅ', 'ᢇ', 'ᢩ', 'ᢪ', '\u18ab', 'ᢰ', '\u18f6', 'ᤀ', '\u191f', 'ᥐ', '\u196e', 'ᥰ', '\u1975', 'ᦀ', '\u19ac', 'ᦰ', '\u19ca', 'ᨀ', 'ᨗ', 'ᨠ', 'ᩕ', 'ᪧ', '᪨', 'ᬅ', '᬴', 'ᭅ', '\u1b4d', 'ᮃ', 'ᮡ', 'ᮮ', '᮰', 'ᮺ', '᯦', 'ᰀ', 'ᰤ', 'ᱍ', '᱐', 'ᱚ', '᱾', 'ᲀ', '\u1c8b', 'Ა', '\u1cbb', 'Ჽ', '᳀', 'ᳩ', '᳭', 'ᳮ', '᳴', 'ᳵ', '᳷', 'ᳺ', '\u1cfb', 'ᴀ', '᷀', 'Ḁ', '\u1f16', 'Ἐ', '\u1f1e', 'ἠ', '\u1f46', 'Ὀ', '\u1f4e', 'ὐ', '\u1f58', 'Ὑ', '\u1f5a', 'Ὓ', '\u1f5c', 'Ὕ', '\u1f5e', 'Ὗ', '\u1f7e', 'ᾀ', '\u1fb5', 'ᾶ', '᾽', 'ι', '᾿', 'ῂ', '\u1fc5', 'ῆ', '῍', 'ῐ', '\u1fd4', 'ῖ', '\u1fdc', 'ῠ', '῭', 'ῲ', '\u1ff5', 'ῶ', '´', 'ⁱ', '\u2072', 'ⁿ', '₀', 'ₐ', '\u209d', 'ℂ', '℃', 'ℇ', '℈', 'ℊ', '℔', 'ℕ', '№', 'ℙ', '℞', 'ℤ', '℥', 'Ω', '℧', 'ℨ', '℩', 'K', '℮', 'ℯ', '℺', 'ℼ', '⅀', 'ⅅ', '⅊', 'ⅎ', '⅏', 'Ↄ', 'ↅ', 'Ⰰ', '⳥', 'Ⳬ', '⳯', 'Ⳳ', '\u2cf4', 'ⴀ', '\u2d26', 'ⴧ', '\u2d28', 'ⴭ', '\u2d2e', 'ⴰ', '\u2d68', 'ⵯ', '⵰', 'ⶀ', '\u2d97', 'ⶠ', '\u2da7', 'ⶨ', '\u2daf', 'ⶰ', '\u2db7', 'ⶸ', '\u2dbf', 'ⷀ', '\u2dc7', 'ⷈ', '\u2dcf', 'ⷐ', '\u2dd7', 'ⷘ', '\u2ddf', 'ⸯ', '⸰', '々', '〇', '〱', '〶', '〻', '〽', 'ぁ', '\u3097', 'ゝ', '゠', 'ァ', '・', 'ー', '\u3100', 'ㄅ', '\u3130', 'ㄱ', '\u318f', 'ㆠ', '㇀', 'ㇰ', '㈀', '㐀', '䷀', '一', '\ua48d', 'ꓐ', '꓾', 'ꔀ', '꘍', 'ꘐ', '꘠', 'ꘪ', '\ua62c', 'Ꙁ', '꙯', 'ꙿ', 'ꚞ', 'ꚠ', 'ꛦ', 'ꜗ', '꜠', 'Ꜣ', '꞉', 'Ꞌ', '\ua7dd', '꟱', 'ꠂ', 'ꠃ', '꠆', 'ꠇ', 'ꠋ', 'ꠌ', 'ꠣ', 'ꡀ', '꡴', 'ꢂ', 'ꢴ', 'ꣲ', '꣸', 'ꣻ', '꣼', 'ꣽ', 'ꣿ', 'ꤊ', 'ꤦ', 'ꤰ', 'ꥇ', 'ꥠ', '\ua97d', 'ꦄ', '꦳', 'ꧏ', '꧐', 'ꧠ', 'ꧥ', 'ꧦ', '꧰', 'ꧺ', '\ua9ff', 'ꨀ', 'ꨩ', 'ꩀ', 'ꩃ', 'ꩄ', 'ꩌ', 'ꩠ', '꩷', 'ꩺ', 'ꩻ', 'ꩾ', 'ꪰ', 'ꪱ', 'ꪲ', 'ꪵ', 'ꪷ', 'ꪹ', 'ꪾ', 'ꫀ', '꫁', 'ꫂ', '\uaac3', 'ꫛ', '꫞', 'ꫠ', 'ꫫ', 'ꫲ', 'ꫵ', 'ꬁ', '\uab07', 'ꬉ', '\uab0f', 'ꬑ', '\uab17', 'ꬠ', '\uab27', 'ꬨ', '\uab2f', 'ꬰ', '꭛', 'ꭜ', '꭪', 'ꭰ', 'ꯣ', '가', '\ud7a4', 'ힰ', '\ud7c7', 'ퟋ', '\ud7fc', '豈', '\ufa6e', '並', '\ufada', 'ﬀ', '\ufb07', 'ﬓ', '\ufb18', 'יִ', 'ﬞ', 'ײַ', '﬩', 'שׁ', '\ufb37', 'טּ', '\ufb3d', 'מּ', '\ufb3f', 'נּ', '\ufb42', 'ףּ', '\ufb45', 'צּ', '﮲', 'ﯓ', '﴾', 'ﵐ', '﶐', 'ﶒ', '﷈', 'ﷰ', '﷼', 'ﹰ', '\ufe75', 'ﹶ', '\ufefd', 'Ａ', '［', 'ａ', '｛', 'ｦ', '\uffbf', 'ￂ', '\uffc8', 'ￊ', '\uffd0', 'ￒ', '\uffd8', 'ￚ', '\uffdd', '𐀀', '\U0001000c', '𐀍', '\U00010027', '𐀨', '\U0001003b', '𐀼', '\U0001003e', '𐀿', '\U0001004e', '𐁐', '\U0001005e', '𐂀', '\U000100fb', '𐊀', '\U0001029d', '𐊠', '\U000102d1', '𐌀', '𐌠', '𐌭', '𐍁', '𐍂', '𐍊', '𐍐', '𐍶', '𐎀', '\U0001039e', '𐎠', '\U000103c4', '𐏈', '𐏐', '𐐀', '\U0001049e', '𐒰', '\U000104d4', '𐓘', '\U000104fc', '𐔀', '\U00010528', '𐔰', '\U00010564', '𐕰', '\U0001057b', '𐕼', '\U0001058b', '𐖌', '\U00010593', '𐖔', '\U00010596', '𐖗', '\U000105a2', '𐖣', '\U000105b2', '𐖳', '\U000105ba', '𐖻', '\U000105bd', '𐗀', '\U000105f4', '𐘀', '\U00010737', '𐝀', '\U00010756', '𐝠', '\U00010768', '𐞀', '\U00010786', '𐞇', '\U000107b1', '𐞲', '\U000107bb', '𐠀', '\U00010806', '𐠈', '\U00010809', '𐠊', '\U00010836', '𐠷', '\U00010839', '𐠼', '\U0001083d', '𐠿', '\U00010856', '𐡠', '𐡷', '𐢀', '\U0001089f', '𐣠', '\U000108f3', '𐣴', '\U000108f6', '𐤀', '𐤖', '𐤠', '\U0001093a', '𐥀', '\U0001095a', '𐦀', '\U000109b8', '𐦾', '𐧀', '𐨀', '𐨁', '𐨐', '\U00010a14', '𐨕', '\U00010a18', '𐨙', '\U00010a36', '𐩠', '𐩽', '𐪀', '𐪝', '𐫀', '𐫈', '𐫉', '𐫥', '𐬀', '\U00010b36', '𐭀', '\U00010b56', '𐭠', '\U00010b73', '𐮀', '\U00010b92', '𐰀', '\U00010c49', '𐲀', '\U00010cb3', '𐳀', '\U00010cf3', '𐴀', '𐴤', '𐵊', '\U00010d66', '𐵯', '\U00010d86', '𐺀', '\U00010eaa', '𐺰', '\U00010eb2', '𐻂', '\U00010ec8', '𐼀', '𐼝', '𐼧', '\U00010f28', '𐼰', '𐽆', '𐽰', '𐾂', '𐾰', '𐿅', '𐿠', '\U00010ff7', '𑀃', '𑀸', '𑁱', '𑁳', '𑁵', '\U00011076', '𑂃', '𑂰', '𑃐', '\U000110e9', '𑄃', '𑄧', '𑅄', '𑅅', '𑅇', '\U00011148', '𑅐', '𑅳', '𑅶', '\U00011177', '𑆃', '𑆳', '𑇁', '𑇅', '𑇚', '𑇛', '𑇜', '𑇝', '𑈀', '\U00011212', '𑈓', '𑈬', '𑈿', '𑉁', '𑊀', '\U00011287', '𑊈', '\U00011289', '𑊊', '\U0001128e', '𑊏', '\U0001129e', '𑊟', '𑊩', '𑊰', '𑋟', '𑌅', '\U0001130d', '𑌏', '\U00011311', '𑌓', '\U00011329', '𑌪', '\U00011331', '𑌲', '\U00011334', '𑌵', '\U0001133a', '𑌽', '𑌾', '𑍐', '\U00011351', '𑍝', '𑍢', '𑎀', '\U0001138a', '𑎋', '\U0001138c', '𑎎', '\U0001138f', '𑎐', '\U000113b6', '𑎷', '𑎸', '𑏑', '𑏒', '𑏓', '𑏔', '𑐀', '𑐵', '𑑇', '𑑋', '𑑟', '\U00011462', '𑒀', '𑒰', '𑓄', '𑓆', '𑓇', '\U000114c8', '𑖀', '𑖯', '𑗘', '𑗜', '𑘀', '𑘰', '𑙄', '\U00011645', '𑚀', '𑚫', '𑚸', '𑚹', '𑜀', '\U0001171b', '𑝀', '\U00011747', '𑠀', '𑠬', '𑢠', '𑣠', '𑣿', '\U00011907', '𑤉', '\U0001190a', '𑤌', '\U00011914', '𑤕', '\U00011917', '𑤘', '𑤰', '𑤿', '𑥀', '𑥁', '𑥂', '𑦠', '\U000119a8', '𑦪', '𑧑', '𑧡', '𑧢', '𑧣', '𑧤', '𑨀', '𑨁', '𑨋', '𑨳', '𑨺', '𑨻', '𑩐', '𑩑', '𑩜', '𑪊', '𑪝', '𑪞', '𑪰', '\U00011af9', '𑯀', '𑯡', '𑰀', '\U00011c09', '𑰊', '𑰯', '𑱀', '𑱁', '𑱲', '\U00011c90', '𑴀', '\U00011d07', '𑴈', '\U00011d0a', '𑴋', '𑴱', '𑵆', '𑵇', '𑵠', '\U00011d66', '𑵧', '\U00011d69', '𑵪', '𑶊', '𑶘', '\U00011d99', '𑶰', '\U00011ddc', '𑻠', '𑻳', '𑼂', '𑼃', '𑼄', '\U00011f11', '𑼒', '𑼴', '𑾰', '\U00011fb1', '𒀀', '\U0001239a', '𒒀', '\U00012544', '𒾐', '𒿱', '𓀀', '\U00013430', '𓑁', '𓑇', '𓑠', '\U000143fb', '𔐀', '\U00014647', '𖄀', '𖄞', '𖠀', '\U00016a39', '𖩀', '\U00016a5f', '𖩰', '\U00016abf', '𖫐', '\U00016aee', '𖬀', '𖬰', '𖭀', '𖭄', '𖭣', '\U00016b78', '𖭽', '\U00016b90', '𖵀', '𖵭', '𖹀', '𖺀', '𖺠', '\U00016eb9', '𖺻', '\U00016ed4', '𖼀', '\U00016f4b', '𖽐', '𖽑', '𖾓', '\U00016fa0', '𖿠', '𖿢', '𖿣', '𖿤', '𖿲', '𖿴', '𗀀', '\U00018cd6', '𘳿', '\U00018d1f', '𘶀', '\U00018df3', '𚿰', '\U0001aff4', '𚿵', '\U0001affc', '𚿽', '\U0001afff', '𛀀', '\U0001b123', '𛄲', '\U0001b133', '𛅐', '\U0001b153', '𛅕', '\U0001b156', '𛅤', '\U0001b168', '𛅰', '\U0001b2fc', '𛰀', '\U0001bc6b', '𛱰', '\U0001bc7d', '𛲀', '\U0001bc89', '𛲐', '\U0001bc9a', '𝐀', '\U0001d455', '𝑖', '\U0001d49d', '𝒞', '\U0001d4a0', '𝒢', '\U0001d4a3', '𝒥', '\U0001d4a7', '𝒩', '\U0001d4ad', '𝒮', '\U0001d4ba', '𝒻', '\U0001d4bc', '𝒽', '\U0001d4c4', '𝓅', '\U0001d506', '𝔇', '\U0001d50b', '𝔍', '\U0001d515', '𝔖', '\U0001d51d', '𝔞', '\U0001d53a', '𝔻', '\U0001d53f', '𝕀', '\U0001d545', '𝕆', '\U0001d547', '𝕊', '\U0001d551', '𝕒', '\U0001d6a6', '𝚨', '𝛁', '𝛂', '𝛛', '𝛜', '𝛻', '𝛼', '𝜕', '𝜖', '𝜵', '𝜶', '𝝏', '𝝐', '𝝯', '𝝰', '𝞉', '𝞊', '𝞩', '𝞪', '𝟃', '𝟄', '\U0001d7cc', '𝼀', '\U0001df1f', '𝼥', '\U0001df2b', '𞀰', '\U0001e06e', '𞄀', '\U0001e12d', '𞄷', '\U0001e13e', '𞅎', '𞅏', '𞊐', '𞊮', '𞋀', '𞋬', '𞓐', '𞓬', '𞗐', '𞗮', '𞗰', '𞗱', '𞛀', '\U0001e6df', '𞛠', '𞛣', '𞛤', '𞛦', '𞛧', '𞛮', '𞛰', '𞛵', '𞛾', '\U0001e700', '𞟠', '\U0001e7e7', '𞟨', '\U0001e7ec', '𞟭', '\U0001e7ef', '𞟰', '\U0001e7ff', '𞠀', '\U0001e8c5', '𞤀', '𞥄', '𞥋', '\U0001e94c', '𞸀', '\U0001ee04', '𞸅', '\U0001ee20', '𞸡', '\U0001ee23', '𞸤', '\U0001ee25', '𞸧', '\U0001ee28', '𞸩', '\U0001ee33', '𞸴', '\U0001ee38', '𞸹', '\U0001ee3a', '𞸻', '\U0001ee3c', '𞹂', '\U0001ee43', '𞹇', '\U0001ee48', '𞹉', '\U0001ee4a', '𞹋', '\U0001ee4c', '𞹍', '\U0001ee50', '𞹑', '\U0001ee53', '𞹔', '\U0001ee55', '𞹗', '\U0001ee58', '𞹙', '\U0001ee5a', '𞹛', '\U0001ee5c', '𞹝', '\U0001ee5e', '𞹟', '\U0001ee60', '𞹡', '\U0001ee63', '𞹤', '\U0001ee65', '𞹧', '\U0001ee6b', '𞹬', '\U0001ee73', '𞹴', '\U0001ee78', '𞹹', '\U0001ee7d', '𞹾', '\U0001ee7f', '𞺀', '\U0001ee8a', '𞺋', '\U0001ee9c', '𞺡', '\U0001eea4', '𞺥', '\U0001eeaa', '𞺫', '\U0001eebc', '𠀀', '\U0002a6e0', '𪜀', '\U0002b81e', '𫠠', '\U0002ceae', '𬺰', '\U0002ebe1', '𮯰', '\U0002ee5e', '丽', '\U0002fa1e', '𰀀', '\U0003134b', '𱍐', '\U0003347a'},
+					rangeSets: "\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x00",
+					alts:      [][]int{{0}, {0, 1}},
+					expected:  [][]string{{"[\\pL_]", "\"&\"", "\"!\"", "\"$\"", "\"re\\\"\"", "\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "\"@\"", "\"#\"", "\"%precedence\"", "\"(\"", "\"%\"", "\"~\""}, {}},
+				},
+			},
+		},
 		{
 			name: "LabeledExpr",
-			pos:  position{line: 109, col: 1, offset: 2946},
+			pos:  position{line: 164, col: 1, offset: 4987},
+			id:   13,
 			expr: &choiceExpr{
-				pos: position{line: 109, col: 15, offset: 2962},
+				pos: position{line: 164, col: 15, offset: 5003},
+				id:  223,
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 109, col: 15, offset: 2962},
+						pos: position{line: 164, col: 15, offset: 5003},
+						id:  224,
 						run: (*parser).callonLabeledExpr2,
 						expr: &seqExpr{
-							pos: position{line: 109, col: 15, offset: 2962},
+							pos: position{line: 164, col: 15, offset: 5003},
+							id:  225,
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 109, col: 15, offset: 2962},
+									pos:   position{line: 164, col: 15, offset: 5003},
+									id:    226,
 									label: "label",
 									expr: &ruleRefExpr{
-										pos:  position{line: 109, col: 21, offset: 2968},
+										pos:  position{line: 164, col: 21, offset: 5009},
+										id:   227,
 										name: "Identifier",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 109, col: 32, offset: 2979},
+									pos:  position{line: 164, col: 32, offset: 5020},
+									id:   228,
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 109, col: 35, offset: 2982},
+									pos:        position{line: 164, col: 35, offset: 5023},
+									id:         229,
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 109, col: 39, offset: 2986},
+									pos:  position{line: 164, col: 39, offset: 5027},
+									id:   230,
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 109, col: 42, offset: 2989},
+									pos:   position{line: 164, col: 42, offset: 5030},
+									id:    231,
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 109, col: 47, offset: 2994},
+										pos:  position{line: 164, col: 47, offset: 5035},
+										id:   232,
 										name: "PrefixedExpr",
 									},
 								},
@@ -479,45 +910,68 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 115, col: 5, offset: 3167},
+						pos:  position{line: 170, col: 5, offset: 5208},
+						id:   233,
 						name: "PrefixedExpr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 115, col: 20, offset: 3182},
+						pos:  position{line: 170, col: 20, offset: 5223},
+						id:   234,
 						name: "ThrowExpr",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 170, col: 32, offset: 5235},
+						id:   235,
+						name: "CutExpr",
+					},
+				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x02\x01\x01\x01\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x01\x00\x00\x00\x03\x01\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x00\x00\x00\x04\x00",
+					ranges:    []rune{'\u0080', 'ª', '«', 'µ', '¶', 'º', '»', 'À', '×', 'Ø', '÷', 'ø', '˂', 'ˆ', '˒', 'ˠ', '˥', 'ˬ', '˭', 'ˮ', '˯', 'Ͱ', '͵', 'Ͷ', '\u0378', 'ͺ', ';', 'Ϳ', '\u0380', 'Ά', '·', 'Έ', '\u038b', 'Ό', '\u038d', 'Ύ', '\u03a2', 'Σ', '϶', 'Ϸ', '҂', 'Ҋ', '\u0530', 'Ա', '\u0557', 'ՙ', '՚', 'ՠ', '։', 'א', '\u05eb', 'ׯ', '׳', 'ؠ', 'ً', 'ٮ', 'ٰ', 'ٱ', '۔', 'ە', 'ۖ', 'ۥ', 'ۧ', 'ۮ', '۰', 'ۺ', '۽', 'ۿ', '܀', 'ܐ', 'ܑ', 'ܒ', 'ܰ', 'ݍ', 'ަ', 'ޱ', '\u07b2', 'ߊ', '߫', 'ߴ', '߶', 'ߺ', '\u07fb', 'ࠀ', 'ࠖ', 'ࠚ', 'ࠛ', 'ࠤ', 'ࠥ', 'ࠨ', 'ࠩ', 'ࡀ', '࡙', 'ࡠ', '\u086b', 'ࡰ', '࢈', 'ࢉ', '\u0890', 'ࢠ', '࣊', 'ऄ', 'ऺ', 'ऽ', 'ा', 'ॐ', '॑', 'क़', 'ॢ', 'ॱ', 'ঁ', 'অ', '\u098d', 'এ', '\u0991', 'ও', '\u09a9', 'প', '\u09b1', 'ল', '\u09b3', 'শ', '\u09ba', 'ঽ', 'া', 'ৎ', '\u09cf', 'ড়', '\u09de', 'য়', 'ৢ', 'ৰ', '৲', 'ৼ', '৽', 'ਅ', '\u0a0b', 'ਏ', '\u0a11', 'ਓ', '\u0a29', 'ਪ', '\u0a31', 'ਲ', '\u0a34', 'ਵ', '\u0a37', 'ਸ', '\u0a3a', 'ਖ਼', '\u0a5d', 'ਫ਼', '\u0a5f', 'ੲ', 'ੵ', 'અ', '\u0a8e', 'એ', '\u0a92', 'ઓ', '\u0aa9', 'પ', '\u0ab1', 'લ', '\u0ab4', 'વ', '\u0aba', 'ઽ', 'ા', 'ૐ', '\u0ad1', 'ૠ', 'ૢ', 'ૹ', 'ૺ', 'ଅ', '\u0b0d', 'ଏ', '\u0b11', 'ଓ', '\u0b29', 'ପ', '\u0b31', 'ଲ', '\u0b34', 'ଵ', '\u0b3a', 'ଽ', 'ା', 'ଡ଼', '\u0b5e', 'ୟ', 'ୢ', 'ୱ', '୲', 'ஃ', '\u0b84', 'அ', '\u0b8b', 'எ', '\u0b91', 'ஒ', '\u0b96', 'ங', '\u0b9b', 'ஜ', '\u0b9d', 'ஞ', '\u0ba0', 'ண', '\u0ba5', 'ந', '\u0bab', 'ம', '\u0bba', 'ௐ', '\u0bd1', 'అ', '\u0c0d', 'ఎ', '\u0c11', 'ఒ', '\u0c29', 'ప', '\u0c3a', 'ఽ', 'ా', 'ౘ', '\u0c5b', '౜', '\u0c5e', 'ౠ', 'ౢ', 'ಀ', 'ಁ', 'ಅ', '\u0c8d', 'ಎ', '\u0c91', 'ಒ', '\u0ca9', 'ಪ', '\u0cb4', 'ವ', '\u0cba', 'ಽ', 'ಾ', '೜', '\u0cdf', 'ೠ', 'ೢ', 'ೱ', 'ೳ', 'ഄ', '\u0d0d', 'എ', '\u0d11', 'ഒ', '഻', 'ഽ', 'ാ', 'ൎ', '൏', 'ൔ', 'ൗ', 'ൟ', 'ൢ', 'ൺ', '\u0d80', 'අ', '\u0d97', 'ක', '\u0db2', 'ඳ', '\u0dbc', 'ල', '\u0dbe', 'ව', '\u0dc7', 'ก', 'ั', 'า', 'ิ', 'เ', '็', 'ກ', '\u0e83', 'ຄ', '\u0e85', 'ຆ', '\u0e8b', 'ຌ', '\u0ea4', 'ລ', '\u0ea6', 'ວ', 'ັ', 'າ', 'ິ', 'ຽ', '\u0ebe', 'ເ', '\u0ec5', 'ໆ', '\u0ec7', 'ໜ', '\u0ee0', 'ༀ', '༁', 'ཀ', '\u0f48', 'ཉ', '\u0f6d', 'ྈ', 'ྍ', 'က', 'ါ', 'ဿ', '၀', 'ၐ', 'ၖ', 'ၚ', 'ၞ', 'ၡ', 'ၢ', 'ၥ', 'ၧ', 'ၮ', 'ၱ', 'ၵ', 'ႂ', 'ႎ', 'ႏ', 'Ⴀ', '\u10c6', 'Ⴧ', '\u10c8', 'Ⴭ', '\u10ce', 'ა', '჻', 'ჼ', '\u1249', 'ቊ', '\u124e', 'ቐ', '\u1257', 'ቘ', '\u1259', 'ቚ', '\u125e', 'በ', '\u1289', 'ኊ', '\u128e', 'ነ', '\u12b1', 'ኲ', '\u12b6', 'ኸ', '\u12bf', 'ዀ', '\u12c1', 'ዂ', '\u12c6', 'ወ', '\u12d7', 'ዘ', '\u1311', 'ጒ', '\u1316', 'ጘ', '\u135b', 'ᎀ', '᎐', 'Ꭰ', '\u13f6', 'ᏸ', '\u13fe', 'ᐁ', '᙭', 'ᙯ', '\u1680', 'ᚁ', '᚛', 'ᚠ', '᛫', 'ᛱ', '\u16f9', 'ᜀ', 'ᜒ', 'ᜟ', 'ᜲ', 'ᝀ', 'ᝒ', 'ᝠ', '\u176d', 'ᝮ', '\u1771', 'ក', '឴', 'ៗ', '៘', 'ៜ', '៝', 'ᠠ', '\u1879', 'ᢀ', 'ᢅ', 'ᢇ', 'ᢩ', 'ᢪ', '\u18ab', 'ᢰ', '\u18f6', 'ᤀ', '\u191f', 'ᥐ', '\u196e', 'ᥰ', '\u1975', 'ᦀ', '\u19ac', 'ᦰ', '\u19ca', 'ᨀ', 'ᨗ', 'ᨠ', 'ᩕ', 'ᪧ', '᪨', 'ᬅ', '᬴', 'ᭅ', '\u1b4d', 'ᮃ', 'ᮡ', 'ᮮ', '᮰', 'ᮺ', '᯦', 'ᰀ', 'ᰤ', 'ᱍ', '᱐', 'ᱚ', '᱾', 'ᲀ', '\u1c8b', 'Ა', '\u1cbb', 'Ჽ', '᳀', 'ᳩ', '᳭', 'ᳮ', '᳴', 'ᳵ', '᳷', 'ᳺ', '\u1cfb', 'ᴀ', '᷀', 'Ḁ', '\u1f16', 'Ἐ', '\u1f1e', 'ἠ', '\u1f46', 'Ὀ', '\u1f4e', 'ὐ', '\u1f58', 'Ὑ', '\u1f5a', 'Ὓ', '\u1f5c', 'Ὕ', '\u1f5e', 'Ὗ', '\u1f7e', 'ᾀ', '\u1fb5', 'ᾶ', '᾽', 'ι', '᾿', 'ῂ', '\u1fc5', 'ῆ', '῍', 'ῐ', '\u1fd4', 'ῖ', '\u1fdc', 'ῠ', '῭', 'ῲ', '\u1ff5', 'ῶ', '´', 'ⁱ', '\u2072', 'ⁿ', '₀', 'ₐ', '\u209d', 'ℂ', '℃', 'ℇ', '℈', 'ℊ', '℔', 'ℕ', '№', 'ℙ', '℞', 'ℤ', '℥', 'Ω', '℧', 'ℨ', '℩', 'K', '℮', 'ℯ', '℺', 'ℼ', '⅀', 'ⅅ', '⅊', 'ⅎ', '⅏', 'Ↄ', 'ↅ', 'Ⰰ', '⳥', 'Ⳬ', '⳯', 'Ⳳ', '\u2cf4', 'ⴀ', '\u2d26', 'ⴧ', '\u2d28', 'ⴭ', '\u2d2e', 'ⴰ', '\u2d68', 'ⵯ', '⵰', 'ⶀ', '\u2d97', 'ⶠ', '\u2da7', 'ⶨ', '\u2daf', 'ⶰ', '\u2db7', 'ⶸ', '\u2dbf', 'ⷀ', '\u2dc7', 'ⷈ', '\u2dcf', 'ⷐ', '\u2dd7', 'ⷘ', '\u2ddf', 'ⸯ', '⸰', '々', '〇', '〱', '〶', '〻', '〽', 'ぁ', '\u3097', 'ゝ', '゠', 'ァ', '・', 'ー', '\u3100', 'ㄅ', '\u3130', 'ㄱ', '\u318f', 'ㆠ', '㇀', 'ㇰ', '㈀', '㐀', '䷀', '一', '\ua48d', 'ꓐ', '꓾', 'ꔀ', '꘍', 'ꘐ', '꘠', 'ꘪ', '\ua62c', 'Ꙁ', '꙯', 'ꙿ', 'ꚞ', 'ꚠ', 'ꛦ', 'ꜗ', '꜠', 'Ꜣ', '꞉', 'Ꞌ', '\ua7dd', '꟱', 'ꠂ', 'ꠃ', '꠆', 'ꠇ', 'ꠋ', 'ꠌ', 'ꠣ', 'ꡀ', '꡴', 'ꢂ', 'ꢴ', 'ꣲ', '꣸', 'ꣻ', '꣼', 'ꣽ', 'ꣿ', 'ꤊ', 'ꤦ', 'ꤰ', 'ꥇ', 'ꥠ', '\ua97d', 'ꦄ', '꦳', 'ꧏ', '꧐', 'ꧠ', 'ꧥ', 'ꧦ', '꧰', 'ꧺ', '\ua9ff', 'ꨀ', 'ꨩ', 'ꩀ', 'ꩃ', 'ꩄ', 'ꩌ', 'ꩠ', '꩷', 'ꩺ', 'ꩻ', 'ꩾ', 'ꪰ', 'ꪱ', 'ꪲ', 'ꪵ', 'ꪷ', 'ꪹ', 'ꪾ', 'ꫀ', '꫁', 'ꫂ', '\uaac3', 'ꫛ', '꫞', 'ꫠ', 'ꫫ', 'ꫲ', 'ꫵ', 'ꬁ', '\uab07', 'ꬉ', '\uab0f', 'ꬑ', '\uab17', 'ꬠ', '\uab27', 'ꬨ', '\uab2f', 'ꬰ', '꭛', 'ꭜ', '꭪', 'ꭰ', 'ꯣ', '가', '\ud7a4', 'ힰ', '\ud7c7', 'ퟋ', '\ud7fc', '豈', '\ufa6e', '並', '\ufada', 'ﬀ', '\ufb07', 'ﬓ', '\ufb18', 'יִ', 'ﬞ', 'ײַ', '﬩', 'שׁ', '\ufb37', 'טּ', '\ufb3d', 'מּ', '\ufb3f', 'נּ', '\ufb42', 'ףּ', '\ufb45', 'צּ', '﮲', 'ﯓ', '﴾', 'ﵐ', '﶐', 'ﶒ', '﷈', 'ﷰ', '﷼', 'ﹰ', '\ufe75', 'ﹶ', '\ufefd', 'Ａ', '［', 'ａ', '｛', 'ｦ', '\uffbf', 'ￂ', '\uffc8', 'ￊ', '\uffd0', 'ￒ', '\uffd8', 'ￚ', '\uffdd', '𐀀', '\U0001000c', '𐀍', '\U00010027', '𐀨', '\U0001003b', '𐀼', '\U0001003e', '𐀿', '\U0001004e', '𐁐', '\U0001005e', '𐂀', '\U000100fb', '𐊀', '\U0001029d', '𐊠', '\U000102d1', '𐌀', '𐌠', '𐌭', '𐍁', '𐍂', '𐍊', '𐍐', '𐍶', '𐎀', '\U0001039e', '𐎠', '\U000103c4', '𐏈', '𐏐', '𐐀', '\U0001049e', '𐒰', '\U000104d4', '𐓘', '\U000104fc', '𐔀', '\U00010528', '𐔰', '\U00010564', '𐕰', '\U0001057b', '𐕼', '\U0001058b', '𐖌', '\U00010593', '𐖔', '\U00010596', '𐖗', '\U000105a2', '𐖣', '\U000105b2', '𐖳', '\U000105ba', '𐖻', '\U000105bd', '𐗀', '\U000105f4', '𐘀', '\U00010737', '𐝀', '\U00010756', '𐝠', '\U00010768', '𐞀', '\U00010786', '𐞇', '\U000107b1', '𐞲', '\U000107bb', '𐠀', '\U00010806', '𐠈', '\U00010809', '𐠊', '\U00010836', '𐠷', '\U00010839', '𐠼', '\U0001083d', '𐠿', '\U00010856', '𐡠', '𐡷', '𐢀', '\U0001089f', '𐣠', '\U000108f3', '𐣴', '\U000108f6', '𐤀', '𐤖', '𐤠', '\U0001093a', '𐥀', '\U0001095a', '𐦀', '\U000109b8', '𐦾', '𐧀', '𐨀', '𐨁', '𐨐', '\U00010a14', '𐨕', '\U00010a18', '𐨙', '\U00010a36', '𐩠', '𐩽', '𐪀', '𐪝', '𐫀', '𐫈', '𐫉', '𐫥', '𐬀', '\U00010b36', '𐭀', '\U00010b56', '𐭠', '\U00010b73', '𐮀', '\U00010b92', '𐰀', '\U00010c49', '𐲀', '\U00010cb3', '𐳀', '\U00010cf3', '𐴀', '𐴤', '𐵊', '\U00010d66', '𐵯', '\U00010d86', '𐺀', '\U00010eaa', '𐺰', '\U00010eb2', '𐻂', '\U00010ec8', '𐼀', '𐼝', '𐼧', '\U00010f28', '𐼰', '𐽆', '𐽰', '𐾂', '𐾰', '𐿅', '𐿠', '\U00010ff7', '𑀃', '𑀸', '𑁱', '𑁳', '𑁵', '\U00011076', '𑂃', '𑂰', '𑃐', '\U000110e9', '𑄃', '𑄧', '𑅄', '𑅅', '𑅇', '\U00011148', '𑅐', '𑅳', '𑅶', '\U00011177', '𑆃', '𑆳', '𑇁', '𑇅', '𑇚', '𑇛', '𑇜', '𑇝', '𑈀', '\U00011212', '𑈓', '𑈬', '𑈿', '𑉁', '𑊀', '\U00011287', '𑊈', '\U00011289', '𑊊', '\U0001128e', '𑊏', '\U0001129e', '𑊟', '𑊩', '𑊰', '𑋟', '𑌅', '\U0001130d', '𑌏', '\U00011311', '𑌓', '\U00011329', '𑌪', '\U00011331', '𑌲', '\U00011334', '𑌵', '\U0001133a', '𑌽', '𑌾', '𑍐', '\U00011351', '𑍝', '𑍢', '𑎀', '\U0001138a', '𑎋', '\U0001138c', '𑎎', '\U0001138f', '𑎐', '\U000113b6', '𑎷', '𑎸', '𑏑', '𑏒', '𑏓', '𑏔', '𑐀', '𑐵', '𑑇', '𑑋', '𑑟', '\U00011462', '𑒀', '𑒰', '𑓄', '𑓆', '𑓇', '\U000114c8', '𑖀', '𑖯', '𑗘', '𑗜', '𑘀', '𑘰', '𑙄', '\U00011645', '𑚀', '𑚫', '𑚸', '𑚹', '𑜀', '\U0001171b', '𑝀', '\U00011747', '𑠀', '𑠬', '𑢠', '𑣠', '𑣿', '\U00011907', '𑤉', '\U0001190a', '𑤌', '\U00011914', '𑤕', '\U00011917', '𑤘', '𑤰', '𑤿', '𑥀', '𑥁', '𑥂', '𑦠', '\U000119a8', '𑦪', '𑧑', '𑧡', '𑧢', '𑧣', '𑧤', '𑨀', '𑨁', '𑨋', '𑨳', '𑨺', '𑨻', '𑩐', '𑩑', '𑩜', '𑪊', '𑪝', '𑪞', '𑪰', '\U00011af9', '𑯀', '𑯡', '𑰀', '\U00011c09', '𑰊', '𑰯', '𑱀', '𑱁', '𑱲', '\U00011c90', '𑴀', '\U00011d07', '𑴈', '\U00011d0a', '𑴋', '𑴱', '𑵆', '𑵇', '𑵠', '\U00011d66', '𑵧', '\U00011d69', '𑵪', '𑶊', '𑶘', '\U00011d99', '𑶰', '\U00011ddc', '𑻠', '𑻳', '𑼂', '𑼃', '𑼄', '\U00011f11', '𑼒', '𑼴', '𑾰', '\U00011fb1', '𒀀', '\U0001239a', '𒒀', '\U00012544', '𒾐', '𒿱', '𓀀', '\U00013430', '𓑁', '𓑇', '𓑠', '\U000143fb', '𔐀', '\U00014647', '𖄀', '𖄞', '𖠀', '\U00016a39', '𖩀', '\U00016a5f', '𖩰', '\U00016abf', '𖫐', '\U00016aee', '𖬀', '𖬰', '𖭀', '𖭄', '𖭣', '\U00016b78', '𖭽', '\U00016b90', '𖵀', '𖵭', '𖹀', '𖺀', '𖺠', '\U00016eb9', '𖺻', '\U00016ed4', '𖼀', '\U00016f4b', '𖽐', '𖽑', '𖾓', '\U00016fa0', '𖿠', '𖿢', '𖿣', '𖿤', '𖿲', '𖿴', '𗀀', '\U00018cd6', '𘳿', '\U00018d1f', '𘶀', '\U00018df3', '𚿰', '\U0001aff4', '𚿵', '\U0001affc', '𚿽', '\U0001afff', '𛀀', '\U0001b123', '𛄲', '\U0001b133', '𛅐', '\U0001b153', '𛅕', '\U0001b156', '𛅤', '\U0001b168', '𛅰', '\U0001b2fc', '𛰀', '\U0001bc6b', '𛱰', '\U0001bc7d', '𛲀', '\U0001bc89', '𛲐', '\U0001bc9a', '𝐀', '\U0001d455', '𝑖', '\U0001d49d', '𝒞', '\U0001d4a0', '𝒢', '\U0001d4a3', '𝒥', '\U0001d4a7', '𝒩', '\U0001d4ad', '𝒮', '\U0001d4ba', '𝒻', '\U0001d4bc', '𝒽', '\U0001d4c4', '𝓅', '\U0001d506', '𝔇', '\U0001d50b', '𝔍', '\U0001d515', '𝔖', '\U0001d51d', '𝔞', '\U0001d53a', '𝔻', '\U0001d53f', '𝕀', '\U0001d545', '𝕆', '\U0001d547', '𝕊', '\U0001d551', '𝕒', '\U0001d6a6', '𝚨', '𝛁', '𝛂', '𝛛', '𝛜', '𝛻', '𝛼', '𝜕', '𝜖', '𝜵', '𝜶', '𝝏', '𝝐', '𝝯', '𝝰', '𝞉', '𝞊', '𝞩', '𝞪', '𝟃', '𝟄', '\U0001d7cc', '𝼀', '\U0001df1f', '𝼥', '\U0001df2b', '𞀰', '\U0001e06e', '𞄀', '\U0001e12d', '𞄷', '\U0001e13e', '𞅎', '𞅏', '𞊐', '𞊮', '𞋀', '𞋬', '𞓐', '𞓬', '𞗐', '𞗮', '𞗰', '𞗱', '𞛀', '\U0001e6df', '𞛠', '𞛣', '𞛤', '𞛦', '𞛧', '𞛮', '𞛰', '𞛵', '𞛾', '\U0001e700', '𞟠', '\U0001e7e7', '𞟨', '\U0001e7ec', '𞟭', '\U0001e7ef', '𞟰', '\U0001e7ff', '𞠀', '\U0001e8c5', '𞤀', '𞥄', '𞥋', '\U0001e94c', '𞸀', '\U0001ee04', '𞸅', '\U0001ee20', '𞸡', '\U0001ee23', '𞸤', '\U0001ee25', '𞸧', '\U0001ee28', '𞸩', '\U0001ee33', '𞸴', '\U0001ee38', '𞸹', '\U0001ee3a', '𞸻', '\U0001ee3c', '𞹂', '\U0001ee43', '𞹇', '\U0001ee48', '𞹉', '\U0001ee4a', '𞹋', '\U0001ee4c', '𞹍', '\U0001ee50', '𞹑', '\U0001ee53', '𞹔', '\U0001ee55', '𞹗', '\U0001ee58', '𞹙', '\U0001ee5a', '𞹛', '\U0001ee5c', '𞹝', '\U0001ee5e', '𞹟', '\U0001ee60', '𞹡', '\U0001ee63', '𞹤', '\U0001ee65', '𞹧', '\U0001ee6b', '𞹬', '\U0001ee73', '𞹴', '\U0001ee78', '𞹹', '\U0001ee7d', '𞹾', '\U0001ee7f', '𞺀', '\U0001ee8a', '𞺋', '\U0001ee9c', '𞺡', '\U0001eea4', '𞺥', '\U0001eeaa', '𞺫', '\U0001eebc', '𠀀', '\U0002a6e0', '𪜀', '\U0002b81e', '𫠠', '\U0002ceae', '𬺰', '\U0002ebe1', '𮯰', '\U0002ee5e', '丽', '\U0002fa1e', '𰀀', '\U0003134b', '𱍐', '\U0003347a'},
+					rangeSets: "\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00",
+					alts:      [][]int{{}, {1}, {1, 2}, {0, 1}, {3}},
+					expected:  [][]string{{"[\\pL_]", "\"&\"", "\"!\"", "\"$\"", "\"re\\\"\"", "\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "\"@\"", "\"#\"", "\"%precedence\"", "\"(\"", "\"%\"", "\"~\""}, {"[\\pL_]", "\"%\"", "\"~\""}, {"[\\pL_]", "\"~\""}, {"\"%\"", "\"~\""}, {"[\\pL_]", "\"&\"", "\"!\"", "\"$\"", "\"re\\\"\"", "\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "\"@\"", "\"#\"", "\"%precedence\"", "\"(\"", "\"%\""}},
 				},
 			},
 		},
 		{
 			name: "PrefixedExpr",
-			pos:  position{line: 117, col: 1, offset: 3193},
+			pos:  position{line: 172, col: 1, offset: 5244},
+			id:   14,
 			expr: &choiceExpr{
-				pos: position{line: 117, col: 16, offset: 3210},
+				pos: position{line: 172, col: 16, offset: 5261},
+				id:  236,
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 117, col: 16, offset: 3210},
+						pos: position{line: 172, col: 16, offset: 5261},
+						id:  237,
 						run: (*parser).callonPrefixedExpr2,
 						expr: &seqExpr{
-							pos: position{line: 117, col: 16, offset: 3210},
+							pos: position{line: 172, col: 16, offset: 5261},
+							id:  238,
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 117, col: 16, offset: 3210},
+									pos:   position{line: 172, col: 16, offset: 5261},
+									id:    239,
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 117, col: 19, offset: 3213},
+										pos:  position{line: 172, col: 19, offset: 5264},
+										id:   240,
 										name: "PrefixedOp",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 117, col: 30, offset: 3224},
+									pos:  position{line: 172, col: 30, offset: 5275},
+									id:   241,
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 117, col: 33, offset: 3227},
+									pos:   position{line: 172, col: 33, offset: 5278},
+									id:    242,
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 117, col: 38, offset: 3232},
+										pos:  position{line: 172, col: 38, offset: 5283},
+										id:   243,
 										name: "SuffixedExpr",
 									},
 								},
@@ -525,166 +979,358 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 128, col: 5, offset: 3514},
+						pos:  position{line: 189, col: 5, offset: 5776},
+						id:   244,
 						name: "SuffixedExpr",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x02\x01\x02\x01\x02\x02\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080', 'ª', '«', 'µ', '¶', 'º', '»', 'À', '×', 'Ø', '÷', 'ø', '˂', 'ˆ', '˒', 'ˠ', '˥', 'ˬ', '˭', 'ˮ', '˯', 'Ͱ', '͵', 'Ͷ', '\u0378', 'ͺ', ';', 'Ϳ', '\u0380', 'Ά', '·', 'Έ', '\u038b', 'Ό', '\u038d', 'Ύ', '\u03a2', 'Σ', '϶', 'Ϸ', '҂', 'Ҋ', '\u0530', 'Ա', '\u0557', 'ՙ', '՚', 'ՠ', '։', 'א', '\u05eb', 'ׯ', '׳', 'ؠ', 'ً', 'ٮ', 'ٰ', 'ٱ', '۔', 'ە', 'ۖ', 'ۥ', 'ۧ', 'ۮ', '۰', 'ۺ', '۽', 'ۿ', '܀', 'ܐ', 'ܑ', 'ܒ', 'ܰ', 'ݍ', 'ަ', 'ޱ', '\u07b2', 'ߊ', '߫', 'ߴ', '߶', 'ߺ', '\u07fb', 'ࠀ', 'ࠖ', 'ࠚ', 'ࠛ', 'ࠤ', 'ࠥ', 'ࠨ', 'ࠩ', 'ࡀ', '࡙', 'ࡠ', '\u086b', 'ࡰ', '࢈', 'ࢉ', '\u0890', 'ࢠ', '࣊', 'ऄ', 'ऺ', 'ऽ', 'ा', 'ॐ', '॑', 'क़', 'ॢ', 'ॱ', 'ঁ', 'অ', '\u098d', 'এ', '\u0991', 'ও', '\u09a9', 'প', '\u09b1', 'ল', '\u09b3', 'শ', '\u09ba', 'ঽ', 'া', 'ৎ', '\u09cf', 'ড়', '\u09de', 'য়', 'ৢ', 'ৰ', '৲', 'ৼ', '৽', 'ਅ', '\u0a0b', 'ਏ', '\u0a11', 'ਓ', '\u0a29', 'ਪ', '\u0a31', 'ਲ', '\u0a34', 'ਵ', '\u0a37', 'ਸ', '\u0a3a', 'ਖ਼', '\u0a5d', 'ਫ਼', '\u0a5f', 'ੲ', 'ੵ', 'અ', '\u0a8e', 'એ', '\u0a92', 'ઓ', '\u0aa9', 'પ', '\u0ab1', 'લ', '\u0ab4', 'વ', '\u0aba', 'ઽ', 'ા', 'ૐ', '\u0ad1', 'ૠ', 'ૢ', 'ૹ', 'ૺ', 'ଅ', '\u0b0d', 'ଏ', '\u0b11', 'ଓ', '\u0b29', 'ପ', '\u0b31', 'ଲ', '\u0b34', 'ଵ', '\u0b3a', 'ଽ', 'ା', 'ଡ଼', '\u0b5e', 'ୟ', 'ୢ', 'ୱ', '୲', 'ஃ', '\u0b84', 'அ', '\u0b8b', 'எ', '\u0b91', 'ஒ', '\u0b96', 'ங', '\u0b9b', 'ஜ', '\u0b9d', 'ஞ', '\u0ba0', 'ண', '\u0ba5', 'ந', '\u0bab', 'ம', '\u0bba', 'ௐ', '\u0bd1', 'అ', '\u0c0d', 'ఎ', '\u0c11', 'ఒ', '\u0c29', 'ప', '\u0c3a', 'ఽ', 'ా', 'ౘ', '\u0c5b', '౜', '\u0c5e', 'ౠ', 'ౢ', 'ಀ', 'ಁ', 'ಅ', '\u0c8d', 'ಎ', '\u0c91', 'ಒ', '\u0ca9', 'ಪ', '\u0cb4', 'ವ', '\u0cba', 'ಽ', 'ಾ', '೜', '\u0cdf', 'ೠ', 'ೢ', 'ೱ', 'ೳ', 'ഄ', '\u0d0d', 'എ', '\u0d11', 'ഒ', '഻', 'ഽ', 'ാ', 'ൎ', '൏', 'ൔ', 'ൗ', 'ൟ', 'ൢ', 'ൺ', '\u0d80', 'අ', '\u0d97', 'ක', '\u0db2', 'ඳ', '\u0dbc', 'ල', '\u0dbe', 'ව', '\u0dc7', 'ก', 'ั', 'า', 'ิ', 'เ', '็', 'ກ', '\u0e83', 'ຄ', '\u0e85', 'ຆ', '\u0e8b', 'ຌ', '\u0ea4', 'ລ', '\u0ea6', 'ວ', 'ັ', 'າ', 'ິ', 'ຽ', '\u0ebe', 'ເ', '\u0ec5', 'ໆ', '\u0ec7', 'ໜ', '\u0ee0', 'ༀ', '༁', 'ཀ', '\u0f48', 'ཉ', '\u0f6d', 'ྈ', 'ྍ', 'က', 'ါ', 'ဿ', '၀', 'ၐ', 'ၖ', 'ၚ', 'ၞ', 'ၡ', 'ၢ', 'ၥ', 'ၧ', 'ၮ', 'ၱ', 'ၵ', 'ႂ', 'ႎ', 'ႏ', 'Ⴀ', '\u10c6', 'Ⴧ', '\u10c8', 'Ⴭ', '\u10ce', 'ა', '჻', 'ჼ', '\u1249', 'ቊ', '\u124e', 'ቐ', '\u1257', 'ቘ', '\u1259', 'ቚ', '\u125e', 'በ', '\u1289', 'ኊ', '\u128e', 'ነ', '\u12b1', 'ኲ', '\u12b6', 'ኸ', '\u12bf', 'ዀ', '\u12c1', 'ዂ', '\u12c6', 'ወ', '\u12d7', 'ዘ', '\u1311', 'ጒ', '\u1316', 'ጘ', '\u135b', 'ᎀ', '᎐', 'Ꭰ', '\u13f6', 'ᏸ', '\u13fe', 'ᐁ', '᙭', 'ᙯ', '\u1680', 'ᚁ', '᚛', 'ᚠ', '᛫', 'ᛱ', '\u16f9', 'ᜀ', 'ᜒ', 'ᜟ', 'ᜲ', 'ᝀ', 'ᝒ', 'ᝠ', '\u176d', 'ᝮ', '\u1771', 'ក', '឴', 'ៗ', '៘', 'ៜ', '៝', 'ᠠ', '\u1879', 'ᢀ', 'ᢅ', 'ᢇ', 'ᢩ', 'ᢪ', '\u18ab', 'ᢰ', '\u18f6', 'ᤀ', '\u191f', 'ᥐ', '\u196e', 'ᥰ', '\u1975', 'ᦀ', '\u19ac', 'ᦰ', '\u19ca', 'ᨀ', 'ᨗ', 'ᨠ', 'ᩕ', 'ᪧ', '᪨', 'ᬅ', '᬴', 'ᭅ', '\u1b4d', 'ᮃ', 'ᮡ', 'ᮮ', '᮰', 'ᮺ', '᯦', 'ᰀ', 'ᰤ', 'ᱍ', '᱐', 'ᱚ', '᱾', 'ᲀ', '\u1c8b', 'Ა', '\u1cbb', 'Ჽ', '᳀', 'ᳩ', '᳭', 'ᳮ', '᳴', 'ᳵ', '᳷', 'ᳺ', '\u1cfb', 'ᴀ', '᷀', 'Ḁ', '\u1f16', 'Ἐ', '\u1f1e', 'ἠ', '\u1f46', 'Ὀ', '\u1f4e', 'ὐ', '\u1f58', 'Ὑ', '\u1f5a', 'Ὓ', '\u1f5c', 'Ὕ', '\u1f5e', 'Ὗ', '\u1f7e', 'ᾀ', '\u1fb5', 'ᾶ', '᾽', 'ι', '᾿', 'ῂ', '\u1fc5', 'ῆ', '῍', 'ῐ', '\u1fd4', 'ῖ', '\u1fdc', 'ῠ', '῭', 'ῲ', '\u1ff5', 'ῶ', '´', 'ⁱ', '\u2072', 'ⁿ', '₀', 'ₐ', '\u209d', 'ℂ', '℃', 'ℇ', '℈', 'ℊ', '℔', 'ℕ', '№', 'ℙ', '℞', 'ℤ', '℥', 'Ω', '℧', 'ℨ', '℩', 'K', '℮', 'ℯ', '℺', 'ℼ', '⅀', 'ⅅ', '⅊', 'ⅎ', '⅏', 'Ↄ', 'ↅ', 'Ⰰ', '⳥', 'Ⳬ', '⳯', 'Ⳳ', '\u2cf4', 'ⴀ', '\u2d26', 'ⴧ', '\u2d28', 'ⴭ', '\u2d2e', 'ⴰ', '\u2d68', 'ⵯ', '⵰', 'ⶀ', '\u2d97', 'ⶠ', '\u2da7', 'ⶨ', '\u2daf', 'ⶰ', '\u2db7', 'ⶸ', '\u2dbf', 'ⷀ', '\u2dc7', 'ⷈ', '\u2dcf', 'ⷐ', '\u2dd7', 'ⷘ', '\u2ddf', 'ⸯ', '⸰', '々', '〇', '〱', '〶', '〻', '〽', 'ぁ', '\u3097', 'ゝ', '゠', 'ァ', '・', 'ー', '\u3100', 'ㄅ', '\u3130', 'ㄱ', '\u318f', 'ㆠ', '㇀', 'ㇰ', '㈀', '㐀', '䷀', '一', '\ua48d', 'ꓐ', '꓾', 'ꔀ', '꘍', 'ꘐ', '꘠', 'ꘪ', '\ua62c', 'Ꙁ', '꙯', 'ꙿ', 'ꚞ', 'ꚠ', 'ꛦ', 'ꜗ', '꜠', 'Ꜣ', '꞉', 'Ꞌ', '\ua7dd', '꟱', 'ꠂ', 'ꠃ', '꠆', 'ꠇ', 'ꠋ', 'ꠌ', 'ꠣ', 'ꡀ', '꡴', 'ꢂ', 'ꢴ', 'ꣲ', '꣸', 'ꣻ', '꣼', 'ꣽ', 'ꣿ', 'ꤊ', 'ꤦ', 'ꤰ', 'ꥇ', 'ꥠ', '\ua97d', 'ꦄ', '꦳', 'ꧏ', '꧐', 'ꧠ', 'ꧥ', 'ꧦ', '꧰', 'ꧺ', '\ua9ff', 'ꨀ', 'ꨩ', 'ꩀ', 'ꩃ', 'ꩄ', 'ꩌ', 'ꩠ', '꩷', 'ꩺ', 'ꩻ', 'ꩾ', 'ꪰ', 'ꪱ', 'ꪲ', 'ꪵ', 'ꪷ', 'ꪹ', 'ꪾ', 'ꫀ', '꫁', 'ꫂ', '\uaac3', 'ꫛ', '꫞', 'ꫠ', 'ꫫ', 'ꫲ', 'ꫵ', 'ꬁ', '\uab07', 'ꬉ', '\uab0f', 'ꬑ', '\uab17', 'ꬠ', '\uab27', 'ꬨ', '\uab2f', 'ꬰ', '꭛', 'ꭜ', '꭪', 'ꭰ', 'ꯣ', '가', '\ud7a4', 'ힰ', '\ud7c7', 'ퟋ', '\ud7fc', '豈', '\ufa6e', '並', '\ufada', 'ﬀ', '\ufb07', 'ﬓ', '\ufb18', 'יִ', 'ﬞ', 'ײַ', '﬩', 'שׁ', '\ufb37', 'טּ', '\ufb3d', 'מּ', '\ufb3f', 'נּ', '\ufb42', 'ףּ', '\ufb45', 'צּ', '﮲', 'ﯓ', '﴾', 'ﵐ', '﶐', 'ﶒ', '﷈', 'ﷰ', '﷼', 'ﹰ', '\ufe75', 'ﹶ', '\ufefd', 'Ａ', '［', 'ａ', '｛', 'ｦ', '\uffbf', 'ￂ', '\uffc8', 'ￊ', '\uffd0', 'ￒ', '\uffd8', 'ￚ', '\uffdd', '𐀀', '\U0001000c', '𐀍', '\U00010027', '𐀨', '\U0001003b', '𐀼', '\U0001003e', '𐀿', '\U0001004e', '𐁐', '\U0001005e', '𐂀', '\U000100fb', '𐊀', '\U0001029d', '𐊠', '\U000102d1', '𐌀', '𐌠', '𐌭', '𐍁', '𐍂', '𐍊', '𐍐', '𐍶', '𐎀', '\U0001039e', '𐎠', '\U000103c4', '𐏈', '𐏐', '𐐀', '\U0001049e', '𐒰', '\U000104d4', '𐓘', '\U000104fc', '𐔀', '\U00010528', '𐔰', '\U00010564', '𐕰', '\U0001057b', '𐕼', '\U0001058b', '𐖌', '\U00010593', '𐖔', '\U00010596', '𐖗', '\U000105a2', '𐖣', '\U000105b2', '𐖳', '\U000105ba', '𐖻', '\U000105bd', '𐗀', '\U000105f4', '𐘀', '\U00010737', '𐝀', '\U00010756', '𐝠', '\U00010768', '𐞀', '\U00010786', '𐞇', '\U000107b1', '𐞲', '\U000107bb', '𐠀', '\U00010806', '𐠈', '\U00010809', '𐠊', '\U00010836', '𐠷', '\U00010839', '𐠼', '\U0001083d', '𐠿', '\U00010856', '𐡠', '𐡷', '𐢀', '\U0001089f', '𐣠', '\U000108f3', '𐣴', '\U000108f6', '𐤀', '𐤖', '𐤠', '\U0001093a', '𐥀', '\U0001095a', '𐦀', '\U000109b8', '𐦾', '𐧀', '𐨀', '𐨁', '𐨐', '\U00010a14', '𐨕', '\U00010a18', '𐨙', '\U00010a36', '𐩠', '𐩽', '𐪀', '𐪝', '𐫀', '𐫈', '𐫉', '𐫥', '𐬀', '\U00010b36', '𐭀', '\U00010b56', '𐭠', '\U00010b73', '𐮀', '\U00010b92', '𐰀', '\U00010c49', '𐲀', '\U00010cb3', '𐳀', '\U00010cf3', '𐴀', '𐴤', '𐵊', '\U00010d66', '𐵯', '\U00010d86', '𐺀', '\U00010eaa', '𐺰', '\U00010eb2', '𐻂', '\U00010ec8', '𐼀', '𐼝', '𐼧', '\U00010f28', '𐼰', '𐽆', '𐽰', '𐾂', '𐾰', '𐿅', '𐿠', '\U00010ff7', '𑀃', '𑀸', '𑁱', '𑁳', '𑁵', '\U00011076', '𑂃', '𑂰', '𑃐', '\U000110e9', '𑄃', '𑄧', '𑅄', '𑅅', '𑅇', '\U00011148', '𑅐', '𑅳', '𑅶', '\U00011177', '𑆃', '𑆳', '𑇁', '𑇅', '𑇚', '𑇛', '𑇜', '𑇝', '𑈀', '\U00011212', '𑈓', '𑈬', '𑈿', '𑉁', '𑊀', '\U00011287', '𑊈', '\U00011289', '𑊊', '\U0001128e', '𑊏', '\U0001129e', '𑊟', '𑊩', '𑊰', '𑋟', '𑌅', '\U0001130d', '𑌏', '\U00011311', '𑌓', '\U00011329', '𑌪', '\U00011331', '𑌲', '\U00011334', '𑌵', '\U0001133a', '𑌽', '𑌾', '𑍐', '\U00011351', '𑍝', '𑍢', '𑎀', '\U0001138a', '𑎋', '\U0001138c', '𑎎', '\U0001138f', '𑎐', '\U000113b6', '𑎷', '𑎸', '𑏑', '𑏒', '𑏓', '𑏔', '𑐀', '𑐵', '𑑇', '𑑋', '𑑟', '\U00011462', '𑒀', '𑒰', '𑓄', '𑓆', '𑓇', '\U000114c8', '𑖀', '𑖯', '𑗘', '𑗜', '𑘀', '𑘰', '𑙄', '\U00011645', '𑚀', '𑚫', '𑚸', '𑚹', '𑜀', '\U0001171b', '𑝀', '\U00011747', '𑠀', '𑠬', '𑢠', '𑣠', '𑣿', '\U00011907', '𑤉', '\U0001190a', '𑤌', '\U00011914', '𑤕', '\U00011917', '𑤘', '𑤰', '𑤿', '𑥀', '𑥁', '𑥂', '𑦠', '\U000119a8', '𑦪', '𑧑', '𑧡', '𑧢', '𑧣', '𑧤', '𑨀', '𑨁', '𑨋', '𑨳', '𑨺', '𑨻', '𑩐', '𑩑', '𑩜', '𑪊', '𑪝', '𑪞', '𑪰', '\U00011af9', '𑯀', '𑯡', '𑰀', '\U00011c09', '𑰊', '𑰯', '𑱀', '𑱁', '𑱲', '\U00011c90', '𑴀', '\U00011d07', '𑴈', '\U00011d0a', '𑴋', '𑴱', '𑵆', '𑵇', '𑵠', '\U00011d66', '𑵧', '\U00011d69', '𑵪', '𑶊', '𑶘', '\U00011d99', '𑶰', '\U00011ddc', '𑻠', '𑻳', '𑼂', '𑼃', '𑼄', '\U00011f11', '𑼒', '𑼴', '𑾰', '\U00011fb1', '𒀀', '\U0001239a', '𒒀', '\U00012544', '𒾐', '𒿱', '𓀀', '\U00013430', '𓑁', '𓑇', '𓑠', '\U000143fb', '𔐀', '\U00014647', '𖄀', '𖄞', '𖠀', '\U00016a39', '𖩀', '\U00016a5f', '𖩰', '\U00016abf', '𖫐', '\U00016aee', '𖬀', '𖬰', '𖭀', '𖭄', '𖭣', '\U00016b78', '𖭽', '\U00016b90', '𖵀', '𖵭', '𖹀', '𖺀', '𖺠', '\U00016eb9', '𖺻', '\U00016ed4', '𖼀', '\U00016f4b', '𖽐', '𖽑', '𖾓', '\U00016fa0', '𖿠', '𖿢', '𖿣', '𖿤', '𖿲', '𖿴', '𗀀', '\U00018cd6', '𘳿', '\U00018d1f', '𘶀', '\U00018df3', '𚿰', '\U0001aff4', '𚿵', '\U0001affc', '𚿽', '\U0001afff', '𛀀', '\U0001b123', '𛄲', '\U0001b133', '𛅐', '\U0001b153', '𛅕', '\U0001b156', '𛅤', '\U0001b168', '𛅰', '\U0001b2fc', '𛰀', '\U0001bc6b', '𛱰', '\U0001bc7d', '𛲀', '\U0001bc89', '𛲐', '\U0001bc9a', '𝐀', '\U0001d455', '𝑖', '\U0001d49d', '𝒞', '\U0001d4a0', '𝒢', '\U0001d4a3', '𝒥', '\U0001d4a7', '𝒩', '\U0001d4ad', '𝒮', '\U0001d4ba', '𝒻', '\U0001d4bc', '𝒽', '\U0001d4c4', '𝓅', '\U0001d506', '𝔇', '\U0001d50b', '𝔍', '\U0001d515', '𝔖', '\U0001d51d', '𝔞', '\U0001d53a', '𝔻', '\U0001d53f', '𝕀', '\U0001d545', '𝕆', '\U0001d547', '𝕊', '\U0001d551', '𝕒', '\U0001d6a6', '𝚨', '𝛁', '𝛂', '𝛛', '𝛜', '𝛻', '𝛼', '𝜕', '𝜖', '𝜵', '𝜶', '𝝏', '𝝐', '𝝯', '𝝰', '𝞉', '𝞊', '𝞩', '𝞪', '𝟃', '𝟄', '\U0001d7cc', '𝼀', '\U0001df1f', '𝼥', '\U0001df2b', '𞀰', '\U0001e06e', '𞄀', '\U0001e12d', '𞄷', '\U0001e13e', '𞅎', '𞅏', '𞊐', '𞊮', '𞋀', '𞋬', '𞓐', '𞓬', '𞗐', '𞗮', '𞗰', '𞗱', '𞛀', '\U0001e6df', '𞛠', '𞛣', '𞛤', '𞛦', '𞛧', '𞛮', '𞛰', '𞛵', '𞛾', '\U0001e700', '𞟠', '\U0001e7e7', '𞟨', '\U0001e7ec', '𞟭', '\U0001e7ef', '𞟰', '\U0001e7ff', '𞠀', '\U0001e8c5', '𞤀', '𞥄', '𞥋', '\U0001e94c', '𞸀', '\U0001ee04', '𞸅', '\U0001ee20', '𞸡', '\U0001ee23', '𞸤', '\U0001ee25', '𞸧', '\U0001ee28', '𞸩', '\U0001ee33', '𞸴', '\U0001ee38', '𞸹', '\U0001ee3a', '𞸻', '\U0001ee3c', '𞹂', '\U0001ee43', '𞹇', '\U0001ee48', '𞹉', '\U0001ee4a', '𞹋', '\U0001ee4c', '𞹍', '\U0001ee50', '𞹑', '\U0001ee53', '𞹔', '\U0001ee55', '𞹗', '\U0001ee58', '𞹙', '\U0001ee5a', '𞹛', '\U0001ee5c', '𞹝', '\U0001ee5e', '𞹟', '\U0001ee60', '𞹡', '\U0001ee63', '𞹤', '\U0001ee65', '𞹧', '\U0001ee6b', '𞹬', '\U0001ee73', '𞹴', '\U0001ee78', '𞹹', '\U0001ee7d', '𞹾', '\U0001ee7f', '𞺀', '\U0001ee8a', '𞺋', '\U0001ee9c', '𞺡', '\U0001eea4', '𞺥', '\U0001eeaa', '𞺫', '\U0001eebc', '𠀀', '\U0002a6e0', '𪜀', '\U0002b81e', '𫠠', '\U0002ceae', '𬺰', '\U0002ebe1', '𮯰', '\U0002ee5e', '丽', '\U0002fa1e', '𰀀', '\U0003134b', '𱍐', '\U0003347a'},
+					rangeSets: "\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00",
+					alts:      [][]int{{}, {0, 1}, {1}},
+					expected:  [][]string{{"\"&\"", "\"!\"", "\"$\"", "\"re\\\"\"", "\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "\"@\"", "[\\pL_]", "\"#\"", "\"%precedence\"", "\"(\""}, {}, {"\"&\"", "\"!\"", "\"$\""}},
+				},
 			},
 		},
 		{
 			name: "PrefixedOp",
-			pos:  position{line: 130, col: 1, offset: 3528},
+			pos:  position{line: 191, col: 1, offset: 5790},
+			id:   15,
 			expr: &actionExpr{
-				pos: position{line: 130, col: 14, offset: 3543},
+				pos: position{line: 191, col: 14, offset: 5805},
+				id:  245,
 				run: (*parser).callonPrefixedOp1,
 				expr: &choiceExpr{
-					pos: position{line: 130, col: 16, offset: 3545},
+					pos: position{line: 191, col: 16, offset: 5807},
+					id:  246,
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 130, col: 16, offset: 3545},
+							pos:        position{line: 191, col: 16, offset: 5807},
+							id:         247,
 							val:        "&",
 							ignoreCase: false,
 							want:       "\"&\"",
 						},
 						&litMatcher{
-							pos:        position{line: 130, col: 22, offset: 3551},
+							pos:        position{line: 191, col: 22, offset: 5813},
+							id:         248,
 							val:        "!",
 							ignoreCase: false,
 							want:       "\"!\"",
 						},
+						&litMatcher{
+							pos:        position{line: 191, col: 28, offset: 5819},
+							id:         249,
+							val:        "$",
+							ignoreCase: false,
+							want:       "\"$\"",
+						},
+					},
+					dispatch: &choiceDispatch{
+						ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x02\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+						ranges:    []rune{'\u0080'},
+						rangeSets: "\x00",
+						alts:      [][]int{{}, {1}, {2}, {0}},
+						expected:  [][]string{{"\"&\"", "\"!\"", "\"$\""}, {"\"&\"", "\"$\""}, {"\"&\"", "\"!\""}, {"\"!\"", "\"$\""}},
 					},
 				},
 			},
 		},
 		{
 			name: "SuffixedExpr",
-			pos:  position{line: 134, col: 1, offset: 3593},
-			expr: &choiceExpr{
-				pos: position{line: 134, col: 16, offset: 3610},
-				alternatives: []any{
-					&actionExpr{
-						pos: position{line: 134, col: 16, offset: 3610},
-						run: (*parser).callonSuffixedExpr2,
-						expr: &seqExpr{
-							pos: position{line: 134, col: 16, offset: 3610},
-							exprs: []any{
-								&labeledExpr{
-									pos:   position{line: 134, col: 16, offset: 3610},
-									label: "expr",
-									expr: &ruleRefExpr{
-										pos:  position{line: 134, col: 21, offset: 3615},
-										name: "PrimaryExpr",
+			pos:  position{line: 195, col: 1, offset: 5861},
+			id:   16,
+			expr: &actionExpr{
+				pos: position{line: 195, col: 16, offset: 5878},
+				id:  250,
+				run: (*parser).callonSuffixedExpr1,
+				expr: &seqExpr{
+					pos: position{line: 195, col: 16, offset: 5878},
+					id:  251,
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 195, col: 16, offset: 5878},
+							id:    252,
+							label: "expr",
+							expr: &ruleRefExpr{
+								pos:  position{line: 195, col: 21, offset: 5883},
+								id:   253,
+								name: "PrimaryExpr",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 195, col: 33, offset: 5895},
+							id:    254,
+							label: "op",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 195, col: 36, offset: 5898},
+								id:  255,
+								expr: &choiceExpr{
+									pos: position{line: 195, col: 38, offset: 5900},
+									id:  256,
+									alternatives: []any{
+										&ruleRefExpr{
+											pos:  position{line: 195, col: 38, offset: 5900},
+											id:   257,
+											name: "RepeatOp",
+										},
+										&seqExpr{
+											pos: position{line: 195, col: 49, offset: 5911},
+											id:  258,
+											exprs: []any{
+												&ruleRefExpr{
+													pos:  position{line: 195, col: 49, offset: 5911},
+													id:   259,
+													name: "__",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 195, col: 52, offset: 5914},
+													id:   260,
+													name: "SuffixedOp",
+												},
+											},
+										},
 									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 134, col: 33, offset: 3627},
-									name: "__",
-								},
-								&labeledExpr{
-									pos:   position{line: 134, col: 36, offset: 3630},
-									label: "op",
-									expr: &ruleRefExpr{
-										pos:  position{line: 134, col: 39, offset: 3633},
-										name: "SuffixedOp",
+									dispatch: &choiceDispatch{
+										ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x00",
+										alts:      [][]int{{1}, {0, 1}},
+										expected:  [][]string{{"\"{\""}, {}},
 									},
 								},
 							},
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 153, col: 5, offset: 4163},
-						name: "PrimaryExpr",
-					},
 				},
 			},
 		},
 		{
 			name: "SuffixedOp",
-			pos:  position{line: 155, col: 1, offset: 4176},
+			pos:  position{line: 225, col: 1, offset: 6703},
+			id:   17,
 			expr: &actionExpr{
-				pos: position{line: 155, col: 14, offset: 4191},
+				pos: position{line: 225, col: 14, offset: 6718},
+				id:  261,
 				run: (*parser).callonSuffixedOp1,
 				expr: &choiceExpr{
-					pos: position{line: 155, col: 16, offset: 4193},
+					pos: position{line: 225, col: 16, offset: 6720},
+					id:  262,
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 155, col: 16, offset: 4193},
+							pos:        position{line: 225, col: 16, offset: 6720},
+							id:         263,
 							val:        "?",
 							ignoreCase: false,
 							want:       "\"?\"",
 						},
 						&litMatcher{
-							pos:        position{line: 155, col: 22, offset: 4199},
+							pos:        position{line: 225, col: 22, offset: 6726},
+							id:         264,
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 						&litMatcher{
-							pos:        position{line: 155, col: 28, offset: 4205},
+							pos:        position{line: 225, col: 28, offset: 6732},
+							id:         265,
 							val:        "+",
 							ignoreCase: false,
 							want:       "\"+\"",
 						},
 					},
+					dispatch: &choiceDispatch{
+						ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+						ranges:    []rune{'\u0080'},
+						rangeSets: "\x00",
+						alts:      [][]int{{}, {1}, {2}, {0}},
+						expected:  [][]string{{"\"?\"", "\"*\"", "\"+\""}, {"\"?\"", "\"+\""}, {"\"?\"", "\"*\""}, {"\"*\"", "\"+\""}},
+					},
+				},
+			},
+		},
+		{
+			name: "RepeatOp",
+			pos:  position{line: 232, col: 1, offset: 6978},
+			id:   18,
+			expr: &actionExpr{
+				pos: position{line: 232, col: 12, offset: 6991},
+				id:  266,
+				run: (*parser).callonRepeatOp1,
+				expr: &seqExpr{
+					pos: position{line: 232, col: 12, offset: 6991},
+					id:  267,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 232, col: 12, offset: 6991},
+							id:         268,
+							val:        "{",
+							ignoreCase: false,
+							want:       "\"{\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 232, col: 16, offset: 6995},
+							id:    269,
+							label: "lo",
+							expr: &ruleRefExpr{
+								pos:  position{line: 232, col: 19, offset: 6998},
+								id:   270,
+								name: "RepeatBound",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 232, col: 31, offset: 7010},
+							id:    271,
+							label: "hi",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 232, col: 34, offset: 7013},
+								id:  272,
+								expr: &seqExpr{
+									pos: position{line: 232, col: 36, offset: 7015},
+									id:  273,
+									exprs: []any{
+										&litMatcher{
+											pos:        position{line: 232, col: 36, offset: 7015},
+											id:         274,
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 232, col: 40, offset: 7019},
+											id:  275,
+											expr: &ruleRefExpr{
+												pos:  position{line: 232, col: 40, offset: 7019},
+												id:   276,
+												name: "RepeatBound",
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 232, col: 56, offset: 7035},
+							id:         277,
+							val:        "}",
+							ignoreCase: false,
+							want:       "\"}\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "RepeatBound",
+			pos:  position{line: 246, col: 1, offset: 7380},
+			id:   19,
+			expr: &actionExpr{
+				pos: position{line: 246, col: 15, offset: 7396},
+				id:  278,
+				run: (*parser).callonRepeatBound1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 246, col: 15, offset: 7396},
+					id:  279,
+					expr: &ruleRefExpr{
+						pos:  position{line: 246, col: 15, offset: 7396},
+						id:   280,
+						name: "DecimalDigit",
+					},
 				},
 			},
 		},
 		{
 			name: "PrimaryExpr",
-			pos:  position{line: 159, col: 1, offset: 4247},
+			pos:  position{line: 254, col: 1, offset: 7559},
+			id:   20,
 			expr: &choiceExpr{
-				pos: position{line: 159, col: 15, offset: 4263},
+				pos: position{line: 254, col: 15, offset: 7575},
+				id:  281,
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 159, col: 15, offset: 4263},
-						name: "LitMatcher",
+						pos:  position{line: 254, col: 15, offset: 7575},
+						id:   282,
+						name: "RegexpMatcher",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 159, col: 28, offset: 4276},
+						pos:  position{line: 254, col: 31, offset: 7591},
+						id:   283,
+						name: "LitMatcher",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 254, col: 44, offset: 7604},
+						id:   284,
 						name: "CharClassMatcher",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 159, col: 47, offset: 4295},
+						pos:  position{line: 254, col: 63, offset: 7623},
+						id:   285,
 						name: "AnyMatcher",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 159, col: 60, offset: 4308},
+						pos:  position{line: 254, col: 76, offset: 7636},
+						id:   286,
+						name: "MatcherFuncExpr",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 254, col: 94, offset: 7654},
+						id:   287,
 						name: "RuleRefExpr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 159, col: 74, offset: 4322},
+						pos:  position{line: 254, col: 108, offset: 7668},
+						id:   288,
+						name: "BackRefExpr",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 254, col: 122, offset: 7682},
+						id:   289,
 						name: "SemanticPredExpr",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 254, col: 141, offset: 7701},
+						id:   290,
+						name: "PrecedenceExpr",
+					},
 					&actionExpr{
-						pos: position{line: 159, col: 93, offset: 4341},
-						run: (*parser).callonPrimaryExpr7,
+						pos: position{line: 254, col: 158, offset: 7718},
+						id:  291,
+						run: (*parser).callonPrimaryExpr11,
 						expr: &seqExpr{
-							pos: position{line: 159, col: 93, offset: 4341},
+							pos: position{line: 254, col: 158, offset: 7718},
+							id:  292,
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 159, col: 93, offset: 4341},
+									pos:        position{line: 254, col: 158, offset: 7718},
+									id:         293,
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 159, col: 97, offset: 4345},
+									pos:  position{line: 254, col: 162, offset: 7722},
+									id:   294,
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 159, col: 100, offset: 4348},
+									pos:   position{line: 254, col: 165, offset: 7725},
+									id:    295,
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 159, col: 105, offset: 4353},
+										pos:  position{line: 254, col: 170, offset: 7730},
+										id:   296,
 										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 159, col: 116, offset: 4364},
+									pos:  position{line: 254, col: 181, offset: 7741},
+									id:   297,
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 159, col: 119, offset: 4367},
+									pos:        position{line: 254, col: 184, offset: 7744},
+									id:         298,
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -693,52 +1339,113 @@ var g = &grammar{
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x01\x03\x04\x01\x02\x05\x00\x00\x00\x00\x00\x06\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\a\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\t\x00\x00\x00\b\x02\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\b\n\b\b\b\b\b\b\b\b\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080', 'ª', '«', 'µ', '¶', 'º', '»', 'À', '×', 'Ø', '÷', 'ø', '˂', 'ˆ', '˒', 'ˠ', '˥', 'ˬ', '˭', 'ˮ', '˯', 'Ͱ', '͵', 'Ͷ', '\u0378', 'ͺ', ';', 'Ϳ', '\u0380', 'Ά', '·', 'Έ', '\u038b', 'Ό', '\u038d', 'Ύ', '\u03a2', 'Σ', '϶', 'Ϸ', '҂', 'Ҋ', '\u0530', 'Ա', '\u0557', 'ՙ', '՚', 'ՠ', '։', 'א', '\u05eb', 'ׯ', '׳', 'ؠ', 'ً', 'ٮ', 'ٰ', 'ٱ', '۔', 'ە', 'ۖ', 'ۥ', 'ۧ', 'ۮ', '۰', 'ۺ', '۽', 'ۿ', '܀', 'ܐ', 'ܑ', 'ܒ', 'ܰ', 'ݍ', 'ަ', 'ޱ', '\u07b2', 'ߊ', '߫', 'ߴ', '߶', 'ߺ', '\u07fb', 'ࠀ', 'ࠖ', 'ࠚ', 'ࠛ', 'ࠤ', 'ࠥ', 'ࠨ', 'ࠩ', 'ࡀ', '࡙', 'ࡠ', '\u086b', 'ࡰ', '࢈', 'ࢉ', '\u0890', 'ࢠ', '࣊', 'ऄ', 'ऺ', 'ऽ', 'ा', 'ॐ', '॑', 'क़', 'ॢ', 'ॱ', 'ঁ', 'অ', '\u098d', 'এ', '\u0991', 'ও', '\u09a9', 'প', '\u09b1', 'ল', '\u09b3', 'শ', '\u09ba', 'ঽ', 'া', 'ৎ', '\u09cf', 'ড়', '\u09de', 'য়', 'ৢ', 'ৰ', '৲', 'ৼ', '৽', 'ਅ', '\u0a0b', 'ਏ', '\u0a11', 'ਓ', '\u0a29', 'ਪ', '\u0a31', 'ਲ', '\u0a34', 'ਵ', '\u0a37', 'ਸ', '\u0a3a', 'ਖ਼', '\u0a5d', 'ਫ਼', '\u0a5f', 'ੲ', 'ੵ', 'અ', '\u0a8e', 'એ', '\u0a92', 'ઓ', '\u0aa9', 'પ', '\u0ab1', 'લ', '\u0ab4', 'વ', '\u0aba', 'ઽ', 'ા', 'ૐ', '\u0ad1', 'ૠ', 'ૢ', 'ૹ', 'ૺ', 'ଅ', '\u0b0d', 'ଏ', '\u0b11', 'ଓ', '\u0b29', 'ପ', '\u0b31', 'ଲ', '\u0b34', 'ଵ', '\u0b3a', 'ଽ', 'ା', 'ଡ଼', '\u0b5e', 'ୟ', 'ୢ', 'ୱ', '୲', 'ஃ', '\u0b84', 'அ', '\u0b8b', 'எ', '\u0b91', 'ஒ', '\u0b96', 'ங', '\u0b9b', 'ஜ', '\u0b9d', 'ஞ', '\u0ba0', 'ண', '\u0ba5', 'ந', '\u0bab', 'ம', '\u0bba', 'ௐ', '\u0bd1', 'అ', '\u0c0d', 'ఎ', '\u0c11', 'ఒ', '\u0c29', 'ప', '\u0c3a', 'ఽ', 'ా', 'ౘ', '\u0c5b', '౜', '\u0c5e', 'ౠ', 'ౢ', 'ಀ', 'ಁ', 'ಅ', '\u0c8d', 'ಎ', '\u0c91', 'ಒ', '\u0ca9', 'ಪ', '\u0cb4', 'ವ', '\u0cba', 'ಽ', 'ಾ', '೜', '\u0cdf', 'ೠ', 'ೢ', 'ೱ', 'ೳ', 'ഄ', '\u0d0d', 'എ', '\u0d11', 'ഒ', '഻', 'ഽ', 'ാ', 'ൎ', '൏', 'ൔ', 'ൗ', 'ൟ', 'ൢ', 'ൺ', '\u0d80', 'අ', '\u0d97', 'ක', '\u0db2', 'ඳ', '\u0dbc', 'ල', '\u0dbe', 'ව', '\u0dc7', 'ก', 'ั', 'า', 'ิ', 'เ', '็', 'ກ', '\u0e83', 'ຄ', '\u0e85', 'ຆ', '\u0e8b', 'ຌ', '\u0ea4', 'ລ', '\u0ea6', 'ວ', 'ັ', 'າ', 'ິ', 'ຽ', '\u0ebe', 'ເ', '\u0ec5', 'ໆ', '\u0ec7', 'ໜ', '\u0ee0', 'ༀ', '༁', 'ཀ', '\u0f48', 'ཉ', '\u0f6d', 'ྈ', 'ྍ', 'က', 'ါ', 'ဿ', '၀', 'ၐ', 'ၖ', 'ၚ', 'ၞ', 'ၡ', 'ၢ', 'ၥ', 'ၧ', 'ၮ', 'ၱ', 'ၵ', 'ႂ', 'ႎ', 'ႏ', 'Ⴀ', '\u10c6', 'Ⴧ', '\u10c8', 'Ⴭ', '\u10ce', 'ა', '჻', 'ჼ', '\u1249', 'ቊ', '\u124e', 'ቐ', '\u1257', 'ቘ', '\u1259', 'ቚ', '\u125e', 'በ', '\u1289', 'ኊ', '\u128e', 'ነ', '\u12b1', 'ኲ', '\u12b6', 'ኸ', '\u12bf', 'ዀ', '\u12c1', 'ዂ', '\u12c6', 'ወ', '\u12d7', 'ዘ', '\u1311', 'ጒ', '\u1316', 'ጘ', '\u135b', 'ᎀ', '᎐', 'Ꭰ', '\u13f6', 'ᏸ', '\u13fe', 'ᐁ', '᙭', 'ᙯ', '\u1680', 'ᚁ', '᚛', 'ᚠ', '᛫', 'ᛱ', '\u16f9', 'ᜀ', 'ᜒ', 'ᜟ', 'ᜲ', 'ᝀ', 'ᝒ', 'ᝠ', '\u176d', 'ᝮ', '\u1771', 'ក', '឴', 'ៗ', '៘', 'ៜ', '៝', 'ᠠ', '\u1879', 'ᢀ', 'ᢅ', 'ᢇ', 'ᢩ', 'ᢪ', '\u18ab', 'ᢰ', '\u18f6', 'ᤀ', '\u191f', 'ᥐ', '\u196e', 'ᥰ', '\u1975', 'ᦀ', '\u19ac', 'ᦰ', '\u19ca', 'ᨀ', 'ᨗ', 'ᨠ', 'ᩕ', 'ᪧ', '᪨', 'ᬅ', '᬴', 'ᭅ', '\u1b4d', 'ᮃ', 'ᮡ', 'ᮮ', '᮰', 'ᮺ', '᯦', 'ᰀ', 'ᰤ', 'ᱍ', '᱐', 'ᱚ', '᱾', 'ᲀ', '\u1c8b', 'Ა', '\u1cbb', 'Ჽ', '᳀', 'ᳩ', '᳭', 'ᳮ', '᳴', 'ᳵ', '᳷', 'ᳺ', '\u1cfb', 'ᴀ', '᷀', 'Ḁ', '\u1f16', 'Ἐ', '\u1f1e', 'ἠ', '\u1f46', 'Ὀ', '\u1f4e', 'ὐ', '\u1f58', 'Ὑ', '\u1f5a', 'Ὓ', '\u1f5c', 'Ὕ', '\u1f5e', 'Ὗ', '\u1f7e', 'ᾀ', '\u1fb5', 'ᾶ', '᾽', 'ι', '᾿', 'ῂ', '\u1fc5', 'ῆ', '῍', 'ῐ', '\u1fd4', 'ῖ', '\u1fdc', 'ῠ', '῭', 'ῲ', '\u1ff5', 'ῶ', '´', 'ⁱ', '\u2072', 'ⁿ', '₀', 'ₐ', '\u209d', 'ℂ', '℃', 'ℇ', '℈', 'ℊ', '℔', 'ℕ', '№', 'ℙ', '℞', 'ℤ', '℥', 'Ω', '℧', 'ℨ', '℩', 'K', '℮', 'ℯ', '℺', 'ℼ', '⅀', 'ⅅ', '⅊', 'ⅎ', '⅏', 'Ↄ', 'ↅ', 'Ⰰ', '⳥', 'Ⳬ', '⳯', 'Ⳳ', '\u2cf4', 'ⴀ', '\u2d26', 'ⴧ', '\u2d28', 'ⴭ', '\u2d2e', 'ⴰ', '\u2d68', 'ⵯ', '⵰', 'ⶀ', '\u2d97', 'ⶠ', '\u2da7', 'ⶨ', '\u2daf', 'ⶰ', '\u2db7', 'ⶸ', '\u2dbf', 'ⷀ', '\u2dc7', 'ⷈ', '\u2dcf', 'ⷐ', '\u2dd7', 'ⷘ', '\u2ddf', 'ⸯ', '⸰', '々', '〇', '〱', '〶', '〻', '〽', 'ぁ', '\u3097', 'ゝ', '゠', 'ァ', '・', 'ー', '\u3100', 'ㄅ', '\u3130', 'ㄱ', '\u318f', 'ㆠ', '㇀', 'ㇰ', '㈀', '㐀', '䷀', '一', '\ua48d', 'ꓐ', '꓾', 'ꔀ', '꘍', 'ꘐ', '꘠', 'ꘪ', '\ua62c', 'Ꙁ', '꙯', 'ꙿ', 'ꚞ', 'ꚠ', 'ꛦ', 'ꜗ', '꜠', 'Ꜣ', '꞉', 'Ꞌ', '\ua7dd', '꟱', 'ꠂ', 'ꠃ', '꠆', 'ꠇ', 'ꠋ', 'ꠌ', 'ꠣ', 'ꡀ', '꡴', 'ꢂ', 'ꢴ', 'ꣲ', '꣸', 'ꣻ', '꣼', 'ꣽ', 'ꣿ', 'ꤊ', 'ꤦ', 'ꤰ', 'ꥇ', 'ꥠ', '\ua97d', 'ꦄ', '꦳', 'ꧏ', '꧐', 'ꧠ', 'ꧥ', 'ꧦ', '꧰', 'ꧺ', '\ua9ff', 'ꨀ', 'ꨩ', 'ꩀ', 'ꩃ', 'ꩄ', 'ꩌ', 'ꩠ', '꩷', 'ꩺ', 'ꩻ', 'ꩾ', 'ꪰ', 'ꪱ', 'ꪲ', 'ꪵ', 'ꪷ', 'ꪹ', 'ꪾ', 'ꫀ', '꫁', 'ꫂ', '\uaac3', 'ꫛ', '꫞', 'ꫠ', 'ꫫ', 'ꫲ', 'ꫵ', 'ꬁ', '\uab07', 'ꬉ', '\uab0f', 'ꬑ', '\uab17', 'ꬠ', '\uab27', 'ꬨ', '\uab2f', 'ꬰ', '꭛', 'ꭜ', '꭪', 'ꭰ', 'ꯣ', '가', '\ud7a4', 'ힰ', '\ud7c7', 'ퟋ', '\ud7fc', '豈', '\ufa6e', '並', '\ufada', 'ﬀ', '\ufb07', 'ﬓ', '\ufb18', 'יִ', 'ﬞ', 'ײַ', '﬩', 'שׁ', '\ufb37', 'טּ', '\ufb3d', 'מּ', '\ufb3f', 'נּ', '\ufb42', 'ףּ', '\ufb45', 'צּ', '﮲', 'ﯓ', '﴾', 'ﵐ', '﶐', 'ﶒ', '﷈', 'ﷰ', '﷼', 'ﹰ', '\ufe75', 'ﹶ', '\ufefd', 'Ａ', '［', 'ａ', '｛', 'ｦ', '\uffbf', 'ￂ', '\uffc8', 'ￊ', '\uffd0', 'ￒ', '\uffd8', 'ￚ', '\uffdd', '𐀀', '\U0001000c', '𐀍', '\U00010027', '𐀨', '\U0001003b', '𐀼', '\U0001003e', '𐀿', '\U0001004e', '𐁐', '\U0001005e', '𐂀', '\U000100fb', '𐊀', '\U0001029d', '𐊠', '\U000102d1', '𐌀', '𐌠', '𐌭', '𐍁', '𐍂', '𐍊', '𐍐', '𐍶', '𐎀', '\U0001039e', '𐎠', '\U000103c4', '𐏈', '𐏐', '𐐀', '\U0001049e', '𐒰', '\U000104d4', '𐓘', '\U000104fc', '𐔀', '\U00010528', '𐔰', '\U00010564', '𐕰', '\U0001057b', '𐕼', '\U0001058b', '𐖌', '\U00010593', '𐖔', '\U00010596', '𐖗', '\U000105a2', '𐖣', '\U000105b2', '𐖳', '\U000105ba', '𐖻', '\U000105bd', '𐗀', '\U000105f4', '𐘀', '\U00010737', '𐝀', '\U00010756', '𐝠', '\U00010768', '𐞀', '\U00010786', '𐞇', '\U000107b1', '𐞲', '\U000107bb', '𐠀', '\U00010806', '𐠈', '\U00010809', '𐠊', '\U00010836', '𐠷', '\U00010839', '𐠼', '\U0001083d', '𐠿', '\U00010856', '𐡠', '𐡷', '𐢀', '\U0001089f', '𐣠', '\U000108f3', '𐣴', '\U000108f6', '𐤀', '𐤖', '𐤠', '\U0001093a', '𐥀', '\U0001095a', '𐦀', '\U000109b8', '𐦾', '𐧀', '𐨀', '𐨁', '𐨐', '\U00010a14', '𐨕', '\U00010a18', '𐨙', '\U00010a36', '𐩠', '𐩽', '𐪀', '𐪝', '𐫀', '𐫈', '𐫉', '𐫥', '𐬀', '\U00010b36', '𐭀', '\U00010b56', '𐭠', '\U00010b73', '𐮀', '\U00010b92', '𐰀', '\U00010c49', '𐲀', '\U00010cb3', '𐳀', '\U00010cf3', '𐴀', '𐴤', '𐵊', '\U00010d66', '𐵯', '\U00010d86', '𐺀', '\U00010eaa', '𐺰', '\U00010eb2', '𐻂', '\U00010ec8', '𐼀', '𐼝', '𐼧', '\U00010f28', '𐼰', '𐽆', '𐽰', '𐾂', '𐾰', '𐿅', '𐿠', '\U00010ff7', '𑀃', '𑀸', '𑁱', '𑁳', '𑁵', '\U00011076', '𑂃', '𑂰', '𑃐', '\U000110e9', '𑄃', '𑄧', '𑅄', '𑅅', '𑅇', '\U00011148', '𑅐', '𑅳', '𑅶', '\U00011177', '𑆃', '𑆳', '𑇁', '𑇅', '𑇚', '𑇛', '𑇜', '𑇝', '𑈀', '\U00011212', '𑈓', '𑈬', '𑈿', '𑉁', '𑊀', '\U00011287', '𑊈', '\U00011289', '𑊊', '\U0001128e', '𑊏', '\U0001129e', '𑊟', '𑊩', '𑊰', '𑋟', '𑌅', '\U0001130d', '𑌏', '\U00011311', '𑌓', '\U00011329', '𑌪', '\U00011331', '𑌲', '\U00011334', '𑌵', '\U0001133a', '𑌽', '𑌾', '𑍐', '\U00011351', '𑍝', '𑍢', '𑎀', '\U0001138a', '𑎋', '\U0001138c', '𑎎', '\U0001138f', '𑎐', '\U000113b6', '𑎷', '𑎸', '𑏑', '𑏒', '𑏓', '𑏔', '𑐀', '𑐵', '𑑇', '𑑋', '𑑟', '\U00011462', '𑒀', '𑒰', '𑓄', '𑓆', '𑓇', '\U000114c8', '𑖀', '𑖯', '𑗘', '𑗜', '𑘀', '𑘰', '𑙄', '\U00011645', '𑚀', '𑚫', '𑚸', '𑚹', '𑜀', '\U0001171b', '𑝀', '\U00011747', '𑠀', '𑠬', '𑢠', '𑣠', '𑣿', '\U00011907', '𑤉', '\U0001190a', '𑤌', '\U00011914', '𑤕', '\U00011917', '𑤘', '𑤰', '𑤿', '𑥀', '𑥁', '𑥂', '𑦠', '\U000119a8', '𑦪', '𑧑', '𑧡', '𑧢', '𑧣', '𑧤', '𑨀', '𑨁', '𑨋', '𑨳', '𑨺', '𑨻', '𑩐', '𑩑', '𑩜', '𑪊', '𑪝', '𑪞', '𑪰', '\U00011af9', '𑯀', '𑯡', '𑰀', '\U00011c09', '𑰊', '𑰯', '𑱀', '𑱁', '𑱲', '\U00011c90', '𑴀', '\U00011d07', '𑴈', '\U00011d0a', '𑴋', '𑴱', '𑵆', '𑵇', '𑵠', '\U00011d66', '𑵧', '\U00011d69', '𑵪', '𑶊', '𑶘', '\U00011d99', '𑶰', '\U00011ddc', '𑻠', '𑻳', '𑼂', '𑼃', '𑼄', '\U00011f11', '𑼒', '𑼴', '𑾰', '\U00011fb1', '𒀀', '\U0001239a', '𒒀', '\U00012544', '𒾐', '𒿱', '𓀀', '\U00013430', '𓑁', '𓑇', '𓑠', '\U000143fb', '𔐀', '\U00014647', '𖄀', '𖄞', '𖠀', '\U00016a39', '𖩀', '\U00016a5f', '𖩰', '\U00016abf', '𖫐', '\U00016aee', '𖬀', '𖬰', '𖭀', '𖭄', '𖭣', '\U00016b78', '𖭽', '\U00016b90', '𖵀', '𖵭', '𖹀', '𖺀', '𖺠', '\U00016eb9', '𖺻', '\U00016ed4', '𖼀', '\U00016f4b', '𖽐', '𖽑', '𖾓', '\U00016fa0', '𖿠', '𖿢', '𖿣', '𖿤', '𖿲', '𖿴', '𗀀', '\U00018cd6', '𘳿', '\U00018d1f', '𘶀', '\U00018df3', '𚿰', '\U0001aff4', '𚿵', '\U0001affc', '𚿽', '\U0001afff', '𛀀', '\U0001b123', '𛄲', '\U0001b133', '𛅐', '\U0001b153', '𛅕', '\U0001b156', '𛅤', '\U0001b168', '𛅰', '\U0001b2fc', '𛰀', '\U0001bc6b', '𛱰', '\U0001bc7d', '𛲀', '\U0001bc89', '𛲐', '\U0001bc9a', '𝐀', '\U0001d455', '𝑖', '\U0001d49d', '𝒞', '\U0001d4a0', '𝒢', '\U0001d4a3', '𝒥', '\U0001d4a7', '𝒩', '\U0001d4ad', '𝒮', '\U0001d4ba', '𝒻', '\U0001d4bc', '𝒽', '\U0001d4c4', '𝓅', '\U0001d506', '𝔇', '\U0001d50b', '𝔍', '\U0001d515', '𝔖', '\U0001d51d', '𝔞', '\U0001d53a', '𝔻', '\U0001d53f', '𝕀', '\U0001d545', '𝕆', '\U0001d547', '𝕊', '\U0001d551', '𝕒', '\U0001d6a6', '𝚨', '𝛁', '𝛂', '𝛛', '𝛜', '𝛻', '𝛼', '𝜕', '𝜖', '𝜵', '𝜶', '𝝏', '𝝐', '𝝯', '𝝰', '𝞉', '𝞊', '𝞩', '𝞪', '𝟃', '𝟄', '\U0001d7cc', '𝼀', '\U0001df1f', '𝼥', '\U0001df2b', '𞀰', '\U0001e06e', '𞄀', '\U0001e12d', '𞄷', '\U0001e13e', '𞅎', '𞅏', '𞊐', '𞊮', '𞋀', '𞋬', '𞓐', '𞓬', '𞗐', '𞗮', '𞗰', '𞗱', '𞛀', '\U0001e6df', '𞛠', '𞛣', '𞛤', '𞛦', '𞛧', '𞛮', '𞛰', '𞛵', '𞛾', '\U0001e700', '𞟠', '\U0001e7e7', '𞟨', '\U0001e7ec', '𞟭', '\U0001e7ef', '𞟰', '\U0001e7ff', '𞠀', '\U0001e8c5', '𞤀', '𞥄', '𞥋', '\U0001e94c', '𞸀', '\U0001ee04', '𞸅', '\U0001ee20', '𞸡', '\U0001ee23', '𞸤', '\U0001ee25', '𞸧', '\U0001ee28', '𞸩', '\U0001ee33', '𞸴', '\U0001ee38', '𞸹', '\U0001ee3a', '𞸻', '\U0001ee3c', '𞹂', '\U0001ee43', '𞹇', '\U0001ee48', '𞹉', '\U0001ee4a', '𞹋', '\U0001ee4c', '𞹍', '\U0001ee50', '𞹑', '\U0001ee53', '𞹔', '\U0001ee55', '𞹗', '\U0001ee58', '𞹙', '\U0001ee5a', '𞹛', '\U0001ee5c', '𞹝', '\U0001ee5e', '𞹟', '\U0001ee60', '𞹡', '\U0001ee63', '𞹤', '\U0001ee65', '𞹧', '\U0001ee6b', '𞹬', '\U0001ee73', '𞹴', '\U0001ee78', '𞹹', '\U0001ee7d', '𞹾', '\U0001ee7f', '𞺀', '\U0001ee8a', '𞺋', '\U0001ee9c', '𞺡', '\U0001eea4', '𞺥', '\U0001eeaa', '𞺫', '\U0001eebc', '𠀀', '\U0002a6e0', '𪜀', '\U0002b81e', '𫠠', '\U0002ceae', '𬺰', '\U0002ebe1', '𮯰', '\U0002ee5e', '丽', '\U0002fa1e', '𰀀', '\U0003134b', '𱍐', '\U0003347a'},
+					rangeSets: "\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00",
+					alts:      [][]int{{}, {7}, {1}, {6}, {8}, {9}, {3}, {4}, {5}, {2}, {0, 5}},
+					expected:  [][]string{{"\"re\\\"\"", "\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "\"@\"", "[\\pL_]", "\"$\"", "\"#\"", "\"&\"", "\"!\"", "\"%precedence\"", "\"(\""}, {"\"re\\\"\"", "\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "\"@\"", "[\\pL_]", "\"$\"", "\"%precedence\"", "\"(\""}, {"\"re\\\"\"", "\"[\"", "\".\"", "\"@\"", "[\\pL_]", "\"$\"", "\"#\"", "\"&\"", "\"!\"", "\"%precedence\"", "\"(\""}, {"\"re\\\"\"", "\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "\"@\"", "[\\pL_]", "\"#\"", "\"&\"", "\"!\"", "\"%precedence\"", "\"(\""}, {"\"re\\\"\"", "\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "\"@\"", "[\\pL_]", "\"$\"", "\"#\"", "\"&\"", "\"!\"", "\"(\""}, {"\"re\\\"\"", "\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "\"@\"", "[\\pL_]", "\"$\"", "\"#\"", "\"&\"", "\"!\"", "\"%precedence\""}, {"\"re\\\"\"", "\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\"@\"", "[\\pL_]", "\"$\"", "\"#\"", "\"&\"", "\"!\"", "\"%precedence\"", "\"(\""}, {"\"re\\\"\"", "\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "[\\pL_]", "\"$\"", "\"#\"", "\"&\"", "\"!\"", "\"%precedence\"", "\"(\""}, {"\"re\\\"\"", "\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "\"@\"", "\"$\"", "\"#\"", "\"&\"", "\"!\"", "\"%precedence\"", "\"(\""}, {"\"re\\\"\"", "\"\\\"\"", "\"'\"", "\"`\"", "\".\"", "\"@\"", "[\\pL_]", "\"$\"", "\"#\"", "\"&\"", "\"!\"", "\"%precedence\"", "\"(\""}, {"\"\\\"\"", "\"'\"", "\"`\"", "\"[\"", "\".\"", "\"@\"", "\"$\"", "\"#\"", "\"&\"", "\"!\"", "\"%precedence\"", "\"(\""}},
+				},
 			},
 		},
 		{
 			name: "RuleRefExpr",
-			pos:  position{line: 162, col: 1, offset: 4396},
+			pos:  position{line: 257, col: 1, offset: 7773},
+			id:   21,
 			expr: &actionExpr{
-				pos: position{line: 162, col: 15, offset: 4412},
+				pos: position{line: 257, col: 15, offset: 7789},
+				id:  299,
 				run: (*parser).callonRuleRefExpr1,
 				expr: &seqExpr{
-					pos: position{line: 162, col: 15, offset: 4412},
+					pos: position{line: 257, col: 15, offset: 7789},
+					id:  300,
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 162, col: 15, offset: 4412},
+							pos:   position{line: 257, col: 15, offset: 7789},
+							id:    301,
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 162, col: 20, offset: 4417},
+								pos:  position{line: 257, col: 20, offset: 7794},
+								id:   302,
 								name: "IdentifierName",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 257, col: 35, offset: 7809},
+							id:    303,
+							label: "args",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 257, col: 40, offset: 7814},
+								id:  304,
+								expr: &ruleRefExpr{
+									pos:  position{line: 257, col: 40, offset: 7814},
+									id:   305,
+									name: "RuleArgs",
+								},
+							},
+						},
 						&notExpr{
-							pos: position{line: 162, col: 35, offset: 4432},
+							pos: position{line: 257, col: 50, offset: 7824},
+							id:  306,
 							expr: &seqExpr{
-								pos: position{line: 162, col: 38, offset: 4435},
+								pos: position{line: 257, col: 53, offset: 7827},
+								id:  307,
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 162, col: 38, offset: 4435},
+										pos:  position{line: 257, col: 53, offset: 7827},
+										id:   308,
 										name: "__",
 									},
 									&zeroOrOneExpr{
-										pos: position{line: 162, col: 41, offset: 4438},
+										pos: position{line: 257, col: 56, offset: 7830},
+										id:  309,
 										expr: &seqExpr{
-											pos: position{line: 162, col: 43, offset: 4440},
+											pos: position{line: 257, col: 58, offset: 7832},
+											id:  310,
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 162, col: 43, offset: 4440},
+													pos:  position{line: 257, col: 58, offset: 7832},
+													id:   311,
 													name: "StringLiteral",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 162, col: 57, offset: 4454},
+													pos:  position{line: 257, col: 72, offset: 7846},
+													id:   312,
+													name: "__",
+												},
+											},
+										},
+									},
+									&zeroOrMoreExpr{
+										pos: position{line: 257, col: 78, offset: 7852},
+										id:  313,
+										expr: &seqExpr{
+											pos: position{line: 257, col: 80, offset: 7854},
+											id:  314,
+											exprs: []any{
+												&litMatcher{
+													pos:        position{line: 257, col: 80, offset: 7854},
+													id:         315,
+													val:        "@",
+													ignoreCase: false,
+													want:       "\"@\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 257, col: 84, offset: 7858},
+													id:   316,
+													name: "IdentifierName",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 257, col: 99, offset: 7873},
+													id:   317,
 													name: "__",
 												},
 											},
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 162, col: 63, offset: 4460},
+										pos:  position{line: 257, col: 105, offset: 7879},
+										id:   318,
 										name: "RuleDefOp",
 									},
 								},
@@ -748,32 +1455,216 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "RuleArgs",
+			pos:  position{line: 265, col: 1, offset: 8065},
+			id:   22,
+			expr: &actionExpr{
+				pos: position{line: 265, col: 12, offset: 8078},
+				id:  319,
+				run: (*parser).callonRuleArgs1,
+				expr: &seqExpr{
+					pos: position{line: 265, col: 12, offset: 8078},
+					id:  320,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 265, col: 12, offset: 8078},
+							id:         321,
+							val:        "<",
+							ignoreCase: false,
+							want:       "\"<\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 265, col: 16, offset: 8082},
+							id:   322,
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 265, col: 19, offset: 8085},
+							id:    323,
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 265, col: 25, offset: 8091},
+								id:   324,
+								name: "Expression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 265, col: 36, offset: 8102},
+							id:    325,
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 265, col: 41, offset: 8107},
+								id:  326,
+								expr: &seqExpr{
+									pos: position{line: 265, col: 43, offset: 8109},
+									id:  327,
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 265, col: 43, offset: 8109},
+											id:   328,
+											name: "__",
+										},
+										&litMatcher{
+											pos:        position{line: 265, col: 46, offset: 8112},
+											id:         329,
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 265, col: 50, offset: 8116},
+											id:   330,
+											name: "__",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 265, col: 53, offset: 8119},
+											id:   331,
+											name: "Expression",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 265, col: 67, offset: 8133},
+							id:   332,
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 265, col: 70, offset: 8136},
+							id:         333,
+							val:        ">",
+							ignoreCase: false,
+							want:       "\">\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MatcherFuncExpr",
+			pos:  position{line: 274, col: 1, offset: 8464},
+			id:   23,
+			expr: &actionExpr{
+				pos: position{line: 274, col: 19, offset: 8484},
+				id:  334,
+				run: (*parser).callonMatcherFuncExpr1,
+				expr: &seqExpr{
+					pos: position{line: 274, col: 19, offset: 8484},
+					id:  335,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 274, col: 19, offset: 8484},
+							id:         336,
+							val:        "@",
+							ignoreCase: false,
+							want:       "\"@\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 274, col: 23, offset: 8488},
+							id:    337,
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 274, col: 28, offset: 8493},
+								id:   338,
+								name: "IdentifierName",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 274, col: 43, offset: 8508},
+							id:         339,
+							val:        "()",
+							ignoreCase: false,
+							want:       "\"()\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BackRefExpr",
+			pos:  position{line: 283, col: 1, offset: 8899},
+			id:   24,
+			expr: &actionExpr{
+				pos: position{line: 283, col: 15, offset: 8915},
+				id:  340,
+				run: (*parser).callonBackRefExpr1,
+				expr: &seqExpr{
+					pos: position{line: 283, col: 15, offset: 8915},
+					id:  341,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 283, col: 15, offset: 8915},
+							id:         342,
+							val:        "$",
+							ignoreCase: false,
+							want:       "\"$\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 283, col: 19, offset: 8919},
+							id:    343,
+							label: "state",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 283, col: 25, offset: 8925},
+								id:  344,
+								expr: &litMatcher{
+									pos:        position{line: 283, col: 25, offset: 8925},
+									id:         345,
+									val:        "#",
+									ignoreCase: false,
+									want:       "\"#\"",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 283, col: 30, offset: 8930},
+							id:    346,
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 283, col: 35, offset: 8935},
+								id:   347,
+								name: "IdentifierName",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "SemanticPredExpr",
-			pos:  position{line: 167, col: 1, offset: 4576},
+			pos:  position{line: 297, col: 1, offset: 9285},
+			id:   25,
 			expr: &actionExpr{
-				pos: position{line: 167, col: 20, offset: 4597},
+				pos: position{line: 297, col: 20, offset: 9306},
+				id:  348,
 				run: (*parser).callonSemanticPredExpr1,
 				expr: &seqExpr{
-					pos: position{line: 167, col: 20, offset: 4597},
+					pos: position{line: 297, col: 20, offset: 9306},
+					id:  349,
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 167, col: 20, offset: 4597},
+							pos:   position{line: 297, col: 20, offset: 9306},
+							id:    350,
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 167, col: 23, offset: 4600},
+								pos:  position{line: 297, col: 23, offset: 9309},
+								id:   351,
 								name: "SemanticPredOp",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 167, col: 38, offset: 4615},
+							pos:  position{line: 297, col: 38, offset: 9324},
+							id:   352,
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 167, col: 41, offset: 4618},
+							pos:   position{line: 297, col: 41, offset: 9327},
+							id:    353,
 							label: "code",
 							expr: &ruleRefExpr{
-								pos:  position{line: 167, col: 46, offset: 4623},
+								pos:  position{line: 297, col: 46, offset: 9332},
+								id:   354,
 								name: "CodeBlock",
 							},
 						},
@@ -783,127 +1674,175 @@ var g = &grammar{
 		},
 		{
 			name: "SemanticPredOp",
-			pos:  position{line: 187, col: 1, offset: 5070},
+			pos:  position{line: 317, col: 1, offset: 9779},
+			id:   26,
 			expr: &actionExpr{
-				pos: position{line: 187, col: 18, offset: 5089},
+				pos: position{line: 317, col: 18, offset: 9798},
+				id:  355,
 				run: (*parser).callonSemanticPredOp1,
 				expr: &choiceExpr{
-					pos: position{line: 187, col: 20, offset: 5091},
+					pos: position{line: 317, col: 20, offset: 9800},
+					id:  356,
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 187, col: 20, offset: 5091},
+							pos:        position{line: 317, col: 20, offset: 9800},
+							id:         357,
 							val:        "#",
 							ignoreCase: false,
 							want:       "\"#\"",
 						},
 						&litMatcher{
-							pos:        position{line: 187, col: 26, offset: 5097},
+							pos:        position{line: 317, col: 26, offset: 9806},
+							id:         358,
 							val:        "&",
 							ignoreCase: false,
 							want:       "\"&\"",
 						},
 						&litMatcher{
-							pos:        position{line: 187, col: 32, offset: 5103},
+							pos:        position{line: 317, col: 32, offset: 9812},
+							id:         359,
 							val:        "!",
 							ignoreCase: false,
 							want:       "\"!\"",
 						},
 					},
+					dispatch: &choiceDispatch{
+						ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x02\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+						ranges:    []rune{'\u0080'},
+						rangeSets: "\x00",
+						alts:      [][]int{{}, {2}, {0}, {1}},
+						expected:  [][]string{{"\"#\"", "\"&\"", "\"!\""}, {"\"#\"", "\"&\""}, {"\"&\"", "\"!\""}, {"\"#\"", "\"!\""}},
+					},
 				},
 			},
 		},
 		{
 			name: "RuleDefOp",
-			pos:  position{line: 191, col: 1, offset: 5145},
+			pos:  position{line: 321, col: 1, offset: 9854},
+			id:   27,
 			expr: &choiceExpr{
-				pos: position{line: 191, col: 13, offset: 5159},
+				pos: position{line: 321, col: 13, offset: 9868},
+				id:  360,
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 191, col: 13, offset: 5159},
+						pos:        position{line: 321, col: 13, offset: 9868},
+						id:         361,
 						val:        "=",
 						ignoreCase: false,
 						want:       "\"=\"",
 					},
 					&litMatcher{
-						pos:        position{line: 191, col: 19, offset: 5165},
+						pos:        position{line: 321, col: 19, offset: 9874},
+						id:         362,
 						val:        "<-",
 						ignoreCase: false,
 						want:       "\"<-\"",
 					},
 					&litMatcher{
-						pos:        position{line: 191, col: 26, offset: 5172},
+						pos:        position{line: 321, col: 26, offset: 9881},
+						id:         363,
 						val:        "←",
 						ignoreCase: false,
 						want:       "\"←\"",
 					},
 					&litMatcher{
-						pos:        position{line: 191, col: 37, offset: 5183},
+						pos:        position{line: 321, col: 37, offset: 9892},
+						id:         364,
 						val:        "⟵",
 						ignoreCase: false,
 						want:       "\"⟵\"",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080', 'â', 'ã', '←', '↑', '⟵', '⟶'},
+					rangeSets: "\x00\x03\x00\x04\x00\x05\x00",
+					alts:      [][]int{{}, {1}, {0}, {2, 3}, {2}, {3}},
+					expected:  [][]string{{"\"=\"", "\"<-\"", "\"←\"", "\"⟵\""}, {"\"=\"", "\"←\"", "\"⟵\""}, {"\"<-\"", "\"←\"", "\"⟵\""}, {"\"=\"", "\"<-\""}, {"\"=\"", "\"<-\"", "\"⟵\""}, {"\"=\"", "\"<-\"", "\"←\""}},
+				},
 			},
 		},
 		{
 			name: "SourceChar",
-			pos:  position{line: 193, col: 1, offset: 5193},
+			pos:  position{line: 323, col: 1, offset: 9902},
+			id:   28,
 			expr: &anyMatcher{
-				line: 193, col: 14, offset: 5208,
+				pos: position{line: 323, col: 14, offset: 9917},
+				id:  365,
 			},
 		},
 		{
 			name: "Comment",
-			pos:  position{line: 194, col: 1, offset: 5210},
+			pos:  position{line: 324, col: 1, offset: 9919},
+			id:   29,
 			expr: &choiceExpr{
-				pos: position{line: 194, col: 11, offset: 5222},
+				pos: position{line: 324, col: 11, offset: 9931},
+				id:  366,
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 194, col: 11, offset: 5222},
+						pos:  position{line: 324, col: 11, offset: 9931},
+						id:   367,
 						name: "MultiLineComment",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 194, col: 30, offset: 5241},
+						pos:  position{line: 324, col: 30, offset: 9950},
+						id:   368,
 						name: "SingleLineComment",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{1}, {0, 1}},
+					expected:  [][]string{{"\"/*\""}, {}},
+				},
 			},
 		},
 		{
 			name: "MultiLineComment",
-			pos:  position{line: 195, col: 1, offset: 5259},
+			pos:  position{line: 325, col: 1, offset: 9968},
+			id:   30,
 			expr: &seqExpr{
-				pos: position{line: 195, col: 20, offset: 5280},
+				pos: position{line: 325, col: 20, offset: 9989},
+				id:  369,
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 195, col: 20, offset: 5280},
+						pos:        position{line: 325, col: 20, offset: 9989},
+						id:         370,
 						val:        "/*",
 						ignoreCase: false,
 						want:       "\"/*\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 195, col: 25, offset: 5285},
+						pos: position{line: 325, col: 25, offset: 9994},
+						id:  371,
 						expr: &seqExpr{
-							pos: position{line: 195, col: 27, offset: 5287},
+							pos: position{line: 325, col: 27, offset: 9996},
+							id:  372,
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 195, col: 27, offset: 5287},
+									pos: position{line: 325, col: 27, offset: 9996},
+									id:  373,
 									expr: &litMatcher{
-										pos:        position{line: 195, col: 28, offset: 5288},
+										pos:        position{line: 325, col: 28, offset: 9997},
+										id:         374,
 										val:        "*/",
 										ignoreCase: false,
 										want:       "\"*/\"",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 195, col: 33, offset: 5293},
+									pos:  position{line: 325, col: 33, offset: 10002},
+									id:   375,
 									name: "SourceChar",
 								},
 							},
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 195, col: 47, offset: 5307},
+						pos:        position{line: 325, col: 47, offset: 10016},
+						id:         376,
 						val:        "*/",
 						ignoreCase: false,
 						want:       "\"*/\"",
@@ -913,48 +1852,66 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineCommentNoLineTerminator",
-			pos:  position{line: 196, col: 1, offset: 5312},
+			pos:  position{line: 326, col: 1, offset: 10021},
+			id:   31,
 			expr: &seqExpr{
-				pos: position{line: 196, col: 36, offset: 5349},
+				pos: position{line: 326, col: 36, offset: 10058},
+				id:  377,
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 196, col: 36, offset: 5349},
+						pos:        position{line: 326, col: 36, offset: 10058},
+						id:         378,
 						val:        "/*",
 						ignoreCase: false,
 						want:       "\"/*\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 196, col: 41, offset: 5354},
+						pos: position{line: 326, col: 41, offset: 10063},
+						id:  379,
 						expr: &seqExpr{
-							pos: position{line: 196, col: 43, offset: 5356},
+							pos: position{line: 326, col: 43, offset: 10065},
+							id:  380,
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 196, col: 43, offset: 5356},
+									pos: position{line: 326, col: 43, offset: 10065},
+									id:  381,
 									expr: &choiceExpr{
-										pos: position{line: 196, col: 46, offset: 5359},
+										pos: position{line: 326, col: 46, offset: 10068},
+										id:  382,
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 196, col: 46, offset: 5359},
+												pos:        position{line: 326, col: 46, offset: 10068},
+												id:         383,
 												val:        "*/",
 												ignoreCase: false,
 												want:       "\"*/\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 196, col: 53, offset: 5366},
+												pos:  position{line: 326, col: 53, offset: 10075},
+												id:   384,
 												name: "EOL",
 											},
 										},
+										dispatch: &choiceDispatch{
+											ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+											ranges:    []rune{'\u0080'},
+											rangeSets: "\x00",
+											alts:      [][]int{{}, {1}, {0}},
+											expected:  [][]string{{"\"*/\"", "\"\\n\""}, {"\"*/\""}, {"\"\\n\""}},
+										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 196, col: 59, offset: 5372},
+									pos:  position{line: 326, col: 59, offset: 10081},
+									id:   385,
 									name: "SourceChar",
 								},
 							},
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 196, col: 73, offset: 5386},
+						pos:        position{line: 326, col: 73, offset: 10095},
+						id:         386,
 						val:        "*/",
 						ignoreCase: false,
 						want:       "\"*/\"",
@@ -964,39 +1921,49 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineComment",
-			pos:  position{line: 197, col: 1, offset: 5391},
+			pos:  position{line: 327, col: 1, offset: 10100},
+			id:   32,
 			expr: &seqExpr{
-				pos: position{line: 197, col: 21, offset: 5413},
+				pos: position{line: 327, col: 21, offset: 10122},
+				id:  387,
 				exprs: []any{
 					&notExpr{
-						pos: position{line: 197, col: 21, offset: 5413},
+						pos: position{line: 327, col: 21, offset: 10122},
+						id:  388,
 						expr: &litMatcher{
-							pos:        position{line: 197, col: 23, offset: 5415},
+							pos:        position{line: 327, col: 23, offset: 10124},
+							id:         389,
 							val:        "//{",
 							ignoreCase: false,
 							want:       "\"//{\"",
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 197, col: 30, offset: 5422},
+						pos:        position{line: 327, col: 30, offset: 10131},
+						id:         390,
 						val:        "//",
 						ignoreCase: false,
 						want:       "\"//\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 197, col: 35, offset: 5427},
+						pos: position{line: 327, col: 35, offset: 10136},
+						id:  391,
 						expr: &seqExpr{
-							pos: position{line: 197, col: 37, offset: 5429},
+							pos: position{line: 327, col: 37, offset: 10138},
+							id:  392,
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 197, col: 37, offset: 5429},
+									pos: position{line: 327, col: 37, offset: 10138},
+									id:  393,
 									expr: &ruleRefExpr{
-										pos:  position{line: 197, col: 38, offset: 5430},
+										pos:  position{line: 327, col: 38, offset: 10139},
+										id:   394,
 										name: "EOL",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 197, col: 42, offset: 5434},
+									pos:  position{line: 327, col: 42, offset: 10143},
+									id:   395,
 									name: "SourceChar",
 								},
 							},
@@ -1007,15 +1974,19 @@ var g = &grammar{
 		},
 		{
 			name: "Identifier",
-			pos:  position{line: 199, col: 1, offset: 5449},
+			pos:  position{line: 329, col: 1, offset: 10158},
+			id:   33,
 			expr: &actionExpr{
-				pos: position{line: 199, col: 14, offset: 5464},
+				pos: position{line: 329, col: 14, offset: 10173},
+				id:  396,
 				run: (*parser).callonIdentifier1,
 				expr: &labeledExpr{
-					pos:   position{line: 199, col: 14, offset: 5464},
+					pos:   position{line: 329, col: 14, offset: 10173},
+					id:    397,
 					label: "ident",
 					expr: &ruleRefExpr{
-						pos:  position{line: 199, col: 20, offset: 5470},
+						pos:  position{line: 329, col: 20, offset: 10179},
+						id:   398,
 						name: "IdentifierName",
 					},
 				},
@@ -1023,21 +1994,27 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierName",
-			pos:  position{line: 207, col: 1, offset: 5689},
+			pos:  position{line: 337, col: 1, offset: 10398},
+			id:   34,
 			expr: &actionExpr{
-				pos: position{line: 207, col: 18, offset: 5708},
+				pos: position{line: 337, col: 18, offset: 10417},
+				id:  399,
 				run: (*parser).callonIdentifierName1,
 				expr: &seqExpr{
-					pos: position{line: 207, col: 18, offset: 5708},
+					pos: position{line: 337, col: 18, offset: 10417},
+					id:  400,
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 207, col: 18, offset: 5708},
+							pos:  position{line: 337, col: 18, offset: 10417},
+							id:   401,
 							name: "IdentifierStart",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 207, col: 34, offset: 5724},
+							pos: position{line: 337, col: 34, offset: 10433},
+							id:  402,
 							expr: &ruleRefExpr{
-								pos:  position{line: 207, col: 34, offset: 5724},
+								pos:  position{line: 337, col: 34, offset: 10433},
+								id:   403,
 								name: "IdentifierPart",
 							},
 						},
@@ -1047,9 +2024,11 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierStart",
-			pos:  position{line: 210, col: 1, offset: 5806},
+			pos:  position{line: 340, col: 1, offset: 10515},
+			id:   35,
 			expr: &charClassMatcher{
-				pos:        position{line: 210, col: 19, offset: 5826},
+				pos:        position{line: 340, col: 19, offset: 10535},
+				id:         404,
 				val:        "[\\pL_]",
 				chars:      []rune{'_'},
 				classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -1059,48 +2038,67 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierPart",
-			pos:  position{line: 211, col: 1, offset: 5833},
+			pos:  position{line: 341, col: 1, offset: 10542},
+			id:   36,
 			expr: &choiceExpr{
-				pos: position{line: 211, col: 18, offset: 5852},
+				pos: position{line: 341, col: 18, offset: 10561},
+				id:  405,
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 211, col: 18, offset: 5852},
+						pos:  position{line: 341, col: 18, offset: 10561},
+						id:   406,
 						name: "IdentifierStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 211, col: 36, offset: 5870},
+						pos:        position{line: 341, col: 36, offset: 10579},
+						id:         407,
 						val:        "[\\p{Nd}]",
 						classes:    []*unicode.RangeTable{rangeTable("Nd")},
 						ignoreCase: false,
 						inverted:   false,
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x02\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080', 'ª', '«', 'µ', '¶', 'º', '»', 'À', '×', 'Ø', '÷', 'ø', '˂', 'ˆ', '˒', 'ˠ', '˥', 'ˬ', '˭', 'ˮ', '˯', 'Ͱ', '͵', 'Ͷ', '\u0378', 'ͺ', ';', 'Ϳ', '\u0380', 'Ά', '·', 'Έ', '\u038b', 'Ό', '\u038d', 'Ύ', '\u03a2', 'Σ', '϶', 'Ϸ', '҂', 'Ҋ', '\u0530', 'Ա', '\u0557', 'ՙ', '՚', 'ՠ', '։', 'א', '\u05eb', 'ׯ', '׳', 'ؠ', 'ً', '٠', '٪', 'ٮ', 'ٰ', 'ٱ', '۔', 'ە', 'ۖ', 'ۥ', 'ۧ', 'ۮ', '۰', 'ۺ', '۽', 'ۿ', '܀', 'ܐ', 'ܑ', 'ܒ', 'ܰ', 'ݍ', 'ަ', 'ޱ', '\u07b2', '߀', 'ߊ', '߫', 'ߴ', '߶', 'ߺ', '\u07fb', 'ࠀ', 'ࠖ', 'ࠚ', 'ࠛ', 'ࠤ', 'ࠥ', 'ࠨ', 'ࠩ', 'ࡀ', '࡙', 'ࡠ', '\u086b', 'ࡰ', '࢈', 'ࢉ', '\u0890', 'ࢠ', '࣊', 'ऄ', 'ऺ', 'ऽ', 'ा', 'ॐ', '॑', 'क़', 'ॢ', '०', '॰', 'ॱ', 'ঁ', 'অ', '\u098d', 'এ', '\u0991', 'ও', '\u09a9', 'প', '\u09b1', 'ল', '\u09b3', 'শ', '\u09ba', 'ঽ', 'া', 'ৎ', '\u09cf', 'ড়', '\u09de', 'য়', 'ৢ', '০', 'ৰ', '৲', 'ৼ', '৽', 'ਅ', '\u0a0b', 'ਏ', '\u0a11', 'ਓ', '\u0a29', 'ਪ', '\u0a31', 'ਲ', '\u0a34', 'ਵ', '\u0a37', 'ਸ', '\u0a3a', 'ਖ਼', '\u0a5d', 'ਫ਼', '\u0a5f', '੦', 'ੰ', 'ੲ', 'ੵ', 'અ', '\u0a8e', 'એ', '\u0a92', 'ઓ', '\u0aa9', 'પ', '\u0ab1', 'લ', '\u0ab4', 'વ', '\u0aba', 'ઽ', 'ા', 'ૐ', '\u0ad1', 'ૠ', 'ૢ', '૦', '૰', 'ૹ', 'ૺ', 'ଅ', '\u0b0d', 'ଏ', '\u0b11', 'ଓ', '\u0b29', 'ପ', '\u0b31', 'ଲ', '\u0b34', 'ଵ', '\u0b3a', 'ଽ', 'ା', 'ଡ଼', '\u0b5e', 'ୟ', 'ୢ', '୦', '୰', 'ୱ', '୲', 'ஃ', '\u0b84', 'அ', '\u0b8b', 'எ', '\u0b91', 'ஒ', '\u0b96', 'ங', '\u0b9b', 'ஜ', '\u0b9d', 'ஞ', '\u0ba0', 'ண', '\u0ba5', 'ந', '\u0bab', 'ம', '\u0bba', 'ௐ', '\u0bd1', '௦', '௰', 'అ', '\u0c0d', 'ఎ', '\u0c11', 'ఒ', '\u0c29', 'ప', '\u0c3a', 'ఽ', 'ా', 'ౘ', '\u0c5b', '౜', '\u0c5e', 'ౠ', 'ౢ', '౦', '\u0c70', 'ಀ', 'ಁ', 'ಅ', '\u0c8d', 'ಎ', '\u0c91', 'ಒ', '\u0ca9', 'ಪ', '\u0cb4', 'ವ', '\u0cba', 'ಽ', 'ಾ', '೜', '\u0cdf', 'ೠ', 'ೢ', '೦', '\u0cf0', 'ೱ', 'ೳ', 'ഄ', '\u0d0d', 'എ', '\u0d11', 'ഒ', '഻', 'ഽ', 'ാ', 'ൎ', '൏', 'ൔ', 'ൗ', 'ൟ', 'ൢ', '൦', '൰', 'ൺ', '\u0d80', 'අ', '\u0d97', 'ක', '\u0db2', 'ඳ', '\u0dbc', 'ල', '\u0dbe', 'ව', '\u0dc7', '෦', '\u0df0', 'ก', 'ั', 'า', 'ิ', 'เ', '็', '๐', '๚', 'ກ', '\u0e83', 'ຄ', '\u0e85', 'ຆ', '\u0e8b', 'ຌ', '\u0ea4', 'ລ', '\u0ea6', 'ວ', 'ັ', 'າ', 'ິ', 'ຽ', '\u0ebe', 'ເ', '\u0ec5', 'ໆ', '\u0ec7', '໐', '\u0eda', 'ໜ', '\u0ee0', 'ༀ', '༁', '༠', '༪', 'ཀ', '\u0f48', 'ཉ', '\u0f6d', 'ྈ', 'ྍ', 'က', 'ါ', 'ဿ', '၀', '၊', 'ၐ', 'ၖ', 'ၚ', 'ၞ', 'ၡ', 'ၢ', 'ၥ', 'ၧ', 'ၮ', 'ၱ', 'ၵ', 'ႂ', 'ႎ', 'ႏ', '႐', 'ႚ', 'Ⴀ', '\u10c6', 'Ⴧ', '\u10c8', 'Ⴭ', '\u10ce', 'ა', '჻', 'ჼ', '\u1249', 'ቊ', '\u124e', 'ቐ', '\u1257', 'ቘ', '\u1259', 'ቚ', '\u125e', 'በ', '\u1289', 'ኊ', '\u128e', 'ነ', '\u12b1', 'ኲ', '\u12b6', 'ኸ', '\u12bf', 'ዀ', '\u12c1', 'ዂ', '\u12c6', 'ወ', '\u12d7', 'ዘ', '\u1311', 'ጒ', '\u1316', 'ጘ', '\u135b', 'ᎀ', '᎐', 'Ꭰ', '\u13f6', 'ᏸ', '\u13fe', 'ᐁ', '᙭', 'ᙯ', '\u1680', 'ᚁ', '᚛', 'ᚠ', '᛫', 'ᛱ', '\u16f9', 'ᜀ', 'ᜒ', 'ᜟ', 'ᜲ', 'ᝀ', 'ᝒ', 'ᝠ', '\u176d', 'ᝮ', '\u1771', 'ក', '឴', 'ៗ', '៘', 'ៜ', '៝', '០', '\u17ea', '᠐', '\u181a', 'ᠠ', '\u1879', 'ᢀ', 'ᢅ', 'ᢇ', 'ᢩ', 'ᢪ', '\u18ab', 'ᢰ', '\u18f6', 'ᤀ', '\u191f', '᥆', 'ᥐ', '\u196e', 'ᥰ', '\u1975', 'ᦀ', '\u19ac', 'ᦰ', '\u19ca', '᧐', '᧚', 'ᨀ', 'ᨗ', 'ᨠ', 'ᩕ', '᪀', '\u1a8a', '᪐', '\u1a9a', 'ᪧ', '᪨', 'ᬅ', '᬴', 'ᭅ', '\u1b4d', '᭐', '᭚', 'ᮃ', 'ᮡ', 'ᮮ', '᮰', 'ᮺ', '᯦', 'ᰀ', 'ᰤ', '᱀', '\u1c4a', 'ᱍ', '᱐', 'ᱚ', '᱾', 'ᲀ', '\u1c8b', 'Ა', '\u1cbb', 'Ჽ', '᳀', 'ᳩ', '᳭', 'ᳮ', '᳴', 'ᳵ', '᳷', 'ᳺ', '\u1cfb', 'ᴀ', '᷀', 'Ḁ', '\u1f16', 'Ἐ', '\u1f1e', 'ἠ', '\u1f46', 'Ὀ', '\u1f4e', 'ὐ', '\u1f58', 'Ὑ', '\u1f5a', 'Ὓ', '\u1f5c', 'Ὕ', '\u1f5e', 'Ὗ', '\u1f7e', 'ᾀ', '\u1fb5', 'ᾶ', '᾽', 'ι', '᾿', 'ῂ', '\u1fc5', 'ῆ', '῍', 'ῐ', '\u1fd4', 'ῖ', '\u1fdc', 'ῠ', '῭', 'ῲ', '\u1ff5', 'ῶ', '´', 'ⁱ', '\u2072', 'ⁿ', '₀', 'ₐ', '\u209d', 'ℂ', '℃', 'ℇ', '℈', 'ℊ', '℔', 'ℕ', '№', 'ℙ', '℞', 'ℤ', '℥', 'Ω', '℧', 'ℨ', '℩', 'K', '℮', 'ℯ', '℺', 'ℼ', '⅀', 'ⅅ', '⅊', 'ⅎ', '⅏', 'Ↄ', 'ↅ', 'Ⰰ', '⳥', 'Ⳬ', '⳯', 'Ⳳ', '\u2cf4', 'ⴀ', '\u2d26', 'ⴧ', '\u2d28', 'ⴭ', '\u2d2e', 'ⴰ', '\u2d68', 'ⵯ', '⵰', 'ⶀ', '\u2d97', 'ⶠ', '\u2da7', 'ⶨ', '\u2daf', 'ⶰ', '\u2db7', 'ⶸ', '\u2dbf', 'ⷀ', '\u2dc7', 'ⷈ', '\u2dcf', 'ⷐ', '\u2dd7', 'ⷘ', '\u2ddf', 'ⸯ', '⸰', '々', '〇', '〱', '〶', '〻', '〽', 'ぁ', '\u3097', 'ゝ', '゠', 'ァ', '・', 'ー', '\u3100', 'ㄅ', '\u3130', 'ㄱ', '\u318f', 'ㆠ', '㇀', 'ㇰ', '㈀', '㐀', '䷀', '一', '\ua48d', 'ꓐ', '꓾', 'ꔀ', '꘍', 'ꘐ', '꘠', 'ꘪ', '\ua62c', 'Ꙁ', '꙯', 'ꙿ', 'ꚞ', 'ꚠ', 'ꛦ', 'ꜗ', '꜠', 'Ꜣ', '꞉', 'Ꞌ', '\ua7dd', '꟱', 'ꠂ', 'ꠃ', '꠆', 'ꠇ', 'ꠋ', 'ꠌ', 'ꠣ', 'ꡀ', '꡴', 'ꢂ', 'ꢴ', '꣐', '\ua8da', 'ꣲ', '꣸', 'ꣻ', '꣼', 'ꣽ', 'ꣿ', '꤀', 'ꤊ', 'ꤦ', 'ꤰ', 'ꥇ', 'ꥠ', '\ua97d', 'ꦄ', '꦳', 'ꧏ', '꧐', '\ua9da', 'ꧠ', 'ꧥ', 'ꧦ', '꧰', 'ꧺ', '\ua9ff', 'ꨀ', 'ꨩ', 'ꩀ', 'ꩃ', 'ꩄ', 'ꩌ', '꩐', '\uaa5a', 'ꩠ', '꩷', 'ꩺ', 'ꩻ', 'ꩾ', 'ꪰ', 'ꪱ', 'ꪲ', 'ꪵ', 'ꪷ', 'ꪹ', 'ꪾ', 'ꫀ', '꫁', 'ꫂ', '\uaac3', 'ꫛ', '꫞', 'ꫠ', 'ꫫ', 'ꫲ', 'ꫵ', 'ꬁ', '\uab07', 'ꬉ', '\uab0f', 'ꬑ', '\uab17', 'ꬠ', '\uab27', 'ꬨ', '\uab2f', 'ꬰ', '꭛', 'ꭜ', '꭪', 'ꭰ', 'ꯣ', '꯰', '\uabfa', '가', '\ud7a4', 'ힰ', '\ud7c7', 'ퟋ', '\ud7fc', '豈', '\ufa6e', '並', '\ufada', 'ﬀ', '\ufb07', 'ﬓ', '\ufb18', 'יִ', 'ﬞ', 'ײַ', '﬩', 'שׁ', '\ufb37', 'טּ', '\ufb3d', 'מּ', '\ufb3f', 'נּ', '\ufb42', 'ףּ', '\ufb45', 'צּ', '﮲', 'ﯓ', '﴾', 'ﵐ', '﶐', 'ﶒ', '﷈', 'ﷰ', '﷼', 'ﹰ', '\ufe75', 'ﹶ', '\ufefd', '０', '：', 'Ａ', '［', 'ａ', '｛', 'ｦ', '\uffbf', 'ￂ', '\uffc8', 'ￊ', '\uffd0', 'ￒ', '\uffd8', 'ￚ', '\uffdd', '𐀀', '\U0001000c', '𐀍', '\U00010027', '𐀨', '\U0001003b', '𐀼', '\U0001003e', '𐀿', '\U0001004e', '𐁐', '\U0001005e', '𐂀', '\U000100fb', '𐊀', '\U0001029d', '𐊠', '\U000102d1', '𐌀', '𐌠', '𐌭', '𐍁', '𐍂', '𐍊', '𐍐', '𐍶', '𐎀', '\U0001039e', '𐎠', '\U000103c4', '𐏈', '𐏐', '𐐀', '\U0001049e', '𐒠', '\U000104aa', '𐒰', '\U000104d4', '𐓘', '\U000104fc', '𐔀', '\U00010528', '𐔰', '\U00010564', '𐕰', '\U0001057b', '𐕼', '\U0001058b', '𐖌', '\U00010593', '𐖔', '\U00010596', '𐖗', '\U000105a2', '𐖣', '\U000105b2', '𐖳', '\U000105ba', '𐖻', '\U000105bd', '𐗀', '\U000105f4', '𐘀', '\U00010737', '𐝀', '\U00010756', '𐝠', '\U00010768', '𐞀', '\U00010786', '𐞇', '\U000107b1', '𐞲', '\U000107bb', '𐠀', '\U00010806', '𐠈', '\U00010809', '𐠊', '\U00010836', '𐠷', '\U00010839', '𐠼', '\U0001083d', '𐠿', '\U00010856', '𐡠', '𐡷', '𐢀', '\U0001089f', '𐣠', '\U000108f3', '𐣴', '\U000108f6', '𐤀', '𐤖', '𐤠', '\U0001093a', '𐥀', '\U0001095a', '𐦀', '\U000109b8', '𐦾', '𐧀', '𐨀', '𐨁', '𐨐', '\U00010a14', '𐨕', '\U00010a18', '𐨙', '\U00010a36', '𐩠', '𐩽', '𐪀', '𐪝', '𐫀', '𐫈', '𐫉', '𐫥', '𐬀', '\U00010b36', '𐭀', '\U00010b56', '𐭠', '\U00010b73', '𐮀', '\U00010b92', '𐰀', '\U00010c49', '𐲀', '\U00010cb3', '𐳀', '\U00010cf3', '𐴀', '𐴤', '𐴰', '\U00010d3a', '𐵀', '𐵊', '\U00010d66', '𐵯', '\U00010d86', '𐺀', '\U00010eaa', '𐺰', '\U00010eb2', '𐻂', '\U00010ec8', '𐼀', '𐼝', '𐼧', '\U00010f28', '𐼰', '𐽆', '𐽰', '𐾂', '𐾰', '𐿅', '𐿠', '\U00010ff7', '𑀃', '𑀸', '𑁦', '𑁰', '𑁱', '𑁳', '𑁵', '\U00011076', '𑂃', '𑂰', '𑃐', '\U000110e9', '𑃰', '\U000110fa', '𑄃', '𑄧', '𑄶', '𑅀', '𑅄', '𑅅', '𑅇', '\U00011148', '𑅐', '𑅳', '𑅶', '\U00011177', '𑆃', '𑆳', '𑇁', '𑇅', '𑇐', '𑇚', '𑇛', '𑇜', '𑇝', '𑈀', '\U00011212', '𑈓', '𑈬', '𑈿', '𑉁', '𑊀', '\U00011287', '𑊈', '\U00011289', '𑊊', '\U0001128e', '𑊏', '\U0001129e', '𑊟', '𑊩', '𑊰', '𑋟', '𑋰', '\U000112fa', '𑌅', '\U0001130d', '𑌏', '\U00011311', '𑌓', '\U00011329', '𑌪', '\U00011331', '𑌲', '\U00011334', '𑌵', '\U0001133a', '𑌽', '𑌾', '𑍐', '\U00011351', '𑍝', '𑍢', '𑎀', '\U0001138a', '𑎋', '\U0001138c', '𑎎', '\U0001138f', '𑎐', '\U000113b6', '𑎷', '𑎸', '𑏑', '𑏒', '𑏓', '𑏔', '𑐀', '𑐵', '𑑇', '𑑋', '𑑐', '𑑚', '𑑟', '\U00011462', '𑒀', '𑒰', '𑓄', '𑓆', '𑓇', '\U000114c8', '𑓐', '\U000114da', '𑖀', '𑖯', '𑗘', '𑗜', '𑘀', '𑘰', '𑙄', '\U00011645', '𑙐', '\U0001165a', '𑚀', '𑚫', '𑚸', '𑚹', '𑛀', '\U000116ca', '𑛐', '\U000116e4', '𑜀', '\U0001171b', '𑜰', '𑜺', '𑝀', '\U00011747', '𑠀', '𑠬', '𑢠', '𑣠', '𑣪', '𑣿', '\U00011907', '𑤉', '\U0001190a', '𑤌', '\U00011914', '𑤕', '\U00011917', '𑤘', '𑤰', '𑤿', '𑥀', '𑥁', '𑥂', '𑥐', '\U0001195a', '𑦠', '\U000119a8', '𑦪', '𑧑', '𑧡', '𑧢', '𑧣', '𑧤', '𑨀', '𑨁', '𑨋', '𑨳', '𑨺', '𑨻', '𑩐', '𑩑', '𑩜', '𑪊', '𑪝', '𑪞', '𑪰', '\U00011af9', '𑯀', '𑯡', '𑯰', '\U00011bfa', '𑰀', '\U00011c09', '𑰊', '𑰯', '𑱀', '𑱁', '𑱐', '𑱚', '𑱲', '\U00011c90', '𑴀', '\U00011d07', '𑴈', '\U00011d0a', '𑴋', '𑴱', '𑵆', '𑵇', '𑵐', '\U00011d5a', '𑵠', '\U00011d66', '𑵧', '\U00011d69', '𑵪', '𑶊', '𑶘', '\U00011d99', '𑶠', '\U00011daa', '𑶰', '\U00011ddc', '𑷠', '\U00011dea', '𑻠', '𑻳', '𑼂', '𑼃', '𑼄', '\U00011f11', '𑼒', '𑼴', '𑽐', '𑽚', '𑾰', '\U00011fb1', '𒀀', '\U0001239a', '𒒀', '\U00012544', '𒾐', '𒿱', '𓀀', '\U00013430', '𓑁', '𓑇', '𓑠', '\U000143fb', '𔐀', '\U00014647', '𖄀', '𖄞', '𖄰', '\U0001613a', '𖠀', '\U00016a39', '𖩀', '\U00016a5f', '𖩠', '\U00016a6a', '𖩰', '\U00016abf', '𖫀', '\U00016aca', '𖫐', '\U00016aee', '𖬀', '𖬰', '𖭀', '𖭄', '𖭐', '\U00016b5a', '𖭣', '\U00016b78', '𖭽', '\U00016b90', '𖵀', '𖵭', '𖵰', '\U00016d7a', '𖹀', '𖺀', '𖺠', '\U00016eb9', '𖺻', '\U00016ed4', '𖼀', '\U00016f4b', '𖽐', '𖽑', '𖾓', '\U00016fa0', '𖿠', '𖿢', '𖿣', '𖿤', '𖿲', '𖿴', '𗀀', '\U00018cd6', '𘳿', '\U00018d1f', '𘶀', '\U00018df3', '𚿰', '\U0001aff4', '𚿵', '\U0001affc', '𚿽', '\U0001afff', '𛀀', '\U0001b123', '𛄲', '\U0001b133', '𛅐', '\U0001b153', '𛅕', '\U0001b156', '𛅤', '\U0001b168', '𛅰', '\U0001b2fc', '𛰀', '\U0001bc6b', '𛱰', '\U0001bc7d', '𛲀', '\U0001bc89', '𛲐', '\U0001bc9a', '𜳰', '𜳺', '𝐀', '\U0001d455', '𝑖', '\U0001d49d', '𝒞', '\U0001d4a0', '𝒢', '\U0001d4a3', '𝒥', '\U0001d4a7', '𝒩', '\U0001d4ad', '𝒮', '\U0001d4ba', '𝒻', '\U0001d4bc', '𝒽', '\U0001d4c4', '𝓅', '\U0001d506', '𝔇', '\U0001d50b', '𝔍', '\U0001d515', '𝔖', '\U0001d51d', '𝔞', '\U0001d53a', '𝔻', '\U0001d53f', '𝕀', '\U0001d545', '𝕆', '\U0001d547', '𝕊', '\U0001d551', '𝕒', '\U0001d6a6', '𝚨', '𝛁', '𝛂', '𝛛', '𝛜', '𝛻', '𝛼', '𝜕', '𝜖', '𝜵', '𝜶', '𝝏', '𝝐', '𝝯', '𝝰', '𝞉', '𝞊', '𝞩', '𝞪', '𝟃', '𝟄', '\U0001d7cc', '𝟎', '𝠀', '𝼀', '\U0001df1f', '𝼥', '\U0001df2b', '𞀰', '\U0001e06e', '𞄀', '\U0001e12d', '𞄷', '\U0001e13e', '𞅀', '\U0001e14a', '𞅎', '𞅏', '𞊐', '𞊮', '𞋀', '𞋬', '𞋰', '\U0001e2fa', '𞓐', '𞓬', '𞓰', '\U0001e4fa', '𞗐', '𞗮', '𞗰', '𞗱', '\U0001e5fb', '𞛀', '\U0001e6df', '𞛠', '𞛣', '𞛤', '𞛦', '𞛧', '𞛮', '𞛰', '𞛵', '𞛾', '\U0001e700', '𞟠', '\U0001e7e7', '𞟨', '\U0001e7ec', '𞟭', '\U0001e7ef', '𞟰', '\U0001e7ff', '𞠀', '\U0001e8c5', '𞤀', '𞥄', '𞥋', '\U0001e94c', '𞥐', '\U0001e95a', '𞸀', '\U0001ee04', '𞸅', '\U0001ee20', '𞸡', '\U0001ee23', '𞸤', '\U0001ee25', '𞸧', '\U0001ee28', '𞸩', '\U0001ee33', '𞸴', '\U0001ee38', '𞸹', '\U0001ee3a', '𞸻', '\U0001ee3c', '𞹂', '\U0001ee43', '𞹇', '\U0001ee48', '𞹉', '\U0001ee4a', '𞹋', '\U0001ee4c', '𞹍', '\U0001ee50', '𞹑', '\U0001ee53', '𞹔', '\U0001ee55', '𞹗', '\U0001ee58', '𞹙', '\U0001ee5a', '𞹛', '\U0001ee5c', '𞹝', '\U0001ee5e', '𞹟', '\U0001ee60', '𞹡', '\U0001ee63', '𞹤', '\U0001ee65', '𞹧', '\U0001ee6b', '𞹬', '\U0001ee73', '𞹴', '\U0001ee78', '𞹹', '\U0001ee7d', '𞹾', '\U0001ee7f', '𞺀', '\U0001ee8a', '𞺋', '\U0001ee9c', '𞺡', '\U0001eea4', '𞺥', '\U0001eeaa', '𞺫', '\U0001eebc', '🯰', '🯺', '𠀀', '\U0002a6e0', '𪜀', '\U0002b81e', '𫠠', '\U0002ceae', '𬺰', '\U0002ebe1', '𮯰', '\U0002ee5e', '丽', '\U0002fa1e', '𰀀', '\U0003134b', '𱍐', '\U0003347a'},
+					rangeSets: "\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x01\x00\x01\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x01\x02\x00\x02\x00\x01\x00\x02\x01\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x01\x02\x00\x02\x00\x02\x00\x02\x00\x02\x01\x00\x02\x00\x02\x01\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x01\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x01\x00\x01\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x01\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x01\x00\x02\x00\x02\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00",
+					alts:      [][]int{{}, {1}, {0}},
+					expected:  [][]string{{"[\\pL_]", "[\\p{Nd}]"}, {"[\\pL_]"}, {"[\\p{Nd}]"}},
+				},
 			},
 		},
 		{
 			name: "LitMatcher",
-			pos:  position{line: 213, col: 1, offset: 5880},
+			pos:  position{line: 343, col: 1, offset: 10589},
+			id:   37,
 			expr: &actionExpr{
-				pos: position{line: 213, col: 14, offset: 5895},
+				pos: position{line: 343, col: 14, offset: 10604},
+				id:  408,
 				run: (*parser).callonLitMatcher1,
 				expr: &seqExpr{
-					pos: position{line: 213, col: 14, offset: 5895},
+					pos: position{line: 343, col: 14, offset: 10604},
+					id:  409,
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 213, col: 14, offset: 5895},
+							pos:   position{line: 343, col: 14, offset: 10604},
+							id:    410,
 							label: "lit",
 							expr: &ruleRefExpr{
-								pos:  position{line: 213, col: 18, offset: 5899},
+								pos:  position{line: 343, col: 18, offset: 10608},
+								id:   411,
 								name: "StringLiteral",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 213, col: 32, offset: 5913},
+							pos:   position{line: 343, col: 32, offset: 10622},
+							id:    412,
 							label: "ignore",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 213, col: 39, offset: 5920},
+								pos: position{line: 343, col: 39, offset: 10629},
+								id:  413,
 								expr: &litMatcher{
-									pos:        position{line: 213, col: 39, offset: 5920},
+									pos:        position{line: 343, col: 39, offset: 10629},
+									id:         414,
 									val:        "i",
 									ignoreCase: false,
 									want:       "\"i\"",
@@ -1113,34 +2111,43 @@ var g = &grammar{
 		},
 		{
 			name: "StringLiteral",
-			pos:  position{line: 226, col: 1, offset: 6319},
+			pos:  position{line: 356, col: 1, offset: 11028},
+			id:   38,
 			expr: &choiceExpr{
-				pos: position{line: 226, col: 17, offset: 6337},
+				pos: position{line: 356, col: 17, offset: 11046},
+				id:  415,
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 226, col: 17, offset: 6337},
+						pos: position{line: 356, col: 17, offset: 11046},
+						id:  416,
 						run: (*parser).callonStringLiteral2,
 						expr: &choiceExpr{
-							pos: position{line: 226, col: 19, offset: 6339},
+							pos: position{line: 356, col: 19, offset: 11048},
+							id:  417,
 							alternatives: []any{
 								&seqExpr{
-									pos: position{line: 226, col: 19, offset: 6339},
+									pos: position{line: 356, col: 19, offset: 11048},
+									id:  418,
 									exprs: []any{
 										&litMatcher{
-											pos:        position{line: 226, col: 19, offset: 6339},
+											pos:        position{line: 356, col: 19, offset: 11048},
+											id:         419,
 											val:        "\"",
 											ignoreCase: false,
 											want:       "\"\\\"\"",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 226, col: 23, offset: 6343},
+											pos: position{line: 356, col: 23, offset: 11052},
+											id:  420,
 											expr: &ruleRefExpr{
-												pos:  position{line: 226, col: 23, offset: 6343},
+												pos:  position{line: 356, col: 23, offset: 11052},
+												id:   421,
 												name: "DoubleStringChar",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 226, col: 41, offset: 6361},
+											pos:        position{line: 356, col: 41, offset: 11070},
+											id:         422,
 											val:        "\"",
 											ignoreCase: false,
 											want:       "\"\\\"\"",
@@ -1148,20 +2155,24 @@ var g = &grammar{
 									},
 								},
 								&seqExpr{
-									pos: position{line: 226, col: 47, offset: 6367},
+									pos: position{line: 356, col: 47, offset: 11076},
+									id:  423,
 									exprs: []any{
 										&litMatcher{
-											pos:        position{line: 226, col: 47, offset: 6367},
+											pos:        position{line: 356, col: 47, offset: 11076},
+											id:         424,
 											val:        "'",
 											ignoreCase: false,
 											want:       "\"'\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 226, col: 51, offset: 6371},
+											pos:  position{line: 356, col: 51, offset: 11080},
+											id:   425,
 											name: "SingleStringChar",
 										},
 										&litMatcher{
-											pos:        position{line: 226, col: 68, offset: 6388},
+											pos:        position{line: 356, col: 68, offset: 11097},
+											id:         426,
 											val:        "'",
 											ignoreCase: false,
 											want:       "\"'\"",
@@ -1169,23 +2180,28 @@ var g = &grammar{
 									},
 								},
 								&seqExpr{
-									pos: position{line: 226, col: 74, offset: 6394},
+									pos: position{line: 356, col: 74, offset: 11103},
+									id:  427,
 									exprs: []any{
 										&litMatcher{
-											pos:        position{line: 226, col: 74, offset: 6394},
+											pos:        position{line: 356, col: 74, offset: 11103},
+											id:         428,
 											val:        "`",
 											ignoreCase: false,
 											want:       "\"`\"",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 226, col: 78, offset: 6398},
+											pos: position{line: 356, col: 78, offset: 11107},
+											id:  429,
 											expr: &ruleRefExpr{
-												pos:  position{line: 226, col: 78, offset: 6398},
+												pos:  position{line: 356, col: 78, offset: 11107},
+												id:   430,
 												name: "RawStringChar",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 226, col: 93, offset: 6413},
+											pos:        position{line: 356, col: 93, offset: 11122},
+											id:         431,
 											val:        "`",
 											ignoreCase: false,
 											want:       "\"`\"",
@@ -1193,235 +2209,348 @@ var g = &grammar{
 									},
 								},
 							},
+							dispatch: &choiceDispatch{
+								ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+								ranges:    []rune{'\u0080'},
+								rangeSets: "\x00",
+								alts:      [][]int{{}, {0}, {1}, {2}},
+								expected:  [][]string{{"\"\\\"\"", "\"'\"", "\"`\""}, {"\"'\"", "\"`\""}, {"\"\\\"\"", "\"`\""}, {"\"\\\"\"", "\"'\""}},
+							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 228, col: 5, offset: 6486},
+						pos: position{line: 358, col: 5, offset: 11195},
+						id:  432,
 						run: (*parser).callonStringLiteral18,
 						expr: &choiceExpr{
-							pos: position{line: 228, col: 7, offset: 6488},
+							pos: position{line: 358, col: 7, offset: 11197},
+							id:  433,
 							alternatives: []any{
 								&seqExpr{
-									pos: position{line: 228, col: 9, offset: 6490},
+									pos: position{line: 358, col: 9, offset: 11199},
+									id:  434,
 									exprs: []any{
 										&litMatcher{
-											pos:        position{line: 228, col: 9, offset: 6490},
+											pos:        position{line: 358, col: 9, offset: 11199},
+											id:         435,
 											val:        "\"",
 											ignoreCase: false,
 											want:       "\"\\\"\"",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 228, col: 13, offset: 6494},
+											pos: position{line: 358, col: 13, offset: 11203},
+											id:  436,
 											expr: &ruleRefExpr{
-												pos:  position{line: 228, col: 13, offset: 6494},
+												pos:  position{line: 358, col: 13, offset: 11203},
+												id:   437,
 												name: "DoubleStringChar",
 											},
 										},
 										&choiceExpr{
-											pos: position{line: 228, col: 33, offset: 6514},
+											pos: position{line: 358, col: 33, offset: 11223},
+											id:  438,
 											alternatives: []any{
 												&ruleRefExpr{
-													pos:  position{line: 228, col: 33, offset: 6514},
+													pos:  position{line: 358, col: 33, offset: 11223},
+													id:   439,
 													name: "EOL",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 228, col: 39, offset: 6520},
+													pos:  position{line: 358, col: 39, offset: 11229},
+													id:   440,
 													name: "EOF",
 												},
 											},
+											dispatch: &choiceDispatch{
+												ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+												ranges:    []rune{'\u0080'},
+												rangeSets: "\x00",
+												alts:      [][]int{{1}, {0, 1}},
+												expected:  [][]string{{"\"\\n\""}, {}},
+											},
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 228, col: 51, offset: 6532},
+									pos: position{line: 358, col: 51, offset: 11241},
+									id:  441,
 									exprs: []any{
 										&litMatcher{
-											pos:        position{line: 228, col: 51, offset: 6532},
+											pos:        position{line: 358, col: 51, offset: 11241},
+											id:         442,
 											val:        "'",
 											ignoreCase: false,
 											want:       "\"'\"",
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 228, col: 55, offset: 6536},
+											pos: position{line: 358, col: 55, offset: 11245},
+											id:  443,
 											expr: &ruleRefExpr{
-												pos:  position{line: 228, col: 55, offset: 6536},
+												pos:  position{line: 358, col: 55, offset: 11245},
+												id:   444,
 												name: "SingleStringChar",
 											},
 										},
 										&choiceExpr{
-											pos: position{line: 228, col: 75, offset: 6556},
+											pos: position{line: 358, col: 75, offset: 11265},
+											id:  445,
 											alternatives: []any{
 												&ruleRefExpr{
-													pos:  position{line: 228, col: 75, offset: 6556},
+													pos:  position{line: 358, col: 75, offset: 11265},
+													id:   446,
 													name: "EOL",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 228, col: 81, offset: 6562},
+													pos:  position{line: 358, col: 81, offset: 11271},
+													id:   447,
 													name: "EOF",
 												},
 											},
+											dispatch: &choiceDispatch{
+												ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+												ranges:    []rune{'\u0080'},
+												rangeSets: "\x00",
+												alts:      [][]int{{1}, {0, 1}},
+												expected:  [][]string{{"\"\\n\""}, {}},
+											},
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 228, col: 91, offset: 6572},
+									pos: position{line: 358, col: 91, offset: 11281},
+									id:  448,
 									exprs: []any{
 										&litMatcher{
-											pos:        position{line: 228, col: 91, offset: 6572},
+											pos:        position{line: 358, col: 91, offset: 11281},
+											id:         449,
 											val:        "`",
 											ignoreCase: false,
 											want:       "\"`\"",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 228, col: 95, offset: 6576},
+											pos: position{line: 358, col: 95, offset: 11285},
+											id:  450,
 											expr: &ruleRefExpr{
-												pos:  position{line: 228, col: 95, offset: 6576},
+												pos:  position{line: 358, col: 95, offset: 11285},
+												id:   451,
 												name: "RawStringChar",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 228, col: 110, offset: 6591},
+											pos:  position{line: 358, col: 110, offset: 11300},
+											id:   452,
 											name: "EOF",
 										},
 									},
 								},
 							},
+							dispatch: &choiceDispatch{
+								ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+								ranges:    []rune{'\u0080'},
+								rangeSets: "\x00",
+								alts:      [][]int{{}, {0}, {1}, {2}},
+								expected:  [][]string{{"\"\\\"\"", "\"'\"", "\"`\""}, {"\"'\"", "\"`\""}, {"\"\\\"\"", "\"`\""}, {"\"\\\"\"", "\"'\""}},
+							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {0, 1}},
+					expected:  [][]string{{"\"\\\"\"", "\"'\"", "\"`\""}, {}},
+				},
 			},
 		},
 		{
 			name: "DoubleStringChar",
-			pos:  position{line: 232, col: 1, offset: 6693},
+			pos:  position{line: 362, col: 1, offset: 11402},
+			id:   39,
 			expr: &choiceExpr{
-				pos: position{line: 232, col: 20, offset: 6714},
+				pos: position{line: 362, col: 20, offset: 11423},
+				id:  453,
 				alternatives: []any{
 					&seqExpr{
-						pos: position{line: 232, col: 20, offset: 6714},
+						pos: position{line: 362, col: 20, offset: 11423},
+						id:  454,
 						exprs: []any{
 							&notExpr{
-								pos: position{line: 232, col: 20, offset: 6714},
+								pos: position{line: 362, col: 20, offset: 11423},
+								id:  455,
 								expr: &choiceExpr{
-									pos: position{line: 232, col: 23, offset: 6717},
+									pos: position{line: 362, col: 23, offset: 11426},
+									id:  456,
 									alternatives: []any{
 										&litMatcher{
-											pos:        position{line: 232, col: 23, offset: 6717},
+											pos:        position{line: 362, col: 23, offset: 11426},
+											id:         457,
 											val:        "\"",
 											ignoreCase: false,
 											want:       "\"\\\"\"",
 										},
 										&litMatcher{
-											pos:        position{line: 232, col: 29, offset: 6723},
+											pos:        position{line: 362, col: 29, offset: 11432},
+											id:         458,
 											val:        "\\",
 											ignoreCase: false,
 											want:       "\"\\\\\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 232, col: 36, offset: 6730},
+											pos:  position{line: 362, col: 36, offset: 11439},
+											id:   459,
 											name: "EOL",
 										},
 									},
+									dispatch: &choiceDispatch{
+										ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x00",
+										alts:      [][]int{{}, {2}, {0}, {1}},
+										expected:  [][]string{{"\"\\\"\"", "\"\\\\\"", "\"\\n\""}, {"\"\\\"\"", "\"\\\\\""}, {"\"\\\\\"", "\"\\n\""}, {"\"\\\"\"", "\"\\n\""}},
+									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 232, col: 42, offset: 6736},
+								pos:  position{line: 362, col: 42, offset: 11445},
+								id:   460,
 								name: "SourceChar",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 232, col: 55, offset: 6749},
+						pos: position{line: 362, col: 55, offset: 11458},
+						id:  461,
 						exprs: []any{
 							&litMatcher{
-								pos:        position{line: 232, col: 55, offset: 6749},
+								pos:        position{line: 362, col: 55, offset: 11458},
+								id:         462,
 								val:        "\\",
 								ignoreCase: false,
 								want:       "\"\\\\\"",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 232, col: 60, offset: 6754},
+								pos:  position{line: 362, col: 60, offset: 11463},
+								id:   463,
 								name: "DoubleStringEscape",
 							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{0}, {0, 1}},
+					expected:  [][]string{{"\"\\\\\""}, {}},
+				},
 			},
 		},
 		{
 			name: "SingleStringChar",
-			pos:  position{line: 233, col: 1, offset: 6773},
+			pos:  position{line: 363, col: 1, offset: 11482},
+			id:   40,
 			expr: &choiceExpr{
-				pos: position{line: 233, col: 20, offset: 6794},
+				pos: position{line: 363, col: 20, offset: 11503},
+				id:  464,
 				alternatives: []any{
 					&seqExpr{
-						pos: position{line: 233, col: 20, offset: 6794},
+						pos: position{line: 363, col: 20, offset: 11503},
+						id:  465,
 						exprs: []any{
 							&notExpr{
-								pos: position{line: 233, col: 20, offset: 6794},
+								pos: position{line: 363, col: 20, offset: 11503},
+								id:  466,
 								expr: &choiceExpr{
-									pos: position{line: 233, col: 23, offset: 6797},
+									pos: position{line: 363, col: 23, offset: 11506},
+									id:  467,
 									alternatives: []any{
 										&litMatcher{
-											pos:        position{line: 233, col: 23, offset: 6797},
+											pos:        position{line: 363, col: 23, offset: 11506},
+											id:         468,
 											val:        "'",
 											ignoreCase: false,
 											want:       "\"'\"",
 										},
 										&litMatcher{
-											pos:        position{line: 233, col: 29, offset: 6803},
+											pos:        position{line: 363, col: 29, offset: 11512},
+											id:         469,
 											val:        "\\",
 											ignoreCase: false,
 											want:       "\"\\\\\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 233, col: 36, offset: 6810},
+											pos:  position{line: 363, col: 36, offset: 11519},
+											id:   470,
 											name: "EOL",
 										},
 									},
+									dispatch: &choiceDispatch{
+										ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x00",
+										alts:      [][]int{{}, {2}, {0}, {1}},
+										expected:  [][]string{{"\"'\"", "\"\\\\\"", "\"\\n\""}, {"\"'\"", "\"\\\\\""}, {"\"\\\\\"", "\"\\n\""}, {"\"'\"", "\"\\n\""}},
+									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 233, col: 42, offset: 6816},
+								pos:  position{line: 363, col: 42, offset: 11525},
+								id:   471,
 								name: "SourceChar",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 233, col: 55, offset: 6829},
+						pos: position{line: 363, col: 55, offset: 11538},
+						id:  472,
 						exprs: []any{
 							&litMatcher{
-								pos:        position{line: 233, col: 55, offset: 6829},
+								pos:        position{line: 363, col: 55, offset: 11538},
+								id:         473,
 								val:        "\\",
 								ignoreCase: false,
 								want:       "\"\\\\\"",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 233, col: 60, offset: 6834},
+								pos:  position{line: 363, col: 60, offset: 11543},
+								id:   474,
 								name: "SingleStringEscape",
 							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{0}, {0, 1}},
+					expected:  [][]string{{"\"\\\\\""}, {}},
+				},
 			},
 		},
 		{
 			name: "RawStringChar",
-			pos:  position{line: 234, col: 1, offset: 6853},
+			pos:  position{line: 364, col: 1, offset: 11562},
+			id:   41,
 			expr: &seqExpr{
-				pos: position{line: 234, col: 17, offset: 6871},
+				pos: position{line: 364, col: 17, offset: 11580},
+				id:  475,
 				exprs: []any{
 					&notExpr{
-						pos: position{line: 234, col: 17, offset: 6871},
+						pos: position{line: 364, col: 17, offset: 11580},
+						id:  476,
 						expr: &litMatcher{
-							pos:        position{line: 234, col: 18, offset: 6872},
+							pos:        position{line: 364, col: 18, offset: 11581},
+							id:         477,
 							val:        "`",
 							ignoreCase: false,
 							want:       "\"`\"",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 234, col: 22, offset: 6876},
+						pos:  position{line: 364, col: 22, offset: 11585},
+						id:   478,
 						name: "SourceChar",
 					},
 				},
@@ -1429,461 +2558,674 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleStringEscape",
-			pos:  position{line: 236, col: 1, offset: 6888},
+			pos:  position{line: 366, col: 1, offset: 11597},
+			id:   42,
 			expr: &choiceExpr{
-				pos: position{line: 236, col: 22, offset: 6911},
+				pos: position{line: 366, col: 22, offset: 11620},
+				id:  479,
 				alternatives: []any{
 					&choiceExpr{
-						pos: position{line: 236, col: 24, offset: 6913},
+						pos: position{line: 366, col: 24, offset: 11622},
+						id:  480,
 						alternatives: []any{
 							&litMatcher{
-								pos:        position{line: 236, col: 24, offset: 6913},
+								pos:        position{line: 366, col: 24, offset: 11622},
+								id:         481,
 								val:        "\"",
 								ignoreCase: false,
 								want:       "\"\\\"\"",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 236, col: 30, offset: 6919},
+								pos:  position{line: 366, col: 30, offset: 11628},
+								id:   482,
 								name: "CommonEscapeSequence",
 							},
 						},
+						dispatch: &choiceDispatch{
+							ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x02\x02\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x02\x00\x02\x02\x02\x00\x02\x00\x00\x00\x00\x00\x00\x00",
+							ranges:    []rune{'\u0080'},
+							rangeSets: "\x00",
+							alts:      [][]int{{}, {0}, {1}},
+							expected:  [][]string{{"\"\\\"\"", "\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}, {"\"\\\"\""}},
+						},
 					},
 					&actionExpr{
-						pos: position{line: 237, col: 7, offset: 6948},
+						pos: position{line: 367, col: 7, offset: 11657},
+						id:  483,
 						run: (*parser).callonDoubleStringEscape5,
 						expr: &choiceExpr{
-							pos: position{line: 237, col: 9, offset: 6950},
+							pos: position{line: 367, col: 9, offset: 11659},
+							id:  484,
 							alternatives: []any{
 								&ruleRefExpr{
-									pos:  position{line: 237, col: 9, offset: 6950},
+									pos:  position{line: 367, col: 9, offset: 11659},
+									id:   485,
 									name: "SourceChar",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 237, col: 22, offset: 6963},
+									pos:  position{line: 367, col: 22, offset: 11672},
+									id:   486,
 									name: "EOL",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 237, col: 28, offset: 6969},
+									pos:  position{line: 367, col: 28, offset: 11678},
+									id:   487,
 									name: "EOF",
 								},
 							},
+							dispatch: &choiceDispatch{
+								ascii:     "\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x02\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01",
+								ranges:    []rune{'\u0080'},
+								rangeSets: "\x01",
+								alts:      [][]int{{2}, {0, 2}, {0, 1, 2}},
+								expected:  [][]string{{".", "\"\\n\""}, {"\"\\n\""}, {}},
+							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x01\x01\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01\x00\x01\x01\x01\x00\x01\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{1}, {0, 1}},
+					expected:  [][]string{{"\"\\\"\"", "\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}, {}},
+				},
 			},
 		},
 		{
 			name: "SingleStringEscape",
-			pos:  position{line: 240, col: 1, offset: 7034},
+			pos:  position{line: 370, col: 1, offset: 11743},
+			id:   43,
 			expr: &choiceExpr{
-				pos: position{line: 240, col: 22, offset: 7057},
+				pos: position{line: 370, col: 22, offset: 11766},
+				id:  488,
 				alternatives: []any{
 					&choiceExpr{
-						pos: position{line: 240, col: 24, offset: 7059},
+						pos: position{line: 370, col: 24, offset: 11768},
+						id:  489,
 						alternatives: []any{
 							&litMatcher{
-								pos:        position{line: 240, col: 24, offset: 7059},
+								pos:        position{line: 370, col: 24, offset: 11768},
+								id:         490,
 								val:        "'",
 								ignoreCase: false,
 								want:       "\"'\"",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 240, col: 30, offset: 7065},
+								pos:  position{line: 370, col: 30, offset: 11774},
+								id:   491,
 								name: "CommonEscapeSequence",
 							},
 						},
+						dispatch: &choiceDispatch{
+							ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x02\x02\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x02\x00\x02\x02\x02\x00\x02\x00\x00\x00\x00\x00\x00\x00",
+							ranges:    []rune{'\u0080'},
+							rangeSets: "\x00",
+							alts:      [][]int{{}, {0}, {1}},
+							expected:  [][]string{{"\"'\"", "\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}, {"\"'\""}},
+						},
 					},
 					&actionExpr{
-						pos: position{line: 241, col: 7, offset: 7094},
+						pos: position{line: 371, col: 7, offset: 11803},
+						id:  492,
 						run: (*parser).callonSingleStringEscape5,
 						expr: &choiceExpr{
-							pos: position{line: 241, col: 9, offset: 7096},
+							pos: position{line: 371, col: 9, offset: 11805},
+							id:  493,
 							alternatives: []any{
 								&ruleRefExpr{
-									pos:  position{line: 241, col: 9, offset: 7096},
+									pos:  position{line: 371, col: 9, offset: 11805},
+									id:   494,
 									name: "SourceChar",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 241, col: 22, offset: 7109},
+									pos:  position{line: 371, col: 22, offset: 11818},
+									id:   495,
 									name: "EOL",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 241, col: 28, offset: 7115},
+									pos:  position{line: 371, col: 28, offset: 11824},
+									id:   496,
 									name: "EOF",
 								},
 							},
+							dispatch: &choiceDispatch{
+								ascii:     "\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x02\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01",
+								ranges:    []rune{'\u0080'},
+								rangeSets: "\x01",
+								alts:      [][]int{{2}, {0, 2}, {0, 1, 2}},
+								expected:  [][]string{{".", "\"\\n\""}, {"\"\\n\""}, {}},
+							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x01\x01\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01\x00\x01\x01\x01\x00\x01\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{1}, {0, 1}},
+					expected:  [][]string{{"\"'\"", "\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}, {}},
+				},
 			},
 		},
 		{
 			name: "CommonEscapeSequence",
-			pos:  position{line: 245, col: 1, offset: 7181},
+			pos:  position{line: 375, col: 1, offset: 11890},
+			id:   44,
 			expr: &choiceExpr{
-				pos: position{line: 245, col: 24, offset: 7206},
+				pos: position{line: 375, col: 24, offset: 11915},
+				id:  497,
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 245, col: 24, offset: 7206},
+						pos:  position{line: 375, col: 24, offset: 11915},
+						id:   498,
 						name: "SingleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 245, col: 43, offset: 7225},
+						pos:  position{line: 375, col: 43, offset: 11934},
+						id:   499,
 						name: "OctalEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 245, col: 57, offset: 7239},
+						pos:  position{line: 375, col: 57, offset: 11948},
+						id:   500,
 						name: "HexEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 245, col: 69, offset: 7251},
+						pos:  position{line: 375, col: 69, offset: 11960},
+						id:   501,
 						name: "LongUnicodeEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 245, col: 89, offset: 7271},
+						pos:  position{line: 375, col: 89, offset: 11980},
+						id:   502,
 						name: "ShortUnicodeEscape",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x03\x03\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x03\x00\x03\x04\x03\x00\x05\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {1}, {3}, {0}, {4}, {2}},
+					expected:  [][]string{{"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "\"x\"", "\"U\"", "\"u\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"u\""}, {"[0-7]", "\"x\"", "\"U\"", "\"u\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"U\"", "\"u\""}},
+				},
 			},
 		},
 		{
 			name: "SingleCharEscape",
-			pos:  position{line: 246, col: 1, offset: 7290},
+			pos:  position{line: 376, col: 1, offset: 11999},
+			id:   45,
 			expr: &choiceExpr{
-				pos: position{line: 246, col: 20, offset: 7311},
+				pos: position{line: 376, col: 20, offset: 12020},
+				id:  503,
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 246, col: 20, offset: 7311},
+						pos:        position{line: 376, col: 20, offset: 12020},
+						id:         504,
 						val:        "a",
 						ignoreCase: false,
 						want:       "\"a\"",
 					},
 					&litMatcher{
-						pos:        position{line: 246, col: 26, offset: 7317},
+						pos:        position{line: 376, col: 26, offset: 12026},
+						id:         505,
 						val:        "b",
 						ignoreCase: false,
 						want:       "\"b\"",
 					},
 					&litMatcher{
-						pos:        position{line: 246, col: 32, offset: 7323},
+						pos:        position{line: 376, col: 32, offset: 12032},
+						id:         506,
 						val:        "n",
 						ignoreCase: false,
 						want:       "\"n\"",
 					},
 					&litMatcher{
-						pos:        position{line: 246, col: 38, offset: 7329},
+						pos:        position{line: 376, col: 38, offset: 12038},
+						id:         507,
 						val:        "f",
 						ignoreCase: false,
 						want:       "\"f\"",
 					},
 					&litMatcher{
-						pos:        position{line: 246, col: 44, offset: 7335},
+						pos:        position{line: 376, col: 44, offset: 12044},
+						id:         508,
 						val:        "r",
 						ignoreCase: false,
 						want:       "\"r\"",
 					},
 					&litMatcher{
-						pos:        position{line: 246, col: 50, offset: 7341},
+						pos:        position{line: 376, col: 50, offset: 12050},
+						id:         509,
 						val:        "t",
 						ignoreCase: false,
 						want:       "\"t\"",
 					},
 					&litMatcher{
-						pos:        position{line: 246, col: 56, offset: 7347},
+						pos:        position{line: 376, col: 56, offset: 12056},
+						id:         510,
 						val:        "v",
 						ignoreCase: false,
 						want:       "\"v\"",
 					},
 					&litMatcher{
-						pos:        position{line: 246, col: 62, offset: 7353},
+						pos:        position{line: 376, col: 62, offset: 12062},
+						id:         511,
 						val:        "\\",
 						ignoreCase: false,
 						want:       "\"\\\\\"",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x02\x03\x00\x00\x00\x04\x00\x00\x00\x00\x00\x00\x00\x05\x00\x00\x00\x06\x00\a\x00\b\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {7}, {0}, {1}, {3}, {2}, {4}, {5}, {6}},
+					expected:  [][]string{{"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\""}, {"\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\""}, {"\"a\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\""}, {"\"a\"", "\"b\"", "\"n\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\""}, {"\"a\"", "\"b\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"t\"", "\"v\"", "\"\\\\\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"v\"", "\"\\\\\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"\\\\\""}},
+				},
 			},
 		},
 		{
 			name: "OctalEscape",
-			pos:  position{line: 247, col: 1, offset: 7358},
+			pos:  position{line: 377, col: 1, offset: 12067},
+			id:   46,
 			expr: &choiceExpr{
-				pos: position{line: 247, col: 15, offset: 7374},
+				pos: position{line: 377, col: 15, offset: 12083},
+				id:  512,
 				alternatives: []any{
 					&seqExpr{
-						pos: position{line: 247, col: 15, offset: 7374},
+						pos: position{line: 377, col: 15, offset: 12083},
+						id:  513,
 						exprs: []any{
 							&ruleRefExpr{
-								pos:  position{line: 247, col: 15, offset: 7374},
+								pos:  position{line: 377, col: 15, offset: 12083},
+								id:   514,
 								name: "OctalDigit",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 247, col: 26, offset: 7385},
+								pos:  position{line: 377, col: 26, offset: 12094},
+								id:   515,
 								name: "OctalDigit",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 247, col: 37, offset: 7396},
+								pos:  position{line: 377, col: 37, offset: 12105},
+								id:   516,
 								name: "OctalDigit",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 248, col: 7, offset: 7413},
+						pos: position{line: 378, col: 7, offset: 12122},
+						id:  517,
 						run: (*parser).callonOctalEscape6,
 						expr: &seqExpr{
-							pos: position{line: 248, col: 7, offset: 7413},
+							pos: position{line: 378, col: 7, offset: 12122},
+							id:  518,
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 248, col: 7, offset: 7413},
+									pos:  position{line: 378, col: 7, offset: 12122},
+									id:   519,
 									name: "OctalDigit",
 								},
 								&choiceExpr{
-									pos: position{line: 248, col: 20, offset: 7426},
+									pos: position{line: 378, col: 20, offset: 12135},
+									id:  520,
 									alternatives: []any{
 										&ruleRefExpr{
-											pos:  position{line: 248, col: 20, offset: 7426},
+											pos:  position{line: 378, col: 20, offset: 12135},
+											id:   521,
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 248, col: 33, offset: 7439},
+											pos:  position{line: 378, col: 33, offset: 12148},
+											id:   522,
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 248, col: 39, offset: 7445},
+											pos:  position{line: 378, col: 39, offset: 12154},
+											id:   523,
 											name: "EOF",
 										},
 									},
+									dispatch: &choiceDispatch{
+										ascii:     "\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x02\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x01",
+										alts:      [][]int{{2}, {0, 2}, {0, 1, 2}},
+										expected:  [][]string{{".", "\"\\n\""}, {"\"\\n\""}, {}},
+									},
 								},
 							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {0, 1}},
+					expected:  [][]string{{"[0-7]"}, {}},
+				},
 			},
 		},
 		{
 			name: "HexEscape",
-			pos:  position{line: 251, col: 1, offset: 7506},
+			pos:  position{line: 381, col: 1, offset: 12215},
+			id:   47,
 			expr: &choiceExpr{
-				pos: position{line: 251, col: 13, offset: 7520},
+				pos: position{line: 381, col: 13, offset: 12229},
+				id:  524,
 				alternatives: []any{
 					&seqExpr{
-						pos: position{line: 251, col: 13, offset: 7520},
+						pos: position{line: 381, col: 13, offset: 12229},
+						id:  525,
 						exprs: []any{
 							&litMatcher{
-								pos:        position{line: 251, col: 13, offset: 7520},
+								pos:        position{line: 381, col: 13, offset: 12229},
+								id:         526,
 								val:        "x",
 								ignoreCase: false,
 								want:       "\"x\"",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 251, col: 17, offset: 7524},
+								pos:  position{line: 381, col: 17, offset: 12233},
+								id:   527,
 								name: "HexDigit",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 251, col: 26, offset: 7533},
+								pos:  position{line: 381, col: 26, offset: 12242},
+								id:   528,
 								name: "HexDigit",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 252, col: 7, offset: 7548},
+						pos: position{line: 382, col: 7, offset: 12257},
+						id:  529,
 						run: (*parser).callonHexEscape6,
 						expr: &seqExpr{
-							pos: position{line: 252, col: 7, offset: 7548},
+							pos: position{line: 382, col: 7, offset: 12257},
+							id:  530,
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 252, col: 7, offset: 7548},
+									pos:        position{line: 382, col: 7, offset: 12257},
+									id:         531,
 									val:        "x",
 									ignoreCase: false,
 									want:       "\"x\"",
 								},
 								&choiceExpr{
-									pos: position{line: 252, col: 13, offset: 7554},
+									pos: position{line: 382, col: 13, offset: 12263},
+									id:  532,
 									alternatives: []any{
 										&ruleRefExpr{
-											pos:  position{line: 252, col: 13, offset: 7554},
+											pos:  position{line: 382, col: 13, offset: 12263},
+											id:   533,
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 252, col: 26, offset: 7567},
+											pos:  position{line: 382, col: 26, offset: 12276},
+											id:   534,
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 252, col: 32, offset: 7573},
+											pos:  position{line: 382, col: 32, offset: 12282},
+											id:   535,
 											name: "EOF",
 										},
 									},
+									dispatch: &choiceDispatch{
+										ascii:     "\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x02\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x01",
+										alts:      [][]int{{2}, {0, 2}, {0, 1, 2}},
+										expected:  [][]string{{".", "\"\\n\""}, {"\"\\n\""}, {}},
+									},
 								},
 							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {0, 1}},
+					expected:  [][]string{{"\"x\""}, {}},
+				},
 			},
 		},
 		{
 			name: "LongUnicodeEscape",
-			pos:  position{line: 255, col: 1, offset: 7640},
+			pos:  position{line: 385, col: 1, offset: 12349},
+			id:   48,
 			expr: &choiceExpr{
-				pos: position{line: 256, col: 5, offset: 7666},
+				pos: position{line: 386, col: 5, offset: 12375},
+				id:  536,
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 256, col: 5, offset: 7666},
+						pos: position{line: 386, col: 5, offset: 12375},
+						id:  537,
 						run: (*parser).callonLongUnicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 256, col: 5, offset: 7666},
+							pos: position{line: 386, col: 5, offset: 12375},
+							id:  538,
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 256, col: 5, offset: 7666},
+									pos:        position{line: 386, col: 5, offset: 12375},
+									id:         539,
 									val:        "U",
 									ignoreCase: false,
 									want:       "\"U\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 256, col: 9, offset: 7670},
+									pos:  position{line: 386, col: 9, offset: 12379},
+									id:   540,
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 256, col: 18, offset: 7679},
+									pos:  position{line: 386, col: 18, offset: 12388},
+									id:   541,
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 256, col: 27, offset: 7688},
+									pos:  position{line: 386, col: 27, offset: 12397},
+									id:   542,
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 256, col: 36, offset: 7697},
+									pos:  position{line: 386, col: 36, offset: 12406},
+									id:   543,
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 256, col: 45, offset: 7706},
+									pos:  position{line: 386, col: 45, offset: 12415},
+									id:   544,
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 256, col: 54, offset: 7715},
+									pos:  position{line: 386, col: 54, offset: 12424},
+									id:   545,
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 256, col: 63, offset: 7724},
+									pos:  position{line: 386, col: 63, offset: 12433},
+									id:   546,
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 256, col: 72, offset: 7733},
+									pos:  position{line: 386, col: 72, offset: 12442},
+									id:   547,
 									name: "HexDigit",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 259, col: 7, offset: 7835},
+						pos: position{line: 389, col: 7, offset: 12544},
+						id:  548,
 						run: (*parser).callonLongUnicodeEscape13,
 						expr: &seqExpr{
-							pos: position{line: 259, col: 7, offset: 7835},
+							pos: position{line: 389, col: 7, offset: 12544},
+							id:  549,
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 259, col: 7, offset: 7835},
+									pos:        position{line: 389, col: 7, offset: 12544},
+									id:         550,
 									val:        "U",
 									ignoreCase: false,
 									want:       "\"U\"",
 								},
 								&choiceExpr{
-									pos: position{line: 259, col: 13, offset: 7841},
+									pos: position{line: 389, col: 13, offset: 12550},
+									id:  551,
 									alternatives: []any{
 										&ruleRefExpr{
-											pos:  position{line: 259, col: 13, offset: 7841},
+											pos:  position{line: 389, col: 13, offset: 12550},
+											id:   552,
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 259, col: 26, offset: 7854},
+											pos:  position{line: 389, col: 26, offset: 12563},
+											id:   553,
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 259, col: 32, offset: 7860},
+											pos:  position{line: 389, col: 32, offset: 12569},
+											id:   554,
 											name: "EOF",
 										},
 									},
+									dispatch: &choiceDispatch{
+										ascii:     "\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x02\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x01",
+										alts:      [][]int{{2}, {0, 2}, {0, 1, 2}},
+										expected:  [][]string{{".", "\"\\n\""}, {"\"\\n\""}, {}},
+									},
 								},
 							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {0, 1}},
+					expected:  [][]string{{"\"U\""}, {}},
+				},
 			},
 		},
 		{
 			name: "ShortUnicodeEscape",
-			pos:  position{line: 262, col: 1, offset: 7923},
+			pos:  position{line: 392, col: 1, offset: 12632},
+			id:   49,
 			expr: &choiceExpr{
-				pos: position{line: 263, col: 5, offset: 7950},
+				pos: position{line: 393, col: 5, offset: 12659},
+				id:  555,
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 263, col: 5, offset: 7950},
+						pos: position{line: 393, col: 5, offset: 12659},
+						id:  556,
 						run: (*parser).callonShortUnicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 263, col: 5, offset: 7950},
+							pos: position{line: 393, col: 5, offset: 12659},
+							id:  557,
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 263, col: 5, offset: 7950},
+									pos:        position{line: 393, col: 5, offset: 12659},
+									id:         558,
 									val:        "u",
 									ignoreCase: false,
 									want:       "\"u\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 263, col: 9, offset: 7954},
+									pos:  position{line: 393, col: 9, offset: 12663},
+									id:   559,
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 263, col: 18, offset: 7963},
+									pos:  position{line: 393, col: 18, offset: 12672},
+									id:   560,
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 263, col: 27, offset: 7972},
+									pos:  position{line: 393, col: 27, offset: 12681},
+									id:   561,
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 263, col: 36, offset: 7981},
+									pos:  position{line: 393, col: 36, offset: 12690},
+									id:   562,
 									name: "HexDigit",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 266, col: 7, offset: 8083},
+						pos: position{line: 396, col: 7, offset: 12792},
+						id:  563,
 						run: (*parser).callonShortUnicodeEscape9,
 						expr: &seqExpr{
-							pos: position{line: 266, col: 7, offset: 8083},
+							pos: position{line: 396, col: 7, offset: 12792},
+							id:  564,
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 266, col: 7, offset: 8083},
+									pos:        position{line: 396, col: 7, offset: 12792},
+									id:         565,
 									val:        "u",
 									ignoreCase: false,
 									want:       "\"u\"",
 								},
 								&choiceExpr{
-									pos: position{line: 266, col: 13, offset: 8089},
+									pos: position{line: 396, col: 13, offset: 12798},
+									id:  566,
 									alternatives: []any{
 										&ruleRefExpr{
-											pos:  position{line: 266, col: 13, offset: 8089},
+											pos:  position{line: 396, col: 13, offset: 12798},
+											id:   567,
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 266, col: 26, offset: 8102},
+											pos:  position{line: 396, col: 26, offset: 12811},
+											id:   568,
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 266, col: 32, offset: 8108},
+											pos:  position{line: 396, col: 32, offset: 12817},
+											id:   569,
 											name: "EOF",
 										},
 									},
+									dispatch: &choiceDispatch{
+										ascii:     "\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x02\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x01",
+										alts:      [][]int{{2}, {0, 2}, {0, 1, 2}},
+										expected:  [][]string{{".", "\"\\n\""}, {"\"\\n\""}, {}},
+									},
 								},
 							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {0, 1}},
+					expected:  [][]string{{"\"u\""}, {}},
+				},
 			},
 		},
 		{
 			name: "OctalDigit",
-			pos:  position{line: 270, col: 1, offset: 8172},
+			pos:  position{line: 400, col: 1, offset: 12881},
+			id:   50,
 			expr: &charClassMatcher{
-				pos:        position{line: 270, col: 14, offset: 8187},
+				pos:        position{line: 400, col: 14, offset: 12896},
+				id:         570,
 				val:        "[0-7]",
 				ranges:     []rune{'0', '7'},
 				ignoreCase: false,
@@ -1892,9 +3234,11 @@ var g = &grammar{
 		},
 		{
 			name: "DecimalDigit",
-			pos:  position{line: 271, col: 1, offset: 8193},
+			pos:  position{line: 401, col: 1, offset: 12902},
+			id:   51,
 			expr: &charClassMatcher{
-				pos:        position{line: 271, col: 16, offset: 8210},
+				pos:        position{line: 401, col: 16, offset: 12919},
+				id:         571,
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -1903,9 +3247,11 @@ var g = &grammar{
 		},
 		{
 			name: "HexDigit",
-			pos:  position{line: 272, col: 1, offset: 8216},
+			pos:  position{line: 402, col: 1, offset: 12925},
+			id:   52,
 			expr: &charClassMatcher{
-				pos:        position{line: 272, col: 12, offset: 8229},
+				pos:        position{line: 402, col: 12, offset: 12938},
+				id:         572,
 				val:        "[0-9a-f]i",
 				ranges:     []rune{'0', '9', 'a', 'f'},
 				ignoreCase: true,
@@ -1914,63 +3260,69 @@ var g = &grammar{
 		},
 		{
 			name: "CharClassMatcher",
-			pos:  position{line: 274, col: 1, offset: 8240},
+			pos:  position{line: 404, col: 1, offset: 12949},
+			id:   53,
 			expr: &choiceExpr{
-				pos: position{line: 274, col: 20, offset: 8261},
+				pos: position{line: 404, col: 20, offset: 12970},
+				id:  573,
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 274, col: 20, offset: 8261},
+						pos: position{line: 404, col: 20, offset: 12970},
+						id:  574,
 						run: (*parser).callonCharClassMatcher2,
 						expr: &seqExpr{
-							pos: position{line: 274, col: 20, offset: 8261},
+							pos: position{line: 404, col: 20, offset: 12970},
+							id:  575,
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 274, col: 20, offset: 8261},
+									pos:        position{line: 404, col: 20, offset: 12970},
+									id:         576,
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 274, col: 24, offset: 8265},
-									expr: &choiceExpr{
-										pos: position{line: 274, col: 26, offset: 8267},
-										alternatives: []any{
+									pos: position{line: 404, col: 24, offset: 12974},
+									id:  577,
+									expr: &ruleRefExpr{
+										pos:  position{line: 404, col: 24, offset: 12974},
+										id:   578,
+										name: "ClassItem",
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 404, col: 35, offset: 12985},
+									id:  579,
+									expr: &seqExpr{
+										pos: position{line: 404, col: 37, offset: 12987},
+										id:  580,
+										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 274, col: 26, offset: 8267},
-												name: "ClassCharRange",
+												pos:  position{line: 404, col: 37, offset: 12987},
+												id:   581,
+												name: "ClassSetOp",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 274, col: 43, offset: 8284},
-												name: "ClassChar",
-											},
-											&seqExpr{
-												pos: position{line: 274, col: 55, offset: 8296},
-												exprs: []any{
-													&litMatcher{
-														pos:        position{line: 274, col: 55, offset: 8296},
-														val:        "\\",
-														ignoreCase: false,
-														want:       "\"\\\\\"",
-													},
-													&ruleRefExpr{
-														pos:  position{line: 274, col: 60, offset: 8301},
-														name: "UnicodeClassEscape",
-													},
-												},
+												pos:  position{line: 404, col: 48, offset: 12998},
+												id:   582,
+												name: "ClassOperand",
 											},
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 274, col: 82, offset: 8323},
+									pos:        position{line: 404, col: 64, offset: 13014},
+									id:         583,
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 274, col: 86, offset: 8327},
+									pos: position{line: 404, col: 68, offset: 13018},
+									id:  584,
 									expr: &litMatcher{
-										pos:        position{line: 274, col: 86, offset: 8327},
+										pos:        position{line: 404, col: 68, offset: 13018},
+										id:         585,
 										val:        "i",
 										ignoreCase: false,
 										want:       "\"i\"",
@@ -1980,270 +3332,598 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 278, col: 5, offset: 8434},
-						run: (*parser).callonCharClassMatcher15,
+						pos: position{line: 408, col: 5, offset: 13125},
+						id:  586,
+						run: (*parser).callonCharClassMatcher14,
 						expr: &seqExpr{
-							pos: position{line: 278, col: 5, offset: 8434},
+							pos: position{line: 408, col: 5, offset: 13125},
+							id:  587,
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 278, col: 5, offset: 8434},
+									pos:        position{line: 408, col: 5, offset: 13125},
+									id:         588,
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 278, col: 9, offset: 8438},
+									pos: position{line: 408, col: 9, offset: 13129},
+									id:  589,
 									expr: &seqExpr{
-										pos: position{line: 278, col: 11, offset: 8440},
+										pos: position{line: 408, col: 11, offset: 13131},
+										id:  590,
 										exprs: []any{
 											&notExpr{
-												pos: position{line: 278, col: 11, offset: 8440},
+												pos: position{line: 408, col: 11, offset: 13131},
+												id:  591,
 												expr: &ruleRefExpr{
-													pos:  position{line: 278, col: 14, offset: 8443},
+													pos:  position{line: 408, col: 14, offset: 13134},
+													id:   592,
 													name: "EOL",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 278, col: 20, offset: 8449},
+												pos:  position{line: 408, col: 20, offset: 13140},
+												id:   593,
 												name: "SourceChar",
 											},
 										},
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 278, col: 36, offset: 8465},
+									pos: position{line: 408, col: 36, offset: 13156},
+									id:  594,
 									alternatives: []any{
 										&ruleRefExpr{
-											pos:  position{line: 278, col: 36, offset: 8465},
+											pos:  position{line: 408, col: 36, offset: 13156},
+											id:   595,
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 278, col: 42, offset: 8471},
+											pos:  position{line: 408, col: 42, offset: 13162},
+											id:   596,
 											name: "EOF",
 										},
 									},
+									dispatch: &choiceDispatch{
+										ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x00",
+										alts:      [][]int{{1}, {0, 1}},
+										expected:  [][]string{{"\"\\n\""}, {}},
+									},
 								},
 							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {0, 1}},
+					expected:  [][]string{{"\"[\""}, {}},
+				},
 			},
 		},
 		{
-			name: "ClassCharRange",
-			pos:  position{line: 282, col: 1, offset: 8581},
-			expr: &seqExpr{
-				pos: position{line: 282, col: 18, offset: 8600},
-				exprs: []any{
+			name: "ClassItem",
+			pos:  position{line: 412, col: 1, offset: 13272},
+			id:   54,
+			expr: &choiceExpr{
+				pos: position{line: 412, col: 13, offset: 13286},
+				id:  597,
+				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 282, col: 18, offset: 8600},
-						name: "ClassChar",
-					},
-					&litMatcher{
-						pos:        position{line: 282, col: 28, offset: 8610},
-						val:        "-",
-						ignoreCase: false,
-						want:       "\"-\"",
+						pos:  position{line: 412, col: 13, offset: 13286},
+						id:   598,
+						name: "ClassCharRange",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 282, col: 32, offset: 8614},
+						pos:  position{line: 412, col: 30, offset: 13303},
+						id:   599,
 						name: "ClassChar",
 					},
-				},
-			},
-		},
-		{
-			name: "ClassChar",
-			pos:  position{line: 283, col: 1, offset: 8624},
-			expr: &choiceExpr{
-				pos: position{line: 283, col: 13, offset: 8638},
-				alternatives: []any{
-					&seqExpr{
-						pos: position{line: 283, col: 13, offset: 8638},
-						exprs: []any{
-							&notExpr{
-								pos: position{line: 283, col: 13, offset: 8638},
-								expr: &choiceExpr{
-									pos: position{line: 283, col: 16, offset: 8641},
-									alternatives: []any{
-										&litMatcher{
-											pos:        position{line: 283, col: 16, offset: 8641},
-											val:        "]",
-											ignoreCase: false,
-											want:       "\"]\"",
-										},
-										&litMatcher{
-											pos:        position{line: 283, col: 22, offset: 8647},
-											val:        "\\",
-											ignoreCase: false,
-											want:       "\"\\\\\"",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 283, col: 29, offset: 8654},
-											name: "EOL",
-										},
-									},
-								},
-							},
-							&ruleRefExpr{
-								pos:  position{line: 283, col: 35, offset: 8660},
-								name: "SourceChar",
-							},
-						},
-					},
 					&seqExpr{
-						pos: position{line: 283, col: 48, offset: 8673},
+						pos: position{line: 412, col: 42, offset: 13315},
+						id:  600,
 						exprs: []any{
 							&litMatcher{
-								pos:        position{line: 283, col: 48, offset: 8673},
+								pos:        position{line: 412, col: 42, offset: 13315},
+								id:         601,
 								val:        "\\",
 								ignoreCase: false,
 								want:       "\"\\\\\"",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 283, col: 53, offset: 8678},
-								name: "CharClassEscape",
+								pos:  position{line: 412, col: 47, offset: 13320},
+								id:   602,
+								name: "UnicodeClassEscape",
 							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{0, 1}, {0, 1, 2}},
+					expected:  [][]string{{"\"\\\\\""}, {}},
+				},
 			},
 		},
 		{
-			name: "CharClassEscape",
-			pos:  position{line: 284, col: 1, offset: 8694},
+			name: "ClassOperand",
+			pos:  position{line: 413, col: 1, offset: 13339},
+			id:   55,
 			expr: &choiceExpr{
-				pos: position{line: 284, col: 19, offset: 8714},
+				pos: position{line: 413, col: 16, offset: 13356},
+				id:  603,
 				alternatives: []any{
-					&choiceExpr{
-						pos: position{line: 284, col: 21, offset: 8716},
-						alternatives: []any{
+					&seqExpr{
+						pos: position{line: 413, col: 16, offset: 13356},
+						id:  604,
+						exprs: []any{
+							&litMatcher{
+								pos:        position{line: 413, col: 16, offset: 13356},
+								id:         605,
+								val:        "[",
+								ignoreCase: false,
+								want:       "\"[\"",
+							},
+							&zeroOrOneExpr{
+								pos: position{line: 413, col: 20, offset: 13360},
+								id:  606,
+								expr: &litMatcher{
+									pos:        position{line: 413, col: 20, offset: 13360},
+									id:         607,
+									val:        "^",
+									ignoreCase: false,
+									want:       "\"^\"",
+								},
+							},
+							&zeroOrMoreExpr{
+								pos: position{line: 413, col: 25, offset: 13365},
+								id:  608,
+								expr: &ruleRefExpr{
+									pos:  position{line: 413, col: 25, offset: 13365},
+									id:   609,
+									name: "ClassItem",
+								},
+							},
 							&litMatcher{
-								pos:        position{line: 284, col: 21, offset: 8716},
+								pos:        position{line: 413, col: 36, offset: 13376},
+								id:         610,
 								val:        "]",
 								ignoreCase: false,
 								want:       "\"]\"",
 							},
-							&ruleRefExpr{
-								pos:  position{line: 284, col: 27, offset: 8722},
-								name: "CommonEscapeSequence",
-							},
 						},
 					},
-					&actionExpr{
-						pos: position{line: 285, col: 7, offset: 8751},
-						run: (*parser).callonCharClassEscape5,
-						expr: &seqExpr{
-							pos: position{line: 285, col: 7, offset: 8751},
-							exprs: []any{
-								&notExpr{
-									pos: position{line: 285, col: 7, offset: 8751},
-									expr: &litMatcher{
-										pos:        position{line: 285, col: 8, offset: 8752},
-										val:        "p",
-										ignoreCase: false,
-										want:       "\"p\"",
-									},
-								},
-								&choiceExpr{
-									pos: position{line: 285, col: 14, offset: 8758},
-									alternatives: []any{
-										&ruleRefExpr{
-											pos:  position{line: 285, col: 14, offset: 8758},
-											name: "SourceChar",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 285, col: 27, offset: 8771},
-											name: "EOL",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 285, col: 33, offset: 8777},
-											name: "EOF",
-										},
-									},
-								},
-							},
+					&oneOrMoreExpr{
+						pos: position{line: 413, col: 42, offset: 13382},
+						id:  611,
+						expr: &ruleRefExpr{
+							pos:  position{line: 413, col: 42, offset: 13382},
+							id:   612,
+							name: "ClassItem",
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{1}, {0, 1}},
+					expected:  [][]string{{"\"[\""}, {}},
+				},
 			},
 		},
 		{
-			name: "UnicodeClassEscape",
-			pos:  position{line: 289, col: 1, offset: 8843},
+			name: "ClassSetOp",
+			pos:  position{line: 414, col: 1, offset: 13393},
+			id:   56,
 			expr: &seqExpr{
-				pos: position{line: 289, col: 22, offset: 8866},
+				pos: position{line: 414, col: 14, offset: 13408},
+				id:  613,
 				exprs: []any{
-					&litMatcher{
-						pos:        position{line: 289, col: 22, offset: 8866},
-						val:        "p",
-						ignoreCase: false,
-						want:       "\"p\"",
+					&choiceExpr{
+						pos: position{line: 414, col: 16, offset: 13410},
+						id:  614,
+						alternatives: []any{
+							&litMatcher{
+								pos:        position{line: 414, col: 16, offset: 13410},
+								id:         615,
+								val:        "--",
+								ignoreCase: false,
+								want:       "\"--\"",
+							},
+							&litMatcher{
+								pos:        position{line: 414, col: 23, offset: 13417},
+								id:         616,
+								val:        "&&",
+								ignoreCase: false,
+								want:       "\"&&\"",
+							},
+						},
+						dispatch: &choiceDispatch{
+							ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+							ranges:    []rune{'\u0080'},
+							rangeSets: "\x00",
+							alts:      [][]int{{}, {1}, {0}},
+							expected:  [][]string{{"\"--\"", "\"&&\""}, {"\"--\""}, {"\"&&\""}},
+						},
+					},
+					&andExpr{
+						pos: position{line: 414, col: 30, offset: 13424},
+						id:  617,
+						expr: &choiceExpr{
+							pos: position{line: 414, col: 33, offset: 13427},
+							id:  618,
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 414, col: 33, offset: 13427},
+									id:         619,
+									val:        "[",
+									ignoreCase: false,
+									want:       "\"[\"",
+								},
+								&seqExpr{
+									pos: position{line: 414, col: 39, offset: 13433},
+									id:  620,
+									exprs: []any{
+										&litMatcher{
+											pos:        position{line: 414, col: 39, offset: 13433},
+											id:         621,
+											val:        "\\",
+											ignoreCase: false,
+											want:       "\"\\\\\"",
+										},
+										&charClassMatcher{
+											pos:        position{line: 414, col: 44, offset: 13438},
+											id:         622,
+											val:        "[pP]",
+											chars:      []rune{'p', 'P'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+							},
+							dispatch: &choiceDispatch{
+								ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+								ranges:    []rune{'\u0080'},
+								rangeSets: "\x00",
+								alts:      [][]int{{}, {0}, {1}},
+								expected:  [][]string{{"\"[\"", "\"\\\\\""}, {"\"\\\\\""}, {"\"[\""}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ClassCharRange",
+			pos:  position{line: 415, col: 1, offset: 13445},
+			id:   57,
+			expr: &seqExpr{
+				pos: position{line: 415, col: 18, offset: 13464},
+				id:  623,
+				exprs: []any{
+					&ruleRefExpr{
+						pos:  position{line: 415, col: 18, offset: 13464},
+						id:   624,
+						name: "ClassChar",
+					},
+					&notExpr{
+						pos: position{line: 415, col: 28, offset: 13474},
+						id:  625,
+						expr: &ruleRefExpr{
+							pos:  position{line: 415, col: 29, offset: 13475},
+							id:   626,
+							name: "ClassSetOp",
+						},
+					},
+					&litMatcher{
+						pos:        position{line: 415, col: 40, offset: 13486},
+						id:         627,
+						val:        "-",
+						ignoreCase: false,
+						want:       "\"-\"",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 415, col: 44, offset: 13490},
+						id:   628,
+						name: "ClassChar",
+					},
+				},
+			},
+		},
+		{
+			name: "ClassChar",
+			pos:  position{line: 416, col: 1, offset: 13500},
+			id:   58,
+			expr: &choiceExpr{
+				pos: position{line: 416, col: 13, offset: 13514},
+				id:  629,
+				alternatives: []any{
+					&seqExpr{
+						pos: position{line: 416, col: 13, offset: 13514},
+						id:  630,
+						exprs: []any{
+							&notExpr{
+								pos: position{line: 416, col: 13, offset: 13514},
+								id:  631,
+								expr: &choiceExpr{
+									pos: position{line: 416, col: 16, offset: 13517},
+									id:  632,
+									alternatives: []any{
+										&litMatcher{
+											pos:        position{line: 416, col: 16, offset: 13517},
+											id:         633,
+											val:        "]",
+											ignoreCase: false,
+											want:       "\"]\"",
+										},
+										&litMatcher{
+											pos:        position{line: 416, col: 22, offset: 13523},
+											id:         634,
+											val:        "\\",
+											ignoreCase: false,
+											want:       "\"\\\\\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 416, col: 29, offset: 13530},
+											id:   635,
+											name: "EOL",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 416, col: 35, offset: 13536},
+											id:   636,
+											name: "ClassSetOp",
+										},
+									},
+									dispatch: &choiceDispatch{
+										ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x04\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x00",
+										alts:      [][]int{{}, {2}, {3}, {1}, {0}},
+										expected:  [][]string{{"\"]\"", "\"\\\\\"", "\"\\n\"", "\"--\"", "\"&&\""}, {"\"]\"", "\"\\\\\"", "\"--\"", "\"&&\""}, {"\"]\"", "\"\\\\\"", "\"\\n\""}, {"\"]\"", "\"\\n\"", "\"--\"", "\"&&\""}, {"\"\\\\\"", "\"\\n\"", "\"--\"", "\"&&\""}},
+									},
+								},
+							},
+							&ruleRefExpr{
+								pos:  position{line: 416, col: 48, offset: 13549},
+								id:   637,
+								name: "SourceChar",
+							},
+						},
+					},
+					&seqExpr{
+						pos: position{line: 416, col: 61, offset: 13562},
+						id:  638,
+						exprs: []any{
+							&litMatcher{
+								pos:        position{line: 416, col: 61, offset: 13562},
+								id:         639,
+								val:        "\\",
+								ignoreCase: false,
+								want:       "\"\\\\\"",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 416, col: 66, offset: 13567},
+								id:   640,
+								name: "CharClassEscape",
+							},
+						},
+					},
+				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{0}, {0, 1}},
+					expected:  [][]string{{"\"\\\\\""}, {}},
+				},
+			},
+		},
+		{
+			name: "CharClassEscape",
+			pos:  position{line: 417, col: 1, offset: 13583},
+			id:   59,
+			expr: &choiceExpr{
+				pos: position{line: 417, col: 19, offset: 13603},
+				id:  641,
+				alternatives: []any{
+					&choiceExpr{
+						pos: position{line: 417, col: 21, offset: 13605},
+						id:  642,
+						alternatives: []any{
+							&litMatcher{
+								pos:        position{line: 417, col: 21, offset: 13605},
+								id:         643,
+								val:        "]",
+								ignoreCase: false,
+								want:       "\"]\"",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 417, col: 27, offset: 13611},
+								id:   644,
+								name: "CommonEscapeSequence",
+							},
+						},
+						dispatch: &choiceDispatch{
+							ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x01\x01\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01\x00\x01\x01\x01\x00\x01\x00\x00\x00\x00\x00\x00\x00",
+							ranges:    []rune{'\u0080'},
+							rangeSets: "\x00",
+							alts:      [][]int{{}, {1}, {0}},
+							expected:  [][]string{{"\"]\"", "\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}, {"\"]\""}, {"\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 418, col: 7, offset: 13640},
+						id:  645,
+						run: (*parser).callonCharClassEscape5,
+						expr: &seqExpr{
+							pos: position{line: 418, col: 7, offset: 13640},
+							id:  646,
+							exprs: []any{
+								&notExpr{
+									pos: position{line: 418, col: 7, offset: 13640},
+									id:  647,
+									expr: &charClassMatcher{
+										pos:        position{line: 418, col: 8, offset: 13641},
+										id:         648,
+										val:        "[pP]",
+										chars:      []rune{'p', 'P'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 418, col: 15, offset: 13648},
+									id:  649,
+									alternatives: []any{
+										&ruleRefExpr{
+											pos:  position{line: 418, col: 15, offset: 13648},
+											id:   650,
+											name: "SourceChar",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 418, col: 28, offset: 13661},
+											id:   651,
+											name: "EOL",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 418, col: 34, offset: 13667},
+											id:   652,
+											name: "EOF",
+										},
+									},
+									dispatch: &choiceDispatch{
+										ascii:     "\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x02\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x01",
+										alts:      [][]int{{2}, {0, 2}, {0, 1, 2}},
+										expected:  [][]string{{".", "\"\\n\""}, {"\"\\n\""}, {}},
+									},
+								},
+							},
+						},
+					},
+				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x01\x01\x00\x00\x00\x01\x01\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01\x00\x01\x01\x01\x00\x01\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{1}, {0, 1}},
+					expected:  [][]string{{"\"]\"", "\"a\"", "\"b\"", "\"n\"", "\"f\"", "\"r\"", "\"t\"", "\"v\"", "\"\\\\\"", "[0-7]", "\"x\"", "\"U\"", "\"u\""}, {}},
+				},
+			},
+		},
+		{
+			name: "UnicodeClassEscape",
+			pos:  position{line: 422, col: 1, offset: 13733},
+			id:   60,
+			expr: &seqExpr{
+				pos: position{line: 422, col: 22, offset: 13756},
+				id:  653,
+				exprs: []any{
+					&charClassMatcher{
+						pos:        position{line: 422, col: 22, offset: 13756},
+						id:         654,
+						val:        "[pP]",
+						chars:      []rune{'p', 'P'},
+						ignoreCase: false,
+						inverted:   false,
 					},
 					&choiceExpr{
-						pos: position{line: 290, col: 7, offset: 8878},
+						pos: position{line: 423, col: 7, offset: 13769},
+						id:  655,
 						alternatives: []any{
 							&ruleRefExpr{
-								pos:  position{line: 290, col: 7, offset: 8878},
+								pos:  position{line: 423, col: 7, offset: 13769},
+								id:   656,
 								name: "SingleCharUnicodeClass",
 							},
 							&actionExpr{
-								pos: position{line: 291, col: 7, offset: 8907},
+								pos: position{line: 424, col: 7, offset: 13798},
+								id:  657,
 								run: (*parser).callonUnicodeClassEscape5,
 								expr: &seqExpr{
-									pos: position{line: 291, col: 7, offset: 8907},
+									pos: position{line: 424, col: 7, offset: 13798},
+									id:  658,
 									exprs: []any{
 										&notExpr{
-											pos: position{line: 291, col: 7, offset: 8907},
+											pos: position{line: 424, col: 7, offset: 13798},
+											id:  659,
 											expr: &litMatcher{
-												pos:        position{line: 291, col: 8, offset: 8908},
+												pos:        position{line: 424, col: 8, offset: 13799},
+												id:         660,
 												val:        "{",
 												ignoreCase: false,
 												want:       "\"{\"",
 											},
 										},
 										&choiceExpr{
-											pos: position{line: 291, col: 14, offset: 8914},
+											pos: position{line: 424, col: 14, offset: 13805},
+											id:  661,
 											alternatives: []any{
 												&ruleRefExpr{
-													pos:  position{line: 291, col: 14, offset: 8914},
+													pos:  position{line: 424, col: 14, offset: 13805},
+													id:   662,
 													name: "SourceChar",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 291, col: 27, offset: 8927},
+													pos:  position{line: 424, col: 27, offset: 13818},
+													id:   663,
 													name: "EOL",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 291, col: 33, offset: 8933},
+													pos:  position{line: 424, col: 33, offset: 13824},
+													id:   664,
 													name: "EOF",
 												},
 											},
+											dispatch: &choiceDispatch{
+												ascii:     "\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x02\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01",
+												ranges:    []rune{'\u0080'},
+												rangeSets: "\x01",
+												alts:      [][]int{{2}, {0, 2}, {0, 1, 2}},
+												expected:  [][]string{{".", "\"\\n\""}, {"\"\\n\""}, {}},
+											},
 										},
 									},
 								},
 							},
 							&actionExpr{
-								pos: position{line: 292, col: 7, offset: 9004},
+								pos: position{line: 425, col: 7, offset: 13895},
+								id:  665,
 								run: (*parser).callonUnicodeClassEscape13,
 								expr: &seqExpr{
-									pos: position{line: 292, col: 7, offset: 9004},
+									pos: position{line: 425, col: 7, offset: 13895},
+									id:  666,
 									exprs: []any{
 										&litMatcher{
-											pos:        position{line: 292, col: 7, offset: 9004},
+											pos:        position{line: 425, col: 7, offset: 13895},
+											id:         667,
 											val:        "{",
 											ignoreCase: false,
 											want:       "\"{\"",
 										},
 										&labeledExpr{
-											pos:   position{line: 292, col: 11, offset: 9008},
-											label: "ident",
+											pos:   position{line: 425, col: 11, offset: 13899},
+											id:    668,
+											label: "name",
 											expr: &ruleRefExpr{
-												pos:  position{line: 292, col: 17, offset: 9014},
-												name: "IdentifierName",
+												pos:  position{line: 425, col: 16, offset: 13904},
+												id:   669,
+												name: "UnicodeClassName",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 292, col: 32, offset: 9029},
+											pos:        position{line: 425, col: 33, offset: 13921},
+											id:         670,
 											val:        "}",
 											ignoreCase: false,
 											want:       "\"}\"",
@@ -2252,141 +3932,708 @@ var g = &grammar{
 								},
 							},
 							&actionExpr{
-								pos: position{line: 298, col: 7, offset: 9206},
+								pos: position{line: 432, col: 7, offset: 14153},
+								id:  671,
 								run: (*parser).callonUnicodeClassEscape19,
 								expr: &seqExpr{
-									pos: position{line: 298, col: 7, offset: 9206},
+									pos: position{line: 432, col: 7, offset: 14153},
+									id:  672,
+									exprs: []any{
+										&litMatcher{
+											pos:        position{line: 432, col: 7, offset: 14153},
+											id:         673,
+											val:        "{",
+											ignoreCase: false,
+											want:       "\"{\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 432, col: 11, offset: 14157},
+											id:   674,
+											name: "UnicodeClassName",
+										},
+										&choiceExpr{
+											pos: position{line: 432, col: 30, offset: 14176},
+											id:  675,
+											alternatives: []any{
+												&litMatcher{
+													pos:        position{line: 432, col: 30, offset: 14176},
+													id:         676,
+													val:        "]",
+													ignoreCase: false,
+													want:       "\"]\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 432, col: 36, offset: 14182},
+													id:   677,
+													name: "EOL",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 432, col: 42, offset: 14188},
+													id:   678,
+													name: "EOF",
+												},
+											},
+											dispatch: &choiceDispatch{
+												ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+												ranges:    []rune{'\u0080'},
+												rangeSets: "\x00",
+												alts:      [][]int{{2}, {1, 2}, {0, 2}},
+												expected:  [][]string{{"\"]\"", "\"\\n\""}, {"\"]\""}, {"\"\\n\""}},
+											},
+										},
+									},
+								},
+							},
+						},
+						dispatch: &choiceDispatch{
+							ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x00\x01\x00\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00",
+							ranges:    []rune{'\u0080'},
+							rangeSets: "\x00",
+							alts:      [][]int{{1}, {0, 1}, {1, 2, 3}},
+							expected:  [][]string{{"[LMNCPZS]", "\"{\""}, {"\"{\""}, {"[LMNCPZS]"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "UnicodeClassName",
+			pos:  position{line: 436, col: 1, offset: 14271},
+			id:   61,
+			expr: &actionExpr{
+				pos: position{line: 436, col: 20, offset: 14292},
+				id:  679,
+				run: (*parser).callonUnicodeClassName1,
+				expr: &seqExpr{
+					pos: position{line: 436, col: 20, offset: 14292},
+					id:  680,
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 436, col: 20, offset: 14292},
+							id:   681,
+							name: "IdentifierName",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 436, col: 35, offset: 14307},
+							id:  682,
+							expr: &seqExpr{
+								pos: position{line: 436, col: 37, offset: 14309},
+								id:  683,
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 436, col: 37, offset: 14309},
+										id:         684,
+										val:        "=",
+										ignoreCase: false,
+										want:       "\"=\"",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 436, col: 41, offset: 14313},
+										id:   685,
+										name: "IdentifierName",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SingleCharUnicodeClass",
+			pos:  position{line: 439, col: 1, offset: 14366},
+			id:   62,
+			expr: &charClassMatcher{
+				pos:        position{line: 439, col: 26, offset: 14393},
+				id:         686,
+				val:        "[LMNCPZS]",
+				chars:      []rune{'L', 'M', 'N', 'C', 'P', 'Z', 'S'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "RegexpMatcher",
+			pos:  position{line: 441, col: 1, offset: 14404},
+			id:   63,
+			expr: &choiceExpr{
+				pos: position{line: 441, col: 17, offset: 14422},
+				id:  687,
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 441, col: 17, offset: 14422},
+						id:  688,
+						run: (*parser).callonRegexpMatcher2,
+						expr: &seqExpr{
+							pos: position{line: 441, col: 17, offset: 14422},
+							id:  689,
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 441, col: 17, offset: 14422},
+									id:         690,
+									val:        "re\"",
+									ignoreCase: false,
+									want:       "\"re\\\"\"",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 441, col: 24, offset: 14429},
+									id:  691,
+									expr: &ruleRefExpr{
+										pos:  position{line: 441, col: 24, offset: 14429},
+										id:   692,
+										name: "RegexpChar",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 441, col: 36, offset: 14441},
+									id:         693,
+									val:        "\"",
+									ignoreCase: false,
+									want:       "\"\\\"\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 447, col: 5, offset: 14613},
+						id:  694,
+						run: (*parser).callonRegexpMatcher8,
+						expr: &seqExpr{
+							pos: position{line: 447, col: 5, offset: 14613},
+							id:  695,
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 447, col: 5, offset: 14613},
+									id:         696,
+									val:        "re\"",
+									ignoreCase: false,
+									want:       "\"re\\\"\"",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 447, col: 12, offset: 14620},
+									id:  697,
+									expr: &ruleRefExpr{
+										pos:  position{line: 447, col: 12, offset: 14620},
+										id:   698,
+										name: "RegexpChar",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 447, col: 24, offset: 14632},
+									id:  699,
+									expr: &litMatcher{
+										pos:        position{line: 447, col: 24, offset: 14632},
+										id:         700,
+										val:        "\\",
+										ignoreCase: false,
+										want:       "\"\\\\\"",
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 447, col: 32, offset: 14640},
+									id:  701,
+									alternatives: []any{
+										&ruleRefExpr{
+											pos:  position{line: 447, col: 32, offset: 14640},
+											id:   702,
+											name: "EOL",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 447, col: 38, offset: 14646},
+											id:   703,
+											name: "EOF",
+										},
+									},
+									dispatch: &choiceDispatch{
+										ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x00",
+										alts:      [][]int{{1}, {0, 1}},
+										expected:  [][]string{{"\"\\n\""}, {}},
+									},
+								},
+							},
+						},
+					},
+				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {0, 1}},
+					expected:  [][]string{{"\"re\\\"\""}, {}},
+				},
+			},
+		},
+		{
+			name: "RegexpChar",
+			pos:  position{line: 450, col: 1, offset: 14757},
+			id:   64,
+			expr: &choiceExpr{
+				pos: position{line: 450, col: 14, offset: 14772},
+				id:  704,
+				alternatives: []any{
+					&seqExpr{
+						pos: position{line: 450, col: 14, offset: 14772},
+						id:  705,
+						exprs: []any{
+							&notExpr{
+								pos: position{line: 450, col: 14, offset: 14772},
+								id:  706,
+								expr: &choiceExpr{
+									pos: position{line: 450, col: 17, offset: 14775},
+									id:  707,
+									alternatives: []any{
+										&litMatcher{
+											pos:        position{line: 450, col: 17, offset: 14775},
+											id:         708,
+											val:        "\"",
+											ignoreCase: false,
+											want:       "\"\\\"\"",
+										},
+										&litMatcher{
+											pos:        position{line: 450, col: 23, offset: 14781},
+											id:         709,
+											val:        "\\",
+											ignoreCase: false,
+											want:       "\"\\\\\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 450, col: 30, offset: 14788},
+											id:   710,
+											name: "EOL",
+										},
+									},
+									dispatch: &choiceDispatch{
+										ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x00",
+										alts:      [][]int{{}, {2}, {0}, {1}},
+										expected:  [][]string{{"\"\\\"\"", "\"\\\\\"", "\"\\n\""}, {"\"\\\"\"", "\"\\\\\""}, {"\"\\\\\"", "\"\\n\""}, {"\"\\\"\"", "\"\\n\""}},
+									},
+								},
+							},
+							&ruleRefExpr{
+								pos:  position{line: 450, col: 36, offset: 14794},
+								id:   711,
+								name: "SourceChar",
+							},
+						},
+					},
+					&seqExpr{
+						pos: position{line: 450, col: 49, offset: 14807},
+						id:  712,
+						exprs: []any{
+							&litMatcher{
+								pos:        position{line: 450, col: 49, offset: 14807},
+								id:         713,
+								val:        "\\",
+								ignoreCase: false,
+								want:       "\"\\\\\"",
+							},
+							&notExpr{
+								pos: position{line: 450, col: 54, offset: 14812},
+								id:  714,
+								expr: &ruleRefExpr{
+									pos:  position{line: 450, col: 55, offset: 14813},
+									id:   715,
+									name: "EOL",
+								},
+							},
+							&ruleRefExpr{
+								pos:  position{line: 450, col: 59, offset: 14817},
+								id:   716,
+								name: "SourceChar",
+							},
+						},
+					},
+				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{0}, {0, 1}},
+					expected:  [][]string{{"\"\\\\\""}, {}},
+				},
+			},
+		},
+		{
+			name: "AnyMatcher",
+			pos:  position{line: 452, col: 1, offset: 14829},
+			id:   65,
+			expr: &actionExpr{
+				pos: position{line: 452, col: 14, offset: 14844},
+				id:  717,
+				run: (*parser).callonAnyMatcher1,
+				expr: &litMatcher{
+					pos:        position{line: 452, col: 14, offset: 14844},
+					id:         718,
+					val:        ".",
+					ignoreCase: false,
+					want:       "\".\"",
+				},
+			},
+		},
+		{
+			name: "CutExpr",
+			pos:  position{line: 457, col: 1, offset: 14919},
+			id:   66,
+			expr: &actionExpr{
+				pos: position{line: 457, col: 11, offset: 14931},
+				id:  719,
+				run: (*parser).callonCutExpr1,
+				expr: &litMatcher{
+					pos:        position{line: 457, col: 11, offset: 14931},
+					id:         720,
+					val:        "~",
+					ignoreCase: false,
+					want:       "\"~\"",
+				},
+			},
+		},
+		{
+			name: "ThrowExpr",
+			pos:  position{line: 463, col: 1, offset: 15065},
+			id:   67,
+			expr: &choiceExpr{
+				pos: position{line: 463, col: 13, offset: 15079},
+				id:  721,
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 463, col: 13, offset: 15079},
+						id:  722,
+						run: (*parser).callonThrowExpr2,
+						expr: &seqExpr{
+							pos: position{line: 463, col: 13, offset: 15079},
+							id:  723,
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 463, col: 13, offset: 15079},
+									id:         724,
+									val:        "%",
+									ignoreCase: false,
+									want:       "\"%\"",
+								},
+								&litMatcher{
+									pos:        position{line: 463, col: 17, offset: 15083},
+									id:         725,
+									val:        "{",
+									ignoreCase: false,
+									want:       "\"{\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 463, col: 21, offset: 15087},
+									id:    726,
+									label: "label",
+									expr: &ruleRefExpr{
+										pos:  position{line: 463, col: 27, offset: 15093},
+										id:   727,
+										name: "IdentifierName",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 463, col: 42, offset: 15108},
+									id:         728,
+									val:        "}",
+									ignoreCase: false,
+									want:       "\"}\"",
+								},
+								&notExpr{
+									pos: position{line: 463, col: 46, offset: 15112},
+									id:  729,
+									expr: &seqExpr{
+										pos: position{line: 463, col: 49, offset: 15115},
+										id:  730,
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 463, col: 49, offset: 15115},
+												id:   731,
+												name: "__",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 463, col: 52, offset: 15118},
+												id:   732,
+												name: "RuleDefOp",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 467, col: 5, offset: 15234},
+						id:  733,
+						run: (*parser).callonThrowExpr13,
+						expr: &seqExpr{
+							pos: position{line: 467, col: 5, offset: 15234},
+							id:  734,
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 467, col: 5, offset: 15234},
+									id:         735,
+									val:        "%",
+									ignoreCase: false,
+									want:       "\"%\"",
+								},
+								&litMatcher{
+									pos:        position{line: 467, col: 9, offset: 15238},
+									id:         736,
+									val:        "{",
+									ignoreCase: false,
+									want:       "\"{\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 467, col: 13, offset: 15242},
+									id:   737,
+									name: "IdentifierName",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 467, col: 28, offset: 15257},
+									id:   738,
+									name: "EOF",
+								},
+							},
+						},
+					},
+				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {0, 1}},
+					expected:  [][]string{{"\"%\""}, {}},
+				},
+			},
+		},
+		{
+			name: "PrecedenceExpr",
+			pos:  position{line: 473, col: 1, offset: 15478},
+			id:   68,
+			expr: &actionExpr{
+				pos: position{line: 473, col: 18, offset: 15497},
+				id:  739,
+				run: (*parser).callonPrecedenceExpr1,
+				expr: &seqExpr{
+					pos: position{line: 473, col: 18, offset: 15497},
+					id:  740,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 473, col: 18, offset: 15497},
+							id:         741,
+							val:        "%precedence",
+							ignoreCase: false,
+							want:       "\"%precedence\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 473, col: 32, offset: 15511},
+							id:   742,
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 473, col: 35, offset: 15514},
+							id:         743,
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 473, col: 39, offset: 15518},
+							id:   744,
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 473, col: 42, offset: 15521},
+							id:    745,
+							label: "operand",
+							expr: &ruleRefExpr{
+								pos:  position{line: 473, col: 50, offset: 15529},
+								id:   746,
+								name: "Expression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 473, col: 61, offset: 15540},
+							id:    747,
+							label: "levels",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 473, col: 68, offset: 15547},
+								id:  748,
+								expr: &seqExpr{
+									pos: position{line: 473, col: 70, offset: 15549},
+									id:  749,
 									exprs: []any{
-										&litMatcher{
-											pos:        position{line: 298, col: 7, offset: 9206},
-											val:        "{",
-											ignoreCase: false,
-											want:       "\"{\"",
-										},
 										&ruleRefExpr{
-											pos:  position{line: 298, col: 11, offset: 9210},
-											name: "IdentifierName",
+											pos:  position{line: 473, col: 70, offset: 15549},
+											id:   750,
+											name: "__",
 										},
-										&choiceExpr{
-											pos: position{line: 298, col: 28, offset: 9227},
-											alternatives: []any{
-												&litMatcher{
-													pos:        position{line: 298, col: 28, offset: 9227},
-													val:        "]",
-													ignoreCase: false,
-													want:       "\"]\"",
-												},
-												&ruleRefExpr{
-													pos:  position{line: 298, col: 34, offset: 9233},
-													name: "EOL",
-												},
-												&ruleRefExpr{
-													pos:  position{line: 298, col: 40, offset: 9239},
-													name: "EOF",
-												},
-											},
+										&ruleRefExpr{
+											pos:  position{line: 473, col: 73, offset: 15552},
+											id:   751,
+											name: "PrecedenceLevel",
 										},
 									},
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 473, col: 92, offset: 15571},
+							id:   752,
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 473, col: 95, offset: 15574},
+							id:         753,
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "SingleCharUnicodeClass",
-			pos:  position{line: 302, col: 1, offset: 9322},
-			expr: &charClassMatcher{
-				pos:        position{line: 302, col: 26, offset: 9349},
-				val:        "[LMNCPZS]",
-				chars:      []rune{'L', 'M', 'N', 'C', 'P', 'Z', 'S'},
-				ignoreCase: false,
-				inverted:   false,
-			},
-		},
-		{
-			name: "AnyMatcher",
-			pos:  position{line: 304, col: 1, offset: 9360},
+			name: "PrecedenceLevel",
+			pos:  position{line: 482, col: 1, offset: 15824},
+			id:   69,
 			expr: &actionExpr{
-				pos: position{line: 304, col: 14, offset: 9375},
-				run: (*parser).callonAnyMatcher1,
-				expr: &litMatcher{
-					pos:        position{line: 304, col: 14, offset: 9375},
-					val:        ".",
-					ignoreCase: false,
-					want:       "\".\"",
+				pos: position{line: 482, col: 19, offset: 15844},
+				id:  754,
+				run: (*parser).callonPrecedenceLevel1,
+				expr: &seqExpr{
+					pos: position{line: 482, col: 19, offset: 15844},
+					id:  755,
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 482, col: 19, offset: 15844},
+							id:    756,
+							label: "kind",
+							expr: &ruleRefExpr{
+								pos:  position{line: 482, col: 24, offset: 15849},
+								id:   757,
+								name: "PrecedenceKind",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 482, col: 39, offset: 15864},
+							id:   758,
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 482, col: 42, offset: 15867},
+							id:    759,
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 482, col: 45, offset: 15870},
+								id:   760,
+								name: "PrefixedExpr",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 482, col: 58, offset: 15883},
+							id:    761,
+							label: "code",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 482, col: 63, offset: 15888},
+								id:  762,
+								expr: &seqExpr{
+									pos: position{line: 482, col: 65, offset: 15890},
+									id:  763,
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 482, col: 65, offset: 15890},
+											id:   764,
+											name: "__",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 482, col: 68, offset: 15893},
+											id:   765,
+											name: "CodeBlock",
+										},
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "ThrowExpr",
-			pos:  position{line: 309, col: 1, offset: 9450},
-			expr: &choiceExpr{
-				pos: position{line: 309, col: 13, offset: 9464},
-				alternatives: []any{
-					&actionExpr{
-						pos: position{line: 309, col: 13, offset: 9464},
-						run: (*parser).callonThrowExpr2,
-						expr: &seqExpr{
-							pos: position{line: 309, col: 13, offset: 9464},
-							exprs: []any{
+			name: "PrecedenceKind",
+			pos:  position{line: 491, col: 1, offset: 16124},
+			id:   70,
+			expr: &actionExpr{
+				pos: position{line: 491, col: 18, offset: 16143},
+				id:  766,
+				run: (*parser).callonPrecedenceKind1,
+				expr: &seqExpr{
+					pos: position{line: 491, col: 18, offset: 16143},
+					id:  767,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 491, col: 18, offset: 16143},
+							id:         768,
+							val:        "%",
+							ignoreCase: false,
+							want:       "\"%\"",
+						},
+						&choiceExpr{
+							pos: position{line: 491, col: 24, offset: 16149},
+							id:  769,
+							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 309, col: 13, offset: 9464},
-									val:        "%",
+									pos:        position{line: 491, col: 24, offset: 16149},
+									id:         770,
+									val:        "left",
 									ignoreCase: false,
-									want:       "\"%\"",
+									want:       "\"left\"",
 								},
 								&litMatcher{
-									pos:        position{line: 309, col: 17, offset: 9468},
-									val:        "{",
+									pos:        position{line: 491, col: 33, offset: 16158},
+									id:         771,
+									val:        "right",
 									ignoreCase: false,
-									want:       "\"{\"",
-								},
-								&labeledExpr{
-									pos:   position{line: 309, col: 21, offset: 9472},
-									label: "label",
-									expr: &ruleRefExpr{
-										pos:  position{line: 309, col: 27, offset: 9478},
-										name: "IdentifierName",
-									},
+									want:       "\"right\"",
 								},
 								&litMatcher{
-									pos:        position{line: 309, col: 42, offset: 9493},
-									val:        "}",
+									pos:        position{line: 491, col: 43, offset: 16168},
+									id:         772,
+									val:        "nonassoc",
 									ignoreCase: false,
-									want:       "\"}\"",
+									want:       "\"nonassoc\"",
 								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 313, col: 5, offset: 9601},
-						run: (*parser).callonThrowExpr9,
-						expr: &seqExpr{
-							pos: position{line: 313, col: 5, offset: 9601},
-							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 313, col: 5, offset: 9601},
-									val:        "%",
+									pos:        position{line: 491, col: 56, offset: 16181},
+									id:         773,
+									val:        "prefix",
 									ignoreCase: false,
-									want:       "\"%\"",
+									want:       "\"prefix\"",
 								},
 								&litMatcher{
-									pos:        position{line: 313, col: 9, offset: 9605},
-									val:        "{",
+									pos:        position{line: 491, col: 67, offset: 16192},
+									id:         774,
+									val:        "postfix",
 									ignoreCase: false,
-									want:       "\"{\"",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 313, col: 13, offset: 9609},
-									name: "IdentifierName",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 313, col: 28, offset: 9624},
-									name: "EOF",
+									want:       "\"postfix\"",
 								},
 							},
+							dispatch: &choiceDispatch{
+								ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x02\x00\x03\x00\x04\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+								ranges:    []rune{'\u0080'},
+								rangeSets: "\x00",
+								alts:      [][]int{{}, {0}, {2}, {3, 4}, {1}},
+								expected:  [][]string{{"\"left\"", "\"right\"", "\"nonassoc\"", "\"prefix\"", "\"postfix\""}, {"\"right\"", "\"nonassoc\"", "\"prefix\"", "\"postfix\""}, {"\"left\"", "\"right\"", "\"prefix\"", "\"postfix\""}, {"\"left\"", "\"right\"", "\"nonassoc\""}, {"\"left\"", "\"nonassoc\"", "\"prefix\"", "\"postfix\""}},
+							},
+						},
+						&notExpr{
+							pos: position{line: 491, col: 79, offset: 16204},
+							id:  775,
+							expr: &ruleRefExpr{
+								pos:  position{line: 491, col: 80, offset: 16205},
+								id:   776,
+								name: "IdentifierPart",
+							},
 						},
 					},
 				},
@@ -2394,28 +4641,35 @@ var g = &grammar{
 		},
 		{
 			name: "CodeBlock",
-			pos:  position{line: 317, col: 1, offset: 9695},
+			pos:  position{line: 506, col: 1, offset: 16522},
+			id:   71,
 			expr: &choiceExpr{
-				pos: position{line: 317, col: 13, offset: 9709},
+				pos: position{line: 506, col: 13, offset: 16536},
+				id:  777,
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 317, col: 13, offset: 9709},
+						pos: position{line: 506, col: 13, offset: 16536},
+						id:  778,
 						run: (*parser).callonCodeBlock2,
 						expr: &seqExpr{
-							pos: position{line: 317, col: 13, offset: 9709},
+							pos: position{line: 506, col: 13, offset: 16536},
+							id:  779,
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 317, col: 13, offset: 9709},
+									pos:        position{line: 506, col: 13, offset: 16536},
+									id:         780,
 									val:        "{",
 									ignoreCase: false,
 									want:       "\"{\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 317, col: 17, offset: 9713},
+									pos:  position{line: 506, col: 17, offset: 16540},
+									id:   781,
 									name: "Code",
 								},
 								&litMatcher{
-									pos:        position{line: 317, col: 22, offset: 9718},
+									pos:        position{line: 506, col: 22, offset: 16545},
+									id:         782,
 									val:        "}",
 									ignoreCase: false,
 									want:       "\"}\"",
@@ -2424,59 +4678,81 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 321, col: 5, offset: 9817},
+						pos: position{line: 510, col: 5, offset: 16644},
+						id:  783,
 						run: (*parser).callonCodeBlock7,
 						expr: &seqExpr{
-							pos: position{line: 321, col: 5, offset: 9817},
+							pos: position{line: 510, col: 5, offset: 16644},
+							id:  784,
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 321, col: 5, offset: 9817},
+									pos:        position{line: 510, col: 5, offset: 16644},
+									id:         785,
 									val:        "{",
 									ignoreCase: false,
 									want:       "\"{\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 321, col: 9, offset: 9821},
+									pos:  position{line: 510, col: 9, offset: 16648},
+									id:   786,
 									name: "Code",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 321, col: 14, offset: 9826},
+									pos:  position{line: 510, col: 14, offset: 16653},
+									id:   787,
 									name: "EOF",
 								},
 							},
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {0, 1}},
+					expected:  [][]string{{"\"{\""}, {}},
+				},
 			},
 		},
 		{
 			name: "Code",
-			pos:  position{line: 325, col: 1, offset: 9891},
+			pos:  position{line: 514, col: 1, offset: 16718},
+			id:   72,
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 325, col: 8, offset: 9900},
+				pos: position{line: 514, col: 8, offset: 16727},
+				id:  788,
 				expr: &choiceExpr{
-					pos: position{line: 325, col: 10, offset: 9902},
+					pos: position{line: 514, col: 10, offset: 16729},
+					id:  789,
 					alternatives: []any{
 						&oneOrMoreExpr{
-							pos: position{line: 325, col: 10, offset: 9902},
+							pos: position{line: 514, col: 10, offset: 16729},
+							id:  790,
 							expr: &choiceExpr{
-								pos: position{line: 325, col: 12, offset: 9904},
+								pos: position{line: 514, col: 12, offset: 16731},
+								id:  791,
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 325, col: 12, offset: 9904},
+										pos:  position{line: 514, col: 12, offset: 16731},
+										id:   792,
 										name: "Comment",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 325, col: 22, offset: 9914},
+										pos:  position{line: 514, col: 22, offset: 16741},
+										id:   793,
 										name: "CodeStringLiteral",
 									},
 									&seqExpr{
-										pos: position{line: 325, col: 42, offset: 9934},
+										pos: position{line: 514, col: 42, offset: 16761},
+										id:  794,
 										exprs: []any{
 											&notExpr{
-												pos: position{line: 325, col: 42, offset: 9934},
+												pos: position{line: 514, col: 42, offset: 16761},
+												id:  795,
 												expr: &charClassMatcher{
-													pos:        position{line: 325, col: 43, offset: 9935},
+													pos:        position{line: 514, col: 43, offset: 16762},
+													id:         796,
 													val:        "[{}]",
 													chars:      []rune{'{', '}'},
 													ignoreCase: false,
@@ -2484,29 +4760,41 @@ var g = &grammar{
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 325, col: 48, offset: 9940},
+												pos:  position{line: 514, col: 48, offset: 16767},
+												id:   797,
 												name: "SourceChar",
 											},
 										},
 									},
 								},
+								dispatch: &choiceDispatch{
+									ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+									ranges:    []rune{'\u0080'},
+									rangeSets: "\x00",
+									alts:      [][]int{{0, 2}, {0, 1, 2}},
+									expected:  [][]string{{"\"\\\"\"", "\"`\"", "\"'\""}, {}},
+								},
 							},
 						},
 						&seqExpr{
-							pos: position{line: 325, col: 64, offset: 9956},
+							pos: position{line: 514, col: 64, offset: 16783},
+							id:  798,
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 325, col: 64, offset: 9956},
+									pos:        position{line: 514, col: 64, offset: 16783},
+									id:         799,
 									val:        "{",
 									ignoreCase: false,
 									want:       "\"{\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 325, col: 68, offset: 9960},
+									pos:  position{line: 514, col: 68, offset: 16787},
+									id:   800,
 									name: "Code",
 								},
 								&litMatcher{
-									pos:        position{line: 325, col: 73, offset: 9965},
+									pos:        position{line: 514, col: 73, offset: 16792},
+									id:         801,
 									val:        "}",
 									ignoreCase: false,
 									want:       "\"}\"",
@@ -2514,53 +4802,77 @@ var g = &grammar{
 							},
 						},
 					},
+					dispatch: &choiceDispatch{
+						ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00",
+						ranges:    []rune{'\u0080'},
+						rangeSets: "\x00",
+						alts:      [][]int{{0}, {0, 1}},
+						expected:  [][]string{{"\"{\""}, {}},
+					},
 				},
 			},
 		},
 		{
 			name: "CodeStringLiteral",
-			pos:  position{line: 327, col: 1, offset: 9973},
+			pos:  position{line: 516, col: 1, offset: 16800},
+			id:   73,
 			expr: &choiceExpr{
-				pos: position{line: 327, col: 21, offset: 9995},
+				pos: position{line: 516, col: 21, offset: 16822},
+				id:  802,
 				alternatives: []any{
 					&seqExpr{
-						pos: position{line: 327, col: 21, offset: 9995},
+						pos: position{line: 516, col: 21, offset: 16822},
+						id:  803,
 						exprs: []any{
 							&litMatcher{
-								pos:        position{line: 327, col: 21, offset: 9995},
+								pos:        position{line: 516, col: 21, offset: 16822},
+								id:         804,
 								val:        "\"",
 								ignoreCase: false,
 								want:       "\"\\\"\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 327, col: 25, offset: 9999},
+								pos: position{line: 516, col: 25, offset: 16826},
+								id:  805,
 								expr: &choiceExpr{
-									pos: position{line: 327, col: 26, offset: 10000},
+									pos: position{line: 516, col: 26, offset: 16827},
+									id:  806,
 									alternatives: []any{
 										&litMatcher{
-											pos:        position{line: 327, col: 26, offset: 10000},
+											pos:        position{line: 516, col: 26, offset: 16827},
+											id:         807,
 											val:        "\\\"",
 											ignoreCase: false,
 											want:       "\"\\\\\\\"\"",
 										},
 										&litMatcher{
-											pos:        position{line: 327, col: 33, offset: 10007},
+											pos:        position{line: 516, col: 33, offset: 16834},
+											id:         808,
 											val:        "\\\\",
 											ignoreCase: false,
 											want:       "\"\\\\\\\\\"",
 										},
 										&charClassMatcher{
-											pos:        position{line: 327, col: 40, offset: 10014},
+											pos:        position{line: 516, col: 40, offset: 16841},
+											id:         809,
 											val:        "[^\"\\r\\n]",
 											chars:      []rune{'"', '\r', '\n'},
 											ignoreCase: false,
 											inverted:   true,
 										},
 									},
+									dispatch: &choiceDispatch{
+										ascii:     "\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x01\x01\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x02\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x01",
+										alts:      [][]int{{}, {2}, {0, 1, 2}},
+										expected:  [][]string{{"\"\\\\\\\"\"", "\"\\\\\\\\\"", "[^\"\\r\\n]"}, {"\"\\\\\\\"\"", "\"\\\\\\\\\""}, {}},
+									},
 								},
 							},
 							&litMatcher{
-								pos:        position{line: 327, col: 51, offset: 10025},
+								pos:        position{line: 516, col: 51, offset: 16852},
+								id:         810,
 								val:        "\"",
 								ignoreCase: false,
 								want:       "\"\\\"\"",
@@ -2568,18 +4880,22 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 328, col: 21, offset: 10051},
+						pos: position{line: 517, col: 21, offset: 16878},
+						id:  811,
 						exprs: []any{
 							&litMatcher{
-								pos:        position{line: 328, col: 21, offset: 10051},
+								pos:        position{line: 517, col: 21, offset: 16878},
+								id:         812,
 								val:        "`",
 								ignoreCase: false,
 								want:       "\"`\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 328, col: 25, offset: 10055},
+								pos: position{line: 517, col: 25, offset: 16882},
+								id:  813,
 								expr: &charClassMatcher{
-									pos:        position{line: 328, col: 25, offset: 10055},
+									pos:        position{line: 517, col: 25, offset: 16882},
+									id:         814,
 									val:        "[^`]",
 									chars:      []rune{'`'},
 									ignoreCase: false,
@@ -2587,7 +4903,8 @@ var g = &grammar{
 								},
 							},
 							&litMatcher{
-								pos:        position{line: 328, col: 31, offset: 10061},
+								pos:        position{line: 517, col: 31, offset: 16888},
+								id:         815,
 								val:        "`",
 								ignoreCase: false,
 								want:       "\"`\"",
@@ -2595,33 +4912,40 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 329, col: 21, offset: 10087},
+						pos: position{line: 518, col: 21, offset: 16914},
+						id:  816,
 						exprs: []any{
 							&litMatcher{
-								pos:        position{line: 329, col: 21, offset: 10087},
+								pos:        position{line: 518, col: 21, offset: 16914},
+								id:         817,
 								val:        "'",
 								ignoreCase: false,
 								want:       "\"'\"",
 							},
 							&choiceExpr{
-								pos: position{line: 329, col: 27, offset: 10093},
+								pos: position{line: 518, col: 27, offset: 16920},
+								id:  818,
 								alternatives: []any{
 									&litMatcher{
-										pos:        position{line: 329, col: 27, offset: 10093},
+										pos:        position{line: 518, col: 27, offset: 16920},
+										id:         819,
 										val:        "\\'",
 										ignoreCase: false,
 										want:       "\"\\\\'\"",
 									},
 									&litMatcher{
-										pos:        position{line: 329, col: 34, offset: 10100},
+										pos:        position{line: 518, col: 34, offset: 16927},
+										id:         820,
 										val:        "\\\\",
 										ignoreCase: false,
 										want:       "\"\\\\\\\\\"",
 									},
 									&oneOrMoreExpr{
-										pos: position{line: 329, col: 41, offset: 10107},
+										pos: position{line: 518, col: 41, offset: 16934},
+										id:  821,
 										expr: &charClassMatcher{
-											pos:        position{line: 329, col: 41, offset: 10107},
+											pos:        position{line: 518, col: 41, offset: 16934},
+											id:         822,
 											val:        "[^']",
 											chars:      []rune{'\''},
 											ignoreCase: false,
@@ -2629,9 +4953,17 @@ var g = &grammar{
 										},
 									},
 								},
+								dispatch: &choiceDispatch{
+									ascii:     "\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x02\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01",
+									ranges:    []rune{'\u0080'},
+									rangeSets: "\x01",
+									alts:      [][]int{{}, {2}, {0, 1, 2}},
+									expected:  [][]string{{"\"\\\\'\"", "\"\\\\\\\\\"", "[^']"}, {"\"\\\\'\"", "\"\\\\\\\\\""}, {}},
+								},
 							},
 							&litMatcher{
-								pos:        position{line: 329, col: 48, offset: 10114},
+								pos:        position{line: 518, col: 48, offset: 16941},
+								id:         823,
 								val:        "'",
 								ignoreCase: false,
 								want:       "\"'\"",
@@ -2639,57 +4971,91 @@ var g = &grammar{
 						},
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {0}, {2}, {1}},
+					expected:  [][]string{{"\"\\\"\"", "\"`\"", "\"'\""}, {"\"`\"", "\"'\""}, {"\"\\\"\"", "\"`\""}, {"\"\\\"\"", "\"'\""}},
+				},
 			},
 		},
 		{
 			name: "__",
-			pos:  position{line: 331, col: 1, offset: 10120},
+			pos:  position{line: 520, col: 1, offset: 16947},
+			id:   74,
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 331, col: 6, offset: 10127},
+				pos: position{line: 520, col: 6, offset: 16954},
+				id:  824,
 				expr: &choiceExpr{
-					pos: position{line: 331, col: 8, offset: 10129},
+					pos: position{line: 520, col: 8, offset: 16956},
+					id:  825,
 					alternatives: []any{
 						&ruleRefExpr{
-							pos:  position{line: 331, col: 8, offset: 10129},
+							pos:  position{line: 520, col: 8, offset: 16956},
+							id:   826,
 							name: "Whitespace",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 331, col: 21, offset: 10142},
+							pos:  position{line: 520, col: 21, offset: 16969},
+							id:   827,
 							name: "EOL",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 331, col: 27, offset: 10148},
+							pos:  position{line: 520, col: 27, offset: 16975},
+							id:   828,
 							name: "Comment",
 						},
 					},
+					dispatch: &choiceDispatch{
+						ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+						ranges:    []rune{'\u0080'},
+						rangeSets: "\x00",
+						alts:      [][]int{{2}, {0, 2}, {1, 2}},
+						expected:  [][]string{{"[ \\t\\r]", "\"\\n\""}, {"\"\\n\""}, {"[ \\t\\r]"}},
+					},
 				},
 			},
 		},
 		{
 			name: "_",
-			pos:  position{line: 332, col: 1, offset: 10159},
+			pos:  position{line: 521, col: 1, offset: 16986},
+			id:   75,
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 332, col: 5, offset: 10165},
+				pos: position{line: 521, col: 5, offset: 16992},
+				id:  829,
 				expr: &choiceExpr{
-					pos: position{line: 332, col: 7, offset: 10167},
+					pos: position{line: 521, col: 7, offset: 16994},
+					id:  830,
 					alternatives: []any{
 						&ruleRefExpr{
-							pos:  position{line: 332, col: 7, offset: 10167},
+							pos:  position{line: 521, col: 7, offset: 16994},
+							id:   831,
 							name: "Whitespace",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 332, col: 20, offset: 10180},
+							pos:  position{line: 521, col: 20, offset: 17007},
+							id:   832,
 							name: "MultiLineCommentNoLineTerminator",
 						},
 					},
+					dispatch: &choiceDispatch{
+						ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+						ranges:    []rune{'\u0080'},
+						rangeSets: "\x00",
+						alts:      [][]int{{}, {0}, {1}},
+						expected:  [][]string{{"[ \\t\\r]", "\"/*\""}, {"\"/*\""}, {"[ \\t\\r]"}},
+					},
 				},
 			},
 		},
 		{
 			name: "Whitespace",
-			pos:  position{line: 334, col: 1, offset: 10217},
+			pos:  position{line: 523, col: 1, offset: 17044},
+			id:   76,
 			expr: &charClassMatcher{
-				pos:        position{line: 334, col: 14, offset: 10232},
+				pos:        position{line: 523, col: 14, offset: 17059},
+				id:         833,
 				val:        "[ \\t\\r]",
 				chars:      []rune{' ', '\t', '\r'},
 				ignoreCase: false,
@@ -2698,9 +5064,11 @@ var g = &grammar{
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 335, col: 1, offset: 10240},
+			pos:  position{line: 524, col: 1, offset: 17067},
+			id:   77,
 			expr: &litMatcher{
-				pos:        position{line: 335, col: 7, offset: 10248},
+				pos:        position{line: 524, col: 7, offset: 17075},
+				id:         834,
 				val:        "\n",
 				ignoreCase: false,
 				want:       "\"\\n\"",
@@ -2708,19 +5076,24 @@ var g = &grammar{
 		},
 		{
 			name: "EOS",
-			pos:  position{line: 336, col: 1, offset: 10253},
+			pos:  position{line: 525, col: 1, offset: 17080},
+			id:   78,
 			expr: &choiceExpr{
-				pos: position{line: 336, col: 7, offset: 10261},
+				pos: position{line: 525, col: 7, offset: 17088},
+				id:  835,
 				alternatives: []any{
 					&seqExpr{
-						pos: position{line: 336, col: 7, offset: 10261},
+						pos: position{line: 525, col: 7, offset: 17088},
+						id:  836,
 						exprs: []any{
 							&ruleRefExpr{
-								pos:  position{line: 336, col: 7, offset: 10261},
+								pos:  position{line: 525, col: 7, offset: 17088},
+								id:   837,
 								name: "__",
 							},
 							&litMatcher{
-								pos:        position{line: 336, col: 10, offset: 10264},
+								pos:        position{line: 525, col: 10, offset: 17091},
+								id:         838,
 								val:        ";",
 								ignoreCase: false,
 								want:       "\";\"",
@@ -2728,34 +5101,42 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 336, col: 16, offset: 10270},
+						pos: position{line: 525, col: 16, offset: 17097},
+						id:  839,
 						exprs: []any{
 							&ruleRefExpr{
-								pos:  position{line: 336, col: 16, offset: 10270},
+								pos:  position{line: 525, col: 16, offset: 17097},
+								id:   840,
 								name: "_",
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 336, col: 18, offset: 10272},
+								pos: position{line: 525, col: 18, offset: 17099},
+								id:  841,
 								expr: &ruleRefExpr{
-									pos:  position{line: 336, col: 18, offset: 10272},
+									pos:  position{line: 525, col: 18, offset: 17099},
+									id:   842,
 									name: "SingleLineComment",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 336, col: 37, offset: 10291},
+								pos:  position{line: 525, col: 37, offset: 17118},
+								id:   843,
 								name: "EOL",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 336, col: 43, offset: 10297},
+						pos: position{line: 525, col: 43, offset: 17124},
+						id:  844,
 						exprs: []any{
 							&ruleRefExpr{
-								pos:  position{line: 336, col: 43, offset: 10297},
+								pos:  position{line: 525, col: 43, offset: 17124},
+								id:   845,
 								name: "__",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 336, col: 46, offset: 10300},
+								pos:  position{line: 525, col: 46, offset: 17127},
+								id:   846,
 								name: "EOF",
 							},
 						},
@@ -2765,11 +5146,14 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 338, col: 1, offset: 10305},
+			pos:  position{line: 527, col: 1, offset: 17132},
+			id:   79,
 			expr: &notExpr{
-				pos: position{line: 338, col: 7, offset: 10313},
+				pos: position{line: 527, col: 7, offset: 17140},
+				id:  847,
 				expr: &anyMatcher{
-					line: 338, col: 8, offset: 10314,
+					pos: position{line: 527, col: 8, offset: 17141},
+					id:  848,
 				},
 			},
 		},
@@ -2786,10 +5170,17 @@ func (c *current) onGrammar1(initializer, rules any) (any, error) {
 		g.Init = initSlice[0].(*ast.CodeBlock)
 	}
 
-	rulesSlice := toAnySlice(rules)
-	g.Rules = make([]*ast.Rule, len(rulesSlice))
-	for i, duo := range rulesSlice {
-		g.Rules[i] = duo.([]any)[0].(*ast.Rule)
+	// the failure label declarations are mixed with the rules
+	for _, duo := range toAnySlice(rules) {
+		switch v := duo.([]any)[0].(type) {
+		case *ast.Rule:
+			g.Rules = append(g.Rules, v)
+		case *ast.FailureLabelDecl:
+			g.FailureLabels = append(g.FailureLabels, v)
+		}
+	}
+	if len(g.Rules) == 0 {
+		return g, errors.New("grammar has no rule")
 	}
 
 	return g, nil
@@ -2811,14 +5202,20 @@ func (p *parser) callonInitializer1() (any, error) {
 	return p.cur.onInitializer1(stack["code"])
 }
 
-func (c *current) onRule1(name, display, expr any) (any, error) {
+func (c *current) onRule1(name, params, display, annotations, expr any) (any, error) {
 	pos := c.astPos()
 
 	rule := ast.NewRule(pos, name.(*ast.Identifier))
+	if params != nil {
+		rule.Params = params.([]*ast.Identifier)
+	}
 	displaySlice := toAnySlice(display)
 	if len(displaySlice) > 0 {
 		rule.DisplayName = displaySlice[0].(*ast.StringLit)
 	}
+	for _, sl := range toAnySlice(annotations) {
+		rule.Annotations = append(rule.Annotations, sl.([]any)[0].(*ast.Identifier))
+	}
 	rule.Expr = expr.(ast.Expression)
 
 	return rule, nil
@@ -2827,7 +5224,52 @@ func (c *current) onRule1(name, display, expr any) (any, error) {
 func (p *parser) callonRule1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onRule1(stack["name"], stack["display"], stack["expr"])
+	return p.cur.onRule1(stack["name"], stack["params"], stack["display"], stack["annotations"], stack["expr"])
+}
+
+func (c *current) onFailureLabelDecl1(label, message any) (any, error) {
+	decl := ast.NewFailureLabelDecl(c.astPos())
+	decl.Label = label.(*ast.Identifier)
+	msg, err := strconv.Unquote(message.(*ast.StringLit).Val)
+	if err != nil {
+		return decl, errors.New("invalid message template")
+	}
+	decl.Message = msg
+	return decl, nil
+}
+
+func (p *parser) callonFailureLabelDecl1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFailureLabelDecl1(stack["label"], stack["message"])
+}
+
+func (c *current) onRuleParams1(first, rest any) (any, error) {
+	params := []*ast.Identifier{first.(*ast.Identifier)}
+	for _, v := range toAnySlice(rest) {
+		params = append(params, v.([]any)[3].(*ast.Identifier))
+	}
+	return params, nil
+}
+
+func (p *parser) callonRuleParams1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRuleParams1(stack["first"], stack["rest"])
+}
+
+func (c *current) onRuleAnnotation1(name any) (any, error) {
+	ident := name.(*ast.Identifier)
+	if !ruleAnnotations[ident.Val] {
+		return ident, errors.New("invalid rule annotation")
+	}
+	return ident, nil
+}
+
+func (p *parser) callonRuleAnnotation1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRuleAnnotation1(stack["name"])
 }
 
 func (c *current) onRecoveryExpr1(expr, recoverExprs any) (any, error) {
@@ -2910,6 +5352,10 @@ func (p *parser) callonActionExpr1() (any, error) {
 func (c *current) onSeqExpr1(first, rest any) (any, error) {
 	restSlice := toAnySlice(rest)
 	if len(restSlice) == 0 {
+		// a single expression is its own value, plucked or not
+		if pluck, ok := first.(*ast.PluckExpr); ok {
+			return pluck.Expr, nil
+		}
 		return first, nil
 	}
 	seq := ast.NewSeqExpr(c.astPos())
@@ -2926,6 +5372,18 @@ func (p *parser) callonSeqExpr1() (any, error) {
 	return p.cur.onSeqExpr1(stack["first"], stack["rest"])
 }
 
+func (c *current) onPluckExpr2(expr any) (any, error) {
+	pluck := ast.NewPluckExpr(c.astPos())
+	pluck.Expr = expr.(ast.Expression)
+	return pluck, nil
+}
+
+func (p *parser) callonPluckExpr2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPluckExpr2(stack["expr"])
+}
+
 func (c *current) onLabeledExpr2(label, expr any) (any, error) {
 	pos := c.astPos()
 	lab := ast.NewLabeledExpr(pos)
@@ -2942,11 +5400,17 @@ func (p *parser) callonLabeledExpr2() (any, error) {
 
 func (c *current) onPrefixedExpr2(op, expr any) (any, error) {
 	pos := c.astPos()
-	opStr := op.(string)
-	if opStr == "&" {
+	switch op.(string) {
+	case "&":
 		and := ast.NewAndExpr(pos)
 		and.Expr = expr.(ast.Expression)
 		return and, nil
+	case "$":
+		// $label is a back-reference if the rule has the label, see
+		// ast.ResolveBackRefs.
+		text := ast.NewTextExpr(pos)
+		text.Expr = expr.(ast.Expression)
+		return text, nil
 	}
 	not := ast.NewNotExpr(pos)
 	not.Expr = expr.(ast.Expression)
@@ -2969,9 +5433,18 @@ func (p *parser) callonPrefixedOp1() (any, error) {
 	return p.cur.onPrefixedOp1()
 }
 
-func (c *current) onSuffixedExpr2(expr, op any) (any, error) {
+func (c *current) onSuffixedExpr1(expr, op any) (any, error) {
+	if op == nil {
+		return expr, nil
+	}
 	pos := c.astPos()
-	opStr := op.(string)
+	if bounds, ok := op.([2]int); ok {
+		rep := ast.NewRepeatExpr(pos)
+		rep.Expr = expr.(ast.Expression)
+		rep.Min, rep.Max = bounds[0], bounds[1]
+		return rep, nil
+	}
+	opStr := op.([]any)[1].(string)
 	switch opStr {
 	case "?":
 		zero := ast.NewZeroOrOneExpr(pos)
@@ -2990,10 +5463,10 @@ func (c *current) onSuffixedExpr2(expr, op any) (any, error) {
 	}
 }
 
-func (p *parser) callonSuffixedExpr2() (any, error) {
+func (p *parser) callonSuffixedExpr1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSuffixedExpr2(stack["expr"], stack["op"])
+	return p.cur.onSuffixedExpr1(stack["expr"], stack["op"])
 }
 
 func (c *current) onSuffixedOp1() (any, error) {
@@ -3006,26 +5479,110 @@ func (p *parser) callonSuffixedOp1() (any, error) {
 	return p.cur.onSuffixedOp1()
 }
 
-func (c *current) onPrimaryExpr7(expr any) (any, error) {
+func (c *current) onRepeatOp1(lo, hi any) (any, error) {
+	bounds := [2]int{lo.(int), lo.(int)}
+	if hi != nil {
+		bounds[1] = -1
+		if n := hi.([]any)[1]; n != nil {
+			bounds[1] = n.(int)
+		}
+	}
+	if bounds[1] >= 0 && bounds[1] < bounds[0] {
+		return [2]int{bounds[0], bounds[0]}, errors.New("invalid repetition bounds")
+	}
+	return bounds, nil
+}
+
+func (p *parser) callonRepeatOp1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRepeatOp1(stack["lo"], stack["hi"])
+}
+
+func (c *current) onRepeatBound1() (any, error) {
+	n, err := strconv.Atoi(string(c.text))
+	if err != nil {
+		return 0, errors.New("invalid repetition bound")
+	}
+	return n, nil
+}
+
+func (p *parser) callonRepeatBound1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRepeatBound1()
+}
+
+func (c *current) onPrimaryExpr11(expr any) (any, error) {
 	return expr, nil
 }
 
-func (p *parser) callonPrimaryExpr7() (any, error) {
+func (p *parser) callonPrimaryExpr11() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimaryExpr7(stack["expr"])
+	return p.cur.onPrimaryExpr11(stack["expr"])
 }
 
-func (c *current) onRuleRefExpr1(name any) (any, error) {
+func (c *current) onRuleRefExpr1(name, args any) (any, error) {
 	ref := ast.NewRuleRefExpr(c.astPos())
 	ref.Name = name.(*ast.Identifier)
+	if args != nil {
+		ref.Args = args.([]ast.Expression)
+	}
 	return ref, nil
 }
 
 func (p *parser) callonRuleRefExpr1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onRuleRefExpr1(stack["name"])
+	return p.cur.onRuleRefExpr1(stack["name"], stack["args"])
+}
+
+func (c *current) onRuleArgs1(first, rest any) (any, error) {
+	args := []ast.Expression{first.(ast.Expression)}
+	for _, v := range toAnySlice(rest) {
+		args = append(args, v.([]any)[3].(ast.Expression))
+	}
+	return args, nil
+}
+
+func (p *parser) callonRuleArgs1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRuleArgs1(stack["first"], stack["rest"])
+}
+
+func (c *current) onMatcherFuncExpr1(name any) (any, error) {
+	m := ast.NewMatcherFuncExpr(c.astPos())
+	m.Name = name.(*ast.Identifier)
+	return m, nil
+}
+
+func (p *parser) callonMatcherFuncExpr1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMatcherFuncExpr1(stack["name"])
+}
+
+func (c *current) onBackRefExpr1(state, name any) (any, error) {
+	pos := c.astPos()
+	if state == nil {
+		ref := ast.NewRuleRefExpr(pos)
+		ref.Name = name.(*ast.Identifier)
+		text := ast.NewTextExpr(pos)
+		text.Expr = ref
+		return text, nil
+	}
+	ref := ast.NewBackRefExpr(pos)
+	ref.Name = name.(*ast.Identifier)
+	ref.State = true
+	return ref, nil
+}
+
+func (p *parser) callonBackRefExpr1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBackRefExpr1(stack["state"], stack["name"])
 }
 
 func (c *current) onSemanticPredExpr1(op, code any) (any, error) {
@@ -3223,14 +5780,14 @@ func (p *parser) callonCharClassMatcher2() (any, error) {
 	return p.cur.onCharClassMatcher2()
 }
 
-func (c *current) onCharClassMatcher15() (any, error) {
+func (c *current) onCharClassMatcher14() (any, error) {
 	return ast.NewCharClassMatcher(c.astPos(), "[]"), errors.New("character class not terminated")
 }
 
-func (p *parser) callonCharClassMatcher15() (any, error) {
+func (p *parser) callonCharClassMatcher14() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onCharClassMatcher15()
+	return p.cur.onCharClassMatcher14()
 }
 
 func (c *current) onCharClassEscape5() (any, error) {
@@ -3253,8 +5810,9 @@ func (p *parser) callonUnicodeClassEscape5() (any, error) {
 	return p.cur.onUnicodeClassEscape5()
 }
 
-func (c *current) onUnicodeClassEscape13(ident any) (any, error) {
-	if !unicodeClasses[ident.(*ast.Identifier).Val] {
+func (c *current) onUnicodeClassEscape13(name any) (any, error) {
+	cls := ast.UnicodeClassName(name.(string))
+	if !unicodeClasses[cls] && !ast.IsDerivedClass(cls) {
 		return nil, errors.New("invalid Unicode class escape")
 	}
 	return nil, nil
@@ -3264,7 +5822,7 @@ func (c *current) onUnicodeClassEscape13(ident any) (any, error) {
 func (p *parser) callonUnicodeClassEscape13() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onUnicodeClassEscape13(stack["ident"])
+	return p.cur.onUnicodeClassEscape13(stack["name"])
 }
 
 func (c *current) onUnicodeClassEscape19() (any, error) {
@@ -3278,6 +5836,40 @@ func (p *parser) callonUnicodeClassEscape19() (any, error) {
 	return p.cur.onUnicodeClassEscape19()
 }
 
+func (c *current) onUnicodeClassName1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonUnicodeClassName1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnicodeClassName1()
+}
+
+func (c *current) onRegexpMatcher2() (any, error) {
+	re := ast.NewRegexpMatcher(c.astPos(), string(c.text))
+	if _, err := regexp.Compile(re.Expr); err != nil {
+		return re, err
+	}
+	return re, nil
+}
+
+func (p *parser) callonRegexpMatcher2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRegexpMatcher2()
+}
+
+func (c *current) onRegexpMatcher8() (any, error) {
+	return ast.NewRegexpMatcher(c.astPos(), `re""`), errors.New("regular expression not terminated")
+}
+
+func (p *parser) callonRegexpMatcher8() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRegexpMatcher8()
+}
+
 func (c *current) onAnyMatcher1() (any, error) {
 	any := ast.NewAnyMatcher(c.astPos(), ".")
 	return any, nil
@@ -3289,6 +5881,16 @@ func (p *parser) callonAnyMatcher1() (any, error) {
 	return p.cur.onAnyMatcher1()
 }
 
+func (c *current) onCutExpr1() (any, error) {
+	return ast.NewCutExpr(c.astPos()), nil
+}
+
+func (p *parser) callonCutExpr1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCutExpr1()
+}
+
 func (c *current) onThrowExpr2(label any) (any, error) {
 	t := ast.NewThrowExpr(c.astPos())
 	t.Label = label.(*ast.Identifier).Val
@@ -3301,14 +5903,65 @@ func (p *parser) callonThrowExpr2() (any, error) {
 	return p.cur.onThrowExpr2(stack["label"])
 }
 
-func (c *current) onThrowExpr9() (any, error) {
+func (c *current) onThrowExpr13() (any, error) {
 	return nil, errors.New("throw expression not terminated")
 }
 
-func (p *parser) callonThrowExpr9() (any, error) {
+func (p *parser) callonThrowExpr13() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onThrowExpr13()
+}
+
+func (c *current) onPrecedenceExpr1(operand, levels any) (any, error) {
+	prec := ast.NewPrecedenceExpr(c.astPos())
+	prec.Operand = operand.(ast.Expression)
+	for _, sl := range toAnySlice(levels) {
+		prec.Levels = append(prec.Levels, sl.([]any)[1].(*ast.PrecedenceLevel))
+	}
+	return prec, nil
+}
+
+func (p *parser) callonPrecedenceExpr1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrecedenceExpr1(stack["operand"], stack["levels"])
+}
+
+func (c *current) onPrecedenceLevel1(kind, op, code any) (any, error) {
+	level := ast.NewPrecedenceLevel(c.astPos(), kind.(ast.PrecedenceKind))
+	level.Op = op.(ast.Expression)
+	if code != nil {
+		level.Code = code.([]any)[1].(*ast.CodeBlock)
+	}
+	return level, nil
+}
+
+func (p *parser) callonPrecedenceLevel1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrecedenceLevel1(stack["kind"], stack["op"], stack["code"])
+}
+
+func (c *current) onPrecedenceKind1() (any, error) {
+	switch string(c.text[1:]) {
+	case "left":
+		return ast.PrecLeft, nil
+	case "right":
+		return ast.PrecRight, nil
+	case "nonassoc":
+		return ast.PrecNone, nil
+	case "prefix":
+		return ast.PrecPrefix, nil
+	default:
+		return ast.PrecPostfix, nil
+	}
+}
+
+func (p *parser) callonPrecedenceKind1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onThrowExpr9()
+	return p.cur.onPrecedenceKind1()
 }
 
 func (c *current) onCodeBlock2() (any, error) {
@@ -3387,7 +6040,8 @@ func Entrypoint(ruleName string) Option {
 
 // Statistics adds a user provided Stats struct to the parser to allow
 // the user to process the results after the parsing has finished.
-// Also the key for the "no match" counter is set.
+// Also the key for the "no match" counter is set. Per-rule statistics
+// (see RuleStats) are only collected when this option is set.
 //
 // Example usage:
 //
@@ -3411,6 +6065,9 @@ func Statistics(stats *Stats, choiceNoMatch string) Option {
 		if p.Stats.ChoiceAltCnt == nil {
 			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
 		}
+		if p.Stats.Rules == nil {
+			p.Stats.Rules = make(map[string]*RuleStats)
+		}
 		return Statistics(oldStats, oldChoiceNoMatch)
 	}
 }
@@ -3431,6 +6088,9 @@ func Debug(b bool) Option {
 // the parser will cache all results so each expression is evaluated only
 // once. This guarantees linear parsing time even for pathological cases,
 // at the expense of more memory and slower times for typical cases.
+// The rules annotated with @memo in the grammar are always memoized,
+// regardless of this option. The memoization table is pooled, so its
+// storage is reused by the following parses.
 //
 // The default is false.
 func Memoize(b bool) Option {
@@ -3455,6 +6115,57 @@ func AllowInvalidUTF8(b bool) Option {
 	}
 }
 
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
 // Recover creates an Option to set the recover flag to b. When set to
 // true, this causes the parser to recover from panics and convert it
 // to an error. Setting it to false can be useful while debugging to
@@ -3570,17 +6281,68 @@ type rule struct {
 	name        string
 	displayName string
 	expr        any
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+//
+//	nolint: structcheck
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
 }
 
 // nolint: structcheck
 type choiceExpr struct {
 	pos          position
+	id           int
 	alternatives []any
+	dispatch     *choiceDispatch
 }
 
 // nolint: structcheck
 type actionExpr struct {
 	pos  position
+	id   int
 	expr any
 	run  func(*parser) (any, error)
 }
@@ -3588,6 +6350,7 @@ type actionExpr struct {
 // nolint: structcheck
 type recoveryExpr struct {
 	pos          position
+	id           int
 	expr         any
 	recoverExpr  any
 	failureLabel []string
@@ -3596,18 +6359,14 @@ type recoveryExpr struct {
 // nolint: structcheck
 type seqExpr struct {
 	pos   position
+	id    int
 	exprs []any
 }
 
-// nolint: structcheck
-type throwExpr struct {
-	pos   position
-	label string
-}
-
 // nolint: structcheck
 type labeledExpr struct {
 	pos   position
+	id    int
 	label string
 	expr  any
 }
@@ -3615,7 +6374,18 @@ type labeledExpr struct {
 // nolint: structcheck
 type expr struct {
 	pos  position
+	id   int
+	expr any
+}
+
+// nolint: structcheck
+type repeatExpr struct {
+	pos  position
+	id   int
 	expr any
+	// max is -1 if the number of repetitions is not bounded.
+	min int
+	max int
 }
 
 type (
@@ -3629,30 +6399,35 @@ type (
 // nolint: structcheck
 type ruleRefExpr struct {
 	pos  position
+	id   int
 	name string
 }
 
 // nolint: structcheck
 type stateCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) error
 }
 
 // nolint: structcheck
 type andCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) (bool, error)
 }
 
 // nolint: structcheck
 type notCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) (bool, error)
 }
 
 // nolint: structcheck
 type litMatcher struct {
 	pos        position
+	id         int
 	val        string
 	ignoreCase bool
 	want       string
@@ -3661,6 +6436,7 @@ type litMatcher struct {
 // nolint: structcheck
 type charClassMatcher struct {
 	pos             position
+	id              int
 	val             string
 	basicLatinChars [128]bool
 	chars           []rune
@@ -3670,7 +6446,61 @@ type charClassMatcher struct {
 	inverted        bool
 }
 
-type anyMatcher position // nolint: structcheck
+// nolint: structcheck
+type anyMatcher struct {
+	pos position
+	id  int
+}
+
+// matchCaseFold matches the full case folding of the input against val,
+// the case folding of a case-insensitive literal. The input is consumed
+// if it matches, it must be restored otherwise.
+func (p *parser) matchCaseFold(val string) bool {
+	for len(val) > 0 {
+		if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+			// EOF - see utf8.DecodeRune
+			return false
+		}
+		if f, ok := fullCaseFolds[p.pt.rn]; ok {
+			if !strings.HasPrefix(val, f) {
+				return false
+			}
+			val = val[len(f):]
+		} else {
+			want, n := utf8.DecodeRuneInString(val)
+			if caseFold(p.pt.rn) != want {
+				return false
+			}
+			val = val[n:]
+		}
+		p.read()
+	}
+	return true
+}
+
+// caseFold returns the simple case folding of rn, the smallest lowercase
+// rune of its orbit for unicode.SimpleFold, or its smallest rune if it has
+// no lowercase rune.
+func caseFold(rn rune) rune {
+	if rn < utf8.RuneSelf {
+		if 'A' <= rn && rn <= 'Z' {
+			rn += 'a' - 'A'
+		}
+		return rn
+	}
+
+	folded := rn
+	for r := unicode.SimpleFold(rn); r != rn; r = unicode.SimpleFold(r) {
+		if lower := unicode.IsLower(r); lower != unicode.IsLower(folded) {
+			if lower {
+				folded = r
+			}
+		} else if r < folded {
+			folded = r
+		}
+	}
+	return folded
+}
 
 // errList cumulates the errors found by the parser.
 type errList []error
@@ -3755,6 +6585,7 @@ func newParser(filename string, b []byte, opts ...Option) *parser {
 		entrypoint: g.rules[0].name,
 	}
 	p.setOptions(opts)
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
 
 	if p.maxExprCnt == 0 {
 		p.maxExprCnt = math.MaxUint64
@@ -3790,7 +6621,8 @@ type Stats struct {
 	// ChoiceAltCnt is used to count for each ordered choice expression,
 	// which alternative is used how may times.
 	// These numbers allow to optimize the order of the ordered choice expression
-	// to increase the performance of the parser
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
 	//
 	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
 	// as the line and the column of the ordered choice.
@@ -3800,6 +6632,46 @@ type Stats struct {
 	// the parser option Statistics.
 	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
 	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
 }
 
 // nolint: structcheck,maligned
@@ -3816,9 +6688,9 @@ type parser struct {
 	debug   bool
 
 	memoize bool
-	// memoization table for the packrat algorithm:
-	// map[offset in source] map[expression or rule] {value, match}
-	memo map[int]map[any]resultTuple
+	// memoization table for the packrat algorithm, taken from memoTables
+	// on the first memoized result
+	memo *memoTable
 
 	// rules table, maps the rule identifier to the rule node
 	rules map[string]*rule
@@ -3839,11 +6711,33 @@ type parser struct {
 
 	allowInvalidUTF8 bool
 
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+
 	*Stats
+	// profiling frames, tracks the time spent in invoked rules to compute
+	// the self time of each rule.
+	profStack []profFrame
+	// number of active invocations of each rule, the time of a recursive
+	// rule is only added at its outermost invocation.
+	profActive map[*rule]int
+	// rules evaluated at each offset, to count the repeated evaluations.
+	profEvals map[profEval]bool
 
 	choiceNoMatch string
 	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
-	recoveryStack []map[string]any
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
 }
 
 // push a variable set on the vstack.
@@ -3882,7 +6776,7 @@ func (p *parser) popV() {
 func (p *parser) pushRecovery(labels []string, expr any) {
 	if cap(p.recoveryStack) == len(p.recoveryStack) {
 		// create new empty slot in the stack
-		p.recoveryStack = append(p.recoveryStack, nil)
+		p.recoveryStack = append(p.recoveryStack, recovery{})
 	} else {
 		// slice to 1 more
 		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
@@ -3892,13 +6786,13 @@ func (p *parser) pushRecovery(labels []string, expr any) {
 	for _, fl := range labels {
 		m[fl] = expr
 	}
-	p.recoveryStack[len(p.recoveryStack)-1] = m
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
 }
 
 // pop a recovery expression from the recoveryStack
 func (p *parser) popRecovery() {
 	// GC that map
-	p.recoveryStack[len(p.recoveryStack)-1] = nil
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
 
 	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
 }
@@ -3908,8 +6802,9 @@ func (p *parser) print(prefix, s string) string {
 		return s
 	}
 
+	pos := p.pt.position
 	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
-		prefix, p.pt.line, p.pt.col, p.pt.offset, s, p.pt.rn)
+		prefix, pos.line, pos.col, pos.offset, s, p.pt.rn)
 	return s
 }
 
@@ -3977,14 +6872,19 @@ func (p *parser) failAt(fail bool, pos position, want string) {
 
 // read advances the parser to the next rune.
 func (p *parser) read() {
-	p.pt.offset += p.pt.w
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
 	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
 	p.pt.rn = rn
 	p.pt.w = n
-	p.pt.col++
-	if rn == '\n' {
-		p.pt.line++
-		p.pt.col = 0
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
 	}
 
 	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
@@ -3994,6 +6894,36 @@ func (p *parser) read() {
 	}
 }
 
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
 // restore parser position to the savepoint pt.
 func (p *parser) restore(pt savepoint) {
 	if p.debug {
@@ -4002,6 +6932,9 @@ func (p *parser) restore(pt savepoint) {
 	if pt.offset == p.pt.offset {
 		return
 	}
+	if p.Rules != nil && pt.offset < p.pt.offset && len(p.rstack) > 0 {
+		p.ruleStats(p.rstack[len(p.rstack)-1]).Backtracked += uint64(p.pt.offset - pt.offset)
+	}
 	p.pt = pt
 }
 
@@ -4059,28 +6992,109 @@ func (p *parser) sliceFrom(start savepoint) []byte {
 	return p.data[start.position.offset:p.pt.position.offset]
 }
 
-func (p *parser) getMemoized(node any) (resultTuple, bool) {
-	if len(p.memo) == 0 {
-		return resultTuple{}, false
+// memoSlot is an entry of the memoization table, the key of an empty slot
+// is 0.
+type memoSlot struct {
+	key uint64
+	res resultTuple
+}
+
+// memoTable is the memoization table for the packrat algorithm, an
+// open-addressed hash table with linear probing that maps the offset in
+// the source and the id of a rule or expression to its result.
+type memoTable struct {
+	slots []memoSlot
+	shift uint
+	n     int
+}
+
+// memoTables pools the memoization tables so that their storage is
+// reused across parses.
+var memoTables = &sync.Pool{
+	New: func() any {
+		return &memoTable{slots: make([]memoSlot, 1<<10), shift: 64 - 10}
+	},
+}
+
+// memoKey returns the key of the result of the rule or expression id at
+// offset, it supports up to 1<<24 ids.
+func memoKey(offset, id int) uint64 {
+	return uint64(offset+1)<<24 | uint64(id)
+}
+
+// index returns the index of the slot of key, or of the empty slot where
+// it should be stored.
+func (t *memoTable) index(key uint64) int {
+	mask := len(t.slots) - 1
+	i := int((key*0x9E3779B97F4A7C15)>>t.shift) & mask
+	for t.slots[i].key != 0 && t.slots[i].key != key {
+		i = (i + 1) & mask
+	}
+	return i
+}
+
+func (t *memoTable) get(key uint64) (resultTuple, bool) {
+	s := &t.slots[t.index(key)]
+	return s.res, s.key == key
+}
+
+func (t *memoTable) set(key uint64, res resultTuple) {
+	if 4*(t.n+1) > 3*len(t.slots) {
+		t.grow()
+	}
+	s := &t.slots[t.index(key)]
+	if s.key == 0 {
+		s.key = key
+		t.n++
+	}
+	s.res = res
+}
+
+// grow doubles the capacity of the table.
+func (t *memoTable) grow() {
+	old := t.slots
+	t.slots = make([]memoSlot, 2*len(old))
+	t.shift--
+	for _, s := range old {
+		if s.key != 0 {
+			t.slots[t.index(s.key)] = s
+		}
+	}
+}
+
+// reset empties the table, releasing the references to the results.
+func (t *memoTable) reset() {
+	if t.n == 0 {
+		return
+	}
+	for i := range t.slots {
+		t.slots[i] = memoSlot{}
 	}
-	m := p.memo[p.pt.offset]
-	if len(m) == 0 {
+	t.n = 0
+}
+
+func (p *parser) getMemoized(id int) (resultTuple, bool) {
+	if p.memo == nil {
 		return resultTuple{}, false
 	}
-	res, ok := m[node]
-	return res, ok
+	return p.memo.get(memoKey(p.pt.offset, id))
 }
 
-func (p *parser) setMemoized(pt savepoint, node any, tuple resultTuple) {
+func (p *parser) setMemoized(pt savepoint, id int, tuple resultTuple) {
 	if p.memo == nil {
-		p.memo = make(map[int]map[any]resultTuple)
+		p.memo = memoTables.Get().(*memoTable)
 	}
-	m := p.memo[pt.offset]
-	if m == nil {
-		m = make(map[any]resultTuple)
-		p.memo[pt.offset] = m
+	p.memo.set(memoKey(pt.offset, id), tuple)
+}
+
+// releaseMemo returns the memoization table to the pool.
+func (p *parser) releaseMemo() {
+	if p.memo == nil {
+		return
 	}
-	m[node] = tuple
+	p.memo.reset()
+	memoTables.Put(p.memo)
+	p.memo = nil
 }
 
 func (p *parser) buildRulesTable(g *grammar) {
@@ -4099,6 +7113,7 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 
 	// TODO : not super critical but this could be generated
 	p.buildRulesTable(g)
+	defer p.releaseMemo()
 
 	if p.recover {
 		// panic can be used in action code to stop parsing immediately
@@ -4132,24 +7147,7 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 		if len(*p.errs) == 0 {
 			// If parsing fails, but no errors have been recorded, the expected values
 			// for the farthest parser position are returned as error.
-			maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
-			for _, v := range p.maxFailExpected {
-				maxFailExpectedMap[v] = struct{}{}
-			}
-			expected := make([]string, 0, len(maxFailExpectedMap))
-			eof := false
-			if _, ok := maxFailExpectedMap["!."]; ok {
-				delete(maxFailExpectedMap, "!.")
-				eof = true
-			}
-			for k := range maxFailExpectedMap {
-				expected = append(expected, k)
-			}
-			sort.Strings(expected)
-			if eof {
-				expected = append(expected, "EOF")
-			}
-			p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+			p.addNoMatchErr()
 		}
 
 		return nil, p.errs.err()
@@ -4157,6 +7155,36 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 	return val, p.errs.err()
 }
 
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
 func listJoin(list []string, sep string, lastSep string) string {
 	switch len(list) {
 	case 0:
@@ -4168,8 +7196,90 @@ func listJoin(list []string, sep string, lastSep string) string {
 	}
 }
 
+// profFrame is a rule invocation being profiled.
+type profFrame struct {
+	start time.Time
+	child time.Duration
+}
+
+// profEval is the evaluation of a rule at an offset.
+type profEval struct {
+	rule   *rule
+	offset int
+}
+
+// ruleStats returns the statistics of rule, creating them if needed.
+func (p *parser) ruleStats(rule *rule) *RuleStats {
+	rs := p.Rules[rule.name]
+	if rs == nil {
+		rs = &RuleStats{}
+		p.Rules[rule.name] = rs
+	}
+	return rs
+}
+
+func (p *parser) countMemo(rs *RuleStats, hit bool) {
+	if hit {
+		rs.MemoHits++
+	} else {
+		rs.MemoMisses++
+	}
+}
+
+func (p *parser) profEnter(r *rule) {
+	if p.profActive == nil {
+		p.profActive = make(map[*rule]int)
+	}
+	p.profActive[r]++
+	p.profStack = append(p.profStack, profFrame{start: time.Now()})
+}
+
+// countRepeat counts the evaluation of rule at the current offset if
+// it was already evaluated at that offset.
+func (p *parser) countRepeat(rule *rule) {
+	if p.profEvals == nil {
+		p.profEvals = make(map[profEval]bool)
+	}
+	key := profEval{rule: rule, offset: p.pt.offset}
+	if p.profEvals[key] {
+		p.ruleStats(rule).Repeats++
+		return
+	}
+	p.profEvals[key] = true
+}
+
+func (p *parser) profExit(rule *rule, start savepoint, ok bool) {
+	frame := p.profStack[len(p.profStack)-1]
+	p.profStack = p.profStack[:len(p.profStack)-1]
+	elapsed := time.Since(frame.start)
+	if n := len(p.profStack); n > 0 {
+		p.profStack[n-1].child += elapsed
+	}
+
+	rs := p.ruleStats(rule)
+	rs.Invocations++
+	if p.profActive[rule]--; p.profActive[rule] == 0 {
+		// the time of the inner invocations is included
+		rs.Time += elapsed
+	}
+	rs.SelfTime += elapsed - frame.child
+	if ok {
+		rs.Matches++
+		rs.Bytes += uint64(p.pt.offset - start.offset)
+	} else {
+		rs.Failures++
+	}
+}
+
 func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
-	res, ok := p.getMemoized(rule)
+	if rule.id < 0 {
+		// its results depend on the captured texts or the state store
+		return p.parseRule(rule)
+	}
+	res, ok := p.getMemoized(rule.id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(rule), ok)
+	}
 	if ok {
 		p.restore(res.end)
 		return res.v, res.b
@@ -4177,7 +7287,7 @@ func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
 
 	startMark := p.pt
 	val, ok := p.parseRule(rule)
-	p.setMemoized(startMark, rule, resultTuple{val, ok, p.pt})
+	p.setMemoized(startMark, rule.id, resultTuple{v: val, b: ok, end: p.pt})
 
 	return val, ok
 }
@@ -4192,6 +7302,11 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 		startMark = p.pt
 	)
 
+	if p.Rules != nil {
+		p.profEnter(rule)
+		defer func() { p.profExit(rule, startMark, ok) }()
+	}
+
 	if p.memoize {
 		val, ok = p.parseRuleMemoize(rule)
 	} else {
@@ -4205,6 +7320,9 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 }
 
 func (p *parser) parseRule(rule *rule) (any, bool) {
+	if p.Rules != nil {
+		p.countRepeat(rule)
+	}
 	p.rstack = append(p.rstack, rule)
 	p.pushV()
 	val, ok := p.parseExprWrap(rule.expr)
@@ -4213,11 +7331,32 @@ func (p *parser) parseRule(rule *rule) (any, bool) {
 	return val, ok
 }
 
+// incChoiceAltCnt counts the match of the alternative altI of the choice
+// at pos, or its failure if altI is choiceNoMatch.
+func (p *parser) incChoiceAltCnt(pos position, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, pos.line, pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
 func (p *parser) parseExprWrap(expr any) (any, bool) {
 	var pt savepoint
+	id := exprID(expr)
 
-	if p.memoize {
-		res, ok := p.getMemoized(expr)
+	if p.memoize && id >= 0 {
+		res, ok := p.getMemoized(id)
+		if p.Rules != nil {
+			p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+		}
 		if ok {
 			p.restore(res.end)
 			return res.v, res.b
@@ -4227,12 +7366,60 @@ func (p *parser) parseExprWrap(expr any) (any, bool) {
 
 	val, ok := p.parseExpr(expr)
 
-	if p.memoize {
-		p.setMemoized(pt, expr, resultTuple{val, ok, p.pt})
+	if p.memoize && id >= 0 {
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
 	}
 	return val, ok
 }
 
+// exprID returns the id of the expression in the memoization table, -1 if
+// its results depend on the texts captured by the labels or on the state
+// store and are not memoized.
+//
+//	nolint: gocyclo
+func exprID(expr any) int {
+	switch expr := expr.(type) {
+	case *actionExpr:
+		return expr.id
+	case *andCodeExpr:
+		return expr.id
+	case *andExpr:
+		return expr.id
+	case *anyMatcher:
+		return expr.id
+	case *charClassMatcher:
+		return expr.id
+	case *choiceExpr:
+		return expr.id
+	case *labeledExpr:
+		return expr.id
+	case *litMatcher:
+		return expr.id
+	case *notCodeExpr:
+		return expr.id
+	case *notExpr:
+		return expr.id
+	case *oneOrMoreExpr:
+		return expr.id
+	case *recoveryExpr:
+		return expr.id
+	case *repeatExpr:
+		return expr.id
+	case *ruleRefExpr:
+		return expr.id
+	case *seqExpr:
+		return expr.id
+	case *stateCodeExpr:
+		return expr.id
+	case *zeroOrMoreExpr:
+		return expr.id
+	case *zeroOrOneExpr:
+		return expr.id
+	default:
+		panic(fmt.Sprintf("unknown expression type %T", expr))
+	}
+}
+
 // nolint: gocyclo
 func (p *parser) parseExpr(expr any) (any, bool) {
 	p.ExprCnt++
@@ -4267,14 +7454,14 @@ func (p *parser) parseExpr(expr any) (any, bool) {
 		val, ok = p.parseOneOrMoreExpr(expr)
 	case *recoveryExpr:
 		val, ok = p.parseRecoveryExpr(expr)
+	case *repeatExpr:
+		val, ok = p.parseRepeatExpr(expr)
 	case *ruleRefExpr:
 		val, ok = p.parseRuleRefExpr(expr)
 	case *seqExpr:
 		val, ok = p.parseSeqExpr(expr)
 	case *stateCodeExpr:
 		val, ok = p.parseStateCodeExpr(expr)
-	case *throwExpr:
-		val, ok = p.parseThrowExpr(expr)
 	case *zeroOrMoreExpr:
 		val, ok = p.parseZeroOrMoreExpr(expr)
 	case *zeroOrOneExpr:
@@ -4374,7 +7561,7 @@ func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
 	}
 
 	if chr.ignoreCase {
-		cur = unicode.ToLower(cur)
+		cur = caseFold(cur)
 	}
 
 	// try to match in the list of available chars
@@ -4425,29 +7612,29 @@ func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
 	return nil, false
 }
 
-func (p *parser) incChoiceAltCnt(ch *choiceExpr, altI int) {
-	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, ch.pos.line, ch.pos.col)
-	m := p.ChoiceAltCnt[choiceIdent]
-	if m == nil {
-		m = make(map[string]int)
-		p.ChoiceAltCnt[choiceIdent] = m
-	}
-	// We increment altI by 1, so the keys do not start at 0
-	alt := strconv.Itoa(altI + 1)
-	if altI == choiceNoMatch {
-		alt = p.choiceNoMatch
-	}
-	m[alt]++
-}
-
 func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseChoiceExpr"))
 	}
 
-	for altI, alt := range ch.alternatives {
-		// dummy assignment to prevent compile error if optimized
-		_ = altI
+	n := len(ch.alternatives)
+	var alts []int
+	if d := ch.dispatch; d != nil {
+		// the alternatives that cannot match the current rune are skipped,
+		// with the values they expect.
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		alts = d.alts[set]
+		n = len(alts)
+	}
+	for i := 0; i < n; i++ {
+		altI := i
+		if alts != nil {
+			altI = alts[i]
+		}
+		alt := ch.alternatives[altI]
 
 		state := p.cloneState()
 
@@ -4455,12 +7642,12 @@ func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 		val, ok := p.parseExprWrap(alt)
 		p.popV()
 		if ok {
-			p.incChoiceAltCnt(ch, altI)
+			p.incChoiceAltCnt(ch.pos, altI)
 			return val, ok
 		}
 		p.restoreState(state)
 	}
-	p.incChoiceAltCnt(ch, choiceNoMatch)
+	p.incChoiceAltCnt(ch.pos, choiceNoMatch)
 	return nil, false
 }
 
@@ -4485,6 +7672,15 @@ func (p *parser) parseLitMatcher(lit *litMatcher) (any, bool) {
 	}
 
 	start := p.pt
+	if lit.ignoreCase {
+		if !p.matchCaseFold(lit.val) {
+			p.failAt(false, start.position, lit.want)
+			p.restore(start)
+			return nil, false
+		}
+		p.failAt(true, start.position, lit.want)
+		return p.sliceFrom(start), true
+	}
 	for _, want := range lit.val {
 		cur := p.pt.rn
 		if lit.ignoreCase {
@@ -4569,6 +7765,33 @@ func (p *parser) parseRecoveryExpr(recover *recoveryExpr) (any, bool) {
 	return val, ok
 }
 
+func (p *parser) parseRepeatExpr(expr *repeatExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRepeatExpr"))
+	}
+
+	var vals []any
+
+	pt := p.pt
+	state := p.cloneState()
+	for expr.max < 0 || len(vals) < expr.max {
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			break
+		}
+		vals = append(vals, val)
+	}
+	if len(vals) < expr.min {
+		// did not match enough times, no match
+		p.restoreState(state)
+		p.restore(pt)
+		return nil, false
+	}
+	return vals, true
+}
+
 func (p *parser) parseRuleRefExpr(ref *ruleRefExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseRuleRefExpr " + ref.name))
@@ -4619,22 +7842,6 @@ func (p *parser) parseStateCodeExpr(state *stateCodeExpr) (any, bool) {
 	return nil, true
 }
 
-func (p *parser) parseThrowExpr(expr *throwExpr) (any, bool) {
-	if p.debug {
-		defer p.out(p.in("parseThrowExpr"))
-	}
-
-	for i := len(p.recoveryStack) - 1; i >= 0; i-- {
-		if recoverExpr, ok := p.recoveryStack[i][expr.label]; ok {
-			if val, ok := p.parseExprWrap(recoverExpr); ok {
-				return val, ok
-			}
-		}
-	}
-
-	return nil, false
-}
-
 func (p *parser) parseZeroOrMoreExpr(expr *zeroOrMoreExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseZeroOrMoreExpr"))
@@ -4679,3 +7886,112 @@ func rangeTable(class string) *unicode.RangeTable {
 	// cannot happen
 	panic(fmt.Sprintf("invalid Unicode class: %s", class))
 }
+
+// fullCaseFolds are the full case foldings of more than one rune, with
+// the simple case folding of their runes.
+var fullCaseFolds = map[rune]string{
+	0x00df: "ss",
+	0x0130: "i\u0307",
+	0x0149: "\u02bcn",
+	0x01f0: "j\u030c",
+	0x0390: "\u03b9\u0308\u0301",
+	0x03b0: "\u03c5\u0308\u0301",
+	0x0587: "\u0565\u0582",
+	0x1e96: "h\u0331",
+	0x1e97: "t\u0308",
+	0x1e98: "w\u030a",
+	0x1e99: "y\u030a",
+	0x1e9a: "a\u02be",
+	0x1e9e: "ss",
+	0x1f50: "\u03c5\u0313",
+	0x1f52: "\u03c5\u0313\u0300",
+	0x1f54: "\u03c5\u0313\u0301",
+	0x1f56: "\u03c5\u0313\u0342",
+	0x1f80: "\u1f00\u03b9",
+	0x1f81: "\u1f01\u03b9",
+	0x1f82: "\u1f02\u03b9",
+	0x1f83: "\u1f03\u03b9",
+	0x1f84: "\u1f04\u03b9",
+	0x1f85: "\u1f05\u03b9",
+	0x1f86: "\u1f06\u03b9",
+	0x1f87: "\u1f07\u03b9",
+	0x1f88: "\u1f00\u03b9",
+	0x1f89: "\u1f01\u03b9",
+	0x1f8a: "\u1f02\u03b9",
+	0x1f8b: "\u1f03\u03b9",
+	0x1f8c: "\u1f04\u03b9",
+	0x1f8d: "\u1f05\u03b9",
+	0x1f8e: "\u1f06\u03b9",
+	0x1f8f: "\u1f07\u03b9",
+	0x1f90: "\u1f20\u03b9",
+	0x1f91: "\u1f21\u03b9",
+	0x1f92: "\u1f22\u03b9",
+	0x1f93: "\u1f23\u03b9",
+	0x1f94: "\u1f24\u03b9",
+	0x1f95: "\u1f25\u03b9",
+	0x1f96: "\u1f26\u03b9",
+	0x1f97: "\u1f27\u03b9",
+	0x1f98: "\u1f20\u03b9",
+	0x1f99: "\u1f21\u03b9",
+	0x1f9a: "\u1f22\u03b9",
+	0x1f9b: "\u1f23\u03b9",
+	0x1f9c: "\u1f24\u03b9",
+	0x1f9d: "\u1f25\u03b9",
+	0x1f9e: "\u1f26\u03b9",
+	0x1f9f: "\u1f27\u03b9",
+	0x1fa0: "\u1f60\u03b9",
+	0x1fa1: "\u1f61\u03b9",
+	0x1fa2: "\u1f62\u03b9",
+	0x1fa3: "\u1f63\u03b9",
+	0x1fa4: "\u1f64\u03b9",
+	0x1fa5: "\u1f65\u03b9",
+	0x1fa6: "\u1f66\u03b9",
+	0x1fa7: "\u1f67\u03b9",
+	0x1fa8: "\u1f60\u03b9",
+	0x1fa9: "\u1f61\u03b9",
+	0x1faa: "\u1f62\u03b9",
+	0x1fab: "\u1f63\u03b9",
+	0x1fac: "\u1f64\u03b9",
+	0x1fad: "\u1f65\u03b9",
+	0x1fae: "\u1f66\u03b9",
+	0x1faf: "\u1f67\u03b9",
+	0x1fb2: "\u1f70\u03b9",
+	0x1fb3: "\u03b1\u03b9",
+	0x1fb4: "\u03ac\u03b9",
+	0x1fb6: "\u03b1\u0342",
+	0x1fb7: "\u03b1\u0342\u03b9",
+	0x1fbc: "\u03b1\u03b9",
+	0x1fc2: "\u1f74\u03b9",
+	0x1fc3: "\u03b7\u03b9",
+	0x1fc4: "\u03ae\u03b9",
+	0x1fc6: "\u03b7\u0342",
+	0x1fc7: "\u03b7\u0342\u03b9",
+	0x1fcc: "\u03b7\u03b9",
+	0x1fd2: "\u03b9\u0308\u0300",
+	0x1fd3: "\u03b9\u0308\u0301",
+	0x1fd6: "\u03b9\u0342",
+	0x1fd7: "\u03b9\u0308\u0342",
+	0x1fe2: "\u03c5\u0308\u0300",
+	0x1fe3: "\u03c5\u0308\u0301",
+	0x1fe4: "\u03c1\u0313",
+	0x1fe6: "\u03c5\u0342",
+	0x1fe7: "\u03c5\u0308\u0342",
+	0x1ff2: "\u1f7c\u03b9",
+	0x1ff3: "\u03c9\u03b9",
+	0x1ff4: "\u03ce\u03b9",
+	0x1ff6: "\u03c9\u0342",
+	0x1ff7: "\u03c9\u0342\u03b9",
+	0x1ffc: "\u03c9\u03b9",
+	0xfb00: "ff",
+	0xfb01: "fi",
+	0xfb02: "fl",
+	0xfb03: "ffi",
+	0xfb04: "ffl",
+	0xfb05: "st",
+	0xfb06: "st",
+	0xfb13: "\u0574\u0576",
+	0xfb14: "\u0574\u0565",
+	0xfb15: "\u0574\u056b",
+	0xfb16: "\u057e\u0576",
+	0xfb17: "\u0574\u056d",
+}
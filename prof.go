@@ -0,0 +1,312 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+)
+
+var profUsagePage = `usage: %s prof [options] STATS_FILE
+
+Prof prints a report of the hottest rules from the statistics collected
+by a generated parser created with the Statistics option. STATS_FILE
+must contain the JSON encoding of the parser's Stats struct, e.g.:
+
+	stats := Stats{}
+	_, err := Parse("file", input, Statistics(&stats, "no match"))
+	...
+	b, err := json.Marshal(stats)
+
+	-format FORMAT
+		output format, either "text" (the default) or "pprof". The pprof
+		format is a gzip-compressed profile.proto that can be read by
+		"go tool pprof", with one sample per rule.
+	-memo
+		print the rules that were evaluated more than once at the same
+		offset instead of the profile, sorted by the estimated time spent
+		in those repeated evaluations. They are the candidates for the
+		@memo rule annotation.
+	-o OUTPUT_FILE
+		write the report to OUTPUT_FILE. Defaults to stdout.
+	-sort KEY
+		sort the rules by KEY, one of "self" (the default), "time",
+		"calls", "fails", "bytes", "backtracked" or "repeats".
+	-top N
+		print only the N hottest rules in the text format. Defaults to
+		0, which prints all rules.
+`
+
+// profRule is a rule's name with its statistics.
+type profRule struct {
+	name string
+	*RuleStats
+}
+
+// profSortKeys maps the -sort flag values to the value to sort on.
+var profSortKeys = map[string]func(*RuleStats) uint64{
+	"self":        func(rs *RuleStats) uint64 { return uint64(rs.SelfTime) },
+	"time":        func(rs *RuleStats) uint64 { return uint64(rs.Time) },
+	"calls":       func(rs *RuleStats) uint64 { return rs.Invocations },
+	"fails":       func(rs *RuleStats) uint64 { return rs.Failures },
+	"bytes":       func(rs *RuleStats) uint64 { return rs.Bytes },
+	"backtracked": func(rs *RuleStats) uint64 { return rs.Backtracked },
+	"repeats":     func(rs *RuleStats) uint64 { return rs.Repeats },
+}
+
+// prof implements the prof command, args are the command-line arguments
+// following the command name.
+func prof(args []string) {
+	fs := flag.NewFlagSet(os.Args[0]+" prof", flag.ExitOnError)
+	var (
+		formatFlag = fs.String("format", "text", "output format, text or pprof")
+		memoFlag   = fs.Bool("memo", false, "print the candidate rules for memoization")
+		outputFlag = fs.String("o", "", "output file, defaults to stdout")
+		sortFlag   = fs.String("sort", "self", "sort key")
+		topFlag    = fs.Int("top", 0, "number of rules to print")
+	)
+	fs.Usage = func() {
+		fmt.Printf(profUsagePage, os.Args[0])
+	}
+	if err := fs.Parse(args); err != nil {
+		fmt.Fprintln(os.Stderr, "args parse error:\n", err)
+		exit(6)
+	}
+
+	if fs.NArg() != 1 {
+		fmt.Fprintf(os.Stderr, "expected one argument, got %d\n", fs.NArg())
+		fs.Usage()
+		exit(1)
+	}
+	key, ok := profSortKeys[*sortFlag]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "invalid sort key %q\n", *sortFlag)
+		fs.Usage()
+		exit(1)
+	}
+	if *formatFlag != "text" && *formatFlag != "pprof" {
+		fmt.Fprintf(os.Stderr, "invalid format %q\n", *formatFlag)
+		fs.Usage()
+		exit(1)
+	}
+
+	b, err := os.ReadFile(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		exit(2)
+	}
+	var stats Stats
+	if err := json.Unmarshal(b, &stats); err != nil {
+		fmt.Fprintln(os.Stderr, "stats parse error:\n", err)
+		exit(3)
+	}
+	rules := sortRuleStats(stats.Rules, key)
+
+	var buf bytes.Buffer
+	switch {
+	case *memoFlag:
+		err = writeMemoCandidates(&buf, rules, *topFlag)
+	case *formatFlag == "pprof":
+		err = writePprof(&buf, rules)
+	default:
+		err = writeProfText(&buf, rules, *topFlag)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "report error: ", err)
+		exit(5)
+	}
+
+	out := output(*outputFlag)
+	if _, err := out.Write(buf.Bytes()); err != nil {
+		fmt.Fprintln(os.Stderr, "write error: ", err)
+		exit(7)
+	}
+	if err := out.Close(); err != nil {
+		fmt.Fprintln(os.Stderr, "close file error:\n", err)
+		exit(8)
+	}
+}
+
+// sortRuleStats returns the rules sorted in descending order of the value
+// returned by key, ties are sorted by rule name.
+func sortRuleStats(stats map[string]*RuleStats, key func(*RuleStats) uint64) []profRule {
+	rules := make([]profRule, 0, len(stats))
+	for nm, rs := range stats {
+		rules = append(rules, profRule{name: nm, RuleStats: rs})
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		ki, kj := key(rules[i].RuleStats), key(rules[j].RuleStats)
+		if ki != kj {
+			return ki > kj
+		}
+		return rules[i].name < rules[j].name
+	})
+	return rules
+}
+
+// writeProfText writes the text report of the rules to w, limited to the
+// top n rules if n > 0.
+func writeProfText(w io.Writer, rules []profRule, n int) error {
+	var total time.Duration
+	for _, r := range rules {
+		total += r.SelfTime
+	}
+	if n > 0 && n < len(rules) {
+		rules = rules[:n]
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "self\tself%\ttime\tcalls\tmatches\tfails\tbytes\tmemo hits\tmemo misses\tbacktracked\trepeats\t\trule")
+	for _, r := range rules {
+		pct := 0.0
+		if total > 0 {
+			pct = 100 * float64(r.SelfTime) / float64(total)
+		}
+		fmt.Fprintf(tw, "%s\t%.2f%%\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t\t%s\n",
+			r.SelfTime, pct, r.Time, r.Invocations, r.Matches, r.Failures,
+			r.Bytes, r.MemoHits, r.MemoMisses, r.Backtracked, r.Repeats, r.name)
+	}
+	return tw.Flush()
+}
+
+// repeatedTime estimates the time spent in the repeated evaluations of the
+// rule, from the average time of its invocations.
+func repeatedTime(rs *RuleStats) time.Duration {
+	if rs.Invocations == 0 {
+		return 0
+	}
+	return time.Duration(float64(rs.Time) * float64(rs.Repeats) / float64(rs.Invocations))
+}
+
+// writeMemoCandidates writes the rules that were evaluated more than once
+// at the same offset to w, sorted by the estimated time spent in the
+// repeated evaluations and limited to the top n rules if n > 0.
+func writeMemoCandidates(w io.Writer, rules []profRule, n int) error {
+	var candidates []profRule
+	for _, r := range rules {
+		if r.Repeats > 0 {
+			candidates = append(candidates, r)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return repeatedTime(candidates[i].RuleStats) > repeatedTime(candidates[j].RuleStats)
+	})
+	if n > 0 && n < len(candidates) {
+		candidates = candidates[:n]
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "repeated time\trepeats\tcalls\trepeats%\t\trule")
+	for _, r := range candidates {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%.2f%%\t\t%s\n", repeatedTime(r.RuleStats),
+			r.Repeats, r.Invocations, 100*float64(r.Repeats)/float64(r.Invocations), r.name)
+	}
+	return tw.Flush()
+}
+
+// pprofSampleTypes lists the type and unit of the values of each sample
+// written by writePprof, in order. The last one is the default.
+var pprofSampleTypes = [][2]string{
+	{"calls", "count"},
+	{"fails", "count"},
+	{"bytes", "bytes"},
+	{"backtracked", "bytes"},
+	{"repeats", "count"},
+	{"time", "nanoseconds"},
+}
+
+// writePprof writes the rules as a gzip-compressed profile.proto (see
+// github.com/google/pprof/proto/profile.proto) to w. Each rule is a
+// function with a single sample, so the flat values are the rule's own
+// values (and the time is its self time).
+func writePprof(w io.Writer, rules []profRule) error {
+	var pb protoBuf
+	strs := map[string]int{"": 0}
+	strTable := []string{""}
+	str := func(s string) uint64 {
+		ix, ok := strs[s]
+		if !ok {
+			ix = len(strTable)
+			strs[s] = ix
+			strTable = append(strTable, s)
+		}
+		return uint64(ix)
+	}
+
+	for _, st := range pprofSampleTypes {
+		var vt protoBuf
+		vt.uint64(1, str(st[0]))
+		vt.uint64(2, str(st[1]))
+		pb.bytes(1, vt.Bytes())
+	}
+	for i, r := range rules {
+		id := uint64(i + 1)
+
+		var sample, values protoBuf
+		sample.bytes(1, appendVarint(nil, id))
+		for _, v := range []uint64{r.Invocations, r.Failures, r.Bytes, r.Backtracked, r.Repeats, uint64(r.SelfTime)} {
+			values.Write(appendVarint(nil, v))
+		}
+		sample.bytes(2, values.Bytes())
+		pb.bytes(2, sample.Bytes())
+
+		var loc, line protoBuf
+		loc.uint64(1, id)
+		line.uint64(1, id)
+		loc.bytes(4, line.Bytes())
+		pb.bytes(4, loc.Bytes())
+
+		var fn protoBuf
+		fn.uint64(1, id)
+		fn.uint64(2, str(r.name))
+		fn.uint64(3, str(r.name))
+		pb.bytes(5, fn.Bytes())
+	}
+	defaultType := str(pprofSampleTypes[len(pprofSampleTypes)-1][0])
+	for _, s := range strTable {
+		pb.bytes(6, []byte(s))
+	}
+	pb.uint64(14, defaultType)
+
+	gz := gzip.NewWriter(w)
+	if _, err := gz.Write(pb.Bytes()); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// protoBuf is a minimal protocol buffers encoder, enough to write
+// profile.proto messages.
+type protoBuf struct {
+	bytes.Buffer
+}
+
+// uint64 writes a varint field, zero values are omitted.
+func (b *protoBuf) uint64(field int, v uint64) {
+	if v == 0 {
+		return
+	}
+	b.Write(appendVarint(nil, uint64(field)<<3))
+	b.Write(appendVarint(nil, v))
+}
+
+// bytes writes a length-delimited field.
+func (b *protoBuf) bytes(field int, v []byte) {
+	b.Write(appendVarint(nil, uint64(field)<<3|2))
+	b.Write(appendVarint(nil, uint64(len(v))))
+	b.Write(v)
+}
+
+func appendVarint(buf []byte, v uint64) []byte {
+	for v >= 0x80 {
+		buf = append(buf, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(buf, byte(v))
+}
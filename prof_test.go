@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+var profTestStats = map[string]*RuleStats{
+	"Grammar": {Invocations: 1, Matches: 1, Time: 10 * time.Millisecond, SelfTime: time.Millisecond, Bytes: 100},
+	"Rule":    {Invocations: 20, Matches: 10, Failures: 10, Time: 9 * time.Millisecond, SelfTime: 6 * time.Millisecond, Bytes: 99, Backtracked: 12, Repeats: 2},
+	"Ident":   {Invocations: 40, Matches: 30, Failures: 10, Time: 3 * time.Millisecond, SelfTime: 3 * time.Millisecond, Bytes: 60, Backtracked: 4, Repeats: 10},
+}
+
+func TestSortRuleStats(t *testing.T) {
+	cases := []struct {
+		key  string
+		want []string
+	}{
+		{"self", []string{"Rule", "Ident", "Grammar"}},
+		{"time", []string{"Grammar", "Rule", "Ident"}},
+		{"calls", []string{"Ident", "Rule", "Grammar"}},
+		{"fails", []string{"Ident", "Rule", "Grammar"}},
+		{"bytes", []string{"Grammar", "Rule", "Ident"}},
+		{"backtracked", []string{"Rule", "Ident", "Grammar"}},
+		{"repeats", []string{"Ident", "Rule", "Grammar"}},
+	}
+	for _, tc := range cases {
+		rules := sortRuleStats(profTestStats, profSortKeys[tc.key])
+		var got []string
+		for _, r := range rules {
+			got = append(got, r.name)
+		}
+		if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+			t.Errorf("%s: want %v, got %v", tc.key, tc.want, got)
+		}
+	}
+}
+
+func TestWriteProfText(t *testing.T) {
+	var buf bytes.Buffer
+	rules := sortRuleStats(profTestStats, profSortKeys["self"])
+	if err := writeProfText(&buf, rules, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("want 3 lines, got %d:\n%s", len(lines), buf.String())
+	}
+	if !strings.HasSuffix(lines[1], " Rule") || !strings.Contains(lines[1], "60.00%") {
+		t.Errorf("want Rule with 60%% of self time, got %q", lines[1])
+	}
+	if !strings.HasSuffix(lines[2], " Ident") {
+		t.Errorf("want Ident, got %q", lines[2])
+	}
+}
+
+func TestWriteMemoCandidates(t *testing.T) {
+	var buf bytes.Buffer
+	rules := sortRuleStats(profTestStats, profSortKeys["self"])
+	if err := writeMemoCandidates(&buf, rules, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// Ident repeats take 3ms*10/40 and Rule repeats 9ms*2/20.
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("want 3 lines, got %d:\n%s", len(lines), buf.String())
+	}
+	if !strings.HasSuffix(lines[1], " Rule") || !strings.Contains(lines[1], "900µs") {
+		t.Errorf("want Rule with 900µs of repeated time, got %q", lines[1])
+	}
+	if !strings.HasSuffix(lines[2], " Ident") || !strings.Contains(lines[2], "25.00%") {
+		t.Errorf("want Ident with 25%% of repeats, got %q", lines[2])
+	}
+}
+
+func TestWritePprof(t *testing.T) {
+	var buf bytes.Buffer
+	rules := sortRuleStats(profTestStats, profSortKeys["self"])
+	if err := writePprof(&buf, rules); err != nil {
+		t.Fatal(err)
+	}
+
+	gz, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := io.ReadAll(gz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"Grammar", "Rule", "Ident", "nanoseconds", "backtracked"} {
+		if !bytes.Contains(b, []byte(s)) {
+			t.Errorf("want %q in string table", s)
+		}
+	}
+}
+
+func TestAppendVarint(t *testing.T) {
+	cases := []struct {
+		v    uint64
+		want []byte
+	}{
+		{0, []byte{0}},
+		{1, []byte{1}},
+		{127, []byte{0x7f}},
+		{128, []byte{0x80, 0x01}},
+		{300, []byte{0xac, 0x02}},
+	}
+	for _, tc := range cases {
+		if got := appendVarint(nil, tc.v); !bytes.Equal(got, tc.want) {
+			t.Errorf("%d: want %x, got %x", tc.v, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mna/pigeon/ast"
+)
+
+// loadChoiceCounts reads the choice alternatives counts from the JSON
+// encoding of the Stats struct of a generated parser, or of its
+// ChoiceAltCnt field only.
+func loadChoiceCounts(file string) (map[string]map[string]int, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats Stats
+	if err := json.Unmarshal(b, &stats); err == nil && stats.ChoiceAltCnt != nil {
+		return stats.ChoiceAltCnt, nil
+	}
+	var counts map[string]map[string]int
+	if err := json.Unmarshal(b, &counts); err != nil {
+		return nil, fmt.Errorf("%s: invalid statistics: %w", file, err)
+	}
+	return counts, nil
+}
+
+// writeReorderings reports the reorderings of the choice alternatives,
+// the alternatives are listed by their one-based index in the grammar.
+func writeReorderings(w io.Writer, filename string, reorderings []*ast.ChoiceReordering) {
+	for _, ro := range reorderings {
+		alts := make([]string, len(ro.Order))
+		matches := make([]string, len(ro.Order))
+		for i, ix := range ro.Order {
+			alts[i] = strconv.Itoa(ix + 1)
+			matches[i] = strconv.Itoa(ro.Counts[ix])
+		}
+		pos := ro.Choice.Pos()
+		fmt.Fprintf(w, "%s:%d:%d: rule %s: reordered choice alternatives to %s (matches %s)\n",
+			filename, pos.Line, pos.Col, ro.Rule, strings.Join(alts, ", "), strings.Join(matches, ", "))
+	}
+}
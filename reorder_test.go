@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/mna/pigeon/ast"
+)
+
+func TestLoadChoiceCounts(t *testing.T) {
+	want := map[string]map[string]int{"Value 1:10": {"1": 3, "2": 7, "no match": 1}}
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"stats.json":  `{"ExprCnt": 12, "ChoiceAltCnt": {"Value 1:10": {"1": 3, "2": 7, "no match": 1}}}`,
+		"counts.json": `{"Value 1:10": {"1": 3, "2": 7, "no match": 1}}`,
+	}
+	for nm, content := range files {
+		file := filepath.Join(dir, nm)
+		if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		got, err := loadChoiceCounts(file)
+		if err != nil {
+			t.Errorf("%s: %v", nm, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: want %v, got %v", nm, want, got)
+		}
+	}
+
+	file := filepath.Join(dir, "invalid.json")
+	if err := os.WriteFile(file, []byte(`[1, 2]`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadChoiceCounts(file); err == nil {
+		t.Errorf("want error for invalid statistics")
+	}
+}
+
+func TestWriteReorderings(t *testing.T) {
+	g, err := Parse("", []byte("Value = Num / Str / Null\nNum = [0-9]+\nStr = '\"' [^\"]* '\"'\nNull = \"null\"\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts := map[string]map[string]int{"Value 1:9": {"1": 3, "2": 7, "3": 5}}
+	reorderings := ast.ReorderChoices(g.(*ast.Grammar), counts)
+
+	var buf bytes.Buffer
+	writeReorderings(&buf, "g.peg", reorderings)
+	want := "g.peg:1:9: rule Value: reordered choice alternatives to 2, 3, 1 (matches 7, 5, 3)\n"
+	if got := buf.String(); got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
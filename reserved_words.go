@@ -1,5 +1,7 @@
 package main
 
+import "github.com/mna/pigeon/ast"
+
 var reservedWords = map[string]bool{
 	// Go keywords http://golang.org/ref/spec#Keywords
 	"break":       true,
@@ -74,3 +76,12 @@ var reservedWords = map[string]bool{
 	"real":       true,
 	"recover":    true,
 }
+
+// ruleAnnotations lists the valid rule annotations.
+var ruleAnnotations = map[string]bool{
+	ast.MemoAnnotation:     true,
+	ast.SkipAnnotation:     true,
+	ast.LexicalAnnotation:  true,
+	ast.LongestAnnotation:  true,
+	ast.KeywordsAnnotation: true,
+}
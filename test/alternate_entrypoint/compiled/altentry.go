@@ -0,0 +1,2103 @@
+// Code generated by pigeon; DO NOT EDIT.
+
+package altentry
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+var g = &grammar{
+	rules: []*rule{
+		{
+			name: "Entry1",
+			pos:  position{line: 5, col: 1, offset: 24},
+			id:   0,
+		},
+		{
+			name: "Entry2",
+			pos:  position{line: 9, col: 1, offset: 68},
+			id:   1,
+		},
+		{
+			name: "Entry3",
+			pos:  position{line: 13, col: 1, offset: 112},
+			id:   2,
+		},
+		{
+			name: "C",
+			pos:  position{line: 19, col: 1, offset: 174},
+			id:   3,
+		},
+	},
+}
+
+func init() {
+	g.rules[0].run = (*parser).expr4
+	g.rules[1].run = (*parser).expr13
+	g.rules[2].run = (*parser).expr22
+	g.rules[3].run = (*parser).expr29
+}
+
+func (p *parser) expr4() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(4); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(5); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseSeqExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(6); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseOneOrMoreExpr")
+							}
+							n11 := 0
+							for {
+								var match12 bool
+								{
+									if res13, hit := p.compiledMemoized(7); hit {
+										_, match12 = res13.v, res13.b
+									} else {
+										pt14 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseLitMatcher")
+										}
+										start := p.pt
+										if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "a" {
+											p.read()
+											p.failAt(true, start.position, "\"a\"")
+											match12 = true
+										} else {
+											p.failAt(false, start.position, "\"a\"")
+										}
+										if p.debug {
+											p.out("parseLitMatcher")
+										}
+										p.compiledMemoize(pt14, 7, nil, match12)
+									}
+								}
+								if !match12 {
+									break
+								}
+								n11++
+							}
+							if n11 > 0 {
+								match8 = true
+							}
+							if p.debug {
+								p.out("parseOneOrMoreExpr")
+							}
+							p.compiledMemoize(pt10, 6, nil, match8)
+						}
+					}
+					if match8 {
+						var match15 bool
+						{
+							if res16, hit := p.compiledMemoized(8); hit {
+								_, match15 = res16.v, res16.b
+							} else {
+								pt17 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseActionExpr")
+								}
+								start18 := p.pt
+								{
+									if res19, hit := p.compiledMemoized(9); hit {
+										_, match15 = res19.v, res19.b
+									} else {
+										pt20 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseOneOrMoreExpr")
+										}
+										n21 := 0
+										for {
+											var match22 bool
+											{
+												if res23, hit := p.compiledMemoized(31); hit {
+													_, match22 = res23.v, res23.b
+												} else {
+													pt24 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseLitMatcher")
+													}
+													start := p.pt
+													if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "c" {
+														p.read()
+														p.failAt(true, start.position, "\"c\"")
+														match22 = true
+													} else {
+														p.failAt(false, start.position, "\"c\"")
+													}
+													if p.debug {
+														p.out("parseLitMatcher")
+													}
+													p.compiledMemoize(pt24, 31, nil, match22)
+												}
+											}
+											if !match22 {
+												break
+											}
+											n21++
+										}
+										if n21 > 0 {
+											match15 = true
+										}
+										if p.debug {
+											p.out("parseOneOrMoreExpr")
+										}
+										p.compiledMemoize(pt20, 9, nil, match15)
+									}
+								}
+								if match15 {
+									p.cur.pos = start18.position
+									p.cur.text = p.sliceFrom(start18)
+									state := p.cloneState()
+									actVal, err := p.callonEntry15()
+									if err != nil {
+										p.addErrAt(err, start18.position, []string{})
+									}
+									p.restoreState(state)
+									_ = actVal
+									if p.debug {
+										p.printIndent("MATCH", string(p.sliceFrom(start18)))
+									}
+								}
+								if p.debug {
+									p.out("parseActionExpr")
+								}
+								p.compiledMemoize(pt17, 8, nil, match15)
+							}
+						}
+						if match15 {
+							var match25 bool
+							{
+								if res26, hit := p.compiledMemoized(11); hit {
+									_, match25 = res26.v, res26.b
+								} else {
+									pt27 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseNotExpr")
+									}
+									pt28 := p.pt
+									state29 := p.cloneState()
+									p.maxFailInvertExpected = !p.maxFailInvertExpected
+									var match30 bool
+									{
+										if res31, hit := p.compiledMemoized(28); hit {
+											_, match30 = res31.v, res31.b
+										} else {
+											pt32 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseAnyMatcher")
+											}
+											if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+												// EOF - see utf8.DecodeRune
+												p.failAt(false, p.pt.position, ".")
+											} else {
+												start := p.pt
+												p.read()
+												p.failAt(true, start.position, ".")
+												match30 = true
+											}
+											if p.debug {
+												p.out("parseAnyMatcher")
+											}
+											p.compiledMemoize(pt32, 28, nil, match30)
+										}
+									}
+									p.maxFailInvertExpected = !p.maxFailInvertExpected
+									p.restoreState(state29)
+									p.restore(pt28)
+									match25 = !match30
+									if p.debug {
+										p.out("parseNotExpr")
+									}
+									p.compiledMemoize(pt27, 11, nil, match25)
+								}
+							}
+							if match25 {
+								ok = true
+							}
+						}
+					}
+					if !ok {
+						p.restoreState(state7)
+						p.restore(pt6)
+					}
+					if p.debug {
+						p.out("parseSeqExpr")
+					}
+					p.compiledMemoize(pt5, 5, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonEntry11()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 4, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr13() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(13); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(14); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseSeqExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(15); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseOneOrMoreExpr")
+							}
+							n11 := 0
+							for {
+								var match12 bool
+								{
+									if res13, hit := p.compiledMemoized(16); hit {
+										_, match12 = res13.v, res13.b
+									} else {
+										pt14 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseLitMatcher")
+										}
+										start := p.pt
+										if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "b" {
+											p.read()
+											p.failAt(true, start.position, "\"b\"")
+											match12 = true
+										} else {
+											p.failAt(false, start.position, "\"b\"")
+										}
+										if p.debug {
+											p.out("parseLitMatcher")
+										}
+										p.compiledMemoize(pt14, 16, nil, match12)
+									}
+								}
+								if !match12 {
+									break
+								}
+								n11++
+							}
+							if n11 > 0 {
+								match8 = true
+							}
+							if p.debug {
+								p.out("parseOneOrMoreExpr")
+							}
+							p.compiledMemoize(pt10, 15, nil, match8)
+						}
+					}
+					if match8 {
+						var match15 bool
+						{
+							if res16, hit := p.compiledMemoized(17); hit {
+								_, match15 = res16.v, res16.b
+							} else {
+								pt17 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseActionExpr")
+								}
+								start18 := p.pt
+								{
+									if res19, hit := p.compiledMemoized(18); hit {
+										_, match15 = res19.v, res19.b
+									} else {
+										pt20 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseOneOrMoreExpr")
+										}
+										n21 := 0
+										for {
+											var match22 bool
+											{
+												if res23, hit := p.compiledMemoized(31); hit {
+													_, match22 = res23.v, res23.b
+												} else {
+													pt24 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseLitMatcher")
+													}
+													start := p.pt
+													if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "c" {
+														p.read()
+														p.failAt(true, start.position, "\"c\"")
+														match22 = true
+													} else {
+														p.failAt(false, start.position, "\"c\"")
+													}
+													if p.debug {
+														p.out("parseLitMatcher")
+													}
+													p.compiledMemoize(pt24, 31, nil, match22)
+												}
+											}
+											if !match22 {
+												break
+											}
+											n21++
+										}
+										if n21 > 0 {
+											match15 = true
+										}
+										if p.debug {
+											p.out("parseOneOrMoreExpr")
+										}
+										p.compiledMemoize(pt20, 18, nil, match15)
+									}
+								}
+								if match15 {
+									p.cur.pos = start18.position
+									p.cur.text = p.sliceFrom(start18)
+									state := p.cloneState()
+									actVal, err := p.callonEntry25()
+									if err != nil {
+										p.addErrAt(err, start18.position, []string{})
+									}
+									p.restoreState(state)
+									_ = actVal
+									if p.debug {
+										p.printIndent("MATCH", string(p.sliceFrom(start18)))
+									}
+								}
+								if p.debug {
+									p.out("parseActionExpr")
+								}
+								p.compiledMemoize(pt17, 17, nil, match15)
+							}
+						}
+						if match15 {
+							var match25 bool
+							{
+								if res26, hit := p.compiledMemoized(20); hit {
+									_, match25 = res26.v, res26.b
+								} else {
+									pt27 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseNotExpr")
+									}
+									pt28 := p.pt
+									state29 := p.cloneState()
+									p.maxFailInvertExpected = !p.maxFailInvertExpected
+									var match30 bool
+									{
+										if res31, hit := p.compiledMemoized(28); hit {
+											_, match30 = res31.v, res31.b
+										} else {
+											pt32 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseAnyMatcher")
+											}
+											if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+												// EOF - see utf8.DecodeRune
+												p.failAt(false, p.pt.position, ".")
+											} else {
+												start := p.pt
+												p.read()
+												p.failAt(true, start.position, ".")
+												match30 = true
+											}
+											if p.debug {
+												p.out("parseAnyMatcher")
+											}
+											p.compiledMemoize(pt32, 28, nil, match30)
+										}
+									}
+									p.maxFailInvertExpected = !p.maxFailInvertExpected
+									p.restoreState(state29)
+									p.restore(pt28)
+									match25 = !match30
+									if p.debug {
+										p.out("parseNotExpr")
+									}
+									p.compiledMemoize(pt27, 20, nil, match25)
+								}
+							}
+							if match25 {
+								ok = true
+							}
+						}
+					}
+					if !ok {
+						p.restoreState(state7)
+						p.restore(pt6)
+					}
+					if p.debug {
+						p.out("parseSeqExpr")
+					}
+					p.compiledMemoize(pt5, 14, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonEntry21()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 13, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr22() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(22); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(23); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseSeqExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(24); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseActionExpr")
+							}
+							start11 := p.pt
+							{
+								if res12, hit := p.compiledMemoized(25); hit {
+									_, match8 = res12.v, res12.b
+								} else {
+									pt13 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseOneOrMoreExpr")
+									}
+									n14 := 0
+									for {
+										var match15 bool
+										{
+											if res16, hit := p.compiledMemoized(31); hit {
+												_, match15 = res16.v, res16.b
+											} else {
+												pt17 := p.pt
+												p.countExpr()
+												if p.debug {
+													p.in("parseLitMatcher")
+												}
+												start := p.pt
+												if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "c" {
+													p.read()
+													p.failAt(true, start.position, "\"c\"")
+													match15 = true
+												} else {
+													p.failAt(false, start.position, "\"c\"")
+												}
+												if p.debug {
+													p.out("parseLitMatcher")
+												}
+												p.compiledMemoize(pt17, 31, nil, match15)
+											}
+										}
+										if !match15 {
+											break
+										}
+										n14++
+									}
+									if n14 > 0 {
+										match8 = true
+									}
+									if p.debug {
+										p.out("parseOneOrMoreExpr")
+									}
+									p.compiledMemoize(pt13, 25, nil, match8)
+								}
+							}
+							if match8 {
+								p.cur.pos = start11.position
+								p.cur.text = p.sliceFrom(start11)
+								state := p.cloneState()
+								actVal, err := p.callonEntry33()
+								if err != nil {
+									p.addErrAt(err, start11.position, []string{})
+								}
+								p.restoreState(state)
+								_ = actVal
+								if p.debug {
+									p.printIndent("MATCH", string(p.sliceFrom(start11)))
+								}
+							}
+							if p.debug {
+								p.out("parseActionExpr")
+							}
+							p.compiledMemoize(pt10, 24, nil, match8)
+						}
+					}
+					if match8 {
+						var match18 bool
+						{
+							if res19, hit := p.compiledMemoized(27); hit {
+								_, match18 = res19.v, res19.b
+							} else {
+								pt20 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseNotExpr")
+								}
+								pt21 := p.pt
+								state22 := p.cloneState()
+								p.maxFailInvertExpected = !p.maxFailInvertExpected
+								var match23 bool
+								{
+									if res24, hit := p.compiledMemoized(28); hit {
+										_, match23 = res24.v, res24.b
+									} else {
+										pt25 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseAnyMatcher")
+										}
+										if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+											// EOF - see utf8.DecodeRune
+											p.failAt(false, p.pt.position, ".")
+										} else {
+											start := p.pt
+											p.read()
+											p.failAt(true, start.position, ".")
+											match23 = true
+										}
+										if p.debug {
+											p.out("parseAnyMatcher")
+										}
+										p.compiledMemoize(pt25, 28, nil, match23)
+									}
+								}
+								p.maxFailInvertExpected = !p.maxFailInvertExpected
+								p.restoreState(state22)
+								p.restore(pt21)
+								match18 = !match23
+								if p.debug {
+									p.out("parseNotExpr")
+								}
+								p.compiledMemoize(pt20, 27, nil, match18)
+							}
+						}
+						if match18 {
+							ok = true
+						}
+					}
+					if !ok {
+						p.restoreState(state7)
+						p.restore(pt6)
+					}
+					if p.debug {
+						p.out("parseSeqExpr")
+					}
+					p.compiledMemoize(pt5, 23, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonEntry31()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 22, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr29() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(29); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(30); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseOneOrMoreExpr")
+					}
+					n6 := 0
+					for {
+						var match7 bool
+						{
+							if res8, hit := p.compiledMemoized(31); hit {
+								_, match7 = res8.v, res8.b
+							} else {
+								pt9 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseLitMatcher")
+								}
+								start := p.pt
+								if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "c" {
+									p.read()
+									p.failAt(true, start.position, "\"c\"")
+									match7 = true
+								} else {
+									p.failAt(false, start.position, "\"c\"")
+								}
+								if p.debug {
+									p.out("parseLitMatcher")
+								}
+								p.compiledMemoize(pt9, 31, nil, match7)
+							}
+						}
+						if !match7 {
+							break
+						}
+						n6++
+					}
+					if n6 > 0 {
+						ok = true
+					}
+					if p.debug {
+						p.out("parseOneOrMoreExpr")
+					}
+					p.compiledMemoize(pt5, 30, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonC1()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 29, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (c *current) onEntry15() (any, error) {
+	return c.text, nil
+}
+
+func (p *parser) callonEntry15() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEntry15()
+}
+
+func (c *current) onEntry11() (any, error) {
+	return c.text, nil
+}
+
+func (p *parser) callonEntry11() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEntry11()
+}
+
+func (c *current) onEntry25() (any, error) {
+	return c.text, nil
+}
+
+func (p *parser) callonEntry25() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEntry25()
+}
+
+func (c *current) onEntry21() (any, error) {
+	return c.text, nil
+}
+
+func (p *parser) callonEntry21() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEntry21()
+}
+
+func (c *current) onEntry33() (any, error) {
+	return c.text, nil
+}
+
+func (p *parser) callonEntry33() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEntry33()
+}
+
+func (c *current) onEntry31() (any, error) {
+	return c.text, nil
+}
+
+func (p *parser) callonEntry31() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEntry31()
+}
+
+func (c *current) onC1() (any, error) {
+	return c.text, nil
+}
+
+func (p *parser) callonC1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onC1()
+}
+
+var (
+	// errNoRule is returned when the grammar to parse has no rule.
+	errNoRule = errors.New("grammar has no rule")
+
+	// errInvalidEntrypoint is returned when the specified entrypoint rule
+	// does not exit.
+	errInvalidEntrypoint = errors.New("invalid entrypoint")
+
+	// errInvalidEncoding is returned when the source is not properly
+	// utf8-encoded.
+	errInvalidEncoding = errors.New("invalid encoding")
+
+	// errMaxExprCnt is used to signal that the maximum number of
+	// expressions have been parsed.
+	errMaxExprCnt = errors.New("max number of expressions parsed")
+)
+
+// Option is a function that can set an option on the parser. It returns
+// the previous setting as an Option.
+type Option func(*parser) Option
+
+// MaxExpressions creates an Option to stop parsing after the provided
+// number of expressions have been parsed, if the value is 0 then the parser will
+// parse for as many steps as needed (possibly an infinite number).
+//
+// The default for maxExprCnt is 0.
+func MaxExpressions(maxExprCnt uint64) Option {
+	return func(p *parser) Option {
+		oldMaxExprCnt := p.maxExprCnt
+		p.maxExprCnt = maxExprCnt
+		return MaxExpressions(oldMaxExprCnt)
+	}
+}
+
+// Entrypoint creates an Option to set the rule name to use as entrypoint.
+// The rule name must have been specified in the -alternate-entrypoints
+// if generating the parser with the -optimize-grammar flag, otherwise
+// it may have been optimized out. Passing an empty string sets the
+// entrypoint to the first rule in the grammar.
+//
+// The default is to start parsing at the first rule in the grammar.
+func Entrypoint(ruleName string) Option {
+	return func(p *parser) Option {
+		oldEntrypoint := p.entrypoint
+		p.entrypoint = ruleName
+		if ruleName == "" {
+			p.entrypoint = g.rules[0].name
+		}
+		return Entrypoint(oldEntrypoint)
+	}
+}
+
+// Statistics adds a user provided Stats struct to the parser to allow
+// the user to process the results after the parsing has finished.
+// Also the key for the "no match" counter is set. Per-rule statistics
+// (see RuleStats) are only collected when this option is set.
+//
+// Example usage:
+//
+//	input := "input"
+//	stats := Stats{}
+//	_, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	fmt.Println(string(b))
+func Statistics(stats *Stats, choiceNoMatch string) Option {
+	return func(p *parser) Option {
+		oldStats := p.Stats
+		p.Stats = stats
+		oldChoiceNoMatch := p.choiceNoMatch
+		p.choiceNoMatch = choiceNoMatch
+		if p.Stats.ChoiceAltCnt == nil {
+			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
+		}
+		if p.Stats.Rules == nil {
+			p.Stats.Rules = make(map[string]*RuleStats)
+		}
+		return Statistics(oldStats, oldChoiceNoMatch)
+	}
+}
+
+// Debug creates an Option to set the debug flag to b. When set to true,
+// debugging information is printed to stdout while parsing.
+//
+// The default is false.
+func Debug(b bool) Option {
+	return func(p *parser) Option {
+		old := p.debug
+		p.debug = b
+		return Debug(old)
+	}
+}
+
+// Memoize creates an Option to set the memoize flag to b. When set to true,
+// the parser will cache all results so each expression is evaluated only
+// once. This guarantees linear parsing time even for pathological cases,
+// at the expense of more memory and slower times for typical cases.
+// The rules annotated with @memo in the grammar are always memoized,
+// regardless of this option. The memoization table is pooled, so its
+// storage is reused by the following parses.
+//
+// The default is false.
+func Memoize(b bool) Option {
+	return func(p *parser) Option {
+		old := p.memoize
+		p.memoize = b
+		return Memoize(old)
+	}
+}
+
+// AllowInvalidUTF8 creates an Option to allow invalid UTF-8 bytes.
+// Every invalid UTF-8 byte is treated as a utf8.RuneError (U+FFFD)
+// by character class matchers and is matched by the any matcher.
+// The returned matched value, c.text and c.offset are NOT affected.
+//
+// The default is false.
+func AllowInvalidUTF8(b bool) Option {
+	return func(p *parser) Option {
+		old := p.allowInvalidUTF8
+		p.allowInvalidUTF8 = b
+		return AllowInvalidUTF8(old)
+	}
+}
+
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
+// Recover creates an Option to set the recover flag to b. When set to
+// true, this causes the parser to recover from panics and convert it
+// to an error. Setting it to false can be useful while debugging to
+// access the full stack trace.
+//
+// The default is true.
+func Recover(b bool) Option {
+	return func(p *parser) Option {
+		old := p.recover
+		p.recover = b
+		return Recover(old)
+	}
+}
+
+// GlobalStore creates an Option to set a key to a certain value in
+// the globalStore.
+func GlobalStore(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.globalStore[key]
+		p.cur.globalStore[key] = value
+		return GlobalStore(key, old)
+	}
+}
+
+// InitState creates an Option to set a key to a certain value in
+// the global "state" store.
+func InitState(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.state[key]
+		p.cur.state[key] = value
+		return InitState(key, old)
+	}
+}
+
+// ParseFile parses the file identified by filename.
+func ParseFile(filename string, opts ...Option) (i any, err error) { // nolint: deadcode
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+	return ParseReader(filename, f, opts...)
+}
+
+// ParseReader parses the data from r using filename as information in the
+// error messages.
+func ParseReader(filename string, r io.Reader, opts ...Option) (any, error) { // nolint: deadcode
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(filename, b, opts...)
+}
+
+// Parse parses the data from b using filename as information in the
+// error messages.
+func Parse(filename string, b []byte, opts ...Option) (any, error) {
+	return newParser(filename, b, opts...).parse(g)
+}
+
+// position records a position in the text.
+type position struct {
+	line, col, offset int
+}
+
+func (p position) String() string {
+	return strconv.Itoa(p.line) + ":" + strconv.Itoa(p.col) + " [" + strconv.Itoa(p.offset) + "]"
+}
+
+// savepoint stores all state required to go back to this point in the
+// parser.
+type savepoint struct {
+	position
+	rn rune
+	w  int
+}
+
+type current struct {
+	pos  position // start position of the match
+	text []byte   // raw text of the match
+
+	// state is a store for arbitrary key,value pairs that the user wants to be
+	// tied to the backtracking of the parser.
+	// This is always rolled back if a parsing rule fails.
+	state storeDict
+
+	// globalStore is a general store for the user to store arbitrary key-value
+	// pairs that they need to manage and that they do not want tied to the
+	// backtracking of the parser. This is only modified by the user and never
+	// rolled back by the parser. It is always up to the user to keep this in a
+	// consistent state.
+	globalStore storeDict
+}
+
+type storeDict map[string]any
+
+// the AST types...
+
+// nolint: structcheck
+type grammar struct {
+	pos   position
+	rules []*rule
+}
+
+// nolint: structcheck
+type rule struct {
+	pos         position
+	name        string
+	displayName string
+	run         func(*parser) (any, bool)
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+//
+//	nolint: structcheck
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
+}
+
+// errList cumulates the errors found by the parser.
+type errList []error
+
+func (e *errList) add(err error) {
+	*e = append(*e, err)
+}
+
+func (e errList) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	e.dedupe()
+	return e
+}
+
+func (e *errList) dedupe() {
+	var cleaned []error
+	set := make(map[string]bool)
+	for _, err := range *e {
+		if msg := err.Error(); !set[msg] {
+			set[msg] = true
+			cleaned = append(cleaned, err)
+		}
+	}
+	*e = cleaned
+}
+
+func (e errList) Error() string {
+	switch len(e) {
+	case 0:
+		return ""
+	case 1:
+		return e[0].Error()
+	default:
+		var buf bytes.Buffer
+
+		for i, err := range e {
+			if i > 0 {
+				buf.WriteRune('\n')
+			}
+			buf.WriteString(err.Error())
+		}
+		return buf.String()
+	}
+}
+
+// parserError wraps an error with a prefix indicating the rule in which
+// the error occurred. The original error is stored in the Inner field.
+type parserError struct {
+	Inner    error
+	pos      position
+	prefix   string
+	expected []string
+}
+
+// Error returns the error message.
+func (p *parserError) Error() string {
+	return p.prefix + ": " + p.Inner.Error()
+}
+
+// newParser creates a parser with the specified input source and options.
+func newParser(filename string, b []byte, opts ...Option) *parser {
+	stats := Stats{
+		ChoiceAltCnt: make(map[string]map[string]int),
+	}
+
+	p := &parser{
+		filename: filename,
+		errs:     new(errList),
+		data:     b,
+		pt:       savepoint{position: position{line: 1}},
+		recover:  true,
+		cur: current{
+			state:       make(storeDict),
+			globalStore: make(storeDict),
+		},
+		maxFailPos:      position{col: 1, line: 1},
+		maxFailExpected: make([]string, 0, 20),
+		Stats:           &stats,
+		// start rule is rule [0] unless an alternate entrypoint is specified
+		entrypoint: g.rules[0].name,
+	}
+	p.setOptions(opts)
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
+
+	if p.maxExprCnt == 0 {
+		p.maxExprCnt = math.MaxUint64
+	}
+
+	return p
+}
+
+// setOptions applies the options to the parser.
+func (p *parser) setOptions(opts []Option) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// nolint: structcheck,deadcode
+type resultTuple struct {
+	v   any
+	b   bool
+	end savepoint
+}
+
+// nolint: varcheck
+const choiceNoMatch = -1
+
+// Stats stores some statistics, gathered during parsing
+type Stats struct {
+	// ExprCnt counts the number of expressions processed during parsing
+	// This value is compared to the maximum number of expressions allowed
+	// (set by the MaxExpressions option).
+	ExprCnt uint64
+
+	// ChoiceAltCnt is used to count for each ordered choice expression,
+	// which alternative is used how may times.
+	// These numbers allow to optimize the order of the ordered choice expression
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
+	//
+	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
+	// as the line and the column of the ordered choice.
+	// The inner key of ChoiceAltCnt is the number (one-based) of the matching alternative.
+	// For each alternative the number of matches are counted. If an ordered choice does not
+	// match, a special counter is incremented. The name of this counter is set with
+	// the parser option Statistics.
+	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
+	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
+}
+
+// nolint: structcheck,maligned
+type parser struct {
+	filename string
+	pt       savepoint
+	cur      current
+
+	data []byte
+	errs *errList
+
+	depth   int
+	recover bool
+	debug   bool
+
+	memoize bool
+	// memoization table for the packrat algorithm, taken from memoTables
+	// on the first memoized result
+	memo *memoTable
+
+	// rules table, maps the rule identifier to the rule node
+	rules map[string]*rule
+	// variables stack, map of label to value
+	vstack []map[string]any
+	// rule stack, allows identification of the current rule in errors
+	rstack []*rule
+
+	// parse fail
+	maxFailPos            position
+	maxFailExpected       []string
+	maxFailInvertExpected bool
+
+	// max number of expressions to be parsed
+	maxExprCnt uint64
+	// entrypoint for the parser
+	entrypoint string
+
+	allowInvalidUTF8 bool
+
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+
+	*Stats
+	// profiling frames, tracks the time spent in invoked rules to compute
+	// the self time of each rule.
+	profStack []profFrame
+	// number of active invocations of each rule, the time of a recursive
+	// rule is only added at its outermost invocation.
+	profActive map[*rule]int
+	// rules evaluated at each offset, to count the repeated evaluations.
+	profEvals map[profEval]bool
+
+	choiceNoMatch string
+	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
+}
+
+// push a variable set on the vstack.
+func (p *parser) pushV() {
+	if cap(p.vstack) == len(p.vstack) {
+		// create new empty slot in the stack
+		p.vstack = append(p.vstack, nil)
+	} else {
+		// slice to 1 more
+		p.vstack = p.vstack[:len(p.vstack)+1]
+	}
+
+	// get the last args set
+	m := p.vstack[len(p.vstack)-1]
+	if m != nil && len(m) == 0 {
+		// empty map, all good
+		return
+	}
+
+	m = make(map[string]any)
+	p.vstack[len(p.vstack)-1] = m
+}
+
+// pop a variable set from the vstack.
+func (p *parser) popV() {
+	// if the map is not empty, clear it
+	m := p.vstack[len(p.vstack)-1]
+	if len(m) > 0 {
+		// GC that map
+		p.vstack[len(p.vstack)-1] = nil
+	}
+	p.vstack = p.vstack[:len(p.vstack)-1]
+}
+
+// push a recovery expression with its labels to the recoveryStack
+func (p *parser) pushRecovery(labels []string, expr any) {
+	if cap(p.recoveryStack) == len(p.recoveryStack) {
+		// create new empty slot in the stack
+		p.recoveryStack = append(p.recoveryStack, recovery{})
+	} else {
+		// slice to 1 more
+		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
+	}
+
+	m := make(map[string]any, len(labels))
+	for _, fl := range labels {
+		m[fl] = expr
+	}
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
+}
+
+// pop a recovery expression from the recoveryStack
+func (p *parser) popRecovery() {
+	// GC that map
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
+
+	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
+}
+
+func (p *parser) print(prefix, s string) string {
+	if !p.debug {
+		return s
+	}
+
+	pos := p.pt.position
+	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
+		prefix, pos.line, pos.col, pos.offset, s, p.pt.rn)
+	return s
+}
+
+func (p *parser) printIndent(mark string, s string) string {
+	return p.print(strings.Repeat(" ", p.depth)+mark, s)
+}
+
+func (p *parser) in(s string) string {
+	res := p.printIndent(">", s)
+	p.depth++
+	return res
+}
+
+func (p *parser) out(s string) string {
+	p.depth--
+	return p.printIndent("<", s)
+}
+
+func (p *parser) addErr(err error) {
+	p.addErrAt(err, p.pt.position, []string{})
+}
+
+func (p *parser) addErrAt(err error, pos position, expected []string) {
+	var buf bytes.Buffer
+	if p.filename != "" {
+		buf.WriteString(p.filename)
+	}
+	if buf.Len() > 0 {
+		buf.WriteString(":")
+	}
+	buf.WriteString(fmt.Sprintf("%d:%d (%d)", pos.line, pos.col, pos.offset))
+	if len(p.rstack) > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString(": ")
+		}
+		rule := p.rstack[len(p.rstack)-1]
+		if rule.displayName != "" {
+			buf.WriteString("rule " + rule.displayName)
+		} else {
+			buf.WriteString("rule " + rule.name)
+		}
+	}
+	pe := &parserError{Inner: err, pos: pos, prefix: buf.String(), expected: expected}
+	p.errs.add(pe)
+}
+
+func (p *parser) failAt(fail bool, pos position, want string) {
+	// process fail if parsing fails and not inverted or parsing succeeds and invert is set
+	if fail == p.maxFailInvertExpected {
+		if pos.offset < p.maxFailPos.offset {
+			return
+		}
+
+		if pos.offset > p.maxFailPos.offset {
+			p.maxFailPos = pos
+			p.maxFailExpected = p.maxFailExpected[:0]
+		}
+
+		if p.maxFailInvertExpected {
+			want = "!" + want
+		}
+		p.maxFailExpected = append(p.maxFailExpected, want)
+	}
+}
+
+// read advances the parser to the next rune.
+func (p *parser) read() {
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
+	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
+	p.pt.rn = rn
+	p.pt.w = n
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
+	}
+
+	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
+		if !p.allowInvalidUTF8 {
+			p.addErr(errInvalidEncoding)
+		}
+	}
+}
+
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
+// restore parser position to the savepoint pt.
+func (p *parser) restore(pt savepoint) {
+	if p.debug {
+		defer p.out(p.in("restore"))
+	}
+	if pt.offset == p.pt.offset {
+		return
+	}
+	if p.Rules != nil && pt.offset < p.pt.offset && len(p.rstack) > 0 {
+		p.ruleStats(p.rstack[len(p.rstack)-1]).Backtracked += uint64(p.pt.offset - pt.offset)
+	}
+	p.pt = pt
+}
+
+// Cloner is implemented by any value that has a Clone method, which returns a
+// copy of the value. This is mainly used for types which are not passed by
+// value (e.g map, slice, chan) or structs that contain such types.
+//
+// This is used in conjunction with the global state feature to create proper
+// copies of the state to allow the parser to properly restore the state in
+// the case of backtracking.
+type Cloner interface {
+	Clone() any
+}
+
+var statePool = &sync.Pool{
+	New: func() any { return make(storeDict) },
+}
+
+func (sd storeDict) Discard() {
+	for k := range sd {
+		delete(sd, k)
+	}
+	statePool.Put(sd)
+}
+
+// clone and return parser current state.
+func (p *parser) cloneState() storeDict {
+	if p.debug {
+		defer p.out(p.in("cloneState"))
+	}
+
+	state := statePool.Get().(storeDict)
+	for k, v := range p.cur.state {
+		if c, ok := v.(Cloner); ok {
+			state[k] = c.Clone()
+		} else {
+			state[k] = v
+		}
+	}
+	return state
+}
+
+// restore parser current state to the state storeDict.
+// every restoreState should applied only one time for every cloned state
+func (p *parser) restoreState(state storeDict) {
+	if p.debug {
+		defer p.out(p.in("restoreState"))
+	}
+	p.cur.state.Discard()
+	p.cur.state = state
+}
+
+// get the slice of bytes from the savepoint start to the current position.
+func (p *parser) sliceFrom(start savepoint) []byte {
+	return p.data[start.position.offset:p.pt.position.offset]
+}
+
+// memoSlot is an entry of the memoization table, the key of an empty slot
+// is 0.
+type memoSlot struct {
+	key uint64
+	res resultTuple
+}
+
+// memoTable is the memoization table for the packrat algorithm, an
+// open-addressed hash table with linear probing that maps the offset in
+// the source and the id of a rule or expression to its result.
+type memoTable struct {
+	slots []memoSlot
+	shift uint
+	n     int
+}
+
+// memoTables pools the memoization tables so that their storage is
+// reused across parses.
+var memoTables = &sync.Pool{
+	New: func() any {
+		return &memoTable{slots: make([]memoSlot, 1<<10), shift: 64 - 10}
+	},
+}
+
+// memoKey returns the key of the result of the rule or expression id at
+// offset, it supports up to 1<<24 ids.
+func memoKey(offset, id int) uint64 {
+	return uint64(offset+1)<<24 | uint64(id)
+}
+
+// index returns the index of the slot of key, or of the empty slot where
+// it should be stored.
+func (t *memoTable) index(key uint64) int {
+	mask := len(t.slots) - 1
+	i := int((key*0x9E3779B97F4A7C15)>>t.shift) & mask
+	for t.slots[i].key != 0 && t.slots[i].key != key {
+		i = (i + 1) & mask
+	}
+	return i
+}
+
+func (t *memoTable) get(key uint64) (resultTuple, bool) {
+	s := &t.slots[t.index(key)]
+	return s.res, s.key == key
+}
+
+func (t *memoTable) set(key uint64, res resultTuple) {
+	if 4*(t.n+1) > 3*len(t.slots) {
+		t.grow()
+	}
+	s := &t.slots[t.index(key)]
+	if s.key == 0 {
+		s.key = key
+		t.n++
+	}
+	s.res = res
+}
+
+// grow doubles the capacity of the table.
+func (t *memoTable) grow() {
+	old := t.slots
+	t.slots = make([]memoSlot, 2*len(old))
+	t.shift--
+	for _, s := range old {
+		if s.key != 0 {
+			t.slots[t.index(s.key)] = s
+		}
+	}
+}
+
+// reset empties the table, releasing the references to the results.
+func (t *memoTable) reset() {
+	if t.n == 0 {
+		return
+	}
+	for i := range t.slots {
+		t.slots[i] = memoSlot{}
+	}
+	t.n = 0
+}
+
+func (p *parser) getMemoized(id int) (resultTuple, bool) {
+	if p.memo == nil {
+		return resultTuple{}, false
+	}
+	return p.memo.get(memoKey(p.pt.offset, id))
+}
+
+func (p *parser) setMemoized(pt savepoint, id int, tuple resultTuple) {
+	if p.memo == nil {
+		p.memo = memoTables.Get().(*memoTable)
+	}
+	p.memo.set(memoKey(pt.offset, id), tuple)
+}
+
+// releaseMemo returns the memoization table to the pool.
+func (p *parser) releaseMemo() {
+	if p.memo == nil {
+		return
+	}
+	p.memo.reset()
+	memoTables.Put(p.memo)
+	p.memo = nil
+}
+
+func (p *parser) buildRulesTable(g *grammar) {
+	p.rules = make(map[string]*rule, len(g.rules))
+	for _, r := range g.rules {
+		p.rules[r.name] = r
+	}
+}
+
+// nolint: gocyclo
+func (p *parser) parse(g *grammar) (val any, err error) {
+	if len(g.rules) == 0 {
+		p.addErr(errNoRule)
+		return nil, p.errs.err()
+	}
+
+	// TODO : not super critical but this could be generated
+	p.buildRulesTable(g)
+	defer p.releaseMemo()
+
+	if p.recover {
+		// panic can be used in action code to stop parsing immediately
+		// and return the panic as an error.
+		defer func() {
+			if e := recover(); e != nil {
+				if p.debug {
+					defer p.out(p.in("panic handler"))
+				}
+				val = nil
+				switch e := e.(type) {
+				case error:
+					p.addErr(e)
+				default:
+					p.addErr(fmt.Errorf("%v", e))
+				}
+				err = p.errs.err()
+			}
+		}()
+	}
+
+	startRule, ok := p.rules[p.entrypoint]
+	if !ok {
+		p.addErr(errInvalidEntrypoint)
+		return nil, p.errs.err()
+	}
+
+	p.read() // advance to first rune
+	val, ok = p.parseRuleWrap(startRule)
+	if !ok {
+		if len(*p.errs) == 0 {
+			// If parsing fails, but no errors have been recorded, the expected values
+			// for the farthest parser position are returned as error.
+			p.addNoMatchErr()
+		}
+
+		return nil, p.errs.err()
+	}
+	return val, p.errs.err()
+}
+
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
+func listJoin(list []string, sep string, lastSep string) string {
+	switch len(list) {
+	case 0:
+		return ""
+	case 1:
+		return list[0]
+	default:
+		return strings.Join(list[:len(list)-1], sep) + " " + lastSep + " " + list[len(list)-1]
+	}
+}
+
+// profFrame is a rule invocation being profiled.
+type profFrame struct {
+	start time.Time
+	child time.Duration
+}
+
+// profEval is the evaluation of a rule at an offset.
+type profEval struct {
+	rule   *rule
+	offset int
+}
+
+// ruleStats returns the statistics of rule, creating them if needed.
+func (p *parser) ruleStats(rule *rule) *RuleStats {
+	rs := p.Rules[rule.name]
+	if rs == nil {
+		rs = &RuleStats{}
+		p.Rules[rule.name] = rs
+	}
+	return rs
+}
+
+func (p *parser) countMemo(rs *RuleStats, hit bool) {
+	if hit {
+		rs.MemoHits++
+	} else {
+		rs.MemoMisses++
+	}
+}
+
+func (p *parser) profEnter(r *rule) {
+	if p.profActive == nil {
+		p.profActive = make(map[*rule]int)
+	}
+	p.profActive[r]++
+	p.profStack = append(p.profStack, profFrame{start: time.Now()})
+}
+
+// countRepeat counts the evaluation of rule at the current offset if
+// it was already evaluated at that offset.
+func (p *parser) countRepeat(rule *rule) {
+	if p.profEvals == nil {
+		p.profEvals = make(map[profEval]bool)
+	}
+	key := profEval{rule: rule, offset: p.pt.offset}
+	if p.profEvals[key] {
+		p.ruleStats(rule).Repeats++
+		return
+	}
+	p.profEvals[key] = true
+}
+
+func (p *parser) profExit(rule *rule, start savepoint, ok bool) {
+	frame := p.profStack[len(p.profStack)-1]
+	p.profStack = p.profStack[:len(p.profStack)-1]
+	elapsed := time.Since(frame.start)
+	if n := len(p.profStack); n > 0 {
+		p.profStack[n-1].child += elapsed
+	}
+
+	rs := p.ruleStats(rule)
+	rs.Invocations++
+	if p.profActive[rule]--; p.profActive[rule] == 0 {
+		// the time of the inner invocations is included
+		rs.Time += elapsed
+	}
+	rs.SelfTime += elapsed - frame.child
+	if ok {
+		rs.Matches++
+		rs.Bytes += uint64(p.pt.offset - start.offset)
+	} else {
+		rs.Failures++
+	}
+}
+
+func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
+	if rule.id < 0 {
+		// its results depend on the captured texts or the state store
+		return p.parseRule(rule)
+	}
+	res, ok := p.getMemoized(rule.id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(rule), ok)
+	}
+	if ok {
+		p.restore(res.end)
+		return res.v, res.b
+	}
+
+	startMark := p.pt
+	val, ok := p.parseRule(rule)
+	p.setMemoized(startMark, rule.id, resultTuple{v: val, b: ok, end: p.pt})
+
+	return val, ok
+}
+
+func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRule " + rule.name))
+	}
+	var (
+		val       any
+		ok        bool
+		startMark = p.pt
+	)
+
+	if p.Rules != nil {
+		p.profEnter(rule)
+		defer func() { p.profExit(rule, startMark, ok) }()
+	}
+
+	if p.memoize {
+		val, ok = p.parseRuleMemoize(rule)
+	} else {
+		val, ok = p.parseRule(rule)
+	}
+
+	if ok && p.debug {
+		p.printIndent("MATCH", string(p.sliceFrom(startMark)))
+	}
+	return val, ok
+}
+
+func (p *parser) parseRule(rule *rule) (any, bool) {
+	if p.Rules != nil {
+		p.countRepeat(rule)
+	}
+	p.rstack = append(p.rstack, rule)
+	p.pushV()
+	val, ok := rule.run(p)
+	p.popV()
+	p.rstack = p.rstack[:len(p.rstack)-1]
+	return val, ok
+}
+
+// incChoiceAltCnt counts the match of the alternative altI of the choice
+// at pos, or its failure if altI is choiceNoMatch.
+func (p *parser) incChoiceAltCnt(pos position, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, pos.line, pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
+// countExpr counts the evaluation of an expression.
+func (p *parser) countExpr() {
+	p.ExprCnt++
+	if p.ExprCnt > p.maxExprCnt {
+		panic(errMaxExprCnt)
+	}
+}
+
+// compiledMemoized returns the memoized results of the compiled expression
+// id at the current position, and restores the position at their end. It
+// returns false if they are not memoized or if memoization is disabled.
+func (p *parser) compiledMemoized(id int) (resultTuple, bool) {
+	if !p.memoize {
+		return resultTuple{}, false
+	}
+	res, ok := p.getMemoized(id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+	}
+	if ok {
+		p.restore(res.end)
+	}
+	return res, ok
+}
+
+// compiledMemoize memoizes the results of the compiled expression id,
+// which started at pt, if memoization is enabled.
+func (p *parser) compiledMemoize(pt savepoint, id int, val any, ok bool) {
+	if p.memoize {
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
+	}
+}
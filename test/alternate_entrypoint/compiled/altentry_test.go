@@ -0,0 +1 @@
+../altentry_test.go
\ No newline at end of file
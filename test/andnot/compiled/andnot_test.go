@@ -0,0 +1 @@
+../andnot_test.go
\ No newline at end of file
@@ -0,0 +1,55 @@
+package backref
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBackRef(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: `r"a"` + "\n" + `r#"a"b"#`, want: []any{
+			[]any{"raw", `r"a"`},
+			[]any{"raw", `r#"a"b"#`},
+		}},
+		{in: `r##"a"#"##`, want: []any{[]any{"raw", `r##"a"#"##`}}},
+		{in: "<a><b></b><c></c></a>", want: []any{
+			[]any{"a", []any{[]any{"b", []any(nil)}, []any{"c", []any(nil)}}},
+		}},
+		{in: "<<end\nend x\nend", want: []any{[]any{"heredoc", "<<end\nend x\nend"}}},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err != nil {
+			t.Errorf("%q: %v", tc.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%q: want %#v, got %#v", tc.in, tc.want, got)
+		}
+	}
+}
+
+func TestBackRefError(t *testing.T) {
+	cases := []struct {
+		in  string
+		err string
+	}{
+		{in: `r#"a"`, err: `1:6 (5): no match found, expected: "\"" or .`},
+		// the expected value is the captured text
+		{in: "<a><b></a>", err: `1:9 (8): no match found, expected: "b"`},
+		{in: "<<end\nx\n", err: `3:1 (8): no match found, expected: "\n" or .`},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err == nil {
+			t.Errorf("%q: want error, got %#v", tc.in, got)
+			continue
+		}
+		if err.Error() != tc.err {
+			t.Errorf("%q: want error %q, got %q", tc.in, tc.err, err)
+		}
+	}
+}
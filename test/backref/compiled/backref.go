@@ -0,0 +1,1804 @@
+// Code generated by pigeon; DO NOT EDIT.
+
+package backref
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+var g = &grammar{
+	rules: []*rule{
+		{
+			name: "Doc",
+			pos:  position{line: 8, col: 1, offset: 157},
+		},
+		{
+			name: "Item",
+			pos:  position{line: 16, col: 1, offset: 318},
+		},
+		{
+			name: "Raw",
+			pos:  position{line: 20, col: 1, offset: 460},
+		},
+		{
+			name: "Element",
+			pos:  position{line: 24, col: 1, offset: 574},
+		},
+		{
+			name: "Heredoc",
+			pos:  position{line: 28, col: 1, offset: 680},
+		},
+		{
+			name: "Name",
+			pos:  position{line: 35, col: 1, offset: 856},
+		},
+		{
+			name: "EOF",
+			pos:  position{line: 39, col: 1, offset: 908},
+		},
+	},
+}
+
+func init() {
+	g.rules[0].run = (*parser).expr7
+	g.rules[1].run = (*parser).expr16
+	g.rules[2].run = (*parser).expr20
+	g.rules[3].run = (*parser).expr36
+	g.rules[4].run = (*parser).expr48
+	g.rules[5].run = (*parser).expr64
+	g.rules[6].run = (*parser).expr67
+}
+
+func (p *parser) expr7() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		start1 := p.pt
+		{
+			p.countExpr()
+			pt2 := p.pt
+			state3 := p.cloneState()
+			var match4 bool
+			{
+				p.countExpr()
+				var v5 any
+				p.pushV()
+				{
+					p.countExpr()
+					var vals6 []any
+					for {
+						var v8 any
+						var match7 bool
+						{
+							p.countExpr()
+							pt9 := p.pt
+							state10 := p.cloneState()
+							var v11 any
+							var match12 bool
+							{
+								p.countExpr()
+								v11, match12 = p.parseRuleWrap(g.rules[1])
+							}
+							if match12 {
+								var v13 any
+								var match14 bool
+								{
+									p.countExpr()
+									var vals15 []any
+									for {
+										var v17 any
+										var match16 bool
+										{
+											p.countExpr()
+											start := p.pt
+											if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "\n" {
+												p.read()
+												p.failAt(true, start.position, "\"\\n\"")
+												v17 = p.sliceFrom(start)
+												match16 = true
+											} else {
+												p.failAt(false, start.position, "\"\\n\"")
+											}
+										}
+										if !match16 {
+											break
+										}
+										vals15 = append(vals15, v17)
+									}
+									v13 = vals15
+									match14 = true
+								}
+								if match14 {
+									v8 = []any{v11, v13}
+									match7 = true
+								}
+							}
+							if !match7 {
+								p.restoreState(state10)
+								p.restore(pt9)
+							}
+						}
+						if !match7 {
+							break
+						}
+						vals6 = append(vals6, v8)
+					}
+					v5 = vals6
+					match4 = true
+				}
+				p.popV()
+				if match4 {
+					p.vstack[len(p.vstack)-1]["items"] = v5
+					_ = v5
+				}
+			}
+			if match4 {
+				var match18 bool
+				{
+					p.countExpr()
+					_, match18 = p.parseRuleWrap(g.rules[6])
+				}
+				if match18 {
+					ok = true
+				}
+			}
+			if !ok {
+				p.restoreState(state3)
+				p.restore(pt2)
+			}
+		}
+		if ok {
+			p.cur.pos = start1.position
+			p.cur.text = p.sliceFrom(start1)
+			state := p.cloneState()
+			actVal, err := p.callonDoc1()
+			if err != nil {
+				p.addErrAt(err, start1.position, []string{})
+			}
+			p.restoreState(state)
+			val = actVal
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr16() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		d := expr16Dispatch
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		for _, altI := range d.alts[set] {
+			switch altI {
+			case 0:
+				state1 := p.cloneState()
+				{
+					p.countExpr()
+					val, ok = p.parseRuleWrap(g.rules[2])
+				}
+				if !ok {
+					p.restoreState(state1)
+				}
+			case 1:
+				state2 := p.cloneState()
+				{
+					p.countExpr()
+					val, ok = p.parseRuleWrap(g.rules[3])
+				}
+				if !ok {
+					p.restoreState(state2)
+				}
+			case 2:
+				state3 := p.cloneState()
+				{
+					p.countExpr()
+					val, ok = p.parseRuleWrap(g.rules[4])
+				}
+				if !ok {
+					p.restoreState(state3)
+				}
+			}
+			if ok {
+				break
+			}
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr20() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		start1 := p.pt
+		{
+			p.countExpr()
+			pt2 := p.pt
+			state3 := p.cloneState()
+			var match4 bool
+			{
+				p.countExpr()
+				start := p.pt
+				if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "r" {
+					p.read()
+					p.failAt(true, start.position, "\"r\"")
+					match4 = true
+				} else {
+					p.failAt(false, start.position, "\"r\"")
+				}
+			}
+			if match4 {
+				var match5 bool
+				{
+					p.countExpr()
+					start6 := p.pt
+					var v7 any
+					p.pushV()
+					{
+						p.countExpr()
+						var vals8 []any
+						for {
+							var v10 any
+							var match9 bool
+							{
+								p.countExpr()
+								start := p.pt
+								if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "#" {
+									p.read()
+									p.failAt(true, start.position, "\"#\"")
+									v10 = p.sliceFrom(start)
+									match9 = true
+								} else {
+									p.failAt(false, start.position, "\"#\"")
+								}
+							}
+							if !match9 {
+								break
+							}
+							vals8 = append(vals8, v10)
+						}
+						v7 = vals8
+						match5 = true
+					}
+					p.popV()
+					if match5 {
+						p.vstack[len(p.vstack)-1]["hashes"] = v7
+						p.vstack[len(p.vstack)-1]["$hashes"] = p.sliceFrom(start6)
+						_ = v7
+					}
+				}
+				if match5 {
+					var match11 bool
+					{
+						p.countExpr()
+						start := p.pt
+						if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "\"" {
+							p.read()
+							p.failAt(true, start.position, "\"\\\"\"")
+							match11 = true
+						} else {
+							p.failAt(false, start.position, "\"\\\"\"")
+						}
+					}
+					if match11 {
+						var match12 bool
+						{
+							p.countExpr()
+							for {
+								var match13 bool
+								{
+									p.countExpr()
+									pt14 := p.pt
+									state15 := p.cloneState()
+									var match16 bool
+									{
+										p.countExpr()
+										pt17 := p.pt
+										state18 := p.cloneState()
+										p.maxFailInvertExpected = !p.maxFailInvertExpected
+										var match19 bool
+										{
+											p.countExpr()
+											pt20 := p.pt
+											state21 := p.cloneState()
+											var match22 bool
+											{
+												p.countExpr()
+												start := p.pt
+												if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "\"" {
+													p.read()
+													p.failAt(true, start.position, "\"\\\"\"")
+													match22 = true
+												} else {
+													p.failAt(false, start.position, "\"\\\"\"")
+												}
+											}
+											if match22 {
+												var match23 bool
+												{
+													p.countExpr()
+													_, match23 = p.matchBackRef("$hashes", false)
+												}
+												if match23 {
+													match19 = true
+												}
+											}
+											if !match19 {
+												p.restoreState(state21)
+												p.restore(pt20)
+											}
+										}
+										p.maxFailInvertExpected = !p.maxFailInvertExpected
+										p.restoreState(state18)
+										p.restore(pt17)
+										match16 = !match19
+									}
+									if match16 {
+										var match24 bool
+										{
+											p.countExpr()
+											if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+												// EOF - see utf8.DecodeRune
+												p.failAt(false, p.pt.position, ".")
+											} else {
+												start := p.pt
+												p.read()
+												p.failAt(true, start.position, ".")
+												match24 = true
+											}
+										}
+										if match24 {
+											match13 = true
+										}
+									}
+									if !match13 {
+										p.restoreState(state15)
+										p.restore(pt14)
+									}
+								}
+								if !match13 {
+									break
+								}
+							}
+							match12 = true
+						}
+						if match12 {
+							var match25 bool
+							{
+								p.countExpr()
+								start := p.pt
+								if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "\"" {
+									p.read()
+									p.failAt(true, start.position, "\"\\\"\"")
+									match25 = true
+								} else {
+									p.failAt(false, start.position, "\"\\\"\"")
+								}
+							}
+							if match25 {
+								var match26 bool
+								{
+									p.countExpr()
+									_, match26 = p.matchBackRef("$hashes", false)
+								}
+								if match26 {
+									ok = true
+								}
+							}
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state3)
+				p.restore(pt2)
+			}
+		}
+		if ok {
+			p.cur.pos = start1.position
+			p.cur.text = p.sliceFrom(start1)
+			state := p.cloneState()
+			actVal, err := p.callonRaw1()
+			if err != nil {
+				p.addErrAt(err, start1.position, []string{})
+			}
+			p.restoreState(state)
+			val = actVal
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr36() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		start1 := p.pt
+		{
+			p.countExpr()
+			pt2 := p.pt
+			state3 := p.cloneState()
+			var match4 bool
+			{
+				p.countExpr()
+				start := p.pt
+				if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "<" {
+					p.read()
+					p.failAt(true, start.position, "\"<\"")
+					match4 = true
+				} else {
+					p.failAt(false, start.position, "\"<\"")
+				}
+			}
+			if match4 {
+				var match5 bool
+				{
+					p.countExpr()
+					start6 := p.pt
+					var v7 any
+					p.pushV()
+					{
+						p.countExpr()
+						v7, match5 = p.parseRuleWrap(g.rules[5])
+					}
+					p.popV()
+					if match5 {
+						p.vstack[len(p.vstack)-1]["name"] = v7
+						p.vstack[len(p.vstack)-1]["$name"] = p.sliceFrom(start6)
+						_ = v7
+					}
+				}
+				if match5 {
+					var match8 bool
+					{
+						p.countExpr()
+						start := p.pt
+						if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == ">" {
+							p.read()
+							p.failAt(true, start.position, "\">\"")
+							match8 = true
+						} else {
+							p.failAt(false, start.position, "\">\"")
+						}
+					}
+					if match8 {
+						var match9 bool
+						{
+							p.countExpr()
+							var v10 any
+							p.pushV()
+							{
+								p.countExpr()
+								var vals11 []any
+								for {
+									var v13 any
+									var match12 bool
+									{
+										p.countExpr()
+										v13, match12 = p.parseRuleWrap(g.rules[3])
+									}
+									if !match12 {
+										break
+									}
+									vals11 = append(vals11, v13)
+								}
+								v10 = vals11
+								match9 = true
+							}
+							p.popV()
+							if match9 {
+								p.vstack[len(p.vstack)-1]["children"] = v10
+								_ = v10
+							}
+						}
+						if match9 {
+							var match14 bool
+							{
+								p.countExpr()
+								start := p.pt
+								if end := p.pt.offset + 2; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "</" {
+									for i := 0; i < 2; i++ {
+										p.read()
+									}
+									p.failAt(true, start.position, "\"</\"")
+									match14 = true
+								} else {
+									p.failAt(false, start.position, "\"</\"")
+								}
+							}
+							if match14 {
+								var match15 bool
+								{
+									p.countExpr()
+									_, match15 = p.matchBackRef("$name", false)
+								}
+								if match15 {
+									var match16 bool
+									{
+										p.countExpr()
+										start := p.pt
+										if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == ">" {
+											p.read()
+											p.failAt(true, start.position, "\">\"")
+											match16 = true
+										} else {
+											p.failAt(false, start.position, "\">\"")
+										}
+									}
+									if match16 {
+										ok = true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state3)
+				p.restore(pt2)
+			}
+		}
+		if ok {
+			p.cur.pos = start1.position
+			p.cur.text = p.sliceFrom(start1)
+			state := p.cloneState()
+			actVal, err := p.callonElement1()
+			if err != nil {
+				p.addErrAt(err, start1.position, []string{})
+			}
+			p.restoreState(state)
+			val = actVal
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr48() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		start1 := p.pt
+		{
+			p.countExpr()
+			pt2 := p.pt
+			state3 := p.cloneState()
+			var match4 bool
+			{
+				p.countExpr()
+				start := p.pt
+				if end := p.pt.offset + 2; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "<<" {
+					for i := 0; i < 2; i++ {
+						p.read()
+					}
+					p.failAt(true, start.position, "\"<<\"")
+					match4 = true
+				} else {
+					p.failAt(false, start.position, "\"<<\"")
+				}
+			}
+			if match4 {
+				var match5 bool
+				{
+					p.countExpr()
+					var v6 any
+					p.pushV()
+					{
+						p.countExpr()
+						v6, match5 = p.parseRuleWrap(g.rules[5])
+					}
+					p.popV()
+					if match5 {
+						p.vstack[len(p.vstack)-1]["tag"] = v6
+						_ = v6
+					}
+				}
+				if match5 {
+					var match7 bool
+					{
+						p.countExpr()
+						if err := p.callonHeredoc6(); err != nil {
+							p.addErr(err)
+						}
+						match7 = true
+					}
+					if match7 {
+						var match8 bool
+						{
+							p.countExpr()
+							start := p.pt
+							if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "\n" {
+								p.read()
+								p.failAt(true, start.position, "\"\\n\"")
+								match8 = true
+							} else {
+								p.failAt(false, start.position, "\"\\n\"")
+							}
+						}
+						if match8 {
+							var match9 bool
+							{
+								p.countExpr()
+								for {
+									var match10 bool
+									{
+										p.countExpr()
+										pt11 := p.pt
+										state12 := p.cloneState()
+										var match13 bool
+										{
+											p.countExpr()
+											pt14 := p.pt
+											state15 := p.cloneState()
+											p.maxFailInvertExpected = !p.maxFailInvertExpected
+											var match16 bool
+											{
+												p.countExpr()
+												pt17 := p.pt
+												state18 := p.cloneState()
+												var match19 bool
+												{
+													p.countExpr()
+													start := p.pt
+													if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "\n" {
+														p.read()
+														p.failAt(true, start.position, "\"\\n\"")
+														match19 = true
+													} else {
+														p.failAt(false, start.position, "\"\\n\"")
+													}
+												}
+												if match19 {
+													var match20 bool
+													{
+														p.countExpr()
+														_, match20 = p.matchBackRef("heredoc", true)
+													}
+													if match20 {
+														match16 = true
+													}
+												}
+												if !match16 {
+													p.restoreState(state18)
+													p.restore(pt17)
+												}
+											}
+											p.maxFailInvertExpected = !p.maxFailInvertExpected
+											p.restoreState(state15)
+											p.restore(pt14)
+											match13 = !match16
+										}
+										if match13 {
+											var match21 bool
+											{
+												p.countExpr()
+												if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+													// EOF - see utf8.DecodeRune
+													p.failAt(false, p.pt.position, ".")
+												} else {
+													start := p.pt
+													p.read()
+													p.failAt(true, start.position, ".")
+													match21 = true
+												}
+											}
+											if match21 {
+												match10 = true
+											}
+										}
+										if !match10 {
+											p.restoreState(state12)
+											p.restore(pt11)
+										}
+									}
+									if !match10 {
+										break
+									}
+								}
+								match9 = true
+							}
+							if match9 {
+								var match22 bool
+								{
+									p.countExpr()
+									start := p.pt
+									if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "\n" {
+										p.read()
+										p.failAt(true, start.position, "\"\\n\"")
+										match22 = true
+									} else {
+										p.failAt(false, start.position, "\"\\n\"")
+									}
+								}
+								if match22 {
+									var match23 bool
+									{
+										p.countExpr()
+										_, match23 = p.matchBackRef("heredoc", true)
+									}
+									if match23 {
+										ok = true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state3)
+				p.restore(pt2)
+			}
+		}
+		if ok {
+			p.cur.pos = start1.position
+			p.cur.text = p.sliceFrom(start1)
+			state := p.cloneState()
+			actVal, err := p.callonHeredoc1()
+			if err != nil {
+				p.addErrAt(err, start1.position, []string{})
+			}
+			p.restoreState(state)
+			val = actVal
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr64() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		start1 := p.pt
+		{
+			p.countExpr()
+			n2 := 0
+			for {
+				var match3 bool
+				{
+					p.countExpr()
+					cur := p.pt.rn
+					start := p.pt
+					var matched bool
+					// can't match EOF
+					eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+					if !eof {
+						switch {
+						case cur >= 'a' && cur <= 'z':
+							matched = true
+						}
+					}
+					if matched && !eof {
+						p.read()
+						p.failAt(true, start.position, "[a-z]")
+						match3 = true
+					} else {
+						p.failAt(false, start.position, "[a-z]")
+					}
+				}
+				if !match3 {
+					break
+				}
+				n2++
+			}
+			if n2 > 0 {
+				ok = true
+			}
+		}
+		if ok {
+			p.cur.pos = start1.position
+			p.cur.text = p.sliceFrom(start1)
+			state := p.cloneState()
+			actVal, err := p.callonName1()
+			if err != nil {
+				p.addErrAt(err, start1.position, []string{})
+			}
+			p.restoreState(state)
+			val = actVal
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr67() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		pt1 := p.pt
+		state2 := p.cloneState()
+		p.maxFailInvertExpected = !p.maxFailInvertExpected
+		var match3 bool
+		{
+			p.countExpr()
+			if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+				// EOF - see utf8.DecodeRune
+				p.failAt(false, p.pt.position, ".")
+			} else {
+				start := p.pt
+				p.read()
+				p.failAt(true, start.position, ".")
+				match3 = true
+			}
+		}
+		p.maxFailInvertExpected = !p.maxFailInvertExpected
+		p.restoreState(state2)
+		p.restore(pt1)
+		ok = !match3
+	}
+	return val, ok
+}
+
+var expr16Dispatch = &choiceDispatch{
+	ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+	ranges:    []rune{'\u0080'},
+	rangeSets: "\x00",
+	alts:      [][]int{{}, {1, 2}, {0}},
+	expected:  [][]string{{"\"r\"", "\"<\"", "\"<<\""}, {"\"r\""}, {"\"<\"", "\"<<\""}},
+}
+
+func (c *current) onDoc1(items any) (any, error) {
+	var out []any
+	for _, v := range items.([]any) {
+		out = append(out, v.([]any)[0])
+	}
+	return out, nil
+}
+
+func (p *parser) callonDoc1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoc1(stack["items"])
+}
+
+func (c *current) onRaw1(hashes any) (any, error) {
+	return []any{"raw", string(c.text)}, nil
+}
+
+func (p *parser) callonRaw1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRaw1(stack["hashes"])
+}
+
+func (c *current) onElement1(name, children any) (any, error) {
+	return []any{name, children}, nil
+}
+
+func (p *parser) callonElement1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onElement1(stack["name"], stack["children"])
+}
+
+func (c *current) onHeredoc6(tag any) error {
+	c.state["heredoc"] = tag
+	return nil
+}
+
+func (p *parser) callonHeredoc6() error {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onHeredoc6(stack["tag"])
+}
+
+func (c *current) onHeredoc1(tag any) (any, error) {
+	return []any{"heredoc", string(c.text)}, nil
+}
+
+func (p *parser) callonHeredoc1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onHeredoc1(stack["tag"])
+}
+
+func (c *current) onName1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonName1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onName1()
+}
+
+var (
+	// errNoRule is returned when the grammar to parse has no rule.
+	errNoRule = errors.New("grammar has no rule")
+
+	// errInvalidEntrypoint is returned when the specified entrypoint rule
+	// does not exit.
+	errInvalidEntrypoint = errors.New("invalid entrypoint")
+
+	// errInvalidEncoding is returned when the source is not properly
+	// utf8-encoded.
+	errInvalidEncoding = errors.New("invalid encoding")
+
+	// errMaxExprCnt is used to signal that the maximum number of
+	// expressions have been parsed.
+	errMaxExprCnt = errors.New("max number of expressions parsed")
+)
+
+// Option is a function that can set an option on the parser. It returns
+// the previous setting as an Option.
+type Option func(*parser) Option
+
+// MaxExpressions creates an Option to stop parsing after the provided
+// number of expressions have been parsed, if the value is 0 then the parser will
+// parse for as many steps as needed (possibly an infinite number).
+//
+// The default for maxExprCnt is 0.
+func MaxExpressions(maxExprCnt uint64) Option {
+	return func(p *parser) Option {
+		oldMaxExprCnt := p.maxExprCnt
+		p.maxExprCnt = maxExprCnt
+		return MaxExpressions(oldMaxExprCnt)
+	}
+}
+
+// Entrypoint creates an Option to set the rule name to use as entrypoint.
+// The rule name must have been specified in the -alternate-entrypoints
+// if generating the parser with the -optimize-grammar flag, otherwise
+// it may have been optimized out. Passing an empty string sets the
+// entrypoint to the first rule in the grammar.
+//
+// The default is to start parsing at the first rule in the grammar.
+func Entrypoint(ruleName string) Option {
+	return func(p *parser) Option {
+		oldEntrypoint := p.entrypoint
+		p.entrypoint = ruleName
+		if ruleName == "" {
+			p.entrypoint = g.rules[0].name
+		}
+		return Entrypoint(oldEntrypoint)
+	}
+}
+
+// AllowInvalidUTF8 creates an Option to allow invalid UTF-8 bytes.
+// Every invalid UTF-8 byte is treated as a utf8.RuneError (U+FFFD)
+// by character class matchers and is matched by the any matcher.
+// The returned matched value, c.text and c.offset are NOT affected.
+//
+// The default is false.
+func AllowInvalidUTF8(b bool) Option {
+	return func(p *parser) Option {
+		old := p.allowInvalidUTF8
+		p.allowInvalidUTF8 = b
+		return AllowInvalidUTF8(old)
+	}
+}
+
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
+// Recover creates an Option to set the recover flag to b. When set to
+// true, this causes the parser to recover from panics and convert it
+// to an error. Setting it to false can be useful while debugging to
+// access the full stack trace.
+//
+// The default is true.
+func Recover(b bool) Option {
+	return func(p *parser) Option {
+		old := p.recover
+		p.recover = b
+		return Recover(old)
+	}
+}
+
+// GlobalStore creates an Option to set a key to a certain value in
+// the globalStore.
+func GlobalStore(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.globalStore[key]
+		p.cur.globalStore[key] = value
+		return GlobalStore(key, old)
+	}
+}
+
+// InitState creates an Option to set a key to a certain value in
+// the global "state" store.
+func InitState(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.state[key]
+		p.cur.state[key] = value
+		return InitState(key, old)
+	}
+}
+
+// ParseFile parses the file identified by filename.
+func ParseFile(filename string, opts ...Option) (i any, err error) { // nolint: deadcode
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+	return ParseReader(filename, f, opts...)
+}
+
+// ParseReader parses the data from r using filename as information in the
+// error messages.
+func ParseReader(filename string, r io.Reader, opts ...Option) (any, error) { // nolint: deadcode
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(filename, b, opts...)
+}
+
+// Parse parses the data from b using filename as information in the
+// error messages.
+func Parse(filename string, b []byte, opts ...Option) (any, error) {
+	return newParser(filename, b, opts...).parse(g)
+}
+
+// position records a position in the text.
+type position struct {
+	line, col, offset int
+}
+
+func (p position) String() string {
+	return strconv.Itoa(p.line) + ":" + strconv.Itoa(p.col) + " [" + strconv.Itoa(p.offset) + "]"
+}
+
+// savepoint stores all state required to go back to this point in the
+// parser.
+type savepoint struct {
+	position
+	rn rune
+	w  int
+}
+
+type current struct {
+	pos  position // start position of the match
+	text []byte   // raw text of the match
+
+	// state is a store for arbitrary key,value pairs that the user wants to be
+	// tied to the backtracking of the parser.
+	// This is always rolled back if a parsing rule fails.
+	state storeDict
+
+	// globalStore is a general store for the user to store arbitrary key-value
+	// pairs that they need to manage and that they do not want tied to the
+	// backtracking of the parser. This is only modified by the user and never
+	// rolled back by the parser. It is always up to the user to keep this in a
+	// consistent state.
+	globalStore storeDict
+}
+
+type storeDict map[string]any
+
+// the AST types...
+
+// nolint: structcheck
+type grammar struct {
+	pos   position
+	rules []*rule
+}
+
+// nolint: structcheck
+type rule struct {
+	pos         position
+	name        string
+	displayName string
+	run         func(*parser) (any, bool)
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+//
+//	nolint: structcheck
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
+}
+
+// errList cumulates the errors found by the parser.
+type errList []error
+
+func (e *errList) add(err error) {
+	*e = append(*e, err)
+}
+
+func (e errList) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	e.dedupe()
+	return e
+}
+
+func (e *errList) dedupe() {
+	var cleaned []error
+	set := make(map[string]bool)
+	for _, err := range *e {
+		if msg := err.Error(); !set[msg] {
+			set[msg] = true
+			cleaned = append(cleaned, err)
+		}
+	}
+	*e = cleaned
+}
+
+func (e errList) Error() string {
+	switch len(e) {
+	case 0:
+		return ""
+	case 1:
+		return e[0].Error()
+	default:
+		var buf bytes.Buffer
+
+		for i, err := range e {
+			if i > 0 {
+				buf.WriteRune('\n')
+			}
+			buf.WriteString(err.Error())
+		}
+		return buf.String()
+	}
+}
+
+// parserError wraps an error with a prefix indicating the rule in which
+// the error occurred. The original error is stored in the Inner field.
+type parserError struct {
+	Inner    error
+	pos      position
+	prefix   string
+	expected []string
+}
+
+// Error returns the error message.
+func (p *parserError) Error() string {
+	return p.prefix + ": " + p.Inner.Error()
+}
+
+// newParser creates a parser with the specified input source and options.
+func newParser(filename string, b []byte, opts ...Option) *parser {
+	stats := Stats{
+		ChoiceAltCnt: make(map[string]map[string]int),
+	}
+
+	p := &parser{
+		filename: filename,
+		errs:     new(errList),
+		data:     b,
+		pt:       savepoint{position: position{line: 1}},
+		recover:  true,
+		cur: current{
+			state:       make(storeDict),
+			globalStore: make(storeDict),
+		},
+		maxFailPos:      position{col: 1, line: 1},
+		maxFailExpected: make([]string, 0, 20),
+		Stats:           &stats,
+		// start rule is rule [0] unless an alternate entrypoint is specified
+		entrypoint: g.rules[0].name,
+	}
+	p.setOptions(opts)
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
+
+	if p.maxExprCnt == 0 {
+		p.maxExprCnt = math.MaxUint64
+	}
+
+	return p
+}
+
+// setOptions applies the options to the parser.
+func (p *parser) setOptions(opts []Option) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// nolint: structcheck,deadcode
+type resultTuple struct {
+	v   any
+	b   bool
+	end savepoint
+}
+
+// nolint: varcheck
+const choiceNoMatch = -1
+
+// Stats stores some statistics, gathered during parsing
+type Stats struct {
+	// ExprCnt counts the number of expressions processed during parsing
+	// This value is compared to the maximum number of expressions allowed
+	// (set by the MaxExpressions option).
+	ExprCnt uint64
+
+	// ChoiceAltCnt is used to count for each ordered choice expression,
+	// which alternative is used how may times.
+	// These numbers allow to optimize the order of the ordered choice expression
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
+	//
+	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
+	// as the line and the column of the ordered choice.
+	// The inner key of ChoiceAltCnt is the number (one-based) of the matching alternative.
+	// For each alternative the number of matches are counted. If an ordered choice does not
+	// match, a special counter is incremented. The name of this counter is set with
+	// the parser option Statistics.
+	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
+	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
+}
+
+// nolint: structcheck,maligned
+type parser struct {
+	filename string
+	pt       savepoint
+	cur      current
+
+	data []byte
+	errs *errList
+
+	depth   int
+	recover bool
+
+	// rules table, maps the rule identifier to the rule node
+	rules map[string]*rule
+	// variables stack, map of label to value
+	vstack []map[string]any
+	// rule stack, allows identification of the current rule in errors
+	rstack []*rule
+
+	// parse fail
+	maxFailPos            position
+	maxFailExpected       []string
+	maxFailInvertExpected bool
+
+	// max number of expressions to be parsed
+	maxExprCnt uint64
+	// entrypoint for the parser
+	entrypoint string
+
+	allowInvalidUTF8 bool
+
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+
+	*Stats
+
+	choiceNoMatch string
+	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
+}
+
+// push a variable set on the vstack.
+func (p *parser) pushV() {
+	if cap(p.vstack) == len(p.vstack) {
+		// create new empty slot in the stack
+		p.vstack = append(p.vstack, nil)
+	} else {
+		// slice to 1 more
+		p.vstack = p.vstack[:len(p.vstack)+1]
+	}
+
+	// get the last args set
+	m := p.vstack[len(p.vstack)-1]
+	if m != nil && len(m) == 0 {
+		// empty map, all good
+		return
+	}
+
+	m = make(map[string]any)
+	p.vstack[len(p.vstack)-1] = m
+}
+
+// pop a variable set from the vstack.
+func (p *parser) popV() {
+	// if the map is not empty, clear it
+	m := p.vstack[len(p.vstack)-1]
+	if len(m) > 0 {
+		// GC that map
+		p.vstack[len(p.vstack)-1] = nil
+	}
+	p.vstack = p.vstack[:len(p.vstack)-1]
+}
+
+// push a recovery expression with its labels to the recoveryStack
+func (p *parser) pushRecovery(labels []string, expr any) {
+	if cap(p.recoveryStack) == len(p.recoveryStack) {
+		// create new empty slot in the stack
+		p.recoveryStack = append(p.recoveryStack, recovery{})
+	} else {
+		// slice to 1 more
+		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
+	}
+
+	m := make(map[string]any, len(labels))
+	for _, fl := range labels {
+		m[fl] = expr
+	}
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
+}
+
+// pop a recovery expression from the recoveryStack
+func (p *parser) popRecovery() {
+	// GC that map
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
+
+	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
+}
+
+func (p *parser) addErr(err error) {
+	p.addErrAt(err, p.pt.position, []string{})
+}
+
+func (p *parser) addErrAt(err error, pos position, expected []string) {
+	var buf bytes.Buffer
+	if p.filename != "" {
+		buf.WriteString(p.filename)
+	}
+	if buf.Len() > 0 {
+		buf.WriteString(":")
+	}
+	buf.WriteString(fmt.Sprintf("%d:%d (%d)", pos.line, pos.col, pos.offset))
+	if len(p.rstack) > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString(": ")
+		}
+		rule := p.rstack[len(p.rstack)-1]
+		if rule.displayName != "" {
+			buf.WriteString("rule " + rule.displayName)
+		} else {
+			buf.WriteString("rule " + rule.name)
+		}
+	}
+	pe := &parserError{Inner: err, pos: pos, prefix: buf.String(), expected: expected}
+	p.errs.add(pe)
+}
+
+func (p *parser) failAt(fail bool, pos position, want string) {
+	// process fail if parsing fails and not inverted or parsing succeeds and invert is set
+	if fail == p.maxFailInvertExpected {
+		if pos.offset < p.maxFailPos.offset {
+			return
+		}
+
+		if pos.offset > p.maxFailPos.offset {
+			p.maxFailPos = pos
+			p.maxFailExpected = p.maxFailExpected[:0]
+		}
+
+		if p.maxFailInvertExpected {
+			want = "!" + want
+		}
+		p.maxFailExpected = append(p.maxFailExpected, want)
+	}
+}
+
+// read advances the parser to the next rune.
+func (p *parser) read() {
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
+	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
+	p.pt.rn = rn
+	p.pt.w = n
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
+	}
+
+	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
+		if !p.allowInvalidUTF8 {
+			p.addErr(errInvalidEncoding)
+		}
+	}
+}
+
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
+// matchBackRef matches the text captured by the label whose text key is
+// key, or if state is true, the text of the value of key in the state
+// store, which must be a string or a []byte. Its value is the matched
+// text.
+func (p *parser) matchBackRef(key string, state bool) (any, bool) {
+	var text []byte
+	if state {
+		switch v := p.cur.state[key].(type) {
+		case string:
+			text = []byte(v)
+		case []byte:
+			text = v
+		default:
+			p.addErr(fmt.Errorf("$#%s: invalid text %v", key, v))
+			return nil, false
+		}
+	} else {
+		for i := len(p.vstack) - 1; i >= 0; i-- {
+			if v, ok := p.vstack[i][key]; ok {
+				text = v.([]byte)
+				break
+			}
+		}
+	}
+
+	start := p.pt
+	want := strconv.Quote(string(text))
+	if !bytes.HasPrefix(p.data[start.offset:], text) {
+		p.failAt(false, start.position, want)
+		return nil, false
+	}
+	for p.pt.offset < start.offset+len(text) {
+		p.read()
+	}
+	p.failAt(true, start.position, want)
+	return p.sliceFrom(start), true
+}
+
+// restore parser position to the savepoint pt.
+func (p *parser) restore(pt savepoint) {
+	if pt.offset == p.pt.offset {
+		return
+	}
+	p.pt = pt
+}
+
+// Cloner is implemented by any value that has a Clone method, which returns a
+// copy of the value. This is mainly used for types which are not passed by
+// value (e.g map, slice, chan) or structs that contain such types.
+//
+// This is used in conjunction with the global state feature to create proper
+// copies of the state to allow the parser to properly restore the state in
+// the case of backtracking.
+type Cloner interface {
+	Clone() any
+}
+
+var statePool = &sync.Pool{
+	New: func() any { return make(storeDict) },
+}
+
+func (sd storeDict) Discard() {
+	for k := range sd {
+		delete(sd, k)
+	}
+	statePool.Put(sd)
+}
+
+// clone and return parser current state.
+func (p *parser) cloneState() storeDict {
+
+	state := statePool.Get().(storeDict)
+	for k, v := range p.cur.state {
+		if c, ok := v.(Cloner); ok {
+			state[k] = c.Clone()
+		} else {
+			state[k] = v
+		}
+	}
+	return state
+}
+
+// restore parser current state to the state storeDict.
+// every restoreState should applied only one time for every cloned state
+func (p *parser) restoreState(state storeDict) {
+	p.cur.state.Discard()
+	p.cur.state = state
+}
+
+// get the slice of bytes from the savepoint start to the current position.
+func (p *parser) sliceFrom(start savepoint) []byte {
+	return p.data[start.position.offset:p.pt.position.offset]
+}
+
+func (p *parser) buildRulesTable(g *grammar) {
+	p.rules = make(map[string]*rule, len(g.rules))
+	for _, r := range g.rules {
+		p.rules[r.name] = r
+	}
+}
+
+// nolint: gocyclo
+func (p *parser) parse(g *grammar) (val any, err error) {
+	if len(g.rules) == 0 {
+		p.addErr(errNoRule)
+		return nil, p.errs.err()
+	}
+
+	// TODO : not super critical but this could be generated
+	p.buildRulesTable(g)
+
+	if p.recover {
+		// panic can be used in action code to stop parsing immediately
+		// and return the panic as an error.
+		defer func() {
+			if e := recover(); e != nil {
+				val = nil
+				switch e := e.(type) {
+				case error:
+					p.addErr(e)
+				default:
+					p.addErr(fmt.Errorf("%v", e))
+				}
+				err = p.errs.err()
+			}
+		}()
+	}
+
+	startRule, ok := p.rules[p.entrypoint]
+	if !ok {
+		p.addErr(errInvalidEntrypoint)
+		return nil, p.errs.err()
+	}
+
+	p.read() // advance to first rune
+	val, ok = p.parseRuleWrap(startRule)
+	if !ok {
+		if len(*p.errs) == 0 {
+			// If parsing fails, but no errors have been recorded, the expected values
+			// for the farthest parser position are returned as error.
+			p.addNoMatchErr()
+		}
+
+		return nil, p.errs.err()
+	}
+	return val, p.errs.err()
+}
+
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
+func listJoin(list []string, sep string, lastSep string) string {
+	switch len(list) {
+	case 0:
+		return ""
+	case 1:
+		return list[0]
+	default:
+		return strings.Join(list[:len(list)-1], sep) + " " + lastSep + " " + list[len(list)-1]
+	}
+}
+
+func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
+	var (
+		val any
+		ok  bool
+	)
+
+	val, ok = p.parseRule(rule)
+
+	return val, ok
+}
+
+func (p *parser) parseRule(rule *rule) (any, bool) {
+	p.rstack = append(p.rstack, rule)
+	p.pushV()
+	val, ok := rule.run(p)
+	p.popV()
+	p.rstack = p.rstack[:len(p.rstack)-1]
+	return val, ok
+}
+
+// countExpr counts the evaluation of an expression.
+func (p *parser) countExpr() {
+	p.ExprCnt++
+	if p.ExprCnt > p.maxExprCnt {
+		panic(errMaxExprCnt)
+	}
+}
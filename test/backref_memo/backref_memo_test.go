@@ -0,0 +1,27 @@
+package backrefmemo
+
+import "testing"
+
+func TestBackRefMemoize(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "1ab-ab", want: "first"},
+		{in: "1ab-b", want: "second"},
+		{in: "2xab-ab", want: "first"},
+		{in: "2xab-b", want: "second"},
+	}
+	for _, memo := range []bool{false, true} {
+		for _, tc := range cases {
+			got, err := Parse("", []byte(tc.in), Memoize(memo))
+			if err != nil {
+				t.Errorf("%q, memoize %t: %v", tc.in, memo, err)
+				continue
+			}
+			if got != tc.want {
+				t.Errorf("%q, memoize %t: want %#v, got %#v", tc.in, memo, tc.want, got)
+			}
+		}
+	}
+}
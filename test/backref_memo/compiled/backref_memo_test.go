@@ -0,0 +1 @@
+../backref_memo_test.go
\ No newline at end of file
@@ -0,0 +1,84 @@
+package binary
+
+import (
+	"reflect"
+	"testing"
+)
+
+var png = "\x89PNG\r\n\x1a\n" +
+	"\x00\x00\x00\x03IHDR\xff\x00\n\x01\x02\x03\x04" +
+	"\x00\x00\x00\x00IEND\xae\x42\x60\x82"
+
+func TestPNG(t *testing.T) {
+	got, err := Parse("", []byte(png))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []any{
+		&Chunk{Type: "IHDR", Data: []byte("\xff\x00\n"), CRC: 0x01020304},
+		&Chunk{Type: "IEND", Data: []byte{}, CRC: 0xae426082},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %#v, got %#v", want, got)
+	}
+}
+
+func TestRecord(t *testing.T) {
+	in := "\x00REC\x02\x01\x07\x08\x07\x06\x05\x04\x03\x02\x01\x90\x03abc"
+	got, err := Parse("", []byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Record{Version: 0x0102, Flags: 7, Size: 0x0102030405060708, Tag: 0x90, Name: "abc"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %#v, got %#v", want, got)
+	}
+}
+
+func TestSizedMemoize(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "\x02\x01ab", want: "ab"},
+		// bytes<n> fails at offset 2 with n = 5, then matches with n = 1.
+		{in: "\x05\x01Y", want: "Y"},
+	}
+	for _, memo := range []bool{false, true} {
+		for _, tc := range cases {
+			got, err := Parse("", []byte(tc.in), Entrypoint("Sized"), Memoize(memo))
+			if err != nil {
+				t.Errorf("%q, memoize %t: %v", tc.in, memo, err)
+				continue
+			}
+			if got != tc.want {
+				t.Errorf("%q, memoize %t: want %#v, got %#v", tc.in, memo, tc.want, got)
+			}
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	cases := []struct {
+		in   string
+		want string
+	}{
+		// the positions count bytes, the newline does not start a line.
+		{in: png[:17], want: `1:17 (16): no match found, expected: bytes<length>`},
+		{in: png[:8] + "\x00\x00\x00", want: `1:9 (8): no match found, expected: u32be or EOF`},
+		{in: png[:8] + "\x00\x00\x00\x01ab", want: `1:15 (14): no match found, expected: [a-zA-Z]`},
+		{in: "\x00rec\x01", want: `1:5 (4): no match found, expected: u16le`},
+		{in: "\x00rec\x01\x00\x00" + "\x00\x00\x00\x00\x00\x00\x00\x00" + "\x7f", want: `1:16 (15): no match found, expected: [\x80-\xff]`},
+		{in: "\xff", want: `1:1 (0): no match found, expected: "\x00rec"i or "\x89PNG\r\n\x1a\n"`},
+	}
+	for _, tc := range cases {
+		_, err := Parse("", []byte(tc.in))
+		if err == nil {
+			t.Errorf("%q: want error", tc.in)
+			continue
+		}
+		if err.Error() != tc.want {
+			t.Errorf("%q: want %q, got %q", tc.in, tc.want, err)
+		}
+	}
+}
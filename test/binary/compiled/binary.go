@@ -0,0 +1,2824 @@
+// Code generated by pigeon; DO NOT EDIT.
+
+package binary
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Chunk is a chunk of a PNG file.
+type Chunk struct {
+	Type string
+	Data []byte
+	CRC  uint32
+}
+
+// Record is a record of the little-endian format.
+type Record struct {
+	Version uint16
+	Flags   uint8
+	Size    uint64
+	Tag     byte
+	Name    string
+}
+
+var g = &grammar{
+	rules: []*rule{
+		{
+			name: "File",
+			pos:  position{line: 21, col: 1, offset: 269},
+			id:   -1,
+		},
+		{
+			name: "PNG",
+			pos:  position{line: 23, col: 1, offset: 290},
+			id:   -1,
+		},
+		{
+			name: "Chunk",
+			pos:  position{line: 27, col: 1, offset: 362},
+			id:   -1,
+		},
+		{
+			name: "ChunkType",
+			pos:  position{line: 31, col: 1, offset: 514},
+			id:   3,
+		},
+		{
+			name: "Record",
+			pos:  position{line: 35, col: 1, offset: 598},
+			id:   -1,
+		},
+		{
+			name: "Sized",
+			pos:  position{line: 47, col: 1, offset: 1042},
+			id:   -1,
+		},
+		{
+			name: "SizedBytes",
+			pos:  position{line: 53, col: 1, offset: 1133},
+			id:   -1,
+		},
+		{
+			name: "EOF",
+			pos:  position{line: 57, col: 1, offset: 1210},
+			id:   7,
+		},
+	},
+}
+
+func init() {
+	g.rules[0].run = (*parser).expr8
+	g.rules[1].run = (*parser).expr11
+	g.rules[2].run = (*parser).expr18
+	g.rules[3].run = (*parser).expr28
+	g.rules[4].run = (*parser).expr34
+	g.rules[5].run = (*parser).expr50
+	g.rules[6].run = (*parser).expr62
+	g.rules[7].run = (*parser).expr70
+}
+
+func (p *parser) expr8() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		if p.debug {
+			p.in("parseChoiceExpr")
+		}
+		d := expr8Dispatch
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		for _, altI := range d.alts[set] {
+			switch altI {
+			case 0:
+				state1 := p.cloneState()
+				{
+					p.countExpr()
+					if p.debug {
+						p.in("parseRuleRefExpr PNG")
+					}
+					val, ok = p.parseRuleWrap(g.rules[1])
+					if p.debug {
+						p.out("parseRuleRefExpr PNG")
+					}
+				}
+				if ok {
+					p.incChoiceAltCnt(position{line: 21, col: 8, offset: 276}, altI)
+				}
+				if !ok {
+					p.restoreState(state1)
+				}
+			case 1:
+				state2 := p.cloneState()
+				{
+					p.countExpr()
+					if p.debug {
+						p.in("parseRuleRefExpr Record")
+					}
+					val, ok = p.parseRuleWrap(g.rules[4])
+					if p.debug {
+						p.out("parseRuleRefExpr Record")
+					}
+				}
+				if ok {
+					p.incChoiceAltCnt(position{line: 21, col: 8, offset: 276}, altI)
+				}
+				if !ok {
+					p.restoreState(state2)
+				}
+			}
+			if ok {
+				break
+			}
+		}
+		if !ok {
+			p.incChoiceAltCnt(position{line: 21, col: 8, offset: 276}, choiceNoMatch)
+		}
+		if p.debug {
+			p.out("parseChoiceExpr")
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr11() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		if p.debug {
+			p.in("parseActionExpr")
+		}
+		start1 := p.pt
+		{
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt2 := p.pt
+			state3 := p.cloneState()
+			var match4 bool
+			{
+				if res5, hit := p.compiledMemoized(13); hit {
+					_, match4 = res5.v, res5.b
+				} else {
+					pt6 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLitMatcher")
+					}
+					start := p.pt
+					if end := p.pt.offset + 8; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "\x89PNG\r\n\x1a\n" {
+						p.skip(8)
+						p.failAt(true, start.position, "\"\\x89PNG\\r\\n\\x1a\\n\"")
+						match4 = true
+					} else {
+						p.failAt(false, start.position, "\"\\x89PNG\\r\\n\\x1a\\n\"")
+					}
+					if p.debug {
+						p.out("parseLitMatcher")
+					}
+					p.compiledMemoize(pt6, 13, nil, match4)
+				}
+			}
+			if match4 {
+				var match7 bool
+				{
+					p.countExpr()
+					if p.debug {
+						p.in("parseLabeledExpr")
+					}
+					var v8 any
+					p.pushV()
+					{
+						p.countExpr()
+						if p.debug {
+							p.in("parseZeroOrMoreExpr")
+						}
+						var vals9 []any
+						for {
+							var v11 any
+							var match10 bool
+							{
+								p.countExpr()
+								if p.debug {
+									p.in("parseRuleRefExpr Chunk")
+								}
+								v11, match10 = p.parseRuleWrap(g.rules[2])
+								if p.debug {
+									p.out("parseRuleRefExpr Chunk")
+								}
+							}
+							if !match10 {
+								break
+							}
+							vals9 = append(vals9, v11)
+						}
+						v8 = vals9
+						match7 = true
+						if p.debug {
+							p.out("parseZeroOrMoreExpr")
+						}
+					}
+					p.popV()
+					if match7 {
+						p.vstack[len(p.vstack)-1]["chunks"] = v8
+						_ = v8
+					}
+					if p.debug {
+						p.out("parseLabeledExpr")
+					}
+				}
+				if match7 {
+					var match12 bool
+					{
+						if res13, hit := p.compiledMemoized(17); hit {
+							_, match12 = res13.v, res13.b
+						} else {
+							pt14 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseRuleRefExpr EOF")
+							}
+							_, match12 = p.parseRuleWrap(g.rules[7])
+							if p.debug {
+								p.out("parseRuleRefExpr EOF")
+							}
+							p.compiledMemoize(pt14, 17, nil, match12)
+						}
+					}
+					if match12 {
+						ok = true
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state3)
+				p.restore(pt2)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+		}
+		if ok {
+			p.cur.pos = start1.position
+			p.cur.text = p.sliceFrom(start1)
+			state := p.cloneState()
+			actVal, err := p.callonPNG1()
+			if err != nil {
+				p.addErrAt(err, start1.position, []string{})
+			}
+			p.restoreState(state)
+			val = actVal
+			if p.debug {
+				p.printIndent("MATCH", string(p.sliceFrom(start1)))
+			}
+		}
+		if p.debug {
+			p.out("parseActionExpr")
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr18() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		if p.debug {
+			p.in("parseActionExpr")
+		}
+		start1 := p.pt
+		{
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt2 := p.pt
+			state3 := p.cloneState()
+			var match4 bool
+			{
+				if res5, hit := p.compiledMemoized(20); hit {
+					_, match4 = res5.v, res5.b
+				} else {
+					pt6 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLabeledExpr")
+					}
+					var v7 any
+					p.pushV()
+					{
+						if res8, hit := p.compiledMemoized(21); hit {
+							v7, match4 = res8.v, res8.b
+						} else {
+							pt9 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseUintMatcher u32be")
+							}
+							v7, match4 = p.matchUint("u32be", 4, false)
+							if p.debug {
+								p.out("parseUintMatcher u32be")
+							}
+							p.compiledMemoize(pt9, 21, v7, match4)
+						}
+					}
+					p.popV()
+					if match4 {
+						p.vstack[len(p.vstack)-1]["length"] = v7
+						_ = v7
+					}
+					if p.debug {
+						p.out("parseLabeledExpr")
+					}
+					p.compiledMemoize(pt6, 20, nil, match4)
+				}
+			}
+			if match4 {
+				var match10 bool
+				{
+					if res11, hit := p.compiledMemoized(22); hit {
+						_, match10 = res11.v, res11.b
+					} else {
+						pt12 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseLabeledExpr")
+						}
+						var v13 any
+						p.pushV()
+						{
+							if res14, hit := p.compiledMemoized(23); hit {
+								v13, match10 = res14.v, res14.b
+							} else {
+								pt15 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseRuleRefExpr ChunkType")
+								}
+								v13, match10 = p.parseRuleWrap(g.rules[3])
+								if p.debug {
+									p.out("parseRuleRefExpr ChunkType")
+								}
+								p.compiledMemoize(pt15, 23, v13, match10)
+							}
+						}
+						p.popV()
+						if match10 {
+							p.vstack[len(p.vstack)-1]["typ"] = v13
+							_ = v13
+						}
+						if p.debug {
+							p.out("parseLabeledExpr")
+						}
+						p.compiledMemoize(pt12, 22, nil, match10)
+					}
+				}
+				if match10 {
+					var match16 bool
+					{
+						p.countExpr()
+						if p.debug {
+							p.in("parseLabeledExpr")
+						}
+						var v17 any
+						p.pushV()
+						{
+							p.countExpr()
+							if p.debug {
+								p.in("parseBytesExpr length")
+							}
+							v17, match16 = p.matchBytes("length")
+							if p.debug {
+								p.out("parseBytesExpr length")
+							}
+						}
+						p.popV()
+						if match16 {
+							p.vstack[len(p.vstack)-1]["data"] = v17
+							_ = v17
+						}
+						if p.debug {
+							p.out("parseLabeledExpr")
+						}
+					}
+					if match16 {
+						var match18 bool
+						{
+							if res19, hit := p.compiledMemoized(26); hit {
+								_, match18 = res19.v, res19.b
+							} else {
+								pt20 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseLabeledExpr")
+								}
+								var v21 any
+								p.pushV()
+								{
+									if res22, hit := p.compiledMemoized(27); hit {
+										v21, match18 = res22.v, res22.b
+									} else {
+										pt23 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseUintMatcher u32be")
+										}
+										v21, match18 = p.matchUint("u32be", 4, false)
+										if p.debug {
+											p.out("parseUintMatcher u32be")
+										}
+										p.compiledMemoize(pt23, 27, v21, match18)
+									}
+								}
+								p.popV()
+								if match18 {
+									p.vstack[len(p.vstack)-1]["crc"] = v21
+									_ = v21
+								}
+								if p.debug {
+									p.out("parseLabeledExpr")
+								}
+								p.compiledMemoize(pt20, 26, nil, match18)
+							}
+						}
+						if match18 {
+							ok = true
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state3)
+				p.restore(pt2)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+		}
+		if ok {
+			p.cur.pos = start1.position
+			p.cur.text = p.sliceFrom(start1)
+			state := p.cloneState()
+			actVal, err := p.callonChunk1()
+			if err != nil {
+				p.addErrAt(err, start1.position, []string{})
+			}
+			p.restoreState(state)
+			val = actVal
+			if p.debug {
+				p.printIndent("MATCH", string(p.sliceFrom(start1)))
+			}
+		}
+		if p.debug {
+			p.out("parseActionExpr")
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr28() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(28); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(29); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseSeqExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(30); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseCharClassMatcher")
+							}
+							cur := p.pt.rn
+							start := p.pt
+							var matched bool
+							// can't match EOF
+							eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+							if !eof {
+								switch {
+								case cur >= 'a' && cur <= 'z', cur >= 'A' && cur <= 'Z':
+									matched = true
+								}
+							}
+							if matched && !eof {
+								p.read()
+								p.failAt(true, start.position, "[a-zA-Z]")
+								match8 = true
+							} else {
+								p.failAt(false, start.position, "[a-zA-Z]")
+							}
+							if p.debug {
+								p.out("parseCharClassMatcher")
+							}
+							p.compiledMemoize(pt10, 30, nil, match8)
+						}
+					}
+					if match8 {
+						var match11 bool
+						{
+							if res12, hit := p.compiledMemoized(31); hit {
+								_, match11 = res12.v, res12.b
+							} else {
+								pt13 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseCharClassMatcher")
+								}
+								cur := p.pt.rn
+								start := p.pt
+								var matched bool
+								// can't match EOF
+								eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+								if !eof {
+									switch {
+									case cur >= 'a' && cur <= 'z', cur >= 'A' && cur <= 'Z':
+										matched = true
+									}
+								}
+								if matched && !eof {
+									p.read()
+									p.failAt(true, start.position, "[a-zA-Z]")
+									match11 = true
+								} else {
+									p.failAt(false, start.position, "[a-zA-Z]")
+								}
+								if p.debug {
+									p.out("parseCharClassMatcher")
+								}
+								p.compiledMemoize(pt13, 31, nil, match11)
+							}
+						}
+						if match11 {
+							var match14 bool
+							{
+								if res15, hit := p.compiledMemoized(32); hit {
+									_, match14 = res15.v, res15.b
+								} else {
+									pt16 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseCharClassMatcher")
+									}
+									cur := p.pt.rn
+									start := p.pt
+									var matched bool
+									// can't match EOF
+									eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+									if !eof {
+										switch {
+										case cur >= 'a' && cur <= 'z', cur >= 'A' && cur <= 'Z':
+											matched = true
+										}
+									}
+									if matched && !eof {
+										p.read()
+										p.failAt(true, start.position, "[a-zA-Z]")
+										match14 = true
+									} else {
+										p.failAt(false, start.position, "[a-zA-Z]")
+									}
+									if p.debug {
+										p.out("parseCharClassMatcher")
+									}
+									p.compiledMemoize(pt16, 32, nil, match14)
+								}
+							}
+							if match14 {
+								var match17 bool
+								{
+									if res18, hit := p.compiledMemoized(33); hit {
+										_, match17 = res18.v, res18.b
+									} else {
+										pt19 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseCharClassMatcher")
+										}
+										cur := p.pt.rn
+										start := p.pt
+										var matched bool
+										// can't match EOF
+										eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+										if !eof {
+											switch {
+											case cur >= 'a' && cur <= 'z', cur >= 'A' && cur <= 'Z':
+												matched = true
+											}
+										}
+										if matched && !eof {
+											p.read()
+											p.failAt(true, start.position, "[a-zA-Z]")
+											match17 = true
+										} else {
+											p.failAt(false, start.position, "[a-zA-Z]")
+										}
+										if p.debug {
+											p.out("parseCharClassMatcher")
+										}
+										p.compiledMemoize(pt19, 33, nil, match17)
+									}
+								}
+								if match17 {
+									ok = true
+								}
+							}
+						}
+					}
+					if !ok {
+						p.restoreState(state7)
+						p.restore(pt6)
+					}
+					if p.debug {
+						p.out("parseSeqExpr")
+					}
+					p.compiledMemoize(pt5, 29, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonChunkType1()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 28, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr34() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		if p.debug {
+			p.in("parseActionExpr")
+		}
+		start1 := p.pt
+		{
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt2 := p.pt
+			state3 := p.cloneState()
+			var match4 bool
+			{
+				if res5, hit := p.compiledMemoized(36); hit {
+					_, match4 = res5.v, res5.b
+				} else {
+					pt6 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLitMatcher")
+					}
+					start := p.pt
+					matched := true
+					for _, want := range "\x00rec" {
+						if unicode.ToLower(p.pt.rn) != want {
+							matched = false
+							break
+						}
+						p.read()
+					}
+					if matched {
+						p.failAt(true, start.position, "\"\\x00rec\"i")
+						match4 = true
+					} else {
+						p.failAt(false, start.position, "\"\\x00rec\"i")
+						p.restore(start)
+					}
+					if p.debug {
+						p.out("parseLitMatcher")
+					}
+					p.compiledMemoize(pt6, 36, nil, match4)
+				}
+			}
+			if match4 {
+				var match7 bool
+				{
+					if res8, hit := p.compiledMemoized(37); hit {
+						_, match7 = res8.v, res8.b
+					} else {
+						pt9 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseLabeledExpr")
+						}
+						var v10 any
+						p.pushV()
+						{
+							if res11, hit := p.compiledMemoized(38); hit {
+								v10, match7 = res11.v, res11.b
+							} else {
+								pt12 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseUintMatcher u16le")
+								}
+								v10, match7 = p.matchUint("u16le", 2, true)
+								if p.debug {
+									p.out("parseUintMatcher u16le")
+								}
+								p.compiledMemoize(pt12, 38, v10, match7)
+							}
+						}
+						p.popV()
+						if match7 {
+							p.vstack[len(p.vstack)-1]["version"] = v10
+							_ = v10
+						}
+						if p.debug {
+							p.out("parseLabeledExpr")
+						}
+						p.compiledMemoize(pt9, 37, nil, match7)
+					}
+				}
+				if match7 {
+					var match13 bool
+					{
+						if res14, hit := p.compiledMemoized(39); hit {
+							_, match13 = res14.v, res14.b
+						} else {
+							pt15 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseLabeledExpr")
+							}
+							var v16 any
+							p.pushV()
+							{
+								if res17, hit := p.compiledMemoized(40); hit {
+									v16, match13 = res17.v, res17.b
+								} else {
+									pt18 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseUintMatcher u8")
+									}
+									v16, match13 = p.matchUint("u8", 1, false)
+									if p.debug {
+										p.out("parseUintMatcher u8")
+									}
+									p.compiledMemoize(pt18, 40, v16, match13)
+								}
+							}
+							p.popV()
+							if match13 {
+								p.vstack[len(p.vstack)-1]["flags"] = v16
+								_ = v16
+							}
+							if p.debug {
+								p.out("parseLabeledExpr")
+							}
+							p.compiledMemoize(pt15, 39, nil, match13)
+						}
+					}
+					if match13 {
+						var match19 bool
+						{
+							if res20, hit := p.compiledMemoized(41); hit {
+								_, match19 = res20.v, res20.b
+							} else {
+								pt21 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseLabeledExpr")
+								}
+								var v22 any
+								p.pushV()
+								{
+									if res23, hit := p.compiledMemoized(42); hit {
+										v22, match19 = res23.v, res23.b
+									} else {
+										pt24 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseUintMatcher u64le")
+										}
+										v22, match19 = p.matchUint("u64le", 8, true)
+										if p.debug {
+											p.out("parseUintMatcher u64le")
+										}
+										p.compiledMemoize(pt24, 42, v22, match19)
+									}
+								}
+								p.popV()
+								if match19 {
+									p.vstack[len(p.vstack)-1]["size"] = v22
+									_ = v22
+								}
+								if p.debug {
+									p.out("parseLabeledExpr")
+								}
+								p.compiledMemoize(pt21, 41, nil, match19)
+							}
+						}
+						if match19 {
+							var match25 bool
+							{
+								if res26, hit := p.compiledMemoized(43); hit {
+									_, match25 = res26.v, res26.b
+								} else {
+									pt27 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseLabeledExpr")
+									}
+									var v28 any
+									p.pushV()
+									{
+										if res29, hit := p.compiledMemoized(44); hit {
+											v28, match25 = res29.v, res29.b
+										} else {
+											pt30 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseCharClassMatcher")
+											}
+											cur := p.pt.rn
+											start := p.pt
+											var matched bool
+											// can't match EOF
+											eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+											if !eof {
+												switch {
+												case cur >= '\u0080' && cur <= 'ÿ':
+													matched = true
+												}
+											}
+											if matched && !eof {
+												p.read()
+												p.failAt(true, start.position, "[\\x80-\\xff]")
+												v28 = p.sliceFrom(start)
+												match25 = true
+											} else {
+												p.failAt(false, start.position, "[\\x80-\\xff]")
+											}
+											if p.debug {
+												p.out("parseCharClassMatcher")
+											}
+											p.compiledMemoize(pt30, 44, v28, match25)
+										}
+									}
+									p.popV()
+									if match25 {
+										p.vstack[len(p.vstack)-1]["tag"] = v28
+										_ = v28
+									}
+									if p.debug {
+										p.out("parseLabeledExpr")
+									}
+									p.compiledMemoize(pt27, 43, nil, match25)
+								}
+							}
+							if match25 {
+								var match31 bool
+								{
+									if res32, hit := p.compiledMemoized(45); hit {
+										_, match31 = res32.v, res32.b
+									} else {
+										pt33 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseLabeledExpr")
+										}
+										var v34 any
+										p.pushV()
+										{
+											if res35, hit := p.compiledMemoized(46); hit {
+												v34, match31 = res35.v, res35.b
+											} else {
+												pt36 := p.pt
+												p.countExpr()
+												if p.debug {
+													p.in("parseUintMatcher u8")
+												}
+												v34, match31 = p.matchUint("u8", 1, false)
+												if p.debug {
+													p.out("parseUintMatcher u8")
+												}
+												p.compiledMemoize(pt36, 46, v34, match31)
+											}
+										}
+										p.popV()
+										if match31 {
+											p.vstack[len(p.vstack)-1]["n"] = v34
+											_ = v34
+										}
+										if p.debug {
+											p.out("parseLabeledExpr")
+										}
+										p.compiledMemoize(pt33, 45, nil, match31)
+									}
+								}
+								if match31 {
+									var match37 bool
+									{
+										p.countExpr()
+										if p.debug {
+											p.in("parseLabeledExpr")
+										}
+										var v38 any
+										p.pushV()
+										{
+											p.countExpr()
+											if p.debug {
+												p.in("parseBytesExpr n")
+											}
+											v38, match37 = p.matchBytes("n")
+											if p.debug {
+												p.out("parseBytesExpr n")
+											}
+										}
+										p.popV()
+										if match37 {
+											p.vstack[len(p.vstack)-1]["name"] = v38
+											_ = v38
+										}
+										if p.debug {
+											p.out("parseLabeledExpr")
+										}
+									}
+									if match37 {
+										var match39 bool
+										{
+											if res40, hit := p.compiledMemoized(49); hit {
+												_, match39 = res40.v, res40.b
+											} else {
+												pt41 := p.pt
+												p.countExpr()
+												if p.debug {
+													p.in("parseRuleRefExpr EOF")
+												}
+												_, match39 = p.parseRuleWrap(g.rules[7])
+												if p.debug {
+													p.out("parseRuleRefExpr EOF")
+												}
+												p.compiledMemoize(pt41, 49, nil, match39)
+											}
+										}
+										if match39 {
+											ok = true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state3)
+				p.restore(pt2)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+		}
+		if ok {
+			p.cur.pos = start1.position
+			p.cur.text = p.sliceFrom(start1)
+			state := p.cloneState()
+			actVal, err := p.callonRecord1()
+			if err != nil {
+				p.addErrAt(err, start1.position, []string{})
+			}
+			p.restoreState(state)
+			val = actVal
+			if p.debug {
+				p.printIndent("MATCH", string(p.sliceFrom(start1)))
+			}
+		}
+		if p.debug {
+			p.out("parseActionExpr")
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr50() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		if p.debug {
+			p.in("parseChoiceExpr")
+		}
+		d := expr50Dispatch
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		for _, altI := range d.alts[set] {
+			switch altI {
+			case 0:
+				state1 := p.cloneState()
+				p.pushV()
+				{
+					p.countExpr()
+					if p.debug {
+						p.in("parseActionExpr")
+					}
+					start2 := p.pt
+					{
+						p.countExpr()
+						if p.debug {
+							p.in("parseSeqExpr")
+						}
+						pt3 := p.pt
+						state4 := p.cloneState()
+						var match5 bool
+						{
+							p.countExpr()
+							if p.debug {
+								p.in("parseLabeledExpr")
+							}
+							var v6 any
+							p.pushV()
+							{
+								p.countExpr()
+								if p.debug {
+									p.in("parseRuleRefExpr SizedBytes")
+								}
+								v6, match5 = p.parseRuleWrap(g.rules[6])
+								if p.debug {
+									p.out("parseRuleRefExpr SizedBytes")
+								}
+							}
+							p.popV()
+							if match5 {
+								p.vstack[len(p.vstack)-1]["v"] = v6
+								_ = v6
+							}
+							if p.debug {
+								p.out("parseLabeledExpr")
+							}
+						}
+						if match5 {
+							var match7 bool
+							{
+								if res8, hit := p.compiledMemoized(55); hit {
+									_, match7 = res8.v, res8.b
+								} else {
+									pt9 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseRuleRefExpr EOF")
+									}
+									_, match7 = p.parseRuleWrap(g.rules[7])
+									if p.debug {
+										p.out("parseRuleRefExpr EOF")
+									}
+									p.compiledMemoize(pt9, 55, nil, match7)
+								}
+							}
+							if match7 {
+								ok = true
+							}
+						}
+						if !ok {
+							p.restoreState(state4)
+							p.restore(pt3)
+						}
+						if p.debug {
+							p.out("parseSeqExpr")
+						}
+					}
+					if ok {
+						p.cur.pos = start2.position
+						p.cur.text = p.sliceFrom(start2)
+						state := p.cloneState()
+						actVal, err := p.callonSized2()
+						if err != nil {
+							p.addErrAt(err, start2.position, []string{})
+						}
+						p.restoreState(state)
+						val = actVal
+						if p.debug {
+							p.printIndent("MATCH", string(p.sliceFrom(start2)))
+						}
+					}
+					if p.debug {
+						p.out("parseActionExpr")
+					}
+				}
+				p.popV()
+				if ok {
+					p.incChoiceAltCnt(position{line: 47, col: 9, offset: 1050}, altI)
+				}
+				if !ok {
+					p.restoreState(state1)
+				}
+			case 1:
+				state10 := p.cloneState()
+				p.pushV()
+				{
+					p.countExpr()
+					if p.debug {
+						p.in("parseActionExpr")
+					}
+					start11 := p.pt
+					{
+						p.countExpr()
+						if p.debug {
+							p.in("parseSeqExpr")
+						}
+						pt12 := p.pt
+						state13 := p.cloneState()
+						var match14 bool
+						{
+							if res15, hit := p.compiledMemoized(58); hit {
+								_, match14 = res15.v, res15.b
+							} else {
+								pt16 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseAnyMatcher")
+								}
+								if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+									// EOF - see utf8.DecodeRune
+									p.failAt(false, p.pt.position, ".")
+								} else {
+									start := p.pt
+									p.read()
+									p.failAt(true, start.position, ".")
+									match14 = true
+								}
+								if p.debug {
+									p.out("parseAnyMatcher")
+								}
+								p.compiledMemoize(pt16, 58, nil, match14)
+							}
+						}
+						if match14 {
+							var match17 bool
+							{
+								p.countExpr()
+								if p.debug {
+									p.in("parseLabeledExpr")
+								}
+								var v18 any
+								p.pushV()
+								{
+									p.countExpr()
+									if p.debug {
+										p.in("parseRuleRefExpr SizedBytes")
+									}
+									v18, match17 = p.parseRuleWrap(g.rules[6])
+									if p.debug {
+										p.out("parseRuleRefExpr SizedBytes")
+									}
+								}
+								p.popV()
+								if match17 {
+									p.vstack[len(p.vstack)-1]["v"] = v18
+									_ = v18
+								}
+								if p.debug {
+									p.out("parseLabeledExpr")
+								}
+							}
+							if match17 {
+								var match19 bool
+								{
+									if res20, hit := p.compiledMemoized(61); hit {
+										_, match19 = res20.v, res20.b
+									} else {
+										pt21 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr EOF")
+										}
+										_, match19 = p.parseRuleWrap(g.rules[7])
+										if p.debug {
+											p.out("parseRuleRefExpr EOF")
+										}
+										p.compiledMemoize(pt21, 61, nil, match19)
+									}
+								}
+								if match19 {
+									ok = true
+								}
+							}
+						}
+						if !ok {
+							p.restoreState(state13)
+							p.restore(pt12)
+						}
+						if p.debug {
+							p.out("parseSeqExpr")
+						}
+					}
+					if ok {
+						p.cur.pos = start11.position
+						p.cur.text = p.sliceFrom(start11)
+						state := p.cloneState()
+						actVal, err := p.callonSized7()
+						if err != nil {
+							p.addErrAt(err, start11.position, []string{})
+						}
+						p.restoreState(state)
+						val = actVal
+						if p.debug {
+							p.printIndent("MATCH", string(p.sliceFrom(start11)))
+						}
+					}
+					if p.debug {
+						p.out("parseActionExpr")
+					}
+				}
+				p.popV()
+				if ok {
+					p.incChoiceAltCnt(position{line: 47, col: 9, offset: 1050}, altI)
+				}
+				if !ok {
+					p.restoreState(state10)
+				}
+			}
+			if ok {
+				break
+			}
+		}
+		if !ok {
+			p.incChoiceAltCnt(position{line: 47, col: 9, offset: 1050}, choiceNoMatch)
+		}
+		if p.debug {
+			p.out("parseChoiceExpr")
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr62() (any, bool) {
+	var val any
+	var ok bool
+	{
+		p.countExpr()
+		if p.debug {
+			p.in("parseActionExpr")
+		}
+		start1 := p.pt
+		{
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt2 := p.pt
+			state3 := p.cloneState()
+			var match4 bool
+			{
+				if res5, hit := p.compiledMemoized(64); hit {
+					_, match4 = res5.v, res5.b
+				} else {
+					pt6 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLabeledExpr")
+					}
+					var v7 any
+					p.pushV()
+					{
+						if res8, hit := p.compiledMemoized(65); hit {
+							v7, match4 = res8.v, res8.b
+						} else {
+							pt9 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseUintMatcher u8")
+							}
+							v7, match4 = p.matchUint("u8", 1, false)
+							if p.debug {
+								p.out("parseUintMatcher u8")
+							}
+							p.compiledMemoize(pt9, 65, v7, match4)
+						}
+					}
+					p.popV()
+					if match4 {
+						p.vstack[len(p.vstack)-1]["n"] = v7
+						_ = v7
+					}
+					if p.debug {
+						p.out("parseLabeledExpr")
+					}
+					p.compiledMemoize(pt6, 64, nil, match4)
+				}
+			}
+			if match4 {
+				var match10 bool
+				{
+					if res11, hit := p.compiledMemoized(66); hit {
+						_, match10 = res11.v, res11.b
+					} else {
+						pt12 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseZeroOrOneExpr")
+						}
+						{
+							if res13, hit := p.compiledMemoized(67); hit {
+								_, match10 = res13.v, res13.b
+							} else {
+								pt14 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseLitMatcher")
+								}
+								start := p.pt
+								if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "\x01" {
+									p.skip(1)
+									p.failAt(true, start.position, "\"\\x01\"")
+									match10 = true
+								} else {
+									p.failAt(false, start.position, "\"\\x01\"")
+								}
+								if p.debug {
+									p.out("parseLitMatcher")
+								}
+								p.compiledMemoize(pt14, 67, nil, match10)
+							}
+						}
+						match10 = true
+						if p.debug {
+							p.out("parseZeroOrOneExpr")
+						}
+						p.compiledMemoize(pt12, 66, nil, match10)
+					}
+				}
+				if match10 {
+					var match15 bool
+					{
+						p.countExpr()
+						if p.debug {
+							p.in("parseLabeledExpr")
+						}
+						var v16 any
+						p.pushV()
+						{
+							p.countExpr()
+							if p.debug {
+								p.in("parseBytesExpr n")
+							}
+							v16, match15 = p.matchBytes("n")
+							if p.debug {
+								p.out("parseBytesExpr n")
+							}
+						}
+						p.popV()
+						if match15 {
+							p.vstack[len(p.vstack)-1]["v"] = v16
+							_ = v16
+						}
+						if p.debug {
+							p.out("parseLabeledExpr")
+						}
+					}
+					if match15 {
+						ok = true
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state3)
+				p.restore(pt2)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+		}
+		if ok {
+			p.cur.pos = start1.position
+			p.cur.text = p.sliceFrom(start1)
+			state := p.cloneState()
+			actVal, err := p.callonSizedBytes1()
+			if err != nil {
+				p.addErrAt(err, start1.position, []string{})
+			}
+			p.restoreState(state)
+			val = actVal
+			if p.debug {
+				p.printIndent("MATCH", string(p.sliceFrom(start1)))
+			}
+		}
+		if p.debug {
+			p.out("parseActionExpr")
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr70() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(70); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseNotExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			p.maxFailInvertExpected = !p.maxFailInvertExpected
+			var match5 bool
+			{
+				if res6, hit := p.compiledMemoized(71); hit {
+					_, match5 = res6.v, res6.b
+				} else {
+					pt7 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseAnyMatcher")
+					}
+					if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+						// EOF - see utf8.DecodeRune
+						p.failAt(false, p.pt.position, ".")
+					} else {
+						start := p.pt
+						p.read()
+						p.failAt(true, start.position, ".")
+						match5 = true
+					}
+					if p.debug {
+						p.out("parseAnyMatcher")
+					}
+					p.compiledMemoize(pt7, 71, nil, match5)
+				}
+			}
+			p.maxFailInvertExpected = !p.maxFailInvertExpected
+			p.restoreState(state4)
+			p.restore(pt3)
+			ok = !match5
+			if p.debug {
+				p.out("parseNotExpr")
+			}
+			p.compiledMemoize(pt2, 70, val, ok)
+		}
+	}
+	return val, ok
+}
+
+var expr8Dispatch = &choiceDispatch{
+	ascii:     "\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+	ranges:    []rune{'\u0080', '\u0089', '\u008a', '�', '\ufffe'},
+	rangeSets: "\x00\x02\x00\x02\x00",
+	alts:      [][]int{{}, {1}, {0}},
+	expected:  [][]string{{"\"\\x89PNG\\r\\n\\x1a\\n\"", "\"\\x00rec\"i"}, {"\"\\x89PNG\\r\\n\\x1a\\n\""}, {"\"\\x00rec\"i"}},
+}
+
+var expr50Dispatch = &choiceDispatch{
+	ascii:     "\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01",
+	ranges:    []rune{'\u0080'},
+	rangeSets: "\x01",
+	alts:      [][]int{{0}, {0, 1}},
+	expected:  [][]string{{"."}, {}},
+}
+
+func (c *current) onPNG1(chunks any) (any, error) {
+	return chunks, nil
+}
+
+func (p *parser) callonPNG1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPNG1(stack["chunks"])
+}
+
+func (c *current) onChunk1(length, typ, data, crc any) (any, error) {
+	return &Chunk{Type: typ.(string), Data: data.([]byte), CRC: crc.(uint32)}, nil
+}
+
+func (p *parser) callonChunk1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onChunk1(stack["length"], stack["typ"], stack["data"], stack["crc"])
+}
+
+func (c *current) onChunkType1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonChunkType1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onChunkType1()
+}
+
+func (c *current) onRecord1(version, flags, size, tag, n, name any) (any, error) {
+	return &Record{
+		Version: version.(uint16),
+		Flags:   flags.(uint8),
+		Size:    size.(uint64),
+		Tag:     tag.([]byte)[0],
+		Name:    string(name.([]byte)),
+	}, nil
+}
+
+func (p *parser) callonRecord1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRecord1(stack["version"], stack["flags"], stack["size"], stack["tag"], stack["n"], stack["name"])
+}
+
+func (c *current) onSized2(v any) (any, error) {
+	return v, nil
+}
+
+func (p *parser) callonSized2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSized2(stack["v"])
+}
+
+func (c *current) onSized7(v any) (any, error) {
+	return v, nil
+}
+
+func (p *parser) callonSized7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSized7(stack["v"])
+}
+
+func (c *current) onSizedBytes1(n, v any) (any, error) {
+	return string(v.([]byte)), nil
+}
+
+func (p *parser) callonSizedBytes1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSizedBytes1(stack["n"], stack["v"])
+}
+
+var (
+	// errNoRule is returned when the grammar to parse has no rule.
+	errNoRule = errors.New("grammar has no rule")
+
+	// errInvalidEntrypoint is returned when the specified entrypoint rule
+	// does not exit.
+	errInvalidEntrypoint = errors.New("invalid entrypoint")
+
+	// errInvalidEncoding is returned when the source is not properly
+	// utf8-encoded.
+	errInvalidEncoding = errors.New("invalid encoding")
+
+	// errMaxExprCnt is used to signal that the maximum number of
+	// expressions have been parsed.
+	errMaxExprCnt = errors.New("max number of expressions parsed")
+)
+
+// Option is a function that can set an option on the parser. It returns
+// the previous setting as an Option.
+type Option func(*parser) Option
+
+// MaxExpressions creates an Option to stop parsing after the provided
+// number of expressions have been parsed, if the value is 0 then the parser will
+// parse for as many steps as needed (possibly an infinite number).
+//
+// The default for maxExprCnt is 0.
+func MaxExpressions(maxExprCnt uint64) Option {
+	return func(p *parser) Option {
+		oldMaxExprCnt := p.maxExprCnt
+		p.maxExprCnt = maxExprCnt
+		return MaxExpressions(oldMaxExprCnt)
+	}
+}
+
+// Entrypoint creates an Option to set the rule name to use as entrypoint.
+// The rule name must have been specified in the -alternate-entrypoints
+// if generating the parser with the -optimize-grammar flag, otherwise
+// it may have been optimized out. Passing an empty string sets the
+// entrypoint to the first rule in the grammar.
+//
+// The default is to start parsing at the first rule in the grammar.
+func Entrypoint(ruleName string) Option {
+	return func(p *parser) Option {
+		oldEntrypoint := p.entrypoint
+		p.entrypoint = ruleName
+		if ruleName == "" {
+			p.entrypoint = g.rules[0].name
+		}
+		return Entrypoint(oldEntrypoint)
+	}
+}
+
+// Statistics adds a user provided Stats struct to the parser to allow
+// the user to process the results after the parsing has finished.
+// Also the key for the "no match" counter is set. Per-rule statistics
+// (see RuleStats) are only collected when this option is set.
+//
+// Example usage:
+//
+//	input := "input"
+//	stats := Stats{}
+//	_, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	fmt.Println(string(b))
+func Statistics(stats *Stats, choiceNoMatch string) Option {
+	return func(p *parser) Option {
+		oldStats := p.Stats
+		p.Stats = stats
+		oldChoiceNoMatch := p.choiceNoMatch
+		p.choiceNoMatch = choiceNoMatch
+		if p.Stats.ChoiceAltCnt == nil {
+			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
+		}
+		if p.Stats.Rules == nil {
+			p.Stats.Rules = make(map[string]*RuleStats)
+		}
+		return Statistics(oldStats, oldChoiceNoMatch)
+	}
+}
+
+// Debug creates an Option to set the debug flag to b. When set to true,
+// debugging information is printed to stdout while parsing.
+//
+// The default is false.
+func Debug(b bool) Option {
+	return func(p *parser) Option {
+		old := p.debug
+		p.debug = b
+		return Debug(old)
+	}
+}
+
+// Memoize creates an Option to set the memoize flag to b. When set to true,
+// the parser will cache all results so each expression is evaluated only
+// once. This guarantees linear parsing time even for pathological cases,
+// at the expense of more memory and slower times for typical cases.
+// The rules annotated with @memo in the grammar are always memoized,
+// regardless of this option. The memoization table is pooled, so its
+// storage is reused by the following parses.
+//
+// The default is false.
+func Memoize(b bool) Option {
+	return func(p *parser) Option {
+		old := p.memoize
+		p.memoize = b
+		return Memoize(old)
+	}
+}
+
+// AllowInvalidUTF8 creates an Option to allow invalid UTF-8 bytes.
+// Every invalid UTF-8 byte is treated as a utf8.RuneError (U+FFFD)
+// by character class matchers and is matched by the any matcher.
+// The returned matched value, c.text and c.offset are NOT affected.
+//
+// The default is false.
+func AllowInvalidUTF8(b bool) Option {
+	return func(p *parser) Option {
+		old := p.allowInvalidUTF8
+		p.allowInvalidUTF8 = b
+		return AllowInvalidUTF8(old)
+	}
+}
+
+// Recover creates an Option to set the recover flag to b. When set to
+// true, this causes the parser to recover from panics and convert it
+// to an error. Setting it to false can be useful while debugging to
+// access the full stack trace.
+//
+// The default is true.
+func Recover(b bool) Option {
+	return func(p *parser) Option {
+		old := p.recover
+		p.recover = b
+		return Recover(old)
+	}
+}
+
+// GlobalStore creates an Option to set a key to a certain value in
+// the globalStore.
+func GlobalStore(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.globalStore[key]
+		p.cur.globalStore[key] = value
+		return GlobalStore(key, old)
+	}
+}
+
+// InitState creates an Option to set a key to a certain value in
+// the global "state" store.
+func InitState(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.state[key]
+		p.cur.state[key] = value
+		return InitState(key, old)
+	}
+}
+
+// ParseFile parses the file identified by filename.
+func ParseFile(filename string, opts ...Option) (i any, err error) { // nolint: deadcode
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+	return ParseReader(filename, f, opts...)
+}
+
+// ParseReader parses the data from r using filename as information in the
+// error messages.
+func ParseReader(filename string, r io.Reader, opts ...Option) (any, error) { // nolint: deadcode
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(filename, b, opts...)
+}
+
+// Parse parses the data from b using filename as information in the
+// error messages.
+func Parse(filename string, b []byte, opts ...Option) (any, error) {
+	return newParser(filename, b, opts...).parse(g)
+}
+
+// position records a position in the text.
+type position struct {
+	line, col, offset int
+}
+
+func (p position) String() string {
+	return strconv.Itoa(p.line) + ":" + strconv.Itoa(p.col) + " [" + strconv.Itoa(p.offset) + "]"
+}
+
+// savepoint stores all state required to go back to this point in the
+// parser.
+type savepoint struct {
+	position
+	rn rune
+	w  int
+}
+
+type current struct {
+	pos  position // start position of the match
+	text []byte   // raw text of the match
+
+	// state is a store for arbitrary key,value pairs that the user wants to be
+	// tied to the backtracking of the parser.
+	// This is always rolled back if a parsing rule fails.
+	state storeDict
+
+	// globalStore is a general store for the user to store arbitrary key-value
+	// pairs that they need to manage and that they do not want tied to the
+	// backtracking of the parser. This is only modified by the user and never
+	// rolled back by the parser. It is always up to the user to keep this in a
+	// consistent state.
+	globalStore storeDict
+}
+
+type storeDict map[string]any
+
+// the AST types...
+
+// nolint: structcheck
+type grammar struct {
+	pos   position
+	rules []*rule
+}
+
+// nolint: structcheck
+type rule struct {
+	pos         position
+	name        string
+	displayName string
+	run         func(*parser) (any, bool)
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+//
+//	nolint: structcheck
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
+}
+
+// errList cumulates the errors found by the parser.
+type errList []error
+
+func (e *errList) add(err error) {
+	*e = append(*e, err)
+}
+
+func (e errList) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	e.dedupe()
+	return e
+}
+
+func (e *errList) dedupe() {
+	var cleaned []error
+	set := make(map[string]bool)
+	for _, err := range *e {
+		if msg := err.Error(); !set[msg] {
+			set[msg] = true
+			cleaned = append(cleaned, err)
+		}
+	}
+	*e = cleaned
+}
+
+func (e errList) Error() string {
+	switch len(e) {
+	case 0:
+		return ""
+	case 1:
+		return e[0].Error()
+	default:
+		var buf bytes.Buffer
+
+		for i, err := range e {
+			if i > 0 {
+				buf.WriteRune('\n')
+			}
+			buf.WriteString(err.Error())
+		}
+		return buf.String()
+	}
+}
+
+// parserError wraps an error with a prefix indicating the rule in which
+// the error occurred. The original error is stored in the Inner field.
+type parserError struct {
+	Inner    error
+	pos      position
+	prefix   string
+	expected []string
+}
+
+// Error returns the error message.
+func (p *parserError) Error() string {
+	return p.prefix + ": " + p.Inner.Error()
+}
+
+// newParser creates a parser with the specified input source and options.
+func newParser(filename string, b []byte, opts ...Option) *parser {
+	stats := Stats{
+		ChoiceAltCnt: make(map[string]map[string]int),
+	}
+
+	p := &parser{
+		filename: filename,
+		errs:     new(errList),
+		data:     b,
+		pt:       savepoint{position: position{line: 1}},
+		recover:  true,
+		cur: current{
+			state:       make(storeDict),
+			globalStore: make(storeDict),
+		},
+		maxFailPos:      position{col: 1, line: 1},
+		maxFailExpected: make([]string, 0, 20),
+		Stats:           &stats,
+		// start rule is rule [0] unless an alternate entrypoint is specified
+		entrypoint: g.rules[0].name,
+	}
+	p.setOptions(opts)
+
+	if p.maxExprCnt == 0 {
+		p.maxExprCnt = math.MaxUint64
+	}
+
+	return p
+}
+
+// setOptions applies the options to the parser.
+func (p *parser) setOptions(opts []Option) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// nolint: structcheck,deadcode
+type resultTuple struct {
+	v   any
+	b   bool
+	end savepoint
+}
+
+// nolint: varcheck
+const choiceNoMatch = -1
+
+// Stats stores some statistics, gathered during parsing
+type Stats struct {
+	// ExprCnt counts the number of expressions processed during parsing
+	// This value is compared to the maximum number of expressions allowed
+	// (set by the MaxExpressions option).
+	ExprCnt uint64
+
+	// ChoiceAltCnt is used to count for each ordered choice expression,
+	// which alternative is used how may times.
+	// These numbers allow to optimize the order of the ordered choice expression
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
+	//
+	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
+	// as the line and the column of the ordered choice.
+	// The inner key of ChoiceAltCnt is the number (one-based) of the matching alternative.
+	// For each alternative the number of matches are counted. If an ordered choice does not
+	// match, a special counter is incremented. The name of this counter is set with
+	// the parser option Statistics.
+	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
+	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
+}
+
+// nolint: structcheck,maligned
+type parser struct {
+	filename string
+	pt       savepoint
+	cur      current
+
+	data []byte
+	errs *errList
+
+	depth   int
+	recover bool
+	debug   bool
+
+	memoize bool
+	// memoization table for the packrat algorithm, taken from memoTables
+	// on the first memoized result
+	memo *memoTable
+
+	// rules table, maps the rule identifier to the rule node
+	rules map[string]*rule
+	// variables stack, map of label to value
+	vstack []map[string]any
+	// rule stack, allows identification of the current rule in errors
+	rstack []*rule
+
+	// parse fail
+	maxFailPos            position
+	maxFailExpected       []string
+	maxFailInvertExpected bool
+
+	// max number of expressions to be parsed
+	maxExprCnt uint64
+	// entrypoint for the parser
+	entrypoint string
+
+	allowInvalidUTF8 bool
+
+	*Stats
+	// profiling frames, tracks the time spent in invoked rules to compute
+	// the self time of each rule.
+	profStack []profFrame
+	// number of active invocations of each rule, the time of a recursive
+	// rule is only added at its outermost invocation.
+	profActive map[*rule]int
+	// rules evaluated at each offset, to count the repeated evaluations.
+	profEvals map[profEval]bool
+
+	choiceNoMatch string
+	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
+}
+
+// push a variable set on the vstack.
+func (p *parser) pushV() {
+	if cap(p.vstack) == len(p.vstack) {
+		// create new empty slot in the stack
+		p.vstack = append(p.vstack, nil)
+	} else {
+		// slice to 1 more
+		p.vstack = p.vstack[:len(p.vstack)+1]
+	}
+
+	// get the last args set
+	m := p.vstack[len(p.vstack)-1]
+	if m != nil && len(m) == 0 {
+		// empty map, all good
+		return
+	}
+
+	m = make(map[string]any)
+	p.vstack[len(p.vstack)-1] = m
+}
+
+// pop a variable set from the vstack.
+func (p *parser) popV() {
+	// if the map is not empty, clear it
+	m := p.vstack[len(p.vstack)-1]
+	if len(m) > 0 {
+		// GC that map
+		p.vstack[len(p.vstack)-1] = nil
+	}
+	p.vstack = p.vstack[:len(p.vstack)-1]
+}
+
+// push a recovery expression with its labels to the recoveryStack
+func (p *parser) pushRecovery(labels []string, expr any) {
+	if cap(p.recoveryStack) == len(p.recoveryStack) {
+		// create new empty slot in the stack
+		p.recoveryStack = append(p.recoveryStack, recovery{})
+	} else {
+		// slice to 1 more
+		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
+	}
+
+	m := make(map[string]any, len(labels))
+	for _, fl := range labels {
+		m[fl] = expr
+	}
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
+}
+
+// pop a recovery expression from the recoveryStack
+func (p *parser) popRecovery() {
+	// GC that map
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
+
+	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
+}
+
+func (p *parser) print(prefix, s string) string {
+	if !p.debug {
+		return s
+	}
+
+	pos := p.pt.position
+	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
+		prefix, pos.line, pos.col, pos.offset, s, p.pt.rn)
+	return s
+}
+
+func (p *parser) printIndent(mark string, s string) string {
+	return p.print(strings.Repeat(" ", p.depth)+mark, s)
+}
+
+func (p *parser) in(s string) string {
+	res := p.printIndent(">", s)
+	p.depth++
+	return res
+}
+
+func (p *parser) out(s string) string {
+	p.depth--
+	return p.printIndent("<", s)
+}
+
+func (p *parser) addErr(err error) {
+	p.addErrAt(err, p.pt.position, []string{})
+}
+
+func (p *parser) addErrAt(err error, pos position, expected []string) {
+	var buf bytes.Buffer
+	if p.filename != "" {
+		buf.WriteString(p.filename)
+	}
+	if buf.Len() > 0 {
+		buf.WriteString(":")
+	}
+	buf.WriteString(fmt.Sprintf("%d:%d (%d)", pos.line, pos.col, pos.offset))
+	if len(p.rstack) > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString(": ")
+		}
+		rule := p.rstack[len(p.rstack)-1]
+		if rule.displayName != "" {
+			buf.WriteString("rule " + rule.displayName)
+		} else {
+			buf.WriteString("rule " + rule.name)
+		}
+	}
+	pe := &parserError{Inner: err, pos: pos, prefix: buf.String(), expected: expected}
+	p.errs.add(pe)
+}
+
+func (p *parser) failAt(fail bool, pos position, want string) {
+	// process fail if parsing fails and not inverted or parsing succeeds and invert is set
+	if fail == p.maxFailInvertExpected {
+		if pos.offset < p.maxFailPos.offset {
+			return
+		}
+
+		if pos.offset > p.maxFailPos.offset {
+			p.maxFailPos = pos
+			p.maxFailExpected = p.maxFailExpected[:0]
+		}
+
+		if p.maxFailInvertExpected {
+			want = "!" + want
+		}
+		p.maxFailExpected = append(p.maxFailExpected, want)
+	}
+}
+
+// read advances the parser to the next rune.
+func (p *parser) read() {
+	// the binary parser reads a byte at a time, the column is the byte
+	// count and there are no lines.
+	p.pt.offset += p.pt.w
+	if p.pt.offset < len(p.data) {
+		p.pt.rn = rune(p.data[p.pt.offset])
+		p.pt.w = 1
+	} else {
+		p.pt.rn = utf8.RuneError
+		p.pt.w = 0
+	}
+	p.pt.col++
+}
+
+// skip advances the binary parser by n bytes, there must be at least n
+// bytes left in the input.
+func (p *parser) skip(n int) {
+	if n == 0 {
+		return
+	}
+	p.pt.offset += n - 1
+	p.pt.col += n - 1
+	p.read()
+}
+
+// matchUint matches the built-in rule name, an unsigned integer of size
+// bytes. Its value is an uint8, uint16, uint32 or uint64.
+func (p *parser) matchUint(name string, size int, littleEndian bool) (any, bool) {
+	start := p.pt
+	if len(p.data)-start.offset < size {
+		p.failAt(false, start.position, name)
+		return nil, false
+	}
+	var v uint64
+	for i := 0; i < size; i++ {
+		ix := i
+		if littleEndian {
+			ix = size - 1 - i
+		}
+		v = v<<8 | uint64(p.data[start.offset+ix])
+	}
+	p.skip(size)
+	p.failAt(true, start.position, name)
+
+	switch size {
+	case 1:
+		return uint8(v), true
+	case 2:
+		return uint16(v), true
+	case 4:
+		return uint32(v), true
+	}
+	return v, true
+}
+
+// matchBytes matches the built-in rule bytes<label>, the number of bytes
+// given by the value of the label. Its value is the matched bytes.
+func (p *parser) matchBytes(label string) (any, bool) {
+	start := p.pt
+	want := "bytes<" + label + ">"
+	var v any
+	for i := len(p.vstack) - 1; i >= 0; i-- {
+		var ok bool
+		if v, ok = p.vstack[i][label]; ok {
+			break
+		}
+	}
+	n, ok := byteCount(v)
+	if !ok {
+		p.addErr(fmt.Errorf("%s: invalid number of bytes %v", want, v))
+		return nil, false
+	}
+	if len(p.data)-start.offset < n {
+		p.failAt(false, start.position, want)
+		return nil, false
+	}
+	p.skip(n)
+	p.failAt(true, start.position, want)
+	return p.sliceFrom(start), true
+}
+
+// byteCount returns the number of bytes represented by the value v of a
+// label, which must be a non-negative integer.
+func byteCount(v any) (int, bool) {
+	var n uint64
+	switch v := v.(type) {
+	case uint8:
+		n = uint64(v)
+	case uint16:
+		n = uint64(v)
+	case uint32:
+		n = uint64(v)
+	case uint64:
+		n = v
+	case int:
+		if v < 0 {
+			return 0, false
+		}
+		n = uint64(v)
+	default:
+		return 0, false
+	}
+	if n > math.MaxInt32 {
+		return 0, false
+	}
+	return int(n), true
+}
+
+// restore parser position to the savepoint pt.
+func (p *parser) restore(pt savepoint) {
+	if p.debug {
+		defer p.out(p.in("restore"))
+	}
+	if pt.offset == p.pt.offset {
+		return
+	}
+	if p.Rules != nil && pt.offset < p.pt.offset && len(p.rstack) > 0 {
+		p.ruleStats(p.rstack[len(p.rstack)-1]).Backtracked += uint64(p.pt.offset - pt.offset)
+	}
+	p.pt = pt
+}
+
+// Cloner is implemented by any value that has a Clone method, which returns a
+// copy of the value. This is mainly used for types which are not passed by
+// value (e.g map, slice, chan) or structs that contain such types.
+//
+// This is used in conjunction with the global state feature to create proper
+// copies of the state to allow the parser to properly restore the state in
+// the case of backtracking.
+type Cloner interface {
+	Clone() any
+}
+
+var statePool = &sync.Pool{
+	New: func() any { return make(storeDict) },
+}
+
+func (sd storeDict) Discard() {
+	for k := range sd {
+		delete(sd, k)
+	}
+	statePool.Put(sd)
+}
+
+// clone and return parser current state.
+func (p *parser) cloneState() storeDict {
+	if p.debug {
+		defer p.out(p.in("cloneState"))
+	}
+
+	state := statePool.Get().(storeDict)
+	for k, v := range p.cur.state {
+		if c, ok := v.(Cloner); ok {
+			state[k] = c.Clone()
+		} else {
+			state[k] = v
+		}
+	}
+	return state
+}
+
+// restore parser current state to the state storeDict.
+// every restoreState should applied only one time for every cloned state
+func (p *parser) restoreState(state storeDict) {
+	if p.debug {
+		defer p.out(p.in("restoreState"))
+	}
+	p.cur.state.Discard()
+	p.cur.state = state
+}
+
+// get the slice of bytes from the savepoint start to the current position.
+func (p *parser) sliceFrom(start savepoint) []byte {
+	return p.data[start.position.offset:p.pt.position.offset]
+}
+
+// memoSlot is an entry of the memoization table, the key of an empty slot
+// is 0.
+type memoSlot struct {
+	key uint64
+	res resultTuple
+}
+
+// memoTable is the memoization table for the packrat algorithm, an
+// open-addressed hash table with linear probing that maps the offset in
+// the source and the id of a rule or expression to its result.
+type memoTable struct {
+	slots []memoSlot
+	shift uint
+	n     int
+}
+
+// memoTables pools the memoization tables so that their storage is
+// reused across parses.
+var memoTables = &sync.Pool{
+	New: func() any {
+		return &memoTable{slots: make([]memoSlot, 1<<10), shift: 64 - 10}
+	},
+}
+
+// memoKey returns the key of the result of the rule or expression id at
+// offset, it supports up to 1<<24 ids.
+func memoKey(offset, id int) uint64 {
+	return uint64(offset+1)<<24 | uint64(id)
+}
+
+// index returns the index of the slot of key, or of the empty slot where
+// it should be stored.
+func (t *memoTable) index(key uint64) int {
+	mask := len(t.slots) - 1
+	i := int((key*0x9E3779B97F4A7C15)>>t.shift) & mask
+	for t.slots[i].key != 0 && t.slots[i].key != key {
+		i = (i + 1) & mask
+	}
+	return i
+}
+
+func (t *memoTable) get(key uint64) (resultTuple, bool) {
+	s := &t.slots[t.index(key)]
+	return s.res, s.key == key
+}
+
+func (t *memoTable) set(key uint64, res resultTuple) {
+	if 4*(t.n+1) > 3*len(t.slots) {
+		t.grow()
+	}
+	s := &t.slots[t.index(key)]
+	if s.key == 0 {
+		s.key = key
+		t.n++
+	}
+	s.res = res
+}
+
+// grow doubles the capacity of the table.
+func (t *memoTable) grow() {
+	old := t.slots
+	t.slots = make([]memoSlot, 2*len(old))
+	t.shift--
+	for _, s := range old {
+		if s.key != 0 {
+			t.slots[t.index(s.key)] = s
+		}
+	}
+}
+
+// reset empties the table, releasing the references to the results.
+func (t *memoTable) reset() {
+	if t.n == 0 {
+		return
+	}
+	for i := range t.slots {
+		t.slots[i] = memoSlot{}
+	}
+	t.n = 0
+}
+
+func (p *parser) getMemoized(id int) (resultTuple, bool) {
+	if p.memo == nil {
+		return resultTuple{}, false
+	}
+	return p.memo.get(memoKey(p.pt.offset, id))
+}
+
+func (p *parser) setMemoized(pt savepoint, id int, tuple resultTuple) {
+	if p.memo == nil {
+		p.memo = memoTables.Get().(*memoTable)
+	}
+	p.memo.set(memoKey(pt.offset, id), tuple)
+}
+
+// releaseMemo returns the memoization table to the pool.
+func (p *parser) releaseMemo() {
+	if p.memo == nil {
+		return
+	}
+	p.memo.reset()
+	memoTables.Put(p.memo)
+	p.memo = nil
+}
+
+func (p *parser) buildRulesTable(g *grammar) {
+	p.rules = make(map[string]*rule, len(g.rules))
+	for _, r := range g.rules {
+		p.rules[r.name] = r
+	}
+}
+
+// nolint: gocyclo
+func (p *parser) parse(g *grammar) (val any, err error) {
+	if len(g.rules) == 0 {
+		p.addErr(errNoRule)
+		return nil, p.errs.err()
+	}
+
+	// TODO : not super critical but this could be generated
+	p.buildRulesTable(g)
+	defer p.releaseMemo()
+
+	if p.recover {
+		// panic can be used in action code to stop parsing immediately
+		// and return the panic as an error.
+		defer func() {
+			if e := recover(); e != nil {
+				if p.debug {
+					defer p.out(p.in("panic handler"))
+				}
+				val = nil
+				switch e := e.(type) {
+				case error:
+					p.addErr(e)
+				default:
+					p.addErr(fmt.Errorf("%v", e))
+				}
+				err = p.errs.err()
+			}
+		}()
+	}
+
+	startRule, ok := p.rules[p.entrypoint]
+	if !ok {
+		p.addErr(errInvalidEntrypoint)
+		return nil, p.errs.err()
+	}
+
+	p.read() // advance to first rune
+	val, ok = p.parseRuleWrap(startRule)
+	if !ok {
+		if len(*p.errs) == 0 {
+			// If parsing fails, but no errors have been recorded, the expected values
+			// for the farthest parser position are returned as error.
+			p.addNoMatchErr()
+		}
+
+		return nil, p.errs.err()
+	}
+	return val, p.errs.err()
+}
+
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
+func listJoin(list []string, sep string, lastSep string) string {
+	switch len(list) {
+	case 0:
+		return ""
+	case 1:
+		return list[0]
+	default:
+		return strings.Join(list[:len(list)-1], sep) + " " + lastSep + " " + list[len(list)-1]
+	}
+}
+
+// profFrame is a rule invocation being profiled.
+type profFrame struct {
+	start time.Time
+	child time.Duration
+}
+
+// profEval is the evaluation of a rule at an offset.
+type profEval struct {
+	rule   *rule
+	offset int
+}
+
+// ruleStats returns the statistics of rule, creating them if needed.
+func (p *parser) ruleStats(rule *rule) *RuleStats {
+	rs := p.Rules[rule.name]
+	if rs == nil {
+		rs = &RuleStats{}
+		p.Rules[rule.name] = rs
+	}
+	return rs
+}
+
+func (p *parser) countMemo(rs *RuleStats, hit bool) {
+	if hit {
+		rs.MemoHits++
+	} else {
+		rs.MemoMisses++
+	}
+}
+
+func (p *parser) profEnter(r *rule) {
+	if p.profActive == nil {
+		p.profActive = make(map[*rule]int)
+	}
+	p.profActive[r]++
+	p.profStack = append(p.profStack, profFrame{start: time.Now()})
+}
+
+// countRepeat counts the evaluation of rule at the current offset if
+// it was already evaluated at that offset.
+func (p *parser) countRepeat(rule *rule) {
+	if p.profEvals == nil {
+		p.profEvals = make(map[profEval]bool)
+	}
+	key := profEval{rule: rule, offset: p.pt.offset}
+	if p.profEvals[key] {
+		p.ruleStats(rule).Repeats++
+		return
+	}
+	p.profEvals[key] = true
+}
+
+func (p *parser) profExit(rule *rule, start savepoint, ok bool) {
+	frame := p.profStack[len(p.profStack)-1]
+	p.profStack = p.profStack[:len(p.profStack)-1]
+	elapsed := time.Since(frame.start)
+	if n := len(p.profStack); n > 0 {
+		p.profStack[n-1].child += elapsed
+	}
+
+	rs := p.ruleStats(rule)
+	rs.Invocations++
+	if p.profActive[rule]--; p.profActive[rule] == 0 {
+		// the time of the inner invocations is included
+		rs.Time += elapsed
+	}
+	rs.SelfTime += elapsed - frame.child
+	if ok {
+		rs.Matches++
+		rs.Bytes += uint64(p.pt.offset - start.offset)
+	} else {
+		rs.Failures++
+	}
+}
+
+func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
+	if rule.id < 0 {
+		// its results depend on the captured texts or the state store
+		return p.parseRule(rule)
+	}
+	res, ok := p.getMemoized(rule.id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(rule), ok)
+	}
+	if ok {
+		p.restore(res.end)
+		return res.v, res.b
+	}
+
+	startMark := p.pt
+	val, ok := p.parseRule(rule)
+	p.setMemoized(startMark, rule.id, resultTuple{v: val, b: ok, end: p.pt})
+
+	return val, ok
+}
+
+func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRule " + rule.name))
+	}
+	var (
+		val       any
+		ok        bool
+		startMark = p.pt
+	)
+
+	if p.Rules != nil {
+		p.profEnter(rule)
+		defer func() { p.profExit(rule, startMark, ok) }()
+	}
+
+	if p.memoize {
+		val, ok = p.parseRuleMemoize(rule)
+	} else {
+		val, ok = p.parseRule(rule)
+	}
+
+	if ok && p.debug {
+		p.printIndent("MATCH", string(p.sliceFrom(startMark)))
+	}
+	return val, ok
+}
+
+func (p *parser) parseRule(rule *rule) (any, bool) {
+	if p.Rules != nil {
+		p.countRepeat(rule)
+	}
+	p.rstack = append(p.rstack, rule)
+	p.pushV()
+	val, ok := rule.run(p)
+	p.popV()
+	p.rstack = p.rstack[:len(p.rstack)-1]
+	return val, ok
+}
+
+// incChoiceAltCnt counts the match of the alternative altI of the choice
+// at pos, or its failure if altI is choiceNoMatch.
+func (p *parser) incChoiceAltCnt(pos position, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, pos.line, pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
+// countExpr counts the evaluation of an expression.
+func (p *parser) countExpr() {
+	p.ExprCnt++
+	if p.ExprCnt > p.maxExprCnt {
+		panic(errMaxExprCnt)
+	}
+}
+
+// compiledMemoized returns the memoized results of the compiled expression
+// id at the current position, and restores the position at their end. It
+// returns false if they are not memoized or if memoization is disabled.
+func (p *parser) compiledMemoized(id int) (resultTuple, bool) {
+	if !p.memoize {
+		return resultTuple{}, false
+	}
+	res, ok := p.getMemoized(id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+	}
+	if ok {
+		p.restore(res.end)
+	}
+	return res, ok
+}
+
+// compiledMemoize memoizes the results of the compiled expression id,
+// which started at pt, if memoization is enabled.
+func (p *parser) compiledMemoize(pt savepoint, id int, val any, ok bool) {
+	if p.memoize {
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
+	}
+}
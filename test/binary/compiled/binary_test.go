@@ -0,0 +1 @@
+../binary_test.go
\ No newline at end of file
@@ -0,0 +1,57 @@
+package casefold
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCaseFold(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "STRASSE straße Straße", want: []any{"keyword:STRASSE", "keyword:straße", "keyword:Straße"}},
+		// U+017F is the long s
+		{in: "ſtrasse", want: []any{"keyword:ſtrasse"}},
+		// U+212A is the Kelvin sign
+		{in: "Kelvin KELVIN", want: []any{"keyword:Kelvin", "keyword:KELVIN"}},
+		// U+FB03 is the ffi ligature
+		{in: "oﬃce OFFICE", want: []any{"keyword:oﬃce", "keyword:OFFICE"}},
+		{in: "ßh SSH", want: []any{"keyword:ßh", "keyword:SSH"}},
+		{in: "İstanbul istanbul", want: []any{"keyword:İstanbul", "ident:istanbul"}},
+		{in: "Σσς", want: []any{"sigma:Σσς"}},
+		{in: "Kſ", want: []any{"ident:Kſ"}},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err != nil {
+			t.Errorf("%q: %v", tc.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%q: want %#v, got %#v", tc.in, tc.want, got)
+		}
+	}
+}
+
+func TestCaseFoldError(t *testing.T) {
+	cases := []struct {
+		in  string
+		err string
+	}{
+		// the dotless i only folds to itself
+		{in: "ı", err: `1:1 (0): no match found, expected: "i̇stanbul"i, "kelvin"i, "office"i, "ssh"i, "strasse"i, [ \t], [a-z]i or [σ]i`},
+		// the simple case folding of ß is itself
+		{in: "straß", err: `1:5 (4): no match found, expected: "i̇stanbul"i, "kelvin"i, "office"i, "ssh"i, "strasse"i, [ \t], [a-z]i, [σ]i or EOF`},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err == nil {
+			t.Errorf("%q: want error, got %#v", tc.in, got)
+			continue
+		}
+		if err.Error() != tc.err {
+			t.Errorf("%q: want error %q, got %q", tc.in, tc.err, err)
+		}
+	}
+}
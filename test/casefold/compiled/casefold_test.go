@@ -0,0 +1 @@
+../casefold_test.go
\ No newline at end of file
@@ -0,0 +1,4627 @@
+// Code generated by pigeon; DO NOT EDIT.
+
+package charclass
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+var g = &grammar{
+	rules: []*rule{
+		{
+			name: "Tokens",
+			pos:  position{line: 9, col: 1, offset: 212},
+			id:   0,
+			expr: &actionExpr{
+				pos: position{line: 9, col: 10, offset: 223},
+				id:  8,
+				run: (*parser).callonTokens1,
+				expr: &seqExpr{
+					pos: position{line: 9, col: 10, offset: 223},
+					id:  9,
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 9, col: 10, offset: 223},
+							id:   10,
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 9, col: 12, offset: 225},
+							id:    11,
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 9, col: 18, offset: 231},
+								id:   12,
+								name: "Token",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 9, col: 24, offset: 237},
+							id:    13,
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 9, col: 29, offset: 242},
+								id:  14,
+								expr: &seqExpr{
+									pos:   position{line: 9, col: 31, offset: 244},
+									id:    15,
+									pluck: []int{1},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 9, col: 31, offset: 244},
+											id:   16,
+											name: "_",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 9, col: 34, offset: 247},
+											id:   17,
+											name: "Token",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 9, col: 43, offset: 256},
+							id:   18,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 9, col: 45, offset: 258},
+							id:   19,
+							name: "EOF",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Token",
+			pos:  position{line: 13, col: 1, offset: 321},
+			id:   1,
+			expr: &choiceExpr{
+				pos: position{line: 13, col: 9, offset: 331},
+				id:  20,
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 13, col: 9, offset: 331},
+						id:   21,
+						name: "Greek",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 13, col: 17, offset: 339},
+						id:   22,
+						name: "Consonants",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 13, col: 30, offset: 352},
+						id:   23,
+						name: "Ident",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 13, col: 38, offset: 360},
+						id:   24,
+						name: "Other",
+					},
+				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x02\x03\x03\x03\x02\x03\x03\x03\x02\x03\x03\x03\x03\x03\x02\x03\x03\x03\x03\x03\x02\x03\x03\x03\x03\x03\x01\x01\x01\x01\x01\x01\x02\x03\x03\x03\x02\x03\x03\x03\x02\x03\x03\x03\x03\x03\x02\x03\x03\x03\x03\x03\x02\x03\x03\x03\x03\x03\x01\x01\x01\x01\x01",
+					ranges:    []rune{'\u0080', '\u00a0', '¡', 'ª', '«', 'µ', '¶', 'º', '»', 'À', '×', 'Ø', '÷', 'ø', 'ſ', 'ƀ', 'ʹ', '˂', 'ˆ', '˒', 'ˠ', '˥', 'ˬ', '˭', 'ˮ', '˯', 'Ͱ', 'ʹ', '͵', 'Ͷ', '\u0378', 'ͺ', 'ͻ', ';', 'Ϳ', '\u0380', '΄', '΅', 'Ά', '·', 'Έ', '\u038b', 'Ό', '\u038d', 'Ύ', '\u03a2', 'Σ', 'Ϣ', 'ϰ', '϶', 'Ϸ', 'Ѐ', '҂', 'Ҋ', '\u0530', 'Ա', '\u0557', 'ՙ', '՚', 'ՠ', '։', 'א', '\u05eb', 'ׯ', '׳', 'ؠ', 'ً', 'ٮ', 'ٰ', 'ٱ', '۔', 'ە', 'ۖ', 'ۥ', 'ۧ', 'ۮ', '۰', 'ۺ', '۽', 'ۿ', '܀', 'ܐ', 'ܑ', 'ܒ', 'ܰ', 'ݍ', 'ަ', 'ޱ', '\u07b2', 'ߊ', '߫', 'ߴ', '߶', 'ߺ', '\u07fb', 'ࠀ', 'ࠖ', 'ࠚ', 'ࠛ', 'ࠤ', 'ࠥ', 'ࠨ', 'ࠩ', 'ࡀ', '࡙', 'ࡠ', '\u086b', 'ࡰ', '࢈', 'ࢉ', '\u0890', 'ࢠ', '࣊', 'ऄ', 'ऺ', 'ऽ', 'ा', 'ॐ', '॑', 'क़', 'ॢ', 'ॱ', 'ঁ', 'অ', '\u098d', 'এ', '\u0991', 'ও', '\u09a9', 'প', '\u09b1', 'ল', '\u09b3', 'শ', '\u09ba', 'ঽ', 'া', 'ৎ', '\u09cf', 'ড়', '\u09de', 'য়', 'ৢ', 'ৰ', '৲', 'ৼ', '৽', 'ਅ', '\u0a0b', 'ਏ', '\u0a11', 'ਓ', '\u0a29', 'ਪ', '\u0a31', 'ਲ', '\u0a34', 'ਵ', '\u0a37', 'ਸ', '\u0a3a', 'ਖ਼', '\u0a5d', 'ਫ਼', '\u0a5f', 'ੲ', 'ੵ', 'અ', '\u0a8e', 'એ', '\u0a92', 'ઓ', '\u0aa9', 'પ', '\u0ab1', 'લ', '\u0ab4', 'વ', '\u0aba', 'ઽ', 'ા', 'ૐ', '\u0ad1', 'ૠ', 'ૢ', 'ૹ', 'ૺ', 'ଅ', '\u0b0d', 'ଏ', '\u0b11', 'ଓ', '\u0b29', 'ପ', '\u0b31', 'ଲ', '\u0b34', 'ଵ', '\u0b3a', 'ଽ', 'ା', 'ଡ଼', '\u0b5e', 'ୟ', 'ୢ', 'ୱ', '୲', 'ஃ', '\u0b84', 'அ', '\u0b8b', 'எ', '\u0b91', 'ஒ', '\u0b96', 'ங', '\u0b9b', 'ஜ', '\u0b9d', 'ஞ', '\u0ba0', 'ண', '\u0ba5', 'ந', '\u0bab', 'ம', '\u0bba', 'ௐ', '\u0bd1', 'అ', '\u0c0d', 'ఎ', '\u0c11', 'ఒ', '\u0c29', 'ప', '\u0c3a', 'ఽ', 'ా', 'ౘ', '\u0c5b', '౜', '\u0c5e', 'ౠ', 'ౢ', 'ಀ', 'ಁ', 'ಅ', '\u0c8d', 'ಎ', '\u0c91', 'ಒ', '\u0ca9', 'ಪ', '\u0cb4', 'ವ', '\u0cba', 'ಽ', 'ಾ', '೜', '\u0cdf', 'ೠ', 'ೢ', 'ೱ', 'ೳ', 'ഄ', '\u0d0d', 'എ', '\u0d11', 'ഒ', '഻', 'ഽ', 'ാ', 'ൎ', '൏', 'ൔ', 'ൗ', 'ൟ', 'ൢ', 'ൺ', '\u0d80', 'අ', '\u0d97', 'ක', '\u0db2', 'ඳ', '\u0dbc', 'ල', '\u0dbe', 'ව', '\u0dc7', 'ก', 'ั', 'า', 'ำ', 'เ', '็', 'ກ', '\u0e83', 'ຄ', '\u0e85', 'ຆ', '\u0e8b', 'ຌ', '\u0ea4', 'ລ', '\u0ea6', 'ວ', 'ັ', 'າ', 'ຳ', 'ຽ', '\u0ebe', 'ເ', '\u0ec5', 'ໆ', '\u0ec7', 'ໜ', '\u0ee0', 'ༀ', '༁', 'ཀ', '\u0f48', 'ཉ', '\u0f6d', 'ྈ', 'ྍ', 'က', 'ါ', 'ဿ', '၀', 'ၐ', 'ၖ', 'ၚ', 'ၞ', 'ၡ', 'ၢ', 'ၥ', 'ၧ', 'ၮ', 'ၱ', 'ၵ', 'ႂ', 'ႎ', 'ႏ', 'Ⴀ', '\u10c6', 'Ⴧ', '\u10c8', 'Ⴭ', '\u10ce', 'ა', '჻', 'ჼ', '\u1249', 'ቊ', '\u124e', 'ቐ', '\u1257', 'ቘ', '\u1259', 'ቚ', '\u125e', 'በ', '\u1289', 'ኊ', '\u128e', 'ነ', '\u12b1', 'ኲ', '\u12b6', 'ኸ', '\u12bf', 'ዀ', '\u12c1', 'ዂ', '\u12c6', 'ወ', '\u12d7', 'ዘ', '\u1311', 'ጒ', '\u1316', 'ጘ', '\u135b', 'ᎀ', '᎐', 'Ꭰ', '\u13f6', 'ᏸ', '\u13fe', 'ᐁ', '᙭', 'ᙯ', '\u1680', 'ᚁ', '᚛', 'ᚠ', '᛫', 'ᛮ', '\u16f9', 'ᜀ', 'ᜒ', 'ᜟ', 'ᜲ', 'ᝀ', 'ᝒ', 'ᝠ', '\u176d', 'ᝮ', '\u1771', 'ក', '឴', 'ៗ', '៘', 'ៜ', '៝', 'ᠠ', '\u1879', 'ᢀ', 'ᢩ', 'ᢪ', '\u18ab', 'ᢰ', '\u18f6', 'ᤀ', '\u191f', 'ᥐ', '\u196e', 'ᥰ', '\u1975', 'ᦀ', '\u19ac', 'ᦰ', '\u19ca', 'ᨀ', 'ᨗ', 'ᨠ', 'ᩕ', 'ᪧ', '᪨', 'ᬅ', '᬴', 'ᭅ', '\u1b4d', 'ᮃ', 'ᮡ', 'ᮮ', '᮰', 'ᮺ', '᯦', 'ᰀ', 'ᰤ', 'ᱍ', '᱐', 'ᱚ', '᱾', 'ᲀ', '\u1c8b', 'Ა', '\u1cbb', 'Ჽ', '᳀', 'ᳩ', '᳭', 'ᳮ', '᳴', 'ᳵ', '᳷', 'ᳺ', '\u1cfb', 'ᴀ', 'ᴦ', 'ᴫ', 'ᴬ', 'ᵝ', 'ᵢ', 'ᵦ', 'ᵫ', 'ᵸ', 'ᵹ', 'ᶿ', '᷀', 'Ḁ', 'ἀ', '\u1f16', 'Ἐ', '\u1f1e', 'ἠ', '\u1f46', 'Ὀ', '\u1f4e', 'ὐ', '\u1f58', 'Ὑ', '\u1f5a', 'Ὓ', '\u1f5c', 'Ὕ', '\u1f5e', 'Ὗ', '\u1f7e', 'ᾀ', '\u1fb5', 'ᾶ', '᾽', 'ι', '᾿', 'ῂ', '\u1fc5', 'ῆ', '῍', 'ῐ', '\u1fd4', 'ῖ', '\u1fdc', '῝', 'ῠ', '῭', '\u1ff0', 'ῲ', '\u1ff5', 'ῶ', '´', '\u1fff', '\u2000', '\u200b', '\u2028', '\u202a', '\u202f', '‰', '\u205f', '\u2060', 'ⁱ', '\u2072', 'ⁿ', '₀', 'ₐ', '\u209d', 'ℂ', '℃', 'ℇ', '℈', 'ℊ', '℔', 'ℕ', '№', '℘', '℞', 'ℤ', '℥', 'Ω', '℧', 'ℨ', '℩', 'K', 'Å', 'ℬ', 'Ⅎ', 'ℳ', '℺', 'ℼ', '⅀', 'ⅅ', '⅊', 'ⅎ', '⅏', 'Ⅰ', '↉', 'Ⰰ', 'Ⱡ', 'Ⲁ', '⳥', 'Ⳬ', '⳯', 'Ⳳ', '\u2cf4', 'ⴀ', '\u2d26', 'ⴧ', '\u2d28', 'ⴭ', '\u2d2e', 'ⴰ', '\u2d68', 'ⵯ', '⵰', 'ⶀ', '\u2d97', 'ⶠ', '\u2da7', 'ⶨ', '\u2daf', 'ⶰ', '\u2db7', 'ⶸ', '\u2dbf', 'ⷀ', '\u2dc7', 'ⷈ', '\u2dcf', 'ⷐ', '\u2dd7', 'ⷘ', '\u2ddf', '\u3000', '、', '々', '〈', '〡', '〪', '〱', '〶', '〸', '〽', 'ぁ', '\u3097', 'ゝ', '゠', 'ァ', '・', 'ー', '\u3100', 'ㄅ', '\u3130', 'ㄱ', '\u318f', 'ㆠ', '㇀', 'ㇰ', '㈀', '㐀', '䷀', '一', '\ua48d', 'ꓐ', '꓾', 'ꔀ', '꘍', 'ꘐ', '꘠', 'ꘪ', '\ua62c', 'Ꙁ', '꙯', 'ꙿ', 'ꚞ', 'ꚠ', '꛰', 'ꜗ', '꜠', 'Ꜣ', 'ꞈ', '꞉', 'Ꞌ', '\ua7dd', '꟱', 'ꠀ', 'ꠂ', 'ꠃ', '꠆', 'ꠇ', 'ꠋ', 'ꠌ', 'ꠣ', 'ꡀ', '꡴', 'ꢂ', 'ꢴ', 'ꣲ', '꣸', 'ꣻ', '꣼', 'ꣽ', 'ꣿ', 'ꤊ', 'ꤦ', 'ꤰ', 'ꥇ', 'ꥠ', '\ua97d', 'ꦄ', '꦳', 'ꧏ', '꧐', 'ꧠ', 'ꧥ', 'ꧦ', '꧰', 'ꧺ', '\ua9ff', 'ꨀ', 'ꨩ', 'ꩀ', 'ꩃ', 'ꩄ', 'ꩌ', 'ꩠ', '꩷', 'ꩺ', 'ꩻ', 'ꩾ', 'ꪰ', 'ꪱ', 'ꪲ', 'ꪵ', 'ꪷ', 'ꪹ', 'ꪾ', 'ꫀ', '꫁', 'ꫂ', '\uaac3', 'ꫛ', '꫞', 'ꫠ', 'ꫫ', 'ꫲ', 'ꫵ', 'ꬁ', '\uab07', 'ꬉ', '\uab0f', 'ꬑ', '\uab17', 'ꬠ', '\uab27', 'ꬨ', '\uab2f', 'ꬰ', '꭛', 'ꭜ', 'ꭥ', 'ꭦ', '꭪', 'ꭰ', 'ꯣ', '가', '\ud7a4', 'ힰ', '\ud7c7', 'ퟋ', '\ud7fc', '豈', '\ufa6e', '並', '\ufada', 'ﬀ', '\ufb07', 'ﬓ', '\ufb18', 'יִ', 'ﬞ', 'ײַ', '﬩', 'שׁ', '\ufb37', 'טּ', '\ufb3d', 'מּ', '\ufb3f', 'נּ', '\ufb42', 'ףּ', '\ufb45', 'צּ', '﮲', 'ﯓ', 'ﱞ', 'ﱤ', '﴾', 'ﵐ', '﶐', 'ﶒ', '﷈', 'ﷰ', 'ﷺ', 'ﹱ', 'ﹲ', 'ﹳ', 'ﹴ', 'ﹷ', 'ﹸ', 'ﹹ', 'ﹺ', 'ﹻ', 'ﹼ', 'ﹽ', 'ﹾ', 'ﹿ', '\ufefd', 'Ａ', '［', 'ａ', '｛', 'ｦ', 'ﾞ', 'ﾠ', '\uffbf', 'ￂ', '\uffc8', 'ￊ', '\uffd0', 'ￒ', '\uffd8', 'ￚ', '\uffdd', '𐀀', '\U0001000c', '𐀍', '\U00010027', '𐀨', '\U0001003b', '𐀼', '\U0001003e', '𐀿', '\U0001004e', '𐁐', '\U0001005e', '𐂀', '\U000100fb', '𐅀', '𐅵', '\U0001018f', '𐆠', '\U000101a1', '𐊀', '\U0001029d', '𐊠', '\U000102d1', '𐌀', '𐌠', '𐌭', '\U0001034b', '𐍐', '𐍶', '𐎀', '\U0001039e', '𐎠', '\U000103c4', '𐏈', '𐏐', '𐏑', '\U000103d6', '𐐀', '\U0001049e', '𐒰', '\U000104d4', '𐓘', '\U000104fc', '𐔀', '\U00010528', '𐔰', '\U00010564', '𐕰', '\U0001057b', '𐕼', '\U0001058b', '𐖌', '\U00010593', '𐖔', '\U00010596', '𐖗', '\U000105a2', '𐖣', '\U000105b2', '𐖳', '\U000105ba', '𐖻', '\U000105bd', '𐗀', '\U000105f4', '𐘀', '\U00010737', '𐝀', '\U00010756', '𐝠', '\U00010768', '𐞀', '\U00010786', '𐞇', '\U000107b1', '𐞲', '\U000107bb', '𐠀', '\U00010806', '𐠈', '\U00010809', '𐠊', '\U00010836', '𐠷', '\U00010839', '𐠼', '\U0001083d', '𐠿', '\U00010856', '𐡠', '𐡷', '𐢀', '\U0001089f', '𐣠', '\U000108f3', '𐣴', '\U000108f6', '𐤀', '𐤖', '𐤠', '\U0001093a', '𐥀', '\U0001095a', '𐦀', '\U000109b8', '𐦾', '𐧀', '𐨀', '𐨁', '𐨐', '\U00010a14', '𐨕', '\U00010a18', '𐨙', '\U00010a36', '𐩠', '𐩽', '𐪀', '𐪝', '𐫀', '𐫈', '𐫉', '𐫥', '𐬀', '\U00010b36', '𐭀', '\U00010b56', '𐭠', '\U00010b73', '𐮀', '\U00010b92', '𐰀', '\U00010c49', '𐲀', '\U00010cb3', '𐳀', '\U00010cf3', '𐴀', '𐴤', '𐵊', '\U00010d66', '𐵯', '\U00010d86', '𐺀', '\U00010eaa', '𐺰', '\U00010eb2', '𐻂', '\U00010ec8', '𐼀', '𐼝', '𐼧', '\U00010f28', '𐼰', '𐽆', '𐽰', '𐾂', '𐾰', '𐿅', '𐿠', '\U00010ff7', '𑀃', '𑀸', '𑁱', '𑁳', '𑁵', '\U00011076', '𑂃', '𑂰', '𑃐', '\U000110e9', '𑄃', '𑄧', '𑅄', '𑅅', '𑅇', '\U00011148', '𑅐', '𑅳', '𑅶', '\U00011177', '𑆃', '𑆳', '𑇁', '𑇅', '𑇚', '𑇛', '𑇜', '𑇝', '𑈀', '\U00011212', '𑈓', '𑈬', '𑈿', '𑉁', '𑊀', '\U00011287', '𑊈', '\U00011289', '𑊊', '\U0001128e', '𑊏', '\U0001129e', '𑊟', '𑊩', '𑊰', '𑋟', '𑌅', '\U0001130d', '𑌏', '\U00011311', '𑌓', '\U00011329', '𑌪', '\U00011331', '𑌲', '\U00011334', '𑌵', '\U0001133a', '𑌽', '𑌾', '𑍐', '\U00011351', '𑍝', '𑍢', '𑎀', '\U0001138a', '𑎋', '\U0001138c', '𑎎', '\U0001138f', '𑎐', '\U000113b6', '𑎷', '𑎸', '𑏑', '𑏒', '𑏓', '𑏔', '𑐀', '𑐵', '𑑇', '𑑋', '𑑟', '\U00011462', '𑒀', '𑒰', '𑓄', '𑓆', '𑓇', '\U000114c8', '𑖀', '𑖯', '𑗘', '𑗜', '𑘀', '𑘰', '𑙄', '\U00011645', '𑚀', '𑚫', '𑚸', '𑚹', '𑜀', '\U0001171b', '𑝀', '\U00011747', '𑠀', '𑠬', '𑢠', '𑣠', '𑣿', '\U00011907', '𑤉', '\U0001190a', '𑤌', '\U00011914', '𑤕', '\U00011917', '𑤘', '𑤰', '𑤿', '𑥀', '𑥁', '𑥂', '𑦠', '\U000119a8', '𑦪', '𑧑', '𑧡', '𑧢', '𑧣', '𑧤', '𑨀', '𑨁', '𑨋', '𑨳', '𑨺', '𑨻', '𑩐', '𑩑', '𑩜', '𑪊', '𑪝', '𑪞', '𑪰', '\U00011af9', '𑯀', '𑯡', '𑰀', '\U00011c09', '𑰊', '𑰯', '𑱀', '𑱁', '𑱲', '\U00011c90', '𑴀', '\U00011d07', '𑴈', '\U00011d0a', '𑴋', '𑴱', '𑵆', '𑵇', '𑵠', '\U00011d66', '𑵧', '\U00011d69', '𑵪', '𑶊', '𑶘', '\U00011d99', '𑶰', '\U00011ddc', '𑻠', '𑻳', '𑼂', '𑼃', '𑼄', '\U00011f11', '𑼒', '𑼴', '𑾰', '\U00011fb1', '𒀀', '\U0001239a', '𒐀', '\U0001246f', '𒒀', '\U00012544', '𒾐', '𒿱', '𓀀', '\U00013430', '𓑁', '𓑇', '𓑠', '\U000143fb', '𔐀', '\U00014647', '𖄀', '𖄞', '𖠀', '\U00016a39', '𖩀', '\U00016a5f', '𖩰', '\U00016abf', '𖫐', '\U00016aee', '𖬀', '𖬰', '𖭀', '𖭄', '𖭣', '\U00016b78', '𖭽', '\U00016b90', '𖵀', '𖵭', '𖹀', '𖺀', '𖺠', '\U00016eb9', '𖺻', '\U00016ed4', '𖼀', '\U00016f4b', '𖽐', '𖽑', '𖾓', '\U00016fa0', '𖿠', '𖿢', '𖿣', '𖿤', '𖿲', '\U00016ff7', '𗀀', '\U00018cd6', '𘳿', '\U00018d1f', '𘶀', '\U00018df3', '𚿰', '\U0001aff4', '𚿵', '\U0001affc', '𚿽', '\U0001afff', '𛀀', '\U0001b123', '𛄲', '\U0001b133', '𛅐', '\U0001b153', '𛅕', '\U0001b156', '𛅤', '\U0001b168', '𛅰', '\U0001b2fc', '𛰀', '\U0001bc6b', '𛱰', '\U0001bc7d', '𛲀', '\U0001bc89', '𛲐', '\U0001bc9a', '𝈀', '\U0001d246', '𝐀', '\U0001d455', '𝑖', '\U0001d49d', '𝒞', '\U0001d4a0', '𝒢', '\U0001d4a3', '𝒥', '\U0001d4a7', '𝒩', '\U0001d4ad', '𝒮', '\U0001d4ba', '𝒻', '\U0001d4bc', '𝒽', '\U0001d4c4', '𝓅', '\U0001d506', '𝔇', '\U0001d50b', '𝔍', '\U0001d515', '𝔖', '\U0001d51d', '𝔞', '\U0001d53a', '𝔻', '\U0001d53f', '𝕀', '\U0001d545', '𝕆', '\U0001d547', '𝕊', '\U0001d551', '𝕒', '\U0001d6a6', '𝚨', '𝛁', '𝛂', '𝛛', '𝛜', '𝛻', '𝛼', '𝜕', '𝜖', '𝜵', '𝜶', '𝝏', '𝝐', '𝝯', '𝝰', '𝞉', '𝞊', '𝞩', '𝞪', '𝟃', '𝟄', '\U0001d7cc', '𝼀', '\U0001df1f', '𝼥', '\U0001df2b', '𞀰', '\U0001e06e', '𞄀', '\U0001e12d', '𞄷', '\U0001e13e', '𞅎', '𞅏', '𞊐', '𞊮', '𞋀', '𞋬', '𞓐', '𞓬', '𞗐', '𞗮', '𞗰', '𞗱', '𞛀', '\U0001e6df', '𞛠', '𞛣', '𞛤', '𞛦', '𞛧', '𞛮', '𞛰', '𞛵', '𞛾', '\U0001e700', '𞟠', '\U0001e7e7', '𞟨', '\U0001e7ec', '𞟭', '\U0001e7ef', '𞟰', '\U0001e7ff', '𞠀', '\U0001e8c5', '𞤀', '𞥄', '𞥋', '\U0001e94c', '𞸀', '\U0001ee04', '𞸅', '\U0001ee20', '𞸡', '\U0001ee23', '𞸤', '\U0001ee25', '𞸧', '\U0001ee28', '𞸩', '\U0001ee33', '𞸴', '\U0001ee38', '𞸹', '\U0001ee3a', '𞸻', '\U0001ee3c', '𞹂', '\U0001ee43', '𞹇', '\U0001ee48', '𞹉', '\U0001ee4a', '𞹋', '\U0001ee4c', '𞹍', '\U0001ee50', '𞹑', '\U0001ee53', '𞹔', '\U0001ee55', '𞹗', '\U0001ee58', '𞹙', '\U0001ee5a', '𞹛', '\U0001ee5c', '𞹝', '\U0001ee5e', '𞹟', '\U0001ee60', '𞹡', '\U0001ee63', '𞹤', '\U0001ee65', '𞹧', '\U0001ee6b', '𞹬', '\U0001ee73', '𞹴', '\U0001ee78', '𞹹', '\U0001ee7d', '𞹾', '\U0001ee7f', '𞺀', '\U0001ee8a', '𞺋', '\U0001ee9c', '𞺡', '\U0001eea4', '𞺥', '\U0001eeaa', '𞺫', '\U0001eebc', '𠀀', '\U0002a6e0', '𪜀', '\U0002b81e', '𫠠', '\U0002ceae', '𬺰', '\U0002ebe1', '𮯰', '\U0002ee5e', '丽', '\U0002fa1e', '𰀀', '\U0003134b', '𱍐', '\U0003347a'},
+					rangeSets: "\x01\x00\x01\x02\x01\x04\x01\x02\x01\x02\x01\x02\x01\x02\x03\x02\x04\x01\x04\x01\x02\x01\x04\x01\x04\x01\x05\x04\x00\x05\x01\x06\x05\x01\x05\x01\x00\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x04\x05\x00\x05\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x00\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x02\x05\x04\x02\x05\x02\x05\x02\x04\x02\x05\x01\x02\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x00\x05\x00\x05\x01\x05\x00\x05\x01\x05\x01\x00\x05\x00\x01\x05\x01\x05\x00\x01\x00\x01\x00\x01\x00\x01\x00\x01\x02\x01\x02\x01\x02\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x05\x01\x04\x01\x03\x02\x04\x02\x04\x01\x04\x01\x04\x01\x02\x01\x02\x01\x04\x02\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x00\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x02\x04\x01\x02\x01\x02\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x02\x01\x02\x05\x02\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x02\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x02\x01\x02\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x02\x00\x01\x00\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x02\x01\x02\x01\x02\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x00\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x02\x01\x02\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01\x04\x01",
+					alts:      [][]int{{}, {3}, {2}, {1, 2}, {2, 3}, {0, 2}, {0}},
+					expected:  [][]string{{"[\\p{Greek}&&\\pL]", "[b-z--[eiou]]i", "[\\p{XID_Start}]", "[\\P{sc=Latin}--[\\p{Greek}\\p{gc=Z}]]"}, {"[\\p{Greek}&&\\pL]", "[b-z--[eiou]]i", "[\\p{XID_Start}]"}, {"[\\p{Greek}&&\\pL]", "[b-z--[eiou]]i", "[\\P{sc=Latin}--[\\p{Greek}\\p{gc=Z}]]"}, {"[\\p{Greek}&&\\pL]", "[\\P{sc=Latin}--[\\p{Greek}\\p{gc=Z}]]"}, {"[\\p{Greek}&&\\pL]", "[b-z--[eiou]]i"}, {"[b-z--[eiou]]i", "[\\P{sc=Latin}--[\\p{Greek}\\p{gc=Z}]]"}, {"[b-z--[eiou]]i", "[\\p{XID_Start}]", "[\\P{sc=Latin}--[\\p{Greek}\\p{gc=Z}]]"}},
+				},
+			},
+		},
+		{
+			name: "Greek",
+			pos:  position{line: 15, col: 1, offset: 367},
+			id:   2,
+			expr: &actionExpr{
+				pos: position{line: 15, col: 9, offset: 377},
+				id:  25,
+				run: (*parser).callonGreek1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 15, col: 9, offset: 377},
+					id:  26,
+					expr: &charClassMatcher{
+						pos: position{line: 15, col: 9, offset: 377},
+						id:  27,
+						val: "[\\p{Greek}&&\\pL]",
+						classes: []*unicode.RangeTable{&unicode.RangeTable{
+							R16: []unicode.Range16{
+								{Lo: 0x0370, Hi: 0x0373, Stride: 1},
+								{Lo: 0x0376, Hi: 0x0377, Stride: 1},
+								{Lo: 0x037a, Hi: 0x037d, Stride: 1},
+								{Lo: 0x037f, Hi: 0x037f, Stride: 1},
+								{Lo: 0x0386, Hi: 0x0386, Stride: 1},
+								{Lo: 0x0388, Hi: 0x038a, Stride: 1},
+								{Lo: 0x038c, Hi: 0x038c, Stride: 1},
+								{Lo: 0x038e, Hi: 0x03a1, Stride: 1},
+								{Lo: 0x03a3, Hi: 0x03e1, Stride: 1},
+								{Lo: 0x03f0, Hi: 0x03f5, Stride: 1},
+								{Lo: 0x03f7, Hi: 0x03ff, Stride: 1},
+								{Lo: 0x1d26, Hi: 0x1d2a, Stride: 1},
+								{Lo: 0x1d5d, Hi: 0x1d61, Stride: 1},
+								{Lo: 0x1d66, Hi: 0x1d6a, Stride: 1},
+								{Lo: 0x1dbf, Hi: 0x1dbf, Stride: 1},
+								{Lo: 0x1f00, Hi: 0x1f15, Stride: 1},
+								{Lo: 0x1f18, Hi: 0x1f1d, Stride: 1},
+								{Lo: 0x1f20, Hi: 0x1f45, Stride: 1},
+								{Lo: 0x1f48, Hi: 0x1f4d, Stride: 1},
+								{Lo: 0x1f50, Hi: 0x1f57, Stride: 1},
+								{Lo: 0x1f59, Hi: 0x1f59, Stride: 1},
+								{Lo: 0x1f5b, Hi: 0x1f5b, Stride: 1},
+								{Lo: 0x1f5d, Hi: 0x1f5d, Stride: 1},
+								{Lo: 0x1f5f, Hi: 0x1f7d, Stride: 1},
+								{Lo: 0x1f80, Hi: 0x1fb4, Stride: 1},
+								{Lo: 0x1fb6, Hi: 0x1fbc, Stride: 1},
+								{Lo: 0x1fbe, Hi: 0x1fbe, Stride: 1},
+								{Lo: 0x1fc2, Hi: 0x1fc4, Stride: 1},
+								{Lo: 0x1fc6, Hi: 0x1fcc, Stride: 1},
+								{Lo: 0x1fd0, Hi: 0x1fd3, Stride: 1},
+								{Lo: 0x1fd6, Hi: 0x1fdb, Stride: 1},
+								{Lo: 0x1fe0, Hi: 0x1fec, Stride: 1},
+								{Lo: 0x1ff2, Hi: 0x1ff4, Stride: 1},
+								{Lo: 0x1ff6, Hi: 0x1ffc, Stride: 1},
+								{Lo: 0x2126, Hi: 0x2126, Stride: 1},
+								{Lo: 0xab65, Hi: 0xab65, Stride: 1},
+							},
+						}},
+						ignoreCase: false,
+						inverted:   false,
+					},
+				},
+			},
+		},
+		{
+			name: "Consonants",
+			pos:  position{line: 19, col: 1, offset: 442},
+			id:   3,
+			expr: &actionExpr{
+				pos: position{line: 19, col: 14, offset: 457},
+				id:  28,
+				run: (*parser).callonConsonants1,
+				expr: &seqExpr{
+					pos: position{line: 19, col: 14, offset: 457},
+					id:  29,
+					exprs: []any{
+						&oneOrMoreExpr{
+							pos: position{line: 19, col: 14, offset: 457},
+							id:  30,
+							expr: &charClassMatcher{
+								pos: position{line: 19, col: 14, offset: 457},
+								id:  31,
+								val: "[b-z--[eiou]]i",
+								classes: []*unicode.RangeTable{&unicode.RangeTable{
+									R16: []unicode.Range16{
+										{Lo: 0x0042, Hi: 0x0044, Stride: 1},
+										{Lo: 0x0046, Hi: 0x0048, Stride: 1},
+										{Lo: 0x004a, Hi: 0x004e, Stride: 1},
+										{Lo: 0x0050, Hi: 0x0054, Stride: 1},
+										{Lo: 0x0056, Hi: 0x005a, Stride: 1},
+										{Lo: 0x0062, Hi: 0x0064, Stride: 1},
+										{Lo: 0x0066, Hi: 0x0068, Stride: 1},
+										{Lo: 0x006a, Hi: 0x006e, Stride: 1},
+										{Lo: 0x0070, Hi: 0x0074, Stride: 1},
+										{Lo: 0x0076, Hi: 0x007a, Stride: 1},
+										{Lo: 0x017f, Hi: 0x017f, Stride: 1},
+										{Lo: 0x212a, Hi: 0x212a, Stride: 1},
+									},
+									LatinOffset: 10,
+								}},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+						&notExpr{
+							pos: position{line: 19, col: 30, offset: 473},
+							id:  32,
+							expr: &charClassMatcher{
+								pos: position{line: 19, col: 31, offset: 474},
+								id:  33,
+								val: "[\\p{XID_Continue}]",
+								classes: []*unicode.RangeTable{&unicode.RangeTable{
+									R16: []unicode.Range16{
+										{Lo: 0x0030, Hi: 0x0039, Stride: 1},
+										{Lo: 0x0041, Hi: 0x005a, Stride: 1},
+										{Lo: 0x005f, Hi: 0x005f, Stride: 1},
+										{Lo: 0x0061, Hi: 0x007a, Stride: 1},
+										{Lo: 0x00aa, Hi: 0x00aa, Stride: 1},
+										{Lo: 0x00b5, Hi: 0x00b5, Stride: 1},
+										{Lo: 0x00b7, Hi: 0x00b7, Stride: 1},
+										{Lo: 0x00ba, Hi: 0x00ba, Stride: 1},
+										{Lo: 0x00c0, Hi: 0x00d6, Stride: 1},
+										{Lo: 0x00d8, Hi: 0x00f6, Stride: 1},
+										{Lo: 0x00f8, Hi: 0x02c1, Stride: 1},
+										{Lo: 0x02c6, Hi: 0x02d1, Stride: 1},
+										{Lo: 0x02e0, Hi: 0x02e4, Stride: 1},
+										{Lo: 0x02ec, Hi: 0x02ec, Stride: 1},
+										{Lo: 0x02ee, Hi: 0x02ee, Stride: 1},
+										{Lo: 0x0300, Hi: 0x0374, Stride: 1},
+										{Lo: 0x0376, Hi: 0x0377, Stride: 1},
+										{Lo: 0x037b, Hi: 0x037d, Stride: 1},
+										{Lo: 0x037f, Hi: 0x037f, Stride: 1},
+										{Lo: 0x0386, Hi: 0x038a, Stride: 1},
+										{Lo: 0x038c, Hi: 0x038c, Stride: 1},
+										{Lo: 0x038e, Hi: 0x03a1, Stride: 1},
+										{Lo: 0x03a3, Hi: 0x03f5, Stride: 1},
+										{Lo: 0x03f7, Hi: 0x0481, Stride: 1},
+										{Lo: 0x0483, Hi: 0x0487, Stride: 1},
+										{Lo: 0x048a, Hi: 0x052f, Stride: 1},
+										{Lo: 0x0531, Hi: 0x0556, Stride: 1},
+										{Lo: 0x0559, Hi: 0x0559, Stride: 1},
+										{Lo: 0x0560, Hi: 0x0588, Stride: 1},
+										{Lo: 0x0591, Hi: 0x05bd, Stride: 1},
+										{Lo: 0x05bf, Hi: 0x05bf, Stride: 1},
+										{Lo: 0x05c1, Hi: 0x05c2, Stride: 1},
+										{Lo: 0x05c4, Hi: 0x05c5, Stride: 1},
+										{Lo: 0x05c7, Hi: 0x05c7, Stride: 1},
+										{Lo: 0x05d0, Hi: 0x05ea, Stride: 1},
+										{Lo: 0x05ef, Hi: 0x05f2, Stride: 1},
+										{Lo: 0x0610, Hi: 0x061a, Stride: 1},
+										{Lo: 0x0620, Hi: 0x0669, Stride: 1},
+										{Lo: 0x066e, Hi: 0x06d3, Stride: 1},
+										{Lo: 0x06d5, Hi: 0x06dc, Stride: 1},
+										{Lo: 0x06df, Hi: 0x06e8, Stride: 1},
+										{Lo: 0x06ea, Hi: 0x06fc, Stride: 1},
+										{Lo: 0x06ff, Hi: 0x06ff, Stride: 1},
+										{Lo: 0x0710, Hi: 0x074a, Stride: 1},
+										{Lo: 0x074d, Hi: 0x07b1, Stride: 1},
+										{Lo: 0x07c0, Hi: 0x07f5, Stride: 1},
+										{Lo: 0x07fa, Hi: 0x07fa, Stride: 1},
+										{Lo: 0x07fd, Hi: 0x07fd, Stride: 1},
+										{Lo: 0x0800, Hi: 0x082d, Stride: 1},
+										{Lo: 0x0840, Hi: 0x085b, Stride: 1},
+										{Lo: 0x0860, Hi: 0x086a, Stride: 1},
+										{Lo: 0x0870, Hi: 0x0887, Stride: 1},
+										{Lo: 0x0889, Hi: 0x088f, Stride: 1},
+										{Lo: 0x0897, Hi: 0x08e1, Stride: 1},
+										{Lo: 0x08e3, Hi: 0x0963, Stride: 1},
+										{Lo: 0x0966, Hi: 0x096f, Stride: 1},
+										{Lo: 0x0971, Hi: 0x0983, Stride: 1},
+										{Lo: 0x0985, Hi: 0x098c, Stride: 1},
+										{Lo: 0x098f, Hi: 0x0990, Stride: 1},
+										{Lo: 0x0993, Hi: 0x09a8, Stride: 1},
+										{Lo: 0x09aa, Hi: 0x09b0, Stride: 1},
+										{Lo: 0x09b2, Hi: 0x09b2, Stride: 1},
+										{Lo: 0x09b6, Hi: 0x09b9, Stride: 1},
+										{Lo: 0x09bc, Hi: 0x09c4, Stride: 1},
+										{Lo: 0x09c7, Hi: 0x09c8, Stride: 1},
+										{Lo: 0x09cb, Hi: 0x09ce, Stride: 1},
+										{Lo: 0x09d7, Hi: 0x09d7, Stride: 1},
+										{Lo: 0x09dc, Hi: 0x09dd, Stride: 1},
+										{Lo: 0x09df, Hi: 0x09e3, Stride: 1},
+										{Lo: 0x09e6, Hi: 0x09f1, Stride: 1},
+										{Lo: 0x09fc, Hi: 0x09fc, Stride: 1},
+										{Lo: 0x09fe, Hi: 0x09fe, Stride: 1},
+										{Lo: 0x0a01, Hi: 0x0a03, Stride: 1},
+										{Lo: 0x0a05, Hi: 0x0a0a, Stride: 1},
+										{Lo: 0x0a0f, Hi: 0x0a10, Stride: 1},
+										{Lo: 0x0a13, Hi: 0x0a28, Stride: 1},
+										{Lo: 0x0a2a, Hi: 0x0a30, Stride: 1},
+										{Lo: 0x0a32, Hi: 0x0a33, Stride: 1},
+										{Lo: 0x0a35, Hi: 0x0a36, Stride: 1},
+										{Lo: 0x0a38, Hi: 0x0a39, Stride: 1},
+										{Lo: 0x0a3c, Hi: 0x0a3c, Stride: 1},
+										{Lo: 0x0a3e, Hi: 0x0a42, Stride: 1},
+										{Lo: 0x0a47, Hi: 0x0a48, Stride: 1},
+										{Lo: 0x0a4b, Hi: 0x0a4d, Stride: 1},
+										{Lo: 0x0a51, Hi: 0x0a51, Stride: 1},
+										{Lo: 0x0a59, Hi: 0x0a5c, Stride: 1},
+										{Lo: 0x0a5e, Hi: 0x0a5e, Stride: 1},
+										{Lo: 0x0a66, Hi: 0x0a75, Stride: 1},
+										{Lo: 0x0a81, Hi: 0x0a83, Stride: 1},
+										{Lo: 0x0a85, Hi: 0x0a8d, Stride: 1},
+										{Lo: 0x0a8f, Hi: 0x0a91, Stride: 1},
+										{Lo: 0x0a93, Hi: 0x0aa8, Stride: 1},
+										{Lo: 0x0aaa, Hi: 0x0ab0, Stride: 1},
+										{Lo: 0x0ab2, Hi: 0x0ab3, Stride: 1},
+										{Lo: 0x0ab5, Hi: 0x0ab9, Stride: 1},
+										{Lo: 0x0abc, Hi: 0x0ac5, Stride: 1},
+										{Lo: 0x0ac7, Hi: 0x0ac9, Stride: 1},
+										{Lo: 0x0acb, Hi: 0x0acd, Stride: 1},
+										{Lo: 0x0ad0, Hi: 0x0ad0, Stride: 1},
+										{Lo: 0x0ae0, Hi: 0x0ae3, Stride: 1},
+										{Lo: 0x0ae6, Hi: 0x0aef, Stride: 1},
+										{Lo: 0x0af9, Hi: 0x0aff, Stride: 1},
+										{Lo: 0x0b01, Hi: 0x0b03, Stride: 1},
+										{Lo: 0x0b05, Hi: 0x0b0c, Stride: 1},
+										{Lo: 0x0b0f, Hi: 0x0b10, Stride: 1},
+										{Lo: 0x0b13, Hi: 0x0b28, Stride: 1},
+										{Lo: 0x0b2a, Hi: 0x0b30, Stride: 1},
+										{Lo: 0x0b32, Hi: 0x0b33, Stride: 1},
+										{Lo: 0x0b35, Hi: 0x0b39, Stride: 1},
+										{Lo: 0x0b3c, Hi: 0x0b44, Stride: 1},
+										{Lo: 0x0b47, Hi: 0x0b48, Stride: 1},
+										{Lo: 0x0b4b, Hi: 0x0b4d, Stride: 1},
+										{Lo: 0x0b55, Hi: 0x0b57, Stride: 1},
+										{Lo: 0x0b5c, Hi: 0x0b5d, Stride: 1},
+										{Lo: 0x0b5f, Hi: 0x0b63, Stride: 1},
+										{Lo: 0x0b66, Hi: 0x0b6f, Stride: 1},
+										{Lo: 0x0b71, Hi: 0x0b71, Stride: 1},
+										{Lo: 0x0b82, Hi: 0x0b83, Stride: 1},
+										{Lo: 0x0b85, Hi: 0x0b8a, Stride: 1},
+										{Lo: 0x0b8e, Hi: 0x0b90, Stride: 1},
+										{Lo: 0x0b92, Hi: 0x0b95, Stride: 1},
+										{Lo: 0x0b99, Hi: 0x0b9a, Stride: 1},
+										{Lo: 0x0b9c, Hi: 0x0b9c, Stride: 1},
+										{Lo: 0x0b9e, Hi: 0x0b9f, Stride: 1},
+										{Lo: 0x0ba3, Hi: 0x0ba4, Stride: 1},
+										{Lo: 0x0ba8, Hi: 0x0baa, Stride: 1},
+										{Lo: 0x0bae, Hi: 0x0bb9, Stride: 1},
+										{Lo: 0x0bbe, Hi: 0x0bc2, Stride: 1},
+										{Lo: 0x0bc6, Hi: 0x0bc8, Stride: 1},
+										{Lo: 0x0bca, Hi: 0x0bcd, Stride: 1},
+										{Lo: 0x0bd0, Hi: 0x0bd0, Stride: 1},
+										{Lo: 0x0bd7, Hi: 0x0bd7, Stride: 1},
+										{Lo: 0x0be6, Hi: 0x0bef, Stride: 1},
+										{Lo: 0x0c00, Hi: 0x0c0c, Stride: 1},
+										{Lo: 0x0c0e, Hi: 0x0c10, Stride: 1},
+										{Lo: 0x0c12, Hi: 0x0c28, Stride: 1},
+										{Lo: 0x0c2a, Hi: 0x0c39, Stride: 1},
+										{Lo: 0x0c3c, Hi: 0x0c44, Stride: 1},
+										{Lo: 0x0c46, Hi: 0x0c48, Stride: 1},
+										{Lo: 0x0c4a, Hi: 0x0c4d, Stride: 1},
+										{Lo: 0x0c55, Hi: 0x0c56, Stride: 1},
+										{Lo: 0x0c58, Hi: 0x0c5a, Stride: 1},
+										{Lo: 0x0c5c, Hi: 0x0c5d, Stride: 1},
+										{Lo: 0x0c60, Hi: 0x0c63, Stride: 1},
+										{Lo: 0x0c66, Hi: 0x0c6f, Stride: 1},
+										{Lo: 0x0c80, Hi: 0x0c83, Stride: 1},
+										{Lo: 0x0c85, Hi: 0x0c8c, Stride: 1},
+										{Lo: 0x0c8e, Hi: 0x0c90, Stride: 1},
+										{Lo: 0x0c92, Hi: 0x0ca8, Stride: 1},
+										{Lo: 0x0caa, Hi: 0x0cb3, Stride: 1},
+										{Lo: 0x0cb5, Hi: 0x0cb9, Stride: 1},
+										{Lo: 0x0cbc, Hi: 0x0cc4, Stride: 1},
+										{Lo: 0x0cc6, Hi: 0x0cc8, Stride: 1},
+										{Lo: 0x0cca, Hi: 0x0ccd, Stride: 1},
+										{Lo: 0x0cd5, Hi: 0x0cd6, Stride: 1},
+										{Lo: 0x0cdc, Hi: 0x0cde, Stride: 1},
+										{Lo: 0x0ce0, Hi: 0x0ce3, Stride: 1},
+										{Lo: 0x0ce6, Hi: 0x0cef, Stride: 1},
+										{Lo: 0x0cf1, Hi: 0x0cf3, Stride: 1},
+										{Lo: 0x0d00, Hi: 0x0d0c, Stride: 1},
+										{Lo: 0x0d0e, Hi: 0x0d10, Stride: 1},
+										{Lo: 0x0d12, Hi: 0x0d44, Stride: 1},
+										{Lo: 0x0d46, Hi: 0x0d48, Stride: 1},
+										{Lo: 0x0d4a, Hi: 0x0d4e, Stride: 1},
+										{Lo: 0x0d54, Hi: 0x0d57, Stride: 1},
+										{Lo: 0x0d5f, Hi: 0x0d63, Stride: 1},
+										{Lo: 0x0d66, Hi: 0x0d6f, Stride: 1},
+										{Lo: 0x0d7a, Hi: 0x0d7f, Stride: 1},
+										{Lo: 0x0d81, Hi: 0x0d83, Stride: 1},
+										{Lo: 0x0d85, Hi: 0x0d96, Stride: 1},
+										{Lo: 0x0d9a, Hi: 0x0db1, Stride: 1},
+										{Lo: 0x0db3, Hi: 0x0dbb, Stride: 1},
+										{Lo: 0x0dbd, Hi: 0x0dbd, Stride: 1},
+										{Lo: 0x0dc0, Hi: 0x0dc6, Stride: 1},
+										{Lo: 0x0dca, Hi: 0x0dca, Stride: 1},
+										{Lo: 0x0dcf, Hi: 0x0dd4, Stride: 1},
+										{Lo: 0x0dd6, Hi: 0x0dd6, Stride: 1},
+										{Lo: 0x0dd8, Hi: 0x0ddf, Stride: 1},
+										{Lo: 0x0de6, Hi: 0x0def, Stride: 1},
+										{Lo: 0x0df2, Hi: 0x0df3, Stride: 1},
+										{Lo: 0x0e01, Hi: 0x0e3a, Stride: 1},
+										{Lo: 0x0e40, Hi: 0x0e4e, Stride: 1},
+										{Lo: 0x0e50, Hi: 0x0e59, Stride: 1},
+										{Lo: 0x0e81, Hi: 0x0e82, Stride: 1},
+										{Lo: 0x0e84, Hi: 0x0e84, Stride: 1},
+										{Lo: 0x0e86, Hi: 0x0e8a, Stride: 1},
+										{Lo: 0x0e8c, Hi: 0x0ea3, Stride: 1},
+										{Lo: 0x0ea5, Hi: 0x0ea5, Stride: 1},
+										{Lo: 0x0ea7, Hi: 0x0ebd, Stride: 1},
+										{Lo: 0x0ec0, Hi: 0x0ec4, Stride: 1},
+										{Lo: 0x0ec6, Hi: 0x0ec6, Stride: 1},
+										{Lo: 0x0ec8, Hi: 0x0ece, Stride: 1},
+										{Lo: 0x0ed0, Hi: 0x0ed9, Stride: 1},
+										{Lo: 0x0edc, Hi: 0x0edf, Stride: 1},
+										{Lo: 0x0f00, Hi: 0x0f00, Stride: 1},
+										{Lo: 0x0f18, Hi: 0x0f19, Stride: 1},
+										{Lo: 0x0f20, Hi: 0x0f29, Stride: 1},
+										{Lo: 0x0f35, Hi: 0x0f35, Stride: 1},
+										{Lo: 0x0f37, Hi: 0x0f37, Stride: 1},
+										{Lo: 0x0f39, Hi: 0x0f39, Stride: 1},
+										{Lo: 0x0f3e, Hi: 0x0f47, Stride: 1},
+										{Lo: 0x0f49, Hi: 0x0f6c, Stride: 1},
+										{Lo: 0x0f71, Hi: 0x0f84, Stride: 1},
+										{Lo: 0x0f86, Hi: 0x0f97, Stride: 1},
+										{Lo: 0x0f99, Hi: 0x0fbc, Stride: 1},
+										{Lo: 0x0fc6, Hi: 0x0fc6, Stride: 1},
+										{Lo: 0x1000, Hi: 0x1049, Stride: 1},
+										{Lo: 0x1050, Hi: 0x109d, Stride: 1},
+										{Lo: 0x10a0, Hi: 0x10c5, Stride: 1},
+										{Lo: 0x10c7, Hi: 0x10c7, Stride: 1},
+										{Lo: 0x10cd, Hi: 0x10cd, Stride: 1},
+										{Lo: 0x10d0, Hi: 0x10fa, Stride: 1},
+										{Lo: 0x10fc, Hi: 0x1248, Stride: 1},
+										{Lo: 0x124a, Hi: 0x124d, Stride: 1},
+										{Lo: 0x1250, Hi: 0x1256, Stride: 1},
+										{Lo: 0x1258, Hi: 0x1258, Stride: 1},
+										{Lo: 0x125a, Hi: 0x125d, Stride: 1},
+										{Lo: 0x1260, Hi: 0x1288, Stride: 1},
+										{Lo: 0x128a, Hi: 0x128d, Stride: 1},
+										{Lo: 0x1290, Hi: 0x12b0, Stride: 1},
+										{Lo: 0x12b2, Hi: 0x12b5, Stride: 1},
+										{Lo: 0x12b8, Hi: 0x12be, Stride: 1},
+										{Lo: 0x12c0, Hi: 0x12c0, Stride: 1},
+										{Lo: 0x12c2, Hi: 0x12c5, Stride: 1},
+										{Lo: 0x12c8, Hi: 0x12d6, Stride: 1},
+										{Lo: 0x12d8, Hi: 0x1310, Stride: 1},
+										{Lo: 0x1312, Hi: 0x1315, Stride: 1},
+										{Lo: 0x1318, Hi: 0x135a, Stride: 1},
+										{Lo: 0x135d, Hi: 0x135f, Stride: 1},
+										{Lo: 0x1369, Hi: 0x1371, Stride: 1},
+										{Lo: 0x1380, Hi: 0x138f, Stride: 1},
+										{Lo: 0x13a0, Hi: 0x13f5, Stride: 1},
+										{Lo: 0x13f8, Hi: 0x13fd, Stride: 1},
+										{Lo: 0x1401, Hi: 0x166c, Stride: 1},
+										{Lo: 0x166f, Hi: 0x167f, Stride: 1},
+										{Lo: 0x1681, Hi: 0x169a, Stride: 1},
+										{Lo: 0x16a0, Hi: 0x16ea, Stride: 1},
+										{Lo: 0x16ee, Hi: 0x16f8, Stride: 1},
+										{Lo: 0x1700, Hi: 0x1715, Stride: 1},
+										{Lo: 0x171f, Hi: 0x1734, Stride: 1},
+										{Lo: 0x1740, Hi: 0x1753, Stride: 1},
+										{Lo: 0x1760, Hi: 0x176c, Stride: 1},
+										{Lo: 0x176e, Hi: 0x1770, Stride: 1},
+										{Lo: 0x1772, Hi: 0x1773, Stride: 1},
+										{Lo: 0x1780, Hi: 0x17d3, Stride: 1},
+										{Lo: 0x17d7, Hi: 0x17d7, Stride: 1},
+										{Lo: 0x17dc, Hi: 0x17dd, Stride: 1},
+										{Lo: 0x17e0, Hi: 0x17e9, Stride: 1},
+										{Lo: 0x180b, Hi: 0x180d, Stride: 1},
+										{Lo: 0x180f, Hi: 0x1819, Stride: 1},
+										{Lo: 0x1820, Hi: 0x1878, Stride: 1},
+										{Lo: 0x1880, Hi: 0x18aa, Stride: 1},
+										{Lo: 0x18b0, Hi: 0x18f5, Stride: 1},
+										{Lo: 0x1900, Hi: 0x191e, Stride: 1},
+										{Lo: 0x1920, Hi: 0x192b, Stride: 1},
+										{Lo: 0x1930, Hi: 0x193b, Stride: 1},
+										{Lo: 0x1946, Hi: 0x196d, Stride: 1},
+										{Lo: 0x1970, Hi: 0x1974, Stride: 1},
+										{Lo: 0x1980, Hi: 0x19ab, Stride: 1},
+										{Lo: 0x19b0, Hi: 0x19c9, Stride: 1},
+										{Lo: 0x19d0, Hi: 0x19da, Stride: 1},
+										{Lo: 0x1a00, Hi: 0x1a1b, Stride: 1},
+										{Lo: 0x1a20, Hi: 0x1a5e, Stride: 1},
+										{Lo: 0x1a60, Hi: 0x1a7c, Stride: 1},
+										{Lo: 0x1a7f, Hi: 0x1a89, Stride: 1},
+										{Lo: 0x1a90, Hi: 0x1a99, Stride: 1},
+										{Lo: 0x1aa7, Hi: 0x1aa7, Stride: 1},
+										{Lo: 0x1ab0, Hi: 0x1abd, Stride: 1},
+										{Lo: 0x1abf, Hi: 0x1add, Stride: 1},
+										{Lo: 0x1ae0, Hi: 0x1aeb, Stride: 1},
+										{Lo: 0x1b00, Hi: 0x1b4c, Stride: 1},
+										{Lo: 0x1b50, Hi: 0x1b59, Stride: 1},
+										{Lo: 0x1b6b, Hi: 0x1b73, Stride: 1},
+										{Lo: 0x1b80, Hi: 0x1bf3, Stride: 1},
+										{Lo: 0x1c00, Hi: 0x1c37, Stride: 1},
+										{Lo: 0x1c40, Hi: 0x1c49, Stride: 1},
+										{Lo: 0x1c4d, Hi: 0x1c7d, Stride: 1},
+										{Lo: 0x1c80, Hi: 0x1c8a, Stride: 1},
+										{Lo: 0x1c90, Hi: 0x1cba, Stride: 1},
+										{Lo: 0x1cbd, Hi: 0x1cbf, Stride: 1},
+										{Lo: 0x1cd0, Hi: 0x1cd2, Stride: 1},
+										{Lo: 0x1cd4, Hi: 0x1cfa, Stride: 1},
+										{Lo: 0x1d00, Hi: 0x1f15, Stride: 1},
+										{Lo: 0x1f18, Hi: 0x1f1d, Stride: 1},
+										{Lo: 0x1f20, Hi: 0x1f45, Stride: 1},
+										{Lo: 0x1f48, Hi: 0x1f4d, Stride: 1},
+										{Lo: 0x1f50, Hi: 0x1f57, Stride: 1},
+										{Lo: 0x1f59, Hi: 0x1f59, Stride: 1},
+										{Lo: 0x1f5b, Hi: 0x1f5b, Stride: 1},
+										{Lo: 0x1f5d, Hi: 0x1f5d, Stride: 1},
+										{Lo: 0x1f5f, Hi: 0x1f7d, Stride: 1},
+										{Lo: 0x1f80, Hi: 0x1fb4, Stride: 1},
+										{Lo: 0x1fb6, Hi: 0x1fbc, Stride: 1},
+										{Lo: 0x1fbe, Hi: 0x1fbe, Stride: 1},
+										{Lo: 0x1fc2, Hi: 0x1fc4, Stride: 1},
+										{Lo: 0x1fc6, Hi: 0x1fcc, Stride: 1},
+										{Lo: 0x1fd0, Hi: 0x1fd3, Stride: 1},
+										{Lo: 0x1fd6, Hi: 0x1fdb, Stride: 1},
+										{Lo: 0x1fe0, Hi: 0x1fec, Stride: 1},
+										{Lo: 0x1ff2, Hi: 0x1ff4, Stride: 1},
+										{Lo: 0x1ff6, Hi: 0x1ffc, Stride: 1},
+										{Lo: 0x200c, Hi: 0x200d, Stride: 1},
+										{Lo: 0x203f, Hi: 0x2040, Stride: 1},
+										{Lo: 0x2054, Hi: 0x2054, Stride: 1},
+										{Lo: 0x2071, Hi: 0x2071, Stride: 1},
+										{Lo: 0x207f, Hi: 0x207f, Stride: 1},
+										{Lo: 0x2090, Hi: 0x209c, Stride: 1},
+										{Lo: 0x20d0, Hi: 0x20dc, Stride: 1},
+										{Lo: 0x20e1, Hi: 0x20e1, Stride: 1},
+										{Lo: 0x20e5, Hi: 0x20f0, Stride: 1},
+										{Lo: 0x2102, Hi: 0x2102, Stride: 1},
+										{Lo: 0x2107, Hi: 0x2107, Stride: 1},
+										{Lo: 0x210a, Hi: 0x2113, Stride: 1},
+										{Lo: 0x2115, Hi: 0x2115, Stride: 1},
+										{Lo: 0x2118, Hi: 0x211d, Stride: 1},
+										{Lo: 0x2124, Hi: 0x2124, Stride: 1},
+										{Lo: 0x2126, Hi: 0x2126, Stride: 1},
+										{Lo: 0x2128, Hi: 0x2128, Stride: 1},
+										{Lo: 0x212a, Hi: 0x2139, Stride: 1},
+										{Lo: 0x213c, Hi: 0x213f, Stride: 1},
+										{Lo: 0x2145, Hi: 0x2149, Stride: 1},
+										{Lo: 0x214e, Hi: 0x214e, Stride: 1},
+										{Lo: 0x2160, Hi: 0x2188, Stride: 1},
+										{Lo: 0x2c00, Hi: 0x2ce4, Stride: 1},
+										{Lo: 0x2ceb, Hi: 0x2cf3, Stride: 1},
+										{Lo: 0x2d00, Hi: 0x2d25, Stride: 1},
+										{Lo: 0x2d27, Hi: 0x2d27, Stride: 1},
+										{Lo: 0x2d2d, Hi: 0x2d2d, Stride: 1},
+										{Lo: 0x2d30, Hi: 0x2d67, Stride: 1},
+										{Lo: 0x2d6f, Hi: 0x2d6f, Stride: 1},
+										{Lo: 0x2d7f, Hi: 0x2d96, Stride: 1},
+										{Lo: 0x2da0, Hi: 0x2da6, Stride: 1},
+										{Lo: 0x2da8, Hi: 0x2dae, Stride: 1},
+										{Lo: 0x2db0, Hi: 0x2db6, Stride: 1},
+										{Lo: 0x2db8, Hi: 0x2dbe, Stride: 1},
+										{Lo: 0x2dc0, Hi: 0x2dc6, Stride: 1},
+										{Lo: 0x2dc8, Hi: 0x2dce, Stride: 1},
+										{Lo: 0x2dd0, Hi: 0x2dd6, Stride: 1},
+										{Lo: 0x2dd8, Hi: 0x2dde, Stride: 1},
+										{Lo: 0x2de0, Hi: 0x2dff, Stride: 1},
+										{Lo: 0x3005, Hi: 0x3007, Stride: 1},
+										{Lo: 0x3021, Hi: 0x302f, Stride: 1},
+										{Lo: 0x3031, Hi: 0x3035, Stride: 1},
+										{Lo: 0x3038, Hi: 0x303c, Stride: 1},
+										{Lo: 0x3041, Hi: 0x3096, Stride: 1},
+										{Lo: 0x3099, Hi: 0x309a, Stride: 1},
+										{Lo: 0x309d, Hi: 0x309f, Stride: 1},
+										{Lo: 0x30a1, Hi: 0x30ff, Stride: 1},
+										{Lo: 0x3105, Hi: 0x312f, Stride: 1},
+										{Lo: 0x3131, Hi: 0x318e, Stride: 1},
+										{Lo: 0x31a0, Hi: 0x31bf, Stride: 1},
+										{Lo: 0x31f0, Hi: 0x31ff, Stride: 1},
+										{Lo: 0x3400, Hi: 0x4dbf, Stride: 1},
+										{Lo: 0x4e00, Hi: 0xa48c, Stride: 1},
+										{Lo: 0xa4d0, Hi: 0xa4fd, Stride: 1},
+										{Lo: 0xa500, Hi: 0xa60c, Stride: 1},
+										{Lo: 0xa610, Hi: 0xa62b, Stride: 1},
+										{Lo: 0xa640, Hi: 0xa66f, Stride: 1},
+										{Lo: 0xa674, Hi: 0xa67d, Stride: 1},
+										{Lo: 0xa67f, Hi: 0xa6f1, Stride: 1},
+										{Lo: 0xa717, Hi: 0xa71f, Stride: 1},
+										{Lo: 0xa722, Hi: 0xa788, Stride: 1},
+										{Lo: 0xa78b, Hi: 0xa7dc, Stride: 1},
+										{Lo: 0xa7f1, Hi: 0xa827, Stride: 1},
+										{Lo: 0xa82c, Hi: 0xa82c, Stride: 1},
+										{Lo: 0xa840, Hi: 0xa873, Stride: 1},
+										{Lo: 0xa880, Hi: 0xa8c5, Stride: 1},
+										{Lo: 0xa8d0, Hi: 0xa8d9, Stride: 1},
+										{Lo: 0xa8e0, Hi: 0xa8f7, Stride: 1},
+										{Lo: 0xa8fb, Hi: 0xa8fb, Stride: 1},
+										{Lo: 0xa8fd, Hi: 0xa92d, Stride: 1},
+										{Lo: 0xa930, Hi: 0xa953, Stride: 1},
+										{Lo: 0xa960, Hi: 0xa97c, Stride: 1},
+										{Lo: 0xa980, Hi: 0xa9c0, Stride: 1},
+										{Lo: 0xa9cf, Hi: 0xa9d9, Stride: 1},
+										{Lo: 0xa9e0, Hi: 0xa9fe, Stride: 1},
+										{Lo: 0xaa00, Hi: 0xaa36, Stride: 1},
+										{Lo: 0xaa40, Hi: 0xaa4d, Stride: 1},
+										{Lo: 0xaa50, Hi: 0xaa59, Stride: 1},
+										{Lo: 0xaa60, Hi: 0xaa76, Stride: 1},
+										{Lo: 0xaa7a, Hi: 0xaac2, Stride: 1},
+										{Lo: 0xaadb, Hi: 0xaadd, Stride: 1},
+										{Lo: 0xaae0, Hi: 0xaaef, Stride: 1},
+										{Lo: 0xaaf2, Hi: 0xaaf6, Stride: 1},
+										{Lo: 0xab01, Hi: 0xab06, Stride: 1},
+										{Lo: 0xab09, Hi: 0xab0e, Stride: 1},
+										{Lo: 0xab11, Hi: 0xab16, Stride: 1},
+										{Lo: 0xab20, Hi: 0xab26, Stride: 1},
+										{Lo: 0xab28, Hi: 0xab2e, Stride: 1},
+										{Lo: 0xab30, Hi: 0xab5a, Stride: 1},
+										{Lo: 0xab5c, Hi: 0xab69, Stride: 1},
+										{Lo: 0xab70, Hi: 0xabea, Stride: 1},
+										{Lo: 0xabec, Hi: 0xabed, Stride: 1},
+										{Lo: 0xabf0, Hi: 0xabf9, Stride: 1},
+										{Lo: 0xac00, Hi: 0xd7a3, Stride: 1},
+										{Lo: 0xd7b0, Hi: 0xd7c6, Stride: 1},
+										{Lo: 0xd7cb, Hi: 0xd7fb, Stride: 1},
+										{Lo: 0xf900, Hi: 0xfa6d, Stride: 1},
+										{Lo: 0xfa70, Hi: 0xfad9, Stride: 1},
+										{Lo: 0xfb00, Hi: 0xfb06, Stride: 1},
+										{Lo: 0xfb13, Hi: 0xfb17, Stride: 1},
+										{Lo: 0xfb1d, Hi: 0xfb28, Stride: 1},
+										{Lo: 0xfb2a, Hi: 0xfb36, Stride: 1},
+										{Lo: 0xfb38, Hi: 0xfb3c, Stride: 1},
+										{Lo: 0xfb3e, Hi: 0xfb3e, Stride: 1},
+										{Lo: 0xfb40, Hi: 0xfb41, Stride: 1},
+										{Lo: 0xfb43, Hi: 0xfb44, Stride: 1},
+										{Lo: 0xfb46, Hi: 0xfbb1, Stride: 1},
+										{Lo: 0xfbd3, Hi: 0xfc5d, Stride: 1},
+										{Lo: 0xfc64, Hi: 0xfd3d, Stride: 1},
+										{Lo: 0xfd50, Hi: 0xfd8f, Stride: 1},
+										{Lo: 0xfd92, Hi: 0xfdc7, Stride: 1},
+										{Lo: 0xfdf0, Hi: 0xfdf9, Stride: 1},
+										{Lo: 0xfe00, Hi: 0xfe0f, Stride: 1},
+										{Lo: 0xfe20, Hi: 0xfe2f, Stride: 1},
+										{Lo: 0xfe33, Hi: 0xfe34, Stride: 1},
+										{Lo: 0xfe4d, Hi: 0xfe4f, Stride: 1},
+										{Lo: 0xfe71, Hi: 0xfe71, Stride: 1},
+										{Lo: 0xfe73, Hi: 0xfe73, Stride: 1},
+										{Lo: 0xfe77, Hi: 0xfe77, Stride: 1},
+										{Lo: 0xfe79, Hi: 0xfe79, Stride: 1},
+										{Lo: 0xfe7b, Hi: 0xfe7b, Stride: 1},
+										{Lo: 0xfe7d, Hi: 0xfe7d, Stride: 1},
+										{Lo: 0xfe7f, Hi: 0xfefc, Stride: 1},
+										{Lo: 0xff10, Hi: 0xff19, Stride: 1},
+										{Lo: 0xff21, Hi: 0xff3a, Stride: 1},
+										{Lo: 0xff3f, Hi: 0xff3f, Stride: 1},
+										{Lo: 0xff41, Hi: 0xff5a, Stride: 1},
+										{Lo: 0xff65, Hi: 0xffbe, Stride: 1},
+										{Lo: 0xffc2, Hi: 0xffc7, Stride: 1},
+										{Lo: 0xffca, Hi: 0xffcf, Stride: 1},
+										{Lo: 0xffd2, Hi: 0xffd7, Stride: 1},
+										{Lo: 0xffda, Hi: 0xffdc, Stride: 1},
+									},
+									R32: []unicode.Range32{
+										{Lo: 0x10000, Hi: 0x1000b, Stride: 1},
+										{Lo: 0x1000d, Hi: 0x10026, Stride: 1},
+										{Lo: 0x10028, Hi: 0x1003a, Stride: 1},
+										{Lo: 0x1003c, Hi: 0x1003d, Stride: 1},
+										{Lo: 0x1003f, Hi: 0x1004d, Stride: 1},
+										{Lo: 0x10050, Hi: 0x1005d, Stride: 1},
+										{Lo: 0x10080, Hi: 0x100fa, Stride: 1},
+										{Lo: 0x10140, Hi: 0x10174, Stride: 1},
+										{Lo: 0x101fd, Hi: 0x101fd, Stride: 1},
+										{Lo: 0x10280, Hi: 0x1029c, Stride: 1},
+										{Lo: 0x102a0, Hi: 0x102d0, Stride: 1},
+										{Lo: 0x102e0, Hi: 0x102e0, Stride: 1},
+										{Lo: 0x10300, Hi: 0x1031f, Stride: 1},
+										{Lo: 0x1032d, Hi: 0x1034a, Stride: 1},
+										{Lo: 0x10350, Hi: 0x1037a, Stride: 1},
+										{Lo: 0x10380, Hi: 0x1039d, Stride: 1},
+										{Lo: 0x103a0, Hi: 0x103c3, Stride: 1},
+										{Lo: 0x103c8, Hi: 0x103cf, Stride: 1},
+										{Lo: 0x103d1, Hi: 0x103d5, Stride: 1},
+										{Lo: 0x10400, Hi: 0x1049d, Stride: 1},
+										{Lo: 0x104a0, Hi: 0x104a9, Stride: 1},
+										{Lo: 0x104b0, Hi: 0x104d3, Stride: 1},
+										{Lo: 0x104d8, Hi: 0x104fb, Stride: 1},
+										{Lo: 0x10500, Hi: 0x10527, Stride: 1},
+										{Lo: 0x10530, Hi: 0x10563, Stride: 1},
+										{Lo: 0x10570, Hi: 0x1057a, Stride: 1},
+										{Lo: 0x1057c, Hi: 0x1058a, Stride: 1},
+										{Lo: 0x1058c, Hi: 0x10592, Stride: 1},
+										{Lo: 0x10594, Hi: 0x10595, Stride: 1},
+										{Lo: 0x10597, Hi: 0x105a1, Stride: 1},
+										{Lo: 0x105a3, Hi: 0x105b1, Stride: 1},
+										{Lo: 0x105b3, Hi: 0x105b9, Stride: 1},
+										{Lo: 0x105bb, Hi: 0x105bc, Stride: 1},
+										{Lo: 0x105c0, Hi: 0x105f3, Stride: 1},
+										{Lo: 0x10600, Hi: 0x10736, Stride: 1},
+										{Lo: 0x10740, Hi: 0x10755, Stride: 1},
+										{Lo: 0x10760, Hi: 0x10767, Stride: 1},
+										{Lo: 0x10780, Hi: 0x10785, Stride: 1},
+										{Lo: 0x10787, Hi: 0x107b0, Stride: 1},
+										{Lo: 0x107b2, Hi: 0x107ba, Stride: 1},
+										{Lo: 0x10800, Hi: 0x10805, Stride: 1},
+										{Lo: 0x10808, Hi: 0x10808, Stride: 1},
+										{Lo: 0x1080a, Hi: 0x10835, Stride: 1},
+										{Lo: 0x10837, Hi: 0x10838, Stride: 1},
+										{Lo: 0x1083c, Hi: 0x1083c, Stride: 1},
+										{Lo: 0x1083f, Hi: 0x10855, Stride: 1},
+										{Lo: 0x10860, Hi: 0x10876, Stride: 1},
+										{Lo: 0x10880, Hi: 0x1089e, Stride: 1},
+										{Lo: 0x108e0, Hi: 0x108f2, Stride: 1},
+										{Lo: 0x108f4, Hi: 0x108f5, Stride: 1},
+										{Lo: 0x10900, Hi: 0x10915, Stride: 1},
+										{Lo: 0x10920, Hi: 0x10939, Stride: 1},
+										{Lo: 0x10940, Hi: 0x10959, Stride: 1},
+										{Lo: 0x10980, Hi: 0x109b7, Stride: 1},
+										{Lo: 0x109be, Hi: 0x109bf, Stride: 1},
+										{Lo: 0x10a00, Hi: 0x10a03, Stride: 1},
+										{Lo: 0x10a05, Hi: 0x10a06, Stride: 1},
+										{Lo: 0x10a0c, Hi: 0x10a13, Stride: 1},
+										{Lo: 0x10a15, Hi: 0x10a17, Stride: 1},
+										{Lo: 0x10a19, Hi: 0x10a35, Stride: 1},
+										{Lo: 0x10a38, Hi: 0x10a3a, Stride: 1},
+										{Lo: 0x10a3f, Hi: 0x10a3f, Stride: 1},
+										{Lo: 0x10a60, Hi: 0x10a7c, Stride: 1},
+										{Lo: 0x10a80, Hi: 0x10a9c, Stride: 1},
+										{Lo: 0x10ac0, Hi: 0x10ac7, Stride: 1},
+										{Lo: 0x10ac9, Hi: 0x10ae6, Stride: 1},
+										{Lo: 0x10b00, Hi: 0x10b35, Stride: 1},
+										{Lo: 0x10b40, Hi: 0x10b55, Stride: 1},
+										{Lo: 0x10b60, Hi: 0x10b72, Stride: 1},
+										{Lo: 0x10b80, Hi: 0x10b91, Stride: 1},
+										{Lo: 0x10c00, Hi: 0x10c48, Stride: 1},
+										{Lo: 0x10c80, Hi: 0x10cb2, Stride: 1},
+										{Lo: 0x10cc0, Hi: 0x10cf2, Stride: 1},
+										{Lo: 0x10d00, Hi: 0x10d27, Stride: 1},
+										{Lo: 0x10d30, Hi: 0x10d39, Stride: 1},
+										{Lo: 0x10d40, Hi: 0x10d65, Stride: 1},
+										{Lo: 0x10d69, Hi: 0x10d6d, Stride: 1},
+										{Lo: 0x10d6f, Hi: 0x10d85, Stride: 1},
+										{Lo: 0x10e80, Hi: 0x10ea9, Stride: 1},
+										{Lo: 0x10eab, Hi: 0x10eac, Stride: 1},
+										{Lo: 0x10eb0, Hi: 0x10eb1, Stride: 1},
+										{Lo: 0x10ec2, Hi: 0x10ec7, Stride: 1},
+										{Lo: 0x10efa, Hi: 0x10f1c, Stride: 1},
+										{Lo: 0x10f27, Hi: 0x10f27, Stride: 1},
+										{Lo: 0x10f30, Hi: 0x10f50, Stride: 1},
+										{Lo: 0x10f70, Hi: 0x10f85, Stride: 1},
+										{Lo: 0x10fb0, Hi: 0x10fc4, Stride: 1},
+										{Lo: 0x10fe0, Hi: 0x10ff6, Stride: 1},
+										{Lo: 0x11000, Hi: 0x11046, Stride: 1},
+										{Lo: 0x11066, Hi: 0x11075, Stride: 1},
+										{Lo: 0x1107f, Hi: 0x110ba, Stride: 1},
+										{Lo: 0x110c2, Hi: 0x110c2, Stride: 1},
+										{Lo: 0x110d0, Hi: 0x110e8, Stride: 1},
+										{Lo: 0x110f0, Hi: 0x110f9, Stride: 1},
+										{Lo: 0x11100, Hi: 0x11134, Stride: 1},
+										{Lo: 0x11136, Hi: 0x1113f, Stride: 1},
+										{Lo: 0x11144, Hi: 0x11147, Stride: 1},
+										{Lo: 0x11150, Hi: 0x11173, Stride: 1},
+										{Lo: 0x11176, Hi: 0x11176, Stride: 1},
+										{Lo: 0x11180, Hi: 0x111c4, Stride: 1},
+										{Lo: 0x111c9, Hi: 0x111cc, Stride: 1},
+										{Lo: 0x111ce, Hi: 0x111da, Stride: 1},
+										{Lo: 0x111dc, Hi: 0x111dc, Stride: 1},
+										{Lo: 0x11200, Hi: 0x11211, Stride: 1},
+										{Lo: 0x11213, Hi: 0x11237, Stride: 1},
+										{Lo: 0x1123e, Hi: 0x11241, Stride: 1},
+										{Lo: 0x11280, Hi: 0x11286, Stride: 1},
+										{Lo: 0x11288, Hi: 0x11288, Stride: 1},
+										{Lo: 0x1128a, Hi: 0x1128d, Stride: 1},
+										{Lo: 0x1128f, Hi: 0x1129d, Stride: 1},
+										{Lo: 0x1129f, Hi: 0x112a8, Stride: 1},
+										{Lo: 0x112b0, Hi: 0x112ea, Stride: 1},
+										{Lo: 0x112f0, Hi: 0x112f9, Stride: 1},
+										{Lo: 0x11300, Hi: 0x11303, Stride: 1},
+										{Lo: 0x11305, Hi: 0x1130c, Stride: 1},
+										{Lo: 0x1130f, Hi: 0x11310, Stride: 1},
+										{Lo: 0x11313, Hi: 0x11328, Stride: 1},
+										{Lo: 0x1132a, Hi: 0x11330, Stride: 1},
+										{Lo: 0x11332, Hi: 0x11333, Stride: 1},
+										{Lo: 0x11335, Hi: 0x11339, Stride: 1},
+										{Lo: 0x1133b, Hi: 0x11344, Stride: 1},
+										{Lo: 0x11347, Hi: 0x11348, Stride: 1},
+										{Lo: 0x1134b, Hi: 0x1134d, Stride: 1},
+										{Lo: 0x11350, Hi: 0x11350, Stride: 1},
+										{Lo: 0x11357, Hi: 0x11357, Stride: 1},
+										{Lo: 0x1135d, Hi: 0x11363, Stride: 1},
+										{Lo: 0x11366, Hi: 0x1136c, Stride: 1},
+										{Lo: 0x11370, Hi: 0x11374, Stride: 1},
+										{Lo: 0x11380, Hi: 0x11389, Stride: 1},
+										{Lo: 0x1138b, Hi: 0x1138b, Stride: 1},
+										{Lo: 0x1138e, Hi: 0x1138e, Stride: 1},
+										{Lo: 0x11390, Hi: 0x113b5, Stride: 1},
+										{Lo: 0x113b7, Hi: 0x113c0, Stride: 1},
+										{Lo: 0x113c2, Hi: 0x113c2, Stride: 1},
+										{Lo: 0x113c5, Hi: 0x113c5, Stride: 1},
+										{Lo: 0x113c7, Hi: 0x113ca, Stride: 1},
+										{Lo: 0x113cc, Hi: 0x113d3, Stride: 1},
+										{Lo: 0x113e1, Hi: 0x113e2, Stride: 1},
+										{Lo: 0x11400, Hi: 0x1144a, Stride: 1},
+										{Lo: 0x11450, Hi: 0x11459, Stride: 1},
+										{Lo: 0x1145e, Hi: 0x11461, Stride: 1},
+										{Lo: 0x11480, Hi: 0x114c5, Stride: 1},
+										{Lo: 0x114c7, Hi: 0x114c7, Stride: 1},
+										{Lo: 0x114d0, Hi: 0x114d9, Stride: 1},
+										{Lo: 0x11580, Hi: 0x115b5, Stride: 1},
+										{Lo: 0x115b8, Hi: 0x115c0, Stride: 1},
+										{Lo: 0x115d8, Hi: 0x115dd, Stride: 1},
+										{Lo: 0x11600, Hi: 0x11640, Stride: 1},
+										{Lo: 0x11644, Hi: 0x11644, Stride: 1},
+										{Lo: 0x11650, Hi: 0x11659, Stride: 1},
+										{Lo: 0x11680, Hi: 0x116b8, Stride: 1},
+										{Lo: 0x116c0, Hi: 0x116c9, Stride: 1},
+										{Lo: 0x116d0, Hi: 0x116e3, Stride: 1},
+										{Lo: 0x11700, Hi: 0x1171a, Stride: 1},
+										{Lo: 0x1171d, Hi: 0x1172b, Stride: 1},
+										{Lo: 0x11730, Hi: 0x11739, Stride: 1},
+										{Lo: 0x11740, Hi: 0x11746, Stride: 1},
+										{Lo: 0x11800, Hi: 0x1183a, Stride: 1},
+										{Lo: 0x118a0, Hi: 0x118e9, Stride: 1},
+										{Lo: 0x118ff, Hi: 0x11906, Stride: 1},
+										{Lo: 0x11909, Hi: 0x11909, Stride: 1},
+										{Lo: 0x1190c, Hi: 0x11913, Stride: 1},
+										{Lo: 0x11915, Hi: 0x11916, Stride: 1},
+										{Lo: 0x11918, Hi: 0x11935, Stride: 1},
+										{Lo: 0x11937, Hi: 0x11938, Stride: 1},
+										{Lo: 0x1193b, Hi: 0x11943, Stride: 1},
+										{Lo: 0x11950, Hi: 0x11959, Stride: 1},
+										{Lo: 0x119a0, Hi: 0x119a7, Stride: 1},
+										{Lo: 0x119aa, Hi: 0x119d7, Stride: 1},
+										{Lo: 0x119da, Hi: 0x119e1, Stride: 1},
+										{Lo: 0x119e3, Hi: 0x119e4, Stride: 1},
+										{Lo: 0x11a00, Hi: 0x11a3e, Stride: 1},
+										{Lo: 0x11a47, Hi: 0x11a47, Stride: 1},
+										{Lo: 0x11a50, Hi: 0x11a99, Stride: 1},
+										{Lo: 0x11a9d, Hi: 0x11a9d, Stride: 1},
+										{Lo: 0x11ab0, Hi: 0x11af8, Stride: 1},
+										{Lo: 0x11b60, Hi: 0x11b67, Stride: 1},
+										{Lo: 0x11bc0, Hi: 0x11be0, Stride: 1},
+										{Lo: 0x11bf0, Hi: 0x11bf9, Stride: 1},
+										{Lo: 0x11c00, Hi: 0x11c08, Stride: 1},
+										{Lo: 0x11c0a, Hi: 0x11c36, Stride: 1},
+										{Lo: 0x11c38, Hi: 0x11c40, Stride: 1},
+										{Lo: 0x11c50, Hi: 0x11c59, Stride: 1},
+										{Lo: 0x11c72, Hi: 0x11c8f, Stride: 1},
+										{Lo: 0x11c92, Hi: 0x11ca7, Stride: 1},
+										{Lo: 0x11ca9, Hi: 0x11cb6, Stride: 1},
+										{Lo: 0x11d00, Hi: 0x11d06, Stride: 1},
+										{Lo: 0x11d08, Hi: 0x11d09, Stride: 1},
+										{Lo: 0x11d0b, Hi: 0x11d36, Stride: 1},
+										{Lo: 0x11d3a, Hi: 0x11d3a, Stride: 1},
+										{Lo: 0x11d3c, Hi: 0x11d3d, Stride: 1},
+										{Lo: 0x11d3f, Hi: 0x11d47, Stride: 1},
+										{Lo: 0x11d50, Hi: 0x11d59, Stride: 1},
+										{Lo: 0x11d60, Hi: 0x11d65, Stride: 1},
+										{Lo: 0x11d67, Hi: 0x11d68, Stride: 1},
+										{Lo: 0x11d6a, Hi: 0x11d8e, Stride: 1},
+										{Lo: 0x11d90, Hi: 0x11d91, Stride: 1},
+										{Lo: 0x11d93, Hi: 0x11d98, Stride: 1},
+										{Lo: 0x11da0, Hi: 0x11da9, Stride: 1},
+										{Lo: 0x11db0, Hi: 0x11ddb, Stride: 1},
+										{Lo: 0x11de0, Hi: 0x11de9, Stride: 1},
+										{Lo: 0x11ee0, Hi: 0x11ef6, Stride: 1},
+										{Lo: 0x11f00, Hi: 0x11f10, Stride: 1},
+										{Lo: 0x11f12, Hi: 0x11f3a, Stride: 1},
+										{Lo: 0x11f3e, Hi: 0x11f42, Stride: 1},
+										{Lo: 0x11f50, Hi: 0x11f5a, Stride: 1},
+										{Lo: 0x11fb0, Hi: 0x11fb0, Stride: 1},
+										{Lo: 0x12000, Hi: 0x12399, Stride: 1},
+										{Lo: 0x12400, Hi: 0x1246e, Stride: 1},
+										{Lo: 0x12480, Hi: 0x12543, Stride: 1},
+										{Lo: 0x12f90, Hi: 0x12ff0, Stride: 1},
+										{Lo: 0x13000, Hi: 0x1342f, Stride: 1},
+										{Lo: 0x13440, Hi: 0x13455, Stride: 1},
+										{Lo: 0x13460, Hi: 0x143fa, Stride: 1},
+										{Lo: 0x14400, Hi: 0x14646, Stride: 1},
+										{Lo: 0x16100, Hi: 0x16139, Stride: 1},
+										{Lo: 0x16800, Hi: 0x16a38, Stride: 1},
+										{Lo: 0x16a40, Hi: 0x16a5e, Stride: 1},
+										{Lo: 0x16a60, Hi: 0x16a69, Stride: 1},
+										{Lo: 0x16a70, Hi: 0x16abe, Stride: 1},
+										{Lo: 0x16ac0, Hi: 0x16ac9, Stride: 1},
+										{Lo: 0x16ad0, Hi: 0x16aed, Stride: 1},
+										{Lo: 0x16af0, Hi: 0x16af4, Stride: 1},
+										{Lo: 0x16b00, Hi: 0x16b36, Stride: 1},
+										{Lo: 0x16b40, Hi: 0x16b43, Stride: 1},
+										{Lo: 0x16b50, Hi: 0x16b59, Stride: 1},
+										{Lo: 0x16b63, Hi: 0x16b77, Stride: 1},
+										{Lo: 0x16b7d, Hi: 0x16b8f, Stride: 1},
+										{Lo: 0x16d40, Hi: 0x16d6c, Stride: 1},
+										{Lo: 0x16d70, Hi: 0x16d79, Stride: 1},
+										{Lo: 0x16e40, Hi: 0x16e7f, Stride: 1},
+										{Lo: 0x16ea0, Hi: 0x16eb8, Stride: 1},
+										{Lo: 0x16ebb, Hi: 0x16ed3, Stride: 1},
+										{Lo: 0x16f00, Hi: 0x16f4a, Stride: 1},
+										{Lo: 0x16f4f, Hi: 0x16f87, Stride: 1},
+										{Lo: 0x16f8f, Hi: 0x16f9f, Stride: 1},
+										{Lo: 0x16fe0, Hi: 0x16fe1, Stride: 1},
+										{Lo: 0x16fe3, Hi: 0x16fe4, Stride: 1},
+										{Lo: 0x16ff0, Hi: 0x16ff6, Stride: 1},
+										{Lo: 0x17000, Hi: 0x18cd5, Stride: 1},
+										{Lo: 0x18cff, Hi: 0x18d1e, Stride: 1},
+										{Lo: 0x18d80, Hi: 0x18df2, Stride: 1},
+										{Lo: 0x1aff0, Hi: 0x1aff3, Stride: 1},
+										{Lo: 0x1aff5, Hi: 0x1affb, Stride: 1},
+										{Lo: 0x1affd, Hi: 0x1affe, Stride: 1},
+										{Lo: 0x1b000, Hi: 0x1b122, Stride: 1},
+										{Lo: 0x1b132, Hi: 0x1b132, Stride: 1},
+										{Lo: 0x1b150, Hi: 0x1b152, Stride: 1},
+										{Lo: 0x1b155, Hi: 0x1b155, Stride: 1},
+										{Lo: 0x1b164, Hi: 0x1b167, Stride: 1},
+										{Lo: 0x1b170, Hi: 0x1b2fb, Stride: 1},
+										{Lo: 0x1bc00, Hi: 0x1bc6a, Stride: 1},
+										{Lo: 0x1bc70, Hi: 0x1bc7c, Stride: 1},
+										{Lo: 0x1bc80, Hi: 0x1bc88, Stride: 1},
+										{Lo: 0x1bc90, Hi: 0x1bc99, Stride: 1},
+										{Lo: 0x1bc9d, Hi: 0x1bc9e, Stride: 1},
+										{Lo: 0x1ccf0, Hi: 0x1ccf9, Stride: 1},
+										{Lo: 0x1cf00, Hi: 0x1cf2d, Stride: 1},
+										{Lo: 0x1cf30, Hi: 0x1cf46, Stride: 1},
+										{Lo: 0x1d165, Hi: 0x1d169, Stride: 1},
+										{Lo: 0x1d16d, Hi: 0x1d172, Stride: 1},
+										{Lo: 0x1d17b, Hi: 0x1d182, Stride: 1},
+										{Lo: 0x1d185, Hi: 0x1d18b, Stride: 1},
+										{Lo: 0x1d1aa, Hi: 0x1d1ad, Stride: 1},
+										{Lo: 0x1d242, Hi: 0x1d244, Stride: 1},
+										{Lo: 0x1d400, Hi: 0x1d454, Stride: 1},
+										{Lo: 0x1d456, Hi: 0x1d49c, Stride: 1},
+										{Lo: 0x1d49e, Hi: 0x1d49f, Stride: 1},
+										{Lo: 0x1d4a2, Hi: 0x1d4a2, Stride: 1},
+										{Lo: 0x1d4a5, Hi: 0x1d4a6, Stride: 1},
+										{Lo: 0x1d4a9, Hi: 0x1d4ac, Stride: 1},
+										{Lo: 0x1d4ae, Hi: 0x1d4b9, Stride: 1},
+										{Lo: 0x1d4bb, Hi: 0x1d4bb, Stride: 1},
+										{Lo: 0x1d4bd, Hi: 0x1d4c3, Stride: 1},
+										{Lo: 0x1d4c5, Hi: 0x1d505, Stride: 1},
+										{Lo: 0x1d507, Hi: 0x1d50a, Stride: 1},
+										{Lo: 0x1d50d, Hi: 0x1d514, Stride: 1},
+										{Lo: 0x1d516, Hi: 0x1d51c, Stride: 1},
+										{Lo: 0x1d51e, Hi: 0x1d539, Stride: 1},
+										{Lo: 0x1d53b, Hi: 0x1d53e, Stride: 1},
+										{Lo: 0x1d540, Hi: 0x1d544, Stride: 1},
+										{Lo: 0x1d546, Hi: 0x1d546, Stride: 1},
+										{Lo: 0x1d54a, Hi: 0x1d550, Stride: 1},
+										{Lo: 0x1d552, Hi: 0x1d6a5, Stride: 1},
+										{Lo: 0x1d6a8, Hi: 0x1d6c0, Stride: 1},
+										{Lo: 0x1d6c2, Hi: 0x1d6da, Stride: 1},
+										{Lo: 0x1d6dc, Hi: 0x1d6fa, Stride: 1},
+										{Lo: 0x1d6fc, Hi: 0x1d714, Stride: 1},
+										{Lo: 0x1d716, Hi: 0x1d734, Stride: 1},
+										{Lo: 0x1d736, Hi: 0x1d74e, Stride: 1},
+										{Lo: 0x1d750, Hi: 0x1d76e, Stride: 1},
+										{Lo: 0x1d770, Hi: 0x1d788, Stride: 1},
+										{Lo: 0x1d78a, Hi: 0x1d7a8, Stride: 1},
+										{Lo: 0x1d7aa, Hi: 0x1d7c2, Stride: 1},
+										{Lo: 0x1d7c4, Hi: 0x1d7cb, Stride: 1},
+										{Lo: 0x1d7ce, Hi: 0x1d7ff, Stride: 1},
+										{Lo: 0x1da00, Hi: 0x1da36, Stride: 1},
+										{Lo: 0x1da3b, Hi: 0x1da6c, Stride: 1},
+										{Lo: 0x1da75, Hi: 0x1da75, Stride: 1},
+										{Lo: 0x1da84, Hi: 0x1da84, Stride: 1},
+										{Lo: 0x1da9b, Hi: 0x1da9f, Stride: 1},
+										{Lo: 0x1daa1, Hi: 0x1daaf, Stride: 1},
+										{Lo: 0x1df00, Hi: 0x1df1e, Stride: 1},
+										{Lo: 0x1df25, Hi: 0x1df2a, Stride: 1},
+										{Lo: 0x1e000, Hi: 0x1e006, Stride: 1},
+										{Lo: 0x1e008, Hi: 0x1e018, Stride: 1},
+										{Lo: 0x1e01b, Hi: 0x1e021, Stride: 1},
+										{Lo: 0x1e023, Hi: 0x1e024, Stride: 1},
+										{Lo: 0x1e026, Hi: 0x1e02a, Stride: 1},
+										{Lo: 0x1e030, Hi: 0x1e06d, Stride: 1},
+										{Lo: 0x1e08f, Hi: 0x1e08f, Stride: 1},
+										{Lo: 0x1e100, Hi: 0x1e12c, Stride: 1},
+										{Lo: 0x1e130, Hi: 0x1e13d, Stride: 1},
+										{Lo: 0x1e140, Hi: 0x1e149, Stride: 1},
+										{Lo: 0x1e14e, Hi: 0x1e14e, Stride: 1},
+										{Lo: 0x1e290, Hi: 0x1e2ae, Stride: 1},
+										{Lo: 0x1e2c0, Hi: 0x1e2f9, Stride: 1},
+										{Lo: 0x1e4d0, Hi: 0x1e4f9, Stride: 1},
+										{Lo: 0x1e5d0, Hi: 0x1e5fa, Stride: 1},
+										{Lo: 0x1e6c0, Hi: 0x1e6de, Stride: 1},
+										{Lo: 0x1e6e0, Hi: 0x1e6f5, Stride: 1},
+										{Lo: 0x1e6fe, Hi: 0x1e6ff, Stride: 1},
+										{Lo: 0x1e7e0, Hi: 0x1e7e6, Stride: 1},
+										{Lo: 0x1e7e8, Hi: 0x1e7eb, Stride: 1},
+										{Lo: 0x1e7ed, Hi: 0x1e7ee, Stride: 1},
+										{Lo: 0x1e7f0, Hi: 0x1e7fe, Stride: 1},
+										{Lo: 0x1e800, Hi: 0x1e8c4, Stride: 1},
+										{Lo: 0x1e8d0, Hi: 0x1e8d6, Stride: 1},
+										{Lo: 0x1e900, Hi: 0x1e94b, Stride: 1},
+										{Lo: 0x1e950, Hi: 0x1e959, Stride: 1},
+										{Lo: 0x1ee00, Hi: 0x1ee03, Stride: 1},
+										{Lo: 0x1ee05, Hi: 0x1ee1f, Stride: 1},
+										{Lo: 0x1ee21, Hi: 0x1ee22, Stride: 1},
+										{Lo: 0x1ee24, Hi: 0x1ee24, Stride: 1},
+										{Lo: 0x1ee27, Hi: 0x1ee27, Stride: 1},
+										{Lo: 0x1ee29, Hi: 0x1ee32, Stride: 1},
+										{Lo: 0x1ee34, Hi: 0x1ee37, Stride: 1},
+										{Lo: 0x1ee39, Hi: 0x1ee39, Stride: 1},
+										{Lo: 0x1ee3b, Hi: 0x1ee3b, Stride: 1},
+										{Lo: 0x1ee42, Hi: 0x1ee42, Stride: 1},
+										{Lo: 0x1ee47, Hi: 0x1ee47, Stride: 1},
+										{Lo: 0x1ee49, Hi: 0x1ee49, Stride: 1},
+										{Lo: 0x1ee4b, Hi: 0x1ee4b, Stride: 1},
+										{Lo: 0x1ee4d, Hi: 0x1ee4f, Stride: 1},
+										{Lo: 0x1ee51, Hi: 0x1ee52, Stride: 1},
+										{Lo: 0x1ee54, Hi: 0x1ee54, Stride: 1},
+										{Lo: 0x1ee57, Hi: 0x1ee57, Stride: 1},
+										{Lo: 0x1ee59, Hi: 0x1ee59, Stride: 1},
+										{Lo: 0x1ee5b, Hi: 0x1ee5b, Stride: 1},
+										{Lo: 0x1ee5d, Hi: 0x1ee5d, Stride: 1},
+										{Lo: 0x1ee5f, Hi: 0x1ee5f, Stride: 1},
+										{Lo: 0x1ee61, Hi: 0x1ee62, Stride: 1},
+										{Lo: 0x1ee64, Hi: 0x1ee64, Stride: 1},
+										{Lo: 0x1ee67, Hi: 0x1ee6a, Stride: 1},
+										{Lo: 0x1ee6c, Hi: 0x1ee72, Stride: 1},
+										{Lo: 0x1ee74, Hi: 0x1ee77, Stride: 1},
+										{Lo: 0x1ee79, Hi: 0x1ee7c, Stride: 1},
+										{Lo: 0x1ee7e, Hi: 0x1ee7e, Stride: 1},
+										{Lo: 0x1ee80, Hi: 0x1ee89, Stride: 1},
+										{Lo: 0x1ee8b, Hi: 0x1ee9b, Stride: 1},
+										{Lo: 0x1eea1, Hi: 0x1eea3, Stride: 1},
+										{Lo: 0x1eea5, Hi: 0x1eea9, Stride: 1},
+										{Lo: 0x1eeab, Hi: 0x1eebb, Stride: 1},
+										{Lo: 0x1fbf0, Hi: 0x1fbf9, Stride: 1},
+										{Lo: 0x20000, Hi: 0x2a6df, Stride: 1},
+										{Lo: 0x2a700, Hi: 0x2b81d, Stride: 1},
+										{Lo: 0x2b820, Hi: 0x2cead, Stride: 1},
+										{Lo: 0x2ceb0, Hi: 0x2ebe0, Stride: 1},
+										{Lo: 0x2ebf0, Hi: 0x2ee5d, Stride: 1},
+										{Lo: 0x2f800, Hi: 0x2fa1d, Stride: 1},
+										{Lo: 0x30000, Hi: 0x3134a, Stride: 1},
+										{Lo: 0x31350, Hi: 0x33479, Stride: 1},
+										{Lo: 0xe0100, Hi: 0xe01ef, Stride: 1},
+									},
+									LatinOffset: 10,
+								}},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Ident",
+			pos:  position{line: 23, col: 1, offset: 545},
+			id:   4,
+			expr: &actionExpr{
+				pos: position{line: 23, col: 9, offset: 555},
+				id:  34,
+				run: (*parser).callonIdent1,
+				expr: &seqExpr{
+					pos: position{line: 23, col: 9, offset: 555},
+					id:  35,
+					exprs: []any{
+						&charClassMatcher{
+							pos: position{line: 23, col: 9, offset: 555},
+							id:  36,
+							val: "[\\p{XID_Start}]",
+							classes: []*unicode.RangeTable{&unicode.RangeTable{
+								R16: []unicode.Range16{
+									{Lo: 0x0041, Hi: 0x005a, Stride: 1},
+									{Lo: 0x0061, Hi: 0x007a, Stride: 1},
+									{Lo: 0x00aa, Hi: 0x00aa, Stride: 1},
+									{Lo: 0x00b5, Hi: 0x00b5, Stride: 1},
+									{Lo: 0x00ba, Hi: 0x00ba, Stride: 1},
+									{Lo: 0x00c0, Hi: 0x00d6, Stride: 1},
+									{Lo: 0x00d8, Hi: 0x00f6, Stride: 1},
+									{Lo: 0x00f8, Hi: 0x02c1, Stride: 1},
+									{Lo: 0x02c6, Hi: 0x02d1, Stride: 1},
+									{Lo: 0x02e0, Hi: 0x02e4, Stride: 1},
+									{Lo: 0x02ec, Hi: 0x02ec, Stride: 1},
+									{Lo: 0x02ee, Hi: 0x02ee, Stride: 1},
+									{Lo: 0x0370, Hi: 0x0374, Stride: 1},
+									{Lo: 0x0376, Hi: 0x0377, Stride: 1},
+									{Lo: 0x037b, Hi: 0x037d, Stride: 1},
+									{Lo: 0x037f, Hi: 0x037f, Stride: 1},
+									{Lo: 0x0386, Hi: 0x0386, Stride: 1},
+									{Lo: 0x0388, Hi: 0x038a, Stride: 1},
+									{Lo: 0x038c, Hi: 0x038c, Stride: 1},
+									{Lo: 0x038e, Hi: 0x03a1, Stride: 1},
+									{Lo: 0x03a3, Hi: 0x03f5, Stride: 1},
+									{Lo: 0x03f7, Hi: 0x0481, Stride: 1},
+									{Lo: 0x048a, Hi: 0x052f, Stride: 1},
+									{Lo: 0x0531, Hi: 0x0556, Stride: 1},
+									{Lo: 0x0559, Hi: 0x0559, Stride: 1},
+									{Lo: 0x0560, Hi: 0x0588, Stride: 1},
+									{Lo: 0x05d0, Hi: 0x05ea, Stride: 1},
+									{Lo: 0x05ef, Hi: 0x05f2, Stride: 1},
+									{Lo: 0x0620, Hi: 0x064a, Stride: 1},
+									{Lo: 0x066e, Hi: 0x066f, Stride: 1},
+									{Lo: 0x0671, Hi: 0x06d3, Stride: 1},
+									{Lo: 0x06d5, Hi: 0x06d5, Stride: 1},
+									{Lo: 0x06e5, Hi: 0x06e6, Stride: 1},
+									{Lo: 0x06ee, Hi: 0x06ef, Stride: 1},
+									{Lo: 0x06fa, Hi: 0x06fc, Stride: 1},
+									{Lo: 0x06ff, Hi: 0x06ff, Stride: 1},
+									{Lo: 0x0710, Hi: 0x0710, Stride: 1},
+									{Lo: 0x0712, Hi: 0x072f, Stride: 1},
+									{Lo: 0x074d, Hi: 0x07a5, Stride: 1},
+									{Lo: 0x07b1, Hi: 0x07b1, Stride: 1},
+									{Lo: 0x07ca, Hi: 0x07ea, Stride: 1},
+									{Lo: 0x07f4, Hi: 0x07f5, Stride: 1},
+									{Lo: 0x07fa, Hi: 0x07fa, Stride: 1},
+									{Lo: 0x0800, Hi: 0x0815, Stride: 1},
+									{Lo: 0x081a, Hi: 0x081a, Stride: 1},
+									{Lo: 0x0824, Hi: 0x0824, Stride: 1},
+									{Lo: 0x0828, Hi: 0x0828, Stride: 1},
+									{Lo: 0x0840, Hi: 0x0858, Stride: 1},
+									{Lo: 0x0860, Hi: 0x086a, Stride: 1},
+									{Lo: 0x0870, Hi: 0x0887, Stride: 1},
+									{Lo: 0x0889, Hi: 0x088f, Stride: 1},
+									{Lo: 0x08a0, Hi: 0x08c9, Stride: 1},
+									{Lo: 0x0904, Hi: 0x0939, Stride: 1},
+									{Lo: 0x093d, Hi: 0x093d, Stride: 1},
+									{Lo: 0x0950, Hi: 0x0950, Stride: 1},
+									{Lo: 0x0958, Hi: 0x0961, Stride: 1},
+									{Lo: 0x0971, Hi: 0x0980, Stride: 1},
+									{Lo: 0x0985, Hi: 0x098c, Stride: 1},
+									{Lo: 0x098f, Hi: 0x0990, Stride: 1},
+									{Lo: 0x0993, Hi: 0x09a8, Stride: 1},
+									{Lo: 0x09aa, Hi: 0x09b0, Stride: 1},
+									{Lo: 0x09b2, Hi: 0x09b2, Stride: 1},
+									{Lo: 0x09b6, Hi: 0x09b9, Stride: 1},
+									{Lo: 0x09bd, Hi: 0x09bd, Stride: 1},
+									{Lo: 0x09ce, Hi: 0x09ce, Stride: 1},
+									{Lo: 0x09dc, Hi: 0x09dd, Stride: 1},
+									{Lo: 0x09df, Hi: 0x09e1, Stride: 1},
+									{Lo: 0x09f0, Hi: 0x09f1, Stride: 1},
+									{Lo: 0x09fc, Hi: 0x09fc, Stride: 1},
+									{Lo: 0x0a05, Hi: 0x0a0a, Stride: 1},
+									{Lo: 0x0a0f, Hi: 0x0a10, Stride: 1},
+									{Lo: 0x0a13, Hi: 0x0a28, Stride: 1},
+									{Lo: 0x0a2a, Hi: 0x0a30, Stride: 1},
+									{Lo: 0x0a32, Hi: 0x0a33, Stride: 1},
+									{Lo: 0x0a35, Hi: 0x0a36, Stride: 1},
+									{Lo: 0x0a38, Hi: 0x0a39, Stride: 1},
+									{Lo: 0x0a59, Hi: 0x0a5c, Stride: 1},
+									{Lo: 0x0a5e, Hi: 0x0a5e, Stride: 1},
+									{Lo: 0x0a72, Hi: 0x0a74, Stride: 1},
+									{Lo: 0x0a85, Hi: 0x0a8d, Stride: 1},
+									{Lo: 0x0a8f, Hi: 0x0a91, Stride: 1},
+									{Lo: 0x0a93, Hi: 0x0aa8, Stride: 1},
+									{Lo: 0x0aaa, Hi: 0x0ab0, Stride: 1},
+									{Lo: 0x0ab2, Hi: 0x0ab3, Stride: 1},
+									{Lo: 0x0ab5, Hi: 0x0ab9, Stride: 1},
+									{Lo: 0x0abd, Hi: 0x0abd, Stride: 1},
+									{Lo: 0x0ad0, Hi: 0x0ad0, Stride: 1},
+									{Lo: 0x0ae0, Hi: 0x0ae1, Stride: 1},
+									{Lo: 0x0af9, Hi: 0x0af9, Stride: 1},
+									{Lo: 0x0b05, Hi: 0x0b0c, Stride: 1},
+									{Lo: 0x0b0f, Hi: 0x0b10, Stride: 1},
+									{Lo: 0x0b13, Hi: 0x0b28, Stride: 1},
+									{Lo: 0x0b2a, Hi: 0x0b30, Stride: 1},
+									{Lo: 0x0b32, Hi: 0x0b33, Stride: 1},
+									{Lo: 0x0b35, Hi: 0x0b39, Stride: 1},
+									{Lo: 0x0b3d, Hi: 0x0b3d, Stride: 1},
+									{Lo: 0x0b5c, Hi: 0x0b5d, Stride: 1},
+									{Lo: 0x0b5f, Hi: 0x0b61, Stride: 1},
+									{Lo: 0x0b71, Hi: 0x0b71, Stride: 1},
+									{Lo: 0x0b83, Hi: 0x0b83, Stride: 1},
+									{Lo: 0x0b85, Hi: 0x0b8a, Stride: 1},
+									{Lo: 0x0b8e, Hi: 0x0b90, Stride: 1},
+									{Lo: 0x0b92, Hi: 0x0b95, Stride: 1},
+									{Lo: 0x0b99, Hi: 0x0b9a, Stride: 1},
+									{Lo: 0x0b9c, Hi: 0x0b9c, Stride: 1},
+									{Lo: 0x0b9e, Hi: 0x0b9f, Stride: 1},
+									{Lo: 0x0ba3, Hi: 0x0ba4, Stride: 1},
+									{Lo: 0x0ba8, Hi: 0x0baa, Stride: 1},
+									{Lo: 0x0bae, Hi: 0x0bb9, Stride: 1},
+									{Lo: 0x0bd0, Hi: 0x0bd0, Stride: 1},
+									{Lo: 0x0c05, Hi: 0x0c0c, Stride: 1},
+									{Lo: 0x0c0e, Hi: 0x0c10, Stride: 1},
+									{Lo: 0x0c12, Hi: 0x0c28, Stride: 1},
+									{Lo: 0x0c2a, Hi: 0x0c39, Stride: 1},
+									{Lo: 0x0c3d, Hi: 0x0c3d, Stride: 1},
+									{Lo: 0x0c58, Hi: 0x0c5a, Stride: 1},
+									{Lo: 0x0c5c, Hi: 0x0c5d, Stride: 1},
+									{Lo: 0x0c60, Hi: 0x0c61, Stride: 1},
+									{Lo: 0x0c80, Hi: 0x0c80, Stride: 1},
+									{Lo: 0x0c85, Hi: 0x0c8c, Stride: 1},
+									{Lo: 0x0c8e, Hi: 0x0c90, Stride: 1},
+									{Lo: 0x0c92, Hi: 0x0ca8, Stride: 1},
+									{Lo: 0x0caa, Hi: 0x0cb3, Stride: 1},
+									{Lo: 0x0cb5, Hi: 0x0cb9, Stride: 1},
+									{Lo: 0x0cbd, Hi: 0x0cbd, Stride: 1},
+									{Lo: 0x0cdc, Hi: 0x0cde, Stride: 1},
+									{Lo: 0x0ce0, Hi: 0x0ce1, Stride: 1},
+									{Lo: 0x0cf1, Hi: 0x0cf2, Stride: 1},
+									{Lo: 0x0d04, Hi: 0x0d0c, Stride: 1},
+									{Lo: 0x0d0e, Hi: 0x0d10, Stride: 1},
+									{Lo: 0x0d12, Hi: 0x0d3a, Stride: 1},
+									{Lo: 0x0d3d, Hi: 0x0d3d, Stride: 1},
+									{Lo: 0x0d4e, Hi: 0x0d4e, Stride: 1},
+									{Lo: 0x0d54, Hi: 0x0d56, Stride: 1},
+									{Lo: 0x0d5f, Hi: 0x0d61, Stride: 1},
+									{Lo: 0x0d7a, Hi: 0x0d7f, Stride: 1},
+									{Lo: 0x0d85, Hi: 0x0d96, Stride: 1},
+									{Lo: 0x0d9a, Hi: 0x0db1, Stride: 1},
+									{Lo: 0x0db3, Hi: 0x0dbb, Stride: 1},
+									{Lo: 0x0dbd, Hi: 0x0dbd, Stride: 1},
+									{Lo: 0x0dc0, Hi: 0x0dc6, Stride: 1},
+									{Lo: 0x0e01, Hi: 0x0e30, Stride: 1},
+									{Lo: 0x0e32, Hi: 0x0e32, Stride: 1},
+									{Lo: 0x0e40, Hi: 0x0e46, Stride: 1},
+									{Lo: 0x0e81, Hi: 0x0e82, Stride: 1},
+									{Lo: 0x0e84, Hi: 0x0e84, Stride: 1},
+									{Lo: 0x0e86, Hi: 0x0e8a, Stride: 1},
+									{Lo: 0x0e8c, Hi: 0x0ea3, Stride: 1},
+									{Lo: 0x0ea5, Hi: 0x0ea5, Stride: 1},
+									{Lo: 0x0ea7, Hi: 0x0eb0, Stride: 1},
+									{Lo: 0x0eb2, Hi: 0x0eb2, Stride: 1},
+									{Lo: 0x0ebd, Hi: 0x0ebd, Stride: 1},
+									{Lo: 0x0ec0, Hi: 0x0ec4, Stride: 1},
+									{Lo: 0x0ec6, Hi: 0x0ec6, Stride: 1},
+									{Lo: 0x0edc, Hi: 0x0edf, Stride: 1},
+									{Lo: 0x0f00, Hi: 0x0f00, Stride: 1},
+									{Lo: 0x0f40, Hi: 0x0f47, Stride: 1},
+									{Lo: 0x0f49, Hi: 0x0f6c, Stride: 1},
+									{Lo: 0x0f88, Hi: 0x0f8c, Stride: 1},
+									{Lo: 0x1000, Hi: 0x102a, Stride: 1},
+									{Lo: 0x103f, Hi: 0x103f, Stride: 1},
+									{Lo: 0x1050, Hi: 0x1055, Stride: 1},
+									{Lo: 0x105a, Hi: 0x105d, Stride: 1},
+									{Lo: 0x1061, Hi: 0x1061, Stride: 1},
+									{Lo: 0x1065, Hi: 0x1066, Stride: 1},
+									{Lo: 0x106e, Hi: 0x1070, Stride: 1},
+									{Lo: 0x1075, Hi: 0x1081, Stride: 1},
+									{Lo: 0x108e, Hi: 0x108e, Stride: 1},
+									{Lo: 0x10a0, Hi: 0x10c5, Stride: 1},
+									{Lo: 0x10c7, Hi: 0x10c7, Stride: 1},
+									{Lo: 0x10cd, Hi: 0x10cd, Stride: 1},
+									{Lo: 0x10d0, Hi: 0x10fa, Stride: 1},
+									{Lo: 0x10fc, Hi: 0x1248, Stride: 1},
+									{Lo: 0x124a, Hi: 0x124d, Stride: 1},
+									{Lo: 0x1250, Hi: 0x1256, Stride: 1},
+									{Lo: 0x1258, Hi: 0x1258, Stride: 1},
+									{Lo: 0x125a, Hi: 0x125d, Stride: 1},
+									{Lo: 0x1260, Hi: 0x1288, Stride: 1},
+									{Lo: 0x128a, Hi: 0x128d, Stride: 1},
+									{Lo: 0x1290, Hi: 0x12b0, Stride: 1},
+									{Lo: 0x12b2, Hi: 0x12b5, Stride: 1},
+									{Lo: 0x12b8, Hi: 0x12be, Stride: 1},
+									{Lo: 0x12c0, Hi: 0x12c0, Stride: 1},
+									{Lo: 0x12c2, Hi: 0x12c5, Stride: 1},
+									{Lo: 0x12c8, Hi: 0x12d6, Stride: 1},
+									{Lo: 0x12d8, Hi: 0x1310, Stride: 1},
+									{Lo: 0x1312, Hi: 0x1315, Stride: 1},
+									{Lo: 0x1318, Hi: 0x135a, Stride: 1},
+									{Lo: 0x1380, Hi: 0x138f, Stride: 1},
+									{Lo: 0x13a0, Hi: 0x13f5, Stride: 1},
+									{Lo: 0x13f8, Hi: 0x13fd, Stride: 1},
+									{Lo: 0x1401, Hi: 0x166c, Stride: 1},
+									{Lo: 0x166f, Hi: 0x167f, Stride: 1},
+									{Lo: 0x1681, Hi: 0x169a, Stride: 1},
+									{Lo: 0x16a0, Hi: 0x16ea, Stride: 1},
+									{Lo: 0x16ee, Hi: 0x16f8, Stride: 1},
+									{Lo: 0x1700, Hi: 0x1711, Stride: 1},
+									{Lo: 0x171f, Hi: 0x1731, Stride: 1},
+									{Lo: 0x1740, Hi: 0x1751, Stride: 1},
+									{Lo: 0x1760, Hi: 0x176c, Stride: 1},
+									{Lo: 0x176e, Hi: 0x1770, Stride: 1},
+									{Lo: 0x1780, Hi: 0x17b3, Stride: 1},
+									{Lo: 0x17d7, Hi: 0x17d7, Stride: 1},
+									{Lo: 0x17dc, Hi: 0x17dc, Stride: 1},
+									{Lo: 0x1820, Hi: 0x1878, Stride: 1},
+									{Lo: 0x1880, Hi: 0x18a8, Stride: 1},
+									{Lo: 0x18aa, Hi: 0x18aa, Stride: 1},
+									{Lo: 0x18b0, Hi: 0x18f5, Stride: 1},
+									{Lo: 0x1900, Hi: 0x191e, Stride: 1},
+									{Lo: 0x1950, Hi: 0x196d, Stride: 1},
+									{Lo: 0x1970, Hi: 0x1974, Stride: 1},
+									{Lo: 0x1980, Hi: 0x19ab, Stride: 1},
+									{Lo: 0x19b0, Hi: 0x19c9, Stride: 1},
+									{Lo: 0x1a00, Hi: 0x1a16, Stride: 1},
+									{Lo: 0x1a20, Hi: 0x1a54, Stride: 1},
+									{Lo: 0x1aa7, Hi: 0x1aa7, Stride: 1},
+									{Lo: 0x1b05, Hi: 0x1b33, Stride: 1},
+									{Lo: 0x1b45, Hi: 0x1b4c, Stride: 1},
+									{Lo: 0x1b83, Hi: 0x1ba0, Stride: 1},
+									{Lo: 0x1bae, Hi: 0x1baf, Stride: 1},
+									{Lo: 0x1bba, Hi: 0x1be5, Stride: 1},
+									{Lo: 0x1c00, Hi: 0x1c23, Stride: 1},
+									{Lo: 0x1c4d, Hi: 0x1c4f, Stride: 1},
+									{Lo: 0x1c5a, Hi: 0x1c7d, Stride: 1},
+									{Lo: 0x1c80, Hi: 0x1c8a, Stride: 1},
+									{Lo: 0x1c90, Hi: 0x1cba, Stride: 1},
+									{Lo: 0x1cbd, Hi: 0x1cbf, Stride: 1},
+									{Lo: 0x1ce9, Hi: 0x1cec, Stride: 1},
+									{Lo: 0x1cee, Hi: 0x1cf3, Stride: 1},
+									{Lo: 0x1cf5, Hi: 0x1cf6, Stride: 1},
+									{Lo: 0x1cfa, Hi: 0x1cfa, Stride: 1},
+									{Lo: 0x1d00, Hi: 0x1dbf, Stride: 1},
+									{Lo: 0x1e00, Hi: 0x1f15, Stride: 1},
+									{Lo: 0x1f18, Hi: 0x1f1d, Stride: 1},
+									{Lo: 0x1f20, Hi: 0x1f45, Stride: 1},
+									{Lo: 0x1f48, Hi: 0x1f4d, Stride: 1},
+									{Lo: 0x1f50, Hi: 0x1f57, Stride: 1},
+									{Lo: 0x1f59, Hi: 0x1f59, Stride: 1},
+									{Lo: 0x1f5b, Hi: 0x1f5b, Stride: 1},
+									{Lo: 0x1f5d, Hi: 0x1f5d, Stride: 1},
+									{Lo: 0x1f5f, Hi: 0x1f7d, Stride: 1},
+									{Lo: 0x1f80, Hi: 0x1fb4, Stride: 1},
+									{Lo: 0x1fb6, Hi: 0x1fbc, Stride: 1},
+									{Lo: 0x1fbe, Hi: 0x1fbe, Stride: 1},
+									{Lo: 0x1fc2, Hi: 0x1fc4, Stride: 1},
+									{Lo: 0x1fc6, Hi: 0x1fcc, Stride: 1},
+									{Lo: 0x1fd0, Hi: 0x1fd3, Stride: 1},
+									{Lo: 0x1fd6, Hi: 0x1fdb, Stride: 1},
+									{Lo: 0x1fe0, Hi: 0x1fec, Stride: 1},
+									{Lo: 0x1ff2, Hi: 0x1ff4, Stride: 1},
+									{Lo: 0x1ff6, Hi: 0x1ffc, Stride: 1},
+									{Lo: 0x2071, Hi: 0x2071, Stride: 1},
+									{Lo: 0x207f, Hi: 0x207f, Stride: 1},
+									{Lo: 0x2090, Hi: 0x209c, Stride: 1},
+									{Lo: 0x2102, Hi: 0x2102, Stride: 1},
+									{Lo: 0x2107, Hi: 0x2107, Stride: 1},
+									{Lo: 0x210a, Hi: 0x2113, Stride: 1},
+									{Lo: 0x2115, Hi: 0x2115, Stride: 1},
+									{Lo: 0x2118, Hi: 0x211d, Stride: 1},
+									{Lo: 0x2124, Hi: 0x2124, Stride: 1},
+									{Lo: 0x2126, Hi: 0x2126, Stride: 1},
+									{Lo: 0x2128, Hi: 0x2128, Stride: 1},
+									{Lo: 0x212a, Hi: 0x2139, Stride: 1},
+									{Lo: 0x213c, Hi: 0x213f, Stride: 1},
+									{Lo: 0x2145, Hi: 0x2149, Stride: 1},
+									{Lo: 0x214e, Hi: 0x214e, Stride: 1},
+									{Lo: 0x2160, Hi: 0x2188, Stride: 1},
+									{Lo: 0x2c00, Hi: 0x2ce4, Stride: 1},
+									{Lo: 0x2ceb, Hi: 0x2cee, Stride: 1},
+									{Lo: 0x2cf2, Hi: 0x2cf3, Stride: 1},
+									{Lo: 0x2d00, Hi: 0x2d25, Stride: 1},
+									{Lo: 0x2d27, Hi: 0x2d27, Stride: 1},
+									{Lo: 0x2d2d, Hi: 0x2d2d, Stride: 1},
+									{Lo: 0x2d30, Hi: 0x2d67, Stride: 1},
+									{Lo: 0x2d6f, Hi: 0x2d6f, Stride: 1},
+									{Lo: 0x2d80, Hi: 0x2d96, Stride: 1},
+									{Lo: 0x2da0, Hi: 0x2da6, Stride: 1},
+									{Lo: 0x2da8, Hi: 0x2dae, Stride: 1},
+									{Lo: 0x2db0, Hi: 0x2db6, Stride: 1},
+									{Lo: 0x2db8, Hi: 0x2dbe, Stride: 1},
+									{Lo: 0x2dc0, Hi: 0x2dc6, Stride: 1},
+									{Lo: 0x2dc8, Hi: 0x2dce, Stride: 1},
+									{Lo: 0x2dd0, Hi: 0x2dd6, Stride: 1},
+									{Lo: 0x2dd8, Hi: 0x2dde, Stride: 1},
+									{Lo: 0x3005, Hi: 0x3007, Stride: 1},
+									{Lo: 0x3021, Hi: 0x3029, Stride: 1},
+									{Lo: 0x3031, Hi: 0x3035, Stride: 1},
+									{Lo: 0x3038, Hi: 0x303c, Stride: 1},
+									{Lo: 0x3041, Hi: 0x3096, Stride: 1},
+									{Lo: 0x309d, Hi: 0x309f, Stride: 1},
+									{Lo: 0x30a1, Hi: 0x30fa, Stride: 1},
+									{Lo: 0x30fc, Hi: 0x30ff, Stride: 1},
+									{Lo: 0x3105, Hi: 0x312f, Stride: 1},
+									{Lo: 0x3131, Hi: 0x318e, Stride: 1},
+									{Lo: 0x31a0, Hi: 0x31bf, Stride: 1},
+									{Lo: 0x31f0, Hi: 0x31ff, Stride: 1},
+									{Lo: 0x3400, Hi: 0x4dbf, Stride: 1},
+									{Lo: 0x4e00, Hi: 0xa48c, Stride: 1},
+									{Lo: 0xa4d0, Hi: 0xa4fd, Stride: 1},
+									{Lo: 0xa500, Hi: 0xa60c, Stride: 1},
+									{Lo: 0xa610, Hi: 0xa61f, Stride: 1},
+									{Lo: 0xa62a, Hi: 0xa62b, Stride: 1},
+									{Lo: 0xa640, Hi: 0xa66e, Stride: 1},
+									{Lo: 0xa67f, Hi: 0xa69d, Stride: 1},
+									{Lo: 0xa6a0, Hi: 0xa6ef, Stride: 1},
+									{Lo: 0xa717, Hi: 0xa71f, Stride: 1},
+									{Lo: 0xa722, Hi: 0xa788, Stride: 1},
+									{Lo: 0xa78b, Hi: 0xa7dc, Stride: 1},
+									{Lo: 0xa7f1, Hi: 0xa801, Stride: 1},
+									{Lo: 0xa803, Hi: 0xa805, Stride: 1},
+									{Lo: 0xa807, Hi: 0xa80a, Stride: 1},
+									{Lo: 0xa80c, Hi: 0xa822, Stride: 1},
+									{Lo: 0xa840, Hi: 0xa873, Stride: 1},
+									{Lo: 0xa882, Hi: 0xa8b3, Stride: 1},
+									{Lo: 0xa8f2, Hi: 0xa8f7, Stride: 1},
+									{Lo: 0xa8fb, Hi: 0xa8fb, Stride: 1},
+									{Lo: 0xa8fd, Hi: 0xa8fe, Stride: 1},
+									{Lo: 0xa90a, Hi: 0xa925, Stride: 1},
+									{Lo: 0xa930, Hi: 0xa946, Stride: 1},
+									{Lo: 0xa960, Hi: 0xa97c, Stride: 1},
+									{Lo: 0xa984, Hi: 0xa9b2, Stride: 1},
+									{Lo: 0xa9cf, Hi: 0xa9cf, Stride: 1},
+									{Lo: 0xa9e0, Hi: 0xa9e4, Stride: 1},
+									{Lo: 0xa9e6, Hi: 0xa9ef, Stride: 1},
+									{Lo: 0xa9fa, Hi: 0xa9fe, Stride: 1},
+									{Lo: 0xaa00, Hi: 0xaa28, Stride: 1},
+									{Lo: 0xaa40, Hi: 0xaa42, Stride: 1},
+									{Lo: 0xaa44, Hi: 0xaa4b, Stride: 1},
+									{Lo: 0xaa60, Hi: 0xaa76, Stride: 1},
+									{Lo: 0xaa7a, Hi: 0xaa7a, Stride: 1},
+									{Lo: 0xaa7e, Hi: 0xaaaf, Stride: 1},
+									{Lo: 0xaab1, Hi: 0xaab1, Stride: 1},
+									{Lo: 0xaab5, Hi: 0xaab6, Stride: 1},
+									{Lo: 0xaab9, Hi: 0xaabd, Stride: 1},
+									{Lo: 0xaac0, Hi: 0xaac0, Stride: 1},
+									{Lo: 0xaac2, Hi: 0xaac2, Stride: 1},
+									{Lo: 0xaadb, Hi: 0xaadd, Stride: 1},
+									{Lo: 0xaae0, Hi: 0xaaea, Stride: 1},
+									{Lo: 0xaaf2, Hi: 0xaaf4, Stride: 1},
+									{Lo: 0xab01, Hi: 0xab06, Stride: 1},
+									{Lo: 0xab09, Hi: 0xab0e, Stride: 1},
+									{Lo: 0xab11, Hi: 0xab16, Stride: 1},
+									{Lo: 0xab20, Hi: 0xab26, Stride: 1},
+									{Lo: 0xab28, Hi: 0xab2e, Stride: 1},
+									{Lo: 0xab30, Hi: 0xab5a, Stride: 1},
+									{Lo: 0xab5c, Hi: 0xab69, Stride: 1},
+									{Lo: 0xab70, Hi: 0xabe2, Stride: 1},
+									{Lo: 0xac00, Hi: 0xd7a3, Stride: 1},
+									{Lo: 0xd7b0, Hi: 0xd7c6, Stride: 1},
+									{Lo: 0xd7cb, Hi: 0xd7fb, Stride: 1},
+									{Lo: 0xf900, Hi: 0xfa6d, Stride: 1},
+									{Lo: 0xfa70, Hi: 0xfad9, Stride: 1},
+									{Lo: 0xfb00, Hi: 0xfb06, Stride: 1},
+									{Lo: 0xfb13, Hi: 0xfb17, Stride: 1},
+									{Lo: 0xfb1d, Hi: 0xfb1d, Stride: 1},
+									{Lo: 0xfb1f, Hi: 0xfb28, Stride: 1},
+									{Lo: 0xfb2a, Hi: 0xfb36, Stride: 1},
+									{Lo: 0xfb38, Hi: 0xfb3c, Stride: 1},
+									{Lo: 0xfb3e, Hi: 0xfb3e, Stride: 1},
+									{Lo: 0xfb40, Hi: 0xfb41, Stride: 1},
+									{Lo: 0xfb43, Hi: 0xfb44, Stride: 1},
+									{Lo: 0xfb46, Hi: 0xfbb1, Stride: 1},
+									{Lo: 0xfbd3, Hi: 0xfc5d, Stride: 1},
+									{Lo: 0xfc64, Hi: 0xfd3d, Stride: 1},
+									{Lo: 0xfd50, Hi: 0xfd8f, Stride: 1},
+									{Lo: 0xfd92, Hi: 0xfdc7, Stride: 1},
+									{Lo: 0xfdf0, Hi: 0xfdf9, Stride: 1},
+									{Lo: 0xfe71, Hi: 0xfe71, Stride: 1},
+									{Lo: 0xfe73, Hi: 0xfe73, Stride: 1},
+									{Lo: 0xfe77, Hi: 0xfe77, Stride: 1},
+									{Lo: 0xfe79, Hi: 0xfe79, Stride: 1},
+									{Lo: 0xfe7b, Hi: 0xfe7b, Stride: 1},
+									{Lo: 0xfe7d, Hi: 0xfe7d, Stride: 1},
+									{Lo: 0xfe7f, Hi: 0xfefc, Stride: 1},
+									{Lo: 0xff21, Hi: 0xff3a, Stride: 1},
+									{Lo: 0xff41, Hi: 0xff5a, Stride: 1},
+									{Lo: 0xff66, Hi: 0xff9d, Stride: 1},
+									{Lo: 0xffa0, Hi: 0xffbe, Stride: 1},
+									{Lo: 0xffc2, Hi: 0xffc7, Stride: 1},
+									{Lo: 0xffca, Hi: 0xffcf, Stride: 1},
+									{Lo: 0xffd2, Hi: 0xffd7, Stride: 1},
+									{Lo: 0xffda, Hi: 0xffdc, Stride: 1},
+								},
+								R32: []unicode.Range32{
+									{Lo: 0x10000, Hi: 0x1000b, Stride: 1},
+									{Lo: 0x1000d, Hi: 0x10026, Stride: 1},
+									{Lo: 0x10028, Hi: 0x1003a, Stride: 1},
+									{Lo: 0x1003c, Hi: 0x1003d, Stride: 1},
+									{Lo: 0x1003f, Hi: 0x1004d, Stride: 1},
+									{Lo: 0x10050, Hi: 0x1005d, Stride: 1},
+									{Lo: 0x10080, Hi: 0x100fa, Stride: 1},
+									{Lo: 0x10140, Hi: 0x10174, Stride: 1},
+									{Lo: 0x10280, Hi: 0x1029c, Stride: 1},
+									{Lo: 0x102a0, Hi: 0x102d0, Stride: 1},
+									{Lo: 0x10300, Hi: 0x1031f, Stride: 1},
+									{Lo: 0x1032d, Hi: 0x1034a, Stride: 1},
+									{Lo: 0x10350, Hi: 0x10375, Stride: 1},
+									{Lo: 0x10380, Hi: 0x1039d, Stride: 1},
+									{Lo: 0x103a0, Hi: 0x103c3, Stride: 1},
+									{Lo: 0x103c8, Hi: 0x103cf, Stride: 1},
+									{Lo: 0x103d1, Hi: 0x103d5, Stride: 1},
+									{Lo: 0x10400, Hi: 0x1049d, Stride: 1},
+									{Lo: 0x104b0, Hi: 0x104d3, Stride: 1},
+									{Lo: 0x104d8, Hi: 0x104fb, Stride: 1},
+									{Lo: 0x10500, Hi: 0x10527, Stride: 1},
+									{Lo: 0x10530, Hi: 0x10563, Stride: 1},
+									{Lo: 0x10570, Hi: 0x1057a, Stride: 1},
+									{Lo: 0x1057c, Hi: 0x1058a, Stride: 1},
+									{Lo: 0x1058c, Hi: 0x10592, Stride: 1},
+									{Lo: 0x10594, Hi: 0x10595, Stride: 1},
+									{Lo: 0x10597, Hi: 0x105a1, Stride: 1},
+									{Lo: 0x105a3, Hi: 0x105b1, Stride: 1},
+									{Lo: 0x105b3, Hi: 0x105b9, Stride: 1},
+									{Lo: 0x105bb, Hi: 0x105bc, Stride: 1},
+									{Lo: 0x105c0, Hi: 0x105f3, Stride: 1},
+									{Lo: 0x10600, Hi: 0x10736, Stride: 1},
+									{Lo: 0x10740, Hi: 0x10755, Stride: 1},
+									{Lo: 0x10760, Hi: 0x10767, Stride: 1},
+									{Lo: 0x10780, Hi: 0x10785, Stride: 1},
+									{Lo: 0x10787, Hi: 0x107b0, Stride: 1},
+									{Lo: 0x107b2, Hi: 0x107ba, Stride: 1},
+									{Lo: 0x10800, Hi: 0x10805, Stride: 1},
+									{Lo: 0x10808, Hi: 0x10808, Stride: 1},
+									{Lo: 0x1080a, Hi: 0x10835, Stride: 1},
+									{Lo: 0x10837, Hi: 0x10838, Stride: 1},
+									{Lo: 0x1083c, Hi: 0x1083c, Stride: 1},
+									{Lo: 0x1083f, Hi: 0x10855, Stride: 1},
+									{Lo: 0x10860, Hi: 0x10876, Stride: 1},
+									{Lo: 0x10880, Hi: 0x1089e, Stride: 1},
+									{Lo: 0x108e0, Hi: 0x108f2, Stride: 1},
+									{Lo: 0x108f4, Hi: 0x108f5, Stride: 1},
+									{Lo: 0x10900, Hi: 0x10915, Stride: 1},
+									{Lo: 0x10920, Hi: 0x10939, Stride: 1},
+									{Lo: 0x10940, Hi: 0x10959, Stride: 1},
+									{Lo: 0x10980, Hi: 0x109b7, Stride: 1},
+									{Lo: 0x109be, Hi: 0x109bf, Stride: 1},
+									{Lo: 0x10a00, Hi: 0x10a00, Stride: 1},
+									{Lo: 0x10a10, Hi: 0x10a13, Stride: 1},
+									{Lo: 0x10a15, Hi: 0x10a17, Stride: 1},
+									{Lo: 0x10a19, Hi: 0x10a35, Stride: 1},
+									{Lo: 0x10a60, Hi: 0x10a7c, Stride: 1},
+									{Lo: 0x10a80, Hi: 0x10a9c, Stride: 1},
+									{Lo: 0x10ac0, Hi: 0x10ac7, Stride: 1},
+									{Lo: 0x10ac9, Hi: 0x10ae4, Stride: 1},
+									{Lo: 0x10b00, Hi: 0x10b35, Stride: 1},
+									{Lo: 0x10b40, Hi: 0x10b55, Stride: 1},
+									{Lo: 0x10b60, Hi: 0x10b72, Stride: 1},
+									{Lo: 0x10b80, Hi: 0x10b91, Stride: 1},
+									{Lo: 0x10c00, Hi: 0x10c48, Stride: 1},
+									{Lo: 0x10c80, Hi: 0x10cb2, Stride: 1},
+									{Lo: 0x10cc0, Hi: 0x10cf2, Stride: 1},
+									{Lo: 0x10d00, Hi: 0x10d23, Stride: 1},
+									{Lo: 0x10d4a, Hi: 0x10d65, Stride: 1},
+									{Lo: 0x10d6f, Hi: 0x10d85, Stride: 1},
+									{Lo: 0x10e80, Hi: 0x10ea9, Stride: 1},
+									{Lo: 0x10eb0, Hi: 0x10eb1, Stride: 1},
+									{Lo: 0x10ec2, Hi: 0x10ec7, Stride: 1},
+									{Lo: 0x10f00, Hi: 0x10f1c, Stride: 1},
+									{Lo: 0x10f27, Hi: 0x10f27, Stride: 1},
+									{Lo: 0x10f30, Hi: 0x10f45, Stride: 1},
+									{Lo: 0x10f70, Hi: 0x10f81, Stride: 1},
+									{Lo: 0x10fb0, Hi: 0x10fc4, Stride: 1},
+									{Lo: 0x10fe0, Hi: 0x10ff6, Stride: 1},
+									{Lo: 0x11003, Hi: 0x11037, Stride: 1},
+									{Lo: 0x11071, Hi: 0x11072, Stride: 1},
+									{Lo: 0x11075, Hi: 0x11075, Stride: 1},
+									{Lo: 0x11083, Hi: 0x110af, Stride: 1},
+									{Lo: 0x110d0, Hi: 0x110e8, Stride: 1},
+									{Lo: 0x11103, Hi: 0x11126, Stride: 1},
+									{Lo: 0x11144, Hi: 0x11144, Stride: 1},
+									{Lo: 0x11147, Hi: 0x11147, Stride: 1},
+									{Lo: 0x11150, Hi: 0x11172, Stride: 1},
+									{Lo: 0x11176, Hi: 0x11176, Stride: 1},
+									{Lo: 0x11183, Hi: 0x111b2, Stride: 1},
+									{Lo: 0x111c1, Hi: 0x111c4, Stride: 1},
+									{Lo: 0x111da, Hi: 0x111da, Stride: 1},
+									{Lo: 0x111dc, Hi: 0x111dc, Stride: 1},
+									{Lo: 0x11200, Hi: 0x11211, Stride: 1},
+									{Lo: 0x11213, Hi: 0x1122b, Stride: 1},
+									{Lo: 0x1123f, Hi: 0x11240, Stride: 1},
+									{Lo: 0x11280, Hi: 0x11286, Stride: 1},
+									{Lo: 0x11288, Hi: 0x11288, Stride: 1},
+									{Lo: 0x1128a, Hi: 0x1128d, Stride: 1},
+									{Lo: 0x1128f, Hi: 0x1129d, Stride: 1},
+									{Lo: 0x1129f, Hi: 0x112a8, Stride: 1},
+									{Lo: 0x112b0, Hi: 0x112de, Stride: 1},
+									{Lo: 0x11305, Hi: 0x1130c, Stride: 1},
+									{Lo: 0x1130f, Hi: 0x11310, Stride: 1},
+									{Lo: 0x11313, Hi: 0x11328, Stride: 1},
+									{Lo: 0x1132a, Hi: 0x11330, Stride: 1},
+									{Lo: 0x11332, Hi: 0x11333, Stride: 1},
+									{Lo: 0x11335, Hi: 0x11339, Stride: 1},
+									{Lo: 0x1133d, Hi: 0x1133d, Stride: 1},
+									{Lo: 0x11350, Hi: 0x11350, Stride: 1},
+									{Lo: 0x1135d, Hi: 0x11361, Stride: 1},
+									{Lo: 0x11380, Hi: 0x11389, Stride: 1},
+									{Lo: 0x1138b, Hi: 0x1138b, Stride: 1},
+									{Lo: 0x1138e, Hi: 0x1138e, Stride: 1},
+									{Lo: 0x11390, Hi: 0x113b5, Stride: 1},
+									{Lo: 0x113b7, Hi: 0x113b7, Stride: 1},
+									{Lo: 0x113d1, Hi: 0x113d1, Stride: 1},
+									{Lo: 0x113d3, Hi: 0x113d3, Stride: 1},
+									{Lo: 0x11400, Hi: 0x11434, Stride: 1},
+									{Lo: 0x11447, Hi: 0x1144a, Stride: 1},
+									{Lo: 0x1145f, Hi: 0x11461, Stride: 1},
+									{Lo: 0x11480, Hi: 0x114af, Stride: 1},
+									{Lo: 0x114c4, Hi: 0x114c5, Stride: 1},
+									{Lo: 0x114c7, Hi: 0x114c7, Stride: 1},
+									{Lo: 0x11580, Hi: 0x115ae, Stride: 1},
+									{Lo: 0x115d8, Hi: 0x115db, Stride: 1},
+									{Lo: 0x11600, Hi: 0x1162f, Stride: 1},
+									{Lo: 0x11644, Hi: 0x11644, Stride: 1},
+									{Lo: 0x11680, Hi: 0x116aa, Stride: 1},
+									{Lo: 0x116b8, Hi: 0x116b8, Stride: 1},
+									{Lo: 0x11700, Hi: 0x1171a, Stride: 1},
+									{Lo: 0x11740, Hi: 0x11746, Stride: 1},
+									{Lo: 0x11800, Hi: 0x1182b, Stride: 1},
+									{Lo: 0x118a0, Hi: 0x118df, Stride: 1},
+									{Lo: 0x118ff, Hi: 0x11906, Stride: 1},
+									{Lo: 0x11909, Hi: 0x11909, Stride: 1},
+									{Lo: 0x1190c, Hi: 0x11913, Stride: 1},
+									{Lo: 0x11915, Hi: 0x11916, Stride: 1},
+									{Lo: 0x11918, Hi: 0x1192f, Stride: 1},
+									{Lo: 0x1193f, Hi: 0x1193f, Stride: 1},
+									{Lo: 0x11941, Hi: 0x11941, Stride: 1},
+									{Lo: 0x119a0, Hi: 0x119a7, Stride: 1},
+									{Lo: 0x119aa, Hi: 0x119d0, Stride: 1},
+									{Lo: 0x119e1, Hi: 0x119e1, Stride: 1},
+									{Lo: 0x119e3, Hi: 0x119e3, Stride: 1},
+									{Lo: 0x11a00, Hi: 0x11a00, Stride: 1},
+									{Lo: 0x11a0b, Hi: 0x11a32, Stride: 1},
+									{Lo: 0x11a3a, Hi: 0x11a3a, Stride: 1},
+									{Lo: 0x11a50, Hi: 0x11a50, Stride: 1},
+									{Lo: 0x11a5c, Hi: 0x11a89, Stride: 1},
+									{Lo: 0x11a9d, Hi: 0x11a9d, Stride: 1},
+									{Lo: 0x11ab0, Hi: 0x11af8, Stride: 1},
+									{Lo: 0x11bc0, Hi: 0x11be0, Stride: 1},
+									{Lo: 0x11c00, Hi: 0x11c08, Stride: 1},
+									{Lo: 0x11c0a, Hi: 0x11c2e, Stride: 1},
+									{Lo: 0x11c40, Hi: 0x11c40, Stride: 1},
+									{Lo: 0x11c72, Hi: 0x11c8f, Stride: 1},
+									{Lo: 0x11d00, Hi: 0x11d06, Stride: 1},
+									{Lo: 0x11d08, Hi: 0x11d09, Stride: 1},
+									{Lo: 0x11d0b, Hi: 0x11d30, Stride: 1},
+									{Lo: 0x11d46, Hi: 0x11d46, Stride: 1},
+									{Lo: 0x11d60, Hi: 0x11d65, Stride: 1},
+									{Lo: 0x11d67, Hi: 0x11d68, Stride: 1},
+									{Lo: 0x11d6a, Hi: 0x11d89, Stride: 1},
+									{Lo: 0x11d98, Hi: 0x11d98, Stride: 1},
+									{Lo: 0x11db0, Hi: 0x11ddb, Stride: 1},
+									{Lo: 0x11ee0, Hi: 0x11ef2, Stride: 1},
+									{Lo: 0x11f02, Hi: 0x11f02, Stride: 1},
+									{Lo: 0x11f04, Hi: 0x11f10, Stride: 1},
+									{Lo: 0x11f12, Hi: 0x11f33, Stride: 1},
+									{Lo: 0x11fb0, Hi: 0x11fb0, Stride: 1},
+									{Lo: 0x12000, Hi: 0x12399, Stride: 1},
+									{Lo: 0x12400, Hi: 0x1246e, Stride: 1},
+									{Lo: 0x12480, Hi: 0x12543, Stride: 1},
+									{Lo: 0x12f90, Hi: 0x12ff0, Stride: 1},
+									{Lo: 0x13000, Hi: 0x1342f, Stride: 1},
+									{Lo: 0x13441, Hi: 0x13446, Stride: 1},
+									{Lo: 0x13460, Hi: 0x143fa, Stride: 1},
+									{Lo: 0x14400, Hi: 0x14646, Stride: 1},
+									{Lo: 0x16100, Hi: 0x1611d, Stride: 1},
+									{Lo: 0x16800, Hi: 0x16a38, Stride: 1},
+									{Lo: 0x16a40, Hi: 0x16a5e, Stride: 1},
+									{Lo: 0x16a70, Hi: 0x16abe, Stride: 1},
+									{Lo: 0x16ad0, Hi: 0x16aed, Stride: 1},
+									{Lo: 0x16b00, Hi: 0x16b2f, Stride: 1},
+									{Lo: 0x16b40, Hi: 0x16b43, Stride: 1},
+									{Lo: 0x16b63, Hi: 0x16b77, Stride: 1},
+									{Lo: 0x16b7d, Hi: 0x16b8f, Stride: 1},
+									{Lo: 0x16d40, Hi: 0x16d6c, Stride: 1},
+									{Lo: 0x16e40, Hi: 0x16e7f, Stride: 1},
+									{Lo: 0x16ea0, Hi: 0x16eb8, Stride: 1},
+									{Lo: 0x16ebb, Hi: 0x16ed3, Stride: 1},
+									{Lo: 0x16f00, Hi: 0x16f4a, Stride: 1},
+									{Lo: 0x16f50, Hi: 0x16f50, Stride: 1},
+									{Lo: 0x16f93, Hi: 0x16f9f, Stride: 1},
+									{Lo: 0x16fe0, Hi: 0x16fe1, Stride: 1},
+									{Lo: 0x16fe3, Hi: 0x16fe3, Stride: 1},
+									{Lo: 0x16ff2, Hi: 0x16ff6, Stride: 1},
+									{Lo: 0x17000, Hi: 0x18cd5, Stride: 1},
+									{Lo: 0x18cff, Hi: 0x18d1e, Stride: 1},
+									{Lo: 0x18d80, Hi: 0x18df2, Stride: 1},
+									{Lo: 0x1aff0, Hi: 0x1aff3, Stride: 1},
+									{Lo: 0x1aff5, Hi: 0x1affb, Stride: 1},
+									{Lo: 0x1affd, Hi: 0x1affe, Stride: 1},
+									{Lo: 0x1b000, Hi: 0x1b122, Stride: 1},
+									{Lo: 0x1b132, Hi: 0x1b132, Stride: 1},
+									{Lo: 0x1b150, Hi: 0x1b152, Stride: 1},
+									{Lo: 0x1b155, Hi: 0x1b155, Stride: 1},
+									{Lo: 0x1b164, Hi: 0x1b167, Stride: 1},
+									{Lo: 0x1b170, Hi: 0x1b2fb, Stride: 1},
+									{Lo: 0x1bc00, Hi: 0x1bc6a, Stride: 1},
+									{Lo: 0x1bc70, Hi: 0x1bc7c, Stride: 1},
+									{Lo: 0x1bc80, Hi: 0x1bc88, Stride: 1},
+									{Lo: 0x1bc90, Hi: 0x1bc99, Stride: 1},
+									{Lo: 0x1d400, Hi: 0x1d454, Stride: 1},
+									{Lo: 0x1d456, Hi: 0x1d49c, Stride: 1},
+									{Lo: 0x1d49e, Hi: 0x1d49f, Stride: 1},
+									{Lo: 0x1d4a2, Hi: 0x1d4a2, Stride: 1},
+									{Lo: 0x1d4a5, Hi: 0x1d4a6, Stride: 1},
+									{Lo: 0x1d4a9, Hi: 0x1d4ac, Stride: 1},
+									{Lo: 0x1d4ae, Hi: 0x1d4b9, Stride: 1},
+									{Lo: 0x1d4bb, Hi: 0x1d4bb, Stride: 1},
+									{Lo: 0x1d4bd, Hi: 0x1d4c3, Stride: 1},
+									{Lo: 0x1d4c5, Hi: 0x1d505, Stride: 1},
+									{Lo: 0x1d507, Hi: 0x1d50a, Stride: 1},
+									{Lo: 0x1d50d, Hi: 0x1d514, Stride: 1},
+									{Lo: 0x1d516, Hi: 0x1d51c, Stride: 1},
+									{Lo: 0x1d51e, Hi: 0x1d539, Stride: 1},
+									{Lo: 0x1d53b, Hi: 0x1d53e, Stride: 1},
+									{Lo: 0x1d540, Hi: 0x1d544, Stride: 1},
+									{Lo: 0x1d546, Hi: 0x1d546, Stride: 1},
+									{Lo: 0x1d54a, Hi: 0x1d550, Stride: 1},
+									{Lo: 0x1d552, Hi: 0x1d6a5, Stride: 1},
+									{Lo: 0x1d6a8, Hi: 0x1d6c0, Stride: 1},
+									{Lo: 0x1d6c2, Hi: 0x1d6da, Stride: 1},
+									{Lo: 0x1d6dc, Hi: 0x1d6fa, Stride: 1},
+									{Lo: 0x1d6fc, Hi: 0x1d714, Stride: 1},
+									{Lo: 0x1d716, Hi: 0x1d734, Stride: 1},
+									{Lo: 0x1d736, Hi: 0x1d74e, Stride: 1},
+									{Lo: 0x1d750, Hi: 0x1d76e, Stride: 1},
+									{Lo: 0x1d770, Hi: 0x1d788, Stride: 1},
+									{Lo: 0x1d78a, Hi: 0x1d7a8, Stride: 1},
+									{Lo: 0x1d7aa, Hi: 0x1d7c2, Stride: 1},
+									{Lo: 0x1d7c4, Hi: 0x1d7cb, Stride: 1},
+									{Lo: 0x1df00, Hi: 0x1df1e, Stride: 1},
+									{Lo: 0x1df25, Hi: 0x1df2a, Stride: 1},
+									{Lo: 0x1e030, Hi: 0x1e06d, Stride: 1},
+									{Lo: 0x1e100, Hi: 0x1e12c, Stride: 1},
+									{Lo: 0x1e137, Hi: 0x1e13d, Stride: 1},
+									{Lo: 0x1e14e, Hi: 0x1e14e, Stride: 1},
+									{Lo: 0x1e290, Hi: 0x1e2ad, Stride: 1},
+									{Lo: 0x1e2c0, Hi: 0x1e2eb, Stride: 1},
+									{Lo: 0x1e4d0, Hi: 0x1e4eb, Stride: 1},
+									{Lo: 0x1e5d0, Hi: 0x1e5ed, Stride: 1},
+									{Lo: 0x1e5f0, Hi: 0x1e5f0, Stride: 1},
+									{Lo: 0x1e6c0, Hi: 0x1e6de, Stride: 1},
+									{Lo: 0x1e6e0, Hi: 0x1e6e2, Stride: 1},
+									{Lo: 0x1e6e4, Hi: 0x1e6e5, Stride: 1},
+									{Lo: 0x1e6e7, Hi: 0x1e6ed, Stride: 1},
+									{Lo: 0x1e6f0, Hi: 0x1e6f4, Stride: 1},
+									{Lo: 0x1e6fe, Hi: 0x1e6ff, Stride: 1},
+									{Lo: 0x1e7e0, Hi: 0x1e7e6, Stride: 1},
+									{Lo: 0x1e7e8, Hi: 0x1e7eb, Stride: 1},
+									{Lo: 0x1e7ed, Hi: 0x1e7ee, Stride: 1},
+									{Lo: 0x1e7f0, Hi: 0x1e7fe, Stride: 1},
+									{Lo: 0x1e800, Hi: 0x1e8c4, Stride: 1},
+									{Lo: 0x1e900, Hi: 0x1e943, Stride: 1},
+									{Lo: 0x1e94b, Hi: 0x1e94b, Stride: 1},
+									{Lo: 0x1ee00, Hi: 0x1ee03, Stride: 1},
+									{Lo: 0x1ee05, Hi: 0x1ee1f, Stride: 1},
+									{Lo: 0x1ee21, Hi: 0x1ee22, Stride: 1},
+									{Lo: 0x1ee24, Hi: 0x1ee24, Stride: 1},
+									{Lo: 0x1ee27, Hi: 0x1ee27, Stride: 1},
+									{Lo: 0x1ee29, Hi: 0x1ee32, Stride: 1},
+									{Lo: 0x1ee34, Hi: 0x1ee37, Stride: 1},
+									{Lo: 0x1ee39, Hi: 0x1ee39, Stride: 1},
+									{Lo: 0x1ee3b, Hi: 0x1ee3b, Stride: 1},
+									{Lo: 0x1ee42, Hi: 0x1ee42, Stride: 1},
+									{Lo: 0x1ee47, Hi: 0x1ee47, Stride: 1},
+									{Lo: 0x1ee49, Hi: 0x1ee49, Stride: 1},
+									{Lo: 0x1ee4b, Hi: 0x1ee4b, Stride: 1},
+									{Lo: 0x1ee4d, Hi: 0x1ee4f, Stride: 1},
+									{Lo: 0x1ee51, Hi: 0x1ee52, Stride: 1},
+									{Lo: 0x1ee54, Hi: 0x1ee54, Stride: 1},
+									{Lo: 0x1ee57, Hi: 0x1ee57, Stride: 1},
+									{Lo: 0x1ee59, Hi: 0x1ee59, Stride: 1},
+									{Lo: 0x1ee5b, Hi: 0x1ee5b, Stride: 1},
+									{Lo: 0x1ee5d, Hi: 0x1ee5d, Stride: 1},
+									{Lo: 0x1ee5f, Hi: 0x1ee5f, Stride: 1},
+									{Lo: 0x1ee61, Hi: 0x1ee62, Stride: 1},
+									{Lo: 0x1ee64, Hi: 0x1ee64, Stride: 1},
+									{Lo: 0x1ee67, Hi: 0x1ee6a, Stride: 1},
+									{Lo: 0x1ee6c, Hi: 0x1ee72, Stride: 1},
+									{Lo: 0x1ee74, Hi: 0x1ee77, Stride: 1},
+									{Lo: 0x1ee79, Hi: 0x1ee7c, Stride: 1},
+									{Lo: 0x1ee7e, Hi: 0x1ee7e, Stride: 1},
+									{Lo: 0x1ee80, Hi: 0x1ee89, Stride: 1},
+									{Lo: 0x1ee8b, Hi: 0x1ee9b, Stride: 1},
+									{Lo: 0x1eea1, Hi: 0x1eea3, Stride: 1},
+									{Lo: 0x1eea5, Hi: 0x1eea9, Stride: 1},
+									{Lo: 0x1eeab, Hi: 0x1eebb, Stride: 1},
+									{Lo: 0x20000, Hi: 0x2a6df, Stride: 1},
+									{Lo: 0x2a700, Hi: 0x2b81d, Stride: 1},
+									{Lo: 0x2b820, Hi: 0x2cead, Stride: 1},
+									{Lo: 0x2ceb0, Hi: 0x2ebe0, Stride: 1},
+									{Lo: 0x2ebf0, Hi: 0x2ee5d, Stride: 1},
+									{Lo: 0x2f800, Hi: 0x2fa1d, Stride: 1},
+									{Lo: 0x30000, Hi: 0x3134a, Stride: 1},
+									{Lo: 0x31350, Hi: 0x33479, Stride: 1},
+								},
+								LatinOffset: 7,
+							}},
+							ignoreCase: false,
+							inverted:   false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 23, col: 25, offset: 571},
+							id:  37,
+							expr: &charClassMatcher{
+								pos: position{line: 23, col: 25, offset: 571},
+								id:  38,
+								val: "[\\p{XID_Continue}]",
+								classes: []*unicode.RangeTable{&unicode.RangeTable{
+									R16: []unicode.Range16{
+										{Lo: 0x0030, Hi: 0x0039, Stride: 1},
+										{Lo: 0x0041, Hi: 0x005a, Stride: 1},
+										{Lo: 0x005f, Hi: 0x005f, Stride: 1},
+										{Lo: 0x0061, Hi: 0x007a, Stride: 1},
+										{Lo: 0x00aa, Hi: 0x00aa, Stride: 1},
+										{Lo: 0x00b5, Hi: 0x00b5, Stride: 1},
+										{Lo: 0x00b7, Hi: 0x00b7, Stride: 1},
+										{Lo: 0x00ba, Hi: 0x00ba, Stride: 1},
+										{Lo: 0x00c0, Hi: 0x00d6, Stride: 1},
+										{Lo: 0x00d8, Hi: 0x00f6, Stride: 1},
+										{Lo: 0x00f8, Hi: 0x02c1, Stride: 1},
+										{Lo: 0x02c6, Hi: 0x02d1, Stride: 1},
+										{Lo: 0x02e0, Hi: 0x02e4, Stride: 1},
+										{Lo: 0x02ec, Hi: 0x02ec, Stride: 1},
+										{Lo: 0x02ee, Hi: 0x02ee, Stride: 1},
+										{Lo: 0x0300, Hi: 0x0374, Stride: 1},
+										{Lo: 0x0376, Hi: 0x0377, Stride: 1},
+										{Lo: 0x037b, Hi: 0x037d, Stride: 1},
+										{Lo: 0x037f, Hi: 0x037f, Stride: 1},
+										{Lo: 0x0386, Hi: 0x038a, Stride: 1},
+										{Lo: 0x038c, Hi: 0x038c, Stride: 1},
+										{Lo: 0x038e, Hi: 0x03a1, Stride: 1},
+										{Lo: 0x03a3, Hi: 0x03f5, Stride: 1},
+										{Lo: 0x03f7, Hi: 0x0481, Stride: 1},
+										{Lo: 0x0483, Hi: 0x0487, Stride: 1},
+										{Lo: 0x048a, Hi: 0x052f, Stride: 1},
+										{Lo: 0x0531, Hi: 0x0556, Stride: 1},
+										{Lo: 0x0559, Hi: 0x0559, Stride: 1},
+										{Lo: 0x0560, Hi: 0x0588, Stride: 1},
+										{Lo: 0x0591, Hi: 0x05bd, Stride: 1},
+										{Lo: 0x05bf, Hi: 0x05bf, Stride: 1},
+										{Lo: 0x05c1, Hi: 0x05c2, Stride: 1},
+										{Lo: 0x05c4, Hi: 0x05c5, Stride: 1},
+										{Lo: 0x05c7, Hi: 0x05c7, Stride: 1},
+										{Lo: 0x05d0, Hi: 0x05ea, Stride: 1},
+										{Lo: 0x05ef, Hi: 0x05f2, Stride: 1},
+										{Lo: 0x0610, Hi: 0x061a, Stride: 1},
+										{Lo: 0x0620, Hi: 0x0669, Stride: 1},
+										{Lo: 0x066e, Hi: 0x06d3, Stride: 1},
+										{Lo: 0x06d5, Hi: 0x06dc, Stride: 1},
+										{Lo: 0x06df, Hi: 0x06e8, Stride: 1},
+										{Lo: 0x06ea, Hi: 0x06fc, Stride: 1},
+										{Lo: 0x06ff, Hi: 0x06ff, Stride: 1},
+										{Lo: 0x0710, Hi: 0x074a, Stride: 1},
+										{Lo: 0x074d, Hi: 0x07b1, Stride: 1},
+										{Lo: 0x07c0, Hi: 0x07f5, Stride: 1},
+										{Lo: 0x07fa, Hi: 0x07fa, Stride: 1},
+										{Lo: 0x07fd, Hi: 0x07fd, Stride: 1},
+										{Lo: 0x0800, Hi: 0x082d, Stride: 1},
+										{Lo: 0x0840, Hi: 0x085b, Stride: 1},
+										{Lo: 0x0860, Hi: 0x086a, Stride: 1},
+										{Lo: 0x0870, Hi: 0x0887, Stride: 1},
+										{Lo: 0x0889, Hi: 0x088f, Stride: 1},
+										{Lo: 0x0897, Hi: 0x08e1, Stride: 1},
+										{Lo: 0x08e3, Hi: 0x0963, Stride: 1},
+										{Lo: 0x0966, Hi: 0x096f, Stride: 1},
+										{Lo: 0x0971, Hi: 0x0983, Stride: 1},
+										{Lo: 0x0985, Hi: 0x098c, Stride: 1},
+										{Lo: 0x098f, Hi: 0x0990, Stride: 1},
+										{Lo: 0x0993, Hi: 0x09a8, Stride: 1},
+										{Lo: 0x09aa, Hi: 0x09b0, Stride: 1},
+										{Lo: 0x09b2, Hi: 0x09b2, Stride: 1},
+										{Lo: 0x09b6, Hi: 0x09b9, Stride: 1},
+										{Lo: 0x09bc, Hi: 0x09c4, Stride: 1},
+										{Lo: 0x09c7, Hi: 0x09c8, Stride: 1},
+										{Lo: 0x09cb, Hi: 0x09ce, Stride: 1},
+										{Lo: 0x09d7, Hi: 0x09d7, Stride: 1},
+										{Lo: 0x09dc, Hi: 0x09dd, Stride: 1},
+										{Lo: 0x09df, Hi: 0x09e3, Stride: 1},
+										{Lo: 0x09e6, Hi: 0x09f1, Stride: 1},
+										{Lo: 0x09fc, Hi: 0x09fc, Stride: 1},
+										{Lo: 0x09fe, Hi: 0x09fe, Stride: 1},
+										{Lo: 0x0a01, Hi: 0x0a03, Stride: 1},
+										{Lo: 0x0a05, Hi: 0x0a0a, Stride: 1},
+										{Lo: 0x0a0f, Hi: 0x0a10, Stride: 1},
+										{Lo: 0x0a13, Hi: 0x0a28, Stride: 1},
+										{Lo: 0x0a2a, Hi: 0x0a30, Stride: 1},
+										{Lo: 0x0a32, Hi: 0x0a33, Stride: 1},
+										{Lo: 0x0a35, Hi: 0x0a36, Stride: 1},
+										{Lo: 0x0a38, Hi: 0x0a39, Stride: 1},
+										{Lo: 0x0a3c, Hi: 0x0a3c, Stride: 1},
+										{Lo: 0x0a3e, Hi: 0x0a42, Stride: 1},
+										{Lo: 0x0a47, Hi: 0x0a48, Stride: 1},
+										{Lo: 0x0a4b, Hi: 0x0a4d, Stride: 1},
+										{Lo: 0x0a51, Hi: 0x0a51, Stride: 1},
+										{Lo: 0x0a59, Hi: 0x0a5c, Stride: 1},
+										{Lo: 0x0a5e, Hi: 0x0a5e, Stride: 1},
+										{Lo: 0x0a66, Hi: 0x0a75, Stride: 1},
+										{Lo: 0x0a81, Hi: 0x0a83, Stride: 1},
+										{Lo: 0x0a85, Hi: 0x0a8d, Stride: 1},
+										{Lo: 0x0a8f, Hi: 0x0a91, Stride: 1},
+										{Lo: 0x0a93, Hi: 0x0aa8, Stride: 1},
+										{Lo: 0x0aaa, Hi: 0x0ab0, Stride: 1},
+										{Lo: 0x0ab2, Hi: 0x0ab3, Stride: 1},
+										{Lo: 0x0ab5, Hi: 0x0ab9, Stride: 1},
+										{Lo: 0x0abc, Hi: 0x0ac5, Stride: 1},
+										{Lo: 0x0ac7, Hi: 0x0ac9, Stride: 1},
+										{Lo: 0x0acb, Hi: 0x0acd, Stride: 1},
+										{Lo: 0x0ad0, Hi: 0x0ad0, Stride: 1},
+										{Lo: 0x0ae0, Hi: 0x0ae3, Stride: 1},
+										{Lo: 0x0ae6, Hi: 0x0aef, Stride: 1},
+										{Lo: 0x0af9, Hi: 0x0aff, Stride: 1},
+										{Lo: 0x0b01, Hi: 0x0b03, Stride: 1},
+										{Lo: 0x0b05, Hi: 0x0b0c, Stride: 1},
+										{Lo: 0x0b0f, Hi: 0x0b10, Stride: 1},
+										{Lo: 0x0b13, Hi: 0x0b28, Stride: 1},
+										{Lo: 0x0b2a, Hi: 0x0b30, Stride: 1},
+										{Lo: 0x0b32, Hi: 0x0b33, Stride: 1},
+										{Lo: 0x0b35, Hi: 0x0b39, Stride: 1},
+										{Lo: 0x0b3c, Hi: 0x0b44, Stride: 1},
+										{Lo: 0x0b47, Hi: 0x0b48, Stride: 1},
+										{Lo: 0x0b4b, Hi: 0x0b4d, Stride: 1},
+										{Lo: 0x0b55, Hi: 0x0b57, Stride: 1},
+										{Lo: 0x0b5c, Hi: 0x0b5d, Stride: 1},
+										{Lo: 0x0b5f, Hi: 0x0b63, Stride: 1},
+										{Lo: 0x0b66, Hi: 0x0b6f, Stride: 1},
+										{Lo: 0x0b71, Hi: 0x0b71, Stride: 1},
+										{Lo: 0x0b82, Hi: 0x0b83, Stride: 1},
+										{Lo: 0x0b85, Hi: 0x0b8a, Stride: 1},
+										{Lo: 0x0b8e, Hi: 0x0b90, Stride: 1},
+										{Lo: 0x0b92, Hi: 0x0b95, Stride: 1},
+										{Lo: 0x0b99, Hi: 0x0b9a, Stride: 1},
+										{Lo: 0x0b9c, Hi: 0x0b9c, Stride: 1},
+										{Lo: 0x0b9e, Hi: 0x0b9f, Stride: 1},
+										{Lo: 0x0ba3, Hi: 0x0ba4, Stride: 1},
+										{Lo: 0x0ba8, Hi: 0x0baa, Stride: 1},
+										{Lo: 0x0bae, Hi: 0x0bb9, Stride: 1},
+										{Lo: 0x0bbe, Hi: 0x0bc2, Stride: 1},
+										{Lo: 0x0bc6, Hi: 0x0bc8, Stride: 1},
+										{Lo: 0x0bca, Hi: 0x0bcd, Stride: 1},
+										{Lo: 0x0bd0, Hi: 0x0bd0, Stride: 1},
+										{Lo: 0x0bd7, Hi: 0x0bd7, Stride: 1},
+										{Lo: 0x0be6, Hi: 0x0bef, Stride: 1},
+										{Lo: 0x0c00, Hi: 0x0c0c, Stride: 1},
+										{Lo: 0x0c0e, Hi: 0x0c10, Stride: 1},
+										{Lo: 0x0c12, Hi: 0x0c28, Stride: 1},
+										{Lo: 0x0c2a, Hi: 0x0c39, Stride: 1},
+										{Lo: 0x0c3c, Hi: 0x0c44, Stride: 1},
+										{Lo: 0x0c46, Hi: 0x0c48, Stride: 1},
+										{Lo: 0x0c4a, Hi: 0x0c4d, Stride: 1},
+										{Lo: 0x0c55, Hi: 0x0c56, Stride: 1},
+										{Lo: 0x0c58, Hi: 0x0c5a, Stride: 1},
+										{Lo: 0x0c5c, Hi: 0x0c5d, Stride: 1},
+										{Lo: 0x0c60, Hi: 0x0c63, Stride: 1},
+										{Lo: 0x0c66, Hi: 0x0c6f, Stride: 1},
+										{Lo: 0x0c80, Hi: 0x0c83, Stride: 1},
+										{Lo: 0x0c85, Hi: 0x0c8c, Stride: 1},
+										{Lo: 0x0c8e, Hi: 0x0c90, Stride: 1},
+										{Lo: 0x0c92, Hi: 0x0ca8, Stride: 1},
+										{Lo: 0x0caa, Hi: 0x0cb3, Stride: 1},
+										{Lo: 0x0cb5, Hi: 0x0cb9, Stride: 1},
+										{Lo: 0x0cbc, Hi: 0x0cc4, Stride: 1},
+										{Lo: 0x0cc6, Hi: 0x0cc8, Stride: 1},
+										{Lo: 0x0cca, Hi: 0x0ccd, Stride: 1},
+										{Lo: 0x0cd5, Hi: 0x0cd6, Stride: 1},
+										{Lo: 0x0cdc, Hi: 0x0cde, Stride: 1},
+										{Lo: 0x0ce0, Hi: 0x0ce3, Stride: 1},
+										{Lo: 0x0ce6, Hi: 0x0cef, Stride: 1},
+										{Lo: 0x0cf1, Hi: 0x0cf3, Stride: 1},
+										{Lo: 0x0d00, Hi: 0x0d0c, Stride: 1},
+										{Lo: 0x0d0e, Hi: 0x0d10, Stride: 1},
+										{Lo: 0x0d12, Hi: 0x0d44, Stride: 1},
+										{Lo: 0x0d46, Hi: 0x0d48, Stride: 1},
+										{Lo: 0x0d4a, Hi: 0x0d4e, Stride: 1},
+										{Lo: 0x0d54, Hi: 0x0d57, Stride: 1},
+										{Lo: 0x0d5f, Hi: 0x0d63, Stride: 1},
+										{Lo: 0x0d66, Hi: 0x0d6f, Stride: 1},
+										{Lo: 0x0d7a, Hi: 0x0d7f, Stride: 1},
+										{Lo: 0x0d81, Hi: 0x0d83, Stride: 1},
+										{Lo: 0x0d85, Hi: 0x0d96, Stride: 1},
+										{Lo: 0x0d9a, Hi: 0x0db1, Stride: 1},
+										{Lo: 0x0db3, Hi: 0x0dbb, Stride: 1},
+										{Lo: 0x0dbd, Hi: 0x0dbd, Stride: 1},
+										{Lo: 0x0dc0, Hi: 0x0dc6, Stride: 1},
+										{Lo: 0x0dca, Hi: 0x0dca, Stride: 1},
+										{Lo: 0x0dcf, Hi: 0x0dd4, Stride: 1},
+										{Lo: 0x0dd6, Hi: 0x0dd6, Stride: 1},
+										{Lo: 0x0dd8, Hi: 0x0ddf, Stride: 1},
+										{Lo: 0x0de6, Hi: 0x0def, Stride: 1},
+										{Lo: 0x0df2, Hi: 0x0df3, Stride: 1},
+										{Lo: 0x0e01, Hi: 0x0e3a, Stride: 1},
+										{Lo: 0x0e40, Hi: 0x0e4e, Stride: 1},
+										{Lo: 0x0e50, Hi: 0x0e59, Stride: 1},
+										{Lo: 0x0e81, Hi: 0x0e82, Stride: 1},
+										{Lo: 0x0e84, Hi: 0x0e84, Stride: 1},
+										{Lo: 0x0e86, Hi: 0x0e8a, Stride: 1},
+										{Lo: 0x0e8c, Hi: 0x0ea3, Stride: 1},
+										{Lo: 0x0ea5, Hi: 0x0ea5, Stride: 1},
+										{Lo: 0x0ea7, Hi: 0x0ebd, Stride: 1},
+										{Lo: 0x0ec0, Hi: 0x0ec4, Stride: 1},
+										{Lo: 0x0ec6, Hi: 0x0ec6, Stride: 1},
+										{Lo: 0x0ec8, Hi: 0x0ece, Stride: 1},
+										{Lo: 0x0ed0, Hi: 0x0ed9, Stride: 1},
+										{Lo: 0x0edc, Hi: 0x0edf, Stride: 1},
+										{Lo: 0x0f00, Hi: 0x0f00, Stride: 1},
+										{Lo: 0x0f18, Hi: 0x0f19, Stride: 1},
+										{Lo: 0x0f20, Hi: 0x0f29, Stride: 1},
+										{Lo: 0x0f35, Hi: 0x0f35, Stride: 1},
+										{Lo: 0x0f37, Hi: 0x0f37, Stride: 1},
+										{Lo: 0x0f39, Hi: 0x0f39, Stride: 1},
+										{Lo: 0x0f3e, Hi: 0x0f47, Stride: 1},
+										{Lo: 0x0f49, Hi: 0x0f6c, Stride: 1},
+										{Lo: 0x0f71, Hi: 0x0f84, Stride: 1},
+										{Lo: 0x0f86, Hi: 0x0f97, Stride: 1},
+										{Lo: 0x0f99, Hi: 0x0fbc, Stride: 1},
+										{Lo: 0x0fc6, Hi: 0x0fc6, Stride: 1},
+										{Lo: 0x1000, Hi: 0x1049, Stride: 1},
+										{Lo: 0x1050, Hi: 0x109d, Stride: 1},
+										{Lo: 0x10a0, Hi: 0x10c5, Stride: 1},
+										{Lo: 0x10c7, Hi: 0x10c7, Stride: 1},
+										{Lo: 0x10cd, Hi: 0x10cd, Stride: 1},
+										{Lo: 0x10d0, Hi: 0x10fa, Stride: 1},
+										{Lo: 0x10fc, Hi: 0x1248, Stride: 1},
+										{Lo: 0x124a, Hi: 0x124d, Stride: 1},
+										{Lo: 0x1250, Hi: 0x1256, Stride: 1},
+										{Lo: 0x1258, Hi: 0x1258, Stride: 1},
+										{Lo: 0x125a, Hi: 0x125d, Stride: 1},
+										{Lo: 0x1260, Hi: 0x1288, Stride: 1},
+										{Lo: 0x128a, Hi: 0x128d, Stride: 1},
+										{Lo: 0x1290, Hi: 0x12b0, Stride: 1},
+										{Lo: 0x12b2, Hi: 0x12b5, Stride: 1},
+										{Lo: 0x12b8, Hi: 0x12be, Stride: 1},
+										{Lo: 0x12c0, Hi: 0x12c0, Stride: 1},
+										{Lo: 0x12c2, Hi: 0x12c5, Stride: 1},
+										{Lo: 0x12c8, Hi: 0x12d6, Stride: 1},
+										{Lo: 0x12d8, Hi: 0x1310, Stride: 1},
+										{Lo: 0x1312, Hi: 0x1315, Stride: 1},
+										{Lo: 0x1318, Hi: 0x135a, Stride: 1},
+										{Lo: 0x135d, Hi: 0x135f, Stride: 1},
+										{Lo: 0x1369, Hi: 0x1371, Stride: 1},
+										{Lo: 0x1380, Hi: 0x138f, Stride: 1},
+										{Lo: 0x13a0, Hi: 0x13f5, Stride: 1},
+										{Lo: 0x13f8, Hi: 0x13fd, Stride: 1},
+										{Lo: 0x1401, Hi: 0x166c, Stride: 1},
+										{Lo: 0x166f, Hi: 0x167f, Stride: 1},
+										{Lo: 0x1681, Hi: 0x169a, Stride: 1},
+										{Lo: 0x16a0, Hi: 0x16ea, Stride: 1},
+										{Lo: 0x16ee, Hi: 0x16f8, Stride: 1},
+										{Lo: 0x1700, Hi: 0x1715, Stride: 1},
+										{Lo: 0x171f, Hi: 0x1734, Stride: 1},
+										{Lo: 0x1740, Hi: 0x1753, Stride: 1},
+										{Lo: 0x1760, Hi: 0x176c, Stride: 1},
+										{Lo: 0x176e, Hi: 0x1770, Stride: 1},
+										{Lo: 0x1772, Hi: 0x1773, Stride: 1},
+										{Lo: 0x1780, Hi: 0x17d3, Stride: 1},
+										{Lo: 0x17d7, Hi: 0x17d7, Stride: 1},
+										{Lo: 0x17dc, Hi: 0x17dd, Stride: 1},
+										{Lo: 0x17e0, Hi: 0x17e9, Stride: 1},
+										{Lo: 0x180b, Hi: 0x180d, Stride: 1},
+										{Lo: 0x180f, Hi: 0x1819, Stride: 1},
+										{Lo: 0x1820, Hi: 0x1878, Stride: 1},
+										{Lo: 0x1880, Hi: 0x18aa, Stride: 1},
+										{Lo: 0x18b0, Hi: 0x18f5, Stride: 1},
+										{Lo: 0x1900, Hi: 0x191e, Stride: 1},
+										{Lo: 0x1920, Hi: 0x192b, Stride: 1},
+										{Lo: 0x1930, Hi: 0x193b, Stride: 1},
+										{Lo: 0x1946, Hi: 0x196d, Stride: 1},
+										{Lo: 0x1970, Hi: 0x1974, Stride: 1},
+										{Lo: 0x1980, Hi: 0x19ab, Stride: 1},
+										{Lo: 0x19b0, Hi: 0x19c9, Stride: 1},
+										{Lo: 0x19d0, Hi: 0x19da, Stride: 1},
+										{Lo: 0x1a00, Hi: 0x1a1b, Stride: 1},
+										{Lo: 0x1a20, Hi: 0x1a5e, Stride: 1},
+										{Lo: 0x1a60, Hi: 0x1a7c, Stride: 1},
+										{Lo: 0x1a7f, Hi: 0x1a89, Stride: 1},
+										{Lo: 0x1a90, Hi: 0x1a99, Stride: 1},
+										{Lo: 0x1aa7, Hi: 0x1aa7, Stride: 1},
+										{Lo: 0x1ab0, Hi: 0x1abd, Stride: 1},
+										{Lo: 0x1abf, Hi: 0x1add, Stride: 1},
+										{Lo: 0x1ae0, Hi: 0x1aeb, Stride: 1},
+										{Lo: 0x1b00, Hi: 0x1b4c, Stride: 1},
+										{Lo: 0x1b50, Hi: 0x1b59, Stride: 1},
+										{Lo: 0x1b6b, Hi: 0x1b73, Stride: 1},
+										{Lo: 0x1b80, Hi: 0x1bf3, Stride: 1},
+										{Lo: 0x1c00, Hi: 0x1c37, Stride: 1},
+										{Lo: 0x1c40, Hi: 0x1c49, Stride: 1},
+										{Lo: 0x1c4d, Hi: 0x1c7d, Stride: 1},
+										{Lo: 0x1c80, Hi: 0x1c8a, Stride: 1},
+										{Lo: 0x1c90, Hi: 0x1cba, Stride: 1},
+										{Lo: 0x1cbd, Hi: 0x1cbf, Stride: 1},
+										{Lo: 0x1cd0, Hi: 0x1cd2, Stride: 1},
+										{Lo: 0x1cd4, Hi: 0x1cfa, Stride: 1},
+										{Lo: 0x1d00, Hi: 0x1f15, Stride: 1},
+										{Lo: 0x1f18, Hi: 0x1f1d, Stride: 1},
+										{Lo: 0x1f20, Hi: 0x1f45, Stride: 1},
+										{Lo: 0x1f48, Hi: 0x1f4d, Stride: 1},
+										{Lo: 0x1f50, Hi: 0x1f57, Stride: 1},
+										{Lo: 0x1f59, Hi: 0x1f59, Stride: 1},
+										{Lo: 0x1f5b, Hi: 0x1f5b, Stride: 1},
+										{Lo: 0x1f5d, Hi: 0x1f5d, Stride: 1},
+										{Lo: 0x1f5f, Hi: 0x1f7d, Stride: 1},
+										{Lo: 0x1f80, Hi: 0x1fb4, Stride: 1},
+										{Lo: 0x1fb6, Hi: 0x1fbc, Stride: 1},
+										{Lo: 0x1fbe, Hi: 0x1fbe, Stride: 1},
+										{Lo: 0x1fc2, Hi: 0x1fc4, Stride: 1},
+										{Lo: 0x1fc6, Hi: 0x1fcc, Stride: 1},
+										{Lo: 0x1fd0, Hi: 0x1fd3, Stride: 1},
+										{Lo: 0x1fd6, Hi: 0x1fdb, Stride: 1},
+										{Lo: 0x1fe0, Hi: 0x1fec, Stride: 1},
+										{Lo: 0x1ff2, Hi: 0x1ff4, Stride: 1},
+										{Lo: 0x1ff6, Hi: 0x1ffc, Stride: 1},
+										{Lo: 0x200c, Hi: 0x200d, Stride: 1},
+										{Lo: 0x203f, Hi: 0x2040, Stride: 1},
+										{Lo: 0x2054, Hi: 0x2054, Stride: 1},
+										{Lo: 0x2071, Hi: 0x2071, Stride: 1},
+										{Lo: 0x207f, Hi: 0x207f, Stride: 1},
+										{Lo: 0x2090, Hi: 0x209c, Stride: 1},
+										{Lo: 0x20d0, Hi: 0x20dc, Stride: 1},
+										{Lo: 0x20e1, Hi: 0x20e1, Stride: 1},
+										{Lo: 0x20e5, Hi: 0x20f0, Stride: 1},
+										{Lo: 0x2102, Hi: 0x2102, Stride: 1},
+										{Lo: 0x2107, Hi: 0x2107, Stride: 1},
+										{Lo: 0x210a, Hi: 0x2113, Stride: 1},
+										{Lo: 0x2115, Hi: 0x2115, Stride: 1},
+										{Lo: 0x2118, Hi: 0x211d, Stride: 1},
+										{Lo: 0x2124, Hi: 0x2124, Stride: 1},
+										{Lo: 0x2126, Hi: 0x2126, Stride: 1},
+										{Lo: 0x2128, Hi: 0x2128, Stride: 1},
+										{Lo: 0x212a, Hi: 0x2139, Stride: 1},
+										{Lo: 0x213c, Hi: 0x213f, Stride: 1},
+										{Lo: 0x2145, Hi: 0x2149, Stride: 1},
+										{Lo: 0x214e, Hi: 0x214e, Stride: 1},
+										{Lo: 0x2160, Hi: 0x2188, Stride: 1},
+										{Lo: 0x2c00, Hi: 0x2ce4, Stride: 1},
+										{Lo: 0x2ceb, Hi: 0x2cf3, Stride: 1},
+										{Lo: 0x2d00, Hi: 0x2d25, Stride: 1},
+										{Lo: 0x2d27, Hi: 0x2d27, Stride: 1},
+										{Lo: 0x2d2d, Hi: 0x2d2d, Stride: 1},
+										{Lo: 0x2d30, Hi: 0x2d67, Stride: 1},
+										{Lo: 0x2d6f, Hi: 0x2d6f, Stride: 1},
+										{Lo: 0x2d7f, Hi: 0x2d96, Stride: 1},
+										{Lo: 0x2da0, Hi: 0x2da6, Stride: 1},
+										{Lo: 0x2da8, Hi: 0x2dae, Stride: 1},
+										{Lo: 0x2db0, Hi: 0x2db6, Stride: 1},
+										{Lo: 0x2db8, Hi: 0x2dbe, Stride: 1},
+										{Lo: 0x2dc0, Hi: 0x2dc6, Stride: 1},
+										{Lo: 0x2dc8, Hi: 0x2dce, Stride: 1},
+										{Lo: 0x2dd0, Hi: 0x2dd6, Stride: 1},
+										{Lo: 0x2dd8, Hi: 0x2dde, Stride: 1},
+										{Lo: 0x2de0, Hi: 0x2dff, Stride: 1},
+										{Lo: 0x3005, Hi: 0x3007, Stride: 1},
+										{Lo: 0x3021, Hi: 0x302f, Stride: 1},
+										{Lo: 0x3031, Hi: 0x3035, Stride: 1},
+										{Lo: 0x3038, Hi: 0x303c, Stride: 1},
+										{Lo: 0x3041, Hi: 0x3096, Stride: 1},
+										{Lo: 0x3099, Hi: 0x309a, Stride: 1},
+										{Lo: 0x309d, Hi: 0x309f, Stride: 1},
+										{Lo: 0x30a1, Hi: 0x30ff, Stride: 1},
+										{Lo: 0x3105, Hi: 0x312f, Stride: 1},
+										{Lo: 0x3131, Hi: 0x318e, Stride: 1},
+										{Lo: 0x31a0, Hi: 0x31bf, Stride: 1},
+										{Lo: 0x31f0, Hi: 0x31ff, Stride: 1},
+										{Lo: 0x3400, Hi: 0x4dbf, Stride: 1},
+										{Lo: 0x4e00, Hi: 0xa48c, Stride: 1},
+										{Lo: 0xa4d0, Hi: 0xa4fd, Stride: 1},
+										{Lo: 0xa500, Hi: 0xa60c, Stride: 1},
+										{Lo: 0xa610, Hi: 0xa62b, Stride: 1},
+										{Lo: 0xa640, Hi: 0xa66f, Stride: 1},
+										{Lo: 0xa674, Hi: 0xa67d, Stride: 1},
+										{Lo: 0xa67f, Hi: 0xa6f1, Stride: 1},
+										{Lo: 0xa717, Hi: 0xa71f, Stride: 1},
+										{Lo: 0xa722, Hi: 0xa788, Stride: 1},
+										{Lo: 0xa78b, Hi: 0xa7dc, Stride: 1},
+										{Lo: 0xa7f1, Hi: 0xa827, Stride: 1},
+										{Lo: 0xa82c, Hi: 0xa82c, Stride: 1},
+										{Lo: 0xa840, Hi: 0xa873, Stride: 1},
+										{Lo: 0xa880, Hi: 0xa8c5, Stride: 1},
+										{Lo: 0xa8d0, Hi: 0xa8d9, Stride: 1},
+										{Lo: 0xa8e0, Hi: 0xa8f7, Stride: 1},
+										{Lo: 0xa8fb, Hi: 0xa8fb, Stride: 1},
+										{Lo: 0xa8fd, Hi: 0xa92d, Stride: 1},
+										{Lo: 0xa930, Hi: 0xa953, Stride: 1},
+										{Lo: 0xa960, Hi: 0xa97c, Stride: 1},
+										{Lo: 0xa980, Hi: 0xa9c0, Stride: 1},
+										{Lo: 0xa9cf, Hi: 0xa9d9, Stride: 1},
+										{Lo: 0xa9e0, Hi: 0xa9fe, Stride: 1},
+										{Lo: 0xaa00, Hi: 0xaa36, Stride: 1},
+										{Lo: 0xaa40, Hi: 0xaa4d, Stride: 1},
+										{Lo: 0xaa50, Hi: 0xaa59, Stride: 1},
+										{Lo: 0xaa60, Hi: 0xaa76, Stride: 1},
+										{Lo: 0xaa7a, Hi: 0xaac2, Stride: 1},
+										{Lo: 0xaadb, Hi: 0xaadd, Stride: 1},
+										{Lo: 0xaae0, Hi: 0xaaef, Stride: 1},
+										{Lo: 0xaaf2, Hi: 0xaaf6, Stride: 1},
+										{Lo: 0xab01, Hi: 0xab06, Stride: 1},
+										{Lo: 0xab09, Hi: 0xab0e, Stride: 1},
+										{Lo: 0xab11, Hi: 0xab16, Stride: 1},
+										{Lo: 0xab20, Hi: 0xab26, Stride: 1},
+										{Lo: 0xab28, Hi: 0xab2e, Stride: 1},
+										{Lo: 0xab30, Hi: 0xab5a, Stride: 1},
+										{Lo: 0xab5c, Hi: 0xab69, Stride: 1},
+										{Lo: 0xab70, Hi: 0xabea, Stride: 1},
+										{Lo: 0xabec, Hi: 0xabed, Stride: 1},
+										{Lo: 0xabf0, Hi: 0xabf9, Stride: 1},
+										{Lo: 0xac00, Hi: 0xd7a3, Stride: 1},
+										{Lo: 0xd7b0, Hi: 0xd7c6, Stride: 1},
+										{Lo: 0xd7cb, Hi: 0xd7fb, Stride: 1},
+										{Lo: 0xf900, Hi: 0xfa6d, Stride: 1},
+										{Lo: 0xfa70, Hi: 0xfad9, Stride: 1},
+										{Lo: 0xfb00, Hi: 0xfb06, Stride: 1},
+										{Lo: 0xfb13, Hi: 0xfb17, Stride: 1},
+										{Lo: 0xfb1d, Hi: 0xfb28, Stride: 1},
+										{Lo: 0xfb2a, Hi: 0xfb36, Stride: 1},
+										{Lo: 0xfb38, Hi: 0xfb3c, Stride: 1},
+										{Lo: 0xfb3e, Hi: 0xfb3e, Stride: 1},
+										{Lo: 0xfb40, Hi: 0xfb41, Stride: 1},
+										{Lo: 0xfb43, Hi: 0xfb44, Stride: 1},
+										{Lo: 0xfb46, Hi: 0xfbb1, Stride: 1},
+										{Lo: 0xfbd3, Hi: 0xfc5d, Stride: 1},
+										{Lo: 0xfc64, Hi: 0xfd3d, Stride: 1},
+										{Lo: 0xfd50, Hi: 0xfd8f, Stride: 1},
+										{Lo: 0xfd92, Hi: 0xfdc7, Stride: 1},
+										{Lo: 0xfdf0, Hi: 0xfdf9, Stride: 1},
+										{Lo: 0xfe00, Hi: 0xfe0f, Stride: 1},
+										{Lo: 0xfe20, Hi: 0xfe2f, Stride: 1},
+										{Lo: 0xfe33, Hi: 0xfe34, Stride: 1},
+										{Lo: 0xfe4d, Hi: 0xfe4f, Stride: 1},
+										{Lo: 0xfe71, Hi: 0xfe71, Stride: 1},
+										{Lo: 0xfe73, Hi: 0xfe73, Stride: 1},
+										{Lo: 0xfe77, Hi: 0xfe77, Stride: 1},
+										{Lo: 0xfe79, Hi: 0xfe79, Stride: 1},
+										{Lo: 0xfe7b, Hi: 0xfe7b, Stride: 1},
+										{Lo: 0xfe7d, Hi: 0xfe7d, Stride: 1},
+										{Lo: 0xfe7f, Hi: 0xfefc, Stride: 1},
+										{Lo: 0xff10, Hi: 0xff19, Stride: 1},
+										{Lo: 0xff21, Hi: 0xff3a, Stride: 1},
+										{Lo: 0xff3f, Hi: 0xff3f, Stride: 1},
+										{Lo: 0xff41, Hi: 0xff5a, Stride: 1},
+										{Lo: 0xff65, Hi: 0xffbe, Stride: 1},
+										{Lo: 0xffc2, Hi: 0xffc7, Stride: 1},
+										{Lo: 0xffca, Hi: 0xffcf, Stride: 1},
+										{Lo: 0xffd2, Hi: 0xffd7, Stride: 1},
+										{Lo: 0xffda, Hi: 0xffdc, Stride: 1},
+									},
+									R32: []unicode.Range32{
+										{Lo: 0x10000, Hi: 0x1000b, Stride: 1},
+										{Lo: 0x1000d, Hi: 0x10026, Stride: 1},
+										{Lo: 0x10028, Hi: 0x1003a, Stride: 1},
+										{Lo: 0x1003c, Hi: 0x1003d, Stride: 1},
+										{Lo: 0x1003f, Hi: 0x1004d, Stride: 1},
+										{Lo: 0x10050, Hi: 0x1005d, Stride: 1},
+										{Lo: 0x10080, Hi: 0x100fa, Stride: 1},
+										{Lo: 0x10140, Hi: 0x10174, Stride: 1},
+										{Lo: 0x101fd, Hi: 0x101fd, Stride: 1},
+										{Lo: 0x10280, Hi: 0x1029c, Stride: 1},
+										{Lo: 0x102a0, Hi: 0x102d0, Stride: 1},
+										{Lo: 0x102e0, Hi: 0x102e0, Stride: 1},
+										{Lo: 0x10300, Hi: 0x1031f, Stride: 1},
+										{Lo: 0x1032d, Hi: 0x1034a, Stride: 1},
+										{Lo: 0x10350, Hi: 0x1037a, Stride: 1},
+										{Lo: 0x10380, Hi: 0x1039d, Stride: 1},
+										{Lo: 0x103a0, Hi: 0x103c3, Stride: 1},
+										{Lo: 0x103c8, Hi: 0x103cf, Stride: 1},
+										{Lo: 0x103d1, Hi: 0x103d5, Stride: 1},
+										{Lo: 0x10400, Hi: 0x1049d, Stride: 1},
+										{Lo: 0x104a0, Hi: 0x104a9, Stride: 1},
+										{Lo: 0x104b0, Hi: 0x104d3, Stride: 1},
+										{Lo: 0x104d8, Hi: 0x104fb, Stride: 1},
+										{Lo: 0x10500, Hi: 0x10527, Stride: 1},
+										{Lo: 0x10530, Hi: 0x10563, Stride: 1},
+										{Lo: 0x10570, Hi: 0x1057a, Stride: 1},
+										{Lo: 0x1057c, Hi: 0x1058a, Stride: 1},
+										{Lo: 0x1058c, Hi: 0x10592, Stride: 1},
+										{Lo: 0x10594, Hi: 0x10595, Stride: 1},
+										{Lo: 0x10597, Hi: 0x105a1, Stride: 1},
+										{Lo: 0x105a3, Hi: 0x105b1, Stride: 1},
+										{Lo: 0x105b3, Hi: 0x105b9, Stride: 1},
+										{Lo: 0x105bb, Hi: 0x105bc, Stride: 1},
+										{Lo: 0x105c0, Hi: 0x105f3, Stride: 1},
+										{Lo: 0x10600, Hi: 0x10736, Stride: 1},
+										{Lo: 0x10740, Hi: 0x10755, Stride: 1},
+										{Lo: 0x10760, Hi: 0x10767, Stride: 1},
+										{Lo: 0x10780, Hi: 0x10785, Stride: 1},
+										{Lo: 0x10787, Hi: 0x107b0, Stride: 1},
+										{Lo: 0x107b2, Hi: 0x107ba, Stride: 1},
+										{Lo: 0x10800, Hi: 0x10805, Stride: 1},
+										{Lo: 0x10808, Hi: 0x10808, Stride: 1},
+										{Lo: 0x1080a, Hi: 0x10835, Stride: 1},
+										{Lo: 0x10837, Hi: 0x10838, Stride: 1},
+										{Lo: 0x1083c, Hi: 0x1083c, Stride: 1},
+										{Lo: 0x1083f, Hi: 0x10855, Stride: 1},
+										{Lo: 0x10860, Hi: 0x10876, Stride: 1},
+										{Lo: 0x10880, Hi: 0x1089e, Stride: 1},
+										{Lo: 0x108e0, Hi: 0x108f2, Stride: 1},
+										{Lo: 0x108f4, Hi: 0x108f5, Stride: 1},
+										{Lo: 0x10900, Hi: 0x10915, Stride: 1},
+										{Lo: 0x10920, Hi: 0x10939, Stride: 1},
+										{Lo: 0x10940, Hi: 0x10959, Stride: 1},
+										{Lo: 0x10980, Hi: 0x109b7, Stride: 1},
+										{Lo: 0x109be, Hi: 0x109bf, Stride: 1},
+										{Lo: 0x10a00, Hi: 0x10a03, Stride: 1},
+										{Lo: 0x10a05, Hi: 0x10a06, Stride: 1},
+										{Lo: 0x10a0c, Hi: 0x10a13, Stride: 1},
+										{Lo: 0x10a15, Hi: 0x10a17, Stride: 1},
+										{Lo: 0x10a19, Hi: 0x10a35, Stride: 1},
+										{Lo: 0x10a38, Hi: 0x10a3a, Stride: 1},
+										{Lo: 0x10a3f, Hi: 0x10a3f, Stride: 1},
+										{Lo: 0x10a60, Hi: 0x10a7c, Stride: 1},
+										{Lo: 0x10a80, Hi: 0x10a9c, Stride: 1},
+										{Lo: 0x10ac0, Hi: 0x10ac7, Stride: 1},
+										{Lo: 0x10ac9, Hi: 0x10ae6, Stride: 1},
+										{Lo: 0x10b00, Hi: 0x10b35, Stride: 1},
+										{Lo: 0x10b40, Hi: 0x10b55, Stride: 1},
+										{Lo: 0x10b60, Hi: 0x10b72, Stride: 1},
+										{Lo: 0x10b80, Hi: 0x10b91, Stride: 1},
+										{Lo: 0x10c00, Hi: 0x10c48, Stride: 1},
+										{Lo: 0x10c80, Hi: 0x10cb2, Stride: 1},
+										{Lo: 0x10cc0, Hi: 0x10cf2, Stride: 1},
+										{Lo: 0x10d00, Hi: 0x10d27, Stride: 1},
+										{Lo: 0x10d30, Hi: 0x10d39, Stride: 1},
+										{Lo: 0x10d40, Hi: 0x10d65, Stride: 1},
+										{Lo: 0x10d69, Hi: 0x10d6d, Stride: 1},
+										{Lo: 0x10d6f, Hi: 0x10d85, Stride: 1},
+										{Lo: 0x10e80, Hi: 0x10ea9, Stride: 1},
+										{Lo: 0x10eab, Hi: 0x10eac, Stride: 1},
+										{Lo: 0x10eb0, Hi: 0x10eb1, Stride: 1},
+										{Lo: 0x10ec2, Hi: 0x10ec7, Stride: 1},
+										{Lo: 0x10efa, Hi: 0x10f1c, Stride: 1},
+										{Lo: 0x10f27, Hi: 0x10f27, Stride: 1},
+										{Lo: 0x10f30, Hi: 0x10f50, Stride: 1},
+										{Lo: 0x10f70, Hi: 0x10f85, Stride: 1},
+										{Lo: 0x10fb0, Hi: 0x10fc4, Stride: 1},
+										{Lo: 0x10fe0, Hi: 0x10ff6, Stride: 1},
+										{Lo: 0x11000, Hi: 0x11046, Stride: 1},
+										{Lo: 0x11066, Hi: 0x11075, Stride: 1},
+										{Lo: 0x1107f, Hi: 0x110ba, Stride: 1},
+										{Lo: 0x110c2, Hi: 0x110c2, Stride: 1},
+										{Lo: 0x110d0, Hi: 0x110e8, Stride: 1},
+										{Lo: 0x110f0, Hi: 0x110f9, Stride: 1},
+										{Lo: 0x11100, Hi: 0x11134, Stride: 1},
+										{Lo: 0x11136, Hi: 0x1113f, Stride: 1},
+										{Lo: 0x11144, Hi: 0x11147, Stride: 1},
+										{Lo: 0x11150, Hi: 0x11173, Stride: 1},
+										{Lo: 0x11176, Hi: 0x11176, Stride: 1},
+										{Lo: 0x11180, Hi: 0x111c4, Stride: 1},
+										{Lo: 0x111c9, Hi: 0x111cc, Stride: 1},
+										{Lo: 0x111ce, Hi: 0x111da, Stride: 1},
+										{Lo: 0x111dc, Hi: 0x111dc, Stride: 1},
+										{Lo: 0x11200, Hi: 0x11211, Stride: 1},
+										{Lo: 0x11213, Hi: 0x11237, Stride: 1},
+										{Lo: 0x1123e, Hi: 0x11241, Stride: 1},
+										{Lo: 0x11280, Hi: 0x11286, Stride: 1},
+										{Lo: 0x11288, Hi: 0x11288, Stride: 1},
+										{Lo: 0x1128a, Hi: 0x1128d, Stride: 1},
+										{Lo: 0x1128f, Hi: 0x1129d, Stride: 1},
+										{Lo: 0x1129f, Hi: 0x112a8, Stride: 1},
+										{Lo: 0x112b0, Hi: 0x112ea, Stride: 1},
+										{Lo: 0x112f0, Hi: 0x112f9, Stride: 1},
+										{Lo: 0x11300, Hi: 0x11303, Stride: 1},
+										{Lo: 0x11305, Hi: 0x1130c, Stride: 1},
+										{Lo: 0x1130f, Hi: 0x11310, Stride: 1},
+										{Lo: 0x11313, Hi: 0x11328, Stride: 1},
+										{Lo: 0x1132a, Hi: 0x11330, Stride: 1},
+										{Lo: 0x11332, Hi: 0x11333, Stride: 1},
+										{Lo: 0x11335, Hi: 0x11339, Stride: 1},
+										{Lo: 0x1133b, Hi: 0x11344, Stride: 1},
+										{Lo: 0x11347, Hi: 0x11348, Stride: 1},
+										{Lo: 0x1134b, Hi: 0x1134d, Stride: 1},
+										{Lo: 0x11350, Hi: 0x11350, Stride: 1},
+										{Lo: 0x11357, Hi: 0x11357, Stride: 1},
+										{Lo: 0x1135d, Hi: 0x11363, Stride: 1},
+										{Lo: 0x11366, Hi: 0x1136c, Stride: 1},
+										{Lo: 0x11370, Hi: 0x11374, Stride: 1},
+										{Lo: 0x11380, Hi: 0x11389, Stride: 1},
+										{Lo: 0x1138b, Hi: 0x1138b, Stride: 1},
+										{Lo: 0x1138e, Hi: 0x1138e, Stride: 1},
+										{Lo: 0x11390, Hi: 0x113b5, Stride: 1},
+										{Lo: 0x113b7, Hi: 0x113c0, Stride: 1},
+										{Lo: 0x113c2, Hi: 0x113c2, Stride: 1},
+										{Lo: 0x113c5, Hi: 0x113c5, Stride: 1},
+										{Lo: 0x113c7, Hi: 0x113ca, Stride: 1},
+										{Lo: 0x113cc, Hi: 0x113d3, Stride: 1},
+										{Lo: 0x113e1, Hi: 0x113e2, Stride: 1},
+										{Lo: 0x11400, Hi: 0x1144a, Stride: 1},
+										{Lo: 0x11450, Hi: 0x11459, Stride: 1},
+										{Lo: 0x1145e, Hi: 0x11461, Stride: 1},
+										{Lo: 0x11480, Hi: 0x114c5, Stride: 1},
+										{Lo: 0x114c7, Hi: 0x114c7, Stride: 1},
+										{Lo: 0x114d0, Hi: 0x114d9, Stride: 1},
+										{Lo: 0x11580, Hi: 0x115b5, Stride: 1},
+										{Lo: 0x115b8, Hi: 0x115c0, Stride: 1},
+										{Lo: 0x115d8, Hi: 0x115dd, Stride: 1},
+										{Lo: 0x11600, Hi: 0x11640, Stride: 1},
+										{Lo: 0x11644, Hi: 0x11644, Stride: 1},
+										{Lo: 0x11650, Hi: 0x11659, Stride: 1},
+										{Lo: 0x11680, Hi: 0x116b8, Stride: 1},
+										{Lo: 0x116c0, Hi: 0x116c9, Stride: 1},
+										{Lo: 0x116d0, Hi: 0x116e3, Stride: 1},
+										{Lo: 0x11700, Hi: 0x1171a, Stride: 1},
+										{Lo: 0x1171d, Hi: 0x1172b, Stride: 1},
+										{Lo: 0x11730, Hi: 0x11739, Stride: 1},
+										{Lo: 0x11740, Hi: 0x11746, Stride: 1},
+										{Lo: 0x11800, Hi: 0x1183a, Stride: 1},
+										{Lo: 0x118a0, Hi: 0x118e9, Stride: 1},
+										{Lo: 0x118ff, Hi: 0x11906, Stride: 1},
+										{Lo: 0x11909, Hi: 0x11909, Stride: 1},
+										{Lo: 0x1190c, Hi: 0x11913, Stride: 1},
+										{Lo: 0x11915, Hi: 0x11916, Stride: 1},
+										{Lo: 0x11918, Hi: 0x11935, Stride: 1},
+										{Lo: 0x11937, Hi: 0x11938, Stride: 1},
+										{Lo: 0x1193b, Hi: 0x11943, Stride: 1},
+										{Lo: 0x11950, Hi: 0x11959, Stride: 1},
+										{Lo: 0x119a0, Hi: 0x119a7, Stride: 1},
+										{Lo: 0x119aa, Hi: 0x119d7, Stride: 1},
+										{Lo: 0x119da, Hi: 0x119e1, Stride: 1},
+										{Lo: 0x119e3, Hi: 0x119e4, Stride: 1},
+										{Lo: 0x11a00, Hi: 0x11a3e, Stride: 1},
+										{Lo: 0x11a47, Hi: 0x11a47, Stride: 1},
+										{Lo: 0x11a50, Hi: 0x11a99, Stride: 1},
+										{Lo: 0x11a9d, Hi: 0x11a9d, Stride: 1},
+										{Lo: 0x11ab0, Hi: 0x11af8, Stride: 1},
+										{Lo: 0x11b60, Hi: 0x11b67, Stride: 1},
+										{Lo: 0x11bc0, Hi: 0x11be0, Stride: 1},
+										{Lo: 0x11bf0, Hi: 0x11bf9, Stride: 1},
+										{Lo: 0x11c00, Hi: 0x11c08, Stride: 1},
+										{Lo: 0x11c0a, Hi: 0x11c36, Stride: 1},
+										{Lo: 0x11c38, Hi: 0x11c40, Stride: 1},
+										{Lo: 0x11c50, Hi: 0x11c59, Stride: 1},
+										{Lo: 0x11c72, Hi: 0x11c8f, Stride: 1},
+										{Lo: 0x11c92, Hi: 0x11ca7, Stride: 1},
+										{Lo: 0x11ca9, Hi: 0x11cb6, Stride: 1},
+										{Lo: 0x11d00, Hi: 0x11d06, Stride: 1},
+										{Lo: 0x11d08, Hi: 0x11d09, Stride: 1},
+										{Lo: 0x11d0b, Hi: 0x11d36, Stride: 1},
+										{Lo: 0x11d3a, Hi: 0x11d3a, Stride: 1},
+										{Lo: 0x11d3c, Hi: 0x11d3d, Stride: 1},
+										{Lo: 0x11d3f, Hi: 0x11d47, Stride: 1},
+										{Lo: 0x11d50, Hi: 0x11d59, Stride: 1},
+										{Lo: 0x11d60, Hi: 0x11d65, Stride: 1},
+										{Lo: 0x11d67, Hi: 0x11d68, Stride: 1},
+										{Lo: 0x11d6a, Hi: 0x11d8e, Stride: 1},
+										{Lo: 0x11d90, Hi: 0x11d91, Stride: 1},
+										{Lo: 0x11d93, Hi: 0x11d98, Stride: 1},
+										{Lo: 0x11da0, Hi: 0x11da9, Stride: 1},
+										{Lo: 0x11db0, Hi: 0x11ddb, Stride: 1},
+										{Lo: 0x11de0, Hi: 0x11de9, Stride: 1},
+										{Lo: 0x11ee0, Hi: 0x11ef6, Stride: 1},
+										{Lo: 0x11f00, Hi: 0x11f10, Stride: 1},
+										{Lo: 0x11f12, Hi: 0x11f3a, Stride: 1},
+										{Lo: 0x11f3e, Hi: 0x11f42, Stride: 1},
+										{Lo: 0x11f50, Hi: 0x11f5a, Stride: 1},
+										{Lo: 0x11fb0, Hi: 0x11fb0, Stride: 1},
+										{Lo: 0x12000, Hi: 0x12399, Stride: 1},
+										{Lo: 0x12400, Hi: 0x1246e, Stride: 1},
+										{Lo: 0x12480, Hi: 0x12543, Stride: 1},
+										{Lo: 0x12f90, Hi: 0x12ff0, Stride: 1},
+										{Lo: 0x13000, Hi: 0x1342f, Stride: 1},
+										{Lo: 0x13440, Hi: 0x13455, Stride: 1},
+										{Lo: 0x13460, Hi: 0x143fa, Stride: 1},
+										{Lo: 0x14400, Hi: 0x14646, Stride: 1},
+										{Lo: 0x16100, Hi: 0x16139, Stride: 1},
+										{Lo: 0x16800, Hi: 0x16a38, Stride: 1},
+										{Lo: 0x16a40, Hi: 0x16a5e, Stride: 1},
+										{Lo: 0x16a60, Hi: 0x16a69, Stride: 1},
+										{Lo: 0x16a70, Hi: 0x16abe, Stride: 1},
+										{Lo: 0x16ac0, Hi: 0x16ac9, Stride: 1},
+										{Lo: 0x16ad0, Hi: 0x16aed, Stride: 1},
+										{Lo: 0x16af0, Hi: 0x16af4, Stride: 1},
+										{Lo: 0x16b00, Hi: 0x16b36, Stride: 1},
+										{Lo: 0x16b40, Hi: 0x16b43, Stride: 1},
+										{Lo: 0x16b50, Hi: 0x16b59, Stride: 1},
+										{Lo: 0x16b63, Hi: 0x16b77, Stride: 1},
+										{Lo: 0x16b7d, Hi: 0x16b8f, Stride: 1},
+										{Lo: 0x16d40, Hi: 0x16d6c, Stride: 1},
+										{Lo: 0x16d70, Hi: 0x16d79, Stride: 1},
+										{Lo: 0x16e40, Hi: 0x16e7f, Stride: 1},
+										{Lo: 0x16ea0, Hi: 0x16eb8, Stride: 1},
+										{Lo: 0x16ebb, Hi: 0x16ed3, Stride: 1},
+										{Lo: 0x16f00, Hi: 0x16f4a, Stride: 1},
+										{Lo: 0x16f4f, Hi: 0x16f87, Stride: 1},
+										{Lo: 0x16f8f, Hi: 0x16f9f, Stride: 1},
+										{Lo: 0x16fe0, Hi: 0x16fe1, Stride: 1},
+										{Lo: 0x16fe3, Hi: 0x16fe4, Stride: 1},
+										{Lo: 0x16ff0, Hi: 0x16ff6, Stride: 1},
+										{Lo: 0x17000, Hi: 0x18cd5, Stride: 1},
+										{Lo: 0x18cff, Hi: 0x18d1e, Stride: 1},
+										{Lo: 0x18d80, Hi: 0x18df2, Stride: 1},
+										{Lo: 0x1aff0, Hi: 0x1aff3, Stride: 1},
+										{Lo: 0x1aff5, Hi: 0x1affb, Stride: 1},
+										{Lo: 0x1affd, Hi: 0x1affe, Stride: 1},
+										{Lo: 0x1b000, Hi: 0x1b122, Stride: 1},
+										{Lo: 0x1b132, Hi: 0x1b132, Stride: 1},
+										{Lo: 0x1b150, Hi: 0x1b152, Stride: 1},
+										{Lo: 0x1b155, Hi: 0x1b155, Stride: 1},
+										{Lo: 0x1b164, Hi: 0x1b167, Stride: 1},
+										{Lo: 0x1b170, Hi: 0x1b2fb, Stride: 1},
+										{Lo: 0x1bc00, Hi: 0x1bc6a, Stride: 1},
+										{Lo: 0x1bc70, Hi: 0x1bc7c, Stride: 1},
+										{Lo: 0x1bc80, Hi: 0x1bc88, Stride: 1},
+										{Lo: 0x1bc90, Hi: 0x1bc99, Stride: 1},
+										{Lo: 0x1bc9d, Hi: 0x1bc9e, Stride: 1},
+										{Lo: 0x1ccf0, Hi: 0x1ccf9, Stride: 1},
+										{Lo: 0x1cf00, Hi: 0x1cf2d, Stride: 1},
+										{Lo: 0x1cf30, Hi: 0x1cf46, Stride: 1},
+										{Lo: 0x1d165, Hi: 0x1d169, Stride: 1},
+										{Lo: 0x1d16d, Hi: 0x1d172, Stride: 1},
+										{Lo: 0x1d17b, Hi: 0x1d182, Stride: 1},
+										{Lo: 0x1d185, Hi: 0x1d18b, Stride: 1},
+										{Lo: 0x1d1aa, Hi: 0x1d1ad, Stride: 1},
+										{Lo: 0x1d242, Hi: 0x1d244, Stride: 1},
+										{Lo: 0x1d400, Hi: 0x1d454, Stride: 1},
+										{Lo: 0x1d456, Hi: 0x1d49c, Stride: 1},
+										{Lo: 0x1d49e, Hi: 0x1d49f, Stride: 1},
+										{Lo: 0x1d4a2, Hi: 0x1d4a2, Stride: 1},
+										{Lo: 0x1d4a5, Hi: 0x1d4a6, Stride: 1},
+										{Lo: 0x1d4a9, Hi: 0x1d4ac, Stride: 1},
+										{Lo: 0x1d4ae, Hi: 0x1d4b9, Stride: 1},
+										{Lo: 0x1d4bb, Hi: 0x1d4bb, Stride: 1},
+										{Lo: 0x1d4bd, Hi: 0x1d4c3, Stride: 1},
+										{Lo: 0x1d4c5, Hi: 0x1d505, Stride: 1},
+										{Lo: 0x1d507, Hi: 0x1d50a, Stride: 1},
+										{Lo: 0x1d50d, Hi: 0x1d514, Stride: 1},
+										{Lo: 0x1d516, Hi: 0x1d51c, Stride: 1},
+										{Lo: 0x1d51e, Hi: 0x1d539, Stride: 1},
+										{Lo: 0x1d53b, Hi: 0x1d53e, Stride: 1},
+										{Lo: 0x1d540, Hi: 0x1d544, Stride: 1},
+										{Lo: 0x1d546, Hi: 0x1d546, Stride: 1},
+										{Lo: 0x1d54a, Hi: 0x1d550, Stride: 1},
+										{Lo: 0x1d552, Hi: 0x1d6a5, Stride: 1},
+										{Lo: 0x1d6a8, Hi: 0x1d6c0, Stride: 1},
+										{Lo: 0x1d6c2, Hi: 0x1d6da, Stride: 1},
+										{Lo: 0x1d6dc, Hi: 0x1d6fa, Stride: 1},
+										{Lo: 0x1d6fc, Hi: 0x1d714, Stride: 1},
+										{Lo: 0x1d716, Hi: 0x1d734, Stride: 1},
+										{Lo: 0x1d736, Hi: 0x1d74e, Stride: 1},
+										{Lo: 0x1d750, Hi: 0x1d76e, Stride: 1},
+										{Lo: 0x1d770, Hi: 0x1d788, Stride: 1},
+										{Lo: 0x1d78a, Hi: 0x1d7a8, Stride: 1},
+										{Lo: 0x1d7aa, Hi: 0x1d7c2, Stride: 1},
+										{Lo: 0x1d7c4, Hi: 0x1d7cb, Stride: 1},
+										{Lo: 0x1d7ce, Hi: 0x1d7ff, Stride: 1},
+										{Lo: 0x1da00, Hi: 0x1da36, Stride: 1},
+										{Lo: 0x1da3b, Hi: 0x1da6c, Stride: 1},
+										{Lo: 0x1da75, Hi: 0x1da75, Stride: 1},
+										{Lo: 0x1da84, Hi: 0x1da84, Stride: 1},
+										{Lo: 0x1da9b, Hi: 0x1da9f, Stride: 1},
+										{Lo: 0x1daa1, Hi: 0x1daaf, Stride: 1},
+										{Lo: 0x1df00, Hi: 0x1df1e, Stride: 1},
+										{Lo: 0x1df25, Hi: 0x1df2a, Stride: 1},
+										{Lo: 0x1e000, Hi: 0x1e006, Stride: 1},
+										{Lo: 0x1e008, Hi: 0x1e018, Stride: 1},
+										{Lo: 0x1e01b, Hi: 0x1e021, Stride: 1},
+										{Lo: 0x1e023, Hi: 0x1e024, Stride: 1},
+										{Lo: 0x1e026, Hi: 0x1e02a, Stride: 1},
+										{Lo: 0x1e030, Hi: 0x1e06d, Stride: 1},
+										{Lo: 0x1e08f, Hi: 0x1e08f, Stride: 1},
+										{Lo: 0x1e100, Hi: 0x1e12c, Stride: 1},
+										{Lo: 0x1e130, Hi: 0x1e13d, Stride: 1},
+										{Lo: 0x1e140, Hi: 0x1e149, Stride: 1},
+										{Lo: 0x1e14e, Hi: 0x1e14e, Stride: 1},
+										{Lo: 0x1e290, Hi: 0x1e2ae, Stride: 1},
+										{Lo: 0x1e2c0, Hi: 0x1e2f9, Stride: 1},
+										{Lo: 0x1e4d0, Hi: 0x1e4f9, Stride: 1},
+										{Lo: 0x1e5d0, Hi: 0x1e5fa, Stride: 1},
+										{Lo: 0x1e6c0, Hi: 0x1e6de, Stride: 1},
+										{Lo: 0x1e6e0, Hi: 0x1e6f5, Stride: 1},
+										{Lo: 0x1e6fe, Hi: 0x1e6ff, Stride: 1},
+										{Lo: 0x1e7e0, Hi: 0x1e7e6, Stride: 1},
+										{Lo: 0x1e7e8, Hi: 0x1e7eb, Stride: 1},
+										{Lo: 0x1e7ed, Hi: 0x1e7ee, Stride: 1},
+										{Lo: 0x1e7f0, Hi: 0x1e7fe, Stride: 1},
+										{Lo: 0x1e800, Hi: 0x1e8c4, Stride: 1},
+										{Lo: 0x1e8d0, Hi: 0x1e8d6, Stride: 1},
+										{Lo: 0x1e900, Hi: 0x1e94b, Stride: 1},
+										{Lo: 0x1e950, Hi: 0x1e959, Stride: 1},
+										{Lo: 0x1ee00, Hi: 0x1ee03, Stride: 1},
+										{Lo: 0x1ee05, Hi: 0x1ee1f, Stride: 1},
+										{Lo: 0x1ee21, Hi: 0x1ee22, Stride: 1},
+										{Lo: 0x1ee24, Hi: 0x1ee24, Stride: 1},
+										{Lo: 0x1ee27, Hi: 0x1ee27, Stride: 1},
+										{Lo: 0x1ee29, Hi: 0x1ee32, Stride: 1},
+										{Lo: 0x1ee34, Hi: 0x1ee37, Stride: 1},
+										{Lo: 0x1ee39, Hi: 0x1ee39, Stride: 1},
+										{Lo: 0x1ee3b, Hi: 0x1ee3b, Stride: 1},
+										{Lo: 0x1ee42, Hi: 0x1ee42, Stride: 1},
+										{Lo: 0x1ee47, Hi: 0x1ee47, Stride: 1},
+										{Lo: 0x1ee49, Hi: 0x1ee49, Stride: 1},
+										{Lo: 0x1ee4b, Hi: 0x1ee4b, Stride: 1},
+										{Lo: 0x1ee4d, Hi: 0x1ee4f, Stride: 1},
+										{Lo: 0x1ee51, Hi: 0x1ee52, Stride: 1},
+										{Lo: 0x1ee54, Hi: 0x1ee54, Stride: 1},
+										{Lo: 0x1ee57, Hi: 0x1ee57, Stride: 1},
+										{Lo: 0x1ee59, Hi: 0x1ee59, Stride: 1},
+										{Lo: 0x1ee5b, Hi: 0x1ee5b, Stride: 1},
+										{Lo: 0x1ee5d, Hi: 0x1ee5d, Stride: 1},
+										{Lo: 0x1ee5f, Hi: 0x1ee5f, Stride: 1},
+										{Lo: 0x1ee61, Hi: 0x1ee62, Stride: 1},
+										{Lo: 0x1ee64, Hi: 0x1ee64, Stride: 1},
+										{Lo: 0x1ee67, Hi: 0x1ee6a, Stride: 1},
+										{Lo: 0x1ee6c, Hi: 0x1ee72, Stride: 1},
+										{Lo: 0x1ee74, Hi: 0x1ee77, Stride: 1},
+										{Lo: 0x1ee79, Hi: 0x1ee7c, Stride: 1},
+										{Lo: 0x1ee7e, Hi: 0x1ee7e, Stride: 1},
+										{Lo: 0x1ee80, Hi: 0x1ee89, Stride: 1},
+										{Lo: 0x1ee8b, Hi: 0x1ee9b, Stride: 1},
+										{Lo: 0x1eea1, Hi: 0x1eea3, Stride: 1},
+										{Lo: 0x1eea5, Hi: 0x1eea9, Stride: 1},
+										{Lo: 0x1eeab, Hi: 0x1eebb, Stride: 1},
+										{Lo: 0x1fbf0, Hi: 0x1fbf9, Stride: 1},
+										{Lo: 0x20000, Hi: 0x2a6df, Stride: 1},
+										{Lo: 0x2a700, Hi: 0x2b81d, Stride: 1},
+										{Lo: 0x2b820, Hi: 0x2cead, Stride: 1},
+										{Lo: 0x2ceb0, Hi: 0x2ebe0, Stride: 1},
+										{Lo: 0x2ebf0, Hi: 0x2ee5d, Stride: 1},
+										{Lo: 0x2f800, Hi: 0x2fa1d, Stride: 1},
+										{Lo: 0x30000, Hi: 0x3134a, Stride: 1},
+										{Lo: 0x31350, Hi: 0x33479, Stride: 1},
+										{Lo: 0xe0100, Hi: 0xe01ef, Stride: 1},
+									},
+									LatinOffset: 10,
+								}},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Other",
+			pos:  position{line: 28, col: 1, offset: 705},
+			id:   5,
+			expr: &actionExpr{
+				pos: position{line: 28, col: 9, offset: 715},
+				id:  39,
+				run: (*parser).callonOther1,
+				expr: &charClassMatcher{
+					pos: position{line: 28, col: 9, offset: 715},
+					id:  40,
+					val: "[\\P{sc=Latin}--[\\p{Greek}\\p{gc=Z}]]",
+					classes: []*unicode.RangeTable{&unicode.RangeTable{
+						R16: []unicode.Range16{
+							{Lo: 0x0000, Hi: 0x001f, Stride: 1},
+							{Lo: 0x0021, Hi: 0x0040, Stride: 1},
+							{Lo: 0x005b, Hi: 0x0060, Stride: 1},
+							{Lo: 0x007b, Hi: 0x009f, Stride: 1},
+							{Lo: 0x00a1, Hi: 0x00a9, Stride: 1},
+							{Lo: 0x00ab, Hi: 0x00b9, Stride: 1},
+							{Lo: 0x00bb, Hi: 0x00bf, Stride: 1},
+							{Lo: 0x00d7, Hi: 0x00d7, Stride: 1},
+							{Lo: 0x00f7, Hi: 0x00f7, Stride: 1},
+							{Lo: 0x02b9, Hi: 0x02df, Stride: 1},
+							{Lo: 0x02e5, Hi: 0x036f, Stride: 1},
+							{Lo: 0x0374, Hi: 0x0374, Stride: 1},
+							{Lo: 0x0378, Hi: 0x0379, Stride: 1},
+							{Lo: 0x037e, Hi: 0x037e, Stride: 1},
+							{Lo: 0x0380, Hi: 0x0383, Stride: 1},
+							{Lo: 0x0385, Hi: 0x0385, Stride: 1},
+							{Lo: 0x0387, Hi: 0x0387, Stride: 1},
+							{Lo: 0x038b, Hi: 0x038b, Stride: 1},
+							{Lo: 0x038d, Hi: 0x038d, Stride: 1},
+							{Lo: 0x03a2, Hi: 0x03a2, Stride: 1},
+							{Lo: 0x03e2, Hi: 0x03ef, Stride: 1},
+							{Lo: 0x0400, Hi: 0x167f, Stride: 1},
+							{Lo: 0x1681, Hi: 0x1cff, Stride: 1},
+							{Lo: 0x1d2b, Hi: 0x1d2b, Stride: 1},
+							{Lo: 0x1d78, Hi: 0x1d78, Stride: 1},
+							{Lo: 0x1dc0, Hi: 0x1dff, Stride: 1},
+							{Lo: 0x1f16, Hi: 0x1f17, Stride: 1},
+							{Lo: 0x1f1e, Hi: 0x1f1f, Stride: 1},
+							{Lo: 0x1f46, Hi: 0x1f47, Stride: 1},
+							{Lo: 0x1f4e, Hi: 0x1f4f, Stride: 1},
+							{Lo: 0x1f58, Hi: 0x1f58, Stride: 1},
+							{Lo: 0x1f5a, Hi: 0x1f5a, Stride: 1},
+							{Lo: 0x1f5c, Hi: 0x1f5c, Stride: 1},
+							{Lo: 0x1f5e, Hi: 0x1f5e, Stride: 1},
+							{Lo: 0x1f7e, Hi: 0x1f7f, Stride: 1},
+							{Lo: 0x1fb5, Hi: 0x1fb5, Stride: 1},
+							{Lo: 0x1fc5, Hi: 0x1fc5, Stride: 1},
+							{Lo: 0x1fd4, Hi: 0x1fd5, Stride: 1},
+							{Lo: 0x1fdc, Hi: 0x1fdc, Stride: 1},
+							{Lo: 0x1ff0, Hi: 0x1ff1, Stride: 1},
+							{Lo: 0x1ff5, Hi: 0x1ff5, Stride: 1},
+							{Lo: 0x1fff, Hi: 0x1fff, Stride: 1},
+							{Lo: 0x200b, Hi: 0x2027, Stride: 1},
+							{Lo: 0x202a, Hi: 0x202e, Stride: 1},
+							{Lo: 0x2030, Hi: 0x205e, Stride: 1},
+							{Lo: 0x2060, Hi: 0x2070, Stride: 1},
+							{Lo: 0x2072, Hi: 0x207e, Stride: 1},
+							{Lo: 0x2080, Hi: 0x208f, Stride: 1},
+							{Lo: 0x209d, Hi: 0x2125, Stride: 1},
+							{Lo: 0x2127, Hi: 0x2129, Stride: 1},
+							{Lo: 0x212c, Hi: 0x2131, Stride: 1},
+							{Lo: 0x2133, Hi: 0x214d, Stride: 1},
+							{Lo: 0x214f, Hi: 0x215f, Stride: 1},
+							{Lo: 0x2189, Hi: 0x2c5f, Stride: 1},
+							{Lo: 0x2c80, Hi: 0x2fff, Stride: 1},
+							{Lo: 0x3001, Hi: 0xa721, Stride: 1},
+							{Lo: 0xa788, Hi: 0xa78a, Stride: 1},
+							{Lo: 0xa7dd, Hi: 0xa7f0, Stride: 1},
+							{Lo: 0xa800, Hi: 0xab2f, Stride: 1},
+							{Lo: 0xab5b, Hi: 0xab5b, Stride: 1},
+							{Lo: 0xab6a, Hi: 0xfaff, Stride: 1},
+							{Lo: 0xfb07, Hi: 0xff20, Stride: 1},
+							{Lo: 0xff3b, Hi: 0xff40, Stride: 1},
+							{Lo: 0xff5b, Hi: 0xffff, Stride: 1},
+						},
+						R32: []unicode.Range32{
+							{Lo: 0x10000, Hi: 0x1013f, Stride: 1},
+							{Lo: 0x1018f, Hi: 0x1019f, Stride: 1},
+							{Lo: 0x101a1, Hi: 0x1077f, Stride: 1},
+							{Lo: 0x10786, Hi: 0x10786, Stride: 1},
+							{Lo: 0x107b1, Hi: 0x107b1, Stride: 1},
+							{Lo: 0x107bb, Hi: 0x1d1ff, Stride: 1},
+							{Lo: 0x1d246, Hi: 0x1deff, Stride: 1},
+							{Lo: 0x1df1f, Hi: 0x1df24, Stride: 1},
+							{Lo: 0x1df2b, Hi: 0x10ffff, Stride: 1},
+						},
+						LatinOffset: 9,
+					}},
+					ignoreCase: false,
+					inverted:   false,
+				},
+			},
+		},
+		{
+			name: "_",
+			pos:  position{line: 32, col: 1, offset: 798},
+			id:   6,
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 32, col: 5, offset: 804},
+				id:  41,
+				expr: &charClassMatcher{
+					pos:        position{line: 32, col: 5, offset: 804},
+					id:         42,
+					val:        "[ \\t]",
+					chars:      []rune{' ', '\t'},
+					ignoreCase: false,
+					inverted:   false,
+				},
+			},
+		},
+		{
+			name: "EOF",
+			pos:  position{line: 34, col: 1, offset: 812},
+			id:   7,
+			expr: &notExpr{
+				pos: position{line: 34, col: 7, offset: 820},
+				id:  43,
+				expr: &anyMatcher{
+					pos: position{line: 34, col: 8, offset: 821},
+					id:  44,
+				},
+			},
+		},
+	},
+}
+
+func (c *current) onTokens1(first, rest any) (any, error) {
+	return append([]any{first}, rest.([]any)...), nil
+}
+
+func (p *parser) callonTokens1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTokens1(stack["first"], stack["rest"])
+}
+
+func (c *current) onGreek1() (any, error) {
+	return "greek:" + string(c.text), nil
+}
+
+func (p *parser) callonGreek1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGreek1()
+}
+
+func (c *current) onConsonants1() (any, error) {
+	return "consonants:" + string(c.text), nil
+}
+
+func (p *parser) callonConsonants1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onConsonants1()
+}
+
+func (c *current) onIdent1() (any, error) {
+	return "ident:" + string(c.text), nil
+}
+
+func (p *parser) callonIdent1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIdent1()
+}
+
+func (c *current) onOther1() (any, error) {
+	return "other:" + string(c.text), nil
+}
+
+func (p *parser) callonOther1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOther1()
+}
+
+var (
+	// errNoRule is returned when the grammar to parse has no rule.
+	errNoRule = errors.New("grammar has no rule")
+
+	// errInvalidEntrypoint is returned when the specified entrypoint rule
+	// does not exit.
+	errInvalidEntrypoint = errors.New("invalid entrypoint")
+
+	// errInvalidEncoding is returned when the source is not properly
+	// utf8-encoded.
+	errInvalidEncoding = errors.New("invalid encoding")
+
+	// errMaxExprCnt is used to signal that the maximum number of
+	// expressions have been parsed.
+	errMaxExprCnt = errors.New("max number of expressions parsed")
+)
+
+// Option is a function that can set an option on the parser. It returns
+// the previous setting as an Option.
+type Option func(*parser) Option
+
+// MaxExpressions creates an Option to stop parsing after the provided
+// number of expressions have been parsed, if the value is 0 then the parser will
+// parse for as many steps as needed (possibly an infinite number).
+//
+// The default for maxExprCnt is 0.
+func MaxExpressions(maxExprCnt uint64) Option {
+	return func(p *parser) Option {
+		oldMaxExprCnt := p.maxExprCnt
+		p.maxExprCnt = maxExprCnt
+		return MaxExpressions(oldMaxExprCnt)
+	}
+}
+
+// Entrypoint creates an Option to set the rule name to use as entrypoint.
+// The rule name must have been specified in the -alternate-entrypoints
+// if generating the parser with the -optimize-grammar flag, otherwise
+// it may have been optimized out. Passing an empty string sets the
+// entrypoint to the first rule in the grammar.
+//
+// The default is to start parsing at the first rule in the grammar.
+func Entrypoint(ruleName string) Option {
+	return func(p *parser) Option {
+		oldEntrypoint := p.entrypoint
+		p.entrypoint = ruleName
+		if ruleName == "" {
+			p.entrypoint = g.rules[0].name
+		}
+		return Entrypoint(oldEntrypoint)
+	}
+}
+
+// Statistics adds a user provided Stats struct to the parser to allow
+// the user to process the results after the parsing has finished.
+// Also the key for the "no match" counter is set. Per-rule statistics
+// (see RuleStats) are only collected when this option is set.
+//
+// Example usage:
+//
+//	input := "input"
+//	stats := Stats{}
+//	_, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	fmt.Println(string(b))
+func Statistics(stats *Stats, choiceNoMatch string) Option {
+	return func(p *parser) Option {
+		oldStats := p.Stats
+		p.Stats = stats
+		oldChoiceNoMatch := p.choiceNoMatch
+		p.choiceNoMatch = choiceNoMatch
+		if p.Stats.ChoiceAltCnt == nil {
+			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
+		}
+		if p.Stats.Rules == nil {
+			p.Stats.Rules = make(map[string]*RuleStats)
+		}
+		return Statistics(oldStats, oldChoiceNoMatch)
+	}
+}
+
+// Debug creates an Option to set the debug flag to b. When set to true,
+// debugging information is printed to stdout while parsing.
+//
+// The default is false.
+func Debug(b bool) Option {
+	return func(p *parser) Option {
+		old := p.debug
+		p.debug = b
+		return Debug(old)
+	}
+}
+
+// Memoize creates an Option to set the memoize flag to b. When set to true,
+// the parser will cache all results so each expression is evaluated only
+// once. This guarantees linear parsing time even for pathological cases,
+// at the expense of more memory and slower times for typical cases.
+// The rules annotated with @memo in the grammar are always memoized,
+// regardless of this option. The memoization table is pooled, so its
+// storage is reused by the following parses.
+//
+// The default is false.
+func Memoize(b bool) Option {
+	return func(p *parser) Option {
+		old := p.memoize
+		p.memoize = b
+		return Memoize(old)
+	}
+}
+
+// AllowInvalidUTF8 creates an Option to allow invalid UTF-8 bytes.
+// Every invalid UTF-8 byte is treated as a utf8.RuneError (U+FFFD)
+// by character class matchers and is matched by the any matcher.
+// The returned matched value, c.text and c.offset are NOT affected.
+//
+// The default is false.
+func AllowInvalidUTF8(b bool) Option {
+	return func(p *parser) Option {
+		old := p.allowInvalidUTF8
+		p.allowInvalidUTF8 = b
+		return AllowInvalidUTF8(old)
+	}
+}
+
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
+// Recover creates an Option to set the recover flag to b. When set to
+// true, this causes the parser to recover from panics and convert it
+// to an error. Setting it to false can be useful while debugging to
+// access the full stack trace.
+//
+// The default is true.
+func Recover(b bool) Option {
+	return func(p *parser) Option {
+		old := p.recover
+		p.recover = b
+		return Recover(old)
+	}
+}
+
+// GlobalStore creates an Option to set a key to a certain value in
+// the globalStore.
+func GlobalStore(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.globalStore[key]
+		p.cur.globalStore[key] = value
+		return GlobalStore(key, old)
+	}
+}
+
+// InitState creates an Option to set a key to a certain value in
+// the global "state" store.
+func InitState(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.state[key]
+		p.cur.state[key] = value
+		return InitState(key, old)
+	}
+}
+
+// ParseFile parses the file identified by filename.
+func ParseFile(filename string, opts ...Option) (i any, err error) { // nolint: deadcode
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+	return ParseReader(filename, f, opts...)
+}
+
+// ParseReader parses the data from r using filename as information in the
+// error messages.
+func ParseReader(filename string, r io.Reader, opts ...Option) (any, error) { // nolint: deadcode
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(filename, b, opts...)
+}
+
+// Parse parses the data from b using filename as information in the
+// error messages.
+func Parse(filename string, b []byte, opts ...Option) (any, error) {
+	return newParser(filename, b, opts...).parse(g)
+}
+
+// position records a position in the text.
+type position struct {
+	line, col, offset int
+}
+
+func (p position) String() string {
+	return strconv.Itoa(p.line) + ":" + strconv.Itoa(p.col) + " [" + strconv.Itoa(p.offset) + "]"
+}
+
+// savepoint stores all state required to go back to this point in the
+// parser.
+type savepoint struct {
+	position
+	rn rune
+	w  int
+}
+
+type current struct {
+	pos  position // start position of the match
+	text []byte   // raw text of the match
+
+	// state is a store for arbitrary key,value pairs that the user wants to be
+	// tied to the backtracking of the parser.
+	// This is always rolled back if a parsing rule fails.
+	state storeDict
+
+	// globalStore is a general store for the user to store arbitrary key-value
+	// pairs that they need to manage and that they do not want tied to the
+	// backtracking of the parser. This is only modified by the user and never
+	// rolled back by the parser. It is always up to the user to keep this in a
+	// consistent state.
+	globalStore storeDict
+}
+
+type storeDict map[string]any
+
+// the AST types...
+
+// nolint: structcheck
+type grammar struct {
+	pos   position
+	rules []*rule
+}
+
+// nolint: structcheck
+type rule struct {
+	pos         position
+	name        string
+	displayName string
+	expr        any
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+//
+//	nolint: structcheck
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
+}
+
+// nolint: structcheck
+type choiceExpr struct {
+	pos          position
+	id           int
+	alternatives []any
+	dispatch     *choiceDispatch
+}
+
+// nolint: structcheck
+type actionExpr struct {
+	pos  position
+	id   int
+	expr any
+	run  func(*parser) (any, error)
+}
+
+// nolint: structcheck
+type recoveryExpr struct {
+	pos          position
+	id           int
+	expr         any
+	recoverExpr  any
+	failureLabel []string
+}
+
+// nolint: structcheck
+type seqExpr struct {
+	pos   position
+	id    int
+	exprs []any
+	// pluck is the indexes of the expressions whose values are the value of
+	// the sequence, if any.
+	pluck []int
+}
+
+// nolint: structcheck
+type labeledExpr struct {
+	pos   position
+	id    int
+	label string
+	expr  any
+}
+
+// nolint: structcheck
+type expr struct {
+	pos  position
+	id   int
+	expr any
+}
+
+// nolint: structcheck
+type repeatExpr struct {
+	pos  position
+	id   int
+	expr any
+	// max is -1 if the number of repetitions is not bounded.
+	min int
+	max int
+}
+
+type (
+	andExpr        expr // nolint: structcheck
+	notExpr        expr // nolint: structcheck
+	zeroOrOneExpr  expr // nolint: structcheck
+	zeroOrMoreExpr expr // nolint: structcheck
+	oneOrMoreExpr  expr // nolint: structcheck
+)
+
+// nolint: structcheck
+type ruleRefExpr struct {
+	pos  position
+	id   int
+	name string
+}
+
+// nolint: structcheck
+type stateCodeExpr struct {
+	pos position
+	id  int
+	run func(*parser) error
+}
+
+// nolint: structcheck
+type andCodeExpr struct {
+	pos position
+	id  int
+	run func(*parser) (bool, error)
+}
+
+// nolint: structcheck
+type notCodeExpr struct {
+	pos position
+	id  int
+	run func(*parser) (bool, error)
+}
+
+// nolint: structcheck
+type litMatcher struct {
+	pos        position
+	id         int
+	val        string
+	ignoreCase bool
+	want       string
+}
+
+// nolint: structcheck
+type charClassMatcher struct {
+	pos             position
+	id              int
+	val             string
+	basicLatinChars [128]bool
+	chars           []rune
+	ranges          []rune
+	classes         []*unicode.RangeTable
+	ignoreCase      bool
+	inverted        bool
+}
+
+// nolint: structcheck
+type anyMatcher struct {
+	pos position
+	id  int
+}
+
+// errList cumulates the errors found by the parser.
+type errList []error
+
+func (e *errList) add(err error) {
+	*e = append(*e, err)
+}
+
+func (e errList) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	e.dedupe()
+	return e
+}
+
+func (e *errList) dedupe() {
+	var cleaned []error
+	set := make(map[string]bool)
+	for _, err := range *e {
+		if msg := err.Error(); !set[msg] {
+			set[msg] = true
+			cleaned = append(cleaned, err)
+		}
+	}
+	*e = cleaned
+}
+
+func (e errList) Error() string {
+	switch len(e) {
+	case 0:
+		return ""
+	case 1:
+		return e[0].Error()
+	default:
+		var buf bytes.Buffer
+
+		for i, err := range e {
+			if i > 0 {
+				buf.WriteRune('\n')
+			}
+			buf.WriteString(err.Error())
+		}
+		return buf.String()
+	}
+}
+
+// parserError wraps an error with a prefix indicating the rule in which
+// the error occurred. The original error is stored in the Inner field.
+type parserError struct {
+	Inner    error
+	pos      position
+	prefix   string
+	expected []string
+}
+
+// Error returns the error message.
+func (p *parserError) Error() string {
+	return p.prefix + ": " + p.Inner.Error()
+}
+
+// newParser creates a parser with the specified input source and options.
+func newParser(filename string, b []byte, opts ...Option) *parser {
+	stats := Stats{
+		ChoiceAltCnt: make(map[string]map[string]int),
+	}
+
+	p := &parser{
+		filename: filename,
+		errs:     new(errList),
+		data:     b,
+		pt:       savepoint{position: position{line: 1}},
+		recover:  true,
+		cur: current{
+			state:       make(storeDict),
+			globalStore: make(storeDict),
+		},
+		maxFailPos:      position{col: 1, line: 1},
+		maxFailExpected: make([]string, 0, 20),
+		Stats:           &stats,
+		// start rule is rule [0] unless an alternate entrypoint is specified
+		entrypoint: g.rules[0].name,
+	}
+	p.setOptions(opts)
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
+
+	if p.maxExprCnt == 0 {
+		p.maxExprCnt = math.MaxUint64
+	}
+
+	return p
+}
+
+// setOptions applies the options to the parser.
+func (p *parser) setOptions(opts []Option) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// nolint: structcheck,deadcode
+type resultTuple struct {
+	v   any
+	b   bool
+	end savepoint
+}
+
+// nolint: varcheck
+const choiceNoMatch = -1
+
+// Stats stores some statistics, gathered during parsing
+type Stats struct {
+	// ExprCnt counts the number of expressions processed during parsing
+	// This value is compared to the maximum number of expressions allowed
+	// (set by the MaxExpressions option).
+	ExprCnt uint64
+
+	// ChoiceAltCnt is used to count for each ordered choice expression,
+	// which alternative is used how may times.
+	// These numbers allow to optimize the order of the ordered choice expression
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
+	//
+	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
+	// as the line and the column of the ordered choice.
+	// The inner key of ChoiceAltCnt is the number (one-based) of the matching alternative.
+	// For each alternative the number of matches are counted. If an ordered choice does not
+	// match, a special counter is incremented. The name of this counter is set with
+	// the parser option Statistics.
+	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
+	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
+}
+
+// nolint: structcheck,maligned
+type parser struct {
+	filename string
+	pt       savepoint
+	cur      current
+
+	data []byte
+	errs *errList
+
+	depth   int
+	recover bool
+	debug   bool
+
+	memoize bool
+	// memoization table for the packrat algorithm, taken from memoTables
+	// on the first memoized result
+	memo *memoTable
+
+	// rules table, maps the rule identifier to the rule node
+	rules map[string]*rule
+	// variables stack, map of label to value
+	vstack []map[string]any
+	// rule stack, allows identification of the current rule in errors
+	rstack []*rule
+
+	// parse fail
+	maxFailPos            position
+	maxFailExpected       []string
+	maxFailInvertExpected bool
+
+	// max number of expressions to be parsed
+	maxExprCnt uint64
+	// entrypoint for the parser
+	entrypoint string
+
+	allowInvalidUTF8 bool
+
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+
+	*Stats
+	// profiling frames, tracks the time spent in invoked rules to compute
+	// the self time of each rule.
+	profStack []profFrame
+	// number of active invocations of each rule, the time of a recursive
+	// rule is only added at its outermost invocation.
+	profActive map[*rule]int
+	// rules evaluated at each offset, to count the repeated evaluations.
+	profEvals map[profEval]bool
+
+	choiceNoMatch string
+	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
+}
+
+// push a variable set on the vstack.
+func (p *parser) pushV() {
+	if cap(p.vstack) == len(p.vstack) {
+		// create new empty slot in the stack
+		p.vstack = append(p.vstack, nil)
+	} else {
+		// slice to 1 more
+		p.vstack = p.vstack[:len(p.vstack)+1]
+	}
+
+	// get the last args set
+	m := p.vstack[len(p.vstack)-1]
+	if m != nil && len(m) == 0 {
+		// empty map, all good
+		return
+	}
+
+	m = make(map[string]any)
+	p.vstack[len(p.vstack)-1] = m
+}
+
+// pop a variable set from the vstack.
+func (p *parser) popV() {
+	// if the map is not empty, clear it
+	m := p.vstack[len(p.vstack)-1]
+	if len(m) > 0 {
+		// GC that map
+		p.vstack[len(p.vstack)-1] = nil
+	}
+	p.vstack = p.vstack[:len(p.vstack)-1]
+}
+
+// push a recovery expression with its labels to the recoveryStack
+func (p *parser) pushRecovery(labels []string, expr any) {
+	if cap(p.recoveryStack) == len(p.recoveryStack) {
+		// create new empty slot in the stack
+		p.recoveryStack = append(p.recoveryStack, recovery{})
+	} else {
+		// slice to 1 more
+		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
+	}
+
+	m := make(map[string]any, len(labels))
+	for _, fl := range labels {
+		m[fl] = expr
+	}
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
+}
+
+// pop a recovery expression from the recoveryStack
+func (p *parser) popRecovery() {
+	// GC that map
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
+
+	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
+}
+
+func (p *parser) print(prefix, s string) string {
+	if !p.debug {
+		return s
+	}
+
+	pos := p.pt.position
+	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
+		prefix, pos.line, pos.col, pos.offset, s, p.pt.rn)
+	return s
+}
+
+func (p *parser) printIndent(mark string, s string) string {
+	return p.print(strings.Repeat(" ", p.depth)+mark, s)
+}
+
+func (p *parser) in(s string) string {
+	res := p.printIndent(">", s)
+	p.depth++
+	return res
+}
+
+func (p *parser) out(s string) string {
+	p.depth--
+	return p.printIndent("<", s)
+}
+
+func (p *parser) addErr(err error) {
+	p.addErrAt(err, p.pt.position, []string{})
+}
+
+func (p *parser) addErrAt(err error, pos position, expected []string) {
+	var buf bytes.Buffer
+	if p.filename != "" {
+		buf.WriteString(p.filename)
+	}
+	if buf.Len() > 0 {
+		buf.WriteString(":")
+	}
+	buf.WriteString(fmt.Sprintf("%d:%d (%d)", pos.line, pos.col, pos.offset))
+	if len(p.rstack) > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString(": ")
+		}
+		rule := p.rstack[len(p.rstack)-1]
+		if rule.displayName != "" {
+			buf.WriteString("rule " + rule.displayName)
+		} else {
+			buf.WriteString("rule " + rule.name)
+		}
+	}
+	pe := &parserError{Inner: err, pos: pos, prefix: buf.String(), expected: expected}
+	p.errs.add(pe)
+}
+
+func (p *parser) failAt(fail bool, pos position, want string) {
+	// process fail if parsing fails and not inverted or parsing succeeds and invert is set
+	if fail == p.maxFailInvertExpected {
+		if pos.offset < p.maxFailPos.offset {
+			return
+		}
+
+		if pos.offset > p.maxFailPos.offset {
+			p.maxFailPos = pos
+			p.maxFailExpected = p.maxFailExpected[:0]
+		}
+
+		if p.maxFailInvertExpected {
+			want = "!" + want
+		}
+		p.maxFailExpected = append(p.maxFailExpected, want)
+	}
+}
+
+// read advances the parser to the next rune.
+func (p *parser) read() {
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
+	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
+	p.pt.rn = rn
+	p.pt.w = n
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
+	}
+
+	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
+		if !p.allowInvalidUTF8 {
+			p.addErr(errInvalidEncoding)
+		}
+	}
+}
+
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
+// restore parser position to the savepoint pt.
+func (p *parser) restore(pt savepoint) {
+	if p.debug {
+		defer p.out(p.in("restore"))
+	}
+	if pt.offset == p.pt.offset {
+		return
+	}
+	if p.Rules != nil && pt.offset < p.pt.offset && len(p.rstack) > 0 {
+		p.ruleStats(p.rstack[len(p.rstack)-1]).Backtracked += uint64(p.pt.offset - pt.offset)
+	}
+	p.pt = pt
+}
+
+// Cloner is implemented by any value that has a Clone method, which returns a
+// copy of the value. This is mainly used for types which are not passed by
+// value (e.g map, slice, chan) or structs that contain such types.
+//
+// This is used in conjunction with the global state feature to create proper
+// copies of the state to allow the parser to properly restore the state in
+// the case of backtracking.
+type Cloner interface {
+	Clone() any
+}
+
+var statePool = &sync.Pool{
+	New: func() any { return make(storeDict) },
+}
+
+func (sd storeDict) Discard() {
+	for k := range sd {
+		delete(sd, k)
+	}
+	statePool.Put(sd)
+}
+
+// clone and return parser current state.
+func (p *parser) cloneState() storeDict {
+	if p.debug {
+		defer p.out(p.in("cloneState"))
+	}
+
+	state := statePool.Get().(storeDict)
+	for k, v := range p.cur.state {
+		if c, ok := v.(Cloner); ok {
+			state[k] = c.Clone()
+		} else {
+			state[k] = v
+		}
+	}
+	return state
+}
+
+// restore parser current state to the state storeDict.
+// every restoreState should applied only one time for every cloned state
+func (p *parser) restoreState(state storeDict) {
+	if p.debug {
+		defer p.out(p.in("restoreState"))
+	}
+	p.cur.state.Discard()
+	p.cur.state = state
+}
+
+// get the slice of bytes from the savepoint start to the current position.
+func (p *parser) sliceFrom(start savepoint) []byte {
+	return p.data[start.position.offset:p.pt.position.offset]
+}
+
+// memoSlot is an entry of the memoization table, the key of an empty slot
+// is 0.
+type memoSlot struct {
+	key uint64
+	res resultTuple
+}
+
+// memoTable is the memoization table for the packrat algorithm, an
+// open-addressed hash table with linear probing that maps the offset in
+// the source and the id of a rule or expression to its result.
+type memoTable struct {
+	slots []memoSlot
+	shift uint
+	n     int
+}
+
+// memoTables pools the memoization tables so that their storage is
+// reused across parses.
+var memoTables = &sync.Pool{
+	New: func() any {
+		return &memoTable{slots: make([]memoSlot, 1<<10), shift: 64 - 10}
+	},
+}
+
+// memoKey returns the key of the result of the rule or expression id at
+// offset, it supports up to 1<<24 ids.
+func memoKey(offset, id int) uint64 {
+	return uint64(offset+1)<<24 | uint64(id)
+}
+
+// index returns the index of the slot of key, or of the empty slot where
+// it should be stored.
+func (t *memoTable) index(key uint64) int {
+	mask := len(t.slots) - 1
+	i := int((key*0x9E3779B97F4A7C15)>>t.shift) & mask
+	for t.slots[i].key != 0 && t.slots[i].key != key {
+		i = (i + 1) & mask
+	}
+	return i
+}
+
+func (t *memoTable) get(key uint64) (resultTuple, bool) {
+	s := &t.slots[t.index(key)]
+	return s.res, s.key == key
+}
+
+func (t *memoTable) set(key uint64, res resultTuple) {
+	if 4*(t.n+1) > 3*len(t.slots) {
+		t.grow()
+	}
+	s := &t.slots[t.index(key)]
+	if s.key == 0 {
+		s.key = key
+		t.n++
+	}
+	s.res = res
+}
+
+// grow doubles the capacity of the table.
+func (t *memoTable) grow() {
+	old := t.slots
+	t.slots = make([]memoSlot, 2*len(old))
+	t.shift--
+	for _, s := range old {
+		if s.key != 0 {
+			t.slots[t.index(s.key)] = s
+		}
+	}
+}
+
+// reset empties the table, releasing the references to the results.
+func (t *memoTable) reset() {
+	if t.n == 0 {
+		return
+	}
+	for i := range t.slots {
+		t.slots[i] = memoSlot{}
+	}
+	t.n = 0
+}
+
+func (p *parser) getMemoized(id int) (resultTuple, bool) {
+	if p.memo == nil {
+		return resultTuple{}, false
+	}
+	return p.memo.get(memoKey(p.pt.offset, id))
+}
+
+func (p *parser) setMemoized(pt savepoint, id int, tuple resultTuple) {
+	if p.memo == nil {
+		p.memo = memoTables.Get().(*memoTable)
+	}
+	p.memo.set(memoKey(pt.offset, id), tuple)
+}
+
+// releaseMemo returns the memoization table to the pool.
+func (p *parser) releaseMemo() {
+	if p.memo == nil {
+		return
+	}
+	p.memo.reset()
+	memoTables.Put(p.memo)
+	p.memo = nil
+}
+
+func (p *parser) buildRulesTable(g *grammar) {
+	p.rules = make(map[string]*rule, len(g.rules))
+	for _, r := range g.rules {
+		p.rules[r.name] = r
+	}
+}
+
+// nolint: gocyclo
+func (p *parser) parse(g *grammar) (val any, err error) {
+	if len(g.rules) == 0 {
+		p.addErr(errNoRule)
+		return nil, p.errs.err()
+	}
+
+	// TODO : not super critical but this could be generated
+	p.buildRulesTable(g)
+	defer p.releaseMemo()
+
+	if p.recover {
+		// panic can be used in action code to stop parsing immediately
+		// and return the panic as an error.
+		defer func() {
+			if e := recover(); e != nil {
+				if p.debug {
+					defer p.out(p.in("panic handler"))
+				}
+				val = nil
+				switch e := e.(type) {
+				case error:
+					p.addErr(e)
+				default:
+					p.addErr(fmt.Errorf("%v", e))
+				}
+				err = p.errs.err()
+			}
+		}()
+	}
+
+	startRule, ok := p.rules[p.entrypoint]
+	if !ok {
+		p.addErr(errInvalidEntrypoint)
+		return nil, p.errs.err()
+	}
+
+	p.read() // advance to first rune
+	val, ok = p.parseRuleWrap(startRule)
+	if !ok {
+		if len(*p.errs) == 0 {
+			// If parsing fails, but no errors have been recorded, the expected values
+			// for the farthest parser position are returned as error.
+			p.addNoMatchErr()
+		}
+
+		return nil, p.errs.err()
+	}
+	return val, p.errs.err()
+}
+
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
+func listJoin(list []string, sep string, lastSep string) string {
+	switch len(list) {
+	case 0:
+		return ""
+	case 1:
+		return list[0]
+	default:
+		return strings.Join(list[:len(list)-1], sep) + " " + lastSep + " " + list[len(list)-1]
+	}
+}
+
+// profFrame is a rule invocation being profiled.
+type profFrame struct {
+	start time.Time
+	child time.Duration
+}
+
+// profEval is the evaluation of a rule at an offset.
+type profEval struct {
+	rule   *rule
+	offset int
+}
+
+// ruleStats returns the statistics of rule, creating them if needed.
+func (p *parser) ruleStats(rule *rule) *RuleStats {
+	rs := p.Rules[rule.name]
+	if rs == nil {
+		rs = &RuleStats{}
+		p.Rules[rule.name] = rs
+	}
+	return rs
+}
+
+func (p *parser) countMemo(rs *RuleStats, hit bool) {
+	if hit {
+		rs.MemoHits++
+	} else {
+		rs.MemoMisses++
+	}
+}
+
+func (p *parser) profEnter(r *rule) {
+	if p.profActive == nil {
+		p.profActive = make(map[*rule]int)
+	}
+	p.profActive[r]++
+	p.profStack = append(p.profStack, profFrame{start: time.Now()})
+}
+
+// countRepeat counts the evaluation of rule at the current offset if
+// it was already evaluated at that offset.
+func (p *parser) countRepeat(rule *rule) {
+	if p.profEvals == nil {
+		p.profEvals = make(map[profEval]bool)
+	}
+	key := profEval{rule: rule, offset: p.pt.offset}
+	if p.profEvals[key] {
+		p.ruleStats(rule).Repeats++
+		return
+	}
+	p.profEvals[key] = true
+}
+
+func (p *parser) profExit(rule *rule, start savepoint, ok bool) {
+	frame := p.profStack[len(p.profStack)-1]
+	p.profStack = p.profStack[:len(p.profStack)-1]
+	elapsed := time.Since(frame.start)
+	if n := len(p.profStack); n > 0 {
+		p.profStack[n-1].child += elapsed
+	}
+
+	rs := p.ruleStats(rule)
+	rs.Invocations++
+	if p.profActive[rule]--; p.profActive[rule] == 0 {
+		// the time of the inner invocations is included
+		rs.Time += elapsed
+	}
+	rs.SelfTime += elapsed - frame.child
+	if ok {
+		rs.Matches++
+		rs.Bytes += uint64(p.pt.offset - start.offset)
+	} else {
+		rs.Failures++
+	}
+}
+
+func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
+	if rule.id < 0 {
+		// its results depend on the captured texts or the state store
+		return p.parseRule(rule)
+	}
+	res, ok := p.getMemoized(rule.id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(rule), ok)
+	}
+	if ok {
+		p.restore(res.end)
+		return res.v, res.b
+	}
+
+	startMark := p.pt
+	val, ok := p.parseRule(rule)
+	p.setMemoized(startMark, rule.id, resultTuple{v: val, b: ok, end: p.pt})
+
+	return val, ok
+}
+
+func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRule " + rule.name))
+	}
+	var (
+		val       any
+		ok        bool
+		startMark = p.pt
+	)
+
+	if p.Rules != nil {
+		p.profEnter(rule)
+		defer func() { p.profExit(rule, startMark, ok) }()
+	}
+
+	if p.memoize {
+		val, ok = p.parseRuleMemoize(rule)
+	} else {
+		val, ok = p.parseRule(rule)
+	}
+
+	if ok && p.debug {
+		p.printIndent("MATCH", string(p.sliceFrom(startMark)))
+	}
+	return val, ok
+}
+
+func (p *parser) parseRule(rule *rule) (any, bool) {
+	if p.Rules != nil {
+		p.countRepeat(rule)
+	}
+	p.rstack = append(p.rstack, rule)
+	p.pushV()
+	val, ok := p.parseExprWrap(rule.expr)
+	p.popV()
+	p.rstack = p.rstack[:len(p.rstack)-1]
+	return val, ok
+}
+
+// incChoiceAltCnt counts the match of the alternative altI of the choice
+// at pos, or its failure if altI is choiceNoMatch.
+func (p *parser) incChoiceAltCnt(pos position, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, pos.line, pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
+func (p *parser) parseExprWrap(expr any) (any, bool) {
+	var pt savepoint
+	id := exprID(expr)
+
+	if p.memoize && id >= 0 {
+		res, ok := p.getMemoized(id)
+		if p.Rules != nil {
+			p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+		}
+		if ok {
+			p.restore(res.end)
+			return res.v, res.b
+		}
+		pt = p.pt
+	}
+
+	val, ok := p.parseExpr(expr)
+
+	if p.memoize && id >= 0 {
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
+	}
+	return val, ok
+}
+
+// exprID returns the id of the expression in the memoization table, -1 if
+// its results depend on the texts captured by the labels or on the state
+// store and are not memoized.
+//
+//	nolint: gocyclo
+func exprID(expr any) int {
+	switch expr := expr.(type) {
+	case *actionExpr:
+		return expr.id
+	case *andCodeExpr:
+		return expr.id
+	case *andExpr:
+		return expr.id
+	case *anyMatcher:
+		return expr.id
+	case *charClassMatcher:
+		return expr.id
+	case *choiceExpr:
+		return expr.id
+	case *labeledExpr:
+		return expr.id
+	case *litMatcher:
+		return expr.id
+	case *notCodeExpr:
+		return expr.id
+	case *notExpr:
+		return expr.id
+	case *oneOrMoreExpr:
+		return expr.id
+	case *recoveryExpr:
+		return expr.id
+	case *repeatExpr:
+		return expr.id
+	case *ruleRefExpr:
+		return expr.id
+	case *seqExpr:
+		return expr.id
+	case *stateCodeExpr:
+		return expr.id
+	case *zeroOrMoreExpr:
+		return expr.id
+	case *zeroOrOneExpr:
+		return expr.id
+	default:
+		panic(fmt.Sprintf("unknown expression type %T", expr))
+	}
+}
+
+// nolint: gocyclo
+func (p *parser) parseExpr(expr any) (any, bool) {
+	p.ExprCnt++
+	if p.ExprCnt > p.maxExprCnt {
+		panic(errMaxExprCnt)
+	}
+
+	var val any
+	var ok bool
+	switch expr := expr.(type) {
+	case *actionExpr:
+		val, ok = p.parseActionExpr(expr)
+	case *andCodeExpr:
+		val, ok = p.parseAndCodeExpr(expr)
+	case *andExpr:
+		val, ok = p.parseAndExpr(expr)
+	case *anyMatcher:
+		val, ok = p.parseAnyMatcher(expr)
+	case *charClassMatcher:
+		val, ok = p.parseCharClassMatcher(expr)
+	case *choiceExpr:
+		val, ok = p.parseChoiceExpr(expr)
+	case *labeledExpr:
+		val, ok = p.parseLabeledExpr(expr)
+	case *litMatcher:
+		val, ok = p.parseLitMatcher(expr)
+	case *notCodeExpr:
+		val, ok = p.parseNotCodeExpr(expr)
+	case *notExpr:
+		val, ok = p.parseNotExpr(expr)
+	case *oneOrMoreExpr:
+		val, ok = p.parseOneOrMoreExpr(expr)
+	case *recoveryExpr:
+		val, ok = p.parseRecoveryExpr(expr)
+	case *repeatExpr:
+		val, ok = p.parseRepeatExpr(expr)
+	case *ruleRefExpr:
+		val, ok = p.parseRuleRefExpr(expr)
+	case *seqExpr:
+		val, ok = p.parseSeqExpr(expr)
+	case *stateCodeExpr:
+		val, ok = p.parseStateCodeExpr(expr)
+	case *zeroOrMoreExpr:
+		val, ok = p.parseZeroOrMoreExpr(expr)
+	case *zeroOrOneExpr:
+		val, ok = p.parseZeroOrOneExpr(expr)
+	default:
+		panic(fmt.Sprintf("unknown expression type %T", expr))
+	}
+	return val, ok
+}
+
+func (p *parser) parseActionExpr(act *actionExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseActionExpr"))
+	}
+
+	start := p.pt
+	val, ok := p.parseExprWrap(act.expr)
+	if ok {
+		p.cur.pos = start.position
+		p.cur.text = p.sliceFrom(start)
+		state := p.cloneState()
+		actVal, err := act.run(p)
+		if err != nil {
+			p.addErrAt(err, start.position, []string{})
+		}
+		p.restoreState(state)
+
+		val = actVal
+	}
+	if ok && p.debug {
+		p.printIndent("MATCH", string(p.sliceFrom(start)))
+	}
+	return val, ok
+}
+
+func (p *parser) parseAndCodeExpr(and *andCodeExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseAndCodeExpr"))
+	}
+
+	state := p.cloneState()
+
+	ok, err := and.run(p)
+	if err != nil {
+		p.addErr(err)
+	}
+	p.restoreState(state)
+
+	return nil, ok
+}
+
+func (p *parser) parseAndExpr(and *andExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseAndExpr"))
+	}
+
+	pt := p.pt
+	state := p.cloneState()
+	p.pushV()
+	_, ok := p.parseExprWrap(and.expr)
+	p.popV()
+	p.restoreState(state)
+	p.restore(pt)
+
+	return nil, ok
+}
+
+func (p *parser) parseAnyMatcher(any *anyMatcher) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseAnyMatcher"))
+	}
+
+	if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+		// EOF - see utf8.DecodeRune
+		p.failAt(false, p.pt.position, ".")
+		return nil, false
+	}
+	start := p.pt
+	p.read()
+	p.failAt(true, start.position, ".")
+	return p.sliceFrom(start), true
+}
+
+// nolint: gocyclo
+func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseCharClassMatcher"))
+	}
+
+	cur := p.pt.rn
+	start := p.pt
+
+	// can't match EOF
+	if cur == utf8.RuneError && p.pt.w == 0 { // see utf8.DecodeRune
+		p.failAt(false, start.position, chr.val)
+		return nil, false
+	}
+
+	if chr.ignoreCase {
+		cur = unicode.ToLower(cur)
+	}
+
+	// try to match in the list of available chars
+	for _, rn := range chr.chars {
+		if rn == cur {
+			if chr.inverted {
+				p.failAt(false, start.position, chr.val)
+				return nil, false
+			}
+			p.read()
+			p.failAt(true, start.position, chr.val)
+			return p.sliceFrom(start), true
+		}
+	}
+
+	// try to match in the list of ranges
+	for i := 0; i < len(chr.ranges); i += 2 {
+		if cur >= chr.ranges[i] && cur <= chr.ranges[i+1] {
+			if chr.inverted {
+				p.failAt(false, start.position, chr.val)
+				return nil, false
+			}
+			p.read()
+			p.failAt(true, start.position, chr.val)
+			return p.sliceFrom(start), true
+		}
+	}
+
+	// try to match in the list of Unicode classes
+	for _, cl := range chr.classes {
+		if unicode.Is(cl, cur) {
+			if chr.inverted {
+				p.failAt(false, start.position, chr.val)
+				return nil, false
+			}
+			p.read()
+			p.failAt(true, start.position, chr.val)
+			return p.sliceFrom(start), true
+		}
+	}
+
+	if chr.inverted {
+		p.read()
+		p.failAt(true, start.position, chr.val)
+		return p.sliceFrom(start), true
+	}
+	p.failAt(false, start.position, chr.val)
+	return nil, false
+}
+
+func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseChoiceExpr"))
+	}
+
+	n := len(ch.alternatives)
+	var alts []int
+	if d := ch.dispatch; d != nil {
+		// the alternatives that cannot match the current rune are skipped,
+		// with the values they expect.
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		alts = d.alts[set]
+		n = len(alts)
+	}
+	for i := 0; i < n; i++ {
+		altI := i
+		if alts != nil {
+			altI = alts[i]
+		}
+		alt := ch.alternatives[altI]
+
+		state := p.cloneState()
+
+		p.pushV()
+		val, ok := p.parseExprWrap(alt)
+		p.popV()
+		if ok {
+			p.incChoiceAltCnt(ch.pos, altI)
+			return val, ok
+		}
+		p.restoreState(state)
+	}
+	p.incChoiceAltCnt(ch.pos, choiceNoMatch)
+	return nil, false
+}
+
+func (p *parser) parseLabeledExpr(lab *labeledExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseLabeledExpr"))
+	}
+
+	p.pushV()
+	val, ok := p.parseExprWrap(lab.expr)
+	p.popV()
+	if ok && lab.label != "" {
+		m := p.vstack[len(p.vstack)-1]
+		m[lab.label] = val
+	}
+	return val, ok
+}
+
+func (p *parser) parseLitMatcher(lit *litMatcher) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseLitMatcher"))
+	}
+
+	start := p.pt
+	for _, want := range lit.val {
+		cur := p.pt.rn
+		if lit.ignoreCase {
+			cur = unicode.ToLower(cur)
+		}
+		if cur != want {
+			p.failAt(false, start.position, lit.want)
+			p.restore(start)
+			return nil, false
+		}
+		p.read()
+	}
+	p.failAt(true, start.position, lit.want)
+	return p.sliceFrom(start), true
+}
+
+func (p *parser) parseNotCodeExpr(not *notCodeExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseNotCodeExpr"))
+	}
+
+	state := p.cloneState()
+
+	ok, err := not.run(p)
+	if err != nil {
+		p.addErr(err)
+	}
+	p.restoreState(state)
+
+	return nil, !ok
+}
+
+func (p *parser) parseNotExpr(not *notExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseNotExpr"))
+	}
+
+	pt := p.pt
+	state := p.cloneState()
+	p.pushV()
+	p.maxFailInvertExpected = !p.maxFailInvertExpected
+	_, ok := p.parseExprWrap(not.expr)
+	p.maxFailInvertExpected = !p.maxFailInvertExpected
+	p.popV()
+	p.restoreState(state)
+	p.restore(pt)
+
+	return nil, !ok
+}
+
+func (p *parser) parseOneOrMoreExpr(expr *oneOrMoreExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseOneOrMoreExpr"))
+	}
+
+	var vals []any
+
+	for {
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			if len(vals) == 0 {
+				// did not match once, no match
+				return nil, false
+			}
+			return vals, true
+		}
+		vals = append(vals, val)
+	}
+}
+
+func (p *parser) parseRecoveryExpr(recover *recoveryExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRecoveryExpr (" + strings.Join(recover.failureLabel, ",") + ")"))
+	}
+
+	p.pushRecovery(recover.failureLabel, recover.recoverExpr)
+	val, ok := p.parseExprWrap(recover.expr)
+	p.popRecovery()
+
+	return val, ok
+}
+
+func (p *parser) parseRepeatExpr(expr *repeatExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRepeatExpr"))
+	}
+
+	var vals []any
+
+	pt := p.pt
+	state := p.cloneState()
+	for expr.max < 0 || len(vals) < expr.max {
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			break
+		}
+		vals = append(vals, val)
+	}
+	if len(vals) < expr.min {
+		// did not match enough times, no match
+		p.restoreState(state)
+		p.restore(pt)
+		return nil, false
+	}
+	return vals, true
+}
+
+func (p *parser) parseRuleRefExpr(ref *ruleRefExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRuleRefExpr " + ref.name))
+	}
+
+	if ref.name == "" {
+		panic(fmt.Sprintf("%s: invalid rule: missing name", ref.pos))
+	}
+
+	rule := p.rules[ref.name]
+	if rule == nil {
+		p.addErr(fmt.Errorf("undefined rule: %s", ref.name))
+		return nil, false
+	}
+	return p.parseRuleWrap(rule)
+}
+
+func (p *parser) parseSeqExpr(seq *seqExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseSeqExpr"))
+	}
+
+	vals := make([]any, 0, len(seq.exprs))
+
+	pt := p.pt
+	state := p.cloneState()
+	for _, expr := range seq.exprs {
+		val, ok := p.parseExprWrap(expr)
+		if !ok {
+			p.restoreState(state)
+			p.restore(pt)
+			return nil, false
+		}
+		vals = append(vals, val)
+	}
+	if len(seq.pluck) > 0 {
+		return p.pluck(vals, seq.pluck), true
+	}
+	return vals, true
+}
+
+// pluck returns the value of a sequence with pluck expressions at the
+// indexes ix: the value at the index if there is only one, the slice of
+// the values at the indexes otherwise.
+func (p *parser) pluck(vals []any, ix []int) any {
+	if len(ix) == 1 {
+		return vals[ix[0]]
+	}
+	plucked := make([]any, len(ix))
+	for i, j := range ix {
+		plucked[i] = vals[j]
+	}
+	return plucked
+}
+
+func (p *parser) parseStateCodeExpr(state *stateCodeExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseStateCodeExpr"))
+	}
+
+	err := state.run(p)
+	if err != nil {
+		p.addErr(err)
+	}
+	return nil, true
+}
+
+func (p *parser) parseZeroOrMoreExpr(expr *zeroOrMoreExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseZeroOrMoreExpr"))
+	}
+
+	var vals []any
+
+	for {
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			return vals, true
+		}
+		vals = append(vals, val)
+	}
+}
+
+func (p *parser) parseZeroOrOneExpr(expr *zeroOrOneExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseZeroOrOneExpr"))
+	}
+
+	p.pushV()
+	val, _ := p.parseExprWrap(expr.expr)
+	p.popV()
+	// whether it matched or not, consider it a match
+	return val, true
+}
@@ -0,0 +1,52 @@
+package charclass
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCharClass(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "bcd", want: []any{"consonants:bcd"}},
+		{in: "BcD xyz1 Añb", want: []any{"consonants:BcD", "ident:xyz1", "ident:Añb"}},
+		{in: "bce", want: []any{"ident:bce"}},
+		{in: "αβγ ΔΕ", want: []any{"greek:αβγ", "greek:ΔΕ"}},
+		{in: "1 + 日本", want: []any{"other:1", "other:+", "ident:日本"}},
+		// U+309B is in ID_Start, but not in XID_Start
+		{in: "゛", want: []any{"other:゛"}},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err != nil {
+			t.Errorf("%q: %v", tc.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%q: want %#v, got %#v", tc.in, tc.want, got)
+		}
+	}
+}
+
+func TestCharClassError(t *testing.T) {
+	cases := []struct {
+		in  string
+		err string
+	}{
+		{in: "", err: `1:1 (0): no match found, expected: [ \t], [\P{sc=Latin}--[\p{Greek}\p{gc=Z}]], [\p{Greek}&&\pL], [\p{XID_Start}] or [b-z--[eiou]]i`},
+		// U+0375 is a Greek numeral sign, which is not a letter
+		{in: "a ͵", err: `1:3 (2): no match found, expected: [ \t], [\P{sc=Latin}--[\p{Greek}\p{gc=Z}]], [\p{Greek}&&\pL], [\p{XID_Start}], [b-z--[eiou]]i or EOF`},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err == nil {
+			t.Errorf("%q: want error, got %#v", tc.in, got)
+			continue
+		}
+		if err.Error() != tc.err {
+			t.Errorf("%q: want error %q, got %q", tc.in, tc.err, err)
+		}
+	}
+}
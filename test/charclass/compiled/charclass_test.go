@@ -0,0 +1 @@
+../charclass_test.go
\ No newline at end of file
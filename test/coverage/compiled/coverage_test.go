@@ -0,0 +1 @@
+../coverage_test.go
\ No newline at end of file
@@ -0,0 +1,55 @@
+package coverage
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWriteCoverProfile(t *testing.T) {
+	for i := range coverCounts {
+		coverCounts[i] = 0
+	}
+
+	for _, in := range []string{"1, abc", "-12", "nil"} {
+		_, _ = Parse("", []byte(in))
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCoverProfile(&buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if lines[0] != "mode: count" {
+		t.Fatalf("want mode header, got %q", lines[0])
+	}
+	lines = lines[1:]
+	if len(lines) != len(coverPoints) {
+		t.Fatalf("want %d coverage points, got %d", len(coverPoints), len(lines))
+	}
+
+	want := map[string]string{
+		"test/coverage/coverage.peg:5:8 31 List rule":            "2",
+		"test/coverage/coverage.peg:5:28 51 List repetition":     "1",
+		"test/coverage/coverage.peg:7:8 81 Item alternative":     "2",
+		"test/coverage/coverage.peg:7:17 90 Item alternative":    "1",
+		"test/coverage/coverage.peg:7:24 97 Item alternative":    "0",
+		"test/coverage/coverage.peg:9:10 116 Number optional":    "1",
+		"test/coverage/coverage.peg:9:29 135 Number andcode":     "2",
+		"test/coverage/coverage.peg:11:8 189 Word not":           "1",
+		"test/coverage/coverage.peg:13:17 226 Keyword not":       "1",
+		"test/coverage/coverage.peg:15:14 249 Quoted repetition": "0",
+	}
+	for _, line := range lines {
+		ix := strings.LastIndexByte(line, ' ')
+		if exp, ok := want[line[:ix]]; ok {
+			if got := line[ix+1:]; got != exp {
+				t.Errorf("%s: want count %s, got %s", line[:ix], exp, got)
+			}
+			delete(want, line[:ix])
+		}
+	}
+	for k := range want {
+		t.Errorf("%s: missing coverage point", k)
+	}
+}
@@ -0,0 +1,3288 @@
+// Code generated by pigeon; DO NOT EDIT.
+
+package cut
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+var g = &grammar{
+	rules: []*rule{
+		{
+			name: "Program",
+			pos:  position{line: 9, col: 1, offset: 191},
+			id:   0,
+		},
+		{
+			name: "Stmt",
+			pos:  position{line: 13, col: 1, offset: 248},
+			id:   1,
+		},
+		{
+			name: "If",
+			pos:  position{line: 15, col: 1, offset: 293},
+			id:   2,
+		},
+		{
+			name: "Print",
+			pos:  position{line: 19, col: 1, offset: 389},
+			id:   3,
+		},
+		{
+			name: "Goto",
+			pos:  position{line: 25, col: 1, offset: 525},
+			id:   4,
+		},
+		{
+			name: "Break",
+			pos:  position{line: 27, col: 1, offset: 567},
+			id:   5,
+		},
+		{
+			name: "Assign",
+			pos:  position{line: 31, col: 1, offset: 667},
+			id:   6,
+		},
+		{
+			name: "Ident",
+			pos:  position{line: 35, col: 1, offset: 754},
+			id:   7,
+		},
+		{
+			name: "Letter",
+			pos:  position{line: 39, col: 1, offset: 829},
+			id:   8,
+		},
+		{
+			name: "_",
+			pos:  position{line: 41, col: 1, offset: 847},
+			id:   9,
+		},
+		{
+			name: "EOF",
+			pos:  position{line: 43, col: 1, offset: 863},
+			id:   10,
+		},
+	},
+}
+
+func init() {
+	g.rules[0].run = (*parser).expr11
+	g.rules[1].run = (*parser).expr18
+	g.rules[2].run = (*parser).expr24
+	g.rules[3].run = (*parser).expr37
+	g.rules[4].run = (*parser).expr48
+	g.rules[5].run = (*parser).expr58
+	g.rules[6].run = (*parser).expr69
+	g.rules[7].run = (*parser).expr79
+	g.rules[8].run = (*parser).expr84
+	g.rules[9].run = (*parser).expr85
+	g.rules[10].run = (*parser).expr87
+}
+
+func (p *parser) expr11() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(11); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(12); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseSeqExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(13); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseRuleRefExpr _")
+							}
+							_, match8 = p.parseRuleWrap(g.rules[9])
+							if p.debug {
+								p.out("parseRuleRefExpr _")
+							}
+							p.compiledMemoize(pt10, 13, nil, match8)
+						}
+					}
+					if match8 {
+						var match11 bool
+						{
+							if res12, hit := p.compiledMemoized(14); hit {
+								_, match11 = res12.v, res12.b
+							} else {
+								pt13 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseLabeledExpr")
+								}
+								var v14 any
+								p.pushV()
+								{
+									if res15, hit := p.compiledMemoized(15); hit {
+										v14, match11 = res15.v, res15.b
+									} else {
+										pt16 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseZeroOrMoreExpr")
+										}
+										var vals17 []any
+										for {
+											var v19 any
+											var match18 bool
+											{
+												if res20, hit := p.compiledMemoized(16); hit {
+													v19, match18 = res20.v, res20.b
+												} else {
+													pt21 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseRuleRefExpr Stmt")
+													}
+													v19, match18 = p.parseRuleWrap(g.rules[1])
+													if p.debug {
+														p.out("parseRuleRefExpr Stmt")
+													}
+													p.compiledMemoize(pt21, 16, v19, match18)
+												}
+											}
+											if !match18 {
+												break
+											}
+											vals17 = append(vals17, v19)
+										}
+										v14 = vals17
+										match11 = true
+										if p.debug {
+											p.out("parseZeroOrMoreExpr")
+										}
+										p.compiledMemoize(pt16, 15, v14, match11)
+									}
+								}
+								p.popV()
+								if match11 {
+									p.vstack[len(p.vstack)-1]["stmts"] = v14
+									_ = v14
+								}
+								if p.debug {
+									p.out("parseLabeledExpr")
+								}
+								p.compiledMemoize(pt13, 14, nil, match11)
+							}
+						}
+						if match11 {
+							var match22 bool
+							{
+								if res23, hit := p.compiledMemoized(17); hit {
+									_, match22 = res23.v, res23.b
+								} else {
+									pt24 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseRuleRefExpr EOF")
+									}
+									_, match22 = p.parseRuleWrap(g.rules[10])
+									if p.debug {
+										p.out("parseRuleRefExpr EOF")
+									}
+									p.compiledMemoize(pt24, 17, nil, match22)
+								}
+							}
+							if match22 {
+								ok = true
+							}
+						}
+					}
+					if !ok {
+						p.restoreState(state7)
+						p.restore(pt6)
+					}
+					if p.debug {
+						p.out("parseSeqExpr")
+					}
+					p.compiledMemoize(pt5, 12, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonProgram1()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 11, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr18() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(18); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseChoiceExpr")
+			}
+			d := expr18Dispatch
+			set := d.set(p.pt)
+			for _, want := range d.expected[set] {
+				p.failAt(false, p.pt.position, want)
+			}
+			for _, altI := range d.alts[set] {
+				switch altI {
+				case 0:
+					state3 := p.cloneState()
+					{
+						if res4, hit := p.compiledMemoized(19); hit {
+							val, ok = res4.v, res4.b
+						} else {
+							pt5 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseRuleRefExpr If")
+							}
+							val, ok = p.parseRuleWrap(g.rules[2])
+							if p.debug {
+								p.out("parseRuleRefExpr If")
+							}
+							p.compiledMemoize(pt5, 19, val, ok)
+						}
+					}
+					if ok {
+						p.incChoiceAltCnt(position{line: 13, col: 8, offset: 257}, altI)
+					}
+					if !ok {
+						p.restoreState(state3)
+					}
+				case 1:
+					state6 := p.cloneState()
+					{
+						if res7, hit := p.compiledMemoized(20); hit {
+							val, ok = res7.v, res7.b
+						} else {
+							pt8 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseRuleRefExpr Print")
+							}
+							val, ok = p.parseRuleWrap(g.rules[3])
+							if p.debug {
+								p.out("parseRuleRefExpr Print")
+							}
+							p.compiledMemoize(pt8, 20, val, ok)
+						}
+					}
+					if ok {
+						p.incChoiceAltCnt(position{line: 13, col: 8, offset: 257}, altI)
+					}
+					if !ok {
+						p.restoreState(state6)
+					}
+				case 2:
+					state9 := p.cloneState()
+					{
+						if res10, hit := p.compiledMemoized(21); hit {
+							val, ok = res10.v, res10.b
+						} else {
+							pt11 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseRuleRefExpr Goto")
+							}
+							val, ok = p.parseRuleWrap(g.rules[4])
+							if p.debug {
+								p.out("parseRuleRefExpr Goto")
+							}
+							p.compiledMemoize(pt11, 21, val, ok)
+						}
+					}
+					if ok {
+						p.incChoiceAltCnt(position{line: 13, col: 8, offset: 257}, altI)
+					}
+					if !ok {
+						p.restoreState(state9)
+					}
+				case 3:
+					state12 := p.cloneState()
+					{
+						if res13, hit := p.compiledMemoized(22); hit {
+							val, ok = res13.v, res13.b
+						} else {
+							pt14 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseRuleRefExpr Break")
+							}
+							val, ok = p.parseRuleWrap(g.rules[5])
+							if p.debug {
+								p.out("parseRuleRefExpr Break")
+							}
+							p.compiledMemoize(pt14, 22, val, ok)
+						}
+					}
+					if ok {
+						p.incChoiceAltCnt(position{line: 13, col: 8, offset: 257}, altI)
+					}
+					if !ok {
+						p.restoreState(state12)
+					}
+				case 4:
+					state15 := p.cloneState()
+					{
+						if res16, hit := p.compiledMemoized(23); hit {
+							val, ok = res16.v, res16.b
+						} else {
+							pt17 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseRuleRefExpr Assign")
+							}
+							val, ok = p.parseRuleWrap(g.rules[6])
+							if p.debug {
+								p.out("parseRuleRefExpr Assign")
+							}
+							p.compiledMemoize(pt17, 23, val, ok)
+						}
+					}
+					if ok {
+						p.incChoiceAltCnt(position{line: 13, col: 8, offset: 257}, altI)
+					}
+					if !ok {
+						p.restoreState(state15)
+					}
+				}
+				if ok {
+					break
+				}
+			}
+			if !ok {
+				p.incChoiceAltCnt(position{line: 13, col: 8, offset: 257}, choiceNoMatch)
+			}
+			if p.debug {
+				p.out("parseChoiceExpr")
+			}
+			p.compiledMemoize(pt2, 18, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr24() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(24); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(25); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseSeqExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(26); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseLitMatcher")
+							}
+							start := p.pt
+							if end := p.pt.offset + 2; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "if" {
+								for i := 0; i < 2; i++ {
+									p.read()
+								}
+								p.failAt(true, start.position, "\"if\"")
+								match8 = true
+							} else {
+								p.failAt(false, start.position, "\"if\"")
+							}
+							if p.debug {
+								p.out("parseLitMatcher")
+							}
+							p.compiledMemoize(pt10, 26, nil, match8)
+						}
+					}
+					if match8 {
+						var match11 bool
+						{
+							if res12, hit := p.compiledMemoized(27); hit {
+								_, match11 = res12.v, res12.b
+							} else {
+								pt13 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseNotExpr")
+								}
+								pt14 := p.pt
+								state15 := p.cloneState()
+								p.maxFailInvertExpected = !p.maxFailInvertExpected
+								var match16 bool
+								{
+									if res17, hit := p.compiledMemoized(28); hit {
+										_, match16 = res17.v, res17.b
+									} else {
+										pt18 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr Letter")
+										}
+										_, match16 = p.parseRuleWrap(g.rules[8])
+										if p.debug {
+											p.out("parseRuleRefExpr Letter")
+										}
+										p.compiledMemoize(pt18, 28, nil, match16)
+									}
+								}
+								p.maxFailInvertExpected = !p.maxFailInvertExpected
+								p.restoreState(state15)
+								p.restore(pt14)
+								match11 = !match16
+								if p.debug {
+									p.out("parseNotExpr")
+								}
+								p.compiledMemoize(pt13, 27, nil, match11)
+							}
+						}
+						if match11 {
+							var match19 bool
+							{
+								if res20, hit := p.compiledMemoized(29); hit {
+									_, match19 = res20.v, res20.b
+								} else {
+									pt21 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseCutExpr")
+									}
+									match19 = true
+									if p.debug {
+										p.out("parseCutExpr")
+									}
+									p.compiledMemoize(pt21, 29, nil, match19)
+								}
+							}
+							if match19 {
+								var match22 bool
+								{
+									if res23, hit := p.compiledMemoized(30); hit {
+										_, match22 = res23.v, res23.b
+									} else {
+										pt24 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr _")
+										}
+										_, match22 = p.parseRuleWrap(g.rules[9])
+										if p.debug {
+											p.out("parseRuleRefExpr _")
+										}
+										p.compiledMemoize(pt24, 30, nil, match22)
+									}
+								}
+								if match22 {
+									var match25 bool
+									{
+										if res26, hit := p.compiledMemoized(31); hit {
+											_, match25 = res26.v, res26.b
+										} else {
+											pt27 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseLabeledExpr")
+											}
+											var v28 any
+											p.pushV()
+											{
+												if res29, hit := p.compiledMemoized(32); hit {
+													v28, match25 = res29.v, res29.b
+												} else {
+													pt30 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseRuleRefExpr Ident")
+													}
+													v28, match25 = p.parseRuleWrap(g.rules[7])
+													if p.debug {
+														p.out("parseRuleRefExpr Ident")
+													}
+													p.compiledMemoize(pt30, 32, v28, match25)
+												}
+											}
+											p.popV()
+											if match25 {
+												p.vstack[len(p.vstack)-1]["cond"] = v28
+												_ = v28
+											}
+											if p.debug {
+												p.out("parseLabeledExpr")
+											}
+											p.compiledMemoize(pt27, 31, nil, match25)
+										}
+									}
+									if match25 {
+										var match31 bool
+										{
+											if res32, hit := p.compiledMemoized(33); hit {
+												_, match31 = res32.v, res32.b
+											} else {
+												pt33 := p.pt
+												p.countExpr()
+												if p.debug {
+													p.in("parseLitMatcher")
+												}
+												start := p.pt
+												if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == ":" {
+													p.read()
+													p.failAt(true, start.position, "\":\"")
+													match31 = true
+												} else {
+													p.failAt(false, start.position, "\":\"")
+												}
+												if p.debug {
+													p.out("parseLitMatcher")
+												}
+												p.compiledMemoize(pt33, 33, nil, match31)
+											}
+										}
+										if match31 {
+											var match34 bool
+											{
+												if res35, hit := p.compiledMemoized(34); hit {
+													_, match34 = res35.v, res35.b
+												} else {
+													pt36 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseRuleRefExpr _")
+													}
+													_, match34 = p.parseRuleWrap(g.rules[9])
+													if p.debug {
+														p.out("parseRuleRefExpr _")
+													}
+													p.compiledMemoize(pt36, 34, nil, match34)
+												}
+											}
+											if match34 {
+												var match37 bool
+												{
+													if res38, hit := p.compiledMemoized(35); hit {
+														_, match37 = res38.v, res38.b
+													} else {
+														pt39 := p.pt
+														p.countExpr()
+														if p.debug {
+															p.in("parseLabeledExpr")
+														}
+														var v40 any
+														p.pushV()
+														{
+															if res41, hit := p.compiledMemoized(36); hit {
+																v40, match37 = res41.v, res41.b
+															} else {
+																pt42 := p.pt
+																p.countExpr()
+																if p.debug {
+																	p.in("parseRuleRefExpr Stmt")
+																}
+																v40, match37 = p.parseRuleWrap(g.rules[1])
+																if p.debug {
+																	p.out("parseRuleRefExpr Stmt")
+																}
+																p.compiledMemoize(pt42, 36, v40, match37)
+															}
+														}
+														p.popV()
+														if match37 {
+															p.vstack[len(p.vstack)-1]["body"] = v40
+															_ = v40
+														}
+														if p.debug {
+															p.out("parseLabeledExpr")
+														}
+														p.compiledMemoize(pt39, 35, nil, match37)
+													}
+												}
+												if match37 {
+													ok = true
+												} else {
+													panic(errCutFailure)
+												}
+											} else {
+												panic(errCutFailure)
+											}
+										} else {
+											panic(errCutFailure)
+										}
+									} else {
+										panic(errCutFailure)
+									}
+								} else {
+									panic(errCutFailure)
+								}
+							}
+						}
+					}
+					if !ok {
+						p.restoreState(state7)
+						p.restore(pt6)
+					}
+					if p.debug {
+						p.out("parseSeqExpr")
+					}
+					p.compiledMemoize(pt5, 25, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonIf1()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 24, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr37() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(37); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(38); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseSeqExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(39); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseLitMatcher")
+							}
+							start := p.pt
+							if end := p.pt.offset + 5; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "print" {
+								for i := 0; i < 5; i++ {
+									p.read()
+								}
+								p.failAt(true, start.position, "\"print\"")
+								match8 = true
+							} else {
+								p.failAt(false, start.position, "\"print\"")
+							}
+							if p.debug {
+								p.out("parseLitMatcher")
+							}
+							p.compiledMemoize(pt10, 39, nil, match8)
+						}
+					}
+					if match8 {
+						var match11 bool
+						{
+							if res12, hit := p.compiledMemoized(40); hit {
+								_, match11 = res12.v, res12.b
+							} else {
+								pt13 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseNotExpr")
+								}
+								pt14 := p.pt
+								state15 := p.cloneState()
+								p.maxFailInvertExpected = !p.maxFailInvertExpected
+								var match16 bool
+								{
+									if res17, hit := p.compiledMemoized(41); hit {
+										_, match16 = res17.v, res17.b
+									} else {
+										pt18 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr Letter")
+										}
+										_, match16 = p.parseRuleWrap(g.rules[8])
+										if p.debug {
+											p.out("parseRuleRefExpr Letter")
+										}
+										p.compiledMemoize(pt18, 41, nil, match16)
+									}
+								}
+								p.maxFailInvertExpected = !p.maxFailInvertExpected
+								p.restoreState(state15)
+								p.restore(pt14)
+								match11 = !match16
+								if p.debug {
+									p.out("parseNotExpr")
+								}
+								p.compiledMemoize(pt13, 40, nil, match11)
+							}
+						}
+						if match11 {
+							var match19 bool
+							{
+								if res20, hit := p.compiledMemoized(42); hit {
+									_, match19 = res20.v, res20.b
+								} else {
+									pt21 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseCutExpr")
+									}
+									match19 = true
+									if p.debug {
+										p.out("parseCutExpr")
+									}
+									p.compiledMemoize(pt21, 42, nil, match19)
+								}
+							}
+							if match19 {
+								var match22 bool
+								{
+									if res23, hit := p.compiledMemoized(43); hit {
+										_, match22 = res23.v, res23.b
+									} else {
+										pt24 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr _")
+										}
+										_, match22 = p.parseRuleWrap(g.rules[9])
+										if p.debug {
+											p.out("parseRuleRefExpr _")
+										}
+										p.compiledMemoize(pt24, 43, nil, match22)
+									}
+								}
+								if match22 {
+									var match25 bool
+									{
+										if res26, hit := p.compiledMemoized(44); hit {
+											_, match25 = res26.v, res26.b
+										} else {
+											pt27 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseLabeledExpr")
+											}
+											var v28 any
+											p.pushV()
+											{
+												if res29, hit := p.compiledMemoized(45); hit {
+													v28, match25 = res29.v, res29.b
+												} else {
+													pt30 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseRuleRefExpr Ident")
+													}
+													v28, match25 = p.parseRuleWrap(g.rules[7])
+													if p.debug {
+														p.out("parseRuleRefExpr Ident")
+													}
+													p.compiledMemoize(pt30, 45, v28, match25)
+												}
+											}
+											p.popV()
+											if match25 {
+												p.vstack[len(p.vstack)-1]["val"] = v28
+												_ = v28
+											}
+											if p.debug {
+												p.out("parseLabeledExpr")
+											}
+											p.compiledMemoize(pt27, 44, nil, match25)
+										}
+									}
+									if match25 {
+										var match31 bool
+										{
+											if res32, hit := p.compiledMemoized(46); hit {
+												_, match31 = res32.v, res32.b
+											} else {
+												pt33 := p.pt
+												p.countExpr()
+												if p.debug {
+													p.in("parseLitMatcher")
+												}
+												start := p.pt
+												if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == ";" {
+													p.read()
+													p.failAt(true, start.position, "\";\"")
+													match31 = true
+												} else {
+													p.failAt(false, start.position, "\";\"")
+												}
+												if p.debug {
+													p.out("parseLitMatcher")
+												}
+												p.compiledMemoize(pt33, 46, nil, match31)
+											}
+										}
+										if match31 {
+											var match34 bool
+											{
+												if res35, hit := p.compiledMemoized(47); hit {
+													_, match34 = res35.v, res35.b
+												} else {
+													pt36 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseRuleRefExpr _")
+													}
+													_, match34 = p.parseRuleWrap(g.rules[9])
+													if p.debug {
+														p.out("parseRuleRefExpr _")
+													}
+													p.compiledMemoize(pt36, 47, nil, match34)
+												}
+											}
+											if match34 {
+												ok = true
+											} else {
+												panic(errCutFailure)
+											}
+										} else {
+											panic(errCutFailure)
+										}
+									} else {
+										panic(errCutFailure)
+									}
+								} else {
+									panic(errCutFailure)
+								}
+							}
+						}
+					}
+					if !ok {
+						p.restoreState(state7)
+						p.restore(pt6)
+					}
+					if p.debug {
+						p.out("parseSeqExpr")
+					}
+					p.compiledMemoize(pt5, 38, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonPrint1()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 37, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr48() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(48); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			var match5 bool
+			{
+				if res6, hit := p.compiledMemoized(49); hit {
+					_, match5 = res6.v, res6.b
+				} else {
+					pt7 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLitMatcher")
+					}
+					start := p.pt
+					if end := p.pt.offset + 4; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "goto" {
+						for i := 0; i < 4; i++ {
+							p.read()
+						}
+						p.failAt(true, start.position, "\"goto\"")
+						match5 = true
+					} else {
+						p.failAt(false, start.position, "\"goto\"")
+					}
+					if p.debug {
+						p.out("parseLitMatcher")
+					}
+					p.compiledMemoize(pt7, 49, nil, match5)
+				}
+			}
+			if match5 {
+				var match8 bool
+				{
+					if res9, hit := p.compiledMemoized(50); hit {
+						_, match8 = res9.v, res9.b
+					} else {
+						pt10 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseNotExpr")
+						}
+						pt11 := p.pt
+						state12 := p.cloneState()
+						p.maxFailInvertExpected = !p.maxFailInvertExpected
+						var match13 bool
+						{
+							if res14, hit := p.compiledMemoized(51); hit {
+								_, match13 = res14.v, res14.b
+							} else {
+								pt15 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseRuleRefExpr Letter")
+								}
+								_, match13 = p.parseRuleWrap(g.rules[8])
+								if p.debug {
+									p.out("parseRuleRefExpr Letter")
+								}
+								p.compiledMemoize(pt15, 51, nil, match13)
+							}
+						}
+						p.maxFailInvertExpected = !p.maxFailInvertExpected
+						p.restoreState(state12)
+						p.restore(pt11)
+						match8 = !match13
+						if p.debug {
+							p.out("parseNotExpr")
+						}
+						p.compiledMemoize(pt10, 50, nil, match8)
+					}
+				}
+				if match8 {
+					var match16 bool
+					{
+						if res17, hit := p.compiledMemoized(52); hit {
+							_, match16 = res17.v, res17.b
+						} else {
+							pt18 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseCutExpr")
+							}
+							match16 = true
+							if p.debug {
+								p.out("parseCutExpr")
+							}
+							p.compiledMemoize(pt18, 52, nil, match16)
+						}
+					}
+					if match16 {
+						var match19 bool
+						{
+							if res20, hit := p.compiledMemoized(53); hit {
+								_, match19 = res20.v, res20.b
+							} else {
+								pt21 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseRuleRefExpr _")
+								}
+								_, match19 = p.parseRuleWrap(g.rules[9])
+								if p.debug {
+									p.out("parseRuleRefExpr _")
+								}
+								p.compiledMemoize(pt21, 53, nil, match19)
+							}
+						}
+						if match19 {
+							var v22 any
+							var match23 bool
+							{
+								if res24, hit := p.compiledMemoized(54); hit {
+									v22, match23 = res24.v, res24.b
+								} else {
+									pt25 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parsePluckExpr")
+									}
+									{
+										if res26, hit := p.compiledMemoized(55); hit {
+											v22, match23 = res26.v, res26.b
+										} else {
+											pt27 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseRuleRefExpr Ident")
+											}
+											v22, match23 = p.parseRuleWrap(g.rules[7])
+											if p.debug {
+												p.out("parseRuleRefExpr Ident")
+											}
+											p.compiledMemoize(pt27, 55, v22, match23)
+										}
+									}
+									if p.debug {
+										p.out("parsePluckExpr")
+									}
+									p.compiledMemoize(pt25, 54, v22, match23)
+								}
+							}
+							if match23 {
+								var match28 bool
+								{
+									if res29, hit := p.compiledMemoized(56); hit {
+										_, match28 = res29.v, res29.b
+									} else {
+										pt30 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseLitMatcher")
+										}
+										start := p.pt
+										if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == ";" {
+											p.read()
+											p.failAt(true, start.position, "\";\"")
+											match28 = true
+										} else {
+											p.failAt(false, start.position, "\";\"")
+										}
+										if p.debug {
+											p.out("parseLitMatcher")
+										}
+										p.compiledMemoize(pt30, 56, nil, match28)
+									}
+								}
+								if match28 {
+									var match31 bool
+									{
+										if res32, hit := p.compiledMemoized(57); hit {
+											_, match31 = res32.v, res32.b
+										} else {
+											pt33 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseRuleRefExpr _")
+											}
+											_, match31 = p.parseRuleWrap(g.rules[9])
+											if p.debug {
+												p.out("parseRuleRefExpr _")
+											}
+											p.compiledMemoize(pt33, 57, nil, match31)
+										}
+									}
+									if match31 {
+										val = v22
+										ok = true
+									} else {
+										panic(errCutFailure)
+									}
+								} else {
+									panic(errCutFailure)
+								}
+							} else {
+								panic(errCutFailure)
+							}
+						} else {
+							panic(errCutFailure)
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state4)
+				p.restore(pt3)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+			p.compiledMemoize(pt2, 48, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr58() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(58); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(59); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseSeqExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(60); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseLabeledExpr")
+							}
+							var v11 any
+							p.pushV()
+							{
+								if res12, hit := p.compiledMemoized(61); hit {
+									v11, match8 = res12.v, res12.b
+								} else {
+									pt13 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseSeqExpr")
+									}
+									pt14 := p.pt
+									state15 := p.cloneState()
+									var v16 any
+									var match17 bool
+									{
+										if res18, hit := p.compiledMemoized(62); hit {
+											v16, match17 = res18.v, res18.b
+										} else {
+											pt19 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseLitMatcher")
+											}
+											start := p.pt
+											if end := p.pt.offset + 5; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "break" {
+												for i := 0; i < 5; i++ {
+													p.read()
+												}
+												p.failAt(true, start.position, "\"break\"")
+												v16 = p.sliceFrom(start)
+												match17 = true
+											} else {
+												p.failAt(false, start.position, "\"break\"")
+											}
+											if p.debug {
+												p.out("parseLitMatcher")
+											}
+											p.compiledMemoize(pt19, 62, v16, match17)
+										}
+									}
+									if match17 {
+										var v20 any
+										var match21 bool
+										{
+											if res22, hit := p.compiledMemoized(63); hit {
+												v20, match21 = res22.v, res22.b
+											} else {
+												pt23 := p.pt
+												p.countExpr()
+												if p.debug {
+													p.in("parseNotExpr")
+												}
+												pt24 := p.pt
+												state25 := p.cloneState()
+												p.maxFailInvertExpected = !p.maxFailInvertExpected
+												var match26 bool
+												{
+													if res27, hit := p.compiledMemoized(64); hit {
+														_, match26 = res27.v, res27.b
+													} else {
+														pt28 := p.pt
+														p.countExpr()
+														if p.debug {
+															p.in("parseRuleRefExpr Letter")
+														}
+														_, match26 = p.parseRuleWrap(g.rules[8])
+														if p.debug {
+															p.out("parseRuleRefExpr Letter")
+														}
+														p.compiledMemoize(pt28, 64, nil, match26)
+													}
+												}
+												p.maxFailInvertExpected = !p.maxFailInvertExpected
+												p.restoreState(state25)
+												p.restore(pt24)
+												match21 = !match26
+												if p.debug {
+													p.out("parseNotExpr")
+												}
+												p.compiledMemoize(pt23, 63, v20, match21)
+											}
+										}
+										if match21 {
+											var match29 bool
+											{
+												if res30, hit := p.compiledMemoized(65); hit {
+													_, match29 = res30.v, res30.b
+												} else {
+													pt31 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseCutExpr")
+													}
+													match29 = true
+													if p.debug {
+														p.out("parseCutExpr")
+													}
+													p.compiledMemoize(pt31, 65, nil, match29)
+												}
+											}
+											if match29 {
+												var v32 any
+												var match33 bool
+												{
+													if res34, hit := p.compiledMemoized(66); hit {
+														v32, match33 = res34.v, res34.b
+													} else {
+														pt35 := p.pt
+														p.countExpr()
+														if p.debug {
+															p.in("parseRuleRefExpr _")
+														}
+														v32, match33 = p.parseRuleWrap(g.rules[9])
+														if p.debug {
+															p.out("parseRuleRefExpr _")
+														}
+														p.compiledMemoize(pt35, 66, v32, match33)
+													}
+												}
+												if match33 {
+													var v36 any
+													var match37 bool
+													{
+														if res38, hit := p.compiledMemoized(67); hit {
+															v36, match37 = res38.v, res38.b
+														} else {
+															pt39 := p.pt
+															p.countExpr()
+															if p.debug {
+																p.in("parseLitMatcher")
+															}
+															start := p.pt
+															if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == ";" {
+																p.read()
+																p.failAt(true, start.position, "\";\"")
+																v36 = p.sliceFrom(start)
+																match37 = true
+															} else {
+																p.failAt(false, start.position, "\";\"")
+															}
+															if p.debug {
+																p.out("parseLitMatcher")
+															}
+															p.compiledMemoize(pt39, 67, v36, match37)
+														}
+													}
+													if match37 {
+														v11 = []any{v16, v20, v32, v36}
+														match8 = true
+													} else {
+														panic(errCutFailure)
+													}
+												} else {
+													panic(errCutFailure)
+												}
+											}
+										}
+									}
+									if !match8 {
+										p.restoreState(state15)
+										p.restore(pt14)
+									}
+									if p.debug {
+										p.out("parseSeqExpr")
+									}
+									p.compiledMemoize(pt13, 61, v11, match8)
+								}
+							}
+							p.popV()
+							if match8 {
+								p.vstack[len(p.vstack)-1]["vals"] = v11
+								_ = v11
+							}
+							if p.debug {
+								p.out("parseLabeledExpr")
+							}
+							p.compiledMemoize(pt10, 60, nil, match8)
+						}
+					}
+					if match8 {
+						var match40 bool
+						{
+							if res41, hit := p.compiledMemoized(68); hit {
+								_, match40 = res41.v, res41.b
+							} else {
+								pt42 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseRuleRefExpr _")
+								}
+								_, match40 = p.parseRuleWrap(g.rules[9])
+								if p.debug {
+									p.out("parseRuleRefExpr _")
+								}
+								p.compiledMemoize(pt42, 68, nil, match40)
+							}
+						}
+						if match40 {
+							ok = true
+						}
+					}
+					if !ok {
+						p.restoreState(state7)
+						p.restore(pt6)
+					}
+					if p.debug {
+						p.out("parseSeqExpr")
+					}
+					p.compiledMemoize(pt5, 59, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonBreak1()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 58, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr69() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(69); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(70); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseSeqExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(71); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseLabeledExpr")
+							}
+							var v11 any
+							p.pushV()
+							{
+								if res12, hit := p.compiledMemoized(72); hit {
+									v11, match8 = res12.v, res12.b
+								} else {
+									pt13 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseRuleRefExpr Ident")
+									}
+									v11, match8 = p.parseRuleWrap(g.rules[7])
+									if p.debug {
+										p.out("parseRuleRefExpr Ident")
+									}
+									p.compiledMemoize(pt13, 72, v11, match8)
+								}
+							}
+							p.popV()
+							if match8 {
+								p.vstack[len(p.vstack)-1]["name"] = v11
+								_ = v11
+							}
+							if p.debug {
+								p.out("parseLabeledExpr")
+							}
+							p.compiledMemoize(pt10, 71, nil, match8)
+						}
+					}
+					if match8 {
+						var match14 bool
+						{
+							if res15, hit := p.compiledMemoized(73); hit {
+								_, match14 = res15.v, res15.b
+							} else {
+								pt16 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseLitMatcher")
+								}
+								start := p.pt
+								if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "=" {
+									p.read()
+									p.failAt(true, start.position, "\"=\"")
+									match14 = true
+								} else {
+									p.failAt(false, start.position, "\"=\"")
+								}
+								if p.debug {
+									p.out("parseLitMatcher")
+								}
+								p.compiledMemoize(pt16, 73, nil, match14)
+							}
+						}
+						if match14 {
+							var match17 bool
+							{
+								if res18, hit := p.compiledMemoized(74); hit {
+									_, match17 = res18.v, res18.b
+								} else {
+									pt19 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseRuleRefExpr _")
+									}
+									_, match17 = p.parseRuleWrap(g.rules[9])
+									if p.debug {
+										p.out("parseRuleRefExpr _")
+									}
+									p.compiledMemoize(pt19, 74, nil, match17)
+								}
+							}
+							if match17 {
+								var match20 bool
+								{
+									if res21, hit := p.compiledMemoized(75); hit {
+										_, match20 = res21.v, res21.b
+									} else {
+										pt22 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseLabeledExpr")
+										}
+										var v23 any
+										p.pushV()
+										{
+											if res24, hit := p.compiledMemoized(76); hit {
+												v23, match20 = res24.v, res24.b
+											} else {
+												pt25 := p.pt
+												p.countExpr()
+												if p.debug {
+													p.in("parseRuleRefExpr Ident")
+												}
+												v23, match20 = p.parseRuleWrap(g.rules[7])
+												if p.debug {
+													p.out("parseRuleRefExpr Ident")
+												}
+												p.compiledMemoize(pt25, 76, v23, match20)
+											}
+										}
+										p.popV()
+										if match20 {
+											p.vstack[len(p.vstack)-1]["val"] = v23
+											_ = v23
+										}
+										if p.debug {
+											p.out("parseLabeledExpr")
+										}
+										p.compiledMemoize(pt22, 75, nil, match20)
+									}
+								}
+								if match20 {
+									var match26 bool
+									{
+										if res27, hit := p.compiledMemoized(77); hit {
+											_, match26 = res27.v, res27.b
+										} else {
+											pt28 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseLitMatcher")
+											}
+											start := p.pt
+											if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == ";" {
+												p.read()
+												p.failAt(true, start.position, "\";\"")
+												match26 = true
+											} else {
+												p.failAt(false, start.position, "\";\"")
+											}
+											if p.debug {
+												p.out("parseLitMatcher")
+											}
+											p.compiledMemoize(pt28, 77, nil, match26)
+										}
+									}
+									if match26 {
+										var match29 bool
+										{
+											if res30, hit := p.compiledMemoized(78); hit {
+												_, match29 = res30.v, res30.b
+											} else {
+												pt31 := p.pt
+												p.countExpr()
+												if p.debug {
+													p.in("parseRuleRefExpr _")
+												}
+												_, match29 = p.parseRuleWrap(g.rules[9])
+												if p.debug {
+													p.out("parseRuleRefExpr _")
+												}
+												p.compiledMemoize(pt31, 78, nil, match29)
+											}
+										}
+										if match29 {
+											ok = true
+										}
+									}
+								}
+							}
+						}
+					}
+					if !ok {
+						p.restoreState(state7)
+						p.restore(pt6)
+					}
+					if p.debug {
+						p.out("parseSeqExpr")
+					}
+					p.compiledMemoize(pt5, 70, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonAssign1()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 69, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr79() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(79); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(80); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseSeqExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(81); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseOneOrMoreExpr")
+							}
+							n11 := 0
+							for {
+								var match12 bool
+								{
+									if res13, hit := p.compiledMemoized(82); hit {
+										_, match12 = res13.v, res13.b
+									} else {
+										pt14 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr Letter")
+										}
+										_, match12 = p.parseRuleWrap(g.rules[8])
+										if p.debug {
+											p.out("parseRuleRefExpr Letter")
+										}
+										p.compiledMemoize(pt14, 82, nil, match12)
+									}
+								}
+								if !match12 {
+									break
+								}
+								n11++
+							}
+							if n11 > 0 {
+								match8 = true
+							}
+							if p.debug {
+								p.out("parseOneOrMoreExpr")
+							}
+							p.compiledMemoize(pt10, 81, nil, match8)
+						}
+					}
+					if match8 {
+						var match15 bool
+						{
+							if res16, hit := p.compiledMemoized(83); hit {
+								_, match15 = res16.v, res16.b
+							} else {
+								pt17 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseRuleRefExpr _")
+								}
+								_, match15 = p.parseRuleWrap(g.rules[9])
+								if p.debug {
+									p.out("parseRuleRefExpr _")
+								}
+								p.compiledMemoize(pt17, 83, nil, match15)
+							}
+						}
+						if match15 {
+							ok = true
+						}
+					}
+					if !ok {
+						p.restoreState(state7)
+						p.restore(pt6)
+					}
+					if p.debug {
+						p.out("parseSeqExpr")
+					}
+					p.compiledMemoize(pt5, 80, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonIdent1()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 79, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr84() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(84); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseCharClassMatcher")
+			}
+			cur := p.pt.rn
+			start := p.pt
+			var matched bool
+			// can't match EOF
+			eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+			if !eof {
+				switch {
+				case cur >= 'a' && cur <= 'z':
+					matched = true
+				}
+			}
+			if matched && !eof {
+				p.read()
+				p.failAt(true, start.position, "[a-z]")
+				val = p.sliceFrom(start)
+				ok = true
+			} else {
+				p.failAt(false, start.position, "[a-z]")
+			}
+			if p.debug {
+				p.out("parseCharClassMatcher")
+			}
+			p.compiledMemoize(pt2, 84, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr85() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(85); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseZeroOrMoreExpr")
+			}
+			var vals3 []any
+			for {
+				var v5 any
+				var match4 bool
+				{
+					if res6, hit := p.compiledMemoized(86); hit {
+						v5, match4 = res6.v, res6.b
+					} else {
+						pt7 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseCharClassMatcher")
+						}
+						cur := p.pt.rn
+						start := p.pt
+						var matched bool
+						// can't match EOF
+						eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+						if !eof {
+							switch cur {
+							case ' ', '\t', '\n':
+								matched = true
+							}
+						}
+						if matched && !eof {
+							p.read()
+							p.failAt(true, start.position, "[ \\t\\n]")
+							v5 = p.sliceFrom(start)
+							match4 = true
+						} else {
+							p.failAt(false, start.position, "[ \\t\\n]")
+						}
+						if p.debug {
+							p.out("parseCharClassMatcher")
+						}
+						p.compiledMemoize(pt7, 86, v5, match4)
+					}
+				}
+				if !match4 {
+					break
+				}
+				vals3 = append(vals3, v5)
+			}
+			val = vals3
+			ok = true
+			if p.debug {
+				p.out("parseZeroOrMoreExpr")
+			}
+			p.compiledMemoize(pt2, 85, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr87() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(87); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseNotExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			p.maxFailInvertExpected = !p.maxFailInvertExpected
+			var match5 bool
+			{
+				if res6, hit := p.compiledMemoized(88); hit {
+					_, match5 = res6.v, res6.b
+				} else {
+					pt7 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseAnyMatcher")
+					}
+					if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+						// EOF - see utf8.DecodeRune
+						p.failAt(false, p.pt.position, ".")
+					} else {
+						start := p.pt
+						p.read()
+						p.failAt(true, start.position, ".")
+						match5 = true
+					}
+					if p.debug {
+						p.out("parseAnyMatcher")
+					}
+					p.compiledMemoize(pt7, 88, nil, match5)
+				}
+			}
+			p.maxFailInvertExpected = !p.maxFailInvertExpected
+			p.restoreState(state4)
+			p.restore(pt3)
+			ok = !match5
+			if p.debug {
+				p.out("parseNotExpr")
+			}
+			p.compiledMemoize(pt2, 87, val, ok)
+		}
+	}
+	return val, ok
+}
+
+var expr18Dispatch = &choiceDispatch{
+	ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x01\x01\x01\x01\x03\x01\x04\x01\x01\x01\x01\x01\x01\x05\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00",
+	ranges:    []rune{'\u0080'},
+	rangeSets: "\x00",
+	alts:      [][]int{{}, {4}, {3, 4}, {2, 4}, {0, 4}, {1, 4}},
+	expected:  [][]string{{"\"if\"", "\"print\"", "\"goto\"", "\"break\"", "[a-z]"}, {"\"if\"", "\"print\"", "\"goto\"", "\"break\""}, {"\"if\"", "\"print\"", "\"goto\""}, {"\"if\"", "\"print\"", "\"break\""}, {"\"print\"", "\"goto\"", "\"break\""}, {"\"if\"", "\"goto\"", "\"break\""}},
+}
+
+func (c *current) onProgram1(stmts any) (any, error) {
+	return stmts, nil
+}
+
+func (p *parser) callonProgram1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram1(stack["stmts"])
+}
+
+func (c *current) onIf1(cond, body any) (any, error) {
+	return []any{"if", cond, body}, nil
+}
+
+func (p *parser) callonIf1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIf1(stack["cond"], stack["body"])
+}
+
+func (c *current) onPrint1(val any) (any, error) {
+	return []any{"print", val}, nil
+}
+
+func (p *parser) callonPrint1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrint1(stack["val"])
+}
+
+func (c *current) onBreak1(vals any) (any, error) {
+	return []any{"break", len(vals.([]any))}, nil
+}
+
+func (p *parser) callonBreak1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBreak1(stack["vals"])
+}
+
+func (c *current) onAssign1(name, val any) (any, error) {
+	return []any{"=", name, val}, nil
+}
+
+func (p *parser) callonAssign1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAssign1(stack["name"], stack["val"])
+}
+
+func (c *current) onIdent1() (any, error) {
+	return strings.TrimSpace(string(c.text)), nil
+}
+
+func (p *parser) callonIdent1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIdent1()
+}
+
+var (
+	// errNoRule is returned when the grammar to parse has no rule.
+	errNoRule = errors.New("grammar has no rule")
+
+	// errInvalidEntrypoint is returned when the specified entrypoint rule
+	// does not exit.
+	errInvalidEntrypoint = errors.New("invalid entrypoint")
+
+	// errInvalidEncoding is returned when the source is not properly
+	// utf8-encoded.
+	errInvalidEncoding = errors.New("invalid encoding")
+
+	// errMaxExprCnt is used to signal that the maximum number of
+	// expressions have been parsed.
+	errMaxExprCnt = errors.New("max number of expressions parsed")
+
+	// errCutFailure is used to signal that an expression that follows a
+	// cut did not match, which stops the parsing.
+	errCutFailure = errors.New("no match after cut")
+)
+
+// Option is a function that can set an option on the parser. It returns
+// the previous setting as an Option.
+type Option func(*parser) Option
+
+// MaxExpressions creates an Option to stop parsing after the provided
+// number of expressions have been parsed, if the value is 0 then the parser will
+// parse for as many steps as needed (possibly an infinite number).
+//
+// The default for maxExprCnt is 0.
+func MaxExpressions(maxExprCnt uint64) Option {
+	return func(p *parser) Option {
+		oldMaxExprCnt := p.maxExprCnt
+		p.maxExprCnt = maxExprCnt
+		return MaxExpressions(oldMaxExprCnt)
+	}
+}
+
+// Entrypoint creates an Option to set the rule name to use as entrypoint.
+// The rule name must have been specified in the -alternate-entrypoints
+// if generating the parser with the -optimize-grammar flag, otherwise
+// it may have been optimized out. Passing an empty string sets the
+// entrypoint to the first rule in the grammar.
+//
+// The default is to start parsing at the first rule in the grammar.
+func Entrypoint(ruleName string) Option {
+	return func(p *parser) Option {
+		oldEntrypoint := p.entrypoint
+		p.entrypoint = ruleName
+		if ruleName == "" {
+			p.entrypoint = g.rules[0].name
+		}
+		return Entrypoint(oldEntrypoint)
+	}
+}
+
+// Statistics adds a user provided Stats struct to the parser to allow
+// the user to process the results after the parsing has finished.
+// Also the key for the "no match" counter is set. Per-rule statistics
+// (see RuleStats) are only collected when this option is set.
+//
+// Example usage:
+//
+//	input := "input"
+//	stats := Stats{}
+//	_, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	fmt.Println(string(b))
+func Statistics(stats *Stats, choiceNoMatch string) Option {
+	return func(p *parser) Option {
+		oldStats := p.Stats
+		p.Stats = stats
+		oldChoiceNoMatch := p.choiceNoMatch
+		p.choiceNoMatch = choiceNoMatch
+		if p.Stats.ChoiceAltCnt == nil {
+			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
+		}
+		if p.Stats.Rules == nil {
+			p.Stats.Rules = make(map[string]*RuleStats)
+		}
+		return Statistics(oldStats, oldChoiceNoMatch)
+	}
+}
+
+// Debug creates an Option to set the debug flag to b. When set to true,
+// debugging information is printed to stdout while parsing.
+//
+// The default is false.
+func Debug(b bool) Option {
+	return func(p *parser) Option {
+		old := p.debug
+		p.debug = b
+		return Debug(old)
+	}
+}
+
+// Memoize creates an Option to set the memoize flag to b. When set to true,
+// the parser will cache all results so each expression is evaluated only
+// once. This guarantees linear parsing time even for pathological cases,
+// at the expense of more memory and slower times for typical cases.
+// The rules annotated with @memo in the grammar are always memoized,
+// regardless of this option. The memoization table is pooled, so its
+// storage is reused by the following parses.
+//
+// The default is false.
+func Memoize(b bool) Option {
+	return func(p *parser) Option {
+		old := p.memoize
+		p.memoize = b
+		return Memoize(old)
+	}
+}
+
+// AllowInvalidUTF8 creates an Option to allow invalid UTF-8 bytes.
+// Every invalid UTF-8 byte is treated as a utf8.RuneError (U+FFFD)
+// by character class matchers and is matched by the any matcher.
+// The returned matched value, c.text and c.offset are NOT affected.
+//
+// The default is false.
+func AllowInvalidUTF8(b bool) Option {
+	return func(p *parser) Option {
+		old := p.allowInvalidUTF8
+		p.allowInvalidUTF8 = b
+		return AllowInvalidUTF8(old)
+	}
+}
+
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
+// Recover creates an Option to set the recover flag to b. When set to
+// true, this causes the parser to recover from panics and convert it
+// to an error. Setting it to false can be useful while debugging to
+// access the full stack trace.
+//
+// The default is true.
+func Recover(b bool) Option {
+	return func(p *parser) Option {
+		old := p.recover
+		p.recover = b
+		return Recover(old)
+	}
+}
+
+// GlobalStore creates an Option to set a key to a certain value in
+// the globalStore.
+func GlobalStore(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.globalStore[key]
+		p.cur.globalStore[key] = value
+		return GlobalStore(key, old)
+	}
+}
+
+// InitState creates an Option to set a key to a certain value in
+// the global "state" store.
+func InitState(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.state[key]
+		p.cur.state[key] = value
+		return InitState(key, old)
+	}
+}
+
+// ParseFile parses the file identified by filename.
+func ParseFile(filename string, opts ...Option) (i any, err error) { // nolint: deadcode
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+	return ParseReader(filename, f, opts...)
+}
+
+// ParseReader parses the data from r using filename as information in the
+// error messages.
+func ParseReader(filename string, r io.Reader, opts ...Option) (any, error) { // nolint: deadcode
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(filename, b, opts...)
+}
+
+// Parse parses the data from b using filename as information in the
+// error messages.
+func Parse(filename string, b []byte, opts ...Option) (any, error) {
+	return newParser(filename, b, opts...).parse(g)
+}
+
+// position records a position in the text.
+type position struct {
+	line, col, offset int
+}
+
+func (p position) String() string {
+	return strconv.Itoa(p.line) + ":" + strconv.Itoa(p.col) + " [" + strconv.Itoa(p.offset) + "]"
+}
+
+// savepoint stores all state required to go back to this point in the
+// parser.
+type savepoint struct {
+	position
+	rn rune
+	w  int
+}
+
+type current struct {
+	pos  position // start position of the match
+	text []byte   // raw text of the match
+
+	// state is a store for arbitrary key,value pairs that the user wants to be
+	// tied to the backtracking of the parser.
+	// This is always rolled back if a parsing rule fails.
+	state storeDict
+
+	// globalStore is a general store for the user to store arbitrary key-value
+	// pairs that they need to manage and that they do not want tied to the
+	// backtracking of the parser. This is only modified by the user and never
+	// rolled back by the parser. It is always up to the user to keep this in a
+	// consistent state.
+	globalStore storeDict
+}
+
+type storeDict map[string]any
+
+// the AST types...
+
+// nolint: structcheck
+type grammar struct {
+	pos   position
+	rules []*rule
+}
+
+// nolint: structcheck
+type rule struct {
+	pos         position
+	name        string
+	displayName string
+	run         func(*parser) (any, bool)
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+//
+//	nolint: structcheck
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
+}
+
+// errList cumulates the errors found by the parser.
+type errList []error
+
+func (e *errList) add(err error) {
+	*e = append(*e, err)
+}
+
+func (e errList) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	e.dedupe()
+	return e
+}
+
+func (e *errList) dedupe() {
+	var cleaned []error
+	set := make(map[string]bool)
+	for _, err := range *e {
+		if msg := err.Error(); !set[msg] {
+			set[msg] = true
+			cleaned = append(cleaned, err)
+		}
+	}
+	*e = cleaned
+}
+
+func (e errList) Error() string {
+	switch len(e) {
+	case 0:
+		return ""
+	case 1:
+		return e[0].Error()
+	default:
+		var buf bytes.Buffer
+
+		for i, err := range e {
+			if i > 0 {
+				buf.WriteRune('\n')
+			}
+			buf.WriteString(err.Error())
+		}
+		return buf.String()
+	}
+}
+
+// parserError wraps an error with a prefix indicating the rule in which
+// the error occurred. The original error is stored in the Inner field.
+type parserError struct {
+	Inner    error
+	pos      position
+	prefix   string
+	expected []string
+}
+
+// Error returns the error message.
+func (p *parserError) Error() string {
+	return p.prefix + ": " + p.Inner.Error()
+}
+
+// newParser creates a parser with the specified input source and options.
+func newParser(filename string, b []byte, opts ...Option) *parser {
+	stats := Stats{
+		ChoiceAltCnt: make(map[string]map[string]int),
+	}
+
+	p := &parser{
+		filename: filename,
+		errs:     new(errList),
+		data:     b,
+		pt:       savepoint{position: position{line: 1}},
+		recover:  true,
+		cur: current{
+			state:       make(storeDict),
+			globalStore: make(storeDict),
+		},
+		maxFailPos:      position{col: 1, line: 1},
+		maxFailExpected: make([]string, 0, 20),
+		Stats:           &stats,
+		// start rule is rule [0] unless an alternate entrypoint is specified
+		entrypoint: g.rules[0].name,
+	}
+	p.setOptions(opts)
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
+
+	if p.maxExprCnt == 0 {
+		p.maxExprCnt = math.MaxUint64
+	}
+
+	return p
+}
+
+// setOptions applies the options to the parser.
+func (p *parser) setOptions(opts []Option) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// nolint: structcheck,deadcode
+type resultTuple struct {
+	v   any
+	b   bool
+	end savepoint
+}
+
+// nolint: varcheck
+const choiceNoMatch = -1
+
+// Stats stores some statistics, gathered during parsing
+type Stats struct {
+	// ExprCnt counts the number of expressions processed during parsing
+	// This value is compared to the maximum number of expressions allowed
+	// (set by the MaxExpressions option).
+	ExprCnt uint64
+
+	// ChoiceAltCnt is used to count for each ordered choice expression,
+	// which alternative is used how may times.
+	// These numbers allow to optimize the order of the ordered choice expression
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
+	//
+	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
+	// as the line and the column of the ordered choice.
+	// The inner key of ChoiceAltCnt is the number (one-based) of the matching alternative.
+	// For each alternative the number of matches are counted. If an ordered choice does not
+	// match, a special counter is incremented. The name of this counter is set with
+	// the parser option Statistics.
+	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
+	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
+}
+
+// nolint: structcheck,maligned
+type parser struct {
+	filename string
+	pt       savepoint
+	cur      current
+
+	data []byte
+	errs *errList
+
+	depth   int
+	recover bool
+	debug   bool
+
+	memoize bool
+	// memoization table for the packrat algorithm, taken from memoTables
+	// on the first memoized result
+	memo *memoTable
+
+	// rules table, maps the rule identifier to the rule node
+	rules map[string]*rule
+	// variables stack, map of label to value
+	vstack []map[string]any
+	// rule stack, allows identification of the current rule in errors
+	rstack []*rule
+
+	// parse fail
+	maxFailPos            position
+	maxFailExpected       []string
+	maxFailInvertExpected bool
+
+	// max number of expressions to be parsed
+	maxExprCnt uint64
+	// entrypoint for the parser
+	entrypoint string
+
+	allowInvalidUTF8 bool
+
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+
+	*Stats
+	// profiling frames, tracks the time spent in invoked rules to compute
+	// the self time of each rule.
+	profStack []profFrame
+	// number of active invocations of each rule, the time of a recursive
+	// rule is only added at its outermost invocation.
+	profActive map[*rule]int
+	// rules evaluated at each offset, to count the repeated evaluations.
+	profEvals map[profEval]bool
+
+	choiceNoMatch string
+	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
+}
+
+// push a variable set on the vstack.
+func (p *parser) pushV() {
+	if cap(p.vstack) == len(p.vstack) {
+		// create new empty slot in the stack
+		p.vstack = append(p.vstack, nil)
+	} else {
+		// slice to 1 more
+		p.vstack = p.vstack[:len(p.vstack)+1]
+	}
+
+	// get the last args set
+	m := p.vstack[len(p.vstack)-1]
+	if m != nil && len(m) == 0 {
+		// empty map, all good
+		return
+	}
+
+	m = make(map[string]any)
+	p.vstack[len(p.vstack)-1] = m
+}
+
+// pop a variable set from the vstack.
+func (p *parser) popV() {
+	// if the map is not empty, clear it
+	m := p.vstack[len(p.vstack)-1]
+	if len(m) > 0 {
+		// GC that map
+		p.vstack[len(p.vstack)-1] = nil
+	}
+	p.vstack = p.vstack[:len(p.vstack)-1]
+}
+
+// push a recovery expression with its labels to the recoveryStack
+func (p *parser) pushRecovery(labels []string, expr any) {
+	if cap(p.recoveryStack) == len(p.recoveryStack) {
+		// create new empty slot in the stack
+		p.recoveryStack = append(p.recoveryStack, recovery{})
+	} else {
+		// slice to 1 more
+		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
+	}
+
+	m := make(map[string]any, len(labels))
+	for _, fl := range labels {
+		m[fl] = expr
+	}
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
+}
+
+// pop a recovery expression from the recoveryStack
+func (p *parser) popRecovery() {
+	// GC that map
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
+
+	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
+}
+
+func (p *parser) print(prefix, s string) string {
+	if !p.debug {
+		return s
+	}
+
+	pos := p.pt.position
+	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
+		prefix, pos.line, pos.col, pos.offset, s, p.pt.rn)
+	return s
+}
+
+func (p *parser) printIndent(mark string, s string) string {
+	return p.print(strings.Repeat(" ", p.depth)+mark, s)
+}
+
+func (p *parser) in(s string) string {
+	res := p.printIndent(">", s)
+	p.depth++
+	return res
+}
+
+func (p *parser) out(s string) string {
+	p.depth--
+	return p.printIndent("<", s)
+}
+
+func (p *parser) addErr(err error) {
+	p.addErrAt(err, p.pt.position, []string{})
+}
+
+func (p *parser) addErrAt(err error, pos position, expected []string) {
+	var buf bytes.Buffer
+	if p.filename != "" {
+		buf.WriteString(p.filename)
+	}
+	if buf.Len() > 0 {
+		buf.WriteString(":")
+	}
+	buf.WriteString(fmt.Sprintf("%d:%d (%d)", pos.line, pos.col, pos.offset))
+	if len(p.rstack) > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString(": ")
+		}
+		rule := p.rstack[len(p.rstack)-1]
+		if rule.displayName != "" {
+			buf.WriteString("rule " + rule.displayName)
+		} else {
+			buf.WriteString("rule " + rule.name)
+		}
+	}
+	pe := &parserError{Inner: err, pos: pos, prefix: buf.String(), expected: expected}
+	p.errs.add(pe)
+}
+
+func (p *parser) failAt(fail bool, pos position, want string) {
+	// process fail if parsing fails and not inverted or parsing succeeds and invert is set
+	if fail == p.maxFailInvertExpected {
+		if pos.offset < p.maxFailPos.offset {
+			return
+		}
+
+		if pos.offset > p.maxFailPos.offset {
+			p.maxFailPos = pos
+			p.maxFailExpected = p.maxFailExpected[:0]
+		}
+
+		if p.maxFailInvertExpected {
+			want = "!" + want
+		}
+		p.maxFailExpected = append(p.maxFailExpected, want)
+	}
+}
+
+// read advances the parser to the next rune.
+func (p *parser) read() {
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
+	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
+	p.pt.rn = rn
+	p.pt.w = n
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
+	}
+
+	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
+		if !p.allowInvalidUTF8 {
+			p.addErr(errInvalidEncoding)
+		}
+	}
+}
+
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
+// restore parser position to the savepoint pt.
+func (p *parser) restore(pt savepoint) {
+	if p.debug {
+		defer p.out(p.in("restore"))
+	}
+	if pt.offset == p.pt.offset {
+		return
+	}
+	if p.Rules != nil && pt.offset < p.pt.offset && len(p.rstack) > 0 {
+		p.ruleStats(p.rstack[len(p.rstack)-1]).Backtracked += uint64(p.pt.offset - pt.offset)
+	}
+	p.pt = pt
+}
+
+// Cloner is implemented by any value that has a Clone method, which returns a
+// copy of the value. This is mainly used for types which are not passed by
+// value (e.g map, slice, chan) or structs that contain such types.
+//
+// This is used in conjunction with the global state feature to create proper
+// copies of the state to allow the parser to properly restore the state in
+// the case of backtracking.
+type Cloner interface {
+	Clone() any
+}
+
+var statePool = &sync.Pool{
+	New: func() any { return make(storeDict) },
+}
+
+func (sd storeDict) Discard() {
+	for k := range sd {
+		delete(sd, k)
+	}
+	statePool.Put(sd)
+}
+
+// clone and return parser current state.
+func (p *parser) cloneState() storeDict {
+	if p.debug {
+		defer p.out(p.in("cloneState"))
+	}
+
+	state := statePool.Get().(storeDict)
+	for k, v := range p.cur.state {
+		if c, ok := v.(Cloner); ok {
+			state[k] = c.Clone()
+		} else {
+			state[k] = v
+		}
+	}
+	return state
+}
+
+// restore parser current state to the state storeDict.
+// every restoreState should applied only one time for every cloned state
+func (p *parser) restoreState(state storeDict) {
+	if p.debug {
+		defer p.out(p.in("restoreState"))
+	}
+	p.cur.state.Discard()
+	p.cur.state = state
+}
+
+// get the slice of bytes from the savepoint start to the current position.
+func (p *parser) sliceFrom(start savepoint) []byte {
+	return p.data[start.position.offset:p.pt.position.offset]
+}
+
+// memoSlot is an entry of the memoization table, the key of an empty slot
+// is 0.
+type memoSlot struct {
+	key uint64
+	res resultTuple
+}
+
+// memoTable is the memoization table for the packrat algorithm, an
+// open-addressed hash table with linear probing that maps the offset in
+// the source and the id of a rule or expression to its result.
+type memoTable struct {
+	slots []memoSlot
+	shift uint
+	n     int
+}
+
+// memoTables pools the memoization tables so that their storage is
+// reused across parses.
+var memoTables = &sync.Pool{
+	New: func() any {
+		return &memoTable{slots: make([]memoSlot, 1<<10), shift: 64 - 10}
+	},
+}
+
+// memoKey returns the key of the result of the rule or expression id at
+// offset, it supports up to 1<<24 ids.
+func memoKey(offset, id int) uint64 {
+	return uint64(offset+1)<<24 | uint64(id)
+}
+
+// index returns the index of the slot of key, or of the empty slot where
+// it should be stored.
+func (t *memoTable) index(key uint64) int {
+	mask := len(t.slots) - 1
+	i := int((key*0x9E3779B97F4A7C15)>>t.shift) & mask
+	for t.slots[i].key != 0 && t.slots[i].key != key {
+		i = (i + 1) & mask
+	}
+	return i
+}
+
+func (t *memoTable) get(key uint64) (resultTuple, bool) {
+	s := &t.slots[t.index(key)]
+	return s.res, s.key == key
+}
+
+func (t *memoTable) set(key uint64, res resultTuple) {
+	if 4*(t.n+1) > 3*len(t.slots) {
+		t.grow()
+	}
+	s := &t.slots[t.index(key)]
+	if s.key == 0 {
+		s.key = key
+		t.n++
+	}
+	s.res = res
+}
+
+// grow doubles the capacity of the table.
+func (t *memoTable) grow() {
+	old := t.slots
+	t.slots = make([]memoSlot, 2*len(old))
+	t.shift--
+	for _, s := range old {
+		if s.key != 0 {
+			t.slots[t.index(s.key)] = s
+		}
+	}
+}
+
+// reset empties the table, releasing the references to the results.
+func (t *memoTable) reset() {
+	if t.n == 0 {
+		return
+	}
+	for i := range t.slots {
+		t.slots[i] = memoSlot{}
+	}
+	t.n = 0
+}
+
+func (p *parser) getMemoized(id int) (resultTuple, bool) {
+	if p.memo == nil {
+		return resultTuple{}, false
+	}
+	return p.memo.get(memoKey(p.pt.offset, id))
+}
+
+func (p *parser) setMemoized(pt savepoint, id int, tuple resultTuple) {
+	if p.memo == nil {
+		p.memo = memoTables.Get().(*memoTable)
+	}
+	p.memo.set(memoKey(pt.offset, id), tuple)
+}
+
+// releaseMemo returns the memoization table to the pool.
+func (p *parser) releaseMemo() {
+	if p.memo == nil {
+		return
+	}
+	p.memo.reset()
+	memoTables.Put(p.memo)
+	p.memo = nil
+}
+
+func (p *parser) buildRulesTable(g *grammar) {
+	p.rules = make(map[string]*rule, len(g.rules))
+	for _, r := range g.rules {
+		p.rules[r.name] = r
+	}
+}
+
+// nolint: gocyclo
+func (p *parser) parse(g *grammar) (val any, err error) {
+	if len(g.rules) == 0 {
+		p.addErr(errNoRule)
+		return nil, p.errs.err()
+	}
+
+	// TODO : not super critical but this could be generated
+	p.buildRulesTable(g)
+	defer p.releaseMemo()
+
+	if p.recover {
+		// panic can be used in action code to stop parsing immediately
+		// and return the panic as an error.
+		defer func() {
+			if e := recover(); e != nil {
+				if p.debug {
+					defer p.out(p.in("panic handler"))
+				}
+				val = nil
+				switch e := e.(type) {
+				case error:
+					p.addErr(e)
+				default:
+					p.addErr(fmt.Errorf("%v", e))
+				}
+				err = p.errs.err()
+			}
+		}()
+	}
+
+	// a failure after a cut stops the parsing, the error reports the
+	// expected values where it failed, in the rule of the cut.
+	defer func() {
+		if e := recover(); e != nil {
+			if e != errCutFailure {
+				panic(e)
+			}
+			val = nil
+			p.addNoMatchErr()
+			err = p.errs.err()
+		}
+	}()
+
+	startRule, ok := p.rules[p.entrypoint]
+	if !ok {
+		p.addErr(errInvalidEntrypoint)
+		return nil, p.errs.err()
+	}
+
+	p.read() // advance to first rune
+	val, ok = p.parseRuleWrap(startRule)
+	if !ok {
+		if len(*p.errs) == 0 {
+			// If parsing fails, but no errors have been recorded, the expected values
+			// for the farthest parser position are returned as error.
+			p.addNoMatchErr()
+		}
+
+		return nil, p.errs.err()
+	}
+	return val, p.errs.err()
+}
+
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
+func listJoin(list []string, sep string, lastSep string) string {
+	switch len(list) {
+	case 0:
+		return ""
+	case 1:
+		return list[0]
+	default:
+		return strings.Join(list[:len(list)-1], sep) + " " + lastSep + " " + list[len(list)-1]
+	}
+}
+
+// profFrame is a rule invocation being profiled.
+type profFrame struct {
+	start time.Time
+	child time.Duration
+}
+
+// profEval is the evaluation of a rule at an offset.
+type profEval struct {
+	rule   *rule
+	offset int
+}
+
+// ruleStats returns the statistics of rule, creating them if needed.
+func (p *parser) ruleStats(rule *rule) *RuleStats {
+	rs := p.Rules[rule.name]
+	if rs == nil {
+		rs = &RuleStats{}
+		p.Rules[rule.name] = rs
+	}
+	return rs
+}
+
+func (p *parser) countMemo(rs *RuleStats, hit bool) {
+	if hit {
+		rs.MemoHits++
+	} else {
+		rs.MemoMisses++
+	}
+}
+
+func (p *parser) profEnter(r *rule) {
+	if p.profActive == nil {
+		p.profActive = make(map[*rule]int)
+	}
+	p.profActive[r]++
+	p.profStack = append(p.profStack, profFrame{start: time.Now()})
+}
+
+// countRepeat counts the evaluation of rule at the current offset if
+// it was already evaluated at that offset.
+func (p *parser) countRepeat(rule *rule) {
+	if p.profEvals == nil {
+		p.profEvals = make(map[profEval]bool)
+	}
+	key := profEval{rule: rule, offset: p.pt.offset}
+	if p.profEvals[key] {
+		p.ruleStats(rule).Repeats++
+		return
+	}
+	p.profEvals[key] = true
+}
+
+func (p *parser) profExit(rule *rule, start savepoint, ok bool) {
+	frame := p.profStack[len(p.profStack)-1]
+	p.profStack = p.profStack[:len(p.profStack)-1]
+	elapsed := time.Since(frame.start)
+	if n := len(p.profStack); n > 0 {
+		p.profStack[n-1].child += elapsed
+	}
+
+	rs := p.ruleStats(rule)
+	rs.Invocations++
+	if p.profActive[rule]--; p.profActive[rule] == 0 {
+		// the time of the inner invocations is included
+		rs.Time += elapsed
+	}
+	rs.SelfTime += elapsed - frame.child
+	if ok {
+		rs.Matches++
+		rs.Bytes += uint64(p.pt.offset - start.offset)
+	} else {
+		rs.Failures++
+	}
+}
+
+func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
+	if rule.id < 0 {
+		// its results depend on the captured texts or the state store
+		return p.parseRule(rule)
+	}
+	res, ok := p.getMemoized(rule.id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(rule), ok)
+	}
+	if ok {
+		p.restore(res.end)
+		return res.v, res.b
+	}
+
+	startMark := p.pt
+	val, ok := p.parseRule(rule)
+	p.setMemoized(startMark, rule.id, resultTuple{v: val, b: ok, end: p.pt})
+
+	return val, ok
+}
+
+func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRule " + rule.name))
+	}
+	var (
+		val       any
+		ok        bool
+		startMark = p.pt
+	)
+
+	if p.Rules != nil {
+		p.profEnter(rule)
+		defer func() { p.profExit(rule, startMark, ok) }()
+	}
+
+	if p.memoize {
+		val, ok = p.parseRuleMemoize(rule)
+	} else {
+		val, ok = p.parseRule(rule)
+	}
+
+	if ok && p.debug {
+		p.printIndent("MATCH", string(p.sliceFrom(startMark)))
+	}
+	return val, ok
+}
+
+func (p *parser) parseRule(rule *rule) (any, bool) {
+	if p.Rules != nil {
+		p.countRepeat(rule)
+	}
+	p.rstack = append(p.rstack, rule)
+	p.pushV()
+	val, ok := rule.run(p)
+	p.popV()
+	p.rstack = p.rstack[:len(p.rstack)-1]
+	return val, ok
+}
+
+// incChoiceAltCnt counts the match of the alternative altI of the choice
+// at pos, or its failure if altI is choiceNoMatch.
+func (p *parser) incChoiceAltCnt(pos position, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, pos.line, pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
+// countExpr counts the evaluation of an expression.
+func (p *parser) countExpr() {
+	p.ExprCnt++
+	if p.ExprCnt > p.maxExprCnt {
+		panic(errMaxExprCnt)
+	}
+}
+
+// compiledMemoized returns the memoized results of the compiled expression
+// id at the current position, and restores the position at their end. It
+// returns false if they are not memoized or if memoization is disabled.
+func (p *parser) compiledMemoized(id int) (resultTuple, bool) {
+	if !p.memoize {
+		return resultTuple{}, false
+	}
+	res, ok := p.getMemoized(id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+	}
+	if ok {
+		p.restore(res.end)
+	}
+	return res, ok
+}
+
+// compiledMemoize memoizes the results of the compiled expression id,
+// which started at pt, if memoization is enabled.
+func (p *parser) compiledMemoize(pt savepoint, id int, val any, ok bool) {
+	if p.memoize {
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
+	}
+}
@@ -0,0 +1 @@
+../cut_test.go
\ No newline at end of file
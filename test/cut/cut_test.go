@@ -0,0 +1,59 @@
+package cut
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCut(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "", want: []any(nil)},
+		{in: "a = b;", want: []any{[]any{"=", "a", "b"}}},
+		{in: "printer = a;", want: []any{[]any{"=", "printer", "a"}}},
+		{in: "a = b;\nif x: print y;", want: []any{
+			[]any{"=", "a", "b"},
+			[]any{"if", "x", []any{"print", "y"}},
+		}},
+		{in: "goto x; break;", want: []any{"x", []any{"break", 4}}},
+	}
+	for _, tc := range cases {
+		for _, memo := range []bool{false, true} {
+			got, err := Parse("", []byte(tc.in), Memoize(memo))
+			if err != nil {
+				t.Errorf("%q: memoize %t: %v", tc.in, memo, err)
+				continue
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("%q: memoize %t: want %#v, got %#v", tc.in, memo, tc.want, got)
+			}
+		}
+	}
+}
+
+func TestCutError(t *testing.T) {
+	cases := []struct {
+		in  string
+		err string
+	}{
+		// without the cut, it would be an assignment
+		{in: "if = a;", err: `1:4 (3): rule If: no match found, expected: [ \t\n] or [a-z]`},
+		{in: "print ;", err: `1:7 (6): rule Print: no match found, expected: [ \t\n] or [a-z]`},
+		{in: "if x print y;", err: `1:6 (5): rule If: no match found, expected: ":" or [ \t\n]`},
+		{in: "a = ;", err: `1:5 (4): no match found, expected: [ \t\n] or [a-z]`},
+	}
+	for _, tc := range cases {
+		for _, memo := range []bool{false, true} {
+			got, err := Parse("", []byte(tc.in), Memoize(memo))
+			if err == nil {
+				t.Errorf("%q: memoize %t: want error, got %#v", tc.in, memo, got)
+				continue
+			}
+			if err.Error() != tc.err {
+				t.Errorf("%q: memoize %t: want error %q, got %q", tc.in, memo, tc.err, err)
+			}
+		}
+	}
+}
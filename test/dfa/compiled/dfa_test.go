@@ -0,0 +1,106 @@
+package dfa
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	ref "github.com/mna/pigeon/test/dfa"
+)
+
+func TestDFA(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "", want: []any(nil)},
+		{in: "ab aba a0a ba bab baa a0b aa", want: []any{
+			"kw:ab", "word:aba", "kw:a0a", "kw:ba", "kw:bab", "kw:baa", "kw:a0b", "kw:aa",
+		}},
+		// the first alternative that matches is used
+		{in: "ab0a", want: []any{"kw:ab", "num:0", "word:a"}},
+		{in: "abc a.b.c b", want: []any{"word:abc", "word:a.b.c", "word:b"}},
+		{in: "0x12 1.5 .5 12", want: []any{"num:0x12", "num:1.5", "num:.5", "num:12"}},
+		{in: ".. ... ..5", want: []any{"dots:..", "dots:...", "dots:..", "num:5"}},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err != nil {
+			t.Errorf("%q: %v", tc.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%q: want %#v, got %#v", tc.in, tc.want, got)
+		}
+	}
+}
+
+func TestDFAError(t *testing.T) {
+	cases := []struct {
+		in  string
+		err string
+	}{
+		{in: "....", err: `1:5 (4): no match found, expected: "." or [0-9]`},
+		{in: "1.", err: `1:3 (2): no match found, expected: "." or [0-9]`},
+		{in: "a.", err: `1:3 (2): no match found, expected: ".", [0-9] or [a-z]`},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err == nil {
+			t.Errorf("%q: want error, got %#v", tc.in, got)
+			continue
+		}
+		if err.Error() != tc.err {
+			t.Errorf("%q: want error %q, got %q", tc.in, tc.err, err)
+		}
+	}
+}
+
+// TestDFAReference compares the results of the parser to the ones of the
+// parser generated without automata, for all the inputs of up to 6 runes
+// of a small alphabet.
+func TestDFAReference(t *testing.T) {
+	const alphabet = "ab0x. "
+
+	inputs := []string{""}
+	for i := 0; i < len(inputs); i++ {
+		in := inputs[i]
+		got, err := Parse("", []byte(in))
+		want, wantErr := ref.Parse("", []byte(in))
+		if !reflect.DeepEqual(got, want) || fmt.Sprint(err) != fmt.Sprint(wantErr) {
+			t.Errorf("%q: want %#v, %v, got %#v, %v", in, want, wantErr, got, err)
+		}
+		if len(in) < 6 {
+			for _, r := range alphabet {
+				inputs = append(inputs, in+string(r))
+			}
+		}
+	}
+}
+
+func TestDFAStatistics(t *testing.T) {
+	in := []byte("ab aba a0a ba bab baa a0b aa abc a.b.c 0x12 1.5 .5 .. ...")
+	var stats Stats
+	if _, err := Parse("", in, Statistics(&stats, "no match")); err != nil {
+		t.Fatal(err)
+	}
+	var refStats ref.Stats
+	if _, err := ref.Parse("", in, ref.Statistics(&refStats, "no match")); err != nil {
+		t.Fatal(err)
+	}
+
+	// the expressions matched by automata are evaluated as usual to count
+	// their rules and alternatives.
+	if !reflect.DeepEqual(stats.ChoiceAltCnt, refStats.ChoiceAltCnt) {
+		t.Errorf("want alternatives %v, got %v", refStats.ChoiceAltCnt, stats.ChoiceAltCnt)
+	}
+	if len(stats.Rules) != len(refStats.Rules) {
+		t.Errorf("want %d rules, got %d", len(refStats.Rules), len(stats.Rules))
+	}
+	for nm, want := range refStats.Rules {
+		got := stats.Rules[nm]
+		if got == nil || got.Invocations != want.Invocations || got.Matches != want.Matches {
+			t.Errorf("%s: want %d invocations and %d matches, got %+v", nm, want.Invocations, want.Matches, got)
+		}
+	}
+}
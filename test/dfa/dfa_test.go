@@ -0,0 +1,54 @@
+package dfa
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDFA(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "", want: []any(nil)},
+		{in: "ab aba a0a ba bab baa a0b aa", want: []any{
+			"kw:ab", "word:aba", "kw:a0a", "kw:ba", "kw:bab", "kw:baa", "kw:a0b", "kw:aa",
+		}},
+		// the first alternative that matches is used
+		{in: "ab0a", want: []any{"kw:ab", "num:0", "word:a"}},
+		{in: "abc a.b.c b", want: []any{"word:abc", "word:a.b.c", "word:b"}},
+		{in: "0x12 1.5 .5 12", want: []any{"num:0x12", "num:1.5", "num:.5", "num:12"}},
+		{in: ".. ... ..5", want: []any{"dots:..", "dots:...", "dots:..", "num:5"}},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err != nil {
+			t.Errorf("%q: %v", tc.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%q: want %#v, got %#v", tc.in, tc.want, got)
+		}
+	}
+}
+
+func TestDFAError(t *testing.T) {
+	cases := []struct {
+		in  string
+		err string
+	}{
+		{in: "....", err: `1:5 (4): no match found, expected: "." or [0-9]`},
+		{in: "1.", err: `1:3 (2): no match found, expected: "." or [0-9]`},
+		{in: "a.", err: `1:3 (2): no match found, expected: ".", [0-9] or [a-z]`},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err == nil {
+			t.Errorf("%q: want error, got %#v", tc.in, got)
+			continue
+		}
+		if err.Error() != tc.err {
+			t.Errorf("%q: want error %q, got %q", tc.in, tc.err, err)
+		}
+	}
+}
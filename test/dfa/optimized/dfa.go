@@ -0,0 +1,2863 @@
+// Code generated by pigeon; DO NOT EDIT.
+
+package dfa
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+var g = &grammar{
+	rules: []*rule{
+		{
+			name: "Tokens",
+			pos:  position{line: 9, col: 1, offset: 226},
+			id:   0,
+			expr: &actionExpr{
+				pos: position{line: 9, col: 10, offset: 237},
+				id:  10,
+				run: (*parser).callonTokens1,
+				expr: &seqExpr{
+					pos: position{line: 9, col: 10, offset: 237},
+					id:  11,
+					exprs: []any{
+						&dfaExpr{
+							pos: position{line: 9, col: 10, offset: 237},
+							id:  12,
+							run: (*parser).dfa0,
+							expr: &ruleRefExpr{
+								pos:  position{line: 9, col: 10, offset: 237},
+								id:   13,
+								name: "_",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 9, col: 12, offset: 239},
+							id:    14,
+							label: "tokens",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 9, col: 19, offset: 246},
+								id:  15,
+								expr: &seqExpr{
+									pos:   position{line: 9, col: 21, offset: 248},
+									id:    16,
+									pluck: []int{0},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 9, col: 22, offset: 249},
+											id:   17,
+											name: "Token",
+										},
+										&dfaExpr{
+											pos: position{line: 9, col: 28, offset: 255},
+											id:  18,
+											run: (*parser).dfa0,
+											expr: &ruleRefExpr{
+												pos:  position{line: 9, col: 28, offset: 255},
+												id:   19,
+												name: "_",
+											},
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 9, col: 33, offset: 260},
+							id:   20,
+							name: "EOF",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Token",
+			pos:  position{line: 13, col: 1, offset: 292},
+			id:   1,
+			expr: &choiceExpr{
+				pos: position{line: 13, col: 9, offset: 302},
+				id:  21,
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 13, col: 9, offset: 302},
+						id:   22,
+						name: "Keyword",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 13, col: 19, offset: 312},
+						id:   23,
+						name: "Number",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 13, col: 28, offset: 321},
+						id:   24,
+						name: "Dots",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 13, col: 35, offset: 328},
+						id:   25,
+						name: "Word",
+					},
+				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x03\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {1, 2}, {1}, {0, 3}, {3}},
+					expected:  [][]string{{"\"ab\"", "\"aba\"", "\"a\"", "\"ba\"", "\"a0b\"", "\"aa\"", "\"0x\"", "[0-9]", "\".\"", "[a-z]"}, {"\"ab\"", "\"aba\"", "\"a\"", "\"ba\"", "\"a0b\"", "\"aa\"", "[a-z]"}, {"\"ab\"", "\"aba\"", "\"a\"", "\"ba\"", "\"a0b\"", "\"aa\"", "\".\"", "[a-z]"}, {"\"0x\"", "[0-9]", "\".\""}, {"\"ab\"", "\"aba\"", "\"a\"", "\"ba\"", "\"a0b\"", "\"aa\"", "\"0x\"", "[0-9]", "\".\""}},
+				},
+			},
+		},
+		{
+			name: "Keyword",
+			pos:  position{line: 17, col: 1, offset: 434},
+			id:   2,
+			expr: &actionExpr{
+				pos: position{line: 17, col: 11, offset: 446},
+				id:  26,
+				run: (*parser).callonKeyword1,
+				expr: &seqExpr{
+					pos: position{line: 17, col: 11, offset: 446},
+					id:  27,
+					exprs: []any{
+						&dfaExpr{
+							pos: position{line: 17, col: 13, offset: 448},
+							id:  28,
+							run: (*parser).dfa1,
+							expr: &choiceExpr{
+								pos: position{line: 17, col: 13, offset: 448},
+								id:  29,
+								alternatives: []any{
+									&litMatcher{
+										pos:        position{line: 17, col: 13, offset: 448},
+										id:         30,
+										val:        "ab",
+										ignoreCase: false,
+										want:       "\"ab\"",
+									},
+									&litMatcher{
+										pos:        position{line: 17, col: 20, offset: 455},
+										id:         31,
+										val:        "aba",
+										ignoreCase: false,
+										want:       "\"aba\"",
+									},
+									&seqExpr{
+										pos: position{line: 17, col: 28, offset: 463},
+										id:  32,
+										exprs: []any{
+											&litMatcher{
+												pos:        position{line: 17, col: 28, offset: 463},
+												id:         33,
+												val:        "a",
+												ignoreCase: false,
+												want:       "\"a\"",
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 17, col: 32, offset: 467},
+												id:  34,
+												expr: &litMatcher{
+													pos:        position{line: 17, col: 32, offset: 467},
+													id:         35,
+													val:        "b",
+													ignoreCase: false,
+													want:       "\"b\"",
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 17, col: 37, offset: 472},
+												id:         36,
+												val:        "0a",
+												ignoreCase: false,
+												want:       "\"0a\"",
+											},
+										},
+									},
+									&seqExpr{
+										pos: position{line: 17, col: 44, offset: 479},
+										id:  37,
+										exprs: []any{
+											&litMatcher{
+												pos:        position{line: 17, col: 44, offset: 479},
+												id:         38,
+												val:        "ba",
+												ignoreCase: false,
+												want:       "\"ba\"",
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 17, col: 49, offset: 484},
+												id:  39,
+												expr: &choiceExpr{
+													pos: position{line: 17, col: 51, offset: 486},
+													id:  40,
+													alternatives: []any{
+														&litMatcher{
+															pos:        position{line: 17, col: 51, offset: 486},
+															id:         41,
+															val:        "b",
+															ignoreCase: false,
+															want:       "\"b\"",
+														},
+														&litMatcher{
+															pos:        position{line: 17, col: 57, offset: 492},
+															id:         42,
+															val:        "a",
+															ignoreCase: false,
+															want:       "\"a\"",
+														},
+													},
+													dispatch: &choiceDispatch{
+														ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+														ranges:    []rune{'\u0080'},
+														rangeSets: "\x00",
+														alts:      [][]int{{}, {1}, {0}},
+														expected:  [][]string{{"\"b\"", "\"a\""}, {"\"b\""}, {"\"a\""}},
+													},
+												},
+											},
+										},
+									},
+									&litMatcher{
+										pos:        position{line: 17, col: 66, offset: 501},
+										id:         43,
+										val:        "a0b",
+										ignoreCase: false,
+										want:       "\"a0b\"",
+									},
+									&litMatcher{
+										pos:        position{line: 17, col: 74, offset: 509},
+										id:         44,
+										val:        "aa",
+										ignoreCase: false,
+										want:       "\"aa\"",
+									},
+								},
+								dispatch: &choiceDispatch{
+									ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+									ranges:    []rune{'\u0080'},
+									rangeSets: "\x00",
+									alts:      [][]int{{}, {0, 1, 2, 4, 5}, {3}},
+									expected:  [][]string{{"\"ab\"", "\"aba\"", "\"a\"", "\"ba\"", "\"a0b\"", "\"aa\""}, {"\"ba\""}, {"\"ab\"", "\"aba\"", "\"a\"", "\"a0b\"", "\"aa\""}},
+								},
+							},
+						},
+						&notExpr{
+							pos: position{line: 17, col: 81, offset: 516},
+							id:  45,
+							expr: &ruleRefExpr{
+								pos:  position{line: 17, col: 82, offset: 517},
+								id:   46,
+								name: "Letter",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Number",
+			pos:  position{line: 21, col: 1, offset: 568},
+			id:   3,
+			expr: &actionExpr{
+				pos: position{line: 21, col: 10, offset: 579},
+				id:  47,
+				run: (*parser).callonNumber1,
+				expr: &dfaExpr{
+					pos: position{line: 21, col: 12, offset: 581},
+					id:  48,
+					run: (*parser).dfa2,
+					expr: &choiceExpr{
+						pos: position{line: 21, col: 12, offset: 581},
+						id:  49,
+						alternatives: []any{
+							&seqExpr{
+								pos: position{line: 21, col: 12, offset: 581},
+								id:  50,
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 21, col: 12, offset: 581},
+										id:         51,
+										val:        "0x",
+										ignoreCase: false,
+										want:       "\"0x\"",
+									},
+									&oneOrMoreExpr{
+										pos: position{line: 21, col: 17, offset: 586},
+										id:  52,
+										expr: &ruleRefExpr{
+											pos:  position{line: 21, col: 17, offset: 586},
+											id:   53,
+											name: "Digit",
+										},
+									},
+								},
+							},
+							&seqExpr{
+								pos: position{line: 21, col: 26, offset: 595},
+								id:  54,
+								exprs: []any{
+									&oneOrMoreExpr{
+										pos: position{line: 21, col: 26, offset: 595},
+										id:  55,
+										expr: &ruleRefExpr{
+											pos:  position{line: 21, col: 26, offset: 595},
+											id:   56,
+											name: "Digit",
+										},
+									},
+									&zeroOrOneExpr{
+										pos: position{line: 21, col: 33, offset: 602},
+										id:  57,
+										expr: &seqExpr{
+											pos: position{line: 21, col: 35, offset: 604},
+											id:  58,
+											exprs: []any{
+												&litMatcher{
+													pos:        position{line: 21, col: 35, offset: 604},
+													id:         59,
+													val:        ".",
+													ignoreCase: false,
+													want:       "\".\"",
+												},
+												&oneOrMoreExpr{
+													pos: position{line: 21, col: 39, offset: 608},
+													id:  60,
+													expr: &ruleRefExpr{
+														pos:  position{line: 21, col: 39, offset: 608},
+														id:   61,
+														name: "Digit",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							&seqExpr{
+								pos: position{line: 21, col: 51, offset: 620},
+								id:  62,
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 21, col: 51, offset: 620},
+										id:         63,
+										val:        ".",
+										ignoreCase: false,
+										want:       "\".\"",
+									},
+									&oneOrMoreExpr{
+										pos: position{line: 21, col: 55, offset: 624},
+										id:  64,
+										expr: &ruleRefExpr{
+											pos:  position{line: 21, col: 55, offset: 624},
+											id:   65,
+											name: "Digit",
+										},
+									},
+								},
+							},
+						},
+						dispatch: &choiceDispatch{
+							ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x02\x03\x03\x03\x03\x03\x03\x03\x03\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+							ranges:    []rune{'\u0080'},
+							rangeSets: "\x00",
+							alts:      [][]int{{}, {2}, {0, 1}, {1}},
+							expected:  [][]string{{"\"0x\"", "[0-9]", "\".\""}, {"\"0x\"", "[0-9]"}, {"\".\""}, {"\"0x\"", "\".\""}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Dots",
+			pos:  position{line: 25, col: 1, offset: 678},
+			id:   4,
+			expr: &actionExpr{
+				pos: position{line: 25, col: 8, offset: 687},
+				id:  66,
+				run: (*parser).callonDots1,
+				expr: &seqExpr{
+					pos: position{line: 25, col: 8, offset: 687},
+					id:  67,
+					exprs: []any{
+						&dfaExpr{
+							pos: position{line: 25, col: 8, offset: 687},
+							id:  68,
+							run: (*parser).dfa3,
+							expr: &repeatExpr{
+								pos: position{line: 25, col: 8, offset: 687},
+								id:  69,
+								min: 2,
+								max: 3,
+								expr: &litMatcher{
+									pos:        position{line: 25, col: 8, offset: 687},
+									id:         70,
+									val:        ".",
+									ignoreCase: false,
+									want:       "\".\"",
+								},
+							},
+						},
+						&notExpr{
+							pos: position{line: 25, col: 17, offset: 696},
+							id:  71,
+							expr: &seqExpr{
+								pos: position{line: 25, col: 20, offset: 699},
+								id:  72,
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 25, col: 20, offset: 699},
+										id:         73,
+										val:        ".",
+										ignoreCase: false,
+										want:       "\".\"",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 25, col: 24, offset: 703},
+										id:   74,
+										name: "Digit",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Word",
+			pos:  position{line: 29, col: 1, offset: 757},
+			id:   5,
+			expr: &actionExpr{
+				pos: position{line: 29, col: 8, offset: 766},
+				id:  75,
+				run: (*parser).callonWord1,
+				expr: &dfaExpr{
+					pos: position{line: 29, col: 8, offset: 766},
+					id:  76,
+					run: (*parser).dfa4,
+					expr: &seqExpr{
+						pos: position{line: 29, col: 8, offset: 766},
+						id:  77,
+						exprs: []any{
+							&oneOrMoreExpr{
+								pos: position{line: 29, col: 8, offset: 766},
+								id:  78,
+								expr: &ruleRefExpr{
+									pos:  position{line: 29, col: 8, offset: 766},
+									id:   79,
+									name: "Letter",
+								},
+							},
+							&zeroOrMoreExpr{
+								pos: position{line: 29, col: 16, offset: 774},
+								id:  80,
+								expr: &seqExpr{
+									pos: position{line: 29, col: 18, offset: 776},
+									id:  81,
+									exprs: []any{
+										&litMatcher{
+											pos:        position{line: 29, col: 18, offset: 776},
+											id:         82,
+											val:        ".",
+											ignoreCase: false,
+											want:       "\".\"",
+										},
+										&oneOrMoreExpr{
+											pos: position{line: 29, col: 22, offset: 780},
+											id:  83,
+											expr: &ruleRefExpr{
+												pos:  position{line: 29, col: 22, offset: 780},
+												id:   84,
+												name: "Letter",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Letter",
+			pos:  position{line: 33, col: 1, offset: 837},
+			id:   6,
+			expr: &charClassMatcher{
+				pos:        position{line: 33, col: 10, offset: 848},
+				id:         85,
+				val:        "[a-z]",
+				ranges:     []rune{'a', 'z'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "Digit",
+			pos:  position{line: 35, col: 1, offset: 855},
+			id:   7,
+			expr: &charClassMatcher{
+				pos:        position{line: 35, col: 9, offset: 865},
+				id:         86,
+				val:        "[0-9]",
+				ranges:     []rune{'0', '9'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "_",
+			pos:  position{line: 37, col: 1, offset: 872},
+			id:   8,
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 37, col: 5, offset: 878},
+				id:  87,
+				expr: &litMatcher{
+					pos:        position{line: 37, col: 5, offset: 878},
+					id:         88,
+					val:        " ",
+					ignoreCase: false,
+					want:       "\" \"",
+				},
+			},
+		},
+		{
+			name: "EOF",
+			pos:  position{line: 39, col: 1, offset: 884},
+			id:   9,
+			expr: &notExpr{
+				pos: position{line: 39, col: 7, offset: 892},
+				id:  89,
+				expr: &anyMatcher{
+					pos: position{line: 39, col: 8, offset: 893},
+					id:  90,
+				},
+			},
+		},
+	},
+}
+
+func (c *current) onTokens1(tokens any) (any, error) {
+	return tokens, nil
+}
+
+func (p *parser) callonTokens1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTokens1(stack["tokens"])
+}
+
+func (c *current) onKeyword1() (any, error) {
+	return "kw:" + string(c.text), nil
+}
+
+func (p *parser) callonKeyword1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onKeyword1()
+}
+
+func (c *current) onNumber1() (any, error) {
+	return "num:" + string(c.text), nil
+}
+
+func (p *parser) callonNumber1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNumber1()
+}
+
+func (c *current) onDots1() (any, error) {
+	return "dots:" + string(c.text), nil
+}
+
+func (p *parser) callonDots1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDots1()
+}
+
+func (c *current) onWord1() (any, error) {
+	return "word:" + string(c.text), nil
+}
+
+func (p *parser) callonWord1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWord1()
+}
+
+func (p *parser) dfa0() (any, bool) {
+	start := p.pt
+	accept := start
+s0:
+	switch cur := p.dfaRune(); {
+	case cur == ' ':
+		p.read()
+		accept = p.pt
+		goto s0
+	}
+	p.failAt(false, p.pt.position, "\" \"")
+	p.restore(accept)
+	return p.sliceFrom(start), true
+}
+
+func (p *parser) dfa1() (any, bool) {
+	start := p.pt
+	accept := start
+	var back [2]position
+	var n int
+	switch cur := p.dfaRune(); {
+	case cur == 'a':
+		back[n%2] = p.pt.position
+		n++
+		p.read()
+		goto s1
+	case cur == 'b':
+		p.failAt(false, p.pt.position, "\"ab\"")
+		p.failAt(false, p.pt.position, "\"aba\"")
+		p.failAt(false, p.pt.position, "\"a\"")
+		back[n%2] = p.pt.position
+		n++
+		p.read()
+		goto s2
+	}
+	p.failAt(false, p.pt.position, "\"ab\"")
+	p.failAt(false, p.pt.position, "\"aba\"")
+	p.failAt(false, p.pt.position, "\"a\"")
+	p.failAt(false, p.pt.position, "\"ba\"")
+	p.failAt(false, p.pt.position, "\"a0b\"")
+	p.failAt(false, p.pt.position, "\"aa\"")
+	p.restore(start)
+	return nil, false
+s1:
+	switch cur := p.dfaRune(); {
+	case cur == '0':
+		p.failAt(false, back[(n-1)%2], "\"ab\"")
+		p.failAt(false, back[(n-1)%2], "\"aba\"")
+		p.failAt(false, p.pt.position, "\"b\"")
+		back[n%2] = p.pt.position
+		n++
+		p.read()
+		goto s3
+	case cur == 'a':
+		p.failAt(false, back[(n-1)%2], "\"ba\"")
+		p.failAt(false, back[(n-1)%2], "\"ab\"")
+		p.failAt(false, back[(n-1)%2], "\"aba\"")
+		p.failAt(false, p.pt.position, "\"b\"")
+		p.failAt(false, p.pt.position, "\"0a\"")
+		p.failAt(false, back[(n-1)%2], "\"a0b\"")
+		back[n%2] = p.pt.position
+		n++
+		p.read()
+		return p.sliceFrom(start), true
+	case cur == 'b':
+		back[n%2] = p.pt.position
+		n++
+		p.read()
+		return p.sliceFrom(start), true
+	}
+	p.failAt(false, back[(n-1)%2], "\"ba\"")
+	p.failAt(false, back[(n-1)%2], "\"ab\"")
+	p.failAt(false, back[(n-1)%2], "\"aba\"")
+	p.failAt(false, p.pt.position, "\"b\"")
+	p.failAt(false, p.pt.position, "\"0a\"")
+	p.failAt(false, back[(n-1)%2], "\"a0b\"")
+	p.failAt(false, back[(n-1)%2], "\"aa\"")
+	p.restore(start)
+	return nil, false
+s2:
+	switch cur := p.dfaRune(); {
+	case cur == 'a':
+		back[n%2] = p.pt.position
+		n++
+		p.read()
+		accept = p.pt
+		goto s4
+	}
+	p.failAt(false, back[(n-1)%2], "\"a0b\"")
+	p.failAt(false, back[(n-1)%2], "\"aa\"")
+	p.failAt(false, back[(n-1)%2], "\"ba\"")
+	p.restore(start)
+	return nil, false
+s3:
+	switch cur := p.dfaRune(); {
+	case cur == 'a':
+		back[n%2] = p.pt.position
+		n++
+		p.read()
+		return p.sliceFrom(start), true
+	case cur == 'b':
+		p.failAt(false, back[(n-2)%2], "\"ba\"")
+		p.failAt(false, back[(n-1)%2], "\"0a\"")
+		back[n%2] = p.pt.position
+		n++
+		p.read()
+		return p.sliceFrom(start), true
+	}
+	p.failAt(false, back[(n-2)%2], "\"ba\"")
+	p.failAt(false, back[(n-2)%2], "\"aa\"")
+	p.failAt(false, back[(n-1)%2], "\"0a\"")
+	p.failAt(false, back[(n-2)%2], "\"a0b\"")
+	p.restore(start)
+	return nil, false
+s4:
+	switch cur := p.dfaRune(); {
+	case cur == 'a':
+		p.failAt(false, p.pt.position, "\"b\"")
+		back[n%2] = p.pt.position
+		n++
+		p.read()
+		return p.sliceFrom(start), true
+	case cur == 'b':
+		back[n%2] = p.pt.position
+		n++
+		p.read()
+		return p.sliceFrom(start), true
+	}
+	p.failAt(false, p.pt.position, "\"b\"")
+	p.failAt(false, p.pt.position, "\"a\"")
+	p.restore(accept)
+	return p.sliceFrom(start), true
+}
+
+func (p *parser) dfa2() (any, bool) {
+	start := p.pt
+	accept := start
+	var back [1]position
+	var n int
+	switch cur := p.dfaRune(); {
+	case cur == '.':
+		p.failAt(false, p.pt.position, "\"0x\"")
+		p.failAt(false, p.pt.position, "[0-9]")
+		back[n%1] = p.pt.position
+		n++
+		p.read()
+		goto s1
+	case cur == '0':
+		back[n%1] = p.pt.position
+		n++
+		p.read()
+		accept = p.pt
+		goto s2
+	case cur >= '1' && cur <= '9':
+		p.failAt(false, p.pt.position, "\"0x\"")
+		back[n%1] = p.pt.position
+		n++
+		p.read()
+		accept = p.pt
+		goto s3
+	}
+	p.failAt(false, p.pt.position, "\"0x\"")
+	p.failAt(false, p.pt.position, "[0-9]")
+	p.failAt(false, p.pt.position, "\".\"")
+	p.restore(start)
+	return nil, false
+s1:
+	switch cur := p.dfaRune(); {
+	case cur >= '0' && cur <= '9':
+		back[n%1] = p.pt.position
+		n++
+		p.read()
+		accept = p.pt
+		goto s4
+	}
+	p.failAt(false, p.pt.position, "[0-9]")
+	p.restore(start)
+	return nil, false
+s2:
+	switch cur := p.dfaRune(); {
+	case cur == '.':
+		p.failAt(false, back[(n-1)%1], "\"0x\"")
+		p.failAt(false, p.pt.position, "[0-9]")
+		back[n%1] = p.pt.position
+		n++
+		p.read()
+		goto s5
+	case cur >= '0' && cur <= '9':
+		p.failAt(false, back[(n-1)%1], "\"0x\"")
+		back[n%1] = p.pt.position
+		n++
+		p.read()
+		accept = p.pt
+		goto s3
+	case cur == 'x':
+		back[n%1] = p.pt.position
+		n++
+		p.read()
+		goto s6
+	}
+	p.failAt(false, back[(n-1)%1], "\"0x\"")
+	p.failAt(false, p.pt.position, "[0-9]")
+	p.failAt(false, p.pt.position, "\".\"")
+	p.restore(accept)
+	return p.sliceFrom(start), true
+s3:
+	switch cur := p.dfaRune(); {
+	case cur == '.':
+		p.failAt(false, p.pt.position, "[0-9]")
+		back[n%1] = p.pt.position
+		n++
+		p.read()
+		goto s5
+	case cur >= '0' && cur <= '9':
+		back[n%1] = p.pt.position
+		n++
+		p.read()
+		accept = p.pt
+		goto s3
+	}
+	p.failAt(false, p.pt.position, "[0-9]")
+	p.failAt(false, p.pt.position, "\".\"")
+	p.restore(accept)
+	return p.sliceFrom(start), true
+s4:
+	switch cur := p.dfaRune(); {
+	case cur >= '0' && cur <= '9':
+		back[n%1] = p.pt.position
+		n++
+		p.read()
+		accept = p.pt
+		goto s4
+	}
+	p.failAt(false, p.pt.position, "[0-9]")
+	p.restore(accept)
+	return p.sliceFrom(start), true
+s5:
+	switch cur := p.dfaRune(); {
+	case cur >= '0' && cur <= '9':
+		back[n%1] = p.pt.position
+		n++
+		p.read()
+		accept = p.pt
+		goto s7
+	}
+	p.failAt(false, p.pt.position, "[0-9]")
+	p.restore(accept)
+	return p.sliceFrom(start), true
+s6:
+	switch cur := p.dfaRune(); {
+	case cur >= '0' && cur <= '9':
+		back[n%1] = p.pt.position
+		n++
+		p.read()
+		accept = p.pt
+		goto s8
+	}
+	p.failAt(false, back[(n-1)%1], "[0-9]")
+	p.failAt(false, back[(n-1)%1], "\".\"")
+	p.failAt(false, p.pt.position, "[0-9]")
+	p.restore(accept)
+	return p.sliceFrom(start), true
+s7:
+	switch cur := p.dfaRune(); {
+	case cur >= '0' && cur <= '9':
+		back[n%1] = p.pt.position
+		n++
+		p.read()
+		accept = p.pt
+		goto s7
+	}
+	p.failAt(false, p.pt.position, "[0-9]")
+	p.restore(accept)
+	return p.sliceFrom(start), true
+s8:
+	switch cur := p.dfaRune(); {
+	case cur >= '0' && cur <= '9':
+		back[n%1] = p.pt.position
+		n++
+		p.read()
+		accept = p.pt
+		goto s8
+	}
+	p.failAt(false, p.pt.position, "[0-9]")
+	p.restore(accept)
+	return p.sliceFrom(start), true
+}
+
+func (p *parser) dfa3() (any, bool) {
+	start := p.pt
+	accept := start
+	switch cur := p.dfaRune(); {
+	case cur == '.':
+		p.read()
+		goto s1
+	}
+	p.failAt(false, p.pt.position, "\".\"")
+	p.restore(start)
+	return nil, false
+s1:
+	switch cur := p.dfaRune(); {
+	case cur == '.':
+		p.read()
+		accept = p.pt
+		goto s2
+	}
+	p.failAt(false, p.pt.position, "\".\"")
+	p.restore(start)
+	return nil, false
+s2:
+	switch cur := p.dfaRune(); {
+	case cur == '.':
+		p.read()
+		return p.sliceFrom(start), true
+	}
+	p.failAt(false, p.pt.position, "\".\"")
+	p.restore(accept)
+	return p.sliceFrom(start), true
+}
+
+func (p *parser) dfa4() (any, bool) {
+	start := p.pt
+	accept := start
+	switch cur := p.dfaRune(); {
+	case cur >= 'a' && cur <= 'z':
+		p.read()
+		accept = p.pt
+		goto s1
+	}
+	p.failAt(false, p.pt.position, "[a-z]")
+	p.restore(start)
+	return nil, false
+s1:
+	switch cur := p.dfaRune(); {
+	case cur == '.':
+		p.failAt(false, p.pt.position, "[a-z]")
+		p.read()
+		goto s2
+	case cur >= 'a' && cur <= 'z':
+		p.read()
+		accept = p.pt
+		goto s1
+	}
+	p.failAt(false, p.pt.position, "[a-z]")
+	p.failAt(false, p.pt.position, "\".\"")
+	p.restore(accept)
+	return p.sliceFrom(start), true
+s2:
+	switch cur := p.dfaRune(); {
+	case cur >= 'a' && cur <= 'z':
+		p.read()
+		accept = p.pt
+		goto s3
+	}
+	p.failAt(false, p.pt.position, "[a-z]")
+	p.restore(accept)
+	return p.sliceFrom(start), true
+s3:
+	switch cur := p.dfaRune(); {
+	case cur == '.':
+		p.failAt(false, p.pt.position, "[a-z]")
+		p.read()
+		goto s2
+	case cur >= 'a' && cur <= 'z':
+		p.read()
+		accept = p.pt
+		goto s3
+	}
+	p.failAt(false, p.pt.position, "[a-z]")
+	p.failAt(false, p.pt.position, "\".\"")
+	p.restore(accept)
+	return p.sliceFrom(start), true
+}
+
+var (
+	// errNoRule is returned when the grammar to parse has no rule.
+	errNoRule = errors.New("grammar has no rule")
+
+	// errInvalidEntrypoint is returned when the specified entrypoint rule
+	// does not exit.
+	errInvalidEntrypoint = errors.New("invalid entrypoint")
+
+	// errInvalidEncoding is returned when the source is not properly
+	// utf8-encoded.
+	errInvalidEncoding = errors.New("invalid encoding")
+
+	// errMaxExprCnt is used to signal that the maximum number of
+	// expressions have been parsed.
+	errMaxExprCnt = errors.New("max number of expressions parsed")
+)
+
+// Option is a function that can set an option on the parser. It returns
+// the previous setting as an Option.
+type Option func(*parser) Option
+
+// MaxExpressions creates an Option to stop parsing after the provided
+// number of expressions have been parsed, if the value is 0 then the parser will
+// parse for as many steps as needed (possibly an infinite number).
+//
+// The default for maxExprCnt is 0.
+func MaxExpressions(maxExprCnt uint64) Option {
+	return func(p *parser) Option {
+		oldMaxExprCnt := p.maxExprCnt
+		p.maxExprCnt = maxExprCnt
+		return MaxExpressions(oldMaxExprCnt)
+	}
+}
+
+// Entrypoint creates an Option to set the rule name to use as entrypoint.
+// The rule name must have been specified in the -alternate-entrypoints
+// if generating the parser with the -optimize-grammar flag, otherwise
+// it may have been optimized out. Passing an empty string sets the
+// entrypoint to the first rule in the grammar.
+//
+// The default is to start parsing at the first rule in the grammar.
+func Entrypoint(ruleName string) Option {
+	return func(p *parser) Option {
+		oldEntrypoint := p.entrypoint
+		p.entrypoint = ruleName
+		if ruleName == "" {
+			p.entrypoint = g.rules[0].name
+		}
+		return Entrypoint(oldEntrypoint)
+	}
+}
+
+// Statistics adds a user provided Stats struct to the parser to allow
+// the user to process the results after the parsing has finished.
+// Also the key for the "no match" counter is set. Per-rule statistics
+// (see RuleStats) are only collected when this option is set.
+//
+// Example usage:
+//
+//	input := "input"
+//	stats := Stats{}
+//	_, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	fmt.Println(string(b))
+func Statistics(stats *Stats, choiceNoMatch string) Option {
+	return func(p *parser) Option {
+		oldStats := p.Stats
+		p.Stats = stats
+		oldChoiceNoMatch := p.choiceNoMatch
+		p.choiceNoMatch = choiceNoMatch
+		if p.Stats.ChoiceAltCnt == nil {
+			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
+		}
+		if p.Stats.Rules == nil {
+			p.Stats.Rules = make(map[string]*RuleStats)
+		}
+		return Statistics(oldStats, oldChoiceNoMatch)
+	}
+}
+
+// Debug creates an Option to set the debug flag to b. When set to true,
+// debugging information is printed to stdout while parsing.
+//
+// The default is false.
+func Debug(b bool) Option {
+	return func(p *parser) Option {
+		old := p.debug
+		p.debug = b
+		return Debug(old)
+	}
+}
+
+// Memoize creates an Option to set the memoize flag to b. When set to true,
+// the parser will cache all results so each expression is evaluated only
+// once. This guarantees linear parsing time even for pathological cases,
+// at the expense of more memory and slower times for typical cases.
+// The rules annotated with @memo in the grammar are always memoized,
+// regardless of this option. The memoization table is pooled, so its
+// storage is reused by the following parses.
+//
+// The default is false.
+func Memoize(b bool) Option {
+	return func(p *parser) Option {
+		old := p.memoize
+		p.memoize = b
+		return Memoize(old)
+	}
+}
+
+// AllowInvalidUTF8 creates an Option to allow invalid UTF-8 bytes.
+// Every invalid UTF-8 byte is treated as a utf8.RuneError (U+FFFD)
+// by character class matchers and is matched by the any matcher.
+// The returned matched value, c.text and c.offset are NOT affected.
+//
+// The default is false.
+func AllowInvalidUTF8(b bool) Option {
+	return func(p *parser) Option {
+		old := p.allowInvalidUTF8
+		p.allowInvalidUTF8 = b
+		return AllowInvalidUTF8(old)
+	}
+}
+
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
+// Recover creates an Option to set the recover flag to b. When set to
+// true, this causes the parser to recover from panics and convert it
+// to an error. Setting it to false can be useful while debugging to
+// access the full stack trace.
+//
+// The default is true.
+func Recover(b bool) Option {
+	return func(p *parser) Option {
+		old := p.recover
+		p.recover = b
+		return Recover(old)
+	}
+}
+
+// GlobalStore creates an Option to set a key to a certain value in
+// the globalStore.
+func GlobalStore(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.globalStore[key]
+		p.cur.globalStore[key] = value
+		return GlobalStore(key, old)
+	}
+}
+
+// InitState creates an Option to set a key to a certain value in
+// the global "state" store.
+func InitState(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.state[key]
+		p.cur.state[key] = value
+		return InitState(key, old)
+	}
+}
+
+// ParseFile parses the file identified by filename.
+func ParseFile(filename string, opts ...Option) (i any, err error) { // nolint: deadcode
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+	return ParseReader(filename, f, opts...)
+}
+
+// ParseReader parses the data from r using filename as information in the
+// error messages.
+func ParseReader(filename string, r io.Reader, opts ...Option) (any, error) { // nolint: deadcode
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(filename, b, opts...)
+}
+
+// Parse parses the data from b using filename as information in the
+// error messages.
+func Parse(filename string, b []byte, opts ...Option) (any, error) {
+	return newParser(filename, b, opts...).parse(g)
+}
+
+// position records a position in the text.
+type position struct {
+	line, col, offset int
+}
+
+func (p position) String() string {
+	return strconv.Itoa(p.line) + ":" + strconv.Itoa(p.col) + " [" + strconv.Itoa(p.offset) + "]"
+}
+
+// savepoint stores all state required to go back to this point in the
+// parser.
+type savepoint struct {
+	position
+	rn rune
+	w  int
+}
+
+type current struct {
+	pos  position // start position of the match
+	text []byte   // raw text of the match
+
+	// state is a store for arbitrary key,value pairs that the user wants to be
+	// tied to the backtracking of the parser.
+	// This is always rolled back if a parsing rule fails.
+	state storeDict
+
+	// globalStore is a general store for the user to store arbitrary key-value
+	// pairs that they need to manage and that they do not want tied to the
+	// backtracking of the parser. This is only modified by the user and never
+	// rolled back by the parser. It is always up to the user to keep this in a
+	// consistent state.
+	globalStore storeDict
+}
+
+type storeDict map[string]any
+
+// the AST types...
+
+// nolint: structcheck
+type grammar struct {
+	pos   position
+	rules []*rule
+}
+
+// nolint: structcheck
+type rule struct {
+	pos         position
+	name        string
+	displayName string
+	expr        any
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+//
+//	nolint: structcheck
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
+}
+
+// nolint: structcheck
+type choiceExpr struct {
+	pos          position
+	id           int
+	alternatives []any
+	dispatch     *choiceDispatch
+}
+
+// nolint: structcheck
+type actionExpr struct {
+	pos  position
+	id   int
+	expr any
+	run  func(*parser) (any, error)
+}
+
+// nolint: structcheck
+type recoveryExpr struct {
+	pos          position
+	id           int
+	expr         any
+	recoverExpr  any
+	failureLabel []string
+}
+
+// nolint: structcheck
+type seqExpr struct {
+	pos   position
+	id    int
+	exprs []any
+	// pluck is the indexes of the expressions whose values are the value of
+	// the sequence, if any.
+	pluck []int
+}
+
+// nolint: structcheck
+type labeledExpr struct {
+	pos   position
+	id    int
+	label string
+	expr  any
+}
+
+// nolint: structcheck
+type expr struct {
+	pos  position
+	id   int
+	expr any
+}
+
+// nolint: structcheck
+type repeatExpr struct {
+	pos  position
+	id   int
+	expr any
+	// max is -1 if the number of repetitions is not bounded.
+	min int
+	max int
+}
+
+type (
+	andExpr        expr // nolint: structcheck
+	notExpr        expr // nolint: structcheck
+	zeroOrOneExpr  expr // nolint: structcheck
+	zeroOrMoreExpr expr // nolint: structcheck
+	oneOrMoreExpr  expr // nolint: structcheck
+)
+
+// nolint: structcheck
+type ruleRefExpr struct {
+	pos  position
+	id   int
+	name string
+}
+
+// nolint: structcheck
+type stateCodeExpr struct {
+	pos position
+	id  int
+	run func(*parser) error
+}
+
+// nolint: structcheck
+type andCodeExpr struct {
+	pos position
+	id  int
+	run func(*parser) (bool, error)
+}
+
+// nolint: structcheck
+type notCodeExpr struct {
+	pos position
+	id  int
+	run func(*parser) (bool, error)
+}
+
+// nolint: structcheck
+type litMatcher struct {
+	pos        position
+	id         int
+	val        string
+	ignoreCase bool
+	want       string
+}
+
+// nolint: structcheck
+type charClassMatcher struct {
+	pos             position
+	id              int
+	val             string
+	basicLatinChars [128]bool
+	chars           []rune
+	ranges          []rune
+	classes         []*unicode.RangeTable
+	ignoreCase      bool
+	inverted        bool
+}
+
+// nolint: structcheck
+type anyMatcher struct {
+	pos position
+	id  int
+}
+
+// dfaExpr is an expression that is matched by the deterministic finite
+// automaton run, expr is the expression itself, see parseDFAExpr.
+//
+//	nolint: structcheck
+type dfaExpr struct {
+	pos  position
+	id   int
+	run  func(*parser) (any, bool)
+	expr any
+}
+
+// errList cumulates the errors found by the parser.
+type errList []error
+
+func (e *errList) add(err error) {
+	*e = append(*e, err)
+}
+
+func (e errList) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	e.dedupe()
+	return e
+}
+
+func (e *errList) dedupe() {
+	var cleaned []error
+	set := make(map[string]bool)
+	for _, err := range *e {
+		if msg := err.Error(); !set[msg] {
+			set[msg] = true
+			cleaned = append(cleaned, err)
+		}
+	}
+	*e = cleaned
+}
+
+func (e errList) Error() string {
+	switch len(e) {
+	case 0:
+		return ""
+	case 1:
+		return e[0].Error()
+	default:
+		var buf bytes.Buffer
+
+		for i, err := range e {
+			if i > 0 {
+				buf.WriteRune('\n')
+			}
+			buf.WriteString(err.Error())
+		}
+		return buf.String()
+	}
+}
+
+// parserError wraps an error with a prefix indicating the rule in which
+// the error occurred. The original error is stored in the Inner field.
+type parserError struct {
+	Inner    error
+	pos      position
+	prefix   string
+	expected []string
+}
+
+// Error returns the error message.
+func (p *parserError) Error() string {
+	return p.prefix + ": " + p.Inner.Error()
+}
+
+// newParser creates a parser with the specified input source and options.
+func newParser(filename string, b []byte, opts ...Option) *parser {
+	stats := Stats{
+		ChoiceAltCnt: make(map[string]map[string]int),
+	}
+
+	p := &parser{
+		filename: filename,
+		errs:     new(errList),
+		data:     b,
+		pt:       savepoint{position: position{line: 1}},
+		recover:  true,
+		cur: current{
+			state:       make(storeDict),
+			globalStore: make(storeDict),
+		},
+		maxFailPos:      position{col: 1, line: 1},
+		maxFailExpected: make([]string, 0, 20),
+		Stats:           &stats,
+		// start rule is rule [0] unless an alternate entrypoint is specified
+		entrypoint: g.rules[0].name,
+	}
+	p.setOptions(opts)
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
+
+	if p.maxExprCnt == 0 {
+		p.maxExprCnt = math.MaxUint64
+	}
+
+	return p
+}
+
+// setOptions applies the options to the parser.
+func (p *parser) setOptions(opts []Option) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// nolint: structcheck,deadcode
+type resultTuple struct {
+	v   any
+	b   bool
+	end savepoint
+}
+
+// nolint: varcheck
+const choiceNoMatch = -1
+
+// Stats stores some statistics, gathered during parsing
+type Stats struct {
+	// ExprCnt counts the number of expressions processed during parsing
+	// This value is compared to the maximum number of expressions allowed
+	// (set by the MaxExpressions option).
+	ExprCnt uint64
+
+	// ChoiceAltCnt is used to count for each ordered choice expression,
+	// which alternative is used how may times.
+	// These numbers allow to optimize the order of the ordered choice expression
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
+	//
+	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
+	// as the line and the column of the ordered choice.
+	// The inner key of ChoiceAltCnt is the number (one-based) of the matching alternative.
+	// For each alternative the number of matches are counted. If an ordered choice does not
+	// match, a special counter is incremented. The name of this counter is set with
+	// the parser option Statistics.
+	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
+	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
+}
+
+// nolint: structcheck,maligned
+type parser struct {
+	filename string
+	pt       savepoint
+	cur      current
+
+	data []byte
+	errs *errList
+
+	depth   int
+	recover bool
+	debug   bool
+
+	memoize bool
+	// memoization table for the packrat algorithm, taken from memoTables
+	// on the first memoized result
+	memo *memoTable
+
+	// rules table, maps the rule identifier to the rule node
+	rules map[string]*rule
+	// variables stack, map of label to value
+	vstack []map[string]any
+	// rule stack, allows identification of the current rule in errors
+	rstack []*rule
+
+	// parse fail
+	maxFailPos            position
+	maxFailExpected       []string
+	maxFailInvertExpected bool
+
+	// max number of expressions to be parsed
+	maxExprCnt uint64
+	// entrypoint for the parser
+	entrypoint string
+
+	allowInvalidUTF8 bool
+
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+
+	*Stats
+	// profiling frames, tracks the time spent in invoked rules to compute
+	// the self time of each rule.
+	profStack []profFrame
+	// number of active invocations of each rule, the time of a recursive
+	// rule is only added at its outermost invocation.
+	profActive map[*rule]int
+	// rules evaluated at each offset, to count the repeated evaluations.
+	profEvals map[profEval]bool
+
+	choiceNoMatch string
+	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
+}
+
+// push a variable set on the vstack.
+func (p *parser) pushV() {
+	if cap(p.vstack) == len(p.vstack) {
+		// create new empty slot in the stack
+		p.vstack = append(p.vstack, nil)
+	} else {
+		// slice to 1 more
+		p.vstack = p.vstack[:len(p.vstack)+1]
+	}
+
+	// get the last args set
+	m := p.vstack[len(p.vstack)-1]
+	if m != nil && len(m) == 0 {
+		// empty map, all good
+		return
+	}
+
+	m = make(map[string]any)
+	p.vstack[len(p.vstack)-1] = m
+}
+
+// pop a variable set from the vstack.
+func (p *parser) popV() {
+	// if the map is not empty, clear it
+	m := p.vstack[len(p.vstack)-1]
+	if len(m) > 0 {
+		// GC that map
+		p.vstack[len(p.vstack)-1] = nil
+	}
+	p.vstack = p.vstack[:len(p.vstack)-1]
+}
+
+// push a recovery expression with its labels to the recoveryStack
+func (p *parser) pushRecovery(labels []string, expr any) {
+	if cap(p.recoveryStack) == len(p.recoveryStack) {
+		// create new empty slot in the stack
+		p.recoveryStack = append(p.recoveryStack, recovery{})
+	} else {
+		// slice to 1 more
+		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
+	}
+
+	m := make(map[string]any, len(labels))
+	for _, fl := range labels {
+		m[fl] = expr
+	}
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
+}
+
+// pop a recovery expression from the recoveryStack
+func (p *parser) popRecovery() {
+	// GC that map
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
+
+	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
+}
+
+func (p *parser) print(prefix, s string) string {
+	if !p.debug {
+		return s
+	}
+
+	pos := p.pt.position
+	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
+		prefix, pos.line, pos.col, pos.offset, s, p.pt.rn)
+	return s
+}
+
+func (p *parser) printIndent(mark string, s string) string {
+	return p.print(strings.Repeat(" ", p.depth)+mark, s)
+}
+
+func (p *parser) in(s string) string {
+	res := p.printIndent(">", s)
+	p.depth++
+	return res
+}
+
+func (p *parser) out(s string) string {
+	p.depth--
+	return p.printIndent("<", s)
+}
+
+func (p *parser) addErr(err error) {
+	p.addErrAt(err, p.pt.position, []string{})
+}
+
+func (p *parser) addErrAt(err error, pos position, expected []string) {
+	var buf bytes.Buffer
+	if p.filename != "" {
+		buf.WriteString(p.filename)
+	}
+	if buf.Len() > 0 {
+		buf.WriteString(":")
+	}
+	buf.WriteString(fmt.Sprintf("%d:%d (%d)", pos.line, pos.col, pos.offset))
+	if len(p.rstack) > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString(": ")
+		}
+		rule := p.rstack[len(p.rstack)-1]
+		if rule.displayName != "" {
+			buf.WriteString("rule " + rule.displayName)
+		} else {
+			buf.WriteString("rule " + rule.name)
+		}
+	}
+	pe := &parserError{Inner: err, pos: pos, prefix: buf.String(), expected: expected}
+	p.errs.add(pe)
+}
+
+func (p *parser) failAt(fail bool, pos position, want string) {
+	// process fail if parsing fails and not inverted or parsing succeeds and invert is set
+	if fail == p.maxFailInvertExpected {
+		if pos.offset < p.maxFailPos.offset {
+			return
+		}
+
+		if pos.offset > p.maxFailPos.offset {
+			p.maxFailPos = pos
+			p.maxFailExpected = p.maxFailExpected[:0]
+		}
+
+		if p.maxFailInvertExpected {
+			want = "!" + want
+		}
+		p.maxFailExpected = append(p.maxFailExpected, want)
+	}
+}
+
+// read advances the parser to the next rune.
+func (p *parser) read() {
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
+	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
+	p.pt.rn = rn
+	p.pt.w = n
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
+	}
+
+	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
+		if !p.allowInvalidUTF8 {
+			p.addErr(errInvalidEncoding)
+		}
+	}
+}
+
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
+// dfaRune returns the current rune for the automata of the grammar, or -1
+// at the end of the input.
+func (p *parser) dfaRune() rune {
+	if p.pt.w == 0 {
+		return -1
+	}
+	return p.pt.rn
+}
+
+// restore parser position to the savepoint pt.
+func (p *parser) restore(pt savepoint) {
+	if p.debug {
+		defer p.out(p.in("restore"))
+	}
+	if pt.offset == p.pt.offset {
+		return
+	}
+	if p.Rules != nil && pt.offset < p.pt.offset && len(p.rstack) > 0 {
+		p.ruleStats(p.rstack[len(p.rstack)-1]).Backtracked += uint64(p.pt.offset - pt.offset)
+	}
+	p.pt = pt
+}
+
+// Cloner is implemented by any value that has a Clone method, which returns a
+// copy of the value. This is mainly used for types which are not passed by
+// value (e.g map, slice, chan) or structs that contain such types.
+//
+// This is used in conjunction with the global state feature to create proper
+// copies of the state to allow the parser to properly restore the state in
+// the case of backtracking.
+type Cloner interface {
+	Clone() any
+}
+
+var statePool = &sync.Pool{
+	New: func() any { return make(storeDict) },
+}
+
+func (sd storeDict) Discard() {
+	for k := range sd {
+		delete(sd, k)
+	}
+	statePool.Put(sd)
+}
+
+// clone and return parser current state.
+func (p *parser) cloneState() storeDict {
+	if p.debug {
+		defer p.out(p.in("cloneState"))
+	}
+
+	state := statePool.Get().(storeDict)
+	for k, v := range p.cur.state {
+		if c, ok := v.(Cloner); ok {
+			state[k] = c.Clone()
+		} else {
+			state[k] = v
+		}
+	}
+	return state
+}
+
+// restore parser current state to the state storeDict.
+// every restoreState should applied only one time for every cloned state
+func (p *parser) restoreState(state storeDict) {
+	if p.debug {
+		defer p.out(p.in("restoreState"))
+	}
+	p.cur.state.Discard()
+	p.cur.state = state
+}
+
+// get the slice of bytes from the savepoint start to the current position.
+func (p *parser) sliceFrom(start savepoint) []byte {
+	return p.data[start.position.offset:p.pt.position.offset]
+}
+
+// memoSlot is an entry of the memoization table, the key of an empty slot
+// is 0.
+type memoSlot struct {
+	key uint64
+	res resultTuple
+}
+
+// memoTable is the memoization table for the packrat algorithm, an
+// open-addressed hash table with linear probing that maps the offset in
+// the source and the id of a rule or expression to its result.
+type memoTable struct {
+	slots []memoSlot
+	shift uint
+	n     int
+}
+
+// memoTables pools the memoization tables so that their storage is
+// reused across parses.
+var memoTables = &sync.Pool{
+	New: func() any {
+		return &memoTable{slots: make([]memoSlot, 1<<10), shift: 64 - 10}
+	},
+}
+
+// memoKey returns the key of the result of the rule or expression id at
+// offset, it supports up to 1<<24 ids.
+func memoKey(offset, id int) uint64 {
+	return uint64(offset+1)<<24 | uint64(id)
+}
+
+// index returns the index of the slot of key, or of the empty slot where
+// it should be stored.
+func (t *memoTable) index(key uint64) int {
+	mask := len(t.slots) - 1
+	i := int((key*0x9E3779B97F4A7C15)>>t.shift) & mask
+	for t.slots[i].key != 0 && t.slots[i].key != key {
+		i = (i + 1) & mask
+	}
+	return i
+}
+
+func (t *memoTable) get(key uint64) (resultTuple, bool) {
+	s := &t.slots[t.index(key)]
+	return s.res, s.key == key
+}
+
+func (t *memoTable) set(key uint64, res resultTuple) {
+	if 4*(t.n+1) > 3*len(t.slots) {
+		t.grow()
+	}
+	s := &t.slots[t.index(key)]
+	if s.key == 0 {
+		s.key = key
+		t.n++
+	}
+	s.res = res
+}
+
+// grow doubles the capacity of the table.
+func (t *memoTable) grow() {
+	old := t.slots
+	t.slots = make([]memoSlot, 2*len(old))
+	t.shift--
+	for _, s := range old {
+		if s.key != 0 {
+			t.slots[t.index(s.key)] = s
+		}
+	}
+}
+
+// reset empties the table, releasing the references to the results.
+func (t *memoTable) reset() {
+	if t.n == 0 {
+		return
+	}
+	for i := range t.slots {
+		t.slots[i] = memoSlot{}
+	}
+	t.n = 0
+}
+
+func (p *parser) getMemoized(id int) (resultTuple, bool) {
+	if p.memo == nil {
+		return resultTuple{}, false
+	}
+	return p.memo.get(memoKey(p.pt.offset, id))
+}
+
+func (p *parser) setMemoized(pt savepoint, id int, tuple resultTuple) {
+	if p.memo == nil {
+		p.memo = memoTables.Get().(*memoTable)
+	}
+	p.memo.set(memoKey(pt.offset, id), tuple)
+}
+
+// releaseMemo returns the memoization table to the pool.
+func (p *parser) releaseMemo() {
+	if p.memo == nil {
+		return
+	}
+	p.memo.reset()
+	memoTables.Put(p.memo)
+	p.memo = nil
+}
+
+func (p *parser) buildRulesTable(g *grammar) {
+	p.rules = make(map[string]*rule, len(g.rules))
+	for _, r := range g.rules {
+		p.rules[r.name] = r
+	}
+}
+
+// nolint: gocyclo
+func (p *parser) parse(g *grammar) (val any, err error) {
+	if len(g.rules) == 0 {
+		p.addErr(errNoRule)
+		return nil, p.errs.err()
+	}
+
+	// TODO : not super critical but this could be generated
+	p.buildRulesTable(g)
+	defer p.releaseMemo()
+
+	if p.recover {
+		// panic can be used in action code to stop parsing immediately
+		// and return the panic as an error.
+		defer func() {
+			if e := recover(); e != nil {
+				if p.debug {
+					defer p.out(p.in("panic handler"))
+				}
+				val = nil
+				switch e := e.(type) {
+				case error:
+					p.addErr(e)
+				default:
+					p.addErr(fmt.Errorf("%v", e))
+				}
+				err = p.errs.err()
+			}
+		}()
+	}
+
+	startRule, ok := p.rules[p.entrypoint]
+	if !ok {
+		p.addErr(errInvalidEntrypoint)
+		return nil, p.errs.err()
+	}
+
+	p.read() // advance to first rune
+	val, ok = p.parseRuleWrap(startRule)
+	if !ok {
+		if len(*p.errs) == 0 {
+			// If parsing fails, but no errors have been recorded, the expected values
+			// for the farthest parser position are returned as error.
+			p.addNoMatchErr()
+		}
+
+		return nil, p.errs.err()
+	}
+	return val, p.errs.err()
+}
+
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
+func listJoin(list []string, sep string, lastSep string) string {
+	switch len(list) {
+	case 0:
+		return ""
+	case 1:
+		return list[0]
+	default:
+		return strings.Join(list[:len(list)-1], sep) + " " + lastSep + " " + list[len(list)-1]
+	}
+}
+
+// profFrame is a rule invocation being profiled.
+type profFrame struct {
+	start time.Time
+	child time.Duration
+}
+
+// profEval is the evaluation of a rule at an offset.
+type profEval struct {
+	rule   *rule
+	offset int
+}
+
+// ruleStats returns the statistics of rule, creating them if needed.
+func (p *parser) ruleStats(rule *rule) *RuleStats {
+	rs := p.Rules[rule.name]
+	if rs == nil {
+		rs = &RuleStats{}
+		p.Rules[rule.name] = rs
+	}
+	return rs
+}
+
+func (p *parser) countMemo(rs *RuleStats, hit bool) {
+	if hit {
+		rs.MemoHits++
+	} else {
+		rs.MemoMisses++
+	}
+}
+
+func (p *parser) profEnter(r *rule) {
+	if p.profActive == nil {
+		p.profActive = make(map[*rule]int)
+	}
+	p.profActive[r]++
+	p.profStack = append(p.profStack, profFrame{start: time.Now()})
+}
+
+// countRepeat counts the evaluation of rule at the current offset if
+// it was already evaluated at that offset.
+func (p *parser) countRepeat(rule *rule) {
+	if p.profEvals == nil {
+		p.profEvals = make(map[profEval]bool)
+	}
+	key := profEval{rule: rule, offset: p.pt.offset}
+	if p.profEvals[key] {
+		p.ruleStats(rule).Repeats++
+		return
+	}
+	p.profEvals[key] = true
+}
+
+func (p *parser) profExit(rule *rule, start savepoint, ok bool) {
+	frame := p.profStack[len(p.profStack)-1]
+	p.profStack = p.profStack[:len(p.profStack)-1]
+	elapsed := time.Since(frame.start)
+	if n := len(p.profStack); n > 0 {
+		p.profStack[n-1].child += elapsed
+	}
+
+	rs := p.ruleStats(rule)
+	rs.Invocations++
+	if p.profActive[rule]--; p.profActive[rule] == 0 {
+		// the time of the inner invocations is included
+		rs.Time += elapsed
+	}
+	rs.SelfTime += elapsed - frame.child
+	if ok {
+		rs.Matches++
+		rs.Bytes += uint64(p.pt.offset - start.offset)
+	} else {
+		rs.Failures++
+	}
+}
+
+func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
+	if rule.id < 0 {
+		// its results depend on the captured texts or the state store
+		return p.parseRule(rule)
+	}
+	res, ok := p.getMemoized(rule.id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(rule), ok)
+	}
+	if ok {
+		p.restore(res.end)
+		return res.v, res.b
+	}
+
+	startMark := p.pt
+	val, ok := p.parseRule(rule)
+	p.setMemoized(startMark, rule.id, resultTuple{v: val, b: ok, end: p.pt})
+
+	return val, ok
+}
+
+func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRule " + rule.name))
+	}
+	var (
+		val       any
+		ok        bool
+		startMark = p.pt
+	)
+
+	if p.Rules != nil {
+		p.profEnter(rule)
+		defer func() { p.profExit(rule, startMark, ok) }()
+	}
+
+	if p.memoize {
+		val, ok = p.parseRuleMemoize(rule)
+	} else {
+		val, ok = p.parseRule(rule)
+	}
+
+	if ok && p.debug {
+		p.printIndent("MATCH", string(p.sliceFrom(startMark)))
+	}
+	return val, ok
+}
+
+func (p *parser) parseRule(rule *rule) (any, bool) {
+	if p.Rules != nil {
+		p.countRepeat(rule)
+	}
+	p.rstack = append(p.rstack, rule)
+	p.pushV()
+	val, ok := p.parseExprWrap(rule.expr)
+	p.popV()
+	p.rstack = p.rstack[:len(p.rstack)-1]
+	return val, ok
+}
+
+// incChoiceAltCnt counts the match of the alternative altI of the choice
+// at pos, or its failure if altI is choiceNoMatch.
+func (p *parser) incChoiceAltCnt(pos position, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, pos.line, pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
+func (p *parser) parseExprWrap(expr any) (any, bool) {
+	var pt savepoint
+	id := exprID(expr)
+
+	if p.memoize && id >= 0 {
+		res, ok := p.getMemoized(id)
+		if p.Rules != nil {
+			p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+		}
+		if ok {
+			p.restore(res.end)
+			return res.v, res.b
+		}
+		pt = p.pt
+	}
+
+	val, ok := p.parseExpr(expr)
+
+	if p.memoize && id >= 0 {
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
+	}
+	return val, ok
+}
+
+// exprID returns the id of the expression in the memoization table, -1 if
+// its results depend on the texts captured by the labels or on the state
+// store and are not memoized.
+//
+//	nolint: gocyclo
+func exprID(expr any) int {
+	switch expr := expr.(type) {
+	case *actionExpr:
+		return expr.id
+	case *andCodeExpr:
+		return expr.id
+	case *andExpr:
+		return expr.id
+	case *anyMatcher:
+		return expr.id
+	case *charClassMatcher:
+		return expr.id
+	case *choiceExpr:
+		return expr.id
+	case *labeledExpr:
+		return expr.id
+	case *litMatcher:
+		return expr.id
+	case *notCodeExpr:
+		return expr.id
+	case *notExpr:
+		return expr.id
+	case *oneOrMoreExpr:
+		return expr.id
+	case *recoveryExpr:
+		return expr.id
+	case *repeatExpr:
+		return expr.id
+	case *ruleRefExpr:
+		return expr.id
+	case *seqExpr:
+		return expr.id
+	case *stateCodeExpr:
+		return expr.id
+	case *zeroOrMoreExpr:
+		return expr.id
+	case *zeroOrOneExpr:
+		return expr.id
+	case *dfaExpr:
+		return expr.id
+	default:
+		panic(fmt.Sprintf("unknown expression type %T", expr))
+	}
+}
+
+// nolint: gocyclo
+func (p *parser) parseExpr(expr any) (any, bool) {
+	p.ExprCnt++
+	if p.ExprCnt > p.maxExprCnt {
+		panic(errMaxExprCnt)
+	}
+
+	var val any
+	var ok bool
+	switch expr := expr.(type) {
+	case *actionExpr:
+		val, ok = p.parseActionExpr(expr)
+	case *andCodeExpr:
+		val, ok = p.parseAndCodeExpr(expr)
+	case *andExpr:
+		val, ok = p.parseAndExpr(expr)
+	case *anyMatcher:
+		val, ok = p.parseAnyMatcher(expr)
+	case *charClassMatcher:
+		val, ok = p.parseCharClassMatcher(expr)
+	case *choiceExpr:
+		val, ok = p.parseChoiceExpr(expr)
+	case *labeledExpr:
+		val, ok = p.parseLabeledExpr(expr)
+	case *litMatcher:
+		val, ok = p.parseLitMatcher(expr)
+	case *notCodeExpr:
+		val, ok = p.parseNotCodeExpr(expr)
+	case *notExpr:
+		val, ok = p.parseNotExpr(expr)
+	case *oneOrMoreExpr:
+		val, ok = p.parseOneOrMoreExpr(expr)
+	case *recoveryExpr:
+		val, ok = p.parseRecoveryExpr(expr)
+	case *repeatExpr:
+		val, ok = p.parseRepeatExpr(expr)
+	case *ruleRefExpr:
+		val, ok = p.parseRuleRefExpr(expr)
+	case *dfaExpr:
+		val, ok = p.parseDFAExpr(expr)
+	case *seqExpr:
+		val, ok = p.parseSeqExpr(expr)
+	case *stateCodeExpr:
+		val, ok = p.parseStateCodeExpr(expr)
+	case *zeroOrMoreExpr:
+		val, ok = p.parseZeroOrMoreExpr(expr)
+	case *zeroOrOneExpr:
+		val, ok = p.parseZeroOrOneExpr(expr)
+	default:
+		panic(fmt.Sprintf("unknown expression type %T", expr))
+	}
+	return val, ok
+}
+
+func (p *parser) parseActionExpr(act *actionExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseActionExpr"))
+	}
+
+	start := p.pt
+	val, ok := p.parseExprWrap(act.expr)
+	if ok {
+		p.cur.pos = start.position
+		p.cur.text = p.sliceFrom(start)
+		state := p.cloneState()
+		actVal, err := act.run(p)
+		if err != nil {
+			p.addErrAt(err, start.position, []string{})
+		}
+		p.restoreState(state)
+
+		val = actVal
+	}
+	if ok && p.debug {
+		p.printIndent("MATCH", string(p.sliceFrom(start)))
+	}
+	return val, ok
+}
+
+func (p *parser) parseAndCodeExpr(and *andCodeExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseAndCodeExpr"))
+	}
+
+	state := p.cloneState()
+
+	ok, err := and.run(p)
+	if err != nil {
+		p.addErr(err)
+	}
+	p.restoreState(state)
+
+	return nil, ok
+}
+
+func (p *parser) parseAndExpr(and *andExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseAndExpr"))
+	}
+
+	pt := p.pt
+	state := p.cloneState()
+	p.pushV()
+	_, ok := p.parseExprWrap(and.expr)
+	p.popV()
+	p.restoreState(state)
+	p.restore(pt)
+
+	return nil, ok
+}
+
+func (p *parser) parseAnyMatcher(any *anyMatcher) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseAnyMatcher"))
+	}
+
+	if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+		// EOF - see utf8.DecodeRune
+		p.failAt(false, p.pt.position, ".")
+		return nil, false
+	}
+	start := p.pt
+	p.read()
+	p.failAt(true, start.position, ".")
+	return p.sliceFrom(start), true
+}
+
+func (p *parser) parseDFAExpr(dfa *dfaExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseDFAExpr"))
+	}
+
+	if p.maxFailInvertExpected {
+		// the automaton does not record the matches that an inverted
+		// predicate expects, see failAt.
+		return p.parseExpr(dfa.expr)
+	}
+	if p.Stats != nil {
+		// nor the statistics of the rules and the alternatives that it
+		// matches.
+		return p.parseExpr(dfa.expr)
+	}
+	return dfa.run(p)
+}
+
+// nolint: gocyclo
+func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseCharClassMatcher"))
+	}
+
+	cur := p.pt.rn
+	start := p.pt
+
+	// can't match EOF
+	if cur == utf8.RuneError && p.pt.w == 0 { // see utf8.DecodeRune
+		p.failAt(false, start.position, chr.val)
+		return nil, false
+	}
+
+	if chr.ignoreCase {
+		cur = unicode.ToLower(cur)
+	}
+
+	// try to match in the list of available chars
+	for _, rn := range chr.chars {
+		if rn == cur {
+			if chr.inverted {
+				p.failAt(false, start.position, chr.val)
+				return nil, false
+			}
+			p.read()
+			p.failAt(true, start.position, chr.val)
+			return p.sliceFrom(start), true
+		}
+	}
+
+	// try to match in the list of ranges
+	for i := 0; i < len(chr.ranges); i += 2 {
+		if cur >= chr.ranges[i] && cur <= chr.ranges[i+1] {
+			if chr.inverted {
+				p.failAt(false, start.position, chr.val)
+				return nil, false
+			}
+			p.read()
+			p.failAt(true, start.position, chr.val)
+			return p.sliceFrom(start), true
+		}
+	}
+
+	// try to match in the list of Unicode classes
+	for _, cl := range chr.classes {
+		if unicode.Is(cl, cur) {
+			if chr.inverted {
+				p.failAt(false, start.position, chr.val)
+				return nil, false
+			}
+			p.read()
+			p.failAt(true, start.position, chr.val)
+			return p.sliceFrom(start), true
+		}
+	}
+
+	if chr.inverted {
+		p.read()
+		p.failAt(true, start.position, chr.val)
+		return p.sliceFrom(start), true
+	}
+	p.failAt(false, start.position, chr.val)
+	return nil, false
+}
+
+func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseChoiceExpr"))
+	}
+
+	n := len(ch.alternatives)
+	var alts []int
+	if d := ch.dispatch; d != nil {
+		// the alternatives that cannot match the current rune are skipped,
+		// with the values they expect.
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		alts = d.alts[set]
+		n = len(alts)
+	}
+	for i := 0; i < n; i++ {
+		altI := i
+		if alts != nil {
+			altI = alts[i]
+		}
+		alt := ch.alternatives[altI]
+
+		state := p.cloneState()
+
+		p.pushV()
+		val, ok := p.parseExprWrap(alt)
+		p.popV()
+		if ok {
+			p.incChoiceAltCnt(ch.pos, altI)
+			return val, ok
+		}
+		p.restoreState(state)
+	}
+	p.incChoiceAltCnt(ch.pos, choiceNoMatch)
+	return nil, false
+}
+
+func (p *parser) parseLabeledExpr(lab *labeledExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseLabeledExpr"))
+	}
+
+	p.pushV()
+	val, ok := p.parseExprWrap(lab.expr)
+	p.popV()
+	if ok && lab.label != "" {
+		m := p.vstack[len(p.vstack)-1]
+		m[lab.label] = val
+	}
+	return val, ok
+}
+
+func (p *parser) parseLitMatcher(lit *litMatcher) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseLitMatcher"))
+	}
+
+	start := p.pt
+	for _, want := range lit.val {
+		cur := p.pt.rn
+		if lit.ignoreCase {
+			cur = unicode.ToLower(cur)
+		}
+		if cur != want {
+			p.failAt(false, start.position, lit.want)
+			p.restore(start)
+			return nil, false
+		}
+		p.read()
+	}
+	p.failAt(true, start.position, lit.want)
+	return p.sliceFrom(start), true
+}
+
+func (p *parser) parseNotCodeExpr(not *notCodeExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseNotCodeExpr"))
+	}
+
+	state := p.cloneState()
+
+	ok, err := not.run(p)
+	if err != nil {
+		p.addErr(err)
+	}
+	p.restoreState(state)
+
+	return nil, !ok
+}
+
+func (p *parser) parseNotExpr(not *notExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseNotExpr"))
+	}
+
+	pt := p.pt
+	state := p.cloneState()
+	p.pushV()
+	p.maxFailInvertExpected = !p.maxFailInvertExpected
+	_, ok := p.parseExprWrap(not.expr)
+	p.maxFailInvertExpected = !p.maxFailInvertExpected
+	p.popV()
+	p.restoreState(state)
+	p.restore(pt)
+
+	return nil, !ok
+}
+
+func (p *parser) parseOneOrMoreExpr(expr *oneOrMoreExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseOneOrMoreExpr"))
+	}
+
+	var vals []any
+
+	for {
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			if len(vals) == 0 {
+				// did not match once, no match
+				return nil, false
+			}
+			return vals, true
+		}
+		vals = append(vals, val)
+	}
+}
+
+func (p *parser) parseRecoveryExpr(recover *recoveryExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRecoveryExpr (" + strings.Join(recover.failureLabel, ",") + ")"))
+	}
+
+	p.pushRecovery(recover.failureLabel, recover.recoverExpr)
+	val, ok := p.parseExprWrap(recover.expr)
+	p.popRecovery()
+
+	return val, ok
+}
+
+func (p *parser) parseRepeatExpr(expr *repeatExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRepeatExpr"))
+	}
+
+	var vals []any
+
+	pt := p.pt
+	state := p.cloneState()
+	for expr.max < 0 || len(vals) < expr.max {
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			break
+		}
+		vals = append(vals, val)
+	}
+	if len(vals) < expr.min {
+		// did not match enough times, no match
+		p.restoreState(state)
+		p.restore(pt)
+		return nil, false
+	}
+	return vals, true
+}
+
+func (p *parser) parseRuleRefExpr(ref *ruleRefExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRuleRefExpr " + ref.name))
+	}
+
+	if ref.name == "" {
+		panic(fmt.Sprintf("%s: invalid rule: missing name", ref.pos))
+	}
+
+	rule := p.rules[ref.name]
+	if rule == nil {
+		p.addErr(fmt.Errorf("undefined rule: %s", ref.name))
+		return nil, false
+	}
+	return p.parseRuleWrap(rule)
+}
+
+func (p *parser) parseSeqExpr(seq *seqExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseSeqExpr"))
+	}
+
+	vals := make([]any, 0, len(seq.exprs))
+
+	pt := p.pt
+	state := p.cloneState()
+	for _, expr := range seq.exprs {
+		val, ok := p.parseExprWrap(expr)
+		if !ok {
+			p.restoreState(state)
+			p.restore(pt)
+			return nil, false
+		}
+		vals = append(vals, val)
+	}
+	if len(seq.pluck) > 0 {
+		return p.pluck(vals, seq.pluck), true
+	}
+	return vals, true
+}
+
+// pluck returns the value of a sequence with pluck expressions at the
+// indexes ix: the value at the index if there is only one, the slice of
+// the values at the indexes otherwise.
+func (p *parser) pluck(vals []any, ix []int) any {
+	if len(ix) == 1 {
+		return vals[ix[0]]
+	}
+	plucked := make([]any, len(ix))
+	for i, j := range ix {
+		plucked[i] = vals[j]
+	}
+	return plucked
+}
+
+func (p *parser) parseStateCodeExpr(state *stateCodeExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseStateCodeExpr"))
+	}
+
+	err := state.run(p)
+	if err != nil {
+		p.addErr(err)
+	}
+	return nil, true
+}
+
+func (p *parser) parseZeroOrMoreExpr(expr *zeroOrMoreExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseZeroOrMoreExpr"))
+	}
+
+	var vals []any
+
+	for {
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			return vals, true
+		}
+		vals = append(vals, val)
+	}
+}
+
+func (p *parser) parseZeroOrOneExpr(expr *zeroOrOneExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseZeroOrOneExpr"))
+	}
+
+	p.pushV()
+	val, _ := p.parseExprWrap(expr.expr)
+	p.popV()
+	// whether it matched or not, consider it a match
+	return val, true
+}
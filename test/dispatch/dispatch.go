@@ -0,0 +1,3181 @@
+// Code generated by pigeon; DO NOT EDIT.
+
+package dispatch
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+var g = &grammar{
+	rules: []*rule{
+		{
+			name: "Program",
+			pos:  position{line: 10, col: 1, offset: 284},
+			id:   0,
+			expr: &actionExpr{
+				pos: position{line: 10, col: 11, offset: 296},
+				id:  26,
+				run: (*parser).callonProgram1,
+				expr: &seqExpr{
+					pos: position{line: 10, col: 11, offset: 296},
+					id:  27,
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 10, col: 11, offset: 296},
+							id:   28,
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 10, col: 13, offset: 298},
+							id:    29,
+							label: "stmts",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 10, col: 19, offset: 304},
+								id:  30,
+								expr: &ruleRefExpr{
+									pos:  position{line: 10, col: 19, offset: 304},
+									id:   31,
+									name: "Stmt",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 10, col: 25, offset: 310},
+							id:   32,
+							name: "EOF",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Stmt",
+			pos:  position{line: 14, col: 1, offset: 341},
+			id:   1,
+			expr: &choiceExpr{
+				pos: position{line: 14, col: 8, offset: 350},
+				id:  33,
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 8, offset: 350},
+						id:   34,
+						name: "Break",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 16, offset: 358},
+						id:   35,
+						name: "Const",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 24, offset: 366},
+						id:   36,
+						name: "Continue",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 35, offset: 377},
+						id:   37,
+						name: "Defer",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 43, offset: 385},
+						id:   38,
+						name: "Else",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 50, offset: 392},
+						id:   39,
+						name: "For",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 56, offset: 398},
+						id:   40,
+						name: "Func",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 63, offset: 405},
+						id:   41,
+						name: "Go",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 68, offset: 410},
+						id:   42,
+						name: "Goto",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 75, offset: 417},
+						id:   43,
+						name: "If",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 80, offset: 422},
+						id:   44,
+						name: "Import",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 89, offset: 431},
+						id:   45,
+						name: "Print",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 97, offset: 439},
+						id:   46,
+						name: "Return",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 106, offset: 448},
+						id:   47,
+						name: "Switch",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 115, offset: 457},
+						id:   48,
+						name: "Type",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 122, offset: 464},
+						id:   49,
+						name: "Var",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 128, offset: 470},
+						id:   50,
+						name: "While",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 14, col: 136, offset: 478},
+						id:   51,
+						name: "Assign",
+					},
+				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x01\x02\x03\x04\x05\x06\a\x01\b\x01\x01\x01\x01\x01\x01\t\x01\n\v\f\x01\r\x0e\x01\x01\x01\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {17}, {0, 17}, {1, 2, 17}, {3, 17}, {4, 17}, {5, 6, 17}, {7, 8, 17}, {9, 10, 17}, {11, 17}, {12, 17}, {13, 17}, {14, 17}, {15, 17}, {16, 17}},
+					expected:  [][]string{{"\"break\"", "\"const\"", "\"continue\"", "\"defer\"", "\"else\"", "\"for\"", "\"func\"", "\"go\"", "\"goto\"", "\"if\"", "\"import\"", "\"print\"", "\"return\"", "\"switch\"", "\"type\"", "\"var\"", "\"while\"", "[a-z_]"}, {"\"break\"", "\"const\"", "\"continue\"", "\"defer\"", "\"else\"", "\"for\"", "\"func\"", "\"go\"", "\"goto\"", "\"if\"", "\"import\"", "\"print\"", "\"return\"", "\"switch\"", "\"type\"", "\"var\"", "\"while\""}, {"\"const\"", "\"continue\"", "\"defer\"", "\"else\"", "\"for\"", "\"func\"", "\"go\"", "\"goto\"", "\"if\"", "\"import\"", "\"print\"", "\"return\"", "\"switch\"", "\"type\"", "\"var\"", "\"while\""}, {"\"break\"", "\"defer\"", "\"else\"", "\"for\"", "\"func\"", "\"go\"", "\"goto\"", "\"if\"", "\"import\"", "\"print\"", "\"return\"", "\"switch\"", "\"type\"", "\"var\"", "\"while\""}, {"\"break\"", "\"const\"", "\"continue\"", "\"else\"", "\"for\"", "\"func\"", "\"go\"", "\"goto\"", "\"if\"", "\"import\"", "\"print\"", "\"return\"", "\"switch\"", "\"type\"", "\"var\"", "\"while\""}, {"\"break\"", "\"const\"", "\"continue\"", "\"defer\"", "\"for\"", "\"func\"", "\"go\"", "\"goto\"", "\"if\"", "\"import\"", "\"print\"", "\"return\"", "\"switch\"", "\"type\"", "\"var\"", "\"while\""}, {"\"break\"", "\"const\"", "\"continue\"", "\"defer\"", "\"else\"", "\"go\"", "\"goto\"", "\"if\"", "\"import\"", "\"print\"", "\"return\"", "\"switch\"", "\"type\"", "\"var\"", "\"while\""}, {"\"break\"", "\"const\"", "\"continue\"", "\"defer\"", "\"else\"", "\"for\"", "\"func\"", "\"if\"", "\"import\"", "\"print\"", "\"return\"", "\"switch\"", "\"type\"", "\"var\"", "\"while\""}, {"\"break\"", "\"const\"", "\"continue\"", "\"defer\"", "\"else\"", "\"for\"", "\"func\"", "\"go\"", "\"goto\"", "\"print\"", "\"return\"", "\"switch\"", "\"type\"", "\"var\"", "\"while\""}, {"\"break\"", "\"const\"", "\"continue\"", "\"defer\"", "\"else\"", "\"for\"", "\"func\"", "\"go\"", "\"goto\"", "\"if\"", "\"import\"", "\"return\"", "\"switch\"", "\"type\"", "\"var\"", "\"while\""}, {"\"break\"", "\"const\"", "\"continue\"", "\"defer\"", "\"else\"", "\"for\"", "\"func\"", "\"go\"", "\"goto\"", "\"if\"", "\"import\"", "\"print\"", "\"switch\"", "\"type\"", "\"var\"", "\"while\""}, {"\"break\"", "\"const\"", "\"continue\"", "\"defer\"", "\"else\"", "\"for\"", "\"func\"", "\"go\"", "\"goto\"", "\"if\"", "\"import\"", "\"print\"", "\"return\"", "\"type\"", "\"var\"", "\"while\""}, {"\"break\"", "\"const\"", "\"continue\"", "\"defer\"", "\"else\"", "\"for\"", "\"func\"", "\"go\"", "\"goto\"", "\"if\"", "\"import\"", "\"print\"", "\"return\"", "\"switch\"", "\"var\"", "\"while\""}, {"\"break\"", "\"const\"", "\"continue\"", "\"defer\"", "\"else\"", "\"for\"", "\"func\"", "\"go\"", "\"goto\"", "\"if\"", "\"import\"", "\"print\"", "\"return\"", "\"switch\"", "\"type\"", "\"while\""}, {"\"break\"", "\"const\"", "\"continue\"", "\"defer\"", "\"else\"", "\"for\"", "\"func\"", "\"go\"", "\"goto\"", "\"if\"", "\"import\"", "\"print\"", "\"return\"", "\"switch\"", "\"type\"", "\"var\""}},
+				},
+			},
+		},
+		{
+			name: "Break",
+			pos:  position{line: 16, col: 1, offset: 486},
+			id:   2,
+			expr: &actionExpr{
+				pos: position{line: 16, col: 9, offset: 496},
+				id:  52,
+				run: (*parser).callonBreak1,
+				expr: &seqExpr{
+					pos: position{line: 16, col: 9, offset: 496},
+					id:  53,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 16, col: 9, offset: 496},
+							id:         54,
+							val:        "break",
+							ignoreCase: false,
+							want:       "\"break\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 16, col: 17, offset: 504},
+							id:   55,
+							name: "_",
+						},
+						&litMatcher{
+							pos:        position{line: 16, col: 19, offset: 506},
+							id:         56,
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 16, col: 23, offset: 510},
+							id:   57,
+							name: "_",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Const",
+			pos:  position{line: 20, col: 1, offset: 541},
+			id:   3,
+			expr: &actionExpr{
+				pos: position{line: 20, col: 9, offset: 551},
+				id:  58,
+				run: (*parser).callonConst1,
+				expr: &seqExpr{
+					pos: position{line: 20, col: 9, offset: 551},
+					id:  59,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 20, col: 9, offset: 551},
+							id:         60,
+							val:        "const",
+							ignoreCase: false,
+							want:       "\"const\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 20, col: 17, offset: 559},
+							id:   61,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 20, col: 19, offset: 561},
+							id:   62,
+							name: "Ident",
+						},
+						&litMatcher{
+							pos:        position{line: 20, col: 25, offset: 567},
+							id:         63,
+							val:        "=",
+							ignoreCase: false,
+							want:       "\"=\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 20, col: 29, offset: 571},
+							id:   64,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 20, col: 31, offset: 573},
+							id:   65,
+							name: "Value",
+						},
+						&litMatcher{
+							pos:        position{line: 20, col: 37, offset: 579},
+							id:         66,
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 20, col: 41, offset: 583},
+							id:   67,
+							name: "_",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Continue",
+			pos:  position{line: 24, col: 1, offset: 614},
+			id:   4,
+			expr: &actionExpr{
+				pos: position{line: 24, col: 12, offset: 627},
+				id:  68,
+				run: (*parser).callonContinue1,
+				expr: &seqExpr{
+					pos: position{line: 24, col: 12, offset: 627},
+					id:  69,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 24, col: 12, offset: 627},
+							id:         70,
+							val:        "continue",
+							ignoreCase: false,
+							want:       "\"continue\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 24, col: 23, offset: 638},
+							id:   71,
+							name: "_",
+						},
+						&litMatcher{
+							pos:        position{line: 24, col: 25, offset: 640},
+							id:         72,
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 24, col: 29, offset: 644},
+							id:   73,
+							name: "_",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Defer",
+			pos:  position{line: 28, col: 1, offset: 678},
+			id:   5,
+			expr: &actionExpr{
+				pos: position{line: 28, col: 9, offset: 688},
+				id:  74,
+				run: (*parser).callonDefer1,
+				expr: &seqExpr{
+					pos: position{line: 28, col: 9, offset: 688},
+					id:  75,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 28, col: 9, offset: 688},
+							id:         76,
+							val:        "defer",
+							ignoreCase: false,
+							want:       "\"defer\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 28, col: 17, offset: 696},
+							id:   77,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 28, col: 19, offset: 698},
+							id:   78,
+							name: "Value",
+						},
+						&litMatcher{
+							pos:        position{line: 28, col: 25, offset: 704},
+							id:         79,
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 28, col: 29, offset: 708},
+							id:   80,
+							name: "_",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Else",
+			pos:  position{line: 32, col: 1, offset: 739},
+			id:   6,
+			expr: &actionExpr{
+				pos: position{line: 32, col: 8, offset: 748},
+				id:  81,
+				run: (*parser).callonElse1,
+				expr: &seqExpr{
+					pos: position{line: 32, col: 8, offset: 748},
+					id:  82,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 32, col: 8, offset: 748},
+							id:         83,
+							val:        "else",
+							ignoreCase: false,
+							want:       "\"else\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 32, col: 15, offset: 755},
+							id:   84,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 32, col: 17, offset: 757},
+							id:   85,
+							name: "Stmt",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "For",
+			pos:  position{line: 36, col: 1, offset: 790},
+			id:   7,
+			expr: &actionExpr{
+				pos: position{line: 36, col: 7, offset: 798},
+				id:  86,
+				run: (*parser).callonFor1,
+				expr: &seqExpr{
+					pos: position{line: 36, col: 7, offset: 798},
+					id:  87,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 36, col: 7, offset: 798},
+							id:         88,
+							val:        "for",
+							ignoreCase: false,
+							want:       "\"for\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 36, col: 13, offset: 804},
+							id:   89,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 36, col: 15, offset: 806},
+							id:   90,
+							name: "Value",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 36, col: 21, offset: 812},
+							id:   91,
+							name: "Stmt",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Func",
+			pos:  position{line: 40, col: 1, offset: 844},
+			id:   8,
+			expr: &actionExpr{
+				pos: position{line: 40, col: 8, offset: 853},
+				id:  92,
+				run: (*parser).callonFunc1,
+				expr: &seqExpr{
+					pos: position{line: 40, col: 8, offset: 853},
+					id:  93,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 40, col: 8, offset: 853},
+							id:         94,
+							val:        "func",
+							ignoreCase: false,
+							want:       "\"func\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 40, col: 15, offset: 860},
+							id:   95,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 40, col: 17, offset: 862},
+							id:   96,
+							name: "Ident",
+						},
+						&litMatcher{
+							pos:        position{line: 40, col: 23, offset: 868},
+							id:         97,
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 40, col: 27, offset: 872},
+							id:   98,
+							name: "_",
+						},
+						&litMatcher{
+							pos:        position{line: 40, col: 29, offset: 874},
+							id:         99,
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 40, col: 33, offset: 878},
+							id:   100,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 40, col: 35, offset: 880},
+							id:   101,
+							name: "Stmt",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Go",
+			pos:  position{line: 44, col: 1, offset: 913},
+			id:   9,
+			expr: &actionExpr{
+				pos: position{line: 44, col: 6, offset: 920},
+				id:  102,
+				run: (*parser).callonGo1,
+				expr: &seqExpr{
+					pos: position{line: 44, col: 6, offset: 920},
+					id:  103,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 44, col: 6, offset: 920},
+							id:         104,
+							val:        "go",
+							ignoreCase: false,
+							want:       "\"go\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 44, col: 11, offset: 925},
+							id:   105,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 44, col: 13, offset: 927},
+							id:   106,
+							name: "Value",
+						},
+						&litMatcher{
+							pos:        position{line: 44, col: 19, offset: 933},
+							id:         107,
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 44, col: 23, offset: 937},
+							id:   108,
+							name: "_",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Goto",
+			pos:  position{line: 48, col: 1, offset: 965},
+			id:   10,
+			expr: &actionExpr{
+				pos: position{line: 48, col: 8, offset: 974},
+				id:  109,
+				run: (*parser).callonGoto1,
+				expr: &seqExpr{
+					pos: position{line: 48, col: 8, offset: 974},
+					id:  110,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 48, col: 8, offset: 974},
+							id:         111,
+							val:        "goto",
+							ignoreCase: false,
+							want:       "\"goto\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 48, col: 15, offset: 981},
+							id:   112,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 48, col: 17, offset: 983},
+							id:   113,
+							name: "Ident",
+						},
+						&litMatcher{
+							pos:        position{line: 48, col: 23, offset: 989},
+							id:         114,
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 48, col: 27, offset: 993},
+							id:   115,
+							name: "_",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "If",
+			pos:  position{line: 52, col: 1, offset: 1023},
+			id:   11,
+			expr: &actionExpr{
+				pos: position{line: 52, col: 6, offset: 1030},
+				id:  116,
+				run: (*parser).callonIf1,
+				expr: &seqExpr{
+					pos: position{line: 52, col: 6, offset: 1030},
+					id:  117,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 52, col: 6, offset: 1030},
+							id:         118,
+							val:        "if",
+							ignoreCase: false,
+							want:       "\"if\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 52, col: 11, offset: 1035},
+							id:   119,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 52, col: 13, offset: 1037},
+							id:   120,
+							name: "Value",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 52, col: 19, offset: 1043},
+							id:   121,
+							name: "Stmt",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Import",
+			pos:  position{line: 56, col: 1, offset: 1074},
+			id:   12,
+			expr: &actionExpr{
+				pos: position{line: 56, col: 10, offset: 1085},
+				id:  122,
+				run: (*parser).callonImport1,
+				expr: &seqExpr{
+					pos: position{line: 56, col: 10, offset: 1085},
+					id:  123,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 56, col: 10, offset: 1085},
+							id:         124,
+							val:        "import",
+							ignoreCase: false,
+							want:       "\"import\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 56, col: 19, offset: 1094},
+							id:   125,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 56, col: 21, offset: 1096},
+							id:   126,
+							name: "String",
+						},
+						&litMatcher{
+							pos:        position{line: 56, col: 28, offset: 1103},
+							id:         127,
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 56, col: 32, offset: 1107},
+							id:   128,
+							name: "_",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Print",
+			pos:  position{line: 60, col: 1, offset: 1139},
+			id:   13,
+			expr: &actionExpr{
+				pos: position{line: 60, col: 9, offset: 1149},
+				id:  129,
+				run: (*parser).callonPrint1,
+				expr: &seqExpr{
+					pos: position{line: 60, col: 9, offset: 1149},
+					id:  130,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 60, col: 9, offset: 1149},
+							id:         131,
+							val:        "print",
+							ignoreCase: false,
+							want:       "\"print\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 60, col: 17, offset: 1157},
+							id:   132,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 60, col: 19, offset: 1159},
+							id:   133,
+							name: "Value",
+						},
+						&litMatcher{
+							pos:        position{line: 60, col: 25, offset: 1165},
+							id:         134,
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 60, col: 29, offset: 1169},
+							id:   135,
+							name: "_",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Return",
+			pos:  position{line: 64, col: 1, offset: 1200},
+			id:   14,
+			expr: &actionExpr{
+				pos: position{line: 64, col: 10, offset: 1211},
+				id:  136,
+				run: (*parser).callonReturn1,
+				expr: &seqExpr{
+					pos: position{line: 64, col: 10, offset: 1211},
+					id:  137,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 64, col: 10, offset: 1211},
+							id:         138,
+							val:        "return",
+							ignoreCase: false,
+							want:       "\"return\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 64, col: 19, offset: 1220},
+							id:   139,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 64, col: 21, offset: 1222},
+							id:   140,
+							name: "Value",
+						},
+						&litMatcher{
+							pos:        position{line: 64, col: 27, offset: 1228},
+							id:         141,
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 64, col: 31, offset: 1232},
+							id:   142,
+							name: "_",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Switch",
+			pos:  position{line: 68, col: 1, offset: 1264},
+			id:   15,
+			expr: &actionExpr{
+				pos: position{line: 68, col: 10, offset: 1275},
+				id:  143,
+				run: (*parser).callonSwitch1,
+				expr: &seqExpr{
+					pos: position{line: 68, col: 10, offset: 1275},
+					id:  144,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 68, col: 10, offset: 1275},
+							id:         145,
+							val:        "switch",
+							ignoreCase: false,
+							want:       "\"switch\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 68, col: 19, offset: 1284},
+							id:   146,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 68, col: 21, offset: 1286},
+							id:   147,
+							name: "Value",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 68, col: 27, offset: 1292},
+							id:   148,
+							name: "Stmt",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Type",
+			pos:  position{line: 72, col: 1, offset: 1327},
+			id:   16,
+			expr: &actionExpr{
+				pos: position{line: 72, col: 8, offset: 1336},
+				id:  149,
+				run: (*parser).callonType1,
+				expr: &seqExpr{
+					pos: position{line: 72, col: 8, offset: 1336},
+					id:  150,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 72, col: 8, offset: 1336},
+							id:         151,
+							val:        "type",
+							ignoreCase: false,
+							want:       "\"type\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 72, col: 15, offset: 1343},
+							id:   152,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 72, col: 17, offset: 1345},
+							id:   153,
+							name: "Ident",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 72, col: 23, offset: 1351},
+							id:   154,
+							name: "Ident",
+						},
+						&litMatcher{
+							pos:        position{line: 72, col: 29, offset: 1357},
+							id:         155,
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 72, col: 33, offset: 1361},
+							id:   156,
+							name: "_",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Var",
+			pos:  position{line: 76, col: 1, offset: 1391},
+			id:   17,
+			expr: &actionExpr{
+				pos: position{line: 76, col: 7, offset: 1399},
+				id:  157,
+				run: (*parser).callonVar1,
+				expr: &seqExpr{
+					pos: position{line: 76, col: 7, offset: 1399},
+					id:  158,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 76, col: 7, offset: 1399},
+							id:         159,
+							val:        "var",
+							ignoreCase: false,
+							want:       "\"var\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 76, col: 13, offset: 1405},
+							id:   160,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 76, col: 15, offset: 1407},
+							id:   161,
+							name: "Ident",
+						},
+						&litMatcher{
+							pos:        position{line: 76, col: 21, offset: 1413},
+							id:         162,
+							val:        "=",
+							ignoreCase: false,
+							want:       "\"=\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 76, col: 25, offset: 1417},
+							id:   163,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 76, col: 27, offset: 1419},
+							id:   164,
+							name: "Value",
+						},
+						&litMatcher{
+							pos:        position{line: 76, col: 33, offset: 1425},
+							id:         165,
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 76, col: 37, offset: 1429},
+							id:   166,
+							name: "_",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "While",
+			pos:  position{line: 80, col: 1, offset: 1458},
+			id:   18,
+			expr: &actionExpr{
+				pos: position{line: 80, col: 9, offset: 1468},
+				id:  167,
+				run: (*parser).callonWhile1,
+				expr: &seqExpr{
+					pos: position{line: 80, col: 9, offset: 1468},
+					id:  168,
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 80, col: 9, offset: 1468},
+							id:         169,
+							val:        "while",
+							ignoreCase: false,
+							want:       "\"while\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 80, col: 17, offset: 1476},
+							id:   170,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 80, col: 19, offset: 1478},
+							id:   171,
+							name: "Value",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 80, col: 25, offset: 1484},
+							id:   172,
+							name: "Stmt",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Assign",
+			pos:  position{line: 84, col: 1, offset: 1518},
+			id:   19,
+			expr: &actionExpr{
+				pos: position{line: 84, col: 10, offset: 1529},
+				id:  173,
+				run: (*parser).callonAssign1,
+				expr: &seqExpr{
+					pos: position{line: 84, col: 10, offset: 1529},
+					id:  174,
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 84, col: 10, offset: 1529},
+							id:   175,
+							name: "Ident",
+						},
+						&litMatcher{
+							pos:        position{line: 84, col: 16, offset: 1535},
+							id:         176,
+							val:        "=",
+							ignoreCase: false,
+							want:       "\"=\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 84, col: 20, offset: 1539},
+							id:   177,
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 84, col: 22, offset: 1541},
+							id:   178,
+							name: "Value",
+						},
+						&litMatcher{
+							pos:        position{line: 84, col: 28, offset: 1547},
+							id:         179,
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 84, col: 32, offset: 1551},
+							id:   180,
+							name: "_",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Value",
+			pos:  position{line: 88, col: 1, offset: 1578},
+			id:   20,
+			expr: &choiceExpr{
+				pos: position{line: 88, col: 9, offset: 1588},
+				id:  181,
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 88, col: 9, offset: 1588},
+						id:   182,
+						name: "Ident",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 88, col: 17, offset: 1596},
+						id:   183,
+						name: "Number",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 88, col: 26, offset: 1605},
+						id:   184,
+						name: "String",
+					},
+				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{}, {2}, {1}, {0}},
+					expected:  [][]string{{"[a-z_]", "[0-9]", "\"\\\"\""}, {"[a-z_]", "[0-9]"}, {"[a-z_]", "\"\\\"\""}, {"[0-9]", "\"\\\"\""}},
+				},
+			},
+		},
+		{
+			name: "Ident",
+			pos:  position{line: 90, col: 1, offset: 1613},
+			id:   21,
+			expr: &seqExpr{
+				pos: position{line: 90, col: 9, offset: 1623},
+				id:  185,
+				exprs: []any{
+					&charClassMatcher{
+						pos:        position{line: 90, col: 9, offset: 1623},
+						id:         186,
+						val:        "[a-z_]",
+						chars:      []rune{'_'},
+						ranges:     []rune{'a', 'z'},
+						ignoreCase: false,
+						inverted:   false,
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 90, col: 16, offset: 1630},
+						id:  187,
+						expr: &charClassMatcher{
+							pos:        position{line: 90, col: 16, offset: 1630},
+							id:         188,
+							val:        "[a-z0-9_]",
+							chars:      []rune{'_'},
+							ranges:     []rune{'a', 'z', '0', '9'},
+							ignoreCase: false,
+							inverted:   false,
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 90, col: 27, offset: 1641},
+						id:   189,
+						name: "_",
+					},
+				},
+			},
+		},
+		{
+			name: "Number",
+			pos:  position{line: 92, col: 1, offset: 1644},
+			id:   22,
+			expr: &seqExpr{
+				pos: position{line: 92, col: 10, offset: 1655},
+				id:  190,
+				exprs: []any{
+					&oneOrMoreExpr{
+						pos: position{line: 92, col: 10, offset: 1655},
+						id:  191,
+						expr: &charClassMatcher{
+							pos:        position{line: 92, col: 10, offset: 1655},
+							id:         192,
+							val:        "[0-9]",
+							ranges:     []rune{'0', '9'},
+							ignoreCase: false,
+							inverted:   false,
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 92, col: 17, offset: 1662},
+						id:   193,
+						name: "_",
+					},
+				},
+			},
+		},
+		{
+			name: "String",
+			pos:  position{line: 94, col: 1, offset: 1665},
+			id:   23,
+			expr: &seqExpr{
+				pos: position{line: 94, col: 10, offset: 1676},
+				id:  194,
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 94, col: 10, offset: 1676},
+						id:         195,
+						val:        "\"",
+						ignoreCase: false,
+						want:       "\"\\\"\"",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 94, col: 14, offset: 1680},
+						id:  196,
+						expr: &charClassMatcher{
+							pos:        position{line: 94, col: 14, offset: 1680},
+							id:         197,
+							val:        "[^\"]",
+							chars:      []rune{'"'},
+							ignoreCase: false,
+							inverted:   true,
+						},
+					},
+					&litMatcher{
+						pos:        position{line: 94, col: 20, offset: 1686},
+						id:         198,
+						val:        "\"",
+						ignoreCase: false,
+						want:       "\"\\\"\"",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 94, col: 24, offset: 1690},
+						id:   199,
+						name: "_",
+					},
+				},
+			},
+		},
+		{
+			name: "_",
+			pos:  position{line: 96, col: 1, offset: 1693},
+			id:   24,
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 96, col: 5, offset: 1699},
+				id:  200,
+				expr: &charClassMatcher{
+					pos:        position{line: 96, col: 5, offset: 1699},
+					id:         201,
+					val:        "[ \\t\\n]",
+					chars:      []rune{' ', '\t', '\n'},
+					ignoreCase: false,
+					inverted:   false,
+				},
+			},
+		},
+		{
+			name: "EOF",
+			pos:  position{line: 98, col: 1, offset: 1709},
+			id:   25,
+			expr: &notExpr{
+				pos: position{line: 98, col: 7, offset: 1717},
+				id:  202,
+				expr: &anyMatcher{
+					pos: position{line: 98, col: 8, offset: 1718},
+					id:  203,
+				},
+			},
+		},
+	},
+}
+
+func (c *current) onProgram1(stmts any) (any, error) {
+	return stmts, nil
+}
+
+func (p *parser) callonProgram1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram1(stack["stmts"])
+}
+
+func (c *current) onBreak1() (any, error) {
+	return "break", nil
+}
+
+func (p *parser) callonBreak1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBreak1()
+}
+
+func (c *current) onConst1() (any, error) {
+	return "const", nil
+}
+
+func (p *parser) callonConst1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onConst1()
+}
+
+func (c *current) onContinue1() (any, error) {
+	return "continue", nil
+}
+
+func (p *parser) callonContinue1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onContinue1()
+}
+
+func (c *current) onDefer1() (any, error) {
+	return "defer", nil
+}
+
+func (p *parser) callonDefer1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDefer1()
+}
+
+func (c *current) onElse1() (any, error) {
+	return "else", nil
+}
+
+func (p *parser) callonElse1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onElse1()
+}
+
+func (c *current) onFor1() (any, error) {
+	return "for", nil
+}
+
+func (p *parser) callonFor1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFor1()
+}
+
+func (c *current) onFunc1() (any, error) {
+	return "func", nil
+}
+
+func (p *parser) callonFunc1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunc1()
+}
+
+func (c *current) onGo1() (any, error) {
+	return "go", nil
+}
+
+func (p *parser) callonGo1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGo1()
+}
+
+func (c *current) onGoto1() (any, error) {
+	return "goto", nil
+}
+
+func (p *parser) callonGoto1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGoto1()
+}
+
+func (c *current) onIf1() (any, error) {
+	return "if", nil
+}
+
+func (p *parser) callonIf1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIf1()
+}
+
+func (c *current) onImport1() (any, error) {
+	return "import", nil
+}
+
+func (p *parser) callonImport1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onImport1()
+}
+
+func (c *current) onPrint1() (any, error) {
+	return "print", nil
+}
+
+func (p *parser) callonPrint1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrint1()
+}
+
+func (c *current) onReturn1() (any, error) {
+	return "return", nil
+}
+
+func (p *parser) callonReturn1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onReturn1()
+}
+
+func (c *current) onSwitch1() (any, error) {
+	return "switch", nil
+}
+
+func (p *parser) callonSwitch1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSwitch1()
+}
+
+func (c *current) onType1() (any, error) {
+	return "type", nil
+}
+
+func (p *parser) callonType1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onType1()
+}
+
+func (c *current) onVar1() (any, error) {
+	return "var", nil
+}
+
+func (p *parser) callonVar1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVar1()
+}
+
+func (c *current) onWhile1() (any, error) {
+	return "while", nil
+}
+
+func (p *parser) callonWhile1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWhile1()
+}
+
+func (c *current) onAssign1() (any, error) {
+	return "=", nil
+}
+
+func (p *parser) callonAssign1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAssign1()
+}
+
+var (
+	// errNoRule is returned when the grammar to parse has no rule.
+	errNoRule = errors.New("grammar has no rule")
+
+	// errInvalidEntrypoint is returned when the specified entrypoint rule
+	// does not exit.
+	errInvalidEntrypoint = errors.New("invalid entrypoint")
+
+	// errInvalidEncoding is returned when the source is not properly
+	// utf8-encoded.
+	errInvalidEncoding = errors.New("invalid encoding")
+
+	// errMaxExprCnt is used to signal that the maximum number of
+	// expressions have been parsed.
+	errMaxExprCnt = errors.New("max number of expressions parsed")
+)
+
+// Option is a function that can set an option on the parser. It returns
+// the previous setting as an Option.
+type Option func(*parser) Option
+
+// MaxExpressions creates an Option to stop parsing after the provided
+// number of expressions have been parsed, if the value is 0 then the parser will
+// parse for as many steps as needed (possibly an infinite number).
+//
+// The default for maxExprCnt is 0.
+func MaxExpressions(maxExprCnt uint64) Option {
+	return func(p *parser) Option {
+		oldMaxExprCnt := p.maxExprCnt
+		p.maxExprCnt = maxExprCnt
+		return MaxExpressions(oldMaxExprCnt)
+	}
+}
+
+// Entrypoint creates an Option to set the rule name to use as entrypoint.
+// The rule name must have been specified in the -alternate-entrypoints
+// if generating the parser with the -optimize-grammar flag, otherwise
+// it may have been optimized out. Passing an empty string sets the
+// entrypoint to the first rule in the grammar.
+//
+// The default is to start parsing at the first rule in the grammar.
+func Entrypoint(ruleName string) Option {
+	return func(p *parser) Option {
+		oldEntrypoint := p.entrypoint
+		p.entrypoint = ruleName
+		if ruleName == "" {
+			p.entrypoint = g.rules[0].name
+		}
+		return Entrypoint(oldEntrypoint)
+	}
+}
+
+// Statistics adds a user provided Stats struct to the parser to allow
+// the user to process the results after the parsing has finished.
+// Also the key for the "no match" counter is set. Per-rule statistics
+// (see RuleStats) are only collected when this option is set.
+//
+// Example usage:
+//
+//	input := "input"
+//	stats := Stats{}
+//	_, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	fmt.Println(string(b))
+func Statistics(stats *Stats, choiceNoMatch string) Option {
+	return func(p *parser) Option {
+		oldStats := p.Stats
+		p.Stats = stats
+		oldChoiceNoMatch := p.choiceNoMatch
+		p.choiceNoMatch = choiceNoMatch
+		if p.Stats.ChoiceAltCnt == nil {
+			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
+		}
+		if p.Stats.Rules == nil {
+			p.Stats.Rules = make(map[string]*RuleStats)
+		}
+		return Statistics(oldStats, oldChoiceNoMatch)
+	}
+}
+
+// Debug creates an Option to set the debug flag to b. When set to true,
+// debugging information is printed to stdout while parsing.
+//
+// The default is false.
+func Debug(b bool) Option {
+	return func(p *parser) Option {
+		old := p.debug
+		p.debug = b
+		return Debug(old)
+	}
+}
+
+// Memoize creates an Option to set the memoize flag to b. When set to true,
+// the parser will cache all results so each expression is evaluated only
+// once. This guarantees linear parsing time even for pathological cases,
+// at the expense of more memory and slower times for typical cases.
+// The rules annotated with @memo in the grammar are always memoized,
+// regardless of this option. The memoization table is pooled, so its
+// storage is reused by the following parses.
+//
+// The default is false.
+func Memoize(b bool) Option {
+	return func(p *parser) Option {
+		old := p.memoize
+		p.memoize = b
+		return Memoize(old)
+	}
+}
+
+// AllowInvalidUTF8 creates an Option to allow invalid UTF-8 bytes.
+// Every invalid UTF-8 byte is treated as a utf8.RuneError (U+FFFD)
+// by character class matchers and is matched by the any matcher.
+// The returned matched value, c.text and c.offset are NOT affected.
+//
+// The default is false.
+func AllowInvalidUTF8(b bool) Option {
+	return func(p *parser) Option {
+		old := p.allowInvalidUTF8
+		p.allowInvalidUTF8 = b
+		return AllowInvalidUTF8(old)
+	}
+}
+
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
+// Recover creates an Option to set the recover flag to b. When set to
+// true, this causes the parser to recover from panics and convert it
+// to an error. Setting it to false can be useful while debugging to
+// access the full stack trace.
+//
+// The default is true.
+func Recover(b bool) Option {
+	return func(p *parser) Option {
+		old := p.recover
+		p.recover = b
+		return Recover(old)
+	}
+}
+
+// GlobalStore creates an Option to set a key to a certain value in
+// the globalStore.
+func GlobalStore(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.globalStore[key]
+		p.cur.globalStore[key] = value
+		return GlobalStore(key, old)
+	}
+}
+
+// InitState creates an Option to set a key to a certain value in
+// the global "state" store.
+func InitState(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.state[key]
+		p.cur.state[key] = value
+		return InitState(key, old)
+	}
+}
+
+// ParseFile parses the file identified by filename.
+func ParseFile(filename string, opts ...Option) (i any, err error) { // nolint: deadcode
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+	return ParseReader(filename, f, opts...)
+}
+
+// ParseReader parses the data from r using filename as information in the
+// error messages.
+func ParseReader(filename string, r io.Reader, opts ...Option) (any, error) { // nolint: deadcode
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(filename, b, opts...)
+}
+
+// Parse parses the data from b using filename as information in the
+// error messages.
+func Parse(filename string, b []byte, opts ...Option) (any, error) {
+	return newParser(filename, b, opts...).parse(g)
+}
+
+// position records a position in the text.
+type position struct {
+	line, col, offset int
+}
+
+func (p position) String() string {
+	return strconv.Itoa(p.line) + ":" + strconv.Itoa(p.col) + " [" + strconv.Itoa(p.offset) + "]"
+}
+
+// savepoint stores all state required to go back to this point in the
+// parser.
+type savepoint struct {
+	position
+	rn rune
+	w  int
+}
+
+type current struct {
+	pos  position // start position of the match
+	text []byte   // raw text of the match
+
+	// state is a store for arbitrary key,value pairs that the user wants to be
+	// tied to the backtracking of the parser.
+	// This is always rolled back if a parsing rule fails.
+	state storeDict
+
+	// globalStore is a general store for the user to store arbitrary key-value
+	// pairs that they need to manage and that they do not want tied to the
+	// backtracking of the parser. This is only modified by the user and never
+	// rolled back by the parser. It is always up to the user to keep this in a
+	// consistent state.
+	globalStore storeDict
+}
+
+type storeDict map[string]any
+
+// the AST types...
+
+// nolint: structcheck
+type grammar struct {
+	pos   position
+	rules []*rule
+}
+
+// nolint: structcheck
+type rule struct {
+	pos         position
+	name        string
+	displayName string
+	expr        any
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+//
+//	nolint: structcheck
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
+}
+
+// nolint: structcheck
+type choiceExpr struct {
+	pos          position
+	id           int
+	alternatives []any
+	dispatch     *choiceDispatch
+}
+
+// nolint: structcheck
+type actionExpr struct {
+	pos  position
+	id   int
+	expr any
+	run  func(*parser) (any, error)
+}
+
+// nolint: structcheck
+type recoveryExpr struct {
+	pos          position
+	id           int
+	expr         any
+	recoverExpr  any
+	failureLabel []string
+}
+
+// nolint: structcheck
+type seqExpr struct {
+	pos   position
+	id    int
+	exprs []any
+}
+
+// nolint: structcheck
+type labeledExpr struct {
+	pos   position
+	id    int
+	label string
+	expr  any
+}
+
+// nolint: structcheck
+type expr struct {
+	pos  position
+	id   int
+	expr any
+}
+
+// nolint: structcheck
+type repeatExpr struct {
+	pos  position
+	id   int
+	expr any
+	// max is -1 if the number of repetitions is not bounded.
+	min int
+	max int
+}
+
+type (
+	andExpr        expr // nolint: structcheck
+	notExpr        expr // nolint: structcheck
+	zeroOrOneExpr  expr // nolint: structcheck
+	zeroOrMoreExpr expr // nolint: structcheck
+	oneOrMoreExpr  expr // nolint: structcheck
+)
+
+// nolint: structcheck
+type ruleRefExpr struct {
+	pos  position
+	id   int
+	name string
+}
+
+// nolint: structcheck
+type stateCodeExpr struct {
+	pos position
+	id  int
+	run func(*parser) error
+}
+
+// nolint: structcheck
+type andCodeExpr struct {
+	pos position
+	id  int
+	run func(*parser) (bool, error)
+}
+
+// nolint: structcheck
+type notCodeExpr struct {
+	pos position
+	id  int
+	run func(*parser) (bool, error)
+}
+
+// nolint: structcheck
+type litMatcher struct {
+	pos        position
+	id         int
+	val        string
+	ignoreCase bool
+	want       string
+}
+
+// nolint: structcheck
+type charClassMatcher struct {
+	pos             position
+	id              int
+	val             string
+	basicLatinChars [128]bool
+	chars           []rune
+	ranges          []rune
+	classes         []*unicode.RangeTable
+	ignoreCase      bool
+	inverted        bool
+}
+
+// nolint: structcheck
+type anyMatcher struct {
+	pos position
+	id  int
+}
+
+// errList cumulates the errors found by the parser.
+type errList []error
+
+func (e *errList) add(err error) {
+	*e = append(*e, err)
+}
+
+func (e errList) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	e.dedupe()
+	return e
+}
+
+func (e *errList) dedupe() {
+	var cleaned []error
+	set := make(map[string]bool)
+	for _, err := range *e {
+		if msg := err.Error(); !set[msg] {
+			set[msg] = true
+			cleaned = append(cleaned, err)
+		}
+	}
+	*e = cleaned
+}
+
+func (e errList) Error() string {
+	switch len(e) {
+	case 0:
+		return ""
+	case 1:
+		return e[0].Error()
+	default:
+		var buf bytes.Buffer
+
+		for i, err := range e {
+			if i > 0 {
+				buf.WriteRune('\n')
+			}
+			buf.WriteString(err.Error())
+		}
+		return buf.String()
+	}
+}
+
+// parserError wraps an error with a prefix indicating the rule in which
+// the error occurred. The original error is stored in the Inner field.
+type parserError struct {
+	Inner    error
+	pos      position
+	prefix   string
+	expected []string
+}
+
+// Error returns the error message.
+func (p *parserError) Error() string {
+	return p.prefix + ": " + p.Inner.Error()
+}
+
+// newParser creates a parser with the specified input source and options.
+func newParser(filename string, b []byte, opts ...Option) *parser {
+	stats := Stats{
+		ChoiceAltCnt: make(map[string]map[string]int),
+	}
+
+	p := &parser{
+		filename: filename,
+		errs:     new(errList),
+		data:     b,
+		pt:       savepoint{position: position{line: 1}},
+		recover:  true,
+		cur: current{
+			state:       make(storeDict),
+			globalStore: make(storeDict),
+		},
+		maxFailPos:      position{col: 1, line: 1},
+		maxFailExpected: make([]string, 0, 20),
+		Stats:           &stats,
+		// start rule is rule [0] unless an alternate entrypoint is specified
+		entrypoint: g.rules[0].name,
+	}
+	p.setOptions(opts)
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
+
+	if p.maxExprCnt == 0 {
+		p.maxExprCnt = math.MaxUint64
+	}
+
+	return p
+}
+
+// setOptions applies the options to the parser.
+func (p *parser) setOptions(opts []Option) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// nolint: structcheck,deadcode
+type resultTuple struct {
+	v   any
+	b   bool
+	end savepoint
+}
+
+// nolint: varcheck
+const choiceNoMatch = -1
+
+// Stats stores some statistics, gathered during parsing
+type Stats struct {
+	// ExprCnt counts the number of expressions processed during parsing
+	// This value is compared to the maximum number of expressions allowed
+	// (set by the MaxExpressions option).
+	ExprCnt uint64
+
+	// ChoiceAltCnt is used to count for each ordered choice expression,
+	// which alternative is used how may times.
+	// These numbers allow to optimize the order of the ordered choice expression
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
+	//
+	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
+	// as the line and the column of the ordered choice.
+	// The inner key of ChoiceAltCnt is the number (one-based) of the matching alternative.
+	// For each alternative the number of matches are counted. If an ordered choice does not
+	// match, a special counter is incremented. The name of this counter is set with
+	// the parser option Statistics.
+	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
+	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
+}
+
+// nolint: structcheck,maligned
+type parser struct {
+	filename string
+	pt       savepoint
+	cur      current
+
+	data []byte
+	errs *errList
+
+	depth   int
+	recover bool
+	debug   bool
+
+	memoize bool
+	// memoization table for the packrat algorithm, taken from memoTables
+	// on the first memoized result
+	memo *memoTable
+
+	// rules table, maps the rule identifier to the rule node
+	rules map[string]*rule
+	// variables stack, map of label to value
+	vstack []map[string]any
+	// rule stack, allows identification of the current rule in errors
+	rstack []*rule
+
+	// parse fail
+	maxFailPos            position
+	maxFailExpected       []string
+	maxFailInvertExpected bool
+
+	// max number of expressions to be parsed
+	maxExprCnt uint64
+	// entrypoint for the parser
+	entrypoint string
+
+	allowInvalidUTF8 bool
+
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+
+	*Stats
+	// profiling frames, tracks the time spent in invoked rules to compute
+	// the self time of each rule.
+	profStack []profFrame
+	// number of active invocations of each rule, the time of a recursive
+	// rule is only added at its outermost invocation.
+	profActive map[*rule]int
+	// rules evaluated at each offset, to count the repeated evaluations.
+	profEvals map[profEval]bool
+
+	choiceNoMatch string
+	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
+}
+
+// push a variable set on the vstack.
+func (p *parser) pushV() {
+	if cap(p.vstack) == len(p.vstack) {
+		// create new empty slot in the stack
+		p.vstack = append(p.vstack, nil)
+	} else {
+		// slice to 1 more
+		p.vstack = p.vstack[:len(p.vstack)+1]
+	}
+
+	// get the last args set
+	m := p.vstack[len(p.vstack)-1]
+	if m != nil && len(m) == 0 {
+		// empty map, all good
+		return
+	}
+
+	m = make(map[string]any)
+	p.vstack[len(p.vstack)-1] = m
+}
+
+// pop a variable set from the vstack.
+func (p *parser) popV() {
+	// if the map is not empty, clear it
+	m := p.vstack[len(p.vstack)-1]
+	if len(m) > 0 {
+		// GC that map
+		p.vstack[len(p.vstack)-1] = nil
+	}
+	p.vstack = p.vstack[:len(p.vstack)-1]
+}
+
+// push a recovery expression with its labels to the recoveryStack
+func (p *parser) pushRecovery(labels []string, expr any) {
+	if cap(p.recoveryStack) == len(p.recoveryStack) {
+		// create new empty slot in the stack
+		p.recoveryStack = append(p.recoveryStack, recovery{})
+	} else {
+		// slice to 1 more
+		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
+	}
+
+	m := make(map[string]any, len(labels))
+	for _, fl := range labels {
+		m[fl] = expr
+	}
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
+}
+
+// pop a recovery expression from the recoveryStack
+func (p *parser) popRecovery() {
+	// GC that map
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
+
+	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
+}
+
+func (p *parser) print(prefix, s string) string {
+	if !p.debug {
+		return s
+	}
+
+	pos := p.pt.position
+	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
+		prefix, pos.line, pos.col, pos.offset, s, p.pt.rn)
+	return s
+}
+
+func (p *parser) printIndent(mark string, s string) string {
+	return p.print(strings.Repeat(" ", p.depth)+mark, s)
+}
+
+func (p *parser) in(s string) string {
+	res := p.printIndent(">", s)
+	p.depth++
+	return res
+}
+
+func (p *parser) out(s string) string {
+	p.depth--
+	return p.printIndent("<", s)
+}
+
+func (p *parser) addErr(err error) {
+	p.addErrAt(err, p.pt.position, []string{})
+}
+
+func (p *parser) addErrAt(err error, pos position, expected []string) {
+	var buf bytes.Buffer
+	if p.filename != "" {
+		buf.WriteString(p.filename)
+	}
+	if buf.Len() > 0 {
+		buf.WriteString(":")
+	}
+	buf.WriteString(fmt.Sprintf("%d:%d (%d)", pos.line, pos.col, pos.offset))
+	if len(p.rstack) > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString(": ")
+		}
+		rule := p.rstack[len(p.rstack)-1]
+		if rule.displayName != "" {
+			buf.WriteString("rule " + rule.displayName)
+		} else {
+			buf.WriteString("rule " + rule.name)
+		}
+	}
+	pe := &parserError{Inner: err, pos: pos, prefix: buf.String(), expected: expected}
+	p.errs.add(pe)
+}
+
+func (p *parser) failAt(fail bool, pos position, want string) {
+	// process fail if parsing fails and not inverted or parsing succeeds and invert is set
+	if fail == p.maxFailInvertExpected {
+		if pos.offset < p.maxFailPos.offset {
+			return
+		}
+
+		if pos.offset > p.maxFailPos.offset {
+			p.maxFailPos = pos
+			p.maxFailExpected = p.maxFailExpected[:0]
+		}
+
+		if p.maxFailInvertExpected {
+			want = "!" + want
+		}
+		p.maxFailExpected = append(p.maxFailExpected, want)
+	}
+}
+
+// read advances the parser to the next rune.
+func (p *parser) read() {
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
+	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
+	p.pt.rn = rn
+	p.pt.w = n
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
+	}
+
+	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
+		if !p.allowInvalidUTF8 {
+			p.addErr(errInvalidEncoding)
+		}
+	}
+}
+
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
+// restore parser position to the savepoint pt.
+func (p *parser) restore(pt savepoint) {
+	if p.debug {
+		defer p.out(p.in("restore"))
+	}
+	if pt.offset == p.pt.offset {
+		return
+	}
+	if p.Rules != nil && pt.offset < p.pt.offset && len(p.rstack) > 0 {
+		p.ruleStats(p.rstack[len(p.rstack)-1]).Backtracked += uint64(p.pt.offset - pt.offset)
+	}
+	p.pt = pt
+}
+
+// Cloner is implemented by any value that has a Clone method, which returns a
+// copy of the value. This is mainly used for types which are not passed by
+// value (e.g map, slice, chan) or structs that contain such types.
+//
+// This is used in conjunction with the global state feature to create proper
+// copies of the state to allow the parser to properly restore the state in
+// the case of backtracking.
+type Cloner interface {
+	Clone() any
+}
+
+var statePool = &sync.Pool{
+	New: func() any { return make(storeDict) },
+}
+
+func (sd storeDict) Discard() {
+	for k := range sd {
+		delete(sd, k)
+	}
+	statePool.Put(sd)
+}
+
+// clone and return parser current state.
+func (p *parser) cloneState() storeDict {
+	if p.debug {
+		defer p.out(p.in("cloneState"))
+	}
+
+	state := statePool.Get().(storeDict)
+	for k, v := range p.cur.state {
+		if c, ok := v.(Cloner); ok {
+			state[k] = c.Clone()
+		} else {
+			state[k] = v
+		}
+	}
+	return state
+}
+
+// restore parser current state to the state storeDict.
+// every restoreState should applied only one time for every cloned state
+func (p *parser) restoreState(state storeDict) {
+	if p.debug {
+		defer p.out(p.in("restoreState"))
+	}
+	p.cur.state.Discard()
+	p.cur.state = state
+}
+
+// get the slice of bytes from the savepoint start to the current position.
+func (p *parser) sliceFrom(start savepoint) []byte {
+	return p.data[start.position.offset:p.pt.position.offset]
+}
+
+// memoSlot is an entry of the memoization table, the key of an empty slot
+// is 0.
+type memoSlot struct {
+	key uint64
+	res resultTuple
+}
+
+// memoTable is the memoization table for the packrat algorithm, an
+// open-addressed hash table with linear probing that maps the offset in
+// the source and the id of a rule or expression to its result.
+type memoTable struct {
+	slots []memoSlot
+	shift uint
+	n     int
+}
+
+// memoTables pools the memoization tables so that their storage is
+// reused across parses.
+var memoTables = &sync.Pool{
+	New: func() any {
+		return &memoTable{slots: make([]memoSlot, 1<<10), shift: 64 - 10}
+	},
+}
+
+// memoKey returns the key of the result of the rule or expression id at
+// offset, it supports up to 1<<24 ids.
+func memoKey(offset, id int) uint64 {
+	return uint64(offset+1)<<24 | uint64(id)
+}
+
+// index returns the index of the slot of key, or of the empty slot where
+// it should be stored.
+func (t *memoTable) index(key uint64) int {
+	mask := len(t.slots) - 1
+	i := int((key*0x9E3779B97F4A7C15)>>t.shift) & mask
+	for t.slots[i].key != 0 && t.slots[i].key != key {
+		i = (i + 1) & mask
+	}
+	return i
+}
+
+func (t *memoTable) get(key uint64) (resultTuple, bool) {
+	s := &t.slots[t.index(key)]
+	return s.res, s.key == key
+}
+
+func (t *memoTable) set(key uint64, res resultTuple) {
+	if 4*(t.n+1) > 3*len(t.slots) {
+		t.grow()
+	}
+	s := &t.slots[t.index(key)]
+	if s.key == 0 {
+		s.key = key
+		t.n++
+	}
+	s.res = res
+}
+
+// grow doubles the capacity of the table.
+func (t *memoTable) grow() {
+	old := t.slots
+	t.slots = make([]memoSlot, 2*len(old))
+	t.shift--
+	for _, s := range old {
+		if s.key != 0 {
+			t.slots[t.index(s.key)] = s
+		}
+	}
+}
+
+// reset empties the table, releasing the references to the results.
+func (t *memoTable) reset() {
+	if t.n == 0 {
+		return
+	}
+	for i := range t.slots {
+		t.slots[i] = memoSlot{}
+	}
+	t.n = 0
+}
+
+func (p *parser) getMemoized(id int) (resultTuple, bool) {
+	if p.memo == nil {
+		return resultTuple{}, false
+	}
+	return p.memo.get(memoKey(p.pt.offset, id))
+}
+
+func (p *parser) setMemoized(pt savepoint, id int, tuple resultTuple) {
+	if p.memo == nil {
+		p.memo = memoTables.Get().(*memoTable)
+	}
+	p.memo.set(memoKey(pt.offset, id), tuple)
+}
+
+// releaseMemo returns the memoization table to the pool.
+func (p *parser) releaseMemo() {
+	if p.memo == nil {
+		return
+	}
+	p.memo.reset()
+	memoTables.Put(p.memo)
+	p.memo = nil
+}
+
+func (p *parser) buildRulesTable(g *grammar) {
+	p.rules = make(map[string]*rule, len(g.rules))
+	for _, r := range g.rules {
+		p.rules[r.name] = r
+	}
+}
+
+// nolint: gocyclo
+func (p *parser) parse(g *grammar) (val any, err error) {
+	if len(g.rules) == 0 {
+		p.addErr(errNoRule)
+		return nil, p.errs.err()
+	}
+
+	// TODO : not super critical but this could be generated
+	p.buildRulesTable(g)
+	defer p.releaseMemo()
+
+	if p.recover {
+		// panic can be used in action code to stop parsing immediately
+		// and return the panic as an error.
+		defer func() {
+			if e := recover(); e != nil {
+				if p.debug {
+					defer p.out(p.in("panic handler"))
+				}
+				val = nil
+				switch e := e.(type) {
+				case error:
+					p.addErr(e)
+				default:
+					p.addErr(fmt.Errorf("%v", e))
+				}
+				err = p.errs.err()
+			}
+		}()
+	}
+
+	startRule, ok := p.rules[p.entrypoint]
+	if !ok {
+		p.addErr(errInvalidEntrypoint)
+		return nil, p.errs.err()
+	}
+
+	p.read() // advance to first rune
+	val, ok = p.parseRuleWrap(startRule)
+	if !ok {
+		if len(*p.errs) == 0 {
+			// If parsing fails, but no errors have been recorded, the expected values
+			// for the farthest parser position are returned as error.
+			p.addNoMatchErr()
+		}
+
+		return nil, p.errs.err()
+	}
+	return val, p.errs.err()
+}
+
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
+func listJoin(list []string, sep string, lastSep string) string {
+	switch len(list) {
+	case 0:
+		return ""
+	case 1:
+		return list[0]
+	default:
+		return strings.Join(list[:len(list)-1], sep) + " " + lastSep + " " + list[len(list)-1]
+	}
+}
+
+// profFrame is a rule invocation being profiled.
+type profFrame struct {
+	start time.Time
+	child time.Duration
+}
+
+// profEval is the evaluation of a rule at an offset.
+type profEval struct {
+	rule   *rule
+	offset int
+}
+
+// ruleStats returns the statistics of rule, creating them if needed.
+func (p *parser) ruleStats(rule *rule) *RuleStats {
+	rs := p.Rules[rule.name]
+	if rs == nil {
+		rs = &RuleStats{}
+		p.Rules[rule.name] = rs
+	}
+	return rs
+}
+
+func (p *parser) countMemo(rs *RuleStats, hit bool) {
+	if hit {
+		rs.MemoHits++
+	} else {
+		rs.MemoMisses++
+	}
+}
+
+func (p *parser) profEnter(r *rule) {
+	if p.profActive == nil {
+		p.profActive = make(map[*rule]int)
+	}
+	p.profActive[r]++
+	p.profStack = append(p.profStack, profFrame{start: time.Now()})
+}
+
+// countRepeat counts the evaluation of rule at the current offset if
+// it was already evaluated at that offset.
+func (p *parser) countRepeat(rule *rule) {
+	if p.profEvals == nil {
+		p.profEvals = make(map[profEval]bool)
+	}
+	key := profEval{rule: rule, offset: p.pt.offset}
+	if p.profEvals[key] {
+		p.ruleStats(rule).Repeats++
+		return
+	}
+	p.profEvals[key] = true
+}
+
+func (p *parser) profExit(rule *rule, start savepoint, ok bool) {
+	frame := p.profStack[len(p.profStack)-1]
+	p.profStack = p.profStack[:len(p.profStack)-1]
+	elapsed := time.Since(frame.start)
+	if n := len(p.profStack); n > 0 {
+		p.profStack[n-1].child += elapsed
+	}
+
+	rs := p.ruleStats(rule)
+	rs.Invocations++
+	if p.profActive[rule]--; p.profActive[rule] == 0 {
+		// the time of the inner invocations is included
+		rs.Time += elapsed
+	}
+	rs.SelfTime += elapsed - frame.child
+	if ok {
+		rs.Matches++
+		rs.Bytes += uint64(p.pt.offset - start.offset)
+	} else {
+		rs.Failures++
+	}
+}
+
+func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
+	if rule.id < 0 {
+		// its results depend on the captured texts or the state store
+		return p.parseRule(rule)
+	}
+	res, ok := p.getMemoized(rule.id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(rule), ok)
+	}
+	if ok {
+		p.restore(res.end)
+		return res.v, res.b
+	}
+
+	startMark := p.pt
+	val, ok := p.parseRule(rule)
+	p.setMemoized(startMark, rule.id, resultTuple{v: val, b: ok, end: p.pt})
+
+	return val, ok
+}
+
+func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRule " + rule.name))
+	}
+	var (
+		val       any
+		ok        bool
+		startMark = p.pt
+	)
+
+	if p.Rules != nil {
+		p.profEnter(rule)
+		defer func() { p.profExit(rule, startMark, ok) }()
+	}
+
+	if p.memoize {
+		val, ok = p.parseRuleMemoize(rule)
+	} else {
+		val, ok = p.parseRule(rule)
+	}
+
+	if ok && p.debug {
+		p.printIndent("MATCH", string(p.sliceFrom(startMark)))
+	}
+	return val, ok
+}
+
+func (p *parser) parseRule(rule *rule) (any, bool) {
+	if p.Rules != nil {
+		p.countRepeat(rule)
+	}
+	p.rstack = append(p.rstack, rule)
+	p.pushV()
+	val, ok := p.parseExprWrap(rule.expr)
+	p.popV()
+	p.rstack = p.rstack[:len(p.rstack)-1]
+	return val, ok
+}
+
+// incChoiceAltCnt counts the match of the alternative altI of the choice
+// at pos, or its failure if altI is choiceNoMatch.
+func (p *parser) incChoiceAltCnt(pos position, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, pos.line, pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
+func (p *parser) parseExprWrap(expr any) (any, bool) {
+	var pt savepoint
+	id := exprID(expr)
+
+	if p.memoize && id >= 0 {
+		res, ok := p.getMemoized(id)
+		if p.Rules != nil {
+			p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+		}
+		if ok {
+			p.restore(res.end)
+			return res.v, res.b
+		}
+		pt = p.pt
+	}
+
+	val, ok := p.parseExpr(expr)
+
+	if p.memoize && id >= 0 {
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
+	}
+	return val, ok
+}
+
+// exprID returns the id of the expression in the memoization table, -1 if
+// its results depend on the texts captured by the labels or on the state
+// store and are not memoized.
+//
+//	nolint: gocyclo
+func exprID(expr any) int {
+	switch expr := expr.(type) {
+	case *actionExpr:
+		return expr.id
+	case *andCodeExpr:
+		return expr.id
+	case *andExpr:
+		return expr.id
+	case *anyMatcher:
+		return expr.id
+	case *charClassMatcher:
+		return expr.id
+	case *choiceExpr:
+		return expr.id
+	case *labeledExpr:
+		return expr.id
+	case *litMatcher:
+		return expr.id
+	case *notCodeExpr:
+		return expr.id
+	case *notExpr:
+		return expr.id
+	case *oneOrMoreExpr:
+		return expr.id
+	case *recoveryExpr:
+		return expr.id
+	case *repeatExpr:
+		return expr.id
+	case *ruleRefExpr:
+		return expr.id
+	case *seqExpr:
+		return expr.id
+	case *stateCodeExpr:
+		return expr.id
+	case *zeroOrMoreExpr:
+		return expr.id
+	case *zeroOrOneExpr:
+		return expr.id
+	default:
+		panic(fmt.Sprintf("unknown expression type %T", expr))
+	}
+}
+
+// nolint: gocyclo
+func (p *parser) parseExpr(expr any) (any, bool) {
+	p.ExprCnt++
+	if p.ExprCnt > p.maxExprCnt {
+		panic(errMaxExprCnt)
+	}
+
+	var val any
+	var ok bool
+	switch expr := expr.(type) {
+	case *actionExpr:
+		val, ok = p.parseActionExpr(expr)
+	case *andCodeExpr:
+		val, ok = p.parseAndCodeExpr(expr)
+	case *andExpr:
+		val, ok = p.parseAndExpr(expr)
+	case *anyMatcher:
+		val, ok = p.parseAnyMatcher(expr)
+	case *charClassMatcher:
+		val, ok = p.parseCharClassMatcher(expr)
+	case *choiceExpr:
+		val, ok = p.parseChoiceExpr(expr)
+	case *labeledExpr:
+		val, ok = p.parseLabeledExpr(expr)
+	case *litMatcher:
+		val, ok = p.parseLitMatcher(expr)
+	case *notCodeExpr:
+		val, ok = p.parseNotCodeExpr(expr)
+	case *notExpr:
+		val, ok = p.parseNotExpr(expr)
+	case *oneOrMoreExpr:
+		val, ok = p.parseOneOrMoreExpr(expr)
+	case *recoveryExpr:
+		val, ok = p.parseRecoveryExpr(expr)
+	case *repeatExpr:
+		val, ok = p.parseRepeatExpr(expr)
+	case *ruleRefExpr:
+		val, ok = p.parseRuleRefExpr(expr)
+	case *seqExpr:
+		val, ok = p.parseSeqExpr(expr)
+	case *stateCodeExpr:
+		val, ok = p.parseStateCodeExpr(expr)
+	case *zeroOrMoreExpr:
+		val, ok = p.parseZeroOrMoreExpr(expr)
+	case *zeroOrOneExpr:
+		val, ok = p.parseZeroOrOneExpr(expr)
+	default:
+		panic(fmt.Sprintf("unknown expression type %T", expr))
+	}
+	return val, ok
+}
+
+func (p *parser) parseActionExpr(act *actionExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseActionExpr"))
+	}
+
+	start := p.pt
+	val, ok := p.parseExprWrap(act.expr)
+	if ok {
+		p.cur.pos = start.position
+		p.cur.text = p.sliceFrom(start)
+		state := p.cloneState()
+		actVal, err := act.run(p)
+		if err != nil {
+			p.addErrAt(err, start.position, []string{})
+		}
+		p.restoreState(state)
+
+		val = actVal
+	}
+	if ok && p.debug {
+		p.printIndent("MATCH", string(p.sliceFrom(start)))
+	}
+	return val, ok
+}
+
+func (p *parser) parseAndCodeExpr(and *andCodeExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseAndCodeExpr"))
+	}
+
+	state := p.cloneState()
+
+	ok, err := and.run(p)
+	if err != nil {
+		p.addErr(err)
+	}
+	p.restoreState(state)
+
+	return nil, ok
+}
+
+func (p *parser) parseAndExpr(and *andExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseAndExpr"))
+	}
+
+	pt := p.pt
+	state := p.cloneState()
+	p.pushV()
+	_, ok := p.parseExprWrap(and.expr)
+	p.popV()
+	p.restoreState(state)
+	p.restore(pt)
+
+	return nil, ok
+}
+
+func (p *parser) parseAnyMatcher(any *anyMatcher) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseAnyMatcher"))
+	}
+
+	if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+		// EOF - see utf8.DecodeRune
+		p.failAt(false, p.pt.position, ".")
+		return nil, false
+	}
+	start := p.pt
+	p.read()
+	p.failAt(true, start.position, ".")
+	return p.sliceFrom(start), true
+}
+
+// nolint: gocyclo
+func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseCharClassMatcher"))
+	}
+
+	cur := p.pt.rn
+	start := p.pt
+
+	// can't match EOF
+	if cur == utf8.RuneError && p.pt.w == 0 { // see utf8.DecodeRune
+		p.failAt(false, start.position, chr.val)
+		return nil, false
+	}
+
+	if chr.ignoreCase {
+		cur = unicode.ToLower(cur)
+	}
+
+	// try to match in the list of available chars
+	for _, rn := range chr.chars {
+		if rn == cur {
+			if chr.inverted {
+				p.failAt(false, start.position, chr.val)
+				return nil, false
+			}
+			p.read()
+			p.failAt(true, start.position, chr.val)
+			return p.sliceFrom(start), true
+		}
+	}
+
+	// try to match in the list of ranges
+	for i := 0; i < len(chr.ranges); i += 2 {
+		if cur >= chr.ranges[i] && cur <= chr.ranges[i+1] {
+			if chr.inverted {
+				p.failAt(false, start.position, chr.val)
+				return nil, false
+			}
+			p.read()
+			p.failAt(true, start.position, chr.val)
+			return p.sliceFrom(start), true
+		}
+	}
+
+	// try to match in the list of Unicode classes
+	for _, cl := range chr.classes {
+		if unicode.Is(cl, cur) {
+			if chr.inverted {
+				p.failAt(false, start.position, chr.val)
+				return nil, false
+			}
+			p.read()
+			p.failAt(true, start.position, chr.val)
+			return p.sliceFrom(start), true
+		}
+	}
+
+	if chr.inverted {
+		p.read()
+		p.failAt(true, start.position, chr.val)
+		return p.sliceFrom(start), true
+	}
+	p.failAt(false, start.position, chr.val)
+	return nil, false
+}
+
+func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseChoiceExpr"))
+	}
+
+	n := len(ch.alternatives)
+	var alts []int
+	if d := ch.dispatch; d != nil {
+		// the alternatives that cannot match the current rune are skipped,
+		// with the values they expect.
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		alts = d.alts[set]
+		n = len(alts)
+	}
+	for i := 0; i < n; i++ {
+		altI := i
+		if alts != nil {
+			altI = alts[i]
+		}
+		alt := ch.alternatives[altI]
+
+		state := p.cloneState()
+
+		p.pushV()
+		val, ok := p.parseExprWrap(alt)
+		p.popV()
+		if ok {
+			p.incChoiceAltCnt(ch.pos, altI)
+			return val, ok
+		}
+		p.restoreState(state)
+	}
+	p.incChoiceAltCnt(ch.pos, choiceNoMatch)
+	return nil, false
+}
+
+func (p *parser) parseLabeledExpr(lab *labeledExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseLabeledExpr"))
+	}
+
+	p.pushV()
+	val, ok := p.parseExprWrap(lab.expr)
+	p.popV()
+	if ok && lab.label != "" {
+		m := p.vstack[len(p.vstack)-1]
+		m[lab.label] = val
+	}
+	return val, ok
+}
+
+func (p *parser) parseLitMatcher(lit *litMatcher) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseLitMatcher"))
+	}
+
+	start := p.pt
+	for _, want := range lit.val {
+		cur := p.pt.rn
+		if lit.ignoreCase {
+			cur = unicode.ToLower(cur)
+		}
+		if cur != want {
+			p.failAt(false, start.position, lit.want)
+			p.restore(start)
+			return nil, false
+		}
+		p.read()
+	}
+	p.failAt(true, start.position, lit.want)
+	return p.sliceFrom(start), true
+}
+
+func (p *parser) parseNotCodeExpr(not *notCodeExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseNotCodeExpr"))
+	}
+
+	state := p.cloneState()
+
+	ok, err := not.run(p)
+	if err != nil {
+		p.addErr(err)
+	}
+	p.restoreState(state)
+
+	return nil, !ok
+}
+
+func (p *parser) parseNotExpr(not *notExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseNotExpr"))
+	}
+
+	pt := p.pt
+	state := p.cloneState()
+	p.pushV()
+	p.maxFailInvertExpected = !p.maxFailInvertExpected
+	_, ok := p.parseExprWrap(not.expr)
+	p.maxFailInvertExpected = !p.maxFailInvertExpected
+	p.popV()
+	p.restoreState(state)
+	p.restore(pt)
+
+	return nil, !ok
+}
+
+func (p *parser) parseOneOrMoreExpr(expr *oneOrMoreExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseOneOrMoreExpr"))
+	}
+
+	var vals []any
+
+	for {
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			if len(vals) == 0 {
+				// did not match once, no match
+				return nil, false
+			}
+			return vals, true
+		}
+		vals = append(vals, val)
+	}
+}
+
+func (p *parser) parseRecoveryExpr(recover *recoveryExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRecoveryExpr (" + strings.Join(recover.failureLabel, ",") + ")"))
+	}
+
+	p.pushRecovery(recover.failureLabel, recover.recoverExpr)
+	val, ok := p.parseExprWrap(recover.expr)
+	p.popRecovery()
+
+	return val, ok
+}
+
+func (p *parser) parseRepeatExpr(expr *repeatExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRepeatExpr"))
+	}
+
+	var vals []any
+
+	pt := p.pt
+	state := p.cloneState()
+	for expr.max < 0 || len(vals) < expr.max {
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			break
+		}
+		vals = append(vals, val)
+	}
+	if len(vals) < expr.min {
+		// did not match enough times, no match
+		p.restoreState(state)
+		p.restore(pt)
+		return nil, false
+	}
+	return vals, true
+}
+
+func (p *parser) parseRuleRefExpr(ref *ruleRefExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRuleRefExpr " + ref.name))
+	}
+
+	if ref.name == "" {
+		panic(fmt.Sprintf("%s: invalid rule: missing name", ref.pos))
+	}
+
+	rule := p.rules[ref.name]
+	if rule == nil {
+		p.addErr(fmt.Errorf("undefined rule: %s", ref.name))
+		return nil, false
+	}
+	return p.parseRuleWrap(rule)
+}
+
+func (p *parser) parseSeqExpr(seq *seqExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseSeqExpr"))
+	}
+
+	vals := make([]any, 0, len(seq.exprs))
+
+	pt := p.pt
+	state := p.cloneState()
+	for _, expr := range seq.exprs {
+		val, ok := p.parseExprWrap(expr)
+		if !ok {
+			p.restoreState(state)
+			p.restore(pt)
+			return nil, false
+		}
+		vals = append(vals, val)
+	}
+	return vals, true
+}
+
+func (p *parser) parseStateCodeExpr(state *stateCodeExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseStateCodeExpr"))
+	}
+
+	err := state.run(p)
+	if err != nil {
+		p.addErr(err)
+	}
+	return nil, true
+}
+
+func (p *parser) parseZeroOrMoreExpr(expr *zeroOrMoreExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseZeroOrMoreExpr"))
+	}
+
+	var vals []any
+
+	for {
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			return vals, true
+		}
+		vals = append(vals, val)
+	}
+}
+
+func (p *parser) parseZeroOrOneExpr(expr *zeroOrOneExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseZeroOrOneExpr"))
+	}
+
+	p.pushV()
+	val, _ := p.parseExprWrap(expr.expr)
+	p.popV()
+	// whether it matched or not, consider it a match
+	return val, true
+}
@@ -0,0 +1,113 @@
+package dispatch
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var program = strings.Repeat(`
+import "fmt";
+const n = 10;
+var i = 0;
+func main() while i if n print "x";
+type t int;
+switch i goto end;
+defer i;
+go main;
+x = 1;
+y = "s";
+return 0;
+`, 100)
+
+// withoutDispatch clears the dispatch tables of the choices of the
+// grammar while f runs, so that the choices test all their alternatives.
+func withoutDispatch(f func()) {
+	var choices []*choiceExpr
+	var walk func(expr any)
+	walk = func(expr any) {
+		switch expr := expr.(type) {
+		case *actionExpr:
+			walk(expr.expr)
+		case *labeledExpr:
+			walk(expr.expr)
+		case *notExpr:
+			walk(expr.expr)
+		case *oneOrMoreExpr:
+			walk(expr.expr)
+		case *zeroOrMoreExpr:
+			walk(expr.expr)
+		case *seqExpr:
+			for _, e := range expr.exprs {
+				walk(e)
+			}
+		case *choiceExpr:
+			choices = append(choices, expr)
+			for _, alt := range expr.alternatives {
+				walk(alt)
+			}
+		}
+	}
+	for _, r := range g.rules {
+		walk(r.expr)
+	}
+
+	dispatches := make([]*choiceDispatch, len(choices))
+	for i, ch := range choices {
+		dispatches[i], ch.dispatch = ch.dispatch, nil
+	}
+	defer func() {
+		for i, ch := range choices {
+			ch.dispatch = dispatches[i]
+		}
+	}()
+	f()
+}
+
+func TestDispatch(t *testing.T) {
+	cases := []string{
+		program,
+		"x = 1;",
+		"if x y = ;",
+		"whilst = 1;",
+		"for i = 1;",
+	}
+	for _, in := range cases {
+		got, err := Parse("", []byte(in))
+		var want any
+		var wantErr error
+		withoutDispatch(func() {
+			want, wantErr = Parse("", []byte(in))
+		})
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%q: want %#v, got %#v", in, want, got)
+		}
+		if (err == nil) != (wantErr == nil) || err != nil && err.Error() != wantErr.Error() {
+			t.Errorf("%q: want error %v, got %v", in, wantErr, err)
+		}
+	}
+}
+
+func BenchmarkDispatch(b *testing.B) {
+	d := []byte(program)
+	b.SetBytes(int64(len(d)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Parse("", d); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNoDispatch(b *testing.B) {
+	d := []byte(program)
+	b.SetBytes(int64(len(d)))
+	withoutDispatch(func() {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := Parse("", d); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
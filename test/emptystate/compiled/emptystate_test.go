@@ -0,0 +1 @@
+../emptystate_test.go
\ No newline at end of file
@@ -0,0 +1,4462 @@
+// Code generated by pigeon; DO NOT EDIT.
+
+package errorpos
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+var g = &grammar{
+	rules: []*rule{
+		{
+			name: "Input",
+			pos:  position{line: 5, col: 1, offset: 22},
+			id:   0,
+		},
+		{
+			name: "case01",
+			pos:  position{line: 7, col: 1, offset: 138},
+			id:   1,
+		},
+		{
+			name: "case02",
+			pos:  position{line: 8, col: 1, offset: 197},
+			id:   2,
+		},
+		{
+			name: "case03",
+			pos:  position{line: 9, col: 1, offset: 228},
+			id:   3,
+		},
+		{
+			name: "case04",
+			pos:  position{line: 10, col: 1, offset: 262},
+			id:   4,
+		},
+		{
+			name: "case05",
+			pos:  position{line: 11, col: 1, offset: 323},
+			id:   5,
+		},
+		{
+			name: "case06",
+			pos:  position{line: 12, col: 1, offset: 359},
+			id:   6,
+		},
+		{
+			name: "case07",
+			pos:  position{line: 13, col: 1, offset: 393},
+			id:   7,
+		},
+		{
+			name: "case08",
+			pos:  position{line: 14, col: 1, offset: 449},
+			id:   8,
+		},
+		{
+			name: "case09",
+			pos:  position{line: 15, col: 1, offset: 480},
+			id:   9,
+		},
+		{
+			name: "case10",
+			pos:  position{line: 16, col: 1, offset: 512},
+			id:   10,
+		},
+		{
+			name: "case11",
+			pos:  position{line: 17, col: 1, offset: 568},
+			id:   11,
+		},
+		{
+			name: "increment",
+			pos:  position{line: 19, col: 1, offset: 606},
+			id:   12,
+		},
+		{
+			name: "decrement",
+			pos:  position{line: 20, col: 1, offset: 626},
+			id:   13,
+		},
+		{
+			name: "zero",
+			pos:  position{line: 21, col: 1, offset: 646},
+			id:   14,
+		},
+		{
+			name: "oneOrMore",
+			pos:  position{line: 22, col: 1, offset: 662},
+			id:   15,
+		},
+		{
+			name: "_",
+			pos:  position{line: 23, col: 1, offset: 688},
+			id:   16,
+		},
+		{
+			name: "__",
+			pos:  position{line: 24, col: 1, offset: 705},
+			id:   17,
+		},
+		{
+			name: "EOF",
+			pos:  position{line: 25, col: 1, offset: 716},
+			id:   18,
+		},
+	},
+}
+
+func init() {
+	g.rules[0].run = (*parser).expr19
+	g.rules[1].run = (*parser).expr34
+	g.rules[2].run = (*parser).expr44
+	g.rules[3].run = (*parser).expr49
+	g.rules[4].run = (*parser).expr55
+	g.rules[5].run = (*parser).expr62
+	g.rules[6].run = (*parser).expr68
+	g.rules[7].run = (*parser).expr74
+	g.rules[8].run = (*parser).expr83
+	g.rules[9].run = (*parser).expr89
+	g.rules[10].run = (*parser).expr95
+	g.rules[11].run = (*parser).expr105
+	g.rules[12].run = (*parser).expr112
+	g.rules[13].run = (*parser).expr113
+	g.rules[14].run = (*parser).expr114
+	g.rules[15].run = (*parser).expr115
+	g.rules[16].run = (*parser).expr116
+	g.rules[17].run = (*parser).expr118
+	g.rules[18].run = (*parser).expr119
+}
+
+func (p *parser) expr19() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(19); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			var v5 any
+			var match6 bool
+			{
+				if res7, hit := p.compiledMemoized(20); hit {
+					v5, match6 = res7.v, res7.b
+				} else {
+					pt8 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseRuleRefExpr _")
+					}
+					v5, match6 = p.parseRuleWrap(g.rules[16])
+					if p.debug {
+						p.out("parseRuleRefExpr _")
+					}
+					p.compiledMemoize(pt8, 20, v5, match6)
+				}
+			}
+			if match6 {
+				var v9 any
+				var match10 bool
+				{
+					if res11, hit := p.compiledMemoized(21); hit {
+						v9, match10 = res11.v, res11.b
+					} else {
+						pt12 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseChoiceExpr")
+						}
+						d := expr21Dispatch
+						set := d.set(p.pt)
+						for _, want := range d.expected[set] {
+							p.failAt(false, p.pt.position, want)
+						}
+						for _, altI := range d.alts[set] {
+							switch altI {
+							case 0:
+								state13 := p.cloneState()
+								{
+									if res14, hit := p.compiledMemoized(22); hit {
+										v9, match10 = res14.v, res14.b
+									} else {
+										pt15 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr case01")
+										}
+										v9, match10 = p.parseRuleWrap(g.rules[1])
+										if p.debug {
+											p.out("parseRuleRefExpr case01")
+										}
+										p.compiledMemoize(pt15, 22, v9, match10)
+									}
+								}
+								if match10 {
+									p.incChoiceAltCnt(position{line: 5, col: 12, offset: 35}, altI)
+								}
+								if !match10 {
+									p.restoreState(state13)
+								}
+							case 1:
+								state16 := p.cloneState()
+								{
+									if res17, hit := p.compiledMemoized(23); hit {
+										v9, match10 = res17.v, res17.b
+									} else {
+										pt18 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr case02")
+										}
+										v9, match10 = p.parseRuleWrap(g.rules[2])
+										if p.debug {
+											p.out("parseRuleRefExpr case02")
+										}
+										p.compiledMemoize(pt18, 23, v9, match10)
+									}
+								}
+								if match10 {
+									p.incChoiceAltCnt(position{line: 5, col: 12, offset: 35}, altI)
+								}
+								if !match10 {
+									p.restoreState(state16)
+								}
+							case 2:
+								state19 := p.cloneState()
+								{
+									if res20, hit := p.compiledMemoized(24); hit {
+										v9, match10 = res20.v, res20.b
+									} else {
+										pt21 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr case03")
+										}
+										v9, match10 = p.parseRuleWrap(g.rules[3])
+										if p.debug {
+											p.out("parseRuleRefExpr case03")
+										}
+										p.compiledMemoize(pt21, 24, v9, match10)
+									}
+								}
+								if match10 {
+									p.incChoiceAltCnt(position{line: 5, col: 12, offset: 35}, altI)
+								}
+								if !match10 {
+									p.restoreState(state19)
+								}
+							case 3:
+								state22 := p.cloneState()
+								{
+									if res23, hit := p.compiledMemoized(25); hit {
+										v9, match10 = res23.v, res23.b
+									} else {
+										pt24 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr case04")
+										}
+										v9, match10 = p.parseRuleWrap(g.rules[4])
+										if p.debug {
+											p.out("parseRuleRefExpr case04")
+										}
+										p.compiledMemoize(pt24, 25, v9, match10)
+									}
+								}
+								if match10 {
+									p.incChoiceAltCnt(position{line: 5, col: 12, offset: 35}, altI)
+								}
+								if !match10 {
+									p.restoreState(state22)
+								}
+							case 4:
+								state25 := p.cloneState()
+								{
+									if res26, hit := p.compiledMemoized(26); hit {
+										v9, match10 = res26.v, res26.b
+									} else {
+										pt27 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr case05")
+										}
+										v9, match10 = p.parseRuleWrap(g.rules[5])
+										if p.debug {
+											p.out("parseRuleRefExpr case05")
+										}
+										p.compiledMemoize(pt27, 26, v9, match10)
+									}
+								}
+								if match10 {
+									p.incChoiceAltCnt(position{line: 5, col: 12, offset: 35}, altI)
+								}
+								if !match10 {
+									p.restoreState(state25)
+								}
+							case 5:
+								state28 := p.cloneState()
+								{
+									if res29, hit := p.compiledMemoized(27); hit {
+										v9, match10 = res29.v, res29.b
+									} else {
+										pt30 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr case06")
+										}
+										v9, match10 = p.parseRuleWrap(g.rules[6])
+										if p.debug {
+											p.out("parseRuleRefExpr case06")
+										}
+										p.compiledMemoize(pt30, 27, v9, match10)
+									}
+								}
+								if match10 {
+									p.incChoiceAltCnt(position{line: 5, col: 12, offset: 35}, altI)
+								}
+								if !match10 {
+									p.restoreState(state28)
+								}
+							case 6:
+								state31 := p.cloneState()
+								{
+									if res32, hit := p.compiledMemoized(28); hit {
+										v9, match10 = res32.v, res32.b
+									} else {
+										pt33 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr case07")
+										}
+										v9, match10 = p.parseRuleWrap(g.rules[7])
+										if p.debug {
+											p.out("parseRuleRefExpr case07")
+										}
+										p.compiledMemoize(pt33, 28, v9, match10)
+									}
+								}
+								if match10 {
+									p.incChoiceAltCnt(position{line: 5, col: 12, offset: 35}, altI)
+								}
+								if !match10 {
+									p.restoreState(state31)
+								}
+							case 7:
+								state34 := p.cloneState()
+								{
+									if res35, hit := p.compiledMemoized(29); hit {
+										v9, match10 = res35.v, res35.b
+									} else {
+										pt36 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr case08")
+										}
+										v9, match10 = p.parseRuleWrap(g.rules[8])
+										if p.debug {
+											p.out("parseRuleRefExpr case08")
+										}
+										p.compiledMemoize(pt36, 29, v9, match10)
+									}
+								}
+								if match10 {
+									p.incChoiceAltCnt(position{line: 5, col: 12, offset: 35}, altI)
+								}
+								if !match10 {
+									p.restoreState(state34)
+								}
+							case 8:
+								state37 := p.cloneState()
+								{
+									if res38, hit := p.compiledMemoized(30); hit {
+										v9, match10 = res38.v, res38.b
+									} else {
+										pt39 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr case09")
+										}
+										v9, match10 = p.parseRuleWrap(g.rules[9])
+										if p.debug {
+											p.out("parseRuleRefExpr case09")
+										}
+										p.compiledMemoize(pt39, 30, v9, match10)
+									}
+								}
+								if match10 {
+									p.incChoiceAltCnt(position{line: 5, col: 12, offset: 35}, altI)
+								}
+								if !match10 {
+									p.restoreState(state37)
+								}
+							case 9:
+								state40 := p.cloneState()
+								{
+									if res41, hit := p.compiledMemoized(31); hit {
+										v9, match10 = res41.v, res41.b
+									} else {
+										pt42 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr case10")
+										}
+										v9, match10 = p.parseRuleWrap(g.rules[10])
+										if p.debug {
+											p.out("parseRuleRefExpr case10")
+										}
+										p.compiledMemoize(pt42, 31, v9, match10)
+									}
+								}
+								if match10 {
+									p.incChoiceAltCnt(position{line: 5, col: 12, offset: 35}, altI)
+								}
+								if !match10 {
+									p.restoreState(state40)
+								}
+							case 10:
+								state43 := p.cloneState()
+								{
+									if res44, hit := p.compiledMemoized(32); hit {
+										v9, match10 = res44.v, res44.b
+									} else {
+										pt45 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr case11")
+										}
+										v9, match10 = p.parseRuleWrap(g.rules[11])
+										if p.debug {
+											p.out("parseRuleRefExpr case11")
+										}
+										p.compiledMemoize(pt45, 32, v9, match10)
+									}
+								}
+								if match10 {
+									p.incChoiceAltCnt(position{line: 5, col: 12, offset: 35}, altI)
+								}
+								if !match10 {
+									p.restoreState(state43)
+								}
+							}
+							if match10 {
+								break
+							}
+						}
+						if !match10 {
+							p.incChoiceAltCnt(position{line: 5, col: 12, offset: 35}, choiceNoMatch)
+						}
+						if p.debug {
+							p.out("parseChoiceExpr")
+						}
+						p.compiledMemoize(pt12, 21, v9, match10)
+					}
+				}
+				if match10 {
+					var v46 any
+					var match47 bool
+					{
+						if res48, hit := p.compiledMemoized(33); hit {
+							v46, match47 = res48.v, res48.b
+						} else {
+							pt49 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseRuleRefExpr EOF")
+							}
+							v46, match47 = p.parseRuleWrap(g.rules[18])
+							if p.debug {
+								p.out("parseRuleRefExpr EOF")
+							}
+							p.compiledMemoize(pt49, 33, v46, match47)
+						}
+					}
+					if match47 {
+						val = []any{v5, v9, v46}
+						ok = true
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state4)
+				p.restore(pt3)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+			p.compiledMemoize(pt2, 19, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr34() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(34); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			var v5 any
+			var match6 bool
+			{
+				if res7, hit := p.compiledMemoized(35); hit {
+					v5, match6 = res7.v, res7.b
+				} else {
+					pt8 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLitMatcher")
+					}
+					start := p.pt
+					if end := p.pt.offset + 6; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "case01" {
+						for i := 0; i < 6; i++ {
+							p.read()
+						}
+						p.failAt(true, start.position, "\"case01\"")
+						v5 = p.sliceFrom(start)
+						match6 = true
+					} else {
+						p.failAt(false, start.position, "\"case01\"")
+					}
+					if p.debug {
+						p.out("parseLitMatcher")
+					}
+					p.compiledMemoize(pt8, 35, v5, match6)
+				}
+			}
+			if match6 {
+				var v9 any
+				var match10 bool
+				{
+					if res11, hit := p.compiledMemoized(36); hit {
+						v9, match10 = res11.v, res11.b
+					} else {
+						pt12 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseRuleRefExpr _")
+						}
+						v9, match10 = p.parseRuleWrap(g.rules[16])
+						if p.debug {
+							p.out("parseRuleRefExpr _")
+						}
+						p.compiledMemoize(pt12, 36, v9, match10)
+					}
+				}
+				if match10 {
+					var v13 any
+					var match14 bool
+					{
+						if res15, hit := p.compiledMemoized(37); hit {
+							v13, match14 = res15.v, res15.b
+						} else {
+							pt16 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseOneOrMoreExpr")
+							}
+							var vals17 []any
+							for {
+								var v19 any
+								var match18 bool
+								{
+									if res20, hit := p.compiledMemoized(38); hit {
+										v19, match18 = res20.v, res20.b
+									} else {
+										pt21 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseSeqExpr")
+										}
+										pt22 := p.pt
+										state23 := p.cloneState()
+										var v24 any
+										var match25 bool
+										{
+											if res26, hit := p.compiledMemoized(39); hit {
+												v24, match25 = res26.v, res26.b
+											} else {
+												pt27 := p.pt
+												p.countExpr()
+												if p.debug {
+													p.in("parseChoiceExpr")
+												}
+												d := expr39Dispatch
+												set := d.set(p.pt)
+												for _, want := range d.expected[set] {
+													p.failAt(false, p.pt.position, want)
+												}
+												for _, altI := range d.alts[set] {
+													switch altI {
+													case 0:
+														state28 := p.cloneState()
+														{
+															if res29, hit := p.compiledMemoized(40); hit {
+																v24, match25 = res29.v, res29.b
+															} else {
+																pt30 := p.pt
+																p.countExpr()
+																if p.debug {
+																	p.in("parseRuleRefExpr increment")
+																}
+																v24, match25 = p.parseRuleWrap(g.rules[12])
+																if p.debug {
+																	p.out("parseRuleRefExpr increment")
+																}
+																p.compiledMemoize(pt30, 40, v24, match25)
+															}
+														}
+														if match25 {
+															p.incChoiceAltCnt(position{line: 7, col: 23, offset: 162}, altI)
+														}
+														if !match25 {
+															p.restoreState(state28)
+														}
+													case 1:
+														state31 := p.cloneState()
+														{
+															if res32, hit := p.compiledMemoized(41); hit {
+																v24, match25 = res32.v, res32.b
+															} else {
+																pt33 := p.pt
+																p.countExpr()
+																if p.debug {
+																	p.in("parseRuleRefExpr decrement")
+																}
+																v24, match25 = p.parseRuleWrap(g.rules[13])
+																if p.debug {
+																	p.out("parseRuleRefExpr decrement")
+																}
+																p.compiledMemoize(pt33, 41, v24, match25)
+															}
+														}
+														if match25 {
+															p.incChoiceAltCnt(position{line: 7, col: 23, offset: 162}, altI)
+														}
+														if !match25 {
+															p.restoreState(state31)
+														}
+													case 2:
+														state34 := p.cloneState()
+														{
+															if res35, hit := p.compiledMemoized(42); hit {
+																v24, match25 = res35.v, res35.b
+															} else {
+																pt36 := p.pt
+																p.countExpr()
+																if p.debug {
+																	p.in("parseRuleRefExpr zero")
+																}
+																v24, match25 = p.parseRuleWrap(g.rules[14])
+																if p.debug {
+																	p.out("parseRuleRefExpr zero")
+																}
+																p.compiledMemoize(pt36, 42, v24, match25)
+															}
+														}
+														if match25 {
+															p.incChoiceAltCnt(position{line: 7, col: 23, offset: 162}, altI)
+														}
+														if !match25 {
+															p.restoreState(state34)
+														}
+													}
+													if match25 {
+														break
+													}
+												}
+												if !match25 {
+													p.incChoiceAltCnt(position{line: 7, col: 23, offset: 162}, choiceNoMatch)
+												}
+												if p.debug {
+													p.out("parseChoiceExpr")
+												}
+												p.compiledMemoize(pt27, 39, v24, match25)
+											}
+										}
+										if match25 {
+											var v37 any
+											var match38 bool
+											{
+												if res39, hit := p.compiledMemoized(43); hit {
+													v37, match38 = res39.v, res39.b
+												} else {
+													pt40 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseRuleRefExpr _")
+													}
+													v37, match38 = p.parseRuleWrap(g.rules[16])
+													if p.debug {
+														p.out("parseRuleRefExpr _")
+													}
+													p.compiledMemoize(pt40, 43, v37, match38)
+												}
+											}
+											if match38 {
+												v19 = []any{v24, v37}
+												match18 = true
+											}
+										}
+										if !match18 {
+											p.restoreState(state23)
+											p.restore(pt22)
+										}
+										if p.debug {
+											p.out("parseSeqExpr")
+										}
+										p.compiledMemoize(pt21, 38, v19, match18)
+									}
+								}
+								if !match18 {
+									break
+								}
+								vals17 = append(vals17, v19)
+							}
+							if len(vals17) > 0 {
+								v13 = vals17
+								match14 = true
+							}
+							if p.debug {
+								p.out("parseOneOrMoreExpr")
+							}
+							p.compiledMemoize(pt16, 37, v13, match14)
+						}
+					}
+					if match14 {
+						val = []any{v5, v9, v13}
+						ok = true
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state4)
+				p.restore(pt3)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+			p.compiledMemoize(pt2, 34, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr44() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(44); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			var v5 any
+			var match6 bool
+			{
+				if res7, hit := p.compiledMemoized(45); hit {
+					v5, match6 = res7.v, res7.b
+				} else {
+					pt8 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLitMatcher")
+					}
+					start := p.pt
+					if end := p.pt.offset + 6; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "case02" {
+						for i := 0; i < 6; i++ {
+							p.read()
+						}
+						p.failAt(true, start.position, "\"case02\"")
+						v5 = p.sliceFrom(start)
+						match6 = true
+					} else {
+						p.failAt(false, start.position, "\"case02\"")
+					}
+					if p.debug {
+						p.out("parseLitMatcher")
+					}
+					p.compiledMemoize(pt8, 45, v5, match6)
+				}
+			}
+			if match6 {
+				var v9 any
+				var match10 bool
+				{
+					if res11, hit := p.compiledMemoized(46); hit {
+						v9, match10 = res11.v, res11.b
+					} else {
+						pt12 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseRuleRefExpr __")
+						}
+						v9, match10 = p.parseRuleWrap(g.rules[17])
+						if p.debug {
+							p.out("parseRuleRefExpr __")
+						}
+						p.compiledMemoize(pt12, 46, v9, match10)
+					}
+				}
+				if match10 {
+					var v13 any
+					var match14 bool
+					{
+						if res15, hit := p.compiledMemoized(47); hit {
+							v13, match14 = res15.v, res15.b
+						} else {
+							pt16 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseOneOrMoreExpr")
+							}
+							var vals17 []any
+							for {
+								var v19 any
+								var match18 bool
+								{
+									if res20, hit := p.compiledMemoized(48); hit {
+										v19, match18 = res20.v, res20.b
+									} else {
+										pt21 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseCharClassMatcher")
+										}
+										cur := p.pt.rn
+										start := p.pt
+										var matched bool
+										// can't match EOF
+										eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+										if !eof {
+											switch cur {
+											case 'a', 'b', 'c':
+												matched = true
+											}
+										}
+										if !matched && !eof {
+											p.read()
+											p.failAt(true, start.position, "[^abc]")
+											v19 = p.sliceFrom(start)
+											match18 = true
+										} else {
+											p.failAt(false, start.position, "[^abc]")
+										}
+										if p.debug {
+											p.out("parseCharClassMatcher")
+										}
+										p.compiledMemoize(pt21, 48, v19, match18)
+									}
+								}
+								if !match18 {
+									break
+								}
+								vals17 = append(vals17, v19)
+							}
+							if len(vals17) > 0 {
+								v13 = vals17
+								match14 = true
+							}
+							if p.debug {
+								p.out("parseOneOrMoreExpr")
+							}
+							p.compiledMemoize(pt16, 47, v13, match14)
+						}
+					}
+					if match14 {
+						val = []any{v5, v9, v13}
+						ok = true
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state4)
+				p.restore(pt3)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+			p.compiledMemoize(pt2, 44, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr49() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(49); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			var v5 any
+			var match6 bool
+			{
+				if res7, hit := p.compiledMemoized(50); hit {
+					v5, match6 = res7.v, res7.b
+				} else {
+					pt8 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLitMatcher")
+					}
+					start := p.pt
+					if end := p.pt.offset + 6; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "case03" {
+						for i := 0; i < 6; i++ {
+							p.read()
+						}
+						p.failAt(true, start.position, "\"case03\"")
+						v5 = p.sliceFrom(start)
+						match6 = true
+					} else {
+						p.failAt(false, start.position, "\"case03\"")
+					}
+					if p.debug {
+						p.out("parseLitMatcher")
+					}
+					p.compiledMemoize(pt8, 50, v5, match6)
+				}
+			}
+			if match6 {
+				var v9 any
+				var match10 bool
+				{
+					if res11, hit := p.compiledMemoized(51); hit {
+						v9, match10 = res11.v, res11.b
+					} else {
+						pt12 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseRuleRefExpr __")
+						}
+						v9, match10 = p.parseRuleWrap(g.rules[17])
+						if p.debug {
+							p.out("parseRuleRefExpr __")
+						}
+						p.compiledMemoize(pt12, 51, v9, match10)
+					}
+				}
+				if match10 {
+					var v13 any
+					var match14 bool
+					{
+						if res15, hit := p.compiledMemoized(52); hit {
+							v13, match14 = res15.v, res15.b
+						} else {
+							pt16 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseZeroOrOneExpr")
+							}
+							{
+								if res17, hit := p.compiledMemoized(53); hit {
+									v13, match14 = res17.v, res17.b
+								} else {
+									pt18 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseLitMatcher")
+									}
+									start := p.pt
+									if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "x" {
+										p.read()
+										p.failAt(true, start.position, "\"x\"")
+										v13 = p.sliceFrom(start)
+										match14 = true
+									} else {
+										p.failAt(false, start.position, "\"x\"")
+									}
+									if p.debug {
+										p.out("parseLitMatcher")
+									}
+									p.compiledMemoize(pt18, 53, v13, match14)
+								}
+							}
+							match14 = true
+							if p.debug {
+								p.out("parseZeroOrOneExpr")
+							}
+							p.compiledMemoize(pt16, 52, v13, match14)
+						}
+					}
+					if match14 {
+						var v19 any
+						var match20 bool
+						{
+							if res21, hit := p.compiledMemoized(54); hit {
+								v19, match20 = res21.v, res21.b
+							} else {
+								pt22 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseCharClassMatcher")
+								}
+								cur := p.pt.rn
+								start := p.pt
+								var matched bool
+								// can't match EOF
+								eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+								if !eof {
+									switch {
+									case cur >= '0' && cur <= '9':
+										matched = true
+									}
+								}
+								if matched && !eof {
+									p.read()
+									p.failAt(true, start.position, "[0-9]")
+									v19 = p.sliceFrom(start)
+									match20 = true
+								} else {
+									p.failAt(false, start.position, "[0-9]")
+								}
+								if p.debug {
+									p.out("parseCharClassMatcher")
+								}
+								p.compiledMemoize(pt22, 54, v19, match20)
+							}
+						}
+						if match20 {
+							val = []any{v5, v9, v13, v19}
+							ok = true
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state4)
+				p.restore(pt3)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+			p.compiledMemoize(pt2, 49, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr55() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(55); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			var v5 any
+			var match6 bool
+			{
+				if res7, hit := p.compiledMemoized(56); hit {
+					v5, match6 = res7.v, res7.b
+				} else {
+					pt8 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLitMatcher")
+					}
+					start := p.pt
+					if end := p.pt.offset + 6; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "case04" {
+						for i := 0; i < 6; i++ {
+							p.read()
+						}
+						p.failAt(true, start.position, "\"case04\"")
+						v5 = p.sliceFrom(start)
+						match6 = true
+					} else {
+						p.failAt(false, start.position, "\"case04\"")
+					}
+					if p.debug {
+						p.out("parseLitMatcher")
+					}
+					p.compiledMemoize(pt8, 56, v5, match6)
+				}
+			}
+			if match6 {
+				var v9 any
+				var match10 bool
+				{
+					if res11, hit := p.compiledMemoized(57); hit {
+						v9, match10 = res11.v, res11.b
+					} else {
+						pt12 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseRuleRefExpr __")
+						}
+						v9, match10 = p.parseRuleWrap(g.rules[17])
+						if p.debug {
+							p.out("parseRuleRefExpr __")
+						}
+						p.compiledMemoize(pt12, 57, v9, match10)
+					}
+				}
+				if match10 {
+					var v13 any
+					var match14 bool
+					{
+						if res15, hit := p.compiledMemoized(58); hit {
+							v13, match14 = res15.v, res15.b
+						} else {
+							pt16 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseCharClassMatcher")
+							}
+							cur := p.pt.rn
+							start := p.pt
+							var matched bool
+							// can't match EOF
+							eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+							if !eof {
+								switch {
+								case cur >= '0' && cur <= '9':
+									matched = true
+								}
+							}
+							if matched && !eof {
+								p.read()
+								p.failAt(true, start.position, "[\\x30-\\x39]")
+								v13 = p.sliceFrom(start)
+								match14 = true
+							} else {
+								p.failAt(false, start.position, "[\\x30-\\x39]")
+							}
+							if p.debug {
+								p.out("parseCharClassMatcher")
+							}
+							p.compiledMemoize(pt16, 58, v13, match14)
+						}
+					}
+					if match14 {
+						var v17 any
+						var match18 bool
+						{
+							if res19, hit := p.compiledMemoized(59); hit {
+								v17, match18 = res19.v, res19.b
+							} else {
+								pt20 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseCharClassMatcher")
+								}
+								cur := p.pt.rn
+								start := p.pt
+								var matched bool
+								// can't match EOF
+								eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+								if !eof {
+									switch {
+									case cur >= '0' && cur <= '9':
+										matched = true
+									}
+								}
+								if !matched && !eof {
+									p.read()
+									p.failAt(true, start.position, "[^\\x30-\\x39]")
+									v17 = p.sliceFrom(start)
+									match18 = true
+								} else {
+									p.failAt(false, start.position, "[^\\x30-\\x39]")
+								}
+								if p.debug {
+									p.out("parseCharClassMatcher")
+								}
+								p.compiledMemoize(pt20, 59, v17, match18)
+							}
+						}
+						if match18 {
+							var v21 any
+							var match22 bool
+							{
+								if res23, hit := p.compiledMemoized(60); hit {
+									v21, match22 = res23.v, res23.b
+								} else {
+									pt24 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseCharClassMatcher")
+									}
+									cur := p.pt.rn
+									start := p.pt
+									var matched bool
+									// can't match EOF
+									eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+									if !eof {
+										switch {
+										case unicode.Is(compiledClasses[0], cur):
+											matched = true
+										}
+									}
+									if matched && !eof {
+										p.read()
+										p.failAt(true, start.position, "[\\pN]")
+										v21 = p.sliceFrom(start)
+										match22 = true
+									} else {
+										p.failAt(false, start.position, "[\\pN]")
+									}
+									if p.debug {
+										p.out("parseCharClassMatcher")
+									}
+									p.compiledMemoize(pt24, 60, v21, match22)
+								}
+							}
+							if match22 {
+								var v25 any
+								var match26 bool
+								{
+									if res27, hit := p.compiledMemoized(61); hit {
+										v25, match26 = res27.v, res27.b
+									} else {
+										pt28 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseCharClassMatcher")
+										}
+										cur := p.pt.rn
+										start := p.pt
+										var matched bool
+										// can't match EOF
+										eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+										if !eof {
+											switch {
+											case unicode.Is(compiledClasses[0], cur):
+												matched = true
+											}
+										}
+										if !matched && !eof {
+											p.read()
+											p.failAt(true, start.position, "[^\\pN]")
+											v25 = p.sliceFrom(start)
+											match26 = true
+										} else {
+											p.failAt(false, start.position, "[^\\pN]")
+										}
+										if p.debug {
+											p.out("parseCharClassMatcher")
+										}
+										p.compiledMemoize(pt28, 61, v25, match26)
+									}
+								}
+								if match26 {
+									val = []any{v5, v9, v13, v17, v21, v25}
+									ok = true
+								}
+							}
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state4)
+				p.restore(pt3)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+			p.compiledMemoize(pt2, 55, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr62() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(62); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			var v5 any
+			var match6 bool
+			{
+				if res7, hit := p.compiledMemoized(63); hit {
+					v5, match6 = res7.v, res7.b
+				} else {
+					pt8 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLitMatcher")
+					}
+					start := p.pt
+					if end := p.pt.offset + 6; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "case05" {
+						for i := 0; i < 6; i++ {
+							p.read()
+						}
+						p.failAt(true, start.position, "\"case05\"")
+						v5 = p.sliceFrom(start)
+						match6 = true
+					} else {
+						p.failAt(false, start.position, "\"case05\"")
+					}
+					if p.debug {
+						p.out("parseLitMatcher")
+					}
+					p.compiledMemoize(pt8, 63, v5, match6)
+				}
+			}
+			if match6 {
+				var v9 any
+				var match10 bool
+				{
+					if res11, hit := p.compiledMemoized(64); hit {
+						v9, match10 = res11.v, res11.b
+					} else {
+						pt12 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseRuleRefExpr __")
+						}
+						v9, match10 = p.parseRuleWrap(g.rules[17])
+						if p.debug {
+							p.out("parseRuleRefExpr __")
+						}
+						p.compiledMemoize(pt12, 64, v9, match10)
+					}
+				}
+				if match10 {
+					var v13 any
+					var match14 bool
+					{
+						if res15, hit := p.compiledMemoized(65); hit {
+							v13, match14 = res15.v, res15.b
+						} else {
+							pt16 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseNotExpr")
+							}
+							pt17 := p.pt
+							state18 := p.cloneState()
+							p.maxFailInvertExpected = !p.maxFailInvertExpected
+							var match19 bool
+							{
+								if res20, hit := p.compiledMemoized(66); hit {
+									_, match19 = res20.v, res20.b
+								} else {
+									pt21 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseLitMatcher")
+									}
+									start := p.pt
+									if end := p.pt.offset + 3; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "not" {
+										for i := 0; i < 3; i++ {
+											p.read()
+										}
+										p.failAt(true, start.position, "\"not\"")
+										match19 = true
+									} else {
+										p.failAt(false, start.position, "\"not\"")
+									}
+									if p.debug {
+										p.out("parseLitMatcher")
+									}
+									p.compiledMemoize(pt21, 66, nil, match19)
+								}
+							}
+							p.maxFailInvertExpected = !p.maxFailInvertExpected
+							p.restoreState(state18)
+							p.restore(pt17)
+							match14 = !match19
+							if p.debug {
+								p.out("parseNotExpr")
+							}
+							p.compiledMemoize(pt16, 65, v13, match14)
+						}
+					}
+					if match14 {
+						var v22 any
+						var match23 bool
+						{
+							if res24, hit := p.compiledMemoized(67); hit {
+								v22, match23 = res24.v, res24.b
+							} else {
+								pt25 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseLitMatcher")
+								}
+								start := p.pt
+								if end := p.pt.offset + 3; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "yes" {
+									for i := 0; i < 3; i++ {
+										p.read()
+									}
+									p.failAt(true, start.position, "\"yes\"")
+									v22 = p.sliceFrom(start)
+									match23 = true
+								} else {
+									p.failAt(false, start.position, "\"yes\"")
+								}
+								if p.debug {
+									p.out("parseLitMatcher")
+								}
+								p.compiledMemoize(pt25, 67, v22, match23)
+							}
+						}
+						if match23 {
+							val = []any{v5, v9, v13, v22}
+							ok = true
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state4)
+				p.restore(pt3)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+			p.compiledMemoize(pt2, 62, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr68() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(68); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			var v5 any
+			var match6 bool
+			{
+				if res7, hit := p.compiledMemoized(69); hit {
+					v5, match6 = res7.v, res7.b
+				} else {
+					pt8 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLitMatcher")
+					}
+					start := p.pt
+					if end := p.pt.offset + 6; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "case06" {
+						for i := 0; i < 6; i++ {
+							p.read()
+						}
+						p.failAt(true, start.position, "\"case06\"")
+						v5 = p.sliceFrom(start)
+						match6 = true
+					} else {
+						p.failAt(false, start.position, "\"case06\"")
+					}
+					if p.debug {
+						p.out("parseLitMatcher")
+					}
+					p.compiledMemoize(pt8, 69, v5, match6)
+				}
+			}
+			if match6 {
+				var v9 any
+				var match10 bool
+				{
+					if res11, hit := p.compiledMemoized(70); hit {
+						v9, match10 = res11.v, res11.b
+					} else {
+						pt12 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseRuleRefExpr __")
+						}
+						v9, match10 = p.parseRuleWrap(g.rules[17])
+						if p.debug {
+							p.out("parseRuleRefExpr __")
+						}
+						p.compiledMemoize(pt12, 70, v9, match10)
+					}
+				}
+				if match10 {
+					var v13 any
+					var match14 bool
+					{
+						if res15, hit := p.compiledMemoized(71); hit {
+							v13, match14 = res15.v, res15.b
+						} else {
+							pt16 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseNotExpr")
+							}
+							pt17 := p.pt
+							state18 := p.cloneState()
+							p.maxFailInvertExpected = !p.maxFailInvertExpected
+							var match19 bool
+							{
+								if res20, hit := p.compiledMemoized(72); hit {
+									_, match19 = res20.v, res20.b
+								} else {
+									pt21 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseCharClassMatcher")
+									}
+									cur := p.pt.rn
+									start := p.pt
+									var matched bool
+									// can't match EOF
+									eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+									if !eof {
+										switch {
+										case cur >= '0' && cur <= '9':
+											matched = true
+										}
+									}
+									if matched && !eof {
+										p.read()
+										p.failAt(true, start.position, "[0-9]")
+										match19 = true
+									} else {
+										p.failAt(false, start.position, "[0-9]")
+									}
+									if p.debug {
+										p.out("parseCharClassMatcher")
+									}
+									p.compiledMemoize(pt21, 72, nil, match19)
+								}
+							}
+							p.maxFailInvertExpected = !p.maxFailInvertExpected
+							p.restoreState(state18)
+							p.restore(pt17)
+							match14 = !match19
+							if p.debug {
+								p.out("parseNotExpr")
+							}
+							p.compiledMemoize(pt16, 71, v13, match14)
+						}
+					}
+					if match14 {
+						var v22 any
+						var match23 bool
+						{
+							if res24, hit := p.compiledMemoized(73); hit {
+								v22, match23 = res24.v, res24.b
+							} else {
+								pt25 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseLitMatcher")
+								}
+								start := p.pt
+								if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "x" {
+									p.read()
+									p.failAt(true, start.position, "\"x\"")
+									v22 = p.sliceFrom(start)
+									match23 = true
+								} else {
+									p.failAt(false, start.position, "\"x\"")
+								}
+								if p.debug {
+									p.out("parseLitMatcher")
+								}
+								p.compiledMemoize(pt25, 73, v22, match23)
+							}
+						}
+						if match23 {
+							val = []any{v5, v9, v13, v22}
+							ok = true
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state4)
+				p.restore(pt3)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+			p.compiledMemoize(pt2, 68, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr74() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(74); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			var v5 any
+			var match6 bool
+			{
+				if res7, hit := p.compiledMemoized(75); hit {
+					v5, match6 = res7.v, res7.b
+				} else {
+					pt8 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLitMatcher")
+					}
+					start := p.pt
+					if end := p.pt.offset + 6; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "case07" {
+						for i := 0; i < 6; i++ {
+							p.read()
+						}
+						p.failAt(true, start.position, "\"case07\"")
+						v5 = p.sliceFrom(start)
+						match6 = true
+					} else {
+						p.failAt(false, start.position, "\"case07\"")
+					}
+					if p.debug {
+						p.out("parseLitMatcher")
+					}
+					p.compiledMemoize(pt8, 75, v5, match6)
+				}
+			}
+			if match6 {
+				var v9 any
+				var match10 bool
+				{
+					if res11, hit := p.compiledMemoized(76); hit {
+						v9, match10 = res11.v, res11.b
+					} else {
+						pt12 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseRuleRefExpr __")
+						}
+						v9, match10 = p.parseRuleWrap(g.rules[17])
+						if p.debug {
+							p.out("parseRuleRefExpr __")
+						}
+						p.compiledMemoize(pt12, 76, v9, match10)
+					}
+				}
+				if match10 {
+					var v13 any
+					var match14 bool
+					{
+						if res15, hit := p.compiledMemoized(77); hit {
+							v13, match14 = res15.v, res15.b
+						} else {
+							pt16 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseNotExpr")
+							}
+							pt17 := p.pt
+							state18 := p.cloneState()
+							p.maxFailInvertExpected = !p.maxFailInvertExpected
+							var match19 bool
+							{
+								if res20, hit := p.compiledMemoized(78); hit {
+									_, match19 = res20.v, res20.b
+								} else {
+									pt21 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseChoiceExpr")
+									}
+									d := expr78Dispatch
+									set := d.set(p.pt)
+									for _, want := range d.expected[set] {
+										p.failAt(false, p.pt.position, want)
+									}
+									for _, altI := range d.alts[set] {
+										switch altI {
+										case 0:
+											state22 := p.cloneState()
+											{
+												if res23, hit := p.compiledMemoized(79); hit {
+													_, match19 = res23.v, res23.b
+												} else {
+													pt24 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseLitMatcher")
+													}
+													start := p.pt
+													if p.matchCaseFold("abc") {
+														p.failAt(true, start.position, "\"abc\"i")
+														match19 = true
+													} else {
+														p.failAt(false, start.position, "\"abc\"i")
+														p.restore(start)
+													}
+													if p.debug {
+														p.out("parseLitMatcher")
+													}
+													p.compiledMemoize(pt24, 79, nil, match19)
+												}
+											}
+											if match19 {
+												p.incChoiceAltCnt(position{line: 13, col: 24, offset: 418}, altI)
+											}
+											if !match19 {
+												p.restoreState(state22)
+											}
+										case 1:
+											state25 := p.cloneState()
+											{
+												if res26, hit := p.compiledMemoized(80); hit {
+													_, match19 = res26.v, res26.b
+												} else {
+													pt27 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseCharClassMatcher")
+													}
+													cur := p.pt.rn
+													start := p.pt
+													var matched bool
+													// can't match EOF
+													eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+													if !eof {
+														cur = caseFold(cur)
+														switch {
+														case cur >= 'a' && cur <= 'c':
+															matched = true
+														}
+													}
+													if matched && !eof {
+														p.read()
+														p.failAt(true, start.position, "[a-c]i")
+														match19 = true
+													} else {
+														p.failAt(false, start.position, "[a-c]i")
+													}
+													if p.debug {
+														p.out("parseCharClassMatcher")
+													}
+													p.compiledMemoize(pt27, 80, nil, match19)
+												}
+											}
+											if match19 {
+												p.incChoiceAltCnt(position{line: 13, col: 24, offset: 418}, altI)
+											}
+											if !match19 {
+												p.restoreState(state25)
+											}
+										case 2:
+											state28 := p.cloneState()
+											{
+												if res29, hit := p.compiledMemoized(81); hit {
+													_, match19 = res29.v, res29.b
+												} else {
+													pt30 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseCharClassMatcher")
+													}
+													cur := p.pt.rn
+													start := p.pt
+													var matched bool
+													// can't match EOF
+													eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+													if !eof {
+														switch {
+														case unicode.Is(compiledClasses[1], cur):
+															matched = true
+														}
+													}
+													if matched && !eof {
+														p.read()
+														p.failAt(true, start.position, "[\\pL]")
+														match19 = true
+													} else {
+														p.failAt(false, start.position, "[\\pL]")
+													}
+													if p.debug {
+														p.out("parseCharClassMatcher")
+													}
+													p.compiledMemoize(pt30, 81, nil, match19)
+												}
+											}
+											if match19 {
+												p.incChoiceAltCnt(position{line: 13, col: 24, offset: 418}, altI)
+											}
+											if !match19 {
+												p.restoreState(state28)
+											}
+										}
+										if match19 {
+											break
+										}
+									}
+									if !match19 {
+										p.incChoiceAltCnt(position{line: 13, col: 24, offset: 418}, choiceNoMatch)
+									}
+									if p.debug {
+										p.out("parseChoiceExpr")
+									}
+									p.compiledMemoize(pt21, 78, nil, match19)
+								}
+							}
+							p.maxFailInvertExpected = !p.maxFailInvertExpected
+							p.restoreState(state18)
+							p.restore(pt17)
+							match14 = !match19
+							if p.debug {
+								p.out("parseNotExpr")
+							}
+							p.compiledMemoize(pt16, 77, v13, match14)
+						}
+					}
+					if match14 {
+						var v31 any
+						var match32 bool
+						{
+							if res33, hit := p.compiledMemoized(82); hit {
+								v31, match32 = res33.v, res33.b
+							} else {
+								pt34 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseCharClassMatcher")
+								}
+								cur := p.pt.rn
+								start := p.pt
+								var matched bool
+								// can't match EOF
+								eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+								if !eof {
+									switch {
+									case cur >= '0' && cur <= '9':
+										matched = true
+									}
+								}
+								if matched && !eof {
+									p.read()
+									p.failAt(true, start.position, "[0-9]")
+									v31 = p.sliceFrom(start)
+									match32 = true
+								} else {
+									p.failAt(false, start.position, "[0-9]")
+								}
+								if p.debug {
+									p.out("parseCharClassMatcher")
+								}
+								p.compiledMemoize(pt34, 82, v31, match32)
+							}
+						}
+						if match32 {
+							val = []any{v5, v9, v13, v31}
+							ok = true
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state4)
+				p.restore(pt3)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+			p.compiledMemoize(pt2, 74, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr83() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(83); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			var v5 any
+			var match6 bool
+			{
+				if res7, hit := p.compiledMemoized(84); hit {
+					v5, match6 = res7.v, res7.b
+				} else {
+					pt8 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLitMatcher")
+					}
+					start := p.pt
+					if end := p.pt.offset + 6; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "case08" {
+						for i := 0; i < 6; i++ {
+							p.read()
+						}
+						p.failAt(true, start.position, "\"case08\"")
+						v5 = p.sliceFrom(start)
+						match6 = true
+					} else {
+						p.failAt(false, start.position, "\"case08\"")
+					}
+					if p.debug {
+						p.out("parseLitMatcher")
+					}
+					p.compiledMemoize(pt8, 84, v5, match6)
+				}
+			}
+			if match6 {
+				var v9 any
+				var match10 bool
+				{
+					if res11, hit := p.compiledMemoized(85); hit {
+						v9, match10 = res11.v, res11.b
+					} else {
+						pt12 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseRuleRefExpr __")
+						}
+						v9, match10 = p.parseRuleWrap(g.rules[17])
+						if p.debug {
+							p.out("parseRuleRefExpr __")
+						}
+						p.compiledMemoize(pt12, 85, v9, match10)
+					}
+				}
+				if match10 {
+					var v13 any
+					var match14 bool
+					{
+						if res15, hit := p.compiledMemoized(86); hit {
+							v13, match14 = res15.v, res15.b
+						} else {
+							pt16 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseAndExpr")
+							}
+							pt17 := p.pt
+							state18 := p.cloneState()
+							var match19 bool
+							{
+								if res20, hit := p.compiledMemoized(87); hit {
+									_, match19 = res20.v, res20.b
+								} else {
+									pt21 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseLitMatcher")
+									}
+									start := p.pt
+									if p.matchCaseFold("a") {
+										p.failAt(true, start.position, "\"a\"i")
+										match19 = true
+									} else {
+										p.failAt(false, start.position, "\"a\"i")
+										p.restore(start)
+									}
+									if p.debug {
+										p.out("parseLitMatcher")
+									}
+									p.compiledMemoize(pt21, 87, nil, match19)
+								}
+							}
+							p.restoreState(state18)
+							p.restore(pt17)
+							match14 = match19
+							if p.debug {
+								p.out("parseAndExpr")
+							}
+							p.compiledMemoize(pt16, 86, v13, match14)
+						}
+					}
+					if match14 {
+						var v22 any
+						var match23 bool
+						{
+							if res24, hit := p.compiledMemoized(88); hit {
+								v22, match23 = res24.v, res24.b
+							} else {
+								pt25 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseAnyMatcher")
+								}
+								if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+									// EOF - see utf8.DecodeRune
+									p.failAt(false, p.pt.position, ".")
+								} else {
+									start := p.pt
+									p.read()
+									p.failAt(true, start.position, ".")
+									v22 = p.sliceFrom(start)
+									match23 = true
+								}
+								if p.debug {
+									p.out("parseAnyMatcher")
+								}
+								p.compiledMemoize(pt25, 88, v22, match23)
+							}
+						}
+						if match23 {
+							val = []any{v5, v9, v13, v22}
+							ok = true
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state4)
+				p.restore(pt3)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+			p.compiledMemoize(pt2, 83, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr89() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(89); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			var v5 any
+			var match6 bool
+			{
+				if res7, hit := p.compiledMemoized(90); hit {
+					v5, match6 = res7.v, res7.b
+				} else {
+					pt8 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLitMatcher")
+					}
+					start := p.pt
+					if end := p.pt.offset + 6; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "case09" {
+						for i := 0; i < 6; i++ {
+							p.read()
+						}
+						p.failAt(true, start.position, "\"case09\"")
+						v5 = p.sliceFrom(start)
+						match6 = true
+					} else {
+						p.failAt(false, start.position, "\"case09\"")
+					}
+					if p.debug {
+						p.out("parseLitMatcher")
+					}
+					p.compiledMemoize(pt8, 90, v5, match6)
+				}
+			}
+			if match6 {
+				var v9 any
+				var match10 bool
+				{
+					if res11, hit := p.compiledMemoized(91); hit {
+						v9, match10 = res11.v, res11.b
+					} else {
+						pt12 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseRuleRefExpr __")
+						}
+						v9, match10 = p.parseRuleWrap(g.rules[17])
+						if p.debug {
+							p.out("parseRuleRefExpr __")
+						}
+						p.compiledMemoize(pt12, 91, v9, match10)
+					}
+				}
+				if match10 {
+					var v13 any
+					var match14 bool
+					{
+						if res15, hit := p.compiledMemoized(92); hit {
+							v13, match14 = res15.v, res15.b
+						} else {
+							pt16 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseAndExpr")
+							}
+							pt17 := p.pt
+							state18 := p.cloneState()
+							var match19 bool
+							{
+								if res20, hit := p.compiledMemoized(93); hit {
+									_, match19 = res20.v, res20.b
+								} else {
+									pt21 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseCharClassMatcher")
+									}
+									cur := p.pt.rn
+									start := p.pt
+									var matched bool
+									// can't match EOF
+									eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+									if !eof {
+										switch {
+										case cur >= '0' && cur <= '9':
+											matched = true
+										}
+									}
+									if matched && !eof {
+										p.read()
+										p.failAt(true, start.position, "[0-9]")
+										match19 = true
+									} else {
+										p.failAt(false, start.position, "[0-9]")
+									}
+									if p.debug {
+										p.out("parseCharClassMatcher")
+									}
+									p.compiledMemoize(pt21, 93, nil, match19)
+								}
+							}
+							p.restoreState(state18)
+							p.restore(pt17)
+							match14 = match19
+							if p.debug {
+								p.out("parseAndExpr")
+							}
+							p.compiledMemoize(pt16, 92, v13, match14)
+						}
+					}
+					if match14 {
+						var v22 any
+						var match23 bool
+						{
+							if res24, hit := p.compiledMemoized(94); hit {
+								v22, match23 = res24.v, res24.b
+							} else {
+								pt25 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseAnyMatcher")
+								}
+								if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+									// EOF - see utf8.DecodeRune
+									p.failAt(false, p.pt.position, ".")
+								} else {
+									start := p.pt
+									p.read()
+									p.failAt(true, start.position, ".")
+									v22 = p.sliceFrom(start)
+									match23 = true
+								}
+								if p.debug {
+									p.out("parseAnyMatcher")
+								}
+								p.compiledMemoize(pt25, 94, v22, match23)
+							}
+						}
+						if match23 {
+							val = []any{v5, v9, v13, v22}
+							ok = true
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state4)
+				p.restore(pt3)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+			p.compiledMemoize(pt2, 89, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr95() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(95); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			var v5 any
+			var match6 bool
+			{
+				if res7, hit := p.compiledMemoized(96); hit {
+					v5, match6 = res7.v, res7.b
+				} else {
+					pt8 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLitMatcher")
+					}
+					start := p.pt
+					if end := p.pt.offset + 6; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "case10" {
+						for i := 0; i < 6; i++ {
+							p.read()
+						}
+						p.failAt(true, start.position, "\"case10\"")
+						v5 = p.sliceFrom(start)
+						match6 = true
+					} else {
+						p.failAt(false, start.position, "\"case10\"")
+					}
+					if p.debug {
+						p.out("parseLitMatcher")
+					}
+					p.compiledMemoize(pt8, 96, v5, match6)
+				}
+			}
+			if match6 {
+				var v9 any
+				var match10 bool
+				{
+					if res11, hit := p.compiledMemoized(97); hit {
+						v9, match10 = res11.v, res11.b
+					} else {
+						pt12 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseRuleRefExpr __")
+						}
+						v9, match10 = p.parseRuleWrap(g.rules[17])
+						if p.debug {
+							p.out("parseRuleRefExpr __")
+						}
+						p.compiledMemoize(pt12, 97, v9, match10)
+					}
+				}
+				if match10 {
+					var v13 any
+					var match14 bool
+					{
+						if res15, hit := p.compiledMemoized(98); hit {
+							v13, match14 = res15.v, res15.b
+						} else {
+							pt16 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseAndExpr")
+							}
+							pt17 := p.pt
+							state18 := p.cloneState()
+							var match19 bool
+							{
+								if res20, hit := p.compiledMemoized(99); hit {
+									_, match19 = res20.v, res20.b
+								} else {
+									pt21 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseChoiceExpr")
+									}
+									d := expr99Dispatch
+									set := d.set(p.pt)
+									for _, want := range d.expected[set] {
+										p.failAt(false, p.pt.position, want)
+									}
+									for _, altI := range d.alts[set] {
+										switch altI {
+										case 0:
+											state22 := p.cloneState()
+											{
+												if res23, hit := p.compiledMemoized(100); hit {
+													_, match19 = res23.v, res23.b
+												} else {
+													pt24 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseLitMatcher")
+													}
+													start := p.pt
+													if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "0" {
+														p.read()
+														p.failAt(true, start.position, "\"0\"")
+														match19 = true
+													} else {
+														p.failAt(false, start.position, "\"0\"")
+													}
+													if p.debug {
+														p.out("parseLitMatcher")
+													}
+													p.compiledMemoize(pt24, 100, nil, match19)
+												}
+											}
+											if match19 {
+												p.incChoiceAltCnt(position{line: 16, col: 24, offset: 537}, altI)
+											}
+											if !match19 {
+												p.restoreState(state22)
+											}
+										case 1:
+											state25 := p.cloneState()
+											{
+												if res26, hit := p.compiledMemoized(101); hit {
+													_, match19 = res26.v, res26.b
+												} else {
+													pt27 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseCharClassMatcher")
+													}
+													cur := p.pt.rn
+													start := p.pt
+													var matched bool
+													// can't match EOF
+													eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+													if !eof {
+														switch cur {
+														case '0', '1', '2':
+															matched = true
+														}
+													}
+													if matched && !eof {
+														p.read()
+														p.failAt(true, start.position, "[012]")
+														match19 = true
+													} else {
+														p.failAt(false, start.position, "[012]")
+													}
+													if p.debug {
+														p.out("parseCharClassMatcher")
+													}
+													p.compiledMemoize(pt27, 101, nil, match19)
+												}
+											}
+											if match19 {
+												p.incChoiceAltCnt(position{line: 16, col: 24, offset: 537}, altI)
+											}
+											if !match19 {
+												p.restoreState(state25)
+											}
+										case 2:
+											state28 := p.cloneState()
+											{
+												if res29, hit := p.compiledMemoized(102); hit {
+													_, match19 = res29.v, res29.b
+												} else {
+													pt30 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseCharClassMatcher")
+													}
+													cur := p.pt.rn
+													start := p.pt
+													var matched bool
+													// can't match EOF
+													eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+													if !eof {
+														switch {
+														case cur >= '3' && cur <= '9':
+															matched = true
+														}
+													}
+													if matched && !eof {
+														p.read()
+														p.failAt(true, start.position, "[3-9]")
+														match19 = true
+													} else {
+														p.failAt(false, start.position, "[3-9]")
+													}
+													if p.debug {
+														p.out("parseCharClassMatcher")
+													}
+													p.compiledMemoize(pt30, 102, nil, match19)
+												}
+											}
+											if match19 {
+												p.incChoiceAltCnt(position{line: 16, col: 24, offset: 537}, altI)
+											}
+											if !match19 {
+												p.restoreState(state28)
+											}
+										case 3:
+											state31 := p.cloneState()
+											{
+												if res32, hit := p.compiledMemoized(103); hit {
+													_, match19 = res32.v, res32.b
+												} else {
+													pt33 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseCharClassMatcher")
+													}
+													cur := p.pt.rn
+													start := p.pt
+													var matched bool
+													// can't match EOF
+													eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+													if !eof {
+														switch {
+														case unicode.Is(compiledClasses[0], cur):
+															matched = true
+														}
+													}
+													if matched && !eof {
+														p.read()
+														p.failAt(true, start.position, "[\\pN]")
+														match19 = true
+													} else {
+														p.failAt(false, start.position, "[\\pN]")
+													}
+													if p.debug {
+														p.out("parseCharClassMatcher")
+													}
+													p.compiledMemoize(pt33, 103, nil, match19)
+												}
+											}
+											if match19 {
+												p.incChoiceAltCnt(position{line: 16, col: 24, offset: 537}, altI)
+											}
+											if !match19 {
+												p.restoreState(state31)
+											}
+										}
+										if match19 {
+											break
+										}
+									}
+									if !match19 {
+										p.incChoiceAltCnt(position{line: 16, col: 24, offset: 537}, choiceNoMatch)
+									}
+									if p.debug {
+										p.out("parseChoiceExpr")
+									}
+									p.compiledMemoize(pt21, 99, nil, match19)
+								}
+							}
+							p.restoreState(state18)
+							p.restore(pt17)
+							match14 = match19
+							if p.debug {
+								p.out("parseAndExpr")
+							}
+							p.compiledMemoize(pt16, 98, v13, match14)
+						}
+					}
+					if match14 {
+						var v34 any
+						var match35 bool
+						{
+							if res36, hit := p.compiledMemoized(104); hit {
+								v34, match35 = res36.v, res36.b
+							} else {
+								pt37 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseAnyMatcher")
+								}
+								if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+									// EOF - see utf8.DecodeRune
+									p.failAt(false, p.pt.position, ".")
+								} else {
+									start := p.pt
+									p.read()
+									p.failAt(true, start.position, ".")
+									v34 = p.sliceFrom(start)
+									match35 = true
+								}
+								if p.debug {
+									p.out("parseAnyMatcher")
+								}
+								p.compiledMemoize(pt37, 104, v34, match35)
+							}
+						}
+						if match35 {
+							val = []any{v5, v9, v13, v34}
+							ok = true
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state4)
+				p.restore(pt3)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+			p.compiledMemoize(pt2, 95, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr105() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(105); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			var v5 any
+			var match6 bool
+			{
+				if res7, hit := p.compiledMemoized(106); hit {
+					v5, match6 = res7.v, res7.b
+				} else {
+					pt8 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLitMatcher")
+					}
+					start := p.pt
+					if end := p.pt.offset + 6; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "case11" {
+						for i := 0; i < 6; i++ {
+							p.read()
+						}
+						p.failAt(true, start.position, "\"case11\"")
+						v5 = p.sliceFrom(start)
+						match6 = true
+					} else {
+						p.failAt(false, start.position, "\"case11\"")
+					}
+					if p.debug {
+						p.out("parseLitMatcher")
+					}
+					p.compiledMemoize(pt8, 106, v5, match6)
+				}
+			}
+			if match6 {
+				var v9 any
+				var match10 bool
+				{
+					if res11, hit := p.compiledMemoized(107); hit {
+						v9, match10 = res11.v, res11.b
+					} else {
+						pt12 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseRuleRefExpr __")
+						}
+						v9, match10 = p.parseRuleWrap(g.rules[17])
+						if p.debug {
+							p.out("parseRuleRefExpr __")
+						}
+						p.compiledMemoize(pt12, 107, v9, match10)
+					}
+				}
+				if match10 {
+					var v13 any
+					var match14 bool
+					{
+						if res15, hit := p.compiledMemoized(108); hit {
+							v13, match14 = res15.v, res15.b
+						} else {
+							pt16 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseNotExpr")
+							}
+							pt17 := p.pt
+							state18 := p.cloneState()
+							p.maxFailInvertExpected = !p.maxFailInvertExpected
+							var match19 bool
+							{
+								if res20, hit := p.compiledMemoized(109); hit {
+									_, match19 = res20.v, res20.b
+								} else {
+									pt21 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseNotExpr")
+									}
+									pt22 := p.pt
+									state23 := p.cloneState()
+									p.maxFailInvertExpected = !p.maxFailInvertExpected
+									var match24 bool
+									{
+										if res25, hit := p.compiledMemoized(110); hit {
+											_, match24 = res25.v, res25.b
+										} else {
+											pt26 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseLitMatcher")
+											}
+											start := p.pt
+											if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "a" {
+												p.read()
+												p.failAt(true, start.position, "\"a\"")
+												match24 = true
+											} else {
+												p.failAt(false, start.position, "\"a\"")
+											}
+											if p.debug {
+												p.out("parseLitMatcher")
+											}
+											p.compiledMemoize(pt26, 110, nil, match24)
+										}
+									}
+									p.maxFailInvertExpected = !p.maxFailInvertExpected
+									p.restoreState(state23)
+									p.restore(pt22)
+									match19 = !match24
+									if p.debug {
+										p.out("parseNotExpr")
+									}
+									p.compiledMemoize(pt21, 109, nil, match19)
+								}
+							}
+							p.maxFailInvertExpected = !p.maxFailInvertExpected
+							p.restoreState(state18)
+							p.restore(pt17)
+							match14 = !match19
+							if p.debug {
+								p.out("parseNotExpr")
+							}
+							p.compiledMemoize(pt16, 108, v13, match14)
+						}
+					}
+					if match14 {
+						var v27 any
+						var match28 bool
+						{
+							if res29, hit := p.compiledMemoized(111); hit {
+								v27, match28 = res29.v, res29.b
+							} else {
+								pt30 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseLitMatcher")
+								}
+								start := p.pt
+								if end := p.pt.offset + 1; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "a" {
+									p.read()
+									p.failAt(true, start.position, "\"a\"")
+									v27 = p.sliceFrom(start)
+									match28 = true
+								} else {
+									p.failAt(false, start.position, "\"a\"")
+								}
+								if p.debug {
+									p.out("parseLitMatcher")
+								}
+								p.compiledMemoize(pt30, 111, v27, match28)
+							}
+						}
+						if match28 {
+							val = []any{v5, v9, v13, v27}
+							ok = true
+						}
+					}
+				}
+			}
+			if !ok {
+				p.restoreState(state4)
+				p.restore(pt3)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+			p.compiledMemoize(pt2, 105, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr112() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(112); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseLitMatcher")
+			}
+			start := p.pt
+			if end := p.pt.offset + 3; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "inc" {
+				for i := 0; i < 3; i++ {
+					p.read()
+				}
+				p.failAt(true, start.position, "\"inc\"")
+				val = p.sliceFrom(start)
+				ok = true
+			} else {
+				p.failAt(false, start.position, "\"inc\"")
+			}
+			if p.debug {
+				p.out("parseLitMatcher")
+			}
+			p.compiledMemoize(pt2, 112, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr113() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(113); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseLitMatcher")
+			}
+			start := p.pt
+			if end := p.pt.offset + 3; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "dec" {
+				for i := 0; i < 3; i++ {
+					p.read()
+				}
+				p.failAt(true, start.position, "\"dec\"")
+				val = p.sliceFrom(start)
+				ok = true
+			} else {
+				p.failAt(false, start.position, "\"dec\"")
+			}
+			if p.debug {
+				p.out("parseLitMatcher")
+			}
+			p.compiledMemoize(pt2, 113, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr114() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(114); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseLitMatcher")
+			}
+			start := p.pt
+			if end := p.pt.offset + 4; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "zero" {
+				for i := 0; i < 4; i++ {
+					p.read()
+				}
+				p.failAt(true, start.position, "\"zero\"")
+				val = p.sliceFrom(start)
+				ok = true
+			} else {
+				p.failAt(false, start.position, "\"zero\"")
+			}
+			if p.debug {
+				p.out("parseLitMatcher")
+			}
+			p.compiledMemoize(pt2, 114, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr115() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(115); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseLitMatcher")
+			}
+			start := p.pt
+			if end := p.pt.offset + 9; end <= len(p.data) && string(p.data[p.pt.offset:end]) == "oneOrMore" {
+				for i := 0; i < 9; i++ {
+					p.read()
+				}
+				p.failAt(true, start.position, "\"oneOrMore\"")
+				val = p.sliceFrom(start)
+				ok = true
+			} else {
+				p.failAt(false, start.position, "\"oneOrMore\"")
+			}
+			if p.debug {
+				p.out("parseLitMatcher")
+			}
+			p.compiledMemoize(pt2, 115, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr116() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(116); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseZeroOrMoreExpr")
+			}
+			var vals3 []any
+			for {
+				var v5 any
+				var match4 bool
+				{
+					if res6, hit := p.compiledMemoized(117); hit {
+						v5, match4 = res6.v, res6.b
+					} else {
+						pt7 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseCharClassMatcher")
+						}
+						cur := p.pt.rn
+						start := p.pt
+						var matched bool
+						// can't match EOF
+						eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+						if !eof {
+							switch cur {
+							case ' ', '\t', '\n', '\r':
+								matched = true
+							}
+						}
+						if matched && !eof {
+							p.read()
+							p.failAt(true, start.position, "[ \\t\\n\\r]")
+							v5 = p.sliceFrom(start)
+							match4 = true
+						} else {
+							p.failAt(false, start.position, "[ \\t\\n\\r]")
+						}
+						if p.debug {
+							p.out("parseCharClassMatcher")
+						}
+						p.compiledMemoize(pt7, 117, v5, match4)
+					}
+				}
+				if !match4 {
+					break
+				}
+				vals3 = append(vals3, v5)
+			}
+			val = vals3
+			ok = true
+			if p.debug {
+				p.out("parseZeroOrMoreExpr")
+			}
+			p.compiledMemoize(pt2, 116, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr118() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(118); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseCharClassMatcher")
+			}
+			cur := p.pt.rn
+			start := p.pt
+			var matched bool
+			// can't match EOF
+			eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+			if !eof {
+				switch cur {
+				case ' ':
+					matched = true
+				}
+			}
+			if matched && !eof {
+				p.read()
+				p.failAt(true, start.position, "[ ]")
+				val = p.sliceFrom(start)
+				ok = true
+			} else {
+				p.failAt(false, start.position, "[ ]")
+			}
+			if p.debug {
+				p.out("parseCharClassMatcher")
+			}
+			p.compiledMemoize(pt2, 118, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr119() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(119); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseNotExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			p.maxFailInvertExpected = !p.maxFailInvertExpected
+			var match5 bool
+			{
+				if res6, hit := p.compiledMemoized(120); hit {
+					_, match5 = res6.v, res6.b
+				} else {
+					pt7 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseAnyMatcher")
+					}
+					if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+						// EOF - see utf8.DecodeRune
+						p.failAt(false, p.pt.position, ".")
+					} else {
+						start := p.pt
+						p.read()
+						p.failAt(true, start.position, ".")
+						match5 = true
+					}
+					if p.debug {
+						p.out("parseAnyMatcher")
+					}
+					p.compiledMemoize(pt7, 120, nil, match5)
+				}
+			}
+			p.maxFailInvertExpected = !p.maxFailInvertExpected
+			p.restoreState(state4)
+			p.restore(pt3)
+			ok = !match5
+			if p.debug {
+				p.out("parseNotExpr")
+			}
+			p.compiledMemoize(pt2, 119, val, ok)
+		}
+	}
+	return val, ok
+}
+
+var expr21Dispatch = &choiceDispatch{
+	ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+	ranges:    []rune{'\u0080'},
+	rangeSets: "\x00",
+	alts:      [][]int{{}, {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+	expected:  [][]string{{"\"case01\"", "\"case02\"", "\"case03\"", "\"case04\"", "\"case05\"", "\"case06\"", "\"case07\"", "\"case08\"", "\"case09\"", "\"case10\"", "\"case11\""}, {}},
+}
+
+var expr39Dispatch = &choiceDispatch{
+	ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00",
+	ranges:    []rune{'\u0080'},
+	rangeSets: "\x00",
+	alts:      [][]int{{}, {1}, {0}, {2}},
+	expected:  [][]string{{"\"inc\"", "\"dec\"", "\"zero\""}, {"\"inc\"", "\"zero\""}, {"\"dec\"", "\"zero\""}, {"\"inc\"", "\"dec\""}},
+}
+
+var expr78Dispatch = &choiceDispatch{
+	ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x02\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x00\x00\x00\x00\x00\x00\x01\x02\x02\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x00\x00\x00\x00\x00",
+	ranges:    []rune{'\u0080', 'ª', '«', 'µ', '¶', 'º', '»', 'À', '×', 'Ø', '÷', 'ø', '˂', 'ˆ', '˒', 'ˠ', '˥', 'ˬ', '˭', 'ˮ', '˯', 'Ͱ', '͵', 'Ͷ', '\u0378', 'ͺ', ';', 'Ϳ', '\u0380', 'Ά', '·', 'Έ', '\u038b', 'Ό', '\u038d', 'Ύ', '\u03a2', 'Σ', '϶', 'Ϸ', '҂', 'Ҋ', '\u0530', 'Ա', '\u0557', 'ՙ', '՚', 'ՠ', '։', 'א', '\u05eb', 'ׯ', '׳', 'ؠ', 'ً', 'ٮ', 'ٰ', 'ٱ', '۔', 'ە', 'ۖ', 'ۥ', 'ۧ', 'ۮ', '۰', 'ۺ', '۽', 'ۿ', '܀', 'ܐ', 'ܑ', 'ܒ', 'ܰ', 'ݍ', 'ަ', 'ޱ', '\u07b2', 'ߊ', '߫', 'ߴ', '߶', 'ߺ', '\u07fb', 'ࠀ', 'ࠖ', 'ࠚ', 'ࠛ', 'ࠤ', 'ࠥ', 'ࠨ', 'ࠩ', 'ࡀ', '࡙', 'ࡠ', '\u086b', 'ࡰ', '࢈', 'ࢉ', '\u0890', 'ࢠ', '࣊', 'ऄ', 'ऺ', 'ऽ', 'ा', 'ॐ', '॑', 'क़', 'ॢ', 'ॱ', 'ঁ', 'অ', '\u098d', 'এ', '\u0991', 'ও', '\u09a9', 'প', '\u09b1', 'ল', '\u09b3', 'শ', '\u09ba', 'ঽ', 'া', 'ৎ', '\u09cf', 'ড়', '\u09de', 'য়', 'ৢ', 'ৰ', '৲', 'ৼ', '৽', 'ਅ', '\u0a0b', 'ਏ', '\u0a11', 'ਓ', '\u0a29', 'ਪ', '\u0a31', 'ਲ', '\u0a34', 'ਵ', '\u0a37', 'ਸ', '\u0a3a', 'ਖ਼', '\u0a5d', 'ਫ਼', '\u0a5f', 'ੲ', 'ੵ', 'અ', '\u0a8e', 'એ', '\u0a92', 'ઓ', '\u0aa9', 'પ', '\u0ab1', 'લ', '\u0ab4', 'વ', '\u0aba', 'ઽ', 'ા', 'ૐ', '\u0ad1', 'ૠ', 'ૢ', 'ૹ', 'ૺ', 'ଅ', '\u0b0d', 'ଏ', '\u0b11', 'ଓ', '\u0b29', 'ପ', '\u0b31', 'ଲ', '\u0b34', 'ଵ', '\u0b3a', 'ଽ', 'ା', 'ଡ଼', '\u0b5e', 'ୟ', 'ୢ', 'ୱ', '୲', 'ஃ', '\u0b84', 'அ', '\u0b8b', 'எ', '\u0b91', 'ஒ', '\u0b96', 'ங', '\u0b9b', 'ஜ', '\u0b9d', 'ஞ', '\u0ba0', 'ண', '\u0ba5', 'ந', '\u0bab', 'ம', '\u0bba', 'ௐ', '\u0bd1', 'అ', '\u0c0d', 'ఎ', '\u0c11', 'ఒ', '\u0c29', 'ప', '\u0c3a', 'ఽ', 'ా', 'ౘ', '\u0c5b', '౜', '\u0c5e', 'ౠ', 'ౢ', 'ಀ', 'ಁ', 'ಅ', '\u0c8d', 'ಎ', '\u0c91', 'ಒ', '\u0ca9', 'ಪ', '\u0cb4', 'ವ', '\u0cba', 'ಽ', 'ಾ', '೜', '\u0cdf', 'ೠ', 'ೢ', 'ೱ', 'ೳ', 'ഄ', '\u0d0d', 'എ', '\u0d11', 'ഒ', '഻', 'ഽ', 'ാ', 'ൎ', '൏', 'ൔ', 'ൗ', 'ൟ', 'ൢ', 'ൺ', '\u0d80', 'අ', '\u0d97', 'ක', '\u0db2', 'ඳ', '\u0dbc', 'ල', '\u0dbe', 'ව', '\u0dc7', 'ก', 'ั', 'า', 'ิ', 'เ', '็', 'ກ', '\u0e83', 'ຄ', '\u0e85', 'ຆ', '\u0e8b', 'ຌ', '\u0ea4', 'ລ', '\u0ea6', 'ວ', 'ັ', 'າ', 'ິ', 'ຽ', '\u0ebe', 'ເ', '\u0ec5', 'ໆ', '\u0ec7', 'ໜ', '\u0ee0', 'ༀ', '༁', 'ཀ', '\u0f48', 'ཉ', '\u0f6d', 'ྈ', 'ྍ', 'က', 'ါ', 'ဿ', '၀', 'ၐ', 'ၖ', 'ၚ', 'ၞ', 'ၡ', 'ၢ', 'ၥ', 'ၧ', 'ၮ', 'ၱ', 'ၵ', 'ႂ', 'ႎ', 'ႏ', 'Ⴀ', '\u10c6', 'Ⴧ', '\u10c8', 'Ⴭ', '\u10ce', 'ა', '჻', 'ჼ', '\u1249', 'ቊ', '\u124e', 'ቐ', '\u1257', 'ቘ', '\u1259', 'ቚ', '\u125e', 'በ', '\u1289', 'ኊ', '\u128e', 'ነ', '\u12b1', 'ኲ', '\u12b6', 'ኸ', '\u12bf', 'ዀ', '\u12c1', 'ዂ', '\u12c6', 'ወ', '\u12d7', 'ዘ', '\u1311', 'ጒ', '\u1316', 'ጘ', '\u135b', 'ᎀ', '᎐', 'Ꭰ', '\u13f6', 'ᏸ', '\u13fe', 'ᐁ', '᙭', 'ᙯ', '\u1680', 'ᚁ', '᚛', 'ᚠ', '᛫', 'ᛱ', '\u16f9', 'ᜀ', 'ᜒ', 'ᜟ', 'ᜲ', 'ᝀ', 'ᝒ', 'ᝠ', '\u176d', 'ᝮ', '\u1771', 'ក', '឴', 'ៗ', '៘', 'ៜ', '៝', 'ᠠ', '\u1879', 'ᢀ', 'ᢅ', 'ᢇ', 'ᢩ', 'ᢪ', '\u18ab', 'ᢰ', '\u18f6', 'ᤀ', '\u191f', 'ᥐ', '\u196e', 'ᥰ', '\u1975', 'ᦀ', '\u19ac', 'ᦰ', '\u19ca', 'ᨀ', 'ᨗ', 'ᨠ', 'ᩕ', 'ᪧ', '᪨', 'ᬅ', '᬴', 'ᭅ', '\u1b4d', 'ᮃ', 'ᮡ', 'ᮮ', '᮰', 'ᮺ', '᯦', 'ᰀ', 'ᰤ', 'ᱍ', '᱐', 'ᱚ', '᱾', 'ᲀ', '\u1c8b', 'Ა', '\u1cbb', 'Ჽ', '᳀', 'ᳩ', '᳭', 'ᳮ', '᳴', 'ᳵ', '᳷', 'ᳺ', '\u1cfb', 'ᴀ', '᷀', 'Ḁ', '\u1f16', 'Ἐ', '\u1f1e', 'ἠ', '\u1f46', 'Ὀ', '\u1f4e', 'ὐ', '\u1f58', 'Ὑ', '\u1f5a', 'Ὓ', '\u1f5c', 'Ὕ', '\u1f5e', 'Ὗ', '\u1f7e', 'ᾀ', '\u1fb5', 'ᾶ', '᾽', 'ι', '᾿', 'ῂ', '\u1fc5', 'ῆ', '῍', 'ῐ', '\u1fd4', 'ῖ', '\u1fdc', 'ῠ', '῭', 'ῲ', '\u1ff5', 'ῶ', '´', 'ⁱ', '\u2072', 'ⁿ', '₀', 'ₐ', '\u209d', 'ℂ', '℃', 'ℇ', '℈', 'ℊ', '℔', 'ℕ', '№', 'ℙ', '℞', 'ℤ', '℥', 'Ω', '℧', 'ℨ', '℩', 'K', '℮', 'ℯ', '℺', 'ℼ', '⅀', 'ⅅ', '⅊', 'ⅎ', '⅏', 'Ↄ', 'ↅ', 'Ⰰ', '⳥', 'Ⳬ', '⳯', 'Ⳳ', '\u2cf4', 'ⴀ', '\u2d26', 'ⴧ', '\u2d28', 'ⴭ', '\u2d2e', 'ⴰ', '\u2d68', 'ⵯ', '⵰', 'ⶀ', '\u2d97', 'ⶠ', '\u2da7', 'ⶨ', '\u2daf', 'ⶰ', '\u2db7', 'ⶸ', '\u2dbf', 'ⷀ', '\u2dc7', 'ⷈ', '\u2dcf', 'ⷐ', '\u2dd7', 'ⷘ', '\u2ddf', 'ⸯ', '⸰', '々', '〇', '〱', '〶', '〻', '〽', 'ぁ', '\u3097', 'ゝ', '゠', 'ァ', '・', 'ー', '\u3100', 'ㄅ', '\u3130', 'ㄱ', '\u318f', 'ㆠ', '㇀', 'ㇰ', '㈀', '㐀', '䷀', '一', '\ua48d', 'ꓐ', '꓾', 'ꔀ', '꘍', 'ꘐ', '꘠', 'ꘪ', '\ua62c', 'Ꙁ', '꙯', 'ꙿ', 'ꚞ', 'ꚠ', 'ꛦ', 'ꜗ', '꜠', 'Ꜣ', '꞉', 'Ꞌ', '\ua7dd', '꟱', 'ꠂ', 'ꠃ', '꠆', 'ꠇ', 'ꠋ', 'ꠌ', 'ꠣ', 'ꡀ', '꡴', 'ꢂ', 'ꢴ', 'ꣲ', '꣸', 'ꣻ', '꣼', 'ꣽ', 'ꣿ', 'ꤊ', 'ꤦ', 'ꤰ', 'ꥇ', 'ꥠ', '\ua97d', 'ꦄ', '꦳', 'ꧏ', '꧐', 'ꧠ', 'ꧥ', 'ꧦ', '꧰', 'ꧺ', '\ua9ff', 'ꨀ', 'ꨩ', 'ꩀ', 'ꩃ', 'ꩄ', 'ꩌ', 'ꩠ', '꩷', 'ꩺ', 'ꩻ', 'ꩾ', 'ꪰ', 'ꪱ', 'ꪲ', 'ꪵ', 'ꪷ', 'ꪹ', 'ꪾ', 'ꫀ', '꫁', 'ꫂ', '\uaac3', 'ꫛ', '꫞', 'ꫠ', 'ꫫ', 'ꫲ', 'ꫵ', 'ꬁ', '\uab07', 'ꬉ', '\uab0f', 'ꬑ', '\uab17', 'ꬠ', '\uab27', 'ꬨ', '\uab2f', 'ꬰ', '꭛', 'ꭜ', '꭪', 'ꭰ', 'ꯣ', '가', '\ud7a4', 'ힰ', '\ud7c7', 'ퟋ', '\ud7fc', '豈', '\ufa6e', '並', '\ufada', 'ﬀ', '\ufb07', 'ﬓ', '\ufb18', 'יִ', 'ﬞ', 'ײַ', '﬩', 'שׁ', '\ufb37', 'טּ', '\ufb3d', 'מּ', '\ufb3f', 'נּ', '\ufb42', 'ףּ', '\ufb45', 'צּ', '﮲', 'ﯓ', '﴾', 'ﵐ', '﶐', 'ﶒ', '﷈', 'ﷰ', '﷼', 'ﹰ', '\ufe75', 'ﹶ', '\ufefd', 'Ａ', '［', 'ａ', '｛', 'ｦ', '\uffbf', 'ￂ', '\uffc8', 'ￊ', '\uffd0', 'ￒ', '\uffd8', 'ￚ', '\uffdd', '𐀀', '\U0001000c', '𐀍', '\U00010027', '𐀨', '\U0001003b', '𐀼', '\U0001003e', '𐀿', '\U0001004e', '𐁐', '\U0001005e', '𐂀', '\U000100fb', '𐊀', '\U0001029d', '𐊠', '\U000102d1', '𐌀', '𐌠', '𐌭', '𐍁', '𐍂', '𐍊', '𐍐', '𐍶', '𐎀', '\U0001039e', '𐎠', '\U000103c4', '𐏈', '𐏐', '𐐀', '\U0001049e', '𐒰', '\U000104d4', '𐓘', '\U000104fc', '𐔀', '\U00010528', '𐔰', '\U00010564', '𐕰', '\U0001057b', '𐕼', '\U0001058b', '𐖌', '\U00010593', '𐖔', '\U00010596', '𐖗', '\U000105a2', '𐖣', '\U000105b2', '𐖳', '\U000105ba', '𐖻', '\U000105bd', '𐗀', '\U000105f4', '𐘀', '\U00010737', '𐝀', '\U00010756', '𐝠', '\U00010768', '𐞀', '\U00010786', '𐞇', '\U000107b1', '𐞲', '\U000107bb', '𐠀', '\U00010806', '𐠈', '\U00010809', '𐠊', '\U00010836', '𐠷', '\U00010839', '𐠼', '\U0001083d', '𐠿', '\U00010856', '𐡠', '𐡷', '𐢀', '\U0001089f', '𐣠', '\U000108f3', '𐣴', '\U000108f6', '𐤀', '𐤖', '𐤠', '\U0001093a', '𐥀', '\U0001095a', '𐦀', '\U000109b8', '𐦾', '𐧀', '𐨀', '𐨁', '𐨐', '\U00010a14', '𐨕', '\U00010a18', '𐨙', '\U00010a36', '𐩠', '𐩽', '𐪀', '𐪝', '𐫀', '𐫈', '𐫉', '𐫥', '𐬀', '\U00010b36', '𐭀', '\U00010b56', '𐭠', '\U00010b73', '𐮀', '\U00010b92', '𐰀', '\U00010c49', '𐲀', '\U00010cb3', '𐳀', '\U00010cf3', '𐴀', '𐴤', '𐵊', '\U00010d66', '𐵯', '\U00010d86', '𐺀', '\U00010eaa', '𐺰', '\U00010eb2', '𐻂', '\U00010ec8', '𐼀', '𐼝', '𐼧', '\U00010f28', '𐼰', '𐽆', '𐽰', '𐾂', '𐾰', '𐿅', '𐿠', '\U00010ff7', '𑀃', '𑀸', '𑁱', '𑁳', '𑁵', '\U00011076', '𑂃', '𑂰', '𑃐', '\U000110e9', '𑄃', '𑄧', '𑅄', '𑅅', '𑅇', '\U00011148', '𑅐', '𑅳', '𑅶', '\U00011177', '𑆃', '𑆳', '𑇁', '𑇅', '𑇚', '𑇛', '𑇜', '𑇝', '𑈀', '\U00011212', '𑈓', '𑈬', '𑈿', '𑉁', '𑊀', '\U00011287', '𑊈', '\U00011289', '𑊊', '\U0001128e', '𑊏', '\U0001129e', '𑊟', '𑊩', '𑊰', '𑋟', '𑌅', '\U0001130d', '𑌏', '\U00011311', '𑌓', '\U00011329', '𑌪', '\U00011331', '𑌲', '\U00011334', '𑌵', '\U0001133a', '𑌽', '𑌾', '𑍐', '\U00011351', '𑍝', '𑍢', '𑎀', '\U0001138a', '𑎋', '\U0001138c', '𑎎', '\U0001138f', '𑎐', '\U000113b6', '𑎷', '𑎸', '𑏑', '𑏒', '𑏓', '𑏔', '𑐀', '𑐵', '𑑇', '𑑋', '𑑟', '\U00011462', '𑒀', '𑒰', '𑓄', '𑓆', '𑓇', '\U000114c8', '𑖀', '𑖯', '𑗘', '𑗜', '𑘀', '𑘰', '𑙄', '\U00011645', '𑚀', '𑚫', '𑚸', '𑚹', '𑜀', '\U0001171b', '𑝀', '\U00011747', '𑠀', '𑠬', '𑢠', '𑣠', '𑣿', '\U00011907', '𑤉', '\U0001190a', '𑤌', '\U00011914', '𑤕', '\U00011917', '𑤘', '𑤰', '𑤿', '𑥀', '𑥁', '𑥂', '𑦠', '\U000119a8', '𑦪', '𑧑', '𑧡', '𑧢', '𑧣', '𑧤', '𑨀', '𑨁', '𑨋', '𑨳', '𑨺', '𑨻', '𑩐', '𑩑', '𑩜', '𑪊', '𑪝', '𑪞', '𑪰', '\U00011af9', '𑯀', '𑯡', '𑰀', '\U00011c09', '𑰊', '𑰯', '𑱀', '𑱁', '𑱲', '\U00011c90', '𑴀', '\U00011d07', '𑴈', '\U00011d0a', '𑴋', '𑴱', '𑵆', '𑵇', '𑵠', '\U00011d66', '𑵧', '\U00011d69', '𑵪', '𑶊', '𑶘', '\U00011d99', '𑶰', '\U00011ddc', '𑻠', '𑻳', '𑼂', '𑼃', '𑼄', '\U00011f11', '𑼒', '𑼴', '𑾰', '\U00011fb1', '𒀀', '\U0001239a', '𒒀', '\U00012544', '𒾐', '𒿱', '𓀀', '\U00013430', '𓑁', '𓑇', '𓑠', '\U000143fb', '𔐀', '\U00014647', '𖄀', '𖄞', '𖠀', '\U00016a39', '𖩀', '\U00016a5f', '𖩰', '\U00016abf', '𖫐', '\U00016aee', '𖬀', '𖬰', '𖭀', '𖭄', '𖭣', '\U00016b78', '𖭽', '\U00016b90', '𖵀', '𖵭', '𖹀', '𖺀', '𖺠', '\U00016eb9', '𖺻', '\U00016ed4', '𖼀', '\U00016f4b', '𖽐', '𖽑', '𖾓', '\U00016fa0', '𖿠', '𖿢', '𖿣', '𖿤', '𖿲', '𖿴', '𗀀', '\U00018cd6', '𘳿', '\U00018d1f', '𘶀', '\U00018df3', '𚿰', '\U0001aff4', '𚿵', '\U0001affc', '𚿽', '\U0001afff', '𛀀', '\U0001b123', '𛄲', '\U0001b133', '𛅐', '\U0001b153', '𛅕', '\U0001b156', '𛅤', '\U0001b168', '𛅰', '\U0001b2fc', '𛰀', '\U0001bc6b', '𛱰', '\U0001bc7d', '𛲀', '\U0001bc89', '𛲐', '\U0001bc9a', '𝐀', '\U0001d455', '𝑖', '\U0001d49d', '𝒞', '\U0001d4a0', '𝒢', '\U0001d4a3', '𝒥', '\U0001d4a7', '𝒩', '\U0001d4ad', '𝒮', '\U0001d4ba', '𝒻', '\U0001d4bc', '𝒽', '\U0001d4c4', '𝓅', '\U0001d506', '𝔇', '\U0001d50b', '𝔍', '\U0001d515', '𝔖', '\U0001d51d', '𝔞', '\U0001d53a', '𝔻', '\U0001d53f', '𝕀', '\U0001d545', '𝕆', '\U0001d547', '𝕊', '\U0001d551', '𝕒', '\U0001d6a6', '𝚨', '𝛁', '𝛂', '𝛛', '𝛜', '𝛻', '𝛼', '𝜕', '𝜖', '𝜵', '𝜶', '𝝏', '𝝐', '𝝯', '𝝰', '𝞉', '𝞊', '𝞩', '𝞪', '𝟃', '𝟄', '\U0001d7cc', '𝼀', '\U0001df1f', '𝼥', '\U0001df2b', '𞀰', '\U0001e06e', '𞄀', '\U0001e12d', '𞄷', '\U0001e13e', '𞅎', '𞅏', '𞊐', '𞊮', '𞋀', '𞋬', '𞓐', '𞓬', '𞗐', '𞗮', '𞗰', '𞗱', '𞛀', '\U0001e6df', '𞛠', '𞛣', '𞛤', '𞛦', '𞛧', '𞛮', '𞛰', '𞛵', '𞛾', '\U0001e700', '𞟠', '\U0001e7e7', '𞟨', '\U0001e7ec', '𞟭', '\U0001e7ef', '𞟰', '\U0001e7ff', '𞠀', '\U0001e8c5', '𞤀', '𞥄', '𞥋', '\U0001e94c', '𞸀', '\U0001ee04', '𞸅', '\U0001ee20', '𞸡', '\U0001ee23', '𞸤', '\U0001ee25', '𞸧', '\U0001ee28', '𞸩', '\U0001ee33', '𞸴', '\U0001ee38', '𞸹', '\U0001ee3a', '𞸻', '\U0001ee3c', '𞹂', '\U0001ee43', '𞹇', '\U0001ee48', '𞹉', '\U0001ee4a', '𞹋', '\U0001ee4c', '𞹍', '\U0001ee50', '𞹑', '\U0001ee53', '𞹔', '\U0001ee55', '𞹗', '\U0001ee58', '𞹙', '\U0001ee5a', '𞹛', '\U0001ee5c', '𞹝', '\U0001ee5e', '𞹟', '\U0001ee60', '𞹡', '\U0001ee63', '𞹤', '\U0001ee65', '𞹧', '\U0001ee6b', '𞹬', '\U0001ee73', '𞹴', '\U0001ee78', '𞹹', '\U0001ee7d', '𞹾', '\U0001ee7f', '𞺀', '\U0001ee8a', '𞺋', '\U0001ee9c', '𞺡', '\U0001eea4', '𞺥', '\U0001eeaa', '𞺫', '\U0001eebc', '𠀀', '\U0002a6e0', '𪜀', '\U0002b81e', '𫠠', '\U0002ceae', '𬺰', '\U0002ebe1', '𮯰', '\U0002ee5e', '丽', '\U0002fa1e', '𰀀', '\U0003134b', '𱍐', '\U0003347a'},
+	rangeSets: "\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00",
+	alts:      [][]int{{}, {0, 1, 2}, {1, 2}, {2}},
+	expected:  [][]string{{"\"abc\"i", "[a-c]i", "[\\pL]"}, {}, {"\"abc\"i"}, {"\"abc\"i", "[a-c]i"}},
+}
+
+var expr99Dispatch = &choiceDispatch{
+	ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x02\x03\x03\x03\x03\x03\x03\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+	ranges:    []rune{'\u0080', '²', '´', '¹', 'º', '¼', '¿', '٠', '٪', '۰', 'ۺ', '߀', 'ߊ', '०', '॰', '০', 'ৰ', '৴', '৺', '੦', 'ੰ', '૦', '૰', '୦', '୰', '୲', '\u0b78', '௦', '௳', '౦', '\u0c70', '౸', '౿', '೦', '\u0cf0', '൘', 'ൟ', '൦', '൹', '෦', '\u0df0', '๐', '๚', '໐', '\u0eda', '༠', '༴', '၀', '၊', '႐', 'ႚ', '፩', '\u137d', 'ᛮ', 'ᛱ', '០', '\u17ea', '៰', '\u17fa', '᠐', '\u181a', '᥆', 'ᥐ', '᧐', '\u19db', '᪀', '\u1a8a', '᪐', '\u1a9a', '᭐', '᭚', '᮰', 'ᮺ', '᱀', '\u1c4a', '᱐', 'ᱚ', '⁰', 'ⁱ', '⁴', '⁺', '₀', '₊', '⅐', 'Ↄ', 'ↅ', '↊', '①', '⒜', '⓪', '─', '❶', '➔', '⳽', '⳾', '〇', '〈', '〡', '〪', '〸', '〻', '㆒', '㆖', '㈠', '㈪', '㉈', '㉐', '㉑', '㉠', '㊀', '㊊', '㊱', '㋀', '꘠', 'ꘪ', 'ꛦ', '꛰', '꠰', '꠶', '꣐', '\ua8da', '꤀', 'ꤊ', '꧐', '\ua9da', '꧰', 'ꧺ', '꩐', '\uaa5a', '꯰', '\uabfa', '０', '：', '𐄇', '\U00010134', '𐅀', '𐅹', '𐆊', '𐆌', '𐋡', '\U000102fc', '𐌠', '\U00010324', '𐍁', '𐍂', '𐍊', '\U0001034b', '𐏑', '\U000103d6', '𐒠', '\U000104aa', '𐡘', '𐡠', '𐡹', '𐢀', '𐢧', '\U000108b0', '𐣻', '𐤀', '𐤖', '\U0001091c', '𐦼', '𐦾', '𐧀', '\U000109d0', '𐧒', '𐨀', '𐩀', '\U00010a49', '𐩽', '𐩿', '𐪝', '\U00010aa0', '𐫫', '𐫰', '𐭘', '𐭠', '𐭸', '𐮀', '𐮩', '\U00010bb0', '𐳺', '𐴀', '𐴰', '\U00010d3a', '𐵀', '𐵊', '𐹠', '\U00010e7f', '𐼝', '𐼧', '𐽑', '𐽕', '𐿅', '\U00010fcc', '𑁒', '𑁰', '𑃰', '\U000110fa', '𑄶', '𑅀', '𑇐', '𑇚', '𑇡', '\U000111f5', '𑋰', '\U000112fa', '𑑐', '𑑚', '𑓐', '\U000114da', '𑙐', '\U0001165a', '𑛀', '\U000116ca', '𑛐', '\U000116e4', '𑜰', '𑜼', '𑣠', '\U000118f3', '𑥐', '\U0001195a', '𑯰', '\U00011bfa', '𑱐', '\U00011c6d', '𑵐', '\U00011d5a', '𑶠', '\U00011daa', '𑷠', '\U00011dea', '𑽐', '𑽚', '𑿀', '𑿕', '𒐀', '\U0001246f', '𖄰', '\U0001613a', '𖩠', '\U00016a6a', '𖫀', '\U00016aca', '𖭐', '\U00016b5a', '𖭛', '\U00016b62', '𖵰', '\U00016d7a', '𖺀', '𖺗', '𖿴', '\U00016ff7', '𜳰', '𜳺', '𝋀', '\U0001d2d4', '𝋠', '\U0001d2f4', '𝍠', '\U0001d379', '𝟎', '𝠀', '𞅀', '\U0001e14a', '𞋰', '\U0001e2fa', '𞓰', '\U0001e4fa', '𞗱', '\U0001e5fb', '𞣇', '𞣐', '𞥐', '\U0001e95a', '𞱱', '𞲬', '𞲭', '𞲰', '𞲱', '\U0001ecb5', '𞴁', '𞴮', '𞴯', '\U0001ed3e', '🄀', '🄍', '🯰', '🯺'},
+	rangeSets: "\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00",
+	alts:      [][]int{{}, {0, 1, 3}, {1, 3}, {2, 3}, {3}},
+	expected:  [][]string{{"\"0\"", "[012]", "[3-9]", "[\\pN]"}, {"[3-9]"}, {"\"0\"", "[3-9]"}, {"\"0\"", "[012]"}, {"\"0\"", "[012]", "[3-9]"}},
+}
+
+var compiledClasses = []*unicode.RangeTable{
+	rangeTable("N"),
+	rangeTable("L"),
+}
+
+var (
+	// errNoRule is returned when the grammar to parse has no rule.
+	errNoRule = errors.New("grammar has no rule")
+
+	// errInvalidEntrypoint is returned when the specified entrypoint rule
+	// does not exit.
+	errInvalidEntrypoint = errors.New("invalid entrypoint")
+
+	// errInvalidEncoding is returned when the source is not properly
+	// utf8-encoded.
+	errInvalidEncoding = errors.New("invalid encoding")
+
+	// errMaxExprCnt is used to signal that the maximum number of
+	// expressions have been parsed.
+	errMaxExprCnt = errors.New("max number of expressions parsed")
+)
+
+// Option is a function that can set an option on the parser. It returns
+// the previous setting as an Option.
+type Option func(*parser) Option
+
+// MaxExpressions creates an Option to stop parsing after the provided
+// number of expressions have been parsed, if the value is 0 then the parser will
+// parse for as many steps as needed (possibly an infinite number).
+//
+// The default for maxExprCnt is 0.
+func MaxExpressions(maxExprCnt uint64) Option {
+	return func(p *parser) Option {
+		oldMaxExprCnt := p.maxExprCnt
+		p.maxExprCnt = maxExprCnt
+		return MaxExpressions(oldMaxExprCnt)
+	}
+}
+
+// Entrypoint creates an Option to set the rule name to use as entrypoint.
+// The rule name must have been specified in the -alternate-entrypoints
+// if generating the parser with the -optimize-grammar flag, otherwise
+// it may have been optimized out. Passing an empty string sets the
+// entrypoint to the first rule in the grammar.
+//
+// The default is to start parsing at the first rule in the grammar.
+func Entrypoint(ruleName string) Option {
+	return func(p *parser) Option {
+		oldEntrypoint := p.entrypoint
+		p.entrypoint = ruleName
+		if ruleName == "" {
+			p.entrypoint = g.rules[0].name
+		}
+		return Entrypoint(oldEntrypoint)
+	}
+}
+
+// Statistics adds a user provided Stats struct to the parser to allow
+// the user to process the results after the parsing has finished.
+// Also the key for the "no match" counter is set. Per-rule statistics
+// (see RuleStats) are only collected when this option is set.
+//
+// Example usage:
+//
+//	input := "input"
+//	stats := Stats{}
+//	_, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	fmt.Println(string(b))
+func Statistics(stats *Stats, choiceNoMatch string) Option {
+	return func(p *parser) Option {
+		oldStats := p.Stats
+		p.Stats = stats
+		oldChoiceNoMatch := p.choiceNoMatch
+		p.choiceNoMatch = choiceNoMatch
+		if p.Stats.ChoiceAltCnt == nil {
+			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
+		}
+		if p.Stats.Rules == nil {
+			p.Stats.Rules = make(map[string]*RuleStats)
+		}
+		return Statistics(oldStats, oldChoiceNoMatch)
+	}
+}
+
+// Debug creates an Option to set the debug flag to b. When set to true,
+// debugging information is printed to stdout while parsing.
+//
+// The default is false.
+func Debug(b bool) Option {
+	return func(p *parser) Option {
+		old := p.debug
+		p.debug = b
+		return Debug(old)
+	}
+}
+
+// Memoize creates an Option to set the memoize flag to b. When set to true,
+// the parser will cache all results so each expression is evaluated only
+// once. This guarantees linear parsing time even for pathological cases,
+// at the expense of more memory and slower times for typical cases.
+// The rules annotated with @memo in the grammar are always memoized,
+// regardless of this option. The memoization table is pooled, so its
+// storage is reused by the following parses.
+//
+// The default is false.
+func Memoize(b bool) Option {
+	return func(p *parser) Option {
+		old := p.memoize
+		p.memoize = b
+		return Memoize(old)
+	}
+}
+
+// AllowInvalidUTF8 creates an Option to allow invalid UTF-8 bytes.
+// Every invalid UTF-8 byte is treated as a utf8.RuneError (U+FFFD)
+// by character class matchers and is matched by the any matcher.
+// The returned matched value, c.text and c.offset are NOT affected.
+//
+// The default is false.
+func AllowInvalidUTF8(b bool) Option {
+	return func(p *parser) Option {
+		old := p.allowInvalidUTF8
+		p.allowInvalidUTF8 = b
+		return AllowInvalidUTF8(old)
+	}
+}
+
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
+// Recover creates an Option to set the recover flag to b. When set to
+// true, this causes the parser to recover from panics and convert it
+// to an error. Setting it to false can be useful while debugging to
+// access the full stack trace.
+//
+// The default is true.
+func Recover(b bool) Option {
+	return func(p *parser) Option {
+		old := p.recover
+		p.recover = b
+		return Recover(old)
+	}
+}
+
+// GlobalStore creates an Option to set a key to a certain value in
+// the globalStore.
+func GlobalStore(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.globalStore[key]
+		p.cur.globalStore[key] = value
+		return GlobalStore(key, old)
+	}
+}
+
+// InitState creates an Option to set a key to a certain value in
+// the global "state" store.
+func InitState(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.state[key]
+		p.cur.state[key] = value
+		return InitState(key, old)
+	}
+}
+
+// ParseFile parses the file identified by filename.
+func ParseFile(filename string, opts ...Option) (i any, err error) { // nolint: deadcode
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+	return ParseReader(filename, f, opts...)
+}
+
+// ParseReader parses the data from r using filename as information in the
+// error messages.
+func ParseReader(filename string, r io.Reader, opts ...Option) (any, error) { // nolint: deadcode
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(filename, b, opts...)
+}
+
+// Parse parses the data from b using filename as information in the
+// error messages.
+func Parse(filename string, b []byte, opts ...Option) (any, error) {
+	return newParser(filename, b, opts...).parse(g)
+}
+
+// position records a position in the text.
+type position struct {
+	line, col, offset int
+}
+
+func (p position) String() string {
+	return strconv.Itoa(p.line) + ":" + strconv.Itoa(p.col) + " [" + strconv.Itoa(p.offset) + "]"
+}
+
+// savepoint stores all state required to go back to this point in the
+// parser.
+type savepoint struct {
+	position
+	rn rune
+	w  int
+}
+
+type current struct {
+	pos  position // start position of the match
+	text []byte   // raw text of the match
+
+	// state is a store for arbitrary key,value pairs that the user wants to be
+	// tied to the backtracking of the parser.
+	// This is always rolled back if a parsing rule fails.
+	state storeDict
+
+	// globalStore is a general store for the user to store arbitrary key-value
+	// pairs that they need to manage and that they do not want tied to the
+	// backtracking of the parser. This is only modified by the user and never
+	// rolled back by the parser. It is always up to the user to keep this in a
+	// consistent state.
+	globalStore storeDict
+}
+
+type storeDict map[string]any
+
+// the AST types...
+
+// nolint: structcheck
+type grammar struct {
+	pos   position
+	rules []*rule
+}
+
+// nolint: structcheck
+type rule struct {
+	pos         position
+	name        string
+	displayName string
+	run         func(*parser) (any, bool)
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+//
+//	nolint: structcheck
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
+}
+
+// matchCaseFold matches the full case folding of the input against val,
+// the case folding of a case-insensitive literal. The input is consumed
+// if it matches, it must be restored otherwise.
+func (p *parser) matchCaseFold(val string) bool {
+	for len(val) > 0 {
+		if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+			// EOF - see utf8.DecodeRune
+			return false
+		}
+		if f, ok := fullCaseFolds[p.pt.rn]; ok {
+			if !strings.HasPrefix(val, f) {
+				return false
+			}
+			val = val[len(f):]
+		} else {
+			want, n := utf8.DecodeRuneInString(val)
+			if caseFold(p.pt.rn) != want {
+				return false
+			}
+			val = val[n:]
+		}
+		p.read()
+	}
+	return true
+}
+
+// caseFold returns the simple case folding of rn, the smallest lowercase
+// rune of its orbit for unicode.SimpleFold, or its smallest rune if it has
+// no lowercase rune.
+func caseFold(rn rune) rune {
+	if rn < utf8.RuneSelf {
+		if 'A' <= rn && rn <= 'Z' {
+			rn += 'a' - 'A'
+		}
+		return rn
+	}
+
+	folded := rn
+	for r := unicode.SimpleFold(rn); r != rn; r = unicode.SimpleFold(r) {
+		if lower := unicode.IsLower(r); lower != unicode.IsLower(folded) {
+			if lower {
+				folded = r
+			}
+		} else if r < folded {
+			folded = r
+		}
+	}
+	return folded
+}
+
+// errList cumulates the errors found by the parser.
+type errList []error
+
+func (e *errList) add(err error) {
+	*e = append(*e, err)
+}
+
+func (e errList) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	e.dedupe()
+	return e
+}
+
+func (e *errList) dedupe() {
+	var cleaned []error
+	set := make(map[string]bool)
+	for _, err := range *e {
+		if msg := err.Error(); !set[msg] {
+			set[msg] = true
+			cleaned = append(cleaned, err)
+		}
+	}
+	*e = cleaned
+}
+
+func (e errList) Error() string {
+	switch len(e) {
+	case 0:
+		return ""
+	case 1:
+		return e[0].Error()
+	default:
+		var buf bytes.Buffer
+
+		for i, err := range e {
+			if i > 0 {
+				buf.WriteRune('\n')
+			}
+			buf.WriteString(err.Error())
+		}
+		return buf.String()
+	}
+}
+
+// parserError wraps an error with a prefix indicating the rule in which
+// the error occurred. The original error is stored in the Inner field.
+type parserError struct {
+	Inner    error
+	pos      position
+	prefix   string
+	expected []string
+}
+
+// Error returns the error message.
+func (p *parserError) Error() string {
+	return p.prefix + ": " + p.Inner.Error()
+}
+
+// newParser creates a parser with the specified input source and options.
+func newParser(filename string, b []byte, opts ...Option) *parser {
+	stats := Stats{
+		ChoiceAltCnt: make(map[string]map[string]int),
+	}
+
+	p := &parser{
+		filename: filename,
+		errs:     new(errList),
+		data:     b,
+		pt:       savepoint{position: position{line: 1}},
+		recover:  true,
+		cur: current{
+			state:       make(storeDict),
+			globalStore: make(storeDict),
+		},
+		maxFailPos:      position{col: 1, line: 1},
+		maxFailExpected: make([]string, 0, 20),
+		Stats:           &stats,
+		// start rule is rule [0] unless an alternate entrypoint is specified
+		entrypoint: g.rules[0].name,
+	}
+	p.setOptions(opts)
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
+
+	if p.maxExprCnt == 0 {
+		p.maxExprCnt = math.MaxUint64
+	}
+
+	return p
+}
+
+// setOptions applies the options to the parser.
+func (p *parser) setOptions(opts []Option) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// nolint: structcheck,deadcode
+type resultTuple struct {
+	v   any
+	b   bool
+	end savepoint
+}
+
+// nolint: varcheck
+const choiceNoMatch = -1
+
+// Stats stores some statistics, gathered during parsing
+type Stats struct {
+	// ExprCnt counts the number of expressions processed during parsing
+	// This value is compared to the maximum number of expressions allowed
+	// (set by the MaxExpressions option).
+	ExprCnt uint64
+
+	// ChoiceAltCnt is used to count for each ordered choice expression,
+	// which alternative is used how may times.
+	// These numbers allow to optimize the order of the ordered choice expression
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
+	//
+	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
+	// as the line and the column of the ordered choice.
+	// The inner key of ChoiceAltCnt is the number (one-based) of the matching alternative.
+	// For each alternative the number of matches are counted. If an ordered choice does not
+	// match, a special counter is incremented. The name of this counter is set with
+	// the parser option Statistics.
+	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
+	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
+}
+
+// nolint: structcheck,maligned
+type parser struct {
+	filename string
+	pt       savepoint
+	cur      current
+
+	data []byte
+	errs *errList
+
+	depth   int
+	recover bool
+	debug   bool
+
+	memoize bool
+	// memoization table for the packrat algorithm, taken from memoTables
+	// on the first memoized result
+	memo *memoTable
+
+	// rules table, maps the rule identifier to the rule node
+	rules map[string]*rule
+	// variables stack, map of label to value
+	vstack []map[string]any
+	// rule stack, allows identification of the current rule in errors
+	rstack []*rule
+
+	// parse fail
+	maxFailPos            position
+	maxFailExpected       []string
+	maxFailInvertExpected bool
+
+	// max number of expressions to be parsed
+	maxExprCnt uint64
+	// entrypoint for the parser
+	entrypoint string
+
+	allowInvalidUTF8 bool
+
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+
+	*Stats
+	// profiling frames, tracks the time spent in invoked rules to compute
+	// the self time of each rule.
+	profStack []profFrame
+	// number of active invocations of each rule, the time of a recursive
+	// rule is only added at its outermost invocation.
+	profActive map[*rule]int
+	// rules evaluated at each offset, to count the repeated evaluations.
+	profEvals map[profEval]bool
+
+	choiceNoMatch string
+	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
+}
+
+// push a variable set on the vstack.
+func (p *parser) pushV() {
+	if cap(p.vstack) == len(p.vstack) {
+		// create new empty slot in the stack
+		p.vstack = append(p.vstack, nil)
+	} else {
+		// slice to 1 more
+		p.vstack = p.vstack[:len(p.vstack)+1]
+	}
+
+	// get the last args set
+	m := p.vstack[len(p.vstack)-1]
+	if m != nil && len(m) == 0 {
+		// empty map, all good
+		return
+	}
+
+	m = make(map[string]any)
+	p.vstack[len(p.vstack)-1] = m
+}
+
+// pop a variable set from the vstack.
+func (p *parser) popV() {
+	// if the map is not empty, clear it
+	m := p.vstack[len(p.vstack)-1]
+	if len(m) > 0 {
+		// GC that map
+		p.vstack[len(p.vstack)-1] = nil
+	}
+	p.vstack = p.vstack[:len(p.vstack)-1]
+}
+
+// push a recovery expression with its labels to the recoveryStack
+func (p *parser) pushRecovery(labels []string, expr any) {
+	if cap(p.recoveryStack) == len(p.recoveryStack) {
+		// create new empty slot in the stack
+		p.recoveryStack = append(p.recoveryStack, recovery{})
+	} else {
+		// slice to 1 more
+		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
+	}
+
+	m := make(map[string]any, len(labels))
+	for _, fl := range labels {
+		m[fl] = expr
+	}
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
+}
+
+// pop a recovery expression from the recoveryStack
+func (p *parser) popRecovery() {
+	// GC that map
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
+
+	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
+}
+
+func (p *parser) print(prefix, s string) string {
+	if !p.debug {
+		return s
+	}
+
+	pos := p.pt.position
+	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
+		prefix, pos.line, pos.col, pos.offset, s, p.pt.rn)
+	return s
+}
+
+func (p *parser) printIndent(mark string, s string) string {
+	return p.print(strings.Repeat(" ", p.depth)+mark, s)
+}
+
+func (p *parser) in(s string) string {
+	res := p.printIndent(">", s)
+	p.depth++
+	return res
+}
+
+func (p *parser) out(s string) string {
+	p.depth--
+	return p.printIndent("<", s)
+}
+
+func (p *parser) addErr(err error) {
+	p.addErrAt(err, p.pt.position, []string{})
+}
+
+func (p *parser) addErrAt(err error, pos position, expected []string) {
+	var buf bytes.Buffer
+	if p.filename != "" {
+		buf.WriteString(p.filename)
+	}
+	if buf.Len() > 0 {
+		buf.WriteString(":")
+	}
+	buf.WriteString(fmt.Sprintf("%d:%d (%d)", pos.line, pos.col, pos.offset))
+	if len(p.rstack) > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString(": ")
+		}
+		rule := p.rstack[len(p.rstack)-1]
+		if rule.displayName != "" {
+			buf.WriteString("rule " + rule.displayName)
+		} else {
+			buf.WriteString("rule " + rule.name)
+		}
+	}
+	pe := &parserError{Inner: err, pos: pos, prefix: buf.String(), expected: expected}
+	p.errs.add(pe)
+}
+
+func (p *parser) failAt(fail bool, pos position, want string) {
+	// process fail if parsing fails and not inverted or parsing succeeds and invert is set
+	if fail == p.maxFailInvertExpected {
+		if pos.offset < p.maxFailPos.offset {
+			return
+		}
+
+		if pos.offset > p.maxFailPos.offset {
+			p.maxFailPos = pos
+			p.maxFailExpected = p.maxFailExpected[:0]
+		}
+
+		if p.maxFailInvertExpected {
+			want = "!" + want
+		}
+		p.maxFailExpected = append(p.maxFailExpected, want)
+	}
+}
+
+// read advances the parser to the next rune.
+func (p *parser) read() {
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
+	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
+	p.pt.rn = rn
+	p.pt.w = n
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
+	}
+
+	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
+		if !p.allowInvalidUTF8 {
+			p.addErr(errInvalidEncoding)
+		}
+	}
+}
+
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
+// restore parser position to the savepoint pt.
+func (p *parser) restore(pt savepoint) {
+	if p.debug {
+		defer p.out(p.in("restore"))
+	}
+	if pt.offset == p.pt.offset {
+		return
+	}
+	if p.Rules != nil && pt.offset < p.pt.offset && len(p.rstack) > 0 {
+		p.ruleStats(p.rstack[len(p.rstack)-1]).Backtracked += uint64(p.pt.offset - pt.offset)
+	}
+	p.pt = pt
+}
+
+// Cloner is implemented by any value that has a Clone method, which returns a
+// copy of the value. This is mainly used for types which are not passed by
+// value (e.g map, slice, chan) or structs that contain such types.
+//
+// This is used in conjunction with the global state feature to create proper
+// copies of the state to allow the parser to properly restore the state in
+// the case of backtracking.
+type Cloner interface {
+	Clone() any
+}
+
+var statePool = &sync.Pool{
+	New: func() any { return make(storeDict) },
+}
+
+func (sd storeDict) Discard() {
+	for k := range sd {
+		delete(sd, k)
+	}
+	statePool.Put(sd)
+}
+
+// clone and return parser current state.
+func (p *parser) cloneState() storeDict {
+	if p.debug {
+		defer p.out(p.in("cloneState"))
+	}
+
+	state := statePool.Get().(storeDict)
+	for k, v := range p.cur.state {
+		if c, ok := v.(Cloner); ok {
+			state[k] = c.Clone()
+		} else {
+			state[k] = v
+		}
+	}
+	return state
+}
+
+// restore parser current state to the state storeDict.
+// every restoreState should applied only one time for every cloned state
+func (p *parser) restoreState(state storeDict) {
+	if p.debug {
+		defer p.out(p.in("restoreState"))
+	}
+	p.cur.state.Discard()
+	p.cur.state = state
+}
+
+// get the slice of bytes from the savepoint start to the current position.
+func (p *parser) sliceFrom(start savepoint) []byte {
+	return p.data[start.position.offset:p.pt.position.offset]
+}
+
+// memoSlot is an entry of the memoization table, the key of an empty slot
+// is 0.
+type memoSlot struct {
+	key uint64
+	res resultTuple
+}
+
+// memoTable is the memoization table for the packrat algorithm, an
+// open-addressed hash table with linear probing that maps the offset in
+// the source and the id of a rule or expression to its result.
+type memoTable struct {
+	slots []memoSlot
+	shift uint
+	n     int
+}
+
+// memoTables pools the memoization tables so that their storage is
+// reused across parses.
+var memoTables = &sync.Pool{
+	New: func() any {
+		return &memoTable{slots: make([]memoSlot, 1<<10), shift: 64 - 10}
+	},
+}
+
+// memoKey returns the key of the result of the rule or expression id at
+// offset, it supports up to 1<<24 ids.
+func memoKey(offset, id int) uint64 {
+	return uint64(offset+1)<<24 | uint64(id)
+}
+
+// index returns the index of the slot of key, or of the empty slot where
+// it should be stored.
+func (t *memoTable) index(key uint64) int {
+	mask := len(t.slots) - 1
+	i := int((key*0x9E3779B97F4A7C15)>>t.shift) & mask
+	for t.slots[i].key != 0 && t.slots[i].key != key {
+		i = (i + 1) & mask
+	}
+	return i
+}
+
+func (t *memoTable) get(key uint64) (resultTuple, bool) {
+	s := &t.slots[t.index(key)]
+	return s.res, s.key == key
+}
+
+func (t *memoTable) set(key uint64, res resultTuple) {
+	if 4*(t.n+1) > 3*len(t.slots) {
+		t.grow()
+	}
+	s := &t.slots[t.index(key)]
+	if s.key == 0 {
+		s.key = key
+		t.n++
+	}
+	s.res = res
+}
+
+// grow doubles the capacity of the table.
+func (t *memoTable) grow() {
+	old := t.slots
+	t.slots = make([]memoSlot, 2*len(old))
+	t.shift--
+	for _, s := range old {
+		if s.key != 0 {
+			t.slots[t.index(s.key)] = s
+		}
+	}
+}
+
+// reset empties the table, releasing the references to the results.
+func (t *memoTable) reset() {
+	if t.n == 0 {
+		return
+	}
+	for i := range t.slots {
+		t.slots[i] = memoSlot{}
+	}
+	t.n = 0
+}
+
+func (p *parser) getMemoized(id int) (resultTuple, bool) {
+	if p.memo == nil {
+		return resultTuple{}, false
+	}
+	return p.memo.get(memoKey(p.pt.offset, id))
+}
+
+func (p *parser) setMemoized(pt savepoint, id int, tuple resultTuple) {
+	if p.memo == nil {
+		p.memo = memoTables.Get().(*memoTable)
+	}
+	p.memo.set(memoKey(pt.offset, id), tuple)
+}
+
+// releaseMemo returns the memoization table to the pool.
+func (p *parser) releaseMemo() {
+	if p.memo == nil {
+		return
+	}
+	p.memo.reset()
+	memoTables.Put(p.memo)
+	p.memo = nil
+}
+
+func (p *parser) buildRulesTable(g *grammar) {
+	p.rules = make(map[string]*rule, len(g.rules))
+	for _, r := range g.rules {
+		p.rules[r.name] = r
+	}
+}
+
+// nolint: gocyclo
+func (p *parser) parse(g *grammar) (val any, err error) {
+	if len(g.rules) == 0 {
+		p.addErr(errNoRule)
+		return nil, p.errs.err()
+	}
+
+	// TODO : not super critical but this could be generated
+	p.buildRulesTable(g)
+	defer p.releaseMemo()
+
+	if p.recover {
+		// panic can be used in action code to stop parsing immediately
+		// and return the panic as an error.
+		defer func() {
+			if e := recover(); e != nil {
+				if p.debug {
+					defer p.out(p.in("panic handler"))
+				}
+				val = nil
+				switch e := e.(type) {
+				case error:
+					p.addErr(e)
+				default:
+					p.addErr(fmt.Errorf("%v", e))
+				}
+				err = p.errs.err()
+			}
+		}()
+	}
+
+	startRule, ok := p.rules[p.entrypoint]
+	if !ok {
+		p.addErr(errInvalidEntrypoint)
+		return nil, p.errs.err()
+	}
+
+	p.read() // advance to first rune
+	val, ok = p.parseRuleWrap(startRule)
+	if !ok {
+		if len(*p.errs) == 0 {
+			// If parsing fails, but no errors have been recorded, the expected values
+			// for the farthest parser position are returned as error.
+			p.addNoMatchErr()
+		}
+
+		return nil, p.errs.err()
+	}
+	return val, p.errs.err()
+}
+
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
+func listJoin(list []string, sep string, lastSep string) string {
+	switch len(list) {
+	case 0:
+		return ""
+	case 1:
+		return list[0]
+	default:
+		return strings.Join(list[:len(list)-1], sep) + " " + lastSep + " " + list[len(list)-1]
+	}
+}
+
+// profFrame is a rule invocation being profiled.
+type profFrame struct {
+	start time.Time
+	child time.Duration
+}
+
+// profEval is the evaluation of a rule at an offset.
+type profEval struct {
+	rule   *rule
+	offset int
+}
+
+// ruleStats returns the statistics of rule, creating them if needed.
+func (p *parser) ruleStats(rule *rule) *RuleStats {
+	rs := p.Rules[rule.name]
+	if rs == nil {
+		rs = &RuleStats{}
+		p.Rules[rule.name] = rs
+	}
+	return rs
+}
+
+func (p *parser) countMemo(rs *RuleStats, hit bool) {
+	if hit {
+		rs.MemoHits++
+	} else {
+		rs.MemoMisses++
+	}
+}
+
+func (p *parser) profEnter(r *rule) {
+	if p.profActive == nil {
+		p.profActive = make(map[*rule]int)
+	}
+	p.profActive[r]++
+	p.profStack = append(p.profStack, profFrame{start: time.Now()})
+}
+
+// countRepeat counts the evaluation of rule at the current offset if
+// it was already evaluated at that offset.
+func (p *parser) countRepeat(rule *rule) {
+	if p.profEvals == nil {
+		p.profEvals = make(map[profEval]bool)
+	}
+	key := profEval{rule: rule, offset: p.pt.offset}
+	if p.profEvals[key] {
+		p.ruleStats(rule).Repeats++
+		return
+	}
+	p.profEvals[key] = true
+}
+
+func (p *parser) profExit(rule *rule, start savepoint, ok bool) {
+	frame := p.profStack[len(p.profStack)-1]
+	p.profStack = p.profStack[:len(p.profStack)-1]
+	elapsed := time.Since(frame.start)
+	if n := len(p.profStack); n > 0 {
+		p.profStack[n-1].child += elapsed
+	}
+
+	rs := p.ruleStats(rule)
+	rs.Invocations++
+	if p.profActive[rule]--; p.profActive[rule] == 0 {
+		// the time of the inner invocations is included
+		rs.Time += elapsed
+	}
+	rs.SelfTime += elapsed - frame.child
+	if ok {
+		rs.Matches++
+		rs.Bytes += uint64(p.pt.offset - start.offset)
+	} else {
+		rs.Failures++
+	}
+}
+
+func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
+	if rule.id < 0 {
+		// its results depend on the captured texts or the state store
+		return p.parseRule(rule)
+	}
+	res, ok := p.getMemoized(rule.id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(rule), ok)
+	}
+	if ok {
+		p.restore(res.end)
+		return res.v, res.b
+	}
+
+	startMark := p.pt
+	val, ok := p.parseRule(rule)
+	p.setMemoized(startMark, rule.id, resultTuple{v: val, b: ok, end: p.pt})
+
+	return val, ok
+}
+
+func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRule " + rule.name))
+	}
+	var (
+		val       any
+		ok        bool
+		startMark = p.pt
+	)
+
+	if p.Rules != nil {
+		p.profEnter(rule)
+		defer func() { p.profExit(rule, startMark, ok) }()
+	}
+
+	if p.memoize {
+		val, ok = p.parseRuleMemoize(rule)
+	} else {
+		val, ok = p.parseRule(rule)
+	}
+
+	if ok && p.debug {
+		p.printIndent("MATCH", string(p.sliceFrom(startMark)))
+	}
+	return val, ok
+}
+
+func (p *parser) parseRule(rule *rule) (any, bool) {
+	if p.Rules != nil {
+		p.countRepeat(rule)
+	}
+	p.rstack = append(p.rstack, rule)
+	p.pushV()
+	val, ok := rule.run(p)
+	p.popV()
+	p.rstack = p.rstack[:len(p.rstack)-1]
+	return val, ok
+}
+
+// incChoiceAltCnt counts the match of the alternative altI of the choice
+// at pos, or its failure if altI is choiceNoMatch.
+func (p *parser) incChoiceAltCnt(pos position, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, pos.line, pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
+// countExpr counts the evaluation of an expression.
+func (p *parser) countExpr() {
+	p.ExprCnt++
+	if p.ExprCnt > p.maxExprCnt {
+		panic(errMaxExprCnt)
+	}
+}
+
+// compiledMemoized returns the memoized results of the compiled expression
+// id at the current position, and restores the position at their end. It
+// returns false if they are not memoized or if memoization is disabled.
+func (p *parser) compiledMemoized(id int) (resultTuple, bool) {
+	if !p.memoize {
+		return resultTuple{}, false
+	}
+	res, ok := p.getMemoized(id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+	}
+	if ok {
+		p.restore(res.end)
+	}
+	return res, ok
+}
+
+// compiledMemoize memoizes the results of the compiled expression id,
+// which started at pt, if memoization is enabled.
+func (p *parser) compiledMemoize(pt savepoint, id int, val any, ok bool) {
+	if p.memoize {
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
+	}
+}
+
+func rangeTable(class string) *unicode.RangeTable {
+	if rt, ok := unicode.Categories[class]; ok {
+		return rt
+	}
+	if rt, ok := unicode.Properties[class]; ok {
+		return rt
+	}
+	if rt, ok := unicode.Scripts[class]; ok {
+		return rt
+	}
+
+	// cannot happen
+	panic(fmt.Sprintf("invalid Unicode class: %s", class))
+}
+
+// fullCaseFolds are the full case foldings of more than one rune, with
+// the simple case folding of their runes.
+var fullCaseFolds = map[rune]string{
+	0x00df: "ss",
+	0x0130: "i\u0307",
+	0x0149: "\u02bcn",
+	0x01f0: "j\u030c",
+	0x0390: "\u03b9\u0308\u0301",
+	0x03b0: "\u03c5\u0308\u0301",
+	0x0587: "\u0565\u0582",
+	0x1e96: "h\u0331",
+	0x1e97: "t\u0308",
+	0x1e98: "w\u030a",
+	0x1e99: "y\u030a",
+	0x1e9a: "a\u02be",
+	0x1e9e: "ss",
+	0x1f50: "\u03c5\u0313",
+	0x1f52: "\u03c5\u0313\u0300",
+	0x1f54: "\u03c5\u0313\u0301",
+	0x1f56: "\u03c5\u0313\u0342",
+	0x1f80: "\u1f00\u03b9",
+	0x1f81: "\u1f01\u03b9",
+	0x1f82: "\u1f02\u03b9",
+	0x1f83: "\u1f03\u03b9",
+	0x1f84: "\u1f04\u03b9",
+	0x1f85: "\u1f05\u03b9",
+	0x1f86: "\u1f06\u03b9",
+	0x1f87: "\u1f07\u03b9",
+	0x1f88: "\u1f00\u03b9",
+	0x1f89: "\u1f01\u03b9",
+	0x1f8a: "\u1f02\u03b9",
+	0x1f8b: "\u1f03\u03b9",
+	0x1f8c: "\u1f04\u03b9",
+	0x1f8d: "\u1f05\u03b9",
+	0x1f8e: "\u1f06\u03b9",
+	0x1f8f: "\u1f07\u03b9",
+	0x1f90: "\u1f20\u03b9",
+	0x1f91: "\u1f21\u03b9",
+	0x1f92: "\u1f22\u03b9",
+	0x1f93: "\u1f23\u03b9",
+	0x1f94: "\u1f24\u03b9",
+	0x1f95: "\u1f25\u03b9",
+	0x1f96: "\u1f26\u03b9",
+	0x1f97: "\u1f27\u03b9",
+	0x1f98: "\u1f20\u03b9",
+	0x1f99: "\u1f21\u03b9",
+	0x1f9a: "\u1f22\u03b9",
+	0x1f9b: "\u1f23\u03b9",
+	0x1f9c: "\u1f24\u03b9",
+	0x1f9d: "\u1f25\u03b9",
+	0x1f9e: "\u1f26\u03b9",
+	0x1f9f: "\u1f27\u03b9",
+	0x1fa0: "\u1f60\u03b9",
+	0x1fa1: "\u1f61\u03b9",
+	0x1fa2: "\u1f62\u03b9",
+	0x1fa3: "\u1f63\u03b9",
+	0x1fa4: "\u1f64\u03b9",
+	0x1fa5: "\u1f65\u03b9",
+	0x1fa6: "\u1f66\u03b9",
+	0x1fa7: "\u1f67\u03b9",
+	0x1fa8: "\u1f60\u03b9",
+	0x1fa9: "\u1f61\u03b9",
+	0x1faa: "\u1f62\u03b9",
+	0x1fab: "\u1f63\u03b9",
+	0x1fac: "\u1f64\u03b9",
+	0x1fad: "\u1f65\u03b9",
+	0x1fae: "\u1f66\u03b9",
+	0x1faf: "\u1f67\u03b9",
+	0x1fb2: "\u1f70\u03b9",
+	0x1fb3: "\u03b1\u03b9",
+	0x1fb4: "\u03ac\u03b9",
+	0x1fb6: "\u03b1\u0342",
+	0x1fb7: "\u03b1\u0342\u03b9",
+	0x1fbc: "\u03b1\u03b9",
+	0x1fc2: "\u1f74\u03b9",
+	0x1fc3: "\u03b7\u03b9",
+	0x1fc4: "\u03ae\u03b9",
+	0x1fc6: "\u03b7\u0342",
+	0x1fc7: "\u03b7\u0342\u03b9",
+	0x1fcc: "\u03b7\u03b9",
+	0x1fd2: "\u03b9\u0308\u0300",
+	0x1fd3: "\u03b9\u0308\u0301",
+	0x1fd6: "\u03b9\u0342",
+	0x1fd7: "\u03b9\u0308\u0342",
+	0x1fe2: "\u03c5\u0308\u0300",
+	0x1fe3: "\u03c5\u0308\u0301",
+	0x1fe4: "\u03c1\u0313",
+	0x1fe6: "\u03c5\u0342",
+	0x1fe7: "\u03c5\u0308\u0342",
+	0x1ff2: "\u1f7c\u03b9",
+	0x1ff3: "\u03c9\u03b9",
+	0x1ff4: "\u03ce\u03b9",
+	0x1ff6: "\u03c9\u0342",
+	0x1ff7: "\u03c9\u0342\u03b9",
+	0x1ffc: "\u03c9\u03b9",
+	0xfb00: "ff",
+	0xfb01: "fi",
+	0xfb02: "fl",
+	0xfb03: "ffi",
+	0xfb04: "ffl",
+	0xfb05: "st",
+	0xfb06: "st",
+	0xfb13: "\u0574\u0576",
+	0xfb14: "\u0574\u0565",
+	0xfb15: "\u0574\u056b",
+	0xfb16: "\u057e\u0576",
+	0xfb17: "\u0574\u056d",
+}
@@ -0,0 +1 @@
+../errorpos_test.go
\ No newline at end of file
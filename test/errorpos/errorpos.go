@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 	"unicode"
 	"unicode/utf8"
 )
@@ -22,64 +23,87 @@ var g = &grammar{
 		{
 			name: "Input",
 			pos:  position{line: 5, col: 1, offset: 22},
+			id:   0,
 			expr: &seqExpr{
 				pos: position{line: 5, col: 9, offset: 32},
+				id:  19,
 				exprs: []any{
 					&ruleRefExpr{
 						pos:  position{line: 5, col: 9, offset: 32},
+						id:   20,
 						name: "_",
 					},
 					&choiceExpr{
 						pos: position{line: 5, col: 12, offset: 35},
+						id:  21,
 						alternatives: []any{
 							&ruleRefExpr{
 								pos:  position{line: 5, col: 12, offset: 35},
+								id:   22,
 								name: "case01",
 							},
 							&ruleRefExpr{
 								pos:  position{line: 5, col: 21, offset: 44},
+								id:   23,
 								name: "case02",
 							},
 							&ruleRefExpr{
 								pos:  position{line: 5, col: 30, offset: 53},
+								id:   24,
 								name: "case03",
 							},
 							&ruleRefExpr{
 								pos:  position{line: 5, col: 39, offset: 62},
+								id:   25,
 								name: "case04",
 							},
 							&ruleRefExpr{
 								pos:  position{line: 5, col: 48, offset: 71},
+								id:   26,
 								name: "case05",
 							},
 							&ruleRefExpr{
 								pos:  position{line: 5, col: 57, offset: 80},
+								id:   27,
 								name: "case06",
 							},
 							&ruleRefExpr{
 								pos:  position{line: 5, col: 66, offset: 89},
+								id:   28,
 								name: "case07",
 							},
 							&ruleRefExpr{
 								pos:  position{line: 5, col: 75, offset: 98},
+								id:   29,
 								name: "case08",
 							},
 							&ruleRefExpr{
 								pos:  position{line: 5, col: 84, offset: 107},
+								id:   30,
 								name: "case09",
 							},
 							&ruleRefExpr{
 								pos:  position{line: 5, col: 93, offset: 116},
+								id:   31,
 								name: "case10",
 							},
 							&ruleRefExpr{
 								pos:  position{line: 5, col: 102, offset: 125},
+								id:   32,
 								name: "case11",
 							},
 						},
+						dispatch: &choiceDispatch{
+							ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+							ranges:    []rune{'\u0080'},
+							rangeSets: "\x00",
+							alts:      [][]int{{}, {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+							expected:  [][]string{{"\"case01\"", "\"case02\"", "\"case03\"", "\"case04\"", "\"case05\"", "\"case06\"", "\"case07\"", "\"case08\"", "\"case09\"", "\"case10\"", "\"case11\""}, {}},
+						},
 					},
 					&ruleRefExpr{
 						pos:  position{line: 5, col: 110, offset: 133},
+						id:   33,
 						name: "EOF",
 					},
 				},
@@ -88,43 +112,61 @@ var g = &grammar{
 		{
 			name: "case01",
 			pos:  position{line: 7, col: 1, offset: 138},
+			id:   1,
 			expr: &seqExpr{
 				pos: position{line: 7, col: 10, offset: 149},
+				id:  34,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 7, col: 10, offset: 149},
+						id:         35,
 						val:        "case01",
 						ignoreCase: false,
 						want:       "\"case01\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 7, col: 19, offset: 158},
+						id:   36,
 						name: "_",
 					},
 					&oneOrMoreExpr{
 						pos: position{line: 7, col: 21, offset: 160},
+						id:  37,
 						expr: &seqExpr{
 							pos: position{line: 7, col: 22, offset: 161},
+							id:  38,
 							exprs: []any{
 								&choiceExpr{
 									pos: position{line: 7, col: 23, offset: 162},
+									id:  39,
 									alternatives: []any{
 										&ruleRefExpr{
 											pos:  position{line: 7, col: 23, offset: 162},
+											id:   40,
 											name: "increment",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 7, col: 35, offset: 174},
+											id:   41,
 											name: "decrement",
 										},
 										&ruleRefExpr{
 											pos:  position{line: 7, col: 47, offset: 186},
+											id:   42,
 											name: "zero",
 										},
 									},
+									dispatch: &choiceDispatch{
+										ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00",
+										ranges:    []rune{'\u0080'},
+										rangeSets: "\x00",
+										alts:      [][]int{{}, {1}, {0}, {2}},
+										expected:  [][]string{{"\"inc\"", "\"dec\"", "\"zero\""}, {"\"inc\"", "\"zero\""}, {"\"dec\"", "\"zero\""}, {"\"inc\"", "\"dec\""}},
+									},
 								},
 								&ruleRefExpr{
 									pos:  position{line: 7, col: 53, offset: 192},
+									id:   43,
 									name: "_",
 								},
 							},
@@ -136,23 +178,29 @@ var g = &grammar{
 		{
 			name: "case02",
 			pos:  position{line: 8, col: 1, offset: 197},
+			id:   2,
 			expr: &seqExpr{
 				pos: position{line: 8, col: 10, offset: 208},
+				id:  44,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 8, col: 10, offset: 208},
+						id:         45,
 						val:        "case02",
 						ignoreCase: false,
 						want:       "\"case02\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 8, col: 19, offset: 217},
+						id:   46,
 						name: "__",
 					},
 					&oneOrMoreExpr{
 						pos: position{line: 8, col: 22, offset: 220},
+						id:  47,
 						expr: &charClassMatcher{
 							pos:        position{line: 8, col: 22, offset: 220},
+							id:         48,
 							val:        "[^abc]",
 							chars:      []rune{'a', 'b', 'c'},
 							ignoreCase: false,
@@ -165,23 +213,29 @@ var g = &grammar{
 		{
 			name: "case03",
 			pos:  position{line: 9, col: 1, offset: 228},
+			id:   3,
 			expr: &seqExpr{
 				pos: position{line: 9, col: 10, offset: 239},
+				id:  49,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 9, col: 10, offset: 239},
+						id:         50,
 						val:        "case03",
 						ignoreCase: false,
 						want:       "\"case03\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 9, col: 19, offset: 248},
+						id:   51,
 						name: "__",
 					},
 					&zeroOrOneExpr{
 						pos: position{line: 9, col: 22, offset: 251},
+						id:  52,
 						expr: &litMatcher{
 							pos:        position{line: 9, col: 22, offset: 251},
+							id:         53,
 							val:        "x",
 							ignoreCase: false,
 							want:       "\"x\"",
@@ -189,6 +243,7 @@ var g = &grammar{
 					},
 					&charClassMatcher{
 						pos:        position{line: 9, col: 27, offset: 256},
+						id:         54,
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -200,21 +255,26 @@ var g = &grammar{
 		{
 			name: "case04",
 			pos:  position{line: 10, col: 1, offset: 262},
+			id:   4,
 			expr: &seqExpr{
 				pos: position{line: 10, col: 10, offset: 273},
+				id:  55,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 10, col: 10, offset: 273},
+						id:         56,
 						val:        "case04",
 						ignoreCase: false,
 						want:       "\"case04\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 10, col: 19, offset: 282},
+						id:   57,
 						name: "__",
 					},
 					&charClassMatcher{
 						pos:        position{line: 10, col: 22, offset: 285},
+						id:         58,
 						val:        "[\\x30-\\x39]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -222,6 +282,7 @@ var g = &grammar{
 					},
 					&charClassMatcher{
 						pos:        position{line: 10, col: 34, offset: 297},
+						id:         59,
 						val:        "[^\\x30-\\x39]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -229,6 +290,7 @@ var g = &grammar{
 					},
 					&charClassMatcher{
 						pos:        position{line: 10, col: 47, offset: 310},
+						id:         60,
 						val:        "[\\pN]",
 						classes:    []*unicode.RangeTable{rangeTable("N")},
 						ignoreCase: false,
@@ -236,6 +298,7 @@ var g = &grammar{
 					},
 					&charClassMatcher{
 						pos:        position{line: 10, col: 53, offset: 316},
+						id:         61,
 						val:        "[^\\pN]",
 						classes:    []*unicode.RangeTable{rangeTable("N")},
 						ignoreCase: false,
@@ -247,23 +310,29 @@ var g = &grammar{
 		{
 			name: "case05",
 			pos:  position{line: 11, col: 1, offset: 323},
+			id:   5,
 			expr: &seqExpr{
 				pos: position{line: 11, col: 10, offset: 334},
+				id:  62,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 11, col: 10, offset: 334},
+						id:         63,
 						val:        "case05",
 						ignoreCase: false,
 						want:       "\"case05\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 11, col: 19, offset: 343},
+						id:   64,
 						name: "__",
 					},
 					&notExpr{
 						pos: position{line: 11, col: 22, offset: 346},
+						id:  65,
 						expr: &litMatcher{
 							pos:        position{line: 11, col: 23, offset: 347},
+							id:         66,
 							val:        "not",
 							ignoreCase: false,
 							want:       "\"not\"",
@@ -271,6 +340,7 @@ var g = &grammar{
 					},
 					&litMatcher{
 						pos:        position{line: 11, col: 29, offset: 353},
+						id:         67,
 						val:        "yes",
 						ignoreCase: false,
 						want:       "\"yes\"",
@@ -281,23 +351,29 @@ var g = &grammar{
 		{
 			name: "case06",
 			pos:  position{line: 12, col: 1, offset: 359},
+			id:   6,
 			expr: &seqExpr{
 				pos: position{line: 12, col: 10, offset: 370},
+				id:  68,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 12, col: 10, offset: 370},
+						id:         69,
 						val:        "case06",
 						ignoreCase: false,
 						want:       "\"case06\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 12, col: 19, offset: 379},
+						id:   70,
 						name: "__",
 					},
 					&notExpr{
 						pos: position{line: 12, col: 22, offset: 382},
+						id:  71,
 						expr: &charClassMatcher{
 							pos:        position{line: 12, col: 23, offset: 383},
+							id:         72,
 							val:        "[0-9]",
 							ranges:     []rune{'0', '9'},
 							ignoreCase: false,
@@ -306,6 +382,7 @@ var g = &grammar{
 					},
 					&litMatcher{
 						pos:        position{line: 12, col: 29, offset: 389},
+						id:         73,
 						val:        "x",
 						ignoreCase: false,
 						want:       "\"x\"",
@@ -316,32 +393,40 @@ var g = &grammar{
 		{
 			name: "case07",
 			pos:  position{line: 13, col: 1, offset: 393},
+			id:   7,
 			expr: &seqExpr{
 				pos: position{line: 13, col: 10, offset: 404},
+				id:  74,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 13, col: 10, offset: 404},
+						id:         75,
 						val:        "case07",
 						ignoreCase: false,
 						want:       "\"case07\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 13, col: 19, offset: 413},
+						id:   76,
 						name: "__",
 					},
 					&notExpr{
 						pos: position{line: 13, col: 22, offset: 416},
+						id:  77,
 						expr: &choiceExpr{
 							pos: position{line: 13, col: 24, offset: 418},
+							id:  78,
 							alternatives: []any{
 								&litMatcher{
 									pos:        position{line: 13, col: 24, offset: 418},
+									id:         79,
 									val:        "abc",
 									ignoreCase: true,
 									want:       "\"abc\"i",
 								},
 								&charClassMatcher{
 									pos:        position{line: 13, col: 33, offset: 427},
+									id:         80,
 									val:        "[a-c]i",
 									ranges:     []rune{'a', 'c'},
 									ignoreCase: true,
@@ -349,16 +434,25 @@ var g = &grammar{
 								},
 								&charClassMatcher{
 									pos:        position{line: 13, col: 42, offset: 436},
+									id:         81,
 									val:        "[\\pL]",
 									classes:    []*unicode.RangeTable{rangeTable("L")},
 									ignoreCase: false,
 									inverted:   false,
 								},
 							},
+							dispatch: &choiceDispatch{
+								ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x02\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x00\x00\x00\x00\x00\x00\x01\x02\x02\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x00\x00\x00\x00\x00",
+								ranges:    []rune{'\u0080', 'ª', '«', 'µ', '¶', 'º', '»', 'À', '×', 'Ø', '÷', 'ø', '˂', 'ˆ', '˒', 'ˠ', '˥', 'ˬ', '˭', 'ˮ', '˯', 'Ͱ', '͵', 'Ͷ', '\u0378', 'ͺ', ';', 'Ϳ', '\u0380', 'Ά', '·', 'Έ', '\u038b', 'Ό', '\u038d', 'Ύ', '\u03a2', 'Σ', '϶', 'Ϸ', '҂', 'Ҋ', '\u0530', 'Ա', '\u0557', 'ՙ', '՚', 'ՠ', '։', 'א', '\u05eb', 'ׯ', '׳', 'ؠ', 'ً', 'ٮ', 'ٰ', 'ٱ', '۔', 'ە', 'ۖ', 'ۥ', 'ۧ', 'ۮ', '۰', 'ۺ', '۽', 'ۿ', '܀', 'ܐ', 'ܑ', 'ܒ', 'ܰ', 'ݍ', 'ަ', 'ޱ', '\u07b2', 'ߊ', '߫', 'ߴ', '߶', 'ߺ', '\u07fb', 'ࠀ', 'ࠖ', 'ࠚ', 'ࠛ', 'ࠤ', 'ࠥ', 'ࠨ', 'ࠩ', 'ࡀ', '࡙', 'ࡠ', '\u086b', 'ࡰ', '࢈', 'ࢉ', '\u0890', 'ࢠ', '࣊', 'ऄ', 'ऺ', 'ऽ', 'ा', 'ॐ', '॑', 'क़', 'ॢ', 'ॱ', 'ঁ', 'অ', '\u098d', 'এ', '\u0991', 'ও', '\u09a9', 'প', '\u09b1', 'ল', '\u09b3', 'শ', '\u09ba', 'ঽ', 'া', 'ৎ', '\u09cf', 'ড়', '\u09de', 'য়', 'ৢ', 'ৰ', '৲', 'ৼ', '৽', 'ਅ', '\u0a0b', 'ਏ', '\u0a11', 'ਓ', '\u0a29', 'ਪ', '\u0a31', 'ਲ', '\u0a34', 'ਵ', '\u0a37', 'ਸ', '\u0a3a', 'ਖ਼', '\u0a5d', 'ਫ਼', '\u0a5f', 'ੲ', 'ੵ', 'અ', '\u0a8e', 'એ', '\u0a92', 'ઓ', '\u0aa9', 'પ', '\u0ab1', 'લ', '\u0ab4', 'વ', '\u0aba', 'ઽ', 'ા', 'ૐ', '\u0ad1', 'ૠ', 'ૢ', 'ૹ', 'ૺ', 'ଅ', '\u0b0d', 'ଏ', '\u0b11', 'ଓ', '\u0b29', 'ପ', '\u0b31', 'ଲ', '\u0b34', 'ଵ', '\u0b3a', 'ଽ', 'ା', 'ଡ଼', '\u0b5e', 'ୟ', 'ୢ', 'ୱ', '୲', 'ஃ', '\u0b84', 'அ', '\u0b8b', 'எ', '\u0b91', 'ஒ', '\u0b96', 'ங', '\u0b9b', 'ஜ', '\u0b9d', 'ஞ', '\u0ba0', 'ண', '\u0ba5', 'ந', '\u0bab', 'ம', '\u0bba', 'ௐ', '\u0bd1', 'అ', '\u0c0d', 'ఎ', '\u0c11', 'ఒ', '\u0c29', 'ప', '\u0c3a', 'ఽ', 'ా', 'ౘ', '\u0c5b', '౜', '\u0c5e', 'ౠ', 'ౢ', 'ಀ', 'ಁ', 'ಅ', '\u0c8d', 'ಎ', '\u0c91', 'ಒ', '\u0ca9', 'ಪ', '\u0cb4', 'ವ', '\u0cba', 'ಽ', 'ಾ', '೜', '\u0cdf', 'ೠ', 'ೢ', 'ೱ', 'ೳ', 'ഄ', '\u0d0d', 'എ', '\u0d11', 'ഒ', '഻', 'ഽ', 'ാ', 'ൎ', '൏', 'ൔ', 'ൗ', 'ൟ', 'ൢ', 'ൺ', '\u0d80', 'අ', '\u0d97', 'ක', '\u0db2', 'ඳ', '\u0dbc', 'ල', '\u0dbe', 'ව', '\u0dc7', 'ก', 'ั', 'า', 'ิ', 'เ', '็', 'ກ', '\u0e83', 'ຄ', '\u0e85', 'ຆ', '\u0e8b', 'ຌ', '\u0ea4', 'ລ', '\u0ea6', 'ວ', 'ັ', 'າ', 'ິ', 'ຽ', '\u0ebe', 'ເ', '\u0ec5', 'ໆ', '\u0ec7', 'ໜ', '\u0ee0', 'ༀ', '༁', 'ཀ', '\u0f48', 'ཉ', '\u0f6d', 'ྈ', 'ྍ', 'က', 'ါ', 'ဿ', '၀', 'ၐ', 'ၖ', 'ၚ', 'ၞ', 'ၡ', 'ၢ', 'ၥ', 'ၧ', 'ၮ', 'ၱ', 'ၵ', 'ႂ', 'ႎ', 'ႏ', 'Ⴀ', '\u10c6', 'Ⴧ', '\u10c8', 'Ⴭ', '\u10ce', 'ა', '჻', 'ჼ', '\u1249', 'ቊ', '\u124e', 'ቐ', '\u1257', 'ቘ', '\u1259', 'ቚ', '\u125e', 'በ', '\u1289', 'ኊ', '\u128e', 'ነ', '\u12b1', 'ኲ', '\u12b6', 'ኸ', '\u12bf', 'ዀ', '\u12c1', 'ዂ', '\u12c6', 'ወ', '\u12d7', 'ዘ', '\u1311', 'ጒ', '\u1316', 'ጘ', '\u135b', 'ᎀ', '᎐', 'Ꭰ', '\u13f6', 'ᏸ', '\u13fe', 'ᐁ', '᙭', 'ᙯ', '\u1680', 'ᚁ', '᚛', 'ᚠ', '᛫', 'ᛱ', '\u16f9', 'ᜀ', 'ᜒ', 'ᜟ', 'ᜲ', 'ᝀ', 'ᝒ', 'ᝠ', '\u176d', 'ᝮ', '\u1771', 'ក', '឴', 'ៗ', '៘', 'ៜ', '៝', 'ᠠ', '\u1879', 'ᢀ', 'ᢅ', 'ᢇ', 'ᢩ', 'ᢪ', '\u18ab', 'ᢰ', '\u18f6', 'ᤀ', '\u191f', 'ᥐ', '\u196e', 'ᥰ', '\u1975', 'ᦀ', '\u19ac', 'ᦰ', '\u19ca', 'ᨀ', 'ᨗ', 'ᨠ', 'ᩕ', 'ᪧ', '᪨', 'ᬅ', '᬴', 'ᭅ', '\u1b4d', 'ᮃ', 'ᮡ', 'ᮮ', '᮰', 'ᮺ', '᯦', 'ᰀ', 'ᰤ', 'ᱍ', '᱐', 'ᱚ', '᱾', 'ᲀ', '\u1c8b', 'Ა', '\u1cbb', 'Ჽ', '᳀', 'ᳩ', '᳭', 'ᳮ', '᳴', 'ᳵ', '᳷', 'ᳺ', '\u1cfb', 'ᴀ', '᷀', 'Ḁ', '\u1f16', 'Ἐ', '\u1f1e', 'ἠ', '\u1f46', 'Ὀ', '\u1f4e', 'ὐ', '\u1f58', 'Ὑ', '\u1f5a', 'Ὓ', '\u1f5c', 'Ὕ', '\u1f5e', 'Ὗ', '\u1f7e', 'ᾀ', '\u1fb5', 'ᾶ', '᾽', 'ι', '᾿', 'ῂ', '\u1fc5', 'ῆ', '῍', 'ῐ', '\u1fd4', 'ῖ', '\u1fdc', 'ῠ', '῭', 'ῲ', '\u1ff5', 'ῶ', '´', 'ⁱ', '\u2072', 'ⁿ', '₀', 'ₐ', '\u209d', 'ℂ', '℃', 'ℇ', '℈', 'ℊ', '℔', 'ℕ', '№', 'ℙ', '℞', 'ℤ', '℥', 'Ω', '℧', 'ℨ', '℩', 'K', '℮', 'ℯ', '℺', 'ℼ', '⅀', 'ⅅ', '⅊', 'ⅎ', '⅏', 'Ↄ', 'ↅ', 'Ⰰ', '⳥', 'Ⳬ', '⳯', 'Ⳳ', '\u2cf4', 'ⴀ', '\u2d26', 'ⴧ', '\u2d28', 'ⴭ', '\u2d2e', 'ⴰ', '\u2d68', 'ⵯ', '⵰', 'ⶀ', '\u2d97', 'ⶠ', '\u2da7', 'ⶨ', '\u2daf', 'ⶰ', '\u2db7', 'ⶸ', '\u2dbf', 'ⷀ', '\u2dc7', 'ⷈ', '\u2dcf', 'ⷐ', '\u2dd7', 'ⷘ', '\u2ddf', 'ⸯ', '⸰', '々', '〇', '〱', '〶', '〻', '〽', 'ぁ', '\u3097', 'ゝ', '゠', 'ァ', '・', 'ー', '\u3100', 'ㄅ', '\u3130', 'ㄱ', '\u318f', 'ㆠ', '㇀', 'ㇰ', '㈀', '㐀', '䷀', '一', '\ua48d', 'ꓐ', '꓾', 'ꔀ', '꘍', 'ꘐ', '꘠', 'ꘪ', '\ua62c', 'Ꙁ', '꙯', 'ꙿ', 'ꚞ', 'ꚠ', 'ꛦ', 'ꜗ', '꜠', 'Ꜣ', '꞉', 'Ꞌ', '\ua7dd', '꟱', 'ꠂ', 'ꠃ', '꠆', 'ꠇ', 'ꠋ', 'ꠌ', 'ꠣ', 'ꡀ', '꡴', 'ꢂ', 'ꢴ', 'ꣲ', '꣸', 'ꣻ', '꣼', 'ꣽ', 'ꣿ', 'ꤊ', 'ꤦ', 'ꤰ', 'ꥇ', 'ꥠ', '\ua97d', 'ꦄ', '꦳', 'ꧏ', '꧐', 'ꧠ', 'ꧥ', 'ꧦ', '꧰', 'ꧺ', '\ua9ff', 'ꨀ', 'ꨩ', 'ꩀ', 'ꩃ', 'ꩄ', 'ꩌ', 'ꩠ', '꩷', 'ꩺ', 'ꩻ', 'ꩾ', 'ꪰ', 'ꪱ', 'ꪲ', 'ꪵ', 'ꪷ', 'ꪹ', 'ꪾ', 'ꫀ', '꫁', 'ꫂ', '\uaac3', 'ꫛ', '꫞', 'ꫠ', 'ꫫ', 'ꫲ', 'ꫵ', 'ꬁ', '\uab07', 'ꬉ', '\uab0f', 'ꬑ', '\uab17', 'ꬠ', '\uab27', 'ꬨ', '\uab2f', 'ꬰ', '꭛', 'ꭜ', '꭪', 'ꭰ', 'ꯣ', '가', '\ud7a4', 'ힰ', '\ud7c7', 'ퟋ', '\ud7fc', '豈', '\ufa6e', '並', '\ufada', 'ﬀ', '\ufb07', 'ﬓ', '\ufb18', 'יִ', 'ﬞ', 'ײַ', '﬩', 'שׁ', '\ufb37', 'טּ', '\ufb3d', 'מּ', '\ufb3f', 'נּ', '\ufb42', 'ףּ', '\ufb45', 'צּ', '﮲', 'ﯓ', '﴾', 'ﵐ', '﶐', 'ﶒ', '﷈', 'ﷰ', '﷼', 'ﹰ', '\ufe75', 'ﹶ', '\ufefd', 'Ａ', '［', 'ａ', '｛', 'ｦ', '\uffbf', 'ￂ', '\uffc8', 'ￊ', '\uffd0', 'ￒ', '\uffd8', 'ￚ', '\uffdd', '𐀀', '\U0001000c', '𐀍', '\U00010027', '𐀨', '\U0001003b', '𐀼', '\U0001003e', '𐀿', '\U0001004e', '𐁐', '\U0001005e', '𐂀', '\U000100fb', '𐊀', '\U0001029d', '𐊠', '\U000102d1', '𐌀', '𐌠', '𐌭', '𐍁', '𐍂', '𐍊', '𐍐', '𐍶', '𐎀', '\U0001039e', '𐎠', '\U000103c4', '𐏈', '𐏐', '𐐀', '\U0001049e', '𐒰', '\U000104d4', '𐓘', '\U000104fc', '𐔀', '\U00010528', '𐔰', '\U00010564', '𐕰', '\U0001057b', '𐕼', '\U0001058b', '𐖌', '\U00010593', '𐖔', '\U00010596', '𐖗', '\U000105a2', '𐖣', '\U000105b2', '𐖳', '\U000105ba', '𐖻', '\U000105bd', '𐗀', '\U000105f4', '𐘀', '\U00010737', '𐝀', '\U00010756', '𐝠', '\U00010768', '𐞀', '\U00010786', '𐞇', '\U000107b1', '𐞲', '\U000107bb', '𐠀', '\U00010806', '𐠈', '\U00010809', '𐠊', '\U00010836', '𐠷', '\U00010839', '𐠼', '\U0001083d', '𐠿', '\U00010856', '𐡠', '𐡷', '𐢀', '\U0001089f', '𐣠', '\U000108f3', '𐣴', '\U000108f6', '𐤀', '𐤖', '𐤠', '\U0001093a', '𐥀', '\U0001095a', '𐦀', '\U000109b8', '𐦾', '𐧀', '𐨀', '𐨁', '𐨐', '\U00010a14', '𐨕', '\U00010a18', '𐨙', '\U00010a36', '𐩠', '𐩽', '𐪀', '𐪝', '𐫀', '𐫈', '𐫉', '𐫥', '𐬀', '\U00010b36', '𐭀', '\U00010b56', '𐭠', '\U00010b73', '𐮀', '\U00010b92', '𐰀', '\U00010c49', '𐲀', '\U00010cb3', '𐳀', '\U00010cf3', '𐴀', '𐴤', '𐵊', '\U00010d66', '𐵯', '\U00010d86', '𐺀', '\U00010eaa', '𐺰', '\U00010eb2', '𐻂', '\U00010ec8', '𐼀', '𐼝', '𐼧', '\U00010f28', '𐼰', '𐽆', '𐽰', '𐾂', '𐾰', '𐿅', '𐿠', '\U00010ff7', '𑀃', '𑀸', '𑁱', '𑁳', '𑁵', '\U00011076', '𑂃', '𑂰', '𑃐', '\U000110e9', '𑄃', '𑄧', '𑅄', '𑅅', '𑅇', '\U00011148', '𑅐', '𑅳', '𑅶', '\U00011177', '𑆃', '𑆳', '𑇁', '𑇅', '𑇚', '𑇛', '𑇜', '𑇝', '𑈀', '\U00011212', '𑈓', '𑈬', '𑈿', '𑉁', '𑊀', '\U00011287', '𑊈', '\U00011289', '𑊊', '\U0001128e', '𑊏', '\U0001129e', '𑊟', '𑊩', '𑊰', '𑋟', '𑌅', '\U0001130d', '𑌏', '\U00011311', '𑌓', '\U00011329', '𑌪', '\U00011331', '𑌲', '\U00011334', '𑌵', '\U0001133a', '𑌽', '𑌾', '𑍐', '\U00011351', '𑍝', '𑍢', '𑎀', '\U0001138a', '𑎋', '\U0001138c', '𑎎', '\U0001138f', '𑎐', '\U000113b6', '𑎷', '𑎸', '𑏑', '𑏒', '𑏓', '𑏔', '𑐀', '𑐵', '𑑇', '𑑋', '𑑟', '\U00011462', '𑒀', '𑒰', '𑓄', '𑓆', '𑓇', '\U000114c8', '𑖀', '𑖯', '𑗘', '𑗜', '𑘀', '𑘰', '𑙄', '\U00011645', '𑚀', '𑚫', '𑚸', '𑚹', '𑜀', '\U0001171b', '𑝀', '\U00011747', '𑠀', '𑠬', '𑢠', '𑣠', '𑣿', '\U00011907', '𑤉', '\U0001190a', '𑤌', '\U00011914', '𑤕', '\U00011917', '𑤘', '𑤰', '𑤿', '𑥀', '𑥁', '𑥂', '𑦠', '\U000119a8', '𑦪', '𑧑', '𑧡', '𑧢', '𑧣', '𑧤', '𑨀', '𑨁', '𑨋', '𑨳', '𑨺', '𑨻', '𑩐', '𑩑', '𑩜', '𑪊', '𑪝', '𑪞', '𑪰', '\U00011af9', '𑯀', '𑯡', '𑰀', '\U00011c09', '𑰊', '𑰯', '𑱀', '𑱁', '𑱲', '\U00011c90', '𑴀', '\U00011d07', '𑴈', '\U00011d0a', '𑴋', '𑴱', '𑵆', '𑵇', '𑵠', '\U00011d66', '𑵧', '\U00011d69', '𑵪', '𑶊', '𑶘', '\U00011d99', '𑶰', '\U00011ddc', '𑻠', '𑻳', '𑼂', '𑼃', '𑼄', '\U00011f11', '𑼒', '𑼴', '𑾰', '\U00011fb1', '𒀀', '\U0001239a', '𒒀', '\U00012544', '𒾐', '𒿱', '𓀀', '\U00013430', '𓑁', '𓑇', '𓑠', '\U000143fb', '𔐀', '\U00014647', '𖄀', '𖄞', '𖠀', '\U00016a39', '𖩀', '\U00016a5f', '𖩰', '\U00016abf', '𖫐', '\U00016aee', '𖬀', '𖬰', '𖭀', '𖭄', '𖭣', '\U00016b78', '𖭽', '\U00016b90', '𖵀', '𖵭', '𖹀', '𖺀', '𖺠', '\U00016eb9', '𖺻', '\U00016ed4', '𖼀', '\U00016f4b', '𖽐', '𖽑', '𖾓', '\U00016fa0', '𖿠', '𖿢', '𖿣', '𖿤', '𖿲', '𖿴', '𗀀', '\U00018cd6', '𘳿', '\U00018d1f', '𘶀', '\U00018df3', '𚿰', '\U0001aff4', '𚿵', '\U0001affc', '𚿽', '\U0001afff', '𛀀', '\U0001b123', '𛄲', '\U0001b133', '𛅐', '\U0001b153', '𛅕', '\U0001b156', '𛅤', '\U0001b168', '𛅰', '\U0001b2fc', '𛰀', '\U0001bc6b', '𛱰', '\U0001bc7d', '𛲀', '\U0001bc89', '𛲐', '\U0001bc9a', '𝐀', '\U0001d455', '𝑖', '\U0001d49d', '𝒞', '\U0001d4a0', '𝒢', '\U0001d4a3', '𝒥', '\U0001d4a7', '𝒩', '\U0001d4ad', '𝒮', '\U0001d4ba', '𝒻', '\U0001d4bc', '𝒽', '\U0001d4c4', '𝓅', '\U0001d506', '𝔇', '\U0001d50b', '𝔍', '\U0001d515', '𝔖', '\U0001d51d', '𝔞', '\U0001d53a', '𝔻', '\U0001d53f', '𝕀', '\U0001d545', '𝕆', '\U0001d547', '𝕊', '\U0001d551', '𝕒', '\U0001d6a6', '𝚨', '𝛁', '𝛂', '𝛛', '𝛜', '𝛻', '𝛼', '𝜕', '𝜖', '𝜵', '𝜶', '𝝏', '𝝐', '𝝯', '𝝰', '𝞉', '𝞊', '𝞩', '𝞪', '𝟃', '𝟄', '\U0001d7cc', '𝼀', '\U0001df1f', '𝼥', '\U0001df2b', '𞀰', '\U0001e06e', '𞄀', '\U0001e12d', '𞄷', '\U0001e13e', '𞅎', '𞅏', '𞊐', '𞊮', '𞋀', '𞋬', '𞓐', '𞓬', '𞗐', '𞗮', '𞗰', '𞗱', '𞛀', '\U0001e6df', '𞛠', '𞛣', '𞛤', '𞛦', '𞛧', '𞛮', '𞛰', '𞛵', '𞛾', '\U0001e700', '𞟠', '\U0001e7e7', '𞟨', '\U0001e7ec', '𞟭', '\U0001e7ef', '𞟰', '\U0001e7ff', '𞠀', '\U0001e8c5', '𞤀', '𞥄', '𞥋', '\U0001e94c', '𞸀', '\U0001ee04', '𞸅', '\U0001ee20', '𞸡', '\U0001ee23', '𞸤', '\U0001ee25', '𞸧', '\U0001ee28', '𞸩', '\U0001ee33', '𞸴', '\U0001ee38', '𞸹', '\U0001ee3a', '𞸻', '\U0001ee3c', '𞹂', '\U0001ee43', '𞹇', '\U0001ee48', '𞹉', '\U0001ee4a', '𞹋', '\U0001ee4c', '𞹍', '\U0001ee50', '𞹑', '\U0001ee53', '𞹔', '\U0001ee55', '𞹗', '\U0001ee58', '𞹙', '\U0001ee5a', '𞹛', '\U0001ee5c', '𞹝', '\U0001ee5e', '𞹟', '\U0001ee60', '𞹡', '\U0001ee63', '𞹤', '\U0001ee65', '𞹧', '\U0001ee6b', '𞹬', '\U0001ee73', '𞹴', '\U0001ee78', '𞹹', '\U0001ee7d', '𞹾', '\U0001ee7f', '𞺀', '\U0001ee8a', '𞺋', '\U0001ee9c', '𞺡', '\U0001eea4', '𞺥', '\U0001eeaa', '𞺫', '\U0001eebc', '𠀀', '\U0002a6e0', '𪜀', '\U0002b81e', '𫠠', '\U0002ceae', '𬺰', '\U0002ebe1', '𮯰', '\U0002ee5e', '丽', '\U0002fa1e', '𰀀', '\U0003134b', '𱍐', '\U0003347a'},
+								rangeSets: "\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00",
+								alts:      [][]int{{}, {0, 1, 2}, {1, 2}, {2}},
+								expected:  [][]string{{"\"abc\"i", "[a-c]i", "[\\pL]"}, {}, {"\"abc\"i"}, {"\"abc\"i", "[a-c]i"}},
+							},
 						},
 					},
 					&charClassMatcher{
 						pos:        position{line: 13, col: 49, offset: 443},
+						id:         82,
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -370,30 +464,37 @@ var g = &grammar{
 		{
 			name: "case08",
 			pos:  position{line: 14, col: 1, offset: 449},
+			id:   8,
 			expr: &seqExpr{
 				pos: position{line: 14, col: 10, offset: 460},
+				id:  83,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 14, col: 10, offset: 460},
+						id:         84,
 						val:        "case08",
 						ignoreCase: false,
 						want:       "\"case08\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 14, col: 19, offset: 469},
+						id:   85,
 						name: "__",
 					},
 					&andExpr{
 						pos: position{line: 14, col: 22, offset: 472},
+						id:  86,
 						expr: &litMatcher{
 							pos:        position{line: 14, col: 23, offset: 473},
+							id:         87,
 							val:        "a",
 							ignoreCase: true,
 							want:       "\"a\"i",
 						},
 					},
 					&anyMatcher{
-						line: 14, col: 28, offset: 478,
+						pos: position{line: 14, col: 28, offset: 478},
+						id:  88,
 					},
 				},
 			},
@@ -401,23 +502,29 @@ var g = &grammar{
 		{
 			name: "case09",
 			pos:  position{line: 15, col: 1, offset: 480},
+			id:   9,
 			expr: &seqExpr{
 				pos: position{line: 15, col: 10, offset: 491},
+				id:  89,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 15, col: 10, offset: 491},
+						id:         90,
 						val:        "case09",
 						ignoreCase: false,
 						want:       "\"case09\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 15, col: 19, offset: 500},
+						id:   91,
 						name: "__",
 					},
 					&andExpr{
 						pos: position{line: 15, col: 22, offset: 503},
+						id:  92,
 						expr: &charClassMatcher{
 							pos:        position{line: 15, col: 23, offset: 504},
+							id:         93,
 							val:        "[0-9]",
 							ranges:     []rune{'0', '9'},
 							ignoreCase: false,
@@ -425,7 +532,8 @@ var g = &grammar{
 						},
 					},
 					&anyMatcher{
-						line: 15, col: 29, offset: 510,
+						pos: position{line: 15, col: 29, offset: 510},
+						id:  94,
 					},
 				},
 			},
@@ -433,32 +541,40 @@ var g = &grammar{
 		{
 			name: "case10",
 			pos:  position{line: 16, col: 1, offset: 512},
+			id:   10,
 			expr: &seqExpr{
 				pos: position{line: 16, col: 10, offset: 523},
+				id:  95,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 16, col: 10, offset: 523},
+						id:         96,
 						val:        "case10",
 						ignoreCase: false,
 						want:       "\"case10\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 16, col: 19, offset: 532},
+						id:   97,
 						name: "__",
 					},
 					&andExpr{
 						pos: position{line: 16, col: 22, offset: 535},
+						id:  98,
 						expr: &choiceExpr{
 							pos: position{line: 16, col: 24, offset: 537},
+							id:  99,
 							alternatives: []any{
 								&litMatcher{
 									pos:        position{line: 16, col: 24, offset: 537},
+									id:         100,
 									val:        "0",
 									ignoreCase: false,
 									want:       "\"0\"",
 								},
 								&charClassMatcher{
 									pos:        position{line: 16, col: 30, offset: 543},
+									id:         101,
 									val:        "[012]",
 									chars:      []rune{'0', '1', '2'},
 									ignoreCase: false,
@@ -466,6 +582,7 @@ var g = &grammar{
 								},
 								&charClassMatcher{
 									pos:        position{line: 16, col: 38, offset: 551},
+									id:         102,
 									val:        "[3-9]",
 									ranges:     []rune{'3', '9'},
 									ignoreCase: false,
@@ -473,16 +590,25 @@ var g = &grammar{
 								},
 								&charClassMatcher{
 									pos:        position{line: 16, col: 46, offset: 559},
+									id:         103,
 									val:        "[\\pN]",
 									classes:    []*unicode.RangeTable{rangeTable("N")},
 									ignoreCase: false,
 									inverted:   false,
 								},
 							},
+							dispatch: &choiceDispatch{
+								ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x02\x03\x03\x03\x03\x03\x03\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+								ranges:    []rune{'\u0080', '²', '´', '¹', 'º', '¼', '¿', '٠', '٪', '۰', 'ۺ', '߀', 'ߊ', '०', '॰', '০', 'ৰ', '৴', '৺', '੦', 'ੰ', '૦', '૰', '୦', '୰', '୲', '\u0b78', '௦', '௳', '౦', '\u0c70', '౸', '౿', '೦', '\u0cf0', '൘', 'ൟ', '൦', '൹', '෦', '\u0df0', '๐', '๚', '໐', '\u0eda', '༠', '༴', '၀', '၊', '႐', 'ႚ', '፩', '\u137d', 'ᛮ', 'ᛱ', '០', '\u17ea', '៰', '\u17fa', '᠐', '\u181a', '᥆', 'ᥐ', '᧐', '\u19db', '᪀', '\u1a8a', '᪐', '\u1a9a', '᭐', '᭚', '᮰', 'ᮺ', '᱀', '\u1c4a', '᱐', 'ᱚ', '⁰', 'ⁱ', '⁴', '⁺', '₀', '₊', '⅐', 'Ↄ', 'ↅ', '↊', '①', '⒜', '⓪', '─', '❶', '➔', '⳽', '⳾', '〇', '〈', '〡', '〪', '〸', '〻', '㆒', '㆖', '㈠', '㈪', '㉈', '㉐', '㉑', '㉠', '㊀', '㊊', '㊱', '㋀', '꘠', 'ꘪ', 'ꛦ', '꛰', '꠰', '꠶', '꣐', '\ua8da', '꤀', 'ꤊ', '꧐', '\ua9da', '꧰', 'ꧺ', '꩐', '\uaa5a', '꯰', '\uabfa', '０', '：', '𐄇', '\U00010134', '𐅀', '𐅹', '𐆊', '𐆌', '𐋡', '\U000102fc', '𐌠', '\U00010324', '𐍁', '𐍂', '𐍊', '\U0001034b', '𐏑', '\U000103d6', '𐒠', '\U000104aa', '𐡘', '𐡠', '𐡹', '𐢀', '𐢧', '\U000108b0', '𐣻', '𐤀', '𐤖', '\U0001091c', '𐦼', '𐦾', '𐧀', '\U000109d0', '𐧒', '𐨀', '𐩀', '\U00010a49', '𐩽', '𐩿', '𐪝', '\U00010aa0', '𐫫', '𐫰', '𐭘', '𐭠', '𐭸', '𐮀', '𐮩', '\U00010bb0', '𐳺', '𐴀', '𐴰', '\U00010d3a', '𐵀', '𐵊', '𐹠', '\U00010e7f', '𐼝', '𐼧', '𐽑', '𐽕', '𐿅', '\U00010fcc', '𑁒', '𑁰', '𑃰', '\U000110fa', '𑄶', '𑅀', '𑇐', '𑇚', '𑇡', '\U000111f5', '𑋰', '\U000112fa', '𑑐', '𑑚', '𑓐', '\U000114da', '𑙐', '\U0001165a', '𑛀', '\U000116ca', '𑛐', '\U000116e4', '𑜰', '𑜼', '𑣠', '\U000118f3', '𑥐', '\U0001195a', '𑯰', '\U00011bfa', '𑱐', '\U00011c6d', '𑵐', '\U00011d5a', '𑶠', '\U00011daa', '𑷠', '\U00011dea', '𑽐', '𑽚', '𑿀', '𑿕', '𒐀', '\U0001246f', '𖄰', '\U0001613a', '𖩠', '\U00016a6a', '𖫀', '\U00016aca', '𖭐', '\U00016b5a', '𖭛', '\U00016b62', '𖵰', '\U00016d7a', '𖺀', '𖺗', '𖿴', '\U00016ff7', '𜳰', '𜳺', '𝋀', '\U0001d2d4', '𝋠', '\U0001d2f4', '𝍠', '\U0001d379', '𝟎', '𝠀', '𞅀', '\U0001e14a', '𞋰', '\U0001e2fa', '𞓰', '\U0001e4fa', '𞗱', '\U0001e5fb', '𞣇', '𞣐', '𞥐', '\U0001e95a', '𞱱', '𞲬', '𞲭', '𞲰', '𞲱', '\U0001ecb5', '𞴁', '𞴮', '𞴯', '\U0001ed3e', '🄀', '🄍', '🯰', '🯺'},
+								rangeSets: "\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00",
+								alts:      [][]int{{}, {0, 1, 3}, {1, 3}, {2, 3}, {3}},
+								expected:  [][]string{{"\"0\"", "[012]", "[3-9]", "[\\pN]"}, {"[3-9]"}, {"\"0\"", "[3-9]"}, {"\"0\"", "[012]"}, {"\"0\"", "[012]", "[3-9]"}},
+							},
 						},
 					},
 					&anyMatcher{
-						line: 16, col: 53, offset: 566,
+						pos: position{line: 16, col: 53, offset: 566},
+						id:  104,
 					},
 				},
 			},
@@ -490,25 +616,32 @@ var g = &grammar{
 		{
 			name: "case11",
 			pos:  position{line: 17, col: 1, offset: 568},
+			id:   11,
 			expr: &seqExpr{
 				pos: position{line: 17, col: 10, offset: 579},
+				id:  105,
 				exprs: []any{
 					&litMatcher{
 						pos:        position{line: 17, col: 10, offset: 579},
+						id:         106,
 						val:        "case11",
 						ignoreCase: false,
 						want:       "\"case11\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 17, col: 19, offset: 588},
+						id:   107,
 						name: "__",
 					},
 					&notExpr{
 						pos: position{line: 17, col: 22, offset: 591},
+						id:  108,
 						expr: &notExpr{
 							pos: position{line: 17, col: 24, offset: 593},
+							id:  109,
 							expr: &litMatcher{
 								pos:        position{line: 17, col: 26, offset: 595},
+								id:         110,
 								val:        "a",
 								ignoreCase: false,
 								want:       "\"a\"",
@@ -517,6 +650,7 @@ var g = &grammar{
 					},
 					&litMatcher{
 						pos:        position{line: 17, col: 32, offset: 601},
+						id:         111,
 						val:        "a",
 						ignoreCase: false,
 						want:       "\"a\"",
@@ -527,8 +661,10 @@ var g = &grammar{
 		{
 			name: "increment",
 			pos:  position{line: 19, col: 1, offset: 606},
+			id:   12,
 			expr: &litMatcher{
 				pos:        position{line: 19, col: 13, offset: 620},
+				id:         112,
 				val:        "inc",
 				ignoreCase: false,
 				want:       "\"inc\"",
@@ -537,8 +673,10 @@ var g = &grammar{
 		{
 			name: "decrement",
 			pos:  position{line: 20, col: 1, offset: 626},
+			id:   13,
 			expr: &litMatcher{
 				pos:        position{line: 20, col: 13, offset: 640},
+				id:         113,
 				val:        "dec",
 				ignoreCase: false,
 				want:       "\"dec\"",
@@ -547,8 +685,10 @@ var g = &grammar{
 		{
 			name: "zero",
 			pos:  position{line: 21, col: 1, offset: 646},
+			id:   14,
 			expr: &litMatcher{
 				pos:        position{line: 21, col: 8, offset: 655},
+				id:         114,
 				val:        "zero",
 				ignoreCase: false,
 				want:       "\"zero\"",
@@ -557,8 +697,10 @@ var g = &grammar{
 		{
 			name: "oneOrMore",
 			pos:  position{line: 22, col: 1, offset: 662},
+			id:   15,
 			expr: &litMatcher{
 				pos:        position{line: 22, col: 13, offset: 676},
+				id:         115,
 				val:        "oneOrMore",
 				ignoreCase: false,
 				want:       "\"oneOrMore\"",
@@ -567,10 +709,13 @@ var g = &grammar{
 		{
 			name: "_",
 			pos:  position{line: 23, col: 1, offset: 688},
+			id:   16,
 			expr: &zeroOrMoreExpr{
 				pos: position{line: 23, col: 5, offset: 694},
+				id:  116,
 				expr: &charClassMatcher{
 					pos:        position{line: 23, col: 5, offset: 694},
+					id:         117,
 					val:        "[ \\t\\n\\r]",
 					chars:      []rune{' ', '\t', '\n', '\r'},
 					ignoreCase: false,
@@ -581,8 +726,10 @@ var g = &grammar{
 		{
 			name: "__",
 			pos:  position{line: 24, col: 1, offset: 705},
+			id:   17,
 			expr: &charClassMatcher{
 				pos:        position{line: 24, col: 6, offset: 712},
+				id:         118,
 				val:        "[ ]",
 				chars:      []rune{' '},
 				ignoreCase: false,
@@ -592,10 +739,13 @@ var g = &grammar{
 		{
 			name: "EOF",
 			pos:  position{line: 25, col: 1, offset: 716},
+			id:   18,
 			expr: &notExpr{
 				pos: position{line: 25, col: 7, offset: 724},
+				id:  119,
 				expr: &anyMatcher{
-					line: 25, col: 8, offset: 725,
+					pos: position{line: 25, col: 8, offset: 725},
+					id:  120,
 				},
 			},
 		},
@@ -656,7 +806,8 @@ func Entrypoint(ruleName string) Option {
 
 // Statistics adds a user provided Stats struct to the parser to allow
 // the user to process the results after the parsing has finished.
-// Also the key for the "no match" counter is set.
+// Also the key for the "no match" counter is set. Per-rule statistics
+// (see RuleStats) are only collected when this option is set.
 //
 // Example usage:
 //
@@ -680,6 +831,9 @@ func Statistics(stats *Stats, choiceNoMatch string) Option {
 		if p.Stats.ChoiceAltCnt == nil {
 			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
 		}
+		if p.Stats.Rules == nil {
+			p.Stats.Rules = make(map[string]*RuleStats)
+		}
 		return Statistics(oldStats, oldChoiceNoMatch)
 	}
 }
@@ -700,6 +854,9 @@ func Debug(b bool) Option {
 // the parser will cache all results so each expression is evaluated only
 // once. This guarantees linear parsing time even for pathological cases,
 // at the expense of more memory and slower times for typical cases.
+// The rules annotated with @memo in the grammar are always memoized,
+// regardless of this option. The memoization table is pooled, so its
+// storage is reused by the following parses.
 //
 // The default is false.
 func Memoize(b bool) Option {
@@ -724,6 +881,57 @@ func AllowInvalidUTF8(b bool) Option {
 	}
 }
 
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
 // Recover creates an Option to set the recover flag to b. When set to
 // true, this causes the parser to recover from panics and convert it
 // to an error. Setting it to false can be useful while debugging to
@@ -839,17 +1047,68 @@ type rule struct {
 	name        string
 	displayName string
 	expr        any
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+//
+//	nolint: structcheck
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
 }
 
 // nolint: structcheck
 type choiceExpr struct {
 	pos          position
+	id           int
 	alternatives []any
+	dispatch     *choiceDispatch
 }
 
 // nolint: structcheck
 type actionExpr struct {
 	pos  position
+	id   int
 	expr any
 	run  func(*parser) (any, error)
 }
@@ -857,6 +1116,7 @@ type actionExpr struct {
 // nolint: structcheck
 type recoveryExpr struct {
 	pos          position
+	id           int
 	expr         any
 	recoverExpr  any
 	failureLabel []string
@@ -865,18 +1125,14 @@ type recoveryExpr struct {
 // nolint: structcheck
 type seqExpr struct {
 	pos   position
+	id    int
 	exprs []any
 }
 
-// nolint: structcheck
-type throwExpr struct {
-	pos   position
-	label string
-}
-
 // nolint: structcheck
 type labeledExpr struct {
 	pos   position
+	id    int
 	label string
 	expr  any
 }
@@ -884,7 +1140,18 @@ type labeledExpr struct {
 // nolint: structcheck
 type expr struct {
 	pos  position
+	id   int
+	expr any
+}
+
+// nolint: structcheck
+type repeatExpr struct {
+	pos  position
+	id   int
 	expr any
+	// max is -1 if the number of repetitions is not bounded.
+	min int
+	max int
 }
 
 type (
@@ -898,30 +1165,35 @@ type (
 // nolint: structcheck
 type ruleRefExpr struct {
 	pos  position
+	id   int
 	name string
 }
 
 // nolint: structcheck
 type stateCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) error
 }
 
 // nolint: structcheck
 type andCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) (bool, error)
 }
 
 // nolint: structcheck
 type notCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) (bool, error)
 }
 
 // nolint: structcheck
 type litMatcher struct {
 	pos        position
+	id         int
 	val        string
 	ignoreCase bool
 	want       string
@@ -930,6 +1202,7 @@ type litMatcher struct {
 // nolint: structcheck
 type charClassMatcher struct {
 	pos             position
+	id              int
 	val             string
 	basicLatinChars [128]bool
 	chars           []rune
@@ -939,7 +1212,61 @@ type charClassMatcher struct {
 	inverted        bool
 }
 
-type anyMatcher position // nolint: structcheck
+// nolint: structcheck
+type anyMatcher struct {
+	pos position
+	id  int
+}
+
+// matchCaseFold matches the full case folding of the input against val,
+// the case folding of a case-insensitive literal. The input is consumed
+// if it matches, it must be restored otherwise.
+func (p *parser) matchCaseFold(val string) bool {
+	for len(val) > 0 {
+		if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+			// EOF - see utf8.DecodeRune
+			return false
+		}
+		if f, ok := fullCaseFolds[p.pt.rn]; ok {
+			if !strings.HasPrefix(val, f) {
+				return false
+			}
+			val = val[len(f):]
+		} else {
+			want, n := utf8.DecodeRuneInString(val)
+			if caseFold(p.pt.rn) != want {
+				return false
+			}
+			val = val[n:]
+		}
+		p.read()
+	}
+	return true
+}
+
+// caseFold returns the simple case folding of rn, the smallest lowercase
+// rune of its orbit for unicode.SimpleFold, or its smallest rune if it has
+// no lowercase rune.
+func caseFold(rn rune) rune {
+	if rn < utf8.RuneSelf {
+		if 'A' <= rn && rn <= 'Z' {
+			rn += 'a' - 'A'
+		}
+		return rn
+	}
+
+	folded := rn
+	for r := unicode.SimpleFold(rn); r != rn; r = unicode.SimpleFold(r) {
+		if lower := unicode.IsLower(r); lower != unicode.IsLower(folded) {
+			if lower {
+				folded = r
+			}
+		} else if r < folded {
+			folded = r
+		}
+	}
+	return folded
+}
 
 // errList cumulates the errors found by the parser.
 type errList []error
@@ -1024,6 +1351,7 @@ func newParser(filename string, b []byte, opts ...Option) *parser {
 		entrypoint: g.rules[0].name,
 	}
 	p.setOptions(opts)
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
 
 	if p.maxExprCnt == 0 {
 		p.maxExprCnt = math.MaxUint64
@@ -1059,7 +1387,8 @@ type Stats struct {
 	// ChoiceAltCnt is used to count for each ordered choice expression,
 	// which alternative is used how may times.
 	// These numbers allow to optimize the order of the ordered choice expression
-	// to increase the performance of the parser
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
 	//
 	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
 	// as the line and the column of the ordered choice.
@@ -1069,6 +1398,46 @@ type Stats struct {
 	// the parser option Statistics.
 	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
 	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
 }
 
 // nolint: structcheck,maligned
@@ -1085,9 +1454,9 @@ type parser struct {
 	debug   bool
 
 	memoize bool
-	// memoization table for the packrat algorithm:
-	// map[offset in source] map[expression or rule] {value, match}
-	memo map[int]map[any]resultTuple
+	// memoization table for the packrat algorithm, taken from memoTables
+	// on the first memoized result
+	memo *memoTable
 
 	// rules table, maps the rule identifier to the rule node
 	rules map[string]*rule
@@ -1108,11 +1477,33 @@ type parser struct {
 
 	allowInvalidUTF8 bool
 
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+
 	*Stats
+	// profiling frames, tracks the time spent in invoked rules to compute
+	// the self time of each rule.
+	profStack []profFrame
+	// number of active invocations of each rule, the time of a recursive
+	// rule is only added at its outermost invocation.
+	profActive map[*rule]int
+	// rules evaluated at each offset, to count the repeated evaluations.
+	profEvals map[profEval]bool
 
 	choiceNoMatch string
 	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
-	recoveryStack []map[string]any
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
 }
 
 // push a variable set on the vstack.
@@ -1151,7 +1542,7 @@ func (p *parser) popV() {
 func (p *parser) pushRecovery(labels []string, expr any) {
 	if cap(p.recoveryStack) == len(p.recoveryStack) {
 		// create new empty slot in the stack
-		p.recoveryStack = append(p.recoveryStack, nil)
+		p.recoveryStack = append(p.recoveryStack, recovery{})
 	} else {
 		// slice to 1 more
 		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
@@ -1161,13 +1552,13 @@ func (p *parser) pushRecovery(labels []string, expr any) {
 	for _, fl := range labels {
 		m[fl] = expr
 	}
-	p.recoveryStack[len(p.recoveryStack)-1] = m
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
 }
 
 // pop a recovery expression from the recoveryStack
 func (p *parser) popRecovery() {
 	// GC that map
-	p.recoveryStack[len(p.recoveryStack)-1] = nil
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
 
 	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
 }
@@ -1177,8 +1568,9 @@ func (p *parser) print(prefix, s string) string {
 		return s
 	}
 
+	pos := p.pt.position
 	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
-		prefix, p.pt.line, p.pt.col, p.pt.offset, s, p.pt.rn)
+		prefix, pos.line, pos.col, pos.offset, s, p.pt.rn)
 	return s
 }
 
@@ -1246,14 +1638,19 @@ func (p *parser) failAt(fail bool, pos position, want string) {
 
 // read advances the parser to the next rune.
 func (p *parser) read() {
-	p.pt.offset += p.pt.w
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
 	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
 	p.pt.rn = rn
 	p.pt.w = n
-	p.pt.col++
-	if rn == '\n' {
-		p.pt.line++
-		p.pt.col = 0
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
 	}
 
 	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
@@ -1263,6 +1660,36 @@ func (p *parser) read() {
 	}
 }
 
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
 // restore parser position to the savepoint pt.
 func (p *parser) restore(pt savepoint) {
 	if p.debug {
@@ -1271,6 +1698,9 @@ func (p *parser) restore(pt savepoint) {
 	if pt.offset == p.pt.offset {
 		return
 	}
+	if p.Rules != nil && pt.offset < p.pt.offset && len(p.rstack) > 0 {
+		p.ruleStats(p.rstack[len(p.rstack)-1]).Backtracked += uint64(p.pt.offset - pt.offset)
+	}
 	p.pt = pt
 }
 
@@ -1328,28 +1758,109 @@ func (p *parser) sliceFrom(start savepoint) []byte {
 	return p.data[start.position.offset:p.pt.position.offset]
 }
 
-func (p *parser) getMemoized(node any) (resultTuple, bool) {
-	if len(p.memo) == 0 {
-		return resultTuple{}, false
+// memoSlot is an entry of the memoization table, the key of an empty slot
+// is 0.
+type memoSlot struct {
+	key uint64
+	res resultTuple
+}
+
+// memoTable is the memoization table for the packrat algorithm, an
+// open-addressed hash table with linear probing that maps the offset in
+// the source and the id of a rule or expression to its result.
+type memoTable struct {
+	slots []memoSlot
+	shift uint
+	n     int
+}
+
+// memoTables pools the memoization tables so that their storage is
+// reused across parses.
+var memoTables = &sync.Pool{
+	New: func() any {
+		return &memoTable{slots: make([]memoSlot, 1<<10), shift: 64 - 10}
+	},
+}
+
+// memoKey returns the key of the result of the rule or expression id at
+// offset, it supports up to 1<<24 ids.
+func memoKey(offset, id int) uint64 {
+	return uint64(offset+1)<<24 | uint64(id)
+}
+
+// index returns the index of the slot of key, or of the empty slot where
+// it should be stored.
+func (t *memoTable) index(key uint64) int {
+	mask := len(t.slots) - 1
+	i := int((key*0x9E3779B97F4A7C15)>>t.shift) & mask
+	for t.slots[i].key != 0 && t.slots[i].key != key {
+		i = (i + 1) & mask
+	}
+	return i
+}
+
+func (t *memoTable) get(key uint64) (resultTuple, bool) {
+	s := &t.slots[t.index(key)]
+	return s.res, s.key == key
+}
+
+func (t *memoTable) set(key uint64, res resultTuple) {
+	if 4*(t.n+1) > 3*len(t.slots) {
+		t.grow()
+	}
+	s := &t.slots[t.index(key)]
+	if s.key == 0 {
+		s.key = key
+		t.n++
+	}
+	s.res = res
+}
+
+// grow doubles the capacity of the table.
+func (t *memoTable) grow() {
+	old := t.slots
+	t.slots = make([]memoSlot, 2*len(old))
+	t.shift--
+	for _, s := range old {
+		if s.key != 0 {
+			t.slots[t.index(s.key)] = s
+		}
+	}
+}
+
+// reset empties the table, releasing the references to the results.
+func (t *memoTable) reset() {
+	if t.n == 0 {
+		return
+	}
+	for i := range t.slots {
+		t.slots[i] = memoSlot{}
 	}
-	m := p.memo[p.pt.offset]
-	if len(m) == 0 {
+	t.n = 0
+}
+
+func (p *parser) getMemoized(id int) (resultTuple, bool) {
+	if p.memo == nil {
 		return resultTuple{}, false
 	}
-	res, ok := m[node]
-	return res, ok
+	return p.memo.get(memoKey(p.pt.offset, id))
 }
 
-func (p *parser) setMemoized(pt savepoint, node any, tuple resultTuple) {
+func (p *parser) setMemoized(pt savepoint, id int, tuple resultTuple) {
 	if p.memo == nil {
-		p.memo = make(map[int]map[any]resultTuple)
+		p.memo = memoTables.Get().(*memoTable)
 	}
-	m := p.memo[pt.offset]
-	if m == nil {
-		m = make(map[any]resultTuple)
-		p.memo[pt.offset] = m
+	p.memo.set(memoKey(pt.offset, id), tuple)
+}
+
+// releaseMemo returns the memoization table to the pool.
+func (p *parser) releaseMemo() {
+	if p.memo == nil {
+		return
 	}
-	m[node] = tuple
+	p.memo.reset()
+	memoTables.Put(p.memo)
+	p.memo = nil
 }
 
 func (p *parser) buildRulesTable(g *grammar) {
@@ -1368,6 +1879,7 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 
 	// TODO : not super critical but this could be generated
 	p.buildRulesTable(g)
+	defer p.releaseMemo()
 
 	if p.recover {
 		// panic can be used in action code to stop parsing immediately
@@ -1401,24 +1913,7 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 		if len(*p.errs) == 0 {
 			// If parsing fails, but no errors have been recorded, the expected values
 			// for the farthest parser position are returned as error.
-			maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
-			for _, v := range p.maxFailExpected {
-				maxFailExpectedMap[v] = struct{}{}
-			}
-			expected := make([]string, 0, len(maxFailExpectedMap))
-			eof := false
-			if _, ok := maxFailExpectedMap["!."]; ok {
-				delete(maxFailExpectedMap, "!.")
-				eof = true
-			}
-			for k := range maxFailExpectedMap {
-				expected = append(expected, k)
-			}
-			sort.Strings(expected)
-			if eof {
-				expected = append(expected, "EOF")
-			}
-			p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+			p.addNoMatchErr()
 		}
 
 		return nil, p.errs.err()
@@ -1426,6 +1921,36 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 	return val, p.errs.err()
 }
 
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
 func listJoin(list []string, sep string, lastSep string) string {
 	switch len(list) {
 	case 0:
@@ -1437,8 +1962,90 @@ func listJoin(list []string, sep string, lastSep string) string {
 	}
 }
 
+// profFrame is a rule invocation being profiled.
+type profFrame struct {
+	start time.Time
+	child time.Duration
+}
+
+// profEval is the evaluation of a rule at an offset.
+type profEval struct {
+	rule   *rule
+	offset int
+}
+
+// ruleStats returns the statistics of rule, creating them if needed.
+func (p *parser) ruleStats(rule *rule) *RuleStats {
+	rs := p.Rules[rule.name]
+	if rs == nil {
+		rs = &RuleStats{}
+		p.Rules[rule.name] = rs
+	}
+	return rs
+}
+
+func (p *parser) countMemo(rs *RuleStats, hit bool) {
+	if hit {
+		rs.MemoHits++
+	} else {
+		rs.MemoMisses++
+	}
+}
+
+func (p *parser) profEnter(r *rule) {
+	if p.profActive == nil {
+		p.profActive = make(map[*rule]int)
+	}
+	p.profActive[r]++
+	p.profStack = append(p.profStack, profFrame{start: time.Now()})
+}
+
+// countRepeat counts the evaluation of rule at the current offset if
+// it was already evaluated at that offset.
+func (p *parser) countRepeat(rule *rule) {
+	if p.profEvals == nil {
+		p.profEvals = make(map[profEval]bool)
+	}
+	key := profEval{rule: rule, offset: p.pt.offset}
+	if p.profEvals[key] {
+		p.ruleStats(rule).Repeats++
+		return
+	}
+	p.profEvals[key] = true
+}
+
+func (p *parser) profExit(rule *rule, start savepoint, ok bool) {
+	frame := p.profStack[len(p.profStack)-1]
+	p.profStack = p.profStack[:len(p.profStack)-1]
+	elapsed := time.Since(frame.start)
+	if n := len(p.profStack); n > 0 {
+		p.profStack[n-1].child += elapsed
+	}
+
+	rs := p.ruleStats(rule)
+	rs.Invocations++
+	if p.profActive[rule]--; p.profActive[rule] == 0 {
+		// the time of the inner invocations is included
+		rs.Time += elapsed
+	}
+	rs.SelfTime += elapsed - frame.child
+	if ok {
+		rs.Matches++
+		rs.Bytes += uint64(p.pt.offset - start.offset)
+	} else {
+		rs.Failures++
+	}
+}
+
 func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
-	res, ok := p.getMemoized(rule)
+	if rule.id < 0 {
+		// its results depend on the captured texts or the state store
+		return p.parseRule(rule)
+	}
+	res, ok := p.getMemoized(rule.id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(rule), ok)
+	}
 	if ok {
 		p.restore(res.end)
 		return res.v, res.b
@@ -1446,7 +2053,7 @@ func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
 
 	startMark := p.pt
 	val, ok := p.parseRule(rule)
-	p.setMemoized(startMark, rule, resultTuple{val, ok, p.pt})
+	p.setMemoized(startMark, rule.id, resultTuple{v: val, b: ok, end: p.pt})
 
 	return val, ok
 }
@@ -1461,6 +2068,11 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 		startMark = p.pt
 	)
 
+	if p.Rules != nil {
+		p.profEnter(rule)
+		defer func() { p.profExit(rule, startMark, ok) }()
+	}
+
 	if p.memoize {
 		val, ok = p.parseRuleMemoize(rule)
 	} else {
@@ -1474,6 +2086,9 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 }
 
 func (p *parser) parseRule(rule *rule) (any, bool) {
+	if p.Rules != nil {
+		p.countRepeat(rule)
+	}
 	p.rstack = append(p.rstack, rule)
 	p.pushV()
 	val, ok := p.parseExprWrap(rule.expr)
@@ -1482,11 +2097,32 @@ func (p *parser) parseRule(rule *rule) (any, bool) {
 	return val, ok
 }
 
+// incChoiceAltCnt counts the match of the alternative altI of the choice
+// at pos, or its failure if altI is choiceNoMatch.
+func (p *parser) incChoiceAltCnt(pos position, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, pos.line, pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
 func (p *parser) parseExprWrap(expr any) (any, bool) {
 	var pt savepoint
+	id := exprID(expr)
 
-	if p.memoize {
-		res, ok := p.getMemoized(expr)
+	if p.memoize && id >= 0 {
+		res, ok := p.getMemoized(id)
+		if p.Rules != nil {
+			p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+		}
 		if ok {
 			p.restore(res.end)
 			return res.v, res.b
@@ -1496,12 +2132,60 @@ func (p *parser) parseExprWrap(expr any) (any, bool) {
 
 	val, ok := p.parseExpr(expr)
 
-	if p.memoize {
-		p.setMemoized(pt, expr, resultTuple{val, ok, p.pt})
+	if p.memoize && id >= 0 {
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
 	}
 	return val, ok
 }
 
+// exprID returns the id of the expression in the memoization table, -1 if
+// its results depend on the texts captured by the labels or on the state
+// store and are not memoized.
+//
+//	nolint: gocyclo
+func exprID(expr any) int {
+	switch expr := expr.(type) {
+	case *actionExpr:
+		return expr.id
+	case *andCodeExpr:
+		return expr.id
+	case *andExpr:
+		return expr.id
+	case *anyMatcher:
+		return expr.id
+	case *charClassMatcher:
+		return expr.id
+	case *choiceExpr:
+		return expr.id
+	case *labeledExpr:
+		return expr.id
+	case *litMatcher:
+		return expr.id
+	case *notCodeExpr:
+		return expr.id
+	case *notExpr:
+		return expr.id
+	case *oneOrMoreExpr:
+		return expr.id
+	case *recoveryExpr:
+		return expr.id
+	case *repeatExpr:
+		return expr.id
+	case *ruleRefExpr:
+		return expr.id
+	case *seqExpr:
+		return expr.id
+	case *stateCodeExpr:
+		return expr.id
+	case *zeroOrMoreExpr:
+		return expr.id
+	case *zeroOrOneExpr:
+		return expr.id
+	default:
+		panic(fmt.Sprintf("unknown expression type %T", expr))
+	}
+}
+
 // nolint: gocyclo
 func (p *parser) parseExpr(expr any) (any, bool) {
 	p.ExprCnt++
@@ -1536,14 +2220,14 @@ func (p *parser) parseExpr(expr any) (any, bool) {
 		val, ok = p.parseOneOrMoreExpr(expr)
 	case *recoveryExpr:
 		val, ok = p.parseRecoveryExpr(expr)
+	case *repeatExpr:
+		val, ok = p.parseRepeatExpr(expr)
 	case *ruleRefExpr:
 		val, ok = p.parseRuleRefExpr(expr)
 	case *seqExpr:
 		val, ok = p.parseSeqExpr(expr)
 	case *stateCodeExpr:
 		val, ok = p.parseStateCodeExpr(expr)
-	case *throwExpr:
-		val, ok = p.parseThrowExpr(expr)
 	case *zeroOrMoreExpr:
 		val, ok = p.parseZeroOrMoreExpr(expr)
 	case *zeroOrOneExpr:
@@ -1643,7 +2327,7 @@ func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
 	}
 
 	if chr.ignoreCase {
-		cur = unicode.ToLower(cur)
+		cur = caseFold(cur)
 	}
 
 	// try to match in the list of available chars
@@ -1694,29 +2378,29 @@ func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
 	return nil, false
 }
 
-func (p *parser) incChoiceAltCnt(ch *choiceExpr, altI int) {
-	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, ch.pos.line, ch.pos.col)
-	m := p.ChoiceAltCnt[choiceIdent]
-	if m == nil {
-		m = make(map[string]int)
-		p.ChoiceAltCnt[choiceIdent] = m
-	}
-	// We increment altI by 1, so the keys do not start at 0
-	alt := strconv.Itoa(altI + 1)
-	if altI == choiceNoMatch {
-		alt = p.choiceNoMatch
-	}
-	m[alt]++
-}
-
 func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseChoiceExpr"))
 	}
 
-	for altI, alt := range ch.alternatives {
-		// dummy assignment to prevent compile error if optimized
-		_ = altI
+	n := len(ch.alternatives)
+	var alts []int
+	if d := ch.dispatch; d != nil {
+		// the alternatives that cannot match the current rune are skipped,
+		// with the values they expect.
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		alts = d.alts[set]
+		n = len(alts)
+	}
+	for i := 0; i < n; i++ {
+		altI := i
+		if alts != nil {
+			altI = alts[i]
+		}
+		alt := ch.alternatives[altI]
 
 		state := p.cloneState()
 
@@ -1724,12 +2408,12 @@ func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 		val, ok := p.parseExprWrap(alt)
 		p.popV()
 		if ok {
-			p.incChoiceAltCnt(ch, altI)
+			p.incChoiceAltCnt(ch.pos, altI)
 			return val, ok
 		}
 		p.restoreState(state)
 	}
-	p.incChoiceAltCnt(ch, choiceNoMatch)
+	p.incChoiceAltCnt(ch.pos, choiceNoMatch)
 	return nil, false
 }
 
@@ -1754,6 +2438,15 @@ func (p *parser) parseLitMatcher(lit *litMatcher) (any, bool) {
 	}
 
 	start := p.pt
+	if lit.ignoreCase {
+		if !p.matchCaseFold(lit.val) {
+			p.failAt(false, start.position, lit.want)
+			p.restore(start)
+			return nil, false
+		}
+		p.failAt(true, start.position, lit.want)
+		return p.sliceFrom(start), true
+	}
 	for _, want := range lit.val {
 		cur := p.pt.rn
 		if lit.ignoreCase {
@@ -1838,6 +2531,33 @@ func (p *parser) parseRecoveryExpr(recover *recoveryExpr) (any, bool) {
 	return val, ok
 }
 
+func (p *parser) parseRepeatExpr(expr *repeatExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRepeatExpr"))
+	}
+
+	var vals []any
+
+	pt := p.pt
+	state := p.cloneState()
+	for expr.max < 0 || len(vals) < expr.max {
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			break
+		}
+		vals = append(vals, val)
+	}
+	if len(vals) < expr.min {
+		// did not match enough times, no match
+		p.restoreState(state)
+		p.restore(pt)
+		return nil, false
+	}
+	return vals, true
+}
+
 func (p *parser) parseRuleRefExpr(ref *ruleRefExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseRuleRefExpr " + ref.name))
@@ -1888,22 +2608,6 @@ func (p *parser) parseStateCodeExpr(state *stateCodeExpr) (any, bool) {
 	return nil, true
 }
 
-func (p *parser) parseThrowExpr(expr *throwExpr) (any, bool) {
-	if p.debug {
-		defer p.out(p.in("parseThrowExpr"))
-	}
-
-	for i := len(p.recoveryStack) - 1; i >= 0; i-- {
-		if recoverExpr, ok := p.recoveryStack[i][expr.label]; ok {
-			if val, ok := p.parseExprWrap(recoverExpr); ok {
-				return val, ok
-			}
-		}
-	}
-
-	return nil, false
-}
-
 func (p *parser) parseZeroOrMoreExpr(expr *zeroOrMoreExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseZeroOrMoreExpr"))
@@ -1948,3 +2652,112 @@ func rangeTable(class string) *unicode.RangeTable {
 	// cannot happen
 	panic(fmt.Sprintf("invalid Unicode class: %s", class))
 }
+
+// fullCaseFolds are the full case foldings of more than one rune, with
+// the simple case folding of their runes.
+var fullCaseFolds = map[rune]string{
+	0x00df: "ss",
+	0x0130: "i\u0307",
+	0x0149: "\u02bcn",
+	0x01f0: "j\u030c",
+	0x0390: "\u03b9\u0308\u0301",
+	0x03b0: "\u03c5\u0308\u0301",
+	0x0587: "\u0565\u0582",
+	0x1e96: "h\u0331",
+	0x1e97: "t\u0308",
+	0x1e98: "w\u030a",
+	0x1e99: "y\u030a",
+	0x1e9a: "a\u02be",
+	0x1e9e: "ss",
+	0x1f50: "\u03c5\u0313",
+	0x1f52: "\u03c5\u0313\u0300",
+	0x1f54: "\u03c5\u0313\u0301",
+	0x1f56: "\u03c5\u0313\u0342",
+	0x1f80: "\u1f00\u03b9",
+	0x1f81: "\u1f01\u03b9",
+	0x1f82: "\u1f02\u03b9",
+	0x1f83: "\u1f03\u03b9",
+	0x1f84: "\u1f04\u03b9",
+	0x1f85: "\u1f05\u03b9",
+	0x1f86: "\u1f06\u03b9",
+	0x1f87: "\u1f07\u03b9",
+	0x1f88: "\u1f00\u03b9",
+	0x1f89: "\u1f01\u03b9",
+	0x1f8a: "\u1f02\u03b9",
+	0x1f8b: "\u1f03\u03b9",
+	0x1f8c: "\u1f04\u03b9",
+	0x1f8d: "\u1f05\u03b9",
+	0x1f8e: "\u1f06\u03b9",
+	0x1f8f: "\u1f07\u03b9",
+	0x1f90: "\u1f20\u03b9",
+	0x1f91: "\u1f21\u03b9",
+	0x1f92: "\u1f22\u03b9",
+	0x1f93: "\u1f23\u03b9",
+	0x1f94: "\u1f24\u03b9",
+	0x1f95: "\u1f25\u03b9",
+	0x1f96: "\u1f26\u03b9",
+	0x1f97: "\u1f27\u03b9",
+	0x1f98: "\u1f20\u03b9",
+	0x1f99: "\u1f21\u03b9",
+	0x1f9a: "\u1f22\u03b9",
+	0x1f9b: "\u1f23\u03b9",
+	0x1f9c: "\u1f24\u03b9",
+	0x1f9d: "\u1f25\u03b9",
+	0x1f9e: "\u1f26\u03b9",
+	0x1f9f: "\u1f27\u03b9",
+	0x1fa0: "\u1f60\u03b9",
+	0x1fa1: "\u1f61\u03b9",
+	0x1fa2: "\u1f62\u03b9",
+	0x1fa3: "\u1f63\u03b9",
+	0x1fa4: "\u1f64\u03b9",
+	0x1fa5: "\u1f65\u03b9",
+	0x1fa6: "\u1f66\u03b9",
+	0x1fa7: "\u1f67\u03b9",
+	0x1fa8: "\u1f60\u03b9",
+	0x1fa9: "\u1f61\u03b9",
+	0x1faa: "\u1f62\u03b9",
+	0x1fab: "\u1f63\u03b9",
+	0x1fac: "\u1f64\u03b9",
+	0x1fad: "\u1f65\u03b9",
+	0x1fae: "\u1f66\u03b9",
+	0x1faf: "\u1f67\u03b9",
+	0x1fb2: "\u1f70\u03b9",
+	0x1fb3: "\u03b1\u03b9",
+	0x1fb4: "\u03ac\u03b9",
+	0x1fb6: "\u03b1\u0342",
+	0x1fb7: "\u03b1\u0342\u03b9",
+	0x1fbc: "\u03b1\u03b9",
+	0x1fc2: "\u1f74\u03b9",
+	0x1fc3: "\u03b7\u03b9",
+	0x1fc4: "\u03ae\u03b9",
+	0x1fc6: "\u03b7\u0342",
+	0x1fc7: "\u03b7\u0342\u03b9",
+	0x1fcc: "\u03b7\u03b9",
+	0x1fd2: "\u03b9\u0308\u0300",
+	0x1fd3: "\u03b9\u0308\u0301",
+	0x1fd6: "\u03b9\u0342",
+	0x1fd7: "\u03b9\u0308\u0342",
+	0x1fe2: "\u03c5\u0308\u0300",
+	0x1fe3: "\u03c5\u0308\u0301",
+	0x1fe4: "\u03c1\u0313",
+	0x1fe6: "\u03c5\u0342",
+	0x1fe7: "\u03c5\u0308\u0342",
+	0x1ff2: "\u1f7c\u03b9",
+	0x1ff3: "\u03c9\u03b9",
+	0x1ff4: "\u03ce\u03b9",
+	0x1ff6: "\u03c9\u0342",
+	0x1ff7: "\u03c9\u0342\u03b9",
+	0x1ffc: "\u03c9\u03b9",
+	0xfb00: "ff",
+	0xfb01: "fi",
+	0xfb02: "fl",
+	0xfb03: "ffi",
+	0xfb04: "ffl",
+	0xfb05: "st",
+	0xfb06: "st",
+	0xfb13: "\u0574\u0576",
+	0xfb14: "\u0574\u0565",
+	0xfb15: "\u0574\u056b",
+	0xfb16: "\u057e\u0576",
+	0xfb17: "\u0574\u056d",
+}
@@ -0,0 +1 @@
+../failure_messages_test.go
\ No newline at end of file
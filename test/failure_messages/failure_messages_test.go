@@ -0,0 +1,121 @@
+package failuremessages
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFailureMessages(t *testing.T) {
+	cases := []struct {
+		input    string
+		captures []string
+		errors   []string
+	}{
+		{
+			input:    "one,two",
+			captures: []string{"one", "two"},
+		},
+		{
+			input:    "one two three",
+			captures: []string{"one", "two", "three"},
+			errors: []string{
+				`1:5 (4): rule ErrComma: expecting ',', found 't' after "one"`,
+				`1:9 (8): rule ErrComma: expecting ',', found 't' after "one two"`,
+			},
+		},
+		{
+			input:    "1,\n two, \n3,",
+			captures: []string{"NONE", "two", "NONE", "NONE"},
+			errors: []string{
+				`1:1 (0): rule ErrID: errId: expecting [ \t\r\n] or [a-z], found '1'`,
+				// the failure is at the unexpected 3, after the spaces
+				`3:1 (10): rule ErrID: errId: expecting [ \t\r\n] or [a-z], found '3'`,
+				`3:3 (12): rule ErrID: errId: expecting [ \t\r\n] or [a-z], found EOF`,
+			},
+		},
+		{
+			input:    "one\n two123, \nthree,",
+			captures: []string{"one", "two", "three", "NONE"},
+			errors: []string{
+				// the failure is at the unexpected t, after the spaces
+				`2:2 (5): rule ErrComma: expecting ',', found 't' after "one"`,
+				`2:5 (8): rule ErrComma: expecting ',', found '1' after "one\n two"`,
+				`3:7 (20): rule ErrID: errId: expecting [ \t\r\n] or [a-z], found EOF`,
+			},
+		},
+		{
+			input:    "",
+			captures: []string{"NONE"},
+			errors: []string{
+				`1:1 (0): rule ErrID: errId: expecting [ \t\r\n] or [a-z], found EOF`,
+			},
+		},
+		{
+			input:    "1",
+			captures: []string{"NONE"},
+			errors:   []string{`1:1 (0): rule ErrID: errId: expecting [ \t\r\n] or [a-z], found '1'`},
+		},
+		{
+			input:    "1,2",
+			captures: []string{"NONE", "NONE"},
+			errors: []string{
+				`1:1 (0): rule ErrID: errId: expecting [ \t\r\n] or [a-z], found '1'`,
+				`1:3 (2): rule ErrID: errId: expecting [ \t\r\n] or [a-z], found '2'`,
+			},
+		},
+	}
+	for _, test := range cases {
+		got, err := Parse("", []byte(test.input))
+		if test.errors == nil && err != nil {
+			t.Fatalf("for input %q got error: %s, but expect to parse without errors", test.input, err)
+		}
+		if !reflect.DeepEqual(got, test.captures) {
+			t.Errorf("for input %q want %s, got %s", test.input, test.captures, got)
+		}
+		if err != nil {
+			list := err.(errList)
+			if len(list) != len(test.errors) {
+				t.Errorf("for input %q want %d error(s), got %d", test.input, len(test.errors), len(list))
+				t.Logf("expected errors:\n")
+				for _, ee := range test.errors {
+					t.Logf("- %s\n", ee)
+				}
+				t.Logf("got errors:\n")
+				for _, ee := range list {
+					t.Logf("- %s\n", ee)
+				}
+				t.FailNow()
+			}
+			for i, err := range list {
+				pe := err.(*parserError)
+				if pe.Error() != test.errors[i] {
+					t.Errorf("for input %q want %dth error to be %s, got %s", test.input, i+1, test.errors[i], pe)
+				}
+			}
+		}
+	}
+}
+
+func TestFailure(t *testing.T) {
+	_, err := Parse("", []byte("one two"))
+	list, ok := err.(errList)
+	if !ok || len(list) != 1 {
+		t.Fatalf("want 1 error, got %v", err)
+	}
+	pe := list[0].(*parserError)
+	var f *failure
+	if !errors.As(pe.Inner, &f) {
+		t.Fatalf("want failure, got %T", pe.Inner)
+	}
+	if f.label != "errComma" || string(f.text) != "one" {
+		t.Errorf("want label errComma and text %q, got %s and %q", "one", f.label, f.text)
+	}
+	if f.pos.offset != 4 || pe.pos != f.pos {
+		t.Errorf("want failure and error at offset 4, got %d and %d", f.pos.offset, pe.pos.offset)
+	}
+	want := []string{`","`, `[ \t\r\n]`}
+	if !reflect.DeepEqual(f.expected, want) || !reflect.DeepEqual(pe.expected, want) {
+		t.Errorf("want expected %q, got %q and %q", want, f.expected, pe.expected)
+	}
+}
@@ -0,0 +1 @@
+../global_store_test.go
\ No newline at end of file
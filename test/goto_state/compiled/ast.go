@@ -0,0 +1 @@
+../ast.go
\ No newline at end of file
@@ -0,0 +1 @@
+../goto_state_test.go
\ No newline at end of file
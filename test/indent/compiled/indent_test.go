@@ -0,0 +1 @@
+../indent_test.go
\ No newline at end of file
@@ -0,0 +1,89 @@
+package indent
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIndent(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "print a\n", want: []any{[]any{"print", "a"}}},
+		{in: "if a:\n  print b\n  print c\nprint d\n", want: []any{
+			[]any{"if", "a", []any{[]any{"print", "b"}, []any{"print", "c"}}},
+			[]any{"print", "d"},
+		}},
+		// the block of the else is indented with a tab, expanded to 8
+		{in: "if a:\n  if b:\n      print c\n  print d\nelse:\n\tprint e\n", want: []any{
+			[]any{"if", "a",
+				[]any{[]any{"if", "b", []any{[]any{"print", "c"}}}, []any{"print", "d"}},
+				[]any{[]any{"print", "e"}},
+			},
+		}},
+		{in: "\n  \nif a:\n\n  print b\n\nprint c", want: []any{
+			[]any{"if", "a", []any{[]any{"print", "b"}}},
+			[]any{"print", "c"},
+		}},
+	}
+	for _, tc := range cases {
+		for _, memo := range []bool{false, true} {
+			got, err := Parse("", []byte(tc.in), Memoize(memo))
+			if err != nil {
+				t.Errorf("%q: memoize %t: %v", tc.in, memo, err)
+				continue
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("%q: memoize %t: want %#v, got %#v", tc.in, memo, tc.want, got)
+			}
+		}
+	}
+}
+
+func TestIndentError(t *testing.T) {
+	cases := []struct {
+		in   string
+		opts []Option
+		err  string
+	}{
+		{in: "if a:\nprint b\n", err: `2:1 (6): no match found, expected: "\n", INDENT or [ \t]`},
+		{in: "if a:\n  print b\n    print c\n", err: `3:5 (20): no match found, expected: "\n" or [ \t]`},
+		// the dedent does not match any enclosing indentation
+		{in: "if a:\n    if b:\n        print c\n\n  print d\n", err: `5:3 (35): no match found, expected: "\n" or [ \t]`},
+		{
+			in:   "if a:\n  \tprint b\n",
+			opts: []Option{Indentation(4, IndentTabsNoMix)},
+			err:  "2:1 (6): rule Block: invalid tabs in the indentation",
+		},
+		{
+			in:   "if a:\n\tprint b\n",
+			opts: []Option{Indentation(4, IndentTabsReject)},
+			err:  "2:1 (6): rule Block: invalid tabs in the indentation",
+		},
+	}
+	for _, tc := range cases {
+		for _, memo := range []bool{false, true} {
+			got, err := Parse("", []byte(tc.in), append(tc.opts, Memoize(memo))...)
+			if err == nil {
+				t.Errorf("%q: memoize %t: want error, got %#v", tc.in, memo, got)
+				continue
+			}
+			if err.Error() != tc.err {
+				t.Errorf("%q: memoize %t: want error %q, got %q", tc.in, memo, tc.err, err)
+			}
+		}
+	}
+}
+
+func TestIndentTabs(t *testing.T) {
+	// the tabs are allowed without spaces
+	want := []any{[]any{"if", "a", []any{[]any{"print", "b"}}}}
+	got, err := Parse("", []byte("if a:\n\tprint b\n"), Indentation(4, IndentTabsNoMix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %#v, got %#v", want, got)
+	}
+}
@@ -0,0 +1 @@
+../issue_1_test.go
\ No newline at end of file
@@ -0,0 +1 @@
+../issue_18_test.go
\ No newline at end of file
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	compiled "github.com/mna/pigeon/test/issue_65/compiled"
 	optimized "github.com/mna/pigeon/test/issue_65/optimized"
 	optimizedgrammar "github.com/mna/pigeon/test/issue_65/optimized-grammar"
 )
@@ -27,6 +28,7 @@ func TestOptimizeGrammar(t *testing.T) {
 		"standard":          parseStd,
 		"optimized":         parseOpt,
 		"optimized-grammar": parseOptGrammar,
+		"compiled":          parseCompiled,
 	}
 	for name, parser := range parsers {
 		for _, c := range cases {
@@ -58,3 +60,7 @@ func parseOpt(input string) (any, error) {
 func parseOptGrammar(input string) (any, error) {
 	return optimizedgrammar.Parse("", []byte(input))
 }
+
+func parseCompiled(input string) (any, error) {
+	return compiled.Parse("", []byte(input))
+}
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	compiled "github.com/mna/pigeon/test/issue_70/compiled"
 	optimized "github.com/mna/pigeon/test/issue_70/optimized"
 	optimizedgrammar "github.com/mna/pigeon/test/issue_70/optimized-grammar"
 )
@@ -24,6 +25,7 @@ func TestOptimizeGrammar(t *testing.T) {
 		"standard":          parseStd,
 		"optimized":         parseOpt,
 		"optimized-grammar": parseOptGrammar,
+		"compiled":          parseCompiled,
 	}
 	for name, parser := range parsers {
 		for _, c := range cases {
@@ -59,3 +61,7 @@ func parseOpt(input string) (any, error) {
 func parseOptGrammar(input string) (any, error) {
 	return optimizedgrammar.Parse("", []byte(input))
 }
+
+func parseCompiled(input string) (any, error) {
+	return compiled.Parse("", []byte(input))
+}
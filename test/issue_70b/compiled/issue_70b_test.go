@@ -0,0 +1 @@
+../issue_70b_test.go
\ No newline at end of file
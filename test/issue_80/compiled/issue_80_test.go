@@ -0,0 +1 @@
+../issue_80_test.go
\ No newline at end of file
@@ -0,0 +1 @@
+../keywords_test.go
\ No newline at end of file
@@ -0,0 +1,48 @@
+package keywords
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKeywords(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "", want: []any(nil)},
+		// the longest keyword, at a word boundary
+		{in: "INSERT into inner in_ in2 in", want: []any{"kw:insert", "kw:into", "id:inner", "id:in_", "id:in2", "kw:in"}},
+		{in: "selected set sets", want: []any{"id:selected", "kw:set", "id:sets"}},
+		// the longest operator
+		{in: "a <<= b << c <= d < e == f = g != h", want: []any{
+			"id:a", "op:<<=", "id:b", "op:<<", "id:c", "op:<=", "id:d",
+			"op:<", "id:e", "op:==", "id:f", "op:=", "id:g", "op:!=", "id:h",
+		}},
+		{in: "<<<", want: []any{"op:<<", "op:<"}},
+		// the first type that matches
+		{in: "int integer bool byte bytes", want: []any{"type:int", "id:integer", "type:bool", "type:byte", "id:bytes"}},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err != nil {
+			t.Errorf("%q: %v", tc.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%q: want %#v, got %#v", tc.in, tc.want, got)
+		}
+	}
+}
+
+func TestKeywordsError(t *testing.T) {
+	want := `1:3 (2): no match found, expected: "!=", "<", "<<", "<<=", "<=", "=", "==", "bool", "byte", ` +
+		`"in"i, "insert"i, "int", "integer", "into"i, "select"i, "set"i, [ \t\n], [_a-z]i or EOF`
+	_, err := Parse("", []byte("a !"))
+	if err == nil {
+		t.Fatal("want error")
+	}
+	if err.Error() != want {
+		t.Errorf("want error %q, got %q", want, err)
+	}
+}
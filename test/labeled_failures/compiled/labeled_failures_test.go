@@ -0,0 +1 @@
+../labeled_failures_test.go
\ No newline at end of file
@@ -6,6 +6,8 @@ import (
 	"github.com/mna/pigeon/test/left_recursion/standart/leftrecursion"
 	"github.com/mna/pigeon/test/left_recursion/standart/withoutleftrecursion"
 
+	compiledleftrecursion "github.com/mna/pigeon/test/left_recursion/compiled/leftrecursion"
+	compiledwithoutleftrecursion "github.com/mna/pigeon/test/left_recursion/compiled/withoutleftrecursion"
 	optimizedleftrecursion "github.com/mna/pigeon/test/left_recursion/optimized/leftrecursion"
 	optimizedwithoutleftrecursion "github.com/mna/pigeon/test/left_recursion/optimized/withoutleftrecursion"
 )
@@ -155,6 +157,46 @@ func TestLeftRecursionParse(t *testing.T) {
 					testCase.expr, expr, testCase.want.expr)
 			}
 		})
+
+		t.Run(testCase.name+" compiled(recursion)", func(t *testing.T) {
+			t.Parallel()
+
+			resLR, err := compiledleftrecursion.Parse("", []byte(testCase.expr))
+			if err != nil {
+				t.Fatalf(
+					"for input %q got error: %s, but expect to parse without errors",
+					testCase.expr, err)
+			}
+			exprLR, ok := resLR.(string)
+			if !ok {
+				t.FailNow()
+			}
+			if exprLR != testCase.want.expr {
+				t.Fatalf(
+					"for input %q\ngot result: %q,\nbut expect: %q",
+					testCase.expr, exprLR, testCase.want.expr)
+			}
+		})
+
+		t.Run(testCase.name+" compiled(without recursion)", func(t *testing.T) {
+			t.Parallel()
+
+			res, err := compiledwithoutleftrecursion.Parse("", []byte(testCase.expr))
+			if err != nil {
+				t.Fatalf(
+					"for input %q got error: %s, but expect to parse without errors",
+					testCase.expr, err)
+			}
+			expr, ok := res.(string)
+			if !ok {
+				t.FailNow()
+			}
+			if expr != testCase.want.expr {
+				t.Fatalf(
+					"for input %q\ngot result: %q,\nbut expect: %q",
+					testCase.expr, expr, testCase.want.expr)
+			}
+		})
 	}
 }
 
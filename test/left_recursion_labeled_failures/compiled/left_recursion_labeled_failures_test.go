@@ -0,0 +1 @@
+../left_recursion_labeled_failures_test.go
\ No newline at end of file
@@ -3,6 +3,7 @@ package leftrecursionstate_test
 import (
 	"testing"
 
+	compiledleftrecursionstate "github.com/mna/pigeon/test/left_recursion_state/compiled"
 	optimizedleftrecursionstate "github.com/mna/pigeon/test/left_recursion_state/optimized"
 	leftrecursionstate "github.com/mna/pigeon/test/left_recursion_state/standart"
 )
@@ -111,5 +112,28 @@ func TestLeftRecursionWithState(t *testing.T) {
 					testCase.expr, count, testCase.want.count)
 			}
 		})
+
+		t.Run(testCase.name+" compiled", func(t *testing.T) {
+			t.Parallel()
+
+			count, err := compiledleftrecursionstate.Parse(
+				"", []byte(testCase.expr),
+				compiledleftrecursionstate.InitState("count", initCount))
+			if err != nil {
+				t.Fatalf(
+					"for input %q got error: %s, but expect to parse without errors",
+					testCase.expr, err)
+			}
+			if count != testCase.want.count {
+				t.Fatalf(
+					"for input %q\ngot result: %q,\nbut expect: %q",
+					testCase.expr, count, testCase.want.count)
+			}
+			if count != testCase.want.count {
+				t.Fatalf(
+					"for input %q\ngot result: %d,\nbut expect: %d",
+					testCase.expr, count, testCase.want.count)
+			}
+		})
 	}
 }
@@ -0,0 +1,31 @@
+package leftrecursionthrownrecover
+
+// ErrorLister is the public interface to access the inner errors
+// included in a errList.
+type ErrorLister interface {
+	Errors() []error
+}
+
+func (e errList) Errors() []error {
+	return e
+}
+
+// ParserError is the public interface to errors of type parserError.
+type ParserError interface {
+	Error() string
+	InnerError() error
+	Pos() (int, int, int)
+	Expected() []string
+}
+
+func (p *parserError) InnerError() error {
+	return p.Inner
+}
+
+func (p *parserError) Pos() (line, col, offset int) {
+	return p.pos.line, p.pos.col, p.pos.offset
+}
+
+func (p *parserError) Expected() []string {
+	return p.expected
+}
@@ -0,0 +1,6158 @@
+// Code generated by pigeon; DO NOT EDIT.
+
+package leftrecursionthrownrecover
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+var g = &grammar{
+	rules: []*rule{
+		{
+			name:          "Start",
+			pos:           position{line: 6, col: 1, offset: 41},
+			id:            0,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "case01",
+			pos:           position{line: 11, col: 1, offset: 108},
+			id:            1,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "MultiLabelRecover",
+			pos:           position{line: 13, col: 1, offset: 166},
+			id:            2,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "number",
+			pos:           position{line: 15, col: 1, offset: 230},
+			id:            3,
+			leader:        true,
+			leftRecursive: true,
+		},
+		{
+			name:          "digit",
+			pos:           position{line: 21, col: 1, offset: 349},
+			id:            4,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "ErrNonNumber",
+			pos:           position{line: 27, col: 1, offset: 473},
+			id:            5,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "case02",
+			pos:           position{line: 34, col: 1, offset: 614},
+			id:            6,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "ThrowUndefLabel",
+			pos:           position{line: 36, col: 1, offset: 699},
+			id:            7,
+			leader:        true,
+			leftRecursive: true,
+		},
+		{
+			name:          "case03",
+			pos:           position{line: 41, col: 1, offset: 777},
+			id:            8,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "OuterRecover03",
+			pos:           position{line: 43, col: 1, offset: 832},
+			id:            9,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "InnerRecover03",
+			pos:           position{line: 45, col: 1, offset: 943},
+			id:            10,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "number03",
+			pos:           position{line: 47, col: 1, offset: 1004},
+			id:            11,
+			leader:        true,
+			leftRecursive: true,
+		},
+		{
+			name:          "digit03",
+			pos:           position{line: 53, col: 1, offset: 1131},
+			id:            12,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "ErrAlphaInner03",
+			pos:           position{line: 61, col: 1, offset: 1327},
+			id:            13,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "ErrAlphaOuter03",
+			pos:           position{line: 65, col: 1, offset: 1456},
+			id:            14,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "ErrOtherOuter03",
+			pos:           position{line: 69, col: 1, offset: 1585},
+			id:            15,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "case04",
+			pos:           position{line: 76, col: 1, offset: 1768},
+			id:            16,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "OuterRecover04",
+			pos:           position{line: 78, col: 1, offset: 1823},
+			id:            17,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "InnerRecover04",
+			pos:           position{line: 80, col: 1, offset: 1934},
+			id:            18,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "number04",
+			pos:           position{line: 82, col: 1, offset: 1995},
+			id:            19,
+			leader:        true,
+			leftRecursive: true,
+		},
+		{
+			name:          "digit04",
+			pos:           position{line: 88, col: 1, offset: 2122},
+			id:            20,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "ErrAlphaInner04",
+			pos:           position{line: 96, col: 1, offset: 2318},
+			id:            21,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "ErrAlphaOuter04",
+			pos:           position{line: 100, col: 1, offset: 2364},
+			id:            22,
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name:          "ErrOtherOuter04",
+			pos:           position{line: 104, col: 1, offset: 2484},
+			id:            23,
+			leader:        false,
+			leftRecursive: false,
+		},
+	},
+}
+
+func init() {
+	g.rules[0].run = (*parser).expr24
+	g.rules[1].run = (*parser).expr25
+	g.rules[2].run = (*parser).expr28
+	g.rules[3].run = (*parser).expr31
+	g.rules[4].run = (*parser).expr43
+	g.rules[5].run = (*parser).expr53
+	g.rules[6].run = (*parser).expr61
+	g.rules[7].run = (*parser).expr64
+	g.rules[8].run = (*parser).expr67
+	g.rules[9].run = (*parser).expr70
+	g.rules[10].run = (*parser).expr75
+	g.rules[11].run = (*parser).expr78
+	g.rules[12].run = (*parser).expr90
+	g.rules[13].run = (*parser).expr106
+	g.rules[14].run = (*parser).expr114
+	g.rules[15].run = (*parser).expr122
+	g.rules[16].run = (*parser).expr130
+	g.rules[17].run = (*parser).expr133
+	g.rules[18].run = (*parser).expr138
+	g.rules[19].run = (*parser).expr141
+	g.rules[20].run = (*parser).expr153
+	g.rules[21].run = (*parser).expr169
+	g.rules[22].run = (*parser).expr170
+	g.rules[23].run = (*parser).expr178
+}
+
+func (p *parser) expr24() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(24); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseAndCodeExpr")
+			}
+			state := p.cloneState()
+			match, err := p.callonStart1()
+			if err != nil {
+				p.addErr(err)
+			}
+			p.restoreState(state)
+			ok = match
+			if p.debug {
+				p.out("parseAndCodeExpr")
+			}
+			p.compiledMemoize(pt2, 24, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr25() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(25); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(26); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLabeledExpr")
+					}
+					var v6 any
+					p.pushV()
+					{
+						if res7, hit := p.compiledMemoized(27); hit {
+							v6, ok = res7.v, res7.b
+						} else {
+							pt8 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseRuleRefExpr MultiLabelRecover")
+							}
+							v6, ok = p.parseRuleWrap(g.rules[2])
+							if p.debug {
+								p.out("parseRuleRefExpr MultiLabelRecover")
+							}
+							p.compiledMemoize(pt8, 27, v6, ok)
+						}
+					}
+					p.popV()
+					if ok {
+						p.vstack[len(p.vstack)-1]["case01"] = v6
+						_ = v6
+					}
+					if p.debug {
+						p.out("parseLabeledExpr")
+					}
+					p.compiledMemoize(pt5, 26, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.calloncase011()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 25, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr28() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(28); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseRecoveryExpr (errAlpha,errOther)")
+			}
+			p.pushRecovery([]string{"errAlpha", "errOther"}, (*parser).expr30)
+			{
+				if res3, hit := p.compiledMemoized(29); hit {
+					val, ok = res3.v, res3.b
+				} else {
+					pt4 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseRuleRefExpr number")
+					}
+					val, ok = p.parseRuleWrap(g.rules[3])
+					if p.debug {
+						p.out("parseRuleRefExpr number")
+					}
+					p.compiledMemoize(pt4, 29, val, ok)
+				}
+			}
+			p.popRecovery()
+			if p.debug {
+				p.out("parseRecoveryExpr (errAlpha,errOther)")
+			}
+			p.compiledMemoize(pt2, 28, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr31() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(31); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseChoiceExpr")
+			}
+			state3 := p.cloneState()
+			{
+				if res4, hit := p.compiledMemoized(32); hit {
+					val, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseNotExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					p.maxFailInvertExpected = !p.maxFailInvertExpected
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(33); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseAnyMatcher")
+							}
+							if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+								// EOF - see utf8.DecodeRune
+								p.failAt(false, p.pt.position, ".")
+							} else {
+								start := p.pt
+								p.read()
+								p.failAt(true, start.position, ".")
+								match8 = true
+							}
+							if p.debug {
+								p.out("parseAnyMatcher")
+							}
+							p.compiledMemoize(pt10, 33, nil, match8)
+						}
+					}
+					p.maxFailInvertExpected = !p.maxFailInvertExpected
+					p.restoreState(state7)
+					p.restore(pt6)
+					ok = !match8
+					if p.debug {
+						p.out("parseNotExpr")
+					}
+					p.compiledMemoize(pt5, 32, val, ok)
+				}
+			}
+			if ok {
+				p.incChoiceAltCnt(position{line: 15, col: 10, offset: 239}, 0)
+			}
+			if !ok {
+				p.restoreState(state3)
+			}
+			if !ok {
+				state11 := p.cloneState()
+				p.pushV()
+				{
+					if res12, hit := p.compiledMemoized(34); hit {
+						val, ok = res12.v, res12.b
+					} else {
+						pt13 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseActionExpr")
+						}
+						start14 := p.pt
+						{
+							if res15, hit := p.compiledMemoized(35); hit {
+								_, ok = res15.v, res15.b
+							} else {
+								pt16 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseSeqExpr")
+								}
+								pt17 := p.pt
+								state18 := p.cloneState()
+								var match19 bool
+								{
+									if res20, hit := p.compiledMemoized(36); hit {
+										_, match19 = res20.v, res20.b
+									} else {
+										pt21 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseLabeledExpr")
+										}
+										var v22 any
+										p.pushV()
+										{
+											if res23, hit := p.compiledMemoized(37); hit {
+												v22, match19 = res23.v, res23.b
+											} else {
+												pt24 := p.pt
+												p.countExpr()
+												if p.debug {
+													p.in("parseRuleRefExpr number")
+												}
+												v22, match19 = p.parseRuleWrap(g.rules[3])
+												if p.debug {
+													p.out("parseRuleRefExpr number")
+												}
+												p.compiledMemoize(pt24, 37, v22, match19)
+											}
+										}
+										p.popV()
+										if match19 {
+											p.vstack[len(p.vstack)-1]["n"] = v22
+											_ = v22
+										}
+										if p.debug {
+											p.out("parseLabeledExpr")
+										}
+										p.compiledMemoize(pt21, 36, nil, match19)
+									}
+								}
+								if match19 {
+									var match25 bool
+									{
+										if res26, hit := p.compiledMemoized(38); hit {
+											_, match25 = res26.v, res26.b
+										} else {
+											pt27 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseLabeledExpr")
+											}
+											var v28 any
+											p.pushV()
+											{
+												if res29, hit := p.compiledMemoized(39); hit {
+													v28, match25 = res29.v, res29.b
+												} else {
+													pt30 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseRuleRefExpr digit")
+													}
+													v28, match25 = p.parseRuleWrap(g.rules[4])
+													if p.debug {
+														p.out("parseRuleRefExpr digit")
+													}
+													p.compiledMemoize(pt30, 39, v28, match25)
+												}
+											}
+											p.popV()
+											if match25 {
+												p.vstack[len(p.vstack)-1]["d"] = v28
+												_ = v28
+											}
+											if p.debug {
+												p.out("parseLabeledExpr")
+											}
+											p.compiledMemoize(pt27, 38, nil, match25)
+										}
+									}
+									if match25 {
+										ok = true
+									}
+								}
+								if !ok {
+									p.restoreState(state18)
+									p.restore(pt17)
+								}
+								if p.debug {
+									p.out("parseSeqExpr")
+								}
+								p.compiledMemoize(pt16, 35, nil, ok)
+							}
+						}
+						if ok {
+							p.cur.pos = start14.position
+							p.cur.text = p.sliceFrom(start14)
+							state := p.cloneState()
+							actVal, err := p.callonnumber4()
+							if err != nil {
+								p.addErrAt(err, start14.position, []string{})
+							}
+							p.restoreState(state)
+							val = actVal
+							if p.debug {
+								p.printIndent("MATCH", string(p.sliceFrom(start14)))
+							}
+						}
+						if p.debug {
+							p.out("parseActionExpr")
+						}
+						p.compiledMemoize(pt13, 34, val, ok)
+					}
+				}
+				p.popV()
+				if ok {
+					p.incChoiceAltCnt(position{line: 15, col: 10, offset: 239}, 1)
+				}
+				if !ok {
+					p.restoreState(state11)
+				}
+			}
+			if !ok {
+				state31 := p.cloneState()
+				p.pushV()
+				{
+					if res32, hit := p.compiledMemoized(40); hit {
+						val, ok = res32.v, res32.b
+					} else {
+						pt33 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseActionExpr")
+						}
+						start34 := p.pt
+						{
+							if res35, hit := p.compiledMemoized(41); hit {
+								_, ok = res35.v, res35.b
+							} else {
+								pt36 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseLabeledExpr")
+								}
+								var v37 any
+								p.pushV()
+								{
+									if res38, hit := p.compiledMemoized(42); hit {
+										v37, ok = res38.v, res38.b
+									} else {
+										pt39 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr digit")
+										}
+										v37, ok = p.parseRuleWrap(g.rules[4])
+										if p.debug {
+											p.out("parseRuleRefExpr digit")
+										}
+										p.compiledMemoize(pt39, 42, v37, ok)
+									}
+								}
+								p.popV()
+								if ok {
+									p.vstack[len(p.vstack)-1]["d"] = v37
+									_ = v37
+								}
+								if p.debug {
+									p.out("parseLabeledExpr")
+								}
+								p.compiledMemoize(pt36, 41, nil, ok)
+							}
+						}
+						if ok {
+							p.cur.pos = start34.position
+							p.cur.text = p.sliceFrom(start34)
+							state := p.cloneState()
+							actVal, err := p.callonnumber10()
+							if err != nil {
+								p.addErrAt(err, start34.position, []string{})
+							}
+							p.restoreState(state)
+							val = actVal
+							if p.debug {
+								p.printIndent("MATCH", string(p.sliceFrom(start34)))
+							}
+						}
+						if p.debug {
+							p.out("parseActionExpr")
+						}
+						p.compiledMemoize(pt33, 40, val, ok)
+					}
+				}
+				p.popV()
+				if ok {
+					p.incChoiceAltCnt(position{line: 15, col: 10, offset: 239}, 2)
+				}
+				if !ok {
+					p.restoreState(state31)
+				}
+			}
+			if !ok {
+				p.incChoiceAltCnt(position{line: 15, col: 10, offset: 239}, choiceNoMatch)
+			}
+			if p.debug {
+				p.out("parseChoiceExpr")
+			}
+			p.compiledMemoize(pt2, 31, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr43() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(43); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseChoiceExpr")
+			}
+			d := expr43Dispatch
+			set := d.set(p.pt)
+			for _, want := range d.expected[set] {
+				p.failAt(false, p.pt.position, want)
+			}
+			for _, altI := range d.alts[set] {
+				switch altI {
+				case 0:
+					state3 := p.cloneState()
+					p.pushV()
+					{
+						if res4, hit := p.compiledMemoized(44); hit {
+							val, ok = res4.v, res4.b
+						} else {
+							pt5 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseActionExpr")
+							}
+							start6 := p.pt
+							{
+								if res7, hit := p.compiledMemoized(45); hit {
+									_, ok = res7.v, res7.b
+								} else {
+									pt8 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseCharClassMatcher")
+									}
+									cur := p.pt.rn
+									start := p.pt
+									var matched bool
+									// can't match EOF
+									eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+									if !eof {
+										switch {
+										case cur >= '0' && cur <= '9':
+											matched = true
+										}
+									}
+									if matched && !eof {
+										p.read()
+										p.failAt(true, start.position, "[0-9]")
+										ok = true
+									} else {
+										p.failAt(false, start.position, "[0-9]")
+									}
+									if p.debug {
+										p.out("parseCharClassMatcher")
+									}
+									p.compiledMemoize(pt8, 45, nil, ok)
+								}
+							}
+							if ok {
+								p.cur.pos = start6.position
+								p.cur.text = p.sliceFrom(start6)
+								state := p.cloneState()
+								actVal, err := p.callondigit2()
+								if err != nil {
+									p.addErrAt(err, start6.position, []string{})
+								}
+								p.restoreState(state)
+								val = actVal
+								if p.debug {
+									p.printIndent("MATCH", string(p.sliceFrom(start6)))
+								}
+							}
+							if p.debug {
+								p.out("parseActionExpr")
+							}
+							p.compiledMemoize(pt5, 44, val, ok)
+						}
+					}
+					p.popV()
+					if ok {
+						p.incChoiceAltCnt(position{line: 21, col: 9, offset: 357}, altI)
+					}
+					if !ok {
+						p.restoreState(state3)
+					}
+				case 1:
+					state9 := p.cloneState()
+					p.pushV()
+					{
+						if res10, hit := p.compiledMemoized(46); hit {
+							val, ok = res10.v, res10.b
+						} else {
+							pt11 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseActionExpr")
+							}
+							start12 := p.pt
+							{
+								if res13, hit := p.compiledMemoized(47); hit {
+									_, ok = res13.v, res13.b
+								} else {
+									pt14 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseLabeledExpr")
+									}
+									var v15 any
+									p.pushV()
+									{
+										if res16, hit := p.compiledMemoized(48); hit {
+											v15, ok = res16.v, res16.b
+										} else {
+											pt17 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseSeqExpr")
+											}
+											pt18 := p.pt
+											state19 := p.cloneState()
+											var v20 any
+											var match21 bool
+											{
+												if res22, hit := p.compiledMemoized(49); hit {
+													v20, match21 = res22.v, res22.b
+												} else {
+													pt23 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseAndExpr")
+													}
+													pt24 := p.pt
+													state25 := p.cloneState()
+													var match26 bool
+													{
+														if res27, hit := p.compiledMemoized(50); hit {
+															_, match26 = res27.v, res27.b
+														} else {
+															pt28 := p.pt
+															p.countExpr()
+															if p.debug {
+																p.in("parseCharClassMatcher")
+															}
+															cur := p.pt.rn
+															start := p.pt
+															var matched bool
+															// can't match EOF
+															eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+															if !eof {
+																switch {
+																case cur >= 'a' && cur <= 'z':
+																	matched = true
+																}
+															}
+															if matched && !eof {
+																p.read()
+																p.failAt(true, start.position, "[a-z]")
+																match26 = true
+															} else {
+																p.failAt(false, start.position, "[a-z]")
+															}
+															if p.debug {
+																p.out("parseCharClassMatcher")
+															}
+															p.compiledMemoize(pt28, 50, nil, match26)
+														}
+													}
+													p.restoreState(state25)
+													p.restore(pt24)
+													match21 = match26
+													if p.debug {
+														p.out("parseAndExpr")
+													}
+													p.compiledMemoize(pt23, 49, v20, match21)
+												}
+											}
+											if match21 {
+												var v29 any
+												var match30 bool
+												{
+													if res31, hit := p.compiledMemoized(51); hit {
+														v29, match30 = res31.v, res31.b
+													} else {
+														pt32 := p.pt
+														p.countExpr()
+														if p.debug {
+															p.in("parseThrowExpr")
+														}
+														v29, match30 = p.throw("errAlpha", "errAlpha")
+														if p.debug {
+															p.out("parseThrowExpr")
+														}
+														p.compiledMemoize(pt32, 51, v29, match30)
+													}
+												}
+												if match30 {
+													v15 = []any{v20, v29}
+													ok = true
+												}
+											}
+											if !ok {
+												p.restoreState(state19)
+												p.restore(pt18)
+											}
+											if p.debug {
+												p.out("parseSeqExpr")
+											}
+											p.compiledMemoize(pt17, 48, v15, ok)
+										}
+									}
+									p.popV()
+									if ok {
+										p.vstack[len(p.vstack)-1]["x"] = v15
+										_ = v15
+									}
+									if p.debug {
+										p.out("parseLabeledExpr")
+									}
+									p.compiledMemoize(pt14, 47, nil, ok)
+								}
+							}
+							if ok {
+								p.cur.pos = start12.position
+								p.cur.text = p.sliceFrom(start12)
+								state := p.cloneState()
+								actVal, err := p.callondigit4()
+								if err != nil {
+									p.addErrAt(err, start12.position, []string{})
+								}
+								p.restoreState(state)
+								val = actVal
+								if p.debug {
+									p.printIndent("MATCH", string(p.sliceFrom(start12)))
+								}
+							}
+							if p.debug {
+								p.out("parseActionExpr")
+							}
+							p.compiledMemoize(pt11, 46, val, ok)
+						}
+					}
+					p.popV()
+					if ok {
+						p.incChoiceAltCnt(position{line: 21, col: 9, offset: 357}, altI)
+					}
+					if !ok {
+						p.restoreState(state9)
+					}
+				case 2:
+					state33 := p.cloneState()
+					p.pushV()
+					{
+						if res34, hit := p.compiledMemoized(52); hit {
+							val, ok = res34.v, res34.b
+						} else {
+							pt35 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseThrowExpr")
+							}
+							val, ok = p.throw("errOther", "errOther")
+							if p.debug {
+								p.out("parseThrowExpr")
+							}
+							p.compiledMemoize(pt35, 52, val, ok)
+						}
+					}
+					p.popV()
+					if ok {
+						p.incChoiceAltCnt(position{line: 21, col: 9, offset: 357}, altI)
+					}
+					if !ok {
+						p.restoreState(state33)
+					}
+				}
+				if ok {
+					break
+				}
+			}
+			if !ok {
+				p.incChoiceAltCnt(position{line: 21, col: 9, offset: 357}, choiceNoMatch)
+			}
+			if p.debug {
+				p.out("parseChoiceExpr")
+			}
+			p.compiledMemoize(pt2, 43, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr53() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(53); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(54); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseSeqExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(55); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseAndCodeExpr")
+							}
+							state := p.cloneState()
+							match, err := p.callonErrNonNumber3()
+							if err != nil {
+								p.addErr(err)
+							}
+							p.restoreState(state)
+							match8 = match
+							if p.debug {
+								p.out("parseAndCodeExpr")
+							}
+							p.compiledMemoize(pt10, 55, nil, match8)
+						}
+					}
+					if match8 {
+						var match11 bool
+						{
+							if res12, hit := p.compiledMemoized(56); hit {
+								_, match11 = res12.v, res12.b
+							} else {
+								pt13 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseZeroOrMoreExpr")
+								}
+								for {
+									var match14 bool
+									{
+										if res15, hit := p.compiledMemoized(57); hit {
+											_, match14 = res15.v, res15.b
+										} else {
+											pt16 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseSeqExpr")
+											}
+											pt17 := p.pt
+											state18 := p.cloneState()
+											var match19 bool
+											{
+												if res20, hit := p.compiledMemoized(58); hit {
+													_, match19 = res20.v, res20.b
+												} else {
+													pt21 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseNotExpr")
+													}
+													pt22 := p.pt
+													state23 := p.cloneState()
+													p.maxFailInvertExpected = !p.maxFailInvertExpected
+													var match24 bool
+													{
+														if res25, hit := p.compiledMemoized(59); hit {
+															_, match24 = res25.v, res25.b
+														} else {
+															pt26 := p.pt
+															p.countExpr()
+															if p.debug {
+																p.in("parseCharClassMatcher")
+															}
+															cur := p.pt.rn
+															start := p.pt
+															var matched bool
+															// can't match EOF
+															eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+															if !eof {
+																switch {
+																case cur >= '0' && cur <= '9':
+																	matched = true
+																}
+															}
+															if matched && !eof {
+																p.read()
+																p.failAt(true, start.position, "[0-9]")
+																match24 = true
+															} else {
+																p.failAt(false, start.position, "[0-9]")
+															}
+															if p.debug {
+																p.out("parseCharClassMatcher")
+															}
+															p.compiledMemoize(pt26, 59, nil, match24)
+														}
+													}
+													p.maxFailInvertExpected = !p.maxFailInvertExpected
+													p.restoreState(state23)
+													p.restore(pt22)
+													match19 = !match24
+													if p.debug {
+														p.out("parseNotExpr")
+													}
+													p.compiledMemoize(pt21, 58, nil, match19)
+												}
+											}
+											if match19 {
+												var match27 bool
+												{
+													if res28, hit := p.compiledMemoized(60); hit {
+														_, match27 = res28.v, res28.b
+													} else {
+														pt29 := p.pt
+														p.countExpr()
+														if p.debug {
+															p.in("parseAnyMatcher")
+														}
+														if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+															// EOF - see utf8.DecodeRune
+															p.failAt(false, p.pt.position, ".")
+														} else {
+															start := p.pt
+															p.read()
+															p.failAt(true, start.position, ".")
+															match27 = true
+														}
+														if p.debug {
+															p.out("parseAnyMatcher")
+														}
+														p.compiledMemoize(pt29, 60, nil, match27)
+													}
+												}
+												if match27 {
+													match14 = true
+												}
+											}
+											if !match14 {
+												p.restoreState(state18)
+												p.restore(pt17)
+											}
+											if p.debug {
+												p.out("parseSeqExpr")
+											}
+											p.compiledMemoize(pt16, 57, nil, match14)
+										}
+									}
+									if !match14 {
+										break
+									}
+								}
+								match11 = true
+								if p.debug {
+									p.out("parseZeroOrMoreExpr")
+								}
+								p.compiledMemoize(pt13, 56, nil, match11)
+							}
+						}
+						if match11 {
+							ok = true
+						}
+					}
+					if !ok {
+						p.restoreState(state7)
+						p.restore(pt6)
+					}
+					if p.debug {
+						p.out("parseSeqExpr")
+					}
+					p.compiledMemoize(pt5, 54, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonErrNonNumber1()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 53, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr61() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(61); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseChoiceExpr")
+			}
+			state3 := p.cloneState()
+			{
+				if res4, hit := p.compiledMemoized(62); hit {
+					val, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseRuleRefExpr ThrowUndefLabel")
+					}
+					val, ok = p.parseRuleWrap(g.rules[7])
+					if p.debug {
+						p.out("parseRuleRefExpr ThrowUndefLabel")
+					}
+					p.compiledMemoize(pt5, 62, val, ok)
+				}
+			}
+			if ok {
+				p.incChoiceAltCnt(position{line: 34, col: 11, offset: 624}, 0)
+			}
+			if !ok {
+				p.restoreState(state3)
+			}
+			if !ok {
+				state6 := p.cloneState()
+				p.pushV()
+				{
+					if res7, hit := p.compiledMemoized(63); hit {
+						val, ok = res7.v, res7.b
+					} else {
+						pt8 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseAndCodeExpr")
+						}
+						state := p.cloneState()
+						match, err := p.calloncase023()
+						if err != nil {
+							p.addErr(err)
+						}
+						p.restoreState(state)
+						ok = match
+						if p.debug {
+							p.out("parseAndCodeExpr")
+						}
+						p.compiledMemoize(pt8, 63, val, ok)
+					}
+				}
+				p.popV()
+				if ok {
+					p.incChoiceAltCnt(position{line: 34, col: 11, offset: 624}, 1)
+				}
+				if !ok {
+					p.restoreState(state6)
+				}
+			}
+			if !ok {
+				p.incChoiceAltCnt(position{line: 34, col: 11, offset: 624}, choiceNoMatch)
+			}
+			if p.debug {
+				p.out("parseChoiceExpr")
+			}
+			p.compiledMemoize(pt2, 61, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr64() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(64); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseSeqExpr")
+			}
+			pt3 := p.pt
+			state4 := p.cloneState()
+			var v5 any
+			var match6 bool
+			{
+				if res7, hit := p.compiledMemoized(65); hit {
+					v5, match6 = res7.v, res7.b
+				} else {
+					pt8 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseRuleRefExpr ThrowUndefLabel")
+					}
+					v5, match6 = p.parseRuleWrap(g.rules[7])
+					if p.debug {
+						p.out("parseRuleRefExpr ThrowUndefLabel")
+					}
+					p.compiledMemoize(pt8, 65, v5, match6)
+				}
+			}
+			if match6 {
+				var v9 any
+				var match10 bool
+				{
+					if res11, hit := p.compiledMemoized(66); hit {
+						v9, match10 = res11.v, res11.b
+					} else {
+						pt12 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseThrowExpr")
+						}
+						v9, match10 = p.throw("undeflabel", "undeflabel")
+						if p.debug {
+							p.out("parseThrowExpr")
+						}
+						p.compiledMemoize(pt12, 66, v9, match10)
+					}
+				}
+				if match10 {
+					val = []any{v5, v9}
+					ok = true
+				}
+			}
+			if !ok {
+				p.restoreState(state4)
+				p.restore(pt3)
+			}
+			if p.debug {
+				p.out("parseSeqExpr")
+			}
+			p.compiledMemoize(pt2, 64, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr67() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(67); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(68); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLabeledExpr")
+					}
+					var v6 any
+					p.pushV()
+					{
+						if res7, hit := p.compiledMemoized(69); hit {
+							v6, ok = res7.v, res7.b
+						} else {
+							pt8 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseRuleRefExpr OuterRecover03")
+							}
+							v6, ok = p.parseRuleWrap(g.rules[9])
+							if p.debug {
+								p.out("parseRuleRefExpr OuterRecover03")
+							}
+							p.compiledMemoize(pt8, 69, v6, ok)
+						}
+					}
+					p.popV()
+					if ok {
+						p.vstack[len(p.vstack)-1]["case03"] = v6
+						_ = v6
+					}
+					if p.debug {
+						p.out("parseLabeledExpr")
+					}
+					p.compiledMemoize(pt5, 68, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.calloncase031()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 67, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr70() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(70); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseRecoveryExpr (errOther)")
+			}
+			p.pushRecovery([]string{"errOther"}, (*parser).expr74)
+			{
+				if res3, hit := p.compiledMemoized(71); hit {
+					val, ok = res3.v, res3.b
+				} else {
+					pt4 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseRecoveryExpr (errAlphaLower,errAlphaUpper)")
+					}
+					p.pushRecovery([]string{"errAlphaLower", "errAlphaUpper"}, (*parser).expr73)
+					{
+						if res5, hit := p.compiledMemoized(72); hit {
+							val, ok = res5.v, res5.b
+						} else {
+							pt6 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseRuleRefExpr InnerRecover03")
+							}
+							val, ok = p.parseRuleWrap(g.rules[10])
+							if p.debug {
+								p.out("parseRuleRefExpr InnerRecover03")
+							}
+							p.compiledMemoize(pt6, 72, val, ok)
+						}
+					}
+					p.popRecovery()
+					if p.debug {
+						p.out("parseRecoveryExpr (errAlphaLower,errAlphaUpper)")
+					}
+					p.compiledMemoize(pt4, 71, val, ok)
+				}
+			}
+			p.popRecovery()
+			if p.debug {
+				p.out("parseRecoveryExpr (errOther)")
+			}
+			p.compiledMemoize(pt2, 70, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr75() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(75); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseRecoveryExpr (errAlphaLower)")
+			}
+			p.pushRecovery([]string{"errAlphaLower"}, (*parser).expr77)
+			{
+				if res3, hit := p.compiledMemoized(76); hit {
+					val, ok = res3.v, res3.b
+				} else {
+					pt4 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseRuleRefExpr number03")
+					}
+					val, ok = p.parseRuleWrap(g.rules[11])
+					if p.debug {
+						p.out("parseRuleRefExpr number03")
+					}
+					p.compiledMemoize(pt4, 76, val, ok)
+				}
+			}
+			p.popRecovery()
+			if p.debug {
+				p.out("parseRecoveryExpr (errAlphaLower)")
+			}
+			p.compiledMemoize(pt2, 75, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr78() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(78); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseChoiceExpr")
+			}
+			state3 := p.cloneState()
+			{
+				if res4, hit := p.compiledMemoized(79); hit {
+					val, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseNotExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					p.maxFailInvertExpected = !p.maxFailInvertExpected
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(80); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseAnyMatcher")
+							}
+							if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+								// EOF - see utf8.DecodeRune
+								p.failAt(false, p.pt.position, ".")
+							} else {
+								start := p.pt
+								p.read()
+								p.failAt(true, start.position, ".")
+								match8 = true
+							}
+							if p.debug {
+								p.out("parseAnyMatcher")
+							}
+							p.compiledMemoize(pt10, 80, nil, match8)
+						}
+					}
+					p.maxFailInvertExpected = !p.maxFailInvertExpected
+					p.restoreState(state7)
+					p.restore(pt6)
+					ok = !match8
+					if p.debug {
+						p.out("parseNotExpr")
+					}
+					p.compiledMemoize(pt5, 79, val, ok)
+				}
+			}
+			if ok {
+				p.incChoiceAltCnt(position{line: 47, col: 12, offset: 1015}, 0)
+			}
+			if !ok {
+				p.restoreState(state3)
+			}
+			if !ok {
+				state11 := p.cloneState()
+				p.pushV()
+				{
+					if res12, hit := p.compiledMemoized(81); hit {
+						val, ok = res12.v, res12.b
+					} else {
+						pt13 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseActionExpr")
+						}
+						start14 := p.pt
+						{
+							if res15, hit := p.compiledMemoized(82); hit {
+								_, ok = res15.v, res15.b
+							} else {
+								pt16 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseSeqExpr")
+								}
+								pt17 := p.pt
+								state18 := p.cloneState()
+								var match19 bool
+								{
+									if res20, hit := p.compiledMemoized(83); hit {
+										_, match19 = res20.v, res20.b
+									} else {
+										pt21 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseLabeledExpr")
+										}
+										var v22 any
+										p.pushV()
+										{
+											if res23, hit := p.compiledMemoized(84); hit {
+												v22, match19 = res23.v, res23.b
+											} else {
+												pt24 := p.pt
+												p.countExpr()
+												if p.debug {
+													p.in("parseRuleRefExpr number03")
+												}
+												v22, match19 = p.parseRuleWrap(g.rules[11])
+												if p.debug {
+													p.out("parseRuleRefExpr number03")
+												}
+												p.compiledMemoize(pt24, 84, v22, match19)
+											}
+										}
+										p.popV()
+										if match19 {
+											p.vstack[len(p.vstack)-1]["n"] = v22
+											_ = v22
+										}
+										if p.debug {
+											p.out("parseLabeledExpr")
+										}
+										p.compiledMemoize(pt21, 83, nil, match19)
+									}
+								}
+								if match19 {
+									var match25 bool
+									{
+										if res26, hit := p.compiledMemoized(85); hit {
+											_, match25 = res26.v, res26.b
+										} else {
+											pt27 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseLabeledExpr")
+											}
+											var v28 any
+											p.pushV()
+											{
+												if res29, hit := p.compiledMemoized(86); hit {
+													v28, match25 = res29.v, res29.b
+												} else {
+													pt30 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseRuleRefExpr digit03")
+													}
+													v28, match25 = p.parseRuleWrap(g.rules[12])
+													if p.debug {
+														p.out("parseRuleRefExpr digit03")
+													}
+													p.compiledMemoize(pt30, 86, v28, match25)
+												}
+											}
+											p.popV()
+											if match25 {
+												p.vstack[len(p.vstack)-1]["d"] = v28
+												_ = v28
+											}
+											if p.debug {
+												p.out("parseLabeledExpr")
+											}
+											p.compiledMemoize(pt27, 85, nil, match25)
+										}
+									}
+									if match25 {
+										ok = true
+									}
+								}
+								if !ok {
+									p.restoreState(state18)
+									p.restore(pt17)
+								}
+								if p.debug {
+									p.out("parseSeqExpr")
+								}
+								p.compiledMemoize(pt16, 82, nil, ok)
+							}
+						}
+						if ok {
+							p.cur.pos = start14.position
+							p.cur.text = p.sliceFrom(start14)
+							state := p.cloneState()
+							actVal, err := p.callonnumber034()
+							if err != nil {
+								p.addErrAt(err, start14.position, []string{})
+							}
+							p.restoreState(state)
+							val = actVal
+							if p.debug {
+								p.printIndent("MATCH", string(p.sliceFrom(start14)))
+							}
+						}
+						if p.debug {
+							p.out("parseActionExpr")
+						}
+						p.compiledMemoize(pt13, 81, val, ok)
+					}
+				}
+				p.popV()
+				if ok {
+					p.incChoiceAltCnt(position{line: 47, col: 12, offset: 1015}, 1)
+				}
+				if !ok {
+					p.restoreState(state11)
+				}
+			}
+			if !ok {
+				state31 := p.cloneState()
+				p.pushV()
+				{
+					if res32, hit := p.compiledMemoized(87); hit {
+						val, ok = res32.v, res32.b
+					} else {
+						pt33 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseActionExpr")
+						}
+						start34 := p.pt
+						{
+							if res35, hit := p.compiledMemoized(88); hit {
+								_, ok = res35.v, res35.b
+							} else {
+								pt36 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseLabeledExpr")
+								}
+								var v37 any
+								p.pushV()
+								{
+									if res38, hit := p.compiledMemoized(89); hit {
+										v37, ok = res38.v, res38.b
+									} else {
+										pt39 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr digit03")
+										}
+										v37, ok = p.parseRuleWrap(g.rules[12])
+										if p.debug {
+											p.out("parseRuleRefExpr digit03")
+										}
+										p.compiledMemoize(pt39, 89, v37, ok)
+									}
+								}
+								p.popV()
+								if ok {
+									p.vstack[len(p.vstack)-1]["d"] = v37
+									_ = v37
+								}
+								if p.debug {
+									p.out("parseLabeledExpr")
+								}
+								p.compiledMemoize(pt36, 88, nil, ok)
+							}
+						}
+						if ok {
+							p.cur.pos = start34.position
+							p.cur.text = p.sliceFrom(start34)
+							state := p.cloneState()
+							actVal, err := p.callonnumber0310()
+							if err != nil {
+								p.addErrAt(err, start34.position, []string{})
+							}
+							p.restoreState(state)
+							val = actVal
+							if p.debug {
+								p.printIndent("MATCH", string(p.sliceFrom(start34)))
+							}
+						}
+						if p.debug {
+							p.out("parseActionExpr")
+						}
+						p.compiledMemoize(pt33, 87, val, ok)
+					}
+				}
+				p.popV()
+				if ok {
+					p.incChoiceAltCnt(position{line: 47, col: 12, offset: 1015}, 2)
+				}
+				if !ok {
+					p.restoreState(state31)
+				}
+			}
+			if !ok {
+				p.incChoiceAltCnt(position{line: 47, col: 12, offset: 1015}, choiceNoMatch)
+			}
+			if p.debug {
+				p.out("parseChoiceExpr")
+			}
+			p.compiledMemoize(pt2, 78, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr90() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(90); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseChoiceExpr")
+			}
+			d := expr90Dispatch
+			set := d.set(p.pt)
+			for _, want := range d.expected[set] {
+				p.failAt(false, p.pt.position, want)
+			}
+			for _, altI := range d.alts[set] {
+				switch altI {
+				case 0:
+					state3 := p.cloneState()
+					p.pushV()
+					{
+						if res4, hit := p.compiledMemoized(91); hit {
+							val, ok = res4.v, res4.b
+						} else {
+							pt5 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseActionExpr")
+							}
+							start6 := p.pt
+							{
+								if res7, hit := p.compiledMemoized(92); hit {
+									_, ok = res7.v, res7.b
+								} else {
+									pt8 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseCharClassMatcher")
+									}
+									cur := p.pt.rn
+									start := p.pt
+									var matched bool
+									// can't match EOF
+									eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+									if !eof {
+										switch {
+										case cur >= '0' && cur <= '9':
+											matched = true
+										}
+									}
+									if matched && !eof {
+										p.read()
+										p.failAt(true, start.position, "[0-9]")
+										ok = true
+									} else {
+										p.failAt(false, start.position, "[0-9]")
+									}
+									if p.debug {
+										p.out("parseCharClassMatcher")
+									}
+									p.compiledMemoize(pt8, 92, nil, ok)
+								}
+							}
+							if ok {
+								p.cur.pos = start6.position
+								p.cur.text = p.sliceFrom(start6)
+								state := p.cloneState()
+								actVal, err := p.callondigit032()
+								if err != nil {
+									p.addErrAt(err, start6.position, []string{})
+								}
+								p.restoreState(state)
+								val = actVal
+								if p.debug {
+									p.printIndent("MATCH", string(p.sliceFrom(start6)))
+								}
+							}
+							if p.debug {
+								p.out("parseActionExpr")
+							}
+							p.compiledMemoize(pt5, 91, val, ok)
+						}
+					}
+					p.popV()
+					if ok {
+						p.incChoiceAltCnt(position{line: 53, col: 11, offset: 1141}, altI)
+					}
+					if !ok {
+						p.restoreState(state3)
+					}
+				case 1:
+					state9 := p.cloneState()
+					p.pushV()
+					{
+						if res10, hit := p.compiledMemoized(93); hit {
+							val, ok = res10.v, res10.b
+						} else {
+							pt11 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseActionExpr")
+							}
+							start12 := p.pt
+							{
+								if res13, hit := p.compiledMemoized(94); hit {
+									_, ok = res13.v, res13.b
+								} else {
+									pt14 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseLabeledExpr")
+									}
+									var v15 any
+									p.pushV()
+									{
+										if res16, hit := p.compiledMemoized(95); hit {
+											v15, ok = res16.v, res16.b
+										} else {
+											pt17 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseSeqExpr")
+											}
+											pt18 := p.pt
+											state19 := p.cloneState()
+											var v20 any
+											var match21 bool
+											{
+												if res22, hit := p.compiledMemoized(96); hit {
+													v20, match21 = res22.v, res22.b
+												} else {
+													pt23 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseAndExpr")
+													}
+													pt24 := p.pt
+													state25 := p.cloneState()
+													var match26 bool
+													{
+														if res27, hit := p.compiledMemoized(97); hit {
+															_, match26 = res27.v, res27.b
+														} else {
+															pt28 := p.pt
+															p.countExpr()
+															if p.debug {
+																p.in("parseCharClassMatcher")
+															}
+															cur := p.pt.rn
+															start := p.pt
+															var matched bool
+															// can't match EOF
+															eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+															if !eof {
+																switch {
+																case cur >= 'a' && cur <= 'z':
+																	matched = true
+																}
+															}
+															if matched && !eof {
+																p.read()
+																p.failAt(true, start.position, "[a-z]")
+																match26 = true
+															} else {
+																p.failAt(false, start.position, "[a-z]")
+															}
+															if p.debug {
+																p.out("parseCharClassMatcher")
+															}
+															p.compiledMemoize(pt28, 97, nil, match26)
+														}
+													}
+													p.restoreState(state25)
+													p.restore(pt24)
+													match21 = match26
+													if p.debug {
+														p.out("parseAndExpr")
+													}
+													p.compiledMemoize(pt23, 96, v20, match21)
+												}
+											}
+											if match21 {
+												var v29 any
+												var match30 bool
+												{
+													if res31, hit := p.compiledMemoized(98); hit {
+														v29, match30 = res31.v, res31.b
+													} else {
+														pt32 := p.pt
+														p.countExpr()
+														if p.debug {
+															p.in("parseThrowExpr")
+														}
+														v29, match30 = p.throw("errAlphaLower", "errAlphaLower")
+														if p.debug {
+															p.out("parseThrowExpr")
+														}
+														p.compiledMemoize(pt32, 98, v29, match30)
+													}
+												}
+												if match30 {
+													v15 = []any{v20, v29}
+													ok = true
+												}
+											}
+											if !ok {
+												p.restoreState(state19)
+												p.restore(pt18)
+											}
+											if p.debug {
+												p.out("parseSeqExpr")
+											}
+											p.compiledMemoize(pt17, 95, v15, ok)
+										}
+									}
+									p.popV()
+									if ok {
+										p.vstack[len(p.vstack)-1]["x"] = v15
+										_ = v15
+									}
+									if p.debug {
+										p.out("parseLabeledExpr")
+									}
+									p.compiledMemoize(pt14, 94, nil, ok)
+								}
+							}
+							if ok {
+								p.cur.pos = start12.position
+								p.cur.text = p.sliceFrom(start12)
+								state := p.cloneState()
+								actVal, err := p.callondigit034()
+								if err != nil {
+									p.addErrAt(err, start12.position, []string{})
+								}
+								p.restoreState(state)
+								val = actVal
+								if p.debug {
+									p.printIndent("MATCH", string(p.sliceFrom(start12)))
+								}
+							}
+							if p.debug {
+								p.out("parseActionExpr")
+							}
+							p.compiledMemoize(pt11, 93, val, ok)
+						}
+					}
+					p.popV()
+					if ok {
+						p.incChoiceAltCnt(position{line: 53, col: 11, offset: 1141}, altI)
+					}
+					if !ok {
+						p.restoreState(state9)
+					}
+				case 2:
+					state33 := p.cloneState()
+					p.pushV()
+					{
+						if res34, hit := p.compiledMemoized(99); hit {
+							val, ok = res34.v, res34.b
+						} else {
+							pt35 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseActionExpr")
+							}
+							start36 := p.pt
+							{
+								if res37, hit := p.compiledMemoized(100); hit {
+									_, ok = res37.v, res37.b
+								} else {
+									pt38 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseLabeledExpr")
+									}
+									var v39 any
+									p.pushV()
+									{
+										if res40, hit := p.compiledMemoized(101); hit {
+											v39, ok = res40.v, res40.b
+										} else {
+											pt41 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseSeqExpr")
+											}
+											pt42 := p.pt
+											state43 := p.cloneState()
+											var v44 any
+											var match45 bool
+											{
+												if res46, hit := p.compiledMemoized(102); hit {
+													v44, match45 = res46.v, res46.b
+												} else {
+													pt47 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseAndExpr")
+													}
+													pt48 := p.pt
+													state49 := p.cloneState()
+													var match50 bool
+													{
+														if res51, hit := p.compiledMemoized(103); hit {
+															_, match50 = res51.v, res51.b
+														} else {
+															pt52 := p.pt
+															p.countExpr()
+															if p.debug {
+																p.in("parseCharClassMatcher")
+															}
+															cur := p.pt.rn
+															start := p.pt
+															var matched bool
+															// can't match EOF
+															eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+															if !eof {
+																switch {
+																case cur >= 'A' && cur <= 'Z':
+																	matched = true
+																}
+															}
+															if matched && !eof {
+																p.read()
+																p.failAt(true, start.position, "[A-Z]")
+																match50 = true
+															} else {
+																p.failAt(false, start.position, "[A-Z]")
+															}
+															if p.debug {
+																p.out("parseCharClassMatcher")
+															}
+															p.compiledMemoize(pt52, 103, nil, match50)
+														}
+													}
+													p.restoreState(state49)
+													p.restore(pt48)
+													match45 = match50
+													if p.debug {
+														p.out("parseAndExpr")
+													}
+													p.compiledMemoize(pt47, 102, v44, match45)
+												}
+											}
+											if match45 {
+												var v53 any
+												var match54 bool
+												{
+													if res55, hit := p.compiledMemoized(104); hit {
+														v53, match54 = res55.v, res55.b
+													} else {
+														pt56 := p.pt
+														p.countExpr()
+														if p.debug {
+															p.in("parseThrowExpr")
+														}
+														v53, match54 = p.throw("errAlphaUpper", "errAlphaUpper")
+														if p.debug {
+															p.out("parseThrowExpr")
+														}
+														p.compiledMemoize(pt56, 104, v53, match54)
+													}
+												}
+												if match54 {
+													v39 = []any{v44, v53}
+													ok = true
+												}
+											}
+											if !ok {
+												p.restoreState(state43)
+												p.restore(pt42)
+											}
+											if p.debug {
+												p.out("parseSeqExpr")
+											}
+											p.compiledMemoize(pt41, 101, v39, ok)
+										}
+									}
+									p.popV()
+									if ok {
+										p.vstack[len(p.vstack)-1]["x"] = v39
+										_ = v39
+									}
+									if p.debug {
+										p.out("parseLabeledExpr")
+									}
+									p.compiledMemoize(pt38, 100, nil, ok)
+								}
+							}
+							if ok {
+								p.cur.pos = start36.position
+								p.cur.text = p.sliceFrom(start36)
+								state := p.cloneState()
+								actVal, err := p.callondigit0310()
+								if err != nil {
+									p.addErrAt(err, start36.position, []string{})
+								}
+								p.restoreState(state)
+								val = actVal
+								if p.debug {
+									p.printIndent("MATCH", string(p.sliceFrom(start36)))
+								}
+							}
+							if p.debug {
+								p.out("parseActionExpr")
+							}
+							p.compiledMemoize(pt35, 99, val, ok)
+						}
+					}
+					p.popV()
+					if ok {
+						p.incChoiceAltCnt(position{line: 53, col: 11, offset: 1141}, altI)
+					}
+					if !ok {
+						p.restoreState(state33)
+					}
+				case 3:
+					state57 := p.cloneState()
+					p.pushV()
+					{
+						if res58, hit := p.compiledMemoized(105); hit {
+							val, ok = res58.v, res58.b
+						} else {
+							pt59 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseThrowExpr")
+							}
+							val, ok = p.throw("errOther", "errOther")
+							if p.debug {
+								p.out("parseThrowExpr")
+							}
+							p.compiledMemoize(pt59, 105, val, ok)
+						}
+					}
+					p.popV()
+					if ok {
+						p.incChoiceAltCnt(position{line: 53, col: 11, offset: 1141}, altI)
+					}
+					if !ok {
+						p.restoreState(state57)
+					}
+				}
+				if ok {
+					break
+				}
+			}
+			if !ok {
+				p.incChoiceAltCnt(position{line: 53, col: 11, offset: 1141}, choiceNoMatch)
+			}
+			if p.debug {
+				p.out("parseChoiceExpr")
+			}
+			p.compiledMemoize(pt2, 90, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr106() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(106); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(107); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseSeqExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(108); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseAndCodeExpr")
+							}
+							state := p.cloneState()
+							match, err := p.callonErrAlphaInner033()
+							if err != nil {
+								p.addErr(err)
+							}
+							p.restoreState(state)
+							match8 = match
+							if p.debug {
+								p.out("parseAndCodeExpr")
+							}
+							p.compiledMemoize(pt10, 108, nil, match8)
+						}
+					}
+					if match8 {
+						var match11 bool
+						{
+							if res12, hit := p.compiledMemoized(109); hit {
+								_, match11 = res12.v, res12.b
+							} else {
+								pt13 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseZeroOrMoreExpr")
+								}
+								for {
+									var match14 bool
+									{
+										if res15, hit := p.compiledMemoized(110); hit {
+											_, match14 = res15.v, res15.b
+										} else {
+											pt16 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseSeqExpr")
+											}
+											pt17 := p.pt
+											state18 := p.cloneState()
+											var match19 bool
+											{
+												if res20, hit := p.compiledMemoized(111); hit {
+													_, match19 = res20.v, res20.b
+												} else {
+													pt21 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseNotExpr")
+													}
+													pt22 := p.pt
+													state23 := p.cloneState()
+													p.maxFailInvertExpected = !p.maxFailInvertExpected
+													var match24 bool
+													{
+														if res25, hit := p.compiledMemoized(112); hit {
+															_, match24 = res25.v, res25.b
+														} else {
+															pt26 := p.pt
+															p.countExpr()
+															if p.debug {
+																p.in("parseCharClassMatcher")
+															}
+															cur := p.pt.rn
+															start := p.pt
+															var matched bool
+															// can't match EOF
+															eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+															if !eof {
+																switch {
+																case cur >= '0' && cur <= '9':
+																	matched = true
+																}
+															}
+															if matched && !eof {
+																p.read()
+																p.failAt(true, start.position, "[0-9]")
+																match24 = true
+															} else {
+																p.failAt(false, start.position, "[0-9]")
+															}
+															if p.debug {
+																p.out("parseCharClassMatcher")
+															}
+															p.compiledMemoize(pt26, 112, nil, match24)
+														}
+													}
+													p.maxFailInvertExpected = !p.maxFailInvertExpected
+													p.restoreState(state23)
+													p.restore(pt22)
+													match19 = !match24
+													if p.debug {
+														p.out("parseNotExpr")
+													}
+													p.compiledMemoize(pt21, 111, nil, match19)
+												}
+											}
+											if match19 {
+												var match27 bool
+												{
+													if res28, hit := p.compiledMemoized(113); hit {
+														_, match27 = res28.v, res28.b
+													} else {
+														pt29 := p.pt
+														p.countExpr()
+														if p.debug {
+															p.in("parseAnyMatcher")
+														}
+														if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+															// EOF - see utf8.DecodeRune
+															p.failAt(false, p.pt.position, ".")
+														} else {
+															start := p.pt
+															p.read()
+															p.failAt(true, start.position, ".")
+															match27 = true
+														}
+														if p.debug {
+															p.out("parseAnyMatcher")
+														}
+														p.compiledMemoize(pt29, 113, nil, match27)
+													}
+												}
+												if match27 {
+													match14 = true
+												}
+											}
+											if !match14 {
+												p.restoreState(state18)
+												p.restore(pt17)
+											}
+											if p.debug {
+												p.out("parseSeqExpr")
+											}
+											p.compiledMemoize(pt16, 110, nil, match14)
+										}
+									}
+									if !match14 {
+										break
+									}
+								}
+								match11 = true
+								if p.debug {
+									p.out("parseZeroOrMoreExpr")
+								}
+								p.compiledMemoize(pt13, 109, nil, match11)
+							}
+						}
+						if match11 {
+							ok = true
+						}
+					}
+					if !ok {
+						p.restoreState(state7)
+						p.restore(pt6)
+					}
+					if p.debug {
+						p.out("parseSeqExpr")
+					}
+					p.compiledMemoize(pt5, 107, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonErrAlphaInner031()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 106, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr114() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(114); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(115); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseSeqExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(116); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseAndCodeExpr")
+							}
+							state := p.cloneState()
+							match, err := p.callonErrAlphaOuter033()
+							if err != nil {
+								p.addErr(err)
+							}
+							p.restoreState(state)
+							match8 = match
+							if p.debug {
+								p.out("parseAndCodeExpr")
+							}
+							p.compiledMemoize(pt10, 116, nil, match8)
+						}
+					}
+					if match8 {
+						var match11 bool
+						{
+							if res12, hit := p.compiledMemoized(117); hit {
+								_, match11 = res12.v, res12.b
+							} else {
+								pt13 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseZeroOrMoreExpr")
+								}
+								for {
+									var match14 bool
+									{
+										if res15, hit := p.compiledMemoized(118); hit {
+											_, match14 = res15.v, res15.b
+										} else {
+											pt16 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseSeqExpr")
+											}
+											pt17 := p.pt
+											state18 := p.cloneState()
+											var match19 bool
+											{
+												if res20, hit := p.compiledMemoized(119); hit {
+													_, match19 = res20.v, res20.b
+												} else {
+													pt21 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseNotExpr")
+													}
+													pt22 := p.pt
+													state23 := p.cloneState()
+													p.maxFailInvertExpected = !p.maxFailInvertExpected
+													var match24 bool
+													{
+														if res25, hit := p.compiledMemoized(120); hit {
+															_, match24 = res25.v, res25.b
+														} else {
+															pt26 := p.pt
+															p.countExpr()
+															if p.debug {
+																p.in("parseCharClassMatcher")
+															}
+															cur := p.pt.rn
+															start := p.pt
+															var matched bool
+															// can't match EOF
+															eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+															if !eof {
+																switch {
+																case cur >= '0' && cur <= '9':
+																	matched = true
+																}
+															}
+															if matched && !eof {
+																p.read()
+																p.failAt(true, start.position, "[0-9]")
+																match24 = true
+															} else {
+																p.failAt(false, start.position, "[0-9]")
+															}
+															if p.debug {
+																p.out("parseCharClassMatcher")
+															}
+															p.compiledMemoize(pt26, 120, nil, match24)
+														}
+													}
+													p.maxFailInvertExpected = !p.maxFailInvertExpected
+													p.restoreState(state23)
+													p.restore(pt22)
+													match19 = !match24
+													if p.debug {
+														p.out("parseNotExpr")
+													}
+													p.compiledMemoize(pt21, 119, nil, match19)
+												}
+											}
+											if match19 {
+												var match27 bool
+												{
+													if res28, hit := p.compiledMemoized(121); hit {
+														_, match27 = res28.v, res28.b
+													} else {
+														pt29 := p.pt
+														p.countExpr()
+														if p.debug {
+															p.in("parseAnyMatcher")
+														}
+														if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+															// EOF - see utf8.DecodeRune
+															p.failAt(false, p.pt.position, ".")
+														} else {
+															start := p.pt
+															p.read()
+															p.failAt(true, start.position, ".")
+															match27 = true
+														}
+														if p.debug {
+															p.out("parseAnyMatcher")
+														}
+														p.compiledMemoize(pt29, 121, nil, match27)
+													}
+												}
+												if match27 {
+													match14 = true
+												}
+											}
+											if !match14 {
+												p.restoreState(state18)
+												p.restore(pt17)
+											}
+											if p.debug {
+												p.out("parseSeqExpr")
+											}
+											p.compiledMemoize(pt16, 118, nil, match14)
+										}
+									}
+									if !match14 {
+										break
+									}
+								}
+								match11 = true
+								if p.debug {
+									p.out("parseZeroOrMoreExpr")
+								}
+								p.compiledMemoize(pt13, 117, nil, match11)
+							}
+						}
+						if match11 {
+							ok = true
+						}
+					}
+					if !ok {
+						p.restoreState(state7)
+						p.restore(pt6)
+					}
+					if p.debug {
+						p.out("parseSeqExpr")
+					}
+					p.compiledMemoize(pt5, 115, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonErrAlphaOuter031()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 114, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr122() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(122); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(123); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseSeqExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(124); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseAndCodeExpr")
+							}
+							state := p.cloneState()
+							match, err := p.callonErrOtherOuter033()
+							if err != nil {
+								p.addErr(err)
+							}
+							p.restoreState(state)
+							match8 = match
+							if p.debug {
+								p.out("parseAndCodeExpr")
+							}
+							p.compiledMemoize(pt10, 124, nil, match8)
+						}
+					}
+					if match8 {
+						var match11 bool
+						{
+							if res12, hit := p.compiledMemoized(125); hit {
+								_, match11 = res12.v, res12.b
+							} else {
+								pt13 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseZeroOrMoreExpr")
+								}
+								for {
+									var match14 bool
+									{
+										if res15, hit := p.compiledMemoized(126); hit {
+											_, match14 = res15.v, res15.b
+										} else {
+											pt16 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseSeqExpr")
+											}
+											pt17 := p.pt
+											state18 := p.cloneState()
+											var match19 bool
+											{
+												if res20, hit := p.compiledMemoized(127); hit {
+													_, match19 = res20.v, res20.b
+												} else {
+													pt21 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseNotExpr")
+													}
+													pt22 := p.pt
+													state23 := p.cloneState()
+													p.maxFailInvertExpected = !p.maxFailInvertExpected
+													var match24 bool
+													{
+														if res25, hit := p.compiledMemoized(128); hit {
+															_, match24 = res25.v, res25.b
+														} else {
+															pt26 := p.pt
+															p.countExpr()
+															if p.debug {
+																p.in("parseCharClassMatcher")
+															}
+															cur := p.pt.rn
+															start := p.pt
+															var matched bool
+															// can't match EOF
+															eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+															if !eof {
+																switch {
+																case cur >= '0' && cur <= '9':
+																	matched = true
+																}
+															}
+															if matched && !eof {
+																p.read()
+																p.failAt(true, start.position, "[0-9]")
+																match24 = true
+															} else {
+																p.failAt(false, start.position, "[0-9]")
+															}
+															if p.debug {
+																p.out("parseCharClassMatcher")
+															}
+															p.compiledMemoize(pt26, 128, nil, match24)
+														}
+													}
+													p.maxFailInvertExpected = !p.maxFailInvertExpected
+													p.restoreState(state23)
+													p.restore(pt22)
+													match19 = !match24
+													if p.debug {
+														p.out("parseNotExpr")
+													}
+													p.compiledMemoize(pt21, 127, nil, match19)
+												}
+											}
+											if match19 {
+												var match27 bool
+												{
+													if res28, hit := p.compiledMemoized(129); hit {
+														_, match27 = res28.v, res28.b
+													} else {
+														pt29 := p.pt
+														p.countExpr()
+														if p.debug {
+															p.in("parseAnyMatcher")
+														}
+														if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+															// EOF - see utf8.DecodeRune
+															p.failAt(false, p.pt.position, ".")
+														} else {
+															start := p.pt
+															p.read()
+															p.failAt(true, start.position, ".")
+															match27 = true
+														}
+														if p.debug {
+															p.out("parseAnyMatcher")
+														}
+														p.compiledMemoize(pt29, 129, nil, match27)
+													}
+												}
+												if match27 {
+													match14 = true
+												}
+											}
+											if !match14 {
+												p.restoreState(state18)
+												p.restore(pt17)
+											}
+											if p.debug {
+												p.out("parseSeqExpr")
+											}
+											p.compiledMemoize(pt16, 126, nil, match14)
+										}
+									}
+									if !match14 {
+										break
+									}
+								}
+								match11 = true
+								if p.debug {
+									p.out("parseZeroOrMoreExpr")
+								}
+								p.compiledMemoize(pt13, 125, nil, match11)
+							}
+						}
+						if match11 {
+							ok = true
+						}
+					}
+					if !ok {
+						p.restoreState(state7)
+						p.restore(pt6)
+					}
+					if p.debug {
+						p.out("parseSeqExpr")
+					}
+					p.compiledMemoize(pt5, 123, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonErrOtherOuter031()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 122, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr130() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(130); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(131); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseLabeledExpr")
+					}
+					var v6 any
+					p.pushV()
+					{
+						if res7, hit := p.compiledMemoized(132); hit {
+							v6, ok = res7.v, res7.b
+						} else {
+							pt8 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseRuleRefExpr OuterRecover04")
+							}
+							v6, ok = p.parseRuleWrap(g.rules[17])
+							if p.debug {
+								p.out("parseRuleRefExpr OuterRecover04")
+							}
+							p.compiledMemoize(pt8, 132, v6, ok)
+						}
+					}
+					p.popV()
+					if ok {
+						p.vstack[len(p.vstack)-1]["case04"] = v6
+						_ = v6
+					}
+					if p.debug {
+						p.out("parseLabeledExpr")
+					}
+					p.compiledMemoize(pt5, 131, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.calloncase041()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 130, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr133() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(133); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseRecoveryExpr (errOther)")
+			}
+			p.pushRecovery([]string{"errOther"}, (*parser).expr137)
+			{
+				if res3, hit := p.compiledMemoized(134); hit {
+					val, ok = res3.v, res3.b
+				} else {
+					pt4 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseRecoveryExpr (errAlphaLower,errAlphaUpper)")
+					}
+					p.pushRecovery([]string{"errAlphaLower", "errAlphaUpper"}, (*parser).expr136)
+					{
+						if res5, hit := p.compiledMemoized(135); hit {
+							val, ok = res5.v, res5.b
+						} else {
+							pt6 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseRuleRefExpr InnerRecover04")
+							}
+							val, ok = p.parseRuleWrap(g.rules[18])
+							if p.debug {
+								p.out("parseRuleRefExpr InnerRecover04")
+							}
+							p.compiledMemoize(pt6, 135, val, ok)
+						}
+					}
+					p.popRecovery()
+					if p.debug {
+						p.out("parseRecoveryExpr (errAlphaLower,errAlphaUpper)")
+					}
+					p.compiledMemoize(pt4, 134, val, ok)
+				}
+			}
+			p.popRecovery()
+			if p.debug {
+				p.out("parseRecoveryExpr (errOther)")
+			}
+			p.compiledMemoize(pt2, 133, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr138() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(138); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseRecoveryExpr (errAlphaLower)")
+			}
+			p.pushRecovery([]string{"errAlphaLower"}, (*parser).expr140)
+			{
+				if res3, hit := p.compiledMemoized(139); hit {
+					val, ok = res3.v, res3.b
+				} else {
+					pt4 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseRuleRefExpr number04")
+					}
+					val, ok = p.parseRuleWrap(g.rules[19])
+					if p.debug {
+						p.out("parseRuleRefExpr number04")
+					}
+					p.compiledMemoize(pt4, 139, val, ok)
+				}
+			}
+			p.popRecovery()
+			if p.debug {
+				p.out("parseRecoveryExpr (errAlphaLower)")
+			}
+			p.compiledMemoize(pt2, 138, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr141() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(141); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseChoiceExpr")
+			}
+			state3 := p.cloneState()
+			{
+				if res4, hit := p.compiledMemoized(142); hit {
+					val, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseNotExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					p.maxFailInvertExpected = !p.maxFailInvertExpected
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(143); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseAnyMatcher")
+							}
+							if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+								// EOF - see utf8.DecodeRune
+								p.failAt(false, p.pt.position, ".")
+							} else {
+								start := p.pt
+								p.read()
+								p.failAt(true, start.position, ".")
+								match8 = true
+							}
+							if p.debug {
+								p.out("parseAnyMatcher")
+							}
+							p.compiledMemoize(pt10, 143, nil, match8)
+						}
+					}
+					p.maxFailInvertExpected = !p.maxFailInvertExpected
+					p.restoreState(state7)
+					p.restore(pt6)
+					ok = !match8
+					if p.debug {
+						p.out("parseNotExpr")
+					}
+					p.compiledMemoize(pt5, 142, val, ok)
+				}
+			}
+			if ok {
+				p.incChoiceAltCnt(position{line: 82, col: 12, offset: 2006}, 0)
+			}
+			if !ok {
+				p.restoreState(state3)
+			}
+			if !ok {
+				state11 := p.cloneState()
+				p.pushV()
+				{
+					if res12, hit := p.compiledMemoized(144); hit {
+						val, ok = res12.v, res12.b
+					} else {
+						pt13 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseActionExpr")
+						}
+						start14 := p.pt
+						{
+							if res15, hit := p.compiledMemoized(145); hit {
+								_, ok = res15.v, res15.b
+							} else {
+								pt16 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseSeqExpr")
+								}
+								pt17 := p.pt
+								state18 := p.cloneState()
+								var match19 bool
+								{
+									if res20, hit := p.compiledMemoized(146); hit {
+										_, match19 = res20.v, res20.b
+									} else {
+										pt21 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseLabeledExpr")
+										}
+										var v22 any
+										p.pushV()
+										{
+											if res23, hit := p.compiledMemoized(147); hit {
+												v22, match19 = res23.v, res23.b
+											} else {
+												pt24 := p.pt
+												p.countExpr()
+												if p.debug {
+													p.in("parseRuleRefExpr number04")
+												}
+												v22, match19 = p.parseRuleWrap(g.rules[19])
+												if p.debug {
+													p.out("parseRuleRefExpr number04")
+												}
+												p.compiledMemoize(pt24, 147, v22, match19)
+											}
+										}
+										p.popV()
+										if match19 {
+											p.vstack[len(p.vstack)-1]["n"] = v22
+											_ = v22
+										}
+										if p.debug {
+											p.out("parseLabeledExpr")
+										}
+										p.compiledMemoize(pt21, 146, nil, match19)
+									}
+								}
+								if match19 {
+									var match25 bool
+									{
+										if res26, hit := p.compiledMemoized(148); hit {
+											_, match25 = res26.v, res26.b
+										} else {
+											pt27 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseLabeledExpr")
+											}
+											var v28 any
+											p.pushV()
+											{
+												if res29, hit := p.compiledMemoized(149); hit {
+													v28, match25 = res29.v, res29.b
+												} else {
+													pt30 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseRuleRefExpr digit04")
+													}
+													v28, match25 = p.parseRuleWrap(g.rules[20])
+													if p.debug {
+														p.out("parseRuleRefExpr digit04")
+													}
+													p.compiledMemoize(pt30, 149, v28, match25)
+												}
+											}
+											p.popV()
+											if match25 {
+												p.vstack[len(p.vstack)-1]["d"] = v28
+												_ = v28
+											}
+											if p.debug {
+												p.out("parseLabeledExpr")
+											}
+											p.compiledMemoize(pt27, 148, nil, match25)
+										}
+									}
+									if match25 {
+										ok = true
+									}
+								}
+								if !ok {
+									p.restoreState(state18)
+									p.restore(pt17)
+								}
+								if p.debug {
+									p.out("parseSeqExpr")
+								}
+								p.compiledMemoize(pt16, 145, nil, ok)
+							}
+						}
+						if ok {
+							p.cur.pos = start14.position
+							p.cur.text = p.sliceFrom(start14)
+							state := p.cloneState()
+							actVal, err := p.callonnumber044()
+							if err != nil {
+								p.addErrAt(err, start14.position, []string{})
+							}
+							p.restoreState(state)
+							val = actVal
+							if p.debug {
+								p.printIndent("MATCH", string(p.sliceFrom(start14)))
+							}
+						}
+						if p.debug {
+							p.out("parseActionExpr")
+						}
+						p.compiledMemoize(pt13, 144, val, ok)
+					}
+				}
+				p.popV()
+				if ok {
+					p.incChoiceAltCnt(position{line: 82, col: 12, offset: 2006}, 1)
+				}
+				if !ok {
+					p.restoreState(state11)
+				}
+			}
+			if !ok {
+				state31 := p.cloneState()
+				p.pushV()
+				{
+					if res32, hit := p.compiledMemoized(150); hit {
+						val, ok = res32.v, res32.b
+					} else {
+						pt33 := p.pt
+						p.countExpr()
+						if p.debug {
+							p.in("parseActionExpr")
+						}
+						start34 := p.pt
+						{
+							if res35, hit := p.compiledMemoized(151); hit {
+								_, ok = res35.v, res35.b
+							} else {
+								pt36 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseLabeledExpr")
+								}
+								var v37 any
+								p.pushV()
+								{
+									if res38, hit := p.compiledMemoized(152); hit {
+										v37, ok = res38.v, res38.b
+									} else {
+										pt39 := p.pt
+										p.countExpr()
+										if p.debug {
+											p.in("parseRuleRefExpr digit04")
+										}
+										v37, ok = p.parseRuleWrap(g.rules[20])
+										if p.debug {
+											p.out("parseRuleRefExpr digit04")
+										}
+										p.compiledMemoize(pt39, 152, v37, ok)
+									}
+								}
+								p.popV()
+								if ok {
+									p.vstack[len(p.vstack)-1]["d"] = v37
+									_ = v37
+								}
+								if p.debug {
+									p.out("parseLabeledExpr")
+								}
+								p.compiledMemoize(pt36, 151, nil, ok)
+							}
+						}
+						if ok {
+							p.cur.pos = start34.position
+							p.cur.text = p.sliceFrom(start34)
+							state := p.cloneState()
+							actVal, err := p.callonnumber0410()
+							if err != nil {
+								p.addErrAt(err, start34.position, []string{})
+							}
+							p.restoreState(state)
+							val = actVal
+							if p.debug {
+								p.printIndent("MATCH", string(p.sliceFrom(start34)))
+							}
+						}
+						if p.debug {
+							p.out("parseActionExpr")
+						}
+						p.compiledMemoize(pt33, 150, val, ok)
+					}
+				}
+				p.popV()
+				if ok {
+					p.incChoiceAltCnt(position{line: 82, col: 12, offset: 2006}, 2)
+				}
+				if !ok {
+					p.restoreState(state31)
+				}
+			}
+			if !ok {
+				p.incChoiceAltCnt(position{line: 82, col: 12, offset: 2006}, choiceNoMatch)
+			}
+			if p.debug {
+				p.out("parseChoiceExpr")
+			}
+			p.compiledMemoize(pt2, 141, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr153() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(153); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseChoiceExpr")
+			}
+			d := expr153Dispatch
+			set := d.set(p.pt)
+			for _, want := range d.expected[set] {
+				p.failAt(false, p.pt.position, want)
+			}
+			for _, altI := range d.alts[set] {
+				switch altI {
+				case 0:
+					state3 := p.cloneState()
+					p.pushV()
+					{
+						if res4, hit := p.compiledMemoized(154); hit {
+							val, ok = res4.v, res4.b
+						} else {
+							pt5 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseActionExpr")
+							}
+							start6 := p.pt
+							{
+								if res7, hit := p.compiledMemoized(155); hit {
+									_, ok = res7.v, res7.b
+								} else {
+									pt8 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseCharClassMatcher")
+									}
+									cur := p.pt.rn
+									start := p.pt
+									var matched bool
+									// can't match EOF
+									eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+									if !eof {
+										switch {
+										case cur >= '0' && cur <= '9':
+											matched = true
+										}
+									}
+									if matched && !eof {
+										p.read()
+										p.failAt(true, start.position, "[0-9]")
+										ok = true
+									} else {
+										p.failAt(false, start.position, "[0-9]")
+									}
+									if p.debug {
+										p.out("parseCharClassMatcher")
+									}
+									p.compiledMemoize(pt8, 155, nil, ok)
+								}
+							}
+							if ok {
+								p.cur.pos = start6.position
+								p.cur.text = p.sliceFrom(start6)
+								state := p.cloneState()
+								actVal, err := p.callondigit042()
+								if err != nil {
+									p.addErrAt(err, start6.position, []string{})
+								}
+								p.restoreState(state)
+								val = actVal
+								if p.debug {
+									p.printIndent("MATCH", string(p.sliceFrom(start6)))
+								}
+							}
+							if p.debug {
+								p.out("parseActionExpr")
+							}
+							p.compiledMemoize(pt5, 154, val, ok)
+						}
+					}
+					p.popV()
+					if ok {
+						p.incChoiceAltCnt(position{line: 88, col: 11, offset: 2132}, altI)
+					}
+					if !ok {
+						p.restoreState(state3)
+					}
+				case 1:
+					state9 := p.cloneState()
+					p.pushV()
+					{
+						if res10, hit := p.compiledMemoized(156); hit {
+							val, ok = res10.v, res10.b
+						} else {
+							pt11 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseActionExpr")
+							}
+							start12 := p.pt
+							{
+								if res13, hit := p.compiledMemoized(157); hit {
+									_, ok = res13.v, res13.b
+								} else {
+									pt14 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseLabeledExpr")
+									}
+									var v15 any
+									p.pushV()
+									{
+										if res16, hit := p.compiledMemoized(158); hit {
+											v15, ok = res16.v, res16.b
+										} else {
+											pt17 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseSeqExpr")
+											}
+											pt18 := p.pt
+											state19 := p.cloneState()
+											var v20 any
+											var match21 bool
+											{
+												if res22, hit := p.compiledMemoized(159); hit {
+													v20, match21 = res22.v, res22.b
+												} else {
+													pt23 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseAndExpr")
+													}
+													pt24 := p.pt
+													state25 := p.cloneState()
+													var match26 bool
+													{
+														if res27, hit := p.compiledMemoized(160); hit {
+															_, match26 = res27.v, res27.b
+														} else {
+															pt28 := p.pt
+															p.countExpr()
+															if p.debug {
+																p.in("parseCharClassMatcher")
+															}
+															cur := p.pt.rn
+															start := p.pt
+															var matched bool
+															// can't match EOF
+															eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+															if !eof {
+																switch {
+																case cur >= 'a' && cur <= 'z':
+																	matched = true
+																}
+															}
+															if matched && !eof {
+																p.read()
+																p.failAt(true, start.position, "[a-z]")
+																match26 = true
+															} else {
+																p.failAt(false, start.position, "[a-z]")
+															}
+															if p.debug {
+																p.out("parseCharClassMatcher")
+															}
+															p.compiledMemoize(pt28, 160, nil, match26)
+														}
+													}
+													p.restoreState(state25)
+													p.restore(pt24)
+													match21 = match26
+													if p.debug {
+														p.out("parseAndExpr")
+													}
+													p.compiledMemoize(pt23, 159, v20, match21)
+												}
+											}
+											if match21 {
+												var v29 any
+												var match30 bool
+												{
+													if res31, hit := p.compiledMemoized(161); hit {
+														v29, match30 = res31.v, res31.b
+													} else {
+														pt32 := p.pt
+														p.countExpr()
+														if p.debug {
+															p.in("parseThrowExpr")
+														}
+														v29, match30 = p.throw("errAlphaLower", "errAlphaLower")
+														if p.debug {
+															p.out("parseThrowExpr")
+														}
+														p.compiledMemoize(pt32, 161, v29, match30)
+													}
+												}
+												if match30 {
+													v15 = []any{v20, v29}
+													ok = true
+												}
+											}
+											if !ok {
+												p.restoreState(state19)
+												p.restore(pt18)
+											}
+											if p.debug {
+												p.out("parseSeqExpr")
+											}
+											p.compiledMemoize(pt17, 158, v15, ok)
+										}
+									}
+									p.popV()
+									if ok {
+										p.vstack[len(p.vstack)-1]["x"] = v15
+										_ = v15
+									}
+									if p.debug {
+										p.out("parseLabeledExpr")
+									}
+									p.compiledMemoize(pt14, 157, nil, ok)
+								}
+							}
+							if ok {
+								p.cur.pos = start12.position
+								p.cur.text = p.sliceFrom(start12)
+								state := p.cloneState()
+								actVal, err := p.callondigit044()
+								if err != nil {
+									p.addErrAt(err, start12.position, []string{})
+								}
+								p.restoreState(state)
+								val = actVal
+								if p.debug {
+									p.printIndent("MATCH", string(p.sliceFrom(start12)))
+								}
+							}
+							if p.debug {
+								p.out("parseActionExpr")
+							}
+							p.compiledMemoize(pt11, 156, val, ok)
+						}
+					}
+					p.popV()
+					if ok {
+						p.incChoiceAltCnt(position{line: 88, col: 11, offset: 2132}, altI)
+					}
+					if !ok {
+						p.restoreState(state9)
+					}
+				case 2:
+					state33 := p.cloneState()
+					p.pushV()
+					{
+						if res34, hit := p.compiledMemoized(162); hit {
+							val, ok = res34.v, res34.b
+						} else {
+							pt35 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseActionExpr")
+							}
+							start36 := p.pt
+							{
+								if res37, hit := p.compiledMemoized(163); hit {
+									_, ok = res37.v, res37.b
+								} else {
+									pt38 := p.pt
+									p.countExpr()
+									if p.debug {
+										p.in("parseLabeledExpr")
+									}
+									var v39 any
+									p.pushV()
+									{
+										if res40, hit := p.compiledMemoized(164); hit {
+											v39, ok = res40.v, res40.b
+										} else {
+											pt41 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseSeqExpr")
+											}
+											pt42 := p.pt
+											state43 := p.cloneState()
+											var v44 any
+											var match45 bool
+											{
+												if res46, hit := p.compiledMemoized(165); hit {
+													v44, match45 = res46.v, res46.b
+												} else {
+													pt47 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseAndExpr")
+													}
+													pt48 := p.pt
+													state49 := p.cloneState()
+													var match50 bool
+													{
+														if res51, hit := p.compiledMemoized(166); hit {
+															_, match50 = res51.v, res51.b
+														} else {
+															pt52 := p.pt
+															p.countExpr()
+															if p.debug {
+																p.in("parseCharClassMatcher")
+															}
+															cur := p.pt.rn
+															start := p.pt
+															var matched bool
+															// can't match EOF
+															eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+															if !eof {
+																switch {
+																case cur >= 'A' && cur <= 'Z':
+																	matched = true
+																}
+															}
+															if matched && !eof {
+																p.read()
+																p.failAt(true, start.position, "[A-Z]")
+																match50 = true
+															} else {
+																p.failAt(false, start.position, "[A-Z]")
+															}
+															if p.debug {
+																p.out("parseCharClassMatcher")
+															}
+															p.compiledMemoize(pt52, 166, nil, match50)
+														}
+													}
+													p.restoreState(state49)
+													p.restore(pt48)
+													match45 = match50
+													if p.debug {
+														p.out("parseAndExpr")
+													}
+													p.compiledMemoize(pt47, 165, v44, match45)
+												}
+											}
+											if match45 {
+												var v53 any
+												var match54 bool
+												{
+													if res55, hit := p.compiledMemoized(167); hit {
+														v53, match54 = res55.v, res55.b
+													} else {
+														pt56 := p.pt
+														p.countExpr()
+														if p.debug {
+															p.in("parseThrowExpr")
+														}
+														v53, match54 = p.throw("errAlphaUpper", "errAlphaUpper")
+														if p.debug {
+															p.out("parseThrowExpr")
+														}
+														p.compiledMemoize(pt56, 167, v53, match54)
+													}
+												}
+												if match54 {
+													v39 = []any{v44, v53}
+													ok = true
+												}
+											}
+											if !ok {
+												p.restoreState(state43)
+												p.restore(pt42)
+											}
+											if p.debug {
+												p.out("parseSeqExpr")
+											}
+											p.compiledMemoize(pt41, 164, v39, ok)
+										}
+									}
+									p.popV()
+									if ok {
+										p.vstack[len(p.vstack)-1]["x"] = v39
+										_ = v39
+									}
+									if p.debug {
+										p.out("parseLabeledExpr")
+									}
+									p.compiledMemoize(pt38, 163, nil, ok)
+								}
+							}
+							if ok {
+								p.cur.pos = start36.position
+								p.cur.text = p.sliceFrom(start36)
+								state := p.cloneState()
+								actVal, err := p.callondigit0410()
+								if err != nil {
+									p.addErrAt(err, start36.position, []string{})
+								}
+								p.restoreState(state)
+								val = actVal
+								if p.debug {
+									p.printIndent("MATCH", string(p.sliceFrom(start36)))
+								}
+							}
+							if p.debug {
+								p.out("parseActionExpr")
+							}
+							p.compiledMemoize(pt35, 162, val, ok)
+						}
+					}
+					p.popV()
+					if ok {
+						p.incChoiceAltCnt(position{line: 88, col: 11, offset: 2132}, altI)
+					}
+					if !ok {
+						p.restoreState(state33)
+					}
+				case 3:
+					state57 := p.cloneState()
+					p.pushV()
+					{
+						if res58, hit := p.compiledMemoized(168); hit {
+							val, ok = res58.v, res58.b
+						} else {
+							pt59 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseThrowExpr")
+							}
+							val, ok = p.throw("errOther", "errOther")
+							if p.debug {
+								p.out("parseThrowExpr")
+							}
+							p.compiledMemoize(pt59, 168, val, ok)
+						}
+					}
+					p.popV()
+					if ok {
+						p.incChoiceAltCnt(position{line: 88, col: 11, offset: 2132}, altI)
+					}
+					if !ok {
+						p.restoreState(state57)
+					}
+				}
+				if ok {
+					break
+				}
+			}
+			if !ok {
+				p.incChoiceAltCnt(position{line: 88, col: 11, offset: 2132}, choiceNoMatch)
+			}
+			if p.debug {
+				p.out("parseChoiceExpr")
+			}
+			p.compiledMemoize(pt2, 153, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr169() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(169); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseAndCodeExpr")
+			}
+			state := p.cloneState()
+			match, err := p.callonErrAlphaInner041()
+			if err != nil {
+				p.addErr(err)
+			}
+			p.restoreState(state)
+			ok = match
+			if p.debug {
+				p.out("parseAndCodeExpr")
+			}
+			p.compiledMemoize(pt2, 169, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr170() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(170); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(171); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseSeqExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(172); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseAndCodeExpr")
+							}
+							state := p.cloneState()
+							match, err := p.callonErrAlphaOuter043()
+							if err != nil {
+								p.addErr(err)
+							}
+							p.restoreState(state)
+							match8 = match
+							if p.debug {
+								p.out("parseAndCodeExpr")
+							}
+							p.compiledMemoize(pt10, 172, nil, match8)
+						}
+					}
+					if match8 {
+						var match11 bool
+						{
+							if res12, hit := p.compiledMemoized(173); hit {
+								_, match11 = res12.v, res12.b
+							} else {
+								pt13 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseZeroOrMoreExpr")
+								}
+								for {
+									var match14 bool
+									{
+										if res15, hit := p.compiledMemoized(174); hit {
+											_, match14 = res15.v, res15.b
+										} else {
+											pt16 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseSeqExpr")
+											}
+											pt17 := p.pt
+											state18 := p.cloneState()
+											var match19 bool
+											{
+												if res20, hit := p.compiledMemoized(175); hit {
+													_, match19 = res20.v, res20.b
+												} else {
+													pt21 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseNotExpr")
+													}
+													pt22 := p.pt
+													state23 := p.cloneState()
+													p.maxFailInvertExpected = !p.maxFailInvertExpected
+													var match24 bool
+													{
+														if res25, hit := p.compiledMemoized(176); hit {
+															_, match24 = res25.v, res25.b
+														} else {
+															pt26 := p.pt
+															p.countExpr()
+															if p.debug {
+																p.in("parseCharClassMatcher")
+															}
+															cur := p.pt.rn
+															start := p.pt
+															var matched bool
+															// can't match EOF
+															eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+															if !eof {
+																switch {
+																case cur >= '0' && cur <= '9':
+																	matched = true
+																}
+															}
+															if matched && !eof {
+																p.read()
+																p.failAt(true, start.position, "[0-9]")
+																match24 = true
+															} else {
+																p.failAt(false, start.position, "[0-9]")
+															}
+															if p.debug {
+																p.out("parseCharClassMatcher")
+															}
+															p.compiledMemoize(pt26, 176, nil, match24)
+														}
+													}
+													p.maxFailInvertExpected = !p.maxFailInvertExpected
+													p.restoreState(state23)
+													p.restore(pt22)
+													match19 = !match24
+													if p.debug {
+														p.out("parseNotExpr")
+													}
+													p.compiledMemoize(pt21, 175, nil, match19)
+												}
+											}
+											if match19 {
+												var match27 bool
+												{
+													if res28, hit := p.compiledMemoized(177); hit {
+														_, match27 = res28.v, res28.b
+													} else {
+														pt29 := p.pt
+														p.countExpr()
+														if p.debug {
+															p.in("parseAnyMatcher")
+														}
+														if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+															// EOF - see utf8.DecodeRune
+															p.failAt(false, p.pt.position, ".")
+														} else {
+															start := p.pt
+															p.read()
+															p.failAt(true, start.position, ".")
+															match27 = true
+														}
+														if p.debug {
+															p.out("parseAnyMatcher")
+														}
+														p.compiledMemoize(pt29, 177, nil, match27)
+													}
+												}
+												if match27 {
+													match14 = true
+												}
+											}
+											if !match14 {
+												p.restoreState(state18)
+												p.restore(pt17)
+											}
+											if p.debug {
+												p.out("parseSeqExpr")
+											}
+											p.compiledMemoize(pt16, 174, nil, match14)
+										}
+									}
+									if !match14 {
+										break
+									}
+								}
+								match11 = true
+								if p.debug {
+									p.out("parseZeroOrMoreExpr")
+								}
+								p.compiledMemoize(pt13, 173, nil, match11)
+							}
+						}
+						if match11 {
+							ok = true
+						}
+					}
+					if !ok {
+						p.restoreState(state7)
+						p.restore(pt6)
+					}
+					if p.debug {
+						p.out("parseSeqExpr")
+					}
+					p.compiledMemoize(pt5, 171, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonErrAlphaOuter041()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 170, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr178() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(178); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseActionExpr")
+			}
+			start3 := p.pt
+			{
+				if res4, hit := p.compiledMemoized(179); hit {
+					_, ok = res4.v, res4.b
+				} else {
+					pt5 := p.pt
+					p.countExpr()
+					if p.debug {
+						p.in("parseSeqExpr")
+					}
+					pt6 := p.pt
+					state7 := p.cloneState()
+					var match8 bool
+					{
+						if res9, hit := p.compiledMemoized(180); hit {
+							_, match8 = res9.v, res9.b
+						} else {
+							pt10 := p.pt
+							p.countExpr()
+							if p.debug {
+								p.in("parseAndCodeExpr")
+							}
+							state := p.cloneState()
+							match, err := p.callonErrOtherOuter043()
+							if err != nil {
+								p.addErr(err)
+							}
+							p.restoreState(state)
+							match8 = match
+							if p.debug {
+								p.out("parseAndCodeExpr")
+							}
+							p.compiledMemoize(pt10, 180, nil, match8)
+						}
+					}
+					if match8 {
+						var match11 bool
+						{
+							if res12, hit := p.compiledMemoized(181); hit {
+								_, match11 = res12.v, res12.b
+							} else {
+								pt13 := p.pt
+								p.countExpr()
+								if p.debug {
+									p.in("parseZeroOrMoreExpr")
+								}
+								for {
+									var match14 bool
+									{
+										if res15, hit := p.compiledMemoized(182); hit {
+											_, match14 = res15.v, res15.b
+										} else {
+											pt16 := p.pt
+											p.countExpr()
+											if p.debug {
+												p.in("parseSeqExpr")
+											}
+											pt17 := p.pt
+											state18 := p.cloneState()
+											var match19 bool
+											{
+												if res20, hit := p.compiledMemoized(183); hit {
+													_, match19 = res20.v, res20.b
+												} else {
+													pt21 := p.pt
+													p.countExpr()
+													if p.debug {
+														p.in("parseNotExpr")
+													}
+													pt22 := p.pt
+													state23 := p.cloneState()
+													p.maxFailInvertExpected = !p.maxFailInvertExpected
+													var match24 bool
+													{
+														if res25, hit := p.compiledMemoized(184); hit {
+															_, match24 = res25.v, res25.b
+														} else {
+															pt26 := p.pt
+															p.countExpr()
+															if p.debug {
+																p.in("parseCharClassMatcher")
+															}
+															cur := p.pt.rn
+															start := p.pt
+															var matched bool
+															// can't match EOF
+															eof := cur == utf8.RuneError && p.pt.w == 0 // see utf8.DecodeRune
+															if !eof {
+																switch {
+																case cur >= '0' && cur <= '9':
+																	matched = true
+																}
+															}
+															if matched && !eof {
+																p.read()
+																p.failAt(true, start.position, "[0-9]")
+																match24 = true
+															} else {
+																p.failAt(false, start.position, "[0-9]")
+															}
+															if p.debug {
+																p.out("parseCharClassMatcher")
+															}
+															p.compiledMemoize(pt26, 184, nil, match24)
+														}
+													}
+													p.maxFailInvertExpected = !p.maxFailInvertExpected
+													p.restoreState(state23)
+													p.restore(pt22)
+													match19 = !match24
+													if p.debug {
+														p.out("parseNotExpr")
+													}
+													p.compiledMemoize(pt21, 183, nil, match19)
+												}
+											}
+											if match19 {
+												var match27 bool
+												{
+													if res28, hit := p.compiledMemoized(185); hit {
+														_, match27 = res28.v, res28.b
+													} else {
+														pt29 := p.pt
+														p.countExpr()
+														if p.debug {
+															p.in("parseAnyMatcher")
+														}
+														if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+															// EOF - see utf8.DecodeRune
+															p.failAt(false, p.pt.position, ".")
+														} else {
+															start := p.pt
+															p.read()
+															p.failAt(true, start.position, ".")
+															match27 = true
+														}
+														if p.debug {
+															p.out("parseAnyMatcher")
+														}
+														p.compiledMemoize(pt29, 185, nil, match27)
+													}
+												}
+												if match27 {
+													match14 = true
+												}
+											}
+											if !match14 {
+												p.restoreState(state18)
+												p.restore(pt17)
+											}
+											if p.debug {
+												p.out("parseSeqExpr")
+											}
+											p.compiledMemoize(pt16, 182, nil, match14)
+										}
+									}
+									if !match14 {
+										break
+									}
+								}
+								match11 = true
+								if p.debug {
+									p.out("parseZeroOrMoreExpr")
+								}
+								p.compiledMemoize(pt13, 181, nil, match11)
+							}
+						}
+						if match11 {
+							ok = true
+						}
+					}
+					if !ok {
+						p.restoreState(state7)
+						p.restore(pt6)
+					}
+					if p.debug {
+						p.out("parseSeqExpr")
+					}
+					p.compiledMemoize(pt5, 179, nil, ok)
+				}
+			}
+			if ok {
+				p.cur.pos = start3.position
+				p.cur.text = p.sliceFrom(start3)
+				state := p.cloneState()
+				actVal, err := p.callonErrOtherOuter041()
+				if err != nil {
+					p.addErrAt(err, start3.position, []string{})
+				}
+				p.restoreState(state)
+				val = actVal
+				if p.debug {
+					p.printIndent("MATCH", string(p.sliceFrom(start3)))
+				}
+			}
+			if p.debug {
+				p.out("parseActionExpr")
+			}
+			p.compiledMemoize(pt2, 178, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr30() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(30); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseRuleRefExpr ErrNonNumber")
+			}
+			val, ok = p.parseRuleWrap(g.rules[5])
+			if p.debug {
+				p.out("parseRuleRefExpr ErrNonNumber")
+			}
+			p.compiledMemoize(pt2, 30, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr74() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(74); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseRuleRefExpr ErrOtherOuter03")
+			}
+			val, ok = p.parseRuleWrap(g.rules[15])
+			if p.debug {
+				p.out("parseRuleRefExpr ErrOtherOuter03")
+			}
+			p.compiledMemoize(pt2, 74, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr73() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(73); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseRuleRefExpr ErrAlphaOuter03")
+			}
+			val, ok = p.parseRuleWrap(g.rules[14])
+			if p.debug {
+				p.out("parseRuleRefExpr ErrAlphaOuter03")
+			}
+			p.compiledMemoize(pt2, 73, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr77() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(77); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseRuleRefExpr ErrAlphaInner03")
+			}
+			val, ok = p.parseRuleWrap(g.rules[13])
+			if p.debug {
+				p.out("parseRuleRefExpr ErrAlphaInner03")
+			}
+			p.compiledMemoize(pt2, 77, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr137() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(137); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseRuleRefExpr ErrOtherOuter04")
+			}
+			val, ok = p.parseRuleWrap(g.rules[23])
+			if p.debug {
+				p.out("parseRuleRefExpr ErrOtherOuter04")
+			}
+			p.compiledMemoize(pt2, 137, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr136() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(136); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseRuleRefExpr ErrAlphaOuter04")
+			}
+			val, ok = p.parseRuleWrap(g.rules[22])
+			if p.debug {
+				p.out("parseRuleRefExpr ErrAlphaOuter04")
+			}
+			p.compiledMemoize(pt2, 136, val, ok)
+		}
+	}
+	return val, ok
+}
+
+func (p *parser) expr140() (any, bool) {
+	var val any
+	var ok bool
+	{
+		if res1, hit := p.compiledMemoized(140); hit {
+			val, ok = res1.v, res1.b
+		} else {
+			pt2 := p.pt
+			p.countExpr()
+			if p.debug {
+				p.in("parseRuleRefExpr ErrAlphaInner04")
+			}
+			val, ok = p.parseRuleWrap(g.rules[21])
+			if p.debug {
+				p.out("parseRuleRefExpr ErrAlphaInner04")
+			}
+			p.compiledMemoize(pt2, 140, val, ok)
+		}
+	}
+	return val, ok
+}
+
+var expr43Dispatch = &choiceDispatch{
+	ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+	ranges:    []rune{'\u0080'},
+	rangeSets: "\x00",
+	alts:      [][]int{{1, 2}, {0, 1, 2}},
+	expected:  [][]string{{"[0-9]"}, {}},
+}
+
+var expr90Dispatch = &choiceDispatch{
+	ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+	ranges:    []rune{'\u0080'},
+	rangeSets: "\x00",
+	alts:      [][]int{{1, 2, 3}, {0, 1, 2, 3}},
+	expected:  [][]string{{"[0-9]"}, {}},
+}
+
+var expr153Dispatch = &choiceDispatch{
+	ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+	ranges:    []rune{'\u0080'},
+	rangeSets: "\x00",
+	alts:      [][]int{{1, 2, 3}, {0, 1, 2, 3}},
+	expected:  [][]string{{"[0-9]"}, {}},
+}
+
+func (c *current) onStart1() (bool, error) {
+	return false, nil
+}
+
+func (p *parser) callonStart1() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStart1()
+}
+
+func (c *current) oncase011(case01 any) (any, error) {
+	return case01, nil
+}
+
+func (p *parser) calloncase011() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.oncase011(stack["case01"])
+}
+
+func (c *current) onnumber4(n, d any) (any, error) {
+	return n.(string) + d.(string), nil
+}
+
+func (p *parser) callonnumber4() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onnumber4(stack["n"], stack["d"])
+}
+
+func (c *current) onnumber10(d any) (any, error) {
+	return d.(string), nil
+}
+
+func (p *parser) callonnumber10() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onnumber10(stack["d"])
+}
+
+func (c *current) ondigit2() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callondigit2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ondigit2()
+}
+
+func (c *current) ondigit4(x any) (any, error) {
+	return x.([]any)[1], nil
+}
+
+func (p *parser) callondigit4() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ondigit4(stack["x"])
+}
+
+func (c *current) onErrNonNumber3() (bool, error) {
+	return true, errors.New("expecting a number")
+}
+
+func (p *parser) callonErrNonNumber3() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onErrNonNumber3()
+}
+
+func (c *current) onErrNonNumber1() (any, error) {
+	return "?", nil
+}
+
+func (p *parser) callonErrNonNumber1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onErrNonNumber1()
+}
+
+func (c *current) oncase023() (bool, error) {
+	return false, errors.New("Threw undefined label")
+}
+
+func (p *parser) calloncase023() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.oncase023()
+}
+
+func (c *current) oncase031(case03 any) (any, error) {
+	return case03, nil
+}
+
+func (p *parser) calloncase031() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.oncase031(stack["case03"])
+}
+
+func (c *current) onnumber034(n, d any) (any, error) {
+	return n.(string) + d.(string), nil
+}
+
+func (p *parser) callonnumber034() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onnumber034(stack["n"], stack["d"])
+}
+
+func (c *current) onnumber0310(d any) (any, error) {
+	return d.(string), nil
+}
+
+func (p *parser) callonnumber0310() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onnumber0310(stack["d"])
+}
+
+func (c *current) ondigit032() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callondigit032() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ondigit032()
+}
+
+func (c *current) ondigit034(x any) (any, error) {
+	return x.([]any)[1], nil
+}
+
+func (p *parser) callondigit034() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ondigit034(stack["x"])
+}
+
+func (c *current) ondigit0310(x any) (any, error) {
+	return x.([]any)[1], nil
+}
+
+func (p *parser) callondigit0310() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ondigit0310(stack["x"])
+}
+
+func (c *current) onErrAlphaInner033() (bool, error) {
+	return true, errors.New("expecting a number, got lower case char")
+}
+
+func (p *parser) callonErrAlphaInner033() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onErrAlphaInner033()
+}
+
+func (c *current) onErrAlphaInner031() (any, error) {
+	return "<", nil
+}
+
+func (p *parser) callonErrAlphaInner031() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onErrAlphaInner031()
+}
+
+func (c *current) onErrAlphaOuter033() (bool, error) {
+	return true, errors.New("expecting a number, got upper case char")
+}
+
+func (p *parser) callonErrAlphaOuter033() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onErrAlphaOuter033()
+}
+
+func (c *current) onErrAlphaOuter031() (any, error) {
+	return ">", nil
+}
+
+func (p *parser) callonErrAlphaOuter031() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onErrAlphaOuter031()
+}
+
+func (c *current) onErrOtherOuter033() (bool, error) {
+	return true, errors.New("expecting a number, got a non-char")
+}
+
+func (p *parser) callonErrOtherOuter033() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onErrOtherOuter033()
+}
+
+func (c *current) onErrOtherOuter031() (any, error) {
+	return "?", nil
+}
+
+func (p *parser) callonErrOtherOuter031() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onErrOtherOuter031()
+}
+
+func (c *current) oncase041(case04 any) (any, error) {
+	return case04, nil
+}
+
+func (p *parser) calloncase041() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.oncase041(stack["case04"])
+}
+
+func (c *current) onnumber044(n, d any) (any, error) {
+	return n.(string) + d.(string), nil
+}
+
+func (p *parser) callonnumber044() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onnumber044(stack["n"], stack["d"])
+}
+
+func (c *current) onnumber0410(d any) (any, error) {
+	return d.(string), nil
+}
+
+func (p *parser) callonnumber0410() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onnumber0410(stack["d"])
+}
+
+func (c *current) ondigit042() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callondigit042() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ondigit042()
+}
+
+func (c *current) ondigit044(x any) (any, error) {
+	return x.([]any)[1], nil
+}
+
+func (p *parser) callondigit044() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ondigit044(stack["x"])
+}
+
+func (c *current) ondigit0410(x any) (any, error) {
+	return x.([]any)[1], nil
+}
+
+func (p *parser) callondigit0410() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ondigit0410(stack["x"])
+}
+
+func (c *current) onErrAlphaInner041() (bool, error) {
+	return false, nil
+}
+
+func (p *parser) callonErrAlphaInner041() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onErrAlphaInner041()
+}
+
+func (c *current) onErrAlphaOuter043() (bool, error) {
+	return true, errors.New("expecting a number, got a char")
+}
+
+func (p *parser) callonErrAlphaOuter043() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onErrAlphaOuter043()
+}
+
+func (c *current) onErrAlphaOuter041() (any, error) {
+	return "x", nil
+}
+
+func (p *parser) callonErrAlphaOuter041() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onErrAlphaOuter041()
+}
+
+func (c *current) onErrOtherOuter043() (bool, error) {
+	return true, errors.New("expecting a number, got a non-char")
+}
+
+func (p *parser) callonErrOtherOuter043() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onErrOtherOuter043()
+}
+
+func (c *current) onErrOtherOuter041() (any, error) {
+	return "?", nil
+}
+
+func (p *parser) callonErrOtherOuter041() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onErrOtherOuter041()
+}
+
+var (
+	// errNoRule is returned when the grammar to parse has no rule.
+	errNoRule = errors.New("grammar has no rule")
+
+	// errInvalidEntrypoint is returned when the specified entrypoint rule
+	// does not exit.
+	errInvalidEntrypoint = errors.New("invalid entrypoint")
+
+	// errInvalidEncoding is returned when the source is not properly
+	// utf8-encoded.
+	errInvalidEncoding = errors.New("invalid encoding")
+
+	// errMaxExprCnt is used to signal that the maximum number of
+	// expressions have been parsed.
+	errMaxExprCnt = errors.New("max number of expressions parsed")
+)
+
+// Option is a function that can set an option on the parser. It returns
+// the previous setting as an Option.
+type Option func(*parser) Option
+
+// MaxExpressions creates an Option to stop parsing after the provided
+// number of expressions have been parsed, if the value is 0 then the parser will
+// parse for as many steps as needed (possibly an infinite number).
+//
+// The default for maxExprCnt is 0.
+func MaxExpressions(maxExprCnt uint64) Option {
+	return func(p *parser) Option {
+		oldMaxExprCnt := p.maxExprCnt
+		p.maxExprCnt = maxExprCnt
+		return MaxExpressions(oldMaxExprCnt)
+	}
+}
+
+// Entrypoint creates an Option to set the rule name to use as entrypoint.
+// The rule name must have been specified in the -alternate-entrypoints
+// if generating the parser with the -optimize-grammar flag, otherwise
+// it may have been optimized out. Passing an empty string sets the
+// entrypoint to the first rule in the grammar.
+//
+// The default is to start parsing at the first rule in the grammar.
+func Entrypoint(ruleName string) Option {
+	return func(p *parser) Option {
+		oldEntrypoint := p.entrypoint
+		p.entrypoint = ruleName
+		if ruleName == "" {
+			p.entrypoint = g.rules[0].name
+		}
+		return Entrypoint(oldEntrypoint)
+	}
+}
+
+// Statistics adds a user provided Stats struct to the parser to allow
+// the user to process the results after the parsing has finished.
+// Also the key for the "no match" counter is set. Per-rule statistics
+// (see RuleStats) are only collected when this option is set.
+//
+// Example usage:
+//
+//	input := "input"
+//	stats := Stats{}
+//	_, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	fmt.Println(string(b))
+func Statistics(stats *Stats, choiceNoMatch string) Option {
+	return func(p *parser) Option {
+		oldStats := p.Stats
+		p.Stats = stats
+		oldChoiceNoMatch := p.choiceNoMatch
+		p.choiceNoMatch = choiceNoMatch
+		if p.Stats.ChoiceAltCnt == nil {
+			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
+		}
+		if p.Stats.Rules == nil {
+			p.Stats.Rules = make(map[string]*RuleStats)
+		}
+		return Statistics(oldStats, oldChoiceNoMatch)
+	}
+}
+
+// Debug creates an Option to set the debug flag to b. When set to true,
+// debugging information is printed to stdout while parsing.
+//
+// The default is false.
+func Debug(b bool) Option {
+	return func(p *parser) Option {
+		old := p.debug
+		p.debug = b
+		return Debug(old)
+	}
+}
+
+// Memoize creates an Option to set the memoize flag to b. When set to true,
+// the parser will cache all results so each expression is evaluated only
+// once. This guarantees linear parsing time even for pathological cases,
+// at the expense of more memory and slower times for typical cases.
+// The rules annotated with @memo in the grammar are always memoized,
+// regardless of this option. The memoization table is pooled, so its
+// storage is reused by the following parses.
+//
+// The default is false.
+func Memoize(b bool) Option {
+	return func(p *parser) Option {
+		old := p.memoize
+		p.memoize = b
+		return Memoize(old)
+	}
+}
+
+// AllowInvalidUTF8 creates an Option to allow invalid UTF-8 bytes.
+// Every invalid UTF-8 byte is treated as a utf8.RuneError (U+FFFD)
+// by character class matchers and is matched by the any matcher.
+// The returned matched value, c.text and c.offset are NOT affected.
+//
+// The default is false.
+func AllowInvalidUTF8(b bool) Option {
+	return func(p *parser) Option {
+		old := p.allowInvalidUTF8
+		p.allowInvalidUTF8 = b
+		return AllowInvalidUTF8(old)
+	}
+}
+
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
+// Recover creates an Option to set the recover flag to b. When set to
+// true, this causes the parser to recover from panics and convert it
+// to an error. Setting it to false can be useful while debugging to
+// access the full stack trace.
+//
+// The default is true.
+func Recover(b bool) Option {
+	return func(p *parser) Option {
+		old := p.recover
+		p.recover = b
+		return Recover(old)
+	}
+}
+
+// GlobalStore creates an Option to set a key to a certain value in
+// the globalStore.
+func GlobalStore(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.globalStore[key]
+		p.cur.globalStore[key] = value
+		return GlobalStore(key, old)
+	}
+}
+
+// InitState creates an Option to set a key to a certain value in
+// the global "state" store.
+func InitState(key string, value any) Option {
+	return func(p *parser) Option {
+		old := p.cur.state[key]
+		p.cur.state[key] = value
+		return InitState(key, old)
+	}
+}
+
+// ParseFile parses the file identified by filename.
+func ParseFile(filename string, opts ...Option) (i any, err error) { // nolint: deadcode
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+	return ParseReader(filename, f, opts...)
+}
+
+// ParseReader parses the data from r using filename as information in the
+// error messages.
+func ParseReader(filename string, r io.Reader, opts ...Option) (any, error) { // nolint: deadcode
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(filename, b, opts...)
+}
+
+// Parse parses the data from b using filename as information in the
+// error messages.
+func Parse(filename string, b []byte, opts ...Option) (any, error) {
+	return newParser(filename, b, opts...).parse(g)
+}
+
+// position records a position in the text.
+type position struct {
+	line, col, offset int
+}
+
+func (p position) String() string {
+	return strconv.Itoa(p.line) + ":" + strconv.Itoa(p.col) + " [" + strconv.Itoa(p.offset) + "]"
+}
+
+// savepoint stores all state required to go back to this point in the
+// parser.
+type savepoint struct {
+	position
+	rn rune
+	w  int
+}
+
+type current struct {
+	pos  position // start position of the match
+	text []byte   // raw text of the match
+
+	// state is a store for arbitrary key,value pairs that the user wants to be
+	// tied to the backtracking of the parser.
+	// This is always rolled back if a parsing rule fails.
+	state storeDict
+
+	// failure is the labeled failure that the recovery expression being
+	// parsed recovers from, nil outside of the recovery expressions.
+	failure *failure
+
+	// globalStore is a general store for the user to store arbitrary key-value
+	// pairs that they need to manage and that they do not want tied to the
+	// backtracking of the parser. This is only modified by the user and never
+	// rolled back by the parser. It is always up to the user to keep this in a
+	// consistent state.
+	globalStore storeDict
+}
+
+type storeDict map[string]any
+
+// failure is a labeled failure thrown by a throw expression. It is an error
+// whose message is the message template of its label, which a recovery
+// code block may return to report it at its position.
+type failure struct {
+	label   string
+	message string
+	// pos is the position of the farthest failure at or after the throw
+	// expression, where the input did not match the expected values,
+	// otherwise the position of the throw expression.
+	pos      position
+	expected []string
+	// text is the text matched by the expression that the recovery
+	// expression protects, up to the throw expression.
+	text []byte
+}
+
+// Error returns the message of the failure.
+func (f *failure) Error() string {
+	return f.message
+}
+
+// the AST types...
+
+// nolint: structcheck
+type grammar struct {
+	pos   position
+	rules []*rule
+}
+
+// nolint: structcheck
+type rule struct {
+	pos         position
+	name        string
+	displayName string
+	run         func(*parser) (any, bool)
+
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+
+	leader        bool
+	leftRecursive bool
+}
+
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+//
+//	nolint: structcheck
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
+}
+
+// errList cumulates the errors found by the parser.
+type errList []error
+
+func (e *errList) add(err error) {
+	*e = append(*e, err)
+}
+
+func (e errList) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	e.dedupe()
+	return e
+}
+
+func (e *errList) dedupe() {
+	var cleaned []error
+	set := make(map[string]bool)
+	for _, err := range *e {
+		if msg := err.Error(); !set[msg] {
+			set[msg] = true
+			cleaned = append(cleaned, err)
+		}
+	}
+	*e = cleaned
+}
+
+func (e errList) Error() string {
+	switch len(e) {
+	case 0:
+		return ""
+	case 1:
+		return e[0].Error()
+	default:
+		var buf bytes.Buffer
+
+		for i, err := range e {
+			if i > 0 {
+				buf.WriteRune('\n')
+			}
+			buf.WriteString(err.Error())
+		}
+		return buf.String()
+	}
+}
+
+// parserError wraps an error with a prefix indicating the rule in which
+// the error occurred. The original error is stored in the Inner field.
+type parserError struct {
+	Inner    error
+	pos      position
+	prefix   string
+	expected []string
+}
+
+// Error returns the error message.
+func (p *parserError) Error() string {
+	return p.prefix + ": " + p.Inner.Error()
+}
+
+// newParser creates a parser with the specified input source and options.
+func newParser(filename string, b []byte, opts ...Option) *parser {
+	stats := Stats{
+		ChoiceAltCnt: make(map[string]map[string]int),
+	}
+
+	p := &parser{
+		filename: filename,
+		errs:     new(errList),
+		data:     b,
+		pt:       savepoint{position: position{line: 1}},
+		recover:  true,
+		cur: current{
+			state:       make(storeDict),
+			globalStore: make(storeDict),
+		},
+		maxFailPos:      position{col: 1, line: 1},
+		maxFailExpected: make([]string, 0, 20),
+		Stats:           &stats,
+		// start rule is rule [0] unless an alternate entrypoint is specified
+		entrypoint: g.rules[0].name,
+	}
+	p.setOptions(opts)
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
+
+	if p.maxExprCnt == 0 {
+		p.maxExprCnt = math.MaxUint64
+	}
+
+	return p
+}
+
+// setOptions applies the options to the parser.
+func (p *parser) setOptions(opts []Option) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// nolint: structcheck,deadcode
+type resultTuple struct {
+	v   any
+	b   bool
+	end savepoint
+}
+
+// nolint: varcheck
+const choiceNoMatch = -1
+
+// Stats stores some statistics, gathered during parsing
+type Stats struct {
+	// ExprCnt counts the number of expressions processed during parsing
+	// This value is compared to the maximum number of expressions allowed
+	// (set by the MaxExpressions option).
+	ExprCnt uint64
+
+	// ChoiceAltCnt is used to count for each ordered choice expression,
+	// which alternative is used how may times.
+	// These numbers allow to optimize the order of the ordered choice expression
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
+	//
+	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
+	// as the line and the column of the ordered choice.
+	// The inner key of ChoiceAltCnt is the number (one-based) of the matching alternative.
+	// For each alternative the number of matches are counted. If an ordered choice does not
+	// match, a special counter is incremented. The name of this counter is set with
+	// the parser option Statistics.
+	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
+	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
+}
+
+type ruleWithExpsStack struct {
+	rule   *rule
+	estack []any
+}
+
+// nolint: structcheck,maligned
+type parser struct {
+	filename string
+	pt       savepoint
+	cur      current
+
+	data []byte
+	errs *errList
+
+	depth   int
+	recover bool
+	debug   bool
+
+	memoize bool
+	// memoization table for the packrat algorithm, taken from memoTables
+	// on the first memoized result
+	memo *memoTable
+
+	// rules table, maps the rule identifier to the rule node
+	rules map[string]*rule
+	// variables stack, map of label to value
+	vstack []map[string]any
+	// rule stack, allows identification of the current rule in errors
+	rstack []*rule
+
+	// parse fail
+	maxFailPos            position
+	maxFailExpected       []string
+	maxFailInvertExpected bool
+
+	// max number of expressions to be parsed
+	maxExprCnt uint64
+	// entrypoint for the parser
+	entrypoint string
+
+	allowInvalidUTF8 bool
+
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+
+	*Stats
+	// profiling frames, tracks the time spent in invoked rules to compute
+	// the self time of each rule.
+	profStack []profFrame
+	// number of active invocations of each rule, the time of a recursive
+	// rule is only added at its outermost invocation.
+	profActive map[*rule]int
+	// rules evaluated at each offset, to count the repeated evaluations.
+	profEvals map[profEval]bool
+
+	choiceNoMatch string
+	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
+}
+
+// push a variable set on the vstack.
+func (p *parser) pushV() {
+	if cap(p.vstack) == len(p.vstack) {
+		// create new empty slot in the stack
+		p.vstack = append(p.vstack, nil)
+	} else {
+		// slice to 1 more
+		p.vstack = p.vstack[:len(p.vstack)+1]
+	}
+
+	// get the last args set
+	m := p.vstack[len(p.vstack)-1]
+	if m != nil && len(m) == 0 {
+		// empty map, all good
+		return
+	}
+
+	m = make(map[string]any)
+	p.vstack[len(p.vstack)-1] = m
+}
+
+// pop a variable set from the vstack.
+func (p *parser) popV() {
+	// if the map is not empty, clear it
+	m := p.vstack[len(p.vstack)-1]
+	if len(m) > 0 {
+		// GC that map
+		p.vstack[len(p.vstack)-1] = nil
+	}
+	p.vstack = p.vstack[:len(p.vstack)-1]
+}
+
+// push a recovery expression with its labels to the recoveryStack
+func (p *parser) pushRecovery(labels []string, expr any) {
+	if cap(p.recoveryStack) == len(p.recoveryStack) {
+		// create new empty slot in the stack
+		p.recoveryStack = append(p.recoveryStack, recovery{})
+	} else {
+		// slice to 1 more
+		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
+	}
+
+	m := make(map[string]any, len(labels))
+	for _, fl := range labels {
+		m[fl] = expr
+	}
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
+}
+
+// pop a recovery expression from the recoveryStack
+func (p *parser) popRecovery() {
+	// GC that map
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
+
+	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
+}
+
+// throw throws the failure of label, whose message template is message,
+// to the recovery expressions of the label from the innermost one until
+// one matches. Their code blocks get the failure as c.failure.
+func (p *parser) throw(label, message string) (any, bool) {
+	f := p.newFailure(label, message)
+	prev := p.cur.failure
+	for i := len(p.recoveryStack) - 1; i >= 0; i-- {
+		rec := p.recoveryStack[i]
+		recoverExpr, ok := rec.exprs[label]
+		if !ok {
+			continue
+		}
+		failure := *f
+		failure.text = p.data[rec.start:p.pt.offset]
+		p.cur.failure = &failure
+		val, ok := recoverExpr.(func(*parser) (any, bool))(p)
+		p.cur.failure = prev
+		if ok {
+			return val, ok
+		}
+	}
+	return nil, false
+}
+
+// newFailure returns the failure of label thrown at the current position,
+// see failure.pos. The placeholders {label}, {expected} and {found} of the
+// message template are replaced by the label, the expected values and the
+// input at the position of the failure.
+func (p *parser) newFailure(label, message string) *failure {
+	f := &failure{label: label, pos: p.pt.position}
+	if p.maxFailPos.offset >= p.pt.offset && len(p.maxFailExpected) > 0 {
+		f.pos = p.maxFailPos
+		f.expected = p.expectedList()
+	}
+
+	found := "EOF"
+	if f.pos.offset < len(p.data) {
+		rn, _ := utf8.DecodeRune(p.data[f.pos.offset:])
+		found = strconv.QuoteRune(rn)
+	}
+	f.message = strings.NewReplacer(
+		"{label}", label,
+		"{expected}", listJoin(f.expected, ", ", "or"),
+		"{found}", found,
+	).Replace(message)
+	return f
+}
+
+func (p *parser) print(prefix, s string) string {
+	if !p.debug {
+		return s
+	}
+
+	pos := p.pt.position
+	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
+		prefix, pos.line, pos.col, pos.offset, s, p.pt.rn)
+	return s
+}
+
+func (p *parser) printIndent(mark string, s string) string {
+	return p.print(strings.Repeat(" ", p.depth)+mark, s)
+}
+
+func (p *parser) in(s string) string {
+	res := p.printIndent(">", s)
+	p.depth++
+	return res
+}
+
+func (p *parser) out(s string) string {
+	p.depth--
+	return p.printIndent("<", s)
+}
+
+func (p *parser) addErr(err error) {
+	p.addErrAt(err, p.pt.position, []string{})
+}
+
+func (p *parser) addErrAt(err error, pos position, expected []string) {
+	var f *failure
+	if errors.As(err, &f) {
+		pos, expected = f.pos, f.expected
+	}
+	var buf bytes.Buffer
+	if p.filename != "" {
+		buf.WriteString(p.filename)
+	}
+	if buf.Len() > 0 {
+		buf.WriteString(":")
+	}
+	buf.WriteString(fmt.Sprintf("%d:%d (%d)", pos.line, pos.col, pos.offset))
+	if len(p.rstack) > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString(": ")
+		}
+		rule := p.rstack[len(p.rstack)-1]
+		if rule.displayName != "" {
+			buf.WriteString("rule " + rule.displayName)
+		} else {
+			buf.WriteString("rule " + rule.name)
+		}
+	}
+	pe := &parserError{Inner: err, pos: pos, prefix: buf.String(), expected: expected}
+	p.errs.add(pe)
+}
+
+func (p *parser) failAt(fail bool, pos position, want string) {
+	// process fail if parsing fails and not inverted or parsing succeeds and invert is set
+	if fail == p.maxFailInvertExpected {
+		if pos.offset < p.maxFailPos.offset {
+			return
+		}
+
+		if pos.offset > p.maxFailPos.offset {
+			p.maxFailPos = pos
+			p.maxFailExpected = p.maxFailExpected[:0]
+		}
+
+		if p.maxFailInvertExpected {
+			want = "!" + want
+		}
+		p.maxFailExpected = append(p.maxFailExpected, want)
+	}
+}
+
+// read advances the parser to the next rune.
+func (p *parser) read() {
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
+	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
+	p.pt.rn = rn
+	p.pt.w = n
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
+	}
+
+	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
+		if !p.allowInvalidUTF8 {
+			p.addErr(errInvalidEncoding)
+		}
+	}
+}
+
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
+// restore parser position to the savepoint pt.
+func (p *parser) restore(pt savepoint) {
+	if p.debug {
+		defer p.out(p.in("restore"))
+	}
+	if pt.offset == p.pt.offset {
+		return
+	}
+	if p.Rules != nil && pt.offset < p.pt.offset && len(p.rstack) > 0 {
+		p.ruleStats(p.rstack[len(p.rstack)-1]).Backtracked += uint64(p.pt.offset - pt.offset)
+	}
+	p.pt = pt
+}
+
+// Cloner is implemented by any value that has a Clone method, which returns a
+// copy of the value. This is mainly used for types which are not passed by
+// value (e.g map, slice, chan) or structs that contain such types.
+//
+// This is used in conjunction with the global state feature to create proper
+// copies of the state to allow the parser to properly restore the state in
+// the case of backtracking.
+type Cloner interface {
+	Clone() any
+}
+
+var statePool = &sync.Pool{
+	New: func() any { return make(storeDict) },
+}
+
+func (sd storeDict) Discard() {
+	for k := range sd {
+		delete(sd, k)
+	}
+	statePool.Put(sd)
+}
+
+// clone and return parser current state.
+func (p *parser) cloneState() storeDict {
+	if p.debug {
+		defer p.out(p.in("cloneState"))
+	}
+
+	state := statePool.Get().(storeDict)
+	for k, v := range p.cur.state {
+		if c, ok := v.(Cloner); ok {
+			state[k] = c.Clone()
+		} else {
+			state[k] = v
+		}
+	}
+	return state
+}
+
+// restore parser current state to the state storeDict.
+// every restoreState should applied only one time for every cloned state
+func (p *parser) restoreState(state storeDict) {
+	if p.debug {
+		defer p.out(p.in("restoreState"))
+	}
+	p.cur.state.Discard()
+	p.cur.state = state
+}
+
+// get the slice of bytes from the savepoint start to the current position.
+func (p *parser) sliceFrom(start savepoint) []byte {
+	return p.data[start.position.offset:p.pt.position.offset]
+}
+
+// memoSlot is an entry of the memoization table, the key of an empty slot
+// is 0.
+type memoSlot struct {
+	key uint64
+	res resultTuple
+}
+
+// memoTable is the memoization table for the packrat algorithm, an
+// open-addressed hash table with linear probing that maps the offset in
+// the source and the id of a rule or expression to its result.
+type memoTable struct {
+	slots []memoSlot
+	shift uint
+	n     int
+}
+
+// memoTables pools the memoization tables so that their storage is
+// reused across parses.
+var memoTables = &sync.Pool{
+	New: func() any {
+		return &memoTable{slots: make([]memoSlot, 1<<10), shift: 64 - 10}
+	},
+}
+
+// memoKey returns the key of the result of the rule or expression id at
+// offset, it supports up to 1<<24 ids.
+func memoKey(offset, id int) uint64 {
+	return uint64(offset+1)<<24 | uint64(id)
+}
+
+// index returns the index of the slot of key, or of the empty slot where
+// it should be stored.
+func (t *memoTable) index(key uint64) int {
+	mask := len(t.slots) - 1
+	i := int((key*0x9E3779B97F4A7C15)>>t.shift) & mask
+	for t.slots[i].key != 0 && t.slots[i].key != key {
+		i = (i + 1) & mask
+	}
+	return i
+}
+
+func (t *memoTable) get(key uint64) (resultTuple, bool) {
+	s := &t.slots[t.index(key)]
+	return s.res, s.key == key
+}
+
+func (t *memoTable) set(key uint64, res resultTuple) {
+	if 4*(t.n+1) > 3*len(t.slots) {
+		t.grow()
+	}
+	s := &t.slots[t.index(key)]
+	if s.key == 0 {
+		s.key = key
+		t.n++
+	}
+	s.res = res
+}
+
+// grow doubles the capacity of the table.
+func (t *memoTable) grow() {
+	old := t.slots
+	t.slots = make([]memoSlot, 2*len(old))
+	t.shift--
+	for _, s := range old {
+		if s.key != 0 {
+			t.slots[t.index(s.key)] = s
+		}
+	}
+}
+
+// reset empties the table, releasing the references to the results.
+func (t *memoTable) reset() {
+	if t.n == 0 {
+		return
+	}
+	for i := range t.slots {
+		t.slots[i] = memoSlot{}
+	}
+	t.n = 0
+}
+
+func (p *parser) getMemoized(id int) (resultTuple, bool) {
+	if p.memo == nil {
+		return resultTuple{}, false
+	}
+	return p.memo.get(memoKey(p.pt.offset, id))
+}
+
+func (p *parser) setMemoized(pt savepoint, id int, tuple resultTuple) {
+	if p.memo == nil {
+		p.memo = memoTables.Get().(*memoTable)
+	}
+	p.memo.set(memoKey(pt.offset, id), tuple)
+}
+
+// releaseMemo returns the memoization table to the pool.
+func (p *parser) releaseMemo() {
+	if p.memo == nil {
+		return
+	}
+	p.memo.reset()
+	memoTables.Put(p.memo)
+	p.memo = nil
+}
+
+func (p *parser) buildRulesTable(g *grammar) {
+	p.rules = make(map[string]*rule, len(g.rules))
+	for _, r := range g.rules {
+		p.rules[r.name] = r
+	}
+}
+
+// nolint: gocyclo
+func (p *parser) parse(g *grammar) (val any, err error) {
+	if len(g.rules) == 0 {
+		p.addErr(errNoRule)
+		return nil, p.errs.err()
+	}
+
+	// TODO : not super critical but this could be generated
+	p.buildRulesTable(g)
+	defer p.releaseMemo()
+
+	if p.recover {
+		// panic can be used in action code to stop parsing immediately
+		// and return the panic as an error.
+		defer func() {
+			if e := recover(); e != nil {
+				if p.debug {
+					defer p.out(p.in("panic handler"))
+				}
+				val = nil
+				switch e := e.(type) {
+				case error:
+					p.addErr(e)
+				default:
+					p.addErr(fmt.Errorf("%v", e))
+				}
+				err = p.errs.err()
+			}
+		}()
+	}
+
+	startRule, ok := p.rules[p.entrypoint]
+	if !ok {
+		p.addErr(errInvalidEntrypoint)
+		return nil, p.errs.err()
+	}
+
+	p.read() // advance to first rune
+	val, ok = p.parseRuleWrap(startRule)
+	if !ok {
+		if len(*p.errs) == 0 {
+			// If parsing fails, but no errors have been recorded, the expected values
+			// for the farthest parser position are returned as error.
+			p.addNoMatchErr()
+		}
+
+		return nil, p.errs.err()
+	}
+	return val, p.errs.err()
+}
+
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
+func listJoin(list []string, sep string, lastSep string) string {
+	switch len(list) {
+	case 0:
+		return ""
+	case 1:
+		return list[0]
+	default:
+		return strings.Join(list[:len(list)-1], sep) + " " + lastSep + " " + list[len(list)-1]
+	}
+}
+
+func (p *parser) parseRuleRecursiveLeader(rule *rule) (any, bool) {
+	result, ok := p.getMemoized(rule.id)
+	if ok {
+		p.restore(result.end)
+		return result.v, result.b
+	}
+
+	if p.debug {
+		defer p.out(p.in("recursive " + rule.name))
+	}
+
+	var (
+		depth      = 0
+		startMark  = p.pt
+		lastResult = resultTuple{end: startMark}
+		lastErrors = *p.errs
+	)
+
+	for {
+		lastState := p.cloneState()
+		p.setMemoized(startMark, rule.id, lastResult)
+		val, ok := p.parseRule(rule)
+		endMark := p.pt
+		if p.debug {
+			p.printIndent("RECURSIVE", fmt.Sprintf(
+				"Rule %s depth %d: %t -> %s",
+				rule.name, depth, ok, string(p.sliceFrom(startMark))))
+		}
+		if (!ok) || (endMark.offset <= lastResult.end.offset && depth != 0) {
+			p.restoreState(lastState)
+			*p.errs = lastErrors
+			break
+		}
+		lastResult = resultTuple{v: val, b: ok, end: endMark}
+		lastErrors = *p.errs
+		p.restore(startMark)
+		depth++
+	}
+
+	p.restore(lastResult.end)
+	p.setMemoized(startMark, rule.id, lastResult)
+	return lastResult.v, lastResult.b
+}
+
+func (p *parser) parseRuleRecursiveNoLeader(rule *rule) (any, bool) {
+	return p.parseRule(rule)
+}
+
+// profFrame is a rule invocation being profiled.
+type profFrame struct {
+	start time.Time
+	child time.Duration
+}
+
+// profEval is the evaluation of a rule at an offset.
+type profEval struct {
+	rule   *rule
+	offset int
+}
+
+// ruleStats returns the statistics of rule, creating them if needed.
+func (p *parser) ruleStats(rule *rule) *RuleStats {
+	rs := p.Rules[rule.name]
+	if rs == nil {
+		rs = &RuleStats{}
+		p.Rules[rule.name] = rs
+	}
+	return rs
+}
+
+func (p *parser) countMemo(rs *RuleStats, hit bool) {
+	if hit {
+		rs.MemoHits++
+	} else {
+		rs.MemoMisses++
+	}
+}
+
+func (p *parser) profEnter(r *rule) {
+	if p.profActive == nil {
+		p.profActive = make(map[*rule]int)
+	}
+	p.profActive[r]++
+	p.profStack = append(p.profStack, profFrame{start: time.Now()})
+}
+
+// countRepeat counts the evaluation of rule at the current offset if
+// it was already evaluated at that offset.
+func (p *parser) countRepeat(rule *rule) {
+	if p.profEvals == nil {
+		p.profEvals = make(map[profEval]bool)
+	}
+	key := profEval{rule: rule, offset: p.pt.offset}
+	if p.profEvals[key] {
+		p.ruleStats(rule).Repeats++
+		return
+	}
+	p.profEvals[key] = true
+}
+
+func (p *parser) profExit(rule *rule, start savepoint, ok bool) {
+	frame := p.profStack[len(p.profStack)-1]
+	p.profStack = p.profStack[:len(p.profStack)-1]
+	elapsed := time.Since(frame.start)
+	if n := len(p.profStack); n > 0 {
+		p.profStack[n-1].child += elapsed
+	}
+
+	rs := p.ruleStats(rule)
+	rs.Invocations++
+	if p.profActive[rule]--; p.profActive[rule] == 0 {
+		// the time of the inner invocations is included
+		rs.Time += elapsed
+	}
+	rs.SelfTime += elapsed - frame.child
+	if ok {
+		rs.Matches++
+		rs.Bytes += uint64(p.pt.offset - start.offset)
+	} else {
+		rs.Failures++
+	}
+}
+
+func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
+	if rule.id < 0 {
+		// its results depend on the captured texts or the state store
+		return p.parseRule(rule)
+	}
+	res, ok := p.getMemoized(rule.id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(rule), ok)
+	}
+	if ok {
+		p.restore(res.end)
+		return res.v, res.b
+	}
+
+	startMark := p.pt
+	val, ok := p.parseRule(rule)
+	p.setMemoized(startMark, rule.id, resultTuple{v: val, b: ok, end: p.pt})
+
+	return val, ok
+}
+
+func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRule " + rule.name))
+	}
+	var (
+		val       any
+		ok        bool
+		startMark = p.pt
+	)
+
+	if p.Rules != nil {
+		p.profEnter(rule)
+		defer func() { p.profExit(rule, startMark, ok) }()
+	}
+
+	memoize := p.memoize
+	if memoize || rule.leftRecursive {
+		if rule.leader {
+			val, ok = p.parseRuleRecursiveLeader(rule)
+		} else if memoize && !rule.leftRecursive {
+			val, ok = p.parseRuleMemoize(rule)
+		} else {
+			val, ok = p.parseRuleRecursiveNoLeader(rule)
+		}
+	} else {
+		val, ok = p.parseRule(rule)
+	}
+
+	if ok && p.debug {
+		p.printIndent("MATCH", string(p.sliceFrom(startMark)))
+	}
+	return val, ok
+}
+
+func (p *parser) parseRule(rule *rule) (any, bool) {
+	if p.Rules != nil {
+		p.countRepeat(rule)
+	}
+	p.rstack = append(p.rstack, rule)
+	p.pushV()
+	val, ok := rule.run(p)
+	p.popV()
+	p.rstack = p.rstack[:len(p.rstack)-1]
+	return val, ok
+}
+
+// incChoiceAltCnt counts the match of the alternative altI of the choice
+// at pos, or its failure if altI is choiceNoMatch.
+func (p *parser) incChoiceAltCnt(pos position, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, pos.line, pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
+// countExpr counts the evaluation of an expression.
+func (p *parser) countExpr() {
+	p.ExprCnt++
+	if p.ExprCnt > p.maxExprCnt {
+		panic(errMaxExprCnt)
+	}
+}
+
+// compiledMemoized returns the memoized results of the compiled expression
+// id at the current position, and restores the position at their end. It
+// returns false if they are not memoized or if memoization is disabled.
+func (p *parser) compiledMemoized(id int) (resultTuple, bool) {
+	if !p.memoize || p.rstack[len(p.rstack)-1].leftRecursive {
+		return resultTuple{}, false
+	}
+	res, ok := p.getMemoized(id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+	}
+	if ok {
+		p.restore(res.end)
+	}
+	return res, ok
+}
+
+// compiledMemoize memoizes the results of the compiled expression id,
+// which started at pt, if memoization is enabled.
+func (p *parser) compiledMemoize(pt savepoint, id int, val any, ok bool) {
+	if p.memoize && !p.rstack[len(p.rstack)-1].leftRecursive {
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
+	}
+}
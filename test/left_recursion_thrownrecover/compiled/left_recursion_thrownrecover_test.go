@@ -0,0 +1 @@
+../left_recursion_thrownrecover_test.go
\ No newline at end of file
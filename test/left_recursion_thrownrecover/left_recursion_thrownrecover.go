@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 	"unicode"
 	"unicode/utf8"
 )
@@ -22,8 +23,10 @@ var g = &grammar{
 		{
 			name: "Start",
 			pos:  position{line: 6, col: 1, offset: 41},
+			id:   0,
 			expr: &andCodeExpr{
 				pos: position{line: 6, col: 9, offset: 49},
+				id:  24,
 				run: (*parser).callonStart1,
 			},
 			leader:        false,
@@ -32,14 +35,18 @@ var g = &grammar{
 		{
 			name: "case01",
 			pos:  position{line: 11, col: 1, offset: 108},
+			id:   1,
 			expr: &actionExpr{
 				pos: position{line: 11, col: 10, offset: 117},
+				id:  25,
 				run: (*parser).calloncase011,
 				expr: &labeledExpr{
 					pos:   position{line: 11, col: 10, offset: 117},
+					id:    26,
 					label: "case01",
 					expr: &ruleRefExpr{
 						pos:  position{line: 11, col: 17, offset: 124},
+						id:   27,
 						name: "MultiLabelRecover",
 					},
 				},
@@ -50,14 +57,18 @@ var g = &grammar{
 		{
 			name: "MultiLabelRecover",
 			pos:  position{line: 13, col: 1, offset: 166},
+			id:   2,
 			expr: &recoveryExpr{
 				pos: position{line: 13, col: 21, offset: 186},
+				id:  28,
 				expr: &ruleRefExpr{
 					pos:  position{line: 13, col: 21, offset: 186},
+					id:   29,
 					name: "number",
 				},
 				recoverExpr: &ruleRefExpr{
 					pos:  position{line: 13, col: 51, offset: 216},
+					id:   30,
 					name: "ErrNonNumber",
 				},
 				failureLabel: []string{
@@ -71,34 +82,44 @@ var g = &grammar{
 		{
 			name: "number",
 			pos:  position{line: 15, col: 1, offset: 230},
+			id:   3,
 			expr: &choiceExpr{
 				pos: position{line: 15, col: 10, offset: 239},
+				id:  31,
 				alternatives: []any{
 					&notExpr{
 						pos: position{line: 15, col: 10, offset: 239},
+						id:  32,
 						expr: &anyMatcher{
-							line: 15, col: 11, offset: 240,
+							pos: position{line: 15, col: 11, offset: 240},
+							id:  33,
 						},
 					},
 					&actionExpr{
 						pos: position{line: 15, col: 15, offset: 244},
+						id:  34,
 						run: (*parser).callonnumber4,
 						expr: &seqExpr{
 							pos: position{line: 15, col: 16, offset: 245},
+							id:  35,
 							exprs: []any{
 								&labeledExpr{
 									pos:   position{line: 15, col: 16, offset: 245},
+									id:    36,
 									label: "n",
 									expr: &ruleRefExpr{
 										pos:  position{line: 15, col: 18, offset: 247},
+										id:   37,
 										name: "number",
 									},
 								},
 								&labeledExpr{
 									pos:   position{line: 15, col: 25, offset: 254},
+									id:    38,
 									label: "d",
 									expr: &ruleRefExpr{
 										pos:  position{line: 15, col: 27, offset: 256},
+										id:   39,
 										name: "digit",
 									},
 								},
@@ -107,12 +128,15 @@ var g = &grammar{
 					},
 					&actionExpr{
 						pos: position{line: 17, col: 5, offset: 309},
+						id:  40,
 						run: (*parser).callonnumber10,
 						expr: &labeledExpr{
 							pos:   position{line: 17, col: 5, offset: 309},
+							id:    41,
 							label: "d",
 							expr: &ruleRefExpr{
 								pos:  position{line: 17, col: 7, offset: 311},
+								id:   42,
 								name: "digit",
 							},
 						},
@@ -125,14 +149,18 @@ var g = &grammar{
 		{
 			name: "digit",
 			pos:  position{line: 21, col: 1, offset: 349},
+			id:   4,
 			expr: &choiceExpr{
 				pos: position{line: 21, col: 9, offset: 357},
+				id:  43,
 				alternatives: []any{
 					&actionExpr{
 						pos: position{line: 21, col: 9, offset: 357},
+						id:  44,
 						run: (*parser).callondigit2,
 						expr: &charClassMatcher{
 							pos:        position{line: 21, col: 9, offset: 357},
+							id:         45,
 							val:        "[0-9]",
 							ranges:     []rune{'0', '9'},
 							ignoreCase: false,
@@ -141,17 +169,22 @@ var g = &grammar{
 					},
 					&actionExpr{
 						pos: position{line: 23, col: 5, offset: 400},
+						id:  46,
 						run: (*parser).callondigit4,
 						expr: &labeledExpr{
 							pos:   position{line: 23, col: 5, offset: 400},
+							id:    47,
 							label: "x",
 							expr: &seqExpr{
 								pos: position{line: 23, col: 9, offset: 404},
+								id:  48,
 								exprs: []any{
 									&andExpr{
 										pos: position{line: 23, col: 9, offset: 404},
+										id:  49,
 										expr: &charClassMatcher{
 											pos:        position{line: 23, col: 10, offset: 405},
+											id:         50,
 											val:        "[a-z]",
 											ranges:     []rune{'a', 'z'},
 											ignoreCase: false,
@@ -159,18 +192,29 @@ var g = &grammar{
 										},
 									},
 									&throwExpr{
-										pos:   position{line: 23, col: 16, offset: 411},
-										label: "errAlpha",
+										pos:     position{line: 23, col: 16, offset: 411},
+										id:      51,
+										label:   "errAlpha",
+										message: "errAlpha",
 									},
 								},
 							},
 						},
 					},
 					&throwExpr{
-						pos:   position{line: 25, col: 5, offset: 460},
-						label: "errOther",
+						pos:     position{line: 25, col: 5, offset: 460},
+						id:      52,
+						label:   "errOther",
+						message: "errOther",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{1, 2}, {0, 1, 2}},
+					expected:  [][]string{{"[0-9]"}, {}},
+				},
 			},
 			leader:        false,
 			leftRecursive: false,
@@ -178,25 +222,33 @@ var g = &grammar{
 		{
 			name: "ErrNonNumber",
 			pos:  position{line: 27, col: 1, offset: 473},
+			id:   5,
 			expr: &actionExpr{
 				pos: position{line: 27, col: 16, offset: 488},
+				id:  53,
 				run: (*parser).callonErrNonNumber1,
 				expr: &seqExpr{
 					pos: position{line: 27, col: 16, offset: 488},
+					id:  54,
 					exprs: []any{
 						&andCodeExpr{
 							pos: position{line: 27, col: 16, offset: 488},
+							id:  55,
 							run: (*parser).callonErrNonNumber3,
 						},
 						&zeroOrMoreExpr{
 							pos: position{line: 29, col: 3, offset: 543},
+							id:  56,
 							expr: &seqExpr{
 								pos: position{line: 29, col: 5, offset: 545},
+								id:  57,
 								exprs: []any{
 									&notExpr{
 										pos: position{line: 29, col: 5, offset: 545},
+										id:  58,
 										expr: &charClassMatcher{
 											pos:        position{line: 29, col: 6, offset: 546},
+											id:         59,
 											val:        "[0-9]",
 											ranges:     []rune{'0', '9'},
 											ignoreCase: false,
@@ -204,7 +256,8 @@ var g = &grammar{
 										},
 									},
 									&anyMatcher{
-										line: 29, col: 12, offset: 552,
+										pos: position{line: 29, col: 12, offset: 552},
+										id:  60,
 									},
 								},
 							},
@@ -218,15 +271,19 @@ var g = &grammar{
 		{
 			name: "case02",
 			pos:  position{line: 34, col: 1, offset: 614},
+			id:   6,
 			expr: &choiceExpr{
 				pos: position{line: 34, col: 11, offset: 624},
+				id:  61,
 				alternatives: []any{
 					&ruleRefExpr{
 						pos:  position{line: 34, col: 11, offset: 624},
+						id:   62,
 						name: "ThrowUndefLabel",
 					},
 					&andCodeExpr{
 						pos: position{line: 34, col: 29, offset: 642},
+						id:  63,
 						run: (*parser).calloncase023,
 					},
 				},
@@ -237,16 +294,21 @@ var g = &grammar{
 		{
 			name: "ThrowUndefLabel",
 			pos:  position{line: 36, col: 1, offset: 699},
+			id:   7,
 			expr: &seqExpr{
 				pos: position{line: 36, col: 19, offset: 717},
+				id:  64,
 				exprs: []any{
 					&ruleRefExpr{
 						pos:  position{line: 36, col: 19, offset: 717},
+						id:   65,
 						name: "ThrowUndefLabel",
 					},
 					&throwExpr{
-						pos:   position{line: 36, col: 35, offset: 733},
-						label: "undeflabel",
+						pos:     position{line: 36, col: 35, offset: 733},
+						id:      66,
+						label:   "undeflabel",
+						message: "undeflabel",
 					},
 				},
 			},
@@ -256,14 +318,18 @@ var g = &grammar{
 		{
 			name: "case03",
 			pos:  position{line: 41, col: 1, offset: 777},
+			id:   8,
 			expr: &actionExpr{
 				pos: position{line: 41, col: 10, offset: 786},
+				id:  67,
 				run: (*parser).calloncase031,
 				expr: &labeledExpr{
 					pos:   position{line: 41, col: 10, offset: 786},
+					id:    68,
 					label: "case03",
 					expr: &ruleRefExpr{
 						pos:  position{line: 41, col: 17, offset: 793},
+						id:   69,
 						name: "OuterRecover03",
 					},
 				},
@@ -274,16 +340,21 @@ var g = &grammar{
 		{
 			name: "OuterRecover03",
 			pos:  position{line: 43, col: 1, offset: 832},
+			id:   9,
 			expr: &recoveryExpr{
 				pos: position{line: 43, col: 18, offset: 849},
+				id:  70,
 				expr: &recoveryExpr{
 					pos: position{line: 43, col: 18, offset: 849},
+					id:  71,
 					expr: &ruleRefExpr{
 						pos:  position{line: 43, col: 18, offset: 849},
+						id:   72,
 						name: "InnerRecover03",
 					},
 					recoverExpr: &ruleRefExpr{
 						pos:  position{line: 43, col: 66, offset: 897},
+						id:   73,
 						name: "ErrAlphaOuter03",
 					},
 					failureLabel: []string{
@@ -293,6 +364,7 @@ var g = &grammar{
 				},
 				recoverExpr: &ruleRefExpr{
 					pos:  position{line: 43, col: 95, offset: 926},
+					id:   74,
 					name: "ErrOtherOuter03",
 				},
 				failureLabel: []string{
@@ -305,14 +377,18 @@ var g = &grammar{
 		{
 			name: "InnerRecover03",
 			pos:  position{line: 45, col: 1, offset: 943},
+			id:   10,
 			expr: &recoveryExpr{
 				pos: position{line: 45, col: 18, offset: 960},
+				id:  75,
 				expr: &ruleRefExpr{
 					pos:  position{line: 45, col: 18, offset: 960},
+					id:   76,
 					name: "number03",
 				},
 				recoverExpr: &ruleRefExpr{
 					pos:  position{line: 45, col: 45, offset: 987},
+					id:   77,
 					name: "ErrAlphaInner03",
 				},
 				failureLabel: []string{
@@ -325,34 +401,44 @@ var g = &grammar{
 		{
 			name: "number03",
 			pos:  position{line: 47, col: 1, offset: 1004},
+			id:   11,
 			expr: &choiceExpr{
 				pos: position{line: 47, col: 12, offset: 1015},
+				id:  78,
 				alternatives: []any{
 					&notExpr{
 						pos: position{line: 47, col: 12, offset: 1015},
+						id:  79,
 						expr: &anyMatcher{
-							line: 47, col: 13, offset: 1016,
+							pos: position{line: 47, col: 13, offset: 1016},
+							id:  80,
 						},
 					},
 					&actionExpr{
 						pos: position{line: 47, col: 17, offset: 1020},
+						id:  81,
 						run: (*parser).callonnumber034,
 						expr: &seqExpr{
 							pos: position{line: 47, col: 18, offset: 1021},
+							id:  82,
 							exprs: []any{
 								&labeledExpr{
 									pos:   position{line: 47, col: 18, offset: 1021},
+									id:    83,
 									label: "n",
 									expr: &ruleRefExpr{
 										pos:  position{line: 47, col: 20, offset: 1023},
+										id:   84,
 										name: "number03",
 									},
 								},
 								&labeledExpr{
 									pos:   position{line: 47, col: 29, offset: 1032},
+									id:    85,
 									label: "d",
 									expr: &ruleRefExpr{
 										pos:  position{line: 47, col: 31, offset: 1034},
+										id:   86,
 										name: "digit03",
 									},
 								},
@@ -361,12 +447,15 @@ var g = &grammar{
 					},
 					&actionExpr{
 						pos: position{line: 49, col: 5, offset: 1089},
+						id:  87,
 						run: (*parser).callonnumber0310,
 						expr: &labeledExpr{
 							pos:   position{line: 49, col: 5, offset: 1089},
+							id:    88,
 							label: "d",
 							expr: &ruleRefExpr{
 								pos:  position{line: 49, col: 7, offset: 1091},
+								id:   89,
 								name: "digit03",
 							},
 						},
@@ -379,14 +468,18 @@ var g = &grammar{
 		{
 			name: "digit03",
 			pos:  position{line: 53, col: 1, offset: 1131},
+			id:   12,
 			expr: &choiceExpr{
 				pos: position{line: 53, col: 11, offset: 1141},
+				id:  90,
 				alternatives: []any{
 					&actionExpr{
 						pos: position{line: 53, col: 11, offset: 1141},
+						id:  91,
 						run: (*parser).callondigit032,
 						expr: &charClassMatcher{
 							pos:        position{line: 53, col: 11, offset: 1141},
+							id:         92,
 							val:        "[0-9]",
 							ranges:     []rune{'0', '9'},
 							ignoreCase: false,
@@ -395,17 +488,22 @@ var g = &grammar{
 					},
 					&actionExpr{
 						pos: position{line: 55, col: 5, offset: 1184},
+						id:  93,
 						run: (*parser).callondigit034,
 						expr: &labeledExpr{
 							pos:   position{line: 55, col: 5, offset: 1184},
+							id:    94,
 							label: "x",
 							expr: &seqExpr{
 								pos: position{line: 55, col: 9, offset: 1188},
+								id:  95,
 								exprs: []any{
 									&andExpr{
 										pos: position{line: 55, col: 9, offset: 1188},
+										id:  96,
 										expr: &charClassMatcher{
 											pos:        position{line: 55, col: 10, offset: 1189},
+											id:         97,
 											val:        "[a-z]",
 											ranges:     []rune{'a', 'z'},
 											ignoreCase: false,
@@ -413,8 +511,10 @@ var g = &grammar{
 										},
 									},
 									&throwExpr{
-										pos:   position{line: 55, col: 16, offset: 1195},
-										label: "errAlphaLower",
+										pos:     position{line: 55, col: 16, offset: 1195},
+										id:      98,
+										label:   "errAlphaLower",
+										message: "errAlphaLower",
 									},
 								},
 							},
@@ -422,17 +522,22 @@ var g = &grammar{
 					},
 					&actionExpr{
 						pos: position{line: 57, col: 5, offset: 1249},
+						id:  99,
 						run: (*parser).callondigit0310,
 						expr: &labeledExpr{
 							pos:   position{line: 57, col: 5, offset: 1249},
+							id:    100,
 							label: "x",
 							expr: &seqExpr{
 								pos: position{line: 57, col: 9, offset: 1253},
+								id:  101,
 								exprs: []any{
 									&andExpr{
 										pos: position{line: 57, col: 9, offset: 1253},
+										id:  102,
 										expr: &charClassMatcher{
 											pos:        position{line: 57, col: 10, offset: 1254},
+											id:         103,
 											val:        "[A-Z]",
 											ranges:     []rune{'A', 'Z'},
 											ignoreCase: false,
@@ -440,18 +545,29 @@ var g = &grammar{
 										},
 									},
 									&throwExpr{
-										pos:   position{line: 57, col: 16, offset: 1260},
-										label: "errAlphaUpper",
+										pos:     position{line: 57, col: 16, offset: 1260},
+										id:      104,
+										label:   "errAlphaUpper",
+										message: "errAlphaUpper",
 									},
 								},
 							},
 						},
 					},
 					&throwExpr{
-						pos:   position{line: 59, col: 5, offset: 1314},
-						label: "errOther",
+						pos:     position{line: 59, col: 5, offset: 1314},
+						id:      105,
+						label:   "errOther",
+						message: "errOther",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{1, 2, 3}, {0, 1, 2, 3}},
+					expected:  [][]string{{"[0-9]"}, {}},
+				},
 			},
 			leader:        false,
 			leftRecursive: false,
@@ -459,25 +575,33 @@ var g = &grammar{
 		{
 			name: "ErrAlphaInner03",
 			pos:  position{line: 61, col: 1, offset: 1327},
+			id:   13,
 			expr: &actionExpr{
 				pos: position{line: 61, col: 19, offset: 1345},
+				id:  106,
 				run: (*parser).callonErrAlphaInner031,
 				expr: &seqExpr{
 					pos: position{line: 61, col: 19, offset: 1345},
+					id:  107,
 					exprs: []any{
 						&andCodeExpr{
 							pos: position{line: 61, col: 19, offset: 1345},
+							id:  108,
 							run: (*parser).callonErrAlphaInner033,
 						},
 						&zeroOrMoreExpr{
 							pos: position{line: 63, col: 3, offset: 1421},
+							id:  109,
 							expr: &seqExpr{
 								pos: position{line: 63, col: 5, offset: 1423},
+								id:  110,
 								exprs: []any{
 									&notExpr{
 										pos: position{line: 63, col: 5, offset: 1423},
+										id:  111,
 										expr: &charClassMatcher{
 											pos:        position{line: 63, col: 6, offset: 1424},
+											id:         112,
 											val:        "[0-9]",
 											ranges:     []rune{'0', '9'},
 											ignoreCase: false,
@@ -485,7 +609,8 @@ var g = &grammar{
 										},
 									},
 									&anyMatcher{
-										line: 63, col: 12, offset: 1430,
+										pos: position{line: 63, col: 12, offset: 1430},
+										id:  113,
 									},
 								},
 							},
@@ -499,25 +624,33 @@ var g = &grammar{
 		{
 			name: "ErrAlphaOuter03",
 			pos:  position{line: 65, col: 1, offset: 1456},
+			id:   14,
 			expr: &actionExpr{
 				pos: position{line: 65, col: 19, offset: 1474},
+				id:  114,
 				run: (*parser).callonErrAlphaOuter031,
 				expr: &seqExpr{
 					pos: position{line: 65, col: 19, offset: 1474},
+					id:  115,
 					exprs: []any{
 						&andCodeExpr{
 							pos: position{line: 65, col: 19, offset: 1474},
+							id:  116,
 							run: (*parser).callonErrAlphaOuter033,
 						},
 						&zeroOrMoreExpr{
 							pos: position{line: 67, col: 3, offset: 1550},
+							id:  117,
 							expr: &seqExpr{
 								pos: position{line: 67, col: 5, offset: 1552},
+								id:  118,
 								exprs: []any{
 									&notExpr{
 										pos: position{line: 67, col: 5, offset: 1552},
+										id:  119,
 										expr: &charClassMatcher{
 											pos:        position{line: 67, col: 6, offset: 1553},
+											id:         120,
 											val:        "[0-9]",
 											ranges:     []rune{'0', '9'},
 											ignoreCase: false,
@@ -525,7 +658,8 @@ var g = &grammar{
 										},
 									},
 									&anyMatcher{
-										line: 67, col: 12, offset: 1559,
+										pos: position{line: 67, col: 12, offset: 1559},
+										id:  121,
 									},
 								},
 							},
@@ -539,25 +673,33 @@ var g = &grammar{
 		{
 			name: "ErrOtherOuter03",
 			pos:  position{line: 69, col: 1, offset: 1585},
+			id:   15,
 			expr: &actionExpr{
 				pos: position{line: 69, col: 19, offset: 1603},
+				id:  122,
 				run: (*parser).callonErrOtherOuter031,
 				expr: &seqExpr{
 					pos: position{line: 69, col: 19, offset: 1603},
+					id:  123,
 					exprs: []any{
 						&andCodeExpr{
 							pos: position{line: 69, col: 19, offset: 1603},
+							id:  124,
 							run: (*parser).callonErrOtherOuter033,
 						},
 						&zeroOrMoreExpr{
 							pos: position{line: 71, col: 3, offset: 1674},
+							id:  125,
 							expr: &seqExpr{
 								pos: position{line: 71, col: 5, offset: 1676},
+								id:  126,
 								exprs: []any{
 									&notExpr{
 										pos: position{line: 71, col: 5, offset: 1676},
+										id:  127,
 										expr: &charClassMatcher{
 											pos:        position{line: 71, col: 6, offset: 1677},
+											id:         128,
 											val:        "[0-9]",
 											ranges:     []rune{'0', '9'},
 											ignoreCase: false,
@@ -565,7 +707,8 @@ var g = &grammar{
 										},
 									},
 									&anyMatcher{
-										line: 71, col: 12, offset: 1683,
+										pos: position{line: 71, col: 12, offset: 1683},
+										id:  129,
 									},
 								},
 							},
@@ -579,14 +722,18 @@ var g = &grammar{
 		{
 			name: "case04",
 			pos:  position{line: 76, col: 1, offset: 1768},
+			id:   16,
 			expr: &actionExpr{
 				pos: position{line: 76, col: 10, offset: 1777},
+				id:  130,
 				run: (*parser).calloncase041,
 				expr: &labeledExpr{
 					pos:   position{line: 76, col: 10, offset: 1777},
+					id:    131,
 					label: "case04",
 					expr: &ruleRefExpr{
 						pos:  position{line: 76, col: 17, offset: 1784},
+						id:   132,
 						name: "OuterRecover04",
 					},
 				},
@@ -597,16 +744,21 @@ var g = &grammar{
 		{
 			name: "OuterRecover04",
 			pos:  position{line: 78, col: 1, offset: 1823},
+			id:   17,
 			expr: &recoveryExpr{
 				pos: position{line: 78, col: 18, offset: 1840},
+				id:  133,
 				expr: &recoveryExpr{
 					pos: position{line: 78, col: 18, offset: 1840},
+					id:  134,
 					expr: &ruleRefExpr{
 						pos:  position{line: 78, col: 18, offset: 1840},
+						id:   135,
 						name: "InnerRecover04",
 					},
 					recoverExpr: &ruleRefExpr{
 						pos:  position{line: 78, col: 66, offset: 1888},
+						id:   136,
 						name: "ErrAlphaOuter04",
 					},
 					failureLabel: []string{
@@ -616,6 +768,7 @@ var g = &grammar{
 				},
 				recoverExpr: &ruleRefExpr{
 					pos:  position{line: 78, col: 95, offset: 1917},
+					id:   137,
 					name: "ErrOtherOuter04",
 				},
 				failureLabel: []string{
@@ -628,14 +781,18 @@ var g = &grammar{
 		{
 			name: "InnerRecover04",
 			pos:  position{line: 80, col: 1, offset: 1934},
+			id:   18,
 			expr: &recoveryExpr{
 				pos: position{line: 80, col: 18, offset: 1951},
+				id:  138,
 				expr: &ruleRefExpr{
 					pos:  position{line: 80, col: 18, offset: 1951},
+					id:   139,
 					name: "number04",
 				},
 				recoverExpr: &ruleRefExpr{
 					pos:  position{line: 80, col: 45, offset: 1978},
+					id:   140,
 					name: "ErrAlphaInner04",
 				},
 				failureLabel: []string{
@@ -648,34 +805,44 @@ var g = &grammar{
 		{
 			name: "number04",
 			pos:  position{line: 82, col: 1, offset: 1995},
+			id:   19,
 			expr: &choiceExpr{
 				pos: position{line: 82, col: 12, offset: 2006},
+				id:  141,
 				alternatives: []any{
 					&notExpr{
 						pos: position{line: 82, col: 12, offset: 2006},
+						id:  142,
 						expr: &anyMatcher{
-							line: 82, col: 13, offset: 2007,
+							pos: position{line: 82, col: 13, offset: 2007},
+							id:  143,
 						},
 					},
 					&actionExpr{
 						pos: position{line: 82, col: 17, offset: 2011},
+						id:  144,
 						run: (*parser).callonnumber044,
 						expr: &seqExpr{
 							pos: position{line: 82, col: 18, offset: 2012},
+							id:  145,
 							exprs: []any{
 								&labeledExpr{
 									pos:   position{line: 82, col: 18, offset: 2012},
+									id:    146,
 									label: "n",
 									expr: &ruleRefExpr{
 										pos:  position{line: 82, col: 20, offset: 2014},
+										id:   147,
 										name: "number04",
 									},
 								},
 								&labeledExpr{
 									pos:   position{line: 82, col: 29, offset: 2023},
+									id:    148,
 									label: "d",
 									expr: &ruleRefExpr{
 										pos:  position{line: 82, col: 31, offset: 2025},
+										id:   149,
 										name: "digit04",
 									},
 								},
@@ -684,12 +851,15 @@ var g = &grammar{
 					},
 					&actionExpr{
 						pos: position{line: 84, col: 5, offset: 2080},
+						id:  150,
 						run: (*parser).callonnumber0410,
 						expr: &labeledExpr{
 							pos:   position{line: 84, col: 5, offset: 2080},
+							id:    151,
 							label: "d",
 							expr: &ruleRefExpr{
 								pos:  position{line: 84, col: 7, offset: 2082},
+								id:   152,
 								name: "digit04",
 							},
 						},
@@ -702,14 +872,18 @@ var g = &grammar{
 		{
 			name: "digit04",
 			pos:  position{line: 88, col: 1, offset: 2122},
+			id:   20,
 			expr: &choiceExpr{
 				pos: position{line: 88, col: 11, offset: 2132},
+				id:  153,
 				alternatives: []any{
 					&actionExpr{
 						pos: position{line: 88, col: 11, offset: 2132},
+						id:  154,
 						run: (*parser).callondigit042,
 						expr: &charClassMatcher{
 							pos:        position{line: 88, col: 11, offset: 2132},
+							id:         155,
 							val:        "[0-9]",
 							ranges:     []rune{'0', '9'},
 							ignoreCase: false,
@@ -718,17 +892,22 @@ var g = &grammar{
 					},
 					&actionExpr{
 						pos: position{line: 90, col: 5, offset: 2175},
+						id:  156,
 						run: (*parser).callondigit044,
 						expr: &labeledExpr{
 							pos:   position{line: 90, col: 5, offset: 2175},
+							id:    157,
 							label: "x",
 							expr: &seqExpr{
 								pos: position{line: 90, col: 9, offset: 2179},
+								id:  158,
 								exprs: []any{
 									&andExpr{
 										pos: position{line: 90, col: 9, offset: 2179},
+										id:  159,
 										expr: &charClassMatcher{
 											pos:        position{line: 90, col: 10, offset: 2180},
+											id:         160,
 											val:        "[a-z]",
 											ranges:     []rune{'a', 'z'},
 											ignoreCase: false,
@@ -736,8 +915,10 @@ var g = &grammar{
 										},
 									},
 									&throwExpr{
-										pos:   position{line: 90, col: 16, offset: 2186},
-										label: "errAlphaLower",
+										pos:     position{line: 90, col: 16, offset: 2186},
+										id:      161,
+										label:   "errAlphaLower",
+										message: "errAlphaLower",
 									},
 								},
 							},
@@ -745,17 +926,22 @@ var g = &grammar{
 					},
 					&actionExpr{
 						pos: position{line: 92, col: 5, offset: 2240},
+						id:  162,
 						run: (*parser).callondigit0410,
 						expr: &labeledExpr{
 							pos:   position{line: 92, col: 5, offset: 2240},
+							id:    163,
 							label: "x",
 							expr: &seqExpr{
 								pos: position{line: 92, col: 9, offset: 2244},
+								id:  164,
 								exprs: []any{
 									&andExpr{
 										pos: position{line: 92, col: 9, offset: 2244},
+										id:  165,
 										expr: &charClassMatcher{
 											pos:        position{line: 92, col: 10, offset: 2245},
+											id:         166,
 											val:        "[A-Z]",
 											ranges:     []rune{'A', 'Z'},
 											ignoreCase: false,
@@ -763,18 +949,29 @@ var g = &grammar{
 										},
 									},
 									&throwExpr{
-										pos:   position{line: 92, col: 16, offset: 2251},
-										label: "errAlphaUpper",
+										pos:     position{line: 92, col: 16, offset: 2251},
+										id:      167,
+										label:   "errAlphaUpper",
+										message: "errAlphaUpper",
 									},
 								},
 							},
 						},
 					},
 					&throwExpr{
-						pos:   position{line: 94, col: 5, offset: 2305},
-						label: "errOther",
+						pos:     position{line: 94, col: 5, offset: 2305},
+						id:      168,
+						label:   "errOther",
+						message: "errOther",
 					},
 				},
+				dispatch: &choiceDispatch{
+					ascii:     "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+					ranges:    []rune{'\u0080'},
+					rangeSets: "\x00",
+					alts:      [][]int{{1, 2, 3}, {0, 1, 2, 3}},
+					expected:  [][]string{{"[0-9]"}, {}},
+				},
 			},
 			leader:        false,
 			leftRecursive: false,
@@ -782,8 +979,10 @@ var g = &grammar{
 		{
 			name: "ErrAlphaInner04",
 			pos:  position{line: 96, col: 1, offset: 2318},
+			id:   21,
 			expr: &andCodeExpr{
 				pos: position{line: 96, col: 19, offset: 2336},
+				id:  169,
 				run: (*parser).callonErrAlphaInner041,
 			},
 			leader:        false,
@@ -792,25 +991,33 @@ var g = &grammar{
 		{
 			name: "ErrAlphaOuter04",
 			pos:  position{line: 100, col: 1, offset: 2364},
+			id:   22,
 			expr: &actionExpr{
 				pos: position{line: 100, col: 19, offset: 2382},
+				id:  170,
 				run: (*parser).callonErrAlphaOuter041,
 				expr: &seqExpr{
 					pos: position{line: 100, col: 19, offset: 2382},
+					id:  171,
 					exprs: []any{
 						&andCodeExpr{
 							pos: position{line: 100, col: 19, offset: 2382},
+							id:  172,
 							run: (*parser).callonErrAlphaOuter043,
 						},
 						&zeroOrMoreExpr{
 							pos: position{line: 102, col: 3, offset: 2449},
+							id:  173,
 							expr: &seqExpr{
 								pos: position{line: 102, col: 5, offset: 2451},
+								id:  174,
 								exprs: []any{
 									&notExpr{
 										pos: position{line: 102, col: 5, offset: 2451},
+										id:  175,
 										expr: &charClassMatcher{
 											pos:        position{line: 102, col: 6, offset: 2452},
+											id:         176,
 											val:        "[0-9]",
 											ranges:     []rune{'0', '9'},
 											ignoreCase: false,
@@ -818,7 +1025,8 @@ var g = &grammar{
 										},
 									},
 									&anyMatcher{
-										line: 102, col: 12, offset: 2458,
+										pos: position{line: 102, col: 12, offset: 2458},
+										id:  177,
 									},
 								},
 							},
@@ -832,25 +1040,33 @@ var g = &grammar{
 		{
 			name: "ErrOtherOuter04",
 			pos:  position{line: 104, col: 1, offset: 2484},
+			id:   23,
 			expr: &actionExpr{
 				pos: position{line: 104, col: 19, offset: 2502},
+				id:  178,
 				run: (*parser).callonErrOtherOuter041,
 				expr: &seqExpr{
 					pos: position{line: 104, col: 19, offset: 2502},
+					id:  179,
 					exprs: []any{
 						&andCodeExpr{
 							pos: position{line: 104, col: 19, offset: 2502},
+							id:  180,
 							run: (*parser).callonErrOtherOuter043,
 						},
 						&zeroOrMoreExpr{
 							pos: position{line: 106, col: 3, offset: 2573},
+							id:  181,
 							expr: &seqExpr{
 								pos: position{line: 106, col: 5, offset: 2575},
+								id:  182,
 								exprs: []any{
 									&notExpr{
 										pos: position{line: 106, col: 5, offset: 2575},
+										id:  183,
 										expr: &charClassMatcher{
 											pos:        position{line: 106, col: 6, offset: 2576},
+											id:         184,
 											val:        "[0-9]",
 											ranges:     []rune{'0', '9'},
 											ignoreCase: false,
@@ -858,7 +1074,8 @@ var g = &grammar{
 										},
 									},
 									&anyMatcher{
-										line: 106, col: 12, offset: 2582,
+										pos: position{line: 106, col: 12, offset: 2582},
+										id:  185,
 									},
 								},
 							},
@@ -1246,7 +1463,8 @@ func Entrypoint(ruleName string) Option {
 
 // Statistics adds a user provided Stats struct to the parser to allow
 // the user to process the results after the parsing has finished.
-// Also the key for the "no match" counter is set.
+// Also the key for the "no match" counter is set. Per-rule statistics
+// (see RuleStats) are only collected when this option is set.
 //
 // Example usage:
 //
@@ -1270,6 +1488,9 @@ func Statistics(stats *Stats, choiceNoMatch string) Option {
 		if p.Stats.ChoiceAltCnt == nil {
 			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
 		}
+		if p.Stats.Rules == nil {
+			p.Stats.Rules = make(map[string]*RuleStats)
+		}
 		return Statistics(oldStats, oldChoiceNoMatch)
 	}
 }
@@ -1290,6 +1511,9 @@ func Debug(b bool) Option {
 // the parser will cache all results so each expression is evaluated only
 // once. This guarantees linear parsing time even for pathological cases,
 // at the expense of more memory and slower times for typical cases.
+// The rules annotated with @memo in the grammar are always memoized,
+// regardless of this option. The memoization table is pooled, so its
+// storage is reused by the following parses.
 //
 // The default is false.
 func Memoize(b bool) Option {
@@ -1314,6 +1538,57 @@ func AllowInvalidUTF8(b bool) Option {
 	}
 }
 
+// ColumnUnit is the unit of the columns of the positions, see the Columns
+// option.
+type ColumnUnit int
+
+const (
+	// ColumnRunes counts the columns in runes.
+	ColumnRunes ColumnUnit = iota
+	// ColumnBytes counts the columns in bytes.
+	ColumnBytes
+	// ColumnUTF16 counts the columns in UTF-16 code units, as required by
+	// the Language Server Protocol.
+	ColumnUTF16
+)
+
+// Columns creates an Option to set the unit of the columns of the
+// positions reported in c.pos and in the errors.
+//
+// The default is ColumnRunes.
+func Columns(unit ColumnUnit) Option {
+	return func(p *parser) Option {
+		old := p.columnUnit
+		p.columnUnit = unit
+		return Columns(old)
+	}
+}
+
+// TabWidth creates an Option to set the width of a tab character. If n is
+// greater than 1, a tab advances the column to the next tab stop, every n
+// columns.
+//
+// The default is 1.
+func TabWidth(n int) Option {
+	return func(p *parser) Option {
+		old := p.tabWidth
+		p.tabWidth = n
+		return TabWidth(old)
+	}
+}
+
+// CRLF creates an Option to treat "\r\n" and a lone "\r" as single line
+// breaks, in addition to "\n".
+//
+// The default is false.
+func CRLF(b bool) Option {
+	return func(p *parser) Option {
+		old := p.crlf
+		p.crlf = b
+		return CRLF(old)
+	}
+}
+
 // Recover creates an Option to set the recover flag to b. When set to
 // true, this causes the parser to recover from panics and convert it
 // to an error. Setting it to false can be useful while debugging to
@@ -1405,6 +1680,10 @@ type current struct {
 	// This is always rolled back if a parsing rule fails.
 	state storeDict
 
+	// failure is the labeled failure that the recovery expression being
+	// parsed recovers from, nil outside of the recovery expressions.
+	failure *failure
+
 	// globalStore is a general store for the user to store arbitrary key-value
 	// pairs that they need to manage and that they do not want tied to the
 	// backtracking of the parser. This is only modified by the user and never
@@ -1415,6 +1694,27 @@ type current struct {
 
 type storeDict map[string]any
 
+// failure is a labeled failure thrown by a throw expression. It is an error
+// whose message is the message template of its label, which a recovery
+// code block may return to report it at its position.
+type failure struct {
+	label   string
+	message string
+	// pos is the position of the farthest failure at or after the throw
+	// expression, where the input did not match the expected values,
+	// otherwise the position of the throw expression.
+	pos      position
+	expected []string
+	// text is the text matched by the expression that the recovery
+	// expression protects, up to the throw expression.
+	text []byte
+}
+
+// Error returns the message of the failure.
+func (f *failure) Error() string {
+	return f.message
+}
+
 // the AST types...
 
 // nolint: structcheck
@@ -1430,19 +1730,70 @@ type rule struct {
 	displayName string
 	expr        any
 
+	// id identifies the rule in the memoization table, the expressions
+	// are numbered after the rules.
+	id int
+
 	leader        bool
 	leftRecursive bool
 }
 
+// choiceDispatch selects the alternatives of a choice that can match the
+// current rune, from the FIRST sets of the alternatives.
+//
+//	nolint: structcheck
+type choiceDispatch struct {
+	// index in alts of the set of each rune below utf8.RuneSelf, one byte
+	// per rune.
+	ascii string
+	// start of the ranges of runes from utf8.RuneSelf, and the index in
+	// alts of their set, one byte per range.
+	ranges    []rune
+	rangeSets string
+	// alts lists the alternatives of each set, the set at index 0 is used
+	// at EOF. expected lists the values expected by the other alternatives,
+	// which cannot match.
+	alts     [][]int
+	expected [][]string
+}
+
+// set returns the index of the set of alternatives that can match at pt.
+func (d *choiceDispatch) set(pt savepoint) int {
+	rn := pt.rn
+	switch {
+	case rn == utf8.RuneError && pt.w == 0:
+		// EOF - see utf8.DecodeRune
+		return 0
+	case rn < utf8.RuneSelf:
+		return int(d.ascii[rn])
+	}
+
+	// ranges[0] is utf8.RuneSelf, find the last range that starts at or
+	// before rn.
+	lo, hi := 0, len(d.ranges)
+	for hi-lo > 1 {
+		mid := int(uint(lo+hi) >> 1)
+		if d.ranges[mid] <= rn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return int(d.rangeSets[lo])
+}
+
 // nolint: structcheck
 type choiceExpr struct {
 	pos          position
+	id           int
 	alternatives []any
+	dispatch     *choiceDispatch
 }
 
 // nolint: structcheck
 type actionExpr struct {
 	pos  position
+	id   int
 	expr any
 	run  func(*parser) (any, error)
 }
@@ -1450,6 +1801,7 @@ type actionExpr struct {
 // nolint: structcheck
 type recoveryExpr struct {
 	pos          position
+	id           int
 	expr         any
 	recoverExpr  any
 	failureLabel []string
@@ -1458,18 +1810,22 @@ type recoveryExpr struct {
 // nolint: structcheck
 type seqExpr struct {
 	pos   position
+	id    int
 	exprs []any
 }
 
 // nolint: structcheck
 type throwExpr struct {
-	pos   position
-	label string
+	pos     position
+	id      int
+	label   string
+	message string
 }
 
 // nolint: structcheck
 type labeledExpr struct {
 	pos   position
+	id    int
 	label string
 	expr  any
 }
@@ -1477,7 +1833,18 @@ type labeledExpr struct {
 // nolint: structcheck
 type expr struct {
 	pos  position
+	id   int
+	expr any
+}
+
+// nolint: structcheck
+type repeatExpr struct {
+	pos  position
+	id   int
 	expr any
+	// max is -1 if the number of repetitions is not bounded.
+	min int
+	max int
 }
 
 type (
@@ -1491,30 +1858,35 @@ type (
 // nolint: structcheck
 type ruleRefExpr struct {
 	pos  position
+	id   int
 	name string
 }
 
 // nolint: structcheck
 type stateCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) error
 }
 
 // nolint: structcheck
 type andCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) (bool, error)
 }
 
 // nolint: structcheck
 type notCodeExpr struct {
 	pos position
+	id  int
 	run func(*parser) (bool, error)
 }
 
 // nolint: structcheck
 type litMatcher struct {
 	pos        position
+	id         int
 	val        string
 	ignoreCase bool
 	want       string
@@ -1523,6 +1895,7 @@ type litMatcher struct {
 // nolint: structcheck
 type charClassMatcher struct {
 	pos             position
+	id              int
 	val             string
 	basicLatinChars [128]bool
 	chars           []rune
@@ -1532,7 +1905,11 @@ type charClassMatcher struct {
 	inverted        bool
 }
 
-type anyMatcher position // nolint: structcheck
+// nolint: structcheck
+type anyMatcher struct {
+	pos position
+	id  int
+}
 
 // errList cumulates the errors found by the parser.
 type errList []error
@@ -1617,6 +1994,7 @@ func newParser(filename string, b []byte, opts ...Option) *parser {
 		entrypoint: g.rules[0].name,
 	}
 	p.setOptions(opts)
+	p.customPosition = p.columnUnit != ColumnRunes || p.tabWidth > 1 || p.crlf
 
 	if p.maxExprCnt == 0 {
 		p.maxExprCnt = math.MaxUint64
@@ -1652,7 +2030,8 @@ type Stats struct {
 	// ChoiceAltCnt is used to count for each ordered choice expression,
 	// which alternative is used how may times.
 	// These numbers allow to optimize the order of the ordered choice expression
-	// to increase the performance of the parser
+	// to increase the performance of the parser, see the -reorder-choices flag
+	// of pigeon.
 	//
 	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
 	// as the line and the column of the ordered choice.
@@ -1662,6 +2041,46 @@ type Stats struct {
 	// the parser option Statistics.
 	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
 	ChoiceAltCnt map[string]map[string]int
+
+	// Rules records profiling statistics for each rule, keyed by rule name.
+	// It is only filled when the parser is created with the Statistics
+	// option, as timing every rule invocation has a cost.
+	Rules map[string]*RuleStats
+}
+
+// RuleStats stores the profiling statistics of a single rule.
+type RuleStats struct {
+	// Invocations is the number of times the rule was invoked, Matches and
+	// Failures split it by outcome. The invocations that return memoized
+	// results are counted, but not the invocations inside an expression
+	// whose results are memoized, which are not repeated on a hit.
+	Invocations uint64
+	Matches     uint64
+	Failures    uint64
+
+	// Time is the cumulative time spent in the rule, including the rules it
+	// invoked, counted once for the recursive invocations of the rule.
+	// SelfTime excludes the time spent in the invoked rules.
+	Time     time.Duration
+	SelfTime time.Duration
+
+	// Bytes is the number of bytes consumed by the successful invocations.
+	Bytes uint64
+
+	// MemoHits and MemoMisses count the lookups in the memoization table,
+	// for the rule itself and for the expressions it contains. They stay at
+	// 0 if memoization is not enabled.
+	MemoHits   uint64
+	MemoMisses uint64
+
+	// Backtracked is the number of bytes the parser moved back while this
+	// rule was the innermost rule being parsed.
+	Backtracked uint64
+
+	// Repeats is the number of times the rule was evaluated at an offset
+	// where it had already been evaluated. Rules with many repeats are
+	// candidates for the @memo annotation.
+	Repeats uint64
 }
 
 type ruleWithExpsStack struct {
@@ -1683,9 +2102,9 @@ type parser struct {
 	debug   bool
 
 	memoize bool
-	// memoization table for the packrat algorithm:
-	// map[offset in source] map[expression or rule] {value, match}
-	memo map[int]map[any]resultTuple
+	// memoization table for the packrat algorithm, taken from memoTables
+	// on the first memoized result
+	memo *memoTable
 
 	// rules table, maps the rule identifier to the rule node
 	rules map[string]*rule
@@ -1706,11 +2125,33 @@ type parser struct {
 
 	allowInvalidUTF8 bool
 
+	// position options, customPosition is true if any of them is not the
+	// default
+	columnUnit     ColumnUnit
+	tabWidth       int
+	crlf           bool
+	customPosition bool
+
 	*Stats
+	// profiling frames, tracks the time spent in invoked rules to compute
+	// the self time of each rule.
+	profStack []profFrame
+	// number of active invocations of each rule, the time of a recursive
+	// rule is only added at its outermost invocation.
+	profActive map[*rule]int
+	// rules evaluated at each offset, to count the repeated evaluations.
+	profEvals map[profEval]bool
 
 	choiceNoMatch string
 	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
-	recoveryStack []map[string]any
+	recoveryStack []recovery
+}
+
+// recovery is an entry of the recovery stack, the recovery expressions of
+// the failure labels and the offset of the expression that they protect.
+type recovery struct {
+	exprs map[string]any
+	start int
 }
 
 // push a variable set on the vstack.
@@ -1749,7 +2190,7 @@ func (p *parser) popV() {
 func (p *parser) pushRecovery(labels []string, expr any) {
 	if cap(p.recoveryStack) == len(p.recoveryStack) {
 		// create new empty slot in the stack
-		p.recoveryStack = append(p.recoveryStack, nil)
+		p.recoveryStack = append(p.recoveryStack, recovery{})
 	} else {
 		// slice to 1 more
 		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
@@ -1759,24 +2200,73 @@ func (p *parser) pushRecovery(labels []string, expr any) {
 	for _, fl := range labels {
 		m[fl] = expr
 	}
-	p.recoveryStack[len(p.recoveryStack)-1] = m
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{exprs: m, start: p.pt.offset}
 }
 
 // pop a recovery expression from the recoveryStack
 func (p *parser) popRecovery() {
 	// GC that map
-	p.recoveryStack[len(p.recoveryStack)-1] = nil
+	p.recoveryStack[len(p.recoveryStack)-1] = recovery{}
 
 	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
 }
 
+// throw throws the failure of label, whose message template is message,
+// to the recovery expressions of the label from the innermost one until
+// one matches. Their code blocks get the failure as c.failure.
+func (p *parser) throw(label, message string) (any, bool) {
+	f := p.newFailure(label, message)
+	prev := p.cur.failure
+	for i := len(p.recoveryStack) - 1; i >= 0; i-- {
+		rec := p.recoveryStack[i]
+		recoverExpr, ok := rec.exprs[label]
+		if !ok {
+			continue
+		}
+		failure := *f
+		failure.text = p.data[rec.start:p.pt.offset]
+		p.cur.failure = &failure
+		val, ok := p.parseExprWrap(recoverExpr)
+		p.cur.failure = prev
+		if ok {
+			return val, ok
+		}
+	}
+	return nil, false
+}
+
+// newFailure returns the failure of label thrown at the current position,
+// see failure.pos. The placeholders {label}, {expected} and {found} of the
+// message template are replaced by the label, the expected values and the
+// input at the position of the failure.
+func (p *parser) newFailure(label, message string) *failure {
+	f := &failure{label: label, pos: p.pt.position}
+	if p.maxFailPos.offset >= p.pt.offset && len(p.maxFailExpected) > 0 {
+		f.pos = p.maxFailPos
+		f.expected = p.expectedList()
+	}
+
+	found := "EOF"
+	if f.pos.offset < len(p.data) {
+		rn, _ := utf8.DecodeRune(p.data[f.pos.offset:])
+		found = strconv.QuoteRune(rn)
+	}
+	f.message = strings.NewReplacer(
+		"{label}", label,
+		"{expected}", listJoin(f.expected, ", ", "or"),
+		"{found}", found,
+	).Replace(message)
+	return f
+}
+
 func (p *parser) print(prefix, s string) string {
 	if !p.debug {
 		return s
 	}
 
+	pos := p.pt.position
 	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
-		prefix, p.pt.line, p.pt.col, p.pt.offset, s, p.pt.rn)
+		prefix, pos.line, pos.col, pos.offset, s, p.pt.rn)
 	return s
 }
 
@@ -1800,6 +2290,10 @@ func (p *parser) addErr(err error) {
 }
 
 func (p *parser) addErrAt(err error, pos position, expected []string) {
+	var f *failure
+	if errors.As(err, &f) {
+		pos, expected = f.pos, f.expected
+	}
 	var buf bytes.Buffer
 	if p.filename != "" {
 		buf.WriteString(p.filename)
@@ -1844,14 +2338,19 @@ func (p *parser) failAt(fail bool, pos position, want string) {
 
 // read advances the parser to the next rune.
 func (p *parser) read() {
-	p.pt.offset += p.pt.w
+	prev, prevW := p.pt.rn, p.pt.w
+	p.pt.offset += prevW
 	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
 	p.pt.rn = rn
 	p.pt.w = n
-	p.pt.col++
-	if rn == '\n' {
-		p.pt.line++
-		p.pt.col = 0
+	if p.customPosition {
+		p.advancePosition(&p.pt.position, prev, prevW, rn)
+	} else {
+		p.pt.col++
+		if rn == '\n' {
+			p.pt.line++
+			p.pt.col = 0
+		}
 	}
 
 	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
@@ -1861,6 +2360,36 @@ func (p *parser) read() {
 	}
 }
 
+// advancePosition updates pos to the line and column of the rune rn,
+// which follows the rune prev of prevW bytes, as configured by the
+// Columns, TabWidth and CRLF options.
+func (p *parser) advancePosition(pos *position, prev rune, prevW int, rn rune) {
+	if rn == '\n' && prev == '\r' && p.crlf {
+		// the line break was counted on the '\r'
+		return
+	}
+	if rn == '\n' || rn == '\r' && p.crlf {
+		pos.line++
+		pos.col = 0
+		return
+	}
+
+	switch {
+	case pos.col == 0:
+		// first rune of the line
+		pos.col = 1
+	case prev == '\t' && p.tabWidth > 1:
+		pos.col += p.tabWidth - (pos.col-1)%p.tabWidth
+	case p.columnUnit == ColumnBytes:
+		pos.col += prevW
+	case p.columnUnit == ColumnUTF16 && prev > 0xFFFF:
+		// encoded as a surrogate pair
+		pos.col += 2
+	default:
+		pos.col++
+	}
+}
+
 // restore parser position to the savepoint pt.
 func (p *parser) restore(pt savepoint) {
 	if p.debug {
@@ -1869,6 +2398,9 @@ func (p *parser) restore(pt savepoint) {
 	if pt.offset == p.pt.offset {
 		return
 	}
+	if p.Rules != nil && pt.offset < p.pt.offset && len(p.rstack) > 0 {
+		p.ruleStats(p.rstack[len(p.rstack)-1]).Backtracked += uint64(p.pt.offset - pt.offset)
+	}
 	p.pt = pt
 }
 
@@ -1926,28 +2458,109 @@ func (p *parser) sliceFrom(start savepoint) []byte {
 	return p.data[start.position.offset:p.pt.position.offset]
 }
 
-func (p *parser) getMemoized(node any) (resultTuple, bool) {
-	if len(p.memo) == 0 {
-		return resultTuple{}, false
+// memoSlot is an entry of the memoization table, the key of an empty slot
+// is 0.
+type memoSlot struct {
+	key uint64
+	res resultTuple
+}
+
+// memoTable is the memoization table for the packrat algorithm, an
+// open-addressed hash table with linear probing that maps the offset in
+// the source and the id of a rule or expression to its result.
+type memoTable struct {
+	slots []memoSlot
+	shift uint
+	n     int
+}
+
+// memoTables pools the memoization tables so that their storage is
+// reused across parses.
+var memoTables = &sync.Pool{
+	New: func() any {
+		return &memoTable{slots: make([]memoSlot, 1<<10), shift: 64 - 10}
+	},
+}
+
+// memoKey returns the key of the result of the rule or expression id at
+// offset, it supports up to 1<<24 ids.
+func memoKey(offset, id int) uint64 {
+	return uint64(offset+1)<<24 | uint64(id)
+}
+
+// index returns the index of the slot of key, or of the empty slot where
+// it should be stored.
+func (t *memoTable) index(key uint64) int {
+	mask := len(t.slots) - 1
+	i := int((key*0x9E3779B97F4A7C15)>>t.shift) & mask
+	for t.slots[i].key != 0 && t.slots[i].key != key {
+		i = (i + 1) & mask
+	}
+	return i
+}
+
+func (t *memoTable) get(key uint64) (resultTuple, bool) {
+	s := &t.slots[t.index(key)]
+	return s.res, s.key == key
+}
+
+func (t *memoTable) set(key uint64, res resultTuple) {
+	if 4*(t.n+1) > 3*len(t.slots) {
+		t.grow()
+	}
+	s := &t.slots[t.index(key)]
+	if s.key == 0 {
+		s.key = key
+		t.n++
+	}
+	s.res = res
+}
+
+// grow doubles the capacity of the table.
+func (t *memoTable) grow() {
+	old := t.slots
+	t.slots = make([]memoSlot, 2*len(old))
+	t.shift--
+	for _, s := range old {
+		if s.key != 0 {
+			t.slots[t.index(s.key)] = s
+		}
 	}
-	m := p.memo[p.pt.offset]
-	if len(m) == 0 {
+}
+
+// reset empties the table, releasing the references to the results.
+func (t *memoTable) reset() {
+	if t.n == 0 {
+		return
+	}
+	for i := range t.slots {
+		t.slots[i] = memoSlot{}
+	}
+	t.n = 0
+}
+
+func (p *parser) getMemoized(id int) (resultTuple, bool) {
+	if p.memo == nil {
 		return resultTuple{}, false
 	}
-	res, ok := m[node]
-	return res, ok
+	return p.memo.get(memoKey(p.pt.offset, id))
 }
 
-func (p *parser) setMemoized(pt savepoint, node any, tuple resultTuple) {
+func (p *parser) setMemoized(pt savepoint, id int, tuple resultTuple) {
 	if p.memo == nil {
-		p.memo = make(map[int]map[any]resultTuple)
+		p.memo = memoTables.Get().(*memoTable)
 	}
-	m := p.memo[pt.offset]
-	if m == nil {
-		m = make(map[any]resultTuple)
-		p.memo[pt.offset] = m
+	p.memo.set(memoKey(pt.offset, id), tuple)
+}
+
+// releaseMemo returns the memoization table to the pool.
+func (p *parser) releaseMemo() {
+	if p.memo == nil {
+		return
 	}
-	m[node] = tuple
+	p.memo.reset()
+	memoTables.Put(p.memo)
+	p.memo = nil
 }
 
 func (p *parser) buildRulesTable(g *grammar) {
@@ -1966,6 +2579,7 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 
 	// TODO : not super critical but this could be generated
 	p.buildRulesTable(g)
+	defer p.releaseMemo()
 
 	if p.recover {
 		// panic can be used in action code to stop parsing immediately
@@ -1999,24 +2613,7 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 		if len(*p.errs) == 0 {
 			// If parsing fails, but no errors have been recorded, the expected values
 			// for the farthest parser position are returned as error.
-			maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
-			for _, v := range p.maxFailExpected {
-				maxFailExpectedMap[v] = struct{}{}
-			}
-			expected := make([]string, 0, len(maxFailExpectedMap))
-			eof := false
-			if _, ok := maxFailExpectedMap["!."]; ok {
-				delete(maxFailExpectedMap, "!.")
-				eof = true
-			}
-			for k := range maxFailExpectedMap {
-				expected = append(expected, k)
-			}
-			sort.Strings(expected)
-			if eof {
-				expected = append(expected, "EOF")
-			}
-			p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+			p.addNoMatchErr()
 		}
 
 		return nil, p.errs.err()
@@ -2024,6 +2621,36 @@ func (p *parser) parse(g *grammar) (val any, err error) {
 	return val, p.errs.err()
 }
 
+// addNoMatchErr adds the error that reports the expected values at the
+// farthest parser position.
+func (p *parser) addNoMatchErr() {
+	expected := p.expectedList()
+	p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
+}
+
+// expectedList returns the sorted values expected at the farthest parser
+// position, without duplicates and with EOF last.
+func (p *parser) expectedList() []string {
+	maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
+	for _, v := range p.maxFailExpected {
+		maxFailExpectedMap[v] = struct{}{}
+	}
+	expected := make([]string, 0, len(maxFailExpectedMap))
+	eof := false
+	if _, ok := maxFailExpectedMap["!."]; ok {
+		delete(maxFailExpectedMap, "!.")
+		eof = true
+	}
+	for k := range maxFailExpectedMap {
+		expected = append(expected, k)
+	}
+	sort.Strings(expected)
+	if eof {
+		expected = append(expected, "EOF")
+	}
+	return expected
+}
+
 func listJoin(list []string, sep string, lastSep string) string {
 	switch len(list) {
 	case 0:
@@ -2036,7 +2663,7 @@ func listJoin(list []string, sep string, lastSep string) string {
 }
 
 func (p *parser) parseRuleRecursiveLeader(rule *rule) (any, bool) {
-	result, ok := p.getMemoized(rule)
+	result, ok := p.getMemoized(rule.id)
 	if ok {
 		p.restore(result.end)
 		return result.v, result.b
@@ -2049,13 +2676,13 @@ func (p *parser) parseRuleRecursiveLeader(rule *rule) (any, bool) {
 	var (
 		depth      = 0
 		startMark  = p.pt
-		lastResult = resultTuple{nil, false, startMark}
+		lastResult = resultTuple{end: startMark}
 		lastErrors = *p.errs
 	)
 
 	for {
 		lastState := p.cloneState()
-		p.setMemoized(startMark, rule, lastResult)
+		p.setMemoized(startMark, rule.id, lastResult)
 		val, ok := p.parseRule(rule)
 		endMark := p.pt
 		if p.debug {
@@ -2068,14 +2695,14 @@ func (p *parser) parseRuleRecursiveLeader(rule *rule) (any, bool) {
 			*p.errs = lastErrors
 			break
 		}
-		lastResult = resultTuple{val, ok, endMark}
+		lastResult = resultTuple{v: val, b: ok, end: endMark}
 		lastErrors = *p.errs
 		p.restore(startMark)
 		depth++
 	}
 
 	p.restore(lastResult.end)
-	p.setMemoized(startMark, rule, lastResult)
+	p.setMemoized(startMark, rule.id, lastResult)
 	return lastResult.v, lastResult.b
 }
 
@@ -2083,8 +2710,90 @@ func (p *parser) parseRuleRecursiveNoLeader(rule *rule) (any, bool) {
 	return p.parseRule(rule)
 }
 
+// profFrame is a rule invocation being profiled.
+type profFrame struct {
+	start time.Time
+	child time.Duration
+}
+
+// profEval is the evaluation of a rule at an offset.
+type profEval struct {
+	rule   *rule
+	offset int
+}
+
+// ruleStats returns the statistics of rule, creating them if needed.
+func (p *parser) ruleStats(rule *rule) *RuleStats {
+	rs := p.Rules[rule.name]
+	if rs == nil {
+		rs = &RuleStats{}
+		p.Rules[rule.name] = rs
+	}
+	return rs
+}
+
+func (p *parser) countMemo(rs *RuleStats, hit bool) {
+	if hit {
+		rs.MemoHits++
+	} else {
+		rs.MemoMisses++
+	}
+}
+
+func (p *parser) profEnter(r *rule) {
+	if p.profActive == nil {
+		p.profActive = make(map[*rule]int)
+	}
+	p.profActive[r]++
+	p.profStack = append(p.profStack, profFrame{start: time.Now()})
+}
+
+// countRepeat counts the evaluation of rule at the current offset if
+// it was already evaluated at that offset.
+func (p *parser) countRepeat(rule *rule) {
+	if p.profEvals == nil {
+		p.profEvals = make(map[profEval]bool)
+	}
+	key := profEval{rule: rule, offset: p.pt.offset}
+	if p.profEvals[key] {
+		p.ruleStats(rule).Repeats++
+		return
+	}
+	p.profEvals[key] = true
+}
+
+func (p *parser) profExit(rule *rule, start savepoint, ok bool) {
+	frame := p.profStack[len(p.profStack)-1]
+	p.profStack = p.profStack[:len(p.profStack)-1]
+	elapsed := time.Since(frame.start)
+	if n := len(p.profStack); n > 0 {
+		p.profStack[n-1].child += elapsed
+	}
+
+	rs := p.ruleStats(rule)
+	rs.Invocations++
+	if p.profActive[rule]--; p.profActive[rule] == 0 {
+		// the time of the inner invocations is included
+		rs.Time += elapsed
+	}
+	rs.SelfTime += elapsed - frame.child
+	if ok {
+		rs.Matches++
+		rs.Bytes += uint64(p.pt.offset - start.offset)
+	} else {
+		rs.Failures++
+	}
+}
+
 func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
-	res, ok := p.getMemoized(rule)
+	if rule.id < 0 {
+		// its results depend on the captured texts or the state store
+		return p.parseRule(rule)
+	}
+	res, ok := p.getMemoized(rule.id)
+	if p.Rules != nil {
+		p.countMemo(p.ruleStats(rule), ok)
+	}
 	if ok {
 		p.restore(res.end)
 		return res.v, res.b
@@ -2092,7 +2801,7 @@ func (p *parser) parseRuleMemoize(rule *rule) (any, bool) {
 
 	startMark := p.pt
 	val, ok := p.parseRule(rule)
-	p.setMemoized(startMark, rule, resultTuple{val, ok, p.pt})
+	p.setMemoized(startMark, rule.id, resultTuple{v: val, b: ok, end: p.pt})
 
 	return val, ok
 }
@@ -2107,10 +2816,16 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 		startMark = p.pt
 	)
 
-	if p.memoize || rule.leftRecursive {
+	if p.Rules != nil {
+		p.profEnter(rule)
+		defer func() { p.profExit(rule, startMark, ok) }()
+	}
+
+	memoize := p.memoize
+	if memoize || rule.leftRecursive {
 		if rule.leader {
 			val, ok = p.parseRuleRecursiveLeader(rule)
-		} else if p.memoize && !rule.leftRecursive {
+		} else if memoize && !rule.leftRecursive {
 			val, ok = p.parseRuleMemoize(rule)
 		} else {
 			val, ok = p.parseRuleRecursiveNoLeader(rule)
@@ -2126,6 +2841,9 @@ func (p *parser) parseRuleWrap(rule *rule) (any, bool) {
 }
 
 func (p *parser) parseRule(rule *rule) (any, bool) {
+	if p.Rules != nil {
+		p.countRepeat(rule)
+	}
 	p.rstack = append(p.rstack, rule)
 	p.pushV()
 	val, ok := p.parseExprWrap(rule.expr)
@@ -2134,12 +2852,33 @@ func (p *parser) parseRule(rule *rule) (any, bool) {
 	return val, ok
 }
 
+// incChoiceAltCnt counts the match of the alternative altI of the choice
+// at pos, or its failure if altI is choiceNoMatch.
+func (p *parser) incChoiceAltCnt(pos position, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, pos.line, pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
 func (p *parser) parseExprWrap(expr any) (any, bool) {
 	var pt savepoint
+	id := exprID(expr)
 
 	isLeftRecursion := p.rstack[len(p.rstack)-1].leftRecursive
-	if p.memoize && !isLeftRecursion {
-		res, ok := p.getMemoized(expr)
+	if p.memoize && id >= 0 && !isLeftRecursion {
+		res, ok := p.getMemoized(id)
+		if p.Rules != nil {
+			p.countMemo(p.ruleStats(p.rstack[len(p.rstack)-1]), ok)
+		}
 		if ok {
 			p.restore(res.end)
 			return res.v, res.b
@@ -2149,12 +2888,62 @@ func (p *parser) parseExprWrap(expr any) (any, bool) {
 
 	val, ok := p.parseExpr(expr)
 
-	if p.memoize && !isLeftRecursion {
-		p.setMemoized(pt, expr, resultTuple{val, ok, p.pt})
+	if p.memoize && id >= 0 && !isLeftRecursion {
+		p.setMemoized(pt, id, resultTuple{v: val, b: ok, end: p.pt})
 	}
 	return val, ok
 }
 
+// exprID returns the id of the expression in the memoization table, -1 if
+// its results depend on the texts captured by the labels or on the state
+// store and are not memoized.
+//
+//	nolint: gocyclo
+func exprID(expr any) int {
+	switch expr := expr.(type) {
+	case *actionExpr:
+		return expr.id
+	case *andCodeExpr:
+		return expr.id
+	case *andExpr:
+		return expr.id
+	case *anyMatcher:
+		return expr.id
+	case *charClassMatcher:
+		return expr.id
+	case *choiceExpr:
+		return expr.id
+	case *labeledExpr:
+		return expr.id
+	case *litMatcher:
+		return expr.id
+	case *notCodeExpr:
+		return expr.id
+	case *notExpr:
+		return expr.id
+	case *oneOrMoreExpr:
+		return expr.id
+	case *recoveryExpr:
+		return expr.id
+	case *repeatExpr:
+		return expr.id
+	case *ruleRefExpr:
+		return expr.id
+	case *seqExpr:
+		return expr.id
+	case *stateCodeExpr:
+		return expr.id
+	case *throwExpr:
+		return expr.id
+	case *zeroOrMoreExpr:
+		return expr.id
+	case *zeroOrOneExpr:
+		return expr.id
+	default:
+		panic(fmt.Sprintf("unknown expression type %T", expr))
+	}
+}
+
 // nolint: gocyclo
 func (p *parser) parseExpr(expr any) (any, bool) {
 	p.ExprCnt++
@@ -2189,6 +2978,8 @@ func (p *parser) parseExpr(expr any) (any, bool) {
 		val, ok = p.parseOneOrMoreExpr(expr)
 	case *recoveryExpr:
 		val, ok = p.parseRecoveryExpr(expr)
+	case *repeatExpr:
+		val, ok = p.parseRepeatExpr(expr)
 	case *ruleRefExpr:
 		val, ok = p.parseRuleRefExpr(expr)
 	case *seqExpr:
@@ -2347,29 +3138,29 @@ func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (any, bool) {
 	return nil, false
 }
 
-func (p *parser) incChoiceAltCnt(ch *choiceExpr, altI int) {
-	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, ch.pos.line, ch.pos.col)
-	m := p.ChoiceAltCnt[choiceIdent]
-	if m == nil {
-		m = make(map[string]int)
-		p.ChoiceAltCnt[choiceIdent] = m
-	}
-	// We increment altI by 1, so the keys do not start at 0
-	alt := strconv.Itoa(altI + 1)
-	if altI == choiceNoMatch {
-		alt = p.choiceNoMatch
-	}
-	m[alt]++
-}
-
 func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseChoiceExpr"))
 	}
 
-	for altI, alt := range ch.alternatives {
-		// dummy assignment to prevent compile error if optimized
-		_ = altI
+	n := len(ch.alternatives)
+	var alts []int
+	if d := ch.dispatch; d != nil {
+		// the alternatives that cannot match the current rune are skipped,
+		// with the values they expect.
+		set := d.set(p.pt)
+		for _, want := range d.expected[set] {
+			p.failAt(false, p.pt.position, want)
+		}
+		alts = d.alts[set]
+		n = len(alts)
+	}
+	for i := 0; i < n; i++ {
+		altI := i
+		if alts != nil {
+			altI = alts[i]
+		}
+		alt := ch.alternatives[altI]
 
 		state := p.cloneState()
 
@@ -2377,12 +3168,12 @@ func (p *parser) parseChoiceExpr(ch *choiceExpr) (any, bool) {
 		val, ok := p.parseExprWrap(alt)
 		p.popV()
 		if ok {
-			p.incChoiceAltCnt(ch, altI)
+			p.incChoiceAltCnt(ch.pos, altI)
 			return val, ok
 		}
 		p.restoreState(state)
 	}
-	p.incChoiceAltCnt(ch, choiceNoMatch)
+	p.incChoiceAltCnt(ch.pos, choiceNoMatch)
 	return nil, false
 }
 
@@ -2491,6 +3282,33 @@ func (p *parser) parseRecoveryExpr(recover *recoveryExpr) (any, bool) {
 	return val, ok
 }
 
+func (p *parser) parseRepeatExpr(expr *repeatExpr) (any, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRepeatExpr"))
+	}
+
+	var vals []any
+
+	pt := p.pt
+	state := p.cloneState()
+	for expr.max < 0 || len(vals) < expr.max {
+		p.pushV()
+		val, ok := p.parseExprWrap(expr.expr)
+		p.popV()
+		if !ok {
+			break
+		}
+		vals = append(vals, val)
+	}
+	if len(vals) < expr.min {
+		// did not match enough times, no match
+		p.restoreState(state)
+		p.restore(pt)
+		return nil, false
+	}
+	return vals, true
+}
+
 func (p *parser) parseRuleRefExpr(ref *ruleRefExpr) (any, bool) {
 	if p.debug {
 		defer p.out(p.in("parseRuleRefExpr " + ref.name))
@@ -2546,15 +3364,7 @@ func (p *parser) parseThrowExpr(expr *throwExpr) (any, bool) {
 		defer p.out(p.in("parseThrowExpr"))
 	}
 
-	for i := len(p.recoveryStack) - 1; i >= 0; i-- {
-		if recoverExpr, ok := p.recoveryStack[i][expr.label]; ok {
-			if val, ok := p.parseExprWrap(recoverExpr); ok {
-				return val, ok
-			}
-		}
-	}
-
-	return nil, false
+	return p.throw(expr.label, expr.message)
 }
 
 func (p *parser) parseZeroOrMoreExpr(expr *zeroOrMoreExpr) (any, bool) {
@@ -0,0 +1 @@
+../linear_test.go
\ No newline at end of file
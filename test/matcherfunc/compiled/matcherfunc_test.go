@@ -0,0 +1 @@
+../matcherfunc_test.go
\ No newline at end of file
@@ -0,0 +1,50 @@
+package matcherfunc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatcherFunc(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "/* a /* b */ c */ #5:hello x", want: []any{"comment:2@0", "hello", "ident:x"}},
+		{in: "x /**/ #0: /*/**/*/", want: []any{"ident:x", "comment:1@2", "", "comment:2@11"}},
+		{in: "#9:/* x */ y", want: []any{"/* x */ y"}},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err != nil {
+			t.Errorf("%q: %v", tc.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%q: want %#v, got %#v", tc.in, tc.want, got)
+		}
+	}
+}
+
+func TestMatcherFuncError(t *testing.T) {
+	cases := []struct {
+		in  string
+		err string
+	}{
+		{in: "/* a", err: `1:1 (0): no match found, expected: "!", "#", @scanComment(), [ \t] or [a-z]`},
+		{in: "#3:ab", err: `1:2 (1): no match found, expected: @scanString()`},
+		{in: "a !", err: `1:4 (3): rule Invalid: @scanInvalid(): invalid length 1`},
+		// the length ends in the middle of the 2 bytes of the é
+		{in: "#1:é", err: `1:2 (1): rule String: @scanString(): invalid length 3`},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err == nil {
+			t.Errorf("%q: want error, got %#v", tc.in, got)
+			continue
+		}
+		if err.Error() != tc.err {
+			t.Errorf("%q: want error %q, got %q", tc.in, tc.err, err)
+		}
+	}
+}
@@ -0,0 +1 @@
+../max_expr_cnt_test.go
\ No newline at end of file
@@ -0,0 +1 @@
+../standard/memorules_test.go
\ No newline at end of file
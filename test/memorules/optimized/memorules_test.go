@@ -0,0 +1,15 @@
+package memorules
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMemoizedRules(t *testing.T) {
+	// without memoization of Expr and Term, this takes more than 3^30
+	// expressions.
+	in := strings.Repeat("(", 30) + "1*2+3" + strings.Repeat(")", 30)
+	if _, err := Parse("", []byte(in), MaxExpressions(100000)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,66 @@
+package memorules
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMemoizedRules(t *testing.T) {
+	// without memoization of Expr and Term, this takes more than 3^30
+	// expressions.
+	in := strings.Repeat("(", 30) + "1*2+3" + strings.Repeat(")", 30)
+	for _, memo := range []bool{false, true} {
+		stats := Stats{}
+		if _, err := Parse("", []byte(in), MaxExpressions(100000), Memoize(memo), Statistics(&stats, "no match")); err != nil {
+			t.Fatalf("memoize %t: %v", memo, err)
+		}
+		for _, nm := range []string{"Expr", "Term"} {
+			if rs := stats.Rules[nm]; rs.Repeats != 0 {
+				t.Errorf("memoize %t: want no repeated evaluation of %s, got %d", memo, nm, rs.Repeats)
+			}
+		}
+		if stats.Rules["Term"].MemoHits == 0 {
+			t.Errorf("memoize %t: want memo hits for Term", memo)
+		}
+		if !memo && stats.Rules["Atom"].Repeats == 0 {
+			t.Errorf("want repeated evaluations of Atom")
+		}
+	}
+}
+
+func TestMemoizedRulesError(t *testing.T) {
+	_, err := Parse("", []byte("(1+)"), MaxExpressions(100000))
+	if err == nil {
+		t.Fatal("want error")
+	}
+	if want := `1:4 (3): no match found, expected: "(" or [0-9]`; err.Error() != want {
+		t.Errorf("want %q, got %q", want, err)
+	}
+}
+
+func TestMemoTableReuse(t *testing.T) {
+	// large enough for the memoization table to grow, the results must be
+	// the same with and without memoization, and when the pooled table is
+	// reused.
+	in := strings.Repeat("(1+2*3-", 200) + "4" + strings.Repeat(")", 200)
+	want, err := Parse("", []byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var hits uint64
+	for i := 0; i < 3; i++ {
+		stats := Stats{}
+		got, err := Parse("", []byte(in), Memoize(true), Statistics(&stats, "no match"))
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: want %v, got %v", i, want, got)
+		}
+		if i > 0 && stats.Rules["Term"].MemoHits != hits {
+			t.Errorf("%d: want %d memo hits for Term, got %d", i, hits, stats.Rules["Term"].MemoHits)
+		}
+		hits = stats.Rules["Term"].MemoHits
+	}
+}
@@ -0,0 +1 @@
+../positions_test.go
\ No newline at end of file
@@ -0,0 +1,43 @@
+package positions
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPositions(t *testing.T) {
+	in := "a\tbé\r\n😀c\td\re\n\tf"
+	cases := []struct {
+		opts []Option
+		want []string
+	}{
+		{want: []string{"a@1:1", "bé@1:3", "😀c@2:1", "d@2:4", "e@2:6", "f@3:2"}},
+		{opts: []Option{Columns(ColumnBytes)}, want: []string{"a@1:1", "bé@1:3", "😀c@2:1", "d@2:7", "e@2:9", "f@3:2"}},
+		{opts: []Option{Columns(ColumnUTF16)}, want: []string{"a@1:1", "bé@1:3", "😀c@2:1", "d@2:5", "e@2:7", "f@3:2"}},
+		{opts: []Option{TabWidth(4)}, want: []string{"a@1:1", "bé@1:5", "😀c@2:1", "d@2:5", "e@2:7", "f@3:5"}},
+		{opts: []Option{CRLF(true)}, want: []string{"a@1:1", "bé@1:3", "😀c@2:1", "d@2:4", "e@3:1", "f@4:2"}},
+		{
+			opts: []Option{Columns(ColumnUTF16), TabWidth(8), CRLF(true)},
+			want: []string{"a@1:1", "bé@1:9", "😀c@2:1", "d@2:9", "e@3:1", "f@4:9"},
+		},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(in), tc.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%d options: want %q, got %q", len(tc.opts), tc.want, got)
+		}
+	}
+}
+
+func TestErrorPosition(t *testing.T) {
+	_, err := Parse("", []byte("é😀\r\n\tx!"), Columns(ColumnUTF16), TabWidth(4), CRLF(true))
+	if err == nil {
+		t.Fatal("want error")
+	}
+	if want := `2:6 (10): no match found, expected: [ \t\r\n], [^ \t\r\n!] or EOF`; err.Error() != want {
+		t.Errorf("want %q, got %q", want, err)
+	}
+}
@@ -0,0 +1 @@
+../precedence_test.go
\ No newline at end of file
@@ -0,0 +1,60 @@
+package precedence
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPrecedence(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "1", want: "1"},
+		{in: "1+2*3", want: []any{"+", "1", []any{"*", "2", "3"}}},
+		{in: "1 - 2 - 3", want: []any{"-", []any{"-", "1", "2"}, "3"}},
+		{in: "2^3^4", want: []any{"^", "2", []any{"^", "3", "4"}}},
+		{in: "-2^2", want: []any{"neg", []any{"^", "2", "2"}}},
+		{in: "-2*3", want: []any{"*", []any{"neg", "2"}, "3"}},
+		{in: "2 - -3", want: []any{"-", "2", []any{"neg", "3"}}},
+		{in: "(1+2) * 3!", want: []any{"*", []any{"+", "1", "2"}, []any{"!", "3"}}},
+		{in: "1 == 2 + 3", want: []any{"==", "1", []any{"+", "2", "3"}}},
+		{in: "1|2", want: []any{"1", []byte("|"), "2"}},
+	}
+	for _, tc := range cases {
+		for _, memo := range []bool{false, true} {
+			got, err := Parse("", []byte(tc.in), Memoize(memo))
+			if err != nil {
+				t.Errorf("%q: memoize %t: %v", tc.in, memo, err)
+				continue
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("%q: memoize %t: want %#v, got %#v", tc.in, memo, tc.want, got)
+			}
+		}
+	}
+}
+
+func TestPrecedenceError(t *testing.T) {
+	cases := []struct {
+		in  string
+		err string
+	}{
+		// the comparison does not chain
+		{in: "1<2<3", err: `1:4 (3): no match found, expected: "!", "*", "+", "-", "/", "^", "|", [ \t\n], [0-9] or EOF`},
+		{in: "1 + ", err: `1:5 (4): no match found, expected: "(", "-", [ \t\n] or [0-9]`},
+		{in: "1 * (2", err: `1:7 (6): no match found, expected: "!", ")", "*", "+", "-", "/", "<", "==", "^", "|", [ \t\n] or [0-9]`},
+	}
+	for _, tc := range cases {
+		for _, memo := range []bool{false, true} {
+			got, err := Parse("", []byte(tc.in), Memoize(memo))
+			if err == nil {
+				t.Errorf("%q: memoize %t: want error, got %#v", tc.in, memo, got)
+				continue
+			}
+			if err.Error() != tc.err {
+				t.Errorf("%q: memoize %t: want error %q, got %q", tc.in, memo, tc.err, err)
+			}
+		}
+	}
+}
@@ -0,0 +1 @@
+../predicates_test.go
\ No newline at end of file
@@ -0,0 +1 @@
+../regexp_test.go
\ No newline at end of file
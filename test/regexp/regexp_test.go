@@ -0,0 +1,53 @@
+package regexp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegexp(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "1 2.5 3e10 4.0E-2", want: []any{"number:1", "number:2.5", "number:3e10", "number:4.0E-2"}},
+		{in: "if IF iffy", want: []any{"keyword:if", "keyword:IF", "keyword:if", "ident:fy"}},
+		{in: "x1 été", want: []any{"ident:x1", "ident:été"}},
+		{in: `"a" "b\"c" ""`, want: []any{`string:"a"`, `string:"b\"c"`, `string:""`}},
+		{in: ` if"a"1 `, want: []any{"keyword:if", `string:"a"`, "number:1"}},
+		{in: "why 1why", want: []any{"word:why", "number:1", "ident:why"}},
+		{in: "# 1", want: []any{"hash", "number:1"}},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err != nil {
+			t.Errorf("%q: %v", tc.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%q: want %#v, got %#v", tc.in, tc.want, got)
+		}
+	}
+}
+
+func TestRegexpError(t *testing.T) {
+	cases := []struct {
+		in  string
+		err string
+	}{
+		{in: "", err: `1:1 (0): no match found, expected: re"(?i)if|iffy", re"[0-9]+(\.[0-9]+)?([eE][+-]?[0-9]+)?", re"\"(?:[^\"\\]|\\.)*\"", re"\bw[a-z]*", re"\pL[\pL\pN_]*" or re"^#"`},
+		// the hash is not at the start of the input
+		{in: "1 #", err: `1:3 (2): no match found, expected: re"(?i)if|iffy", re"[0-9]+(\.[0-9]+)?([eE][+-]?[0-9]+)?", re"\"(?:[^\"\\]|\\.)*\"", re"\bw[a-z]*", re"\pL[\pL\pN_]*", re"^#" or EOF`},
+		{in: `1 "a`, err: `1:3 (2): no match found, expected: re"(?i)if|iffy", re"[0-9]+(\.[0-9]+)?([eE][+-]?[0-9]+)?", re"\"(?:[^\"\\]|\\.)*\"", re"\bw[a-z]*", re"\pL[\pL\pN_]*", re"^#" or EOF`},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err == nil {
+			t.Errorf("%q: want error, got %#v", tc.in, got)
+			continue
+		}
+		if err.Error() != tc.err {
+			t.Errorf("%q: want error %q, got %q", tc.in, tc.err, err)
+		}
+	}
+}
@@ -0,0 +1 @@
+../repeat_test.go
\ No newline at end of file
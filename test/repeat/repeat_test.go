@@ -0,0 +1,53 @@
+package repeat
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRepeat(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "#a1B2c3", want: "#a1B2c3"},
+		{in: "#abc", want: "#abc"},
+		{in: "10.0.255.1", want: []any{10, 0, 255, 1}},
+		{in: "xx", want: 2},
+		{in: "xxxxx", want: 5},
+	}
+	for _, tc := range cases {
+		for _, memo := range []bool{false, true} {
+			got, err := Parse("", []byte(tc.in), Memoize(memo))
+			if err != nil {
+				t.Errorf("%q: memoize %t: %v", tc.in, memo, err)
+				continue
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("%q: memoize %t: want %#v, got %#v", tc.in, memo, tc.want, got)
+			}
+		}
+	}
+}
+
+func TestRepeatError(t *testing.T) {
+	cases := []struct {
+		in  string
+		err string
+	}{
+		{in: "#abcd", err: `1:6 (5): no match found, expected: [0-9a-f]i`},
+		{in: "1.2.3", err: `1:6 (5): no match found, expected: "." or [0-9]`},
+		{in: "1234.1.1.1", err: `1:4 (3): no match found, expected: "."`},
+		{in: "x", err: `1:2 (1): no match found, expected: "x"`},
+	}
+	for _, tc := range cases {
+		_, err := Parse("", []byte(tc.in))
+		if err == nil {
+			t.Errorf("%q: want error", tc.in)
+			continue
+		}
+		if err.Error() != tc.err {
+			t.Errorf("%q: want error %q, got %q", tc.in, tc.err, err)
+		}
+	}
+}
@@ -0,0 +1 @@
+../ruleparams_test.go
\ No newline at end of file
@@ -0,0 +1,52 @@
+package ruleparams
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRuleParams(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "(1)", want: []any{1}},
+		{in: "( 1, 2 ,3 )", want: []any{1, 2, 3}},
+		{in: "(a: 1, b: (2; 3), 4)", want: []any{
+			map[string]any{"a": 1},
+			map[string]any{"b": []any{2, 3}},
+			4,
+		}},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err != nil {
+			t.Errorf("%q: %v", tc.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%q: want %v, got %v", tc.in, tc.want, got)
+		}
+	}
+}
+
+func TestRuleParamsError(t *testing.T) {
+	_, err := Parse("", []byte("(a: 1, b: (2, 3))"))
+	if err == nil {
+		t.Fatal("want error")
+	}
+	if want := `1:13 (12): no match found, expected: ")", ";", [ \t\r\n] or [0-9]`; err.Error() != want {
+		t.Errorf("want %q, got %q", want, err)
+	}
+}
+
+func TestRuleInstances(t *testing.T) {
+	var names []string
+	for _, r := range g.rules {
+		names = append(names, r.name)
+	}
+	want := []string{"Start", "Entry", "Key", "Number", "_", "EOF", "Parens_1", "Pair_1", "Pair_2", "List_1", "Parens_2", "List_2"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("want rules %v, got %v", want, names)
+	}
+}
@@ -0,0 +1 @@
+../runeerror_test.go
\ No newline at end of file
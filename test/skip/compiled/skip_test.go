@@ -0,0 +1,52 @@
+package skip
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSkip(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "", want: []any(nil)},
+		{in: "a=1;", want: []any{[]any{"a", []any{1.0}}}},
+		{in: " # comment\n a = 1.5 + b . c ;\tb=(\"x y\" + 2);# end", want: []any{
+			[]any{"a", []any{1.5, "b . c"}},
+			[]any{"b", []any{[]any{"x y", 2.0}}},
+		}},
+		{in: "@a.b x = y;", want: []any{[]any{"@a.b", "x", []any{"y"}}}},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err != nil {
+			t.Errorf("%q: %v", tc.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%q: want %#v, got %#v", tc.in, tc.want, got)
+		}
+	}
+}
+
+func TestSkipLexical(t *testing.T) {
+	cases := []struct {
+		in  string
+		err string
+	}{
+		{in: "a = 1 .5;", err: `1:7 (6): no match found, expected: "#", "+", ";" or [ \t\r\n]`},
+		{in: "@a . b x = y;", err: `1:4 (3): no match found, expected: "#", [ \t\r\n] or [a-z]`},
+		{in: "ab c = 1;", err: `1:4 (3): no match found, expected: "#", "=" or [ \t\r\n]`},
+	}
+	for _, tc := range cases {
+		_, err := Parse("", []byte(tc.in))
+		if err == nil {
+			t.Errorf("%q: want error", tc.in)
+			continue
+		}
+		if err.Error() != tc.err {
+			t.Errorf("%q: want error %q, got %q", tc.in, tc.err, err)
+		}
+	}
+}
@@ -0,0 +1 @@
+../state_test.go
\ No newline at end of file
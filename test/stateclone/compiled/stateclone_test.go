@@ -0,0 +1 @@
+../stateclone_test.go
\ No newline at end of file
@@ -0,0 +1 @@
+../statereadonly_test.go
\ No newline at end of file
@@ -0,0 +1 @@
+../standard/staterestore_test.go
\ No newline at end of file
@@ -0,0 +1 @@
+../text_test.go
\ No newline at end of file
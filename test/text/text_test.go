@@ -0,0 +1,51 @@
+package text
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestText(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "a", want: []any{"a"}},
+		{in: "-42", want: []any{"-42"}},
+		{in: "a=1, (b), ( (c=-2) )", want: []any{
+			[]any{"a", "1"}, "b", []any{"c", "-2"},
+		}},
+		{in: `'x"y', "z'"`, want: []any{`x"y`, `z'`}},
+		{in: `""`, want: []any{""}},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err != nil {
+			t.Errorf("%q: %v", tc.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%q: want %#v, got %#v", tc.in, tc.want, got)
+		}
+	}
+}
+
+func TestTextError(t *testing.T) {
+	cases := []struct {
+		in  string
+		err string
+	}{
+		{in: "a=b", err: `1:3 (2): no match found, expected: "-" or [0-9]`},
+		{in: `'x"`, err: `1:4 (3): no match found, expected: "'" or .`},
+	}
+	for _, tc := range cases {
+		got, err := Parse("", []byte(tc.in))
+		if err == nil {
+			t.Errorf("%q: want error, got %#v", tc.in, got)
+			continue
+		}
+		if err.Error() != tc.err {
+			t.Errorf("%q: want error %q, got %q", tc.in, tc.err, err)
+		}
+	}
+}
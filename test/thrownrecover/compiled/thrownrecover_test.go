@@ -0,0 +1 @@
+../thrownrecover_test.go
\ No newline at end of file